@@ -25,14 +25,51 @@ func main() {
 		Version: version.String(),
 		Long: `ORC is a CLI tool for managing commissions, shipments, and tasks.
 It coordinates IMPs (Implementation Agents) working in isolated workbenches (worktrees).`,
-		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Shell completion runs on every <TAB>; skip side effects
+			if cmd.Name() == cobra.ShellCompRequestCmd || cmd.Name() == cobra.ShellCompNoDescRequestCmd {
+				return nil
+			}
+			// --plan must switch to the sandbox before anything opens the ledger
+			if err := cli.StartPlan(cmd); err != nil {
+				return err
+			}
+			cli.ConfigureTimeDisplay(cmd)
+			// Polled commands (tmux status line) skip startup entirely
+			if cli.Lightweight(cmd) {
+				return nil
+			}
 			// Detect actor identity at CLI startup
 			cli.DetectAndStoreActor()
 			// Apply global tmux bindings (idempotent, no-op if tmux not running)
 			cli.ApplyGlobalBindings()
+			// Warn on ledger/binary schema skew; refuse destructive commands if behind
+			if err := cli.CheckSchemaSkew(cmd); err != nil {
+				return err
+			}
+			// Refuse ledger writes from an actor throttled for runaway activity
+			if err := cli.CheckRateLimit(cmd); err != nil {
+				return err
+			}
+			// Accept aliases (e.g. auth-refactor) wherever an ID is expected
+			if err := cli.ResolveAliasArgs(cmd, args); err != nil {
+				return err
+			}
+			// Note which entities the command names, for "active 2m ago" markers
+			cli.RecordPresence(cmd, args)
+			// Default --commission/--shipment/--tome from focus where marked
+			return cli.InferFlags(cmd)
 		},
 	}
 
+	// Simulation: run against a copy of the ledger and print what would change
+	rootCmd.PersistentFlags().Bool("plan", false, "Show the records and changes a command would make, without making them")
+	// Time display: local zone and relative ages unless asked otherwise
+	rootCmd.PersistentFlags().Bool("utc", false, "Show times in UTC instead of the local timezone")
+	rootCmd.PersistentFlags().Bool("absolute", false, "Show dates and times instead of relative ages (\"2h ago\")")
+	// Focus inference: create commands default their container flags from focus
+	rootCmd.PersistentFlags().Bool("no-infer", false, "Don't default --commission, --shipment, or --tome from the workbench's focus")
+
 	// Add subcommands
 	rootCmd.AddCommand(cli.InitCmd())
 	rootCmd.AddCommand(cli.DoctorCmd())
@@ -41,6 +78,7 @@ It coordinates IMPs (Implementation Agents) working in isolated workbenches (wor
 	rootCmd.AddCommand(cli.TaskCmd())
 	rootCmd.AddCommand(cli.TagCmd())
 	rootCmd.AddCommand(cli.SummaryCmd())
+	rootCmd.AddCommand(cli.ReportCmd())
 	rootCmd.AddCommand(cli.StatusCmd())
 	rootCmd.AddCommand(cli.AttachCmd())
 	rootCmd.AddCommand(cli.ConnectCmd())
@@ -48,15 +86,29 @@ It coordinates IMPs (Implementation Agents) working in isolated workbenches (wor
 	rootCmd.AddCommand(cli.PrimeCmd())
 	rootCmd.AddCommand(cli.TestCmd())
 	rootCmd.AddCommand(cli.FocusCmd())
+	rootCmd.AddCommand(cli.ContextCmd())
+	rootCmd.AddCommand(cli.UndoCmd())
+	rootCmd.AddCommand(cli.LockCmd())
+	rootCmd.AddCommand(cli.RateLimitCmd())
+	rootCmd.AddCommand(cli.ActivityCmd())
+	rootCmd.AddCommand(cli.RecallCmd())
+	rootCmd.AddCommand(cli.PaletteCmd())
+	rootCmd.AddCommand(cli.ImportCmd())
+	rootCmd.AddCommand(cli.FlowCmd())
+	rootCmd.AddCommand(cli.LedgerCmd())
+	rootCmd.AddCommand(cli.DemoCmd())
 
 	// Entity commands (semantic model)
 	rootCmd.AddCommand(cli.NoteCmd())
 	rootCmd.AddCommand(cli.PlanCmd())
 	rootCmd.AddCommand(cli.TomeCmd())
+	rootCmd.AddCommand(cli.CommentCmd())
+	rootCmd.AddCommand(cli.GlossaryCmd())
 
 	// Repository and PR commands
 	rootCmd.AddCommand(cli.RepoCmd())
 	rootCmd.AddCommand(cli.PRCmd())
+	rootCmd.AddCommand(cli.SecretCmd())
 
 	// Infrastructure commands (Factory/Workshop/Workbench hierarchy)
 	rootCmd.AddCommand(cli.FactoryCmd())
@@ -68,6 +120,11 @@ It coordinates IMPs (Implementation Agents) working in isolated workbenches (wor
 	rootCmd.AddCommand(cli.ScaffoldCmd())
 	rootCmd.AddCommand(cli.DebugCmd())
 	rootCmd.AddCommand(cli.LogCmd())
+	rootCmd.AddCommand(cli.DBCmd())
+	rootCmd.AddCommand(cli.BackfillCmd())
+	rootCmd.AddCommand(cli.UpgradeCmd())
+	rootCmd.AddCommand(cli.MetricsCmd())
+	rootCmd.AddCommand(cli.WebhookCmd())
 
 	// Claude Code integration
 	rootCmd.AddCommand(cli.HookCmd())
@@ -75,7 +132,19 @@ It coordinates IMPs (Implementation Agents) working in isolated workbenches (wor
 	// Development utilities (orc-dev shim)
 	rootCmd.AddCommand(cli.DevCmd())
 
-	if err := rootCmd.Execute(); err != nil {
+	// Shell completion with live entity IDs (replaces cobra's static default)
+	rootCmd.CompletionOptions.DisableDefaultCmd = true
+	rootCmd.AddCommand(cli.CompletionCmd())
+	cli.RegisterDynamicCompletions(rootCmd)
+
+	// Opt-in local telemetry (ORC_TELEMETRY=1); must start before the ledger opens
+	cli.StartTelemetry()
+	cmd, err := rootCmd.ExecuteC()
+	err = cli.FinishRateLimit(err)
+	cli.FinishPresence()
+	cli.FinishPlan(cmd, err)
+	cli.FinishTelemetry(cmd, err)
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
@@ -43,7 +43,8 @@ func (a *CommissionAdapter) Create(ctx context.Context, title, description strin
 // List lists commissions with optional status filter.
 func (a *CommissionAdapter) List(ctx context.Context, status string) error {
 	commissions, err := a.service.ListCommissions(ctx, primary.CommissionFilters{
-		Status: status,
+		Status:      status,
+		WithRollups: true,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to list commissions: %w", err)
@@ -54,16 +55,29 @@ func (a *CommissionAdapter) List(ctx context.Context, status string) error {
 		return nil
 	}
 
-	fmt.Fprintf(a.out, "\n%-15s %-10s %s\n", "ID", "STATUS", "TITLE")
-	fmt.Fprintln(a.out, "────────────────────────────────────────────────────────────────")
+	fmt.Fprintf(a.out, "\n%-15s %-10s %5s %5s %6s %7s %7s  %-9s %s\n", "ID", "STATUS", "SHIPS", "READY", "ACTIVE", "BLOCKED", "APPROVE", "HEALTH", "TITLE")
+	fmt.Fprintln(a.out, "──────────────────────────────────────────────────────────────────────────────────────────")
 	for _, c := range commissions {
-		fmt.Fprintf(a.out, "%-15s %-10s %s\n", c.ID, c.Status, c.Title)
+		r := c.Rollup
+		if r == nil {
+			r = &primary.CommissionRollup{}
+		}
+		fmt.Fprintf(a.out, "%-15s %-10s %5d %5d %6d %7d %7d  %-9s %s\n",
+			c.ID, c.Status, r.OpenShipments, r.ReadyTasks, r.InProgressTasks, r.BlockedTasks, r.PendingApprovals, healthBadge(c.Status, r.Health), c.Title)
 	}
 	fmt.Fprintln(a.out)
 
 	return nil
 }
 
+// healthBadge returns the health column. Finished commissions have no badge.
+func healthBadge(status, health string) string {
+	if status == "complete" || status == "archived" || health == "" {
+		return "-"
+	}
+	return health
+}
+
 // Show displays details for a single commission.
 // Note: Related entities (shipments, tomes) are fetched separately by the CLI layer.
 func (a *CommissionAdapter) Show(ctx context.Context, commissionID string) (*primary.Commission, error) {
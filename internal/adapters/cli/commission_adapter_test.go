@@ -157,8 +157,10 @@ func TestCommissionAdapter_List_WithResults(t *testing.T) {
 	mock := &mockCommissionService{
 		listCommissionsFn: func(ctx context.Context, filters primary.CommissionFilters) ([]*primary.Commission, error) {
 			return []*primary.Commission{
-				{ID: "COMM-001", Title: "First", Status: "active"},
-				{ID: "COMM-002", Title: "Second", Status: "complete"},
+				{ID: "COMM-001", Title: "First", Status: "active", Rollup: &primary.CommissionRollup{
+					OpenShipments: 2, ReadyTasks: 3, InProgressTasks: 1, BlockedTasks: 4, PendingApprovals: 1, Health: "at-risk",
+				}},
+				{ID: "COMM-002", Title: "Second", Status: "complete", Rollup: &primary.CommissionRollup{Health: "ok"}},
 			}, nil
 		},
 	}
@@ -177,6 +179,29 @@ func TestCommissionAdapter_List_WithResults(t *testing.T) {
 	if !strings.Contains(output, "COMM-002") {
 		t.Errorf("expected output to contain 'COMM-002', got '%s'", output)
 	}
+	if !strings.Contains(output, "    2     3      1       4       1  at-risk   First") {
+		t.Errorf("expected rollup counts and badge for COMM-001, got '%s'", output)
+	}
+	if !strings.Contains(output, "  -         Second") {
+		t.Errorf("expected no badge for the complete commission, got '%s'", output)
+	}
+}
+
+func TestCommissionAdapter_List_RequestsRollups(t *testing.T) {
+	var captured primary.CommissionFilters
+	mock := &mockCommissionService{
+		listCommissionsFn: func(ctx context.Context, filters primary.CommissionFilters) ([]*primary.Commission, error) {
+			captured = filters
+			return []*primary.Commission{}, nil
+		},
+	}
+	adapter := NewCommissionAdapter(mock, &bytes.Buffer{})
+
+	_ = adapter.List(context.Background(), "")
+
+	if !captured.WithRollups {
+		t.Error("expected List to request rollups")
+	}
 }
 
 func TestCommissionAdapter_List_Empty(t *testing.T) {
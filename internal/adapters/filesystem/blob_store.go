@@ -0,0 +1,83 @@
+package filesystem
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// blobKeyPattern matches the hex SHA-256 keys DirBlobStore hands out.
+var blobKeyPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// DirBlobStore implements secondary.BlobStore as files named by their
+// SHA-256, fanned out by the first two hex digits (blobs/ab/abcd...).
+// The directory is created on first write.
+type DirBlobStore struct {
+	dir string
+}
+
+// NewDirBlobStore creates a blob store rooted at dir.
+func NewDirBlobStore(dir string) *DirBlobStore {
+	return &DirBlobStore{dir: dir}
+}
+
+// Put writes data under its hash. The write goes through a temp file and a
+// rename, so a reader never sees a partial blob.
+func (s *DirBlobStore) Put(data []byte) (string, error) {
+	sum := sha256.Sum256(data)
+	key := hex.EncodeToString(sum[:])
+	path := s.path(key)
+	if _, err := os.Stat(path); err == nil {
+		return key, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return "", fmt.Errorf("failed to create blob directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), key+".tmp-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to write blob: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return "", fmt.Errorf("failed to write blob: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", fmt.Errorf("failed to write blob: %w", err)
+	}
+	return key, nil
+}
+
+// Get reads the blob stored under key and checks it still matches its hash.
+func (s *DirBlobStore) Get(key string) ([]byte, error) {
+	if !blobKeyPattern.MatchString(key) {
+		return nil, fmt.Errorf("invalid blob key %q", key)
+	}
+	data, err := os.ReadFile(s.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("blob %s missing from %s", key, s.dir)
+		}
+		return nil, fmt.Errorf("failed to read blob: %w", err)
+	}
+	if sum := sha256.Sum256(data); hex.EncodeToString(sum[:]) != key {
+		return nil, fmt.Errorf("blob %s is corrupt", key)
+	}
+	return data, nil
+}
+
+func (s *DirBlobStore) path(key string) string {
+	return filepath.Join(s.dir, key[:2], key)
+}
+
+// Ensure DirBlobStore implements the interface
+var _ secondary.BlobStore = (*DirBlobStore)(nil)
@@ -0,0 +1,62 @@
+package filesystem_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/filesystem"
+)
+
+func TestDirBlobStore_RoundTrip(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "blobs")
+	store := filesystem.NewDirBlobStore(dir)
+
+	key, err := store.Put([]byte("investigation report"))
+	if err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if len(key) != 64 {
+		t.Fatalf("expected a hex SHA-256 key, got %q", key)
+	}
+	if _, err := os.Stat(filepath.Join(dir, key[:2], key)); err != nil {
+		t.Errorf("expected blob file under its prefix dir: %v", err)
+	}
+
+	again, err := store.Put([]byte("investigation report"))
+	if err != nil || again != key {
+		t.Errorf("second Put = %q, %v; want the same key", again, err)
+	}
+
+	data, err := store.Get(key)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if string(data) != "investigation report" {
+		t.Errorf("Get = %q", data)
+	}
+}
+
+func TestDirBlobStore_GetErrors(t *testing.T) {
+	dir := t.TempDir()
+	store := filesystem.NewDirBlobStore(dir)
+
+	if _, err := store.Get("../secret.key"); err == nil || !strings.Contains(err.Error(), "invalid blob key") {
+		t.Errorf("expected invalid key error, got %v", err)
+	}
+	if _, err := store.Get(strings.Repeat("a", 64)); err == nil || !strings.Contains(err.Error(), "missing") {
+		t.Errorf("expected missing blob error, got %v", err)
+	}
+
+	key, err := store.Put([]byte("original"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, key[:2], key), []byte("tampered"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Get(key); err == nil || !strings.Contains(err.Error(), "corrupt") {
+		t.Errorf("expected corrupt blob error, got %v", err)
+	}
+}
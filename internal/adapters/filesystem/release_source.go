@@ -0,0 +1,141 @@
+package filesystem
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// versionPackage is the import path whose variables carry build metadata.
+const versionPackage = "github.com/example/orc/internal/version"
+
+// GitReleaseSource implements secondary.ReleaseSource from a local clone of
+// the orc repository. Releases are commits on the remote's default branch
+// (origin/HEAD, falling back to origin/main); installs
+// build from a clean export of the commit, leaving the clone's working tree
+// untouched.
+type GitReleaseSource struct {
+	sourceDir string
+	remote    string
+}
+
+// NewGitReleaseSource creates a release source for the clone at sourceDir,
+// tracking origin.
+func NewGitReleaseSource(sourceDir string) *GitReleaseSource {
+	return &GitReleaseSource{sourceDir: sourceDir, remote: "origin"}
+}
+
+// Latest fetches the upstream branch and returns its head.
+func (s *GitReleaseSource) Latest(ctx context.Context) (*secondary.Release, error) {
+	if _, err := os.Stat(filepath.Join(s.sourceDir, ".git")); err != nil {
+		return nil, fmt.Errorf("orc source checkout not found at %s", s.sourceDir)
+	}
+
+	if out, err := s.git(ctx, "fetch", "--quiet", s.remote); err != nil {
+		return nil, fmt.Errorf("git fetch failed: %w: %s", err, out)
+	}
+
+	ref := s.remote + "/HEAD"
+	if _, err := s.git(ctx, "rev-parse", "--verify", "--quiet", ref); err != nil {
+		ref = s.remote + "/main"
+	}
+
+	out, err := s.git(ctx, "log", "-1", "--format=%H%x1f%s%x1f%cI", ref)
+	if err != nil {
+		return nil, fmt.Errorf("git log failed: %w: %s", err, out)
+	}
+	parts := strings.SplitN(strings.TrimSpace(out), "\x1f", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("unexpected git log output: %q", out)
+	}
+	return &secondary.Release{Commit: parts[0], Subject: parts[1], CommittedAt: parts[2]}, nil
+}
+
+// Contains reports whether commit is in the history of releaseCommit.
+func (s *GitReleaseSource) Contains(ctx context.Context, releaseCommit, commit string) (bool, error) {
+	cmd := exec.CommandContext(ctx, "git", "merge-base", "--is-ancestor", commit, releaseCommit)
+	cmd.Dir = s.sourceDir
+	err := cmd.Run()
+	if err == nil {
+		return true, nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		return false, nil
+	}
+	return false, fmt.Errorf("commit %s not found in %s", commit, s.sourceDir)
+}
+
+// Install builds releaseCommit and atomically replaces the binary at executablePath.
+// The new binary is built beside the old one and smoke-tested before the swap;
+// the old binary is kept as <path>.previous.
+func (s *GitReleaseSource) Install(ctx context.Context, releaseCommit, executablePath string) error {
+	buildDir, err := os.MkdirTemp("", "orc-upgrade-")
+	if err != nil {
+		return fmt.Errorf("failed to create build directory: %w", err)
+	}
+	defer os.RemoveAll(buildDir)
+
+	archive := filepath.Join(buildDir, "source.tar")
+	if out, err := s.git(ctx, "archive", "--format=tar", "-o", archive, releaseCommit); err != nil {
+		return fmt.Errorf("git archive failed: %w: %s", err, out)
+	}
+	extract := exec.CommandContext(ctx, "tar", "-xf", archive, "-C", buildDir)
+	if out, err := extract.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to extract source: %w: %s", err, out)
+	}
+
+	shortCommit, err := s.git(ctx, "rev-parse", "--short", releaseCommit)
+	if err != nil {
+		return fmt.Errorf("git rev-parse failed: %w: %s", err, shortCommit)
+	}
+	ldflags := fmt.Sprintf("-X '%s.Commit=%s' -X '%s.BuildTime=%s'",
+		versionPackage, strings.TrimSpace(shortCommit), versionPackage, time.Now().UTC().Format("2006-01-02 15:04:05"))
+
+	// Build next to the target so the final rename stays on one filesystem
+	newPath := executablePath + ".new"
+	build := exec.CommandContext(ctx, "go", "build", "-ldflags", ldflags, "-o", newPath, "./cmd/orc")
+	build.Dir = buildDir
+	if out, err := build.CombinedOutput(); err != nil {
+		os.Remove(newPath)
+		return fmt.Errorf("go build failed: %w: %s", err, out)
+	}
+
+	if out, err := exec.CommandContext(ctx, newPath, "--version").CombinedOutput(); err != nil {
+		os.Remove(newPath)
+		return fmt.Errorf("new binary failed to run: %w: %s", err, out)
+	}
+
+	previousPath := executablePath + ".previous"
+	if err := os.Rename(executablePath, previousPath); err != nil {
+		os.Remove(newPath)
+		return fmt.Errorf("failed to set aside current binary: %w", err)
+	}
+	if err := os.Rename(newPath, executablePath); err != nil {
+		// Put the old binary back so orc keeps working
+		if restoreErr := os.Rename(previousPath, executablePath); restoreErr != nil {
+			return fmt.Errorf("failed to install new binary (%v) and to restore the old one from %s: %w", err, previousPath, restoreErr)
+		}
+		os.Remove(newPath)
+		return fmt.Errorf("failed to install new binary: %w", err)
+	}
+	return nil
+}
+
+// git runs a git command in the source checkout and returns its combined output.
+func (s *GitReleaseSource) git(ctx context.Context, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = s.sourceDir
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
+
+// Ensure GitReleaseSource implements the interface
+var _ secondary.ReleaseSource = (*GitReleaseSource)(nil)
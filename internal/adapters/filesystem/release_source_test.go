@@ -0,0 +1,81 @@
+package filesystem_test
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/filesystem"
+)
+
+func TestGitReleaseSource_LatestAndContains(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	tmpDir := t.TempDir()
+	adapter, err := filesystem.NewWorkspaceAdapter(tmpDir, tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create adapter: %v", err)
+	}
+	ctx := context.Background()
+
+	// upstream has two commits; the clone is one behind until it fetches
+	setup := `git init -q -b main upstream
+cd upstream
+git -c user.name=t -c user.email=t@example.com commit -q --allow-empty -m "first"
+cd ..
+git clone -q upstream clone
+cd upstream
+git -c user.name=t -c user.email=t@example.com commit -q --allow-empty -m "second"
+cd ../clone
+git checkout -q -b side
+git -c user.name=t -c user.email=t@example.com commit -q --allow-empty -m "unpushed"`
+	if out, err := adapter.RunBootstrap(ctx, tmpDir, setup); err != nil {
+		t.Fatalf("failed to set up repos: %v\n%s", err, out)
+	}
+
+	source := filesystem.NewGitReleaseSource(filepath.Join(tmpDir, "clone"))
+
+	latest, err := source.Latest(ctx)
+	if err != nil {
+		t.Fatalf("Latest failed: %v", err)
+	}
+	if latest.Subject != "second" || len(latest.Commit) != 40 {
+		t.Errorf("unexpected release: %+v", latest)
+	}
+
+	first := gitOutput(t, filepath.Join(tmpDir, "clone"), "rev-parse", "--short", "main")
+	if ok, err := source.Contains(ctx, latest.Commit, first); err != nil || !ok {
+		t.Errorf("expected first commit to be in the release history, got %v, %v", ok, err)
+	}
+
+	unpushed := gitOutput(t, filepath.Join(tmpDir, "clone"), "rev-parse", "side")
+	if ok, err := source.Contains(ctx, latest.Commit, unpushed); err != nil || ok {
+		t.Errorf("expected unpushed commit to be outside the release history, got %v, %v", ok, err)
+	}
+
+	if _, err := source.Contains(ctx, latest.Commit, "unknown"); err == nil {
+		t.Error("expected error for unknown commit")
+	}
+}
+
+func TestGitReleaseSource_MissingCheckout(t *testing.T) {
+	source := filesystem.NewGitReleaseSource(filepath.Join(t.TempDir(), "missing"))
+	if _, err := source.Latest(context.Background()); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected checkout not found error, got %v", err)
+	}
+}
+
+func gitOutput(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("git %v failed: %v", args, err)
+	}
+	return strings.TrimSpace(string(out))
+}
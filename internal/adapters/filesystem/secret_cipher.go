@@ -0,0 +1,138 @@
+package filesystem
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// SecretKeyEnv overrides the key file with a base64-encoded 32-byte key.
+const SecretKeyEnv = "ORC_SECRET_KEY"
+
+// ciphertextPrefix versions the ciphertext format.
+const ciphertextPrefix = "v1:"
+
+// KeyFileCipher implements secondary.SecretCipher with AES-256-GCM, using a
+// key kept in a 0600 file beside the ledger. The key is created on first use,
+// so installs that never store a secret never get a key file.
+type KeyFileCipher struct {
+	keyPath string
+
+	once sync.Once
+	aead cipher.AEAD
+	err  error
+}
+
+// NewKeyFileCipher creates a cipher whose key lives at keyPath.
+func NewKeyFileCipher(keyPath string) *KeyFileCipher {
+	return &KeyFileCipher{keyPath: keyPath}
+}
+
+// Encrypt seals plaintext with a random nonce.
+func (c *KeyFileCipher) Encrypt(plaintext string) (string, error) {
+	aead, err := c.load()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return ciphertextPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a ciphertext produced by Encrypt.
+func (c *KeyFileCipher) Decrypt(ciphertext string) (string, error) {
+	aead, err := c.load()
+	if err != nil {
+		return "", err
+	}
+
+	encoded, ok := strings.CutPrefix(ciphertext, ciphertextPrefix)
+	if !ok {
+		return "", errors.New("unrecognized secret format")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", errors.New("corrupt secret value")
+	}
+
+	plaintext, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt secret (was the key at %s replaced?)", c.keyPath)
+	}
+	return string(plaintext), nil
+}
+
+// load reads (or creates) the key once and builds the AEAD.
+func (c *KeyFileCipher) load() (cipher.AEAD, error) {
+	c.once.Do(func() {
+		var key []byte
+		key, c.err = c.readKey()
+		if c.err != nil {
+			return
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			c.err = fmt.Errorf("invalid secret key: %w", err)
+			return
+		}
+		c.aead, c.err = cipher.NewGCM(block)
+	})
+	return c.aead, c.err
+}
+
+// readKey returns the key from ORC_SECRET_KEY or the key file, creating the file if needed.
+func (c *KeyFileCipher) readKey() ([]byte, error) {
+	if encoded := os.Getenv(SecretKeyEnv); encoded != "" {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(key) != 32 {
+			return nil, fmt.Errorf("%s must be a base64-encoded 32-byte key", SecretKeyEnv)
+		}
+		return key, nil
+	}
+
+	data, err := os.ReadFile(c.keyPath)
+	if err == nil {
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil || len(key) != 32 {
+			return nil, fmt.Errorf("secret key at %s is malformed", c.keyPath)
+		}
+		return key, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read secret key: %w", err)
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate secret key: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.keyPath), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create key directory: %w", err)
+	}
+	// O_EXCL: never clobber a key another process created in the meantime
+	f, err := os.OpenFile(c.keyPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create secret key: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(base64.StdEncoding.EncodeToString(key) + "\n"); err != nil {
+		return nil, fmt.Errorf("failed to write secret key: %w", err)
+	}
+	return key, nil
+}
+
+// Ensure KeyFileCipher implements the interface
+var _ secondary.SecretCipher = (*KeyFileCipher)(nil)
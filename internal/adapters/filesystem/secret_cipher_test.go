@@ -0,0 +1,66 @@
+package filesystem_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/filesystem"
+)
+
+func TestKeyFileCipher_RoundTrip(t *testing.T) {
+	t.Setenv(filesystem.SecretKeyEnv, "")
+	keyPath := filepath.Join(t.TempDir(), "secret.key")
+	c := filesystem.NewKeyFileCipher(keyPath)
+
+	ciphertext, err := c.Encrypt("ghp_example")
+	if err != nil {
+		t.Fatalf("Encrypt failed: %v", err)
+	}
+	if strings.Contains(ciphertext, "ghp_example") {
+		t.Fatal("ciphertext contains the plaintext")
+	}
+
+	info, err := os.Stat(keyPath)
+	if err != nil {
+		t.Fatalf("expected key file to be created: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("key file mode = %v, want 0600", info.Mode().Perm())
+	}
+
+	// A fresh cipher reads the same key from disk
+	plaintext, err := filesystem.NewKeyFileCipher(keyPath).Decrypt(ciphertext)
+	if err != nil {
+		t.Fatalf("Decrypt failed: %v", err)
+	}
+	if plaintext != "ghp_example" {
+		t.Errorf("Decrypt = %q, want %q", plaintext, "ghp_example")
+	}
+}
+
+func TestKeyFileCipher_WrongKey(t *testing.T) {
+	t.Setenv(filesystem.SecretKeyEnv, "")
+	dir := t.TempDir()
+
+	ciphertext, err := filesystem.NewKeyFileCipher(filepath.Join(dir, "a.key")).Encrypt("value")
+	if err != nil {
+		t.Fatalf("Encrypt failed: %v", err)
+	}
+	if _, err := filesystem.NewKeyFileCipher(filepath.Join(dir, "b.key")).Decrypt(ciphertext); err == nil {
+		t.Error("expected decrypt with a different key to fail")
+	}
+}
+
+func TestKeyFileCipher_EnvKey(t *testing.T) {
+	t.Setenv(filesystem.SecretKeyEnv, "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=")
+	keyPath := filepath.Join(t.TempDir(), "secret.key")
+
+	if _, err := filesystem.NewKeyFileCipher(keyPath).Encrypt("value"); err != nil {
+		t.Fatalf("Encrypt failed: %v", err)
+	}
+	if _, err := os.Stat(keyPath); !os.IsNotExist(err) {
+		t.Error("expected no key file when the key comes from the environment")
+	}
+}
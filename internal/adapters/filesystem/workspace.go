@@ -3,10 +3,14 @@ package filesystem
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/example/orc/internal/ports/secondary"
 )
@@ -112,6 +116,322 @@ func (a *WorkspaceAdapter) DirectoryExists(ctx context.Context, path string) (bo
 	return info.IsDir(), nil
 }
 
+// RunBootstrap executes a bootstrap script inside workdir with sh -e, so the
+// first failing command aborts the run. Returns combined stdout/stderr.
+func (a *WorkspaceAdapter) RunBootstrap(ctx context.Context, workdir, script string) (string, error) {
+	if _, err := os.Stat(workdir); os.IsNotExist(err) {
+		return "", fmt.Errorf("workdir not found at %s", workdir)
+	}
+
+	cmd := exec.CommandContext(ctx, "sh", "-e", "-c", script)
+	cmd.Dir = workdir
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(output), fmt.Errorf("bootstrap script failed: %w", err)
+	}
+
+	return string(output), nil
+}
+
+// ListBranchCommits returns commits on HEAD that are not on baseRef
+// (git log baseRef..HEAD), newest first.
+func (a *WorkspaceAdapter) ListBranchCommits(ctx context.Context, workdir, baseRef string) ([]secondary.CommitInfo, error) {
+	if _, err := os.Stat(workdir); os.IsNotExist(err) {
+		return nil, fmt.Errorf("workdir not found at %s", workdir)
+	}
+
+	// Fields are separated by US (0x1f) and records by RS (0x1e) so that
+	// multi-line commit bodies survive parsing.
+	cmd := exec.CommandContext(ctx, "git", "log", baseRef+"..HEAD", "--format=%H%x1f%s%x1f%B%x1f%cI%x1e")
+	cmd.Dir = workdir
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git log failed: %w", err)
+	}
+
+	var commits []secondary.CommitInfo
+	for _, record := range strings.Split(string(output), "\x1e") {
+		record = strings.TrimSpace(record)
+		if record == "" {
+			continue
+		}
+		fields := strings.Split(record, "\x1f")
+		if len(fields) != 4 {
+			continue
+		}
+		committedAt, _ := time.Parse(time.RFC3339, strings.TrimSpace(fields[3]))
+		commits = append(commits, secondary.CommitInfo{
+			SHA:         fields[0],
+			Subject:     fields[1],
+			Message:     strings.TrimSpace(fields[2]),
+			CommittedAt: committedAt,
+		})
+	}
+	return commits, nil
+}
+
+// ListBranchCommitFiles returns the files touched by each commit on HEAD
+// that is not on baseRef, keyed by SHA.
+func (a *WorkspaceAdapter) ListBranchCommitFiles(ctx context.Context, workdir, baseRef string) (map[string][]string, error) {
+	if _, err := os.Stat(workdir); os.IsNotExist(err) {
+		return nil, fmt.Errorf("workdir not found at %s", workdir)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "log", baseRef+"..HEAD", "--no-renames", "--name-only", "--format=%x1e%H")
+	cmd.Dir = workdir
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git log failed: %w", err)
+	}
+
+	files := make(map[string][]string)
+	for _, record := range strings.Split(string(output), "\x1e") {
+		lines := strings.Split(strings.TrimSpace(record), "\n")
+		if len(lines) == 0 || lines[0] == "" {
+			continue
+		}
+		sha := lines[0]
+		for _, line := range lines[1:] {
+			if line = strings.TrimSpace(line); line != "" {
+				files[sha] = append(files[sha], line)
+			}
+		}
+	}
+	return files, nil
+}
+
+// ListWorkingChanges returns the paths with uncommitted changes in workdir
+// (git status --porcelain), including untracked files. Renames report the new path.
+func (a *WorkspaceAdapter) ListWorkingChanges(ctx context.Context, workdir string) ([]string, error) {
+	if _, err := os.Stat(workdir); os.IsNotExist(err) {
+		return nil, fmt.Errorf("workdir not found at %s", workdir)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "status", "--porcelain", "--untracked-files=all", "--no-renames", "-z")
+	cmd.Dir = workdir
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git status failed: %w", err)
+	}
+
+	var paths []string
+	for _, entry := range strings.Split(string(output), "\x00") {
+		if len(entry) > 3 {
+			paths = append(paths, entry[3:])
+		}
+	}
+	return paths, nil
+}
+
+// StashWorkingChanges stashes the uncommitted changes in workdir
+// (git stash push --include-untracked) and returns the new stash commit SHA.
+// The stash list is shared by every worktree of the repository, so the SHA
+// can be applied from any of them.
+func (a *WorkspaceAdapter) StashWorkingChanges(ctx context.Context, workdir, message string) (string, error) {
+	if _, err := os.Stat(workdir); os.IsNotExist(err) {
+		return "", fmt.Errorf("workdir not found at %s", workdir)
+	}
+
+	before := a.stashHead(ctx, workdir)
+
+	cmd := exec.CommandContext(ctx, "git", "stash", "push", "--include-untracked", "-m", message)
+	cmd.Dir = workdir
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("git stash failed: %w\n%s", err, output)
+	}
+
+	after := a.stashHead(ctx, workdir)
+	if after == "" || after == before {
+		return "", fmt.Errorf("git stash saved nothing: no local changes in %s", workdir)
+	}
+	return after, nil
+}
+
+// ApplyStash applies a stash commit to workdir (git stash apply), restoring
+// untracked files too. The stash entry is kept.
+func (a *WorkspaceAdapter) ApplyStash(ctx context.Context, workdir, sha string) error {
+	if _, err := os.Stat(workdir); os.IsNotExist(err) {
+		return fmt.Errorf("workdir not found at %s", workdir)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "stash", "apply", sha)
+	cmd.Dir = workdir
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("git stash apply failed: %w\n%s", err, output)
+	}
+	return nil
+}
+
+// DropStash removes the stash entry whose commit is sha. It does nothing if
+// the entry was already dropped or popped by hand.
+func (a *WorkspaceAdapter) DropStash(ctx context.Context, workdir, sha string) error {
+	cmd := exec.CommandContext(ctx, "git", "stash", "list", "--format=%H")
+	cmd.Dir = workdir
+
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("git stash list failed: %w", err)
+	}
+
+	for i, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if line != sha {
+			continue
+		}
+		drop := exec.CommandContext(ctx, "git", "stash", "drop", "-q", fmt.Sprintf("stash@{%d}", i))
+		drop.Dir = workdir
+		if output, err := drop.CombinedOutput(); err != nil {
+			return fmt.Errorf("git stash drop failed: %w\n%s", err, output)
+		}
+		return nil
+	}
+	return nil
+}
+
+// stashHead returns the SHA of the newest stash entry, or "" if there is none.
+func (a *WorkspaceAdapter) stashHead(ctx context.Context, workdir string) string {
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "-q", "--verify", "refs/stash")
+	cmd.Dir = workdir
+	output, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// DiffBranchStat returns per-file line counts for HEAD against its merge
+// base with baseRef (git diff --numstat baseRef...HEAD).
+func (a *WorkspaceAdapter) DiffBranchStat(ctx context.Context, workdir, baseRef string) ([]secondary.FileChange, error) {
+	if _, err := os.Stat(workdir); os.IsNotExist(err) {
+		return nil, fmt.Errorf("workdir not found at %s", workdir)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "diff", "--numstat", "--no-renames", baseRef+"...HEAD")
+	cmd.Dir = workdir
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git diff failed: %w", err)
+	}
+
+	var changes []secondary.FileChange
+	for _, line := range strings.Split(string(output), "\n") {
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		change := secondary.FileChange{Path: fields[2]}
+		if fields[0] == "-" && fields[1] == "-" {
+			change.Binary = true
+		} else {
+			change.Added, _ = strconv.Atoi(fields[0])
+			change.Deleted, _ = strconv.Atoi(fields[1])
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+// DiffBranchPatch returns the unified diff of HEAD against its merge base
+// with baseRef, limited to paths when any are given.
+func (a *WorkspaceAdapter) DiffBranchPatch(ctx context.Context, workdir, baseRef string, paths []string) (string, error) {
+	if _, err := os.Stat(workdir); os.IsNotExist(err) {
+		return "", fmt.Errorf("workdir not found at %s", workdir)
+	}
+
+	args := []string{"diff", "--no-renames", baseRef + "...HEAD"}
+	if len(paths) > 0 {
+		args = append(append(args, "--"), paths...)
+	}
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = workdir
+
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git diff failed: %w", err)
+	}
+	return string(output), nil
+}
+
+// IsGitRepository reports whether path is inside a git repository.
+func (a *WorkspaceAdapter) IsGitRepository(ctx context.Context, path string) (bool, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return false, nil
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "rev-parse", "--git-dir")
+	cmd.Dir = path
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return false, nil
+		}
+		return false, fmt.Errorf("git rev-parse failed: %w", err)
+	}
+	return true, nil
+}
+
+// GetRemoteURL returns the configured URL of remote in repoPath, or "" if
+// the remote does not exist.
+func (a *WorkspaceAdapter) GetRemoteURL(ctx context.Context, repoPath, remote string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", "remote", "get-url", remote)
+	cmd.Dir = repoPath
+
+	output, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", nil
+		}
+		return "", fmt.Errorf("git remote get-url failed: %w", err)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// BranchExists reports whether branch exists as a local branch or as a
+// remote-tracking branch of origin.
+func (a *WorkspaceAdapter) BranchExists(ctx context.Context, repoPath, branch string) (bool, error) {
+	for _, ref := range []string{"refs/heads/" + branch, "refs/remotes/origin/" + branch} {
+		cmd := exec.CommandContext(ctx, "git", "show-ref", "--verify", "--quiet", ref)
+		cmd.Dir = repoPath
+		err := cmd.Run()
+		if err == nil {
+			return true, nil
+		}
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			return false, fmt.Errorf("git show-ref failed: %w", err)
+		}
+	}
+	return false, nil
+}
+
+// ListWorktrees returns the paths of worktrees registered with repoPath
+// (git worktree list --porcelain), excluding the main working tree.
+func (a *WorkspaceAdapter) ListWorktrees(ctx context.Context, repoPath string) ([]string, error) {
+	cmd := exec.CommandContext(ctx, "git", "worktree", "list", "--porcelain")
+	cmd.Dir = repoPath
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git worktree list failed: %w", err)
+	}
+
+	var paths []string
+	for _, line := range strings.Split(string(output), "\n") {
+		if path, ok := strings.CutPrefix(line, "worktree "); ok {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) > 0 {
+		paths = paths[1:] // the main working tree is always listed first
+	}
+	return paths, nil
+}
+
 // GetWorktreesBasePath returns the base path for worktrees (e.g., ~/wb).
 func (a *WorkspaceAdapter) GetWorktreesBasePath() string {
 	return a.worktreesBasePath
@@ -3,7 +3,9 @@ package filesystem_test
 import (
 	"context"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/example/orc/internal/adapters/filesystem"
@@ -141,3 +143,302 @@ func TestWorkspaceAdapter_WorktreeExists(t *testing.T) {
 		t.Error("expected worktree to exist")
 	}
 }
+
+func TestWorkspaceAdapter_RunBootstrap(t *testing.T) {
+	tmpDir := t.TempDir()
+	adapter, err := filesystem.NewWorkspaceAdapter(tmpDir, tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create adapter: %v", err)
+	}
+
+	ctx := context.Background()
+
+	// Script runs inside workdir
+	output, err := adapter.RunBootstrap(ctx, tmpDir, "touch .envrc\necho bootstrapped")
+	if err != nil {
+		t.Fatalf("RunBootstrap failed: %v", err)
+	}
+	if output != "bootstrapped\n" {
+		t.Errorf("expected output %q, got %q", "bootstrapped\n", output)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, ".envrc")); err != nil {
+		t.Errorf("expected script to run in workdir: %v", err)
+	}
+
+	// First failing command aborts the script
+	output, err = adapter.RunBootstrap(ctx, tmpDir, "echo before\nfalse\necho after")
+	if err == nil {
+		t.Fatal("expected error from failing script")
+	}
+	if output != "before\n" {
+		t.Errorf("expected script to stop at failure, got %q", output)
+	}
+}
+
+func TestWorkspaceAdapter_ListBranchCommits(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	tmpDir := t.TempDir()
+	adapter, err := filesystem.NewWorkspaceAdapter(tmpDir, tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create adapter: %v", err)
+	}
+
+	ctx := context.Background()
+
+	setup := `git init -q -b main
+git -c user.name=t -c user.email=t@example.com commit -q --allow-empty -m "initial"
+git checkout -q -b feature
+git -c user.name=t -c user.email=t@example.com commit -q --allow-empty -m "TASK-001: first" -m "Part of SHIP-001"
+git -c user.name=t -c user.email=t@example.com commit -q --allow-empty -m "second"`
+	if out, err := adapter.RunBootstrap(ctx, tmpDir, setup); err != nil {
+		t.Fatalf("failed to set up repo: %v\n%s", err, out)
+	}
+
+	commits, err := adapter.ListBranchCommits(ctx, tmpDir, "main")
+	if err != nil {
+		t.Fatalf("ListBranchCommits failed: %v", err)
+	}
+	if len(commits) != 2 {
+		t.Fatalf("expected 2 branch commits, got %d", len(commits))
+	}
+
+	// Newest first
+	if commits[0].Subject != "second" {
+		t.Errorf("expected newest commit first, got %q", commits[0].Subject)
+	}
+	if commits[1].Subject != "TASK-001: first" {
+		t.Errorf("expected subject %q, got %q", "TASK-001: first", commits[1].Subject)
+	}
+	if commits[1].Message != "TASK-001: first\n\nPart of SHIP-001" {
+		t.Errorf("expected full message with body, got %q", commits[1].Message)
+	}
+	if len(commits[1].SHA) != 40 {
+		t.Errorf("expected full SHA, got %q", commits[1].SHA)
+	}
+	if commits[1].CommittedAt.IsZero() {
+		t.Error("expected commit time to be parsed")
+	}
+}
+
+func TestWorkspaceAdapter_DiffBranch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	tmpDir := t.TempDir()
+	adapter, err := filesystem.NewWorkspaceAdapter(tmpDir, tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create adapter: %v", err)
+	}
+
+	ctx := context.Background()
+
+	setup := `git init -q -b main
+printf 'a\nb\n' > kept.txt
+git add kept.txt
+git -c user.name=t -c user.email=t@example.com commit -q -m "initial"
+git checkout -q -b feature
+printf 'a\nc\nd\n' > kept.txt
+printf 'new\n' > added.txt
+git add kept.txt added.txt
+git -c user.name=t -c user.email=t@example.com commit -q -m "TASK-001: edit"
+printf 'more\n' >> added.txt
+git -c user.name=t -c user.email=t@example.com commit -q -am "TASK-002: extend"`
+	if out, err := adapter.RunBootstrap(ctx, tmpDir, setup); err != nil {
+		t.Fatalf("failed to set up repo: %v\n%s", err, out)
+	}
+
+	changes, err := adapter.DiffBranchStat(ctx, tmpDir, "main")
+	if err != nil {
+		t.Fatalf("DiffBranchStat failed: %v", err)
+	}
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changed files, got %d", len(changes))
+	}
+	if changes[0].Path != "added.txt" || changes[0].Added != 2 || changes[0].Deleted != 0 {
+		t.Errorf("unexpected change for added.txt: %+v", changes[0])
+	}
+	if changes[1].Path != "kept.txt" || changes[1].Added != 2 || changes[1].Deleted != 1 {
+		t.Errorf("unexpected change for kept.txt: %+v", changes[1])
+	}
+
+	patch, err := adapter.DiffBranchPatch(ctx, tmpDir, "main", []string{"kept.txt"})
+	if err != nil {
+		t.Fatalf("DiffBranchPatch failed: %v", err)
+	}
+	if !strings.Contains(patch, "+++ b/kept.txt") || strings.Contains(patch, "added.txt") {
+		t.Errorf("expected patch limited to kept.txt, got:\n%s", patch)
+	}
+
+	files, err := adapter.ListBranchCommitFiles(ctx, tmpDir, "main")
+	if err != nil {
+		t.Fatalf("ListBranchCommitFiles failed: %v", err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expected files for 2 commits, got %d", len(files))
+	}
+	for sha, paths := range files {
+		if len(sha) != 40 || len(paths) == 0 {
+			t.Errorf("unexpected entry %q: %v", sha, paths)
+		}
+	}
+}
+
+func TestWorkspaceAdapter_ListWorkingChanges(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	tmpDir := t.TempDir()
+	adapter, err := filesystem.NewWorkspaceAdapter(tmpDir, tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create adapter: %v", err)
+	}
+
+	ctx := context.Background()
+
+	setup := `git init -q -b main
+printf 'a\n' > kept.txt
+printf 'a\n' > edited.txt
+git add kept.txt edited.txt
+git -c user.name=t -c user.email=t@example.com commit -q -m "initial"
+printf 'b\n' >> edited.txt
+mkdir -p docs
+printf 'new\n' > "docs/new file.md"`
+	if out, err := adapter.RunBootstrap(ctx, tmpDir, setup); err != nil {
+		t.Fatalf("failed to set up repo: %v\n%s", err, out)
+	}
+
+	paths, err := adapter.ListWorkingChanges(ctx, tmpDir)
+	if err != nil {
+		t.Fatalf("ListWorkingChanges failed: %v", err)
+	}
+	if got := strings.Join(paths, ","); got != "edited.txt,docs/new file.md" {
+		t.Errorf("ListWorkingChanges = %q, want edited.txt,docs/new file.md", got)
+	}
+}
+
+func TestWorkspaceAdapter_RepoInspection(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	tmpDir := t.TempDir()
+	adapter, err := filesystem.NewWorkspaceAdapter(tmpDir, tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create adapter: %v", err)
+	}
+
+	ctx := context.Background()
+
+	repoPath := filepath.Join(tmpDir, "repo")
+	plainPath := filepath.Join(tmpDir, "plain")
+	worktreePath := filepath.Join(tmpDir, "wt")
+	setup := `mkdir repo plain
+cd repo
+git init -q -b main
+git -c user.name=t -c user.email=t@example.com commit -q --allow-empty -m "initial"
+git remote add origin git@github.com:org/repo.git
+git worktree add -q ../wt -b feature`
+	if out, err := adapter.RunBootstrap(ctx, tmpDir, setup); err != nil {
+		t.Fatalf("failed to set up repo: %v\n%s", err, out)
+	}
+
+	if ok, err := adapter.IsGitRepository(ctx, repoPath); err != nil || !ok {
+		t.Errorf("IsGitRepository(repo) = %v, %v; want true", ok, err)
+	}
+	if ok, err := adapter.IsGitRepository(ctx, plainPath); err != nil || ok {
+		t.Errorf("IsGitRepository(plain) = %v, %v; want false", ok, err)
+	}
+
+	if url, err := adapter.GetRemoteURL(ctx, repoPath, "origin"); err != nil || url != "git@github.com:org/repo.git" {
+		t.Errorf("GetRemoteURL(origin) = %q, %v", url, err)
+	}
+	if url, err := adapter.GetRemoteURL(ctx, repoPath, "upstream"); err != nil || url != "" {
+		t.Errorf("GetRemoteURL(upstream) = %q, %v; want empty", url, err)
+	}
+
+	if ok, err := adapter.BranchExists(ctx, repoPath, "main"); err != nil || !ok {
+		t.Errorf("BranchExists(main) = %v, %v; want true", ok, err)
+	}
+	if ok, err := adapter.BranchExists(ctx, repoPath, "develop"); err != nil || ok {
+		t.Errorf("BranchExists(develop) = %v, %v; want false", ok, err)
+	}
+
+	worktrees, err := adapter.ListWorktrees(ctx, repoPath)
+	if err != nil {
+		t.Fatalf("ListWorktrees failed: %v", err)
+	}
+	if len(worktrees) != 1 || filepath.Base(worktrees[0]) != filepath.Base(worktreePath) {
+		t.Errorf("ListWorktrees = %v, want [%s]", worktrees, worktreePath)
+	}
+}
+
+func TestWorkspaceAdapter_Stash(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	tmpDir := t.TempDir()
+	adapter, err := filesystem.NewWorkspaceAdapter(tmpDir, tmpDir)
+	if err != nil {
+		t.Fatalf("failed to create adapter: %v", err)
+	}
+
+	ctx := context.Background()
+
+	repoPath := filepath.Join(tmpDir, "repo")
+	otherPath := filepath.Join(tmpDir, "other")
+	setup := `mkdir repo
+cd repo
+git init -q -b main
+git config user.name t
+git config user.email t@example.com
+printf 'a\n' > edited.txt
+git add edited.txt
+git commit -q -m "initial"
+git worktree add -q ../other -b other
+printf 'b\n' >> edited.txt
+printf 'new\n' > untracked.txt`
+	if out, err := adapter.RunBootstrap(ctx, tmpDir, setup); err != nil {
+		t.Fatalf("failed to set up repo: %v\n%s", err, out)
+	}
+
+	sha, err := adapter.StashWorkingChanges(ctx, repoPath, "wip")
+	if err != nil {
+		t.Fatalf("StashWorkingChanges failed: %v", err)
+	}
+	if len(sha) != 40 {
+		t.Errorf("expected stash SHA, got %q", sha)
+	}
+	if paths, _ := adapter.ListWorkingChanges(ctx, repoPath); len(paths) != 0 {
+		t.Errorf("expected clean worktree after stash, got %v", paths)
+	}
+
+	// Nothing left to stash
+	if _, err := adapter.StashWorkingChanges(ctx, repoPath, "empty"); err == nil {
+		t.Error("expected error stashing a clean worktree")
+	}
+
+	// Restore on another worktree of the same repository
+	if err := adapter.ApplyStash(ctx, otherPath, sha); err != nil {
+		t.Fatalf("ApplyStash failed: %v", err)
+	}
+	paths, err := adapter.ListWorkingChanges(ctx, otherPath)
+	if err != nil {
+		t.Fatalf("ListWorkingChanges failed: %v", err)
+	}
+	if got := strings.Join(paths, ","); got != "edited.txt,untracked.txt" {
+		t.Errorf("ListWorkingChanges = %q, want edited.txt,untracked.txt", got)
+	}
+
+	if err := adapter.DropStash(ctx, otherPath, sha); err != nil {
+		t.Fatalf("DropStash failed: %v", err)
+	}
+	if err := adapter.DropStash(ctx, otherPath, sha); err != nil {
+		t.Errorf("DropStash of a dropped stash should be a no-op: %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// GHAuthChecker implements secondary.HostAuthChecker with `gh auth status`.
+type GHAuthChecker struct {
+	gh string // gh executable
+}
+
+// NewGHAuthChecker creates an auth checker using gh from PATH.
+func NewGHAuthChecker() *GHAuthChecker {
+	return &GHAuthChecker{gh: "gh"}
+}
+
+// CheckAuth reports whether gh has working credentials for host.
+func (c *GHAuthChecker) CheckAuth(ctx context.Context, host string) error {
+	cmd := exec.CommandContext(ctx, c.gh, "auth", "status", "--hostname", host)
+	var stderr bytes.Buffer
+	cmd.Stdout = &stderr // older gh versions report status on stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return fmt.Errorf("gh CLI not found: install it from https://cli.github.com")
+		}
+		return fmt.Errorf("gh is not logged in to %s: %s", host, firstLine(stderr.String()))
+	}
+	return nil
+}
+
+// firstLine returns the first non-empty line of s, trimmed.
+func firstLine(s string) string {
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return "no details"
+}
+
+// Ensure GHAuthChecker implements the interface
+var _ secondary.HostAuthChecker = (*GHAuthChecker)(nil)
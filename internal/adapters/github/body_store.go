@@ -0,0 +1,51 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// GHBodyStore implements secondary.PRBodyStore with `gh api`.
+type GHBodyStore struct {
+	gh string // gh executable
+}
+
+// NewGHBodyStore creates a body store using gh from PATH.
+func NewGHBodyStore() *GHBodyStore {
+	return &GHBodyStore{gh: "gh"}
+}
+
+// FetchBody returns the PR's description; a PR without one returns "".
+func (s *GHBodyStore) FetchBody(ctx context.Context, repo string, number int) (string, error) {
+	out, err := runGH(ctx, s.gh, "api", fmt.Sprintf("repos/%s/pulls/%d", repo, number))
+	if err != nil {
+		return "", err
+	}
+	return parsePullBody(out)
+}
+
+// UpdateBody replaces the PR's description.
+func (s *GHBodyStore) UpdateBody(ctx context.Context, repo string, number int, body string) error {
+	_, err := runGH(ctx, s.gh, "api", "-X", "PATCH", fmt.Sprintf("repos/%s/pulls/%d", repo, number), "-f", "body="+body)
+	return err
+}
+
+// parsePullBody reads the body from a pull request object. GitHub reports a
+// missing description as null.
+func parsePullBody(data []byte) (string, error) {
+	var pull struct {
+		Body *string `json:"body"`
+	}
+	if err := json.Unmarshal(data, &pull); err != nil {
+		return "", fmt.Errorf("failed to parse pull request: %w", err)
+	}
+	if pull.Body == nil {
+		return "", nil
+	}
+	return *pull.Body, nil
+}
+
+var _ secondary.PRBodyStore = (*GHBodyStore)(nil)
@@ -0,0 +1,188 @@
+// Package github fetches pull request data from GitHub through the gh CLI,
+// which handles authentication and enterprise hosts.
+package github
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// GHReviewSource implements secondary.PRReviewSource with `gh api`.
+type GHReviewSource struct {
+	gh string // gh executable
+}
+
+// NewGHReviewSource creates a review source using gh from PATH.
+func NewGHReviewSource() *GHReviewSource {
+	return &GHReviewSource{gh: "gh"}
+}
+
+// FetchReviews returns the PR's reviews followed by its inline review comments.
+func (s *GHReviewSource) FetchReviews(ctx context.Context, repo string, number int) ([]*secondary.FetchedReview, error) {
+	base := fmt.Sprintf("repos/%s/pulls/%d", repo, number)
+
+	out, err := s.api(ctx, base+"/reviews")
+	if err != nil {
+		return nil, err
+	}
+	reviews, err := parseReviews(out)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err = s.api(ctx, base+"/comments")
+	if err != nil {
+		return nil, err
+	}
+	comments, err := parseReviewComments(out)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(reviews, comments...), nil
+}
+
+// api runs a paginated GET against the GitHub API.
+func (s *GHReviewSource) api(ctx context.Context, path string) ([]byte, error) {
+	return runGH(ctx, s.gh, "api", "--paginate", path)
+}
+
+// runGH runs gh with args and returns its stdout.
+func runGH(ctx context.Context, gh string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, gh, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		if errors.Is(err, exec.ErrNotFound) {
+			return nil, fmt.Errorf("gh CLI not found: install it from https://cli.github.com and run 'gh auth login'")
+		}
+		return nil, fmt.Errorf("gh %s failed: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+type ghUser struct {
+	Login string `json:"login"`
+}
+
+type ghReview struct {
+	ID          int64  `json:"id"`
+	User        ghUser `json:"user"`
+	State       string `json:"state"`
+	Body        string `json:"body"`
+	HTMLURL     string `json:"html_url"`
+	SubmittedAt string `json:"submitted_at"`
+}
+
+type ghReviewComment struct {
+	ID                  int64  `json:"id"`
+	PullRequestReviewID int64  `json:"pull_request_review_id"`
+	InReplyToID         int64  `json:"in_reply_to_id"`
+	User                ghUser `json:"user"`
+	Body                string `json:"body"`
+	Path                string `json:"path"`
+	Line                int    `json:"line"`
+	OriginalLine        int    `json:"original_line"`
+	HTMLURL             string `json:"html_url"`
+	CreatedAt           string `json:"created_at"`
+}
+
+// parseReviews decodes `gh api --paginate .../reviews` output: one JSON array per page.
+func parseReviews(data []byte) ([]*secondary.FetchedReview, error) {
+	var result []*secondary.FetchedReview
+	err := decodePages(data, func(dec *json.Decoder) error {
+		var page []ghReview
+		if err := dec.Decode(&page); err != nil {
+			return err
+		}
+		for _, r := range page {
+			// Pending reviews are drafts only their author can see
+			if r.State == "PENDING" {
+				continue
+			}
+			result = append(result, &secondary.FetchedReview{
+				ExternalID:  reviewExternalID(r.ID),
+				Kind:        "review",
+				Author:      r.User.Login,
+				State:       r.State,
+				Body:        r.Body,
+				URL:         r.HTMLURL,
+				SubmittedAt: r.SubmittedAt,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse reviews: %w", err)
+	}
+	return result, nil
+}
+
+// parseReviewComments decodes `gh api --paginate .../comments` output.
+func parseReviewComments(data []byte) ([]*secondary.FetchedReview, error) {
+	var result []*secondary.FetchedReview
+	err := decodePages(data, func(dec *json.Decoder) error {
+		var page []ghReviewComment
+		if err := dec.Decode(&page); err != nil {
+			return err
+		}
+		for _, c := range page {
+			item := &secondary.FetchedReview{
+				ExternalID:  "comment:" + strconv.FormatInt(c.ID, 10),
+				Kind:        "comment",
+				InReplyTo:   c.InReplyToID != 0,
+				Author:      c.User.Login,
+				Body:        c.Body,
+				Path:        c.Path,
+				Line:        c.Line,
+				URL:         c.HTMLURL,
+				SubmittedAt: c.CreatedAt,
+			}
+			if item.Line == 0 {
+				// Outdated comments no longer map onto the current diff
+				item.Line = c.OriginalLine
+			}
+			if c.PullRequestReviewID != 0 {
+				item.ReviewExternalID = reviewExternalID(c.PullRequestReviewID)
+			}
+			result = append(result, item)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse review comments: %w", err)
+	}
+	return result, nil
+}
+
+func reviewExternalID(id int64) string {
+	return "review:" + strconv.FormatInt(id, 10)
+}
+
+// decodePages calls decode once per concatenated JSON page in data.
+func decodePages(data []byte, decode func(*json.Decoder) error) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	for {
+		err := decode(dec)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Ensure GHReviewSource implements the interface
+var _ secondary.PRReviewSource = (*GHReviewSource)(nil)
@@ -0,0 +1,79 @@
+package github
+
+import "testing"
+
+func TestParseReviews(t *testing.T) {
+	// gh --paginate concatenates one array per page
+	data := []byte(`[{"id":1,"user":{"login":"ann"},"state":"APPROVED","body":"","html_url":"u1","submitted_at":"2026-10-01T10:00:00Z"}]
+[{"id":2,"user":{"login":"bob"},"state":"CHANGES_REQUESTED","body":"Needs tests","html_url":"u2","submitted_at":"2026-10-02T10:00:00Z"},
+ {"id":3,"user":{"login":"bob"},"state":"PENDING","body":"draft"}]`)
+
+	reviews, err := parseReviews(data)
+	if err != nil {
+		t.Fatalf("parseReviews failed: %v", err)
+	}
+	if len(reviews) != 2 {
+		t.Fatalf("expected 2 reviews (pending skipped), got %d", len(reviews))
+	}
+	got := reviews[1]
+	if got.ExternalID != "review:2" || got.Kind != "review" || got.Author != "bob" || got.State != "CHANGES_REQUESTED" || got.Body != "Needs tests" {
+		t.Errorf("unexpected review: %+v", got)
+	}
+	if got.SubmittedAt != "2026-10-02T10:00:00Z" || got.URL != "u2" {
+		t.Errorf("unexpected review metadata: %+v", got)
+	}
+}
+
+func TestParseReviewComments(t *testing.T) {
+	data := []byte(`[
+ {"id":10,"pull_request_review_id":2,"user":{"login":"bob"},"body":"Handle nil","path":"a.go","line":12,"html_url":"c10","created_at":"2026-10-02T10:00:00Z"},
+ {"id":11,"pull_request_review_id":4,"in_reply_to_id":10,"user":{"login":"ann"},"body":"Done","path":"a.go","line":null,"original_line":12}
+]`)
+
+	comments, err := parseReviewComments(data)
+	if err != nil {
+		t.Fatalf("parseReviewComments failed: %v", err)
+	}
+	if len(comments) != 2 {
+		t.Fatalf("expected 2 comments, got %d", len(comments))
+	}
+	first := comments[0]
+	if first.ExternalID != "comment:10" || first.ReviewExternalID != "review:2" || first.InReplyTo || first.Path != "a.go" || first.Line != 12 {
+		t.Errorf("unexpected comment: %+v", first)
+	}
+	reply := comments[1]
+	if !reply.InReplyTo || reply.Line != 12 {
+		t.Errorf("expected outdated reply with original line, got %+v", reply)
+	}
+}
+
+func TestParseReviews_Invalid(t *testing.T) {
+	if _, err := parseReviews([]byte(`{"message":"Not Found"}`)); err == nil {
+		t.Error("expected error for non-array response")
+	}
+}
+
+func TestParsePullBody(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    string
+		wantErr bool
+	}{
+		{name: "body", data: `{"number":14,"body":"Adds login"}`, want: "Adds login"},
+		{name: "null body", data: `{"number":14,"body":null}`, want: ""},
+		{name: "invalid", data: `not json`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePullBody([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parsePullBody() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parsePullBody() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
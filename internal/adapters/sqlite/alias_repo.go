@@ -0,0 +1,114 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// AliasRepository implements secondary.AliasRepository with SQLite.
+type AliasRepository struct {
+	db *sql.DB
+}
+
+// NewAliasRepository creates a new SQLite alias repository.
+func NewAliasRepository(db *sql.DB) *AliasRepository {
+	return &AliasRepository{db: db}
+}
+
+// Set assigns an alias, replacing any alias the entity already has and any
+// alias held by another entity under the same slug and commission.
+func (r *AliasRepository) Set(ctx context.Context, alias *secondary.AliasRecord) error {
+	_, err := r.db.ExecContext(ctx,
+		"INSERT OR REPLACE INTO entity_aliases (entity_id, entity_type, commission_id, slug) VALUES (?, ?, ?, ?)",
+		alias.EntityID, alias.EntityType, alias.CommissionID, alias.Slug,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set alias: %w", err)
+	}
+	return nil
+}
+
+// Delete removes an entity's alias.
+func (r *AliasRepository) Delete(ctx context.Context, entityID string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM entity_aliases WHERE entity_id = ?", entityID)
+	if err != nil {
+		return fmt.Errorf("failed to delete alias: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s has no alias", entityID)
+	}
+	return nil
+}
+
+// GetByEntity retrieves an entity's alias (nil if none).
+func (r *AliasRepository) GetByEntity(ctx context.Context, entityID string) (*secondary.AliasRecord, error) {
+	row := r.db.QueryRowContext(ctx,
+		"SELECT entity_id, entity_type, commission_id, slug, created_at FROM entity_aliases WHERE entity_id = ?",
+		entityID,
+	)
+	record, err := scanAlias(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get alias: %w", err)
+	}
+	return record, nil
+}
+
+// FindBySlug retrieves aliases with the given slug across all commissions.
+func (r *AliasRepository) FindBySlug(ctx context.Context, slug string) ([]*secondary.AliasRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT entity_id, entity_type, commission_id, slug, created_at FROM entity_aliases WHERE slug = ? ORDER BY commission_id",
+		slug,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find alias: %w", err)
+	}
+	defer rows.Close()
+
+	var aliases []*secondary.AliasRecord
+	for rows.Next() {
+		record, err := scanAlias(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan alias: %w", err)
+		}
+		aliases = append(aliases, record)
+	}
+	return aliases, rows.Err()
+}
+
+// GetRenamedID returns the ID an entity took when its prefix was renamed.
+func (r *AliasRepository) GetRenamedID(ctx context.Context, oldID string) (string, error) {
+	var newID string
+	err := r.db.QueryRowContext(ctx, "SELECT new_id FROM id_renames WHERE old_id = ?", oldID).Scan(&newID)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to look up renamed ID: %w", err)
+	}
+	return newID, nil
+}
+
+// scanAlias scans an alias row into an AliasRecord.
+func scanAlias(scanner interface {
+	Scan(dest ...any) error
+}) (*secondary.AliasRecord, error) {
+	var createdAt time.Time
+	record := &secondary.AliasRecord{}
+	if err := scanner.Scan(&record.EntityID, &record.EntityType, &record.CommissionID, &record.Slug, &createdAt); err != nil {
+		return nil, err
+	}
+	record.CreatedAt = createdAt.Format(time.RFC3339)
+	return record, nil
+}
+
+// Ensure AliasRepository implements the interface
+var _ secondary.AliasRepository = (*AliasRepository)(nil)
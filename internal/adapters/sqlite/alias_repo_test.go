@@ -0,0 +1,110 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestAliasRepository_SetGetDelete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewAliasRepository(db)
+	ctx := context.Background()
+
+	seedCommission(t, db, "COMM-001", "")
+
+	alias := &secondary.AliasRecord{EntityID: "SHIP-014", EntityType: "shipment", CommissionID: "COMM-001", Slug: "auth-refactor"}
+	if err := repo.Set(ctx, alias); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	got, err := repo.GetByEntity(ctx, "SHIP-014")
+	if err != nil {
+		t.Fatalf("GetByEntity failed: %v", err)
+	}
+	if got == nil || got.Slug != "auth-refactor" || got.EntityType != "shipment" || got.CommissionID != "COMM-001" {
+		t.Fatalf("unexpected alias: %+v", got)
+	}
+
+	// Re-aliasing replaces the entity's previous slug
+	alias.Slug = "auth"
+	if err := repo.Set(ctx, alias); err != nil {
+		t.Fatalf("Set (rename) failed: %v", err)
+	}
+	old, _ := repo.FindBySlug(ctx, "auth-refactor")
+	if len(old) != 0 {
+		t.Errorf("expected old slug to be gone, got %+v", old)
+	}
+
+	if err := repo.Delete(ctx, "SHIP-014"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	got, err = repo.GetByEntity(ctx, "SHIP-014")
+	if err != nil {
+		t.Fatalf("GetByEntity failed: %v", err)
+	}
+	if got != nil {
+		t.Errorf("expected no alias after delete, got %+v", got)
+	}
+	if err := repo.Delete(ctx, "SHIP-014"); err == nil {
+		t.Error("expected error deleting missing alias")
+	}
+}
+
+func TestAliasRepository_FindBySlug_PerCommission(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewAliasRepository(db)
+	ctx := context.Background()
+
+	seedCommission(t, db, "COMM-001", "")
+	seedCommission(t, db, "COMM-002", "")
+
+	for _, a := range []*secondary.AliasRecord{
+		{EntityID: "SHIP-001", EntityType: "shipment", CommissionID: "COMM-001", Slug: "cleanup"},
+		{EntityID: "SHIP-002", EntityType: "shipment", CommissionID: "COMM-002", Slug: "cleanup"},
+	} {
+		if err := repo.Set(ctx, a); err != nil {
+			t.Fatalf("Set failed: %v", err)
+		}
+	}
+
+	aliases, err := repo.FindBySlug(ctx, "cleanup")
+	if err != nil {
+		t.Fatalf("FindBySlug failed: %v", err)
+	}
+	if len(aliases) != 2 {
+		t.Fatalf("expected same slug in two commissions, got %d", len(aliases))
+	}
+	if aliases[0].EntityID != "SHIP-001" || aliases[1].EntityID != "SHIP-002" {
+		t.Errorf("unexpected aliases: %s, %s", aliases[0].EntityID, aliases[1].EntityID)
+	}
+
+	// Same slug in the same commission replaces the previous holder
+	if err := repo.Set(ctx, &secondary.AliasRecord{EntityID: "TASK-001", EntityType: "task", CommissionID: "COMM-001", Slug: "cleanup"}); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if got, _ := repo.GetByEntity(ctx, "SHIP-001"); got != nil {
+		t.Errorf("expected SHIP-001 alias to be replaced, got %+v", got)
+	}
+}
+
+func TestAliasRepository_GetRenamedID(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewAliasRepository(db)
+	ctx := context.Background()
+
+	if _, err := db.Exec("INSERT INTO id_renames (old_id, new_id) VALUES ('MISSION-004', 'COMM-004')"); err != nil {
+		t.Fatalf("failed to seed rename: %v", err)
+	}
+
+	newID, err := repo.GetRenamedID(ctx, "MISSION-004")
+	if err != nil || newID != "COMM-004" {
+		t.Errorf("GetRenamedID(MISSION-004) = %q, %v; want COMM-004", newID, err)
+	}
+	newID, err = repo.GetRenamedID(ctx, "COMM-004")
+	if err != nil || newID != "" {
+		t.Errorf("GetRenamedID(COMM-004) = %q, %v; want none", newID, err)
+	}
+}
@@ -0,0 +1,121 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// CommandStatRepository implements secondary.CommandStatRepository with SQLite.
+type CommandStatRepository struct {
+	db *sql.DB
+}
+
+// NewCommandStatRepository creates a new SQLite command stat repository.
+func NewCommandStatRepository(db *sql.DB) *CommandStatRepository {
+	return &CommandStatRepository{db: db}
+}
+
+const commandStatCols = "id, command, duration_ms, query_count, query_ms, slow_queries, failed, created_at"
+
+// Create records one command invocation.
+func (r *CommandStatRepository) Create(ctx context.Context, stat *secondary.CommandStatRecord) error {
+	var slowQueries sql.NullString
+	if len(stat.SlowQueries) > 0 {
+		data, err := json.Marshal(stat.SlowQueries)
+		if err != nil {
+			return fmt.Errorf("failed to encode slow queries: %w", err)
+		}
+		slowQueries = sql.NullString{String: string(data), Valid: true}
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		"INSERT INTO command_stats (command, duration_ms, query_count, query_ms, slow_queries, failed) VALUES (?, ?, ?, ?, ?, ?)",
+		stat.Command, stat.DurationMS, stat.QueryCount, stat.QueryMS, slowQueries, stat.Failed,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record command stat: %w", err)
+	}
+	stat.ID, _ = result.LastInsertId()
+	return nil
+}
+
+// List retrieves invocations matching the given filters, newest first.
+func (r *CommandStatRepository) List(ctx context.Context, filters secondary.CommandStatFilters) ([]*secondary.CommandStatRecord, error) {
+	query := "SELECT " + commandStatCols + " FROM command_stats WHERE 1=1"
+	args := []any{}
+
+	if filters.Command != "" {
+		query += " AND command = ?"
+		args = append(args, filters.Command)
+	}
+	if filters.Since != "" {
+		since, err := time.Parse(time.RFC3339, filters.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since timestamp %q: %w", filters.Since, err)
+		}
+		// Stored as CURRENT_TIMESTAMP text (UTC, second resolution)
+		query += " AND created_at >= ?"
+		args = append(args, since.UTC().Format("2006-01-02 15:04:05"))
+	}
+
+	query += " ORDER BY created_at DESC, id DESC"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list command stats: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []*secondary.CommandStatRecord
+	for rows.Next() {
+		record, err := scanCommandStat(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan command stat: %w", err)
+		}
+		stats = append(stats, record)
+	}
+	return stats, rows.Err()
+}
+
+// PruneOlderThan removes invocations older than the given number of days.
+func (r *CommandStatRepository) PruneOlderThan(ctx context.Context, days int) (int, error) {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM command_stats WHERE created_at < datetime('now', ?)",
+		fmt.Sprintf("-%d days", days),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to prune command stats: %w", err)
+	}
+
+	count, _ := result.RowsAffected()
+	return int(count), nil
+}
+
+// scanCommandStat scans a command stat row into a CommandStatRecord.
+func scanCommandStat(scanner interface {
+	Scan(dest ...any) error
+}) (*secondary.CommandStatRecord, error) {
+	var slowQueries sql.NullString
+	var createdAt time.Time
+	record := &secondary.CommandStatRecord{}
+	if err := scanner.Scan(&record.ID, &record.Command, &record.DurationMS, &record.QueryCount, &record.QueryMS,
+		&slowQueries, &record.Failed, &createdAt); err != nil {
+		return nil, err
+	}
+
+	if slowQueries.Valid {
+		if err := json.Unmarshal([]byte(slowQueries.String), &record.SlowQueries); err != nil {
+			return nil, fmt.Errorf("invalid slow queries for command stat %d: %w", record.ID, err)
+		}
+	}
+	record.CreatedAt = createdAt.Format(time.RFC3339)
+	return record, nil
+}
+
+// Ensure CommandStatRepository implements the interface
+var _ secondary.CommandStatRepository = (*CommandStatRepository)(nil)
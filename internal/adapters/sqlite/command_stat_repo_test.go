@@ -0,0 +1,72 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestCommandStatRepository_CreateListPrune(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewCommandStatRepository(db)
+	ctx := context.Background()
+
+	summary := &secondary.CommandStatRecord{
+		Command: "orc summary", DurationMS: 420, QueryCount: 38, QueryMS: 310,
+		SlowQueries: []secondary.SlowQueryRecord{{SQL: "SELECT * FROM tasks", MS: 120.5}, {SQL: "SELECT * FROM notes", MS: 80}},
+	}
+	if err := repo.Create(ctx, summary); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if summary.ID == 0 {
+		t.Error("expected Create to set the ID")
+	}
+	if err := repo.Create(ctx, &secondary.CommandStatRecord{Command: "orc task list", DurationMS: 35, QueryCount: 3, QueryMS: 4, Failed: true}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	// An old invocation, outside any recent window
+	if _, err := db.Exec("INSERT INTO command_stats (command, duration_ms, created_at) VALUES ('orc summary', 900, datetime('now', '-40 days'))"); err != nil {
+		t.Fatalf("failed to seed old stat: %v", err)
+	}
+
+	all, err := repo.List(ctx, secondary.CommandStatFilters{})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(all) != 3 || all[0].Command != "orc task list" || !all[0].Failed {
+		t.Fatalf("expected 3 stats newest first, got %+v", all)
+	}
+
+	got := all[1]
+	if got.DurationMS != 420 || got.QueryCount != 38 || got.QueryMS != 310 || got.Failed || got.CreatedAt == "" {
+		t.Errorf("unexpected stat: %+v", got)
+	}
+	if len(got.SlowQueries) != 2 || got.SlowQueries[0].SQL != "SELECT * FROM tasks" || got.SlowQueries[0].MS != 120.5 {
+		t.Errorf("unexpected slow queries: %+v", got.SlowQueries)
+	}
+
+	recent, err := repo.List(ctx, secondary.CommandStatFilters{
+		Command: "orc summary",
+		Since:   time.Now().Add(-24 * time.Hour).Format(time.RFC3339),
+	})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(recent) != 1 || recent[0].DurationMS != 420 {
+		t.Errorf("expected only the recent summary, got %+v", recent)
+	}
+	if _, err := repo.List(ctx, secondary.CommandStatFilters{Since: "yesterday"}); err == nil {
+		t.Error("expected error for invalid since")
+	}
+
+	pruned, err := repo.PruneOlderThan(ctx, 30)
+	if err != nil {
+		t.Fatalf("PruneOlderThan failed: %v", err)
+	}
+	if pruned != 1 {
+		t.Errorf("expected 1 pruned, got %d", pruned)
+	}
+}
@@ -0,0 +1,109 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+const commentSelectCols = "id, entity_id, entity_type, reply_to_id, author, body, created_at"
+
+// CommentRepository implements secondary.CommentRepository with SQLite.
+type CommentRepository struct {
+	db *sql.DB
+}
+
+// NewCommentRepository creates a new SQLite comment repository.
+func NewCommentRepository(db *sql.DB) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+// Create persists a new comment.
+func (r *CommentRepository) Create(ctx context.Context, comment *secondary.CommentRecord) error {
+	var replyToID, author sql.NullString
+	if comment.ReplyToID != "" {
+		replyToID = sql.NullString{String: comment.ReplyToID, Valid: true}
+	}
+	if comment.Author != "" {
+		author = sql.NullString{String: comment.Author, Valid: true}
+	}
+
+	_, err := r.db.ExecContext(ctx,
+		"INSERT INTO comments (id, entity_id, entity_type, reply_to_id, author, body) VALUES (?, ?, ?, ?, ?, ?)",
+		comment.ID, comment.EntityID, comment.EntityType, replyToID, author, comment.Body,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create comment: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a comment by its ID.
+func (r *CommentRepository) GetByID(ctx context.Context, id string) (*secondary.CommentRecord, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT "+commentSelectCols+" FROM comments WHERE id = ?", id)
+	record, err := scanComment(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("comment %s not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get comment: %w", err)
+	}
+	return record, nil
+}
+
+// ListByEntity retrieves the comments on an entity, oldest first.
+func (r *CommentRepository) ListByEntity(ctx context.Context, entityID string) ([]*secondary.CommentRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+commentSelectCols+" FROM comments WHERE entity_id = ? ORDER BY created_at, id",
+		entityID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+	defer rows.Close()
+
+	var comments []*secondary.CommentRecord
+	for rows.Next() {
+		record, err := scanComment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan comment: %w", err)
+		}
+		comments = append(comments, record)
+	}
+	return comments, rows.Err()
+}
+
+// GetNextID returns the next available comment ID.
+func (r *CommentRepository) GetNextID(ctx context.Context) (string, error) {
+	var maxID int
+	err := r.db.QueryRowContext(ctx,
+		"SELECT COALESCE(MAX(CAST(SUBSTR(id, 5) AS INTEGER)), 0) FROM comments",
+	).Scan(&maxID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get next comment ID: %w", err)
+	}
+
+	return fmt.Sprintf("CMT-%03d", maxID+1), nil
+}
+
+// scanComment scans a comment row into a CommentRecord.
+func scanComment(scanner interface {
+	Scan(dest ...any) error
+}) (*secondary.CommentRecord, error) {
+	var replyToID, author sql.NullString
+	var createdAt time.Time
+	record := &secondary.CommentRecord{}
+	if err := scanner.Scan(&record.ID, &record.EntityID, &record.EntityType, &replyToID, &author, &record.Body, &createdAt); err != nil {
+		return nil, err
+	}
+	record.ReplyToID = replyToID.String
+	record.Author = author.String
+	record.CreatedAt = createdAt.Format(time.RFC3339)
+	return record, nil
+}
+
+// Ensure CommentRepository implements the interface
+var _ secondary.CommentRepository = (*CommentRepository)(nil)
@@ -0,0 +1,66 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestCommentRepository_CreateAndList(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewCommentRepository(db)
+	ctx := context.Background()
+
+	id, err := repo.GetNextID(ctx)
+	if err != nil {
+		t.Fatalf("GetNextID failed: %v", err)
+	}
+	if id != "CMT-001" {
+		t.Errorf("expected CMT-001, got %s", id)
+	}
+
+	for _, c := range []*secondary.CommentRecord{
+		{ID: "CMT-001", EntityID: "TASK-042", EntityType: "task", Author: "BENCH-003", Body: "blocked on infra"},
+		{ID: "CMT-002", EntityID: "TASK-042", EntityType: "task", ReplyToID: "CMT-001", Body: "unblocked"},
+		{ID: "CMT-003", EntityID: "SHIP-010", EntityType: "shipment", Body: "elsewhere"},
+	} {
+		if err := repo.Create(ctx, c); err != nil {
+			t.Fatalf("Create %s failed: %v", c.ID, err)
+		}
+	}
+
+	comments, err := repo.ListByEntity(ctx, "TASK-042")
+	if err != nil {
+		t.Fatalf("ListByEntity failed: %v", err)
+	}
+	if len(comments) != 2 {
+		t.Fatalf("expected 2 comments, got %d", len(comments))
+	}
+	if comments[0].Author != "BENCH-003" || comments[0].ReplyToID != "" {
+		t.Errorf("unexpected first comment: %+v", comments[0])
+	}
+	if comments[1].ReplyToID != "CMT-001" || comments[1].Author != "" {
+		t.Errorf("unexpected reply: %+v", comments[1])
+	}
+	if comments[0].CreatedAt == "" {
+		t.Error("expected CreatedAt to be set")
+	}
+
+	got, err := repo.GetByID(ctx, "CMT-003")
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if got.EntityID != "SHIP-010" || got.Body != "elsewhere" {
+		t.Errorf("unexpected comment: %+v", got)
+	}
+	if _, err := repo.GetByID(ctx, "CMT-999"); err == nil {
+		t.Error("expected error for missing comment")
+	}
+
+	id, _ = repo.GetNextID(ctx)
+	if id != "CMT-004" {
+		t.Errorf("expected CMT-004, got %s", id)
+	}
+}
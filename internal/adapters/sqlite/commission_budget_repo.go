@@ -0,0 +1,102 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// CommissionBudgetRepository implements secondary.CommissionBudgetRepository with SQLite.
+type CommissionBudgetRepository struct {
+	db *sql.DB
+}
+
+// NewCommissionBudgetRepository creates a new SQLite commission budget repository.
+func NewCommissionBudgetRepository(db *sql.DB) *CommissionBudgetRepository {
+	return &CommissionBudgetRepository{db: db}
+}
+
+const commissionBudgetCols = "commission_id, unit, amount, thresholds, created_at, updated_at"
+
+// Set creates or replaces a commission's budget.
+func (r *CommissionBudgetRepository) Set(ctx context.Context, budget *secondary.CommissionBudgetRecord) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO commission_budgets (commission_id, unit, amount, thresholds) VALUES (?, ?, ?, ?)
+		ON CONFLICT(commission_id) DO UPDATE SET unit = excluded.unit, amount = excluded.amount,
+			thresholds = excluded.thresholds, updated_at = CURRENT_TIMESTAMP`,
+		budget.CommissionID, budget.Unit, budget.Amount, budget.Thresholds,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set commission budget: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves a commission's budget (nil if none).
+func (r *CommissionBudgetRepository) Get(ctx context.Context, commissionID string) (*secondary.CommissionBudgetRecord, error) {
+	row := r.db.QueryRowContext(ctx,
+		"SELECT "+commissionBudgetCols+" FROM commission_budgets WHERE commission_id = ?",
+		commissionID,
+	)
+	record, err := scanCommissionBudget(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commission budget: %w", err)
+	}
+	return record, nil
+}
+
+// Delete removes a commission's budget.
+func (r *CommissionBudgetRepository) Delete(ctx context.Context, commissionID string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM commission_budgets WHERE commission_id = ?", commissionID)
+	if err != nil {
+		return fmt.Errorf("failed to delete commission budget: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("commission %s has no budget", commissionID)
+	}
+	return nil
+}
+
+// List retrieves all budgets ordered by commission ID.
+func (r *CommissionBudgetRepository) List(ctx context.Context) ([]*secondary.CommissionBudgetRecord, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+commissionBudgetCols+" FROM commission_budgets ORDER BY commission_id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commission budgets: %w", err)
+	}
+	defer rows.Close()
+
+	var budgets []*secondary.CommissionBudgetRecord
+	for rows.Next() {
+		record, err := scanCommissionBudget(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan commission budget: %w", err)
+		}
+		budgets = append(budgets, record)
+	}
+	return budgets, rows.Err()
+}
+
+// scanCommissionBudget scans a budget row into a CommissionBudgetRecord.
+func scanCommissionBudget(scanner interface {
+	Scan(dest ...any) error
+}) (*secondary.CommissionBudgetRecord, error) {
+	var createdAt, updatedAt time.Time
+	record := &secondary.CommissionBudgetRecord{}
+	if err := scanner.Scan(&record.CommissionID, &record.Unit, &record.Amount, &record.Thresholds, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	record.CreatedAt = createdAt.Format(time.RFC3339)
+	record.UpdatedAt = updatedAt.Format(time.RFC3339)
+	return record, nil
+}
+
+// Ensure CommissionBudgetRepository implements the interface
+var _ secondary.CommissionBudgetRepository = (*CommissionBudgetRepository)(nil)
@@ -0,0 +1,61 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestCommissionBudgetRepository_SetGetListDelete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewCommissionBudgetRepository(db)
+	ctx := context.Background()
+
+	seedCommission(t, db, "COMM-001", "Client work")
+	seedCommission(t, db, "COMM-002", "Internal")
+
+	budget, err := repo.Get(ctx, "COMM-001")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if budget != nil {
+		t.Fatalf("expected no budget, got %+v", budget)
+	}
+
+	if err := repo.Set(ctx, &secondary.CommissionBudgetRecord{CommissionID: "COMM-001", Unit: "hours", Amount: 40, Thresholds: "75,90"}); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if err := repo.Set(ctx, &secondary.CommissionBudgetRecord{CommissionID: "COMM-002", Unit: "points", Amount: 20, Thresholds: "80"}); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	// Setting again replaces the budget
+	if err := repo.Set(ctx, &secondary.CommissionBudgetRecord{CommissionID: "COMM-001", Unit: "hours", Amount: 60, Thresholds: "50"}); err != nil {
+		t.Fatalf("Set (replace) failed: %v", err)
+	}
+
+	budget, err = repo.Get(ctx, "COMM-001")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if budget == nil || budget.Unit != "hours" || budget.Amount != 60 || budget.Thresholds != "50" {
+		t.Errorf("unexpected budget: %+v", budget)
+	}
+
+	budgets, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(budgets) != 2 || budgets[0].CommissionID != "COMM-001" || budgets[1].CommissionID != "COMM-002" {
+		t.Errorf("unexpected budgets: %+v", budgets)
+	}
+
+	if err := repo.Delete(ctx, "COMM-001"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := repo.Delete(ctx, "COMM-001"); err == nil {
+		t.Error("expected error deleting missing budget")
+	}
+}
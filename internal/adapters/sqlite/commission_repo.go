@@ -245,6 +245,53 @@ func (r *CommissionRepository) CountShipments(ctx context.Context, commissionID
 	return count, nil
 }
 
+// ListRollups returns every commission's open work counts. Each count is
+// grouped once across all commissions, so the cost does not grow with the
+// number of commissions listed.
+func (r *CommissionRepository) ListRollups(ctx context.Context) (map[string]*secondary.CommissionRollupRecord, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT c.id,
+			COALESCE(s.open_shipments, 0),
+			COALESCE(t.ready, 0),
+			COALESCE(t.in_progress, 0),
+			COALESCE(t.blocked, 0),
+			COALESCE(p.pending, 0)
+		FROM commissions c
+		LEFT JOIN (
+			SELECT commission_id, COUNT(*) AS open_shipments
+			FROM shipments WHERE status != 'closed'
+			GROUP BY commission_id
+		) s ON s.commission_id = c.id
+		LEFT JOIN (
+			SELECT commission_id,
+				SUM(status = 'open') AS ready,
+				SUM(status = 'in-progress') AS in_progress,
+				SUM(status = 'blocked') AS blocked
+			FROM tasks WHERE status != 'closed'
+			GROUP BY commission_id
+		) t ON t.commission_id = c.id
+		LEFT JOIN (
+			SELECT tk.commission_id, COUNT(*) AS pending
+			FROM plans pl JOIN tasks tk ON tk.id = pl.task_id
+			WHERE pl.status = 'draft' AND tk.status != 'closed'
+			GROUP BY tk.commission_id
+		) p ON p.commission_id = c.id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commission rollups: %w", err)
+	}
+	defer rows.Close()
+
+	rollups := make(map[string]*secondary.CommissionRollupRecord)
+	for rows.Next() {
+		rollup := &secondary.CommissionRollupRecord{}
+		if err := rows.Scan(&rollup.CommissionID, &rollup.OpenShipments, &rollup.ReadyTasks, &rollup.InProgressTasks, &rollup.BlockedTasks, &rollup.PendingApprovals); err != nil {
+			return nil, fmt.Errorf("failed to scan commission rollup: %w", err)
+		}
+		rollups[rollup.CommissionID] = rollup
+	}
+	return rollups, rows.Err()
+}
+
 // Pin pins a commission to keep it visible.
 func (r *CommissionRepository) Pin(ctx context.Context, id string) error {
 	result, err := r.db.ExecContext(ctx,
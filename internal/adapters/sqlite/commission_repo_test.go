@@ -400,3 +400,37 @@ func TestCommissionRepository_CountShipments(t *testing.T) {
 		t.Errorf("expected 2 shipments, got %d", count)
 	}
 }
+
+func TestCommissionRepository_ListRollups(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewCommissionRepository(db, nil)
+	ctx := context.Background()
+
+	busy := createTestCommission(t, repo, ctx, "Busy", "")
+	quiet := createTestCommission(t, repo, ctx, "Quiet", "")
+
+	_, _ = db.Exec("INSERT INTO shipments (id, commission_id, title, status) VALUES ('SHIP-001', ?, 'Open', 'in-progress')", busy.ID)
+	_, _ = db.Exec("INSERT INTO shipments (id, commission_id, title, status) VALUES ('SHIP-002', ?, 'Done', 'closed')", busy.ID)
+	for _, task := range []struct{ id, status string }{
+		{"TASK-001", "open"}, {"TASK-002", "open"}, {"TASK-003", "in-progress"}, {"TASK-004", "blocked"}, {"TASK-005", "closed"},
+	} {
+		_, _ = db.Exec("INSERT INTO tasks (id, commission_id, shipment_id, title, status) VALUES (?, ?, 'SHIP-001', ?, ?)", task.id, busy.ID, task.id, task.status)
+	}
+	_, _ = db.Exec("INSERT INTO plans (id, commission_id, task_id, title, status) VALUES ('PLAN-001', ?, 'TASK-001', 'Draft', 'draft')", busy.ID)
+	_, _ = db.Exec("INSERT INTO plans (id, commission_id, task_id, title, status) VALUES ('PLAN-002', ?, 'TASK-003', 'Approved', 'approved')", busy.ID)
+	_, _ = db.Exec("INSERT INTO plans (id, commission_id, task_id, title, status) VALUES ('PLAN-003', ?, 'TASK-005', 'Closed task', 'draft')", busy.ID)
+
+	rollups, err := repo.ListRollups(ctx)
+	if err != nil {
+		t.Fatalf("ListRollups failed: %v", err)
+	}
+
+	got := rollups[busy.ID]
+	want := secondary.CommissionRollupRecord{CommissionID: busy.ID, OpenShipments: 1, ReadyTasks: 2, InProgressTasks: 1, BlockedTasks: 1, PendingApprovals: 1}
+	if got == nil || *got != want {
+		t.Errorf("busy rollup = %+v, want %+v", got, want)
+	}
+	if q := rollups[quiet.ID]; q != nil && (*q != secondary.CommissionRollupRecord{CommissionID: quiet.ID}) {
+		t.Errorf("quiet rollup = %+v, want zero counts", q)
+	}
+}
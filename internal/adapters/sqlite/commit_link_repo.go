@@ -0,0 +1,118 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// CommitLinkRepository implements secondary.CommitLinkRepository with SQLite.
+type CommitLinkRepository struct {
+	db *sql.DB
+}
+
+// NewCommitLinkRepository creates a new SQLite commit link repository.
+func NewCommitLinkRepository(db *sql.DB) *CommitLinkRepository {
+	return &CommitLinkRepository{db: db}
+}
+
+// Create persists a commit link. Existing (commit, entity) pairs are left untouched.
+func (r *CommitLinkRepository) Create(ctx context.Context, link *secondary.CommitLinkRecord) (bool, error) {
+	var workbenchID sql.NullString
+	var committedAt sql.NullTime
+
+	if link.WorkbenchID != "" {
+		workbenchID = sql.NullString{String: link.WorkbenchID, Valid: true}
+	}
+	if link.CommittedAt != "" {
+		t, err := time.Parse(time.RFC3339, link.CommittedAt)
+		if err != nil {
+			return false, fmt.Errorf("invalid commit time %q: %w", link.CommittedAt, err)
+		}
+		committedAt = sql.NullTime{Time: t, Valid: true}
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		`INSERT OR IGNORE INTO commit_links (commit_sha, entity_type, entity_id, workbench_id, subject, committed_at) VALUES (?, ?, ?, ?, ?, ?)`,
+		link.CommitSHA,
+		link.EntityType,
+		link.EntityID,
+		workbenchID,
+		link.Subject,
+		committedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to create commit link: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// ListByEntity retrieves links for an entity, newest commit first.
+func (r *CommitLinkRepository) ListByEntity(ctx context.Context, entityID string) ([]*secondary.CommitLinkRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT commit_sha, entity_type, entity_id, workbench_id, subject, committed_at, created_at FROM commit_links WHERE entity_id = ? ORDER BY committed_at DESC, created_at DESC`,
+		entityID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commit links: %w", err)
+	}
+	defer rows.Close()
+
+	var links []*secondary.CommitLinkRecord
+	for rows.Next() {
+		var (
+			workbenchID sql.NullString
+			committedAt sql.NullTime
+			createdAt   time.Time
+		)
+
+		record := &secondary.CommitLinkRecord{}
+		if err := rows.Scan(&record.CommitSHA, &record.EntityType, &record.EntityID, &workbenchID, &record.Subject, &committedAt, &createdAt); err != nil {
+			return nil, fmt.Errorf("failed to scan commit link: %w", err)
+		}
+
+		record.WorkbenchID = workbenchID.String
+		if committedAt.Valid {
+			record.CommittedAt = committedAt.Time.Format(time.RFC3339)
+		}
+		record.CreatedAt = createdAt.Format(time.RFC3339)
+		links = append(links, record)
+	}
+	return links, rows.Err()
+}
+
+// CountByEntities returns the number of linked commits per entity ID in one query.
+func (r *CommitLinkRepository) CountByEntities(ctx context.Context, entityIDs []string) (map[string]int, error) {
+	counts := make(map[string]int)
+	if len(entityIDs) == 0 {
+		return counts, nil
+	}
+
+	query := "SELECT entity_id, COUNT(*) FROM commit_links WHERE entity_id IN (" + inPlaceholders(len(entityIDs)) + ") GROUP BY entity_id"
+	rows, err := r.db.QueryContext(ctx, query, stringArgs(entityIDs)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count commit links: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entityID string
+		var count int
+		if err := rows.Scan(&entityID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan commit link count: %w", err)
+		}
+		counts[entityID] = count
+	}
+	return counts, rows.Err()
+}
+
+// Ensure CommitLinkRepository implements the interface
+var _ secondary.CommitLinkRepository = (*CommitLinkRepository)(nil)
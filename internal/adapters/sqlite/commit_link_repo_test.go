@@ -0,0 +1,135 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestCommitLinkRepository_Create(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewCommitLinkRepository(db)
+	ctx := context.Background()
+
+	seedWorkbench(t, db, "BENCH-001", "", "test-bench")
+
+	link := &secondary.CommitLinkRecord{
+		CommitSHA:   "abc123",
+		EntityType:  "task",
+		EntityID:    "TASK-001",
+		WorkbenchID: "BENCH-001",
+		Subject:     "TASK-001: add retry",
+		CommittedAt: "2026-01-15T10:30:00Z",
+	}
+
+	created, err := repo.Create(ctx, link)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if !created {
+		t.Error("expected first Create to report a new link")
+	}
+
+	// Same commit and entity again is a no-op
+	created, err = repo.Create(ctx, link)
+	if err != nil {
+		t.Fatalf("duplicate Create failed: %v", err)
+	}
+	if created {
+		t.Error("expected duplicate Create to report no new link")
+	}
+
+	links, err := repo.ListByEntity(ctx, "TASK-001")
+	if err != nil {
+		t.Fatalf("ListByEntity failed: %v", err)
+	}
+	if len(links) != 1 {
+		t.Fatalf("expected 1 link, got %d", len(links))
+	}
+	got := links[0]
+	if got.CommitSHA != "abc123" {
+		t.Errorf("CommitSHA = %q, want %q", got.CommitSHA, "abc123")
+	}
+	if got.EntityType != "task" {
+		t.Errorf("EntityType = %q, want %q", got.EntityType, "task")
+	}
+	if got.WorkbenchID != "BENCH-001" {
+		t.Errorf("WorkbenchID = %q, want %q", got.WorkbenchID, "BENCH-001")
+	}
+	if got.Subject != "TASK-001: add retry" {
+		t.Errorf("Subject = %q, want %q", got.Subject, "TASK-001: add retry")
+	}
+	if got.CommittedAt != "2026-01-15T10:30:00Z" {
+		t.Errorf("CommittedAt = %q, want %q", got.CommittedAt, "2026-01-15T10:30:00Z")
+	}
+}
+
+func TestCommitLinkRepository_ListByEntity_Order(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewCommitLinkRepository(db)
+	ctx := context.Background()
+
+	for _, l := range []*secondary.CommitLinkRecord{
+		{CommitSHA: "old", EntityType: "task", EntityID: "TASK-001", Subject: "older", CommittedAt: "2026-01-01T00:00:00Z"},
+		{CommitSHA: "new", EntityType: "task", EntityID: "TASK-001", Subject: "newer", CommittedAt: "2026-02-01T00:00:00Z"},
+		{CommitSHA: "new", EntityType: "shipment", EntityID: "SHIP-001", Subject: "newer", CommittedAt: "2026-02-01T00:00:00Z"},
+	} {
+		if _, err := repo.Create(ctx, l); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	links, err := repo.ListByEntity(ctx, "TASK-001")
+	if err != nil {
+		t.Fatalf("ListByEntity failed: %v", err)
+	}
+	if len(links) != 2 {
+		t.Fatalf("expected 2 links, got %d", len(links))
+	}
+	if links[0].CommitSHA != "new" || links[1].CommitSHA != "old" {
+		t.Errorf("expected newest first, got %s, %s", links[0].CommitSHA, links[1].CommitSHA)
+	}
+	if links[0].WorkbenchID != "" {
+		t.Errorf("expected empty WorkbenchID, got %q", links[0].WorkbenchID)
+	}
+}
+
+func TestCommitLinkRepository_CountByEntities(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewCommitLinkRepository(db)
+	ctx := context.Background()
+
+	for _, l := range []*secondary.CommitLinkRecord{
+		{CommitSHA: "c1", EntityType: "task", EntityID: "TASK-001", Subject: "one"},
+		{CommitSHA: "c2", EntityType: "task", EntityID: "TASK-001", Subject: "two"},
+		{CommitSHA: "c2", EntityType: "task", EntityID: "TASK-002", Subject: "two"},
+	} {
+		if _, err := repo.Create(ctx, l); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	counts, err := repo.CountByEntities(ctx, []string{"TASK-001", "TASK-002", "TASK-003"})
+	if err != nil {
+		t.Fatalf("CountByEntities failed: %v", err)
+	}
+	if counts["TASK-001"] != 2 {
+		t.Errorf("TASK-001 count = %d, want 2", counts["TASK-001"])
+	}
+	if counts["TASK-002"] != 1 {
+		t.Errorf("TASK-002 count = %d, want 1", counts["TASK-002"])
+	}
+	if _, ok := counts["TASK-003"]; ok {
+		t.Error("expected TASK-003 to be absent")
+	}
+
+	empty, err := repo.CountByEntities(ctx, nil)
+	if err != nil {
+		t.Fatalf("CountByEntities(nil) failed: %v", err)
+	}
+	if len(empty) != 0 {
+		t.Errorf("expected empty map, got %v", empty)
+	}
+}
@@ -0,0 +1,102 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// EmbeddingRepository implements secondary.EmbeddingRepository with SQLite.
+type EmbeddingRepository struct {
+	db *sql.DB
+}
+
+// NewEmbeddingRepository creates a new SQLite embedding repository.
+func NewEmbeddingRepository(db *sql.DB) *EmbeddingRepository {
+	return &EmbeddingRepository{db: db}
+}
+
+const embeddingCols = "entity_id, entity_type, model, content_hash, vector, updated_at"
+
+// Upsert stores an entity's embedding, replacing any previous one.
+func (r *EmbeddingRepository) Upsert(ctx context.Context, embedding *secondary.EmbeddingRecord) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO embeddings (entity_id, entity_type, model, content_hash, vector) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(entity_id) DO UPDATE SET entity_type = excluded.entity_type, model = excluded.model,
+			content_hash = excluded.content_hash, vector = excluded.vector, updated_at = CURRENT_TIMESTAMP`,
+		embedding.EntityID, embedding.EntityType, embedding.Model, embedding.ContentHash, encodeVector(embedding.Vector),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to store embedding: %w", err)
+	}
+	return nil
+}
+
+// List retrieves every stored embedding ordered by entity ID.
+func (r *EmbeddingRepository) List(ctx context.Context) ([]*secondary.EmbeddingRecord, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+embeddingCols+" FROM embeddings ORDER BY entity_id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list embeddings: %w", err)
+	}
+	defer rows.Close()
+
+	var embeddings []*secondary.EmbeddingRecord
+	for rows.Next() {
+		record, err := scanEmbedding(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan embedding: %w", err)
+		}
+		embeddings = append(embeddings, record)
+	}
+	return embeddings, rows.Err()
+}
+
+// Delete removes an entity's embedding. Deleting a missing embedding is not an error.
+func (r *EmbeddingRepository) Delete(ctx context.Context, entityID string) error {
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM embeddings WHERE entity_id = ?", entityID); err != nil {
+		return fmt.Errorf("failed to delete embedding: %w", err)
+	}
+	return nil
+}
+
+// scanEmbedding scans an embedding row into an EmbeddingRecord.
+func scanEmbedding(scanner interface {
+	Scan(dest ...any) error
+}) (*secondary.EmbeddingRecord, error) {
+	var vector []byte
+	var updatedAt time.Time
+	record := &secondary.EmbeddingRecord{}
+	if err := scanner.Scan(&record.EntityID, &record.EntityType, &record.Model, &record.ContentHash, &vector, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	record.Vector = decodeVector(vector)
+	record.UpdatedAt = updatedAt.Format(time.RFC3339)
+	return record, nil
+}
+
+// encodeVector packs a vector as little-endian float32s.
+func encodeVector(v []float32) []byte {
+	buf := make([]byte, 4*len(v))
+	for i, x := range v {
+		binary.LittleEndian.PutUint32(buf[4*i:], math.Float32bits(x))
+	}
+	return buf
+}
+
+// decodeVector unpacks little-endian float32s; a trailing partial value is ignored.
+func decodeVector(buf []byte) []float32 {
+	v := make([]float32, len(buf)/4)
+	for i := range v {
+		v[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[4*i:]))
+	}
+	return v
+}
+
+// Ensure EmbeddingRepository implements the interface
+var _ secondary.EmbeddingRepository = (*EmbeddingRepository)(nil)
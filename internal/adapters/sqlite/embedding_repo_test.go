@@ -0,0 +1,62 @@
+package sqlite_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestEmbeddingRepository_UpsertListDelete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewEmbeddingRepository(db)
+	ctx := context.Background()
+
+	for _, e := range []*secondary.EmbeddingRecord{
+		{EntityID: "PLAN-001", EntityType: "plan", Model: "m1", ContentHash: "h1", Vector: []float32{0.6, -0.8}},
+		{EntityID: "NOTE-001", EntityType: "note", Model: "m1", ContentHash: "h2", Vector: []float32{1, 0}},
+	} {
+		if err := repo.Upsert(ctx, e); err != nil {
+			t.Fatalf("Upsert failed: %v", err)
+		}
+	}
+
+	// Replacing an embedding keeps one row per entity
+	if err := repo.Upsert(ctx, &secondary.EmbeddingRecord{EntityID: "NOTE-001", EntityType: "note", Model: "m2", ContentHash: "h3", Vector: []float32{0, 1, 0.5}}); err != nil {
+		t.Fatalf("Upsert failed: %v", err)
+	}
+
+	embeddings, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(embeddings) != 2 {
+		t.Fatalf("expected 2 embeddings, got %d", len(embeddings))
+	}
+	note, plan := embeddings[0], embeddings[1]
+	if note.EntityID != "NOTE-001" || note.Model != "m2" || note.ContentHash != "h3" || note.UpdatedAt == "" {
+		t.Errorf("unexpected note embedding: %+v", note)
+	}
+	if !reflect.DeepEqual(note.Vector, []float32{0, 1, 0.5}) {
+		t.Errorf("note vector = %v, want [0 1 0.5]", note.Vector)
+	}
+	if plan.EntityType != "plan" || !reflect.DeepEqual(plan.Vector, []float32{0.6, -0.8}) {
+		t.Errorf("unexpected plan embedding: %+v", plan)
+	}
+
+	if err := repo.Delete(ctx, "NOTE-001"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := repo.Delete(ctx, "NOTE-999"); err != nil {
+		t.Errorf("Delete of missing embedding should succeed, got %v", err)
+	}
+	embeddings, err = repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(embeddings) != 1 || embeddings[0].EntityID != "PLAN-001" {
+		t.Errorf("expected only PLAN-001 left, got %+v", embeddings)
+	}
+}
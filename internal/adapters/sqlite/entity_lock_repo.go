@@ -0,0 +1,134 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// EntityLockRepository implements secondary.EntityLockRepository with SQLite.
+type EntityLockRepository struct {
+	db *sql.DB
+}
+
+// NewEntityLockRepository creates a new SQLite entity lock repository.
+func NewEntityLockRepository(db *sql.DB) *EntityLockRepository {
+	return &EntityLockRepository{db: db}
+}
+
+const entityLockCols = "entity_id, held_by, reason, acquired_at, expires_at"
+
+// Save creates or replaces an entity's lock.
+func (r *EntityLockRepository) Save(ctx context.Context, lock *secondary.EntityLockRecord) error {
+	acquiredAt, err := time.Parse(time.RFC3339, lock.AcquiredAt)
+	if err != nil {
+		return fmt.Errorf("invalid acquire time %q: %w", lock.AcquiredAt, err)
+	}
+	expiresAt, err := time.Parse(time.RFC3339, lock.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("invalid expiry time %q: %w", lock.ExpiresAt, err)
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO entity_locks (entity_id, held_by, reason, acquired_at, expires_at) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT(entity_id) DO UPDATE SET held_by = excluded.held_by, reason = excluded.reason,
+			acquired_at = excluded.acquired_at, expires_at = excluded.expires_at`,
+		lock.EntityID,
+		lock.HeldBy,
+		sql.NullString{String: lock.Reason, Valid: lock.Reason != ""},
+		acquiredAt.UTC(),
+		expiresAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save entity lock: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves an entity's lock (nil if none), expired or not.
+func (r *EntityLockRepository) Get(ctx context.Context, entityID string) (*secondary.EntityLockRecord, error) {
+	row := r.db.QueryRowContext(ctx,
+		"SELECT "+entityLockCols+" FROM entity_locks WHERE entity_id = ?",
+		entityID,
+	)
+	record, err := scanEntityLock(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entity lock: %w", err)
+	}
+	return record, nil
+}
+
+// Delete removes an entity's lock.
+func (r *EntityLockRepository) Delete(ctx context.Context, entityID string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM entity_locks WHERE entity_id = ?", entityID)
+	if err != nil {
+		return fmt.Errorf("failed to delete entity lock: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("%s is not locked", entityID)
+	}
+	return nil
+}
+
+// List retrieves all lock rows ordered by entity ID.
+func (r *EntityLockRepository) List(ctx context.Context) ([]*secondary.EntityLockRecord, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+entityLockCols+" FROM entity_locks ORDER BY entity_id")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list entity locks: %w", err)
+	}
+	defer rows.Close()
+
+	var locks []*secondary.EntityLockRecord
+	for rows.Next() {
+		record, err := scanEntityLock(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan entity lock: %w", err)
+		}
+		locks = append(locks, record)
+	}
+	return locks, rows.Err()
+}
+
+// DeleteExpired removes locks that expired at or before the given RFC3339 time.
+func (r *EntityLockRepository) DeleteExpired(ctx context.Context, before string) (int, error) {
+	t, err := time.Parse(time.RFC3339, before)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q: %w", before, err)
+	}
+
+	result, err := r.db.ExecContext(ctx, "DELETE FROM entity_locks WHERE expires_at <= ?", t.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired locks: %w", err)
+	}
+	rowsAffected, _ := result.RowsAffected()
+	return int(rowsAffected), nil
+}
+
+// scanEntityLock scans a lock row into an EntityLockRecord.
+func scanEntityLock(scanner interface {
+	Scan(dest ...any) error
+}) (*secondary.EntityLockRecord, error) {
+	var (
+		reason                sql.NullString
+		acquiredAt, expiresAt time.Time
+	)
+	record := &secondary.EntityLockRecord{}
+	if err := scanner.Scan(&record.EntityID, &record.HeldBy, &reason, &acquiredAt, &expiresAt); err != nil {
+		return nil, err
+	}
+	record.Reason = reason.String
+	record.AcquiredAt = acquiredAt.UTC().Format(time.RFC3339)
+	record.ExpiresAt = expiresAt.UTC().Format(time.RFC3339)
+	return record, nil
+}
+
+// Ensure EntityLockRepository implements the interface
+var _ secondary.EntityLockRepository = (*EntityLockRepository)(nil)
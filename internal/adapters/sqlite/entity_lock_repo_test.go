@@ -0,0 +1,87 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestEntityLockRepository_SaveGetDelete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewEntityLockRepository(db)
+	ctx := context.Background()
+
+	lock, err := repo.Get(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if lock != nil {
+		t.Fatalf("expected no lock, got %+v", lock)
+	}
+
+	if err := repo.Save(ctx, &secondary.EntityLockRecord{
+		EntityID: "SHIP-001", HeldBy: "GOBLIN", Reason: "restructuring",
+		AcquiredAt: "2026-10-01T14:02:00Z", ExpiresAt: "2026-10-01T14:32:00Z",
+	}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	// Saving again replaces the lock (renewal or takeover)
+	if err := repo.Save(ctx, &secondary.EntityLockRecord{
+		EntityID: "SHIP-001", HeldBy: "GOBLIN",
+		AcquiredAt: "2026-10-01T14:02:00Z", ExpiresAt: "2026-10-01T15:02:00Z",
+	}); err != nil {
+		t.Fatalf("Save (renew) failed: %v", err)
+	}
+
+	lock, err = repo.Get(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if lock.HeldBy != "GOBLIN" || lock.Reason != "" || lock.AcquiredAt != "2026-10-01T14:02:00Z" || lock.ExpiresAt != "2026-10-01T15:02:00Z" {
+		t.Errorf("unexpected lock: %+v", lock)
+	}
+
+	if err := repo.Delete(ctx, "SHIP-001"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := repo.Delete(ctx, "SHIP-001"); err == nil {
+		t.Error("expected error deleting a missing lock")
+	}
+}
+
+func TestEntityLockRepository_ListAndDeleteExpired(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewEntityLockRepository(db)
+	ctx := context.Background()
+
+	for _, l := range []*secondary.EntityLockRecord{
+		{EntityID: "SHIP-002", HeldBy: "GOBLIN", AcquiredAt: "2026-10-01T13:00:00Z", ExpiresAt: "2026-10-01T13:30:00Z"},
+		{EntityID: "PLAN-001", HeldBy: "IMP-BENCH-001", AcquiredAt: "2026-10-01T14:00:00Z", ExpiresAt: "2026-10-01T14:30:00Z"},
+	} {
+		if err := repo.Save(ctx, l); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	locks, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(locks) != 2 || locks[0].EntityID != "PLAN-001" {
+		t.Fatalf("expected 2 locks ordered by entity ID, got %+v", locks)
+	}
+
+	removed, err := repo.DeleteExpired(ctx, "2026-10-01T14:00:00Z")
+	if err != nil {
+		t.Fatalf("DeleteExpired failed: %v", err)
+	}
+	if removed != 1 {
+		t.Errorf("removed = %d, want 1", removed)
+	}
+	locks, _ = repo.List(ctx)
+	if len(locks) != 1 || locks[0].EntityID != "PLAN-001" {
+		t.Errorf("expected only PLAN-001 to remain, got %+v", locks)
+	}
+}
@@ -0,0 +1,59 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// FlowStepRepository implements secondary.FlowStepRepository with SQLite.
+type FlowStepRepository struct {
+	db *sql.DB
+}
+
+// NewFlowStepRepository creates a new SQLite flow step repository.
+func NewFlowStepRepository(db *sql.DB) *FlowStepRepository {
+	return &FlowStepRepository{db: db}
+}
+
+// ListByRun retrieves the completed steps of a flow run, oldest first.
+func (r *FlowStepRepository) ListByRun(ctx context.Context, runKey string) ([]*secondary.FlowStepRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT run_key, step_key, output, completed_at FROM flow_steps WHERE run_key = ? ORDER BY completed_at, rowid",
+		runKey,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flow steps: %w", err)
+	}
+	defer rows.Close()
+
+	var steps []*secondary.FlowStepRecord
+	for rows.Next() {
+		var step secondary.FlowStepRecord
+		var completedAt time.Time
+		if err := rows.Scan(&step.RunKey, &step.StepKey, &step.Output, &completedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan flow step: %w", err)
+		}
+		step.CompletedAt = completedAt.Format(time.RFC3339)
+		steps = append(steps, &step)
+	}
+	return steps, rows.Err()
+}
+
+// Record marks a step of a flow run completed.
+func (r *FlowStepRepository) Record(ctx context.Context, step *secondary.FlowStepRecord) error {
+	_, err := r.db.ExecContext(ctx,
+		"INSERT INTO flow_steps (run_key, step_key, output) VALUES (?, ?, ?)",
+		step.RunKey, step.StepKey, step.Output,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record flow step: %w", err)
+	}
+	return nil
+}
+
+// Ensure FlowStepRepository implements the interface
+var _ secondary.FlowStepRepository = (*FlowStepRepository)(nil)
@@ -0,0 +1,41 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestFlowStepRepository_RecordAndList(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewFlowStepRepository(db)
+	ctx := context.Background()
+
+	for _, step := range []*secondary.FlowStepRecord{
+		{RunKey: "kickoff-1", StepKey: "ship", Output: "SHIP-001"},
+		{RunKey: "kickoff-1", StepKey: "tasks#1", Output: "TASK-001"},
+		{RunKey: "kickoff-2", StepKey: "ship", Output: "SHIP-002"},
+	} {
+		if err := repo.Record(ctx, step); err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+	}
+	if err := repo.Record(ctx, &secondary.FlowStepRecord{RunKey: "kickoff-1", StepKey: "ship", Output: "SHIP-003"}); err == nil {
+		t.Error("expected recording a step twice to fail")
+	}
+
+	steps, err := repo.ListByRun(ctx, "kickoff-1")
+	if err != nil {
+		t.Fatalf("ListByRun failed: %v", err)
+	}
+	if len(steps) != 2 || steps[0].StepKey != "ship" || steps[1].Output != "TASK-001" || steps[0].CompletedAt == "" {
+		t.Errorf("unexpected steps: %+v", steps)
+	}
+
+	steps, err = repo.ListByRun(ctx, "other")
+	if err != nil || len(steps) != 0 {
+		t.Errorf("expected no steps for an unknown run, got %v (%v)", steps, err)
+	}
+}
@@ -0,0 +1,72 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// focusHistoryKeep is how many focus changes are kept per workbench.
+const focusHistoryKeep = 50
+
+// FocusHistoryRepository implements secondary.FocusHistoryRepository with SQLite.
+type FocusHistoryRepository struct {
+	db *sql.DB
+}
+
+// NewFocusHistoryRepository creates a new SQLite focus history repository.
+func NewFocusHistoryRepository(db *sql.DB) *FocusHistoryRepository {
+	return &FocusHistoryRepository{db: db}
+}
+
+// Record appends a focus change, keeping only the newest entries per workbench.
+func (r *FocusHistoryRepository) Record(ctx context.Context, workbenchID, focusedID string) error {
+	if _, err := r.db.ExecContext(ctx,
+		"INSERT INTO focus_history (workbench_id, focused_id) VALUES (?, ?)",
+		workbenchID, focusedID,
+	); err != nil {
+		return fmt.Errorf("failed to record focus: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx,
+		`DELETE FROM focus_history WHERE workbench_id = ? AND id NOT IN (
+			SELECT id FROM focus_history WHERE workbench_id = ? ORDER BY id DESC LIMIT ?)`,
+		workbenchID, workbenchID, focusHistoryKeep,
+	); err != nil {
+		return fmt.Errorf("failed to prune focus history: %w", err)
+	}
+	return nil
+}
+
+// ListRecent retrieves a workbench's distinct focus targets, most recently
+// focused first, each with the time it was last focused.
+func (r *FocusHistoryRepository) ListRecent(ctx context.Context, workbenchID string, limit int) ([]*secondary.FocusHistoryRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT workbench_id, focused_id, focused_at FROM focus_history
+		WHERE id IN (SELECT MAX(id) FROM focus_history WHERE workbench_id = ? GROUP BY focused_id)
+		ORDER BY id DESC LIMIT ?`,
+		workbenchID, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list focus history: %w", err)
+	}
+	defer rows.Close()
+
+	var history []*secondary.FocusHistoryRecord
+	for rows.Next() {
+		var focusedAt time.Time
+		record := &secondary.FocusHistoryRecord{}
+		if err := rows.Scan(&record.WorkbenchID, &record.FocusedID, &focusedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan focus history: %w", err)
+		}
+		record.FocusedAt = focusedAt.UTC().Format(time.RFC3339)
+		history = append(history, record)
+	}
+	return history, rows.Err()
+}
+
+// Ensure FocusHistoryRepository implements the interface
+var _ secondary.FocusHistoryRepository = (*FocusHistoryRepository)(nil)
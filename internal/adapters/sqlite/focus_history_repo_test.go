@@ -0,0 +1,58 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+)
+
+func TestFocusHistoryRepository_RecordAndListRecent(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewFocusHistoryRepository(db)
+	ctx := context.Background()
+
+	db.ExecContext(ctx, "INSERT INTO factories (id, name, status) VALUES (?, ?, ?)", "FACT-001", "Test Factory", "active")
+	db.ExecContext(ctx, "INSERT INTO workshops (id, factory_id, name, status) VALUES (?, ?, ?, ?)", "WORK-001", "FACT-001", "Test Workshop", "active")
+	db.ExecContext(ctx, "INSERT INTO workbenches (id, workshop_id, name, status) VALUES (?, ?, ?, ?)", "BENCH-001", "WORK-001", "Test Workbench", "active")
+	db.ExecContext(ctx, "INSERT INTO workbenches (id, workshop_id, name, status) VALUES (?, ?, ?, ?)", "BENCH-002", "WORK-001", "Other Workbench", "active")
+
+	for _, id := range []string{"SHIP-001", "TOME-001", "SHIP-001", "COMM-001"} {
+		if err := repo.Record(ctx, "BENCH-001", id); err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+	}
+	if err := repo.Record(ctx, "BENCH-002", "SHIP-009"); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+
+	history, err := repo.ListRecent(ctx, "BENCH-001", 10)
+	if err != nil {
+		t.Fatalf("ListRecent failed: %v", err)
+	}
+	var ids []string
+	for _, h := range history {
+		ids = append(ids, h.FocusedID)
+		if h.FocusedAt == "" {
+			t.Errorf("%s has no focus time", h.FocusedID)
+		}
+	}
+	// Distinct targets, newest first; the other workbench is not included
+	want := []string{"COMM-001", "SHIP-001", "TOME-001"}
+	if len(ids) != len(want) {
+		t.Fatalf("ListRecent = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("ListRecent = %v, want %v", ids, want)
+		}
+	}
+
+	limited, err := repo.ListRecent(ctx, "BENCH-001", 2)
+	if err != nil {
+		t.Fatalf("ListRecent failed: %v", err)
+	}
+	if len(limited) != 2 {
+		t.Errorf("expected 2 entries with limit, got %d", len(limited))
+	}
+}
@@ -0,0 +1,89 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ForeignLedgerReader implements secondary.ForeignLedgerReader by opening
+// other ledgers read-only for the length of a lookup.
+type ForeignLedgerReader struct{}
+
+// NewForeignLedgerReader creates a new SQLite foreign ledger reader.
+func NewForeignLedgerReader() *ForeignLedgerReader {
+	return &ForeignLedgerReader{}
+}
+
+// open opens the ledger at path read-only. A missing file is an error rather
+// than a new, empty ledger.
+func (r *ForeignLedgerReader) open(path string) (*sql.DB, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("not readable at %s: %w", path, err)
+	}
+	ledger, err := sql.Open("sqlite3", "file:"+path+"?mode=ro&_busy_timeout=5000")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ledger at %s: %w", path, err)
+	}
+	return ledger, nil
+}
+
+// LookupEntity retrieves an entity from the ledger at path.
+func (r *ForeignLedgerReader) LookupEntity(ctx context.Context, path, entityID string) (*secondary.ForeignEntityRecord, error) {
+	table, err := entityTable(entityID)
+	if err != nil {
+		return nil, err
+	}
+	ledger, err := r.open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer ledger.Close()
+
+	commissionColumn := "commission_id"
+	if table == "commissions" {
+		commissionColumn = "''"
+	}
+	var (
+		entity       secondary.ForeignEntityRecord
+		status       sql.NullString
+		commissionID sql.NullString
+	)
+	err = ledger.QueryRowContext(ctx,
+		"SELECT id, title, status, "+commissionColumn+" FROM "+table+" WHERE id = ?", entityID,
+	).Scan(&entity.ID, &entity.Title, &status, &commissionID)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%s not found", entityID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", entityID, err)
+	}
+	entity.Status = status.String
+	entity.CommissionID = commissionID.String
+	return &entity, nil
+}
+
+// Check opens the ledger at path and returns its schema version.
+func (r *ForeignLedgerReader) Check(ctx context.Context, path string) (int, error) {
+	ledger, err := r.open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer ledger.Close()
+
+	var version int
+	if err := ledger.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+		return 0, fmt.Errorf("not an ORC ledger: %w", err)
+	}
+	var tables int
+	if err := ledger.QueryRowContext(ctx, "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'shipments'").Scan(&tables); err != nil || tables == 0 {
+		return 0, fmt.Errorf("not an ORC ledger: %s has no shipments table", path)
+	}
+	return version, nil
+}
+
+// Ensure ForeignLedgerReader implements the interface
+var _ secondary.ForeignLedgerReader = (*ForeignLedgerReader)(nil)
@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// GlossaryRepository implements secondary.GlossaryRepository with SQLite.
+type GlossaryRepository struct {
+	db *sql.DB
+}
+
+// NewGlossaryRepository creates a new SQLite glossary repository.
+func NewGlossaryRepository(db *sql.DB) *GlossaryRepository {
+	return &GlossaryRepository{db: db}
+}
+
+const glossaryTermCols = "commission_id, term, definition, replaced_by, created_at, updated_at"
+
+// Save creates a term or replaces its definition and replacement. An existing
+// term keeps its original spelling.
+func (r *GlossaryRepository) Save(ctx context.Context, term *secondary.GlossaryTermRecord) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO glossary_terms (commission_id, term, definition, replaced_by) VALUES (?, ?, ?, ?)
+		ON CONFLICT(commission_id, term) DO UPDATE SET definition = excluded.definition,
+			replaced_by = excluded.replaced_by, updated_at = CURRENT_TIMESTAMP`,
+		term.CommissionID, term.Term, term.Definition, sql.NullString{String: term.ReplacedBy, Valid: term.ReplacedBy != ""},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save glossary term: %w", err)
+	}
+	return nil
+}
+
+// List retrieves a commission's terms ordered by term.
+func (r *GlossaryRepository) List(ctx context.Context, commissionID string) ([]*secondary.GlossaryTermRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+glossaryTermCols+" FROM glossary_terms WHERE commission_id = ? ORDER BY term",
+		commissionID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list glossary terms: %w", err)
+	}
+	defer rows.Close()
+
+	var terms []*secondary.GlossaryTermRecord
+	for rows.Next() {
+		var (
+			term       secondary.GlossaryTermRecord
+			replacedBy sql.NullString
+			createdAt  sql.NullString
+			updatedAt  sql.NullString
+		)
+		if err := rows.Scan(&term.CommissionID, &term.Term, &term.Definition, &replacedBy, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan glossary term: %w", err)
+		}
+		term.ReplacedBy = replacedBy.String
+		term.CreatedAt = createdAt.String
+		term.UpdatedAt = updatedAt.String
+		terms = append(terms, &term)
+	}
+	return terms, rows.Err()
+}
+
+// Delete removes a term from a commission's glossary.
+func (r *GlossaryRepository) Delete(ctx context.Context, commissionID, term string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM glossary_terms WHERE commission_id = ? AND term = ?", commissionID, term)
+	if err != nil {
+		return fmt.Errorf("failed to delete glossary term: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("term %q not found in the glossary of %s", term, commissionID)
+	}
+	return nil
+}
+
+// Ensure GlossaryRepository implements the interface
+var _ secondary.GlossaryRepository = (*GlossaryRepository)(nil)
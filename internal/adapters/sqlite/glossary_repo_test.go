@@ -0,0 +1,51 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestGlossaryRepository_SaveListDelete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewGlossaryRepository(db)
+	ctx := context.Background()
+
+	seedCommission(t, db, "COMM-001", "Client work")
+	seedCommission(t, db, "COMM-002", "Internal")
+
+	for _, term := range []*secondary.GlossaryTermRecord{
+		{CommissionID: "COMM-001", Term: "workbench", Definition: "A git worktree"},
+		{CommissionID: "COMM-001", Term: "grove", ReplacedBy: "workbench"},
+		{CommissionID: "COMM-002", Term: "tome", Definition: "A collection of notes"},
+	} {
+		if err := repo.Save(ctx, term); err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+	}
+
+	// Saving again, in any case, replaces the definition
+	if err := repo.Save(ctx, &secondary.GlossaryTermRecord{CommissionID: "COMM-001", Term: "Workbench", Definition: "A git worktree an IMP works in"}); err != nil {
+		t.Fatalf("Save (replace) failed: %v", err)
+	}
+
+	terms, err := repo.List(ctx, "COMM-001")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(terms) != 2 || terms[0].Term != "grove" || terms[1].Term != "workbench" {
+		t.Fatalf("unexpected terms: %+v", terms)
+	}
+	if terms[0].ReplacedBy != "workbench" || terms[1].ReplacedBy != "" || terms[1].Definition != "A git worktree an IMP works in" {
+		t.Errorf("unexpected term fields: %+v, %+v", terms[0], terms[1])
+	}
+
+	if err := repo.Delete(ctx, "COMM-001", "GROVE"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := repo.Delete(ctx, "COMM-001", "grove"); err == nil {
+		t.Error("expected error deleting missing term")
+	}
+}
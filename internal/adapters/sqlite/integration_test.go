@@ -85,6 +85,27 @@ func TestIntegration_CommissionExistsConstraint(t *testing.T) {
 	}
 }
 
+func TestIntegration_WorkbenchExistsConstraint(t *testing.T) {
+	db := setupTestDB(t)
+	ctx := context.Background()
+
+	shipmentRepo := sqlite.NewShipmentRepository(db, nil)
+	tomeRepo := sqlite.NewTomeRepository(db, nil)
+
+	for _, check := range []func(context.Context, string) (bool, error){shipmentRepo.WorkbenchExists, tomeRepo.WorkbenchExists} {
+		if exists, err := check(ctx, "BENCH-001"); err != nil || exists {
+			t.Errorf("expected false for non-existent workbench, got %v (%v)", exists, err)
+		}
+	}
+
+	seedWorkbench(t, db, "BENCH-001", "", "bench")
+	for _, check := range []func(context.Context, string) (bool, error){shipmentRepo.WorkbenchExists, tomeRepo.WorkbenchExists} {
+		if exists, err := check(ctx, "BENCH-001"); err != nil || !exists {
+			t.Errorf("expected true for existing workbench, got %v (%v)", exists, err)
+		}
+	}
+}
+
 // ============================================================================
 // Shipment Workflow Tests
 // ============================================================================
@@ -0,0 +1,166 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	coreledger "github.com/example/orc/internal/core/ledger"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// LedgerRepository implements secondary.LedgerRepository with SQLite.
+type LedgerRepository struct {
+	db *sql.DB
+}
+
+// NewLedgerRepository creates a new SQLite ledger repository.
+func NewLedgerRepository(db *sql.DB) *LedgerRepository {
+	return &LedgerRepository{db: db}
+}
+
+// entityTable returns the table holding an entity that can be referred to
+// across ledgers: its type, pluralized.
+func entityTable(entityID string) (string, error) {
+	entityType, ok := coreledger.EntityType(entityID)
+	if !ok {
+		return "", fmt.Errorf("unsupported entity %s", entityID)
+	}
+	return entityType + "s", nil
+}
+
+// Add registers a ledger under an alias.
+func (r *LedgerRepository) Add(ctx context.Context, ledger *secondary.LedgerRecord) error {
+	_, err := r.db.ExecContext(ctx, "INSERT INTO ledgers (alias, path) VALUES (?, ?)", ledger.Alias, ledger.Path)
+	if err != nil {
+		if strings.Contains(err.Error(), "UNIQUE constraint failed") {
+			return fmt.Errorf("ledger %s is already registered", ledger.Alias)
+		}
+		return fmt.Errorf("failed to add ledger: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves a registered ledger by alias.
+func (r *LedgerRepository) Get(ctx context.Context, alias string) (*secondary.LedgerRecord, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT alias, path, created_at FROM ledgers WHERE alias = ?", alias)
+	ledger, err := scanLedger(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("ledger %s not found", alias)
+	}
+	return ledger, err
+}
+
+// List retrieves the registered ledgers, by alias.
+func (r *LedgerRepository) List(ctx context.Context) ([]*secondary.LedgerRecord, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT alias, path, created_at FROM ledgers ORDER BY alias")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ledgers: %w", err)
+	}
+	defer rows.Close()
+
+	var ledgers []*secondary.LedgerRecord
+	for rows.Next() {
+		ledger, err := scanLedger(rows)
+		if err != nil {
+			return nil, err
+		}
+		ledgers = append(ledgers, ledger)
+	}
+	return ledgers, rows.Err()
+}
+
+// Delete unregisters a ledger and removes the links made to its entities.
+func (r *LedgerRepository) Delete(ctx context.Context, alias string) error {
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM ledger_refs WHERE ref LIKE ? ESCAPE '\\'", escapeLike(alias)+":%"); err != nil {
+		return fmt.Errorf("failed to remove ledger references: %w", err)
+	}
+	result, err := r.db.ExecContext(ctx, "DELETE FROM ledgers WHERE alias = ?", alias)
+	if err != nil {
+		return fmt.Errorf("failed to delete ledger: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return fmt.Errorf("ledger %s not found", alias)
+	}
+	return nil
+}
+
+// EntityExists reports whether a commission, shipment, task, tome, note, or
+// plan with the ID exists in this ledger.
+func (r *LedgerRepository) EntityExists(ctx context.Context, entityID string) (bool, error) {
+	table, err := entityTable(entityID)
+	if err != nil {
+		return false, err
+	}
+	var n int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM "+table+" WHERE id = ?", entityID).Scan(&n); err != nil {
+		return false, fmt.Errorf("failed to look up %s: %w", entityID, err)
+	}
+	return n > 0, nil
+}
+
+// AddRef links a local entity to a reference ("alias:ID").
+func (r *LedgerRepository) AddRef(ctx context.Context, entityID, ref string) error {
+	_, err := r.db.ExecContext(ctx, "INSERT OR IGNORE INTO ledger_refs (entity_id, ref) VALUES (?, ?)", entityID, ref)
+	if err != nil {
+		return fmt.Errorf("failed to link reference: %w", err)
+	}
+	return nil
+}
+
+// RemoveRef removes a link.
+func (r *LedgerRepository) RemoveRef(ctx context.Context, entityID, ref string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM ledger_refs WHERE entity_id = ? AND ref = ?", entityID, ref)
+	if err != nil {
+		return fmt.Errorf("failed to unlink reference: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return fmt.Errorf("%s is not linked to %s", entityID, ref)
+	}
+	return nil
+}
+
+// ListRefs retrieves the references linked from an entity, in the order
+// they were made.
+func (r *LedgerRepository) ListRefs(ctx context.Context, entityID string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT ref FROM ledger_refs WHERE entity_id = ? ORDER BY created_at, rowid", entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list references: %w", err)
+	}
+	defer rows.Close()
+
+	var refs []string
+	for rows.Next() {
+		var ref string
+		if err := rows.Scan(&ref); err != nil {
+			return nil, fmt.Errorf("failed to scan reference: %w", err)
+		}
+		refs = append(refs, ref)
+	}
+	return refs, rows.Err()
+}
+
+// escapeLike escapes LIKE wildcards so s matches literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+func scanLedger(row interface{ Scan(...any) error }) (*secondary.LedgerRecord, error) {
+	var (
+		ledger    secondary.LedgerRecord
+		createdAt time.Time
+	)
+	if err := row.Scan(&ledger.Alias, &ledger.Path, &createdAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan ledger: %w", err)
+	}
+	ledger.CreatedAt = createdAt.Format(time.RFC3339)
+	return &ledger, nil
+}
+
+// Ensure LedgerRepository implements the interface
+var _ secondary.LedgerRepository = (*LedgerRepository)(nil)
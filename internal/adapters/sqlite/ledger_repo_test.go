@@ -0,0 +1,180 @@
+package sqlite_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/db"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestLedgerRepository_Registry(t *testing.T) {
+	database := setupTestDB(t)
+	repo := sqlite.NewLedgerRepository(database)
+	ctx := context.Background()
+
+	if err := repo.Add(ctx, &secondary.LedgerRecord{Alias: "zeta", Path: "/ledgers/zeta.db"}); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if err := repo.Add(ctx, &secondary.LedgerRecord{Alias: "acme", Path: "/ledgers/acme.db"}); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	if err := repo.Add(ctx, &secondary.LedgerRecord{Alias: "acme", Path: "/elsewhere.db"}); err == nil {
+		t.Error("expected error registering an alias twice")
+	}
+
+	ledger, err := repo.Get(ctx, "acme")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if ledger.Path != "/ledgers/acme.db" || ledger.CreatedAt == "" {
+		t.Errorf("unexpected ledger: %+v", ledger)
+	}
+
+	ledgers, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(ledgers) != 2 || ledgers[0].Alias != "acme" || ledgers[1].Alias != "zeta" {
+		t.Errorf("expected ledgers by alias, got %+v", ledgers)
+	}
+
+	if _, err := repo.Get(ctx, "missing"); err == nil {
+		t.Error("expected error for a missing ledger")
+	}
+	if err := repo.Delete(ctx, "missing"); err == nil {
+		t.Error("expected error deleting a missing ledger")
+	}
+}
+
+func TestLedgerRepository_Refs(t *testing.T) {
+	database := setupTestDB(t)
+	repo := sqlite.NewLedgerRepository(database)
+	ctx := context.Background()
+	seedCommission(t, database, "COMM-001", "")
+	seedShipment(t, database, "SHIP-001", "COMM-001", "Billing")
+
+	if err := repo.Add(ctx, &secondary.LedgerRecord{Alias: "acme", Path: "/ledgers/acme.db"}); err != nil {
+		t.Fatalf("Add failed: %v", err)
+	}
+	for _, ref := range []string{"acme:SHIP-004", "acme_x:SHIP-001", "acme:TASK-010", "acme:SHIP-004"} {
+		if err := repo.AddRef(ctx, "SHIP-001", ref); err != nil {
+			t.Fatalf("AddRef(%s) failed: %v", ref, err)
+		}
+	}
+
+	refs, err := repo.ListRefs(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("ListRefs failed: %v", err)
+	}
+	if len(refs) != 3 || refs[0] != "acme:SHIP-004" || refs[2] != "acme:TASK-010" {
+		t.Errorf("expected refs in link order without duplicates, got %v", refs)
+	}
+
+	if err := repo.RemoveRef(ctx, "SHIP-001", "acme:TASK-010"); err != nil {
+		t.Fatalf("RemoveRef failed: %v", err)
+	}
+	if err := repo.RemoveRef(ctx, "SHIP-001", "acme:TASK-010"); err == nil {
+		t.Error("expected error removing a missing link")
+	}
+
+	// Unregistering a ledger drops its links, and only its links
+	if err := repo.Delete(ctx, "acme"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	refs, _ = repo.ListRefs(ctx, "SHIP-001")
+	if len(refs) != 1 || refs[0] != "acme_x:SHIP-001" {
+		t.Errorf("expected only the other ledger's link to remain, got %v", refs)
+	}
+}
+
+func TestLedgerRepository_EntityExists(t *testing.T) {
+	database := setupTestDB(t)
+	repo := sqlite.NewLedgerRepository(database)
+	ctx := context.Background()
+	seedCommission(t, database, "COMM-001", "")
+	seedShipment(t, database, "SHIP-001", "COMM-001", "Billing")
+
+	if ok, err := repo.EntityExists(ctx, "SHIP-001"); err != nil || !ok {
+		t.Errorf("EntityExists(SHIP-001) = %v, %v", ok, err)
+	}
+	if ok, err := repo.EntityExists(ctx, "TASK-001"); err != nil || ok {
+		t.Errorf("EntityExists(TASK-001) = %v, %v", ok, err)
+	}
+	if _, err := repo.EntityExists(ctx, "BENCH-001"); err == nil {
+		t.Error("expected error for an unsupported entity")
+	}
+}
+
+// setupForeignLedger creates a ledger file with the authoritative schema
+// and returns its path and an open handle for seeding.
+func setupForeignLedger(t *testing.T) (string, *sql.DB) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "acme.db")
+	ledger, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatalf("failed to open ledger: %v", err)
+	}
+	t.Cleanup(func() { ledger.Close() })
+	if _, err := ledger.Exec(db.GetSchemaSQL()); err != nil {
+		t.Fatalf("failed to create schema: %v", err)
+	}
+	return path, ledger
+}
+
+func TestForeignLedgerReader_LookupEntity(t *testing.T) {
+	path, ledger := setupForeignLedger(t)
+	seedCommission(t, ledger, "COMM-001", "Client portal")
+	seedShipment(t, ledger, "SHIP-004", "COMM-001", "Payments API")
+	reader := sqlite.NewForeignLedgerReader()
+	ctx := context.Background()
+
+	entity, err := reader.LookupEntity(ctx, path, "SHIP-004")
+	if err != nil {
+		t.Fatalf("LookupEntity failed: %v", err)
+	}
+	if entity.Title != "Payments API" || entity.CommissionID != "COMM-001" || entity.Status == "" {
+		t.Errorf("unexpected entity: %+v", entity)
+	}
+
+	commission, err := reader.LookupEntity(ctx, path, "COMM-001")
+	if err != nil {
+		t.Fatalf("LookupEntity(COMM-001) failed: %v", err)
+	}
+	if commission.Title != "Client portal" || commission.CommissionID != "" {
+		t.Errorf("unexpected commission: %+v", commission)
+	}
+
+	if _, err := reader.LookupEntity(ctx, path, "SHIP-999"); err == nil {
+		t.Error("expected error for a missing entity")
+	}
+	if _, err := reader.LookupEntity(ctx, filepath.Join(t.TempDir(), "gone.db"), "SHIP-004"); err == nil {
+		t.Error("expected error for a missing ledger")
+	}
+}
+
+func TestForeignLedgerReader_Check(t *testing.T) {
+	path, ledger := setupForeignLedger(t)
+	if _, err := ledger.Exec("PRAGMA user_version = 23"); err != nil {
+		t.Fatalf("failed to set version: %v", err)
+	}
+	reader := sqlite.NewForeignLedgerReader()
+	ctx := context.Background()
+
+	version, err := reader.Check(ctx, path)
+	if err != nil || version != 23 {
+		t.Errorf("Check() = %d, %v; want 23", version, err)
+	}
+
+	notLedger := filepath.Join(t.TempDir(), "notes.txt")
+	if err := os.WriteFile(notLedger, []byte("hello"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := reader.Check(ctx, notLedger); err == nil {
+		t.Error("expected error for a file that is not a ledger")
+	}
+}
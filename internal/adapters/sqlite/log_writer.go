@@ -68,6 +68,8 @@ func (w *LogWriterAdapter) writeLog(ctx context.Context, entityType, entityID, a
 		FieldName:  fieldName,
 		OldValue:   oldValue,
 		NewValue:   newValue,
+		UndoOf:     ctxutil.UndoOfFromContext(ctx),
+		Forced:     ctxutil.ForcedFromContext(ctx),
 	}
 
 	return w.logRepo.Create(ctx, record)
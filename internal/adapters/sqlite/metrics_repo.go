@@ -0,0 +1,79 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// MetricsRepository implements secondary.MetricsRepository with SQLite.
+type MetricsRepository struct {
+	db *sql.DB
+}
+
+// NewMetricsRepository creates a new SQLite metrics repository.
+func NewMetricsRepository(db *sql.DB) *MetricsRepository {
+	return &MetricsRepository{db: db}
+}
+
+// CountTasks returns task counts grouped by commission and status.
+func (r *MetricsRepository) CountTasks(ctx context.Context) ([]*secondary.StatusCountRecord, error) {
+	return r.countByStatus(ctx, "tasks")
+}
+
+// CountShipments returns shipment counts grouped by commission and status.
+func (r *MetricsRepository) CountShipments(ctx context.Context) ([]*secondary.StatusCountRecord, error) {
+	return r.countByStatus(ctx, "shipments")
+}
+
+// CountPlans returns plan counts grouped by commission and status.
+func (r *MetricsRepository) CountPlans(ctx context.Context) ([]*secondary.StatusCountRecord, error) {
+	return r.countByStatus(ctx, "plans")
+}
+
+// countByStatus groups a commission-scoped table by commission and status.
+// table is always a constant from this file, never user input.
+func (r *MetricsRepository) countByStatus(ctx context.Context, table string) ([]*secondary.StatusCountRecord, error) {
+	query := "SELECT commission_id, status, COUNT(*) FROM " + table + " GROUP BY commission_id, status"
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	var counts []*secondary.StatusCountRecord
+	for rows.Next() {
+		record := &secondary.StatusCountRecord{}
+		if err := rows.Scan(&record.CommissionID, &record.Status, &record.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan %s count: %w", table, err)
+		}
+		counts = append(counts, record)
+	}
+	return counts, rows.Err()
+}
+
+// CountHookEvents returns hook event counts grouped by hook type and decision.
+func (r *MetricsRepository) CountHookEvents(ctx context.Context) ([]*secondary.HookEventCountRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT hook_type, decision, COUNT(*) FROM hook_events GROUP BY hook_type, decision",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count hook events: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []*secondary.HookEventCountRecord
+	for rows.Next() {
+		record := &secondary.HookEventCountRecord{}
+		if err := rows.Scan(&record.HookType, &record.Decision, &record.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan hook event count: %w", err)
+		}
+		counts = append(counts, record)
+	}
+	return counts, rows.Err()
+}
+
+// Ensure MetricsRepository implements the interface
+var _ secondary.MetricsRepository = (*MetricsRepository)(nil)
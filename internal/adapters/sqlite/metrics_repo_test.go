@@ -0,0 +1,93 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+)
+
+func TestMetricsRepository_CountTasks(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewMetricsRepository(db)
+	ctx := context.Background()
+
+	seedCommission(t, db, "COMM-001", "")
+	seedCommission(t, db, "COMM-002", "")
+	seedTask(t, db, "TASK-001", "COMM-001", "")
+	seedTask(t, db, "TASK-002", "COMM-001", "")
+	seedTask(t, db, "TASK-003", "COMM-002", "")
+	db.Exec("UPDATE tasks SET status = 'blocked' WHERE id = 'TASK-002'")
+
+	counts, err := repo.CountTasks(ctx)
+	if err != nil {
+		t.Fatalf("CountTasks failed: %v", err)
+	}
+
+	got := make(map[string]int)
+	for _, c := range counts {
+		got[c.CommissionID+"/"+c.Status] = c.Count
+	}
+	want := map[string]int{"COMM-001/open": 1, "COMM-001/blocked": 1, "COMM-002/open": 1}
+	if len(got) != len(want) {
+		t.Fatalf("expected %d groups, got %v", len(want), got)
+	}
+	for k, v := range want {
+		if got[k] != v {
+			t.Errorf("%s = %d, want %d", k, got[k], v)
+		}
+	}
+}
+
+func TestMetricsRepository_CountShipmentsAndPlans(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewMetricsRepository(db)
+	ctx := context.Background()
+
+	seedCommission(t, db, "COMM-001", "")
+	seedShipment(t, db, "SHIP-001", "COMM-001", "")
+	seedShipment(t, db, "SHIP-002", "COMM-001", "")
+	db.Exec("UPDATE shipments SET status = 'ready'")
+	seedTask(t, db, "TASK-001", "COMM-001", "")
+	db.Exec("INSERT INTO plans (id, commission_id, task_id, title, status) VALUES ('PLAN-001', 'COMM-001', 'TASK-001', 'Plan', 'draft')")
+
+	shipments, err := repo.CountShipments(ctx)
+	if err != nil {
+		t.Fatalf("CountShipments failed: %v", err)
+	}
+	if len(shipments) != 1 || shipments[0].Status != "ready" || shipments[0].Count != 2 {
+		t.Errorf("unexpected shipment counts: %+v", shipments)
+	}
+
+	plans, err := repo.CountPlans(ctx)
+	if err != nil {
+		t.Fatalf("CountPlans failed: %v", err)
+	}
+	if len(plans) != 1 || plans[0].Status != "draft" || plans[0].Count != 1 {
+		t.Errorf("unexpected plan counts: %+v", plans)
+	}
+}
+
+func TestMetricsRepository_CountHookEvents(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewMetricsRepository(db)
+	ctx := context.Background()
+
+	seedWorkbench(t, db, "BENCH-001", "", "test-bench")
+	db.Exec("INSERT INTO hook_events (id, workbench_id, hook_type, decision) VALUES ('HEV-001', 'BENCH-001', 'Stop', 'block')")
+	db.Exec("INSERT INTO hook_events (id, workbench_id, hook_type, decision) VALUES ('HEV-002', 'BENCH-001', 'Stop', 'block')")
+	db.Exec("INSERT INTO hook_events (id, workbench_id, hook_type, decision) VALUES ('HEV-003', 'BENCH-001', 'Stop', 'allow')")
+
+	counts, err := repo.CountHookEvents(ctx)
+	if err != nil {
+		t.Fatalf("CountHookEvents failed: %v", err)
+	}
+
+	got := make(map[string]int)
+	for _, c := range counts {
+		got[c.HookType+"/"+c.Decision] = c.Count
+	}
+	if got["Stop/block"] != 2 || got["Stop/allow"] != 1 {
+		t.Errorf("unexpected hook event counts: %v", got)
+	}
+}
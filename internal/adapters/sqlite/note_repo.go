@@ -10,10 +10,16 @@ import (
 	"github.com/example/orc/internal/ports/secondary"
 )
 
+// DefaultNoteBlobThreshold is the body size at which note content is kept in
+// the blob store instead of the notes table.
+const DefaultNoteBlobThreshold = 64 * 1024
+
 // NoteRepository implements secondary.NoteRepository with SQLite.
 type NoteRepository struct {
-	db        *sql.DB
-	logWriter secondary.LogWriter
+	db            *sql.DB
+	logWriter     secondary.LogWriter
+	blobs         secondary.BlobStore
+	blobThreshold int
 }
 
 // NewNoteRepository creates a new SQLite note repository.
@@ -22,10 +28,19 @@ func NewNoteRepository(db *sql.DB, logWriter secondary.LogWriter) *NoteRepositor
 	return &NoteRepository{db: db, logWriter: logWriter}
 }
 
+// WithBlobStore keeps note bodies of threshold bytes or more in blobs, with
+// only their hash in the notes table. Reads load them back transparently.
+// A threshold of 0 reads existing blobs but writes every body inline.
+func (r *NoteRepository) WithBlobStore(blobs secondary.BlobStore, threshold int) *NoteRepository {
+	r.blobs, r.blobThreshold = blobs, threshold
+	return r
+}
+
 // Create persists a new note.
 func (r *NoteRepository) Create(ctx context.Context, note *secondary.NoteRecord) error {
 	var content, noteType sql.NullString
 	var shipmentID, tomeID sql.NullString
+	var severity, triageStatus sql.NullString
 
 	if note.Content != "" {
 		content = sql.NullString{String: note.Content, Valid: true}
@@ -39,15 +54,26 @@ func (r *NoteRepository) Create(ctx context.Context, note *secondary.NoteRecord)
 	if note.TomeID != "" {
 		tomeID = sql.NullString{String: note.TomeID, Valid: true}
 	}
+	if note.Severity != "" {
+		severity = sql.NullString{String: note.Severity, Valid: true}
+	}
+	if note.TriageStatus != "" {
+		triageStatus = sql.NullString{String: note.TriageStatus, Valid: true}
+	}
 
 	status := "open"
 	if note.Status != "" {
 		status = note.Status
 	}
 
-	_, err := r.db.ExecContext(ctx,
-		"INSERT INTO notes (id, commission_id, title, content, type, status, shipment_id, tome_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
-		note.ID, note.CommissionID, note.Title, content, noteType, status, shipmentID, tomeID,
+	content, contentBlob, err := r.storeContent(content)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		"INSERT INTO notes (id, commission_id, title, content, content_blob, type, status, shipment_id, tome_id, severity, triage_status, draft) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		note.ID, note.CommissionID, note.Title, content, contentBlob, noteType, status, shipmentID, tomeID, severity, triageStatus, note.Draft,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create note: %w", err)
@@ -77,13 +103,19 @@ func (r *NoteRepository) GetByID(ctx context.Context, id string) (*secondary.Not
 		promotedFromType sql.NullString
 		closeReason      sql.NullString
 		closedByNoteID   sql.NullString
+		position         sql.NullInt64
+		severity         sql.NullString
+		triageStatus     sql.NullString
+		resolution       sql.NullString
+		draft            bool
+		contentBlob      sql.NullString
 	)
 
 	record := &secondary.NoteRecord{}
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, commission_id, title, content, type, status, shipment_id, tome_id, pinned, created_at, updated_at, closed_at, promoted_from_id, promoted_from_type, close_reason, closed_by_note_id FROM notes WHERE id = ?",
+		"SELECT id, commission_id, title, content, type, status, shipment_id, tome_id, pinned, created_at, updated_at, closed_at, promoted_from_id, promoted_from_type, close_reason, closed_by_note_id, position, severity, triage_status, resolution, draft, content_blob FROM notes WHERE id = ?",
 		id,
-	).Scan(&record.ID, &record.CommissionID, &record.Title, &content, &noteType, &status, &shipmentID, &tomeID, &pinned, &createdAt, &updatedAt, &closedAt, &promotedFromID, &promotedFromType, &closeReason, &closedByNoteID)
+	).Scan(&record.ID, &record.CommissionID, &record.Title, &content, &noteType, &status, &shipmentID, &tomeID, &pinned, &createdAt, &updatedAt, &closedAt, &promotedFromID, &promotedFromType, &closeReason, &closedByNoteID, &position, &severity, &triageStatus, &resolution, &draft, &contentBlob)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("note %s not found", id)
@@ -92,7 +124,9 @@ func (r *NoteRepository) GetByID(ctx context.Context, id string) (*secondary.Not
 		return nil, fmt.Errorf("failed to get note: %w", err)
 	}
 
-	record.Content = content.String
+	if record.Content, err = r.loadContent(record.ID, content, contentBlob); err != nil {
+		return nil, err
+	}
 	record.Type = noteType.String
 	record.Status = status
 	record.ShipmentID = shipmentID.String
@@ -107,13 +141,18 @@ func (r *NoteRepository) GetByID(ctx context.Context, id string) (*secondary.Not
 	record.PromotedFromType = promotedFromType.String
 	record.CloseReason = closeReason.String
 	record.ClosedByNoteID = closedByNoteID.String
+	record.Position = int(position.Int64)
+	record.Severity = severity.String
+	record.TriageStatus = triageStatus.String
+	record.Resolution = resolution.String
+	record.Draft = draft
 
 	return record, nil
 }
 
 // List retrieves notes matching the given filters.
 func (r *NoteRepository) List(ctx context.Context, filters secondary.NoteFilters) ([]*secondary.NoteRecord, error) {
-	query := "SELECT id, commission_id, title, content, type, status, shipment_id, tome_id, pinned, created_at, updated_at, closed_at, promoted_from_id, promoted_from_type, close_reason, closed_by_note_id FROM notes WHERE 1=1"
+	query := "SELECT id, commission_id, title, content, type, status, shipment_id, tome_id, pinned, created_at, updated_at, closed_at, promoted_from_id, promoted_from_type, close_reason, closed_by_note_id, position, severity, triage_status, resolution, draft, content_blob FROM notes WHERE 1=1"
 	args := []any{}
 
 	if filters.Type != "" {
@@ -126,6 +165,11 @@ func (r *NoteRepository) List(ctx context.Context, filters secondary.NoteFilters
 		args = append(args, filters.CommissionID)
 	}
 
+	if filters.Resolution != "" {
+		query += " AND resolution = ?"
+		args = append(args, filters.Resolution)
+	}
+
 	query += " ORDER BY created_at DESC"
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
@@ -150,15 +194,22 @@ func (r *NoteRepository) List(ctx context.Context, filters secondary.NoteFilters
 			promotedFromType sql.NullString
 			closeReason      sql.NullString
 			closedByNoteID   sql.NullString
+			position         sql.NullInt64
+			severity         sql.NullString
+			triageStatus     sql.NullString
+			resolution       sql.NullString
+			draft            bool
+			contentBlob      sql.NullString
 		)
 
 		record := &secondary.NoteRecord{}
-		err := rows.Scan(&record.ID, &record.CommissionID, &record.Title, &content, &noteType, &status, &shipmentID, &tomeID, &pinned, &createdAt, &updatedAt, &closedAt, &promotedFromID, &promotedFromType, &closeReason, &closedByNoteID)
+		err := rows.Scan(&record.ID, &record.CommissionID, &record.Title, &content, &noteType, &status, &shipmentID, &tomeID, &pinned, &createdAt, &updatedAt, &closedAt, &promotedFromID, &promotedFromType, &closeReason, &closedByNoteID, &position, &severity, &triageStatus, &resolution, &draft, &contentBlob)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan note: %w", err)
 		}
 
-		record.Content = content.String
+		// Externalized bodies stay in the blob store until GetByID
+		record.Content, record.ContentExternal = content.String, contentBlob.Valid
 		record.Type = noteType.String
 		record.Status = status
 		record.ShipmentID = shipmentID.String
@@ -173,6 +224,11 @@ func (r *NoteRepository) List(ctx context.Context, filters secondary.NoteFilters
 		record.PromotedFromType = promotedFromType.String
 		record.CloseReason = closeReason.String
 		record.ClosedByNoteID = closedByNoteID.String
+		record.Position = int(position.Int64)
+		record.Severity = severity.String
+		record.TriageStatus = triageStatus.String
+		record.Resolution = resolution.String
+		record.Draft = draft
 
 		notes = append(notes, record)
 	}
@@ -191,8 +247,12 @@ func (r *NoteRepository) Update(ctx context.Context, note *secondary.NoteRecord)
 	}
 
 	if note.Content != "" {
-		query += ", content = ?"
-		args = append(args, sql.NullString{String: note.Content, Valid: true})
+		content, contentBlob, err := r.storeContent(sql.NullString{String: note.Content, Valid: true})
+		if err != nil {
+			return err
+		}
+		query += ", content = ?, content_blob = ?"
+		args = append(args, content, contentBlob)
 	}
 
 	if note.Type != "" {
@@ -201,16 +261,17 @@ func (r *NoteRepository) Update(ctx context.Context, note *secondary.NoteRecord)
 	}
 
 	// Container move: when moving to a new container, clear the other container ID
-	// to maintain mutual exclusivity (a note can only belong to one container)
+	// to maintain mutual exclusivity (a note can only belong to one container).
+	// A note leaving its tome loses its place in the tome's reading order.
 	if note.PromoteToCommission {
 		// Promote to commission level: clear all container associations
-		query += ", shipment_id = NULL, tome_id = NULL"
+		query += ", shipment_id = NULL, tome_id = NULL, position = NULL"
 	} else if note.ShipmentID != "" {
-		query += ", shipment_id = ?, tome_id = NULL"
+		query += ", shipment_id = ?, tome_id = NULL, position = NULL"
 		args = append(args, note.ShipmentID)
 	} else if note.TomeID != "" {
-		query += ", tome_id = ?, shipment_id = NULL"
-		args = append(args, note.TomeID)
+		query += ", position = CASE WHEN tome_id = ? THEN position END, tome_id = ?, shipment_id = NULL"
+		args = append(args, note.TomeID, note.TomeID)
 	}
 
 	query += " WHERE id = ?"
@@ -280,6 +341,24 @@ func (r *NoteRepository) Unpin(ctx context.Context, id string) error {
 	return nil
 }
 
+// SetDraft marks a handoff note as a draft or as confirmed.
+func (r *NoteRepository) SetDraft(ctx context.Context, id string, draft bool) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE notes SET draft = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		draft, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update note draft: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("note %s not found", id)
+	}
+
+	return nil
+}
+
 // GetNextID returns the next available note ID.
 func (r *NoteRepository) GetNextID(ctx context.Context) (string, error) {
 	var maxID int
@@ -298,12 +377,12 @@ func (r *NoteRepository) GetByContainer(ctx context.Context, containerType, cont
 	var query string
 	switch containerType {
 	case "shipment":
-		query = "SELECT id, commission_id, title, content, type, status, shipment_id, tome_id, pinned, created_at, updated_at, closed_at, promoted_from_id, promoted_from_type, close_reason, closed_by_note_id FROM notes WHERE shipment_id = ? ORDER BY created_at DESC"
+		query = "SELECT id, commission_id, title, content, type, status, shipment_id, tome_id, pinned, created_at, updated_at, closed_at, promoted_from_id, promoted_from_type, close_reason, closed_by_note_id, position, severity, triage_status, resolution, draft, content_blob FROM notes WHERE shipment_id = ? ORDER BY created_at DESC"
 	case "tome":
-		query = "SELECT id, commission_id, title, content, type, status, shipment_id, tome_id, pinned, created_at, updated_at, closed_at, promoted_from_id, promoted_from_type, close_reason, closed_by_note_id FROM notes WHERE tome_id = ? ORDER BY created_at DESC"
+		query = "SELECT id, commission_id, title, content, type, status, shipment_id, tome_id, pinned, created_at, updated_at, closed_at, promoted_from_id, promoted_from_type, close_reason, closed_by_note_id, position, severity, triage_status, resolution, draft, content_blob FROM notes WHERE tome_id = ? ORDER BY position IS NULL, position, created_at DESC"
 	case "commission":
 		// Notes directly under commission (not in any container)
-		query = "SELECT id, commission_id, title, content, type, status, shipment_id, tome_id, pinned, created_at, updated_at, closed_at, promoted_from_id, promoted_from_type, close_reason, closed_by_note_id FROM notes WHERE commission_id = ? AND shipment_id IS NULL AND tome_id IS NULL ORDER BY created_at DESC"
+		query = "SELECT id, commission_id, title, content, type, status, shipment_id, tome_id, pinned, created_at, updated_at, closed_at, promoted_from_id, promoted_from_type, close_reason, closed_by_note_id, position, severity, triage_status, resolution, draft, content_blob FROM notes WHERE commission_id = ? AND shipment_id IS NULL AND tome_id IS NULL ORDER BY created_at DESC"
 	default:
 		return nil, fmt.Errorf("unknown container type: %s", containerType)
 	}
@@ -330,15 +409,22 @@ func (r *NoteRepository) GetByContainer(ctx context.Context, containerType, cont
 			promotedFromType sql.NullString
 			closeReason      sql.NullString
 			closedByNoteID   sql.NullString
+			position         sql.NullInt64
+			severity         sql.NullString
+			triageStatus     sql.NullString
+			resolution       sql.NullString
+			draft            bool
+			contentBlob      sql.NullString
 		)
 
 		record := &secondary.NoteRecord{}
-		err := rows.Scan(&record.ID, &record.CommissionID, &record.Title, &content, &noteType, &status, &shipmentID, &tomeID, &pinned, &createdAt, &updatedAt, &closedAt, &promotedFromID, &promotedFromType, &closeReason, &closedByNoteID)
+		err := rows.Scan(&record.ID, &record.CommissionID, &record.Title, &content, &noteType, &status, &shipmentID, &tomeID, &pinned, &createdAt, &updatedAt, &closedAt, &promotedFromID, &promotedFromType, &closeReason, &closedByNoteID, &position, &severity, &triageStatus, &resolution, &draft, &contentBlob)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan note: %w", err)
 		}
 
-		record.Content = content.String
+		// Externalized bodies stay in the blob store until GetByID
+		record.Content, record.ContentExternal = content.String, contentBlob.Valid
 		record.Type = noteType.String
 		record.Status = status
 		record.ShipmentID = shipmentID.String
@@ -353,6 +439,11 @@ func (r *NoteRepository) GetByContainer(ctx context.Context, containerType, cont
 		record.PromotedFromType = promotedFromType.String
 		record.CloseReason = closeReason.String
 		record.ClosedByNoteID = closedByNoteID.String
+		record.Position = int(position.Int64)
+		record.Severity = severity.String
+		record.TriageStatus = triageStatus.String
+		record.Resolution = resolution.String
+		record.Draft = draft
 
 		notes = append(notes, record)
 	}
@@ -392,11 +483,13 @@ func (r *NoteRepository) TomeExists(ctx context.Context, tomeID string) (bool, e
 
 // UpdateStatus updates the status of a note (open/closed).
 func (r *NoteRepository) UpdateStatus(ctx context.Context, id string, status string) error {
+	oldStatus := r.statusForLog(ctx, id)
+
 	var query string
 	if status == "closed" {
 		query = "UPDATE notes SET status = ?, closed_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP WHERE id = ?"
 	} else {
-		query = "UPDATE notes SET status = ?, closed_at = NULL, updated_at = CURRENT_TIMESTAMP WHERE id = ?"
+		query = "UPDATE notes SET status = ?, closed_at = NULL, resolution = NULL, updated_at = CURRENT_TIMESTAMP WHERE id = ?"
 	}
 
 	result, err := r.db.ExecContext(ctx, query, status, id)
@@ -409,17 +502,22 @@ func (r *NoteRepository) UpdateStatus(ctx context.Context, id string, status str
 		return fmt.Errorf("note %s not found", id)
 	}
 
+	r.logStatusChange(ctx, id, oldStatus, status)
+
 	return nil
 }
 
-// CloseWithMerge closes a note and records it was merged into another note.
+// CloseWithMerge closes a note as a duplicate and records it was merged into another note.
 func (r *NoteRepository) CloseWithMerge(ctx context.Context, sourceID, targetID string) error {
+	oldStatus := r.statusForLog(ctx, sourceID)
+
 	query := `UPDATE notes SET
 		status = 'closed',
 		closed_at = CURRENT_TIMESTAMP,
 		updated_at = CURRENT_TIMESTAMP,
 		promoted_from_id = ?,
-		promoted_from_type = 'merged'
+		promoted_from_type = 'merged',
+		resolution = 'duplicate'
 		WHERE id = ?`
 
 	result, err := r.db.ExecContext(ctx, query, targetID, sourceID)
@@ -432,12 +530,20 @@ func (r *NoteRepository) CloseWithMerge(ctx context.Context, sourceID, targetID
 		return fmt.Errorf("note %s not found", sourceID)
 	}
 
+	r.logStatusChange(ctx, sourceID, oldStatus, "closed")
+
 	return nil
 }
 
-// CloseWithReason closes a note with a reason and optional reference to another note.
-func (r *NoteRepository) CloseWithReason(ctx context.Context, id, reason, byNoteID string) error {
-	var closedByNoteID sql.NullString
+// CloseWithReason closes a note with a resolution, an optional reason, and
+// an optional reference to another note.
+func (r *NoteRepository) CloseWithReason(ctx context.Context, id, resolution, reason, byNoteID string) error {
+	oldStatus := r.statusForLog(ctx, id)
+
+	var closeReason, closedByNoteID sql.NullString
+	if reason != "" {
+		closeReason = sql.NullString{String: reason, Valid: true}
+	}
 	if byNoteID != "" {
 		closedByNoteID = sql.NullString{String: byNoteID, Valid: true}
 	}
@@ -446,11 +552,12 @@ func (r *NoteRepository) CloseWithReason(ctx context.Context, id, reason, byNote
 		status = 'closed',
 		closed_at = CURRENT_TIMESTAMP,
 		updated_at = CURRENT_TIMESTAMP,
+		resolution = ?,
 		close_reason = ?,
 		closed_by_note_id = ?
 		WHERE id = ?`
 
-	result, err := r.db.ExecContext(ctx, query, reason, closedByNoteID, id)
+	result, err := r.db.ExecContext(ctx, query, resolution, closeReason, closedByNoteID, id)
 	if err != nil {
 		return fmt.Errorf("failed to close note with reason: %w", err)
 	}
@@ -460,8 +567,138 @@ func (r *NoteRepository) CloseWithReason(ctx context.Context, id, reason, byNote
 		return fmt.Errorf("note %s not found", id)
 	}
 
+	r.logStatusChange(ctx, id, oldStatus, "closed")
+
+	return nil
+}
+
+// UpdateTriage sets a bug note's severity and triage state.
+func (r *NoteRepository) UpdateTriage(ctx context.Context, id, severity, triageStatus string) error {
+	var oldTriageStatus sql.NullString
+	if r.logWriter != nil {
+		_ = r.db.QueryRowContext(ctx, "SELECT triage_status FROM notes WHERE id = ?", id).Scan(&oldTriageStatus)
+	}
+
+	var sev sql.NullString
+	if severity != "" {
+		sev = sql.NullString{String: severity, Valid: true}
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE notes SET severity = ?, triage_status = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		sev, triageStatus, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to triage note: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("note %s not found", id)
+	}
+
+	if r.logWriter != nil && oldTriageStatus.String != triageStatus {
+		_ = r.logWriter.LogUpdate(ctx, "note", id, "triage_status", oldTriageStatus.String, triageStatus)
+	}
+
 	return nil
 }
 
+// statusForLog reads the current status before a change (only when logging is enabled).
+func (r *NoteRepository) statusForLog(ctx context.Context, id string) string {
+	var status string
+	if r.logWriter != nil {
+		_ = r.db.QueryRowContext(ctx, "SELECT status FROM notes WHERE id = ?", id).Scan(&status)
+	}
+	return status
+}
+
+// logStatusChange records a status transition in the audit log.
+func (r *NoteRepository) logStatusChange(ctx context.Context, id, oldStatus, newStatus string) {
+	if r.logWriter != nil && oldStatus != newStatus {
+		_ = r.logWriter.LogUpdate(ctx, "note", id, "status", oldStatus, newStatus)
+	}
+}
+
+// ExternalizeContent moves note bodies of at least minBytes into the blob store.
+func (r *NoteRepository) ExternalizeContent(ctx context.Context, minBytes int, dryRun bool) ([]*secondary.ExternalizedNoteRecord, error) {
+	if r.blobs == nil && !dryRun {
+		return nil, fmt.Errorf("no blob store configured")
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT id, content FROM notes WHERE content_blob IS NULL AND length(CAST(content AS BLOB)) >= ? ORDER BY id",
+		minBytes,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find large notes: %w", err)
+	}
+	type largeNote struct {
+		id      string
+		content string
+	}
+	var large []largeNote
+	for rows.Next() {
+		var n largeNote
+		if err := rows.Scan(&n.id, &n.content); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan note: %w", err)
+		}
+		large = append(large, n)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to find large notes: %w", err)
+	}
+
+	moved := make([]*secondary.ExternalizedNoteRecord, 0, len(large))
+	for _, n := range large {
+		if !dryRun {
+			key, err := r.blobs.Put([]byte(n.content))
+			if err != nil {
+				return moved, fmt.Errorf("failed to store %s: %w", n.id, err)
+			}
+			// Moving the body is not an edit, so updated_at is left alone
+			if _, err := r.db.ExecContext(ctx,
+				"UPDATE notes SET content = NULL, content_blob = ? WHERE id = ? AND content_blob IS NULL",
+				key, n.id,
+			); err != nil {
+				return moved, fmt.Errorf("failed to update %s: %w", n.id, err)
+			}
+		}
+		moved = append(moved, &secondary.ExternalizedNoteRecord{ID: n.id, Bytes: len(n.content)})
+	}
+	return moved, nil
+}
+
+// storeContent returns the content and content_blob column values for a
+// body, putting it in the blob store when it is at or above the threshold.
+func (r *NoteRepository) storeContent(content sql.NullString) (sql.NullString, sql.NullString, error) {
+	if r.blobs == nil || r.blobThreshold <= 0 || len(content.String) < r.blobThreshold {
+		return content, sql.NullString{}, nil
+	}
+	key, err := r.blobs.Put([]byte(content.String))
+	if err != nil {
+		return sql.NullString{}, sql.NullString{}, fmt.Errorf("failed to store note content: %w", err)
+	}
+	return sql.NullString{}, sql.NullString{String: key, Valid: true}, nil
+}
+
+// loadContent returns a note's body, reading it from the blob store when
+// the row holds only its hash.
+func (r *NoteRepository) loadContent(id string, content, contentBlob sql.NullString) (string, error) {
+	if !contentBlob.Valid {
+		return content.String, nil
+	}
+	if r.blobs == nil {
+		return "", fmt.Errorf("note %s content is stored externally but no blob store is configured", id)
+	}
+	data, err := r.blobs.Get(contentBlob.String)
+	if err != nil {
+		return "", fmt.Errorf("failed to load content of %s: %w", id, err)
+	}
+	return string(data), nil
+}
+
 // Ensure NoteRepository implements the interface
 var _ secondary.NoteRepository = (*NoteRepository)(nil)
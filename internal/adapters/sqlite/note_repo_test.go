@@ -3,8 +3,10 @@ package sqlite_test
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"testing"
 
+	"github.com/example/orc/internal/adapters/filesystem"
 	"github.com/example/orc/internal/adapters/sqlite"
 	"github.com/example/orc/internal/ports/secondary"
 )
@@ -300,6 +302,202 @@ func TestNoteRepository_Pin_NotFound(t *testing.T) {
 	}
 }
 
+func TestNoteRepository_UpdateTriage(t *testing.T) {
+	db := setupNoteTestDB(t)
+	repo := sqlite.NewNoteRepository(db, nil)
+	ctx := context.Background()
+
+	note := &secondary.NoteRecord{
+		ID:           "NOTE-001",
+		CommissionID: "COMM-001",
+		Title:        "Login fails",
+		Type:         "bug",
+		Severity:     "P2",
+		TriageStatus: "untriaged",
+	}
+	if err := repo.Create(ctx, note); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	retrieved, _ := repo.GetByID(ctx, note.ID)
+	if retrieved.Severity != "P2" || retrieved.TriageStatus != "untriaged" {
+		t.Errorf("severity, triage = %q, %q, want P2, untriaged", retrieved.Severity, retrieved.TriageStatus)
+	}
+
+	if err := repo.UpdateTriage(ctx, note.ID, "P0", "accepted"); err != nil {
+		t.Fatalf("UpdateTriage failed: %v", err)
+	}
+
+	notes, _ := repo.List(ctx, secondary.NoteFilters{Type: "bug"})
+	if len(notes) != 1 || notes[0].Severity != "P0" || notes[0].TriageStatus != "accepted" {
+		t.Errorf("expected triaged P0 bug, got %+v", notes)
+	}
+
+	if err := repo.UpdateTriage(ctx, "NOTE-999", "P1", "accepted"); err == nil {
+		t.Error("expected error for non-existent note")
+	}
+}
+
+func TestNoteRepository_CloseWithReason(t *testing.T) {
+	db := setupNoteTestDB(t)
+	repo := sqlite.NewNoteRepository(db, nil)
+	ctx := context.Background()
+
+	dup := createTestNote(t, repo, ctx, "COMM-001", "Flaky login", "")
+	original := createTestNote(t, repo, ctx, "COMM-001", "Login test flakes", "")
+	fixed := createTestNote(t, repo, ctx, "COMM-001", "Slow build", "")
+
+	if err := repo.CloseWithReason(ctx, dup.ID, "duplicate", "", original.ID); err != nil {
+		t.Fatalf("CloseWithReason failed: %v", err)
+	}
+	if err := repo.CloseWithReason(ctx, fixed.ID, "fixed", "resolved", ""); err != nil {
+		t.Fatalf("CloseWithReason failed: %v", err)
+	}
+
+	got, _ := repo.GetByID(ctx, dup.ID)
+	if got.Status != "closed" || got.Resolution != "duplicate" || got.CloseReason != "" || got.ClosedByNoteID != original.ID {
+		t.Errorf("unexpected closed note: %+v", got)
+	}
+
+	notes, err := repo.List(ctx, secondary.NoteFilters{Resolution: "duplicate"})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(notes) != 1 || notes[0].ID != dup.ID {
+		t.Errorf("expected only %s filtered by resolution, got %d notes", dup.ID, len(notes))
+	}
+
+	// Reopening clears the resolution
+	if err := repo.UpdateStatus(ctx, dup.ID, "open"); err != nil {
+		t.Fatalf("UpdateStatus failed: %v", err)
+	}
+	if got, _ := repo.GetByID(ctx, dup.ID); got.Resolution != "" {
+		t.Errorf("expected resolution cleared on reopen, got %q", got.Resolution)
+	}
+}
+
+func TestNoteRepository_SetDraft(t *testing.T) {
+	db := setupNoteTestDB(t)
+	repo := sqlite.NewNoteRepository(db, nil)
+	ctx := context.Background()
+
+	note := &secondary.NoteRecord{
+		ID:           "NOTE-001",
+		CommissionID: "COMM-001",
+		Title:        "Handoff: Fix the login redirect",
+		Type:         "handoff",
+		Draft:        true,
+	}
+	if err := repo.Create(ctx, note); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if got, _ := repo.GetByID(ctx, note.ID); !got.Draft {
+		t.Error("expected handoff to be created as a draft")
+	}
+
+	if err := repo.SetDraft(ctx, note.ID, false); err != nil {
+		t.Fatalf("SetDraft failed: %v", err)
+	}
+	notes, _ := repo.List(ctx, secondary.NoteFilters{Type: "handoff"})
+	if len(notes) != 1 || notes[0].Draft {
+		t.Errorf("expected one confirmed handoff, got %+v", notes)
+	}
+
+	if err := repo.SetDraft(ctx, "NOTE-999", false); err == nil {
+		t.Error("expected error for non-existent note")
+	}
+}
+
+func TestNoteRepository_BlobContent(t *testing.T) {
+	db := setupNoteTestDB(t)
+	blobDir := t.TempDir()
+	repo := sqlite.NewNoteRepository(db, nil).WithBlobStore(filesystem.NewDirBlobStore(blobDir), 32)
+	ctx := context.Background()
+
+	report := strings.Repeat("finding ", 10)
+	large := createTestNote(t, repo, ctx, "COMM-001", "Investigation report", report)
+	small := createTestNote(t, repo, ctx, "COMM-001", "Short", "fits inline")
+
+	var inline sql.NullString
+	var blob sql.NullString
+	if err := db.QueryRow("SELECT content, content_blob FROM notes WHERE id = ?", large.ID).Scan(&inline, &blob); err != nil {
+		t.Fatal(err)
+	}
+	if inline.Valid || !blob.Valid {
+		t.Errorf("expected the large body outside the table, got content=%v blob=%v", inline, blob)
+	}
+
+	got, err := repo.GetByID(ctx, large.ID)
+	if err != nil || got.Content != report {
+		t.Errorf("GetByID content = %q, %v", got.Content, err)
+	}
+	notes, err := repo.List(ctx, secondary.NoteFilters{})
+	if err != nil || len(notes) != 2 {
+		t.Fatalf("List = %d notes, %v", len(notes), err)
+	}
+	// Lists leave externalized bodies in the blob store
+	containerNotes, err := repo.GetByContainer(ctx, "commission", "COMM-001")
+	if err != nil || len(containerNotes) != 2 {
+		t.Fatalf("GetByContainer = %d notes, %v", len(containerNotes), err)
+	}
+	for _, n := range append(notes, containerNotes...) {
+		if n.ID == large.ID && (n.Content != "" || !n.ContentExternal) || n.ID == small.ID && (n.Content != "fits inline" || n.ContentExternal) {
+			t.Errorf("listed %s with content %q, external %v", n.ID, n.Content, n.ContentExternal)
+		}
+	}
+
+	// Shrinking the body brings it back inline
+	if err := repo.Update(ctx, &secondary.NoteRecord{ID: large.ID, Content: "summary"}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if err := db.QueryRow("SELECT content, content_blob FROM notes WHERE id = ?", large.ID).Scan(&inline, &blob); err != nil {
+		t.Fatal(err)
+	}
+	if inline.String != "summary" || blob.Valid {
+		t.Errorf("expected the updated body inline, got content=%v blob=%v", inline, blob)
+	}
+}
+
+func TestNoteRepository_ExternalizeContent(t *testing.T) {
+	db := setupNoteTestDB(t)
+	ctx := context.Background()
+	plain := sqlite.NewNoteRepository(db, nil)
+
+	report := strings.Repeat("x", 100)
+	large := createTestNote(t, plain, ctx, "COMM-001", "Investigation report", report)
+	createTestNote(t, plain, ctx, "COMM-001", "Short", "fits inline")
+
+	if _, err := plain.ExternalizeContent(ctx, 50, false); err == nil {
+		t.Error("expected error without a blob store")
+	}
+
+	repo := sqlite.NewNoteRepository(db, nil).WithBlobStore(filesystem.NewDirBlobStore(t.TempDir()), 0)
+	moved, err := repo.ExternalizeContent(ctx, 50, true)
+	if err != nil || len(moved) != 1 || moved[0].ID != large.ID || moved[0].Bytes != 100 {
+		t.Fatalf("dry run = %+v, %v", moved, err)
+	}
+	var count int
+	_ = db.QueryRow("SELECT COUNT(*) FROM notes WHERE content_blob IS NOT NULL").Scan(&count)
+	if count != 0 {
+		t.Fatal("dry run moved content")
+	}
+
+	if moved, err = repo.ExternalizeContent(ctx, 50, false); err != nil || len(moved) != 1 {
+		t.Fatalf("ExternalizeContent = %+v, %v", moved, err)
+	}
+	got, err := repo.GetByID(ctx, large.ID)
+	if err != nil || got.Content != report {
+		t.Errorf("content after externalizing = %q, %v", got.Content, err)
+	}
+	if moved, _ = repo.ExternalizeContent(ctx, 50, false); len(moved) != 0 {
+		t.Errorf("expected nothing left to move, got %+v", moved)
+	}
+
+	if _, err := plain.GetByID(ctx, large.ID); err == nil || !strings.Contains(err.Error(), "no blob store") {
+		t.Errorf("expected error reading an external body without a blob store, got %v", err)
+	}
+}
+
 func TestNoteRepository_GetNextID(t *testing.T) {
 	db := setupNoteTestDB(t)
 	repo := sqlite.NewNoteRepository(db, nil)
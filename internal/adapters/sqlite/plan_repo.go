@@ -372,5 +372,165 @@ func (r *PlanRepository) TaskExists(ctx context.Context, taskID string) (bool, e
 	return count > 0, nil
 }
 
+// ListSteps retrieves a plan's tracked steps in order, with linked task status.
+func (r *PlanRepository) ListSteps(ctx context.Context, planID string) ([]*secondary.PlanStepRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT s.plan_id, s.position, s.title, s.task_id, t.status
+		FROM plan_steps s
+		LEFT JOIN tasks t ON t.id = s.task_id
+		WHERE s.plan_id = ?
+		ORDER BY s.position ASC`,
+		planID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plan steps: %w", err)
+	}
+	defer rows.Close()
+
+	var steps []*secondary.PlanStepRecord
+	for rows.Next() {
+		var taskID, taskStatus sql.NullString
+		step := &secondary.PlanStepRecord{}
+		if err := rows.Scan(&step.PlanID, &step.Position, &step.Title, &taskID, &taskStatus); err != nil {
+			return nil, fmt.Errorf("failed to scan plan step: %w", err)
+		}
+		step.TaskID = taskID.String
+		step.TaskStatus = taskStatus.String
+		steps = append(steps, step)
+	}
+
+	return steps, rows.Err()
+}
+
+// CreateSteps records a plan's steps in a single transaction.
+func (r *PlanRepository) CreateSteps(ctx context.Context, steps []*secondary.PlanStepRecord) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, step := range steps {
+		var taskID sql.NullString
+		if step.TaskID != "" {
+			taskID = sql.NullString{String: step.TaskID, Valid: true}
+		}
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO plan_steps (plan_id, position, title, task_id) VALUES (?, ?, ?, ?)",
+			step.PlanID, step.Position, step.Title, taskID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create plan step %d: %w", step.Position, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit plan steps: %w", err)
+	}
+	return nil
+}
+
+// SetStepTask links a plan step to a task.
+func (r *PlanRepository) SetStepTask(ctx context.Context, planID string, position int, taskID string) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE plan_steps SET task_id = ? WHERE plan_id = ? AND position = ?",
+		taskID, planID, position,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to link plan step: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return fmt.Errorf("plan %s has no step %d", planID, position)
+	}
+
+	return nil
+}
+
+// ListConditions retrieves a plan's approval conditions in order.
+func (r *PlanRepository) ListConditions(ctx context.Context, planID string) ([]*secondary.PlanConditionRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT plan_id, position, description, status, evidence, approved_by, created_at, addressed_by, addressed_at
+		FROM plan_conditions
+		WHERE plan_id = ?
+		ORDER BY position ASC`,
+		planID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plan conditions: %w", err)
+	}
+	defer rows.Close()
+
+	var conditions []*secondary.PlanConditionRecord
+	for rows.Next() {
+		var (
+			evidence, addressedBy sql.NullString
+			createdAt             time.Time
+			addressedAt           sql.NullTime
+		)
+		condition := &secondary.PlanConditionRecord{}
+		if err := rows.Scan(&condition.PlanID, &condition.Position, &condition.Description, &condition.Status,
+			&evidence, &condition.ApprovedBy, &createdAt, &addressedBy, &addressedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan plan condition: %w", err)
+		}
+		condition.Evidence = evidence.String
+		condition.CreatedAt = createdAt.Format(time.RFC3339)
+		condition.AddressedBy = addressedBy.String
+		if addressedAt.Valid {
+			condition.AddressedAt = addressedAt.Time.Format(time.RFC3339)
+		}
+		conditions = append(conditions, condition)
+	}
+
+	return conditions, rows.Err()
+}
+
+// CreateConditions records a conditional approval's conditions in a single transaction.
+func (r *PlanRepository) CreateConditions(ctx context.Context, conditions []*secondary.PlanConditionRecord) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, condition := range conditions {
+		_, err := tx.ExecContext(ctx,
+			"INSERT INTO plan_conditions (plan_id, position, description, approved_by) VALUES (?, ?, ?, ?)",
+			condition.PlanID, condition.Position, condition.Description, condition.ApprovedBy,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create plan condition %d: %w", condition.Position, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit plan conditions: %w", err)
+	}
+	return nil
+}
+
+// AddressCondition marks an open condition addressed with its evidence.
+func (r *PlanRepository) AddressCondition(ctx context.Context, planID string, position int, evidence, addressedBy string) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE plan_conditions SET status = 'addressed', evidence = ?, addressed_by = ?, addressed_at = CURRENT_TIMESTAMP
+		WHERE plan_id = ? AND position = ? AND status = 'open'`,
+		evidence, addressedBy, planID, position,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to address plan condition: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("plan %s has no open condition %d", planID, position)
+	}
+
+	return nil
+}
+
 // Ensure PlanRepository implements the interface
 var _ secondary.PlanRepository = (*PlanRepository)(nil)
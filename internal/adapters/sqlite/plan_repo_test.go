@@ -504,3 +504,93 @@ func TestPlanRepository_TaskExists(t *testing.T) {
 		t.Error("expected task to not exist")
 	}
 }
+
+func TestPlanRepository_Steps(t *testing.T) {
+	db := setupPlanTestDB(t)
+	repo := sqlite.NewPlanRepository(db, nil)
+	ctx := context.Background()
+	seedTask(t, db, "TASK-002", "COMM-001", "Step Task")
+
+	plan := createTestPlan(t, repo, ctx, "COMM-001", "TASK-001", "Tracked Plan")
+
+	err := repo.CreateSteps(ctx, []*secondary.PlanStepRecord{
+		{PlanID: plan.ID, Position: 1, Title: "Add schema"},
+		{PlanID: plan.ID, Position: 2, Title: "Wire CLI"},
+	})
+	if err != nil {
+		t.Fatalf("CreateSteps failed: %v", err)
+	}
+
+	if err := repo.SetStepTask(ctx, plan.ID, 2, "TASK-002"); err != nil {
+		t.Fatalf("SetStepTask failed: %v", err)
+	}
+
+	steps, err := repo.ListSteps(ctx, plan.ID)
+	if err != nil {
+		t.Fatalf("ListSteps failed: %v", err)
+	}
+	if len(steps) != 2 {
+		t.Fatalf("expected 2 steps, got %d", len(steps))
+	}
+	if steps[0].Title != "Add schema" || steps[0].TaskID != "" || steps[0].TaskStatus != "" {
+		t.Errorf("unexpected unlinked step: %+v", steps[0])
+	}
+	if steps[1].TaskID != "TASK-002" || steps[1].TaskStatus != "open" {
+		t.Errorf("expected step 2 linked to open TASK-002, got %+v", steps[1])
+	}
+
+	if err := repo.SetStepTask(ctx, plan.ID, 3, "TASK-002"); err == nil {
+		t.Error("expected error for non-existent step")
+	}
+}
+
+func TestPlanRepository_Conditions(t *testing.T) {
+	db := setupPlanTestDB(t)
+	repo := sqlite.NewPlanRepository(db, nil)
+	taskRepo := sqlite.NewTaskRepository(db, nil)
+	ctx := context.Background()
+
+	plan := createTestPlan(t, repo, ctx, "COMM-001", "TASK-001", "Conditional Plan")
+
+	err := repo.CreateConditions(ctx, []*secondary.PlanConditionRecord{
+		{PlanID: plan.ID, Position: 1, Description: "Add a rollback step", ApprovedBy: "GOBLIN"},
+		{PlanID: plan.ID, Position: 2, Description: "Cover the empty cart", ApprovedBy: "GOBLIN"},
+	})
+	if err != nil {
+		t.Fatalf("CreateConditions failed: %v", err)
+	}
+
+	planIDs, err := taskRepo.ListConditionalPlanIDs(ctx, "TASK-001")
+	if err != nil || len(planIDs) != 1 || planIDs[0] != plan.ID {
+		t.Fatalf("ListConditionalPlanIDs = %v, %v; want [%s]", planIDs, err, plan.ID)
+	}
+
+	if err := repo.AddressCondition(ctx, plan.ID, 1, "see ## Rollback", "IMP-BENCH-001"); err != nil {
+		t.Fatalf("AddressCondition failed: %v", err)
+	}
+	if err := repo.AddressCondition(ctx, plan.ID, 1, "again", "IMP-BENCH-001"); err == nil {
+		t.Error("expected error addressing a condition twice")
+	}
+
+	conditions, err := repo.ListConditions(ctx, plan.ID)
+	if err != nil {
+		t.Fatalf("ListConditions failed: %v", err)
+	}
+	if len(conditions) != 2 {
+		t.Fatalf("expected 2 conditions, got %d", len(conditions))
+	}
+	if c := conditions[0]; c.Status != "addressed" || c.Evidence != "see ## Rollback" || c.AddressedBy != "IMP-BENCH-001" || c.AddressedAt == "" {
+		t.Errorf("unexpected addressed condition: %+v", c)
+	}
+	if c := conditions[1]; c.Status != "open" || c.Evidence != "" || c.ApprovedBy != "GOBLIN" {
+		t.Errorf("unexpected open condition: %+v", c)
+	}
+
+	if err := repo.AddressCondition(ctx, plan.ID, 2, "TASK-001 checklist", "IMP-BENCH-001"); err != nil {
+		t.Fatalf("AddressCondition failed: %v", err)
+	}
+	planIDs, err = taskRepo.ListConditionalPlanIDs(ctx, "TASK-001")
+	if err != nil || len(planIDs) != 0 {
+		t.Errorf("expected no conditional plans once addressed, got %v (%v)", planIDs, err)
+	}
+}
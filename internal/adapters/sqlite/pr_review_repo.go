@@ -0,0 +1,141 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// PRReviewRepository implements secondary.PRReviewRepository with SQLite.
+type PRReviewRepository struct {
+	db *sql.DB
+}
+
+// NewPRReviewRepository creates a new SQLite PR review repository.
+func NewPRReviewRepository(db *sql.DB) *PRReviewRepository {
+	return &PRReviewRepository{db: db}
+}
+
+const prReviewCols = "pr_id, external_id, kind, review_external_id, in_reply_to, author, state, body, path, line, url, submitted_at, task_id, created_at, updated_at"
+
+// Save inserts or updates a review item. The task link is never overwritten.
+func (r *PRReviewRepository) Save(ctx context.Context, review *secondary.PRReviewRecord) (bool, error) {
+	var submittedAt sql.NullTime
+	if review.SubmittedAt != "" {
+		t, err := time.Parse(time.RFC3339, review.SubmittedAt)
+		if err != nil {
+			return false, fmt.Errorf("invalid submit time %q: %w", review.SubmittedAt, err)
+		}
+		submittedAt = sql.NullTime{Time: t, Valid: true}
+	}
+
+	var exists bool
+	if err := r.db.QueryRowContext(ctx,
+		"SELECT EXISTS(SELECT 1 FROM pr_reviews WHERE pr_id = ? AND external_id = ?)",
+		review.PRID, review.ExternalID,
+	).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check PR review: %w", err)
+	}
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO pr_reviews (pr_id, external_id, kind, review_external_id, in_reply_to, author, state, body, path, line, url, submitted_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(pr_id, external_id) DO UPDATE SET state = excluded.state, body = excluded.body,
+			path = excluded.path, line = excluded.line, url = excluded.url, updated_at = CURRENT_TIMESTAMP`,
+		review.PRID,
+		review.ExternalID,
+		review.Kind,
+		sql.NullString{String: review.ReviewExternalID, Valid: review.ReviewExternalID != ""},
+		review.InReplyTo,
+		sql.NullString{String: review.Author, Valid: review.Author != ""},
+		sql.NullString{String: review.State, Valid: review.State != ""},
+		sql.NullString{String: review.Body, Valid: review.Body != ""},
+		sql.NullString{String: review.Path, Valid: review.Path != ""},
+		sql.NullInt64{Int64: int64(review.Line), Valid: review.Line > 0},
+		sql.NullString{String: review.URL, Valid: review.URL != ""},
+		submittedAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to save PR review: %w", err)
+	}
+	return !exists, nil
+}
+
+// ListByPR retrieves a PR's review items, oldest first; a review precedes
+// the comments submitted with it.
+func (r *PRReviewRepository) ListByPR(ctx context.Context, prID string) ([]*secondary.PRReviewRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+prReviewCols+" FROM pr_reviews WHERE pr_id = ? ORDER BY submitted_at, kind DESC, external_id",
+		prID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PR reviews: %w", err)
+	}
+	defer rows.Close()
+
+	var reviews []*secondary.PRReviewRecord
+	for rows.Next() {
+		record, err := scanPRReview(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan PR review: %w", err)
+		}
+		reviews = append(reviews, record)
+	}
+	return reviews, rows.Err()
+}
+
+// SetTask links a review item to the task created for it.
+func (r *PRReviewRepository) SetTask(ctx context.Context, prID, externalID, taskID string) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE pr_reviews SET task_id = ?, updated_at = CURRENT_TIMESTAMP WHERE pr_id = ? AND external_id = ?",
+		taskID, prID, externalID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to link PR review to task: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("review %s not found on %s", externalID, prID)
+	}
+	return nil
+}
+
+// scanPRReview scans a review row into a PRReviewRecord.
+func scanPRReview(scanner interface {
+	Scan(dest ...any) error
+}) (*secondary.PRReviewRecord, error) {
+	var (
+		reviewExternalID, author, state, body, path, url, taskID sql.NullString
+		line                                                     sql.NullInt64
+		submittedAt                                              sql.NullTime
+		createdAt, updatedAt                                     time.Time
+	)
+
+	record := &secondary.PRReviewRecord{}
+	if err := scanner.Scan(&record.PRID, &record.ExternalID, &record.Kind, &reviewExternalID, &record.InReplyTo,
+		&author, &state, &body, &path, &line, &url, &submittedAt, &taskID, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	record.ReviewExternalID = reviewExternalID.String
+	record.Author = author.String
+	record.State = state.String
+	record.Body = body.String
+	record.Path = path.String
+	record.Line = int(line.Int64)
+	record.URL = url.String
+	if submittedAt.Valid {
+		record.SubmittedAt = submittedAt.Time.Format(time.RFC3339)
+	}
+	record.TaskID = taskID.String
+	record.CreatedAt = createdAt.Format(time.RFC3339)
+	record.UpdatedAt = updatedAt.Format(time.RFC3339)
+	return record, nil
+}
+
+// Ensure PRReviewRepository implements the interface
+var _ secondary.PRReviewRepository = (*PRReviewRepository)(nil)
@@ -0,0 +1,90 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestPRReviewRepository_SaveAndList(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewPRReviewRepository(db)
+	ctx := context.Background()
+
+	review := &secondary.PRReviewRecord{
+		PRID:        "PR-001",
+		ExternalID:  "review:10",
+		Kind:        "review",
+		Author:      "octocat",
+		State:       "CHANGES_REQUESTED",
+		Body:        "Needs tests",
+		SubmittedAt: "2026-10-01T10:00:00Z",
+	}
+	comment := &secondary.PRReviewRecord{
+		PRID:             "PR-001",
+		ExternalID:       "comment:20",
+		Kind:             "comment",
+		ReviewExternalID: "review:10",
+		Author:           "octocat",
+		Body:             "Handle nil",
+		Path:             "a.go",
+		Line:             12,
+		SubmittedAt:      "2026-10-01T10:00:00Z",
+	}
+
+	for _, r := range []*secondary.PRReviewRecord{review, comment} {
+		created, err := repo.Save(ctx, r)
+		if err != nil {
+			t.Fatalf("Save failed: %v", err)
+		}
+		if !created {
+			t.Errorf("expected %s to be new", r.ExternalID)
+		}
+	}
+
+	if err := repo.SetTask(ctx, "PR-001", "comment:20", "TASK-001"); err != nil {
+		t.Fatalf("SetTask failed: %v", err)
+	}
+
+	// Re-fetching updates content but keeps the task link
+	comment.Body = "Handle nil and empty"
+	created, err := repo.Save(ctx, comment)
+	if err != nil {
+		t.Fatalf("second Save failed: %v", err)
+	}
+	if created {
+		t.Error("expected re-saved comment not to be new")
+	}
+
+	reviews, err := repo.ListByPR(ctx, "PR-001")
+	if err != nil {
+		t.Fatalf("ListByPR failed: %v", err)
+	}
+	if len(reviews) != 2 {
+		t.Fatalf("expected 2 review items, got %d", len(reviews))
+	}
+	if reviews[0].State != "CHANGES_REQUESTED" || reviews[0].TaskID != "" {
+		t.Errorf("expected review before its comment, got %+v", reviews[0])
+	}
+	got := reviews[1]
+	if got.ExternalID != "comment:20" || got.Body != "Handle nil and empty" || got.TaskID != "TASK-001" {
+		t.Errorf("unexpected comment: %+v", got)
+	}
+	if got.Path != "a.go" || got.Line != 12 || got.ReviewExternalID != "review:10" {
+		t.Errorf("unexpected comment location: %+v", got)
+	}
+	if got.SubmittedAt != "2026-10-01T10:00:00Z" {
+		t.Errorf("SubmittedAt = %q", got.SubmittedAt)
+	}
+}
+
+func TestPRReviewRepository_SetTask_NotFound(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewPRReviewRepository(db)
+
+	if err := repo.SetTask(context.Background(), "PR-001", "review:99", "TASK-001"); err == nil {
+		t.Error("expected error for unknown review")
+	}
+}
@@ -0,0 +1,76 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// PresenceRepository implements secondary.PresenceRepository with SQLite.
+type PresenceRepository struct {
+	db *sql.DB
+}
+
+// NewPresenceRepository creates a new SQLite presence repository.
+func NewPresenceRepository(db *sql.DB) *PresenceRepository {
+	return &PresenceRepository{db: db}
+}
+
+// Touch creates or replaces an actor's sighting on an entity.
+func (r *PresenceRepository) Touch(ctx context.Context, presence *secondary.PresenceRecord) error {
+	seenAt, err := time.Parse(time.RFC3339, presence.SeenAt)
+	if err != nil {
+		return fmt.Errorf("invalid sighting time %q: %w", presence.SeenAt, err)
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO entity_presence (entity_id, actor_id, action, seen_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT(entity_id, actor_id) DO UPDATE SET action = excluded.action, seen_at = excluded.seen_at`,
+		presence.EntityID,
+		presence.ActorID,
+		presence.Action,
+		seenAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record presence: %w", err)
+	}
+	return nil
+}
+
+// ListSince retrieves sightings at or after the given RFC3339 time, newest first.
+func (r *PresenceRepository) ListSince(ctx context.Context, entityID, since string) ([]*secondary.PresenceRecord, error) {
+	t, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q: %w", since, err)
+	}
+
+	query := "SELECT entity_id, actor_id, action, seen_at FROM entity_presence WHERE seen_at >= ?"
+	args := []any{t.UTC()}
+	if entityID != "" {
+		query += " AND entity_id = ?"
+		args = append(args, entityID)
+	}
+	rows, err := r.db.QueryContext(ctx, query+" ORDER BY seen_at DESC, actor_id", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list presence: %w", err)
+	}
+	defer rows.Close()
+
+	var sightings []*secondary.PresenceRecord
+	for rows.Next() {
+		var seenAt time.Time
+		record := &secondary.PresenceRecord{}
+		if err := rows.Scan(&record.EntityID, &record.ActorID, &record.Action, &seenAt); err != nil {
+			return nil, fmt.Errorf("failed to scan presence: %w", err)
+		}
+		record.SeenAt = seenAt.UTC().Format(time.RFC3339)
+		sightings = append(sightings, record)
+	}
+	return sightings, rows.Err()
+}
+
+// Ensure PresenceRepository implements the interface
+var _ secondary.PresenceRepository = (*PresenceRepository)(nil)
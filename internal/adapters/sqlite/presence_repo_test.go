@@ -0,0 +1,45 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestPresenceRepository_TouchAndListSince(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewPresenceRepository(db)
+	ctx := context.Background()
+
+	for _, p := range []*secondary.PresenceRecord{
+		{EntityID: "PLAN-004", ActorID: "IMP-BENCH-003", Action: "view", SeenAt: "2026-10-01T14:00:00Z"},
+		{EntityID: "PLAN-004", ActorID: "IMP-BENCH-003", Action: "edit", SeenAt: "2026-10-01T14:08:00Z"},
+		{EntityID: "PLAN-004", ActorID: "GOBLIN", Action: "view", SeenAt: "2026-10-01T14:09:00Z"},
+		{EntityID: "SHIP-002", ActorID: "GOBLIN", Action: "view", SeenAt: "2026-10-01T13:00:00Z"},
+	} {
+		if err := repo.Touch(ctx, p); err != nil {
+			t.Fatalf("Touch failed: %v", err)
+		}
+	}
+
+	sightings, err := repo.ListSince(ctx, "PLAN-004", "2026-10-01T14:00:00Z")
+	if err != nil {
+		t.Fatalf("ListSince failed: %v", err)
+	}
+	if len(sightings) != 2 || sightings[0].ActorID != "GOBLIN" {
+		t.Fatalf("expected one sighting per actor, newest first, got %d", len(sightings))
+	}
+	if sightings[1].Action != "edit" || sightings[1].SeenAt != "2026-10-01T14:08:00Z" {
+		t.Errorf("expected the IMP's later edit to replace its view, got %+v", sightings[1])
+	}
+
+	all, err := repo.ListSince(ctx, "", "2026-10-01T12:00:00Z")
+	if err != nil {
+		t.Fatalf("ListSince (all) failed: %v", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("expected 3 sightings across entities, got %d", len(all))
+	}
+}
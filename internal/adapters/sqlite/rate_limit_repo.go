@@ -0,0 +1,175 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// RateLimitRepository implements secondary.RateLimitRepository with SQLite.
+type RateLimitRepository struct {
+	db *sql.DB
+}
+
+// NewRateLimitRepository creates a new SQLite rate limit repository.
+func NewRateLimitRepository(db *sql.DB) *RateLimitRepository {
+	return &RateLimitRepository{db: db}
+}
+
+const actorThrottleCols = "actor_id, reason, throttled_at, expires_at"
+
+// ListLimits retrieves the configured limits ordered by actor type and kind.
+func (r *RateLimitRepository) ListLimits(ctx context.Context) ([]*secondary.RateLimitRecord, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT actor_type, kind, per_minute FROM rate_limits ORDER BY actor_type, kind")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rate limits: %w", err)
+	}
+	defer rows.Close()
+
+	var limits []*secondary.RateLimitRecord
+	for rows.Next() {
+		limit := &secondary.RateLimitRecord{}
+		if err := rows.Scan(&limit.ActorType, &limit.Kind, &limit.PerMinute); err != nil {
+			return nil, fmt.Errorf("failed to scan rate limit: %w", err)
+		}
+		limits = append(limits, limit)
+	}
+	return limits, rows.Err()
+}
+
+// SaveLimit creates or replaces a limit.
+func (r *RateLimitRepository) SaveLimit(ctx context.Context, limit *secondary.RateLimitRecord) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO rate_limits (actor_type, kind, per_minute) VALUES (?, ?, ?)
+		ON CONFLICT(actor_type, kind) DO UPDATE SET per_minute = excluded.per_minute`,
+		limit.ActorType, limit.Kind, limit.PerMinute,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save rate limit: %w", err)
+	}
+	return nil
+}
+
+// DeleteLimit removes a configured limit, restoring the default.
+func (r *RateLimitRepository) DeleteLimit(ctx context.Context, actorType, kind string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM rate_limits WHERE actor_type = ? AND kind = ?", actorType, kind)
+	if err != nil {
+		return fmt.Errorf("failed to delete rate limit: %w", err)
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("no %s limit is set for %s", kind, actorType)
+	}
+	return nil
+}
+
+// CountActivity counts the changes an actor has logged since the given RFC3339 time.
+func (r *RateLimitRepository) CountActivity(ctx context.Context, actorID, since string) (*secondary.ActivityRecord, error) {
+	t, err := time.Parse(time.RFC3339, since)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q: %w", since, err)
+	}
+
+	// Log timestamps are CURRENT_TIMESTAMP text, so compare in the same form
+	activity := &secondary.ActivityRecord{}
+	err = r.db.QueryRowContext(ctx,
+		`SELECT COUNT(*),
+			COALESCE(SUM(entity_type = 'note' AND action = 'create'), 0),
+			COALESCE(SUM(field_name = 'status'), 0)
+		FROM workshop_logs WHERE actor_id = ? AND timestamp >= ?`,
+		actorID, t.UTC().Format("2006-01-02 15:04:05"),
+	).Scan(&activity.Writes, &activity.Notes, &activity.StatusChanges)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count activity: %w", err)
+	}
+	return activity, nil
+}
+
+// SaveThrottle creates or replaces an actor's throttle.
+func (r *RateLimitRepository) SaveThrottle(ctx context.Context, throttle *secondary.ActorThrottleRecord) error {
+	throttledAt, err := time.Parse(time.RFC3339, throttle.ThrottledAt)
+	if err != nil {
+		return fmt.Errorf("invalid throttle time %q: %w", throttle.ThrottledAt, err)
+	}
+	expiresAt, err := time.Parse(time.RFC3339, throttle.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("invalid expiry time %q: %w", throttle.ExpiresAt, err)
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO actor_throttles (actor_id, reason, throttled_at, expires_at) VALUES (?, ?, ?, ?)
+		ON CONFLICT(actor_id) DO UPDATE SET reason = excluded.reason,
+			throttled_at = excluded.throttled_at, expires_at = excluded.expires_at`,
+		throttle.ActorID,
+		throttle.Reason,
+		throttledAt.UTC(),
+		expiresAt.UTC(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save throttle: %w", err)
+	}
+	return nil
+}
+
+// GetThrottle retrieves an actor's throttle (nil if none), expired or not.
+func (r *RateLimitRepository) GetThrottle(ctx context.Context, actorID string) (*secondary.ActorThrottleRecord, error) {
+	row := r.db.QueryRowContext(ctx,
+		"SELECT "+actorThrottleCols+" FROM actor_throttles WHERE actor_id = ?",
+		actorID,
+	)
+	record, err := scanActorThrottle(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get throttle: %w", err)
+	}
+	return record, nil
+}
+
+// ListActiveThrottles retrieves throttles expiring after the given RFC3339 time.
+func (r *RateLimitRepository) ListActiveThrottles(ctx context.Context, after string) ([]*secondary.ActorThrottleRecord, error) {
+	t, err := time.Parse(time.RFC3339, after)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q: %w", after, err)
+	}
+
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+actorThrottleCols+" FROM actor_throttles WHERE expires_at > ? ORDER BY throttled_at",
+		t.UTC(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list throttles: %w", err)
+	}
+	defer rows.Close()
+
+	var throttles []*secondary.ActorThrottleRecord
+	for rows.Next() {
+		record, err := scanActorThrottle(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan throttle: %w", err)
+		}
+		throttles = append(throttles, record)
+	}
+	return throttles, rows.Err()
+}
+
+// scanActorThrottle scans a throttle row into an ActorThrottleRecord.
+func scanActorThrottle(scanner interface {
+	Scan(dest ...any) error
+}) (*secondary.ActorThrottleRecord, error) {
+	var throttledAt, expiresAt time.Time
+	record := &secondary.ActorThrottleRecord{}
+	if err := scanner.Scan(&record.ActorID, &record.Reason, &throttledAt, &expiresAt); err != nil {
+		return nil, err
+	}
+	record.ThrottledAt = throttledAt.UTC().Format(time.RFC3339)
+	record.ExpiresAt = expiresAt.UTC().Format(time.RFC3339)
+	return record, nil
+}
+
+// Ensure RateLimitRepository implements the interface
+var _ secondary.RateLimitRepository = (*RateLimitRepository)(nil)
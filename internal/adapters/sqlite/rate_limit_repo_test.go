@@ -0,0 +1,114 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestRateLimitRepository_Limits(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewRateLimitRepository(db)
+	ctx := context.Background()
+
+	if err := repo.SaveLimit(ctx, &secondary.RateLimitRecord{ActorType: "IMP", Kind: "notes", PerMinute: 10}); err != nil {
+		t.Fatalf("SaveLimit failed: %v", err)
+	}
+	if err := repo.SaveLimit(ctx, &secondary.RateLimitRecord{ActorType: "IMP", Kind: "notes", PerMinute: 15}); err != nil {
+		t.Fatalf("SaveLimit (replace) failed: %v", err)
+	}
+	if err := repo.SaveLimit(ctx, &secondary.RateLimitRecord{ActorType: "GOBLIN", Kind: "writes", PerMinute: 300}); err != nil {
+		t.Fatalf("SaveLimit failed: %v", err)
+	}
+
+	limits, err := repo.ListLimits(ctx)
+	if err != nil {
+		t.Fatalf("ListLimits failed: %v", err)
+	}
+	if len(limits) != 2 || limits[0].ActorType != "GOBLIN" || limits[1].PerMinute != 15 {
+		t.Errorf("unexpected limits: %+v, %+v", limits[0], limits[1])
+	}
+
+	if err := repo.DeleteLimit(ctx, "IMP", "notes"); err != nil {
+		t.Fatalf("DeleteLimit failed: %v", err)
+	}
+	if err := repo.DeleteLimit(ctx, "IMP", "notes"); err == nil {
+		t.Error("expected error deleting a limit that isn't set")
+	}
+}
+
+func TestRateLimitRepository_CountActivity(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewRateLimitRepository(db)
+	ctx := context.Background()
+	seedWorkbench(t, db, "BENCH-001", "", "")
+
+	now := time.Now().UTC()
+	recent := now.Add(-20 * time.Second).Format("2006-01-02 15:04:05")
+	old := now.Add(-5 * time.Minute).Format("2006-01-02 15:04:05")
+	for i, row := range []struct {
+		actor, entityType, action, field, at string
+	}{
+		{"IMP-BENCH-001", "note", "create", "", recent},
+		{"IMP-BENCH-001", "note", "create", "", recent},
+		{"IMP-BENCH-001", "task", "update", "status", recent},
+		{"IMP-BENCH-001", "task", "update", "title", recent},
+		{"IMP-BENCH-001", "note", "create", "", old},
+		{"IMP-BENCH-002", "note", "create", "", recent},
+	} {
+		if _, err := db.Exec(
+			`INSERT INTO workshop_logs (id, workshop_id, actor_id, entity_type, entity_id, action, field_name, timestamp)
+			VALUES (?, 'SHOP-001', ?, ?, 'X-001', ?, NULLIF(?, ''), ?)`,
+			"WL-"+string(rune('A'+i)), row.actor, row.entityType, row.action, row.field, row.at,
+		); err != nil {
+			t.Fatalf("failed to seed log: %v", err)
+		}
+	}
+
+	activity, err := repo.CountActivity(ctx, "IMP-BENCH-001", now.Add(-time.Minute).Format(time.RFC3339))
+	if err != nil {
+		t.Fatalf("CountActivity failed: %v", err)
+	}
+	if activity.Writes != 4 || activity.Notes != 2 || activity.StatusChanges != 1 {
+		t.Errorf("activity = %+v, want 4 writes, 2 notes, 1 status change", activity)
+	}
+}
+
+func TestRateLimitRepository_Throttles(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewRateLimitRepository(db)
+	ctx := context.Background()
+
+	throttle, err := repo.GetThrottle(ctx, "IMP-BENCH-003")
+	if err != nil || throttle != nil {
+		t.Fatalf("expected no throttle, got %+v (%v)", throttle, err)
+	}
+
+	for _, th := range []*secondary.ActorThrottleRecord{
+		{ActorID: "IMP-BENCH-003", Reason: "25 notes in the last minute (limit 20)", ThrottledAt: "2026-10-01T14:10:00Z", ExpiresAt: "2026-10-01T14:25:00Z"},
+		{ActorID: "IMP-BENCH-004", Reason: "31 status changes in the last minute (limit 30)", ThrottledAt: "2026-10-01T13:00:00Z", ExpiresAt: "2026-10-01T13:15:00Z"},
+	} {
+		if err := repo.SaveThrottle(ctx, th); err != nil {
+			t.Fatalf("SaveThrottle failed: %v", err)
+		}
+	}
+
+	throttle, err = repo.GetThrottle(ctx, "IMP-BENCH-003")
+	if err != nil {
+		t.Fatalf("GetThrottle failed: %v", err)
+	}
+	if throttle.ExpiresAt != "2026-10-01T14:25:00Z" || throttle.Reason != "25 notes in the last minute (limit 20)" {
+		t.Errorf("unexpected throttle: %+v", throttle)
+	}
+
+	active, err := repo.ListActiveThrottles(ctx, "2026-10-01T14:12:00Z")
+	if err != nil {
+		t.Fatalf("ListActiveThrottles failed: %v", err)
+	}
+	if len(active) != 1 || active[0].ActorID != "IMP-BENCH-003" {
+		t.Errorf("expected only IMP-BENCH-003 active, got %+v", active)
+	}
+}
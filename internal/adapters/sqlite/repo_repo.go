@@ -22,7 +22,7 @@ func NewRepoRepository(db *sql.DB) *RepoRepository {
 
 // Create persists a new repository.
 func (r *RepoRepository) Create(ctx context.Context, repo *secondary.RepoRecord) error {
-	var url, localPath sql.NullString
+	var url, localPath, bootstrapScript sql.NullString
 
 	if repo.URL != "" {
 		url = sql.NullString{String: repo.URL, Valid: true}
@@ -30,6 +30,9 @@ func (r *RepoRepository) Create(ctx context.Context, repo *secondary.RepoRecord)
 	if repo.LocalPath != "" {
 		localPath = sql.NullString{String: repo.LocalPath, Valid: true}
 	}
+	if repo.BootstrapScript != "" {
+		bootstrapScript = sql.NullString{String: repo.BootstrapScript, Valid: true}
+	}
 
 	defaultBranch := repo.DefaultBranch
 	if defaultBranch == "" {
@@ -37,8 +40,8 @@ func (r *RepoRepository) Create(ctx context.Context, repo *secondary.RepoRecord)
 	}
 
 	_, err := r.db.ExecContext(ctx,
-		"INSERT INTO repos (id, name, url, local_path, default_branch, status) VALUES (?, ?, ?, ?, ?, ?)",
-		repo.ID, repo.Name, url, localPath, defaultBranch, "active",
+		"INSERT INTO repos (id, name, url, local_path, default_branch, bootstrap_script, status) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		repo.ID, repo.Name, url, localPath, defaultBranch, bootstrapScript, "active",
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create repo: %w", err)
@@ -50,19 +53,20 @@ func (r *RepoRepository) Create(ctx context.Context, repo *secondary.RepoRecord)
 // GetByID retrieves a repository by its ID.
 func (r *RepoRepository) GetByID(ctx context.Context, id string) (*secondary.RepoRecord, error) {
 	var (
-		url           sql.NullString
-		localPath     sql.NullString
-		defaultBranch string
-		status        string
-		createdAt     time.Time
-		updatedAt     time.Time
+		url             sql.NullString
+		localPath       sql.NullString
+		defaultBranch   string
+		bootstrapScript sql.NullString
+		status          string
+		createdAt       time.Time
+		updatedAt       time.Time
 	)
 
 	record := &secondary.RepoRecord{}
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, name, url, local_path, default_branch, status, created_at, updated_at FROM repos WHERE id = ?",
+		"SELECT id, name, url, local_path, default_branch, bootstrap_script, status, created_at, updated_at FROM repos WHERE id = ?",
 		id,
-	).Scan(&record.ID, &record.Name, &url, &localPath, &defaultBranch, &status, &createdAt, &updatedAt)
+	).Scan(&record.ID, &record.Name, &url, &localPath, &defaultBranch, &bootstrapScript, &status, &createdAt, &updatedAt)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("repository %s not found", id)
@@ -74,6 +78,7 @@ func (r *RepoRepository) GetByID(ctx context.Context, id string) (*secondary.Rep
 	record.URL = url.String
 	record.LocalPath = localPath.String
 	record.DefaultBranch = defaultBranch
+	record.BootstrapScript = bootstrapScript.String
 	record.Status = status
 	record.CreatedAt = createdAt.Format(time.RFC3339)
 	record.UpdatedAt = updatedAt.Format(time.RFC3339)
@@ -84,19 +89,20 @@ func (r *RepoRepository) GetByID(ctx context.Context, id string) (*secondary.Rep
 // GetByName retrieves a repository by its unique name.
 func (r *RepoRepository) GetByName(ctx context.Context, name string) (*secondary.RepoRecord, error) {
 	var (
-		url           sql.NullString
-		localPath     sql.NullString
-		defaultBranch string
-		status        string
-		createdAt     time.Time
-		updatedAt     time.Time
+		url             sql.NullString
+		localPath       sql.NullString
+		defaultBranch   string
+		bootstrapScript sql.NullString
+		status          string
+		createdAt       time.Time
+		updatedAt       time.Time
 	)
 
 	record := &secondary.RepoRecord{}
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, name, url, local_path, default_branch, status, created_at, updated_at FROM repos WHERE name = ?",
+		"SELECT id, name, url, local_path, default_branch, bootstrap_script, status, created_at, updated_at FROM repos WHERE name = ?",
 		name,
-	).Scan(&record.ID, &record.Name, &url, &localPath, &defaultBranch, &status, &createdAt, &updatedAt)
+	).Scan(&record.ID, &record.Name, &url, &localPath, &defaultBranch, &bootstrapScript, &status, &createdAt, &updatedAt)
 
 	if err == sql.ErrNoRows {
 		return nil, nil // Return nil, nil for "not found" to distinguish from errors
@@ -108,6 +114,7 @@ func (r *RepoRepository) GetByName(ctx context.Context, name string) (*secondary
 	record.URL = url.String
 	record.LocalPath = localPath.String
 	record.DefaultBranch = defaultBranch
+	record.BootstrapScript = bootstrapScript.String
 	record.Status = status
 	record.CreatedAt = createdAt.Format(time.RFC3339)
 	record.UpdatedAt = updatedAt.Format(time.RFC3339)
@@ -117,7 +124,7 @@ func (r *RepoRepository) GetByName(ctx context.Context, name string) (*secondary
 
 // List retrieves repositories matching the given filters.
 func (r *RepoRepository) List(ctx context.Context, filters secondary.RepoFilters) ([]*secondary.RepoRecord, error) {
-	query := "SELECT id, name, url, local_path, default_branch, status, created_at, updated_at FROM repos WHERE 1=1"
+	query := "SELECT id, name, url, local_path, default_branch, bootstrap_script, status, created_at, updated_at FROM repos WHERE 1=1"
 	args := []any{}
 
 	if filters.Status != "" {
@@ -136,16 +143,17 @@ func (r *RepoRepository) List(ctx context.Context, filters secondary.RepoFilters
 	var repos []*secondary.RepoRecord
 	for rows.Next() {
 		var (
-			url           sql.NullString
-			localPath     sql.NullString
-			defaultBranch string
-			status        string
-			createdAt     time.Time
-			updatedAt     time.Time
+			url             sql.NullString
+			localPath       sql.NullString
+			defaultBranch   string
+			bootstrapScript sql.NullString
+			status          string
+			createdAt       time.Time
+			updatedAt       time.Time
 		)
 
 		record := &secondary.RepoRecord{}
-		err := rows.Scan(&record.ID, &record.Name, &url, &localPath, &defaultBranch, &status, &createdAt, &updatedAt)
+		err := rows.Scan(&record.ID, &record.Name, &url, &localPath, &defaultBranch, &bootstrapScript, &status, &createdAt, &updatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan repository: %w", err)
 		}
@@ -153,6 +161,7 @@ func (r *RepoRepository) List(ctx context.Context, filters secondary.RepoFilters
 		record.URL = url.String
 		record.LocalPath = localPath.String
 		record.DefaultBranch = defaultBranch
+		record.BootstrapScript = bootstrapScript.String
 		record.Status = status
 		record.CreatedAt = createdAt.Format(time.RFC3339)
 		record.UpdatedAt = updatedAt.Format(time.RFC3339)
@@ -258,5 +267,28 @@ func (r *RepoRepository) HasActivePRs(ctx context.Context, repoID string) (bool,
 	return count > 0, nil
 }
 
+// UpdateBootstrapScript sets or clears the workbench bootstrap script.
+func (r *RepoRepository) UpdateBootstrapScript(ctx context.Context, id, script string) error {
+	var bootstrapScript sql.NullString
+	if script != "" {
+		bootstrapScript = sql.NullString{String: script, Valid: true}
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE repos SET bootstrap_script = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		bootstrapScript, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update bootstrap script: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("repository %s not found", id)
+	}
+
+	return nil
+}
+
 // Ensure RepoRepository implements the interface
 var _ secondary.RepoRepository = (*RepoRepository)(nil)
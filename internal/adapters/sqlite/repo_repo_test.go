@@ -287,3 +287,40 @@ func TestRepoRepository_UpdateStatus(t *testing.T) {
 		}
 	})
 }
+
+func TestRepoRepository_UpdateBootstrapScript(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewRepoRepository(db)
+	ctx := context.Background()
+
+	_ = repo.Create(ctx, &secondary.RepoRecord{ID: "REPO-001", Name: "bootstrap-repo"})
+
+	t.Run("sets bootstrap script", func(t *testing.T) {
+		script := "direnv allow\npre-commit install"
+		if err := repo.UpdateBootstrapScript(ctx, "REPO-001", script); err != nil {
+			t.Fatalf("UpdateBootstrapScript failed: %v", err)
+		}
+
+		got, _ := repo.GetByID(ctx, "REPO-001")
+		if got.BootstrapScript != script {
+			t.Errorf("BootstrapScript = %q, want %q", got.BootstrapScript, script)
+		}
+	})
+
+	t.Run("clears bootstrap script", func(t *testing.T) {
+		if err := repo.UpdateBootstrapScript(ctx, "REPO-001", ""); err != nil {
+			t.Fatalf("UpdateBootstrapScript failed: %v", err)
+		}
+
+		got, _ := repo.GetByID(ctx, "REPO-001")
+		if got.BootstrapScript != "" {
+			t.Errorf("BootstrapScript = %q, want empty", got.BootstrapScript)
+		}
+	})
+
+	t.Run("returns error for non-existent repository", func(t *testing.T) {
+		if err := repo.UpdateBootstrapScript(ctx, "REPO-999", "make setup"); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
@@ -0,0 +1,120 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// SecretRepository implements secondary.SecretRepository with SQLite.
+// It deliberately takes no LogWriter: secret changes never reach the activity log.
+type SecretRepository struct {
+	db *sql.DB
+}
+
+// NewSecretRepository creates a new SQLite secret repository.
+func NewSecretRepository(db *sql.DB) *SecretRepository {
+	return &SecretRepository{db: db}
+}
+
+// Set stores a secret, replacing any existing value for the same name and scope.
+func (r *SecretRepository) Set(ctx context.Context, secret *secondary.SecretRecord) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO secrets (name, scope_type, scope_id, ciphertext) VALUES (?, ?, ?, ?)
+		ON CONFLICT(name, scope_type, scope_id) DO UPDATE SET ciphertext = excluded.ciphertext, updated_at = CURRENT_TIMESTAMP`,
+		secret.Name, secret.ScopeType, secret.ScopeID, secret.Ciphertext,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set secret: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves a secret by name and scope (nil if none).
+func (r *SecretRepository) Get(ctx context.Context, name, scopeType, scopeID string) (*secondary.SecretRecord, error) {
+	row := r.db.QueryRowContext(ctx,
+		"SELECT name, scope_type, scope_id, ciphertext, created_at, updated_at FROM secrets WHERE name = ? AND scope_type = ? AND scope_id = ?",
+		name, scopeType, scopeID,
+	)
+	record, err := scanSecret(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret: %w", err)
+	}
+	return record, nil
+}
+
+// List retrieves secrets, optionally filtered by scope type and ID.
+func (r *SecretRepository) List(ctx context.Context, scopeType, scopeID string) ([]*secondary.SecretRecord, error) {
+	query := "SELECT name, scope_type, scope_id, ciphertext, created_at, updated_at FROM secrets"
+	var conditions []string
+	var args []any
+	if scopeType != "" {
+		conditions = append(conditions, "scope_type = ?")
+		args = append(args, scopeType)
+	}
+	if scopeID != "" {
+		conditions = append(conditions, "scope_id = ?")
+		args = append(args, scopeID)
+	}
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY name, scope_type, scope_id"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets: %w", err)
+	}
+	defer rows.Close()
+
+	var secrets []*secondary.SecretRecord
+	for rows.Next() {
+		record, err := scanSecret(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan secret: %w", err)
+		}
+		secrets = append(secrets, record)
+	}
+	return secrets, rows.Err()
+}
+
+// Delete removes a secret by name and scope.
+func (r *SecretRepository) Delete(ctx context.Context, name, scopeType, scopeID string) error {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM secrets WHERE name = ? AND scope_type = ? AND scope_id = ?",
+		name, scopeType, scopeID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete secret: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("secret %s not found", name)
+	}
+	return nil
+}
+
+// scanSecret scans a secret row into a SecretRecord.
+func scanSecret(scanner interface {
+	Scan(dest ...any) error
+}) (*secondary.SecretRecord, error) {
+	var createdAt, updatedAt time.Time
+	record := &secondary.SecretRecord{}
+	if err := scanner.Scan(&record.Name, &record.ScopeType, &record.ScopeID, &record.Ciphertext, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	record.CreatedAt = createdAt.Format(time.RFC3339)
+	record.UpdatedAt = updatedAt.Format(time.RFC3339)
+	return record, nil
+}
+
+// Ensure SecretRepository implements the interface
+var _ secondary.SecretRepository = (*SecretRepository)(nil)
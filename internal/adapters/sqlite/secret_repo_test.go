@@ -0,0 +1,63 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestSecretRepository_SetGetListDelete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewSecretRepository(db)
+	ctx := context.Background()
+
+	for _, s := range []*secondary.SecretRecord{
+		{Name: "github-token", ScopeType: "global", Ciphertext: "c-global"},
+		{Name: "github-token", ScopeType: "repo", ScopeID: "REPO-001", Ciphertext: "c-repo"},
+		{Name: "slack-webhook", ScopeType: "factory", ScopeID: "FACT-001", Ciphertext: "c-slack"},
+	} {
+		if err := repo.Set(ctx, s); err != nil {
+			t.Fatalf("Set failed: %v", err)
+		}
+	}
+
+	// Set on the same name and scope replaces the value
+	if err := repo.Set(ctx, &secondary.SecretRecord{Name: "github-token", ScopeType: "repo", ScopeID: "REPO-001", Ciphertext: "c-repo-2"}); err != nil {
+		t.Fatalf("Set (replace) failed: %v", err)
+	}
+
+	got, err := repo.Get(ctx, "github-token", "repo", "REPO-001")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if got == nil || got.Ciphertext != "c-repo-2" {
+		t.Fatalf("unexpected secret: %+v", got)
+	}
+
+	missing, err := repo.Get(ctx, "github-token", "repo", "REPO-002")
+	if err != nil || missing != nil {
+		t.Errorf("expected nil for missing secret, got %+v, %v", missing, err)
+	}
+
+	all, err := repo.List(ctx, "", "")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("expected 3 secrets, got %d", len(all))
+	}
+
+	repoScoped, _ := repo.List(ctx, "repo", "REPO-001")
+	if len(repoScoped) != 1 || repoScoped[0].Name != "github-token" {
+		t.Errorf("expected repo-scoped github-token, got %+v", repoScoped)
+	}
+
+	if err := repo.Delete(ctx, "github-token", "global", ""); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := repo.Delete(ctx, "github-token", "global", ""); err == nil {
+		t.Error("expected error deleting missing secret")
+	}
+}
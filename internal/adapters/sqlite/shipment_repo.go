@@ -69,6 +69,8 @@ func (r *ShipmentRepository) GetByID(ctx context.Context, id string) (*secondary
 		repoID              sql.NullString
 		branch              sql.NullString
 		specNoteID          sql.NullString
+		charter             sql.NullString
+		autorun             sql.NullString
 		pinned              bool
 		createdAt           time.Time
 		updatedAt           time.Time
@@ -77,9 +79,9 @@ func (r *ShipmentRepository) GetByID(ctx context.Context, id string) (*secondary
 
 	record := &secondary.ShipmentRecord{}
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, commission_id, title, description, status, assigned_workbench_id, repo_id, branch, pinned, created_at, updated_at, completed_at, spec_note_id FROM shipments WHERE id = ?",
+		"SELECT id, commission_id, title, description, status, assigned_workbench_id, repo_id, branch, pinned, created_at, updated_at, completed_at, spec_note_id, charter, autorun FROM shipments WHERE id = ?",
 		id,
-	).Scan(&record.ID, &record.CommissionID, &record.Title, &desc, &record.Status, &assignedWorkbenchID, &repoID, &branch, &pinned, &createdAt, &updatedAt, &completedAt, &specNoteID)
+	).Scan(&record.ID, &record.CommissionID, &record.Title, &desc, &record.Status, &assignedWorkbenchID, &repoID, &branch, &pinned, &createdAt, &updatedAt, &completedAt, &specNoteID, &charter, &autorun)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("shipment %s not found", id)
@@ -97,6 +99,8 @@ func (r *ShipmentRepository) GetByID(ctx context.Context, id string) (*secondary
 		record.Branch = branch.String
 	}
 	record.SpecNoteID = specNoteID.String
+	record.Charter = charter.String
+	record.Autorun = autorun.String
 	record.Pinned = pinned
 	record.CreatedAt = createdAt.Format(time.RFC3339)
 	record.UpdatedAt = updatedAt.Format(time.RFC3339)
@@ -109,7 +113,7 @@ func (r *ShipmentRepository) GetByID(ctx context.Context, id string) (*secondary
 
 // List retrieves shipments matching the given filters.
 func (r *ShipmentRepository) List(ctx context.Context, filters secondary.ShipmentFilters) ([]*secondary.ShipmentRecord, error) {
-	query := "SELECT id, commission_id, title, description, status, assigned_workbench_id, repo_id, branch, pinned, created_at, updated_at, completed_at, spec_note_id FROM shipments WHERE 1=1"
+	query := "SELECT id, commission_id, title, description, status, assigned_workbench_id, repo_id, branch, pinned, created_at, updated_at, completed_at, spec_note_id, charter, task_count, tasks_closed, workbench_name FROM shipment_list_view WHERE 1=1"
 	args := []any{}
 
 	if filters.CommissionID != "" {
@@ -138,14 +142,16 @@ func (r *ShipmentRepository) List(ctx context.Context, filters secondary.Shipmen
 			repoID              sql.NullString
 			branch              sql.NullString
 			specNoteID          sql.NullString
+			charter             sql.NullString
 			pinned              bool
 			createdAt           time.Time
 			updatedAt           time.Time
 			completedAt         sql.NullTime
+			workbenchName       sql.NullString
 		)
 
 		record := &secondary.ShipmentRecord{}
-		err := rows.Scan(&record.ID, &record.CommissionID, &record.Title, &desc, &record.Status, &assignedWorkbenchID, &repoID, &branch, &pinned, &createdAt, &updatedAt, &completedAt, &specNoteID)
+		err := rows.Scan(&record.ID, &record.CommissionID, &record.Title, &desc, &record.Status, &assignedWorkbenchID, &repoID, &branch, &pinned, &createdAt, &updatedAt, &completedAt, &specNoteID, &charter, &record.TaskCount, &record.TasksClosed, &workbenchName)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan shipment: %w", err)
 		}
@@ -159,12 +165,14 @@ func (r *ShipmentRepository) List(ctx context.Context, filters secondary.Shipmen
 			record.Branch = branch.String
 		}
 		record.SpecNoteID = specNoteID.String
+		record.Charter = charter.String
 		record.Pinned = pinned
 		record.CreatedAt = createdAt.Format(time.RFC3339)
 		record.UpdatedAt = updatedAt.Format(time.RFC3339)
 		if completedAt.Valid {
 			record.CompletedAt = completedAt.Time.Format(time.RFC3339)
 		}
+		record.WorkbenchName = workbenchName.String
 
 		shipments = append(shipments, record)
 	}
@@ -274,7 +282,7 @@ func (r *ShipmentRepository) GetNextID(ctx context.Context) (string, error) {
 
 // GetByWorkbench retrieves shipments assigned to a workbench.
 func (r *ShipmentRepository) GetByWorkbench(ctx context.Context, workbenchID string) ([]*secondary.ShipmentRecord, error) {
-	query := "SELECT id, commission_id, title, description, status, assigned_workbench_id, repo_id, branch, pinned, created_at, updated_at, completed_at, spec_note_id FROM shipments WHERE assigned_workbench_id = ?"
+	query := "SELECT id, commission_id, title, description, status, assigned_workbench_id, repo_id, branch, pinned, created_at, updated_at, completed_at, spec_note_id, charter, task_count, tasks_closed, workbench_name FROM shipment_list_view WHERE assigned_workbench_id = ?"
 	rows, err := r.db.QueryContext(ctx, query, workbenchID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get shipments by workbench: %w", err)
@@ -289,14 +297,16 @@ func (r *ShipmentRepository) GetByWorkbench(ctx context.Context, workbenchID str
 			repoID              sql.NullString
 			branch              sql.NullString
 			specNoteID          sql.NullString
+			charter             sql.NullString
 			pinned              bool
 			createdAt           time.Time
 			updatedAt           time.Time
 			completedAt         sql.NullTime
+			workbenchName       sql.NullString
 		)
 
 		record := &secondary.ShipmentRecord{}
-		err := rows.Scan(&record.ID, &record.CommissionID, &record.Title, &desc, &record.Status, &assignedWorkbenchID, &repoID, &branch, &pinned, &createdAt, &updatedAt, &completedAt, &specNoteID)
+		err := rows.Scan(&record.ID, &record.CommissionID, &record.Title, &desc, &record.Status, &assignedWorkbenchID, &repoID, &branch, &pinned, &createdAt, &updatedAt, &completedAt, &specNoteID, &charter, &record.TaskCount, &record.TasksClosed, &workbenchName)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan shipment: %w", err)
 		}
@@ -310,12 +320,14 @@ func (r *ShipmentRepository) GetByWorkbench(ctx context.Context, workbenchID str
 			record.Branch = branch.String
 		}
 		record.SpecNoteID = specNoteID.String
+		record.Charter = charter.String
 		record.Pinned = pinned
 		record.CreatedAt = createdAt.Format(time.RFC3339)
 		record.UpdatedAt = updatedAt.Format(time.RFC3339)
 		if completedAt.Valid {
 			record.CompletedAt = completedAt.Time.Format(time.RFC3339)
 		}
+		record.WorkbenchName = workbenchName.String
 
 		shipments = append(shipments, record)
 	}
@@ -325,9 +337,20 @@ func (r *ShipmentRepository) GetByWorkbench(ctx context.Context, workbenchID str
 
 // AssignWorkbench assigns a shipment to a workbench.
 func (r *ShipmentRepository) AssignWorkbench(ctx context.Context, shipmentID, workbenchID string) error {
+	// Get old assignment for logging
+	var oldWorkbenchID sql.NullString
+	if r.logWriter != nil {
+		_ = r.db.QueryRowContext(ctx, "SELECT assigned_workbench_id FROM shipments WHERE id = ?", shipmentID).Scan(&oldWorkbenchID)
+	}
+
+	var workbenchIDNullable sql.NullString
+	if workbenchID != "" {
+		workbenchIDNullable = sql.NullString{String: workbenchID, Valid: true}
+	}
+
 	result, err := r.db.ExecContext(ctx,
 		"UPDATE shipments SET assigned_workbench_id = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
-		workbenchID, shipmentID,
+		workbenchIDNullable, shipmentID,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to assign workbench to shipment: %w", err)
@@ -338,11 +361,22 @@ func (r *ShipmentRepository) AssignWorkbench(ctx context.Context, shipmentID, wo
 		return fmt.Errorf("shipment %s not found", shipmentID)
 	}
 
+	// Log assignment change
+	if r.logWriter != nil && oldWorkbenchID.String != workbenchID {
+		_ = r.logWriter.LogUpdate(ctx, "shipment", shipmentID, "assigned_workbench_id", oldWorkbenchID.String, workbenchID)
+	}
+
 	return nil
 }
 
 // UpdateStatus updates the status and optionally completed_at timestamp.
 func (r *ShipmentRepository) UpdateStatus(ctx context.Context, id, status string, setCompleted bool) error {
+	// Get old status for logging
+	var oldStatus string
+	if r.logWriter != nil {
+		_ = r.db.QueryRowContext(ctx, "SELECT status FROM shipments WHERE id = ?", id).Scan(&oldStatus)
+	}
+
 	var query string
 	var args []any
 
@@ -364,6 +398,54 @@ func (r *ShipmentRepository) UpdateStatus(ctx context.Context, id, status string
 		return fmt.Errorf("shipment %s not found", id)
 	}
 
+	// Log status change
+	if r.logWriter != nil && oldStatus != status {
+		_ = r.logWriter.LogUpdate(ctx, "shipment", id, "status", oldStatus, status)
+	}
+
+	return nil
+}
+
+// UpdateCharter replaces the charter document of a shipment.
+// An empty charter clears the field.
+func (r *ShipmentRepository) UpdateCharter(ctx context.Context, id, charter string) error {
+	var value sql.NullString
+	if charter != "" {
+		value = sql.NullString{String: charter, Valid: true}
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE shipments SET charter = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		value, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update shipment charter: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("shipment %s not found", id)
+	}
+
+	return nil
+}
+
+// UpdateAutorun sets the autorun state of a shipment.
+// An empty state turns autorun off.
+func (r *ShipmentRepository) UpdateAutorun(ctx context.Context, id, state string) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE shipments SET autorun = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		sql.NullString{String: state, Valid: state != ""}, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update shipment autorun: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("shipment %s not found", id)
+	}
+
 	return nil
 }
 
@@ -377,6 +459,16 @@ func (r *ShipmentRepository) CommissionExists(ctx context.Context, commissionID
 	return count > 0, nil
 }
 
+// WorkbenchExists checks if a workbench exists.
+func (r *ShipmentRepository) WorkbenchExists(ctx context.Context, workbenchID string) (bool, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM workbenches WHERE id = ?", workbenchID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check workbench existence: %w", err)
+	}
+	return count > 0, nil
+}
+
 // WorkbenchAssignedToOther checks if workbench is assigned to another active shipment.
 // Returns the shipment ID if assigned to another, empty string if not.
 // Excludes terminal status shipments since workbenches can be reassigned after completion.
@@ -129,6 +129,33 @@ func TestShipmentRepository_List(t *testing.T) {
 	}
 }
 
+func TestShipmentRepository_List_ReadModelFields(t *testing.T) {
+	db := setupShipmentTestDB(t)
+	repo := sqlite.NewShipmentRepository(db, nil)
+	ctx := context.Background()
+
+	shipment := createTestShipment(t, repo, ctx, "COMM-001", "Shipment 1", "")
+	seedWorkbench(t, db, "BENCH-001", "", "orc-045")
+	_, _ = db.Exec("UPDATE shipments SET assigned_workbench_id = 'BENCH-001' WHERE id = ?", shipment.ID)
+	_, _ = db.Exec("INSERT INTO tasks (id, shipment_id, commission_id, title, status) VALUES ('TASK-001', ?, 'COMM-001', 'a', 'closed'), ('TASK-002', ?, 'COMM-001', 'b', 'open')", shipment.ID, shipment.ID)
+
+	shipments, err := repo.List(ctx, secondary.ShipmentFilters{})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(shipments) != 1 {
+		t.Fatalf("expected 1 shipment, got %d", len(shipments))
+	}
+
+	got := shipments[0]
+	if got.TaskCount != 2 || got.TasksClosed != 1 {
+		t.Errorf("expected tasks 1/2, got %d/%d", got.TasksClosed, got.TaskCount)
+	}
+	if got.WorkbenchName != "orc-045" {
+		t.Errorf("expected workbench name 'orc-045', got '%s'", got.WorkbenchName)
+	}
+}
+
 func TestShipmentRepository_List_FilterByCommission(t *testing.T) {
 	db := setupShipmentTestDB(t)
 	repo := sqlite.NewShipmentRepository(db, nil)
@@ -554,3 +581,71 @@ func TestShipmentRepository_SpecNoteID(t *testing.T) {
 		t.Error("expected to find shipment with spec_note_id in list")
 	}
 }
+
+func TestShipmentRepository_UpdateCharter(t *testing.T) {
+	db := setupShipmentTestDB(t)
+	repo := sqlite.NewShipmentRepository(db, nil)
+	ctx := context.Background()
+
+	shipment := createTestShipment(t, repo, ctx, "COMM-001", "Chartered", "")
+
+	err := repo.UpdateCharter(ctx, shipment.ID, "# Why\n\nBecause.")
+	if err != nil {
+		t.Fatalf("UpdateCharter failed: %v", err)
+	}
+
+	retrieved, _ := repo.GetByID(ctx, shipment.ID)
+	if retrieved.Charter != "# Why\n\nBecause." {
+		t.Errorf("expected charter to be stored, got '%s'", retrieved.Charter)
+	}
+
+	// Empty charter clears the field
+	err = repo.UpdateCharter(ctx, shipment.ID, "")
+	if err != nil {
+		t.Fatalf("UpdateCharter (clear) failed: %v", err)
+	}
+	retrieved, _ = repo.GetByID(ctx, shipment.ID)
+	if retrieved.Charter != "" {
+		t.Errorf("expected empty charter, got '%s'", retrieved.Charter)
+	}
+}
+
+func TestShipmentRepository_UpdateCharter_NotFound(t *testing.T) {
+	db := setupShipmentTestDB(t)
+	repo := sqlite.NewShipmentRepository(db, nil)
+	ctx := context.Background()
+
+	err := repo.UpdateCharter(ctx, "SHIP-999", "charter")
+	if err == nil {
+		t.Error("expected error for non-existent shipment")
+	}
+}
+
+func TestShipmentRepository_UpdateAutorun(t *testing.T) {
+	db := setupShipmentTestDB(t)
+	repo := sqlite.NewShipmentRepository(db, nil)
+	ctx := context.Background()
+
+	shipment := createTestShipment(t, repo, ctx, "COMM-001", "Unattended", "")
+
+	if err := repo.UpdateAutorun(ctx, shipment.ID, "on"); err != nil {
+		t.Fatalf("UpdateAutorun failed: %v", err)
+	}
+	retrieved, _ := repo.GetByID(ctx, shipment.ID)
+	if retrieved.Autorun != "on" {
+		t.Errorf("expected autorun 'on', got '%s'", retrieved.Autorun)
+	}
+
+	// Empty state turns autorun off
+	if err := repo.UpdateAutorun(ctx, shipment.ID, ""); err != nil {
+		t.Fatalf("UpdateAutorun (off) failed: %v", err)
+	}
+	retrieved, _ = repo.GetByID(ctx, shipment.ID)
+	if retrieved.Autorun != "" {
+		t.Errorf("expected autorun off, got '%s'", retrieved.Autorun)
+	}
+
+	if err := repo.UpdateAutorun(ctx, "SHIP-999", "on"); err == nil {
+		t.Error("expected error for non-existent shipment")
+	}
+}
@@ -0,0 +1,250 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// SummaryRepository implements secondary.SummaryRepository with SQLite.
+type SummaryRepository struct {
+	db *sql.DB
+}
+
+// NewSummaryRepository creates a new SQLite summary repository.
+func NewSummaryRepository(db *sql.DB) *SummaryRepository {
+	return &SummaryRepository{db: db}
+}
+
+// ListTasksByShipments retrieves tasks for the given shipments in one query.
+func (r *SummaryRepository) ListTasksByShipments(ctx context.Context, shipmentIDs []string) ([]*secondary.TaskRecord, error) {
+	if len(shipmentIDs) == 0 {
+		return nil, nil
+	}
+
+	query := "SELECT id, shipment_id, title, status FROM tasks WHERE shipment_id IN (" + inPlaceholders(len(shipmentIDs)) + ") ORDER BY created_at ASC"
+	rows, err := r.db.QueryContext(ctx, query, stringArgs(shipmentIDs)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks by shipments: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []*secondary.TaskRecord
+	for rows.Next() {
+		record := &secondary.TaskRecord{}
+		if err := rows.Scan(&record.ID, &record.ShipmentID, &record.Title, &record.Status); err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		tasks = append(tasks, record)
+	}
+	return tasks, rows.Err()
+}
+
+// ListNotesByContainers retrieves notes held by any of the given shipments or tomes in one query.
+func (r *SummaryRepository) ListNotesByContainers(ctx context.Context, shipmentIDs, tomeIDs []string) ([]*secondary.NoteRecord, error) {
+	var conditions []string
+	var args []any
+	if len(shipmentIDs) > 0 {
+		conditions = append(conditions, "shipment_id IN ("+inPlaceholders(len(shipmentIDs))+")")
+		args = append(args, stringArgs(shipmentIDs)...)
+	}
+	if len(tomeIDs) > 0 {
+		conditions = append(conditions, "tome_id IN ("+inPlaceholders(len(tomeIDs))+")")
+		args = append(args, stringArgs(tomeIDs)...)
+	}
+	if len(conditions) == 0 {
+		return nil, nil
+	}
+
+	query := "SELECT id, title, type, status, shipment_id, tome_id, pinned FROM notes WHERE " + strings.Join(conditions, " OR ") + " ORDER BY created_at DESC"
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notes by containers: %w", err)
+	}
+	defer rows.Close()
+
+	var notes []*secondary.NoteRecord
+	for rows.Next() {
+		var noteType, shipmentID, tomeID sql.NullString
+		record := &secondary.NoteRecord{}
+		if err := rows.Scan(&record.ID, &record.Title, &noteType, &record.Status, &shipmentID, &tomeID, &record.Pinned); err != nil {
+			return nil, fmt.Errorf("failed to scan note: %w", err)
+		}
+		record.Type = noteType.String
+		record.ShipmentID = shipmentID.String
+		record.TomeID = tomeID.String
+		notes = append(notes, record)
+	}
+	return notes, rows.Err()
+}
+
+// ListPlansByTasks retrieves plans for the given tasks in one query.
+func (r *SummaryRepository) ListPlansByTasks(ctx context.Context, taskIDs []string) ([]*secondary.PlanRecord, error) {
+	if len(taskIDs) == 0 {
+		return nil, nil
+	}
+
+	query := "SELECT id, task_id, status FROM plans WHERE task_id IN (" + inPlaceholders(len(taskIDs)) + ") ORDER BY created_at DESC"
+	rows, err := r.db.QueryContext(ctx, query, stringArgs(taskIDs)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans by tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var plans []*secondary.PlanRecord
+	for rows.Next() {
+		record := &secondary.PlanRecord{}
+		if err := rows.Scan(&record.ID, &record.TaskID, &record.Status); err != nil {
+			return nil, fmt.Errorf("failed to scan plan: %w", err)
+		}
+		plans = append(plans, record)
+	}
+	return plans, rows.Err()
+}
+
+// GetWorkbenchNames maps the given workbench IDs to their names in one query.
+func (r *SummaryRepository) GetWorkbenchNames(ctx context.Context, workbenchIDs []string) (map[string]string, error) {
+	names := make(map[string]string)
+	if len(workbenchIDs) == 0 {
+		return names, nil
+	}
+
+	query := "SELECT id, name FROM workbenches WHERE id IN (" + inPlaceholders(len(workbenchIDs)) + ")"
+	rows, err := r.db.QueryContext(ctx, query, stringArgs(workbenchIDs)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workbench names: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, name string
+		if err := rows.Scan(&id, &name); err != nil {
+			return nil, fmt.Errorf("failed to scan workbench: %w", err)
+		}
+		names[id] = name
+	}
+	return names, rows.Err()
+}
+
+// CountChecklistByTasks returns checklist progress for the given tasks in one query.
+func (r *SummaryRepository) CountChecklistByTasks(ctx context.Context, taskIDs []string) (map[string]secondary.ChecklistCount, error) {
+	counts := make(map[string]secondary.ChecklistCount)
+	if len(taskIDs) == 0 {
+		return counts, nil
+	}
+
+	query := "SELECT task_id, SUM(done), COUNT(*) FROM task_checklist_items WHERE task_id IN (" + inPlaceholders(len(taskIDs)) + ") GROUP BY task_id"
+	rows, err := r.db.QueryContext(ctx, query, stringArgs(taskIDs)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count checklist items: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var taskID string
+		var count secondary.ChecklistCount
+		if err := rows.Scan(&taskID, &count.Done, &count.Total); err != nil {
+			return nil, fmt.Errorf("failed to scan checklist count: %w", err)
+		}
+		counts[taskID] = count
+	}
+	return counts, rows.Err()
+}
+
+// GetAliasesByCommission maps entity IDs in a commission to their alias slugs.
+func (r *SummaryRepository) GetAliasesByCommission(ctx context.Context, commissionID string) (map[string]string, error) {
+	aliases := make(map[string]string)
+	rows, err := r.db.QueryContext(ctx, "SELECT entity_id, slug FROM entity_aliases WHERE commission_id = ?", commissionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get aliases: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entityID, slug string
+		if err := rows.Scan(&entityID, &slug); err != nil {
+			return nil, fmt.Errorf("failed to scan alias: %w", err)
+		}
+		aliases[entityID] = slug
+	}
+	return aliases, rows.Err()
+}
+
+// CountCommentsByEntities returns the number of comments on each of the given entities in one query.
+func (r *SummaryRepository) CountCommentsByEntities(ctx context.Context, entityIDs []string) (map[string]int, error) {
+	counts := make(map[string]int)
+	if len(entityIDs) == 0 {
+		return counts, nil
+	}
+
+	query := "SELECT entity_id, COUNT(*) FROM comments WHERE entity_id IN (" + inPlaceholders(len(entityIDs)) + ") GROUP BY entity_id"
+	rows, err := r.db.QueryContext(ctx, query, stringArgs(entityIDs)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count comments: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entityID string
+		var count int
+		if err := rows.Scan(&entityID, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan comment count: %w", err)
+		}
+		counts[entityID] = count
+	}
+	return counts, rows.Err()
+}
+
+// inPlaceholders returns "?, ?, ..." with n placeholders for an IN clause.
+func inPlaceholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// stringArgs converts string values to query arguments.
+func stringArgs(values []string) []any {
+	args := make([]any, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+	return args
+}
+
+// ListShipmentIDsByTaskTags returns the commission's shipments holding a task
+// tagged with any of the given tag names (compared case-insensitively).
+func (r *SummaryRepository) ListShipmentIDsByTaskTags(ctx context.Context, commissionID string, tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	query := `SELECT DISTINCT t.shipment_id FROM tasks t
+		INNER JOIN entity_tags et ON et.entity_id = t.id AND et.entity_type = 'task'
+		INNER JOIN tags g ON g.id = et.tag_id
+		WHERE t.commission_id = ? AND t.shipment_id IS NOT NULL
+		  AND LOWER(g.name) IN (` + inPlaceholders(len(tags)) + `)
+		ORDER BY t.shipment_id`
+	args := []any{commissionID}
+	for _, tag := range tags {
+		args = append(args, strings.ToLower(tag))
+	}
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shipments by task tags: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan shipment ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// Ensure SummaryRepository implements the interface
+var _ secondary.SummaryRepository = (*SummaryRepository)(nil)
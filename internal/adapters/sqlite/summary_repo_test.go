@@ -0,0 +1,227 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+)
+
+func TestSummaryRepository_ListTasksByShipments(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewSummaryRepository(db)
+	ctx := context.Background()
+
+	seedCommission(t, db, "COMM-001", "")
+	seedShipment(t, db, "SHIP-001", "COMM-001", "")
+	seedShipment(t, db, "SHIP-002", "COMM-001", "")
+	seedShipment(t, db, "SHIP-003", "COMM-001", "")
+	db.Exec("INSERT INTO tasks (id, commission_id, shipment_id, title, status) VALUES ('TASK-001', 'COMM-001', 'SHIP-001', 'One', 'open')")
+	db.Exec("INSERT INTO tasks (id, commission_id, shipment_id, title, status) VALUES ('TASK-002', 'COMM-001', 'SHIP-002', 'Two', 'closed')")
+	db.Exec("INSERT INTO tasks (id, commission_id, shipment_id, title, status) VALUES ('TASK-003', 'COMM-001', 'SHIP-003', 'Three', 'open')")
+
+	tasks, err := repo.ListTasksByShipments(ctx, []string{"SHIP-001", "SHIP-002"})
+	if err != nil {
+		t.Fatalf("ListTasksByShipments failed: %v", err)
+	}
+	if len(tasks) != 2 {
+		t.Fatalf("expected 2 tasks, got %d", len(tasks))
+	}
+	for _, task := range tasks {
+		if task.ID == "TASK-003" {
+			t.Error("TASK-003 belongs to a shipment that was not requested")
+		}
+		if task.ShipmentID == "" || task.Title == "" || task.Status == "" {
+			t.Errorf("expected summary fields populated, got %+v", task)
+		}
+	}
+
+	empty, err := repo.ListTasksByShipments(ctx, nil)
+	if err != nil || len(empty) != 0 {
+		t.Errorf("expected no tasks for empty input, got %d (err %v)", len(empty), err)
+	}
+}
+
+func TestSummaryRepository_ListNotesByContainers(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewSummaryRepository(db)
+	ctx := context.Background()
+
+	seedCommission(t, db, "COMM-001", "")
+	seedShipment(t, db, "SHIP-001", "COMM-001", "")
+	db.Exec("INSERT INTO tomes (id, commission_id, title, status) VALUES ('TOME-001', 'COMM-001', 'Tome', 'open')")
+	db.Exec("INSERT INTO notes (id, commission_id, title, type, status, shipment_id) VALUES ('NOTE-001', 'COMM-001', 'Ship note', 'idea', 'open', 'SHIP-001')")
+	db.Exec("INSERT INTO notes (id, commission_id, title, status, tome_id, pinned) VALUES ('NOTE-002', 'COMM-001', 'Tome note', 'open', 'TOME-001', 1)")
+	db.Exec("INSERT INTO notes (id, commission_id, title, status) VALUES ('NOTE-003', 'COMM-001', 'Loose note', 'open')")
+
+	notes, err := repo.ListNotesByContainers(ctx, []string{"SHIP-001"}, []string{"TOME-001"})
+	if err != nil {
+		t.Fatalf("ListNotesByContainers failed: %v", err)
+	}
+	if len(notes) != 2 {
+		t.Fatalf("expected 2 notes, got %d", len(notes))
+	}
+	byID := map[string]string{}
+	for _, n := range notes {
+		byID[n.ID] = n.ShipmentID + n.TomeID
+		if n.ID == "NOTE-001" && n.Type != "idea" {
+			t.Errorf("NOTE-001 Type = %q, want %q", n.Type, "idea")
+		}
+		if n.ID == "NOTE-002" && !n.Pinned {
+			t.Error("expected NOTE-002 to be pinned")
+		}
+	}
+	if byID["NOTE-001"] != "SHIP-001" || byID["NOTE-002"] != "TOME-001" {
+		t.Errorf("unexpected containers: %v", byID)
+	}
+
+	tomeOnly, err := repo.ListNotesByContainers(ctx, nil, []string{"TOME-001"})
+	if err != nil {
+		t.Fatalf("ListNotesByContainers failed: %v", err)
+	}
+	if len(tomeOnly) != 1 || tomeOnly[0].ID != "NOTE-002" {
+		t.Errorf("expected only NOTE-002, got %d notes", len(tomeOnly))
+	}
+}
+
+func TestSummaryRepository_ListPlansByTasks(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewSummaryRepository(db)
+	ctx := context.Background()
+
+	seedCommission(t, db, "COMM-001", "")
+	seedTask(t, db, "TASK-001", "COMM-001", "")
+	seedTask(t, db, "TASK-002", "COMM-001", "")
+	db.Exec("INSERT INTO plans (id, commission_id, task_id, title, status) VALUES ('PLAN-001', 'COMM-001', 'TASK-001', 'Plan', 'approved')")
+	db.Exec("INSERT INTO plans (id, commission_id, task_id, title, status) VALUES ('PLAN-002', 'COMM-001', 'TASK-002', 'Plan', 'draft')")
+
+	plans, err := repo.ListPlansByTasks(ctx, []string{"TASK-001"})
+	if err != nil {
+		t.Fatalf("ListPlansByTasks failed: %v", err)
+	}
+	if len(plans) != 1 {
+		t.Fatalf("expected 1 plan, got %d", len(plans))
+	}
+	if plans[0].ID != "PLAN-001" || plans[0].TaskID != "TASK-001" || plans[0].Status != "approved" {
+		t.Errorf("unexpected plan: %+v", plans[0])
+	}
+}
+
+func TestSummaryRepository_GetWorkbenchNames(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewSummaryRepository(db)
+	ctx := context.Background()
+
+	seedWorkbench(t, db, "BENCH-001", "", "alpha")
+	seedWorkbench(t, db, "BENCH-002", "", "beta")
+
+	names, err := repo.GetWorkbenchNames(ctx, []string{"BENCH-001", "BENCH-002", "BENCH-999"})
+	if err != nil {
+		t.Fatalf("GetWorkbenchNames failed: %v", err)
+	}
+	if len(names) != 2 || names["BENCH-001"] != "alpha" || names["BENCH-002"] != "beta" {
+		t.Errorf("unexpected names: %v", names)
+	}
+}
+
+func TestSummaryRepository_CountChecklistByTasks(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewSummaryRepository(db)
+	ctx := context.Background()
+
+	seedCommission(t, db, "COMM-001", "")
+	seedTask(t, db, "TASK-001", "COMM-001", "")
+	seedTask(t, db, "TASK-002", "COMM-001", "")
+	db.Exec("INSERT INTO task_checklist_items (task_id, text, done) VALUES ('TASK-001', 'one', 1)")
+	db.Exec("INSERT INTO task_checklist_items (task_id, text, done) VALUES ('TASK-001', 'two', 0)")
+	db.Exec("INSERT INTO task_checklist_items (task_id, text, done) VALUES ('TASK-001', 'three', 1)")
+
+	counts, err := repo.CountChecklistByTasks(ctx, []string{"TASK-001", "TASK-002"})
+	if err != nil {
+		t.Fatalf("CountChecklistByTasks failed: %v", err)
+	}
+	if got := counts["TASK-001"]; got.Done != 2 || got.Total != 3 {
+		t.Errorf("TASK-001 checklist = %d/%d, want 2/3", got.Done, got.Total)
+	}
+	if _, ok := counts["TASK-002"]; ok {
+		t.Error("expected TASK-002 without checklist to be absent")
+	}
+}
+
+func TestSummaryRepository_GetAliasesByCommission(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewSummaryRepository(db)
+	ctx := context.Background()
+
+	seedCommission(t, db, "COMM-001", "")
+	seedCommission(t, db, "COMM-002", "")
+	db.Exec("INSERT INTO entity_aliases (entity_id, entity_type, commission_id, slug) VALUES ('SHIP-001', 'shipment', 'COMM-001', 'auth-refactor')")
+	db.Exec("INSERT INTO entity_aliases (entity_id, entity_type, commission_id, slug) VALUES ('SHIP-002', 'shipment', 'COMM-002', 'other')")
+
+	aliases, err := repo.GetAliasesByCommission(ctx, "COMM-001")
+	if err != nil {
+		t.Fatalf("GetAliasesByCommission failed: %v", err)
+	}
+	if len(aliases) != 1 || aliases["SHIP-001"] != "auth-refactor" {
+		t.Errorf("unexpected aliases: %v", aliases)
+	}
+}
+
+func TestSummaryRepository_CountCommentsByEntities(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewSummaryRepository(db)
+	ctx := context.Background()
+
+	db.Exec("INSERT INTO comments (id, entity_id, entity_type, body) VALUES ('CMT-001', 'TASK-001', 'task', 'one')")
+	db.Exec("INSERT INTO comments (id, entity_id, entity_type, body) VALUES ('CMT-002', 'TASK-001', 'task', 'two')")
+	db.Exec("INSERT INTO comments (id, entity_id, entity_type, body) VALUES ('CMT-003', 'SHIP-001', 'shipment', 'three')")
+
+	counts, err := repo.CountCommentsByEntities(ctx, []string{"TASK-001", "SHIP-001", "TOME-001"})
+	if err != nil {
+		t.Fatalf("CountCommentsByEntities failed: %v", err)
+	}
+	if counts["TASK-001"] != 2 || counts["SHIP-001"] != 1 {
+		t.Errorf("unexpected counts: %v", counts)
+	}
+	if _, ok := counts["TOME-001"]; ok {
+		t.Error("expected TOME-001 without comments to be absent")
+	}
+}
+
+func TestSummaryRepository_ListShipmentIDsByTaskTags(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewSummaryRepository(db)
+	ctx := context.Background()
+
+	seedCommission(t, db, "COMM-001", "")
+	seedShipment(t, db, "SHIP-001", "COMM-001", "")
+	seedShipment(t, db, "SHIP-002", "COMM-001", "")
+	seedShipment(t, db, "SHIP-003", "COMM-001", "")
+	seedTag(t, db, "TAG-001", "Infra")
+	seedTag(t, db, "TAG-002", "docs")
+	db.Exec("INSERT INTO tasks (id, commission_id, shipment_id, title, status) VALUES ('TASK-001', 'COMM-001', 'SHIP-001', 'One', 'open')")
+	db.Exec("INSERT INTO tasks (id, commission_id, shipment_id, title, status) VALUES ('TASK-002', 'COMM-001', 'SHIP-001', 'Two', 'open')")
+	db.Exec("INSERT INTO tasks (id, commission_id, shipment_id, title, status) VALUES ('TASK-003', 'COMM-001', 'SHIP-002', 'Three', 'open')")
+	db.Exec("INSERT INTO tasks (id, commission_id, shipment_id, title, status) VALUES ('TASK-004', 'COMM-001', 'SHIP-003', 'Four', 'open')")
+	db.Exec("INSERT INTO entity_tags (id, entity_id, entity_type, tag_id) VALUES ('ET-001', 'TASK-001', 'task', 'TAG-001')")
+	db.Exec("INSERT INTO entity_tags (id, entity_id, entity_type, tag_id) VALUES ('ET-002', 'TASK-002', 'task', 'TAG-001')")
+	db.Exec("INSERT INTO entity_tags (id, entity_id, entity_type, tag_id) VALUES ('ET-003', 'TASK-003', 'task', 'TAG-002')")
+
+	ids, err := repo.ListShipmentIDsByTaskTags(ctx, "COMM-001", []string{"infra"})
+	if err != nil {
+		t.Fatalf("ListShipmentIDsByTaskTags failed: %v", err)
+	}
+	if len(ids) != 1 || ids[0] != "SHIP-001" {
+		t.Errorf("expected [SHIP-001], got %v", ids)
+	}
+
+	ids, _ = repo.ListShipmentIDsByTaskTags(ctx, "COMM-001", []string{"infra", "docs"})
+	if len(ids) != 2 {
+		t.Errorf("expected two shipments, got %v", ids)
+	}
+
+	ids, err = repo.ListShipmentIDsByTaskTags(ctx, "COMM-001", nil)
+	if err != nil || len(ids) != 0 {
+		t.Errorf("expected nothing for no tags, got %v (%v)", ids, err)
+	}
+}
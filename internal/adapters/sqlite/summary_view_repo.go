@@ -0,0 +1,151 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// SummaryViewRepository implements secondary.SummaryViewRepository with SQLite.
+type SummaryViewRepository struct {
+	db *sql.DB
+}
+
+// NewSummaryViewRepository creates a new SQLite summary view repository.
+func NewSummaryViewRepository(db *sql.DB) *SummaryViewRepository {
+	return &SummaryViewRepository{db: db}
+}
+
+const summaryViewColumns = "v.actor_id, v.name, v.containers, v.statuses, v.tags, v.created_at, v.updated_at"
+
+// Save creates or replaces a view. An upsert rather than INSERT OR REPLACE,
+// so workbench defaults naming the view survive.
+func (r *SummaryViewRepository) Save(ctx context.Context, view *secondary.SummaryViewRecord) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO summary_views (actor_id, name, containers, statuses, tags) VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (actor_id, name) DO UPDATE SET
+			containers = excluded.containers,
+			statuses = excluded.statuses,
+			tags = excluded.tags,
+			updated_at = CURRENT_TIMESTAMP`,
+		view.ActorID, view.Name,
+		sql.NullString{String: view.Containers, Valid: view.Containers != ""},
+		sql.NullString{String: view.Statuses, Valid: view.Statuses != ""},
+		sql.NullString{String: view.Tags, Valid: view.Tags != ""},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save summary view: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves an actor's view by name.
+func (r *SummaryViewRepository) Get(ctx context.Context, actorID, name string) (*secondary.SummaryViewRecord, error) {
+	row := r.db.QueryRowContext(ctx,
+		"SELECT "+summaryViewColumns+" FROM summary_views v WHERE v.actor_id = ? AND v.name = ?",
+		actorID, name,
+	)
+	view, err := scanSummaryView(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("summary view %s not found", name)
+	}
+	return view, err
+}
+
+// List retrieves an actor's views, by name.
+func (r *SummaryViewRepository) List(ctx context.Context, actorID string) ([]*secondary.SummaryViewRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT "+summaryViewColumns+" FROM summary_views v WHERE v.actor_id = ? ORDER BY v.name",
+		actorID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list summary views: %w", err)
+	}
+	defer rows.Close()
+
+	var views []*secondary.SummaryViewRecord
+	for rows.Next() {
+		view, err := scanSummaryView(rows)
+		if err != nil {
+			return nil, err
+		}
+		views = append(views, view)
+	}
+	return views, rows.Err()
+}
+
+// Delete removes a view and any workbench defaults naming it.
+func (r *SummaryViewRepository) Delete(ctx context.Context, actorID, name string) error {
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM summary_view_defaults WHERE actor_id = ? AND view_name = ?", actorID, name); err != nil {
+		return fmt.Errorf("failed to clear default summary views: %w", err)
+	}
+	result, err := r.db.ExecContext(ctx, "DELETE FROM summary_views WHERE actor_id = ? AND name = ?", actorID, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete summary view: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return fmt.Errorf("summary view %s not found", name)
+	}
+	return nil
+}
+
+// SetDefault makes a view the workbench's default, replacing any other.
+func (r *SummaryViewRepository) SetDefault(ctx context.Context, workbenchID, actorID, name string) error {
+	_, err := r.db.ExecContext(ctx,
+		"INSERT OR REPLACE INTO summary_view_defaults (workbench_id, actor_id, view_name) VALUES (?, ?, ?)",
+		workbenchID, actorID, name,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set default summary view: %w", err)
+	}
+	return nil
+}
+
+// ClearDefault removes the workbench's default view.
+func (r *SummaryViewRepository) ClearDefault(ctx context.Context, workbenchID string) error {
+	if _, err := r.db.ExecContext(ctx, "DELETE FROM summary_view_defaults WHERE workbench_id = ?", workbenchID); err != nil {
+		return fmt.Errorf("failed to clear default summary view: %w", err)
+	}
+	return nil
+}
+
+// GetDefault retrieves the workbench's default view, or nil if it has none.
+func (r *SummaryViewRepository) GetDefault(ctx context.Context, workbenchID string) (*secondary.SummaryViewRecord, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT `+summaryViewColumns+` FROM summary_view_defaults d
+		INNER JOIN summary_views v ON v.actor_id = d.actor_id AND v.name = d.view_name
+		WHERE d.workbench_id = ?`,
+		workbenchID,
+	)
+	view, err := scanSummaryView(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return view, err
+}
+
+func scanSummaryView(row interface{ Scan(...any) error }) (*secondary.SummaryViewRecord, error) {
+	var (
+		view                       secondary.SummaryViewRecord
+		containers, statuses, tags sql.NullString
+		createdAt, updatedAt       time.Time
+	)
+	if err := row.Scan(&view.ActorID, &view.Name, &containers, &statuses, &tags, &createdAt, &updatedAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to scan summary view: %w", err)
+	}
+	view.Containers = containers.String
+	view.Statuses = statuses.String
+	view.Tags = tags.String
+	view.CreatedAt = createdAt.Format(time.RFC3339)
+	view.UpdatedAt = updatedAt.Format(time.RFC3339)
+	return &view, nil
+}
+
+// Ensure SummaryViewRepository implements the interface
+var _ secondary.SummaryViewRepository = (*SummaryViewRepository)(nil)
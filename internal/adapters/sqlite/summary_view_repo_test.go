@@ -0,0 +1,101 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestSummaryViewRepository_SaveGetList(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewSummaryViewRepository(db)
+	ctx := context.Background()
+
+	if err := repo.Save(ctx, &secondary.SummaryViewRecord{ActorID: "GOBLIN", Name: "standup", Containers: "SHIP", Statuses: "ready,in-progress"}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if err := repo.Save(ctx, &secondary.SummaryViewRecord{ActorID: "GOBLIN", Name: "infra", Tags: "infra"}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if err := repo.Save(ctx, &secondary.SummaryViewRecord{ActorID: "IMP-BENCH-001", Name: "standup", Containers: "TOME"}); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	view, err := repo.Get(ctx, "GOBLIN", "standup")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if view.Containers != "SHIP" || view.Statuses != "ready,in-progress" || view.Tags != "" || view.UpdatedAt == "" {
+		t.Errorf("unexpected view: %+v", view)
+	}
+
+	// Saving again replaces the filter
+	if err := repo.Save(ctx, &secondary.SummaryViewRecord{ActorID: "GOBLIN", Name: "standup", Statuses: "draft"}); err != nil {
+		t.Fatalf("re-Save failed: %v", err)
+	}
+	view, _ = repo.Get(ctx, "GOBLIN", "standup")
+	if view.Containers != "" || view.Statuses != "draft" {
+		t.Errorf("expected replaced view, got %+v", view)
+	}
+
+	views, err := repo.List(ctx, "GOBLIN")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(views) != 2 || views[0].Name != "infra" || views[1].Name != "standup" {
+		t.Errorf("expected GOBLIN's two views by name, got %+v", views)
+	}
+
+	if _, err := repo.Get(ctx, "GOBLIN", "missing"); err == nil {
+		t.Error("expected error for a missing view")
+	}
+}
+
+func TestSummaryViewRepository_Defaults(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewSummaryViewRepository(db)
+	ctx := context.Background()
+
+	seedWorkbench(t, db, "BENCH-001", "", "bench-one")
+	_ = repo.Save(ctx, &secondary.SummaryViewRecord{ActorID: "GOBLIN", Name: "standup", Containers: "SHIP"})
+
+	view, err := repo.GetDefault(ctx, "BENCH-001")
+	if err != nil || view != nil {
+		t.Fatalf("expected no default, got %+v (%v)", view, err)
+	}
+
+	if err := repo.SetDefault(ctx, "BENCH-001", "GOBLIN", "standup"); err != nil {
+		t.Fatalf("SetDefault failed: %v", err)
+	}
+	view, err = repo.GetDefault(ctx, "BENCH-001")
+	if err != nil || view == nil || view.Name != "standup" || view.Containers != "SHIP" {
+		t.Fatalf("unexpected default: %+v (%v)", view, err)
+	}
+
+	// Re-saving the view keeps it the default
+	_ = repo.Save(ctx, &secondary.SummaryViewRecord{ActorID: "GOBLIN", Name: "standup", Containers: "TOME"})
+	if view, _ = repo.GetDefault(ctx, "BENCH-001"); view == nil || view.Containers != "TOME" {
+		t.Errorf("expected the updated view as default, got %+v", view)
+	}
+
+	if err := repo.ClearDefault(ctx, "BENCH-001"); err != nil {
+		t.Fatalf("ClearDefault failed: %v", err)
+	}
+	if view, _ = repo.GetDefault(ctx, "BENCH-001"); view != nil {
+		t.Errorf("expected default cleared, got %+v", view)
+	}
+
+	// Deleting a view drops defaults naming it
+	_ = repo.SetDefault(ctx, "BENCH-001", "GOBLIN", "standup")
+	if err := repo.Delete(ctx, "GOBLIN", "standup"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if view, _ = repo.GetDefault(ctx, "BENCH-001"); view != nil {
+		t.Errorf("expected no default after delete, got %+v", view)
+	}
+	if err := repo.Delete(ctx, "GOBLIN", "standup"); err == nil {
+		t.Error("expected error deleting a missing view")
+	}
+}
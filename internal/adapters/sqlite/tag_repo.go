@@ -174,5 +174,117 @@ func (r *TagRepository) GetEntityTag(ctx context.Context, entityID, entityType s
 	return r.GetByID(ctx, tagID)
 }
 
+// Rename changes a tag's name.
+func (r *TagRepository) Rename(ctx context.Context, id, name string) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE tags SET name = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		name, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to rename tag: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("tag %s not found", id)
+	}
+
+	return nil
+}
+
+// Merge re-points the source tag's entities (and its route, if the target
+// has none) to the target, then deletes the source, in one transaction.
+// Entities that already carry the target keep their single row.
+func (r *TagRepository) Merge(ctx context.Context, sourceID, targetID string) (*secondary.TagMergeRecord, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	record := &secondary.TagMergeRecord{}
+
+	moved, err := tx.ExecContext(ctx, `
+		UPDATE entity_tags SET tag_id = ?
+		WHERE tag_id = ? AND NOT EXISTS (
+			SELECT 1 FROM entity_tags et
+			WHERE et.entity_id = entity_tags.entity_id
+			  AND et.entity_type = entity_tags.entity_type
+			  AND et.tag_id = ?)`,
+		targetID, sourceID, targetID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-point entity tags: %w", err)
+	}
+	n, _ := moved.RowsAffected()
+	record.Moved = int(n)
+
+	duplicates, err := tx.ExecContext(ctx, "DELETE FROM entity_tags WHERE tag_id = ?", sourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to drop duplicate entity tags: %w", err)
+	}
+	n, _ = duplicates.RowsAffected()
+	record.Duplicates = int(n)
+
+	route, err := tx.ExecContext(ctx, `
+		UPDATE tag_routes SET tag_id = ?
+		WHERE tag_id = ? AND NOT EXISTS (SELECT 1 FROM tag_routes WHERE tag_id = ?)`,
+		targetID, sourceID, targetID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to move tag route: %w", err)
+	}
+	n, _ = route.RowsAffected()
+	record.RouteMoved = n > 0
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM tag_routes WHERE tag_id = ?", sourceID); err != nil {
+		return nil, fmt.Errorf("failed to drop tag route: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM tags WHERE id = ?", sourceID); err != nil {
+		return nil, fmt.Errorf("failed to delete merged tag: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit tag merge: %w", err)
+	}
+	return record, nil
+}
+
+// Usage counts each tag's uses per entity type, ordered by name.
+func (r *TagRepository) Usage(ctx context.Context) ([]*secondary.TagUsageRecord, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT t.id, t.name, et.entity_type, COUNT(et.id)
+		FROM tags t
+		LEFT JOIN entity_tags et ON et.tag_id = t.id
+		GROUP BY t.id, et.entity_type
+		ORDER BY t.name ASC, et.entity_type ASC`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count tag usage: %w", err)
+	}
+	defer rows.Close()
+
+	var usage []*secondary.TagUsageRecord
+	for rows.Next() {
+		var (
+			id, name   string
+			entityType sql.NullString
+			count      int
+		)
+		if err := rows.Scan(&id, &name, &entityType, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan tag usage: %w", err)
+		}
+
+		if len(usage) == 0 || usage[len(usage)-1].TagID != id {
+			usage = append(usage, &secondary.TagUsageRecord{TagID: id, Name: name, Counts: map[string]int{}})
+		}
+		if entityType.Valid {
+			usage[len(usage)-1].Counts[entityType.String] = count
+		}
+	}
+
+	return usage, rows.Err()
+}
+
 // Ensure TagRepository implements the interface.
 var _ secondary.TagRepository = (*TagRepository)(nil)
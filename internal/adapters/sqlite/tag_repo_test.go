@@ -277,3 +277,90 @@ func TestTagRepository_GetEntityTag_WrongType(t *testing.T) {
 		t.Error("expected no entity tag for wrong type")
 	}
 }
+
+func TestTagRepository_Rename(t *testing.T) {
+	db := setupTagTestDB(t)
+	repo := sqlite.NewTagRepository(db)
+	ctx := context.Background()
+
+	tag := createTestTag(t, repo, ctx, "testing", "")
+	createTestTag(t, repo, ctx, "tests", "")
+
+	if err := repo.Rename(ctx, tag.ID, "qa"); err != nil {
+		t.Fatalf("Rename failed: %v", err)
+	}
+	if got, err := repo.GetByName(ctx, "qa"); err != nil || got.ID != tag.ID {
+		t.Errorf("expected %s renamed to qa, got %+v (%v)", tag.ID, got, err)
+	}
+	if err := repo.Rename(ctx, tag.ID, "tests"); err == nil {
+		t.Error("expected renaming onto an existing name to fail")
+	}
+	if err := repo.Rename(ctx, "TAG-999", "x"); err == nil {
+		t.Error("expected renaming a missing tag to fail")
+	}
+}
+
+func TestTagRepository_Merge(t *testing.T) {
+	db := setupTagTestDB(t)
+	repo := sqlite.NewTagRepository(db)
+	ctx := context.Background()
+
+	seedWorkbench(t, db, "BENCH-001", "", "bench-one")
+	source := createTestTag(t, repo, ctx, "testing", "")
+	target := createTestTag(t, repo, ctx, "tests", "")
+	_, _ = db.Exec(`INSERT INTO entity_tags (id, entity_id, entity_type, tag_id) VALUES
+		('ET-001', 'TASK-001', 'task', ?),
+		('ET-002', 'TASK-002', 'task', ?),
+		('ET-003', 'TASK-002', 'task', ?),
+		('ET-004', 'NOTE-001', 'note', ?)`, source.ID, source.ID, target.ID, source.ID)
+	_, _ = db.Exec("INSERT INTO tag_routes (tag_id, workbench_id) VALUES (?, 'BENCH-001')", source.ID)
+
+	result, err := repo.Merge(ctx, source.ID, target.ID)
+	if err != nil {
+		t.Fatalf("Merge failed: %v", err)
+	}
+	if result.Moved != 2 || result.Duplicates != 1 || !result.RouteMoved {
+		t.Errorf("unexpected merge result: %+v", result)
+	}
+
+	if _, err := repo.GetByID(ctx, source.ID); err == nil {
+		t.Error("expected source tag deleted")
+	}
+	var count int
+	_ = db.QueryRow("SELECT COUNT(*) FROM entity_tags WHERE tag_id = ?", target.ID).Scan(&count)
+	if count != 3 {
+		t.Errorf("expected 3 entity tags on target, got %d", count)
+	}
+	var routedTag string
+	_ = db.QueryRow("SELECT tag_id FROM tag_routes WHERE workbench_id = 'BENCH-001'").Scan(&routedTag)
+	if routedTag != target.ID {
+		t.Errorf("expected route moved to %s, got %q", target.ID, routedTag)
+	}
+}
+
+func TestTagRepository_Usage(t *testing.T) {
+	db := setupTagTestDB(t)
+	repo := sqlite.NewTagRepository(db)
+	ctx := context.Background()
+
+	tests := createTestTag(t, repo, ctx, "tests", "")
+	createTestTag(t, repo, ctx, "unused", "")
+	_, _ = db.Exec(`INSERT INTO entity_tags (id, entity_id, entity_type, tag_id) VALUES
+		('ET-001', 'TASK-001', 'task', ?),
+		('ET-002', 'TASK-002', 'task', ?),
+		('ET-003', 'NOTE-001', 'note', ?)`, tests.ID, tests.ID, tests.ID)
+
+	usage, err := repo.Usage(ctx)
+	if err != nil {
+		t.Fatalf("Usage failed: %v", err)
+	}
+	if len(usage) != 2 {
+		t.Fatalf("expected 2 tags, got %d", len(usage))
+	}
+	if usage[0].Name != "tests" || usage[0].Counts["task"] != 2 || usage[0].Counts["note"] != 1 {
+		t.Errorf("unexpected usage for tests: %+v", usage[0])
+	}
+	if usage[1].Name != "unused" || len(usage[1].Counts) != 0 {
+		t.Errorf("expected unused tag with no counts, got %+v", usage[1])
+	}
+}
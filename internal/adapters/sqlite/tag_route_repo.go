@@ -0,0 +1,109 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// TagRouteRepository implements secondary.TagRouteRepository with SQLite.
+type TagRouteRepository struct {
+	db *sql.DB
+}
+
+// NewTagRouteRepository creates a new SQLite tag route repository.
+func NewTagRouteRepository(db *sql.DB) *TagRouteRepository {
+	return &TagRouteRepository{db: db}
+}
+
+// Set routes a tag to a workbench, replacing any existing route for the tag.
+func (r *TagRouteRepository) Set(ctx context.Context, route *secondary.TagRouteRecord) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO tag_routes (tag_id, workbench_id, mode) VALUES (?, ?, ?)
+		ON CONFLICT(tag_id) DO UPDATE SET workbench_id = excluded.workbench_id, mode = excluded.mode`,
+		route.TagID, route.WorkbenchID, route.Mode,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set tag route: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a tag's route.
+func (r *TagRouteRepository) Delete(ctx context.Context, tagID string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM tag_routes WHERE tag_id = ?", tagID)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag route: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("tag %s has no route", tagID)
+	}
+	return nil
+}
+
+// GetByTag retrieves a tag's route (nil if none).
+func (r *TagRouteRepository) GetByTag(ctx context.Context, tagID string) (*secondary.TagRouteRecord, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT tr.tag_id, t.name, tr.workbench_id, tr.mode, tr.created_at
+		FROM tag_routes tr JOIN tags t ON t.id = tr.tag_id
+		WHERE tr.tag_id = ?`,
+		tagID,
+	)
+	record, err := scanTagRoute(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tag route: %w", err)
+	}
+	return record, nil
+}
+
+// List retrieves routes ordered by tag name, optionally filtered by workbench.
+func (r *TagRouteRepository) List(ctx context.Context, workbenchID string) ([]*secondary.TagRouteRecord, error) {
+	query := `SELECT tr.tag_id, t.name, tr.workbench_id, tr.mode, tr.created_at
+		FROM tag_routes tr JOIN tags t ON t.id = tr.tag_id`
+	var args []any
+	if workbenchID != "" {
+		query += " WHERE tr.workbench_id = ?"
+		args = append(args, workbenchID)
+	}
+	query += " ORDER BY t.name"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tag routes: %w", err)
+	}
+	defer rows.Close()
+
+	var routes []*secondary.TagRouteRecord
+	for rows.Next() {
+		record, err := scanTagRoute(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan tag route: %w", err)
+		}
+		routes = append(routes, record)
+	}
+	return routes, rows.Err()
+}
+
+// scanTagRoute scans a tag route row into a TagRouteRecord.
+func scanTagRoute(scanner interface {
+	Scan(dest ...any) error
+}) (*secondary.TagRouteRecord, error) {
+	var createdAt time.Time
+	record := &secondary.TagRouteRecord{}
+	if err := scanner.Scan(&record.TagID, &record.TagName, &record.WorkbenchID, &record.Mode, &createdAt); err != nil {
+		return nil, err
+	}
+	record.CreatedAt = createdAt.Format(time.RFC3339)
+	return record, nil
+}
+
+// Ensure TagRouteRepository implements the interface
+var _ secondary.TagRouteRepository = (*TagRouteRepository)(nil)
@@ -0,0 +1,64 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestTagRouteRepository_SetGetListDelete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewTagRouteRepository(db)
+	ctx := context.Background()
+
+	seedWorkbench(t, db, "BENCH-001", "", "bench-one")
+	seedWorkbench(t, db, "BENCH-002", "", "bench-two")
+	seedTag(t, db, "TAG-001", "database-schema")
+	seedTag(t, db, "TAG-002", "api")
+
+	if err := repo.Set(ctx, &secondary.TagRouteRecord{TagID: "TAG-001", WorkbenchID: "BENCH-001", Mode: "suggest"}); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+	if err := repo.Set(ctx, &secondary.TagRouteRecord{TagID: "TAG-002", WorkbenchID: "BENCH-002", Mode: "assign"}); err != nil {
+		t.Fatalf("Set failed: %v", err)
+	}
+
+	// Re-routing a tag replaces its route
+	if err := repo.Set(ctx, &secondary.TagRouteRecord{TagID: "TAG-001", WorkbenchID: "BENCH-002", Mode: "assign"}); err != nil {
+		t.Fatalf("Set (replace) failed: %v", err)
+	}
+
+	route, err := repo.GetByTag(ctx, "TAG-001")
+	if err != nil {
+		t.Fatalf("GetByTag failed: %v", err)
+	}
+	if route == nil || route.TagName != "database-schema" || route.WorkbenchID != "BENCH-002" || route.Mode != "assign" {
+		t.Errorf("unexpected route: %+v", route)
+	}
+
+	missing, err := repo.GetByTag(ctx, "TAG-999")
+	if err != nil || missing != nil {
+		t.Errorf("expected nil for unrouted tag, got %+v, %v", missing, err)
+	}
+
+	routes, err := repo.List(ctx, "BENCH-002")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(routes) != 2 || routes[0].TagName != "api" || routes[1].TagName != "database-schema" {
+		t.Errorf("expected routes ordered by tag name, got %+v", routes)
+	}
+
+	if routes, _ := repo.List(ctx, "BENCH-001"); len(routes) != 0 {
+		t.Errorf("expected no routes for BENCH-001, got %d", len(routes))
+	}
+
+	if err := repo.Delete(ctx, "TAG-001"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := repo.Delete(ctx, "TAG-001"); err == nil {
+		t.Error("expected error deleting missing route")
+	}
+}
@@ -22,10 +22,11 @@ func NewTaskRepository(db *sql.DB, logWriter secondary.LogWriter) *TaskRepositor
 	return &TaskRepository{db: db, logWriter: logWriter}
 }
 
-// scanTask scans a task row into a TaskRecord.
+// scanTask scans a task row into a TaskRecord. Extra destinations receive
+// any columns selected after taskSelectCols.
 func scanTask(scanner interface {
 	Scan(dest ...any) error
-}) (*secondary.TaskRecord, error) {
+}, extra ...any) (*secondary.TaskRecord, error) {
 	var (
 		shipmentID          sql.NullString
 		tomeID              sql.NullString
@@ -35,18 +36,22 @@ func scanTask(scanner interface {
 		assignedWorkbenchID sql.NullString
 		pinned              bool
 		dependsOn           sql.NullString
+		points              sql.NullInt64
+		dueDate             sql.NullTime
 		createdAt           time.Time
 		updatedAt           time.Time
 		claimedAt           sql.NullTime
+		claimRefreshedAt    sql.NullTime
 		completedAt         sql.NullTime
 	)
 
 	record := &secondary.TaskRecord{}
-	err := scanner.Scan(
+	dest := []any{
 		&record.ID, &shipmentID, &record.CommissionID, &tomeID, &record.Title, &desc,
 		&taskType, &record.Status, &priority, &assignedWorkbenchID,
-		&pinned, &dependsOn, &createdAt, &updatedAt, &claimedAt, &completedAt,
-	)
+		&pinned, &dependsOn, &createdAt, &updatedAt, &claimedAt, &completedAt, &points, &claimRefreshedAt, &dueDate,
+	}
+	err := scanner.Scan(append(dest, extra...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -59,20 +64,30 @@ func scanTask(scanner interface {
 	record.AssignedWorkbenchID = assignedWorkbenchID.String
 	record.Pinned = pinned
 	record.DependsOn = dependsOn.String
+	record.Points = int(points.Int64)
 	record.CreatedAt = createdAt.Format(time.RFC3339)
 	record.UpdatedAt = updatedAt.Format(time.RFC3339)
 
 	if claimedAt.Valid {
 		record.ClaimedAt = claimedAt.Time.Format(time.RFC3339)
 	}
+	if claimRefreshedAt.Valid {
+		record.ClaimRefreshedAt = claimRefreshedAt.Time.Format(time.RFC3339)
+	}
 	if completedAt.Valid {
 		record.CompletedAt = completedAt.Time.Format(time.RFC3339)
 	}
+	if dueDate.Valid {
+		record.DueDate = dueDate.Time.Format("2006-01-02")
+	}
 
 	return record, nil
 }
 
-const taskSelectCols = "id, shipment_id, commission_id, tome_id, title, description, type, status, priority, assigned_workbench_id, pinned, depends_on, created_at, updated_at, claimed_at, completed_at"
+const taskSelectCols = "id, shipment_id, commission_id, tome_id, title, description, type, status, priority, assigned_workbench_id, pinned, depends_on, created_at, updated_at, claimed_at, completed_at, points, claim_refreshed_at, due_date"
+
+// taskListCols adds the task_list_view read-model columns to taskSelectCols.
+const taskListCols = taskSelectCols + ", tag_name, checklist_done, checklist_total, comment_count"
 
 // Create persists a new task.
 func (r *TaskRepository) Create(ctx context.Context, task *secondary.TaskRecord) error {
@@ -91,14 +106,32 @@ func (r *TaskRepository) Create(ctx context.Context, task *secondary.TaskRecord)
 		dependsOn = sql.NullString{String: task.DependsOn, Valid: true}
 	}
 
+	var assignedWorkbenchID sql.NullString
+	if task.AssignedWorkbenchID != "" {
+		assignedWorkbenchID = sql.NullString{String: task.AssignedWorkbenchID, Valid: true}
+	}
+
+	var points sql.NullInt64
+	if task.Points > 0 {
+		points = sql.NullInt64{Int64: int64(task.Points), Valid: true}
+	}
+
+	var priority, dueDate sql.NullString
+	if task.Priority != "" {
+		priority = sql.NullString{String: task.Priority, Valid: true}
+	}
+	if task.DueDate != "" {
+		dueDate = sql.NullString{String: task.DueDate, Valid: true}
+	}
+
 	status := task.Status
 	if status == "" {
 		status = "open"
 	}
 
 	_, err := r.db.ExecContext(ctx,
-		"INSERT INTO tasks (id, shipment_id, commission_id, title, description, type, status, depends_on) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
-		task.ID, shipmentID, task.CommissionID, task.Title, desc, taskType, status, dependsOn,
+		"INSERT INTO tasks (id, shipment_id, commission_id, title, description, type, status, depends_on, assigned_workbench_id, points, priority, due_date) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		task.ID, shipmentID, task.CommissionID, task.Title, desc, taskType, status, dependsOn, assignedWorkbenchID, points, priority, dueDate,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create task: %w", err)
@@ -132,7 +165,7 @@ func (r *TaskRepository) GetByID(ctx context.Context, id string) (*secondary.Tas
 
 // List retrieves tasks matching the given filters.
 func (r *TaskRepository) List(ctx context.Context, filters secondary.TaskFilters) ([]*secondary.TaskRecord, error) {
-	query := "SELECT " + taskSelectCols + " FROM tasks WHERE 1=1"
+	query := "SELECT " + taskListCols + " FROM task_list_view WHERE 1=1"
 	args := []any{}
 
 	if filters.ShipmentID != "" {
@@ -160,10 +193,20 @@ func (r *TaskRepository) List(ctx context.Context, filters secondary.TaskFilters
 
 	var tasks []*secondary.TaskRecord
 	for rows.Next() {
-		record, err := scanTask(rows)
+		var (
+			tagName        sql.NullString
+			checklistDone  int
+			checklistTotal int
+			commentCount   int
+		)
+		record, err := scanTask(rows, &tagName, &checklistDone, &checklistTotal, &commentCount)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan task: %w", err)
 		}
+		record.TagName = tagName.String
+		record.ChecklistDone = checklistDone
+		record.ChecklistTotal = checklistTotal
+		record.CommentCount = commentCount
 		tasks = append(tasks, record)
 	}
 
@@ -185,6 +228,11 @@ func (r *TaskRepository) Update(ctx context.Context, task *secondary.TaskRecord)
 		args = append(args, sql.NullString{String: task.Description, Valid: true})
 	}
 
+	if task.Points > 0 {
+		query += ", points = ?"
+		args = append(args, task.Points)
+	}
+
 	// Container move: when moving to a new container, clear the other container ID
 	// to maintain mutual exclusivity (a task can only belong to one container)
 	if task.ShipmentID != "" {
@@ -361,6 +409,38 @@ func (r *TaskRepository) UpdateStatus(ctx context.Context, id, status string, se
 	return nil
 }
 
+// RevertStatus sets the status back for an undo, optionally clearing the
+// claim and completion timestamps the undone change set.
+func (r *TaskRepository) RevertStatus(ctx context.Context, id, status string, clearClaim, clearCompleted bool) error {
+	var oldStatus string
+	if r.logWriter != nil {
+		_ = r.db.QueryRowContext(ctx, "SELECT status FROM tasks WHERE id = ?", id).Scan(&oldStatus)
+	}
+
+	query := "UPDATE tasks SET status = ?, updated_at = CURRENT_TIMESTAMP"
+	if clearClaim {
+		query += ", claimed_at = NULL, claim_refreshed_at = NULL"
+	}
+	if clearCompleted {
+		query += ", completed_at = NULL"
+	}
+	query += " WHERE id = ?"
+
+	result, err := r.db.ExecContext(ctx, query, status, id)
+	if err != nil {
+		return fmt.Errorf("failed to revert task status: %w", err)
+	}
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("task %s not found", id)
+	}
+
+	if r.logWriter != nil && oldStatus != status {
+		_ = r.logWriter.LogUpdate(ctx, "task", id, "status", oldStatus, status)
+	}
+	return nil
+}
+
 // Claim claims a task for a workbench.
 func (r *TaskRepository) Claim(ctx context.Context, id, workbenchID string) error {
 	var workbenchIDNullable sql.NullString
@@ -384,6 +464,19 @@ func (r *TaskRepository) Claim(ctx context.Context, id, workbenchID string) erro
 	return nil
 }
 
+// RefreshClaims records a heartbeat on a workbench's in-progress tasks.
+func (r *TaskRepository) RefreshClaims(ctx context.Context, workbenchID string) (int, error) {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE tasks SET claim_refreshed_at = CURRENT_TIMESTAMP WHERE assigned_workbench_id = ? AND status = 'in-progress'",
+		workbenchID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to refresh claims: %w", err)
+	}
+	rowsAffected, _ := result.RowsAffected()
+	return int(rowsAffected), nil
+}
+
 // AssignWorkbenchByShipment assigns all tasks of a shipment to a workbench.
 func (r *TaskRepository) AssignWorkbenchByShipment(ctx context.Context, shipmentID, workbenchID string) error {
 	_, err := r.db.ExecContext(ctx,
@@ -461,11 +554,22 @@ func (r *TaskRepository) AddTag(ctx context.Context, taskID, tagID string) error
 		return fmt.Errorf("failed to add tag to task: %w", err)
 	}
 
+	// Log tag change
+	if r.logWriter != nil {
+		_ = r.logWriter.LogUpdate(ctx, "task", taskID, "tag", "", tagID)
+	}
+
 	return nil
 }
 
 // RemoveTag removes the tag from a task.
 func (r *TaskRepository) RemoveTag(ctx context.Context, taskID string) error {
+	// Get old tag for logging
+	var oldTagID string
+	if r.logWriter != nil {
+		_ = r.db.QueryRowContext(ctx, "SELECT tag_id FROM entity_tags WHERE entity_id = ? AND entity_type = 'task'", taskID).Scan(&oldTagID)
+	}
+
 	_, err := r.db.ExecContext(ctx,
 		"DELETE FROM entity_tags WHERE entity_id = ? AND entity_type = 'task'",
 		taskID,
@@ -474,6 +578,11 @@ func (r *TaskRepository) RemoveTag(ctx context.Context, taskID string) error {
 		return fmt.Errorf("failed to remove tag from task: %w", err)
 	}
 
+	// Log tag change
+	if r.logWriter != nil && oldTagID != "" {
+		_ = r.logWriter.LogUpdate(ctx, "task", taskID, "tag", oldTagID, "")
+	}
+
 	return nil
 }
 
@@ -482,7 +591,7 @@ func (r *TaskRepository) ListByTag(ctx context.Context, tagID string) ([]*second
 	query := `
 		SELECT t.id, t.shipment_id, t.commission_id, t.tome_id, t.title, t.description,
 		       t.type, t.status, t.priority, t.assigned_workbench_id,
-		       t.pinned, t.depends_on, t.created_at, t.updated_at, t.claimed_at, t.completed_at
+		       t.pinned, t.depends_on, t.created_at, t.updated_at, t.claimed_at, t.completed_at, t.points, t.claim_refreshed_at, t.due_date
 		FROM tasks t
 		INNER JOIN entity_tags et ON t.id = et.entity_id AND et.entity_type = 'task'
 		WHERE et.tag_id = ?
@@ -520,5 +629,117 @@ func (r *TaskRepository) GetNextEntityTagID(ctx context.Context) (string, error)
 	return fmt.Sprintf("ET-%03d", maxID+1), nil
 }
 
+// ListChecklistItems retrieves a task's checklist items in creation order.
+func (r *TaskRepository) ListChecklistItems(ctx context.Context, taskID string) ([]*secondary.ChecklistItemRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT id, task_id, text, done, created_at, completed_at FROM task_checklist_items WHERE task_id = ? ORDER BY id ASC",
+		taskID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checklist items: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*secondary.ChecklistItemRecord
+	for rows.Next() {
+		var (
+			createdAt   time.Time
+			completedAt sql.NullTime
+		)
+
+		item := &secondary.ChecklistItemRecord{}
+		if err := rows.Scan(&item.ID, &item.TaskID, &item.Text, &item.Done, &createdAt, &completedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan checklist item: %w", err)
+		}
+
+		item.CreatedAt = createdAt.Format(time.RFC3339)
+		if completedAt.Valid {
+			item.CompletedAt = completedAt.Time.Format(time.RFC3339)
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
+
+// AddChecklistItem appends a checklist item to a task and sets its ID.
+func (r *TaskRepository) AddChecklistItem(ctx context.Context, item *secondary.ChecklistItemRecord) error {
+	result, err := r.db.ExecContext(ctx,
+		"INSERT INTO task_checklist_items (task_id, text) VALUES (?, ?)",
+		item.TaskID, item.Text,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to add checklist item: %w", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("failed to get checklist item ID: %w", err)
+	}
+	item.ID = id
+
+	return nil
+}
+
+// SetChecklistItemDone marks a checklist item done or not done.
+func (r *TaskRepository) SetChecklistItemDone(ctx context.Context, itemID int64, done bool) error {
+	query := "UPDATE task_checklist_items SET done = 0, completed_at = NULL WHERE id = ?"
+	if done {
+		query = "UPDATE task_checklist_items SET done = 1, completed_at = CURRENT_TIMESTAMP WHERE id = ?"
+	}
+
+	result, err := r.db.ExecContext(ctx, query, itemID)
+	if err != nil {
+		return fmt.Errorf("failed to update checklist item: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("checklist item %d not found", itemID)
+	}
+
+	return nil
+}
+
+// DeleteChecklistItem removes a checklist item.
+func (r *TaskRepository) DeleteChecklistItem(ctx context.Context, itemID int64) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM task_checklist_items WHERE id = ?", itemID)
+	if err != nil {
+		return fmt.Errorf("failed to delete checklist item: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("checklist item %d not found", itemID)
+	}
+
+	return nil
+}
+
+// ListConditionalPlanIDs returns the task's draft plans with open conditions.
+func (r *TaskRepository) ListConditionalPlanIDs(ctx context.Context, taskID string) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT DISTINCT p.id FROM plans p
+		JOIN plan_conditions c ON c.plan_id = p.id
+		WHERE p.task_id = ? AND p.status = 'draft' AND c.status = 'open'
+		ORDER BY p.id`,
+		taskID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list conditional plans: %w", err)
+	}
+	defer rows.Close()
+
+	var planIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan plan ID: %w", err)
+		}
+		planIDs = append(planIDs, id)
+	}
+	return planIDs, rows.Err()
+}
+
 // Ensure TaskRepository implements the interface
 var _ secondary.TaskRepository = (*TaskRepository)(nil)
@@ -99,6 +99,33 @@ func TestTaskRepository_Create_WithoutShipment(t *testing.T) {
 	}
 }
 
+func TestTaskRepository_Create_PriorityAndDueDate(t *testing.T) {
+	db := setupTaskTestDB(t)
+	repo := sqlite.NewTaskRepository(db, nil)
+	ctx := context.Background()
+
+	err := repo.Create(ctx, &secondary.TaskRecord{
+		ID:           "TASK-001",
+		CommissionID: "COMM-001",
+		Title:        "Fix flaky auth test",
+		Priority:     "high",
+		DueDate:      "2026-10-16",
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	retrieved, _ := repo.GetByID(ctx, "TASK-001")
+	if retrieved.Priority != "high" || retrieved.DueDate != "2026-10-16" {
+		t.Errorf("priority, due date = %q, %q; want high, 2026-10-16", retrieved.Priority, retrieved.DueDate)
+	}
+
+	listed, err := repo.List(ctx, secondary.TaskFilters{CommissionID: "COMM-001"})
+	if err != nil || len(listed) != 1 || listed[0].DueDate != "2026-10-16" {
+		t.Errorf("List due date = %+v (err %v), want 2026-10-16", listed, err)
+	}
+}
+
 func TestTaskRepository_GetByID(t *testing.T) {
 	db := setupTaskTestDB(t)
 	repo := sqlite.NewTaskRepository(db, nil)
@@ -149,6 +176,43 @@ func TestTaskRepository_List(t *testing.T) {
 	}
 }
 
+func TestTaskRepository_List_ReadModelFields(t *testing.T) {
+	db := setupTaskTestDB(t)
+	repo := sqlite.NewTaskRepository(db, nil)
+	ctx := context.Background()
+
+	task := createTestTask(t, repo, ctx, "COMM-001", "", "Tagged Task")
+	createTestTask(t, repo, ctx, "COMM-001", "", "Plain Task")
+	seedTag(t, db, "TAG-001", "database-schema")
+	_, _ = db.Exec("INSERT INTO entity_tags (id, entity_id, entity_type, tag_id) VALUES ('ET-001', ?, 'task', 'TAG-001')", task.ID)
+	_, _ = db.Exec("INSERT INTO task_checklist_items (task_id, text, done) VALUES (?, 'one', 1), (?, 'two', 0)", task.ID, task.ID)
+	_, _ = db.Exec("INSERT INTO comments (id, entity_id, entity_type, body) VALUES ('CMT-001', ?, 'task', 'hi')", task.ID)
+
+	tasks, err := repo.List(ctx, secondary.TaskFilters{})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(tasks) != 2 {
+		t.Fatalf("expected 2 tasks, got %d", len(tasks))
+	}
+
+	tagged := tasks[0]
+	if tagged.TagName != "database-schema" {
+		t.Errorf("expected tag 'database-schema', got '%s'", tagged.TagName)
+	}
+	if tagged.ChecklistDone != 1 || tagged.ChecklistTotal != 2 {
+		t.Errorf("expected checklist 1/2, got %d/%d", tagged.ChecklistDone, tagged.ChecklistTotal)
+	}
+	if tagged.CommentCount != 1 {
+		t.Errorf("expected 1 comment, got %d", tagged.CommentCount)
+	}
+
+	plain := tasks[1]
+	if plain.TagName != "" || plain.ChecklistTotal != 0 || plain.CommentCount != 0 {
+		t.Errorf("expected no read-model data on plain task, got %+v", plain)
+	}
+}
+
 func TestTaskRepository_List_FilterByShipment(t *testing.T) {
 	db := setupTaskTestDB(t)
 	repo := sqlite.NewTaskRepository(db, nil)
@@ -381,6 +445,42 @@ func TestTaskRepository_UpdateStatus_NotFound(t *testing.T) {
 	}
 }
 
+func TestTaskRepository_RevertStatus(t *testing.T) {
+	db := setupTaskTestDB(t)
+	repo := sqlite.NewTaskRepository(db, nil)
+	ctx := context.Background()
+
+	task := createTestTask(t, repo, ctx, "COMM-001", "", "Revert Test")
+	if err := repo.UpdateStatus(ctx, task.ID, "in-progress", true, false); err != nil {
+		t.Fatalf("UpdateStatus failed: %v", err)
+	}
+	if err := repo.UpdateStatus(ctx, task.ID, "closed", false, true); err != nil {
+		t.Fatalf("UpdateStatus failed: %v", err)
+	}
+
+	// Undo the close: completed_at goes, the claim stays
+	if err := repo.RevertStatus(ctx, task.ID, "in-progress", false, true); err != nil {
+		t.Fatalf("RevertStatus failed: %v", err)
+	}
+	retrieved, _ := repo.GetByID(ctx, task.ID)
+	if retrieved.Status != "in-progress" || retrieved.CompletedAt != "" || retrieved.ClaimedAt == "" {
+		t.Errorf("after reverting the close: status %q, completed_at %q, claimed_at %q", retrieved.Status, retrieved.CompletedAt, retrieved.ClaimedAt)
+	}
+
+	// Undo the claim: the claim goes too
+	if err := repo.RevertStatus(ctx, task.ID, "open", true, true); err != nil {
+		t.Fatalf("RevertStatus failed: %v", err)
+	}
+	retrieved, _ = repo.GetByID(ctx, task.ID)
+	if retrieved.Status != "open" || retrieved.ClaimedAt != "" {
+		t.Errorf("after reverting the claim: status %q, claimed_at %q", retrieved.Status, retrieved.ClaimedAt)
+	}
+
+	if err := repo.RevertStatus(ctx, "TASK-999", "open", true, true); err == nil {
+		t.Error("expected error for non-existent task")
+	}
+}
+
 func TestTaskRepository_Claim(t *testing.T) {
 	db := setupTaskTestDB(t)
 	repo := sqlite.NewTaskRepository(db, nil)
@@ -416,6 +516,35 @@ func TestTaskRepository_Claim_NotFound(t *testing.T) {
 	}
 }
 
+func TestTaskRepository_RefreshClaims(t *testing.T) {
+	db := setupTaskTestDB(t)
+	repo := sqlite.NewTaskRepository(db, nil)
+	ctx := context.Background()
+
+	claimed := createTestTask(t, repo, ctx, "COMM-001", "", "Claimed")
+	blocked := createTestTask(t, repo, ctx, "COMM-001", "", "Blocked")
+	_ = repo.Claim(ctx, claimed.ID, "BENCH-001")
+	_ = repo.Claim(ctx, blocked.ID, "BENCH-001")
+	_ = repo.UpdateStatus(ctx, blocked.ID, "blocked", false, false)
+
+	refreshed, err := repo.RefreshClaims(ctx, "BENCH-001")
+	if err != nil {
+		t.Fatalf("RefreshClaims failed: %v", err)
+	}
+	if refreshed != 1 {
+		t.Errorf("expected 1 refreshed claim, got %d", refreshed)
+	}
+
+	retrieved, _ := repo.GetByID(ctx, claimed.ID)
+	if retrieved.ClaimRefreshedAt == "" {
+		t.Error("expected ClaimRefreshedAt to be set")
+	}
+	tasks, _ := repo.List(ctx, secondary.TaskFilters{Status: "blocked"})
+	if len(tasks) != 1 || tasks[0].ClaimRefreshedAt != "" {
+		t.Error("expected blocked task's claim not to be refreshed")
+	}
+}
+
 func TestTaskRepository_GetByWorkbench(t *testing.T) {
 	db := setupTaskTestDB(t)
 	repo := sqlite.NewTaskRepository(db, nil)
@@ -707,3 +836,73 @@ func TestTaskRepository_GetNextEntityTagID(t *testing.T) {
 		t.Errorf("expected ET-002, got %s", id)
 	}
 }
+
+func TestTaskRepository_Checklist(t *testing.T) {
+	db := setupTaskTestDB(t)
+	repo := sqlite.NewTaskRepository(db, nil)
+	ctx := context.Background()
+
+	task := createTestTask(t, repo, ctx, "COMM-001", "", "Checklist Task")
+
+	first := &secondary.ChecklistItemRecord{TaskID: task.ID, Text: "write tests"}
+	if err := repo.AddChecklistItem(ctx, first); err != nil {
+		t.Fatalf("AddChecklistItem failed: %v", err)
+	}
+	if first.ID == 0 {
+		t.Error("expected AddChecklistItem to set ID")
+	}
+	second := &secondary.ChecklistItemRecord{TaskID: task.ID, Text: "update docs"}
+	if err := repo.AddChecklistItem(ctx, second); err != nil {
+		t.Fatalf("AddChecklistItem failed: %v", err)
+	}
+
+	if err := repo.SetChecklistItemDone(ctx, second.ID, true); err != nil {
+		t.Fatalf("SetChecklistItemDone failed: %v", err)
+	}
+
+	items, err := repo.ListChecklistItems(ctx, task.ID)
+	if err != nil {
+		t.Fatalf("ListChecklistItems failed: %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(items))
+	}
+	if items[0].Text != "write tests" || items[1].Text != "update docs" {
+		t.Errorf("expected creation order, got %q, %q", items[0].Text, items[1].Text)
+	}
+	if items[0].Done {
+		t.Error("expected first item to be open")
+	}
+	if !items[1].Done || items[1].CompletedAt == "" {
+		t.Errorf("expected second item done with CompletedAt, got done=%v completed_at=%q", items[1].Done, items[1].CompletedAt)
+	}
+
+	// Undo clears completion
+	if err := repo.SetChecklistItemDone(ctx, second.ID, false); err != nil {
+		t.Fatalf("SetChecklistItemDone(false) failed: %v", err)
+	}
+	if err := repo.DeleteChecklistItem(ctx, first.ID); err != nil {
+		t.Fatalf("DeleteChecklistItem failed: %v", err)
+	}
+
+	items, _ = repo.ListChecklistItems(ctx, task.ID)
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item after delete, got %d", len(items))
+	}
+	if items[0].Done || items[0].CompletedAt != "" {
+		t.Error("expected undone item to have no completion")
+	}
+}
+
+func TestTaskRepository_Checklist_NotFound(t *testing.T) {
+	db := setupTaskTestDB(t)
+	repo := sqlite.NewTaskRepository(db, nil)
+	ctx := context.Background()
+
+	if err := repo.SetChecklistItemDone(ctx, 999, true); err == nil {
+		t.Error("expected error for missing checklist item")
+	}
+	if err := repo.DeleteChecklistItem(ctx, 999); err == nil {
+		t.Error("expected error for missing checklist item")
+	}
+}
@@ -54,13 +54,14 @@ func (r *TomeRepository) GetByID(ctx context.Context, id string) (*secondary.Tom
 		createdAt           time.Time
 		updatedAt           time.Time
 		closedAt            sql.NullTime
+		charter             sql.NullString
 	)
 
 	record := &secondary.TomeRecord{}
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, commission_id, title, description, status, assigned_workbench_id, pinned, created_at, updated_at, closed_at FROM tomes WHERE id = ?",
+		"SELECT id, commission_id, title, description, status, assigned_workbench_id, pinned, created_at, updated_at, closed_at, charter FROM tomes WHERE id = ?",
 		id,
-	).Scan(&record.ID, &record.CommissionID, &record.Title, &desc, &record.Status, &assignedWorkbenchID, &pinned, &createdAt, &updatedAt, &closedAt)
+	).Scan(&record.ID, &record.CommissionID, &record.Title, &desc, &record.Status, &assignedWorkbenchID, &pinned, &createdAt, &updatedAt, &closedAt, &charter)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("tome %s not found", id)
@@ -71,6 +72,7 @@ func (r *TomeRepository) GetByID(ctx context.Context, id string) (*secondary.Tom
 
 	record.Description = desc.String
 	record.AssignedWorkbenchID = assignedWorkbenchID.String
+	record.Charter = charter.String
 	record.Pinned = pinned
 	record.CreatedAt = createdAt.Format(time.RFC3339)
 	record.UpdatedAt = updatedAt.Format(time.RFC3339)
@@ -83,7 +85,7 @@ func (r *TomeRepository) GetByID(ctx context.Context, id string) (*secondary.Tom
 
 // List retrieves tomes matching the given filters.
 func (r *TomeRepository) List(ctx context.Context, filters secondary.TomeFilters) ([]*secondary.TomeRecord, error) {
-	query := "SELECT id, commission_id, title, description, status, assigned_workbench_id, pinned, created_at, updated_at, closed_at FROM tomes WHERE 1=1"
+	query := "SELECT id, commission_id, title, description, status, assigned_workbench_id, pinned, created_at, updated_at, closed_at, charter FROM tomes WHERE 1=1"
 	args := []any{}
 
 	if filters.CommissionID != "" {
@@ -113,16 +115,18 @@ func (r *TomeRepository) List(ctx context.Context, filters secondary.TomeFilters
 			createdAt           time.Time
 			updatedAt           time.Time
 			closedAt            sql.NullTime
+			charter             sql.NullString
 		)
 
 		record := &secondary.TomeRecord{}
-		err := rows.Scan(&record.ID, &record.CommissionID, &record.Title, &desc, &record.Status, &assignedWorkbenchID, &pinned, &createdAt, &updatedAt, &closedAt)
+		err := rows.Scan(&record.ID, &record.CommissionID, &record.Title, &desc, &record.Status, &assignedWorkbenchID, &pinned, &createdAt, &updatedAt, &closedAt, &charter)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan tome: %w", err)
 		}
 
 		record.Description = desc.String
 		record.AssignedWorkbenchID = assignedWorkbenchID.String
+		record.Charter = charter.String
 		record.Pinned = pinned
 		record.CreatedAt = createdAt.Format(time.RFC3339)
 		record.UpdatedAt = updatedAt.Format(time.RFC3339)
@@ -259,7 +263,7 @@ func (r *TomeRepository) UpdateStatus(ctx context.Context, id, status string, se
 
 // GetByWorkbench retrieves tomes assigned to a workbench.
 func (r *TomeRepository) GetByWorkbench(ctx context.Context, workbenchID string) ([]*secondary.TomeRecord, error) {
-	query := "SELECT id, commission_id, title, description, status, assigned_workbench_id, pinned, created_at, updated_at, closed_at FROM tomes WHERE assigned_workbench_id = ?"
+	query := "SELECT id, commission_id, title, description, status, assigned_workbench_id, pinned, created_at, updated_at, closed_at, charter FROM tomes WHERE assigned_workbench_id = ?"
 	rows, err := r.db.QueryContext(ctx, query, workbenchID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tomes by workbench: %w", err)
@@ -275,16 +279,18 @@ func (r *TomeRepository) GetByWorkbench(ctx context.Context, workbenchID string)
 			createdAt           time.Time
 			updatedAt           time.Time
 			closedAt            sql.NullTime
+			charter             sql.NullString
 		)
 
 		record := &secondary.TomeRecord{}
-		err := rows.Scan(&record.ID, &record.CommissionID, &record.Title, &desc, &record.Status, &assignedWorkbenchID, &pinned, &createdAt, &updatedAt, &closedAt)
+		err := rows.Scan(&record.ID, &record.CommissionID, &record.Title, &desc, &record.Status, &assignedWorkbenchID, &pinned, &createdAt, &updatedAt, &closedAt, &charter)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan tome: %w", err)
 		}
 
 		record.Description = desc.String
 		record.AssignedWorkbenchID = assignedWorkbenchID.String
+		record.Charter = charter.String
 		record.Pinned = pinned
 		record.CreatedAt = createdAt.Format(time.RFC3339)
 		record.UpdatedAt = updatedAt.Format(time.RFC3339)
@@ -316,6 +322,30 @@ func (r *TomeRepository) AssignWorkbench(ctx context.Context, tomeID, workbenchI
 	return nil
 }
 
+// UpdateCharter replaces the charter document of a tome.
+// An empty charter clears the field.
+func (r *TomeRepository) UpdateCharter(ctx context.Context, id, charter string) error {
+	var value sql.NullString
+	if charter != "" {
+		value = sql.NullString{String: charter, Valid: true}
+	}
+
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE tomes SET charter = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?",
+		value, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update tome charter: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("tome %s not found", id)
+	}
+
+	return nil
+}
+
 // CommissionExists checks if a commission exists.
 func (r *TomeRepository) CommissionExists(ctx context.Context, commissionID string) (bool, error) {
 	var count int
@@ -326,5 +356,43 @@ func (r *TomeRepository) CommissionExists(ctx context.Context, commissionID stri
 	return count > 0, nil
 }
 
+// WorkbenchExists checks if a workbench exists.
+func (r *TomeRepository) WorkbenchExists(ctx context.Context, workbenchID string) (bool, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM workbenches WHERE id = ?", workbenchID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check workbench existence: %w", err)
+	}
+	return count > 0, nil
+}
+
+// SetNoteOrder records the reading order of a tome's notes: noteIDs[i] gets
+// position i+1. Notes not listed keep their current position.
+func (r *TomeRepository) SetNoteOrder(ctx context.Context, tomeID string, noteIDs []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for i, noteID := range noteIDs {
+		result, err := tx.ExecContext(ctx,
+			"UPDATE notes SET position = ? WHERE id = ? AND tome_id = ?",
+			i+1, noteID, tomeID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to order note %s: %w", noteID, err)
+		}
+		if rowsAffected, _ := result.RowsAffected(); rowsAffected == 0 {
+			return fmt.Errorf("note %s is not in %s", noteID, tomeID)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit note order: %w", err)
+	}
+	return nil
+}
+
 // Ensure TomeRepository implements the interface
 var _ secondary.TomeRepository = (*TomeRepository)(nil)
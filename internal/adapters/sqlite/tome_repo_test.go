@@ -406,3 +406,88 @@ func TestTomeRepository_CommissionExists(t *testing.T) {
 		t.Error("expected commission to not exist")
 	}
 }
+
+func TestTomeRepository_UpdateCharter(t *testing.T) {
+	db := setupTomeTestDB(t)
+	repo := sqlite.NewTomeRepository(db, nil)
+	ctx := context.Background()
+
+	tome := createTestTome(t, repo, ctx, "COMM-001", "Chartered", "")
+
+	err := repo.UpdateCharter(ctx, tome.ID, "Research charter")
+	if err != nil {
+		t.Fatalf("UpdateCharter failed: %v", err)
+	}
+
+	retrieved, _ := repo.GetByID(ctx, tome.ID)
+	if retrieved.Charter != "Research charter" {
+		t.Errorf("expected charter 'Research charter', got '%s'", retrieved.Charter)
+	}
+
+	tomes, err := repo.List(ctx, secondary.TomeFilters{CommissionID: "COMM-001"})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(tomes) != 1 || tomes[0].Charter != "Research charter" {
+		t.Error("expected list to include charter")
+	}
+}
+
+func TestTomeRepository_UpdateCharter_NotFound(t *testing.T) {
+	db := setupTomeTestDB(t)
+	repo := sqlite.NewTomeRepository(db, nil)
+	ctx := context.Background()
+
+	err := repo.UpdateCharter(ctx, "TOME-999", "charter")
+	if err == nil {
+		t.Error("expected error for non-existent tome")
+	}
+}
+
+func TestTomeRepository_SetNoteOrder(t *testing.T) {
+	db := setupTomeTestDB(t)
+	repo := sqlite.NewTomeRepository(db, nil)
+	noteRepo := sqlite.NewNoteRepository(db, nil)
+	ctx := context.Background()
+
+	tome := createTestTome(t, repo, ctx, "COMM-001", "Ordered", "")
+	other := createTestTome(t, repo, ctx, "COMM-001", "Other", "")
+	for _, id := range []string{"NOTE-001", "NOTE-002", "NOTE-003"} {
+		if err := noteRepo.Create(ctx, &secondary.NoteRecord{ID: id, CommissionID: "COMM-001", Title: id, TomeID: tome.ID}); err != nil {
+			t.Fatalf("Create note failed: %v", err)
+		}
+	}
+
+	if err := repo.SetNoteOrder(ctx, tome.ID, []string{"NOTE-002", "NOTE-001"}); err != nil {
+		t.Fatalf("SetNoteOrder failed: %v", err)
+	}
+
+	// Ordered notes come first; NOTE-003 has no position yet
+	notes, err := noteRepo.GetByContainer(ctx, "tome", tome.ID)
+	if err != nil {
+		t.Fatalf("GetByContainer failed: %v", err)
+	}
+	var got []string
+	for _, n := range notes {
+		got = append(got, n.ID)
+	}
+	if len(got) != 3 || got[0] != "NOTE-002" || got[1] != "NOTE-001" || got[2] != "NOTE-003" {
+		t.Errorf("expected NOTE-002, NOTE-001, NOTE-003, got %v", got)
+	}
+	if notes[0].Position != 1 || notes[2].Position != 0 {
+		t.Errorf("unexpected positions: %d, %d", notes[0].Position, notes[2].Position)
+	}
+
+	// Moving a note to another tome drops its position
+	if err := noteRepo.Update(ctx, &secondary.NoteRecord{ID: "NOTE-002", TomeID: other.ID}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	moved, _ := noteRepo.GetByID(ctx, "NOTE-002")
+	if moved.Position != 0 {
+		t.Errorf("expected moved note to lose its position, got %d", moved.Position)
+	}
+
+	if err := repo.SetNoteOrder(ctx, tome.ID, []string{"NOTE-002"}); err == nil {
+		t.Error("expected error ordering a note outside the tome")
+	}
+}
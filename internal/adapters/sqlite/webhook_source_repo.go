@@ -0,0 +1,106 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// WebhookSourceRepository implements secondary.WebhookSourceRepository with SQLite.
+type WebhookSourceRepository struct {
+	db *sql.DB
+}
+
+// NewWebhookSourceRepository creates a new SQLite webhook source repository.
+func NewWebhookSourceRepository(db *sql.DB) *WebhookSourceRepository {
+	return &WebhookSourceRepository{db: db}
+}
+
+const webhookSourceCols = "name, kind, secret_name, mappings, created_at, updated_at"
+
+// Create persists a new webhook source.
+func (r *WebhookSourceRepository) Create(ctx context.Context, source *secondary.WebhookSourceRecord) error {
+	mappings, err := json.Marshal(source.Mappings)
+	if err != nil {
+		return fmt.Errorf("failed to encode mappings: %w", err)
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		"INSERT INTO webhook_sources (name, kind, secret_name, mappings) VALUES (?, ?, ?, ?)",
+		source.Name, source.Kind, source.SecretName, string(mappings),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook source: %w", err)
+	}
+	return nil
+}
+
+// GetByName retrieves a webhook source by name.
+func (r *WebhookSourceRepository) GetByName(ctx context.Context, name string) (*secondary.WebhookSourceRecord, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT "+webhookSourceCols+" FROM webhook_sources WHERE name = ?", name)
+	source, err := scanWebhookSource(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("webhook source %q not found", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook source: %w", err)
+	}
+	return source, nil
+}
+
+// List retrieves all webhook sources ordered by name.
+func (r *WebhookSourceRepository) List(ctx context.Context) ([]*secondary.WebhookSourceRecord, error) {
+	rows, err := r.db.QueryContext(ctx, "SELECT "+webhookSourceCols+" FROM webhook_sources ORDER BY name")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook sources: %w", err)
+	}
+	defer rows.Close()
+
+	var sources []*secondary.WebhookSourceRecord
+	for rows.Next() {
+		source, err := scanWebhookSource(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan webhook source: %w", err)
+		}
+		sources = append(sources, source)
+	}
+	return sources, rows.Err()
+}
+
+// Delete removes a webhook source.
+func (r *WebhookSourceRepository) Delete(ctx context.Context, name string) error {
+	result, err := r.db.ExecContext(ctx, "DELETE FROM webhook_sources WHERE name = ?", name)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook source: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("webhook source %q not found", name)
+	}
+	return nil
+}
+
+func scanWebhookSource(scanner interface{ Scan(dest ...any) error }) (*secondary.WebhookSourceRecord, error) {
+	var (
+		source    secondary.WebhookSourceRecord
+		mappings  string
+		createdAt sql.NullString
+		updatedAt sql.NullString
+	)
+	if err := scanner.Scan(&source.Name, &source.Kind, &source.SecretName, &mappings, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(mappings), &source.Mappings); err != nil {
+		return nil, fmt.Errorf("invalid mappings for %s: %w", source.Name, err)
+	}
+	source.CreatedAt = createdAt.String
+	source.UpdatedAt = updatedAt.String
+	return &source, nil
+}
+
+// Ensure WebhookSourceRepository implements the interface
+var _ secondary.WebhookSourceRepository = (*WebhookSourceRepository)(nil)
@@ -0,0 +1,60 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestWebhookSourceRepository_CRUD(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewWebhookSourceRepository(db)
+	ctx := context.Background()
+
+	github := &secondary.WebhookSourceRecord{
+		Name:       "github",
+		Kind:       "github",
+		SecretName: "gh-webhook",
+		Mappings:   map[string][]string{"ci.failed": {"block", "note"}, "pr.merged": {"pr-sync"}},
+	}
+	if err := repo.Create(ctx, github); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if err := repo.Create(ctx, &secondary.WebhookSourceRecord{Name: "buildkite", Kind: "generic", SecretName: "bk", Mappings: map[string][]string{}}); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if err := repo.Create(ctx, github); err == nil {
+		t.Error("expected duplicate name to fail")
+	}
+
+	got, err := repo.GetByName(ctx, "github")
+	if err != nil {
+		t.Fatalf("GetByName failed: %v", err)
+	}
+	if got.Kind != "github" || got.SecretName != "gh-webhook" || got.CreatedAt == "" {
+		t.Errorf("unexpected source: %+v", got)
+	}
+	if actions := got.Mappings["ci.failed"]; len(actions) != 2 || actions[1] != "note" {
+		t.Errorf("unexpected mappings: %+v", got.Mappings)
+	}
+
+	sources, err := repo.List(ctx)
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(sources) != 2 || sources[0].Name != "buildkite" {
+		t.Errorf("expected 2 sources by name, got %+v", sources)
+	}
+
+	if err := repo.Delete(ctx, "github"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := repo.GetByName(ctx, "github"); err == nil {
+		t.Error("expected deleted source to be gone")
+	}
+	if err := repo.Delete(ctx, "github"); err == nil {
+		t.Error("expected deleting a missing source to fail")
+	}
+}
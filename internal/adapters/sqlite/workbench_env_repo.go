@@ -0,0 +1,89 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// WorkbenchEnvRepository implements secondary.WorkbenchEnvRepository with SQLite.
+type WorkbenchEnvRepository struct {
+	db *sql.DB
+}
+
+// NewWorkbenchEnvRepository creates a new SQLite workbench env repository.
+func NewWorkbenchEnvRepository(db *sql.DB) *WorkbenchEnvRepository {
+	return &WorkbenchEnvRepository{db: db}
+}
+
+// Set stores a variable, replacing any existing one with the same name.
+func (r *WorkbenchEnvRepository) Set(ctx context.Context, env *secondary.WorkbenchEnvRecord) error {
+	var value, secretName sql.NullString
+	if env.Value != "" {
+		value = sql.NullString{String: env.Value, Valid: true}
+	}
+	if env.SecretName != "" {
+		secretName = sql.NullString{String: env.SecretName, Valid: true}
+	}
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO workbench_env (workbench_id, name, value, secret_name) VALUES (?, ?, ?, ?)
+		ON CONFLICT(workbench_id, name) DO UPDATE SET value = excluded.value, secret_name = excluded.secret_name, updated_at = CURRENT_TIMESTAMP`,
+		env.WorkbenchID, env.Name, value, secretName,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set workbench env: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a variable from a workbench.
+func (r *WorkbenchEnvRepository) Delete(ctx context.Context, workbenchID, name string) error {
+	result, err := r.db.ExecContext(ctx,
+		"DELETE FROM workbench_env WHERE workbench_id = ? AND name = ?",
+		workbenchID, name,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete workbench env: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("variable %s not set on workbench %s", name, workbenchID)
+	}
+	return nil
+}
+
+// List retrieves a workbench's variables ordered by name.
+func (r *WorkbenchEnvRepository) List(ctx context.Context, workbenchID string) ([]*secondary.WorkbenchEnvRecord, error) {
+	rows, err := r.db.QueryContext(ctx,
+		"SELECT workbench_id, name, value, secret_name, created_at, updated_at FROM workbench_env WHERE workbench_id = ? ORDER BY name",
+		workbenchID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workbench env: %w", err)
+	}
+	defer rows.Close()
+
+	var vars []*secondary.WorkbenchEnvRecord
+	for rows.Next() {
+		var value, secretName sql.NullString
+		var createdAt, updatedAt time.Time
+		record := &secondary.WorkbenchEnvRecord{}
+		if err := rows.Scan(&record.WorkbenchID, &record.Name, &value, &secretName, &createdAt, &updatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan workbench env: %w", err)
+		}
+		record.Value = value.String
+		record.SecretName = secretName.String
+		record.CreatedAt = createdAt.Format(time.RFC3339)
+		record.UpdatedAt = updatedAt.Format(time.RFC3339)
+		vars = append(vars, record)
+	}
+	return vars, rows.Err()
+}
+
+// Ensure WorkbenchEnvRepository implements the interface
+var _ secondary.WorkbenchEnvRepository = (*WorkbenchEnvRepository)(nil)
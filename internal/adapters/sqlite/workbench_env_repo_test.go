@@ -0,0 +1,58 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestWorkbenchEnvRepository_SetListDelete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewWorkbenchEnvRepository(db)
+	ctx := context.Background()
+
+	seedWorkbench(t, db, "BENCH-001", "", "test-bench")
+
+	for _, env := range []*secondary.WorkbenchEnvRecord{
+		{WorkbenchID: "BENCH-001", Name: "API_BASE", Value: "staging"},
+		{WorkbenchID: "BENCH-001", Name: "GITHUB_TOKEN", SecretName: "github-token"},
+	} {
+		if err := repo.Set(ctx, env); err != nil {
+			t.Fatalf("Set failed: %v", err)
+		}
+	}
+
+	// Set on the same name replaces the variable, including switching to a secret
+	if err := repo.Set(ctx, &secondary.WorkbenchEnvRecord{WorkbenchID: "BENCH-001", Name: "API_BASE", SecretName: "api-base"}); err != nil {
+		t.Fatalf("Set (replace) failed: %v", err)
+	}
+
+	vars, err := repo.List(ctx, "BENCH-001")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(vars) != 2 {
+		t.Fatalf("expected 2 variables, got %d", len(vars))
+	}
+	if vars[0].Name != "API_BASE" || vars[0].Value != "" || vars[0].SecretName != "api-base" {
+		t.Errorf("unexpected API_BASE: %+v", vars[0])
+	}
+	if vars[1].Name != "GITHUB_TOKEN" || vars[1].SecretName != "github-token" {
+		t.Errorf("unexpected GITHUB_TOKEN: %+v", vars[1])
+	}
+
+	if err := repo.Delete(ctx, "BENCH-001", "API_BASE"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := repo.Delete(ctx, "BENCH-001", "API_BASE"); err == nil {
+		t.Error("expected error deleting missing variable")
+	}
+
+	vars, _ = repo.List(ctx, "BENCH-001")
+	if len(vars) != 1 {
+		t.Errorf("expected 1 variable after delete, got %d", len(vars))
+	}
+
+}
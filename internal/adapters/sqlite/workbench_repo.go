@@ -88,19 +88,22 @@ func (r *WorkbenchRepository) Create(ctx context.Context, workbench *secondary.W
 // GetByID retrieves a workbench by its ID.
 func (r *WorkbenchRepository) GetByID(ctx context.Context, id string) (*secondary.WorkbenchRecord, error) {
 	var (
-		createdAt     time.Time
-		updatedAt     time.Time
-		repoID        sql.NullString
-		homeBranch    sql.NullString
-		currentBranch sql.NullString
-		focusedID     sql.NullString
+		createdAt       time.Time
+		updatedAt       time.Time
+		repoID          sql.NullString
+		homeBranch      sql.NullString
+		currentBranch   sql.NullString
+		focusedID       sql.NullString
+		bootstrapStatus sql.NullString
+		bootstrapOutput sql.NullString
+		bootstrappedAt  sql.NullTime
 	)
 
 	record := &secondary.WorkbenchRecord{}
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, created_at, updated_at FROM workbenches WHERE id = ?",
+		"SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, bootstrap_status, bootstrap_output, bootstrapped_at, created_at, updated_at FROM workbenches WHERE id = ?",
 		id,
-	).Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &createdAt, &updatedAt)
+	).Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &bootstrapStatus, &bootstrapOutput, &bootstrappedAt, &createdAt, &updatedAt)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("workbench %s not found", id)
@@ -119,6 +122,11 @@ func (r *WorkbenchRepository) GetByID(ctx context.Context, id string) (*secondar
 		record.CurrentBranch = currentBranch.String
 	}
 	record.FocusedID = focusedID.String
+	record.BootstrapStatus = bootstrapStatus.String
+	record.BootstrapOutput = bootstrapOutput.String
+	if bootstrappedAt.Valid {
+		record.BootstrappedAt = bootstrappedAt.Time.Format(time.RFC3339)
+	}
 	record.CreatedAt = createdAt.Format(time.RFC3339)
 	record.UpdatedAt = updatedAt.Format(time.RFC3339)
 	return record, nil
@@ -134,19 +142,22 @@ func (r *WorkbenchRepository) GetByPath(ctx context.Context, path string) (*seco
 	}
 
 	var (
-		createdAt     time.Time
-		updatedAt     time.Time
-		repoID        sql.NullString
-		homeBranch    sql.NullString
-		currentBranch sql.NullString
-		focusedID     sql.NullString
+		createdAt       time.Time
+		updatedAt       time.Time
+		repoID          sql.NullString
+		homeBranch      sql.NullString
+		currentBranch   sql.NullString
+		focusedID       sql.NullString
+		bootstrapStatus sql.NullString
+		bootstrapOutput sql.NullString
+		bootstrappedAt  sql.NullTime
 	)
 
 	record := &secondary.WorkbenchRecord{}
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, created_at, updated_at FROM workbenches WHERE name = ? AND status = 'active'",
+		"SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, bootstrap_status, bootstrap_output, bootstrapped_at, created_at, updated_at FROM workbenches WHERE name = ? AND status = 'active'",
 		name,
-	).Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &createdAt, &updatedAt)
+	).Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &bootstrapStatus, &bootstrapOutput, &bootstrappedAt, &createdAt, &updatedAt)
 
 	if err == sql.ErrNoRows {
 		return nil, fmt.Errorf("workbench with path %s not found", path)
@@ -165,6 +176,11 @@ func (r *WorkbenchRepository) GetByPath(ctx context.Context, path string) (*seco
 		record.CurrentBranch = currentBranch.String
 	}
 	record.FocusedID = focusedID.String
+	record.BootstrapStatus = bootstrapStatus.String
+	record.BootstrapOutput = bootstrapOutput.String
+	if bootstrappedAt.Valid {
+		record.BootstrappedAt = bootstrappedAt.Time.Format(time.RFC3339)
+	}
 	record.CreatedAt = createdAt.Format(time.RFC3339)
 	record.UpdatedAt = updatedAt.Format(time.RFC3339)
 	return record, nil
@@ -173,7 +189,7 @@ func (r *WorkbenchRepository) GetByPath(ctx context.Context, path string) (*seco
 // GetByWorkshop retrieves all workbenches for a workshop.
 func (r *WorkbenchRepository) GetByWorkshop(ctx context.Context, workshopID string) ([]*secondary.WorkbenchRecord, error) {
 	rows, err := r.db.QueryContext(ctx,
-		"SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, created_at, updated_at FROM workbenches WHERE workshop_id = ? AND status = 'active' ORDER BY created_at DESC",
+		"SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, bootstrap_status, bootstrap_output, bootstrapped_at, created_at, updated_at FROM workbenches WHERE workshop_id = ? AND status = 'active' ORDER BY created_at DESC",
 		workshopID,
 	)
 	if err != nil {
@@ -184,16 +200,19 @@ func (r *WorkbenchRepository) GetByWorkshop(ctx context.Context, workshopID stri
 	var workbenches []*secondary.WorkbenchRecord
 	for rows.Next() {
 		var (
-			createdAt     time.Time
-			updatedAt     time.Time
-			repoID        sql.NullString
-			homeBranch    sql.NullString
-			currentBranch sql.NullString
-			focusedID     sql.NullString
+			createdAt       time.Time
+			updatedAt       time.Time
+			repoID          sql.NullString
+			homeBranch      sql.NullString
+			currentBranch   sql.NullString
+			focusedID       sql.NullString
+			bootstrapStatus sql.NullString
+			bootstrapOutput sql.NullString
+			bootstrappedAt  sql.NullTime
 		)
 
 		record := &secondary.WorkbenchRecord{}
-		err := rows.Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &createdAt, &updatedAt)
+		err := rows.Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &bootstrapStatus, &bootstrapOutput, &bootstrappedAt, &createdAt, &updatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan workbench: %w", err)
 		}
@@ -208,6 +227,11 @@ func (r *WorkbenchRepository) GetByWorkshop(ctx context.Context, workshopID stri
 			record.CurrentBranch = currentBranch.String
 		}
 		record.FocusedID = focusedID.String
+		record.BootstrapStatus = bootstrapStatus.String
+		record.BootstrapOutput = bootstrapOutput.String
+		if bootstrappedAt.Valid {
+			record.BootstrappedAt = bootstrappedAt.Time.Format(time.RFC3339)
+		}
 		record.CreatedAt = createdAt.Format(time.RFC3339)
 		record.UpdatedAt = updatedAt.Format(time.RFC3339)
 		workbenches = append(workbenches, record)
@@ -218,7 +242,7 @@ func (r *WorkbenchRepository) GetByWorkshop(ctx context.Context, workshopID stri
 
 // List retrieves all workbenches, optionally filtered by workshop.
 func (r *WorkbenchRepository) List(ctx context.Context, workshopID string) ([]*secondary.WorkbenchRecord, error) {
-	query := "SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, created_at, updated_at FROM workbenches WHERE 1=1"
+	query := "SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, bootstrap_status, bootstrap_output, bootstrapped_at, created_at, updated_at FROM workbenches WHERE 1=1"
 	args := []any{}
 
 	if workshopID != "" {
@@ -237,16 +261,19 @@ func (r *WorkbenchRepository) List(ctx context.Context, workshopID string) ([]*s
 	var workbenches []*secondary.WorkbenchRecord
 	for rows.Next() {
 		var (
-			createdAt     time.Time
-			updatedAt     time.Time
-			repoID        sql.NullString
-			homeBranch    sql.NullString
-			currentBranch sql.NullString
-			focusedID     sql.NullString
+			createdAt       time.Time
+			updatedAt       time.Time
+			repoID          sql.NullString
+			homeBranch      sql.NullString
+			currentBranch   sql.NullString
+			focusedID       sql.NullString
+			bootstrapStatus sql.NullString
+			bootstrapOutput sql.NullString
+			bootstrappedAt  sql.NullTime
 		)
 
 		record := &secondary.WorkbenchRecord{}
-		err := rows.Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &createdAt, &updatedAt)
+		err := rows.Scan(&record.ID, &record.WorkshopID, &record.Name, &repoID, &record.Status, &homeBranch, &currentBranch, &focusedID, &bootstrapStatus, &bootstrapOutput, &bootstrappedAt, &createdAt, &updatedAt)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan workbench: %w", err)
 		}
@@ -261,6 +288,11 @@ func (r *WorkbenchRepository) List(ctx context.Context, workshopID string) ([]*s
 			record.CurrentBranch = currentBranch.String
 		}
 		record.FocusedID = focusedID.String
+		record.BootstrapStatus = bootstrapStatus.String
+		record.BootstrapOutput = bootstrapOutput.String
+		if bootstrappedAt.Valid {
+			record.BootstrappedAt = bootstrappedAt.Time.Format(time.RFC3339)
+		}
 		record.CreatedAt = createdAt.Format(time.RFC3339)
 		record.UpdatedAt = updatedAt.Format(time.RFC3339)
 		workbenches = append(workbenches, record)
@@ -383,7 +415,7 @@ func (r *WorkbenchRepository) GetByFocusedID(ctx context.Context, focusedID stri
 	}
 
 	rows, err := r.db.QueryContext(ctx,
-		`SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, created_at, updated_at
+		`SELECT id, workshop_id, name, repo_id, status, home_branch, current_branch, focused_id, bootstrap_status, bootstrap_output, bootstrapped_at, created_at, updated_at
 		FROM workbenches WHERE focused_id = ? AND status = 'active'`,
 		focusedID,
 	)
@@ -395,18 +427,22 @@ func (r *WorkbenchRepository) GetByFocusedID(ctx context.Context, focusedID stri
 	var workbenches []*secondary.WorkbenchRecord
 	for rows.Next() {
 		var (
-			repoID        sql.NullString
-			homeBranch    sql.NullString
-			currentBranch sql.NullString
-			focusedIDVal  sql.NullString
-			createdAt     time.Time
-			updatedAt     time.Time
+			repoID          sql.NullString
+			homeBranch      sql.NullString
+			currentBranch   sql.NullString
+			focusedIDVal    sql.NullString
+			bootstrapStatus sql.NullString
+			bootstrapOutput sql.NullString
+			bootstrappedAt  sql.NullTime
+			createdAt       time.Time
+			updatedAt       time.Time
 		)
 
 		record := &secondary.WorkbenchRecord{}
 		err := rows.Scan(
 			&record.ID, &record.WorkshopID, &record.Name,
 			&repoID, &record.Status, &homeBranch, &currentBranch, &focusedIDVal,
+			&bootstrapStatus, &bootstrapOutput, &bootstrappedAt,
 			&createdAt, &updatedAt,
 		)
 		if err != nil {
@@ -417,6 +453,11 @@ func (r *WorkbenchRepository) GetByFocusedID(ctx context.Context, focusedID stri
 		record.HomeBranch = homeBranch.String
 		record.CurrentBranch = currentBranch.String
 		record.FocusedID = focusedIDVal.String
+		record.BootstrapStatus = bootstrapStatus.String
+		record.BootstrapOutput = bootstrapOutput.String
+		if bootstrappedAt.Valid {
+			record.BootstrappedAt = bootstrappedAt.Time.Format(time.RFC3339)
+		}
 		record.CreatedAt = createdAt.Format(time.RFC3339)
 		record.UpdatedAt = updatedAt.Format(time.RFC3339)
 
@@ -426,6 +467,36 @@ func (r *WorkbenchRepository) GetByFocusedID(ctx context.Context, focusedID stri
 	return workbenches, nil
 }
 
+// UpdateBootstrapStatus records the outcome of a bootstrap run.
+// bootstrapped_at is stamped for terminal statuses (succeeded, failed).
+func (r *WorkbenchRepository) UpdateBootstrapStatus(ctx context.Context, id, status, output string) error {
+	var statusValue, outputValue sql.NullString
+	if status != "" {
+		statusValue = sql.NullString{String: status, Valid: true}
+	}
+	if output != "" {
+		outputValue = sql.NullString{String: output, Valid: true}
+	}
+
+	query := "UPDATE workbenches SET bootstrap_status = ?, bootstrap_output = ?, updated_at = CURRENT_TIMESTAMP"
+	if status == "succeeded" || status == "failed" {
+		query += ", bootstrapped_at = CURRENT_TIMESTAMP"
+	}
+	query += " WHERE id = ?"
+
+	result, err := r.db.ExecContext(ctx, query, statusValue, outputValue, id)
+	if err != nil {
+		return fmt.Errorf("failed to update workbench bootstrap status: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("workbench %s not found", id)
+	}
+
+	return nil
+}
+
 // GetNextID returns the next available workbench ID.
 func (r *WorkbenchRepository) GetNextID(ctx context.Context) (string, error) {
 	var maxID int
@@ -475,6 +475,52 @@ func TestWorkbenchRepository_UpdateFocusedID_NotFound(t *testing.T) {
 	}
 }
 
+func TestWorkbenchRepository_UpdateBootstrapStatus(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewWorkbenchRepository(db, nil)
+	ctx := context.Background()
+
+	seedWorkbench(t, db, "BENCH-001", "", "test")
+
+	// Pending does not stamp bootstrapped_at
+	if err := repo.UpdateBootstrapStatus(ctx, "BENCH-001", "pending", ""); err != nil {
+		t.Fatalf("UpdateBootstrapStatus (pending) failed: %v", err)
+	}
+	got, _ := repo.GetByID(ctx, "BENCH-001")
+	if got.BootstrapStatus != "pending" {
+		t.Errorf("expected BootstrapStatus 'pending', got %q", got.BootstrapStatus)
+	}
+	if got.BootstrappedAt != "" {
+		t.Errorf("expected empty BootstrappedAt while pending, got %q", got.BootstrappedAt)
+	}
+
+	// Terminal status records output and timestamp
+	if err := repo.UpdateBootstrapStatus(ctx, "BENCH-001", "failed", "nvm: command not found"); err != nil {
+		t.Fatalf("UpdateBootstrapStatus (failed) failed: %v", err)
+	}
+	got, _ = repo.GetByID(ctx, "BENCH-001")
+	if got.BootstrapStatus != "failed" {
+		t.Errorf("expected BootstrapStatus 'failed', got %q", got.BootstrapStatus)
+	}
+	if got.BootstrapOutput != "nvm: command not found" {
+		t.Errorf("expected BootstrapOutput recorded, got %q", got.BootstrapOutput)
+	}
+	if got.BootstrappedAt == "" {
+		t.Error("expected BootstrappedAt to be set")
+	}
+}
+
+func TestWorkbenchRepository_UpdateBootstrapStatus_NotFound(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewWorkbenchRepository(db, nil)
+	ctx := context.Background()
+
+	err := repo.UpdateBootstrapStatus(ctx, "BENCH-999", "succeeded", "")
+	if err == nil {
+		t.Error("expected error for non-existent workbench")
+	}
+}
+
 func TestWorkbenchRepository_GetByFocusedID(t *testing.T) {
 	db := setupTestDB(t)
 	repo := sqlite.NewWorkbenchRepository(db, nil)
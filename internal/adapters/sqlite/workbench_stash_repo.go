@@ -0,0 +1,156 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// WorkbenchStashRepository implements secondary.WorkbenchStashRepository with SQLite.
+type WorkbenchStashRepository struct {
+	db *sql.DB
+}
+
+// NewWorkbenchStashRepository creates a new SQLite workbench stash repository.
+func NewWorkbenchStashRepository(db *sql.DB) *WorkbenchStashRepository {
+	return &WorkbenchStashRepository{db: db}
+}
+
+const workbenchStashSelectCols = "id, workbench_id, repo_id, task_id, branch, commit_sha, file_count, message, status, restored_to_workbench_id, created_at, restored_at"
+
+// Create persists a new stash record.
+func (r *WorkbenchStashRepository) Create(ctx context.Context, stash *secondary.WorkbenchStashRecord) error {
+	var repoID, taskID, branch, message sql.NullString
+	if stash.RepoID != "" {
+		repoID = sql.NullString{String: stash.RepoID, Valid: true}
+	}
+	if stash.TaskID != "" {
+		taskID = sql.NullString{String: stash.TaskID, Valid: true}
+	}
+	if stash.Branch != "" {
+		branch = sql.NullString{String: stash.Branch, Valid: true}
+	}
+	if stash.Message != "" {
+		message = sql.NullString{String: stash.Message, Valid: true}
+	}
+
+	_, err := r.db.ExecContext(ctx,
+		"INSERT INTO workbench_stashes (id, workbench_id, repo_id, task_id, branch, commit_sha, file_count, message) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		stash.ID, stash.WorkbenchID, repoID, taskID, branch, stash.CommitSHA, stash.FileCount, message,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create stash: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a stash by its ID.
+func (r *WorkbenchStashRepository) GetByID(ctx context.Context, id string) (*secondary.WorkbenchStashRecord, error) {
+	row := r.db.QueryRowContext(ctx, "SELECT "+workbenchStashSelectCols+" FROM workbench_stashes WHERE id = ?", id)
+	record, err := scanWorkbenchStash(row)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("stash %s not found", id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stash: %w", err)
+	}
+	return record, nil
+}
+
+// List retrieves stashes matching the given filters, newest first.
+func (r *WorkbenchStashRepository) List(ctx context.Context, filters secondary.WorkbenchStashFilters) ([]*secondary.WorkbenchStashRecord, error) {
+	query := "SELECT " + workbenchStashSelectCols + " FROM workbench_stashes WHERE 1=1"
+	args := []any{}
+
+	if filters.WorkbenchID != "" {
+		query += " AND workbench_id = ?"
+		args = append(args, filters.WorkbenchID)
+	}
+	if filters.TaskID != "" {
+		query += " AND task_id = ?"
+		args = append(args, filters.TaskID)
+	}
+	if filters.Status != "" {
+		query += " AND status = ?"
+		args = append(args, filters.Status)
+	}
+
+	query += " ORDER BY created_at DESC, id DESC"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stashes: %w", err)
+	}
+	defer rows.Close()
+
+	var stashes []*secondary.WorkbenchStashRecord
+	for rows.Next() {
+		record, err := scanWorkbenchStash(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan stash: %w", err)
+		}
+		stashes = append(stashes, record)
+	}
+	return stashes, rows.Err()
+}
+
+// MarkRestored records that a stash was applied to a workbench.
+func (r *WorkbenchStashRepository) MarkRestored(ctx context.Context, id, workbenchID string) error {
+	result, err := r.db.ExecContext(ctx,
+		"UPDATE workbench_stashes SET status = 'restored', restored_to_workbench_id = ?, restored_at = CURRENT_TIMESTAMP WHERE id = ?",
+		workbenchID, id,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark stash restored: %w", err)
+	}
+
+	rowsAffected, _ := result.RowsAffected()
+	if rowsAffected == 0 {
+		return fmt.Errorf("stash %s not found", id)
+	}
+	return nil
+}
+
+// GetNextID returns the next available stash ID.
+func (r *WorkbenchStashRepository) GetNextID(ctx context.Context) (string, error) {
+	var maxID int
+	err := r.db.QueryRowContext(ctx,
+		"SELECT COALESCE(MAX(CAST(SUBSTR(id, 7) AS INTEGER)), 0) FROM workbench_stashes",
+	).Scan(&maxID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get next stash ID: %w", err)
+	}
+
+	return fmt.Sprintf("STASH-%03d", maxID+1), nil
+}
+
+// scanWorkbenchStash scans a workbench stash row into a WorkbenchStashRecord.
+func scanWorkbenchStash(scanner interface {
+	Scan(dest ...any) error
+}) (*secondary.WorkbenchStashRecord, error) {
+	var repoID, taskID, branch, message, restoredTo sql.NullString
+	var createdAt time.Time
+	var restoredAt sql.NullTime
+	record := &secondary.WorkbenchStashRecord{}
+	if err := scanner.Scan(&record.ID, &record.WorkbenchID, &repoID, &taskID, &branch, &record.CommitSHA,
+		&record.FileCount, &message, &record.Status, &restoredTo, &createdAt, &restoredAt); err != nil {
+		return nil, err
+	}
+
+	record.RepoID = repoID.String
+	record.TaskID = taskID.String
+	record.Branch = branch.String
+	record.Message = message.String
+	record.RestoredToWorkbenchID = restoredTo.String
+	record.CreatedAt = createdAt.Format(time.RFC3339)
+	if restoredAt.Valid {
+		record.RestoredAt = restoredAt.Time.Format(time.RFC3339)
+	}
+	return record, nil
+}
+
+// Ensure WorkbenchStashRepository implements the interface
+var _ secondary.WorkbenchStashRepository = (*WorkbenchStashRepository)(nil)
@@ -0,0 +1,89 @@
+package sqlite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/adapters/sqlite"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func TestWorkbenchStashRepository_CreateListRestore(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewWorkbenchStashRepository(db)
+	ctx := context.Background()
+
+	seedCommission(t, db, "COMM-001", "")
+	seedTask(t, db, "TASK-001", "COMM-001", "")
+	seedWorkbench(t, db, "BENCH-001", "", "bench-one")
+	seedWorkbench(t, db, "BENCH-002", "", "bench-two")
+
+	id, err := repo.GetNextID(ctx)
+	if err != nil {
+		t.Fatalf("GetNextID failed: %v", err)
+	}
+	if id != "STASH-001" {
+		t.Errorf("expected STASH-001, got %s", id)
+	}
+
+	for _, stash := range []*secondary.WorkbenchStashRecord{
+		{ID: "STASH-001", WorkbenchID: "BENCH-001", TaskID: "TASK-001", Branch: "feature", CommitSHA: "aaa111", FileCount: 3, Message: "wip"},
+		{ID: "STASH-002", WorkbenchID: "BENCH-001", CommitSHA: "bbb222", FileCount: 1},
+	} {
+		if err := repo.Create(ctx, stash); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+
+	got, err := repo.GetByID(ctx, "STASH-001")
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if got.TaskID != "TASK-001" || got.Branch != "feature" || got.CommitSHA != "aaa111" ||
+		got.FileCount != 3 || got.Message != "wip" || got.Status != "stashed" || got.RestoredAt != "" {
+		t.Errorf("unexpected stash: %+v", got)
+	}
+	if _, err := repo.GetByID(ctx, "STASH-999"); err == nil {
+		t.Error("expected error for missing stash")
+	}
+
+	// Newest first
+	stashes, err := repo.List(ctx, secondary.WorkbenchStashFilters{WorkbenchID: "BENCH-001"})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(stashes) != 2 || stashes[0].ID != "STASH-002" {
+		t.Errorf("expected STASH-002 first, got %+v", stashes)
+	}
+
+	if err := repo.MarkRestored(ctx, "STASH-001", "BENCH-002"); err != nil {
+		t.Fatalf("MarkRestored failed: %v", err)
+	}
+	if err := repo.MarkRestored(ctx, "STASH-999", "BENCH-002"); err == nil {
+		t.Error("expected error restoring missing stash")
+	}
+
+	restored, err := repo.GetByID(ctx, "STASH-001")
+	if err != nil {
+		t.Fatalf("GetByID failed: %v", err)
+	}
+	if restored.Status != "restored" || restored.RestoredToWorkbenchID != "BENCH-002" || restored.RestoredAt == "" {
+		t.Errorf("unexpected restored stash: %+v", restored)
+	}
+
+	outstanding, err := repo.List(ctx, secondary.WorkbenchStashFilters{TaskID: "TASK-001", Status: "stashed"})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(outstanding) != 0 {
+		t.Errorf("expected no outstanding stashes for TASK-001, got %d", len(outstanding))
+	}
+
+	id, err = repo.GetNextID(ctx)
+	if err != nil {
+		t.Fatalf("GetNextID failed: %v", err)
+	}
+	if id != "STASH-003" {
+		t.Errorf("expected STASH-003, got %s", id)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/example/orc/internal/ports/secondary"
@@ -22,7 +23,7 @@ func NewWorkshopLogRepository(db *sql.DB) *WorkshopLogRepository {
 
 // Create persists a new workshop log entry.
 func (r *WorkshopLogRepository) Create(ctx context.Context, log *secondary.WorkshopLogRecord) error {
-	var actorID, fieldName, oldValue, newValue sql.NullString
+	var actorID, fieldName, oldValue, newValue, undoOf sql.NullString
 	if log.ActorID != "" {
 		actorID = sql.NullString{String: log.ActorID, Valid: true}
 	}
@@ -35,9 +36,12 @@ func (r *WorkshopLogRepository) Create(ctx context.Context, log *secondary.Works
 	if log.NewValue != "" {
 		newValue = sql.NullString{String: log.NewValue, Valid: true}
 	}
+	if log.UndoOf != "" {
+		undoOf = sql.NullString{String: log.UndoOf, Valid: true}
+	}
 
 	_, err := r.db.ExecContext(ctx,
-		`INSERT INTO workshop_logs (id, workshop_id, actor_id, entity_type, entity_id, action, field_name, old_value, new_value) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		`INSERT INTO workshop_logs (id, workshop_id, actor_id, entity_type, entity_id, action, field_name, old_value, new_value, undo_of, forced) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
 		log.ID,
 		log.WorkshopID,
 		actorID,
@@ -47,6 +51,8 @@ func (r *WorkshopLogRepository) Create(ctx context.Context, log *secondary.Works
 		fieldName,
 		oldValue,
 		newValue,
+		undoOf,
+		log.Forced,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to create workshop log: %w", err)
@@ -62,13 +68,14 @@ func (r *WorkshopLogRepository) GetByID(ctx context.Context, id string) (*second
 		fieldName sql.NullString
 		oldValue  sql.NullString
 		newValue  sql.NullString
+		undoOf    sql.NullString
 		timestamp time.Time
 		createdAt time.Time
 	)
 
 	record := &secondary.WorkshopLogRecord{}
 	err := r.db.QueryRowContext(ctx,
-		`SELECT id, workshop_id, timestamp, actor_id, entity_type, entity_id, action, field_name, old_value, new_value, created_at FROM workshop_logs WHERE id = ?`,
+		`SELECT id, workshop_id, timestamp, actor_id, entity_type, entity_id, action, field_name, old_value, new_value, undo_of, forced, created_at FROM workshop_logs WHERE id = ?`,
 		id,
 	).Scan(&record.ID,
 		&record.WorkshopID,
@@ -80,6 +87,8 @@ func (r *WorkshopLogRepository) GetByID(ctx context.Context, id string) (*second
 		&fieldName,
 		&oldValue,
 		&newValue,
+		&undoOf,
+		&record.Forced,
 		&createdAt)
 
 	if err == sql.ErrNoRows {
@@ -93,6 +102,7 @@ func (r *WorkshopLogRepository) GetByID(ctx context.Context, id string) (*second
 	record.FieldName = fieldName.String
 	record.OldValue = oldValue.String
 	record.NewValue = newValue.String
+	record.UndoOf = undoOf.String
 	record.CreatedAt = createdAt.Format(time.RFC3339)
 
 	return record, nil
@@ -100,7 +110,7 @@ func (r *WorkshopLogRepository) GetByID(ctx context.Context, id string) (*second
 
 // List retrieves log entries matching the given filters.
 func (r *WorkshopLogRepository) List(ctx context.Context, filters secondary.WorkshopLogFilters) ([]*secondary.WorkshopLogRecord, error) {
-	query := `SELECT id, workshop_id, timestamp, actor_id, entity_type, entity_id, action, field_name, old_value, new_value, created_at FROM workshop_logs WHERE 1=1`
+	query := `SELECT id, workshop_id, timestamp, actor_id, entity_type, entity_id, action, field_name, old_value, new_value, undo_of, forced, created_at FROM workshop_logs WHERE 1=1`
 	args := []any{}
 
 	if filters.WorkshopID != "" {
@@ -128,6 +138,16 @@ func (r *WorkshopLogRepository) List(ctx context.Context, filters secondary.Work
 		args = append(args, filters.Action)
 	}
 
+	if filters.Since != "" {
+		since, err := time.Parse(time.RFC3339, filters.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since timestamp %q: %w", filters.Since, err)
+		}
+		// Stored as CURRENT_TIMESTAMP text (UTC, second resolution)
+		query += " AND timestamp >= ?"
+		args = append(args, since.UTC().Format("2006-01-02 15:04:05"))
+	}
+
 	query += " ORDER BY timestamp DESC"
 
 	if filters.Limit > 0 {
@@ -141,43 +161,7 @@ func (r *WorkshopLogRepository) List(ctx context.Context, filters secondary.Work
 	}
 	defer rows.Close()
 
-	var logs []*secondary.WorkshopLogRecord
-	for rows.Next() {
-		var (
-			actorID   sql.NullString
-			fieldName sql.NullString
-			oldValue  sql.NullString
-			newValue  sql.NullString
-			timestamp time.Time
-			createdAt time.Time
-		)
-
-		record := &secondary.WorkshopLogRecord{}
-		err := rows.Scan(&record.ID,
-			&record.WorkshopID,
-			&timestamp,
-			&actorID,
-			&record.EntityType,
-			&record.EntityID,
-			&record.Action,
-			&fieldName,
-			&oldValue,
-			&newValue,
-			&createdAt)
-		if err != nil {
-			return nil, fmt.Errorf("failed to scan workshop log: %w", err)
-		}
-		record.Timestamp = timestamp.Format(time.RFC3339)
-		record.ActorID = actorID.String
-		record.FieldName = fieldName.String
-		record.OldValue = oldValue.String
-		record.NewValue = newValue.String
-		record.CreatedAt = createdAt.Format(time.RFC3339)
-
-		logs = append(logs, record)
-	}
-
-	return logs, nil
+	return scanWorkshopLogs(rows)
 }
 
 // GetNextID returns the next available log ID.
@@ -218,5 +202,91 @@ func (r *WorkshopLogRepository) PruneOlderThan(ctx context.Context, days int) (i
 	return int(count), nil
 }
 
+// ListUndoable retrieves update entries made by an actor that have not been
+// undone and are not themselves undo entries, newest first.
+func (r *WorkshopLogRepository) ListUndoable(ctx context.Context, actorID string, fields []string, limit int) ([]*secondary.WorkshopLogRecord, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	query := `SELECT id, workshop_id, timestamp, actor_id, entity_type, entity_id, action, field_name, old_value, new_value, undo_of, forced, created_at FROM workshop_logs
+		WHERE action = 'update' AND actor_id = ? AND undo_of IS NULL
+		AND id NOT IN (SELECT undo_of FROM workshop_logs WHERE undo_of IS NOT NULL)
+		AND entity_type || '.' || field_name IN (?` + strings.Repeat(", ?", len(fields)-1) + `)
+		ORDER BY CAST(SUBSTR(id, 4) AS INTEGER) DESC`
+	args := []any{actorID}
+	for _, f := range fields {
+		args = append(args, f)
+	}
+
+	if limit > 0 {
+		query += " LIMIT ?"
+		args = append(args, limit)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list undoable workshop logs: %w", err)
+	}
+	defer rows.Close()
+
+	return scanWorkshopLogs(rows)
+}
+
+// IsUndone checks if a later entry reverted the given log entry.
+func (r *WorkshopLogRepository) IsUndone(ctx context.Context, logID string) (bool, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM workshop_logs WHERE undo_of = ?", logID).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check undo status: %w", err)
+	}
+	return count > 0, nil
+}
+
+// scanWorkshopLogs scans workshop log rows selected with the standard column list.
+func scanWorkshopLogs(rows *sql.Rows) ([]*secondary.WorkshopLogRecord, error) {
+	var logs []*secondary.WorkshopLogRecord
+	for rows.Next() {
+		var (
+			actorID   sql.NullString
+			fieldName sql.NullString
+			oldValue  sql.NullString
+			newValue  sql.NullString
+			undoOf    sql.NullString
+			timestamp time.Time
+			createdAt time.Time
+		)
+
+		record := &secondary.WorkshopLogRecord{}
+		err := rows.Scan(&record.ID,
+			&record.WorkshopID,
+			&timestamp,
+			&actorID,
+			&record.EntityType,
+			&record.EntityID,
+			&record.Action,
+			&fieldName,
+			&oldValue,
+			&newValue,
+			&undoOf,
+			&record.Forced,
+			&createdAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan workshop log: %w", err)
+		}
+		record.Timestamp = timestamp.Format(time.RFC3339)
+		record.ActorID = actorID.String
+		record.FieldName = fieldName.String
+		record.OldValue = oldValue.String
+		record.NewValue = newValue.String
+		record.UndoOf = undoOf.String
+		record.CreatedAt = createdAt.Format(time.RFC3339)
+
+		logs = append(logs, record)
+	}
+
+	return logs, nil
+}
+
 // Ensure WorkshopLogRepository implements the interface
 var _ secondary.WorkshopLogRepository = (*WorkshopLogRepository)(nil)
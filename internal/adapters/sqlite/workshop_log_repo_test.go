@@ -98,6 +98,35 @@ func TestWorkshopLogRepository_Create(t *testing.T) {
 		if got.NewValue != "" {
 			t.Errorf("NewValue = %q, want empty", got.NewValue)
 		}
+		if got.Forced {
+			t.Error("Forced = true, want false")
+		}
+	})
+
+	t.Run("records forced transitions", func(t *testing.T) {
+		record := &secondary.WorkshopLogRecord{
+			ID:         "WL-0003",
+			WorkshopID: "WORK-001",
+			EntityType: "shipment",
+			EntityID:   "SHIP-001",
+			Action:     "update",
+			FieldName:  "status",
+			OldValue:   "ready",
+			NewValue:   "closed",
+			Forced:     true,
+		}
+
+		if err := repo.Create(ctx, record); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+
+		got, err := repo.GetByID(ctx, "WL-0003")
+		if err != nil {
+			t.Fatalf("GetByID failed: %v", err)
+		}
+		if !got.Forced {
+			t.Error("Forced = false, want true")
+		}
 	})
 }
 
@@ -226,6 +255,23 @@ func TestWorkshopLogRepository_List(t *testing.T) {
 		}
 	})
 
+	t.Run("filters by since", func(t *testing.T) {
+		db.ExecContext(ctx, "UPDATE workshop_logs SET timestamp = '2026-01-01 09:00:00' WHERE id = 'WL-0001'")
+		db.ExecContext(ctx, "UPDATE workshop_logs SET timestamp = '2026-01-01 10:00:00' WHERE id IN ('WL-0002', 'WL-0003')")
+
+		list, err := repo.List(ctx, secondary.WorkshopLogFilters{Since: "2026-01-01T10:00:00Z"})
+		if err != nil {
+			t.Fatalf("List failed: %v", err)
+		}
+		if len(list) != 2 {
+			t.Errorf("len = %d, want 2 (boundary is inclusive)", len(list))
+		}
+
+		if _, err := repo.List(ctx, secondary.WorkshopLogFilters{Since: "yesterday"}); err == nil {
+			t.Error("expected error for invalid since timestamp")
+		}
+	})
+
 	t.Run("combines filters", func(t *testing.T) {
 		list, err := repo.List(ctx, secondary.WorkshopLogFilters{WorkshopID: "WORK-001", Action: "create"})
 		if err != nil {
@@ -307,3 +353,80 @@ func TestWorkshopLogRepository_WorkshopExists(t *testing.T) {
 		}
 	})
 }
+
+func TestWorkshopLogRepository_ListUndoable(t *testing.T) {
+	db := setupTestDB(t)
+	repo := sqlite.NewWorkshopLogRepository(db)
+	ctx := context.Background()
+
+	// Setup
+	db.ExecContext(ctx, "INSERT INTO factories (id, name, status) VALUES (?, ?, ?)", "FACT-001", "Test Factory", "active")
+	db.ExecContext(ctx, "INSERT INTO workshops (id, factory_id, name, status) VALUES (?, ?, ?, ?)", "WORK-001", "FACT-001", "Test Workshop", "active")
+
+	logs := []*secondary.WorkshopLogRecord{
+		{ID: "WL-0001", ActorID: "BENCH-001", EntityType: "task", EntityID: "TASK-001", Action: "update", FieldName: "status", OldValue: "open", NewValue: "in-progress"},
+		{ID: "WL-0002", ActorID: "BENCH-001", EntityType: "task", EntityID: "TASK-001", Action: "update", FieldName: "title", OldValue: "A", NewValue: "B"},
+		{ID: "WL-0003", ActorID: "BENCH-002", EntityType: "task", EntityID: "TASK-001", Action: "update", FieldName: "status", OldValue: "in-progress", NewValue: "closed"},
+		{ID: "WL-0004", ActorID: "BENCH-001", EntityType: "note", EntityID: "NOTE-001", Action: "update", FieldName: "status", OldValue: "open", NewValue: "closed"},
+		{ID: "WL-0005", ActorID: "BENCH-001", EntityType: "note", EntityID: "NOTE-001", Action: "update", FieldName: "status", OldValue: "closed", NewValue: "open", UndoOf: "WL-0004"},
+		{ID: "WL-0010", ActorID: "BENCH-001", EntityType: "shipment", EntityID: "SHIP-001", Action: "update", FieldName: "status", OldValue: "draft", NewValue: "ready"},
+	}
+	for _, l := range logs {
+		l.WorkshopID = "WORK-001"
+		if err := repo.Create(ctx, l); err != nil {
+			t.Fatalf("Create failed: %v", err)
+		}
+	}
+	fields := []string{"note.status", "shipment.status", "task.status"}
+
+	t.Run("returns actor's undoable entries newest first", func(t *testing.T) {
+		got, err := repo.ListUndoable(ctx, "BENCH-001", fields, 0)
+		if err != nil {
+			t.Fatalf("ListUndoable failed: %v", err)
+		}
+		// WL-0002 (field not undoable), WL-0003 (other actor), WL-0004 (undone), WL-0005 (an undo) excluded
+		if len(got) != 2 {
+			t.Fatalf("expected 2 entries, got %d", len(got))
+		}
+		if got[0].ID != "WL-0010" || got[1].ID != "WL-0001" {
+			t.Errorf("order = [%s %s], want [WL-0010 WL-0001]", got[0].ID, got[1].ID)
+		}
+	})
+
+	t.Run("respects limit", func(t *testing.T) {
+		got, err := repo.ListUndoable(ctx, "BENCH-001", fields, 1)
+		if err != nil {
+			t.Fatalf("ListUndoable failed: %v", err)
+		}
+		if len(got) != 1 || got[0].ID != "WL-0010" {
+			t.Errorf("expected only WL-0010, got %d entries", len(got))
+		}
+	})
+
+	t.Run("round-trips undo_of", func(t *testing.T) {
+		got, err := repo.GetByID(ctx, "WL-0005")
+		if err != nil {
+			t.Fatalf("GetByID failed: %v", err)
+		}
+		if got.UndoOf != "WL-0004" {
+			t.Errorf("UndoOf = %q, want %q", got.UndoOf, "WL-0004")
+		}
+	})
+
+	t.Run("IsUndone", func(t *testing.T) {
+		undone, err := repo.IsUndone(ctx, "WL-0004")
+		if err != nil {
+			t.Fatalf("IsUndone failed: %v", err)
+		}
+		if !undone {
+			t.Error("expected WL-0004 to be undone")
+		}
+		undone, err = repo.IsUndone(ctx, "WL-0001")
+		if err != nil {
+			t.Fatalf("IsUndone failed: %v", err)
+		}
+		if undone {
+			t.Error("expected WL-0001 not to be undone")
+		}
+	})
+}
@@ -258,6 +258,19 @@ func (r *WorkshopRepository) FactoryExists(ctx context.Context, factoryID string
 	return count > 0, nil
 }
 
+// CommissionExists checks if a commission exists.
+func (r *WorkshopRepository) CommissionExists(ctx context.Context, commissionID string) (bool, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx,
+		"SELECT COUNT(*) FROM commissions WHERE id = ?",
+		commissionID,
+	).Scan(&count)
+	if err != nil {
+		return false, fmt.Errorf("failed to check commission existence: %w", err)
+	}
+	return count > 0, nil
+}
+
 // SetActiveCommissionID updates the active commission for a workshop (Goblin context).
 // Pass empty string to clear.
 func (r *WorkshopRepository) SetActiveCommissionID(ctx context.Context, workshopID, commissionID string) error {
@@ -228,6 +228,9 @@ type DesiredWorkbench = tmuxpkg.DesiredWorkbench
 // ApplyPlan re-exports the reconciliation plan type.
 type ApplyPlan = tmuxpkg.ApplyPlan
 
+// DeadPane re-exports the dead workbench pane type.
+type DeadPane = tmuxpkg.DeadPane
+
 // NewGotmuxAdapter creates a new gotmux adapter.
 func NewGotmuxAdapter() (*GotmuxAdapter, error) {
 	return tmuxpkg.NewGotmuxAdapter()
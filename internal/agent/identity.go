@@ -24,25 +24,28 @@ type AgentIdentity struct {
 }
 
 // GetCurrentAgentID detects the current agent identity based on working directory context
-// If place_id is BENCH-XXX → IMP, otherwise → Goblin
+// If ORC_WORKBENCH or the nearest place_id is BENCH-XXX → IMP, otherwise → Goblin
 func GetCurrentAgentID() (*AgentIdentity, error) {
 	cwd, err := os.Getwd()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get working directory: %w", err)
 	}
 
-	// Check for config in current directory
-	cfg, err := config.LoadConfig(cwd)
-	if err == nil && cfg.PlaceID != "" {
-		if config.GetPlaceType(cfg.PlaceID) == config.PlaceTypeWorkbench {
-			// We're in a workbench - this is an IMP
-			return &AgentIdentity{
-				Type:   AgentTypeIMP,
-				ID:     cfg.PlaceID,
-				FullID: fmt.Sprintf("IMP-%s", cfg.PlaceID),
-			}, nil
+	placeID := os.Getenv(config.EnvWorkbench)
+	if placeID == "" {
+		// Check for config in the current directory or a parent
+		if cfg, _, err := config.FindConfig(cwd); err == nil {
+			placeID = cfg.PlaceID
 		}
 	}
+	if config.GetPlaceType(placeID) == config.PlaceTypeWorkbench {
+		// We're in a workbench - this is an IMP
+		return &AgentIdentity{
+			Type:   AgentTypeIMP,
+			ID:     placeID,
+			FullID: fmt.Sprintf("IMP-%s", placeID),
+		}, nil
+	}
 
 	// Not in a recognized place - we're a Goblin (orchestrator) by default
 	// Goblin can work anywhere: commission workspaces, ORC repo, anywhere
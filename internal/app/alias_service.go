@@ -0,0 +1,139 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corealias "github.com/example/orc/internal/core/alias"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// AliasServiceImpl implements the AliasService interface.
+type AliasServiceImpl struct {
+	aliasRepo    secondary.AliasRepository
+	shipmentRepo secondary.ShipmentRepository
+	taskRepo     secondary.TaskRepository
+	tomeRepo     secondary.TomeRepository
+}
+
+// NewAliasService creates a new AliasService with injected dependencies.
+func NewAliasService(
+	aliasRepo secondary.AliasRepository,
+	shipmentRepo secondary.ShipmentRepository,
+	taskRepo secondary.TaskRepository,
+	tomeRepo secondary.TomeRepository,
+) *AliasServiceImpl {
+	return &AliasServiceImpl{
+		aliasRepo:    aliasRepo,
+		shipmentRepo: shipmentRepo,
+		taskRepo:     taskRepo,
+		tomeRepo:     tomeRepo,
+	}
+}
+
+// SetAlias assigns a slug to a shipment, task, or tome, replacing any previous alias.
+func (s *AliasServiceImpl) SetAlias(ctx context.Context, entityID, slug string) error {
+	guardCtx := corealias.AssignAliasContext{EntityID: entityID, Slug: slug}
+
+	entityType, aliasable := corealias.EntityType(entityID)
+	var commissionID string
+	if aliasable {
+		var err error
+		commissionID, err = s.commissionOf(ctx, entityID)
+		if err != nil {
+			return err
+		}
+
+		existing, err := s.aliasRepo.FindBySlug(ctx, slug)
+		if err != nil {
+			return fmt.Errorf("failed to check alias: %w", err)
+		}
+		for _, a := range existing {
+			if a.CommissionID == commissionID {
+				guardCtx.HolderID = a.EntityID
+				_, holderErr := s.commissionOf(ctx, a.EntityID)
+				guardCtx.HolderExists = holderErr == nil
+			}
+		}
+	}
+
+	if err := corealias.CanAssignAlias(guardCtx).Error(); err != nil {
+		return err
+	}
+
+	return s.aliasRepo.Set(ctx, &secondary.AliasRecord{
+		EntityID:     entityID,
+		EntityType:   entityType,
+		CommissionID: commissionID,
+		Slug:         slug,
+	})
+}
+
+// RemoveAlias removes an entity's alias.
+func (s *AliasServiceImpl) RemoveAlias(ctx context.Context, entityID string) error {
+	return s.aliasRepo.Delete(ctx, entityID)
+}
+
+// ResolveAlias returns the entity ID a slug or renamed ID refers to, or ref
+// unchanged if it is neither.
+func (s *AliasServiceImpl) ResolveAlias(ctx context.Context, ref, commissionID string) (string, error) {
+	if !corealias.IsSlug(ref) {
+		newID, err := s.aliasRepo.GetRenamedID(ctx, ref)
+		if err != nil || newID == "" {
+			return ref, err
+		}
+		return newID, nil
+	}
+
+	aliases, err := s.aliasRepo.FindBySlug(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve alias: %w", err)
+	}
+
+	switch len(aliases) {
+	case 0:
+		return ref, nil
+	case 1:
+		return aliases[0].EntityID, nil
+	}
+
+	candidates := make([]string, len(aliases))
+	for i, a := range aliases {
+		if a.CommissionID == commissionID {
+			return a.EntityID, nil
+		}
+		candidates[i] = fmt.Sprintf("%s in %s", a.EntityID, a.CommissionID)
+	}
+	return "", fmt.Errorf("alias '%s' is ambiguous (%s). Use the ID or run from a commission context", ref, strings.Join(candidates, ", "))
+}
+
+// commissionOf returns the commission an aliasable entity belongs to.
+func (s *AliasServiceImpl) commissionOf(ctx context.Context, entityID string) (string, error) {
+	entityType, _ := corealias.EntityType(entityID)
+	switch entityType {
+	case "shipment":
+		shipment, err := s.shipmentRepo.GetByID(ctx, entityID)
+		if err != nil {
+			return "", err
+		}
+		return shipment.CommissionID, nil
+	case "task":
+		task, err := s.taskRepo.GetByID(ctx, entityID)
+		if err != nil {
+			return "", err
+		}
+		return task.CommissionID, nil
+	case "tome":
+		tome, err := s.tomeRepo.GetByID(ctx, entityID)
+		if err != nil {
+			return "", err
+		}
+		return tome.CommissionID, nil
+	}
+	return "", fmt.Errorf("cannot alias %s", entityID)
+}
+
+// Ensure AliasServiceImpl implements the interface
+var _ primary.AliasService = (*AliasServiceImpl)(nil)
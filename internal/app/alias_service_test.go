@@ -0,0 +1,190 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// mockAliasRepository implements secondary.AliasRepository for testing.
+type mockAliasRepository struct {
+	aliases map[string]*secondary.AliasRecord // entityID -> alias
+	renames map[string]string                 // old ID -> new ID
+}
+
+func newMockAliasRepository() *mockAliasRepository {
+	return &mockAliasRepository{aliases: make(map[string]*secondary.AliasRecord), renames: make(map[string]string)}
+}
+
+func (m *mockAliasRepository) Set(ctx context.Context, alias *secondary.AliasRecord) error {
+	for id, a := range m.aliases {
+		if a.Slug == alias.Slug && a.CommissionID == alias.CommissionID {
+			delete(m.aliases, id)
+		}
+	}
+	m.aliases[alias.EntityID] = alias
+	return nil
+}
+
+func (m *mockAliasRepository) Delete(ctx context.Context, entityID string) error {
+	if _, ok := m.aliases[entityID]; !ok {
+		return fmt.Errorf("%s has no alias", entityID)
+	}
+	delete(m.aliases, entityID)
+	return nil
+}
+
+func (m *mockAliasRepository) GetByEntity(ctx context.Context, entityID string) (*secondary.AliasRecord, error) {
+	return m.aliases[entityID], nil
+}
+
+func (m *mockAliasRepository) FindBySlug(ctx context.Context, slug string) ([]*secondary.AliasRecord, error) {
+	var result []*secondary.AliasRecord
+	for _, a := range m.aliases {
+		if a.Slug == slug {
+			result = append(result, a)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockAliasRepository) GetRenamedID(ctx context.Context, oldID string) (string, error) {
+	return m.renames[oldID], nil
+}
+
+// newTestAliasService seeds SHIP-014, TASK-001, and TOME-001 in COMM-001 and
+// SHIP-020 in COMM-002.
+func newTestAliasService() (*AliasServiceImpl, *mockAliasRepository) {
+	aliasRepo := newMockAliasRepository()
+	shipmentRepo := newMockShipmentRepository()
+	taskRepo := newMockTaskRepository()
+	tomeRepo := newMockTomeRepository()
+	service := NewAliasService(aliasRepo, shipmentRepo, taskRepo, tomeRepo)
+
+	shipmentRepo.shipments["SHIP-014"] = &secondary.ShipmentRecord{ID: "SHIP-014", CommissionID: "COMM-001"}
+	shipmentRepo.shipments["SHIP-020"] = &secondary.ShipmentRecord{ID: "SHIP-020", CommissionID: "COMM-002"}
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", CommissionID: "COMM-001"}
+	tomeRepo.tomes["TOME-001"] = &secondary.TomeRecord{ID: "TOME-001", CommissionID: "COMM-001"}
+	return service, aliasRepo
+}
+
+func TestAliasService_SetAndResolve(t *testing.T) {
+	service, aliasRepo := newTestAliasService()
+	ctx := context.Background()
+
+	if err := service.SetAlias(ctx, "SHIP-014", "auth-refactor"); err != nil {
+		t.Fatalf("SetAlias failed: %v", err)
+	}
+	if got := aliasRepo.aliases["SHIP-014"]; got == nil || got.CommissionID != "COMM-001" || got.EntityType != "shipment" {
+		t.Fatalf("unexpected stored alias: %+v", got)
+	}
+
+	id, err := service.ResolveAlias(ctx, "auth-refactor", "")
+	if err != nil {
+		t.Fatalf("ResolveAlias failed: %v", err)
+	}
+	if id != "SHIP-014" {
+		t.Errorf("ResolveAlias = %q, want SHIP-014", id)
+	}
+
+	// Renamed IDs resolve to their new ID
+	aliasRepo.renames["MISSION-004"] = "COMM-004"
+	if got, err := service.ResolveAlias(ctx, "MISSION-004", ""); err != nil || got != "COMM-004" {
+		t.Errorf("ResolveAlias(MISSION-004) = %q, %v; want COMM-004", got, err)
+	}
+
+	// IDs and unknown slugs pass through unchanged
+	for _, ref := range []string{"SHIP-014", "no-such-alias"} {
+		got, err := service.ResolveAlias(ctx, ref, "")
+		if err != nil || got != ref {
+			t.Errorf("ResolveAlias(%q) = %q, %v; want unchanged", ref, got, err)
+		}
+	}
+}
+
+func TestAliasService_SetAlias_SlugTaken(t *testing.T) {
+	service, _ := newTestAliasService()
+	ctx := context.Background()
+
+	if err := service.SetAlias(ctx, "SHIP-014", "auth"); err != nil {
+		t.Fatalf("SetAlias failed: %v", err)
+	}
+
+	err := service.SetAlias(ctx, "TASK-001", "auth")
+	if err == nil {
+		t.Fatal("expected error for slug already used in the commission")
+	}
+	if !strings.Contains(err.Error(), "SHIP-014") {
+		t.Errorf("expected error to name the holder, got %q", err.Error())
+	}
+
+	// A different commission may reuse the slug
+	if err := service.SetAlias(ctx, "SHIP-020", "auth"); err != nil {
+		t.Fatalf("SetAlias in other commission failed: %v", err)
+	}
+}
+
+func TestAliasService_SetAlias_ReuseFromDeletedEntity(t *testing.T) {
+	service, aliasRepo := newTestAliasService()
+	ctx := context.Background()
+
+	aliasRepo.aliases["TASK-999"] = &secondary.AliasRecord{EntityID: "TASK-999", EntityType: "task", CommissionID: "COMM-001", Slug: "docs"}
+
+	if err := service.SetAlias(ctx, "TOME-001", "docs"); err != nil {
+		t.Fatalf("expected slug of deleted task to be reusable, got %v", err)
+	}
+	if _, ok := aliasRepo.aliases["TASK-999"]; ok {
+		t.Error("expected stale alias to be replaced")
+	}
+}
+
+func TestAliasService_SetAlias_Invalid(t *testing.T) {
+	service, _ := newTestAliasService()
+	ctx := context.Background()
+
+	if err := service.SetAlias(ctx, "SHIP-014", "Not A Slug"); err == nil {
+		t.Error("expected error for invalid slug")
+	}
+	if err := service.SetAlias(ctx, "NOTE-001", "idea"); err == nil {
+		t.Error("expected error for non-aliasable entity")
+	}
+	if err := service.SetAlias(ctx, "SHIP-999", "ghost"); err == nil {
+		t.Error("expected error for missing shipment")
+	}
+}
+
+func TestAliasService_ResolveAlias_Ambiguous(t *testing.T) {
+	service, _ := newTestAliasService()
+	ctx := context.Background()
+
+	_ = service.SetAlias(ctx, "SHIP-014", "cleanup")
+	_ = service.SetAlias(ctx, "SHIP-020", "cleanup")
+
+	if _, err := service.ResolveAlias(ctx, "cleanup", ""); err == nil {
+		t.Fatal("expected error for alias used in two commissions")
+	}
+
+	id, err := service.ResolveAlias(ctx, "cleanup", "COMM-002")
+	if err != nil {
+		t.Fatalf("ResolveAlias with commission failed: %v", err)
+	}
+	if id != "SHIP-020" {
+		t.Errorf("ResolveAlias = %q, want SHIP-020", id)
+	}
+}
+
+func TestAliasService_RemoveAlias(t *testing.T) {
+	service, _ := newTestAliasService()
+	ctx := context.Background()
+
+	_ = service.SetAlias(ctx, "SHIP-014", "auth")
+	if err := service.RemoveAlias(ctx, "SHIP-014"); err != nil {
+		t.Fatalf("RemoveAlias failed: %v", err)
+	}
+	if err := service.RemoveAlias(ctx, "SHIP-014"); err == nil {
+		t.Error("expected error removing missing alias")
+	}
+}
@@ -0,0 +1,118 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	coreshipment "github.com/example/orc/internal/core/shipment"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// AutorunServiceImpl implements the AutorunService interface.
+type AutorunServiceImpl struct {
+	shipmentRepo secondary.ShipmentRepository
+	taskRepo     secondary.TaskRepository
+	planRepo     secondary.PlanRepository
+}
+
+// NewAutorunService creates a new AutorunService with injected dependencies.
+func NewAutorunService(
+	shipmentRepo secondary.ShipmentRepository,
+	taskRepo secondary.TaskRepository,
+	planRepo secondary.PlanRepository,
+) *AutorunServiceImpl {
+	return &AutorunServiceImpl{
+		shipmentRepo: shipmentRepo,
+		taskRepo:     taskRepo,
+		planRepo:     planRepo,
+	}
+}
+
+// SetAutorun turns autorun on, pauses it, or turns it off.
+func (s *AutorunServiceImpl) SetAutorun(ctx context.Context, shipmentID, state string) error {
+	switch state {
+	case "", primary.AutorunOn, primary.AutorunPaused:
+	default:
+		return fmt.Errorf("invalid autorun state %q (valid: on, paused, or empty for off)", state)
+	}
+
+	shipment, err := s.shipmentRepo.GetByID(ctx, shipmentID)
+	if err != nil {
+		return err
+	}
+	if state != "" && shipment.Status == "closed" {
+		return fmt.Errorf("shipment %s is closed", shipmentID)
+	}
+	if state == primary.AutorunPaused && shipment.Autorun == "" {
+		return fmt.Errorf("autorun is not on for %s\nHint: orc shipment autorun on %s", shipmentID, shipmentID)
+	}
+	return s.shipmentRepo.UpdateAutorun(ctx, shipmentID, state)
+}
+
+// NextStep decides what the shipment's IMP should do next.
+func (s *AutorunServiceImpl) NextStep(ctx context.Context, shipmentID string) (*primary.AutorunStep, error) {
+	shipment, err := s.shipmentRepo.GetByID(ctx, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+	taskRecords, err := s.taskRepo.List(ctx, secondary.TaskFilters{ShipmentID: shipmentID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	plans, err := s.planRepo.List(ctx, secondary.PlanFilters{CommissionID: shipment.CommissionID, Status: "draft"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+	draftPlans := make(map[string]string)
+	for _, p := range plans {
+		if p.TaskID != "" {
+			draftPlans[p.TaskID] = p.ID
+		}
+	}
+
+	titles := make(map[string]string, len(taskRecords))
+	tasks := make([]coreshipment.AutorunTask, len(taskRecords))
+	for i, t := range taskRecords {
+		titles[t.ID] = t.Title
+		tasks[i] = coreshipment.AutorunTask{ID: t.ID, Status: t.Status, DraftPlanID: draftPlans[t.ID]}
+		if t.DependsOn != "" {
+			_ = json.Unmarshal([]byte(t.DependsOn), &tasks[i].DependsOn)
+		}
+	}
+
+	depStatus := make(map[string]string)
+	for _, t := range tasks {
+		for _, dep := range t.DependsOn {
+			if _, inShipment := titles[dep]; inShipment {
+				continue
+			}
+			if _, seen := depStatus[dep]; seen {
+				continue
+			}
+			// Deleted dependencies are left out and don't hold the task back
+			if record, err := s.taskRepo.GetByID(ctx, dep); err == nil {
+				depStatus[dep] = record.Status
+			}
+		}
+	}
+
+	step := coreshipment.NextAutorunStep(coreshipment.AutorunContext{
+		State:          shipment.Autorun,
+		ShipmentStatus: shipment.Status,
+		Tasks:          tasks,
+		DepStatus:      depStatus,
+	})
+	return &primary.AutorunStep{
+		ShipmentID: shipmentID,
+		State:      shipment.Autorun,
+		Action:     step.Action,
+		TaskID:     step.TaskID,
+		TaskTitle:  titles[step.TaskID],
+		Reason:     step.Reason,
+	}, nil
+}
+
+// Ensure AutorunServiceImpl implements the interface
+var _ primary.AutorunService = (*AutorunServiceImpl)(nil)
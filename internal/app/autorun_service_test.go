@@ -0,0 +1,71 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func newTestAutorunService() (*AutorunServiceImpl, *mockShipmentRepository, *mockTaskRepositoryForShipment, *mockPlanRepository) {
+	shipmentRepo := newMockShipmentRepository()
+	taskRepo := newMockTaskRepositoryForShipment()
+	planRepo := newMockPlanRepository()
+	return NewAutorunService(shipmentRepo, taskRepo, planRepo), shipmentRepo, taskRepo, planRepo
+}
+
+func TestAutorunService_SetAutorun(t *testing.T) {
+	service, shipmentRepo, _, _ := newTestAutorunService()
+	ctx := context.Background()
+	shipmentRepo.shipments["SHIP-001"] = &secondary.ShipmentRecord{ID: "SHIP-001", Status: "ready"}
+	shipmentRepo.shipments["SHIP-002"] = &secondary.ShipmentRecord{ID: "SHIP-002", Status: "closed"}
+
+	if err := service.SetAutorun(ctx, "SHIP-001", primary.AutorunPaused); err == nil {
+		t.Error("expected pausing to fail while autorun is off")
+	}
+	if err := service.SetAutorun(ctx, "SHIP-001", primary.AutorunOn); err != nil {
+		t.Fatalf("SetAutorun(on) error = %v", err)
+	}
+	if err := service.SetAutorun(ctx, "SHIP-001", primary.AutorunPaused); err != nil {
+		t.Fatalf("SetAutorun(paused) error = %v", err)
+	}
+	if got := shipmentRepo.shipments["SHIP-001"].Autorun; got != primary.AutorunPaused {
+		t.Errorf("Autorun = %q, want paused", got)
+	}
+
+	if err := service.SetAutorun(ctx, "SHIP-001", "sometimes"); err == nil {
+		t.Error("expected error for an invalid state")
+	}
+	if err := service.SetAutorun(ctx, "SHIP-002", primary.AutorunOn); err == nil || !strings.Contains(err.Error(), "closed") {
+		t.Errorf("expected closed shipment error, got %v", err)
+	}
+}
+
+func TestAutorunService_NextStep(t *testing.T) {
+	service, shipmentRepo, taskRepo, planRepo := newTestAutorunService()
+	ctx := context.Background()
+	shipmentRepo.shipments["SHIP-001"] = &secondary.ShipmentRecord{ID: "SHIP-001", CommissionID: "COMM-001", Status: "in-progress", Autorun: primary.AutorunOn}
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", ShipmentID: "SHIP-001", Title: "Schema", Status: "closed"}
+	taskRepo.tasks["TASK-002"] = &secondary.TaskRecord{ID: "TASK-002", ShipmentID: "SHIP-001", Title: "API", Status: "open", DependsOn: `["TASK-003"]`}
+	taskRepo.tasks["TASK-003"] = &secondary.TaskRecord{ID: "TASK-003", ShipmentID: "SHIP-001", Title: "Model", Status: "open"}
+
+	step, err := service.NextStep(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("NextStep() error = %v", err)
+	}
+	if step.Action != primary.AutorunRun || step.TaskID != "TASK-003" || step.TaskTitle != "Model" {
+		t.Errorf("NextStep() = %+v, want run TASK-003 (Model)", step)
+	}
+
+	// A draft plan for the next task is an approval gate
+	planRepo.plans["PLAN-001"] = &secondary.PlanRecord{ID: "PLAN-001", CommissionID: "COMM-001", TaskID: "TASK-003", Status: "draft"}
+	step, err = service.NextStep(ctx, "SHIP-001")
+	if err != nil {
+		t.Fatalf("NextStep() error = %v", err)
+	}
+	if step.Action != primary.AutorunWait || !strings.Contains(step.Reason, "PLAN-001") {
+		t.Errorf("NextStep() = %+v, want wait on PLAN-001", step)
+	}
+}
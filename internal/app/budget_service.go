@@ -0,0 +1,145 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corecommission "github.com/example/orc/internal/core/commission"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// BudgetServiceImpl implements the BudgetService interface.
+type BudgetServiceImpl struct {
+	budgetRepo     secondary.CommissionBudgetRepository
+	commissionRepo secondary.CommissionRepository
+	taskRepo       secondary.TaskRepository
+	now            func() time.Time
+}
+
+// NewBudgetService creates a new BudgetService with injected dependencies.
+func NewBudgetService(
+	budgetRepo secondary.CommissionBudgetRepository,
+	commissionRepo secondary.CommissionRepository,
+	taskRepo secondary.TaskRepository,
+) *BudgetServiceImpl {
+	return &BudgetServiceImpl{
+		budgetRepo:     budgetRepo,
+		commissionRepo: commissionRepo,
+		taskRepo:       taskRepo,
+		now:            time.Now,
+	}
+}
+
+// SetBudget creates or replaces a commission's budget.
+func (s *BudgetServiceImpl) SetBudget(ctx context.Context, req primary.SetBudgetRequest) error {
+	thresholds := req.Thresholds
+	if thresholds == "" {
+		thresholds = corecommission.DefaultBudgetThresholds
+	}
+
+	_, err := s.commissionRepo.GetByID(ctx, req.CommissionID)
+	guardCtx := corecommission.SetBudgetContext{
+		CommissionID:     req.CommissionID,
+		CommissionExists: err == nil,
+		Unit:             req.Unit,
+		Amount:           req.Amount,
+		Thresholds:       thresholds,
+	}
+	if result := corecommission.CanSetBudget(guardCtx); !result.Allowed {
+		return result.Error()
+	}
+
+	return s.budgetRepo.Set(ctx, &secondary.CommissionBudgetRecord{
+		CommissionID: req.CommissionID,
+		Unit:         req.Unit,
+		Amount:       req.Amount,
+		Thresholds:   thresholds,
+	})
+}
+
+// ClearBudget removes a commission's budget.
+func (s *BudgetServiceImpl) ClearBudget(ctx context.Context, commissionID string) error {
+	return s.budgetRepo.Delete(ctx, commissionID)
+}
+
+// GetBudgetReport computes a commission's budget consumption.
+// Returns nil if the commission has no budget.
+func (s *BudgetServiceImpl) GetBudgetReport(ctx context.Context, commissionID string) (*primary.BudgetReport, error) {
+	budget, err := s.budgetRepo.Get(ctx, commissionID)
+	if err != nil || budget == nil {
+		return nil, err
+	}
+	return s.buildReport(ctx, budget)
+}
+
+// ListBudgetReports computes consumption for every commission with a budget.
+func (s *BudgetServiceImpl) ListBudgetReports(ctx context.Context) ([]*primary.BudgetReport, error) {
+	budgets, err := s.budgetRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*primary.BudgetReport, 0, len(budgets))
+	for _, budget := range budgets {
+		report, err := s.buildReport(ctx, budget)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// buildReport computes consumption for one budget from its commission's tasks.
+func (s *BudgetServiceImpl) buildReport(ctx context.Context, budget *secondary.CommissionBudgetRecord) (*primary.BudgetReport, error) {
+	tasks, err := s.taskRepo.List(ctx, secondary.TaskFilters{CommissionID: budget.CommissionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	report := &primary.BudgetReport{
+		CommissionID: budget.CommissionID,
+		Unit:         budget.Unit,
+		Amount:       budget.Amount,
+	}
+	if commission, err := s.commissionRepo.GetByID(ctx, budget.CommissionID); err == nil {
+		report.CommissionTitle = commission.Title
+	}
+
+	budgetTasks := make([]corecommission.BudgetTask, 0, len(tasks))
+	for _, t := range tasks {
+		budgetTasks = append(budgetTasks, corecommission.BudgetTask{
+			Status:      t.Status,
+			Points:      t.Points,
+			ClaimedAt:   parseRecordTime(t.ClaimedAt),
+			CompletedAt: parseRecordTime(t.CompletedAt),
+		})
+		if budget.Unit == corecommission.BudgetUnitPoints {
+			report.Planned += float64(t.Points)
+			if t.Points == 0 && t.Status != "closed" {
+				report.Unestimated++
+			}
+		}
+	}
+	report.Spent = corecommission.Spent(budget.Unit, budgetTasks, s.now())
+
+	// Stored thresholds were validated on write
+	report.Thresholds, _ = corecommission.ParseThresholds(budget.Thresholds)
+	status := corecommission.EvaluateBudget(budget.Amount, report.Spent, report.Thresholds)
+	report.Percent = status.Percent
+	report.ThresholdReached = status.Threshold
+	report.Over = status.Over
+
+	return report, nil
+}
+
+// parseRecordTime parses an RFC3339 record timestamp, returning the zero time if empty.
+func parseRecordTime(value string) time.Time {
+	t, _ := time.Parse(time.RFC3339, value)
+	return t
+}
+
+// Ensure BudgetServiceImpl implements the interface
+var _ primary.BudgetService = (*BudgetServiceImpl)(nil)
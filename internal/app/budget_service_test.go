@@ -0,0 +1,169 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ============================================================================
+// Mock Implementations
+// ============================================================================
+
+type mockCommissionBudgetRepository struct {
+	budgets map[string]*secondary.CommissionBudgetRecord
+}
+
+func newMockCommissionBudgetRepository() *mockCommissionBudgetRepository {
+	return &mockCommissionBudgetRepository{budgets: make(map[string]*secondary.CommissionBudgetRecord)}
+}
+
+func (m *mockCommissionBudgetRepository) Set(_ context.Context, budget *secondary.CommissionBudgetRecord) error {
+	m.budgets[budget.CommissionID] = budget
+	return nil
+}
+
+func (m *mockCommissionBudgetRepository) Get(_ context.Context, commissionID string) (*secondary.CommissionBudgetRecord, error) {
+	return m.budgets[commissionID], nil
+}
+
+func (m *mockCommissionBudgetRepository) Delete(_ context.Context, commissionID string) error {
+	if _, ok := m.budgets[commissionID]; !ok {
+		return fmt.Errorf("commission %s has no budget", commissionID)
+	}
+	delete(m.budgets, commissionID)
+	return nil
+}
+
+func (m *mockCommissionBudgetRepository) List(_ context.Context) ([]*secondary.CommissionBudgetRecord, error) {
+	var result []*secondary.CommissionBudgetRecord
+	for _, b := range m.budgets {
+		result = append(result, b)
+	}
+	return result, nil
+}
+
+// ============================================================================
+// Test Helper
+// ============================================================================
+
+var budgetTestNow = time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+func newTestBudgetService() (*BudgetServiceImpl, *mockCommissionBudgetRepository, *mockTaskRepository) {
+	budgetRepo := newMockCommissionBudgetRepository()
+	commissionRepo := newMockCommissionRepository()
+	commissionRepo.commissions["COMM-001"] = &secondary.CommissionRecord{ID: "COMM-001", Title: "Client work"}
+	taskRepo := newMockTaskRepository()
+
+	service := NewBudgetService(budgetRepo, commissionRepo, taskRepo)
+	service.now = func() time.Time { return budgetTestNow }
+	return service, budgetRepo, taskRepo
+}
+
+func budgetTestTime(offset time.Duration) string {
+	return budgetTestNow.Add(offset).Format(time.RFC3339)
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestSetBudget_DefaultsThresholds(t *testing.T) {
+	service, budgetRepo, _ := newTestBudgetService()
+	ctx := context.Background()
+
+	err := service.SetBudget(ctx, primary.SetBudgetRequest{CommissionID: "COMM-001", Unit: "hours", Amount: 40})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := budgetRepo.budgets["COMM-001"]; got == nil || got.Thresholds != "75,90" {
+		t.Errorf("expected default thresholds, got %+v", got)
+	}
+}
+
+func TestSetBudget_RejectsUnknownCommission(t *testing.T) {
+	service, _, _ := newTestBudgetService()
+	ctx := context.Background()
+
+	err := service.SetBudget(ctx, primary.SetBudgetRequest{CommissionID: "COMM-999", Unit: "hours", Amount: 40})
+	if err == nil {
+		t.Fatal("expected error for unknown commission")
+	}
+}
+
+func TestGetBudgetReport_Hours(t *testing.T) {
+	service, budgetRepo, taskRepo := newTestBudgetService()
+	ctx := context.Background()
+
+	budgetRepo.budgets["COMM-001"] = &secondary.CommissionBudgetRecord{CommissionID: "COMM-001", Unit: "hours", Amount: 10, Thresholds: "75,90"}
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", CommissionID: "COMM-001", Status: "closed",
+		ClaimedAt: budgetTestTime(-12 * time.Hour), CompletedAt: budgetTestTime(-6 * time.Hour)}
+	taskRepo.tasks["TASK-002"] = &secondary.TaskRecord{ID: "TASK-002", CommissionID: "COMM-001", Status: "in-progress",
+		ClaimedAt: budgetTestTime(-2 * time.Hour)}
+	taskRepo.tasks["TASK-003"] = &secondary.TaskRecord{ID: "TASK-003", CommissionID: "COMM-001", Status: "open"}
+
+	report, err := service.GetBudgetReport(ctx, "COMM-001")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if math.Abs(report.Spent-8) > 1e-9 {
+		t.Errorf("expected 8 hours spent, got %v", report.Spent)
+	}
+	if report.ThresholdReached != 75 || report.Over {
+		t.Errorf("expected 75%% threshold reached and not over, got %+v", report)
+	}
+	if report.CommissionTitle != "Client work" {
+		t.Errorf("expected commission title, got %q", report.CommissionTitle)
+	}
+}
+
+func TestGetBudgetReport_Points(t *testing.T) {
+	service, budgetRepo, taskRepo := newTestBudgetService()
+	ctx := context.Background()
+
+	budgetRepo.budgets["COMM-001"] = &secondary.CommissionBudgetRecord{CommissionID: "COMM-001", Unit: "points", Amount: 5, Thresholds: "50"}
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", CommissionID: "COMM-001", Status: "closed", Points: 6}
+	taskRepo.tasks["TASK-002"] = &secondary.TaskRecord{ID: "TASK-002", CommissionID: "COMM-001", Status: "open", Points: 3}
+	taskRepo.tasks["TASK-003"] = &secondary.TaskRecord{ID: "TASK-003", CommissionID: "COMM-001", Status: "open"}
+
+	report, err := service.GetBudgetReport(ctx, "COMM-001")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if report.Spent != 6 || report.Planned != 9 || report.Unestimated != 1 {
+		t.Errorf("expected spent 6, planned 9, 1 unestimated; got %+v", report)
+	}
+	if !report.Over {
+		t.Error("expected budget to be over")
+	}
+}
+
+func TestGetBudgetReport_NoBudget(t *testing.T) {
+	service, _, _ := newTestBudgetService()
+
+	report, err := service.GetBudgetReport(context.Background(), "COMM-001")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if report != nil {
+		t.Errorf("expected nil report, got %+v", report)
+	}
+}
+
+func TestClearBudget(t *testing.T) {
+	service, budgetRepo, _ := newTestBudgetService()
+	ctx := context.Background()
+	budgetRepo.budgets["COMM-001"] = &secondary.CommissionBudgetRecord{CommissionID: "COMM-001", Unit: "hours", Amount: 10}
+
+	if err := service.ClearBudget(ctx, "COMM-001"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := service.ClearBudget(ctx, "COMM-001"); err == nil {
+		t.Error("expected error clearing missing budget")
+	}
+}
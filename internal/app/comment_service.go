@@ -0,0 +1,139 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corecomment "github.com/example/orc/internal/core/comment"
+	"github.com/example/orc/internal/ctxutil"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// CommentServiceImpl implements the CommentService interface.
+type CommentServiceImpl struct {
+	commentRepo    secondary.CommentRepository
+	commissionRepo secondary.CommissionRepository
+	shipmentRepo   secondary.ShipmentRepository
+	taskRepo       secondary.TaskRepository
+	tomeRepo       secondary.TomeRepository
+	noteRepo       secondary.NoteRepository
+	planRepo       secondary.PlanRepository
+}
+
+// NewCommentService creates a new CommentService with injected dependencies.
+func NewCommentService(
+	commentRepo secondary.CommentRepository,
+	commissionRepo secondary.CommissionRepository,
+	shipmentRepo secondary.ShipmentRepository,
+	taskRepo secondary.TaskRepository,
+	tomeRepo secondary.TomeRepository,
+	noteRepo secondary.NoteRepository,
+	planRepo secondary.PlanRepository,
+) *CommentServiceImpl {
+	return &CommentServiceImpl{
+		commentRepo:    commentRepo,
+		commissionRepo: commissionRepo,
+		shipmentRepo:   shipmentRepo,
+		taskRepo:       taskRepo,
+		tomeRepo:       tomeRepo,
+		noteRepo:       noteRepo,
+		planRepo:       planRepo,
+	}
+}
+
+// AddComment attaches a comment to an entity, attributed to the current actor.
+func (s *CommentServiceImpl) AddComment(ctx context.Context, req primary.AddCommentRequest) (*primary.Comment, error) {
+	entityType, _ := corecomment.EntityType(req.EntityID)
+	guardCtx := corecomment.AddCommentContext{
+		EntityID:     req.EntityID,
+		EntityExists: s.entityExists(ctx, entityType, req.EntityID),
+		Body:         req.Body,
+		ReplyToID:    req.ReplyToID,
+	}
+	if req.ReplyToID != "" {
+		if parent, err := s.commentRepo.GetByID(ctx, req.ReplyToID); err == nil {
+			guardCtx.ReplyToEntityID = parent.EntityID
+		}
+	}
+	if err := corecomment.CanAddComment(guardCtx).Error(); err != nil {
+		return nil, err
+	}
+
+	id, err := s.commentRepo.GetNextID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &secondary.CommentRecord{
+		ID:         id,
+		EntityID:   req.EntityID,
+		EntityType: entityType,
+		ReplyToID:  req.ReplyToID,
+		Author:     ctxutil.ActorFromContext(ctx),
+		Body:       strings.TrimSpace(req.Body),
+	}
+	if err := s.commentRepo.Create(ctx, record); err != nil {
+		return nil, err
+	}
+
+	return s.recordToComment(record, 0), nil
+}
+
+// ListComments retrieves an entity's comments in thread order.
+func (s *CommentServiceImpl) ListComments(ctx context.Context, entityID string) ([]*primary.Comment, error) {
+	records, err := s.commentRepo.ListByEntity(ctx, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list comments: %w", err)
+	}
+
+	entries := make([]corecomment.Entry, len(records))
+	for i, r := range records {
+		entries[i] = corecomment.Entry{ID: r.ID, ReplyToID: r.ReplyToID}
+	}
+
+	placements := corecomment.Thread(entries)
+	comments := make([]*primary.Comment, len(placements))
+	for i, p := range placements {
+		comments[i] = s.recordToComment(records[p.Index], p.Depth)
+	}
+	return comments, nil
+}
+
+// entityExists reports whether a commentable entity is present in the ledger.
+func (s *CommentServiceImpl) entityExists(ctx context.Context, entityType, entityID string) bool {
+	var err error
+	switch entityType {
+	case "commission":
+		_, err = s.commissionRepo.GetByID(ctx, entityID)
+	case "shipment":
+		_, err = s.shipmentRepo.GetByID(ctx, entityID)
+	case "task":
+		_, err = s.taskRepo.GetByID(ctx, entityID)
+	case "tome":
+		_, err = s.tomeRepo.GetByID(ctx, entityID)
+	case "note":
+		_, err = s.noteRepo.GetByID(ctx, entityID)
+	case "plan":
+		_, err = s.planRepo.GetByID(ctx, entityID)
+	default:
+		return false
+	}
+	return err == nil
+}
+
+func (s *CommentServiceImpl) recordToComment(r *secondary.CommentRecord, depth int) *primary.Comment {
+	return &primary.Comment{
+		ID:        r.ID,
+		EntityID:  r.EntityID,
+		ReplyToID: r.ReplyToID,
+		Author:    r.Author,
+		Body:      r.Body,
+		CreatedAt: r.CreatedAt,
+		Depth:     depth,
+	}
+}
+
+// Ensure CommentServiceImpl implements the interface
+var _ primary.CommentService = (*CommentServiceImpl)(nil)
@@ -0,0 +1,124 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ctxutil"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// mockCommentRepository implements secondary.CommentRepository for testing.
+type mockCommentRepository struct {
+	comments []*secondary.CommentRecord // In creation order
+}
+
+func newMockCommentRepository() *mockCommentRepository {
+	return &mockCommentRepository{}
+}
+
+func (m *mockCommentRepository) Create(ctx context.Context, comment *secondary.CommentRecord) error {
+	m.comments = append(m.comments, comment)
+	return nil
+}
+
+func (m *mockCommentRepository) GetByID(ctx context.Context, id string) (*secondary.CommentRecord, error) {
+	for _, c := range m.comments {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return nil, fmt.Errorf("comment %s not found", id)
+}
+
+func (m *mockCommentRepository) ListByEntity(ctx context.Context, entityID string) ([]*secondary.CommentRecord, error) {
+	var result []*secondary.CommentRecord
+	for _, c := range m.comments {
+		if c.EntityID == entityID {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockCommentRepository) GetNextID(ctx context.Context) (string, error) {
+	return fmt.Sprintf("CMT-%03d", len(m.comments)+1), nil
+}
+
+// newTestCommentService seeds TASK-042 and NOTE-001 in COMM-001.
+func newTestCommentService() (*CommentServiceImpl, *mockCommentRepository) {
+	commentRepo := newMockCommentRepository()
+	taskRepo := newMockTaskRepository()
+	noteRepo := newMockNoteRepository()
+	service := NewCommentService(commentRepo, newMockCommissionRepository(), newMockShipmentRepository(),
+		taskRepo, newMockTomeRepository(), noteRepo, newMockPlanRepository())
+
+	taskRepo.tasks["TASK-042"] = &secondary.TaskRecord{ID: "TASK-042", CommissionID: "COMM-001"}
+	noteRepo.notes["NOTE-001"] = &secondary.NoteRecord{ID: "NOTE-001", CommissionID: "COMM-001"}
+	return service, commentRepo
+}
+
+func TestCommentService_AddAndListThreaded(t *testing.T) {
+	service, _ := newTestCommentService()
+	ctx := ctxutil.WithActorID(context.Background(), "BENCH-003")
+
+	first, err := service.AddComment(ctx, primary.AddCommentRequest{EntityID: "TASK-042", Body: "  blocked on infra  "})
+	if err != nil {
+		t.Fatalf("AddComment failed: %v", err)
+	}
+	if first.ID != "CMT-001" || first.Author != "BENCH-003" || first.Body != "blocked on infra" {
+		t.Errorf("unexpected comment: %+v", first)
+	}
+
+	if _, err := service.AddComment(context.Background(), primary.AddCommentRequest{EntityID: "TASK-042", Body: "second thread"}); err != nil {
+		t.Fatalf("AddComment failed: %v", err)
+	}
+	if _, err := service.AddComment(ctx, primary.AddCommentRequest{EntityID: "TASK-042", Body: "unblocked", ReplyToID: "CMT-001"}); err != nil {
+		t.Fatalf("reply failed: %v", err)
+	}
+
+	comments, err := service.ListComments(ctx, "TASK-042")
+	if err != nil {
+		t.Fatalf("ListComments failed: %v", err)
+	}
+	var got []string
+	for _, c := range comments {
+		got = append(got, fmt.Sprintf("%s/%d", c.ID, c.Depth))
+	}
+	if strings.Join(got, " ") != "CMT-001/0 CMT-003/1 CMT-002/0" {
+		t.Errorf("thread order = %v, want [CMT-001/0 CMT-003/1 CMT-002/0]", got)
+	}
+	if comments[2].Author != "" {
+		t.Errorf("expected no author without actor context, got %q", comments[2].Author)
+	}
+}
+
+func TestCommentService_AddComment_Rejected(t *testing.T) {
+	service, _ := newTestCommentService()
+	ctx := context.Background()
+
+	if _, err := service.AddComment(ctx, primary.AddCommentRequest{EntityID: "NOTE-001", Body: "on a note"}); err != nil {
+		t.Fatalf("AddComment on note failed: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		req     primary.AddCommentRequest
+		wantErr string
+	}{
+		{"missing entity", primary.AddCommentRequest{EntityID: "TASK-999", Body: "hi"}, "TASK-999 not found"},
+		{"blank body", primary.AddCommentRequest{EntityID: "TASK-042", Body: " "}, "cannot be empty"},
+		{"reply across entities", primary.AddCommentRequest{EntityID: "TASK-042", Body: "hi", ReplyToID: "CMT-001"}, "it is on NOTE-001"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.AddComment(ctx, tt.req)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
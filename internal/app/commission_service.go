@@ -115,9 +115,41 @@ func (s *CommissionServiceImpl) ListCommissions(ctx context.Context, filters pri
 	for i, r := range records {
 		commissions[i] = s.recordToCommission(r)
 	}
+	if !filters.WithRollups {
+		return commissions, nil
+	}
+
+	rollups, err := s.commissionRepo.ListRollups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range commissions {
+		c.Rollup = rollupToCommissionRollup(rollups[c.ID])
+	}
 	return commissions, nil
 }
 
+// rollupToCommissionRollup converts a rollup record, graded by health. A
+// commission without a record has no open work.
+func rollupToCommissionRollup(r *secondary.CommissionRollupRecord) *primary.CommissionRollup {
+	if r == nil {
+		r = &secondary.CommissionRollupRecord{}
+	}
+	return &primary.CommissionRollup{
+		OpenShipments:    r.OpenShipments,
+		ReadyTasks:       r.ReadyTasks,
+		InProgressTasks:  r.InProgressTasks,
+		BlockedTasks:     r.BlockedTasks,
+		PendingApprovals: r.PendingApprovals,
+		Health: corecommission.Health(corecommission.HealthContext{
+			ReadyTasks:       r.ReadyTasks,
+			InProgressTasks:  r.InProgressTasks,
+			BlockedTasks:     r.BlockedTasks,
+			PendingApprovals: r.PendingApprovals,
+		}),
+	}
+}
+
 // CompleteCommission marks a commission as complete.
 func (s *CommissionServiceImpl) CompleteCommission(ctx context.Context, commissionID string) error {
 	// 1. Fetch commission to check state
@@ -18,6 +18,7 @@ import (
 type mockCommissionRepository struct {
 	commissions   map[string]*secondary.CommissionRecord
 	shipmentCount map[string]int
+	rollups       map[string]*secondary.CommissionRollupRecord
 	createErr     error
 	getErr        error
 	updateErr     error
@@ -88,6 +89,10 @@ func (m *mockCommissionRepository) CountShipments(ctx context.Context, commissio
 	return m.shipmentCount[commissionID], nil
 }
 
+func (m *mockCommissionRepository) ListRollups(ctx context.Context) (map[string]*secondary.CommissionRollupRecord, error) {
+	return m.rollups, nil
+}
+
 func (m *mockCommissionRepository) Pin(ctx context.Context, id string) error {
 	if commission, ok := m.commissions[id]; ok {
 		commission.Pinned = true
@@ -470,6 +475,43 @@ func TestListCommissions_FilterByStatus(t *testing.T) {
 	}
 }
 
+func TestListCommissions_WithRollups(t *testing.T) {
+	service, commissionRepo, _ := newTestService(secondary.AgentTypeORC)
+	ctx := context.Background()
+
+	commissionRepo.commissions["COMM-001"] = &secondary.CommissionRecord{ID: "COMM-001", Title: "Stuck", Status: "active"}
+	commissionRepo.commissions["COMM-002"] = &secondary.CommissionRecord{ID: "COMM-002", Title: "Empty", Status: "active"}
+	commissionRepo.rollups = map[string]*secondary.CommissionRollupRecord{
+		"COMM-001": {CommissionID: "COMM-001", OpenShipments: 1, InProgressTasks: 1, BlockedTasks: 2, PendingApprovals: 1},
+	}
+
+	commissions, err := service.ListCommissions(ctx, primary.CommissionFilters{WithRollups: true})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, c := range commissions {
+		if c.Rollup == nil {
+			t.Fatalf("%s has no rollup", c.ID)
+		}
+		switch c.ID {
+		case "COMM-001":
+			if c.Rollup.BlockedTasks != 2 || c.Rollup.PendingApprovals != 1 || c.Rollup.Health != "at-risk" {
+				t.Errorf("unexpected rollup for COMM-001: %+v", c.Rollup)
+			}
+		case "COMM-002":
+			if (*c.Rollup != primary.CommissionRollup{Health: "ok"}) {
+				t.Errorf("expected empty ok rollup for COMM-002, got %+v", c.Rollup)
+			}
+		}
+	}
+
+	// Without WithRollups no rollup is loaded
+	commissions, _ = service.ListCommissions(ctx, primary.CommissionFilters{})
+	if len(commissions) == 0 || commissions[0].Rollup != nil {
+		t.Error("expected no rollups without WithRollups")
+	}
+}
+
 // ============================================================================
 // Pin/Unpin Tests
 // ============================================================================
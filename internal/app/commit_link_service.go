@@ -0,0 +1,448 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	coregit "github.com/example/orc/internal/core/git"
+	coreshipment "github.com/example/orc/internal/core/shipment"
+	coretask "github.com/example/orc/internal/core/task"
+	coreworkbench "github.com/example/orc/internal/core/workbench"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// defaultBaseRef is the base branch used when a workbench has no linked repo.
+const defaultBaseRef = "main"
+
+// CommitLinkServiceImpl implements the CommitLinkService interface.
+type CommitLinkServiceImpl struct {
+	commitLinkRepo   secondary.CommitLinkRepository
+	workbenchRepo    secondary.WorkbenchRepository
+	repoRepo         secondary.RepoRepository
+	taskRepo         secondary.TaskRepository
+	shipmentRepo     secondary.ShipmentRepository
+	workspaceAdapter secondary.WorkspaceAdapter
+	shipmentService  primary.ShipmentService
+}
+
+// NewCommitLinkService creates a new CommitLinkService with injected dependencies.
+func NewCommitLinkService(
+	commitLinkRepo secondary.CommitLinkRepository,
+	workbenchRepo secondary.WorkbenchRepository,
+	repoRepo secondary.RepoRepository,
+	taskRepo secondary.TaskRepository,
+	shipmentRepo secondary.ShipmentRepository,
+	workspaceAdapter secondary.WorkspaceAdapter,
+	shipmentService primary.ShipmentService,
+) *CommitLinkServiceImpl {
+	return &CommitLinkServiceImpl{
+		commitLinkRepo:   commitLinkRepo,
+		workbenchRepo:    workbenchRepo,
+		repoRepo:         repoRepo,
+		taskRepo:         taskRepo,
+		shipmentRepo:     shipmentRepo,
+		workspaceAdapter: workspaceAdapter,
+		shipmentService:  shipmentService,
+	}
+}
+
+// SyncWorkbenchCommits scans the workbench branch for entity references and records new links.
+// Only commits not yet on the repo's default branch are scanned.
+func (s *CommitLinkServiceImpl) SyncWorkbenchCommits(ctx context.Context, workbenchID string) (*primary.SyncCommitsResult, error) {
+	wb, err := s.workbenchRepo.GetByID(ctx, workbenchID)
+	if err != nil {
+		return nil, fmt.Errorf("workbench not found: %w", err)
+	}
+
+	baseRef, err := s.baseRef(ctx, wb)
+	if err != nil {
+		return nil, err
+	}
+
+	commits, err := s.workspaceAdapter.ListBranchCommits(ctx, coreworkbench.ComputePath(wb.Name), baseRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits: %w", err)
+	}
+
+	result := &primary.SyncCommitsResult{
+		WorkbenchID: workbenchID,
+		BaseRef:     baseRef,
+		Scanned:     len(commits),
+	}
+
+	known := make(map[string]bool)
+	for _, commit := range commits {
+		for _, ref := range coregit.ExtractEntityRefs(commit.Message) {
+			exists, checked := known[ref.EntityID]
+			if !checked {
+				exists = s.entityExists(ctx, ref)
+				known[ref.EntityID] = exists
+				if !exists {
+					result.UnknownRefs = append(result.UnknownRefs, ref.EntityID)
+				}
+			}
+			if !exists {
+				continue
+			}
+
+			var committedAt string
+			if !commit.CommittedAt.IsZero() {
+				committedAt = commit.CommittedAt.UTC().Format(time.RFC3339)
+			}
+			created, err := s.commitLinkRepo.Create(ctx, &secondary.CommitLinkRecord{
+				CommitSHA:   commit.SHA,
+				EntityType:  ref.EntityType,
+				EntityID:    ref.EntityID,
+				WorkbenchID: workbenchID,
+				Subject:     commit.Subject,
+				CommittedAt: committedAt,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to link commit %s: %w", commit.SHA, err)
+			}
+			if created {
+				result.NewLinks++
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// baseRef returns the branch a workbench's commits are measured against:
+// its repo's default branch, or defaultBaseRef.
+func (s *CommitLinkServiceImpl) baseRef(ctx context.Context, wb *secondary.WorkbenchRecord) (string, error) {
+	if wb.RepoID == "" {
+		return defaultBaseRef, nil
+	}
+	repo, err := s.repoRepo.GetByID(ctx, wb.RepoID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get repo: %w", err)
+	}
+	if repo.DefaultBranch == "" {
+		return defaultBaseRef, nil
+	}
+	return repo.DefaultBranch, nil
+}
+
+// FindConflicts compares the branches of open shipments with assigned
+// workbenches and reports pairs modifying the same files. A branch's files
+// are its commits against the base plus uncommitted changes, so overlaps
+// show up while the work is still in progress.
+func (s *CommitLinkServiceImpl) FindConflicts(ctx context.Context, commissionID string) (*primary.ConflictReport, error) {
+	shipments, err := s.shipmentRepo.List(ctx, secondary.ShipmentFilters{CommissionID: commissionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shipments: %w", err)
+	}
+	sort.Slice(shipments, func(i, j int) bool { return shipments[i].ID < shipments[j].ID })
+
+	report := &primary.ConflictReport{}
+	workbenches := make(map[string]string)
+	var branches []coregit.BranchChanges
+	for _, shipment := range shipments {
+		if shipment.Status == "closed" || shipment.AssignedWorkbenchID == "" {
+			continue
+		}
+		skip := func(reason string) {
+			report.Skipped = append(report.Skipped, primary.ConflictSkip{ShipmentID: shipment.ID, Reason: reason})
+		}
+
+		wb, err := s.workbenchRepo.GetByID(ctx, shipment.AssignedWorkbenchID)
+		if err != nil {
+			skip(fmt.Sprintf("workbench %s not found", shipment.AssignedWorkbenchID))
+			continue
+		}
+		baseRef, err := s.baseRef(ctx, wb)
+		if err != nil {
+			skip(err.Error())
+			continue
+		}
+		workdir := coreworkbench.ComputePath(wb.Name)
+
+		changes, err := s.workspaceAdapter.DiffBranchStat(ctx, workdir, baseRef)
+		if err != nil {
+			skip(err.Error())
+			continue
+		}
+		working, err := s.workspaceAdapter.ListWorkingChanges(ctx, workdir)
+		if err != nil {
+			skip(err.Error())
+			continue
+		}
+
+		files := make([]string, 0, len(changes)+len(working))
+		for _, c := range changes {
+			files = append(files, c.Path)
+		}
+		files = append(files, working...)
+
+		workbenches[shipment.ID] = wb.ID
+		report.Checked = append(report.Checked, shipment.ID)
+		branches = append(branches, coregit.BranchChanges{ShipmentID: shipment.ID, RepoID: wb.RepoID, Files: files})
+	}
+
+	for _, o := range coregit.FindOverlaps(branches) {
+		report.Overlaps = append(report.Overlaps, &primary.ShipmentOverlap{
+			ShipmentA:  o.ShipmentA,
+			WorkbenchA: workbenches[o.ShipmentA],
+			ShipmentB:  o.ShipmentB,
+			WorkbenchB: workbenches[o.ShipmentB],
+			Files:      o.Files,
+		})
+	}
+	return report, nil
+}
+
+// entityExists reports whether a referenced entity is present in the ledger.
+func (s *CommitLinkServiceImpl) entityExists(ctx context.Context, ref coregit.EntityRef) bool {
+	switch ref.EntityType {
+	case "task":
+		_, err := s.taskRepo.GetByID(ctx, ref.EntityID)
+		return err == nil
+	case "shipment":
+		_, err := s.shipmentRepo.GetByID(ctx, ref.EntityID)
+		return err == nil
+	}
+	return false
+}
+
+// GetEntityCommits retrieves the commits linked to a task or shipment, newest first.
+func (s *CommitLinkServiceImpl) GetEntityCommits(ctx context.Context, entityID string) ([]*primary.CommitLink, error) {
+	records, err := s.commitLinkRepo.ListByEntity(ctx, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits: %w", err)
+	}
+
+	links := make([]*primary.CommitLink, len(records))
+	for i, r := range records {
+		links[i] = &primary.CommitLink{
+			CommitSHA:   r.CommitSHA,
+			EntityType:  r.EntityType,
+			EntityID:    r.EntityID,
+			WorkbenchID: r.WorkbenchID,
+			Subject:     r.Subject,
+			CommittedAt: r.CommittedAt,
+		}
+	}
+	return links, nil
+}
+
+// LandShipment syncs the assigned workbench, verifies every task has a linked
+// commit, and completes the shipment. Force skips the commit check and the
+// open-task check, and tolerates a failed sync.
+func (s *CommitLinkServiceImpl) LandShipment(ctx context.Context, shipmentID string, force bool) (*primary.LandShipmentResult, error) {
+	shipment, err := s.shipmentRepo.GetByID(ctx, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("shipment not found: %w", err)
+	}
+
+	result := &primary.LandShipmentResult{ShipmentID: shipmentID}
+
+	if shipment.AssignedWorkbenchID != "" {
+		sync, err := s.SyncWorkbenchCommits(ctx, shipment.AssignedWorkbenchID)
+		if err != nil && !force {
+			return nil, fmt.Errorf("failed to sync commits from %s: %w", shipment.AssignedWorkbenchID, err)
+		}
+		result.Sync = sync
+	}
+
+	tasks, err := s.taskRepo.GetByShipment(ctx, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+	result.TaskCount = len(tasks)
+
+	taskIDs := make([]string, len(tasks))
+	summaries := make([]coreshipment.TaskSummary, len(tasks))
+	for i, t := range tasks {
+		taskIDs[i] = t.ID
+		summaries[i] = coreshipment.TaskSummary{ID: t.ID, Status: t.Status}
+	}
+
+	counts, err := s.commitLinkRepo.CountByEntities(ctx, taskIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count linked commits: %w", err)
+	}
+
+	guard := coreshipment.CanLandShipment(coreshipment.LandShipmentContext{
+		ShipmentID:   shipmentID,
+		Tasks:        summaries,
+		CommitCounts: counts,
+		Force:        force,
+	})
+	if err := guard.Error(); err != nil {
+		return nil, err
+	}
+
+	if err := s.shipmentService.CompleteShipment(ctx, shipmentID, force); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DiffShipment diffs the shipment's assigned workbench against its base branch
+// and annotates each file with the tasks named by the commits that touched it.
+func (s *CommitLinkServiceImpl) DiffShipment(ctx context.Context, req primary.DiffShipmentRequest) (*primary.ShipmentDiff, error) {
+	shipmentID := req.ShipmentID
+	shipment, err := s.shipmentRepo.GetByID(ctx, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("shipment not found: %w", err)
+	}
+	if shipment.AssignedWorkbenchID == "" {
+		return nil, fmt.Errorf("shipment %s has no assigned workbench", shipmentID)
+	}
+
+	wb, err := s.workbenchRepo.GetByID(ctx, shipment.AssignedWorkbenchID)
+	if err != nil {
+		return nil, fmt.Errorf("workbench not found: %w", err)
+	}
+	baseRef, err := s.baseRef(ctx, wb)
+	if err != nil {
+		return nil, err
+	}
+	workdir := coreworkbench.ComputePath(wb.Name)
+
+	changes, err := s.workspaceAdapter.DiffBranchStat(ctx, workdir, baseRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff branch: %w", err)
+	}
+	commits, err := s.workspaceAdapter.ListBranchCommits(ctx, workdir, baseRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commits: %w", err)
+	}
+	commitFiles, err := s.workspaceAdapter.ListBranchCommitFiles(ctx, workdir, baseRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commit files: %w", err)
+	}
+
+	messages := make(map[string]string, len(commits))
+	for _, commit := range commits {
+		messages[commit.SHA] = commit.Message
+	}
+	tasksByFile := coregit.TasksByFile(messages, commitFiles)
+
+	diff := &primary.ShipmentDiff{
+		ShipmentID:  shipmentID,
+		WorkbenchID: wb.ID,
+		BaseRef:     baseRef,
+		Files:       make([]*primary.DiffFile, len(changes)),
+	}
+	for i, c := range changes {
+		diff.Files[i] = &primary.DiffFile{
+			Path:    c.Path,
+			Added:   c.Added,
+			Deleted: c.Deleted,
+			Binary:  c.Binary,
+			TaskIDs: tasksByFile[c.Path],
+		}
+		diff.Added += c.Added
+		diff.Deleted += c.Deleted
+	}
+
+	if req.WithPatch && len(changes) > 0 {
+		diff.Patch, err = s.workspaceAdapter.DiffBranchPatch(ctx, workdir, baseRef, req.Paths)
+		if err != nil {
+			return nil, fmt.Errorf("failed to diff branch: %w", err)
+		}
+	}
+
+	return diff, nil
+}
+
+// SuggestTasks matches the workbench's uncommitted changes against the
+// commission's open and in-progress tasks. Files touched by commits that
+// mention a task count for it; a branch that cannot be compared against its
+// base simply contributes no linked files.
+func (s *CommitLinkServiceImpl) SuggestTasks(ctx context.Context, req primary.SuggestTasksRequest) (*primary.TaskSuggestions, error) {
+	wb, err := s.workbenchRepo.GetByID(ctx, req.WorkbenchID)
+	if err != nil {
+		return nil, fmt.Errorf("workbench not found: %w", err)
+	}
+	workdir := coreworkbench.ComputePath(wb.Name)
+
+	edited, err := s.workspaceAdapter.ListWorkingChanges(ctx, workdir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list changes: %w", err)
+	}
+	result := &primary.TaskSuggestions{WorkbenchID: wb.ID, EditedFiles: edited}
+
+	tasks, err := s.taskRepo.List(ctx, secondary.TaskFilters{CommissionID: req.CommissionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	linkedFiles := s.linkedFilesByTask(ctx, wb, workdir)
+
+	titles := make(map[string]string)
+	var candidates []coretask.SuggestCandidate
+	for _, t := range tasks {
+		if t.Status != "open" && t.Status != "in-progress" {
+			continue
+		}
+		// Tasks assigned to other workbenches belong to them
+		if t.AssignedWorkbenchID != "" && t.AssignedWorkbenchID != wb.ID {
+			continue
+		}
+		if t.Status == "in-progress" && result.InProgressID == "" {
+			result.InProgressID = t.ID
+		}
+		titles[t.ID] = t.Title
+		candidates = append(candidates, coretask.SuggestCandidate{
+			ID:          t.ID,
+			Title:       t.Title,
+			Description: t.Description,
+			Status:      t.Status,
+			LinkedFiles: linkedFiles[t.ID],
+		})
+	}
+
+	ranked := coretask.SuggestTasks(edited, candidates)
+	for _, r := range ranked {
+		result.Suggestions = append(result.Suggestions, &primary.TaskSuggestion{
+			TaskID:  r.TaskID,
+			Title:   titles[r.TaskID],
+			Status:  r.Status,
+			Score:   r.Score,
+			Matches: r.Matches,
+		})
+	}
+	result.AutoClaimID = coretask.PickAutoClaim(ranked)
+
+	return result, nil
+}
+
+// linkedFilesByTask maps task IDs to the files touched by branch commits that
+// mention them. Returns nil when the branch cannot be read.
+func (s *CommitLinkServiceImpl) linkedFilesByTask(ctx context.Context, wb *secondary.WorkbenchRecord, workdir string) map[string][]string {
+	baseRef, err := s.baseRef(ctx, wb)
+	if err != nil {
+		return nil
+	}
+	commits, err := s.workspaceAdapter.ListBranchCommits(ctx, workdir, baseRef)
+	if err != nil {
+		return nil
+	}
+	commitFiles, err := s.workspaceAdapter.ListBranchCommitFiles(ctx, workdir, baseRef)
+	if err != nil {
+		return nil
+	}
+
+	messages := make(map[string]string, len(commits))
+	for _, commit := range commits {
+		messages[commit.SHA] = commit.Message
+	}
+	byTask := make(map[string][]string)
+	for file, taskIDs := range coregit.TasksByFile(messages, commitFiles) {
+		for _, id := range taskIDs {
+			byTask[id] = append(byTask[id], file)
+		}
+	}
+	return byTask
+}
+
+// Ensure CommitLinkServiceImpl implements the interface
+var _ primary.CommitLinkService = (*CommitLinkServiceImpl)(nil)
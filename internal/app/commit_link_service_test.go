@@ -0,0 +1,333 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	coreworkbench "github.com/example/orc/internal/core/workbench"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// mockCommitLinkRepository implements secondary.CommitLinkRepository for testing.
+type mockCommitLinkRepository struct {
+	links []*secondary.CommitLinkRecord
+}
+
+func newMockCommitLinkRepository() *mockCommitLinkRepository {
+	return &mockCommitLinkRepository{}
+}
+
+func (m *mockCommitLinkRepository) Create(ctx context.Context, link *secondary.CommitLinkRecord) (bool, error) {
+	for _, l := range m.links {
+		if l.CommitSHA == link.CommitSHA && l.EntityID == link.EntityID {
+			return false, nil
+		}
+	}
+	m.links = append(m.links, link)
+	return true, nil
+}
+
+func (m *mockCommitLinkRepository) ListByEntity(ctx context.Context, entityID string) ([]*secondary.CommitLinkRecord, error) {
+	var result []*secondary.CommitLinkRecord
+	for _, l := range m.links {
+		if l.EntityID == entityID {
+			result = append(result, l)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockCommitLinkRepository) CountByEntities(ctx context.Context, entityIDs []string) (map[string]int, error) {
+	counts := make(map[string]int)
+	for _, id := range entityIDs {
+		for _, l := range m.links {
+			if l.EntityID == id {
+				counts[id]++
+			}
+		}
+	}
+	return counts, nil
+}
+
+// newTestCommitLinkService seeds BENCH-001 (repo default branch trunk)
+// working SHIP-001, whose tasks TASK-001 and TASK-002 are closed.
+func newTestCommitLinkService() (*CommitLinkServiceImpl, *mockWorkbenchRepository, *mockTaskRepository, *mockShipmentRepository, *mockWorkspaceAdapter, *mockShipmentServiceForPR) {
+	linkRepo := newMockCommitLinkRepository()
+	workbenchRepo := newMockWorkbenchRepository()
+	repoRepo := newMockRepoRepository()
+	taskRepo := newMockTaskRepository()
+	shipmentRepo := newMockShipmentRepository()
+	workspace := newMockWorkspaceAdapter()
+	shipmentService := newMockShipmentServiceForPR()
+	service := NewCommitLinkService(linkRepo, workbenchRepo, repoRepo, taskRepo, shipmentRepo, workspace, shipmentService)
+
+	workbenchRepo.workbenches["BENCH-001"] = &secondary.WorkbenchRecord{ID: "BENCH-001", Name: "test-bench", RepoID: "REPO-001"}
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "app", DefaultBranch: "trunk"}
+	shipmentRepo.shipments["SHIP-001"] = &secondary.ShipmentRecord{ID: "SHIP-001", Status: "in-progress", AssignedWorkbenchID: "BENCH-001"}
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", ShipmentID: "SHIP-001", Status: "closed"}
+	taskRepo.tasks["TASK-002"] = &secondary.TaskRecord{ID: "TASK-002", ShipmentID: "SHIP-001", Status: "closed"}
+	return service, workbenchRepo, taskRepo, shipmentRepo, workspace, shipmentService
+}
+
+func TestCommitLinkService_SyncWorkbenchCommits(t *testing.T) {
+	service, _, _, _, workspace, _ := newTestCommitLinkService()
+	ctx := context.Background()
+
+	workspace.branchCommits = []secondary.CommitInfo{
+		{SHA: "c2", Subject: "TASK-002: wire flag", Message: "TASK-002: wire flag\n\nAlso touches TASK-001", CommittedAt: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{SHA: "c1", Subject: "TASK-001: add parser (SHIP-001)", Message: "TASK-001: add parser (SHIP-001)"},
+		{SHA: "c0", Subject: "TASK-999: stale ref", Message: "TASK-999: stale ref"},
+	}
+
+	result, err := service.SyncWorkbenchCommits(ctx, "BENCH-001")
+	if err != nil {
+		t.Fatalf("SyncWorkbenchCommits failed: %v", err)
+	}
+	if workspace.branchCommitsBaseRef != "trunk" {
+		t.Errorf("expected repo default branch as base ref, got %q", workspace.branchCommitsBaseRef)
+	}
+	if result.Scanned != 3 {
+		t.Errorf("Scanned = %d, want 3", result.Scanned)
+	}
+	if result.NewLinks != 4 {
+		t.Errorf("NewLinks = %d, want 4", result.NewLinks)
+	}
+	if len(result.UnknownRefs) != 1 || result.UnknownRefs[0] != "TASK-999" {
+		t.Errorf("UnknownRefs = %v, want [TASK-999]", result.UnknownRefs)
+	}
+
+	links, _ := service.GetEntityCommits(ctx, "TASK-002")
+	if len(links) != 1 {
+		t.Fatalf("expected 1 link for TASK-002, got %d", len(links))
+	}
+	if links[0].CommittedAt != "2026-01-02T00:00:00Z" {
+		t.Errorf("CommittedAt = %q, want %q", links[0].CommittedAt, "2026-01-02T00:00:00Z")
+	}
+	if links[0].WorkbenchID != "BENCH-001" {
+		t.Errorf("WorkbenchID = %q, want %q", links[0].WorkbenchID, "BENCH-001")
+	}
+
+	// Re-sync is idempotent
+	result, err = service.SyncWorkbenchCommits(ctx, "BENCH-001")
+	if err != nil {
+		t.Fatalf("second SyncWorkbenchCommits failed: %v", err)
+	}
+	if result.NewLinks != 0 {
+		t.Errorf("expected no new links on re-sync, got %d", result.NewLinks)
+	}
+}
+
+func TestCommitLinkService_SyncWorkbenchCommits_DefaultBaseRef(t *testing.T) {
+	service, workbenchRepo, _, _, workspace, _ := newTestCommitLinkService()
+	workbenchRepo.workbenches["BENCH-001"].RepoID = ""
+
+	if _, err := service.SyncWorkbenchCommits(context.Background(), "BENCH-001"); err != nil {
+		t.Fatalf("SyncWorkbenchCommits failed: %v", err)
+	}
+	if workspace.branchCommitsBaseRef != "main" {
+		t.Errorf("expected fallback base ref %q, got %q", "main", workspace.branchCommitsBaseRef)
+	}
+}
+
+func TestCommitLinkService_SyncWorkbenchCommits_WorkbenchNotFound(t *testing.T) {
+	service, _, _, _, _, _ := newTestCommitLinkService()
+
+	_, err := service.SyncWorkbenchCommits(context.Background(), "BENCH-999")
+	if err == nil {
+		t.Fatal("expected error for missing workbench")
+	}
+}
+
+func TestCommitLinkService_LandShipment(t *testing.T) {
+	service, _, _, _, workspace, shipmentService := newTestCommitLinkService()
+	ctx := context.Background()
+
+	workspace.branchCommits = []secondary.CommitInfo{
+		{SHA: "c1", Subject: "TASK-001 and TASK-002", Message: "TASK-001 and TASK-002"},
+	}
+
+	result, err := service.LandShipment(ctx, "SHIP-001", false)
+	if err != nil {
+		t.Fatalf("LandShipment failed: %v", err)
+	}
+	if result.Sync == nil || result.Sync.NewLinks != 2 {
+		t.Errorf("expected sync to record 2 links, got %+v", result.Sync)
+	}
+	if result.TaskCount != 2 {
+		t.Errorf("TaskCount = %d, want 2", result.TaskCount)
+	}
+	if !shipmentService.completed["SHIP-001"] {
+		t.Error("expected shipment to be completed")
+	}
+}
+
+func TestCommitLinkService_LandShipment_UnlinkedTask(t *testing.T) {
+	service, _, _, _, workspace, shipmentService := newTestCommitLinkService()
+	ctx := context.Background()
+
+	workspace.branchCommits = []secondary.CommitInfo{
+		{SHA: "c1", Subject: "TASK-001: only one", Message: "TASK-001: only one"},
+	}
+
+	_, err := service.LandShipment(ctx, "SHIP-001", false)
+	if err == nil {
+		t.Fatal("expected error for task without linked commits")
+	}
+	if !strings.Contains(err.Error(), "TASK-002") {
+		t.Errorf("expected error to name TASK-002, got %q", err.Error())
+	}
+	if shipmentService.completed["SHIP-001"] {
+		t.Error("expected shipment not to be completed")
+	}
+}
+
+func TestCommitLinkService_LandShipment_SyncFailure(t *testing.T) {
+	service, _, _, _, workspace, shipmentService := newTestCommitLinkService()
+	ctx := context.Background()
+	workspace.branchCommitsErr = errors.New("workdir not found")
+
+	if _, err := service.LandShipment(ctx, "SHIP-001", false); err == nil {
+		t.Fatal("expected sync failure to block landing")
+	}
+
+	// Force tolerates the failed sync and skips the commit check
+	if _, err := service.LandShipment(ctx, "SHIP-001", true); err != nil {
+		t.Fatalf("forced LandShipment failed: %v", err)
+	}
+	if !shipmentService.completed["SHIP-001"] {
+		t.Error("expected forced land to complete the shipment")
+	}
+}
+
+func TestCommitLinkService_DiffShipment(t *testing.T) {
+	service, _, _, _, workspace, _ := newTestCommitLinkService()
+	ctx := context.Background()
+
+	workspace.branchDiff = []secondary.FileChange{
+		{Path: "cmd/main.go", Added: 10, Deleted: 2},
+		{Path: "parser.go", Added: 30, Deleted: 0},
+		{Path: "logo.png", Binary: true},
+	}
+	workspace.branchCommits = []secondary.CommitInfo{
+		{SHA: "c2", Message: "TASK-002: wire flag"},
+		{SHA: "c1", Message: "TASK-001: add parser"},
+	}
+	workspace.branchCommitFiles = map[string][]string{
+		"c2": {"cmd/main.go", "parser.go"},
+		"c1": {"parser.go"},
+	}
+	workspace.branchPatch = "diff --git a/parser.go b/parser.go\n"
+
+	diff, err := service.DiffShipment(ctx, primary.DiffShipmentRequest{ShipmentID: "SHIP-001", WithPatch: true, Paths: []string{"parser.go"}})
+	if err != nil {
+		t.Fatalf("DiffShipment failed: %v", err)
+	}
+	if diff.BaseRef != "trunk" || diff.WorkbenchID != "BENCH-001" {
+		t.Errorf("got base %q workbench %q, want trunk BENCH-001", diff.BaseRef, diff.WorkbenchID)
+	}
+	if diff.Added != 40 || diff.Deleted != 2 {
+		t.Errorf("totals = +%d -%d, want +40 -2", diff.Added, diff.Deleted)
+	}
+	if len(diff.Files) != 3 {
+		t.Fatalf("expected 3 files, got %d", len(diff.Files))
+	}
+	if got := strings.Join(diff.Files[1].TaskIDs, ","); got != "TASK-001,TASK-002" {
+		t.Errorf("parser.go tasks = %q, want TASK-001,TASK-002", got)
+	}
+	if len(diff.Files[2].TaskIDs) != 0 {
+		t.Errorf("expected no tasks for untouched-by-commit file, got %v", diff.Files[2].TaskIDs)
+	}
+	if diff.Patch == "" {
+		t.Error("expected patch when requested")
+	}
+	if len(workspace.branchPatchPaths) != 1 || workspace.branchPatchPaths[0] != "parser.go" {
+		t.Errorf("patch paths = %v, want [parser.go]", workspace.branchPatchPaths)
+	}
+}
+
+func TestCommitLinkService_DiffShipment_NoWorkbench(t *testing.T) {
+	service, _, _, shipmentRepo, _, _ := newTestCommitLinkService()
+	ctx := context.Background()
+	shipmentRepo.shipments["SHIP-002"] = &secondary.ShipmentRecord{ID: "SHIP-002", Status: "draft"}
+
+	_, err := service.DiffShipment(ctx, primary.DiffShipmentRequest{ShipmentID: "SHIP-002"})
+	if err == nil || !strings.Contains(err.Error(), "no assigned workbench") {
+		t.Errorf("expected no-workbench error, got %v", err)
+	}
+}
+
+func TestCommitLinkService_FindConflicts(t *testing.T) {
+	service, workbenchRepo, _, shipmentRepo, workspace, _ := newTestCommitLinkService()
+	ctx := context.Background()
+
+	workbenchRepo.workbenches["BENCH-002"] = &secondary.WorkbenchRecord{ID: "BENCH-002", Name: "other-bench", RepoID: "REPO-001"}
+	shipmentRepo.shipments["SHIP-002"] = &secondary.ShipmentRecord{ID: "SHIP-002", Status: "ready", AssignedWorkbenchID: "BENCH-002"}
+	shipmentRepo.shipments["SHIP-003"] = &secondary.ShipmentRecord{ID: "SHIP-003", Status: "in-progress", AssignedWorkbenchID: "BENCH-404"}
+	shipmentRepo.shipments["SHIP-004"] = &secondary.ShipmentRecord{ID: "SHIP-004", Status: "closed", AssignedWorkbenchID: "BENCH-001"}
+	shipmentRepo.shipments["SHIP-005"] = &secondary.ShipmentRecord{ID: "SHIP-005", Status: "draft"}
+
+	workspace.branchDiffByWorkdir = map[string][]secondary.FileChange{
+		coreworkbench.ComputePath("test-bench"):  {{Path: "parser.go"}, {Path: "main.go"}},
+		coreworkbench.ComputePath("other-bench"): {{Path: "README.md"}},
+	}
+	workspace.workingChanges = []string{"main.go"}
+
+	report, err := service.FindConflicts(ctx, "")
+	if err != nil {
+		t.Fatalf("FindConflicts failed: %v", err)
+	}
+	if strings.Join(report.Checked, ",") != "SHIP-001,SHIP-002" {
+		t.Errorf("Checked = %v, want [SHIP-001 SHIP-002]", report.Checked)
+	}
+	if len(report.Skipped) != 1 || report.Skipped[0].ShipmentID != "SHIP-003" {
+		t.Errorf("Skipped = %+v, want SHIP-003", report.Skipped)
+	}
+	if len(report.Overlaps) != 1 {
+		t.Fatalf("expected 1 overlap, got %d", len(report.Overlaps))
+	}
+	got := report.Overlaps[0]
+	if got.ShipmentA != "SHIP-001" || got.WorkbenchA != "BENCH-001" || got.ShipmentB != "SHIP-002" || got.WorkbenchB != "BENCH-002" {
+		t.Errorf("unexpected overlap: %+v", got)
+	}
+	if strings.Join(got.Files, ",") != "main.go" {
+		t.Errorf("Files = %v, want [main.go]", got.Files)
+	}
+}
+
+func TestCommitLinkService_SuggestTasks(t *testing.T) {
+	service, _, taskRepo, _, workspace, _ := newTestCommitLinkService()
+	ctx := context.Background()
+
+	taskRepo.tasks["TASK-003"] = &secondary.TaskRecord{ID: "TASK-003", CommissionID: "COMM-001", Title: "Fix parser edge cases", Description: "In parser.go", Status: "open"}
+	taskRepo.tasks["TASK-004"] = &secondary.TaskRecord{ID: "TASK-004", CommissionID: "COMM-001", Title: "Wire flags", Status: "in-progress", AssignedWorkbenchID: "BENCH-001"}
+	taskRepo.tasks["TASK-005"] = &secondary.TaskRecord{ID: "TASK-005", CommissionID: "COMM-001", Title: "parser.go cleanup", Status: "in-progress", AssignedWorkbenchID: "BENCH-002"}
+	workspace.workingChanges = []string{"parser.go", "cmd/main.go"}
+	workspace.branchCommits = []secondary.CommitInfo{{SHA: "c1", Message: "TASK-004: wire flag"}}
+	workspace.branchCommitFiles = map[string][]string{"c1": {"cmd/main.go"}}
+
+	result, err := service.SuggestTasks(ctx, primary.SuggestTasksRequest{WorkbenchID: "BENCH-001", CommissionID: "COMM-001"})
+	if err != nil {
+		t.Fatalf("SuggestTasks failed: %v", err)
+	}
+	if result.InProgressID != "TASK-004" {
+		t.Errorf("InProgressID = %q, want TASK-004", result.InProgressID)
+	}
+	var ids []string
+	for _, s := range result.Suggestions {
+		ids = append(ids, s.TaskID)
+	}
+	// TASK-005 belongs to another workbench; closed tasks are never suggested
+	if got := strings.Join(ids, ","); got != "TASK-003,TASK-004" {
+		t.Errorf("suggestions = %q, want TASK-003,TASK-004", got)
+	}
+	if result.Suggestions[0].Title != "Fix parser edge cases" {
+		t.Errorf("title = %q", result.Suggestions[0].Title)
+	}
+	if result.AutoClaimID != "" {
+		t.Errorf("AutoClaimID = %q, want none for a tie", result.AutoClaimID)
+	}
+}
@@ -0,0 +1,336 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corefactory "github.com/example/orc/internal/core/factory"
+	coreworkbench "github.com/example/orc/internal/core/workbench"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// FactoryTeardownServiceImpl implements the FactoryTeardownService interface.
+type FactoryTeardownServiceImpl struct {
+	factoryRepo      secondary.FactoryRepository
+	workshopRepo     secondary.WorkshopRepository
+	workbenchRepo    secondary.WorkbenchRepository
+	commissionRepo   secondary.CommissionRepository
+	shipmentRepo     secondary.ShipmentRepository
+	shipmentService  primary.ShipmentService
+	workspaceAdapter secondary.WorkspaceAdapter
+	tmuxAdapter      secondary.TMuxAdapter
+}
+
+// NewFactoryTeardownService creates a new FactoryTeardownService with injected dependencies.
+func NewFactoryTeardownService(
+	factoryRepo secondary.FactoryRepository,
+	workshopRepo secondary.WorkshopRepository,
+	workbenchRepo secondary.WorkbenchRepository,
+	commissionRepo secondary.CommissionRepository,
+	shipmentRepo secondary.ShipmentRepository,
+	shipmentService primary.ShipmentService,
+	workspaceAdapter secondary.WorkspaceAdapter,
+	tmuxAdapter secondary.TMuxAdapter,
+) *FactoryTeardownServiceImpl {
+	return &FactoryTeardownServiceImpl{
+		factoryRepo:      factoryRepo,
+		workshopRepo:     workshopRepo,
+		workbenchRepo:    workbenchRepo,
+		commissionRepo:   commissionRepo,
+		shipmentRepo:     shipmentRepo,
+		shipmentService:  shipmentService,
+		workspaceAdapter: workspaceAdapter,
+		tmuxAdapter:      tmuxAdapter,
+	}
+}
+
+// PlanTeardown inspects a factory's workshops, active workbenches, tmux
+// sessions, and open shipments. Open shipments are those of the factory's
+// commissions plus any assigned to one of its workbenches.
+func (s *FactoryTeardownServiceImpl) PlanTeardown(ctx context.Context, factoryID string) (*primary.FactoryTeardownPlan, error) {
+	factory, err := s.factoryRepo.GetByID(ctx, factoryID)
+	if err != nil {
+		return nil, fmt.Errorf("factory not found: %w", err)
+	}
+
+	workshops, err := s.workshopRepo.List(ctx, secondary.WorkshopFilters{FactoryID: factoryID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workshops: %w", err)
+	}
+	sort.Slice(workshops, func(i, j int) bool { return workshops[i].ID < workshops[j].ID })
+
+	plan := &primary.FactoryTeardownPlan{
+		FactoryID:     factory.ID,
+		FactoryName:   factory.Name,
+		FactoryStatus: factory.Status,
+	}
+	workshopIDs := make(map[string]bool)
+	benchIDs := make(map[string]bool)
+	for _, ws := range workshops {
+		workshopIDs[ws.ID] = true
+		tw := primary.TeardownWorkshop{
+			ID:          ws.ID,
+			Name:        ws.Name,
+			Status:      ws.Status,
+			SessionName: s.findSession(ctx, ws),
+		}
+
+		benches, err := s.workbenchRepo.GetByWorkshop(ctx, ws.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list workbenches for %s: %w", ws.ID, err)
+		}
+		sort.Slice(benches, func(i, j int) bool { return benches[i].ID < benches[j].ID })
+		for _, wb := range benches {
+			benchIDs[wb.ID] = true
+			tb := primary.TeardownWorkbench{ID: wb.ID, Name: wb.Name, Path: coreworkbench.ComputePath(wb.Name)}
+			tb.WorktreeExists, err = s.workspaceAdapter.WorktreeExists(ctx, tb.Path)
+			if err != nil {
+				return nil, err
+			}
+			if tb.WorktreeExists {
+				tb.ChangedFiles, err = s.workspaceAdapter.ListWorkingChanges(ctx, tb.Path)
+				if err != nil {
+					return nil, fmt.Errorf("failed to check %s for changes: %w", wb.ID, err)
+				}
+			}
+			tw.Workbenches = append(tw.Workbenches, tb)
+		}
+		plan.Workshops = append(plan.Workshops, tw)
+	}
+
+	commissionIDs, err := s.factoryCommissions(ctx, workshopIDs)
+	if err != nil {
+		return nil, err
+	}
+	shipments, err := s.shipmentRepo.List(ctx, secondary.ShipmentFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shipments: %w", err)
+	}
+	sort.Slice(shipments, func(i, j int) bool { return shipments[i].ID < shipments[j].ID })
+	for _, sh := range shipments {
+		if sh.Status == "closed" || (!commissionIDs[sh.CommissionID] && !benchIDs[sh.AssignedWorkbenchID]) {
+			continue
+		}
+		plan.OpenShipments = append(plan.OpenShipments, primary.TeardownShipment{
+			ID:                 sh.ID,
+			Title:              sh.Title,
+			Status:             sh.Status,
+			CommissionID:       sh.CommissionID,
+			WorkbenchID:        sh.AssignedWorkbenchID,
+			TaskCount:          sh.TaskCount,
+			TasksClosed:        sh.TasksClosed,
+			DefaultDisposition: corefactory.DefaultDisposition(sh.TaskCount, sh.TasksClosed),
+		})
+	}
+
+	return plan, nil
+}
+
+// ApplyTeardown settles open shipments, removes worktrees and archives the
+// workbenches, kills tmux sessions, archives the workshops and the factory,
+// and renders the final commission report. A teardown interrupted by an error
+// can be resumed by running it again.
+func (s *FactoryTeardownServiceImpl) ApplyTeardown(ctx context.Context, req primary.ApplyTeardownRequest) (*primary.FactoryTeardownResult, error) {
+	// 1. Plan (also checks the factory exists)
+	plan, err := s.PlanTeardown(ctx, req.FactoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Resolve dispositions and guard
+	workshopIDs := make(map[string]bool)
+	var dirty []string
+	for _, ws := range plan.Workshops {
+		workshopIDs[ws.ID] = true
+		for _, wb := range ws.Workbenches {
+			if wb.WorktreeExists && len(wb.ChangedFiles) > 0 {
+				dirty = append(dirty, wb.ID)
+			}
+		}
+	}
+
+	dispositions := make(map[string]primary.ShipmentDisposition)
+	guardShipments := make([]corefactory.TeardownShipment, len(plan.OpenShipments))
+	for i, sh := range plan.OpenShipments {
+		d, ok := req.Dispositions[sh.ID]
+		if !ok || d.Action == "" {
+			d = primary.ShipmentDisposition{Action: sh.DefaultDisposition}
+		}
+		dispositions[sh.ID] = d
+
+		gs := corefactory.TeardownShipment{ShipmentID: sh.ID, Disposition: d.Action, TargetWorkbenchID: d.WorkbenchID}
+		if d.Action == primary.TeardownReassign && d.WorkbenchID != "" {
+			if target, err := s.workbenchRepo.GetByID(ctx, d.WorkbenchID); err == nil {
+				gs.TargetActive = target.Status == "active"
+				gs.TargetInFactory = workshopIDs[target.WorkshopID]
+			}
+		}
+		guardShipments[i] = gs
+	}
+
+	guardCtx := corefactory.TeardownFactoryContext{
+		FactoryID:        plan.FactoryID,
+		FactoryExists:    true,
+		FactoryStatus:    plan.FactoryStatus,
+		Shipments:        guardShipments,
+		DirtyWorkbenches: dirty,
+		Force:            req.Force,
+	}
+	if result := corefactory.CanTeardownFactory(guardCtx); !result.Allowed {
+		return nil, result.Error()
+	}
+
+	result := &primary.FactoryTeardownResult{FactoryID: plan.FactoryID, FactoryName: plan.FactoryName}
+
+	// 3. Settle open shipments
+	for _, sh := range plan.OpenShipments {
+		d := dispositions[sh.ID]
+		switch d.Action {
+		case primary.TeardownComplete:
+			if err := s.shipmentService.CompleteShipment(ctx, sh.ID, true); err != nil {
+				return nil, fmt.Errorf("failed to complete %s: %w", sh.ID, err)
+			}
+			result.Completed = append(result.Completed, sh.ID)
+		case primary.TeardownReassign:
+			if err := s.shipmentService.AssignShipmentToWorkbench(ctx, sh.ID, d.WorkbenchID); err != nil {
+				return nil, fmt.Errorf("failed to reassign %s: %w", sh.ID, err)
+			}
+			result.Reassigned = append(result.Reassigned, sh.ID)
+		case primary.TeardownRelease:
+			if sh.WorkbenchID != "" {
+				if err := s.shipmentRepo.AssignWorkbench(ctx, sh.ID, ""); err != nil {
+					return nil, fmt.Errorf("failed to release %s: %w", sh.ID, err)
+				}
+			}
+			result.Released = append(result.Released, sh.ID)
+		}
+	}
+
+	// 4. Workbenches, sessions, and workshops
+	for _, ws := range plan.Workshops {
+		for _, wb := range ws.Workbenches {
+			if wb.WorktreeExists {
+				if err := s.workspaceAdapter.RemoveWorktree(ctx, wb.Path); err != nil {
+					return nil, fmt.Errorf("failed to remove worktree for %s: %w", wb.ID, err)
+				}
+				result.WorktreesRemoved = append(result.WorktreesRemoved, wb.Path)
+			}
+			if err := s.workbenchRepo.Update(ctx, &secondary.WorkbenchRecord{ID: wb.ID, Status: "archived"}); err != nil {
+				return nil, fmt.Errorf("failed to archive workbench %s: %w", wb.ID, err)
+			}
+			result.WorkbenchesArchived = append(result.WorkbenchesArchived, wb.ID)
+		}
+
+		if ws.SessionName != "" {
+			if err := s.tmuxAdapter.KillSession(ctx, ws.SessionName); err != nil {
+				return nil, fmt.Errorf("failed to kill session %s: %w", ws.SessionName, err)
+			}
+			result.SessionsKilled = append(result.SessionsKilled, ws.SessionName)
+		}
+
+		if ws.Status != "archived" {
+			if err := s.workshopRepo.Update(ctx, &secondary.WorkshopRecord{ID: ws.ID, Status: "archived"}); err != nil {
+				return nil, fmt.Errorf("failed to archive workshop %s: %w", ws.ID, err)
+			}
+			result.WorkshopsArchived = append(result.WorkshopsArchived, ws.ID)
+		}
+	}
+
+	// 5. Factory
+	if err := s.factoryRepo.Update(ctx, &secondary.FactoryRecord{ID: plan.FactoryID, Status: "archived"}); err != nil {
+		return nil, fmt.Errorf("failed to archive factory: %w", err)
+	}
+
+	// 6. Final commission report
+	report, err := s.buildReport(ctx, plan, dispositions, workshopIDs, result)
+	if err != nil {
+		return nil, err
+	}
+	result.Report = corefactory.RenderTeardownReport(*report)
+
+	return result, nil
+}
+
+// findSession returns the workshop's running tmux session, if any.
+func (s *FactoryTeardownServiceImpl) findSession(ctx context.Context, ws *secondary.WorkshopRecord) string {
+	if name := s.tmuxAdapter.FindSessionByWorkshopID(ctx, ws.ID); name != "" {
+		return name
+	}
+	if s.tmuxAdapter.SessionExists(ctx, ws.Name) {
+		return ws.Name
+	}
+	return ""
+}
+
+// factoryCommissions returns the IDs of commissions in the given workshops.
+func (s *FactoryTeardownServiceImpl) factoryCommissions(ctx context.Context, workshopIDs map[string]bool) (map[string]bool, error) {
+	commissions, err := s.commissionRepo.List(ctx, secondary.CommissionFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list commissions: %w", err)
+	}
+	ids := make(map[string]bool)
+	for _, c := range commissions {
+		if workshopIDs[c.WorkshopID] {
+			ids[c.ID] = true
+		}
+	}
+	return ids, nil
+}
+
+// buildReport collects the post-teardown state of the factory's commissions
+// and of any other commission whose shipments teardown touched.
+func (s *FactoryTeardownServiceImpl) buildReport(ctx context.Context, plan *primary.FactoryTeardownPlan, dispositions map[string]primary.ShipmentDisposition, workshopIDs map[string]bool, result *primary.FactoryTeardownResult) (*corefactory.TeardownReport, error) {
+	commissionIDs, err := s.factoryCommissions(ctx, workshopIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, sh := range plan.OpenShipments {
+		commissionIDs[sh.CommissionID] = true
+	}
+	ids := make([]string, 0, len(commissionIDs))
+	for id := range commissionIDs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	report := &corefactory.TeardownReport{
+		FactoryID:   plan.FactoryID,
+		FactoryName: plan.FactoryName,
+		GeneratedAt: time.Now().Format("2006-01-02 15:04"),
+		Workshops:   result.WorkshopsArchived,
+		Workbenches: result.WorkbenchesArchived,
+		Worktrees:   result.WorktreesRemoved,
+		Sessions:    result.SessionsKilled,
+	}
+	for _, id := range ids {
+		commission, err := s.commissionRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load commission %s: %w", id, err)
+		}
+		shipments, err := s.shipmentRepo.List(ctx, secondary.ShipmentFilters{CommissionID: id})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list shipments for %s: %w", id, err)
+		}
+		sort.Slice(shipments, func(i, j int) bool { return shipments[i].ID < shipments[j].ID })
+
+		rc := corefactory.ReportCommission{ID: commission.ID, Title: commission.Title, Status: commission.Status}
+		for _, sh := range shipments {
+			rc.Shipments = append(rc.Shipments, corefactory.ReportShipment{
+				ID:          sh.ID,
+				Title:       sh.Title,
+				Status:      sh.Status,
+				TaskCount:   sh.TaskCount,
+				TasksClosed: sh.TasksClosed,
+				Disposition: dispositions[sh.ID].Action,
+			})
+		}
+		report.Commissions = append(report.Commissions, rc)
+	}
+	return report, nil
+}
+
+// Ensure FactoryTeardownServiceImpl implements the interface
+var _ primary.FactoryTeardownService = (*FactoryTeardownServiceImpl)(nil)
@@ -0,0 +1,145 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	coreworkbench "github.com/example/orc/internal/core/workbench"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// newTestFactoryTeardownService seeds FACT-002 with one workshop (session
+// running), one workbench with a worktree, a commission with two open
+// shipments, and a workbench in another factory to reassign to.
+func newTestFactoryTeardownService() (*FactoryTeardownServiceImpl, *mockFactoryRepoForService, *mockWorkshopRepository, *mockWorkbenchRepository, *mockShipmentRepository, *mockWorkspaceAdapter, *mockTMuxAdapter) {
+	factoryRepo := newMockFactoryRepoForService()
+	workshopRepo := newMockWorkshopRepository()
+	workbenchRepo := newMockWorkbenchRepository()
+	commissionRepo := newMockCommissionRepository()
+	shipmentRepo := newMockShipmentRepository()
+	workspace := newMockWorkspaceAdapter()
+	tmux := newMockTMuxAdapter()
+
+	factoryRepo.factories["FACT-002"] = &secondary.FactoryRecord{ID: "FACT-002", Name: "staging", Status: "active"}
+	workshopRepo.workshops["WORK-004"] = &secondary.WorkshopRecord{ID: "WORK-004", FactoryID: "FACT-002", Name: "billing", Status: "active"}
+	workshopRepo.workshops["WORK-009"] = &secondary.WorkshopRecord{ID: "WORK-009", FactoryID: "FACT-001", Name: "main", Status: "active"}
+	workbenchRepo.workbenches["BENCH-003"] = &secondary.WorkbenchRecord{ID: "BENCH-003", WorkshopID: "WORK-004", Name: "billing-api", Status: "active"}
+	workbenchRepo.workbenches["BENCH-009"] = &secondary.WorkbenchRecord{ID: "BENCH-009", WorkshopID: "WORK-009", Name: "main-api", Status: "active"}
+	workspace.worktrees[coreworkbench.ComputePath("billing-api")] = true
+	tmux.sessions["billing"] = true
+	commissionRepo.commissions["COMM-003"] = &secondary.CommissionRecord{ID: "COMM-003", WorkshopID: "WORK-004", Title: "Billing", Status: "active"}
+	shipmentRepo.shipments["SHIP-010"] = &secondary.ShipmentRecord{ID: "SHIP-010", CommissionID: "COMM-003", Title: "Invoices", Status: "in-progress", AssignedWorkbenchID: "BENCH-003", TaskCount: 2, TasksClosed: 2}
+	shipmentRepo.shipments["SHIP-011"] = &secondary.ShipmentRecord{ID: "SHIP-011", CommissionID: "COMM-003", Title: "Refunds", Status: "ready", TaskCount: 3, TasksClosed: 1}
+	shipmentRepo.shipments["SHIP-012"] = &secondary.ShipmentRecord{ID: "SHIP-012", CommissionID: "COMM-003", Title: "Done", Status: "closed"}
+
+	shipmentService := NewShipmentService(shipmentRepo, newMockTaskRepository(), nil, NewLockService(newMockEntityLockRepository(), shipmentRepo, newMockPlanRepository()))
+	service := NewFactoryTeardownService(factoryRepo, workshopRepo, workbenchRepo, commissionRepo, shipmentRepo, shipmentService, workspace, tmux)
+	return service, factoryRepo, workshopRepo, workbenchRepo, shipmentRepo, workspace, tmux
+}
+
+func TestFactoryTeardownService_PlanTeardown(t *testing.T) {
+	service, _, _, _, _, _, _ := newTestFactoryTeardownService()
+
+	plan, err := service.PlanTeardown(context.Background(), "FACT-002")
+	if err != nil {
+		t.Fatalf("PlanTeardown failed: %v", err)
+	}
+
+	if len(plan.Workshops) != 1 || plan.Workshops[0].SessionName != "billing" {
+		t.Fatalf("unexpected workshops: %+v", plan.Workshops)
+	}
+	benches := plan.Workshops[0].Workbenches
+	if len(benches) != 1 || benches[0].ID != "BENCH-003" || !benches[0].WorktreeExists {
+		t.Errorf("unexpected workbenches: %+v", benches)
+	}
+	if len(plan.OpenShipments) != 2 {
+		t.Fatalf("expected 2 open shipments, got %+v", plan.OpenShipments)
+	}
+	if got := plan.OpenShipments[0]; got.ID != "SHIP-010" || got.DefaultDisposition != primary.TeardownComplete {
+		t.Errorf("unexpected first shipment: %+v", got)
+	}
+	if got := plan.OpenShipments[1]; got.ID != "SHIP-011" || got.DefaultDisposition != primary.TeardownRelease {
+		t.Errorf("unexpected second shipment: %+v", got)
+	}
+}
+
+func TestFactoryTeardownService_ApplyTeardown(t *testing.T) {
+	service, factoryRepo, workshopRepo, workbenchRepo, shipmentRepo, workspace, tmux := newTestFactoryTeardownService()
+
+	result, err := service.ApplyTeardown(context.Background(), primary.ApplyTeardownRequest{
+		FactoryID: "FACT-002",
+		Dispositions: map[string]primary.ShipmentDisposition{
+			"SHIP-011": {Action: primary.TeardownReassign, WorkbenchID: "BENCH-009"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ApplyTeardown failed: %v", err)
+	}
+
+	if shipmentRepo.shipments["SHIP-010"].Status != "closed" {
+		t.Errorf("expected SHIP-010 completed, got %s", shipmentRepo.shipments["SHIP-010"].Status)
+	}
+	if shipmentRepo.shipments["SHIP-011"].AssignedWorkbenchID != "BENCH-009" {
+		t.Errorf("expected SHIP-011 reassigned to BENCH-009, got %q", shipmentRepo.shipments["SHIP-011"].AssignedWorkbenchID)
+	}
+	if workbenchRepo.workbenches["BENCH-003"].Status != "archived" {
+		t.Error("expected BENCH-003 archived")
+	}
+	if workspace.worktrees[coreworkbench.ComputePath("billing-api")] {
+		t.Error("expected worktree removed")
+	}
+	if tmux.sessions["billing"] {
+		t.Error("expected session killed")
+	}
+	if workshopRepo.workshops["WORK-004"].Status != "archived" || workshopRepo.workshops["WORK-009"].Status != "active" {
+		t.Error("expected only WORK-004 archived")
+	}
+	if factoryRepo.factories["FACT-002"].Status != "archived" {
+		t.Error("expected factory archived")
+	}
+
+	if len(result.Completed) != 1 || len(result.Reassigned) != 1 || len(result.WorkbenchesArchived) != 1 || len(result.SessionsKilled) != 1 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	for _, want := range []string{"# Factory staging (FACT-002) teardown", "| SHIP-010 | Invoices | closed | 2/2 | complete |", "| SHIP-011 | Refunds | ready | 1/3 | reassign |", "| SHIP-012 | Done | closed | 0/0 | - |"} {
+		if !strings.Contains(result.Report, want) {
+			t.Errorf("report missing %q:\n%s", want, result.Report)
+		}
+	}
+}
+
+func TestFactoryTeardownService_ApplyTeardown_DirtyWorkbench(t *testing.T) {
+	service, factoryRepo, _, _, shipmentRepo, workspace, _ := newTestFactoryTeardownService()
+	workspace.workingChanges = []string{"main.go"}
+
+	_, err := service.ApplyTeardown(context.Background(), primary.ApplyTeardownRequest{FactoryID: "FACT-002"})
+	if err == nil || !strings.Contains(err.Error(), "uncommitted changes: BENCH-003") {
+		t.Fatalf("expected dirty workbench error, got %v", err)
+	}
+	if factoryRepo.factories["FACT-002"].Status != "active" || shipmentRepo.shipments["SHIP-010"].Status != "in-progress" {
+		t.Error("expected nothing changed when guard fails")
+	}
+
+	if _, err := service.ApplyTeardown(context.Background(), primary.ApplyTeardownRequest{FactoryID: "FACT-002", Force: true}); err != nil {
+		t.Fatalf("ApplyTeardown with force failed: %v", err)
+	}
+	if shipmentRepo.shipments["SHIP-011"].Status != "ready" {
+		t.Error("expected SHIP-011 released, not closed")
+	}
+}
+
+func TestFactoryTeardownService_ApplyTeardown_ReassignWithinFactory(t *testing.T) {
+	service, _, _, _, _, _, _ := newTestFactoryTeardownService()
+
+	_, err := service.ApplyTeardown(context.Background(), primary.ApplyTeardownRequest{
+		FactoryID: "FACT-002",
+		Dispositions: map[string]primary.ShipmentDisposition{
+			"SHIP-011": {Action: primary.TeardownReassign, WorkbenchID: "BENCH-003"},
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "being torn down") {
+		t.Fatalf("expected reassign guard error, got %v", err)
+	}
+}
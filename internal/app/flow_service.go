@@ -0,0 +1,222 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	coreflow "github.com/example/orc/internal/core/flow"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// FlowServiceImpl implements the FlowService interface.
+type FlowServiceImpl struct {
+	flowRepo         secondary.FlowStepRepository
+	shipmentService  primary.ShipmentService
+	taskService      primary.TaskService
+	noteService      primary.NoteService
+	workbenchService primary.WorkbenchService
+}
+
+// NewFlowService creates a new FlowService with injected dependencies.
+func NewFlowService(
+	flowRepo secondary.FlowStepRepository,
+	shipmentService primary.ShipmentService,
+	taskService primary.TaskService,
+	noteService primary.NoteService,
+	workbenchService primary.WorkbenchService,
+) *FlowServiceImpl {
+	return &FlowServiceImpl{
+		flowRepo:         flowRepo,
+		shipmentService:  shipmentService,
+		taskService:      taskService,
+		noteService:      noteService,
+		workbenchService: workbenchService,
+	}
+}
+
+// CheckFlow parses and validates a flow definition without running it.
+func (s *FlowServiceImpl) CheckFlow(ctx context.Context, definition string) (*primary.FlowDefinition, error) {
+	f, err := coreflow.Parse(definition)
+	if err != nil {
+		return nil, fmt.Errorf("invalid flow: %w", err)
+	}
+
+	def := &primary.FlowDefinition{Name: f.Name, Description: f.Description, Params: f.Params}
+	for _, step := range f.Steps {
+		def.Steps = append(def.Steps, primary.FlowStepInfo{ID: step.ID, Action: step.Action})
+	}
+	return def, nil
+}
+
+// RunFlow runs a flow's steps in order, skipping those an earlier run with
+// the same key completed. Each step is recorded as soon as it succeeds, so a
+// failed run picks up at the failing step when run again.
+func (s *FlowServiceImpl) RunFlow(ctx context.Context, req primary.RunFlowRequest) (*primary.FlowRunReport, error) {
+	f, err := coreflow.Parse(req.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("invalid flow: %w", err)
+	}
+	if err := f.CheckParams(req.Params); err != nil {
+		return nil, err
+	}
+
+	runKey := req.RunKey
+	if runKey == "" {
+		runKey = coreflow.RunKey(f.Name, req.Params)
+	}
+	records, err := s.flowRepo.ListByRun(ctx, runKey)
+	if err != nil {
+		return nil, err
+	}
+	done := make(map[string]string, len(records))
+	for _, r := range records {
+		done[r.StepKey] = r.Output
+	}
+
+	vars := make(map[string]string, len(req.Params)+len(f.Steps))
+	for k, v := range req.Params {
+		vars[k] = v
+	}
+
+	report := &primary.FlowRunReport{Flow: f.Name, RunKey: runKey}
+	for _, step := range f.Steps {
+		args := make(map[string]string, len(step.Args)+1)
+		for k, v := range step.Args {
+			if args[k], err = coreflow.Expand(v, vars); err != nil {
+				return report, fmt.Errorf("step %s: %s: %w", step.ID, k, err)
+			}
+		}
+		if args["commission"], err = coreflow.Expand(f.StepCommission(step), vars); err != nil {
+			return report, fmt.Errorf("step %s: commission: %w", step.ID, err)
+		}
+
+		result := primary.FlowStepResult{ID: step.ID, Action: step.Action}
+		if step.Action == coreflow.ActionCreateTasks && len(step.Titles) > 0 {
+			result, err = s.runTaskList(ctx, runKey, step, args, vars, done)
+		} else if output, ok := done[step.ID]; ok {
+			result.Output, result.Skipped = output, true
+		} else if result.Output, err = s.runStep(ctx, step.Action, args); err == nil {
+			err = s.record(ctx, runKey, step.ID, result.Output)
+		}
+		if err != nil {
+			return report, fmt.Errorf("step %s (%s) failed: %w", step.ID, step.Action, err)
+		}
+
+		report.Steps = append(report.Steps, result)
+		vars[step.ID] = result.Output
+	}
+	return report, nil
+}
+
+// runTaskList creates one task per title, recording each on its own so a
+// rerun creates only the tasks still missing.
+func (s *FlowServiceImpl) runTaskList(ctx context.Context, runKey string, step coreflow.Step, args, vars, done map[string]string) (primary.FlowStepResult, error) {
+	result := primary.FlowStepResult{ID: step.ID, Action: step.Action, Skipped: true}
+	ids := make([]string, 0, len(step.Titles))
+	for i, title := range step.Titles {
+		key := coreflow.ItemKey(step.ID, i+1)
+		if id, ok := done[key]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		result.Skipped = false
+
+		expanded, err := coreflow.Expand(title, vars)
+		if err != nil {
+			return result, fmt.Errorf("titles: %w", err)
+		}
+		taskArgs := make(map[string]string, len(args)+1)
+		for k, v := range args {
+			taskArgs[k] = v
+		}
+		taskArgs["title"] = expanded
+		id, err := s.runStep(ctx, step.Action, taskArgs)
+		if err != nil {
+			return result, err
+		}
+		if err := s.record(ctx, runKey, key, id); err != nil {
+			return result, err
+		}
+		ids = append(ids, id)
+	}
+	result.Output = strings.Join(ids, ",")
+	return result, nil
+}
+
+// runStep performs one action with expanded arguments and returns the ID it
+// created or acted on.
+func (s *FlowServiceImpl) runStep(ctx context.Context, action string, args map[string]string) (string, error) {
+	switch action {
+	case coreflow.ActionCreateShipment:
+		resp, err := s.shipmentService.CreateShipment(ctx, primary.CreateShipmentRequest{
+			CommissionID: args["commission"],
+			Title:        args["title"],
+			Description:  args["description"],
+			RepoID:       args["repo"],
+			Branch:       args["branch"],
+		})
+		if err != nil {
+			return "", err
+		}
+		return resp.ShipmentID, nil
+
+	case coreflow.ActionCreateTasks:
+		resp, err := s.taskService.CreateTask(ctx, primary.CreateTaskRequest{
+			CommissionID: args["commission"],
+			ShipmentID:   args["shipment"],
+			Title:        args["title"],
+			Description:  args["description"],
+			Type:         args["type"],
+			Tag:          args["tag"],
+		})
+		if err != nil {
+			return "", err
+		}
+		return resp.TaskID, nil
+
+	case coreflow.ActionAssign:
+		if err := s.shipmentService.AssignShipmentToWorkbench(ctx, args["shipment"], args["workbench"]); err != nil {
+			return "", err
+		}
+		return args["workbench"], nil
+
+	case coreflow.ActionCreateNote:
+		req := primary.CreateNoteRequest{
+			CommissionID: args["commission"],
+			Title:        args["title"],
+			Content:      args["content"],
+			Type:         args["type"],
+		}
+		switch {
+		case args["shipment"] != "":
+			req.ContainerID, req.ContainerType = args["shipment"], "shipment"
+		case args["tome"] != "":
+			req.ContainerID, req.ContainerType = args["tome"], "tome"
+		}
+		resp, err := s.noteService.CreateNote(ctx, req)
+		if err != nil {
+			return "", err
+		}
+		return resp.NoteID, nil
+
+	case coreflow.ActionFocus:
+		if _, err := s.workbenchService.GetWorkbench(ctx, args["workbench"]); err != nil {
+			return "", fmt.Errorf("workbench %s not found: %w", args["workbench"], err)
+		}
+		if err := s.workbenchService.UpdateFocusedID(ctx, args["workbench"], args["target"]); err != nil {
+			return "", err
+		}
+		return args["target"], nil
+	}
+	return "", fmt.Errorf("unknown action %q", action)
+}
+
+// record marks a step of the run completed.
+func (s *FlowServiceImpl) record(ctx context.Context, runKey, stepKey, output string) error {
+	return s.flowRepo.Record(ctx, &secondary.FlowStepRecord{RunKey: runKey, StepKey: stepKey, Output: output})
+}
+
+// Ensure FlowServiceImpl implements the interface
+var _ primary.FlowService = (*FlowServiceImpl)(nil)
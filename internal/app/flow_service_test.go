@@ -0,0 +1,240 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// flowFakes stands in for the ledger a flow writes through.
+type flowFakes struct {
+	shipments   []primary.CreateShipmentRequest
+	tasks       []primary.CreateTaskRequest
+	notes       []primary.CreateNoteRequest
+	assigned    map[string]string // shipment -> workbench
+	focus       map[string]string // workbench -> focused ID
+	failTaskNum int               // CreateTask fails on this call (1-based); 0 never
+	steps       []*secondary.FlowStepRecord
+}
+
+type flowShipments struct {
+	primary.ShipmentService
+	f *flowFakes
+}
+
+func (m flowShipments) CreateShipment(ctx context.Context, req primary.CreateShipmentRequest) (*primary.CreateShipmentResponse, error) {
+	m.f.shipments = append(m.f.shipments, req)
+	return &primary.CreateShipmentResponse{ShipmentID: fmt.Sprintf("SHIP-%03d", len(m.f.shipments))}, nil
+}
+
+func (m flowShipments) AssignShipmentToWorkbench(ctx context.Context, shipmentID, workbenchID string) error {
+	m.f.assigned[shipmentID] = workbenchID
+	return nil
+}
+
+type flowTasks struct {
+	primary.TaskService
+	f *flowFakes
+}
+
+func (m flowTasks) CreateTask(ctx context.Context, req primary.CreateTaskRequest) (*primary.CreateTaskResponse, error) {
+	if len(m.f.tasks)+1 == m.f.failTaskNum {
+		m.f.failTaskNum = 0
+		return nil, errors.New("database is locked")
+	}
+	m.f.tasks = append(m.f.tasks, req)
+	return &primary.CreateTaskResponse{TaskID: fmt.Sprintf("TASK-%03d", len(m.f.tasks))}, nil
+}
+
+type flowNotes struct {
+	primary.NoteService
+	f *flowFakes
+}
+
+func (m flowNotes) CreateNote(ctx context.Context, req primary.CreateNoteRequest) (*primary.CreateNoteResponse, error) {
+	m.f.notes = append(m.f.notes, req)
+	return &primary.CreateNoteResponse{NoteID: fmt.Sprintf("NOTE-%03d", len(m.f.notes))}, nil
+}
+
+type flowWorkbenches struct {
+	primary.WorkbenchService
+	f *flowFakes
+}
+
+func (m flowWorkbenches) GetWorkbench(ctx context.Context, id string) (*primary.Workbench, error) {
+	if id != "BENCH-001" {
+		return nil, errors.New("not found")
+	}
+	return &primary.Workbench{ID: id}, nil
+}
+
+func (m flowWorkbenches) UpdateFocusedID(ctx context.Context, workbenchID, focusedID string) error {
+	m.f.focus[workbenchID] = focusedID
+	return nil
+}
+
+type flowSteps struct {
+	f *flowFakes
+}
+
+func (m flowSteps) ListByRun(ctx context.Context, runKey string) ([]*secondary.FlowStepRecord, error) {
+	var steps []*secondary.FlowStepRecord
+	for _, s := range m.f.steps {
+		if s.RunKey == runKey {
+			steps = append(steps, s)
+		}
+	}
+	return steps, nil
+}
+
+func (m flowSteps) Record(ctx context.Context, step *secondary.FlowStepRecord) error {
+	m.f.steps = append(m.f.steps, step)
+	return nil
+}
+
+func newTestFlowService() (*FlowServiceImpl, *flowFakes) {
+	f := &flowFakes{assigned: map[string]string{}, focus: map[string]string{}}
+	return NewFlowService(flowSteps{f}, flowShipments{f: f}, flowTasks{f: f}, flowNotes{f: f}, flowWorkbenches{f: f}), f
+}
+
+const testKickoffFlow = `name: kickoff
+commission: ${commission}
+params:
+  - commission
+  - title
+  - bench
+steps:
+  - id: ship
+    do: shipment.create
+    title: ${title}
+  - id: tasks
+    do: task.create
+    shipment: ${ship}
+    titles:
+      - Spec ${title}
+      - Build ${title}
+  - id: assign
+    do: shipment.assign
+    shipment: ${ship}
+    workbench: ${bench}
+  - id: kickoff
+    do: note.create
+    shipment: ${ship}
+    title: Kickoff
+    content: Tasks ${tasks}
+  - id: focus
+    do: focus
+    workbench: ${bench}
+    target: ${ship}
+`
+
+var testKickoffParams = map[string]string{"commission": "COMM-001", "title": "Login", "bench": "BENCH-001"}
+
+func TestFlowService_RunFlow(t *testing.T) {
+	service, f := newTestFlowService()
+	ctx := context.Background()
+
+	report, err := service.RunFlow(ctx, primary.RunFlowRequest{Definition: testKickoffFlow, Params: testKickoffParams})
+	if err != nil {
+		t.Fatalf("RunFlow failed: %v", err)
+	}
+	if !strings.HasPrefix(report.RunKey, "kickoff-") || len(report.Steps) != 5 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if len(f.shipments) != 1 || f.shipments[0].CommissionID != "COMM-001" || f.shipments[0].Title != "Login" {
+		t.Errorf("unexpected shipments: %+v", f.shipments)
+	}
+	if len(f.tasks) != 2 || f.tasks[1].Title != "Build Login" || f.tasks[1].ShipmentID != "SHIP-001" {
+		t.Errorf("unexpected tasks: %+v", f.tasks)
+	}
+	if report.Steps[1].Output != "TASK-001,TASK-002" {
+		t.Errorf("tasks output = %q", report.Steps[1].Output)
+	}
+	if f.assigned["SHIP-001"] != "BENCH-001" || f.focus["BENCH-001"] != "SHIP-001" {
+		t.Errorf("assign/focus not applied: %v %v", f.assigned, f.focus)
+	}
+	if len(f.notes) != 1 || f.notes[0].ContainerID != "SHIP-001" || f.notes[0].Content != "Tasks TASK-001,TASK-002" {
+		t.Errorf("unexpected notes: %+v", f.notes)
+	}
+
+	// Running again with the same params does nothing new
+	report, err = service.RunFlow(ctx, primary.RunFlowRequest{Definition: testKickoffFlow, Params: testKickoffParams})
+	if err != nil {
+		t.Fatalf("rerun failed: %v", err)
+	}
+	for _, step := range report.Steps {
+		if !step.Skipped {
+			t.Errorf("step %s ran again", step.ID)
+		}
+	}
+	if len(f.shipments) != 1 || len(f.tasks) != 2 || len(f.notes) != 1 {
+		t.Errorf("rerun created entities: %d shipments, %d tasks, %d notes", len(f.shipments), len(f.tasks), len(f.notes))
+	}
+}
+
+func TestFlowService_RunFlow_ResumesAfterFailure(t *testing.T) {
+	service, f := newTestFlowService()
+	ctx := context.Background()
+	f.failTaskNum = 2
+
+	report, err := service.RunFlow(ctx, primary.RunFlowRequest{Definition: testKickoffFlow, Params: testKickoffParams})
+	if err == nil || !strings.Contains(err.Error(), "step tasks") {
+		t.Fatalf("expected the tasks step to fail, got %v", err)
+	}
+	if len(report.Steps) != 1 || report.Steps[0].ID != "ship" {
+		t.Errorf("expected only ship completed, got %+v", report.Steps)
+	}
+
+	report, err = service.RunFlow(ctx, primary.RunFlowRequest{Definition: testKickoffFlow, Params: testKickoffParams})
+	if err != nil {
+		t.Fatalf("resume failed: %v", err)
+	}
+	if !report.Steps[0].Skipped || report.Steps[1].Skipped {
+		t.Errorf("expected ship skipped and tasks resumed: %+v", report.Steps)
+	}
+	if len(f.shipments) != 1 || len(f.tasks) != 2 {
+		t.Errorf("resume duplicated work: %d shipments, %d tasks", len(f.shipments), len(f.tasks))
+	}
+	if report.Steps[1].Output != "TASK-001,TASK-002" {
+		t.Errorf("tasks output = %q", report.Steps[1].Output)
+	}
+}
+
+func TestFlowService_RunFlow_Errors(t *testing.T) {
+	service, f := newTestFlowService()
+	ctx := context.Background()
+
+	if _, err := service.RunFlow(ctx, primary.RunFlowRequest{Definition: "name: x\n"}); err == nil || !strings.Contains(err.Error(), "invalid flow") {
+		t.Errorf("expected invalid flow, got %v", err)
+	}
+	if _, err := service.RunFlow(ctx, primary.RunFlowRequest{Definition: testKickoffFlow, Params: map[string]string{"title": "x"}}); err == nil || !strings.Contains(err.Error(), "missing params") {
+		t.Errorf("expected missing params, got %v", err)
+	}
+
+	params := map[string]string{"commission": "COMM-001", "title": "Login", "bench": "BENCH-404"}
+	if _, err := service.RunFlow(ctx, primary.RunFlowRequest{Definition: testKickoffFlow, Params: params, RunKey: "try"}); err == nil || !strings.Contains(err.Error(), "step focus") {
+		t.Errorf("expected focus to fail on an unknown bench, got %v", err)
+	}
+	for _, s := range f.steps {
+		if s.RunKey != "try" {
+			t.Errorf("step recorded under %q, want the given run key", s.RunKey)
+		}
+	}
+}
+
+func TestFlowService_CheckFlow(t *testing.T) {
+	service, _ := newTestFlowService()
+
+	def, err := service.CheckFlow(context.Background(), testKickoffFlow)
+	if err != nil {
+		t.Fatalf("CheckFlow failed: %v", err)
+	}
+	if def.Name != "kickoff" || len(def.Params) != 3 || len(def.Steps) != 5 || def.Steps[3].Action != "note.create" {
+		t.Errorf("unexpected definition: %+v", def)
+	}
+}
@@ -0,0 +1,166 @@
+package app
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	coreglossary "github.com/example/orc/internal/core/glossary"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// GlossaryServiceImpl implements the GlossaryService interface.
+type GlossaryServiceImpl struct {
+	glossaryRepo   secondary.GlossaryRepository
+	commissionRepo secondary.CommissionRepository
+	tagRepo        secondary.TagRepository
+	planRepo       secondary.PlanRepository
+	noteRepo       secondary.NoteRepository
+}
+
+// NewGlossaryService creates a new GlossaryService with injected dependencies.
+func NewGlossaryService(
+	glossaryRepo secondary.GlossaryRepository,
+	commissionRepo secondary.CommissionRepository,
+	tagRepo secondary.TagRepository,
+	planRepo secondary.PlanRepository,
+	noteRepo secondary.NoteRepository,
+) *GlossaryServiceImpl {
+	return &GlossaryServiceImpl{
+		glossaryRepo:   glossaryRepo,
+		commissionRepo: commissionRepo,
+		tagRepo:        tagRepo,
+		planRepo:       planRepo,
+		noteRepo:       noteRepo,
+	}
+}
+
+// AddTerm adds or updates a term in a commission's glossary.
+func (s *GlossaryServiceImpl) AddTerm(ctx context.Context, req primary.AddGlossaryTermRequest) error {
+	term := coreglossary.NormalizeTerm(req.Term)
+	replacedBy := coreglossary.NormalizeTerm(req.ReplacedBy)
+	definition := strings.TrimSpace(req.Definition)
+
+	_, err := s.commissionRepo.GetByID(ctx, req.CommissionID)
+	guardCtx := coreglossary.AddTermContext{
+		CommissionID:     req.CommissionID,
+		CommissionExists: err == nil,
+		Term:             term,
+		Definition:       definition,
+		ReplacedBy:       replacedBy,
+	}
+	if result := coreglossary.CanAddTerm(guardCtx); !result.Allowed {
+		return result.Error()
+	}
+
+	return s.glossaryRepo.Save(ctx, &secondary.GlossaryTermRecord{
+		CommissionID: req.CommissionID,
+		Term:         term,
+		Definition:   definition,
+		ReplacedBy:   replacedBy,
+	})
+}
+
+// ListTerms lists a commission's glossary ordered by term.
+func (s *GlossaryServiceImpl) ListTerms(ctx context.Context, commissionID string) ([]*primary.GlossaryTerm, error) {
+	records, err := s.glossaryRepo.List(ctx, commissionID)
+	if err != nil {
+		return nil, err
+	}
+	terms := make([]*primary.GlossaryTerm, len(records))
+	for i, r := range records {
+		terms[i] = &primary.GlossaryTerm{
+			CommissionID: r.CommissionID,
+			Term:         r.Term,
+			Definition:   r.Definition,
+			ReplacedBy:   r.ReplacedBy,
+		}
+	}
+	return terms, nil
+}
+
+// RemoveTerm removes a term from a commission's glossary.
+func (s *GlossaryServiceImpl) RemoveTerm(ctx context.Context, commissionID, term string) error {
+	return s.glossaryRepo.Delete(ctx, commissionID, coreglossary.NormalizeTerm(term))
+}
+
+// SeedFromTags adds each described tag as a term, leaving terms already in
+// the glossary as they are.
+func (s *GlossaryServiceImpl) SeedFromTags(ctx context.Context, commissionID string) ([]*primary.GlossaryTerm, error) {
+	existing, err := s.ListTerms(ctx, commissionID)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool, len(existing))
+	for _, t := range existing {
+		known[strings.ToLower(t.Term)] = true
+	}
+
+	tags, err := s.tagRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Name < tags[j].Name })
+
+	var added []*primary.GlossaryTerm
+	for _, tag := range tags {
+		if strings.TrimSpace(tag.Description) == "" || known[strings.ToLower(tag.Name)] {
+			continue
+		}
+		req := primary.AddGlossaryTermRequest{CommissionID: commissionID, Term: tag.Name, Definition: tag.Description}
+		if err := s.AddTerm(ctx, req); err != nil {
+			return nil, err
+		}
+		added = append(added, &primary.GlossaryTerm{CommissionID: commissionID, Term: req.Term, Definition: strings.TrimSpace(req.Definition)})
+	}
+	return added, nil
+}
+
+// Lint scans the commission's plans and open notes for deprecated terms.
+func (s *GlossaryServiceImpl) Lint(ctx context.Context, commissionID string) ([]*primary.GlossaryFinding, error) {
+	records, err := s.glossaryRepo.List(ctx, commissionID)
+	if err != nil {
+		return nil, err
+	}
+	terms := make([]coreglossary.Term, len(records))
+	for i, r := range records {
+		terms[i] = coreglossary.Term{Term: r.Term, Definition: r.Definition, ReplacedBy: r.ReplacedBy}
+	}
+
+	plans, err := s.planRepo.List(ctx, secondary.PlanFilters{CommissionID: commissionID})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(plans, func(i, j int) bool { return plans[i].ID < plans[j].ID })
+	notes, err := s.noteRepo.List(ctx, secondary.NoteFilters{CommissionID: commissionID})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(notes, func(i, j int) bool { return notes[i].ID < notes[j].ID })
+
+	var findings []*primary.GlossaryFinding
+	check := func(entityID, title string, text ...string) {
+		for _, t := range coreglossary.FindDeprecated(strings.Join(text, "\n"), terms) {
+			findings = append(findings, &primary.GlossaryFinding{EntityID: entityID, Title: title, Term: t.Term, ReplacedBy: t.ReplacedBy})
+		}
+	}
+	for _, p := range plans {
+		check(p.ID, p.Title, p.Title, p.Description, p.Content)
+	}
+	for _, n := range notes {
+		if n.Status == "closed" {
+			continue
+		}
+		if n.ContentExternal {
+			if n, err = s.noteRepo.GetByID(ctx, n.ID); err != nil {
+				return nil, err
+			}
+		}
+		check(n.ID, n.Title, n.Title, n.Content)
+	}
+	return findings, nil
+}
+
+// Ensure GlossaryServiceImpl implements the interface
+var _ primary.GlossaryService = (*GlossaryServiceImpl)(nil)
@@ -0,0 +1,122 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// mockGlossaryRepository implements secondary.GlossaryRepository for testing.
+type mockGlossaryRepository struct {
+	terms []*secondary.GlossaryTermRecord
+}
+
+func (m *mockGlossaryRepository) Save(_ context.Context, term *secondary.GlossaryTermRecord) error {
+	for _, t := range m.terms {
+		if t.CommissionID == term.CommissionID && strings.EqualFold(t.Term, term.Term) {
+			t.Definition, t.ReplacedBy = term.Definition, term.ReplacedBy
+			return nil
+		}
+	}
+	m.terms = append(m.terms, term)
+	return nil
+}
+
+func (m *mockGlossaryRepository) List(_ context.Context, commissionID string) ([]*secondary.GlossaryTermRecord, error) {
+	var result []*secondary.GlossaryTermRecord
+	for _, t := range m.terms {
+		if t.CommissionID == commissionID {
+			result = append(result, t)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockGlossaryRepository) Delete(_ context.Context, commissionID, term string) error {
+	for i, t := range m.terms {
+		if t.CommissionID == commissionID && strings.EqualFold(t.Term, term) {
+			m.terms = append(m.terms[:i], m.terms[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("term %q not found", term)
+}
+
+func newTestGlossaryService() (*GlossaryServiceImpl, *mockGlossaryRepository, *mockTagRepository, *mockPlanRepository, *mockNoteRepository) {
+	glossaryRepo := &mockGlossaryRepository{}
+	commissionRepo := newMockCommissionRepository()
+	commissionRepo.commissions["COMM-001"] = &secondary.CommissionRecord{ID: "COMM-001", Title: "Client work"}
+	tagRepo := newMockTagRepository()
+	planRepo := newMockPlanRepository()
+	noteRepo := newMockNoteRepository()
+	return NewGlossaryService(glossaryRepo, commissionRepo, tagRepo, planRepo, noteRepo), glossaryRepo, tagRepo, planRepo, noteRepo
+}
+
+func TestGlossaryService_AddTerm(t *testing.T) {
+	service, glossaryRepo, _, _, _ := newTestGlossaryService()
+	ctx := context.Background()
+
+	if err := service.AddTerm(ctx, primary.AddGlossaryTermRequest{CommissionID: "COMM-001", Term: "  work   order ", ReplacedBy: "shipment"}); err != nil {
+		t.Fatalf("AddTerm failed: %v", err)
+	}
+	if len(glossaryRepo.terms) != 1 || glossaryRepo.terms[0].Term != "work order" {
+		t.Errorf("terms = %+v, want normalized \"work order\"", glossaryRepo.terms)
+	}
+
+	err := service.AddTerm(ctx, primary.AddGlossaryTermRequest{CommissionID: "COMM-404", Term: "grove", ReplacedBy: "workbench"})
+	if err == nil || !strings.Contains(err.Error(), "commission COMM-404 not found") {
+		t.Errorf("error = %v, want missing commission", err)
+	}
+}
+
+func TestGlossaryService_SeedFromTags(t *testing.T) {
+	service, glossaryRepo, tagRepo, _, _ := newTestGlossaryService()
+	ctx := context.Background()
+
+	tagRepo.tags["TAG-001"] = &secondary.TagRecord{ID: "TAG-001", Name: "database-schema", Description: "Changes to schema.sql"}
+	tagRepo.tags["TAG-002"] = &secondary.TagRecord{ID: "TAG-002", Name: "ui"}
+	tagRepo.tags["TAG-003"] = &secondary.TagRecord{ID: "TAG-003", Name: "auth", Description: "Login and sessions"}
+	glossaryRepo.terms = []*secondary.GlossaryTermRecord{{CommissionID: "COMM-001", Term: "Auth", Definition: "Our own definition"}}
+
+	added, err := service.SeedFromTags(ctx, "COMM-001")
+	if err != nil {
+		t.Fatalf("SeedFromTags failed: %v", err)
+	}
+	if len(added) != 1 || added[0].Term != "database-schema" {
+		t.Errorf("added = %+v, want only database-schema", added)
+	}
+	if glossaryRepo.terms[0].Definition != "Our own definition" {
+		t.Error("seeding should not overwrite an existing term")
+	}
+}
+
+func TestGlossaryService_Lint(t *testing.T) {
+	service, glossaryRepo, _, planRepo, noteRepo := newTestGlossaryService()
+	ctx := context.Background()
+
+	glossaryRepo.terms = []*secondary.GlossaryTermRecord{
+		{CommissionID: "COMM-001", Term: "grove", ReplacedBy: "workbench"},
+		{CommissionID: "COMM-001", Term: "mission", ReplacedBy: "commission"},
+		{CommissionID: "COMM-001", Term: "shipment", Definition: "A unit of deliverable work"},
+	}
+	planRepo.plans["PLAN-001"] = &secondary.PlanRecord{ID: "PLAN-001", CommissionID: "COMM-001", Title: "Rebuild", Content: "Spin up a new grove per shipment"}
+	noteRepo.notes["NOTE-001"] = &secondary.NoteRecord{ID: "NOTE-001", CommissionID: "COMM-001", Title: "Mission recap", Status: "open"}
+	noteRepo.notes["NOTE-002"] = &secondary.NoteRecord{ID: "NOTE-002", CommissionID: "COMM-001", Title: "Old grove notes", Status: "closed"}
+
+	findings, err := service.Lint(ctx, "COMM-001")
+	if err != nil {
+		t.Fatalf("Lint failed: %v", err)
+	}
+	var got []string
+	for _, f := range findings {
+		got = append(got, f.EntityID+":"+f.Term+"->"+f.ReplacedBy)
+	}
+	want := "PLAN-001:grove->workbench NOTE-001:mission->commission"
+	if strings.Join(got, " ") != want {
+		t.Errorf("findings = %v, want %s (closed notes skipped)", got, want)
+	}
+}
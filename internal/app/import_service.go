@@ -0,0 +1,263 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	coretracker "github.com/example/orc/internal/core/tracker"
+	"github.com/example/orc/internal/ports/primary"
+)
+
+// ImportServiceImpl implements the ImportService interface.
+type ImportServiceImpl struct {
+	commissionService primary.CommissionService
+	shipmentService   primary.ShipmentService
+	taskService       primary.TaskService
+	tagService        primary.TagService
+	aliasService      primary.AliasService
+}
+
+// NewImportService creates a new ImportService with injected dependencies.
+func NewImportService(
+	commissionService primary.CommissionService,
+	shipmentService primary.ShipmentService,
+	taskService primary.TaskService,
+	tagService primary.TagService,
+	aliasService primary.AliasService,
+) *ImportServiceImpl {
+	return &ImportServiceImpl{
+		commissionService: commissionService,
+		shipmentService:   shipmentService,
+		taskService:       taskService,
+		tagService:        tagService,
+		aliasService:      aliasService,
+	}
+}
+
+// ImportSources describes the trackers ImportIssues accepts exports from.
+func ImportSources() []primary.ImportSource {
+	sources := make([]primary.ImportSource, 0, len(coretracker.Sources))
+	for _, source := range coretracker.Sources {
+		sources = append(sources, primary.ImportSource{
+			Source:  source,
+			Name:    coretracker.SourceName(source),
+			Columns: coretracker.Columns(source),
+		})
+	}
+	return sources
+}
+
+// ImportIssues parses the export, plans the import, and unless DryRun is
+// set creates the shipments, tags, and tasks. Each entity gets its issue's
+// provenance alias (e.g. pay-123), which is how repeated imports skip it.
+func (s *ImportServiceImpl) ImportIssues(ctx context.Context, req primary.ImportIssuesRequest) (*primary.ImportReport, error) {
+	if _, err := s.commissionService.GetCommission(ctx, req.CommissionID); err != nil {
+		return nil, fmt.Errorf("commission not found: %w", err)
+	}
+
+	issues, err := coretracker.ParseCSV(bytes.NewReader(req.CSV), req.Source)
+	if err != nil {
+		return nil, err
+	}
+	mapping := coretracker.DefaultMapping()
+	if len(req.Mapping) > 0 {
+		override, err := coretracker.ParseMapping(string(req.Mapping))
+		if err != nil {
+			return nil, fmt.Errorf("invalid mapping: %w", err)
+		}
+		mapping = mapping.Merge(override)
+	}
+	plan := coretracker.BuildPlan(issues, req.Source, mapping)
+
+	report := &primary.ImportReport{
+		Source:       req.Source,
+		SourceName:   coretracker.SourceName(req.Source),
+		CommissionID: req.CommissionID,
+		DryRun:       req.DryRun,
+		Statuses:     toImportMapCounts(plan.Statuses),
+		Types:        toImportMapCounts(plan.Types),
+	}
+	for _, key := range plan.Skipped {
+		report.Skipped = append(report.Skipped, primary.ImportedItem{Key: key, Reason: "type mapped to skip"})
+	}
+	for _, c := range plan.Statuses {
+		if c.Unmapped {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("status %q is not mapped; %d task(s) will start open", c.From, c.Count))
+		}
+	}
+	for _, c := range plan.Types {
+		if c.Unmapped {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("type %q is not mapped; %d task(s) will have no type", c.From, c.Count))
+		}
+	}
+
+	// Shipments first, so tasks know where to go
+	shipmentIDs := make(map[string]string, len(plan.Shipments))
+	var closedShipments []string
+	for _, ps := range plan.Shipments {
+		item := primary.ImportedItem{Key: ps.Key, Kind: "shipment", Title: ps.Title, Status: "open"}
+		if ps.Closed {
+			item.Status = "closed"
+		}
+
+		if existing := s.findImported(ctx, ps.Alias, req.CommissionID, "SHIP-"); existing != "" {
+			shipmentIDs[ps.Key] = existing
+			item.EntityID = existing
+			item.Reason = fmt.Sprintf("already imported (alias %s)", ps.Alias)
+			report.Skipped = append(report.Skipped, item)
+			continue
+		}
+		if req.DryRun {
+			shipmentIDs[ps.Key] = orDefault(ps.Key, ps.Title)
+			report.Items = append(report.Items, item)
+			continue
+		}
+
+		resp, err := s.shipmentService.CreateShipment(ctx, primary.CreateShipmentRequest{
+			CommissionID: req.CommissionID,
+			Title:        ps.Title,
+			Description:  ps.Description,
+		})
+		if err != nil {
+			return report, fmt.Errorf("failed to create shipment for %s: %w", orDefault(ps.Key, ps.Title), err)
+		}
+		shipmentIDs[ps.Key] = resp.ShipmentID
+		item.EntityID = resp.ShipmentID
+		s.setProvenanceAlias(ctx, report, resp.ShipmentID, ps.Alias)
+		if ps.Closed {
+			closedShipments = append(closedShipments, resp.ShipmentID)
+		}
+		report.Items = append(report.Items, item)
+	}
+
+	// Tags the tasks need
+	for _, name := range plan.Tags {
+		if tag, err := s.tagService.GetTagByName(ctx, name); err == nil && tag != nil {
+			continue
+		}
+		report.NewTags = append(report.NewTags, name)
+		if req.DryRun {
+			continue
+		}
+		if _, err := s.tagService.CreateTag(ctx, primary.CreateTagRequest{
+			Name:        name,
+			Description: fmt.Sprintf("Imported from %s", coretracker.SourceName(req.Source)),
+		}); err != nil {
+			return report, fmt.Errorf("failed to create tag %s: %w", name, err)
+		}
+	}
+
+	for _, pt := range plan.Tasks {
+		item := primary.ImportedItem{
+			Key:        pt.Key,
+			Kind:       "task",
+			Title:      pt.Title,
+			ShipmentID: shipmentIDs[pt.ShipmentKey],
+			Status:     pt.Status,
+			Type:       pt.Type,
+			Tag:        pt.Tag,
+		}
+
+		if existing := s.findImported(ctx, pt.Alias, req.CommissionID, "TASK-"); existing != "" {
+			item.EntityID = existing
+			item.Reason = fmt.Sprintf("already imported (alias %s)", pt.Alias)
+			report.Skipped = append(report.Skipped, item)
+			continue
+		}
+		if req.DryRun {
+			report.Items = append(report.Items, item)
+			continue
+		}
+
+		resp, err := s.taskService.CreateTask(ctx, primary.CreateTaskRequest{
+			ShipmentID:   item.ShipmentID,
+			CommissionID: req.CommissionID,
+			Title:        pt.Title,
+			Description:  pt.Description,
+			Type:         pt.Type,
+			Tag:          pt.Tag,
+			Points:       pt.Points,
+		})
+		if err != nil {
+			return report, fmt.Errorf("failed to create task for %s: %w", pt.Key, err)
+		}
+		item.EntityID = resp.TaskID
+		s.setProvenanceAlias(ctx, report, resp.TaskID, pt.Alias)
+
+		switch pt.Status {
+		case "in-progress":
+			err = s.taskService.ResumeTask(ctx, resp.TaskID)
+		case "closed":
+			err = s.taskService.CompleteTask(ctx, resp.TaskID)
+		}
+		if err != nil {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("%s (%s) left open: %v", resp.TaskID, pt.Key, err))
+			item.Status = "open"
+		}
+		report.Items = append(report.Items, item)
+	}
+
+	// Close shipments whose epic was done, now that their tasks exist
+	for _, id := range closedShipments {
+		if err := s.shipmentService.CompleteShipment(ctx, id, false); err != nil {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("%s left open: %v", id, err))
+		}
+	}
+
+	return report, nil
+}
+
+// findImported returns the entity in the commission that holds alias, or ""
+// if there is none (or alias is empty).
+func (s *ImportServiceImpl) findImported(ctx context.Context, alias, commissionID, prefix string) string {
+	if alias == "" {
+		return ""
+	}
+	id, err := s.aliasService.ResolveAlias(ctx, alias, commissionID)
+	if err != nil || id == alias || !strings.HasPrefix(id, prefix) {
+		return ""
+	}
+
+	// ResolveAlias returns a lone match from any commission
+	switch prefix {
+	case "SHIP-":
+		if ship, err := s.shipmentService.GetShipment(ctx, id); err == nil && ship.CommissionID == commissionID {
+			return id
+		}
+	case "TASK-":
+		if task, err := s.taskService.GetTask(ctx, id); err == nil && task.CommissionID == commissionID {
+			return id
+		}
+	}
+	return ""
+}
+
+// setProvenanceAlias records where an entity came from, warning if the
+// alias cannot be set.
+func (s *ImportServiceImpl) setProvenanceAlias(ctx context.Context, report *primary.ImportReport, entityID, alias string) {
+	if alias == "" {
+		return
+	}
+	if err := s.aliasService.SetAlias(ctx, entityID, alias); err != nil {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("%s: could not set alias %s: %v", entityID, alias, err))
+	}
+}
+
+func toImportMapCounts(counts []coretracker.ValueCount) []primary.ImportMapCount {
+	result := make([]primary.ImportMapCount, len(counts))
+	for i, c := range counts {
+		result[i] = primary.ImportMapCount{From: c.From, To: c.To, Count: c.Count, Unmapped: c.Unmapped}
+	}
+	return result
+}
+
+func orDefault(s, fallback string) string {
+	if s == "" {
+		return fallback
+	}
+	return s
+}
+
+var _ primary.ImportService = (*ImportServiceImpl)(nil)
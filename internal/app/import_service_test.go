@@ -0,0 +1,250 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	coretracker "github.com/example/orc/internal/core/tracker"
+	"github.com/example/orc/internal/ports/primary"
+)
+
+// importFakes stands in for the services an import writes through.
+type importFakes struct {
+	shipments map[string]*primary.Shipment
+	tasks     map[string]*primary.Task
+	tags      map[string]bool
+	aliases   map[string]string // slug -> entity ID
+	completed []string
+}
+
+type importCommissions struct {
+	primary.CommissionService
+}
+
+func (importCommissions) GetCommission(ctx context.Context, id string) (*primary.Commission, error) {
+	if id != "COMM-001" {
+		return nil, errors.New("not found")
+	}
+	return &primary.Commission{ID: id}, nil
+}
+
+type importShipments struct {
+	primary.ShipmentService
+	f *importFakes
+}
+
+func (m importShipments) CreateShipment(ctx context.Context, req primary.CreateShipmentRequest) (*primary.CreateShipmentResponse, error) {
+	id := fmt.Sprintf("SHIP-%03d", len(m.f.shipments)+1)
+	m.f.shipments[id] = &primary.Shipment{ID: id, CommissionID: req.CommissionID, Title: req.Title, Description: req.Description}
+	return &primary.CreateShipmentResponse{ShipmentID: id, Shipment: m.f.shipments[id]}, nil
+}
+
+func (m importShipments) GetShipment(ctx context.Context, id string) (*primary.Shipment, error) {
+	if s, ok := m.f.shipments[id]; ok {
+		return s, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (m importShipments) CompleteShipment(ctx context.Context, id string, force bool) error {
+	m.f.completed = append(m.f.completed, id)
+	return nil
+}
+
+type importTasks struct {
+	primary.TaskService
+	f *importFakes
+}
+
+func (m importTasks) CreateTask(ctx context.Context, req primary.CreateTaskRequest) (*primary.CreateTaskResponse, error) {
+	if req.Tag != "" && !m.f.tags[req.Tag] {
+		return nil, fmt.Errorf("tag '%s' not found", req.Tag)
+	}
+	id := fmt.Sprintf("TASK-%03d", len(m.f.tasks)+1)
+	m.f.tasks[id] = &primary.Task{ID: id, CommissionID: req.CommissionID, ShipmentID: req.ShipmentID, Title: req.Title,
+		Description: req.Description, Type: req.Type, Status: "open"}
+	return &primary.CreateTaskResponse{TaskID: id, Task: m.f.tasks[id]}, nil
+}
+
+func (m importTasks) GetTask(ctx context.Context, id string) (*primary.Task, error) {
+	if t, ok := m.f.tasks[id]; ok {
+		return t, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (m importTasks) ResumeTask(ctx context.Context, id string) error {
+	m.f.tasks[id].Status = "in-progress"
+	return nil
+}
+
+func (m importTasks) CompleteTask(ctx context.Context, id string) error {
+	m.f.tasks[id].Status = "closed"
+	return nil
+}
+
+type importTags struct {
+	primary.TagService
+	f *importFakes
+}
+
+func (m importTags) GetTagByName(ctx context.Context, name string) (*primary.Tag, error) {
+	if m.f.tags[name] {
+		return &primary.Tag{Name: name}, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (m importTags) CreateTag(ctx context.Context, req primary.CreateTagRequest) (*primary.CreateTagResponse, error) {
+	m.f.tags[req.Name] = true
+	return &primary.CreateTagResponse{Tag: &primary.Tag{Name: req.Name}}, nil
+}
+
+type importAliases struct {
+	primary.AliasService
+	f *importFakes
+}
+
+func (m importAliases) SetAlias(ctx context.Context, entityID, slug string) error {
+	m.f.aliases[slug] = entityID
+	return nil
+}
+
+func (m importAliases) ResolveAlias(ctx context.Context, ref, commissionID string) (string, error) {
+	if id, ok := m.f.aliases[ref]; ok {
+		return id, nil
+	}
+	return ref, nil
+}
+
+func newImportTestService() (*ImportServiceImpl, *importFakes) {
+	f := &importFakes{
+		shipments: map[string]*primary.Shipment{},
+		tasks:     map[string]*primary.Task{},
+		tags:      map[string]bool{"backend": true},
+		aliases:   map[string]string{},
+	}
+	return NewImportService(importCommissions{}, importShipments{f: f}, importTasks{f: f}, importTags{f: f}, importAliases{f: f}), f
+}
+
+const jiraExport = `Issue key,Issue id,Summary,Issue Type,Status,Labels,Parent id,Description
+PAY-1,1,Checkout v2,Epic,Done,,,
+PAY-2,2,Card form,Story,In Progress,Frontend,1,Build the form
+PAY-3,3,Rounding,Bug,Ready for QA,backend,,
+`
+
+func TestImportService_ImportIssues(t *testing.T) {
+	service, f := newImportTestService()
+	ctx := context.Background()
+
+	report, err := service.ImportIssues(ctx, primary.ImportIssuesRequest{
+		Source:       coretracker.SourceJira,
+		CommissionID: "COMM-001",
+		CSV:          []byte(jiraExport),
+	})
+	if err != nil {
+		t.Fatalf("ImportIssues failed: %v", err)
+	}
+
+	if len(f.shipments) != 2 || len(f.tasks) != 2 {
+		t.Fatalf("created %d shipments and %d tasks, want 2 (epic + fallback) and 2", len(f.shipments), len(f.tasks))
+	}
+	if f.aliases["pay-1"] != "SHIP-001" || f.aliases["jira-import"] != "SHIP-002" {
+		t.Errorf("shipment aliases = %v", f.aliases)
+	}
+
+	story := f.tasks[f.aliases["pay-2"]]
+	if story == nil || story.ShipmentID != "SHIP-001" || story.Status != "in-progress" || story.Type != "implementation" {
+		t.Errorf("PAY-2 task = %+v", story)
+	}
+	if !strings.Contains(story.Description, "Build the form") || !strings.Contains(story.Description, "Imported from Jira PAY-2.") {
+		t.Errorf("PAY-2 description = %q, want original text and provenance", story.Description)
+	}
+	bug := f.tasks[f.aliases["pay-3"]]
+	if bug == nil || bug.ShipmentID != "SHIP-002" || bug.Status != "open" || bug.Type != "fix" {
+		t.Errorf("PAY-3 task = %+v", bug)
+	}
+
+	if len(report.NewTags) != 1 || report.NewTags[0] != "frontend" || !f.tags["frontend"] {
+		t.Errorf("NewTags = %v, want [frontend] created", report.NewTags)
+	}
+	if len(f.completed) != 1 || f.completed[0] != "SHIP-001" {
+		t.Errorf("completed shipments = %v, want [SHIP-001] (epic was done)", f.completed)
+	}
+	if len(report.Warnings) != 1 || !strings.Contains(report.Warnings[0], `"Ready for QA"`) {
+		t.Errorf("Warnings = %v, want one for the unmapped status", report.Warnings)
+	}
+
+	// A second import finds everything by alias
+	again, err := service.ImportIssues(ctx, primary.ImportIssuesRequest{
+		Source:       coretracker.SourceJira,
+		CommissionID: "COMM-001",
+		CSV:          []byte(jiraExport),
+	})
+	if err != nil {
+		t.Fatalf("second ImportIssues failed: %v", err)
+	}
+	if len(again.Items) != 0 || len(again.Skipped) != 4 {
+		t.Errorf("second import: %d items, %d skipped, want 0 and 4", len(again.Items), len(again.Skipped))
+	}
+	if len(f.shipments) != 2 || len(f.tasks) != 2 {
+		t.Errorf("second import created entities: %d shipments, %d tasks", len(f.shipments), len(f.tasks))
+	}
+}
+
+func TestImportService_DryRun(t *testing.T) {
+	service, f := newImportTestService()
+
+	report, err := service.ImportIssues(context.Background(), primary.ImportIssuesRequest{
+		Source:       coretracker.SourceJira,
+		CommissionID: "COMM-001",
+		CSV:          []byte(jiraExport),
+		Mapping:      []byte("statuses:\n  Ready for QA: in-progress\n"),
+		DryRun:       true,
+	})
+	if err != nil {
+		t.Fatalf("ImportIssues failed: %v", err)
+	}
+
+	if len(f.shipments) != 0 || len(f.tasks) != 0 || len(f.aliases) != 0 || f.tags["frontend"] {
+		t.Error("dry run wrote entities")
+	}
+	if len(report.Items) != 4 {
+		t.Errorf("got %d planned items, want 4", len(report.Items))
+	}
+	if len(report.Warnings) != 0 {
+		t.Errorf("Warnings = %v, want none (status mapped by the file)", report.Warnings)
+	}
+	for _, item := range report.Items {
+		if item.Key == "PAY-3" && (item.Status != "in-progress" || item.ShipmentID != "Imported from Jira") {
+			t.Errorf("PAY-3 = %+v", item)
+		}
+	}
+}
+
+func TestImportService_Errors(t *testing.T) {
+	service, _ := newImportTestService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name    string
+		req     primary.ImportIssuesRequest
+		wantErr string
+	}{
+		{"unknown commission", primary.ImportIssuesRequest{Source: "jira", CommissionID: "COMM-999", CSV: []byte(jiraExport)}, "commission not found"},
+		{"bad mapping", primary.ImportIssuesRequest{Source: "jira", CommissionID: "COMM-001", CSV: []byte(jiraExport), Mapping: []byte("types:\n  Bug: defect\n")}, "invalid mapping"},
+		{"wrong export", primary.ImportIssuesRequest{Source: "linear", CommissionID: "COMM-001", CSV: []byte(jiraExport)}, "Linear export"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.ImportIssues(ctx, tt.req)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ImportIssues() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	coreledger "github.com/example/orc/internal/core/ledger"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// LedgerServiceImpl implements the LedgerService interface.
+type LedgerServiceImpl struct {
+	ledgerRepo secondary.LedgerRepository
+	reader     secondary.ForeignLedgerReader
+	localPath  string // This ledger's path, which can't be registered as another
+}
+
+// IsEntityID reports whether s is shaped like an entity ID (SHIP-004).
+func IsEntityID(s string) bool {
+	return coreledger.IsEntityID(s)
+}
+
+// IsLedgerRef reports whether s is shaped like a reference to another
+// ledger's entity ("acme:SHIP-004").
+func IsLedgerRef(s string) bool {
+	return coreledger.IsRef(s)
+}
+
+// NewLedgerService creates a new LedgerService with injected dependencies.
+func NewLedgerService(ledgerRepo secondary.LedgerRepository, reader secondary.ForeignLedgerReader, localPath string) *LedgerServiceImpl {
+	return &LedgerServiceImpl{
+		ledgerRepo: ledgerRepo,
+		reader:     reader,
+		localPath:  localPath,
+	}
+}
+
+// AddLedger registers the ledger at path under an alias. The ledger must be
+// readable now; it may become unreachable later, which only stops its
+// references from resolving.
+func (s *LedgerServiceImpl) AddLedger(ctx context.Context, alias, path string) (*primary.Ledger, error) {
+	if err := coreledger.ValidateAlias(alias); err != nil {
+		return nil, err
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path %s: %w", path, err)
+	}
+	if s.localPath != "" && sameFile(abs, s.localPath) {
+		return nil, fmt.Errorf("%s is this ledger; refer to its entities by ID", path)
+	}
+	version, err := s.reader.Check(ctx, abs)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.ledgerRepo.Add(ctx, &secondary.LedgerRecord{Alias: alias, Path: abs}); err != nil {
+		return nil, err
+	}
+	record, err := s.ledgerRepo.Get(ctx, alias)
+	if err != nil {
+		return nil, err
+	}
+	ledger := recordToLedger(record)
+	ledger.SchemaVersion = version
+	return ledger, nil
+}
+
+// ListLedgers lists the registered ledgers, checking each is readable.
+func (s *LedgerServiceImpl) ListLedgers(ctx context.Context) ([]*primary.Ledger, error) {
+	records, err := s.ledgerRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ledgers := make([]*primary.Ledger, len(records))
+	for i, r := range records {
+		ledgers[i] = recordToLedger(r)
+		if version, err := s.reader.Check(ctx, r.Path); err != nil {
+			ledgers[i].Problem = err.Error()
+		} else {
+			ledgers[i].SchemaVersion = version
+		}
+	}
+	return ledgers, nil
+}
+
+// RemoveLedger unregisters a ledger and removes the links made to it.
+func (s *LedgerServiceImpl) RemoveLedger(ctx context.Context, alias string) error {
+	return s.ledgerRepo.Delete(ctx, alias)
+}
+
+// Resolve reads the entity a reference points to.
+func (s *LedgerServiceImpl) Resolve(ctx context.Context, ref string) (*primary.ForeignEntity, error) {
+	parsed, err := coreledger.ParseRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	entityType, ok := coreledger.EntityType(parsed.EntityID)
+	if !ok {
+		return nil, fmt.Errorf("cannot refer to %s: only commissions, shipments, tasks, tomes, notes, and plans resolve across ledgers", parsed.EntityID)
+	}
+	ledger, err := s.ledgerRepo.Get(ctx, parsed.Alias)
+	if err != nil {
+		return nil, fmt.Errorf("unknown ledger %s; register it with 'orc ledger add %s <path>'", parsed.Alias, parsed.Alias)
+	}
+	record, err := s.reader.LookupEntity(ctx, ledger.Path, parsed.EntityID)
+	if err != nil {
+		return nil, fmt.Errorf("ledger %s: %w", parsed.Alias, err)
+	}
+	return &primary.ForeignEntity{
+		Ref:          parsed.String(),
+		Ledger:       parsed.Alias,
+		ID:           record.ID,
+		Type:         entityType,
+		Title:        record.Title,
+		Status:       record.Status,
+		CommissionID: record.CommissionID,
+	}, nil
+}
+
+// LinkRef links a local entity to a reference, which must resolve.
+func (s *LedgerServiceImpl) LinkRef(ctx context.Context, entityID, ref string) (*primary.ForeignEntity, error) {
+	exists, err := s.ledgerRepo.EntityExists(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("%s not found", entityID)
+	}
+	entity, err := s.Resolve(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ledgerRepo.AddRef(ctx, entityID, entity.Ref); err != nil {
+		return nil, err
+	}
+	return entity, nil
+}
+
+// UnlinkRef removes a link. The reference need not resolve, so links to
+// unreachable ledgers can still be cleaned up.
+func (s *LedgerServiceImpl) UnlinkRef(ctx context.Context, entityID, ref string) error {
+	return s.ledgerRepo.RemoveRef(ctx, entityID, ref)
+}
+
+// ListRefs lists the references linked from an entity, resolved where the
+// other ledger can be read.
+func (s *LedgerServiceImpl) ListRefs(ctx context.Context, entityID string) ([]*primary.LedgerRef, error) {
+	refs, err := s.ledgerRepo.ListRefs(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+	linked := make([]*primary.LedgerRef, len(refs))
+	for i, ref := range refs {
+		linked[i] = &primary.LedgerRef{Ref: ref}
+		if entity, err := s.Resolve(ctx, ref); err != nil {
+			linked[i].Problem = err.Error()
+		} else {
+			linked[i].Entity = entity
+		}
+	}
+	return linked, nil
+}
+
+func recordToLedger(r *secondary.LedgerRecord) *primary.Ledger {
+	return &primary.Ledger{
+		Alias:     r.Alias,
+		Path:      r.Path,
+		CreatedAt: r.CreatedAt,
+	}
+}
+
+// sameFile reports whether two paths name the same file, comparing cleaned
+// absolute paths when either can't be resolved.
+func sameFile(a, b string) bool {
+	ra, errA := filepath.EvalSymlinks(a)
+	rb, errB := filepath.EvalSymlinks(b)
+	if errA != nil || errB != nil {
+		return filepath.Clean(a) == filepath.Clean(b)
+	}
+	return ra == rb
+}
+
+// Ensure LedgerServiceImpl implements the interface
+var _ primary.LedgerService = (*LedgerServiceImpl)(nil)
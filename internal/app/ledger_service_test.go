@@ -0,0 +1,235 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// mockLedgerRepository keeps ledgers and links in memory.
+type mockLedgerRepository struct {
+	ledgers  map[string]*secondary.LedgerRecord
+	refs     map[string][]string // entity ID -> refs, in link order
+	entities map[string]bool     // local entity IDs that exist
+}
+
+func newMockLedgerRepository() *mockLedgerRepository {
+	return &mockLedgerRepository{
+		ledgers:  make(map[string]*secondary.LedgerRecord),
+		refs:     make(map[string][]string),
+		entities: make(map[string]bool),
+	}
+}
+
+func (m *mockLedgerRepository) Add(ctx context.Context, ledger *secondary.LedgerRecord) error {
+	if _, ok := m.ledgers[ledger.Alias]; ok {
+		return fmt.Errorf("ledger %s is already registered", ledger.Alias)
+	}
+	m.ledgers[ledger.Alias] = ledger
+	return nil
+}
+
+func (m *mockLedgerRepository) Get(ctx context.Context, alias string) (*secondary.LedgerRecord, error) {
+	if ledger, ok := m.ledgers[alias]; ok {
+		return ledger, nil
+	}
+	return nil, fmt.Errorf("ledger %s not found", alias)
+}
+
+func (m *mockLedgerRepository) List(ctx context.Context) ([]*secondary.LedgerRecord, error) {
+	var ledgers []*secondary.LedgerRecord
+	for _, l := range m.ledgers {
+		ledgers = append(ledgers, l)
+	}
+	sort.Slice(ledgers, func(i, j int) bool { return ledgers[i].Alias < ledgers[j].Alias })
+	return ledgers, nil
+}
+
+func (m *mockLedgerRepository) Delete(ctx context.Context, alias string) error {
+	if _, ok := m.ledgers[alias]; !ok {
+		return fmt.Errorf("ledger %s not found", alias)
+	}
+	delete(m.ledgers, alias)
+	for id, refs := range m.refs {
+		var kept []string
+		for _, ref := range refs {
+			if !strings.HasPrefix(ref, alias+":") {
+				kept = append(kept, ref)
+			}
+		}
+		m.refs[id] = kept
+	}
+	return nil
+}
+
+func (m *mockLedgerRepository) EntityExists(ctx context.Context, entityID string) (bool, error) {
+	return m.entities[entityID], nil
+}
+
+func (m *mockLedgerRepository) AddRef(ctx context.Context, entityID, ref string) error {
+	for _, r := range m.refs[entityID] {
+		if r == ref {
+			return nil
+		}
+	}
+	m.refs[entityID] = append(m.refs[entityID], ref)
+	return nil
+}
+
+func (m *mockLedgerRepository) RemoveRef(ctx context.Context, entityID, ref string) error {
+	for i, r := range m.refs[entityID] {
+		if r == ref {
+			m.refs[entityID] = append(m.refs[entityID][:i], m.refs[entityID][i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("%s is not linked to %s", entityID, ref)
+}
+
+func (m *mockLedgerRepository) ListRefs(ctx context.Context, entityID string) ([]string, error) {
+	return m.refs[entityID], nil
+}
+
+// mockForeignLedgerReader serves entities from in-memory ledgers keyed by path.
+type mockForeignLedgerReader struct {
+	ledgers map[string]map[string]*secondary.ForeignEntityRecord
+}
+
+func (m *mockForeignLedgerReader) LookupEntity(ctx context.Context, path, entityID string) (*secondary.ForeignEntityRecord, error) {
+	ledger, ok := m.ledgers[path]
+	if !ok {
+		return nil, fmt.Errorf("ledger not readable at %s", path)
+	}
+	if entity, ok := ledger[entityID]; ok {
+		return entity, nil
+	}
+	return nil, fmt.Errorf("%s not found", entityID)
+}
+
+func (m *mockForeignLedgerReader) Check(ctx context.Context, path string) (int, error) {
+	if _, ok := m.ledgers[path]; !ok {
+		return 0, fmt.Errorf("ledger not readable at %s", path)
+	}
+	return 23, nil
+}
+
+func newTestLedgerService() (*LedgerServiceImpl, *mockLedgerRepository, *mockForeignLedgerReader) {
+	repo := newMockLedgerRepository()
+	reader := &mockForeignLedgerReader{ledgers: map[string]map[string]*secondary.ForeignEntityRecord{
+		"/ledgers/acme.db": {
+			"SHIP-004": {ID: "SHIP-004", Title: "Payments API", Status: "in-progress", CommissionID: "COMM-001"},
+		},
+	}}
+	return NewLedgerService(repo, reader, "/ledgers/orc.db"), repo, reader
+}
+
+func TestLedgerService_AddLedger(t *testing.T) {
+	service, repo, _ := newTestLedgerService()
+	ctx := context.Background()
+
+	ledger, err := service.AddLedger(ctx, "acme", "/ledgers/acme.db")
+	if err != nil {
+		t.Fatalf("AddLedger() error = %v", err)
+	}
+	if ledger.Path != "/ledgers/acme.db" || ledger.SchemaVersion != 23 {
+		t.Errorf("unexpected ledger: %+v", ledger)
+	}
+
+	if _, err := service.AddLedger(ctx, "Acme", "/ledgers/acme.db"); err == nil {
+		t.Error("expected error for an invalid alias")
+	}
+	if _, err := service.AddLedger(ctx, "gone", "/ledgers/gone.db"); err == nil {
+		t.Error("expected error for an unreadable ledger")
+	}
+	if _, err := service.AddLedger(ctx, "self", "/ledgers/orc.db"); err == nil || !strings.Contains(err.Error(), "this ledger") {
+		t.Errorf("expected error registering this ledger, got %v", err)
+	}
+	if len(repo.ledgers) != 1 {
+		t.Errorf("expected only acme registered, got %d ledgers", len(repo.ledgers))
+	}
+}
+
+func TestLedgerService_ListLedgers(t *testing.T) {
+	service, repo, _ := newTestLedgerService()
+	ctx := context.Background()
+	repo.ledgers["acme"] = &secondary.LedgerRecord{Alias: "acme", Path: "/ledgers/acme.db"}
+	repo.ledgers["moved"] = &secondary.LedgerRecord{Alias: "moved", Path: "/ledgers/moved.db"}
+
+	ledgers, err := service.ListLedgers(ctx)
+	if err != nil {
+		t.Fatalf("ListLedgers() error = %v", err)
+	}
+	if len(ledgers) != 2 || ledgers[0].Problem != "" || ledgers[0].SchemaVersion != 23 {
+		t.Fatalf("unexpected ledgers: %+v", ledgers)
+	}
+	if ledgers[1].Problem == "" {
+		t.Error("expected a problem for the unreadable ledger")
+	}
+}
+
+func TestLedgerService_Resolve(t *testing.T) {
+	service, repo, _ := newTestLedgerService()
+	ctx := context.Background()
+	repo.ledgers["acme"] = &secondary.LedgerRecord{Alias: "acme", Path: "/ledgers/acme.db"}
+
+	entity, err := service.Resolve(ctx, "acme:SHIP-004")
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if entity.Ref != "acme:SHIP-004" || entity.Ledger != "acme" || entity.Type != "shipment" || entity.Title != "Payments API" {
+		t.Errorf("unexpected entity: %+v", entity)
+	}
+
+	tests := []struct {
+		ref     string
+		wantErr string
+	}{
+		{"SHIP-004", "invalid reference"},
+		{"globex:SHIP-004", "unknown ledger"},
+		{"acme:BENCH-001", "cannot refer"},
+		{"acme:SHIP-999", "not found"},
+	}
+	for _, tt := range tests {
+		if _, err := service.Resolve(ctx, tt.ref); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+			t.Errorf("Resolve(%s) error = %v, want %q", tt.ref, err, tt.wantErr)
+		}
+	}
+}
+
+func TestLedgerService_Links(t *testing.T) {
+	service, repo, _ := newTestLedgerService()
+	ctx := context.Background()
+	repo.ledgers["acme"] = &secondary.LedgerRecord{Alias: "acme", Path: "/ledgers/acme.db"}
+	repo.entities["SHIP-012"] = true
+
+	if _, err := service.LinkRef(ctx, "SHIP-012", "acme:SHIP-004"); err != nil {
+		t.Fatalf("LinkRef() error = %v", err)
+	}
+	if _, err := service.LinkRef(ctx, "SHIP-013", "acme:SHIP-004"); err == nil {
+		t.Error("expected error linking from a missing entity")
+	}
+	if _, err := service.LinkRef(ctx, "SHIP-012", "acme:SHIP-999"); err == nil {
+		t.Error("expected error linking to an unresolvable reference")
+	}
+
+	// A link whose ledger moved away stays listed, with the problem
+	repo.refs["SHIP-012"] = append(repo.refs["SHIP-012"], "moved:TASK-001")
+	refs, err := service.ListRefs(ctx, "SHIP-012")
+	if err != nil {
+		t.Fatalf("ListRefs() error = %v", err)
+	}
+	if len(refs) != 2 || refs[0].Entity == nil || refs[0].Entity.Title != "Payments API" {
+		t.Fatalf("unexpected refs: %+v", refs)
+	}
+	if refs[1].Entity != nil || refs[1].Problem == "" {
+		t.Errorf("expected an unresolved ref with a problem, got %+v", refs[1])
+	}
+
+	if err := service.UnlinkRef(ctx, "SHIP-012", "moved:TASK-001"); err != nil {
+		t.Errorf("UnlinkRef() error = %v", err)
+	}
+}
@@ -0,0 +1,186 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	corelock "github.com/example/orc/internal/core/lock"
+	"github.com/example/orc/internal/ctxutil"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// LockServiceImpl implements the LockService interface.
+type LockServiceImpl struct {
+	lockRepo     secondary.EntityLockRepository
+	shipmentRepo secondary.ShipmentRepository
+	planRepo     secondary.PlanRepository
+	now          func() time.Time
+}
+
+// NewLockService creates a new LockService with injected dependencies.
+func NewLockService(
+	lockRepo secondary.EntityLockRepository,
+	shipmentRepo secondary.ShipmentRepository,
+	planRepo secondary.PlanRepository,
+) *LockServiceImpl {
+	return &LockServiceImpl{
+		lockRepo:     lockRepo,
+		shipmentRepo: shipmentRepo,
+		planRepo:     planRepo,
+		now:          time.Now,
+	}
+}
+
+// AcquireLock locks an entity for the current actor, or renews the actor's lock.
+// Renewing keeps the original acquire time so "locked since" stays honest.
+func (s *LockServiceImpl) AcquireLock(ctx context.Context, req primary.AcquireLockRequest) (*primary.EntityLock, error) {
+	ttl := req.TTL
+	if ttl == 0 {
+		ttl = corelock.DefaultTTL
+	}
+
+	now := s.now()
+	current, err := s.lockState(ctx, req.EntityID)
+	if err != nil {
+		return nil, err
+	}
+	actorID := ctxutil.ActorFromContext(ctx)
+	entityType, _ := corelock.EntityType(req.EntityID)
+	guardCtx := corelock.AcquireContext{
+		EntityID:     req.EntityID,
+		EntityExists: s.entityExists(ctx, entityType, req.EntityID),
+		ActorID:      actorID,
+		TTL:          ttl,
+		Lock:         current,
+		Now:          now,
+	}
+	if err := corelock.CanAcquire(guardCtx).Error(); err != nil {
+		return nil, err
+	}
+
+	acquiredAt := now
+	if current.Active(now) {
+		acquiredAt = current.AcquiredAt
+	}
+	record := &secondary.EntityLockRecord{
+		EntityID:   req.EntityID,
+		HeldBy:     actorID,
+		Reason:     req.Reason,
+		AcquiredAt: acquiredAt.UTC().Format(time.RFC3339),
+		ExpiresAt:  now.Add(ttl).UTC().Format(time.RFC3339),
+	}
+	if err := s.lockRepo.Save(ctx, record); err != nil {
+		return nil, err
+	}
+	return recordToEntityLock(record), nil
+}
+
+// ReleaseLock releases an entity's lock. Force breaks another actor's lock.
+func (s *LockServiceImpl) ReleaseLock(ctx context.Context, entityID string, force bool) error {
+	current, err := s.lockState(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	guardCtx := corelock.ReleaseContext{
+		EntityID: entityID,
+		ActorID:  ctxutil.ActorFromContext(ctx),
+		Lock:     current,
+		Now:      s.now(),
+		Force:    force,
+	}
+	if err := corelock.CanRelease(guardCtx).Error(); err != nil {
+		return err
+	}
+	return s.lockRepo.Delete(ctx, entityID)
+}
+
+// GetLock retrieves an entity's active lock (nil if unlocked or expired).
+func (s *LockServiceImpl) GetLock(ctx context.Context, entityID string) (*primary.EntityLock, error) {
+	record, err := s.lockRepo.Get(ctx, entityID)
+	if err != nil || record == nil {
+		return nil, err
+	}
+	if !recordToLockState(record).Active(s.now()) {
+		return nil, nil
+	}
+	return recordToEntityLock(record), nil
+}
+
+// ListLocks retrieves all active locks, clearing out expired ones.
+func (s *LockServiceImpl) ListLocks(ctx context.Context) ([]*primary.EntityLock, error) {
+	if _, err := s.lockRepo.DeleteExpired(ctx, s.now().UTC().Format(time.RFC3339)); err != nil {
+		return nil, err
+	}
+	records, err := s.lockRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	locks := make([]*primary.EntityLock, len(records))
+	for i, r := range records {
+		locks[i] = recordToEntityLock(r)
+	}
+	return locks, nil
+}
+
+// CheckUnlocked returns an error if another actor holds an active lock on the entity.
+func (s *LockServiceImpl) CheckUnlocked(ctx context.Context, entityID string) error {
+	current, err := s.lockState(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	return corelock.CanModify(corelock.ModifyContext{
+		EntityID: entityID,
+		ActorID:  ctxutil.ActorFromContext(ctx),
+		Lock:     current,
+		Now:      s.now(),
+	}).Error()
+}
+
+// lockState loads an entity's stored lock as a guard State (zero if none).
+func (s *LockServiceImpl) lockState(ctx context.Context, entityID string) (corelock.State, error) {
+	record, err := s.lockRepo.Get(ctx, entityID)
+	if err != nil || record == nil {
+		return corelock.State{}, err
+	}
+	return recordToLockState(record), nil
+}
+
+func (s *LockServiceImpl) entityExists(ctx context.Context, entityType, entityID string) bool {
+	var err error
+	switch entityType {
+	case "shipment":
+		_, err = s.shipmentRepo.GetByID(ctx, entityID)
+	case "plan":
+		_, err = s.planRepo.GetByID(ctx, entityID)
+	default:
+		return false
+	}
+	return err == nil
+}
+
+// recordToLockState converts a stored lock to local times, so lock messages
+// show the viewer's wall clock.
+func recordToLockState(r *secondary.EntityLockRecord) corelock.State {
+	acquiredAt, _ := time.Parse(time.RFC3339, r.AcquiredAt)
+	expiresAt, _ := time.Parse(time.RFC3339, r.ExpiresAt)
+	return corelock.State{
+		HeldBy:     r.HeldBy,
+		AcquiredAt: acquiredAt.Local(),
+		ExpiresAt:  expiresAt.Local(),
+	}
+}
+
+func recordToEntityLock(r *secondary.EntityLockRecord) *primary.EntityLock {
+	return &primary.EntityLock{
+		EntityID:   r.EntityID,
+		HeldBy:     r.HeldBy,
+		Reason:     r.Reason,
+		AcquiredAt: r.AcquiredAt,
+		ExpiresAt:  r.ExpiresAt,
+	}
+}
+
+// Ensure LockServiceImpl implements the interface
+var _ primary.LockService = (*LockServiceImpl)(nil)
@@ -0,0 +1,189 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/orc/internal/ctxutil"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ============================================================================
+// Mock Implementations
+// ============================================================================
+
+type mockEntityLockRepository struct {
+	locks map[string]*secondary.EntityLockRecord
+}
+
+func newMockEntityLockRepository() *mockEntityLockRepository {
+	return &mockEntityLockRepository{locks: make(map[string]*secondary.EntityLockRecord)}
+}
+
+func (m *mockEntityLockRepository) Save(_ context.Context, lock *secondary.EntityLockRecord) error {
+	copied := *lock
+	m.locks[lock.EntityID] = &copied
+	return nil
+}
+
+func (m *mockEntityLockRepository) Get(_ context.Context, entityID string) (*secondary.EntityLockRecord, error) {
+	return m.locks[entityID], nil
+}
+
+func (m *mockEntityLockRepository) Delete(_ context.Context, entityID string) error {
+	if _, ok := m.locks[entityID]; !ok {
+		return fmt.Errorf("%s is not locked", entityID)
+	}
+	delete(m.locks, entityID)
+	return nil
+}
+
+func (m *mockEntityLockRepository) List(_ context.Context) ([]*secondary.EntityLockRecord, error) {
+	var result []*secondary.EntityLockRecord
+	for _, l := range m.locks {
+		result = append(result, l)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].EntityID < result[j].EntityID })
+	return result, nil
+}
+
+func (m *mockEntityLockRepository) DeleteExpired(_ context.Context, before string) (int, error) {
+	removed := 0
+	for id, l := range m.locks {
+		if l.ExpiresAt <= before {
+			delete(m.locks, id)
+			removed++
+		}
+	}
+	return removed, nil
+}
+
+// ============================================================================
+// Test Helper
+// ============================================================================
+
+var lockTestNow = time.Date(2026, 10, 16, 14, 2, 0, 0, time.UTC)
+
+// newTestLockService returns a lock service over SHIP-001 and PLAN-001.
+func newTestLockService() (*LockServiceImpl, *mockEntityLockRepository) {
+	shipmentRepo := newMockShipmentRepository()
+	shipmentRepo.shipments["SHIP-001"] = &secondary.ShipmentRecord{ID: "SHIP-001", CommissionID: "COMM-001"}
+	planRepo := newMockPlanRepository()
+	planRepo.plans["PLAN-001"] = &secondary.PlanRecord{ID: "PLAN-001", CommissionID: "COMM-001"}
+
+	lockRepo := newMockEntityLockRepository()
+	service := NewLockService(lockRepo, shipmentRepo, planRepo)
+	service.now = func() time.Time { return lockTestNow }
+	return service, lockRepo
+}
+
+func actorCtx(actorID string) context.Context {
+	return ctxutil.WithActorID(context.Background(), actorID)
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestLockService_AcquireAndCheck(t *testing.T) {
+	service, _ := newTestLockService()
+	goblin, imp := actorCtx("GOBLIN"), actorCtx("IMP-BENCH-001")
+
+	lock, err := service.AcquireLock(goblin, primary.AcquireLockRequest{EntityID: "SHIP-001", Reason: "restructuring"})
+	if err != nil {
+		t.Fatalf("AcquireLock failed: %v", err)
+	}
+	if lock.HeldBy != "GOBLIN" || lock.AcquiredAt != "2026-10-16T14:02:00Z" || lock.ExpiresAt != "2026-10-16T14:32:00Z" {
+		t.Errorf("unexpected lock: %+v", lock)
+	}
+
+	if err := service.CheckUnlocked(goblin, "SHIP-001"); err != nil {
+		t.Errorf("holder should be able to edit, got %v", err)
+	}
+	err = service.CheckUnlocked(imp, "SHIP-001")
+	if err == nil || !strings.Contains(err.Error(), "locked by GOBLIN since") {
+		t.Errorf("expected locked error, got %v", err)
+	}
+	if err := service.CheckUnlocked(imp, "SHIP-002"); err != nil {
+		t.Errorf("unlocked entity should be editable, got %v", err)
+	}
+
+	if _, err := service.AcquireLock(imp, primary.AcquireLockRequest{EntityID: "SHIP-001"}); err == nil {
+		t.Error("expected another actor's acquire to fail")
+	}
+}
+
+func TestLockService_RenewKeepsAcquireTime(t *testing.T) {
+	service, _ := newTestLockService()
+	ctx := actorCtx("GOBLIN")
+
+	if _, err := service.AcquireLock(ctx, primary.AcquireLockRequest{EntityID: "PLAN-001"}); err != nil {
+		t.Fatalf("AcquireLock failed: %v", err)
+	}
+	service.now = func() time.Time { return lockTestNow.Add(20 * time.Minute) }
+	lock, err := service.AcquireLock(ctx, primary.AcquireLockRequest{EntityID: "PLAN-001", TTL: time.Hour})
+	if err != nil {
+		t.Fatalf("renew failed: %v", err)
+	}
+	if lock.AcquiredAt != "2026-10-16T14:02:00Z" || lock.ExpiresAt != "2026-10-16T15:22:00Z" {
+		t.Errorf("unexpected renewed lock: %+v", lock)
+	}
+}
+
+func TestLockService_Expiry(t *testing.T) {
+	service, lockRepo := newTestLockService()
+
+	if _, err := service.AcquireLock(actorCtx("GOBLIN"), primary.AcquireLockRequest{EntityID: "SHIP-001", TTL: 10 * time.Minute}); err != nil {
+		t.Fatalf("AcquireLock failed: %v", err)
+	}
+	service.now = func() time.Time { return lockTestNow.Add(11 * time.Minute) }
+
+	imp := actorCtx("IMP-BENCH-001")
+	if err := service.CheckUnlocked(imp, "SHIP-001"); err != nil {
+		t.Errorf("expired lock should not block, got %v", err)
+	}
+	lock, err := service.GetLock(imp, "SHIP-001")
+	if err != nil || lock != nil {
+		t.Errorf("GetLock = %+v, %v, want nil", lock, err)
+	}
+
+	locks, err := service.ListLocks(imp)
+	if err != nil {
+		t.Fatalf("ListLocks failed: %v", err)
+	}
+	if len(locks) != 0 || len(lockRepo.locks) != 0 {
+		t.Errorf("expected expired lock to be cleared, got %+v", locks)
+	}
+}
+
+func TestLockService_Release(t *testing.T) {
+	service, _ := newTestLockService()
+	goblin, imp := actorCtx("GOBLIN"), actorCtx("IMP-BENCH-001")
+
+	if _, err := service.AcquireLock(goblin, primary.AcquireLockRequest{EntityID: "SHIP-001"}); err != nil {
+		t.Fatalf("AcquireLock failed: %v", err)
+	}
+	if err := service.ReleaseLock(imp, "SHIP-001", false); err == nil {
+		t.Error("expected release by non-holder to fail")
+	}
+	if err := service.ReleaseLock(imp, "SHIP-001", true); err != nil {
+		t.Errorf("forced release failed: %v", err)
+	}
+	if err := service.ReleaseLock(goblin, "SHIP-001", false); err == nil {
+		t.Error("expected release of unlocked entity to fail")
+	}
+}
+
+func TestLockService_AcquireUnknownEntity(t *testing.T) {
+	service, _ := newTestLockService()
+
+	_, err := service.AcquireLock(actorCtx("GOBLIN"), primary.AcquireLockRequest{EntityID: "PLAN-404"})
+	if err == nil || !strings.Contains(err.Error(), "PLAN-404 not found") {
+		t.Errorf("expected not-found error, got %v", err)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	coreactivity "github.com/example/orc/internal/core/activity"
+	"github.com/example/orc/internal/ctxutil"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
 )
@@ -28,6 +30,7 @@ func (s *LogServiceImpl) ListLogs(ctx context.Context, filters primary.LogFilter
 		EntityID:   filters.EntityID,
 		ActorID:    filters.ActorID,
 		Action:     filters.Action,
+		Since:      filters.Since,
 		Limit:      filters.Limit,
 	})
 	if err != nil {
@@ -55,6 +58,74 @@ func (s *LogServiceImpl) PruneLogs(ctx context.Context, olderThanDays int) (int,
 	return s.logRepo.PruneOlderThan(ctx, olderThanDays)
 }
 
+// RecordPaneRespawn logs a respawned pane as an update of the workbench's
+// pane.<role> field, from how it ended to "respawned".
+func (s *LogServiceImpl) RecordPaneRespawn(ctx context.Context, req primary.PaneRespawn) error {
+	if req.WorkshopID == "" || req.WorkbenchID == "" || req.Role == "" {
+		return fmt.Errorf("workshop, workbench, and role are required")
+	}
+	id, err := s.logRepo.GetNextID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get next log ID: %w", err)
+	}
+	return s.logRepo.Create(ctx, &secondary.WorkshopLogRecord{
+		ID:         id,
+		WorkshopID: req.WorkshopID,
+		ActorID:    ctxutil.ActorFromContext(ctx),
+		EntityType: "workbench",
+		EntityID:   req.WorkbenchID,
+		Action:     "update",
+		FieldName:  "pane." + req.Role,
+		OldValue:   req.Reason,
+		NewValue:   "respawned",
+	})
+}
+
+// GetActivity describes logged actions as a chronological activity feed.
+// The newest Limit actions are kept, then listed oldest first.
+func (s *LogServiceImpl) GetActivity(ctx context.Context, filters primary.ActivityFilters) ([]*primary.ActivityItem, error) {
+	records, err := s.logRepo.List(ctx, secondary.WorkshopLogFilters{
+		ActorID: coreactivity.NormalizeActorID(filters.ActorID),
+		Since:   filters.Since,
+		Limit:   filters.Limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list logs: %w", err)
+	}
+
+	events := make([]coreactivity.Event, len(records))
+	for i, r := range records {
+		events[i] = coreactivity.Event{
+			LogID:      r.ID,
+			Timestamp:  r.Timestamp,
+			ActorID:    r.ActorID,
+			EntityType: r.EntityType,
+			EntityID:   r.EntityID,
+			Action:     r.Action,
+			FieldName:  r.FieldName,
+			OldValue:   r.OldValue,
+			NewValue:   r.NewValue,
+			Forced:     r.Forced,
+		}
+	}
+
+	feed := coreactivity.Feed(events)
+	items := make([]*primary.ActivityItem, len(feed))
+	for i, it := range feed {
+		items[i] = &primary.ActivityItem{
+			LogID:      it.LogID,
+			Timestamp:  it.Timestamp,
+			ActorID:    it.ActorID,
+			EntityType: it.EntityType,
+			EntityID:   it.EntityID,
+			Kind:       it.Kind,
+			Summary:    it.Summary,
+			Forced:     it.Forced,
+		}
+	}
+	return items, nil
+}
+
 // Helper methods
 
 func (s *LogServiceImpl) recordToLogEntry(r *secondary.WorkshopLogRecord) *primary.LogEntry {
@@ -69,6 +140,7 @@ func (s *LogServiceImpl) recordToLogEntry(r *secondary.WorkshopLogRecord) *prima
 		FieldName:  r.FieldName,
 		OldValue:   r.OldValue,
 		NewValue:   r.NewValue,
+		Forced:     r.Forced,
 		CreatedAt:  r.CreatedAt,
 	}
 }
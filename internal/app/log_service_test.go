@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"testing"
 	"time"
 
@@ -56,6 +57,9 @@ func (m *mockWorkshopLogRepository) List(ctx context.Context, filters secondary.
 		if filters.Action != "" && l.Action != filters.Action {
 			continue
 		}
+		if filters.Since != "" && l.Timestamp < filters.Since {
+			continue
+		}
 		result = append(result, l)
 	}
 
@@ -94,6 +98,44 @@ func (m *mockWorkshopLogRepository) PruneOlderThan(ctx context.Context, days int
 	return count, nil
 }
 
+func (m *mockWorkshopLogRepository) ListUndoable(ctx context.Context, actorID string, fields []string, limit int) ([]*secondary.WorkshopLogRecord, error) {
+	undone := make(map[string]bool)
+	for _, l := range m.logs {
+		if l.UndoOf != "" {
+			undone[l.UndoOf] = true
+		}
+	}
+	allowed := make(map[string]bool)
+	for _, f := range fields {
+		allowed[f] = true
+	}
+	var result []*secondary.WorkshopLogRecord
+	for _, l := range m.logs {
+		if l.Action != "update" || l.ActorID != actorID || l.UndoOf != "" || undone[l.ID] {
+			continue
+		}
+		if !allowed[l.EntityType+"."+l.FieldName] {
+			continue
+		}
+		result = append(result, l)
+	}
+	// Newest first (IDs are zero-padded so lexical order matches sequence)
+	sort.Slice(result, func(i, j int) bool { return result[i].ID > result[j].ID })
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+	return result, nil
+}
+
+func (m *mockWorkshopLogRepository) IsUndone(ctx context.Context, logID string) (bool, error) {
+	for _, l := range m.logs {
+		if l.UndoOf == logID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func newTestLogService() (*LogServiceImpl, *mockWorkshopLogRepository) {
 	repo := newMockWorkshopLogRepository()
 	service := NewLogService(repo)
@@ -190,3 +232,56 @@ func TestLogService_PruneLogs(t *testing.T) {
 		t.Errorf("expected 1 log remaining, got %d", len(repo.logs))
 	}
 }
+
+func TestLogService_GetActivity(t *testing.T) {
+	service, repo := newTestLogService()
+	ctx := context.Background()
+
+	repo.logs["WL-0001"] = &secondary.WorkshopLogRecord{ID: "WL-0001", EntityType: "task", EntityID: "TASK-001", Action: "update", FieldName: "status", OldValue: "in-progress", NewValue: "closed", ActorID: "IMP-BENCH-003", Timestamp: "2024-01-01T12:05:00Z"}
+	repo.logs["WL-0002"] = &secondary.WorkshopLogRecord{ID: "WL-0002", EntityType: "task", EntityID: "TASK-001", Action: "update", FieldName: "status", OldValue: "open", NewValue: "in-progress", ActorID: "IMP-BENCH-003", Timestamp: "2024-01-01T12:00:00Z"}
+	repo.logs["WL-0003"] = &secondary.WorkshopLogRecord{ID: "WL-0003", EntityType: "note", EntityID: "NOTE-001", Action: "create", ActorID: "GOBLIN", Timestamp: "2024-01-01T12:01:00Z"}
+
+	items, err := service.GetActivity(ctx, primary.ActivityFilters{ActorID: "BENCH-003"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %d", len(items))
+	}
+	if items[0].Summary != "claimed TASK-001" || items[1].Summary != "completed TASK-001" {
+		t.Errorf("unexpected feed: %q, %q", items[0].Summary, items[1].Summary)
+	}
+	if items[1].Kind != "complete" || items[1].ActorID != "IMP-BENCH-003" {
+		t.Errorf("unexpected item: %+v", items[1])
+	}
+}
+
+func TestLogService_RecordPaneRespawn(t *testing.T) {
+	service, repo := newTestLogService()
+	ctx := context.Background()
+
+	err := service.RecordPaneRespawn(ctx, primary.PaneRespawn{
+		WorkshopID:  "SHOP-001",
+		WorkbenchID: "BENCH-003",
+		Role:        "goblin",
+		Reason:      "exited 1",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	log := repo.logs["WL-0001"]
+	if log == nil {
+		t.Fatal("expected a log entry")
+	}
+	if log.EntityType != "workbench" || log.EntityID != "BENCH-003" || log.Action != "update" {
+		t.Errorf("unexpected entry: %+v", log)
+	}
+	if log.FieldName != "pane.goblin" || log.OldValue != "exited 1" || log.NewValue != "respawned" {
+		t.Errorf("unexpected change: %s %q -> %q", log.FieldName, log.OldValue, log.NewValue)
+	}
+
+	if err := service.RecordPaneRespawn(ctx, primary.PaneRespawn{WorkshopID: "SHOP-001", Role: "vim"}); err == nil {
+		t.Error("expected error without a workbench")
+	}
+}
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	coremetrics "github.com/example/orc/internal/core/metrics"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// MetricsServiceImpl implements the MetricsService interface.
+type MetricsServiceImpl struct {
+	metricsRepo secondary.MetricsRepository
+	buildCommit string
+}
+
+// NewMetricsService creates a new MetricsService with injected dependencies.
+// buildCommit is reported as the commit label of orc_build_info.
+func NewMetricsService(metricsRepo secondary.MetricsRepository, buildCommit string) *MetricsServiceImpl {
+	return &MetricsServiceImpl{
+		metricsRepo: metricsRepo,
+		buildCommit: buildCommit,
+	}
+}
+
+// ExportPrometheus renders current ledger gauges and counters in the
+// Prometheus text exposition format.
+func (s *MetricsServiceImpl) ExportPrometheus(ctx context.Context) (string, error) {
+	tasks, err := s.metricsRepo.CountTasks(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to count tasks: %w", err)
+	}
+	shipments, err := s.metricsRepo.CountShipments(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to count shipments: %w", err)
+	}
+	plans, err := s.metricsRepo.CountPlans(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to count plans: %w", err)
+	}
+	hookEvents, err := s.metricsRepo.CountHookEvents(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to count hook events: %w", err)
+	}
+
+	hookSamples := make([]coremetrics.Sample, len(hookEvents))
+	for i, c := range hookEvents {
+		hookSamples[i] = coremetrics.Sample{
+			Labels: []coremetrics.Label{{Name: "hook_type", Value: c.HookType}, {Name: "decision", Value: c.Decision}},
+			Value:  float64(c.Count),
+		}
+	}
+
+	families := []coremetrics.Family{
+		{
+			Name:    "orc_tasks",
+			Help:    "Tasks by commission and status.",
+			Type:    coremetrics.Gauge,
+			Samples: statusCountSamples(tasks),
+		},
+		{
+			Name:    "orc_shipments",
+			Help:    "Shipments by commission and status (status=\"ready\" is the queue waiting for a workbench).",
+			Type:    coremetrics.Gauge,
+			Samples: statusCountSamples(shipments),
+		},
+		{
+			Name:    "orc_plans",
+			Help:    "Plans by commission and status (status=\"draft\" is awaiting approval).",
+			Type:    coremetrics.Gauge,
+			Samples: statusCountSamples(plans),
+		},
+		{
+			Name:    "orc_hook_events_total",
+			Help:    "Recorded hook invocations by hook type and decision (Stop/block means an agent was kept working).",
+			Type:    coremetrics.Counter,
+			Samples: hookSamples,
+		},
+		{
+			Name:    "orc_build_info",
+			Help:    "Build of the orc binary serving these metrics.",
+			Type:    coremetrics.Gauge,
+			Samples: []coremetrics.Sample{{Labels: []coremetrics.Label{{Name: "commit", Value: s.buildCommit}}, Value: 1}},
+		},
+	}
+
+	return coremetrics.FormatText(families), nil
+}
+
+// statusCountSamples converts grouped status counts into labelled samples.
+func statusCountSamples(counts []*secondary.StatusCountRecord) []coremetrics.Sample {
+	samples := make([]coremetrics.Sample, len(counts))
+	for i, c := range counts {
+		samples[i] = coremetrics.Sample{
+			Labels: []coremetrics.Label{{Name: "commission", Value: c.CommissionID}, {Name: "status", Value: c.Status}},
+			Value:  float64(c.Count),
+		}
+	}
+	return samples
+}
+
+// Ensure MetricsServiceImpl implements the interface
+var _ primary.MetricsService = (*MetricsServiceImpl)(nil)
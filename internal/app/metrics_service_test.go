@@ -0,0 +1,79 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// mockMetricsRepository implements secondary.MetricsRepository for testing.
+type mockMetricsRepository struct {
+	tasks      []*secondary.StatusCountRecord
+	shipments  []*secondary.StatusCountRecord
+	plans      []*secondary.StatusCountRecord
+	hookEvents []*secondary.HookEventCountRecord
+	tasksErr   error
+}
+
+func (m *mockMetricsRepository) CountTasks(ctx context.Context) ([]*secondary.StatusCountRecord, error) {
+	return m.tasks, m.tasksErr
+}
+
+func (m *mockMetricsRepository) CountShipments(ctx context.Context) ([]*secondary.StatusCountRecord, error) {
+	return m.shipments, nil
+}
+
+func (m *mockMetricsRepository) CountPlans(ctx context.Context) ([]*secondary.StatusCountRecord, error) {
+	return m.plans, nil
+}
+
+func (m *mockMetricsRepository) CountHookEvents(ctx context.Context) ([]*secondary.HookEventCountRecord, error) {
+	return m.hookEvents, nil
+}
+
+func TestMetricsService_ExportPrometheus(t *testing.T) {
+	repo := &mockMetricsRepository{
+		tasks: []*secondary.StatusCountRecord{
+			{CommissionID: "COMM-001", Status: "open", Count: 3},
+			{CommissionID: "COMM-001", Status: "blocked", Count: 1},
+		},
+		shipments: []*secondary.StatusCountRecord{
+			{CommissionID: "COMM-001", Status: "ready", Count: 2},
+		},
+		hookEvents: []*secondary.HookEventCountRecord{
+			{HookType: "Stop", Decision: "block", Count: 7},
+		},
+	}
+	service := NewMetricsService(repo, "abc1234")
+
+	out, err := service.ExportPrometheus(context.Background())
+	if err != nil {
+		t.Fatalf("ExportPrometheus failed: %v", err)
+	}
+
+	for _, want := range []string{
+		`orc_tasks{commission="COMM-001",status="blocked"} 1`,
+		`orc_tasks{commission="COMM-001",status="open"} 3`,
+		`orc_shipments{commission="COMM-001",status="ready"} 2`,
+		"# TYPE orc_plans gauge",
+		"# TYPE orc_hook_events_total counter",
+		`orc_hook_events_total{hook_type="Stop",decision="block"} 7`,
+		`orc_build_info{commit="abc1234"} 1`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected output to contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestMetricsService_ExportPrometheus_RepoError(t *testing.T) {
+	repo := &mockMetricsRepository{tasksErr: errors.New("database is locked")}
+	service := NewMetricsService(repo, "abc1234")
+
+	if _, err := service.ExportPrometheus(context.Background()); err == nil {
+		t.Fatal("expected error when a count fails")
+	}
+}
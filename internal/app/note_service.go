@@ -3,7 +3,12 @@ package app
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
+	corehandoff "github.com/example/orc/internal/core/handoff"
+	corenote "github.com/example/orc/internal/core/note"
+	coretriage "github.com/example/orc/internal/core/triage"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
 )
@@ -22,6 +27,15 @@ func NewNoteService(noteRepo secondary.NoteRepository) *NoteServiceImpl {
 
 // CreateNote creates a new note.
 func (s *NoteServiceImpl) CreateNote(ctx context.Context, req primary.CreateNoteRequest) (*primary.CreateNoteResponse, error) {
+	return s.createNote(ctx, req, false)
+}
+
+// createNote creates a note, optionally as an unconfirmed handoff draft.
+func (s *NoteServiceImpl) createNote(ctx context.Context, req primary.CreateNoteRequest, draft bool) (*primary.CreateNoteResponse, error) {
+	if err := coretriage.CanCreate(coretriage.CreateContext{NoteType: req.Type, Severity: req.Severity}).Error(); err != nil {
+		return nil, err
+	}
+
 	// Validate commission exists
 	exists, err := s.noteRepo.CommissionExists(ctx, req.CommissionID)
 	if err != nil {
@@ -44,6 +58,13 @@ func (s *NoteServiceImpl) CreateNote(ctx context.Context, req primary.CreateNote
 		Title:        req.Title,
 		Content:      req.Content,
 		Type:         req.Type,
+		Draft:        draft,
+	}
+
+	// Bug notes enter the triage queue
+	if req.Type == primary.NoteTypeBug {
+		record.Severity, _ = coretriage.NormalizeSeverity(req.Severity)
+		record.TriageStatus = coretriage.StatusUntriaged
 	}
 
 	// Set appropriate container FK based on container type
@@ -86,6 +107,7 @@ func (s *NoteServiceImpl) ListNotes(ctx context.Context, filters primary.NoteFil
 	records, err := s.noteRepo.List(ctx, secondary.NoteFilters{
 		Type:         filters.Type,
 		CommissionID: filters.CommissionID,
+		Resolution:   filters.Resolution,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list notes: %w", err)
@@ -138,29 +160,28 @@ func (s *NoteServiceImpl) GetNotesByContainer(ctx context.Context, containerType
 	return notes, nil
 }
 
-// CloseNote closes a note with a reason.
+// CloseNote closes a note with a resolution. A legacy close reason alone
+// implies its resolution (resolved → fixed, synthesized → superseded, ...).
 func (s *NoteServiceImpl) CloseNote(ctx context.Context, req primary.CloseNoteRequest) error {
-	// Validate reason vocabulary
-	validReasons := map[string]bool{
-		"superseded":  true,
-		"synthesized": true,
-		"resolved":    true,
-		"deferred":    true,
-		"duplicate":   true,
-		"stale":       true,
-	}
-	if !validReasons[req.Reason] {
-		return fmt.Errorf("invalid close reason %q: must be one of superseded, synthesized, resolved, deferred, duplicate, stale", req.Reason)
-	}
-
 	// Get current note to verify it exists and check status
 	note, err := s.noteRepo.GetByID(ctx, req.NoteID)
 	if err != nil {
 		return err
 	}
 
-	if note.Status == "closed" {
-		return fmt.Errorf("note %s is already closed", req.NoteID)
+	resolution := req.Resolution
+	if resolution == "" {
+		resolution = corenote.ResolutionForReason(req.Reason)
+	}
+	guardCtx := corenote.CloseNoteContext{
+		NoteID:     note.ID,
+		Status:     note.Status,
+		Resolution: resolution,
+		Reason:     req.Reason,
+		ByNoteID:   req.ByNoteID,
+	}
+	if err := corenote.CanCloseNote(guardCtx).Error(); err != nil {
+		return err
 	}
 
 	// If ByNoteID is specified, verify it exists
@@ -171,7 +192,7 @@ func (s *NoteServiceImpl) CloseNote(ctx context.Context, req primary.CloseNoteRe
 		}
 	}
 
-	return s.noteRepo.CloseWithReason(ctx, req.NoteID, req.Reason, req.ByNoteID)
+	return s.noteRepo.CloseWithReason(ctx, req.NoteID, resolution, req.Reason, req.ByNoteID)
 }
 
 // ReopenNote reopens a closed note.
@@ -273,6 +294,12 @@ func (s *NoteServiceImpl) recordToNote(r *secondary.NoteRecord) *primary.Note {
 		PromotedFromType: r.PromotedFromType,
 		CloseReason:      r.CloseReason,
 		ClosedByNoteID:   r.ClosedByNoteID,
+		Position:         r.Position,
+		Severity:         r.Severity,
+		TriageStatus:     r.TriageStatus,
+		Resolution:       r.Resolution,
+		Draft:            r.Draft,
+		ContentExternal:  r.ContentExternal,
 	}
 }
 
@@ -337,5 +364,189 @@ func (s *NoteServiceImpl) SetNoteInFlight(ctx context.Context, noteID string) er
 	return s.noteRepo.UpdateStatus(ctx, noteID, "in_flight")
 }
 
+// TriageNote sets a bug note's severity and triage state.
+func (s *NoteServiceImpl) TriageNote(ctx context.Context, req primary.TriageNoteRequest) error {
+	note, err := s.noteRepo.GetByID(ctx, req.NoteID)
+	if err != nil {
+		return err
+	}
+
+	guardCtx := coretriage.TriageContext{
+		NoteID:          note.ID,
+		NoteType:        note.Type,
+		NoteStatus:      note.Status,
+		CurrentSeverity: note.Severity,
+		Severity:        req.Severity,
+		Status:          req.Status,
+	}
+	if err := coretriage.CanTriage(guardCtx).Error(); err != nil {
+		return err
+	}
+
+	severity := note.Severity
+	if req.Severity != "" {
+		severity, _ = coretriage.NormalizeSeverity(req.Severity)
+	}
+	return s.noteRepo.UpdateTriage(ctx, note.ID, severity, req.Status)
+}
+
+// DraftHandoff summarizes an ended session's transcript into a draft
+// handoff note on the shipment or tome the workbench was focused on.
+func (s *NoteServiceImpl) DraftHandoff(ctx context.Context, req primary.DraftHandoffRequest) (*primary.Note, error) {
+	summary := corehandoff.Summarize(req.Transcript)
+	if summary.Empty() {
+		return nil, nil
+	}
+
+	containerType, containerID := "", ""
+	switch {
+	case req.ShipmentID != "":
+		containerType, containerID = "shipment", req.ShipmentID
+	case req.TomeID != "":
+		containerType, containerID = "tome", req.TomeID
+	}
+
+	resp, err := s.createNote(ctx, primary.CreateNoteRequest{
+		CommissionID:  req.CommissionID,
+		Title:         corehandoff.Title(summary),
+		Content:       corehandoff.Render(summary, corehandoff.RenderContext{SessionID: req.SessionID, TaskIDs: req.TaskIDs}),
+		Type:          primary.NoteTypeHandoff,
+		ContainerID:   containerID,
+		ContainerType: containerType,
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Note, nil
+}
+
+// ConfirmHandoff marks a draft handoff note as reviewed by the IMP.
+func (s *NoteServiceImpl) ConfirmHandoff(ctx context.Context, noteID string) error {
+	note, err := s.noteRepo.GetByID(ctx, noteID)
+	if err != nil {
+		return err
+	}
+
+	guardCtx := corehandoff.ConfirmContext{
+		NoteID:   note.ID,
+		NoteType: note.Type,
+		Draft:    note.Draft,
+	}
+	if err := corehandoff.CanConfirm(guardCtx).Error(); err != nil {
+		return err
+	}
+
+	return s.noteRepo.SetDraft(ctx, noteID, false)
+}
+
+// ExternalizeNoteContent moves large note bodies into the blob store.
+func (s *NoteServiceImpl) ExternalizeNoteContent(ctx context.Context, minBytes int, dryRun bool) ([]*primary.ExternalizedNote, error) {
+	if minBytes <= 0 {
+		return nil, fmt.Errorf("minimum size must be positive")
+	}
+	records, err := s.noteRepo.ExternalizeContent(ctx, minBytes, dryRun)
+	notes := make([]*primary.ExternalizedNote, len(records))
+	for i, r := range records {
+		notes[i] = &primary.ExternalizedNote{ID: r.ID, Bytes: r.Bytes}
+	}
+	return notes, err
+}
+
+// ProposeAnswers parses a report note for answers to question notes.
+func (s *NoteServiceImpl) ProposeAnswers(ctx context.Context, reportID string) ([]*primary.ProposedAnswer, error) {
+	report, err := s.noteRepo.GetByID(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposed []*primary.ProposedAnswer
+	for _, a := range corenote.ParseAnswers(report.Content) {
+		p := &primary.ProposedAnswer{QuestionID: a.QuestionID, Answer: a.Text}
+		question, err := s.noteRepo.GetByID(ctx, a.QuestionID)
+		if err != nil {
+			p.Problem = "not found"
+		} else {
+			p.QuestionTitle = question.Title
+			guardCtx := corenote.AnswerQuestionContext{
+				NoteID:   question.ID,
+				NoteType: question.Type,
+				Status:   question.Status,
+				ReportID: report.ID,
+			}
+			if result := corenote.CanAnswerQuestion(guardCtx); !result.Allowed {
+				p.Problem = result.Reason
+			}
+		}
+		proposed = append(proposed, p)
+	}
+	return proposed, nil
+}
+
+// AnswerQuestion appends the answer to a question note and closes it as
+// fixed, with the report as the note that closed it.
+func (s *NoteServiceImpl) AnswerQuestion(ctx context.Context, req primary.AnswerQuestionRequest) error {
+	if strings.TrimSpace(req.Answer) == "" {
+		return fmt.Errorf("answer for %s is empty", req.QuestionID)
+	}
+	question, err := s.noteRepo.GetByID(ctx, req.QuestionID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.noteRepo.GetByID(ctx, req.ReportID); err != nil {
+		return fmt.Errorf("report note %s not found: %w", req.ReportID, err)
+	}
+
+	guardCtx := corenote.AnswerQuestionContext{
+		NoteID:   question.ID,
+		NoteType: question.Type,
+		Status:   question.Status,
+		ReportID: req.ReportID,
+	}
+	if err := corenote.CanAnswerQuestion(guardCtx).Error(); err != nil {
+		return err
+	}
+
+	content := corenote.AppendAnswer(question.Content, req.ReportID, req.Answer)
+	if err := s.noteRepo.Update(ctx, &secondary.NoteRecord{ID: question.ID, Content: content}); err != nil {
+		return err
+	}
+	return s.noteRepo.CloseWithReason(ctx, question.ID, corenote.ResolutionFixed, "", req.ReportID)
+}
+
+// ListTriageQueue lists open bug notes awaiting triage, most severe and then
+// oldest first.
+func (s *NoteServiceImpl) ListTriageQueue(ctx context.Context, filters primary.TriageQueueFilters) ([]*primary.Note, error) {
+	records, err := s.noteRepo.List(ctx, secondary.NoteFilters{
+		Type:         primary.NoteTypeBug,
+		CommissionID: filters.CommissionID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bug notes: %w", err)
+	}
+
+	var queue []*secondary.NoteRecord
+	for _, r := range records {
+		if r.Status == "closed" || r.Status == "resolved" || !coretriage.Untriaged(r.TriageStatus) {
+			continue
+		}
+		if filters.UrgentOnly && !coretriage.Urgent(r.Severity, r.TriageStatus) {
+			continue
+		}
+		queue = append(queue, r)
+	}
+	slices.SortStableFunc(queue, func(a, b *secondary.NoteRecord) int {
+		return coretriage.Compare(
+			coretriage.Item{Severity: a.Severity, CreatedAt: a.CreatedAt},
+			coretriage.Item{Severity: b.Severity, CreatedAt: b.CreatedAt},
+		)
+	})
+
+	notes := make([]*primary.Note, len(queue))
+	for i, r := range queue {
+		notes[i] = s.recordToNote(r)
+	}
+	return notes, nil
+}
+
 // Ensure NoteServiceImpl implements the interface
 var _ primary.NoteService = (*NoteServiceImpl)(nil)
@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/example/orc/internal/ports/primary"
@@ -62,6 +63,9 @@ func (m *mockNoteRepository) List(ctx context.Context, filters secondary.NoteFil
 		if filters.Type != "" && n.Type != filters.Type {
 			continue
 		}
+		if filters.Resolution != "" && n.Resolution != filters.Resolution {
+			continue
+		}
 		result = append(result, n)
 	}
 	return result, nil
@@ -153,14 +157,16 @@ func (m *mockNoteRepository) CloseWithMerge(ctx context.Context, sourceID, targe
 		note.Status = "closed"
 		note.PromotedFromID = targetID
 		note.PromotedFromType = "merged"
+		note.Resolution = "duplicate"
 		return nil
 	}
 	return errors.New("note not found")
 }
 
-func (m *mockNoteRepository) CloseWithReason(ctx context.Context, id, reason, byNoteID string) error {
+func (m *mockNoteRepository) CloseWithReason(ctx context.Context, id, resolution, reason, byNoteID string) error {
 	if note, ok := m.notes[id]; ok {
 		note.Status = "closed"
+		note.Resolution = resolution
 		note.CloseReason = reason
 		note.ClosedByNoteID = byNoteID
 		return nil
@@ -168,6 +174,33 @@ func (m *mockNoteRepository) CloseWithReason(ctx context.Context, id, reason, by
 	return errors.New("note not found")
 }
 
+func (m *mockNoteRepository) UpdateTriage(ctx context.Context, id, severity, triageStatus string) error {
+	if note, ok := m.notes[id]; ok {
+		note.Severity = severity
+		note.TriageStatus = triageStatus
+		return nil
+	}
+	return errors.New("note not found")
+}
+
+func (m *mockNoteRepository) SetDraft(ctx context.Context, id string, draft bool) error {
+	if note, ok := m.notes[id]; ok {
+		note.Draft = draft
+		return nil
+	}
+	return errors.New("note not found")
+}
+
+func (m *mockNoteRepository) ExternalizeContent(ctx context.Context, minBytes int, dryRun bool) ([]*secondary.ExternalizedNoteRecord, error) {
+	var moved []*secondary.ExternalizedNoteRecord
+	for _, note := range m.notes {
+		if len(note.Content) >= minBytes {
+			moved = append(moved, &secondary.ExternalizedNoteRecord{ID: note.ID, Bytes: len(note.Content)})
+		}
+	}
+	return moved, nil
+}
+
 // ============================================================================
 // Test Helper
 // ============================================================================
@@ -352,6 +385,63 @@ func TestListNotes_FilterByType(t *testing.T) {
 	}
 }
 
+func TestListNotes_FilterByResolution(t *testing.T) {
+	service, noteRepo := newTestNoteService()
+	noteRepo.notes["NOTE-001"] = &secondary.NoteRecord{ID: "NOTE-001", CommissionID: "COMM-001", Status: "closed", Resolution: "duplicate"}
+	noteRepo.notes["NOTE-002"] = &secondary.NoteRecord{ID: "NOTE-002", CommissionID: "COMM-001", Status: "closed", Resolution: "fixed"}
+
+	notes, err := service.ListNotes(context.Background(), primary.NoteFilters{Resolution: "duplicate"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(notes) != 1 || notes[0].Resolution != "duplicate" {
+		t.Errorf("expected 1 duplicate note, got %+v", notes)
+	}
+}
+
+// ============================================================================
+// CloseNote Tests
+// ============================================================================
+
+func TestCloseNote(t *testing.T) {
+	tests := []struct {
+		name           string
+		req            primary.CloseNoteRequest
+		wantErr        bool
+		wantResolution string
+	}{
+		{"resolution", primary.CloseNoteRequest{NoteID: "NOTE-001", Resolution: "wontfix"}, false, "wontfix"},
+		{"legacy reason implies resolution", primary.CloseNoteRequest{NoteID: "NOTE-001", Reason: "synthesized", ByNoteID: "NOTE-002"}, false, "superseded"},
+		{"resolution overrides reason", primary.CloseNoteRequest{NoteID: "NOTE-001", Resolution: "promoted", Reason: "resolved"}, false, "promoted"},
+		{"resolution required", primary.CloseNoteRequest{NoteID: "NOTE-001"}, true, ""},
+		{"duplicate requires reference", primary.CloseNoteRequest{NoteID: "NOTE-001", Resolution: "duplicate"}, true, ""},
+		{"reference must exist", primary.CloseNoteRequest{NoteID: "NOTE-001", Resolution: "duplicate", ByNoteID: "NOTE-999"}, true, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, noteRepo := newTestNoteService()
+			noteRepo.notes["NOTE-001"] = &secondary.NoteRecord{ID: "NOTE-001", CommissionID: "COMM-001", Status: "open"}
+			noteRepo.notes["NOTE-002"] = &secondary.NoteRecord{ID: "NOTE-002", CommissionID: "COMM-001", Status: "open"}
+
+			err := service.CloseNote(context.Background(), tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CloseNote error = %v, wantErr %v", err, tt.wantErr)
+			}
+			note := noteRepo.notes["NOTE-001"]
+			if tt.wantErr {
+				if note.Status != "open" {
+					t.Error("expected note to stay open")
+				}
+				return
+			}
+			if note.Status != "closed" || note.Resolution != tt.wantResolution {
+				t.Errorf("got status %s resolution %q, want closed %q", note.Status, note.Resolution, tt.wantResolution)
+			}
+		})
+	}
+}
+
 // ============================================================================
 // UpdateNote Tests
 // ============================================================================
@@ -608,3 +698,254 @@ func TestSetNoteInFlight_NotFound(t *testing.T) {
 		t.Fatal("expected error for non-existent note")
 	}
 }
+
+// ============================================================================
+// Triage Tests
+// ============================================================================
+
+func TestCreateNote_BugEntersTriage(t *testing.T) {
+	service, noteRepo := newTestNoteService()
+	ctx := context.Background()
+
+	_, err := service.CreateNote(ctx, primary.CreateNoteRequest{
+		CommissionID: "COMM-001",
+		Title:        "Login fails",
+		Type:         "bug",
+		Severity:     "p1",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	note := noteRepo.notes["NOTE-001"]
+	if note.Severity != "P1" || note.TriageStatus != "untriaged" {
+		t.Errorf("severity, triage = %q, %q, want P1, untriaged", note.Severity, note.TriageStatus)
+	}
+}
+
+func TestCreateNote_SeverityOnNonBug(t *testing.T) {
+	service, _ := newTestNoteService()
+	ctx := context.Background()
+
+	_, err := service.CreateNote(ctx, primary.CreateNoteRequest{
+		CommissionID: "COMM-001",
+		Title:        "Use the keychain",
+		Type:         "learning",
+		Severity:     "P1",
+	})
+	if err == nil {
+		t.Fatal("expected error for severity on a learning note")
+	}
+}
+
+func TestTriageNote(t *testing.T) {
+	service, noteRepo := newTestNoteService()
+	ctx := context.Background()
+	noteRepo.notes["NOTE-001"] = &secondary.NoteRecord{ID: "NOTE-001", Type: "bug", Status: "open", Severity: "P2", TriageStatus: "untriaged"}
+
+	if err := service.TriageNote(ctx, primary.TriageNoteRequest{NoteID: "NOTE-001", Status: "accepted"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	note := noteRepo.notes["NOTE-001"]
+	if note.Severity != "P2" || note.TriageStatus != "accepted" {
+		t.Errorf("severity, triage = %q, %q, want P2, accepted", note.Severity, note.TriageStatus)
+	}
+
+	if err := service.TriageNote(ctx, primary.TriageNoteRequest{NoteID: "NOTE-001", Severity: "p0", Status: "accepted"}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if note.Severity != "P0" {
+		t.Errorf("severity = %q, want P0", note.Severity)
+	}
+}
+
+func TestTriageNote_NotABug(t *testing.T) {
+	service, noteRepo := newTestNoteService()
+	ctx := context.Background()
+	noteRepo.notes["NOTE-001"] = &secondary.NoteRecord{ID: "NOTE-001", Type: "idea", Status: "open"}
+
+	err := service.TriageNote(ctx, primary.TriageNoteRequest{NoteID: "NOTE-001", Severity: "P1", Status: "accepted"})
+	if err == nil {
+		t.Fatal("expected error triaging an idea note")
+	}
+}
+
+func TestListTriageQueue(t *testing.T) {
+	service, noteRepo := newTestNoteService()
+	ctx := context.Background()
+	noteRepo.notes = map[string]*secondary.NoteRecord{
+		"NOTE-001": {ID: "NOTE-001", CommissionID: "COMM-001", Type: "bug", Status: "open", Severity: "P2", TriageStatus: "untriaged", CreatedAt: "2026-01-01T00:00:00Z"},
+		"NOTE-002": {ID: "NOTE-002", CommissionID: "COMM-001", Type: "bug", Status: "open", Severity: "P0", TriageStatus: "untriaged", CreatedAt: "2026-03-01T00:00:00Z"},
+		"NOTE-003": {ID: "NOTE-003", CommissionID: "COMM-001", Type: "bug", Status: "open", CreatedAt: "2025-12-01T00:00:00Z"},
+		"NOTE-004": {ID: "NOTE-004", CommissionID: "COMM-001", Type: "bug", Status: "open", Severity: "P1", TriageStatus: "accepted", CreatedAt: "2026-01-01T00:00:00Z"},
+		"NOTE-005": {ID: "NOTE-005", CommissionID: "COMM-001", Type: "bug", Status: "closed", Severity: "P0", TriageStatus: "untriaged", CreatedAt: "2026-01-01T00:00:00Z"},
+		"NOTE-006": {ID: "NOTE-006", CommissionID: "COMM-001", Type: "idea", Status: "open", CreatedAt: "2026-01-01T00:00:00Z"},
+		"NOTE-007": {ID: "NOTE-007", CommissionID: "COMM-001", Type: "bug", Status: "open", Severity: "P0", TriageStatus: "untriaged", CreatedAt: "2026-02-01T00:00:00Z"},
+	}
+
+	queue, err := service.ListTriageQueue(ctx, primary.TriageQueueFilters{CommissionID: "COMM-001"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	var ids []string
+	for _, n := range queue {
+		ids = append(ids, n.ID)
+	}
+	want := []string{"NOTE-007", "NOTE-002", "NOTE-001", "NOTE-003"}
+	if len(ids) != len(want) {
+		t.Fatalf("queue = %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Fatalf("queue = %v, want %v", ids, want)
+		}
+	}
+
+	urgent, err := service.ListTriageQueue(ctx, primary.TriageQueueFilters{UrgentOnly: true})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(urgent) != 2 {
+		t.Errorf("expected 2 urgent bugs, got %d", len(urgent))
+	}
+}
+
+// ============================================================================
+// Handoff Tests
+// ============================================================================
+
+func TestDraftHandoff(t *testing.T) {
+	service, noteRepo := newTestNoteService()
+	ctx := context.Background()
+
+	transcript := []byte(`{"type":"user","message":{"role":"user","content":"Fix the login redirect"}}
+{"type":"assistant","message":{"role":"assistant","content":[{"type":"tool_use","name":"Edit","input":{"file_path":"auth.go"}}]}}`)
+
+	note, err := service.DraftHandoff(ctx, primary.DraftHandoffRequest{
+		CommissionID: "COMM-001",
+		ShipmentID:   "SHIP-001",
+		TaskIDs:      []string{"TASK-001"},
+		SessionID:    "sess-1",
+		Transcript:   transcript,
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if note == nil {
+		t.Fatal("expected a handoff note")
+	}
+
+	record := noteRepo.notes[note.ID]
+	if record.Type != primary.NoteTypeHandoff || !record.Draft || record.ShipmentID != "SHIP-001" {
+		t.Errorf("record = type %q, draft %v, shipment %q; want a draft handoff on SHIP-001", record.Type, record.Draft, record.ShipmentID)
+	}
+	if record.Title != "Handoff: Fix the login redirect" {
+		t.Errorf("title = %q", record.Title)
+	}
+}
+
+func TestDraftHandoff_EmptySession(t *testing.T) {
+	service, noteRepo := newTestNoteService()
+
+	note, err := service.DraftHandoff(context.Background(), primary.DraftHandoffRequest{
+		CommissionID: "COMM-001",
+		Transcript:   []byte(`{"type":"summary","summary":"nothing"}`),
+	})
+	if err != nil || note != nil {
+		t.Fatalf("DraftHandoff = %v, %v; want nil, nil", note, err)
+	}
+	if len(noteRepo.notes) != 0 {
+		t.Error("expected no note for an empty session")
+	}
+}
+
+func TestConfirmHandoff(t *testing.T) {
+	service, noteRepo := newTestNoteService()
+	ctx := context.Background()
+	noteRepo.notes["NOTE-001"] = &secondary.NoteRecord{ID: "NOTE-001", Type: "handoff", Status: "open", Draft: true}
+	noteRepo.notes["NOTE-002"] = &secondary.NoteRecord{ID: "NOTE-002", Type: "idea", Status: "open"}
+
+	if err := service.ConfirmHandoff(ctx, "NOTE-001"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if noteRepo.notes["NOTE-001"].Draft {
+		t.Error("expected handoff to be confirmed")
+	}
+	if err := service.ConfirmHandoff(ctx, "NOTE-001"); err == nil {
+		t.Error("expected error confirming a handoff twice")
+	}
+	if err := service.ConfirmHandoff(ctx, "NOTE-002"); err == nil {
+		t.Error("expected error confirming a non-handoff note")
+	}
+}
+
+func TestExternalizeNoteContent(t *testing.T) {
+	service, noteRepo := newTestNoteService()
+	ctx := context.Background()
+	noteRepo.notes["NOTE-001"] = &secondary.NoteRecord{ID: "NOTE-001", Content: strings.Repeat("x", 100)}
+	noteRepo.notes["NOTE-002"] = &secondary.NoteRecord{ID: "NOTE-002", Content: "short"}
+
+	moved, err := service.ExternalizeNoteContent(ctx, 50, true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(moved) != 1 || moved[0].ID != "NOTE-001" || moved[0].Bytes != 100 {
+		t.Errorf("unexpected notes: %+v", moved)
+	}
+
+	if _, err := service.ExternalizeNoteContent(ctx, 0, true); err == nil {
+		t.Error("expected error for a non-positive minimum size")
+	}
+}
+
+func TestProposeAnswers(t *testing.T) {
+	service, noteRepo := newTestNoteService()
+	ctx := context.Background()
+	noteRepo.notes["NOTE-300"] = &secondary.NoteRecord{ID: "NOTE-300", Type: "finding", Status: "open",
+		Content: "Findings\n- NOTE-001: Cursors\n- NOTE-002: n/a\n- NOTE-003: Yes\n- NOTE-404: Nobody asked"}
+	noteRepo.notes["NOTE-001"] = &secondary.NoteRecord{ID: "NOTE-001", Title: "Does it paginate?", Type: "question", Status: "open"}
+	noteRepo.notes["NOTE-002"] = &secondary.NoteRecord{ID: "NOTE-002", Title: "Idea", Type: "idea", Status: "open"}
+	noteRepo.notes["NOTE-003"] = &secondary.NoteRecord{ID: "NOTE-003", Title: "Old", Type: "question", Status: "closed"}
+
+	proposed, err := service.ProposeAnswers(ctx, "NOTE-300")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(proposed) != 4 {
+		t.Fatalf("expected 4 proposed answers, got %d", len(proposed))
+	}
+	if p := proposed[0]; p.QuestionTitle != "Does it paginate?" || p.Answer != "Cursors" || p.Problem != "" {
+		t.Errorf("unexpected first answer: %+v", p)
+	}
+	for i, want := range []string{"", "NOTE-002 is not a question (type idea)", "NOTE-003 is already closed", "not found"} {
+		if proposed[i].Problem != want {
+			t.Errorf("answer %d problem = %q, want %q", i, proposed[i].Problem, want)
+		}
+	}
+}
+
+func TestAnswerQuestion(t *testing.T) {
+	service, noteRepo := newTestNoteService()
+	ctx := context.Background()
+	noteRepo.notes["NOTE-300"] = &secondary.NoteRecord{ID: "NOTE-300", Type: "finding", Status: "open"}
+	noteRepo.notes["NOTE-001"] = &secondary.NoteRecord{ID: "NOTE-001", Type: "question", Status: "open", Content: "Does it paginate?"}
+
+	err := service.AnswerQuestion(ctx, primary.AnswerQuestionRequest{QuestionID: "NOTE-001", ReportID: "NOTE-300", Answer: "Cursors"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	q := noteRepo.notes["NOTE-001"]
+	if q.Status != "closed" || q.Resolution != "fixed" || q.ClosedByNoteID != "NOTE-300" {
+		t.Errorf("expected question closed by the report, got %+v", q)
+	}
+	if q.Content != "Does it paginate?\n\nAnswer (from NOTE-300): Cursors" {
+		t.Errorf("content = %q", q.Content)
+	}
+
+	if err := service.AnswerQuestion(ctx, primary.AnswerQuestionRequest{QuestionID: "NOTE-001", ReportID: "NOTE-300", Answer: "Again"}); err == nil {
+		t.Error("expected error answering a closed question")
+	}
+	if err := service.AnswerQuestion(ctx, primary.AnswerQuestionRequest{QuestionID: "NOTE-001", ReportID: "NOTE-300"}); err == nil {
+		t.Error("expected error for an empty answer")
+	}
+}
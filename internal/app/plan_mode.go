@@ -0,0 +1,267 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/example/orc/internal/core/effects"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// plannedStashSHA stands in for the stash commit a planned stash would create.
+const plannedStashSHA = "0000000000000000000000000000000000000000"
+
+// IntentRecorder collects what a --plan run would have done outside the
+// ledger. The plan decorators below record into it instead of acting.
+type IntentRecorder struct {
+	mu      sync.Mutex
+	intents []primary.Intent
+}
+
+// NewIntentRecorder creates an empty IntentRecorder.
+func NewIntentRecorder() *IntentRecorder {
+	return &IntentRecorder{}
+}
+
+// Record adds one intent.
+func (r *IntentRecorder) Record(kind, format string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.intents = append(r.intents, primary.Intent{Kind: kind, Action: fmt.Sprintf(format, args...)})
+}
+
+// Intents returns the intents recorded so far, in order.
+func (r *IntentRecorder) Intents() []primary.Intent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]primary.Intent(nil), r.intents...)
+}
+
+// PlanEffectExecutor records file effects instead of executing them. Other
+// effects go to the wrapped executor, which in plan mode persists to the
+// sandbox ledger and reaches git and tmux only through plan adapters.
+type PlanEffectExecutor struct {
+	inner    EffectExecutor
+	recorder *IntentRecorder
+}
+
+// NewPlanEffectExecutor wraps inner for --plan runs.
+func NewPlanEffectExecutor(inner EffectExecutor, recorder *IntentRecorder) *PlanEffectExecutor {
+	return &PlanEffectExecutor{inner: inner, recorder: recorder}
+}
+
+// Execute records file effects and passes the rest through.
+func (e *PlanEffectExecutor) Execute(ctx context.Context, effs []effects.Effect) error {
+	for _, eff := range effs {
+		switch typed := eff.(type) {
+		case effects.FileEffect:
+			switch typed.Operation {
+			case "mkdir":
+				e.recorder.Record("file", "create directory %s", typed.Path)
+			case "write":
+				e.recorder.Record("file", "write %s (%d bytes)", typed.Path, len(typed.Content))
+			}
+		case effects.CompositeEffect:
+			if err := e.Execute(ctx, typed.Effects); err != nil {
+				return err
+			}
+		default:
+			if err := e.inner.Execute(ctx, []effects.Effect{eff}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// PlanWorkspaceAdapter records worktree, directory, bootstrap, and stash
+// changes instead of making them. Reads pass through to the real workspace.
+type PlanWorkspaceAdapter struct {
+	secondary.WorkspaceAdapter
+	recorder *IntentRecorder
+}
+
+// NewPlanWorkspaceAdapter wraps a workspace adapter for --plan runs.
+func NewPlanWorkspaceAdapter(inner secondary.WorkspaceAdapter, recorder *IntentRecorder) *PlanWorkspaceAdapter {
+	return &PlanWorkspaceAdapter{WorkspaceAdapter: inner, recorder: recorder}
+}
+
+func (a *PlanWorkspaceAdapter) CreateWorktree(ctx context.Context, repoPath, branchName, targetPath string) error {
+	a.recorder.Record("git", "create worktree %s from %s on branch %s", targetPath, repoPath, branchName)
+	return nil
+}
+
+func (a *PlanWorkspaceAdapter) RemoveWorktree(ctx context.Context, path string) error {
+	a.recorder.Record("git", "remove worktree %s", path)
+	return nil
+}
+
+func (a *PlanWorkspaceAdapter) CreateDirectory(ctx context.Context, path string) error {
+	a.recorder.Record("file", "create directory %s", path)
+	return nil
+}
+
+func (a *PlanWorkspaceAdapter) RemoveDirectory(ctx context.Context, path string) error {
+	a.recorder.Record("file", "remove directory %s", path)
+	return nil
+}
+
+func (a *PlanWorkspaceAdapter) RunBootstrap(ctx context.Context, workdir, script string) (string, error) {
+	a.recorder.Record("file", "run bootstrap in %s: %s", workdir, firstLine(script))
+	return "", nil
+}
+
+func (a *PlanWorkspaceAdapter) StashWorkingChanges(ctx context.Context, workdir, message string) (string, error) {
+	a.recorder.Record("git", "stash uncommitted changes in %s", workdir)
+	return plannedStashSHA, nil
+}
+
+func (a *PlanWorkspaceAdapter) ApplyStash(ctx context.Context, workdir, sha string) error {
+	a.recorder.Record("git", "apply stash %s in %s", shortSHA(sha), workdir)
+	return nil
+}
+
+func (a *PlanWorkspaceAdapter) DropStash(ctx context.Context, workdir, sha string) error {
+	a.recorder.Record("git", "drop stash %s in %s", shortSHA(sha), workdir)
+	return nil
+}
+
+// PlanTMuxAdapter records session, window, and pane changes instead of
+// making them. Queries pass through to the running tmux server.
+type PlanTMuxAdapter struct {
+	secondary.TMuxAdapter
+	recorder *IntentRecorder
+}
+
+// NewPlanTMuxAdapter wraps a tmux adapter for --plan runs.
+func NewPlanTMuxAdapter(inner secondary.TMuxAdapter, recorder *IntentRecorder) *PlanTMuxAdapter {
+	return &PlanTMuxAdapter{TMuxAdapter: inner, recorder: recorder}
+}
+
+func (a *PlanTMuxAdapter) KillSession(ctx context.Context, name string) error {
+	a.recorder.Record("tmux", "kill session %s", name)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) KillWindow(ctx context.Context, sessionName string, windowName string) error {
+	a.recorder.Record("tmux", "kill window %s:%s", sessionName, windowName)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) SendKeys(ctx context.Context, target, keys string) error {
+	a.recorder.Record("tmux", "send keys to %s: %s", target, keys)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) SplitVertical(ctx context.Context, target, workingDir string) error {
+	a.recorder.Record("tmux", "split %s vertically in %s", target, workingDir)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) SplitHorizontal(ctx context.Context, target, workingDir string) error {
+	a.recorder.Record("tmux", "split %s horizontally in %s", target, workingDir)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) JoinPane(ctx context.Context, source, target string, vertical bool, size int) error {
+	a.recorder.Record("tmux", "join pane %s into %s", source, target)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) SelectWindow(ctx context.Context, sessionName string, index int) error {
+	a.recorder.Record("tmux", "select window %s:%d", sessionName, index)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) RenameWindow(ctx context.Context, target, newName string) error {
+	a.recorder.Record("tmux", "rename window %s to %s", target, newName)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) RespawnPane(ctx context.Context, target string, command ...string) error {
+	a.recorder.Record("tmux", "respawn pane %s: %s", target, strings.Join(command, " "))
+	return nil
+}
+
+func (a *PlanTMuxAdapter) RenameSession(ctx context.Context, session, newName string) error {
+	a.recorder.Record("tmux", "rename session %s to %s", session, newName)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) ConfigureStatusBar(ctx context.Context, session string, config secondary.StatusBarConfig) error {
+	a.recorder.Record("tmux", "configure status bar of %s", session)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) DisplayPopup(ctx context.Context, session, command string, config secondary.PopupConfig) error {
+	a.recorder.Record("tmux", "open popup in %s: %s", session, command)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) ConfigureSessionBindings(ctx context.Context, session string, bindings []secondary.KeyBinding) error {
+	a.recorder.Record("tmux", "bind %d keys in %s", len(bindings), session)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) ConfigureSessionPopupBindings(ctx context.Context, session string, bindings []secondary.PopupKeyBinding) error {
+	a.recorder.Record("tmux", "bind %d popup keys in %s", len(bindings), session)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) SetEnvironment(ctx context.Context, sessionName, key, value string) error {
+	a.recorder.Record("tmux", "set %s=%s in %s", key, value, sessionName)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) SetWindowOption(ctx context.Context, target, option, value string) error {
+	a.recorder.Record("tmux", "set %s=%s on %s", option, value, target)
+	return nil
+}
+
+func (a *PlanTMuxAdapter) SetupGoblinPane(ctx context.Context, sessionName, windowName string) error {
+	a.recorder.Record("tmux", "set up goblin pane in %s:%s", sessionName, windowName)
+	return nil
+}
+
+// PlanPRBodyStore records pull request description updates instead of
+// making them. Reads pass through to the hosting service.
+type PlanPRBodyStore struct {
+	secondary.PRBodyStore
+	recorder *IntentRecorder
+}
+
+// NewPlanPRBodyStore wraps a PR body store for --plan runs.
+func NewPlanPRBodyStore(inner secondary.PRBodyStore, recorder *IntentRecorder) *PlanPRBodyStore {
+	return &PlanPRBodyStore{PRBodyStore: inner, recorder: recorder}
+}
+
+func (s *PlanPRBodyStore) UpdateBody(ctx context.Context, repo string, number int, body string) error {
+	s.recorder.Record("github", "update description of %s#%d (%d bytes)", repo, number, len(body))
+	return nil
+}
+
+// firstLine returns the first line of s, marking any that follow.
+func firstLine(s string) string {
+	line, rest, found := strings.Cut(strings.TrimSpace(s), "\n")
+	if found && strings.TrimSpace(rest) != "" {
+		return line + " …"
+	}
+	return line
+}
+
+// shortSHA abbreviates a commit SHA for display.
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
+var (
+	_ EffectExecutor             = (*PlanEffectExecutor)(nil)
+	_ secondary.WorkspaceAdapter = (*PlanWorkspaceAdapter)(nil)
+	_ secondary.TMuxAdapter      = (*PlanTMuxAdapter)(nil)
+)
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/example/orc/internal/core/effects"
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func TestPlanEffectExecutor_RecordsFileEffects(t *testing.T) {
+	inner := newMockEffectExecutor()
+	recorder := NewIntentRecorder()
+	executor := NewPlanEffectExecutor(inner, recorder)
+
+	persist := effects.PersistEffect{Entity: "commission", Operation: "update_status"}
+	err := executor.Execute(context.Background(), []effects.Effect{
+		effects.FileEffect{Operation: "mkdir", Path: "/wb/orc-014/.orc"},
+		effects.CompositeEffect{Effects: []effects.Effect{
+			effects.FileEffect{Operation: "write", Path: "/wb/orc-014/.orc/config.json", Content: []byte("{}")},
+			persist,
+		}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []primary.Intent{
+		{Kind: "file", Action: "create directory /wb/orc-014/.orc"},
+		{Kind: "file", Action: "write /wb/orc-014/.orc/config.json (2 bytes)"},
+	}
+	if got := recorder.Intents(); !reflect.DeepEqual(got, want) {
+		t.Errorf("intents = %+v, want %+v", got, want)
+	}
+	// Ledger writes still run, against the sandbox
+	if len(inner.executedEffects) != 1 || inner.executedEffects[0] != effects.Effect(persist) {
+		t.Errorf("inner executed %+v, want only the persist effect", inner.executedEffects)
+	}
+}
+
+func TestPlanWorkspaceAdapter_RecordsChanges(t *testing.T) {
+	ctx := context.Background()
+	inner := newMockWorkspaceAdapter()
+	inner.workingChanges = []string{"main.go"}
+	recorder := NewIntentRecorder()
+	workspace := NewPlanWorkspaceAdapter(inner, recorder)
+
+	if err := workspace.CreateWorktree(ctx, "/src/orc", "ml/SHIP-042", "/wb/orc-014"); err != nil {
+		t.Fatalf("CreateWorktree failed: %v", err)
+	}
+	sha, err := workspace.StashWorkingChanges(ctx, "/wb/orc-014", "handoff")
+	if err != nil || sha != plannedStashSHA {
+		t.Fatalf("StashWorkingChanges = %q, %v", sha, err)
+	}
+
+	// Reads pass through
+	changes, err := workspace.ListWorkingChanges(ctx, "/wb/orc-014")
+	if err != nil || len(changes) != 1 {
+		t.Errorf("ListWorkingChanges = %v, %v; want the real changes", changes, err)
+	}
+
+	if len(inner.worktrees) != 0 || len(inner.stashMessages) != 0 {
+		t.Error("plan adapter reached the real workspace")
+	}
+	want := []primary.Intent{
+		{Kind: "git", Action: "create worktree /wb/orc-014 from /src/orc on branch ml/SHIP-042"},
+		{Kind: "git", Action: "stash uncommitted changes in /wb/orc-014"},
+	}
+	if got := recorder.Intents(); !reflect.DeepEqual(got, want) {
+		t.Errorf("intents = %+v, want %+v", got, want)
+	}
+}
+
+func TestPlanPRBodyStore_RecordsApply(t *testing.T) {
+	service, prRepo, body := newPRDescriptionTestService()
+	recorder := NewIntentRecorder()
+	service.bodyStore = NewPlanPRBodyStore(body, recorder)
+
+	desc, err := service.ApplyDescription(context.Background(), "PR-001")
+	if err != nil {
+		t.Fatalf("ApplyDescription failed: %v", err)
+	}
+	if body.updates != 0 {
+		t.Errorf("plan store wrote to GitHub %d times", body.updates)
+	}
+	want := []primary.Intent{
+		{Kind: "github", Action: fmt.Sprintf("update description of acme/app#12 (%d bytes)", len(desc.Body))},
+	}
+	if got := recorder.Intents(); !reflect.DeepEqual(got, want) {
+		t.Errorf("intents = %+v, want %+v", got, want)
+	}
+	// The ledger copy still updates, against the sandbox
+	if prRepo.prs["PR-001"].Description != desc.Body {
+		t.Error("ledger description not updated")
+	}
+}
@@ -3,21 +3,27 @@ package app
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	plancore "github.com/example/orc/internal/core/plan"
+	"github.com/example/orc/internal/ctxutil"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
 )
 
 // PlanServiceImpl implements the PlanService interface.
 type PlanServiceImpl struct {
-	planRepo secondary.PlanRepository
+	planRepo    secondary.PlanRepository
+	taskService primary.TaskService
+	lockService primary.LockService
 }
 
 // NewPlanService creates a new PlanService with injected dependencies.
-func NewPlanService(planRepo secondary.PlanRepository) *PlanServiceImpl {
+func NewPlanService(planRepo secondary.PlanRepository, taskService primary.TaskService, lockService primary.LockService) *PlanServiceImpl {
 	return &PlanServiceImpl{
-		planRepo: planRepo,
+		planRepo:    planRepo,
+		taskService: taskService,
+		lockService: lockService,
 	}
 }
 
@@ -89,38 +95,61 @@ func (s *PlanServiceImpl) GetPlan(ctx context.Context, planID string) (*primary.
 	if err != nil {
 		return nil, err
 	}
-	return s.recordToPlan(record), nil
+	return s.planWithStatus(ctx, record)
 }
 
 // ListPlans lists plans with optional filters.
+// Conditionally approved plans are stored as draft, so the status filter is
+// applied to the reported status.
 func (s *PlanServiceImpl) ListPlans(ctx context.Context, filters primary.PlanFilters) ([]*primary.Plan, error) {
+	storedStatus := filters.Status
+	if storedStatus == plancore.StatusConditionallyApproved {
+		storedStatus = "draft"
+	}
 	records, err := s.planRepo.List(ctx, secondary.PlanFilters{
 		TaskID:       filters.TaskID,
 		CommissionID: filters.CommissionID,
-		Status:       filters.Status,
+		Status:       storedStatus,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list plans: %w", err)
 	}
 
-	plans := make([]*primary.Plan, len(records))
-	for i, r := range records {
-		plans[i] = s.recordToPlan(r)
+	plans := make([]*primary.Plan, 0, len(records))
+	for _, r := range records {
+		plan, err := s.planWithStatus(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+		if filters.Status != "" && plan.Status != filters.Status {
+			continue
+		}
+		plans = append(plans, plan)
 	}
 	return plans, nil
 }
 
 // ApprovePlan approves a plan (draft -> approved).
 func (s *PlanServiceImpl) ApprovePlan(ctx context.Context, planID string) error {
+	if err := s.lockService.CheckUnlocked(ctx, planID); err != nil {
+		return err
+	}
+
 	plan, err := s.planRepo.GetByID(ctx, planID)
 	if err != nil {
 		return err
 	}
 
+	openConditions, err := s.openConditionCount(ctx, planID)
+	if err != nil {
+		return err
+	}
+
 	guardResult := plancore.CanApprovePlan(plancore.ApprovePlanContext{
-		PlanID:   planID,
-		Status:   plan.Status,
-		IsPinned: plan.Pinned,
+		PlanID:         planID,
+		Status:         plan.Status,
+		IsPinned:       plan.Pinned,
+		OpenConditions: openConditions,
 	})
 	if err := guardResult.Error(); err != nil {
 		return err
@@ -129,8 +158,133 @@ func (s *PlanServiceImpl) ApprovePlan(ctx context.Context, planID string) error
 	return s.planRepo.Approve(ctx, planID)
 }
 
+// ApprovePlanWithConditions approves a draft plan subject to required amendments.
+// The plan stays draft, reported as conditionally approved, until every
+// condition is addressed.
+func (s *PlanServiceImpl) ApprovePlanWithConditions(ctx context.Context, planID string, conditions []string) error {
+	if err := s.lockService.CheckUnlocked(ctx, planID); err != nil {
+		return err
+	}
+
+	plan, err := s.planRepo.GetByID(ctx, planID)
+	if err != nil {
+		return err
+	}
+
+	openConditions, err := s.openConditionCount(ctx, planID)
+	if err != nil {
+		return err
+	}
+
+	guardResult := plancore.CanApprovePlanWithConditions(plancore.ApproveWithConditionsContext{
+		ApprovePlanContext: plancore.ApprovePlanContext{
+			PlanID:         planID,
+			Status:         plan.Status,
+			IsPinned:       plan.Pinned,
+			OpenConditions: openConditions,
+		},
+		Conditions: conditions,
+	})
+	if err := guardResult.Error(); err != nil {
+		return err
+	}
+
+	// Number after any conditions a previous approval left, all addressed
+	existing, err := s.planRepo.ListConditions(ctx, planID)
+	if err != nil {
+		return err
+	}
+	approvedBy := ctxutil.ActorFromContext(ctx)
+	records := make([]*secondary.PlanConditionRecord, len(conditions))
+	for i, description := range conditions {
+		records[i] = &secondary.PlanConditionRecord{
+			PlanID:      planID,
+			Position:    len(existing) + i + 1,
+			Description: strings.TrimSpace(description),
+			ApprovedBy:  approvedBy,
+		}
+	}
+	return s.planRepo.CreateConditions(ctx, records)
+}
+
+// ListPlanConditions retrieves a plan's approval conditions in order.
+func (s *PlanServiceImpl) ListPlanConditions(ctx context.Context, planID string) ([]*primary.PlanCondition, error) {
+	if _, err := s.planRepo.GetByID(ctx, planID); err != nil {
+		return nil, err
+	}
+
+	records, err := s.planRepo.ListConditions(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	conditions := make([]*primary.PlanCondition, len(records))
+	for i, r := range records {
+		conditions[i] = &primary.PlanCondition{
+			Position:    r.Position,
+			Description: r.Description,
+			Addressed:   r.Status == "addressed",
+			Evidence:    r.Evidence,
+			ApprovedBy:  r.ApprovedBy,
+			CreatedAt:   r.CreatedAt,
+			AddressedBy: r.AddressedBy,
+			AddressedAt: r.AddressedAt,
+		}
+	}
+	return conditions, nil
+}
+
+// AddressPlanCondition marks a condition addressed with evidence, approving
+// the plan once no conditions remain open.
+func (s *PlanServiceImpl) AddressPlanCondition(ctx context.Context, planID string, position int, evidence string) (bool, error) {
+	plan, err := s.planRepo.GetByID(ctx, planID)
+	if err != nil {
+		return false, err
+	}
+
+	records, err := s.planRepo.ListConditions(ctx, planID)
+	if err != nil {
+		return false, err
+	}
+
+	addressed := false
+	if position >= 1 && position <= len(records) {
+		addressed = records[position-1].Status == "addressed"
+	}
+	guardResult := plancore.CanAddressCondition(plancore.AddressConditionContext{
+		PlanID:         planID,
+		Position:       position,
+		ConditionCount: len(records),
+		Addressed:      addressed,
+		Evidence:       evidence,
+	})
+	if err := guardResult.Error(); err != nil {
+		return false, err
+	}
+
+	if err := s.planRepo.AddressCondition(ctx, planID, position, strings.TrimSpace(evidence), ctxutil.ActorFromContext(ctx)); err != nil {
+		return false, err
+	}
+
+	openConditions, err := s.openConditionCount(ctx, planID)
+	if err != nil {
+		return false, err
+	}
+	if openConditions > 0 || plan.Status != "draft" {
+		return false, nil
+	}
+	if err := s.planRepo.Approve(ctx, planID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // UpdatePlan updates a plan's title, description, and/or content.
 func (s *PlanServiceImpl) UpdatePlan(ctx context.Context, req primary.UpdatePlanRequest) error {
+	if err := s.lockService.CheckUnlocked(ctx, req.PlanID); err != nil {
+		return err
+	}
+
 	record := &secondary.PlanRecord{
 		ID:          req.PlanID,
 		Title:       req.Title,
@@ -152,6 +306,9 @@ func (s *PlanServiceImpl) UnpinPlan(ctx context.Context, planID string) error {
 
 // DeletePlan deletes a plan.
 func (s *PlanServiceImpl) DeletePlan(ctx context.Context, planID string) error {
+	if err := s.lockService.CheckUnlocked(ctx, planID); err != nil {
+		return err
+	}
 	return s.planRepo.Delete(ctx, planID)
 }
 
@@ -164,11 +321,192 @@ func (s *PlanServiceImpl) GetTaskActivePlan(ctx context.Context, taskID string)
 	if record == nil {
 		return nil, nil // No active plan is not an error
 	}
-	return s.recordToPlan(record), nil
+	return s.planWithStatus(ctx, record)
+}
+
+// TrackPlan records an approved plan's steps and optionally generates tasks.
+// Steps are parsed from the plan content the first time; later calls reuse
+// the stored steps, so re-running with generateTasks only fills the gaps.
+func (s *PlanServiceImpl) TrackPlan(ctx context.Context, planID string, generateTasks bool) (*primary.PlanProgress, error) {
+	plan, err := s.planRepo.GetByID(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, err := s.planRepo.ListSteps(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed []string
+	stepCount := len(steps)
+	if stepCount == 0 {
+		parsed = plancore.ParseSteps(plan.Content)
+		stepCount = len(parsed)
+	}
+
+	guardResult := plancore.CanTrackPlan(plancore.TrackPlanContext{
+		PlanID:    planID,
+		Status:    plan.Status,
+		StepCount: stepCount,
+	})
+	if err := guardResult.Error(); err != nil {
+		return nil, err
+	}
+
+	if len(steps) == 0 {
+		records := make([]*secondary.PlanStepRecord, len(parsed))
+		for i, title := range parsed {
+			records[i] = &secondary.PlanStepRecord{PlanID: planID, Position: i + 1, Title: title}
+		}
+		if err := s.planRepo.CreateSteps(ctx, records); err != nil {
+			return nil, err
+		}
+		steps = records
+	}
+
+	var created []string
+	if generateTasks {
+		created, err = s.generateStepTasks(ctx, plan, steps)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	progress, err := s.GetPlanProgress(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	progress.CreatedTaskIDs = created
+	return progress, nil
+}
+
+// generateStepTasks creates a task for each unlinked step, in the plan's shipment.
+func (s *PlanServiceImpl) generateStepTasks(ctx context.Context, plan *secondary.PlanRecord, steps []*secondary.PlanStepRecord) ([]string, error) {
+	var shipmentID string
+	if plan.TaskID != "" {
+		parent, err := s.taskService.GetTask(ctx, plan.TaskID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get plan task: %w", err)
+		}
+		shipmentID = parent.ShipmentID
+	}
+
+	var created []string
+	for _, step := range steps {
+		if step.TaskID != "" {
+			continue
+		}
+		resp, err := s.taskService.CreateTask(ctx, primary.CreateTaskRequest{
+			ShipmentID:   shipmentID,
+			CommissionID: plan.CommissionID,
+			Title:        step.Title,
+			Description:  fmt.Sprintf("Step %d of %s: %s", step.Position, plan.ID, plan.Title),
+		})
+		if err != nil {
+			return created, fmt.Errorf("failed to create task for step %d: %w", step.Position, err)
+		}
+		if err := s.planRepo.SetStepTask(ctx, plan.ID, step.Position, resp.TaskID); err != nil {
+			return created, err
+		}
+		created = append(created, resp.TaskID)
+	}
+	return created, nil
+}
+
+// LinkPlanStep links a tracked plan step to an existing task.
+func (s *PlanServiceImpl) LinkPlanStep(ctx context.Context, planID string, position int, taskID string) error {
+	if _, err := s.planRepo.GetByID(ctx, planID); err != nil {
+		return err
+	}
+
+	steps, err := s.planRepo.ListSteps(ctx, planID)
+	if err != nil {
+		return err
+	}
+
+	taskExists, err := s.planRepo.TaskExists(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to validate task: %w", err)
+	}
+
+	guardResult := plancore.CanLinkPlanStep(plancore.LinkPlanStepContext{
+		PlanID:     planID,
+		Position:   position,
+		StepCount:  len(steps),
+		TaskID:     taskID,
+		TaskExists: taskExists,
+	})
+	if err := guardResult.Error(); err != nil {
+		return err
+	}
+
+	return s.planRepo.SetStepTask(ctx, planID, position, taskID)
+}
+
+// GetPlanProgress reports which of a tracked plan's steps have closed tasks.
+func (s *PlanServiceImpl) GetPlanProgress(ctx context.Context, planID string) (*primary.PlanProgress, error) {
+	if _, err := s.planRepo.GetByID(ctx, planID); err != nil {
+		return nil, err
+	}
+
+	records, err := s.planRepo.ListSteps(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("plan %s is not tracked. Track it first with: orc plan track %s", planID, planID)
+	}
+
+	progress := &primary.PlanProgress{PlanID: planID, Steps: make([]*primary.PlanStep, len(records))}
+	for i, r := range records {
+		complete := r.TaskStatus == "closed"
+		if complete {
+			progress.Completed++
+		}
+		progress.Steps[i] = &primary.PlanStep{
+			Position:   r.Position,
+			Title:      r.Title,
+			TaskID:     r.TaskID,
+			TaskStatus: r.TaskStatus,
+			Complete:   complete,
+		}
+	}
+	return progress, nil
 }
 
 // Helper methods
 
+// openConditionCount counts a plan's conditions that are not yet addressed.
+func (s *PlanServiceImpl) openConditionCount(ctx context.Context, planID string) (int, error) {
+	records, err := s.planRepo.ListConditions(ctx, planID)
+	if err != nil {
+		return 0, err
+	}
+	open := 0
+	for _, r := range records {
+		if r.Status == "open" {
+			open++
+		}
+	}
+	return open, nil
+}
+
+// planWithStatus converts a record to a Plan, reporting a draft plan with
+// open conditions as conditionally approved.
+func (s *PlanServiceImpl) planWithStatus(ctx context.Context, r *secondary.PlanRecord) (*primary.Plan, error) {
+	plan := s.recordToPlan(r)
+	if r.Status != "draft" {
+		return plan, nil
+	}
+	openConditions, err := s.openConditionCount(ctx, r.ID)
+	if err != nil {
+		return nil, err
+	}
+	plan.Status = plancore.EffectiveStatus(r.Status, openConditions)
+	return plan, nil
+}
+
 func (s *PlanServiceImpl) recordToPlan(r *secondary.PlanRecord) *primary.Plan {
 	return &primary.Plan{
 		ID:               r.ID,
@@ -3,8 +3,10 @@ package app
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
+	"github.com/example/orc/internal/ctxutil"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
 )
@@ -29,6 +31,9 @@ type mockPlanRepository struct {
 	hasActivePlanResult    bool
 	hasActivePlanErr       error
 	nextID                 string
+	steps                  []*secondary.PlanStepRecord
+	conditions             []*secondary.PlanConditionRecord
+	taskStatuses           map[string]string // Stands in for the tasks join in ListSteps
 }
 
 func newMockPlanRepository() *mockPlanRepository {
@@ -37,6 +42,7 @@ func newMockPlanRepository() *mockPlanRepository {
 		commissionExistsResult: true,
 		taskExistsResult:       true,
 		nextID:                 "PLAN-001",
+		taskStatuses:           make(map[string]string),
 	}
 }
 
@@ -170,16 +176,82 @@ func (m *mockPlanRepository) TaskExists(ctx context.Context, taskID string) (boo
 	return m.taskExistsResult, nil
 }
 
+func (m *mockPlanRepository) ListSteps(ctx context.Context, planID string) ([]*secondary.PlanStepRecord, error) {
+	var result []*secondary.PlanStepRecord
+	for _, step := range m.steps {
+		if step.PlanID == planID {
+			copied := *step
+			copied.TaskStatus = m.taskStatuses[step.TaskID]
+			result = append(result, &copied)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockPlanRepository) CreateSteps(ctx context.Context, steps []*secondary.PlanStepRecord) error {
+	m.steps = append(m.steps, steps...)
+	return nil
+}
+
+func (m *mockPlanRepository) SetStepTask(ctx context.Context, planID string, position int, taskID string) error {
+	for _, step := range m.steps {
+		if step.PlanID == planID && step.Position == position {
+			step.TaskID = taskID
+			return nil
+		}
+	}
+	return errors.New("step not found")
+}
+
+func (m *mockPlanRepository) ListConditions(ctx context.Context, planID string) ([]*secondary.PlanConditionRecord, error) {
+	var result []*secondary.PlanConditionRecord
+	for _, c := range m.conditions {
+		if c.PlanID == planID {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockPlanRepository) CreateConditions(ctx context.Context, conditions []*secondary.PlanConditionRecord) error {
+	for _, c := range conditions {
+		c.Status = "open"
+		m.conditions = append(m.conditions, c)
+	}
+	return nil
+}
+
+func (m *mockPlanRepository) AddressCondition(ctx context.Context, planID string, position int, evidence, addressedBy string) error {
+	for _, c := range m.conditions {
+		if c.PlanID == planID && c.Position == position && c.Status == "open" {
+			c.Status = "addressed"
+			c.Evidence = evidence
+			c.AddressedBy = addressedBy
+			return nil
+		}
+	}
+	return errors.New("condition not found")
+}
+
 // ============================================================================
 // Test Helper
 // ============================================================================
 
 func newTestPlanService() (*PlanServiceImpl, *mockPlanRepository) {
-	planRepo := newMockPlanRepository()
-	service := NewPlanService(planRepo)
+	service, planRepo, _ := newTestPlanServiceWithTasks()
 	return service, planRepo
 }
 
+// newTestPlanServiceWithTasks also returns the task repository behind the
+// plan service's TaskService, for tests that generate tasks.
+func newTestPlanServiceWithTasks() (*PlanServiceImpl, *mockPlanRepository, *mockTaskRepository) {
+	planRepo := newMockPlanRepository()
+	taskRepo := newMockTaskRepository()
+	taskService := NewTaskService(taskRepo, newMockTagRepositoryForTask(), nil, newMockTagRouteRepository())
+	service := NewPlanService(planRepo, taskService, NewLockService(newMockEntityLockRepository(), newMockShipmentRepository(), planRepo))
+	return service, planRepo, taskRepo
+}
+
 // ============================================================================
 // CreatePlan Tests
 // ============================================================================
@@ -434,6 +506,80 @@ func TestApprovePlan_NotFound(t *testing.T) {
 	}
 }
 
+func TestApprovePlanWithConditions(t *testing.T) {
+	service, planRepo := newTestPlanService()
+	ctx := ctxutil.WithActorID(context.Background(), "GOBLIN")
+	planRepo.plans["PLAN-001"] = &secondary.PlanRecord{ID: "PLAN-001", TaskID: "TASK-001", Status: "draft"}
+
+	if err := service.ApprovePlanWithConditions(ctx, "PLAN-001", nil); err == nil {
+		t.Error("expected approval without conditions to fail")
+	}
+	if err := service.ApprovePlanWithConditions(ctx, "PLAN-001", []string{"add a rollback step", " cover the empty cart "}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	plan, err := service.GetPlan(ctx, "PLAN-001")
+	if err != nil {
+		t.Fatalf("GetPlan failed: %v", err)
+	}
+	if plan.Status != "conditionally_approved" {
+		t.Errorf("expected status 'conditionally_approved', got %q", plan.Status)
+	}
+	conditions, err := service.ListPlanConditions(ctx, "PLAN-001")
+	if err != nil {
+		t.Fatalf("ListPlanConditions failed: %v", err)
+	}
+	if len(conditions) != 2 || conditions[1].Position != 2 || conditions[1].Description != "cover the empty cart" || conditions[0].ApprovedBy != "GOBLIN" {
+		t.Errorf("unexpected conditions: %+v, %+v", conditions[0], conditions[1])
+	}
+
+	if err := service.ApprovePlan(ctx, "PLAN-001"); err == nil || !strings.Contains(err.Error(), "conditionally approved") {
+		t.Errorf("expected plain approval to be refused, got %v", err)
+	}
+	plans, err := service.ListPlans(ctx, primary.PlanFilters{Status: "draft"})
+	if err != nil || len(plans) != 0 {
+		t.Errorf("expected no draft plans, got %d (%v)", len(plans), err)
+	}
+	plans, err = service.ListPlans(ctx, primary.PlanFilters{Status: "conditionally_approved"})
+	if err != nil || len(plans) != 1 {
+		t.Errorf("expected one conditionally approved plan, got %d (%v)", len(plans), err)
+	}
+}
+
+func TestAddressPlanCondition(t *testing.T) {
+	service, planRepo := newTestPlanService()
+	imp := ctxutil.WithActorID(context.Background(), "IMP-BENCH-001")
+	planRepo.plans["PLAN-001"] = &secondary.PlanRecord{ID: "PLAN-001", TaskID: "TASK-001", Status: "draft"}
+	if err := service.ApprovePlanWithConditions(imp, "PLAN-001", []string{"add a rollback step", "cover the empty cart"}); err != nil {
+		t.Fatalf("ApprovePlanWithConditions failed: %v", err)
+	}
+
+	if _, err := service.AddressPlanCondition(imp, "PLAN-001", 1, ""); err == nil {
+		t.Error("expected addressing without evidence to fail")
+	}
+	approved, err := service.AddressPlanCondition(imp, "PLAN-001", 1, "see ## Rollback")
+	if err != nil || approved {
+		t.Fatalf("AddressPlanCondition = %v, %v; want not yet approved", approved, err)
+	}
+	if _, err := service.AddressPlanCondition(imp, "PLAN-001", 1, "again"); err == nil {
+		t.Error("expected addressing twice to fail")
+	}
+	if planRepo.plans["PLAN-001"].Status != "draft" {
+		t.Errorf("expected plan to stay draft, got %q", planRepo.plans["PLAN-001"].Status)
+	}
+
+	approved, err = service.AddressPlanCondition(imp, "PLAN-001", 2, "TASK-001 checklist")
+	if err != nil || !approved {
+		t.Fatalf("AddressPlanCondition = %v, %v; want approved", approved, err)
+	}
+	if planRepo.plans["PLAN-001"].Status != "approved" {
+		t.Errorf("expected plan approved, got %q", planRepo.plans["PLAN-001"].Status)
+	}
+	if c := planRepo.conditions[1]; c.Evidence != "TASK-001 checklist" || c.AddressedBy != "IMP-BENCH-001" {
+		t.Errorf("unexpected addressed condition: %+v", c)
+	}
+}
+
 // ============================================================================
 // UpdatePlan Tests
 // ============================================================================
@@ -460,6 +606,30 @@ func TestUpdatePlan_Success(t *testing.T) {
 	}
 }
 
+func TestUpdatePlan_LockedByAnotherActor(t *testing.T) {
+	service, planRepo := newTestPlanService()
+
+	planRepo.plans["PLAN-001"] = &secondary.PlanRecord{
+		ID:    "PLAN-001",
+		Title: "Old Title",
+	}
+	if _, err := service.lockService.AcquireLock(actorCtx("GOBLIN"), primary.AcquireLockRequest{EntityID: "PLAN-001"}); err != nil {
+		t.Fatalf("AcquireLock failed: %v", err)
+	}
+
+	err := service.UpdatePlan(actorCtx("IMP-BENCH-001"), primary.UpdatePlanRequest{
+		PlanID: "PLAN-001",
+		Title:  "New Title",
+	})
+
+	if err == nil || !strings.Contains(err.Error(), "locked by GOBLIN") {
+		t.Fatalf("expected locked error, got %v", err)
+	}
+	if planRepo.plans["PLAN-001"].Title != "Old Title" {
+		t.Errorf("expected title unchanged, got '%s'", planRepo.plans["PLAN-001"].Title)
+	}
+}
+
 // ============================================================================
 // Pin/Unpin Tests
 // ============================================================================
@@ -567,3 +737,153 @@ func TestGetTaskActivePlan_NoneFound(t *testing.T) {
 		t.Error("expected nil plan when no active plan exists")
 	}
 }
+
+// ============================================================================
+// Plan Tracking Tests
+// ============================================================================
+
+const trackedPlanContent = `## Approach
+
+1. Add schema
+2. Write repository
+3. Wire CLI
+`
+
+func TestTrackPlan_GenerateTasks(t *testing.T) {
+	service, planRepo, taskRepo := newTestPlanServiceWithTasks()
+	ctx := context.Background()
+
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", ShipmentID: "SHIP-001", CommissionID: "COMM-001", Status: "in-progress"}
+	planRepo.plans["PLAN-001"] = &secondary.PlanRecord{
+		ID: "PLAN-001", TaskID: "TASK-001", CommissionID: "COMM-001", Title: "Tracking", Status: "approved", Content: trackedPlanContent,
+	}
+
+	progress, err := service.TrackPlan(ctx, "PLAN-001", true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(progress.Steps) != 3 {
+		t.Fatalf("expected 3 steps, got %d", len(progress.Steps))
+	}
+	if len(progress.CreatedTaskIDs) != 3 {
+		t.Fatalf("expected 3 created tasks, got %v", progress.CreatedTaskIDs)
+	}
+
+	for i, step := range progress.Steps {
+		if step.TaskID != progress.CreatedTaskIDs[i] {
+			t.Errorf("step %d linked to %q, want %q", step.Position, step.TaskID, progress.CreatedTaskIDs[i])
+		}
+		task := taskRepo.tasks[step.TaskID]
+		if task == nil {
+			t.Fatalf("expected task %s to exist", step.TaskID)
+		}
+		if task.Title != step.Title || task.ShipmentID != "SHIP-001" {
+			t.Errorf("generated task = %+v, want title %q in SHIP-001", task, step.Title)
+		}
+	}
+
+	// Re-tracking keeps the stored steps and creates nothing new
+	progress, err = service.TrackPlan(ctx, "PLAN-001", true)
+	if err != nil {
+		t.Fatalf("expected no error on re-track, got %v", err)
+	}
+	if len(progress.CreatedTaskIDs) != 0 {
+		t.Errorf("expected no new tasks on re-track, got %v", progress.CreatedTaskIDs)
+	}
+	if len(planRepo.steps) != 3 {
+		t.Errorf("expected 3 stored steps, got %d", len(planRepo.steps))
+	}
+}
+
+func TestTrackPlan_WithoutTasks(t *testing.T) {
+	service, planRepo, taskRepo := newTestPlanServiceWithTasks()
+	ctx := context.Background()
+
+	planRepo.plans["PLAN-001"] = &secondary.PlanRecord{ID: "PLAN-001", CommissionID: "COMM-001", Status: "approved", Content: trackedPlanContent}
+
+	progress, err := service.TrackPlan(ctx, "PLAN-001", false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(progress.Steps) != 3 || progress.Steps[0].TaskID != "" {
+		t.Errorf("expected 3 unlinked steps, got %+v", progress.Steps)
+	}
+	if len(taskRepo.tasks) != 0 {
+		t.Errorf("expected no tasks created, got %d", len(taskRepo.tasks))
+	}
+}
+
+func TestTrackPlan_DraftBlocked(t *testing.T) {
+	service, planRepo := newTestPlanService()
+	ctx := context.Background()
+
+	planRepo.plans["PLAN-001"] = &secondary.PlanRecord{ID: "PLAN-001", Status: "draft", Content: trackedPlanContent}
+
+	if _, err := service.TrackPlan(ctx, "PLAN-001", false); err == nil {
+		t.Fatal("expected error tracking a draft plan")
+	}
+	if len(planRepo.steps) != 0 {
+		t.Error("expected no steps stored")
+	}
+}
+
+func TestLinkPlanStep(t *testing.T) {
+	service, planRepo := newTestPlanService()
+	ctx := context.Background()
+
+	planRepo.plans["PLAN-001"] = &secondary.PlanRecord{ID: "PLAN-001", Status: "approved"}
+	planRepo.steps = []*secondary.PlanStepRecord{
+		{PlanID: "PLAN-001", Position: 1, Title: "Add schema"},
+		{PlanID: "PLAN-001", Position: 2, Title: "Wire CLI"},
+	}
+
+	if err := service.LinkPlanStep(ctx, "PLAN-001", 2, "TASK-007"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if planRepo.steps[1].TaskID != "TASK-007" {
+		t.Errorf("expected step 2 linked to TASK-007, got %q", planRepo.steps[1].TaskID)
+	}
+
+	if err := service.LinkPlanStep(ctx, "PLAN-001", 3, "TASK-007"); err == nil {
+		t.Error("expected error for out of range step")
+	}
+
+	planRepo.taskExistsResult = false
+	if err := service.LinkPlanStep(ctx, "PLAN-001", 1, "TASK-999"); err == nil {
+		t.Error("expected error for missing task")
+	}
+}
+
+func TestGetPlanProgress(t *testing.T) {
+	service, planRepo := newTestPlanService()
+	ctx := context.Background()
+
+	planRepo.plans["PLAN-001"] = &secondary.PlanRecord{ID: "PLAN-001", Status: "approved"}
+	planRepo.steps = []*secondary.PlanStepRecord{
+		{PlanID: "PLAN-001", Position: 1, Title: "Add schema", TaskID: "TASK-001"},
+		{PlanID: "PLAN-001", Position: 2, Title: "Write repository", TaskID: "TASK-002"},
+		{PlanID: "PLAN-001", Position: 3, Title: "Wire CLI"},
+	}
+	planRepo.taskStatuses["TASK-001"] = "closed"
+	planRepo.taskStatuses["TASK-002"] = "in-progress"
+
+	progress, err := service.GetPlanProgress(ctx, "PLAN-001")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if progress.Completed != 1 || len(progress.Steps) != 3 {
+		t.Errorf("expected 1/3 complete, got %d/%d", progress.Completed, len(progress.Steps))
+	}
+	if !progress.Steps[0].Complete || progress.Steps[1].Complete {
+		t.Errorf("unexpected completion flags: %+v %+v", progress.Steps[0], progress.Steps[1])
+	}
+}
+
+func TestGetPlanProgress_NotTracked(t *testing.T) {
+	service, planRepo := newTestPlanService()
+	planRepo.plans["PLAN-001"] = &secondary.PlanRecord{ID: "PLAN-001", Status: "approved"}
+
+	if _, err := service.GetPlanProgress(context.Background(), "PLAN-001"); err == nil {
+		t.Fatal("expected error for untracked plan")
+	}
+}
@@ -0,0 +1,167 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	corepr "github.com/example/orc/internal/core/pr"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// PRDescriptionServiceImpl implements the PRDescriptionService interface.
+type PRDescriptionServiceImpl struct {
+	prRepo            secondary.PRRepository
+	bodyStore         secondary.PRBodyStore
+	shipmentService   primary.ShipmentService
+	planService       primary.PlanService
+	noteService       primary.NoteService
+	commitLinkService primary.CommitLinkService
+}
+
+// NewPRDescriptionService creates a new PRDescriptionService with injected dependencies.
+func NewPRDescriptionService(
+	prRepo secondary.PRRepository,
+	bodyStore secondary.PRBodyStore,
+	shipmentService primary.ShipmentService,
+	planService primary.PlanService,
+	noteService primary.NoteService,
+	commitLinkService primary.CommitLinkService,
+) *PRDescriptionServiceImpl {
+	return &PRDescriptionServiceImpl{
+		prRepo:            prRepo,
+		bodyStore:         bodyStore,
+		shipmentService:   shipmentService,
+		planService:       planService,
+		noteService:       noteService,
+		commitLinkService: commitLinkService,
+	}
+}
+
+// DescribePR renders the PR's description without writing it.
+func (s *PRDescriptionServiceImpl) DescribePR(ctx context.Context, prID string) (*primary.PRDescription, error) {
+	_, desc, err := s.describe(ctx, prID)
+	return desc, err
+}
+
+// ApplyDescription renders the PR's description and writes it to GitHub,
+// when linked, and to the ledger. An unchanged description is not written.
+func (s *PRDescriptionServiceImpl) ApplyDescription(ctx context.Context, prID string) (*primary.PRDescription, error) {
+	pr, desc, err := s.describe(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	if !desc.Changed {
+		return desc, nil
+	}
+
+	if desc.Source == "github" {
+		repo, number, err := corepr.ParseGitHubPRURL(pr.URL)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.bodyStore.UpdateBody(ctx, repo, number, desc.Body); err != nil {
+			return nil, fmt.Errorf("failed to update PR on GitHub: %w", err)
+		}
+	}
+	if err := s.prRepo.Update(ctx, &secondary.PRRecord{ID: prID, Description: desc.Body}); err != nil {
+		return nil, fmt.Errorf("failed to update PR: %w", err)
+	}
+	return desc, nil
+}
+
+// describe loads the PR and its current description, and renders the new one.
+// A PR with a GitHub URL is described against its GitHub body, so edits made
+// there survive; otherwise against the ledger's description.
+func (s *PRDescriptionServiceImpl) describe(ctx context.Context, prID string) (*secondary.PRRecord, *primary.PRDescription, error) {
+	pr, err := s.prRepo.GetByID(ctx, prID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("PR not found: %w", err)
+	}
+
+	current, source := pr.Description, "ledger"
+	if pr.URL != "" {
+		repo, number, err := corepr.ParseGitHubPRURL(pr.URL)
+		if err != nil {
+			return nil, nil, err
+		}
+		current, err = s.bodyStore.FetchBody(ctx, repo, number)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to fetch PR from GitHub: %w", err)
+		}
+		source = "github"
+	}
+
+	input, err := s.gatherInput(ctx, pr.ShipmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	block := corepr.RenderDescription(*input)
+	body := corepr.MergeDescription(current, block)
+
+	return pr, &primary.PRDescription{
+		PRID:      prID,
+		Body:      body,
+		Generated: block,
+		Source:    source,
+		Changed:   body != current,
+	}, nil
+}
+
+// gatherInput collects what the description is generated from: the shipment's
+// charter (or description), the approved plans and linked commits of its
+// closed tasks, and its notes other than session handoffs.
+func (s *PRDescriptionServiceImpl) gatherInput(ctx context.Context, shipmentID string) (*corepr.DescriptionInput, error) {
+	shipment, err := s.shipmentService.GetShipment(ctx, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("shipment not found: %w", err)
+	}
+	input := &corepr.DescriptionInput{ShipmentID: shipment.ID, Summary: shipment.Charter}
+	if input.Summary == "" {
+		input.Summary = shipment.Description
+	}
+
+	tasks, err := s.shipmentService.GetShipmentTasks(ctx, shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shipment tasks: %w", err)
+	}
+	for _, task := range tasks {
+		if task.Status != "closed" {
+			continue
+		}
+
+		plans, err := s.planService.ListPlans(ctx, primary.PlanFilters{TaskID: task.ID, Status: "approved"})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list plans for %s: %w", task.ID, err)
+		}
+		for _, p := range plans {
+			input.Plans = append(input.Plans, corepr.DescribedPlan{ID: p.ID, TaskID: p.TaskID, Title: p.Title, Description: p.Description})
+		}
+
+		commits, err := s.commitLinkService.GetEntityCommits(ctx, task.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get commits for %s: %w", task.ID, err)
+		}
+		described := corepr.DescribedTask{ID: task.ID, Title: task.Title}
+		for i := len(commits) - 1; i >= 0; i-- { // Oldest first
+			described.Commits = append(described.Commits, shortSHA(commits[i].CommitSHA))
+		}
+		input.Tasks = append(input.Tasks, described)
+	}
+
+	notes, err := s.noteService.GetNotesByContainer(ctx, "shipment", shipmentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shipment notes: %w", err)
+	}
+	for _, n := range notes {
+		if n.Type == primary.NoteTypeHandoff {
+			continue
+		}
+		input.Notes = append(input.Notes, corepr.DescribedNote{ID: n.ID, Type: n.Type, Title: n.Title})
+	}
+
+	return input, nil
+}
+
+// Ensure PRDescriptionServiceImpl implements the interface
+var _ primary.PRDescriptionService = (*PRDescriptionServiceImpl)(nil)
@@ -0,0 +1,183 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corepr "github.com/example/orc/internal/core/pr"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// mockPRBodyStore implements secondary.PRBodyStore for testing.
+type mockPRBodyStore struct {
+	body    string
+	updates int
+}
+
+func (m *mockPRBodyStore) FetchBody(ctx context.Context, repo string, number int) (string, error) {
+	return m.body, nil
+}
+
+func (m *mockPRBodyStore) UpdateBody(ctx context.Context, repo string, number int, body string) error {
+	m.body = body
+	m.updates++
+	return nil
+}
+
+// mockShipmentReader serves GetShipment and GetShipmentTasks; other
+// ShipmentService methods are unused.
+type mockShipmentReader struct {
+	primary.ShipmentService
+	shipment *primary.Shipment
+	tasks    []*primary.Task
+}
+
+func (m *mockShipmentReader) GetShipment(ctx context.Context, shipmentID string) (*primary.Shipment, error) {
+	return m.shipment, nil
+}
+
+func (m *mockShipmentReader) GetShipmentTasks(ctx context.Context, shipmentID string) ([]*primary.Task, error) {
+	return m.tasks, nil
+}
+
+// mockPlanLister serves ListPlans; other PlanService methods are unused.
+type mockPlanLister struct {
+	primary.PlanService
+	plans []*primary.Plan
+}
+
+func (m *mockPlanLister) ListPlans(ctx context.Context, filters primary.PlanFilters) ([]*primary.Plan, error) {
+	var result []*primary.Plan
+	for _, p := range m.plans {
+		if p.TaskID == filters.TaskID && (filters.Status == "" || p.Status == filters.Status) {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
+
+// mockContainerNotes serves GetNotesByContainer; other NoteService methods are unused.
+type mockContainerNotes struct {
+	primary.NoteService
+	notes []*primary.Note
+}
+
+func (m *mockContainerNotes) GetNotesByContainer(ctx context.Context, containerType, containerID string) ([]*primary.Note, error) {
+	return m.notes, nil
+}
+
+// mockEntityCommits serves GetEntityCommits; other CommitLinkService methods are unused.
+type mockEntityCommits struct {
+	primary.CommitLinkService
+	commits map[string][]*primary.CommitLink
+}
+
+func (m *mockEntityCommits) GetEntityCommits(ctx context.Context, entityID string) ([]*primary.CommitLink, error) {
+	return m.commits[entityID], nil
+}
+
+func newPRDescriptionTestService() (*PRDescriptionServiceImpl, *mockPRRepository, *mockPRBodyStore) {
+	prRepo := newMockPRRepository()
+	prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", URL: "https://github.com/acme/app/pull/12"}
+	prRepo.prs["PR-002"] = &secondary.PRRecord{ID: "PR-002", ShipmentID: "SHIP-001", Description: "Hand-written context"}
+
+	shipments := &mockShipmentReader{
+		shipment: &primary.Shipment{ID: "SHIP-001", Description: "Short", Charter: "Add login so users can sign in"},
+		tasks: []*primary.Task{
+			{ID: "TASK-001", Title: "Build form", Status: "closed"},
+			{ID: "TASK-002", Title: "Polish styles", Status: "open"},
+		},
+	}
+	plans := &mockPlanLister{plans: []*primary.Plan{
+		{ID: "PLAN-001", TaskID: "TASK-001", Title: "Form plan", Status: "approved"},
+		{ID: "PLAN-002", TaskID: "TASK-001", Title: "Abandoned draft", Status: "draft"},
+		{ID: "PLAN-003", TaskID: "TASK-002", Title: "Styles plan", Status: "approved"},
+	}}
+	notes := &mockContainerNotes{notes: []*primary.Note{
+		{ID: "NOTE-001", Type: "decision", Title: "Use bcrypt"},
+		{ID: "NOTE-002", Type: primary.NoteTypeHandoff, Title: "Session handoff"},
+	}}
+	commits := &mockEntityCommits{commits: map[string][]*primary.CommitLink{
+		"TASK-001": {{CommitSHA: "bbbbbbb2222"}, {CommitSHA: "aaaaaaa1111"}}, // Newest first
+	}}
+
+	body := &mockPRBodyStore{}
+	return NewPRDescriptionService(prRepo, body, shipments, plans, notes, commits), prRepo, body
+}
+
+func TestPRDescriptionService_DescribePR(t *testing.T) {
+	service, _, body := newPRDescriptionTestService()
+
+	desc, err := service.DescribePR(context.Background(), "PR-001")
+	if err != nil {
+		t.Fatalf("DescribePR failed: %v", err)
+	}
+	if desc.Source != "github" || !desc.Changed {
+		t.Errorf("Source = %q, Changed = %v, want github, true", desc.Source, desc.Changed)
+	}
+	for _, want := range []string{
+		"Add login so users can sign in",
+		"PLAN-001",
+		"- TASK-001 Build form (aaaaaaa, bbbbbbb)",
+		"NOTE-001",
+	} {
+		if !strings.Contains(desc.Body, want) {
+			t.Errorf("body missing %q:\n%s", want, desc.Body)
+		}
+	}
+	for _, unwanted := range []string{"PLAN-002", "TASK-002", "PLAN-003", "NOTE-002"} {
+		if strings.Contains(desc.Body, unwanted) {
+			t.Errorf("body should not mention %s:\n%s", unwanted, desc.Body)
+		}
+	}
+	if body.updates != 0 {
+		t.Errorf("DescribePR wrote to GitHub %d times", body.updates)
+	}
+}
+
+func TestPRDescriptionService_ApplyDescription_PreservesManualEdits(t *testing.T) {
+	service, prRepo, body := newPRDescriptionTestService()
+	ctx := context.Background()
+	body.body = "Reviewer notes\n\n" + corepr.GeneratedStart + "\nstale\n" + corepr.GeneratedEnd + "\n\nTesting: manual"
+
+	desc, err := service.ApplyDescription(ctx, "PR-001")
+	if err != nil {
+		t.Fatalf("ApplyDescription failed: %v", err)
+	}
+	if body.updates != 1 || body.body != desc.Body {
+		t.Fatalf("expected one GitHub update with the new body, got %d", body.updates)
+	}
+	if !strings.HasPrefix(desc.Body, "Reviewer notes\n\n") || !strings.HasSuffix(desc.Body, "\n\nTesting: manual") || strings.Contains(desc.Body, "stale") {
+		t.Errorf("manual edits not preserved around the block:\n%s", desc.Body)
+	}
+	if prRepo.prs["PR-001"].Description != desc.Body {
+		t.Error("ledger description not updated")
+	}
+
+	// Regenerating an unchanged description writes nothing
+	desc, err = service.ApplyDescription(ctx, "PR-001")
+	if err != nil {
+		t.Fatalf("second ApplyDescription failed: %v", err)
+	}
+	if desc.Changed || body.updates != 1 {
+		t.Errorf("Changed = %v, updates = %d, want false, 1", desc.Changed, body.updates)
+	}
+}
+
+func TestPRDescriptionService_ApplyDescription_Ledger(t *testing.T) {
+	service, prRepo, body := newPRDescriptionTestService()
+
+	desc, err := service.ApplyDescription(context.Background(), "PR-002")
+	if err != nil {
+		t.Fatalf("ApplyDescription failed: %v", err)
+	}
+	if desc.Source != "ledger" || body.updates != 0 {
+		t.Errorf("Source = %q, GitHub updates = %d, want ledger, 0", desc.Source, body.updates)
+	}
+	got := prRepo.prs["PR-002"].Description
+	if !strings.HasPrefix(got, corepr.GeneratedStart) || !strings.HasSuffix(got, "\n\nHand-written context") {
+		t.Errorf("unexpected ledger description:\n%s", got)
+	}
+}
@@ -0,0 +1,179 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	corepr "github.com/example/orc/internal/core/pr"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// PRReviewServiceImpl implements the PRReviewService interface.
+type PRReviewServiceImpl struct {
+	prRepo       secondary.PRRepository
+	reviewRepo   secondary.PRReviewRepository
+	reviewSource secondary.PRReviewSource
+	taskService  primary.TaskService
+}
+
+// NewPRReviewService creates a new PRReviewService with injected dependencies.
+func NewPRReviewService(
+	prRepo secondary.PRRepository,
+	reviewRepo secondary.PRReviewRepository,
+	reviewSource secondary.PRReviewSource,
+	taskService primary.TaskService,
+) *PRReviewServiceImpl {
+	return &PRReviewServiceImpl{
+		prRepo:       prRepo,
+		reviewRepo:   reviewRepo,
+		reviewSource: reviewSource,
+		taskService:  taskService,
+	}
+}
+
+// SyncReviews fetches, stores, and turns requested changes into tasks.
+// A change gets no task if its item already has one, or if an earlier item
+// with the same text on the same file does (reviewers often repeat themselves).
+func (s *PRReviewServiceImpl) SyncReviews(ctx context.Context, prID string) (*primary.SyncReviewsResult, error) {
+	pr, err := s.prRepo.GetByID(ctx, prID)
+	if err != nil {
+		return nil, fmt.Errorf("PR not found: %w", err)
+	}
+	if pr.URL == "" {
+		return nil, fmt.Errorf("%s has no GitHub URL", prID)
+	}
+	repo, number, err := corepr.ParseGitHubPRURL(pr.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	fetched, err := s.reviewSource.FetchReviews(ctx, repo, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch reviews: %w", err)
+	}
+
+	result := &primary.SyncReviewsResult{PRID: prID, Fetched: len(fetched)}
+	for _, f := range fetched {
+		created, err := s.reviewRepo.Save(ctx, &secondary.PRReviewRecord{
+			PRID:             prID,
+			ExternalID:       f.ExternalID,
+			Kind:             f.Kind,
+			ReviewExternalID: f.ReviewExternalID,
+			InReplyTo:        f.InReplyTo,
+			Author:           f.Author,
+			State:            f.State,
+			Body:             f.Body,
+			Path:             f.Path,
+			Line:             f.Line,
+			URL:              f.URL,
+			SubmittedAt:      f.SubmittedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if created {
+			result.New++
+		}
+	}
+
+	records, err := s.reviewRepo.ListByPR(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]corepr.ReviewItem, len(records))
+	byID := make(map[string]*secondary.PRReviewRecord, len(records))
+	covered := make(map[string]bool)
+	for i, r := range records {
+		items[i] = recordToReviewItem(r)
+		byID[r.ExternalID] = r
+		if r.TaskID != "" {
+			covered[corepr.ReviewDedupKey(items[i])] = true
+		}
+	}
+
+	for _, change := range corepr.RequestedChanges(items) {
+		key := corepr.ReviewDedupKey(change)
+		if change.TaskID != "" || covered[key] {
+			continue
+		}
+
+		record := byID[change.ExternalID]
+		resp, err := s.taskService.CreateTask(ctx, primary.CreateTaskRequest{
+			ShipmentID:   pr.ShipmentID,
+			CommissionID: pr.CommissionID,
+			Title:        corepr.ReviewTaskTitle(change),
+			Description:  reviewTaskDescription(prID, record),
+			Type:         "fix",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create task for %s: %w", change.ExternalID, err)
+		}
+		if err := s.reviewRepo.SetTask(ctx, prID, change.ExternalID, resp.TaskID); err != nil {
+			return nil, err
+		}
+		covered[key] = true
+		result.CreatedTasks = append(result.CreatedTasks, resp.TaskID)
+	}
+
+	return result, nil
+}
+
+// ListReviews retrieves the stored reviews and comments of a PR.
+func (s *PRReviewServiceImpl) ListReviews(ctx context.Context, prID string) ([]*primary.PRReview, error) {
+	if _, err := s.prRepo.GetByID(ctx, prID); err != nil {
+		return nil, fmt.Errorf("PR not found: %w", err)
+	}
+	records, err := s.reviewRepo.ListByPR(ctx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews := make([]*primary.PRReview, len(records))
+	for i, r := range records {
+		reviews[i] = &primary.PRReview{
+			ExternalID:       r.ExternalID,
+			Kind:             r.Kind,
+			ReviewExternalID: r.ReviewExternalID,
+			InReplyTo:        r.InReplyTo,
+			Author:           r.Author,
+			State:            r.State,
+			Body:             r.Body,
+			Path:             r.Path,
+			Line:             r.Line,
+			URL:              r.URL,
+			SubmittedAt:      r.SubmittedAt,
+			TaskID:           r.TaskID,
+		}
+	}
+	return reviews, nil
+}
+
+func recordToReviewItem(r *secondary.PRReviewRecord) corepr.ReviewItem {
+	return corepr.ReviewItem{
+		ExternalID:       r.ExternalID,
+		Kind:             r.Kind,
+		ReviewExternalID: r.ReviewExternalID,
+		InReplyTo:        r.InReplyTo,
+		State:            r.State,
+		Body:             r.Body,
+		Path:             r.Path,
+		Line:             r.Line,
+		TaskID:           r.TaskID,
+	}
+}
+
+// reviewTaskDescription records where a review-generated task came from.
+func reviewTaskDescription(prID string, r *secondary.PRReviewRecord) string {
+	desc := fmt.Sprintf("Requested in review of %s", prID)
+	if r.Author != "" {
+		desc += " by @" + r.Author
+	}
+	if r.URL != "" {
+		desc += "\n" + r.URL
+	}
+	return desc + "\n\n" + r.Body
+}
+
+// Ensure PRReviewServiceImpl implements the interface
+var _ primary.PRReviewService = (*PRReviewServiceImpl)(nil)
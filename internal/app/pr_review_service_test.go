@@ -0,0 +1,142 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// mockPRReviewRepository implements secondary.PRReviewRepository for testing.
+type mockPRReviewRepository struct {
+	reviews []*secondary.PRReviewRecord
+}
+
+func (m *mockPRReviewRepository) Save(ctx context.Context, review *secondary.PRReviewRecord) (bool, error) {
+	for _, r := range m.reviews {
+		if r.PRID == review.PRID && r.ExternalID == review.ExternalID {
+			r.State, r.Body = review.State, review.Body
+			return false, nil
+		}
+	}
+	copied := *review
+	m.reviews = append(m.reviews, &copied)
+	return true, nil
+}
+
+func (m *mockPRReviewRepository) ListByPR(ctx context.Context, prID string) ([]*secondary.PRReviewRecord, error) {
+	var result []*secondary.PRReviewRecord
+	for _, r := range m.reviews {
+		if r.PRID == prID {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockPRReviewRepository) SetTask(ctx context.Context, prID, externalID, taskID string) error {
+	for _, r := range m.reviews {
+		if r.PRID == prID && r.ExternalID == externalID {
+			r.TaskID = taskID
+			return nil
+		}
+	}
+	return fmt.Errorf("review %s not found", externalID)
+}
+
+// mockPRReviewSource implements secondary.PRReviewSource for testing.
+type mockPRReviewSource struct {
+	reviews []*secondary.FetchedReview
+	repo    string
+	number  int
+}
+
+func (m *mockPRReviewSource) FetchReviews(ctx context.Context, repo string, number int) ([]*secondary.FetchedReview, error) {
+	m.repo, m.number = repo, number
+	return m.reviews, nil
+}
+
+// mockTaskCreator records CreateTask calls; other TaskService methods are unused.
+type mockTaskCreator struct {
+	primary.TaskService
+	created []primary.CreateTaskRequest
+}
+
+func (m *mockTaskCreator) CreateTask(ctx context.Context, req primary.CreateTaskRequest) (*primary.CreateTaskResponse, error) {
+	m.created = append(m.created, req)
+	id := fmt.Sprintf("TASK-%03d", len(m.created))
+	return &primary.CreateTaskResponse{TaskID: id, Task: &primary.Task{ID: id, Title: req.Title}}, nil
+}
+
+func newPRReviewTestService() (*PRReviewServiceImpl, *mockPRReviewSource, *mockTaskCreator) {
+	prRepo := newMockPRRepository()
+	prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", CommissionID: "COMM-001", URL: "https://github.com/acme/app/pull/12"}
+	prRepo.prs["PR-002"] = &secondary.PRRecord{ID: "PR-002", ShipmentID: "SHIP-002", CommissionID: "COMM-001"}
+
+	source := &mockPRReviewSource{}
+	tasks := &mockTaskCreator{}
+	return NewPRReviewService(prRepo, &mockPRReviewRepository{}, source, tasks), source, tasks
+}
+
+func TestPRReviewService_SyncReviews(t *testing.T) {
+	service, source, tasks := newPRReviewTestService()
+	ctx := context.Background()
+
+	source.reviews = []*secondary.FetchedReview{
+		{ExternalID: "review:1", Kind: "review", Author: "ann", State: "APPROVED"},
+		{ExternalID: "review:2", Kind: "review", Author: "bob", State: "CHANGES_REQUESTED", Body: "Needs tests", URL: "https://github.com/acme/app/pull/12#r2"},
+		{ExternalID: "review:3", Kind: "review", Author: "cat", State: "CHANGES_REQUESTED", Body: "needs  tests"},
+		{ExternalID: "comment:10", Kind: "comment", ReviewExternalID: "review:2", Author: "bob", Path: "a.go", Line: 4, Body: "Handle nil"},
+	}
+
+	result, err := service.SyncReviews(ctx, "PR-001")
+	if err != nil {
+		t.Fatalf("SyncReviews failed: %v", err)
+	}
+	if source.repo != "acme/app" || source.number != 12 {
+		t.Errorf("fetched %s#%d, want acme/app#12", source.repo, source.number)
+	}
+	if result.Fetched != 4 || result.New != 4 {
+		t.Errorf("Fetched = %d, New = %d, want 4, 4", result.Fetched, result.New)
+	}
+	// review:3 repeats review:2 and is deduplicated
+	if got := strings.Join(result.CreatedTasks, ","); got != "TASK-001,TASK-002" {
+		t.Errorf("CreatedTasks = %q, want TASK-001,TASK-002", got)
+	}
+	first := tasks.created[0]
+	if first.ShipmentID != "SHIP-001" || first.CommissionID != "COMM-001" || first.Title != "Review: Needs tests" {
+		t.Errorf("unexpected task request: %+v", first)
+	}
+	if !strings.Contains(first.Description, "by @bob") || !strings.Contains(first.Description, "#r2") {
+		t.Errorf("expected provenance in description, got %q", first.Description)
+	}
+
+	// Syncing again creates nothing new
+	result, err = service.SyncReviews(ctx, "PR-001")
+	if err != nil {
+		t.Fatalf("second SyncReviews failed: %v", err)
+	}
+	if result.New != 0 || len(result.CreatedTasks) != 0 {
+		t.Errorf("second sync: New = %d, CreatedTasks = %v, want none", result.New, result.CreatedTasks)
+	}
+
+	reviews, err := service.ListReviews(ctx, "PR-001")
+	if err != nil {
+		t.Fatalf("ListReviews failed: %v", err)
+	}
+	if len(reviews) != 4 || reviews[3].TaskID != "TASK-002" {
+		t.Errorf("unexpected reviews: %+v", reviews)
+	}
+}
+
+func TestPRReviewService_SyncReviews_NoURL(t *testing.T) {
+	service, _, _ := newPRReviewTestService()
+
+	_, err := service.SyncReviews(context.Background(), "PR-002")
+	if err == nil || !strings.Contains(err.Error(), "no GitHub URL") {
+		t.Errorf("expected no-URL error, got %v", err)
+	}
+}
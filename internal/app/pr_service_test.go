@@ -218,6 +218,18 @@ func (m *mockShipmentServiceForPR) SetStatus(ctx context.Context, shipmentID, st
 	return nil
 }
 
+func (m *mockShipmentServiceForPR) SetShipmentCharter(ctx context.Context, shipmentID, charter string) error {
+	return nil
+}
+
+func (m *mockShipmentServiceForPR) StatusTransitions() []primary.StatusTransition {
+	return nil
+}
+
+func (m *mockShipmentServiceForPR) GetShipmentDAG(ctx context.Context, commissionID string) (*primary.ShipmentDAG, error) {
+	return nil, nil
+}
+
 func TestPRService_CreatePR(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,74 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corepresence "github.com/example/orc/internal/core/presence"
+	"github.com/example/orc/internal/ctxutil"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// PresenceServiceImpl implements the PresenceService interface.
+type PresenceServiceImpl struct {
+	presenceRepo secondary.PresenceRepository
+	now          func() time.Time
+}
+
+// NewPresenceService creates a new PresenceService with injected dependencies.
+func NewPresenceService(presenceRepo secondary.PresenceRepository) *PresenceServiceImpl {
+	return &PresenceServiceImpl{
+		presenceRepo: presenceRepo,
+		now:          time.Now,
+	}
+}
+
+// RecordPresence notes that the current actor viewed or edited an entity.
+// Commands run without an actor are not recorded.
+func (s *PresenceServiceImpl) RecordPresence(ctx context.Context, entityID, action string) error {
+	actorID := ctxutil.ActorFromContext(ctx)
+	if actorID == "" {
+		return nil
+	}
+	if action != corepresence.ActionView && action != corepresence.ActionEdit {
+		return fmt.Errorf("unknown presence action %q", action)
+	}
+	return s.presenceRepo.Touch(ctx, &secondary.PresenceRecord{
+		EntityID: entityID,
+		ActorID:  actorID,
+		Action:   action,
+		SeenAt:   s.now().UTC().Format(time.RFC3339),
+	})
+}
+
+// ListActive retrieves other actors seen recently, newest first.
+func (s *PresenceServiceImpl) ListActive(ctx context.Context, entityID string) ([]*primary.EntityPresence, error) {
+	now := s.now()
+	records, err := s.presenceRepo.ListSince(ctx, entityID, now.Add(-corepresence.Window).UTC().Format(time.RFC3339))
+	if err != nil {
+		return nil, err
+	}
+
+	sightings := make([]corepresence.Sighting, len(records))
+	for i, r := range records {
+		seenAt, _ := time.Parse(time.RFC3339, r.SeenAt)
+		sightings[i] = corepresence.Sighting{EntityID: r.EntityID, ActorID: r.ActorID, Action: r.Action, SeenAt: seenAt}
+	}
+
+	var result []*primary.EntityPresence
+	for _, sighting := range corepresence.Active(sightings, ctxutil.ActorFromContext(ctx), now) {
+		result = append(result, &primary.EntityPresence{
+			EntityID: sighting.EntityID,
+			ActorID:  sighting.ActorID,
+			Actor:    corepresence.ActorLabel(sighting.ActorID),
+			Action:   sighting.Action,
+			SeenAt:   sighting.SeenAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return result, nil
+}
+
+// Ensure PresenceServiceImpl implements the interface
+var _ primary.PresenceService = (*PresenceServiceImpl)(nil)
@@ -0,0 +1,86 @@
+package app
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ============================================================================
+// Mock Implementations
+// ============================================================================
+
+type mockPresenceRepository struct {
+	sightings map[[2]string]*secondary.PresenceRecord // (entity, actor) -> sighting
+}
+
+func newMockPresenceRepository() *mockPresenceRepository {
+	return &mockPresenceRepository{sightings: make(map[[2]string]*secondary.PresenceRecord)}
+}
+
+func (m *mockPresenceRepository) Touch(_ context.Context, presence *secondary.PresenceRecord) error {
+	copied := *presence
+	m.sightings[[2]string{presence.EntityID, presence.ActorID}] = &copied
+	return nil
+}
+
+func (m *mockPresenceRepository) ListSince(_ context.Context, entityID, since string) ([]*secondary.PresenceRecord, error) {
+	var result []*secondary.PresenceRecord
+	for _, s := range m.sightings {
+		if s.SeenAt >= since && (entityID == "" || s.EntityID == entityID) {
+			result = append(result, s)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].SeenAt > result[j].SeenAt })
+	return result, nil
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestPresenceService_RecordAndListActive(t *testing.T) {
+	repo := newMockPresenceRepository()
+	service := NewPresenceService(repo)
+	now := time.Date(2026, 10, 1, 14, 10, 0, 0, time.UTC)
+	service.now = func() time.Time { return now }
+
+	if err := service.RecordPresence(actorCtx("IMP-BENCH-003"), "PLAN-004", "edit"); err != nil {
+		t.Fatalf("RecordPresence failed: %v", err)
+	}
+	service.now = func() time.Time { return now.Add(time.Minute) }
+	if err := service.RecordPresence(actorCtx("GOBLIN"), "PLAN-004", "view"); err != nil {
+		t.Fatalf("RecordPresence failed: %v", err)
+	}
+	if err := service.RecordPresence(context.Background(), "PLAN-004", "view"); err != nil {
+		t.Fatalf("RecordPresence without an actor failed: %v", err)
+	}
+	if len(repo.sightings) != 2 {
+		t.Errorf("expected commands without an actor to go unrecorded, got %d sightings", len(repo.sightings))
+	}
+	if err := service.RecordPresence(actorCtx("GOBLIN"), "PLAN-004", "peek"); err == nil {
+		t.Error("expected an error for an unknown action")
+	}
+
+	// ORC sees the IMP's edit, but not its own view
+	active, err := service.ListActive(actorCtx("GOBLIN"), "PLAN-004")
+	if err != nil {
+		t.Fatalf("ListActive failed: %v", err)
+	}
+	if len(active) != 1 || active[0].Actor != "BENCH-003" || active[0].Action != "edit" || active[0].SeenAt != "2026-10-01T14:10:00Z" {
+		t.Errorf("unexpected presence for ORC: %+v", active)
+	}
+
+	// Sightings older than the window drop out
+	service.now = func() time.Time { return now.Add(30 * time.Minute) }
+	active, err = service.ListActive(actorCtx("IMP-BENCH-004"), "")
+	if err != nil {
+		t.Fatalf("ListActive failed: %v", err)
+	}
+	if len(active) != 0 {
+		t.Errorf("expected no presence after the window, got %+v", active)
+	}
+}
@@ -0,0 +1,225 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	coreratelimit "github.com/example/orc/internal/core/ratelimit"
+	"github.com/example/orc/internal/ctxutil"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// RateLimitServiceImpl implements the RateLimitService interface.
+type RateLimitServiceImpl struct {
+	rateLimitRepo secondary.RateLimitRepository
+	now           func() time.Time
+}
+
+// NewRateLimitService creates a new RateLimitService with injected dependencies.
+func NewRateLimitService(rateLimitRepo secondary.RateLimitRepository) *RateLimitServiceImpl {
+	return &RateLimitServiceImpl{
+		rateLimitRepo: rateLimitRepo,
+		now:           time.Now,
+	}
+}
+
+// CheckWrite returns an error if the current actor is throttled, first
+// throttling it if its activity over the last minute is over a limit.
+// Activity from before a throttle ended (expired or lifted) is not counted
+// again, so a lifted actor starts with a clean slate.
+func (s *RateLimitServiceImpl) CheckWrite(ctx context.Context) error {
+	actorID := ctxutil.ActorFromContext(ctx)
+	actorType := coreratelimit.ActorType(actorID)
+	if actorType == "" {
+		return nil
+	}
+
+	now := s.now()
+	current, err := s.throttleState(ctx, actorID)
+	if err != nil {
+		return err
+	}
+	if err := coreratelimit.CanWrite(coreratelimit.WriteContext{ActorID: actorID, Throttle: current, Now: now}).Error(); err != nil {
+		return err
+	}
+
+	limits, err := s.limitsFor(ctx, actorType)
+	if err != nil {
+		return err
+	}
+	since := now.Add(-coreratelimit.Window)
+	if current.ExpiresAt.After(since) {
+		// Log times have second precision, so start after the throttle's last second
+		since = current.ExpiresAt.Truncate(time.Second).Add(time.Second)
+	}
+	record, err := s.rateLimitRepo.CountActivity(ctx, actorID, since.UTC().Format(time.RFC3339))
+	if err != nil {
+		return err
+	}
+	breach, over := coreratelimit.Check(coreratelimit.Activity{
+		Notes:         record.Notes,
+		StatusChanges: record.StatusChanges,
+		Writes:        record.Writes,
+	}, limits)
+	if !over {
+		return nil
+	}
+
+	throttle := &secondary.ActorThrottleRecord{
+		ActorID:     actorID,
+		Reason:      breach.String(),
+		ThrottledAt: now.UTC().Format(time.RFC3339),
+		ExpiresAt:   now.Add(coreratelimit.Cooldown).UTC().Format(time.RFC3339),
+	}
+	if err := s.rateLimitRepo.SaveThrottle(ctx, throttle); err != nil {
+		return err
+	}
+	return coreratelimit.CanWrite(coreratelimit.WriteContext{
+		ActorID:  actorID,
+		Throttle: recordToThrottleState(throttle),
+		Now:      now,
+	}).Error()
+}
+
+// ListLimits retrieves the effective limits for every actor type and kind.
+func (s *RateLimitServiceImpl) ListLimits(ctx context.Context) ([]*primary.RateLimit, error) {
+	records, err := s.rateLimitRepo.ListLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	configured := make(map[[2]string]int, len(records))
+	for _, r := range records {
+		configured[[2]string{r.ActorType, r.Kind}] = r.PerMinute
+	}
+
+	var limits []*primary.RateLimit
+	for _, actorType := range []string{coreratelimit.ActorIMP, coreratelimit.ActorGoblin} {
+		for _, kind := range coreratelimit.Kinds {
+			limit := &primary.RateLimit{ActorType: actorType, Kind: kind}
+			if perMinute, ok := configured[[2]string{actorType, kind}]; ok {
+				limit.PerMinute = perMinute
+			} else {
+				limit.PerMinute = coreratelimit.DefaultLimits[actorType][kind]
+				limit.Default = true
+			}
+			limits = append(limits, limit)
+		}
+	}
+	return limits, nil
+}
+
+// SetLimit sets an actor type's per-minute limit on an activity kind; 0 turns it off.
+func (s *RateLimitServiceImpl) SetLimit(ctx context.Context, actorType, kind string, perMinute int) error {
+	if err := coreratelimit.CanConfigure(ctxutil.ActorFromContext(ctx)).Error(); err != nil {
+		return err
+	}
+	if err := coreratelimit.ValidateActorType(actorType); err != nil {
+		return err
+	}
+	if err := coreratelimit.ValidateKind(kind); err != nil {
+		return err
+	}
+	if perMinute < 0 {
+		return fmt.Errorf("limit must be 0 (off) or more, got %d", perMinute)
+	}
+	return s.rateLimitRepo.SaveLimit(ctx, &secondary.RateLimitRecord{ActorType: actorType, Kind: kind, PerMinute: perMinute})
+}
+
+// ResetLimit restores an actor type's default limit on an activity kind.
+func (s *RateLimitServiceImpl) ResetLimit(ctx context.Context, actorType, kind string) error {
+	if err := coreratelimit.CanConfigure(ctxutil.ActorFromContext(ctx)).Error(); err != nil {
+		return err
+	}
+	if err := coreratelimit.ValidateActorType(actorType); err != nil {
+		return err
+	}
+	if err := coreratelimit.ValidateKind(kind); err != nil {
+		return err
+	}
+	return s.rateLimitRepo.DeleteLimit(ctx, actorType, kind)
+}
+
+// ListThrottles retrieves the active throttles.
+func (s *RateLimitServiceImpl) ListThrottles(ctx context.Context) ([]*primary.ActorThrottle, error) {
+	records, err := s.rateLimitRepo.ListActiveThrottles(ctx, s.now().UTC().Format(time.RFC3339))
+	if err != nil {
+		return nil, err
+	}
+	throttles := make([]*primary.ActorThrottle, len(records))
+	for i, r := range records {
+		throttles[i] = &primary.ActorThrottle{
+			ActorID:     r.ActorID,
+			Reason:      r.Reason,
+			ThrottledAt: r.ThrottledAt,
+			ExpiresAt:   r.ExpiresAt,
+		}
+	}
+	return throttles, nil
+}
+
+// LiftThrottle ends an actor's throttle early. The row is kept, expiring now,
+// so the activity that tripped it is not counted again.
+func (s *RateLimitServiceImpl) LiftThrottle(ctx context.Context, actorID string) error {
+	record, err := s.rateLimitRepo.GetThrottle(ctx, actorID)
+	if err != nil {
+		return err
+	}
+	var current coreratelimit.Throttle
+	if record != nil {
+		current = recordToThrottleState(record)
+	}
+	now := s.now()
+	if err := coreratelimit.CanLift(coreratelimit.LiftContext{
+		ActorID:     ctxutil.ActorFromContext(ctx),
+		ThrottledID: actorID,
+		Throttle:    current,
+		Now:         now,
+	}).Error(); err != nil {
+		return err
+	}
+	record.ExpiresAt = now.UTC().Format(time.RFC3339)
+	return s.rateLimitRepo.SaveThrottle(ctx, record)
+}
+
+// limitsFor returns an actor type's effective limits by kind.
+func (s *RateLimitServiceImpl) limitsFor(ctx context.Context, actorType string) (map[string]int, error) {
+	records, err := s.rateLimitRepo.ListLimits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	limits := make(map[string]int, len(coreratelimit.Kinds))
+	for kind, perMinute := range coreratelimit.DefaultLimits[actorType] {
+		limits[kind] = perMinute
+	}
+	for _, r := range records {
+		if r.ActorType == actorType {
+			limits[r.Kind] = r.PerMinute
+		}
+	}
+	return limits, nil
+}
+
+// throttleState loads an actor's stored throttle as a guard Throttle (zero if none).
+func (s *RateLimitServiceImpl) throttleState(ctx context.Context, actorID string) (coreratelimit.Throttle, error) {
+	record, err := s.rateLimitRepo.GetThrottle(ctx, actorID)
+	if err != nil || record == nil {
+		return coreratelimit.Throttle{}, err
+	}
+	return recordToThrottleState(record), nil
+}
+
+// recordToThrottleState converts a stored throttle to local time, so
+// messages show the viewer's wall clock.
+func recordToThrottleState(r *secondary.ActorThrottleRecord) coreratelimit.Throttle {
+	expiresAt, _ := time.Parse(time.RFC3339, r.ExpiresAt)
+	return coreratelimit.Throttle{
+		ActorID:   r.ActorID,
+		Reason:    r.Reason,
+		ExpiresAt: expiresAt.Local(),
+	}
+}
+
+// Ensure RateLimitServiceImpl implements the interface
+var _ primary.RateLimitService = (*RateLimitServiceImpl)(nil)
@@ -0,0 +1,225 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// ============================================================================
+// Mock Implementations
+// ============================================================================
+
+type mockRateLimitRepository struct {
+	limits    map[[2]string]int
+	activity  secondary.ActivityRecord
+	since     string
+	throttles map[string]*secondary.ActorThrottleRecord
+}
+
+func newMockRateLimitRepository() *mockRateLimitRepository {
+	return &mockRateLimitRepository{
+		limits:    make(map[[2]string]int),
+		throttles: make(map[string]*secondary.ActorThrottleRecord),
+	}
+}
+
+func (m *mockRateLimitRepository) ListLimits(_ context.Context) ([]*secondary.RateLimitRecord, error) {
+	var result []*secondary.RateLimitRecord
+	for key, perMinute := range m.limits {
+		result = append(result, &secondary.RateLimitRecord{ActorType: key[0], Kind: key[1], PerMinute: perMinute})
+	}
+	return result, nil
+}
+
+func (m *mockRateLimitRepository) SaveLimit(_ context.Context, limit *secondary.RateLimitRecord) error {
+	m.limits[[2]string{limit.ActorType, limit.Kind}] = limit.PerMinute
+	return nil
+}
+
+func (m *mockRateLimitRepository) DeleteLimit(_ context.Context, actorType, kind string) error {
+	if _, ok := m.limits[[2]string{actorType, kind}]; !ok {
+		return fmt.Errorf("no %s limit is set for %s", kind, actorType)
+	}
+	delete(m.limits, [2]string{actorType, kind})
+	return nil
+}
+
+func (m *mockRateLimitRepository) CountActivity(_ context.Context, _, since string) (*secondary.ActivityRecord, error) {
+	m.since = since
+	activity := m.activity
+	return &activity, nil
+}
+
+func (m *mockRateLimitRepository) SaveThrottle(_ context.Context, throttle *secondary.ActorThrottleRecord) error {
+	copied := *throttle
+	m.throttles[throttle.ActorID] = &copied
+	return nil
+}
+
+func (m *mockRateLimitRepository) GetThrottle(_ context.Context, actorID string) (*secondary.ActorThrottleRecord, error) {
+	if th, ok := m.throttles[actorID]; ok {
+		copied := *th
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (m *mockRateLimitRepository) ListActiveThrottles(_ context.Context, after string) ([]*secondary.ActorThrottleRecord, error) {
+	var result []*secondary.ActorThrottleRecord
+	for _, th := range m.throttles {
+		if th.ExpiresAt > after {
+			result = append(result, th)
+		}
+	}
+	return result, nil
+}
+
+// ============================================================================
+// Test Helper
+// ============================================================================
+
+var rateLimitTestNow = time.Date(2026, 10, 16, 14, 2, 0, 0, time.UTC)
+
+func newTestRateLimitService() (*RateLimitServiceImpl, *mockRateLimitRepository) {
+	repo := newMockRateLimitRepository()
+	service := NewRateLimitService(repo)
+	service.now = func() time.Time { return rateLimitTestNow }
+	return service, repo
+}
+
+// ============================================================================
+// Tests
+// ============================================================================
+
+func TestRateLimitService_CheckWriteThrottles(t *testing.T) {
+	service, repo := newTestRateLimitService()
+	imp := actorCtx("IMP-BENCH-001")
+
+	repo.activity = secondary.ActivityRecord{Notes: 5, Writes: 8}
+	if err := service.CheckWrite(imp); err != nil {
+		t.Fatalf("quiet IMP should be allowed, got %v", err)
+	}
+	if repo.since != "2026-10-16T14:01:00Z" {
+		t.Errorf("counted since %s, want the last minute", repo.since)
+	}
+
+	repo.activity = secondary.ActivityRecord{Notes: 25, Writes: 25}
+	err := service.CheckWrite(imp)
+	if err == nil || !strings.Contains(err.Error(), "throttled for runaway activity (25 notes in the last minute (limit 20))") {
+		t.Fatalf("expected throttle error, got %v", err)
+	}
+	th := repo.throttles["IMP-BENCH-001"]
+	if th == nil || th.ExpiresAt != "2026-10-16T14:17:00Z" {
+		t.Fatalf("unexpected throttle: %+v", th)
+	}
+
+	// Still refused once activity stops, until the cooldown ends
+	repo.activity = secondary.ActivityRecord{}
+	service.now = func() time.Time { return rateLimitTestNow.Add(10 * time.Minute) }
+	if err := service.CheckWrite(imp); err == nil {
+		t.Error("expected throttled IMP to stay refused")
+	}
+	service.now = func() time.Time { return rateLimitTestNow.Add(16 * time.Minute) }
+	if err := service.CheckWrite(imp); err != nil {
+		t.Errorf("expected throttle to lapse, got %v", err)
+	}
+}
+
+func TestRateLimitService_CheckWriteIgnoresOthers(t *testing.T) {
+	service, repo := newTestRateLimitService()
+	repo.activity = secondary.ActivityRecord{Notes: 500, Writes: 500}
+
+	if err := service.CheckWrite(actorCtx("GOBLIN")); err != nil {
+		t.Errorf("GOBLIN has no default limits, got %v", err)
+	}
+	if err := service.CheckWrite(context.Background()); err != nil {
+		t.Errorf("unknown actor should not be checked, got %v", err)
+	}
+	if len(repo.throttles) != 0 {
+		t.Errorf("expected no throttles, got %+v", repo.throttles)
+	}
+}
+
+func TestRateLimitService_Limits(t *testing.T) {
+	service, repo := newTestRateLimitService()
+	ctx := actorCtx("GOBLIN")
+
+	if err := service.SetLimit(ctx, "IMP", "notes", 5); err != nil {
+		t.Fatalf("SetLimit failed: %v", err)
+	}
+	if err := service.SetLimit(ctx, "IMP", "notes", -1); err == nil {
+		t.Error("expected negative limit to fail")
+	}
+	if err := service.SetLimit(ctx, "IMP", "comments", 5); err == nil {
+		t.Error("expected unknown kind to fail")
+	}
+	if err := service.SetLimit(actorCtx("IMP-BENCH-001"), "IMP", "notes", 0); err == nil {
+		t.Error("expected an IMP changing limits to fail")
+	}
+
+	limits, err := service.ListLimits(ctx)
+	if err != nil {
+		t.Fatalf("ListLimits failed: %v", err)
+	}
+	if len(limits) != 6 {
+		t.Fatalf("expected 6 limits, got %d", len(limits))
+	}
+	if notes := limits[0]; notes.Kind != "notes" || notes.PerMinute != 5 || notes.Default {
+		t.Errorf("unexpected IMP notes limit: %+v", notes)
+	}
+	if status := limits[1]; status.PerMinute != 30 || !status.Default {
+		t.Errorf("unexpected IMP status limit: %+v", status)
+	}
+
+	repo.activity = secondary.ActivityRecord{Notes: 6, Writes: 6}
+	if err := service.CheckWrite(actorCtx("IMP-BENCH-001")); err == nil {
+		t.Error("expected the configured limit to apply")
+	}
+
+	if err := service.ResetLimit(ctx, "IMP", "notes"); err != nil {
+		t.Fatalf("ResetLimit failed: %v", err)
+	}
+	if err := service.ResetLimit(ctx, "IMP", "notes"); err == nil {
+		t.Error("expected reset of a default limit to fail")
+	}
+}
+
+func TestRateLimitService_Lift(t *testing.T) {
+	service, repo := newTestRateLimitService()
+	imp := actorCtx("IMP-BENCH-001")
+
+	repo.activity = secondary.ActivityRecord{StatusChanges: 40, Writes: 40}
+	if err := service.CheckWrite(imp); err == nil {
+		t.Fatal("expected IMP to be throttled")
+	}
+
+	throttles, err := service.ListThrottles(imp)
+	if err != nil || len(throttles) != 1 {
+		t.Fatalf("ListThrottles = %+v, %v", throttles, err)
+	}
+
+	if err := service.LiftThrottle(imp, "IMP-BENCH-001"); err == nil {
+		t.Error("expected IMP to be refused lifting its own throttle")
+	}
+	if err := service.LiftThrottle(actorCtx("GOBLIN"), "IMP-BENCH-001"); err != nil {
+		t.Fatalf("LiftThrottle failed: %v", err)
+	}
+	if err := service.LiftThrottle(actorCtx("GOBLIN"), "IMP-BENCH-002"); err == nil {
+		t.Error("expected lifting an unthrottled actor to fail")
+	}
+
+	// Activity from before the lift is not counted again
+	service.now = func() time.Time { return rateLimitTestNow.Add(10 * time.Second) }
+	repo.activity = secondary.ActivityRecord{Writes: 1}
+	if err := service.CheckWrite(imp); err != nil {
+		t.Errorf("lifted IMP should be allowed, got %v", err)
+	}
+	if repo.since != "2026-10-16T14:02:01Z" {
+		t.Errorf("counted since %s, want just after the lift", repo.since)
+	}
+}
@@ -0,0 +1,221 @@
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	corerecall "github.com/example/orc/internal/core/recall"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+const (
+	// recallDefaultLimit is how many hits Recall returns when none is asked for.
+	recallDefaultLimit = 10
+	// recallMinScore drops hits that share little more than hash collisions with the query.
+	recallMinScore = 0.1
+	// recallSnippetWidth is the longest snippet returned with a hit.
+	recallSnippetWidth = 100
+)
+
+// RecallServiceImpl implements the RecallService interface.
+type RecallServiceImpl struct {
+	embeddingRepo secondary.EmbeddingRepository
+	noteRepo      secondary.NoteRepository
+	planRepo      secondary.PlanRepository
+}
+
+// NewRecallService creates a new RecallService with injected dependencies.
+func NewRecallService(
+	embeddingRepo secondary.EmbeddingRepository,
+	noteRepo secondary.NoteRepository,
+	planRepo secondary.PlanRepository,
+) *RecallServiceImpl {
+	return &RecallServiceImpl{
+		embeddingRepo: embeddingRepo,
+		noteRepo:      noteRepo,
+		planRepo:      planRepo,
+	}
+}
+
+// recallEntity is an indexed note or plan.
+type recallEntity struct {
+	id           string
+	entityType   string // note, plan
+	kind         string
+	commissionID string
+	title        string
+	status       string
+	body         string // Content; also searched for snippets
+}
+
+// Recall refreshes the index and ranks the indexed entities against the query.
+func (s *RecallServiceImpl) Recall(ctx context.Context, req primary.RecallRequest) ([]*primary.RecallHit, error) {
+	if len(corerecall.Tokenize(req.Query)) == 0 {
+		return nil, fmt.Errorf("query has no searchable words")
+	}
+	switch req.Kind {
+	case "", primary.RecallKindNote, primary.RecallKindQuestion, primary.RecallKindPlan:
+	default:
+		return nil, fmt.Errorf("invalid kind %q: must be note, question, or plan", req.Kind)
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = recallDefaultLimit
+	}
+
+	entities, err := s.entities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	vectors, _, err := s.sync(ctx, entities, false)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]recallEntity, len(entities))
+	var candidates []corerecall.Candidate
+	for _, e := range entities {
+		if req.CommissionID != "" && e.commissionID != req.CommissionID {
+			continue
+		}
+		// Questions are notes too, so a note search includes them
+		if req.Kind != "" && req.Kind != e.kind && !(req.Kind == primary.RecallKindNote && e.entityType == "note") {
+			continue
+		}
+		byID[e.id] = e
+		candidates = append(candidates, corerecall.Candidate{ID: e.id, Vector: vectors[e.id]})
+	}
+
+	matches := corerecall.Rank(corerecall.Embed(req.Query), candidates, recallMinScore, limit)
+	hits := make([]*primary.RecallHit, len(matches))
+	for i, m := range matches {
+		e := byID[m.ID]
+		hits[i] = &primary.RecallHit{
+			EntityID:     e.id,
+			Kind:         e.kind,
+			CommissionID: e.commissionID,
+			Title:        e.title,
+			Status:       e.status,
+			Snippet:      corerecall.Snippet(e.body, req.Query, recallSnippetWidth),
+			Score:        m.Score,
+		}
+	}
+	return hits, nil
+}
+
+// Reindex brings the index up to date with the ledger's notes and plans.
+func (s *RecallServiceImpl) Reindex(ctx context.Context, rebuild bool) (*primary.RecallIndexStats, error) {
+	entities, err := s.entities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	_, stats, err := s.sync(ctx, entities, rebuild)
+	return stats, err
+}
+
+// entities loads every note and plan in the ledger.
+func (s *RecallServiceImpl) entities(ctx context.Context) ([]recallEntity, error) {
+	notes, err := s.noteRepo.List(ctx, secondary.NoteFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list notes: %w", err)
+	}
+	plans, err := s.planRepo.List(ctx, secondary.PlanFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list plans: %w", err)
+	}
+
+	entities := make([]recallEntity, 0, len(notes)+len(plans))
+	for _, n := range notes {
+		if n.ContentExternal {
+			if n, err = s.noteRepo.GetByID(ctx, n.ID); err != nil {
+				return nil, err
+			}
+		}
+		kind := primary.RecallKindNote
+		if n.Type == primary.NoteTypeQuestion {
+			kind = primary.RecallKindQuestion
+		}
+		entities = append(entities, recallEntity{
+			id:           n.ID,
+			entityType:   "note",
+			kind:         kind,
+			commissionID: n.CommissionID,
+			title:        n.Title,
+			status:       n.Status,
+			body:         n.Content,
+		})
+	}
+	for _, p := range plans {
+		body := strings.TrimSpace(p.Description + "\n" + p.Content)
+		entities = append(entities, recallEntity{
+			id:           p.ID,
+			entityType:   "plan",
+			kind:         primary.RecallKindPlan,
+			commissionID: p.CommissionID,
+			title:        p.Title,
+			status:       p.Status,
+			body:         body,
+		})
+	}
+	return entities, nil
+}
+
+// sync embeds the entities whose text or embedding model changed since they
+// were last indexed (all of them when rebuild is set), drops embeddings of
+// entities that no longer exist, and returns every entity's vector.
+func (s *RecallServiceImpl) sync(ctx context.Context, entities []recallEntity, rebuild bool) (map[string][]float32, *primary.RecallIndexStats, error) {
+	records, err := s.embeddingRepo.List(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	stored := make(map[string]*secondary.EmbeddingRecord, len(records))
+	for _, r := range records {
+		stored[r.EntityID] = r
+	}
+
+	stats := &primary.RecallIndexStats{Entities: len(entities)}
+	vectors := make(map[string][]float32, len(entities))
+	for _, e := range entities {
+		hash := contentHash(e.title + "\n" + e.body)
+		if r, ok := stored[e.id]; ok && !rebuild && r.Model == corerecall.Model && r.ContentHash == hash {
+			vectors[e.id] = r.Vector
+			continue
+		}
+
+		vector := corerecall.EmbedDocument(e.title, e.body)
+		if err := s.embeddingRepo.Upsert(ctx, &secondary.EmbeddingRecord{
+			EntityID:    e.id,
+			EntityType:  e.entityType,
+			Model:       corerecall.Model,
+			ContentHash: hash,
+			Vector:      vector,
+		}); err != nil {
+			return nil, nil, err
+		}
+		vectors[e.id] = vector
+		stats.Embedded++
+	}
+
+	for id := range stored {
+		if _, ok := vectors[id]; !ok {
+			if err := s.embeddingRepo.Delete(ctx, id); err != nil {
+				return nil, nil, err
+			}
+			stats.Removed++
+		}
+	}
+	return vectors, stats, nil
+}
+
+// contentHash fingerprints embedded text so unchanged entities are not re-embedded.
+func contentHash(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
+// Ensure RecallServiceImpl implements the interface
+var _ primary.RecallService = (*RecallServiceImpl)(nil)
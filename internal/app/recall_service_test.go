@@ -0,0 +1,199 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	corerecall "github.com/example/orc/internal/core/recall"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+type mockEmbeddingRepository struct {
+	embeddings map[string]*secondary.EmbeddingRecord
+	upserts    int
+}
+
+func newMockEmbeddingRepository() *mockEmbeddingRepository {
+	return &mockEmbeddingRepository{embeddings: make(map[string]*secondary.EmbeddingRecord)}
+}
+
+func (m *mockEmbeddingRepository) Upsert(ctx context.Context, embedding *secondary.EmbeddingRecord) error {
+	m.embeddings[embedding.EntityID] = embedding
+	m.upserts++
+	return nil
+}
+
+func (m *mockEmbeddingRepository) List(ctx context.Context) ([]*secondary.EmbeddingRecord, error) {
+	var result []*secondary.EmbeddingRecord
+	for _, e := range m.embeddings {
+		result = append(result, e)
+	}
+	return result, nil
+}
+
+func (m *mockEmbeddingRepository) Delete(ctx context.Context, entityID string) error {
+	delete(m.embeddings, entityID)
+	return nil
+}
+
+func newTestRecallService() (*RecallServiceImpl, *mockEmbeddingRepository, *mockNoteRepository, *mockPlanRepository) {
+	embeddingRepo := newMockEmbeddingRepository()
+	noteRepo := newMockNoteRepository()
+	planRepo := newMockPlanRepository()
+
+	noteRepo.notes["NOTE-001"] = &secondary.NoteRecord{ID: "NOTE-001", CommissionID: "COMM-001", Title: "Branch naming", Type: "decision", Status: "open",
+		Content: "We looked at options.\nBranches are named ml/SHIP-xxx-slug so they sort by shipment."}
+	noteRepo.notes["NOTE-002"] = &secondary.NoteRecord{ID: "NOTE-002", CommissionID: "COMM-001", Title: "Keychain APIs", Type: "learning", Status: "open",
+		Content: "Use the OS keychain for tokens."}
+	noteRepo.notes["NOTE-003"] = &secondary.NoteRecord{ID: "NOTE-003", CommissionID: "COMM-002", Title: "Should branch names include the task?", Type: "question", Status: "open"}
+	planRepo.plans["PLAN-001"] = &secondary.PlanRecord{ID: "PLAN-001", CommissionID: "COMM-001", Title: "Rename branches", Status: "draft",
+		Content: "1. Rename existing branches to the new naming scheme\n2. Update docs"}
+
+	return NewRecallService(embeddingRepo, noteRepo, planRepo), embeddingRepo, noteRepo, planRepo
+}
+
+func TestRecall_RanksMatchesWithSnippets(t *testing.T) {
+	service, embeddingRepo, _, _ := newTestRecallService()
+	ctx := context.Background()
+
+	hits, err := service.Recall(ctx, primary.RecallRequest{Query: "what did we decide about branch naming"})
+	if err != nil {
+		t.Fatalf("Recall failed: %v", err)
+	}
+	if len(hits) == 0 || hits[0].EntityID != "NOTE-001" {
+		t.Fatalf("expected NOTE-001 first, got %+v", hits)
+	}
+	for _, h := range hits {
+		if h.EntityID == "NOTE-002" {
+			t.Errorf("unrelated NOTE-002 should not match: %+v", h)
+		}
+	}
+	if want := "Branches are named ml/SHIP-xxx-slug so they sort by shipment."; hits[0].Snippet != want {
+		t.Errorf("snippet = %q, want %q", hits[0].Snippet, want)
+	}
+	if hits[0].Kind != primary.RecallKindNote || hits[0].Title != "Branch naming" || hits[0].Status != "open" {
+		t.Errorf("unexpected hit: %+v", hits[0])
+	}
+
+	// Every entity is indexed on first recall
+	if len(embeddingRepo.embeddings) != 4 {
+		t.Errorf("expected 4 embeddings, got %d", len(embeddingRepo.embeddings))
+	}
+	if e := embeddingRepo.embeddings["PLAN-001"]; e.EntityType != "plan" || e.Model != corerecall.Model {
+		t.Errorf("unexpected plan embedding: %+v", e)
+	}
+}
+
+func TestRecall_Filters(t *testing.T) {
+	service, _, _, _ := newTestRecallService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		req  primary.RecallRequest
+		want []string
+	}{
+		{
+			name: "commission",
+			req:  primary.RecallRequest{Query: "branch names", CommissionID: "COMM-002"},
+			want: []string{"NOTE-003"},
+		},
+		{
+			name: "plans only",
+			req:  primary.RecallRequest{Query: "branch naming", Kind: primary.RecallKindPlan},
+			want: []string{"PLAN-001"},
+		},
+		{
+			name: "questions only",
+			req:  primary.RecallRequest{Query: "branch naming", Kind: primary.RecallKindQuestion},
+			want: []string{"NOTE-003"},
+		},
+		{
+			name: "limit",
+			req:  primary.RecallRequest{Query: "branch naming", Limit: 1},
+			want: []string{"NOTE-001"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits, err := service.Recall(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("Recall failed: %v", err)
+			}
+			var got []string
+			for _, h := range hits {
+				got = append(got, h.EntityID)
+			}
+			if len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// A note search includes questions
+	hits, err := service.Recall(ctx, primary.RecallRequest{Query: "branch names", Kind: primary.RecallKindNote})
+	if err != nil {
+		t.Fatalf("Recall failed: %v", err)
+	}
+	found := false
+	for _, h := range hits {
+		if h.EntityID == "PLAN-001" {
+			t.Errorf("note search returned a plan: %+v", h)
+		}
+		found = found || h.EntityID == "NOTE-003"
+	}
+	if !found {
+		t.Errorf("note search should include question NOTE-003, got %+v", hits)
+	}
+}
+
+func TestRecall_InvalidRequests(t *testing.T) {
+	service, _, _, _ := newTestRecallService()
+	ctx := context.Background()
+
+	if _, err := service.Recall(ctx, primary.RecallRequest{Query: "the of and"}); err == nil {
+		t.Error("expected error for a query without searchable words")
+	}
+	if _, err := service.Recall(ctx, primary.RecallRequest{Query: "branch", Kind: "task"}); err == nil {
+		t.Error("expected error for an unknown kind")
+	}
+}
+
+func TestReindex_OnlyEmbedsChanges(t *testing.T) {
+	service, embeddingRepo, noteRepo, _ := newTestRecallService()
+	ctx := context.Background()
+
+	stats, err := service.Reindex(ctx, false)
+	if err != nil {
+		t.Fatalf("Reindex failed: %v", err)
+	}
+	if stats.Entities != 4 || stats.Embedded != 4 || stats.Removed != 0 {
+		t.Errorf("first reindex stats = %+v, want 4 entities embedded", stats)
+	}
+
+	// Edit one note, delete another, and leave a stale-model embedding behind
+	noteRepo.notes["NOTE-001"].Content = "Branches are now named after the task."
+	delete(noteRepo.notes, "NOTE-002")
+	embeddingRepo.embeddings["PLAN-001"].Model = "older-model"
+
+	stats, err = service.Reindex(ctx, false)
+	if err != nil {
+		t.Fatalf("Reindex failed: %v", err)
+	}
+	if stats.Entities != 3 || stats.Embedded != 2 || stats.Removed != 1 {
+		t.Errorf("incremental reindex stats = %+v, want 3 entities, 2 embedded, 1 removed", stats)
+	}
+	if _, ok := embeddingRepo.embeddings["NOTE-002"]; ok {
+		t.Error("embedding of deleted NOTE-002 should be removed")
+	}
+
+	stats, err = service.Reindex(ctx, true)
+	if err != nil {
+		t.Fatalf("Reindex failed: %v", err)
+	}
+	if stats.Embedded != 3 {
+		t.Errorf("rebuild embedded %d, want 3", stats.Embedded)
+	}
+}
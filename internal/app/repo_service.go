@@ -3,21 +3,31 @@ package app
 import (
 	"context"
 	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/example/orc/internal/core/repo"
+	coreworkbench "github.com/example/orc/internal/core/workbench"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
 )
 
 // RepoServiceImpl implements the RepoService interface.
 type RepoServiceImpl struct {
-	repoRepo secondary.RepoRepository
+	repoRepo         secondary.RepoRepository
+	workbenchRepo    secondary.WorkbenchRepository
+	workspaceAdapter secondary.WorkspaceAdapter
+	hostAuth         secondary.HostAuthChecker
 }
 
 // NewRepoService creates a new RepoService with injected dependencies.
-func NewRepoService(repoRepo secondary.RepoRepository) *RepoServiceImpl {
+func NewRepoService(repoRepo secondary.RepoRepository, workbenchRepo secondary.WorkbenchRepository, workspaceAdapter secondary.WorkspaceAdapter, hostAuth secondary.HostAuthChecker) *RepoServiceImpl {
 	return &RepoServiceImpl{
-		repoRepo: repoRepo,
+		repoRepo:         repoRepo,
+		workbenchRepo:    workbenchRepo,
+		workspaceAdapter: workspaceAdapter,
+		hostAuth:         hostAuth,
 	}
 }
 
@@ -193,16 +203,136 @@ func (s *RepoServiceImpl) DeleteRepo(ctx context.Context, repoID string) error {
 
 func (s *RepoServiceImpl) recordToRepo(r *secondary.RepoRecord) *primary.Repo {
 	return &primary.Repo{
-		ID:            r.ID,
-		Name:          r.Name,
-		URL:           r.URL,
-		LocalPath:     r.LocalPath,
-		DefaultBranch: r.DefaultBranch,
-		Status:        r.Status,
-		CreatedAt:     r.CreatedAt,
-		UpdatedAt:     r.UpdatedAt,
+		ID:              r.ID,
+		Name:            r.Name,
+		URL:             r.URL,
+		LocalPath:       r.LocalPath,
+		DefaultBranch:   r.DefaultBranch,
+		BootstrapScript: r.BootstrapScript,
+		Status:          r.Status,
+		CreatedAt:       r.CreatedAt,
+		UpdatedAt:       r.UpdatedAt,
 	}
 }
 
+// SetBootstrapScript sets or clears the script run in new workbenches for this repo.
+func (s *RepoServiceImpl) SetBootstrapScript(ctx context.Context, repoID, script string) error {
+	if _, err := s.repoRepo.GetByID(ctx, repoID); err != nil {
+		return err
+	}
+
+	return s.repoRepo.UpdateBootstrapScript(ctx, repoID, strings.TrimSpace(script))
+}
+
+// CheckRepo gathers facts about a repository's clone and worktrees and
+// evaluates them into health checks with suggested fixes.
+func (s *RepoServiceImpl) CheckRepo(ctx context.Context, repoID string) (*primary.RepoHealth, error) {
+	record, err := s.repoRepo.GetByID(ctx, repoID)
+	if err != nil {
+		return nil, err
+	}
+
+	hctx := repo.HealthContext{
+		RepoID:        record.ID,
+		URL:           record.URL,
+		LocalPath:     record.LocalPath,
+		DefaultBranch: record.DefaultBranch,
+	}
+	if err := s.inspectClone(ctx, &hctx); err != nil {
+		return nil, err
+	}
+
+	checks := repo.EvaluateHealth(hctx)
+	health := &primary.RepoHealth{
+		RepoID: record.ID,
+		Name:   record.Name,
+		Status: repo.OverallStatus(checks),
+		Checks: make([]primary.RepoHealthCheck, len(checks)),
+	}
+	for i, c := range checks {
+		health.Checks[i] = primary.RepoHealthCheck{Name: c.Name, Status: c.Status, Detail: c.Detail, Fix: c.Fix}
+	}
+	return health, nil
+}
+
+// inspectClone fills in what can be observed about the repository's local
+// clone, stopping at the first missing prerequisite.
+func (s *RepoServiceImpl) inspectClone(ctx context.Context, hctx *repo.HealthContext) error {
+	if hctx.LocalPath == "" {
+		return nil
+	}
+
+	var err error
+	if hctx.PathExists, err = s.workspaceAdapter.DirectoryExists(ctx, hctx.LocalPath); err != nil || !hctx.PathExists {
+		return err
+	}
+	if hctx.IsGitRepo, err = s.workspaceAdapter.IsGitRepository(ctx, hctx.LocalPath); err != nil || !hctx.IsGitRepo {
+		return err
+	}
+	if hctx.OriginURL, err = s.workspaceAdapter.GetRemoteURL(ctx, hctx.LocalPath, "origin"); err != nil {
+		return fmt.Errorf("failed to read origin: %w", err)
+	}
+	if hctx.DefaultBranchExists, err = s.workspaceAdapter.BranchExists(ctx, hctx.LocalPath, hctx.DefaultBranch); err != nil {
+		return fmt.Errorf("failed to check default branch: %w", err)
+	}
+
+	// gh only speaks to GitHub hosts (github.com or GitHub Enterprise)
+	if host := repo.RemoteHost(hctx.URL); s.hostAuth != nil && strings.Contains(host, "github") {
+		hctx.AuthChecked = true
+		if err := s.hostAuth.CheckAuth(ctx, host); err != nil {
+			hctx.AuthError = err.Error()
+		}
+	}
+
+	return s.inspectWorktrees(ctx, hctx)
+}
+
+// inspectWorktrees compares the repository's workbenches with the worktrees
+// git has registered.
+func (s *RepoServiceImpl) inspectWorktrees(ctx context.Context, hctx *repo.HealthContext) error {
+	registered, err := s.workspaceAdapter.ListWorktrees(ctx, hctx.LocalPath)
+	if err != nil {
+		return fmt.Errorf("failed to list worktrees: %w", err)
+	}
+	isRegistered := make(map[string]bool, len(registered))
+	for _, path := range registered {
+		isRegistered[filepath.Clean(path)] = true
+	}
+
+	workbenches, err := s.workbenchRepo.List(ctx, "")
+	if err != nil {
+		return fmt.Errorf("failed to list workbenches: %w", err)
+	}
+	sort.Slice(workbenches, func(i, j int) bool { return workbenches[i].ID < workbenches[j].ID })
+
+	claimed := make(map[string]bool)
+	for _, wb := range workbenches {
+		if wb.RepoID != hctx.RepoID || wb.Status == "archived" {
+			continue
+		}
+		path := filepath.Clean(coreworkbench.ComputePath(wb.Name))
+		claimed[path] = true
+		exists, err := s.workspaceAdapter.WorktreeExists(ctx, path)
+		if err != nil {
+			return err
+		}
+		hctx.Worktrees = append(hctx.Worktrees, repo.WorktreeFact{
+			WorkbenchID: wb.ID,
+			Path:        path,
+			Exists:      exists,
+			Registered:  isRegistered[path],
+		})
+	}
+
+	base := filepath.Clean(s.workspaceAdapter.GetWorktreesBasePath()) + string(filepath.Separator)
+	for _, path := range registered {
+		path = filepath.Clean(path)
+		if strings.HasPrefix(path, base) && !claimed[path] {
+			hctx.OrphanWorktrees = append(hctx.OrphanWorktrees, path)
+		}
+	}
+	return nil
+}
+
 // Ensure RepoServiceImpl implements the interface
 var _ primary.RepoService = (*RepoServiceImpl)(nil)
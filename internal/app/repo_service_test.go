@@ -3,8 +3,10 @@ package app
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	coreworkbench "github.com/example/orc/internal/core/workbench"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
 )
@@ -96,6 +98,14 @@ func (m *mockRepoRepository) UpdateStatus(ctx context.Context, id, status string
 	return fmt.Errorf("repository %s not found", id)
 }
 
+func (m *mockRepoRepository) UpdateBootstrapScript(ctx context.Context, id, script string) error {
+	if repo, ok := m.repos[id]; ok {
+		repo.BootstrapScript = script
+		return nil
+	}
+	return fmt.Errorf("repository %s not found", id)
+}
+
 func (m *mockRepoRepository) HasActivePRs(ctx context.Context, repoID string) (bool, error) {
 	return m.hasActivePRs, nil
 }
@@ -105,7 +115,7 @@ func TestRepoService_CreateRepo(t *testing.T) {
 
 	t.Run("creates repository with valid name", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo)
+		svc := NewRepoService(repo, nil, nil, nil)
 
 		resp, err := svc.CreateRepo(ctx, primary.CreateRepoRequest{
 			Name:          "my-repo",
@@ -126,7 +136,7 @@ func TestRepoService_CreateRepo(t *testing.T) {
 
 	t.Run("fails with empty name", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo)
+		svc := NewRepoService(repo, nil, nil, nil)
 
 		_, err := svc.CreateRepo(ctx, primary.CreateRepoRequest{
 			Name: "",
@@ -139,7 +149,7 @@ func TestRepoService_CreateRepo(t *testing.T) {
 
 	t.Run("fails with duplicate name", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo)
+		svc := NewRepoService(repo, nil, nil, nil)
 
 		// Create first repo
 		_, err := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "duplicate"})
@@ -156,7 +166,7 @@ func TestRepoService_CreateRepo(t *testing.T) {
 
 	t.Run("uses default branch when not specified", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo)
+		svc := NewRepoService(repo, nil, nil, nil)
 
 		resp, err := svc.CreateRepo(ctx, primary.CreateRepoRequest{
 			Name: "no-branch",
@@ -176,7 +186,7 @@ func TestRepoService_ArchiveRepo(t *testing.T) {
 
 	t.Run("archives active repository", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo)
+		svc := NewRepoService(repo, nil, nil, nil)
 
 		// Create a repo
 		resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "to-archive"})
@@ -195,7 +205,7 @@ func TestRepoService_ArchiveRepo(t *testing.T) {
 
 	t.Run("fails to archive already archived repository", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo)
+		svc := NewRepoService(repo, nil, nil, nil)
 
 		// Create and archive a repo
 		resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "already-archived"})
@@ -214,7 +224,7 @@ func TestRepoService_RestoreRepo(t *testing.T) {
 
 	t.Run("restores archived repository", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo)
+		svc := NewRepoService(repo, nil, nil, nil)
 
 		// Create and archive a repo
 		resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "to-restore"})
@@ -234,7 +244,7 @@ func TestRepoService_RestoreRepo(t *testing.T) {
 
 	t.Run("fails to restore active repository", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo)
+		svc := NewRepoService(repo, nil, nil, nil)
 
 		// Create a repo (starts as active)
 		resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "already-active"})
@@ -252,7 +262,7 @@ func TestRepoService_DeleteRepo(t *testing.T) {
 	t.Run("deletes repository with no active PRs", func(t *testing.T) {
 		repo := newMockRepoRepository()
 		repo.hasActivePRs = false
-		svc := NewRepoService(repo)
+		svc := NewRepoService(repo, nil, nil, nil)
 
 		// Create a repo
 		resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "to-delete"})
@@ -266,7 +276,7 @@ func TestRepoService_DeleteRepo(t *testing.T) {
 	t.Run("fails to delete repository with active PRs", func(t *testing.T) {
 		repo := newMockRepoRepository()
 		repo.hasActivePRs = true
-		svc := NewRepoService(repo)
+		svc := NewRepoService(repo, nil, nil, nil)
 
 		// Create a repo
 		resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "has-prs"})
@@ -283,7 +293,7 @@ func TestRepoService_GetRepoByName(t *testing.T) {
 
 	t.Run("finds repository by name", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo)
+		svc := NewRepoService(repo, nil, nil, nil)
 
 		// Create a repo
 		_, _ = svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "find-me"})
@@ -299,7 +309,7 @@ func TestRepoService_GetRepoByName(t *testing.T) {
 
 	t.Run("returns error for non-existent name", func(t *testing.T) {
 		repo := newMockRepoRepository()
-		svc := NewRepoService(repo)
+		svc := NewRepoService(repo, nil, nil, nil)
 
 		_, err := svc.GetRepoByName(ctx, "non-existent")
 		if err == nil {
@@ -307,3 +317,178 @@ func TestRepoService_GetRepoByName(t *testing.T) {
 		}
 	})
 }
+
+func TestRepoService_SetBootstrapScript(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sets and clears bootstrap script", func(t *testing.T) {
+		repo := newMockRepoRepository()
+		svc := NewRepoService(repo, nil, nil, nil)
+
+		resp, _ := svc.CreateRepo(ctx, primary.CreateRepoRequest{Name: "with-script"})
+
+		if err := svc.SetBootstrapScript(ctx, resp.RepoID, "  direnv allow\n"); err != nil {
+			t.Fatalf("SetBootstrapScript failed: %v", err)
+		}
+		got, _ := svc.GetRepo(ctx, resp.RepoID)
+		if got.BootstrapScript != "direnv allow" {
+			t.Errorf("BootstrapScript = %q, want %q", got.BootstrapScript, "direnv allow")
+		}
+
+		if err := svc.SetBootstrapScript(ctx, resp.RepoID, ""); err != nil {
+			t.Fatalf("SetBootstrapScript clear failed: %v", err)
+		}
+		got, _ = svc.GetRepo(ctx, resp.RepoID)
+		if got.BootstrapScript != "" {
+			t.Errorf("BootstrapScript = %q, want empty", got.BootstrapScript)
+		}
+	})
+
+	t.Run("returns error for non-existent repository", func(t *testing.T) {
+		repo := newMockRepoRepository()
+		svc := NewRepoService(repo, nil, nil, nil)
+
+		if err := svc.SetBootstrapScript(ctx, "REPO-999", "make setup"); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
+
+// mockHostAuthChecker implements secondary.HostAuthChecker for testing.
+type mockHostAuthChecker struct {
+	err   error
+	hosts []string
+}
+
+func (m *mockHostAuthChecker) CheckAuth(ctx context.Context, host string) error {
+	m.hosts = append(m.hosts, host)
+	return m.err
+}
+
+func TestRepoService_CheckRepo(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func() (*RepoServiceImpl, *mockWorkspaceAdapter, *mockWorkbenchRepository, *mockHostAuthChecker) {
+		repo := newMockRepoRepository()
+		repo.repos["REPO-002"] = &secondary.RepoRecord{
+			ID:            "REPO-002",
+			Name:          "api",
+			URL:           "git@github.com:org/api.git",
+			LocalPath:     "/src/api",
+			DefaultBranch: "main",
+			Status:        "active",
+		}
+
+		wbPath := coreworkbench.ComputePath("api-one")
+		workspace := newMockWorkspaceAdapter()
+		workspace.directories = map[string]bool{"/src/api": true}
+		workspace.gitRepos = map[string]bool{"/src/api": true}
+		workspace.remoteURLs = map[string]string{"/src/api": "https://github.com/org/api"}
+		workspace.branches = map[string]bool{"/src/api:main": true}
+		workspace.registeredWorktrees = map[string][]string{"/src/api": {wbPath}}
+		workspace.worktrees[wbPath] = true
+
+		workbenches := newMockWorkbenchRepository()
+		workbenches.workbenches["BENCH-001"] = &secondary.WorkbenchRecord{ID: "BENCH-001", Name: "api-one", RepoID: "REPO-002", Status: "active"}
+		workbenches.workbenches["BENCH-002"] = &secondary.WorkbenchRecord{ID: "BENCH-002", Name: "other", RepoID: "REPO-003", Status: "active"}
+
+		auth := &mockHostAuthChecker{}
+		return NewRepoService(repo, workbenches, workspace, auth), workspace, workbenches, auth
+	}
+
+	statuses := func(h *primary.RepoHealth) map[string]string {
+		m := make(map[string]string)
+		for _, c := range h.Checks {
+			m[c.Name] = c.Status
+		}
+		return m
+	}
+
+	t.Run("healthy repository passes", func(t *testing.T) {
+		svc, _, _, auth := setup()
+
+		health, err := svc.CheckRepo(ctx, "REPO-002")
+		if err != nil {
+			t.Fatalf("CheckRepo failed: %v", err)
+		}
+		if health.Status != primary.RepoHealthPass {
+			t.Errorf("Status = %q, want pass: %+v", health.Status, health.Checks)
+		}
+		if len(health.Checks) != 5 {
+			t.Errorf("expected 5 checks, got %d", len(health.Checks))
+		}
+		if len(auth.hosts) != 1 || auth.hosts[0] != "github.com" {
+			t.Errorf("expected gh auth checked for github.com, got %v", auth.hosts)
+		}
+	})
+
+	t.Run("missing clone stops at the path check", func(t *testing.T) {
+		svc, workspace, _, auth := setup()
+		workspace.directories = nil
+
+		health, err := svc.CheckRepo(ctx, "REPO-002")
+		if err != nil {
+			t.Fatalf("CheckRepo failed: %v", err)
+		}
+		if health.Status != primary.RepoHealthFail || len(health.Checks) != 1 {
+			t.Errorf("expected a single failing check, got %+v", health.Checks)
+		}
+		if len(auth.hosts) != 0 {
+			t.Error("expected no auth check without a clone")
+		}
+	})
+
+	t.Run("reports auth, remote, and worktree problems", func(t *testing.T) {
+		svc, workspace, workbenches, auth := setup()
+		auth.err = fmt.Errorf("not logged in")
+		workspace.remoteURLs["/src/api"] = "git@github.com:fork/api.git"
+		workspace.registeredWorktrees["/src/api"] = append(workspace.registeredWorktrees["/src/api"], "/tmp/worktrees/stale")
+		workbenches.workbenches["BENCH-003"] = &secondary.WorkbenchRecord{ID: "BENCH-003", Name: "api-gone", RepoID: "REPO-002", Status: "active"}
+
+		health, err := svc.CheckRepo(ctx, "REPO-002")
+		if err != nil {
+			t.Fatalf("CheckRepo failed: %v", err)
+		}
+		got := statuses(health)
+		want := map[string]string{
+			"local path":     primary.RepoHealthPass,
+			"remote":         primary.RepoHealthFail,
+			"default branch": primary.RepoHealthPass,
+			"gh auth":        primary.RepoHealthFail,
+			"worktrees":      primary.RepoHealthFail,
+		}
+		for name, status := range want {
+			if got[name] != status {
+				t.Errorf("%s = %q, want %q", name, got[name], status)
+			}
+		}
+		for _, c := range health.Checks {
+			if c.Name == "worktrees" {
+				if !strings.Contains(c.Detail, "BENCH-003") || !strings.Contains(c.Detail, "/tmp/worktrees/stale has no workbench") {
+					t.Errorf("unexpected worktree detail: %q", c.Detail)
+				}
+			}
+		}
+	})
+
+	t.Run("skips gh auth for non-GitHub hosts", func(t *testing.T) {
+		svc, workspace, _, auth := setup()
+		svc.repoRepo.(*mockRepoRepository).repos["REPO-002"].URL = "git@gitlab.com:org/api.git"
+		workspace.remoteURLs["/src/api"] = "git@gitlab.com:org/api.git"
+
+		health, err := svc.CheckRepo(ctx, "REPO-002")
+		if err != nil {
+			t.Fatalf("CheckRepo failed: %v", err)
+		}
+		if _, ok := statuses(health)["gh auth"]; ok || len(auth.hosts) != 0 {
+			t.Error("expected no gh auth check for gitlab.com")
+		}
+	})
+
+	t.Run("returns error for non-existent repository", func(t *testing.T) {
+		svc, _, _, _ := setup()
+		if _, err := svc.CheckRepo(ctx, "REPO-999"); err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+}
@@ -0,0 +1,145 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	coresecret "github.com/example/orc/internal/core/secret"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// SecretServiceImpl implements the SecretService interface.
+type SecretServiceImpl struct {
+	secretRepo  secondary.SecretRepository
+	cipher      secondary.SecretCipher
+	factoryRepo secondary.FactoryRepository
+	repoRepo    secondary.RepoRepository
+}
+
+// NewSecretService creates a new SecretService with injected dependencies.
+func NewSecretService(
+	secretRepo secondary.SecretRepository,
+	cipher secondary.SecretCipher,
+	factoryRepo secondary.FactoryRepository,
+	repoRepo secondary.RepoRepository,
+) *SecretServiceImpl {
+	return &SecretServiceImpl{
+		secretRepo:  secretRepo,
+		cipher:      cipher,
+		factoryRepo: factoryRepo,
+		repoRepo:    repoRepo,
+	}
+}
+
+// SetSecret encrypts and stores a secret, replacing any existing value in the same scope.
+func (s *SecretServiceImpl) SetSecret(ctx context.Context, req primary.SetSecretRequest) error {
+	scope := normalizeScope(req.Scope)
+
+	guardResult := coresecret.CanSetSecret(coresecret.SetSecretContext{
+		Name:        req.Name,
+		Scope:       scope,
+		ScopeExists: s.scopeExists(ctx, scope),
+		ValueLength: len(req.Value),
+	})
+	if err := guardResult.Error(); err != nil {
+		return err
+	}
+
+	ciphertext, err := s.cipher.Encrypt(req.Value)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt secret: %w", err)
+	}
+
+	return s.secretRepo.Set(ctx, &secondary.SecretRecord{
+		Name:       req.Name,
+		ScopeType:  scope.Type,
+		ScopeID:    scope.ID,
+		Ciphertext: ciphertext,
+	})
+}
+
+// GetSecret returns the value stored under name in exactly the given scope.
+func (s *SecretServiceImpl) GetSecret(ctx context.Context, name string, scope primary.SecretScope) (string, error) {
+	normalized := normalizeScope(scope)
+	record, err := s.secretRepo.Get(ctx, name, normalized.Type, normalized.ID)
+	if err != nil {
+		return "", err
+	}
+	if record == nil {
+		return "", fmt.Errorf("secret %s not found in %s scope", name, normalized)
+	}
+	return s.decrypt(record)
+}
+
+// ResolveSecret returns the most specific value for name: repo, then factory, then global.
+func (s *SecretServiceImpl) ResolveSecret(ctx context.Context, name, repoID, factoryID string) (string, error) {
+	for _, scope := range coresecret.ResolutionOrder(repoID, factoryID) {
+		record, err := s.secretRepo.Get(ctx, name, scope.Type, scope.ID)
+		if err != nil {
+			return "", err
+		}
+		if record != nil {
+			return s.decrypt(record)
+		}
+	}
+	return "", fmt.Errorf("secret %s not found", name)
+}
+
+// ListSecrets lists secret metadata without values.
+func (s *SecretServiceImpl) ListSecrets(ctx context.Context, scope primary.SecretScope) ([]*primary.Secret, error) {
+	records, err := s.secretRepo.List(ctx, scope.Type, scope.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets: %w", err)
+	}
+
+	secrets := make([]*primary.Secret, len(records))
+	for i, r := range records {
+		secrets[i] = &primary.Secret{
+			Name:      r.Name,
+			Scope:     primary.SecretScope{Type: r.ScopeType, ID: r.ScopeID},
+			CreatedAt: r.CreatedAt,
+			UpdatedAt: r.UpdatedAt,
+		}
+	}
+	return secrets, nil
+}
+
+// DeleteSecret removes a secret from the given scope.
+func (s *SecretServiceImpl) DeleteSecret(ctx context.Context, name string, scope primary.SecretScope) error {
+	normalized := normalizeScope(scope)
+	return s.secretRepo.Delete(ctx, name, normalized.Type, normalized.ID)
+}
+
+// decrypt opens a stored secret. Errors never include the ciphertext.
+func (s *SecretServiceImpl) decrypt(record *secondary.SecretRecord) (string, error) {
+	value, err := s.cipher.Decrypt(record.Ciphertext)
+	if err != nil {
+		return "", fmt.Errorf("secret %s: %w", record.Name, err)
+	}
+	return value, nil
+}
+
+// scopeExists reports whether a factory or repo scope refers to an existing entity.
+func (s *SecretServiceImpl) scopeExists(ctx context.Context, scope coresecret.Scope) bool {
+	switch scope.Type {
+	case coresecret.ScopeFactory:
+		_, err := s.factoryRepo.GetByID(ctx, scope.ID)
+		return err == nil
+	case coresecret.ScopeRepo:
+		_, err := s.repoRepo.GetByID(ctx, scope.ID)
+		return err == nil
+	}
+	return false
+}
+
+// normalizeScope defaults an empty scope to global.
+func normalizeScope(scope primary.SecretScope) coresecret.Scope {
+	if scope.Type == "" || scope.Type == coresecret.ScopeGlobal {
+		return coresecret.Scope{Type: coresecret.ScopeGlobal}
+	}
+	return coresecret.Scope{Type: scope.Type, ID: scope.ID}
+}
+
+// Ensure SecretServiceImpl implements the interface
+var _ primary.SecretService = (*SecretServiceImpl)(nil)
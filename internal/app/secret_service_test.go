@@ -0,0 +1,173 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// mockSecretRepository implements secondary.SecretRepository for testing.
+type mockSecretRepository struct {
+	secrets map[string]*secondary.SecretRecord // keyed by name|type|id
+}
+
+func newMockSecretRepository() *mockSecretRepository {
+	return &mockSecretRepository{secrets: make(map[string]*secondary.SecretRecord)}
+}
+
+func secretKey(name, scopeType, scopeID string) string {
+	return name + "|" + scopeType + "|" + scopeID
+}
+
+func (m *mockSecretRepository) Set(ctx context.Context, secret *secondary.SecretRecord) error {
+	m.secrets[secretKey(secret.Name, secret.ScopeType, secret.ScopeID)] = secret
+	return nil
+}
+
+func (m *mockSecretRepository) Get(ctx context.Context, name, scopeType, scopeID string) (*secondary.SecretRecord, error) {
+	return m.secrets[secretKey(name, scopeType, scopeID)], nil
+}
+
+func (m *mockSecretRepository) List(ctx context.Context, scopeType, scopeID string) ([]*secondary.SecretRecord, error) {
+	var result []*secondary.SecretRecord
+	for _, s := range m.secrets {
+		if scopeType != "" && s.ScopeType != scopeType {
+			continue
+		}
+		if scopeID != "" && s.ScopeID != scopeID {
+			continue
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+func (m *mockSecretRepository) Delete(ctx context.Context, name, scopeType, scopeID string) error {
+	key := secretKey(name, scopeType, scopeID)
+	if _, ok := m.secrets[key]; !ok {
+		return errors.New("secret not found")
+	}
+	delete(m.secrets, key)
+	return nil
+}
+
+// mockSecretCipher implements secondary.SecretCipher with a reversible marker.
+type mockSecretCipher struct{}
+
+func (mockSecretCipher) Encrypt(plaintext string) (string, error) {
+	return "enc:" + strings.ToUpper(plaintext), nil
+}
+
+func (mockSecretCipher) Decrypt(ciphertext string) (string, error) {
+	encoded, ok := strings.CutPrefix(ciphertext, "enc:")
+	if !ok {
+		return "", errors.New("bad ciphertext")
+	}
+	return strings.ToLower(encoded), nil
+}
+
+func newTestSecretService() (*SecretServiceImpl, *mockSecretRepository) {
+	secretRepo := newMockSecretRepository()
+	factoryRepo := newMockFactoryRepository()
+	factoryRepo.factories["FACT-001"] = &secondary.FactoryRecord{ID: "FACT-001"}
+	repoRepo := newMockRepoRepository()
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001"}
+	return NewSecretService(secretRepo, mockSecretCipher{}, factoryRepo, repoRepo), secretRepo
+}
+
+func TestSecretService_SetSecret_StoresCiphertext(t *testing.T) {
+	service, secretRepo := newTestSecretService()
+	ctx := context.Background()
+
+	err := service.SetSecret(ctx, primary.SetSecretRequest{Name: "github-token", Value: "ghp-abc"})
+	if err != nil {
+		t.Fatalf("SetSecret failed: %v", err)
+	}
+
+	stored := secretRepo.secrets[secretKey("github-token", "global", "")]
+	if stored == nil {
+		t.Fatal("expected secret stored in global scope")
+	}
+	if stored.Ciphertext == "ghp-abc" {
+		t.Error("expected value to be encrypted before storage")
+	}
+
+	value, err := service.GetSecret(ctx, "github-token", primary.SecretScope{})
+	if err != nil {
+		t.Fatalf("GetSecret failed: %v", err)
+	}
+	if value != "ghp-abc" {
+		t.Errorf("GetSecret = %q, want %q", value, "ghp-abc")
+	}
+}
+
+func TestSecretService_SetSecret_MissingScope(t *testing.T) {
+	service, secretRepo := newTestSecretService()
+
+	err := service.SetSecret(context.Background(), primary.SetSecretRequest{
+		Name: "github-token", Scope: primary.SecretScope{Type: "repo", ID: "REPO-999"}, Value: "x",
+	})
+	if err == nil {
+		t.Fatal("expected error for missing repo")
+	}
+	if len(secretRepo.secrets) != 0 {
+		t.Error("expected nothing stored")
+	}
+}
+
+func TestSecretService_ResolveSecret_MostSpecificWins(t *testing.T) {
+	service, _ := newTestSecretService()
+	ctx := context.Background()
+
+	for _, req := range []primary.SetSecretRequest{
+		{Name: "github-token", Value: "global"},
+		{Name: "github-token", Scope: primary.SecretScope{Type: "factory", ID: "FACT-001"}, Value: "factory"},
+		{Name: "github-token", Scope: primary.SecretScope{Type: "repo", ID: "REPO-001"}, Value: "repo"},
+	} {
+		if err := service.SetSecret(ctx, req); err != nil {
+			t.Fatalf("SetSecret failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		repoID, factoryID, want string
+	}{
+		{"REPO-001", "FACT-001", "repo"},
+		{"REPO-002", "FACT-001", "factory"},
+		{"", "FACT-002", "global"},
+	}
+	for _, tt := range tests {
+		got, err := service.ResolveSecret(ctx, "github-token", tt.repoID, tt.factoryID)
+		if err != nil {
+			t.Fatalf("ResolveSecret(%s, %s) failed: %v", tt.repoID, tt.factoryID, err)
+		}
+		if got != tt.want {
+			t.Errorf("ResolveSecret(%s, %s) = %q, want %q", tt.repoID, tt.factoryID, got, tt.want)
+		}
+	}
+
+	if _, err := service.ResolveSecret(ctx, "slack-webhook", "REPO-001", "FACT-001"); err == nil {
+		t.Error("expected error for unknown secret")
+	}
+}
+
+func TestSecretService_ListSecrets_OmitsValues(t *testing.T) {
+	service, _ := newTestSecretService()
+	ctx := context.Background()
+
+	if err := service.SetSecret(ctx, primary.SetSecretRequest{Name: "github-token", Value: "ghp-abc"}); err != nil {
+		t.Fatalf("SetSecret failed: %v", err)
+	}
+
+	secrets, err := service.ListSecrets(ctx, primary.SecretScope{})
+	if err != nil {
+		t.Fatalf("ListSecrets failed: %v", err)
+	}
+	if len(secrets) != 1 || secrets[0].Name != "github-token" || secrets[0].Scope.Type != "global" {
+		t.Errorf("unexpected listing: %+v", secrets)
+	}
+}
@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 
 	coreshipment "github.com/example/orc/internal/core/shipment"
+	"github.com/example/orc/internal/ctxutil"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
 )
@@ -16,6 +19,7 @@ type ShipmentServiceImpl struct {
 	shipmentRepo secondary.ShipmentRepository
 	taskRepo     secondary.TaskRepository
 	noteService  primary.NoteService
+	lockService  primary.LockService
 }
 
 // NewShipmentService creates a new ShipmentService with injected dependencies.
@@ -23,11 +27,13 @@ func NewShipmentService(
 	shipmentRepo secondary.ShipmentRepository,
 	taskRepo secondary.TaskRepository,
 	noteService primary.NoteService,
+	lockService primary.LockService,
 ) *ShipmentServiceImpl {
 	return &ShipmentServiceImpl{
 		shipmentRepo: shipmentRepo,
 		taskRepo:     taskRepo,
 		noteService:  noteService,
+		lockService:  lockService,
 	}
 }
 
@@ -155,8 +161,9 @@ func (s *ShipmentServiceImpl) CloseShipment(ctx context.Context, shipmentID stri
 	// Close spec note if shipment was generated from one
 	if record.SpecNoteID != "" && s.noteService != nil {
 		closeReq := primary.CloseNoteRequest{
-			NoteID: record.SpecNoteID,
-			Reason: "resolved",
+			NoteID:     record.SpecNoteID,
+			Resolution: primary.NoteResolutionPromoted,
+			Reason:     "resolved",
 		}
 		if err := s.noteService.CloseNote(ctx, closeReq); err != nil {
 			// Log but don't fail - shipment is already closed
@@ -174,6 +181,10 @@ func (s *ShipmentServiceImpl) CompleteShipment(ctx context.Context, shipmentID s
 
 // UpdateShipment updates a shipment's title, description, and/or branch.
 func (s *ShipmentServiceImpl) UpdateShipment(ctx context.Context, req primary.UpdateShipmentRequest) error {
+	if err := s.lockService.CheckUnlocked(ctx, req.ShipmentID); err != nil {
+		return err
+	}
+
 	record := &secondary.ShipmentRecord{
 		ID:          req.ShipmentID,
 		Title:       req.Title,
@@ -189,14 +200,15 @@ func (s *ShipmentServiceImpl) UpdateStatus(ctx context.Context, shipmentID, stat
 }
 
 // SetStatus sets a shipment's status with escape hatch protection.
-// If force is true, allows backwards transitions.
+// If force is true, allows transitions outside the state machine;
+// such transitions are logged as forced.
 func (s *ShipmentServiceImpl) SetStatus(ctx context.Context, shipmentID, status string, force bool) error {
 	record, err := s.shipmentRepo.GetByID(ctx, shipmentID)
 	if err != nil {
 		return err
 	}
 
-	// Guard: check for backwards transitions
+	// Guard: check the status state machine
 	guardCtx := coreshipment.OverrideStatusContext{
 		ShipmentID:    shipmentID,
 		CurrentStatus: record.Status,
@@ -206,6 +218,9 @@ func (s *ShipmentServiceImpl) SetStatus(ctx context.Context, shipmentID, status
 	if result := coreshipment.CanOverrideStatus(guardCtx); !result.Allowed {
 		return result.Error()
 	}
+	if !coreshipment.IsLegalTransition(record.Status, status) {
+		ctx = ctxutil.WithForced(ctx)
+	}
 
 	// Set completed flag if transitioning to closed
 	setCompleted := status == "closed"
@@ -213,6 +228,88 @@ func (s *ShipmentServiceImpl) SetStatus(ctx context.Context, shipmentID, status
 	return s.shipmentRepo.UpdateStatus(ctx, shipmentID, status, setCompleted)
 }
 
+// StatusTransitions returns the legal shipment status transitions.
+func (s *ShipmentServiceImpl) StatusTransitions() []primary.StatusTransition {
+	var result []primary.StatusTransition
+	for _, t := range coreshipment.Transitions() {
+		result = append(result, primary.StatusTransition{From: t.From, To: t.To, Reason: t.Reason})
+	}
+	return result
+}
+
+// GetShipmentDAG returns a commission's shipments as a dependency graph
+// derived from cross-shipment task dependencies.
+func (s *ShipmentServiceImpl) GetShipmentDAG(ctx context.Context, commissionID string) (*primary.ShipmentDAG, error) {
+	records, err := s.shipmentRepo.List(ctx, secondary.ShipmentFilters{CommissionID: commissionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shipments: %w", err)
+	}
+	taskRecords, err := s.taskRepo.List(ctx, secondary.TaskFilters{CommissionID: commissionID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	openTasks := make(map[string]int)
+	totalTasks := make(map[string]int)
+	tasks := make([]coreshipment.DAGTask, 0, len(taskRecords))
+	for _, t := range taskRecords {
+		var dependsOn []string
+		if t.DependsOn != "" {
+			_ = json.Unmarshal([]byte(t.DependsOn), &dependsOn)
+		}
+		tasks = append(tasks, coreshipment.DAGTask{ID: t.ID, ShipmentID: t.ShipmentID, DependsOn: dependsOn})
+		if t.ShipmentID == "" {
+			continue
+		}
+		totalTasks[t.ShipmentID]++
+		if t.Status != "closed" {
+			openTasks[t.ShipmentID]++
+		}
+	}
+
+	shipments := make([]coreshipment.DAGShipment, len(records))
+	for i, r := range records {
+		shipments[i] = coreshipment.DAGShipment{ID: r.ID, Status: r.Status, OpenTasks: openTasks[r.ID]}
+	}
+	dag := coreshipment.BuildDAG(shipments, tasks)
+
+	critical := make(map[string]bool, len(dag.CriticalPath))
+	for _, id := range dag.CriticalPath {
+		critical[id] = true
+	}
+	criticalEdges := make(map[coreshipment.Edge]bool)
+	for i := 1; i < len(dag.CriticalPath); i++ {
+		criticalEdges[coreshipment.Edge{From: dag.CriticalPath[i-1], To: dag.CriticalPath[i]}] = true
+	}
+
+	result := &primary.ShipmentDAG{
+		CommissionID: commissionID,
+		CriticalPath: dag.CriticalPath,
+		Cyclic:       dag.Cyclic,
+	}
+	for _, r := range records {
+		result.Nodes = append(result.Nodes, &primary.ShipmentDAGNode{
+			ID:         r.ID,
+			Title:      r.Title,
+			Status:     r.Status,
+			OpenTasks:  openTasks[r.ID],
+			TotalTasks: totalTasks[r.ID],
+			Layer:      dag.Layer[r.ID],
+			Critical:   critical[r.ID],
+		})
+	}
+	sort.Slice(result.Nodes, func(i, j int) bool {
+		if result.Nodes[i].Layer != result.Nodes[j].Layer {
+			return result.Nodes[i].Layer < result.Nodes[j].Layer
+		}
+		return result.Nodes[i].ID < result.Nodes[j].ID
+	})
+	for _, e := range dag.Edges {
+		result.Edges = append(result.Edges, primary.ShipmentDAGEdge{From: e.From, To: e.To, Critical: criticalEdges[e]})
+	}
+	return result, nil
+}
+
 // PinShipment pins a shipment.
 func (s *ShipmentServiceImpl) PinShipment(ctx context.Context, shipmentID string) error {
 	return s.shipmentRepo.Pin(ctx, shipmentID)
@@ -231,6 +328,15 @@ func (s *ShipmentServiceImpl) AssignShipmentToWorkbench(ctx context.Context, shi
 		return err
 	}
 
+	// Workbenches may live in the infra database, out of reach of foreign keys
+	exists, err := s.shipmentRepo.WorkbenchExists(ctx, workbenchID)
+	if err != nil {
+		return fmt.Errorf("failed to validate workbench: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("workbench %s not found", workbenchID)
+	}
+
 	// Check if workbench is already assigned to another shipment
 	otherShipmentID, err := s.shipmentRepo.WorkbenchAssignedToOther(ctx, workbenchID, shipmentID)
 	if err != nil {
@@ -277,8 +383,22 @@ func (s *ShipmentServiceImpl) GetShipmentTasks(ctx context.Context, shipmentID s
 	return tasks, nil
 }
 
+// SetShipmentCharter replaces a shipment's charter document.
+func (s *ShipmentServiceImpl) SetShipmentCharter(ctx context.Context, shipmentID, charter string) error {
+	if _, err := s.shipmentRepo.GetByID(ctx, shipmentID); err != nil {
+		return err
+	}
+	if err := s.lockService.CheckUnlocked(ctx, shipmentID); err != nil {
+		return err
+	}
+	return s.shipmentRepo.UpdateCharter(ctx, shipmentID, strings.TrimSpace(charter))
+}
+
 // DeleteShipment deletes a shipment.
 func (s *ShipmentServiceImpl) DeleteShipment(ctx context.Context, shipmentID string) error {
+	if err := s.lockService.CheckUnlocked(ctx, shipmentID); err != nil {
+		return err
+	}
 	return s.shipmentRepo.Delete(ctx, shipmentID)
 }
 
@@ -296,9 +416,13 @@ func (s *ShipmentServiceImpl) recordToShipment(r *secondary.ShipmentRecord) *pri
 		Branch:              r.Branch,
 		Pinned:              r.Pinned,
 		SpecNoteID:          r.SpecNoteID,
+		Charter:             r.Charter,
 		CreatedAt:           r.CreatedAt,
 		UpdatedAt:           r.UpdatedAt,
 		CompletedAt:         r.CompletedAt,
+		TaskCount:           r.TaskCount,
+		TasksClosed:         r.TasksClosed,
+		WorkbenchName:       r.WorkbenchName,
 	}
 }
 
@@ -322,10 +446,16 @@ func recordToTask(r *secondary.TaskRecord) *primary.Task {
 		AssignedWorkbenchID: r.AssignedWorkbenchID,
 		Pinned:              r.Pinned,
 		DependsOn:           dependsOn,
+		Points:              r.Points,
+		DueDate:             r.DueDate,
 		CreatedAt:           r.CreatedAt,
 		UpdatedAt:           r.UpdatedAt,
 		ClaimedAt:           r.ClaimedAt,
 		CompletedAt:         r.CompletedAt,
+		TagName:             r.TagName,
+		ChecklistDone:       r.ChecklistDone,
+		ChecklistTotal:      r.ChecklistTotal,
+		CommentCount:        r.CommentCount,
 	}
 }
 
@@ -26,6 +26,7 @@ type mockShipmentRepository struct {
 	assignWorkbenchErr     error
 	commissionExistsResult bool
 	commissionExistsErr    error
+	missingWorkbenches     map[string]bool
 }
 
 func newMockShipmentRepository() *mockShipmentRepository {
@@ -155,6 +156,10 @@ func (m *mockShipmentRepository) CommissionExists(ctx context.Context, commissio
 	return m.commissionExistsResult, nil
 }
 
+func (m *mockShipmentRepository) WorkbenchExists(ctx context.Context, workbenchID string) (bool, error) {
+	return !m.missingWorkbenches[workbenchID], nil
+}
+
 func (m *mockShipmentRepository) WorkbenchAssignedToOther(ctx context.Context, workbenchID, excludeShipmentID string) (string, error) {
 	if otherID, ok := m.workbenchAssignments[workbenchID]; ok && otherID != excludeShipmentID {
 		return otherID, nil
@@ -162,6 +167,26 @@ func (m *mockShipmentRepository) WorkbenchAssignedToOther(ctx context.Context, w
 	return "", nil
 }
 
+func (m *mockShipmentRepository) UpdateCharter(ctx context.Context, id, charter string) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	if shipment, ok := m.shipments[id]; ok {
+		shipment.Charter = charter
+	}
+	return nil
+}
+
+func (m *mockShipmentRepository) UpdateAutorun(ctx context.Context, id, state string) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	if shipment, ok := m.shipments[id]; ok {
+		shipment.Autorun = state
+	}
+	return nil
+}
+
 // mockTaskRepositoryForShipment implements minimal TaskRepository for shipment tests.
 type mockTaskRepositoryForShipment struct {
 	tasks     map[string]*secondary.TaskRecord
@@ -225,10 +250,18 @@ func (m *mockTaskRepositoryForShipment) UpdateStatus(ctx context.Context, id, st
 	return nil
 }
 
+func (m *mockTaskRepositoryForShipment) RevertStatus(ctx context.Context, id, status string, clearClaim, clearCompleted bool) error {
+	return nil
+}
+
 func (m *mockTaskRepositoryForShipment) Claim(ctx context.Context, id, workbenchID string) error {
 	return nil
 }
 
+func (m *mockTaskRepositoryForShipment) RefreshClaims(ctx context.Context, workbenchID string) (int, error) {
+	return 0, nil
+}
+
 func (m *mockTaskRepositoryForShipment) AssignWorkbenchByShipment(ctx context.Context, shipmentID, workbenchID string) error {
 	return m.assignErr
 }
@@ -265,6 +298,26 @@ func (m *mockTaskRepositoryForShipment) GetNextEntityTagID(ctx context.Context)
 	return "ENTITY-TAG-001", nil
 }
 
+func (m *mockTaskRepositoryForShipment) ListChecklistItems(ctx context.Context, taskID string) ([]*secondary.ChecklistItemRecord, error) {
+	return nil, nil
+}
+
+func (m *mockTaskRepositoryForShipment) AddChecklistItem(ctx context.Context, item *secondary.ChecklistItemRecord) error {
+	return nil
+}
+
+func (m *mockTaskRepositoryForShipment) SetChecklistItemDone(ctx context.Context, itemID int64, done bool) error {
+	return nil
+}
+
+func (m *mockTaskRepositoryForShipment) DeleteChecklistItem(ctx context.Context, itemID int64) error {
+	return nil
+}
+
+func (m *mockTaskRepositoryForShipment) ListConditionalPlanIDs(ctx context.Context, taskID string) ([]string, error) {
+	return nil, nil
+}
+
 // mockNoteServiceForShipment implements primary.NoteService for testing.
 type mockNoteServiceForShipment struct {
 	closedNotes map[string]string // noteID -> reason
@@ -333,6 +386,34 @@ func (m *mockNoteServiceForShipment) SetNoteInFlight(_ context.Context, _ string
 	return nil
 }
 
+func (m *mockNoteServiceForShipment) TriageNote(_ context.Context, _ primary.TriageNoteRequest) error {
+	return nil
+}
+
+func (m *mockNoteServiceForShipment) ListTriageQueue(_ context.Context, _ primary.TriageQueueFilters) ([]*primary.Note, error) {
+	return nil, nil
+}
+
+func (m *mockNoteServiceForShipment) DraftHandoff(_ context.Context, _ primary.DraftHandoffRequest) (*primary.Note, error) {
+	return nil, nil
+}
+
+func (m *mockNoteServiceForShipment) ConfirmHandoff(_ context.Context, _ string) error {
+	return nil
+}
+
+func (m *mockNoteServiceForShipment) ExternalizeNoteContent(_ context.Context, _ int, _ bool) ([]*primary.ExternalizedNote, error) {
+	return nil, nil
+}
+
+func (m *mockNoteServiceForShipment) ProposeAnswers(_ context.Context, _ string) ([]*primary.ProposedAnswer, error) {
+	return nil, nil
+}
+
+func (m *mockNoteServiceForShipment) AnswerQuestion(_ context.Context, _ primary.AnswerQuestionRequest) error {
+	return nil
+}
+
 // ============================================================================
 // Test Helper
 // ============================================================================
@@ -341,10 +422,53 @@ func newTestShipmentService() (*ShipmentServiceImpl, *mockShipmentRepository, *m
 	shipmentRepo := newMockShipmentRepository()
 	taskRepo := newMockTaskRepositoryForShipment()
 	noteService := newMockNoteServiceForShipment()
-	service := NewShipmentService(shipmentRepo, taskRepo, noteService)
+	service := NewShipmentService(shipmentRepo, taskRepo, noteService, NewLockService(newMockEntityLockRepository(), shipmentRepo, newMockPlanRepository()))
 	return service, shipmentRepo, taskRepo
 }
 
+// ============================================================================
+// SetStatus Tests
+// ============================================================================
+
+func TestSetStatus_IllegalTransitionRequiresForce(t *testing.T) {
+	service, shipmentRepo, _ := newTestShipmentService()
+	ctx := context.Background()
+
+	shipmentRepo.shipments["SHIPMENT-001"] = &secondary.ShipmentRecord{
+		ID:           "SHIPMENT-001",
+		CommissionID: "COMM-001",
+		Title:        "Test Shipment",
+		Status:       "ready",
+	}
+
+	// ready -> closed skips the work
+	if err := service.SetStatus(ctx, "SHIPMENT-001", "closed", false); err == nil {
+		t.Fatal("expected error for ready -> closed without force")
+	}
+	if got := shipmentRepo.shipments["SHIPMENT-001"].Status; got != "ready" {
+		t.Errorf("status changed to %q despite refused transition", got)
+	}
+
+	if err := service.SetStatus(ctx, "SHIPMENT-001", "closed", true); err != nil {
+		t.Fatalf("expected forced transition to succeed, got %v", err)
+	}
+	if got := shipmentRepo.shipments["SHIPMENT-001"].Status; got != "closed" {
+		t.Errorf("status = %q, want closed", got)
+	}
+}
+
+func TestStatusTransitions_Shipment(t *testing.T) {
+	service, _, _ := newTestShipmentService()
+
+	transitions := service.StatusTransitions()
+	if len(transitions) == 0 {
+		t.Fatal("expected shipment transitions")
+	}
+	if transitions[0].From != "draft" || transitions[0].To != "ready" || transitions[0].Reason == "" {
+		t.Errorf("unexpected first transition: %+v", transitions[0])
+	}
+}
+
 // ============================================================================
 // CreateShipment Tests
 // ============================================================================
@@ -722,6 +846,28 @@ func TestAssignShipmentToWorkbench_WorkbenchAlreadyAssigned(t *testing.T) {
 	}
 }
 
+func TestAssignShipmentToWorkbench_WorkbenchNotFound(t *testing.T) {
+	service, shipmentRepo, _ := newTestShipmentService()
+	ctx := context.Background()
+
+	shipmentRepo.shipments["SHIPMENT-001"] = &secondary.ShipmentRecord{
+		ID:           "SHIPMENT-001",
+		CommissionID: "COMM-001",
+		Title:        "Test Shipment",
+		Status:       "draft",
+	}
+	shipmentRepo.missingWorkbenches = map[string]bool{"BENCH-999": true}
+
+	err := service.AssignShipmentToWorkbench(ctx, "SHIPMENT-001", "BENCH-999")
+
+	if err == nil {
+		t.Fatal("expected error for non-existent workbench, got nil")
+	}
+	if shipmentRepo.shipments["SHIPMENT-001"].AssignedWorkbenchID != "" {
+		t.Error("shipment should not be assigned to a missing workbench")
+	}
+}
+
 // ============================================================================
 // GetShipmentsByWorkbench Tests
 // ============================================================================
@@ -809,6 +955,42 @@ func TestUpdateShipment_Title(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// SetShipmentCharter Tests
+// ============================================================================
+
+func TestSetShipmentCharter_Success(t *testing.T) {
+	service, shipmentRepo, _ := newTestShipmentService()
+	ctx := context.Background()
+
+	shipmentRepo.shipments["SHIPMENT-001"] = &secondary.ShipmentRecord{
+		ID:           "SHIPMENT-001",
+		CommissionID: "COMM-001",
+		Title:        "Test Shipment",
+		Status:       "draft",
+	}
+
+	err := service.SetShipmentCharter(ctx, "SHIPMENT-001", "  ## Why\n\nReasons.\n\n")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := shipmentRepo.shipments["SHIPMENT-001"].Charter; got != "## Why\n\nReasons." {
+		t.Errorf("expected trimmed charter, got %q", got)
+	}
+}
+
+func TestSetShipmentCharter_NotFound(t *testing.T) {
+	service, _, _ := newTestShipmentService()
+	ctx := context.Background()
+
+	err := service.SetShipmentCharter(ctx, "SHIPMENT-999", "charter")
+
+	if err == nil {
+		t.Fatal("expected error for non-existent shipment, got nil")
+	}
+}
+
 // ============================================================================
 // DeleteShipment Tests
 // ============================================================================
@@ -842,7 +1024,7 @@ func TestCreateShipment_WithSpecNoteID(t *testing.T) {
 	shipmentRepo := newMockShipmentRepository()
 	taskRepo := newMockTaskRepositoryForShipment()
 	noteService := newMockNoteServiceForShipment()
-	service := NewShipmentService(shipmentRepo, taskRepo, noteService)
+	service := NewShipmentService(shipmentRepo, taskRepo, noteService, NewLockService(newMockEntityLockRepository(), shipmentRepo, newMockPlanRepository()))
 	ctx := context.Background()
 
 	req := primary.CreateShipmentRequest{
@@ -873,7 +1055,7 @@ func TestCreateShipment_WithoutSpecNoteID(t *testing.T) {
 	shipmentRepo := newMockShipmentRepository()
 	taskRepo := newMockTaskRepositoryForShipment()
 	noteService := newMockNoteServiceForShipment()
-	service := NewShipmentService(shipmentRepo, taskRepo, noteService)
+	service := NewShipmentService(shipmentRepo, taskRepo, noteService, NewLockService(newMockEntityLockRepository(), shipmentRepo, newMockPlanRepository()))
 	ctx := context.Background()
 
 	req := primary.CreateShipmentRequest{
@@ -902,7 +1084,7 @@ func TestCompleteShipment_ClosesSpecNote(t *testing.T) {
 	shipmentRepo := newMockShipmentRepository()
 	taskRepo := newMockTaskRepositoryForShipment()
 	noteService := newMockNoteServiceForShipment()
-	service := NewShipmentService(shipmentRepo, taskRepo, noteService)
+	service := NewShipmentService(shipmentRepo, taskRepo, noteService, NewLockService(newMockEntityLockRepository(), shipmentRepo, newMockPlanRepository()))
 	ctx := context.Background()
 
 	// Create a shipment with a SpecNoteID
@@ -934,7 +1116,7 @@ func TestCompleteShipment_WithoutSpecNote(t *testing.T) {
 	shipmentRepo := newMockShipmentRepository()
 	taskRepo := newMockTaskRepositoryForShipment()
 	noteService := newMockNoteServiceForShipment()
-	service := NewShipmentService(shipmentRepo, taskRepo, noteService)
+	service := NewShipmentService(shipmentRepo, taskRepo, noteService, NewLockService(newMockEntityLockRepository(), shipmentRepo, newMockPlanRepository()))
 	ctx := context.Background()
 
 	// Create a shipment without SpecNoteID
@@ -956,3 +1138,38 @@ func TestCompleteShipment_WithoutSpecNote(t *testing.T) {
 		t.Errorf("expected no notes to be closed, got %d", len(noteService.closedNotes))
 	}
 }
+
+// ============================================================================
+// GetShipmentDAG Tests
+// ============================================================================
+
+func TestGetShipmentDAG(t *testing.T) {
+	service, shipmentRepo, taskRepo := newTestShipmentService()
+	ctx := context.Background()
+
+	shipmentRepo.shipments["SHIP-001"] = &secondary.ShipmentRecord{ID: "SHIP-001", CommissionID: "COMM-001", Title: "Schema", Status: "in-progress"}
+	shipmentRepo.shipments["SHIP-002"] = &secondary.ShipmentRecord{ID: "SHIP-002", CommissionID: "COMM-001", Title: "API", Status: "ready"}
+	shipmentRepo.shipments["SHIP-003"] = &secondary.ShipmentRecord{ID: "SHIP-003", CommissionID: "COMM-001", Title: "Docs", Status: "draft"}
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", ShipmentID: "SHIP-001", Status: "closed"}
+	taskRepo.tasks["TASK-002"] = &secondary.TaskRecord{ID: "TASK-002", ShipmentID: "SHIP-001", Status: "open"}
+	taskRepo.tasks["TASK-003"] = &secondary.TaskRecord{ID: "TASK-003", ShipmentID: "SHIP-002", Status: "open", DependsOn: `["TASK-002"]`}
+
+	dag, err := service.GetShipmentDAG(ctx, "COMM-001")
+	if err != nil {
+		t.Fatalf("GetShipmentDAG failed: %v", err)
+	}
+
+	if len(dag.Nodes) != 3 || dag.Nodes[0].ID != "SHIP-001" || dag.Nodes[1].ID != "SHIP-003" || dag.Nodes[2].ID != "SHIP-002" {
+		t.Fatalf("unexpected node order: %+v", dag.Nodes)
+	}
+	first := dag.Nodes[0]
+	if first.OpenTasks != 1 || first.TotalTasks != 2 || !first.Critical {
+		t.Errorf("unexpected SHIP-001 node: %+v", first)
+	}
+	if dag.Nodes[1].Critical {
+		t.Error("expected SHIP-003 off the critical path")
+	}
+	if len(dag.Edges) != 1 || dag.Edges[0].From != "SHIP-001" || dag.Edges[0].To != "SHIP-002" || !dag.Edges[0].Critical {
+		t.Errorf("unexpected edges: %+v", dag.Edges)
+	}
+}
@@ -3,19 +3,26 @@ package app
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	corenote "github.com/example/orc/internal/core/note"
+	coresummary "github.com/example/orc/internal/core/summary"
 	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
 )
 
 // SummaryServiceImpl implements the SummaryService interface.
+//
+// Containers (tomes, shipments) come from their services; leaf data (tasks,
+// notes, plans, bench names) is loaded through SummaryRepository in one
+// batched query per entity type, with independent loads running concurrently.
 type SummaryServiceImpl struct {
 	commissionService primary.CommissionService
 	tomeService       primary.TomeService
 	shipmentService   primary.ShipmentService
-	taskService       primary.TaskService
 	noteService       primary.NoteService
-	workbenchService  primary.WorkbenchService
-	planService       primary.PlanService
+	summaryRepo       secondary.SummaryRepository
+	ledgerService     primary.LedgerService // Optional; resolves the focused shipment's links to other ledgers
 }
 
 // NewSummaryService creates a new SummaryService with injected dependencies.
@@ -23,22 +30,36 @@ func NewSummaryService(
 	commissionService primary.CommissionService,
 	tomeService primary.TomeService,
 	shipmentService primary.ShipmentService,
-	taskService primary.TaskService,
 	noteService primary.NoteService,
-	workbenchService primary.WorkbenchService,
-	planService primary.PlanService,
+	summaryRepo secondary.SummaryRepository,
 ) *SummaryServiceImpl {
 	return &SummaryServiceImpl{
 		commissionService: commissionService,
 		tomeService:       tomeService,
 		shipmentService:   shipmentService,
-		taskService:       taskService,
 		noteService:       noteService,
-		workbenchService:  workbenchService,
-		planService:       planService,
+		summaryRepo:       summaryRepo,
 	}
 }
 
+// WithLedgerService shows the focused shipment's links to other ledgers.
+func (s *SummaryServiceImpl) WithLedgerService(ledgerService primary.LedgerService) *SummaryServiceImpl {
+	s.ledgerService = ledgerService
+	return s
+}
+
+// summaryLeaves holds batch-loaded leaf data, indexed by parent ID.
+type summaryLeaves struct {
+	tasksByShipment map[string][]*secondary.TaskRecord
+	notesByShipment map[string][]*secondary.NoteRecord
+	notesByTome     map[string][]*secondary.NoteRecord
+	plansByTask     map[string][]*secondary.PlanRecord
+	checklistByTask map[string]secondary.ChecklistCount
+	benchNames      map[string]string
+	commentCounts   map[string]int    // entity ID -> comments, for open containers and focused tasks
+	aliases         map[string]string // entity ID -> slug, loaded with the commission
+}
+
 // GetCommissionSummary returns a flat summary of shipments and tomes under a commission.
 func (s *SummaryServiceImpl) GetCommissionSummary(ctx context.Context, req primary.SummaryRequest) (*primary.CommissionSummary, error) {
 	// Debug helper
@@ -49,39 +70,99 @@ func (s *SummaryServiceImpl) GetCommissionSummary(ctx context.Context, req prima
 		}
 	}
 
-	// Validate commission exists
-	commission, err := s.commissionService.GetCommission(ctx, req.CommissionID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get commission: %w", err)
-	}
+	// Phase 1: commission and its direct children (independent, load concurrently)
+	var (
+		wg                 sync.WaitGroup
+		commission         *primary.Commission
+		allTomes           []*primary.Tome
+		allShipments       []*primary.Shipment
+		commissionNotes    []*primary.Note
+		commissionErr      error
+		tomesErr           error
+		shipmentsErr       error
+		commissionNotesErr error
+		aliases            map[string]string
+		aliasesErr         error
+	)
+	wg.Add(5)
+	go func() {
+		defer wg.Done()
+		commission, commissionErr = s.commissionService.GetCommission(ctx, req.CommissionID)
+	}()
+	go func() {
+		defer wg.Done()
+		allTomes, tomesErr = s.tomeService.ListTomes(ctx, primary.TomeFilters{CommissionID: req.CommissionID})
+	}()
+	go func() {
+		defer wg.Done()
+		allShipments, shipmentsErr = s.shipmentService.ListShipments(ctx, primary.ShipmentFilters{CommissionID: req.CommissionID})
+	}()
+	go func() {
+		defer wg.Done()
+		commissionNotes, commissionNotesErr = s.noteService.GetNotesByContainer(ctx, "commission", req.CommissionID)
+	}()
+	go func() {
+		defer wg.Done()
+		aliases, aliasesErr = s.summaryRepo.GetAliasesByCommission(ctx, req.CommissionID)
+	}()
+	wg.Wait()
 
-	// Fetch all tomes for this commission
-	allTomes, err := s.tomeService.ListTomes(ctx, primary.TomeFilters{CommissionID: req.CommissionID})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list tomes: %w", err)
+	if commissionErr != nil {
+		return nil, fmt.Errorf("failed to get commission: %w", commissionErr)
 	}
-
-	// Fetch all shipments for this commission
-	allShipments, err := s.shipmentService.ListShipments(ctx, primary.ShipmentFilters{CommissionID: req.CommissionID})
-	if err != nil {
-		return nil, fmt.Errorf("failed to list shipments: %w", err)
+	if tomesErr != nil {
+		return nil, fmt.Errorf("failed to list tomes: %w", tomesErr)
+	}
+	if shipmentsErr != nil {
+		return nil, fmt.Errorf("failed to list shipments: %w", shipmentsErr)
+	}
+	if aliasesErr != nil {
+		return nil, fmt.Errorf("failed to load aliases: %w", aliasesErr)
 	}
 
 	addDebug(fmt.Sprintf("Fetched %d tomes, %d shipments", len(allTomes), len(allShipments)))
 
-	// Build flat shipment list
-	var shipmentSummaries []primary.ShipmentSummary
+	// Filter out closed containers before loading their leaves
+	var openShipments []*primary.Shipment
 	for _, ship := range allShipments {
 		if ship.Status == "closed" {
 			addDebug(fmt.Sprintf("Hidden: %s (%s) - status is closed", ship.ID, ship.Title))
 			continue
 		}
+		openShipments = append(openShipments, ship)
+	}
+	var openTomes []*primary.Tome
+	for _, tome := range allTomes {
+		if tome.Status == "closed" {
+			addDebug(fmt.Sprintf("Hidden: %s (%s) - status is closed", tome.ID, tome.Title))
+			continue
+		}
+		openTomes = append(openTomes, tome)
+	}
 
-		shipSummary, err := s.buildShipmentSummary(ctx, ship, req.FocusID)
-		if err != nil {
-			continue // Skip on error
+	// Apply the requested view (container kinds, statuses, task tags)
+	openShipments, openTomes, err := s.applyFilter(ctx, req.CommissionID, req.Filter, openShipments, openTomes, addDebug)
+	if err != nil {
+		return nil, err
+	}
+
+	// Phase 2: batched leaf loads
+	leaves, err := s.loadLeaves(ctx, openShipments, openTomes, req.FocusID)
+	if err != nil {
+		return nil, err
+	}
+	leaves.aliases = aliases
+
+	// Build flat shipment list
+	var shipmentSummaries []primary.ShipmentSummary
+	for _, ship := range openShipments {
+		summary := buildShipmentSummary(ship, req.FocusID, leaves)
+		if summary.IsFocused && s.ledgerService != nil {
+			if refs, err := s.ledgerService.ListRefs(ctx, ship.ID); err == nil {
+				summary.LinkedRefs = refs
+			}
 		}
-		shipmentSummaries = append(shipmentSummaries, *shipSummary)
+		shipmentSummaries = append(shipmentSummaries, summary)
 	}
 
 	// Determine if this is the focused commission (needed before tome expansion)
@@ -109,23 +190,14 @@ func (s *SummaryServiceImpl) GetCommissionSummary(ctx context.Context, req prima
 
 	// Build flat tome list
 	var tomeSummaries []primary.TomeSummary
-	for _, tome := range allTomes {
-		if tome.Status == "closed" {
-			addDebug(fmt.Sprintf("Hidden: %s (%s) - status is closed", tome.ID, tome.Title))
-			continue
-		}
+	for _, tome := range openTomes {
 		expandNotes := tome.ID == req.FocusID || focusIsCommission || focusIsShipment
-		tomeSummary, err := s.buildTomeSummary(ctx, tome, req.FocusID, expandNotes)
-		if err != nil {
-			continue // Skip on error
-		}
-		tomeSummaries = append(tomeSummaries, *tomeSummary)
+		tomeSummaries = append(tomeSummaries, buildTomeSummary(tome, req.FocusID, expandNotes, leaves))
 	}
 
-	// Fetch commission-level notes (notes with no container)
+	// Commission-level notes (notes with no container)
 	var noteSummaries []primary.NoteSummary
-	commissionNotes, err := s.noteService.GetNotesByContainer(ctx, "commission", req.CommissionID)
-	if err == nil {
+	if commissionNotesErr == nil {
 		for _, note := range commissionNotes {
 			if note.Status == "closed" {
 				continue // Skip closed notes
@@ -157,132 +229,266 @@ func (s *SummaryServiceImpl) GetCommissionSummary(ctx context.Context, req prima
 	}, nil
 }
 
+// applyFilter drops the containers a summary filter hides.
+func (s *SummaryServiceImpl) applyFilter(ctx context.Context, commissionID string, filter primary.SummaryFilter, shipments []*primary.Shipment, tomes []*primary.Tome, addDebug func(string)) ([]*primary.Shipment, []*primary.Tome, error) {
+	view, err := coresummary.NormalizeView(coresummary.View{Containers: filter.Containers, Statuses: filter.Statuses, Tags: filter.Tags})
+	if err != nil {
+		return nil, nil, err
+	}
+	if view.IsEmpty() {
+		return shipments, tomes, nil
+	}
+
+	var tagged map[string]bool
+	if len(view.Tags) > 0 {
+		ids, err := s.summaryRepo.ListShipmentIDsByTaskTags(ctx, commissionID, view.Tags)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to filter shipments by tag: %w", err)
+		}
+		tagged = make(map[string]bool, len(ids))
+		for _, id := range ids {
+			tagged[id] = true
+		}
+	}
+
+	var keptShipments []*primary.Shipment
+	for _, ship := range shipments {
+		if !view.ShowsContainer(coresummary.ContainerShipment) || !view.ShowsStatus(ship.Status) || (tagged != nil && !tagged[ship.ID]) {
+			addDebug(fmt.Sprintf("Hidden: %s (%s) - outside view %s", ship.ID, ship.Title, view.Describe()))
+			continue
+		}
+		keptShipments = append(keptShipments, ship)
+	}
+	var keptTomes []*primary.Tome
+	for _, tome := range tomes {
+		if !view.ShowsContainer(coresummary.ContainerTome) || !view.ShowsStatus(tome.Status) {
+			addDebug(fmt.Sprintf("Hidden: %s (%s) - outside view %s", tome.ID, tome.Title, view.Describe()))
+			continue
+		}
+		keptTomes = append(keptTomes, tome)
+	}
+	return keptShipments, keptTomes, nil
+}
+
+// loadLeaves batch-loads tasks, notes, and bench names for the given containers
+// concurrently, then plans and checklist counts for the focused shipment's tasks,
+// then comment counts for all of them.
+func (s *SummaryServiceImpl) loadLeaves(ctx context.Context, shipments []*primary.Shipment, tomes []*primary.Tome, focusID string) (*summaryLeaves, error) {
+	shipmentIDs := make([]string, 0, len(shipments))
+	var benchIDs []string
+	for _, ship := range shipments {
+		shipmentIDs = append(shipmentIDs, ship.ID)
+		if ship.AssignedWorkbenchID != "" {
+			benchIDs = append(benchIDs, ship.AssignedWorkbenchID)
+		}
+	}
+	tomeIDs := make([]string, 0, len(tomes))
+	for _, tome := range tomes {
+		tomeIDs = append(tomeIDs, tome.ID)
+	}
+
+	var (
+		wg                           sync.WaitGroup
+		tasks                        []*secondary.TaskRecord
+		notes                        []*secondary.NoteRecord
+		benchNames                   map[string]string
+		tasksErr, notesErr, benchErr error
+	)
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		tasks, tasksErr = s.summaryRepo.ListTasksByShipments(ctx, shipmentIDs)
+	}()
+	go func() {
+		defer wg.Done()
+		notes, notesErr = s.summaryRepo.ListNotesByContainers(ctx, shipmentIDs, tomeIDs)
+	}()
+	go func() {
+		defer wg.Done()
+		benchNames, benchErr = s.summaryRepo.GetWorkbenchNames(ctx, benchIDs)
+	}()
+	wg.Wait()
+
+	if tasksErr != nil {
+		return nil, fmt.Errorf("failed to load tasks: %w", tasksErr)
+	}
+	if notesErr != nil {
+		return nil, fmt.Errorf("failed to load notes: %w", notesErr)
+	}
+	if benchErr != nil {
+		return nil, fmt.Errorf("failed to load workbench names: %w", benchErr)
+	}
+
+	leaves := &summaryLeaves{
+		tasksByShipment: make(map[string][]*secondary.TaskRecord),
+		notesByShipment: make(map[string][]*secondary.NoteRecord),
+		notesByTome:     make(map[string][]*secondary.NoteRecord),
+		plansByTask:     make(map[string][]*secondary.PlanRecord),
+		benchNames:      benchNames,
+	}
+	var focusedTaskIDs []string
+	for _, t := range tasks {
+		leaves.tasksByShipment[t.ShipmentID] = append(leaves.tasksByShipment[t.ShipmentID], t)
+		if t.ShipmentID == focusID && t.Status != "closed" {
+			focusedTaskIDs = append(focusedTaskIDs, t.ID)
+		}
+	}
+	for _, n := range notes {
+		if n.ShipmentID != "" {
+			leaves.notesByShipment[n.ShipmentID] = append(leaves.notesByShipment[n.ShipmentID], n)
+		}
+		if n.TomeID != "" {
+			leaves.notesByTome[n.TomeID] = append(leaves.notesByTome[n.TomeID], n)
+		}
+	}
+
+	// Plans are only rendered under the focused shipment's open tasks
+	if len(focusedTaskIDs) > 0 {
+		plans, err := s.summaryRepo.ListPlansByTasks(ctx, focusedTaskIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load plans: %w", err)
+		}
+		for _, p := range plans {
+			leaves.plansByTask[p.TaskID] = append(leaves.plansByTask[p.TaskID], p)
+		}
+
+		leaves.checklistByTask, err = s.summaryRepo.CountChecklistByTasks(ctx, focusedTaskIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load checklist counts: %w", err)
+		}
+	}
+
+	commentedIDs := append(append(append([]string{}, shipmentIDs...), tomeIDs...), focusedTaskIDs...)
+	commentCounts, err := s.summaryRepo.CountCommentsByEntities(ctx, commentedIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load comment counts: %w", err)
+	}
+	leaves.commentCounts = commentCounts
+
+	return leaves, nil
+}
+
 // buildTomeSummary creates a TomeSummary with note count.
 // When expandNotes is true, includes the full Notes slice (for focused tomes).
-func (s *SummaryServiceImpl) buildTomeSummary(ctx context.Context, tome *primary.Tome, focusID string, expandNotes bool) (*primary.TomeSummary, error) {
-	// Get notes for this tome
-	notes, err := s.tomeService.GetTomeNotes(ctx, tome.ID)
+func buildTomeSummary(tome *primary.Tome, focusID string, expandNotes bool, leaves *summaryLeaves) primary.TomeSummary {
 	noteCount := 0
 	var noteSummaries []primary.NoteSummary
-
-	if err == nil {
-		for _, n := range notes {
-			if n.Status != "closed" {
-				noteCount++
-				if expandNotes {
-					noteSummaries = append(noteSummaries, primary.NoteSummary{
-						ID:     n.ID,
-						Title:  n.Title,
-						Type:   n.Type,
-						Status: n.Status,
-						Pinned: n.Pinned,
-					})
-				}
+	for _, n := range leaves.notesByTome[tome.ID] {
+		if n.Status != "closed" {
+			noteCount++
+			if expandNotes {
+				noteSummaries = append(noteSummaries, noteRecordToSummary(n))
 			}
 		}
 	}
+	concerns, findings := countOpenIssues(leaves.notesByTome[tome.ID])
 
-	return &primary.TomeSummary{
-		ID:        tome.ID,
-		Title:     tome.Title,
-		Status:    tome.Status,
-		NoteCount: noteCount,
-		IsFocused: tome.ID == focusID,
-		Pinned:    tome.Pinned,
-		Notes:     noteSummaries,
-	}, nil
+	return primary.TomeSummary{
+		ID:           tome.ID,
+		Alias:        leaves.aliases[tome.ID],
+		Title:        tome.Title,
+		Status:       tome.Status,
+		NoteCount:    noteCount,
+		OpenConcerns: concerns,
+		OpenFindings: findings,
+		CommentCount: leaves.commentCounts[tome.ID],
+		IsFocused:    tome.ID == focusID,
+		Pinned:       tome.Pinned,
+		Notes:        noteSummaries,
+	}
 }
 
 // buildShipmentSummary creates a ShipmentSummary with task progress.
-func (s *SummaryServiceImpl) buildShipmentSummary(ctx context.Context, ship *primary.Shipment, focusID string) (*primary.ShipmentSummary, error) {
-	// Get tasks for this shipment
-	tasks, err := s.shipmentService.GetShipmentTasks(ctx, ship.ID)
+func buildShipmentSummary(ship *primary.Shipment, focusID string, leaves *summaryLeaves) primary.ShipmentSummary {
 	tasksDone := 0
 	tasksTotal := 0
 	var taskSummaries []primary.TaskSummary
 
 	isFocused := ship.ID == focusID
 
-	if err == nil {
-		for _, t := range tasks {
-			tasksTotal++
-			if t.Status == "closed" {
-				tasksDone++
+	for _, t := range leaves.tasksByShipment[ship.ID] {
+		tasksTotal++
+		if t.Status == "closed" {
+			tasksDone++
+		}
+		// Include non-closed tasks (with plans) for focused shipment
+		if isFocused && t.Status != "closed" {
+			checklist := leaves.checklistByTask[t.ID]
+			taskSummary := primary.TaskSummary{
+				ID:             t.ID,
+				Alias:          leaves.aliases[t.ID],
+				Title:          t.Title,
+				Status:         t.Status,
+				ChecklistDone:  checklist.Done,
+				ChecklistTotal: checklist.Total,
+				CommentCount:   leaves.commentCounts[t.ID],
 			}
-			// Include non-closed tasks for focused shipment
-			if isFocused && t.Status != "closed" {
-				taskSummary := primary.TaskSummary{
-					ID:     t.ID,
-					Title:  t.Title,
-					Status: t.Status,
-				}
-				// Fetch children for focused shipment tasks
-				s.fetchTaskChildren(ctx, &taskSummary)
-				taskSummaries = append(taskSummaries, taskSummary)
+			for _, p := range leaves.plansByTask[t.ID] {
+				taskSummary.Plans = append(taskSummary.Plans, primary.PlanSummary{
+					ID:     p.ID,
+					Status: p.Status,
+				})
 			}
+			taskSummaries = append(taskSummaries, taskSummary)
 		}
 	}
 
-	// Get notes for this shipment (count open notes, expand if focused)
+	// Count open notes, expand if focused
 	noteCount := 0
 	var noteSummaries []primary.NoteSummary
-	notes, err := s.noteService.GetNotesByContainer(ctx, "shipment", ship.ID)
-	if err == nil {
-		for _, n := range notes {
-			if n.Status != "closed" {
-				noteCount++
-				if isFocused {
-					noteSummaries = append(noteSummaries, primary.NoteSummary{
-						ID:     n.ID,
-						Title:  n.Title,
-						Type:   n.Type,
-						Status: n.Status,
-						Pinned: n.Pinned,
-					})
-				}
+	for _, n := range leaves.notesByShipment[ship.ID] {
+		if n.Status != "closed" {
+			noteCount++
+			if isFocused {
+				noteSummaries = append(noteSummaries, noteRecordToSummary(n))
 			}
 		}
 	}
+	concerns, findings := countOpenIssues(leaves.notesByShipment[ship.ID])
 
-	// Get workbench name if assigned
-	benchName := ""
-	if ship.AssignedWorkbenchID != "" {
-		bench, err := s.workbenchService.GetWorkbench(ctx, ship.AssignedWorkbenchID)
-		if err == nil && bench != nil {
-			benchName = bench.Name
-		}
+	return primary.ShipmentSummary{
+		ID:           ship.ID,
+		Alias:        leaves.aliases[ship.ID],
+		Title:        ship.Title,
+		Status:       ship.Status,
+		IsFocused:    isFocused,
+		Pinned:       ship.Pinned,
+		BenchID:      ship.AssignedWorkbenchID,
+		BenchName:    leaves.benchNames[ship.AssignedWorkbenchID],
+		TasksDone:    tasksDone,
+		TasksTotal:   tasksTotal,
+		NoteCount:    noteCount,
+		OpenConcerns: concerns,
+		OpenFindings: findings,
+		CommentCount: leaves.commentCounts[ship.ID],
+		Tasks:        taskSummaries,
+		Notes:        noteSummaries,
 	}
-
-	return &primary.ShipmentSummary{
-		ID:         ship.ID,
-		Title:      ship.Title,
-		Status:     ship.Status,
-		IsFocused:  isFocused,
-		Pinned:     ship.Pinned,
-		BenchID:    ship.AssignedWorkbenchID,
-		BenchName:  benchName,
-		TasksDone:  tasksDone,
-		TasksTotal: tasksTotal,
-		NoteCount:  noteCount,
-		Tasks:      taskSummaries,
-		Notes:      noteSummaries,
-	}, nil
 }
 
-// fetchTaskChildren populates the Plans for a task.
-func (s *SummaryServiceImpl) fetchTaskChildren(ctx context.Context, task *primary.TaskSummary) {
-	// Fetch plans for this task
-	if s.planService != nil {
-		plans, err := s.planService.ListPlans(ctx, primary.PlanFilters{TaskID: task.ID})
-		if err == nil {
-			for _, p := range plans {
-				task.Plans = append(task.Plans, primary.PlanSummary{
-					ID:     p.ID,
-					Status: p.Status,
-				})
-			}
+// countOpenIssues counts a container's open concern and finding notes.
+func countOpenIssues(notes []*secondary.NoteRecord) (concerns, findings int) {
+	for _, n := range notes {
+		if n.Status == "closed" || !corenote.IsIssue(n.Type) {
+			continue
+		}
+		if n.Type == primary.NoteTypeConcern {
+			concerns++
+		} else {
+			findings++
 		}
 	}
+	return concerns, findings
+}
+
+func noteRecordToSummary(n *secondary.NoteRecord) primary.NoteSummary {
+	return primary.NoteSummary{
+		ID:     n.ID,
+		Title:  n.Title,
+		Type:   n.Type,
+		Status: n.Status,
+		Pinned: n.Pinned,
+	}
 }
 
 // Ensure SummaryServiceImpl implements the interface
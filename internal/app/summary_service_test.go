@@ -2,9 +2,14 @@ package app
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
 )
 
 // ============================================================================
@@ -79,14 +84,12 @@ func (m *mockCommissionServiceForSummary) UnpinCommission(_ context.Context, _ s
 
 // mockTomeServiceForSummary implements primary.TomeService for testing.
 type mockTomeServiceForSummary struct {
-	tomes     map[string]*primary.Tome
-	tomeNotes map[string][]*primary.Note
+	tomes map[string]*primary.Tome
 }
 
 func newMockTomeServiceForSummary() *mockTomeServiceForSummary {
 	return &mockTomeServiceForSummary{
-		tomes:     make(map[string]*primary.Tome),
-		tomeNotes: make(map[string][]*primary.Note),
+		tomes: make(map[string]*primary.Tome),
 	}
 }
 
@@ -140,11 +143,12 @@ func (m *mockTomeServiceForSummary) GetTomesByWorkbench(_ context.Context, _ str
 	return nil, nil
 }
 
-func (m *mockTomeServiceForSummary) GetTomeNotes(_ context.Context, tomeID string) ([]*primary.Note, error) {
-	if notes, ok := m.tomeNotes[tomeID]; ok {
-		return notes, nil
-	}
-	return []*primary.Note{}, nil
+func (m *mockTomeServiceForSummary) GetTomeNotes(_ context.Context, _ string) ([]*primary.Note, error) {
+	return nil, nil
+}
+
+func (m *mockTomeServiceForSummary) SetTomeCharter(_ context.Context, _, _ string) error {
+	return nil
 }
 
 func (m *mockTomeServiceForSummary) ParkTome(_ context.Context, _ string) error {
@@ -155,16 +159,22 @@ func (m *mockTomeServiceForSummary) UnparkTome(_ context.Context, _, _ string) e
 	return nil
 }
 
+func (m *mockTomeServiceForSummary) ExportTome(_ context.Context, _ primary.ExportTomeRequest) (string, error) {
+	return "", nil
+}
+
+func (m *mockTomeServiceForSummary) ReorderTomeNote(_ context.Context, _ primary.ReorderTomeNoteRequest) error {
+	return nil
+}
+
 // mockShipmentServiceForSummary implements primary.ShipmentService for testing.
 type mockShipmentServiceForSummary struct {
-	shipments     map[string]*primary.Shipment
-	shipmentTasks map[string][]*primary.Task
+	shipments map[string]*primary.Shipment
 }
 
 func newMockShipmentServiceForSummary() *mockShipmentServiceForSummary {
 	return &mockShipmentServiceForSummary{
-		shipments:     make(map[string]*primary.Shipment),
-		shipmentTasks: make(map[string][]*primary.Task),
+		shipments: make(map[string]*primary.Shipment),
 	}
 }
 
@@ -222,11 +232,8 @@ func (m *mockShipmentServiceForSummary) GetShipmentsByWorkbench(_ context.Contex
 	return nil, nil
 }
 
-func (m *mockShipmentServiceForSummary) GetShipmentTasks(_ context.Context, shipmentID string) ([]*primary.Task, error) {
-	if tasks, ok := m.shipmentTasks[shipmentID]; ok {
-		return tasks, nil
-	}
-	return []*primary.Task{}, nil
+func (m *mockShipmentServiceForSummary) GetShipmentTasks(_ context.Context, _ string) ([]*primary.Task, error) {
+	return nil, nil
 }
 
 func (m *mockShipmentServiceForSummary) DeleteShipment(_ context.Context, _ string) error {
@@ -253,81 +260,18 @@ func (m *mockShipmentServiceForSummary) SetStatus(_ context.Context, _, _ string
 	return nil
 }
 
-// mockTaskServiceForSummary implements primary.TaskService for testing.
-type mockTaskServiceForSummary struct{}
-
-func newMockTaskServiceForSummary() *mockTaskServiceForSummary {
-	return &mockTaskServiceForSummary{}
-}
-
-func (m *mockTaskServiceForSummary) CreateTask(_ context.Context, _ primary.CreateTaskRequest) (*primary.CreateTaskResponse, error) {
-	return nil, nil
-}
-
-func (m *mockTaskServiceForSummary) GetTask(_ context.Context, _ string) (*primary.Task, error) {
-	return nil, nil
-}
-
-func (m *mockTaskServiceForSummary) ListTasks(_ context.Context, _ primary.TaskFilters) ([]*primary.Task, error) {
-	return nil, nil
-}
-
-func (m *mockTaskServiceForSummary) ClaimTask(_ context.Context, _ primary.ClaimTaskRequest) error {
-	return nil
-}
-
-func (m *mockTaskServiceForSummary) CompleteTask(_ context.Context, _ string) error {
-	return nil
-}
-
-func (m *mockTaskServiceForSummary) PauseTask(_ context.Context, _ string) error {
-	return nil
-}
-
-func (m *mockTaskServiceForSummary) ResumeTask(_ context.Context, _ string) error {
+func (m *mockShipmentServiceForSummary) SetShipmentCharter(_ context.Context, _, _ string) error {
 	return nil
 }
 
-func (m *mockTaskServiceForSummary) UpdateTask(_ context.Context, _ primary.UpdateTaskRequest) error {
+func (m *mockShipmentServiceForSummary) StatusTransitions() []primary.StatusTransition {
 	return nil
 }
 
-func (m *mockTaskServiceForSummary) PinTask(_ context.Context, _ string) error {
-	return nil
-}
-
-func (m *mockTaskServiceForSummary) UnpinTask(_ context.Context, _ string) error {
-	return nil
-}
-
-func (m *mockTaskServiceForSummary) DeleteTask(_ context.Context, _ string, _ bool) error {
-	return nil
-}
-
-func (m *mockTaskServiceForSummary) GetTasksByWorkbench(_ context.Context, _ string) ([]*primary.Task, error) {
+func (m *mockShipmentServiceForSummary) GetShipmentDAG(ctx context.Context, commissionID string) (*primary.ShipmentDAG, error) {
 	return nil, nil
 }
 
-func (m *mockTaskServiceForSummary) TagTask(_ context.Context, _, _ string) error {
-	return nil
-}
-
-func (m *mockTaskServiceForSummary) UntagTask(_ context.Context, _ string) error {
-	return nil
-}
-
-func (m *mockTaskServiceForSummary) ListTasksByTag(_ context.Context, _ string) ([]*primary.Task, error) {
-	return nil, nil
-}
-
-func (m *mockTaskServiceForSummary) DiscoverTasks(_ context.Context, _ string) ([]*primary.Task, error) {
-	return nil, nil
-}
-
-func (m *mockTaskServiceForSummary) MoveTask(_ context.Context, _ primary.MoveTaskRequest) error {
-	return nil
-}
-
 // mockNoteServiceForSummary implements primary.NoteService for testing.
 type mockNoteServiceForSummary struct{}
 
@@ -387,70 +331,148 @@ func (m *mockNoteServiceForSummary) SetNoteInFlight(_ context.Context, _ string)
 	return nil
 }
 
-// mockWorkbenchServiceForSummary implements primary.WorkbenchService for testing.
-type mockWorkbenchServiceForSummary struct {
-	workbenches map[string]*primary.Workbench
+func (m *mockNoteServiceForSummary) TriageNote(_ context.Context, _ primary.TriageNoteRequest) error {
+	return nil
 }
 
-func newMockWorkbenchServiceForSummary() *mockWorkbenchServiceForSummary {
-	return &mockWorkbenchServiceForSummary{
-		workbenches: make(map[string]*primary.Workbench),
-	}
+func (m *mockNoteServiceForSummary) ListTriageQueue(_ context.Context, _ primary.TriageQueueFilters) ([]*primary.Note, error) {
+	return nil, nil
 }
 
-func (m *mockWorkbenchServiceForSummary) CreateWorkbench(_ context.Context, _ primary.CreateWorkbenchRequest) (*primary.CreateWorkbenchResponse, error) {
+func (m *mockNoteServiceForSummary) DraftHandoff(_ context.Context, _ primary.DraftHandoffRequest) (*primary.Note, error) {
 	return nil, nil
 }
 
-func (m *mockWorkbenchServiceForSummary) GetWorkbench(_ context.Context, id string) (*primary.Workbench, error) {
-	if wb, ok := m.workbenches[id]; ok {
-		return wb, nil
-	}
-	return nil, nil
+func (m *mockNoteServiceForSummary) ConfirmHandoff(_ context.Context, _ string) error {
+	return nil
 }
 
-func (m *mockWorkbenchServiceForSummary) GetWorkbenchByPath(_ context.Context, _ string) (*primary.Workbench, error) {
+func (m *mockNoteServiceForSummary) ExternalizeNoteContent(_ context.Context, _ int, _ bool) ([]*primary.ExternalizedNote, error) {
 	return nil, nil
 }
 
-func (m *mockWorkbenchServiceForSummary) ListWorkbenches(_ context.Context, _ primary.WorkbenchFilters) ([]*primary.Workbench, error) {
+func (m *mockNoteServiceForSummary) ProposeAnswers(_ context.Context, _ string) ([]*primary.ProposedAnswer, error) {
 	return nil, nil
 }
 
-func (m *mockWorkbenchServiceForSummary) RenameWorkbench(_ context.Context, _ primary.RenameWorkbenchRequest) error {
+func (m *mockNoteServiceForSummary) AnswerQuestion(_ context.Context, _ primary.AnswerQuestionRequest) error {
 	return nil
 }
 
-func (m *mockWorkbenchServiceForSummary) UpdateWorkbenchPath(_ context.Context, _, _ string) error {
-	return nil
+// mockSummaryRepository implements secondary.SummaryRepository for testing.
+type mockSummaryRepository struct {
+	shipmentTasks  map[string][]*secondary.TaskRecord
+	shipmentNotes  map[string][]*secondary.NoteRecord
+	tomeNotes      map[string][]*secondary.NoteRecord
+	taskPlans      map[string][]*secondary.PlanRecord
+	taskChecklists map[string]secondary.ChecklistCount
+	aliases        map[string]string
+	commentCounts  map[string]int
+	workbenchNames map[string]string
+	taggedShips    map[string][]string // tag -> shipments holding a task with it
+	calls          atomic.Int32        // loads run concurrently
+}
+
+func newMockSummaryRepository() *mockSummaryRepository {
+	return &mockSummaryRepository{
+		shipmentTasks:  make(map[string][]*secondary.TaskRecord),
+		shipmentNotes:  make(map[string][]*secondary.NoteRecord),
+		tomeNotes:      make(map[string][]*secondary.NoteRecord),
+		taskPlans:      make(map[string][]*secondary.PlanRecord),
+		taskChecklists: make(map[string]secondary.ChecklistCount),
+		aliases:        make(map[string]string),
+		commentCounts:  make(map[string]int),
+		workbenchNames: make(map[string]string),
+	}
+}
+
+func (m *mockSummaryRepository) ListTasksByShipments(_ context.Context, shipmentIDs []string) ([]*secondary.TaskRecord, error) {
+	m.calls.Add(1)
+	var result []*secondary.TaskRecord
+	for _, id := range shipmentIDs {
+		for _, t := range m.shipmentTasks[id] {
+			t.ShipmentID = id
+			result = append(result, t)
+		}
+	}
+	return result, nil
 }
 
-func (m *mockWorkbenchServiceForSummary) DeleteWorkbench(_ context.Context, _ primary.DeleteWorkbenchRequest) error {
-	return nil
+func (m *mockSummaryRepository) ListNotesByContainers(_ context.Context, shipmentIDs, tomeIDs []string) ([]*secondary.NoteRecord, error) {
+	m.calls.Add(1)
+	var result []*secondary.NoteRecord
+	for _, id := range shipmentIDs {
+		for _, n := range m.shipmentNotes[id] {
+			n.ShipmentID = id
+			result = append(result, n)
+		}
+	}
+	for _, id := range tomeIDs {
+		for _, n := range m.tomeNotes[id] {
+			n.TomeID = id
+			result = append(result, n)
+		}
+	}
+	return result, nil
 }
 
-func (m *mockWorkbenchServiceForSummary) CheckoutBranch(_ context.Context, _ primary.CheckoutBranchRequest) (*primary.CheckoutBranchResponse, error) {
-	return nil, nil
+func (m *mockSummaryRepository) ListPlansByTasks(_ context.Context, taskIDs []string) ([]*secondary.PlanRecord, error) {
+	m.calls.Add(1)
+	var result []*secondary.PlanRecord
+	for _, id := range taskIDs {
+		for _, p := range m.taskPlans[id] {
+			p.TaskID = id
+			result = append(result, p)
+		}
+	}
+	return result, nil
 }
 
-func (m *mockWorkbenchServiceForSummary) GetWorkbenchStatus(_ context.Context, _ string) (*primary.WorkbenchGitStatus, error) {
-	return nil, nil
+func (m *mockSummaryRepository) GetWorkbenchNames(_ context.Context, workbenchIDs []string) (map[string]string, error) {
+	m.calls.Add(1)
+	names := make(map[string]string)
+	for _, id := range workbenchIDs {
+		if name, ok := m.workbenchNames[id]; ok {
+			names[id] = name
+		}
+	}
+	return names, nil
 }
 
-func (m *mockWorkbenchServiceForSummary) UpdateFocusedID(_ context.Context, _, _ string) error {
-	return nil
+func (m *mockSummaryRepository) CountChecklistByTasks(_ context.Context, taskIDs []string) (map[string]secondary.ChecklistCount, error) {
+	m.calls.Add(1)
+	counts := make(map[string]secondary.ChecklistCount)
+	for _, id := range taskIDs {
+		if c, ok := m.taskChecklists[id]; ok {
+			counts[id] = c
+		}
+	}
+	return counts, nil
 }
 
-func (m *mockWorkbenchServiceForSummary) GetFocusedID(_ context.Context, _ string) (string, error) {
-	return "", nil
+func (m *mockSummaryRepository) GetAliasesByCommission(_ context.Context, _ string) (map[string]string, error) {
+	m.calls.Add(1)
+	return m.aliases, nil
 }
 
-func (m *mockWorkbenchServiceForSummary) ArchiveWorkbench(_ context.Context, _ string) error {
-	return nil
+func (m *mockSummaryRepository) CountCommentsByEntities(_ context.Context, entityIDs []string) (map[string]int, error) {
+	m.calls.Add(1)
+	counts := make(map[string]int)
+	for _, id := range entityIDs {
+		if n := m.commentCounts[id]; n > 0 {
+			counts[id] = n
+		}
+	}
+	return counts, nil
 }
 
-func (m *mockWorkbenchServiceForSummary) GetWorkbenchesByFocusedID(_ context.Context, _ string) ([]*primary.Workbench, error) {
-	return nil, nil
+func (m *mockSummaryRepository) ListShipmentIDsByTaskTags(_ context.Context, _ string, tags []string) ([]string, error) {
+	m.calls.Add(1)
+	var ids []string
+	for _, tag := range tags {
+		ids = append(ids, m.taggedShips[tag]...)
+	}
+	return ids, nil
 }
 
 // ============================================================================
@@ -462,9 +484,8 @@ func TestSummaryService_GetCommissionSummary_FlatStructure(t *testing.T) {
 	commissionSvc := newMockCommissionServiceForSummary()
 	tomeSvc := newMockTomeServiceForSummary()
 	shipmentSvc := newMockShipmentServiceForSummary()
-	taskSvc := newMockTaskServiceForSummary()
 	noteSvc := newMockNoteServiceForSummary()
-	workbenchSvc := newMockWorkbenchServiceForSummary()
+	summaryRepo := newMockSummaryRepository()
 
 	// Create commission
 	commissionSvc.commissions["COMM-001"] = &primary.Commission{
@@ -482,7 +503,7 @@ func TestSummaryService_GetCommissionSummary_FlatStructure(t *testing.T) {
 	}
 
 	// Add notes to tome
-	tomeSvc.tomeNotes["TOME-001"] = []*primary.Note{
+	summaryRepo.tomeNotes["TOME-001"] = []*secondary.NoteRecord{
 		{ID: "NOTE-001", Title: "Note 1", Status: "open"},
 		{ID: "NOTE-002", Title: "Note 2", Status: "open"},
 		{ID: "NOTE-003", Title: "Note 3", Status: "closed"},
@@ -498,17 +519,14 @@ func TestSummaryService_GetCommissionSummary_FlatStructure(t *testing.T) {
 	}
 
 	// Add tasks to shipment
-	shipmentSvc.shipmentTasks["SHIP-001"] = []*primary.Task{
+	summaryRepo.shipmentTasks["SHIP-001"] = []*secondary.TaskRecord{
 		{ID: "TASK-001", Title: "Task 1", Status: "closed"},
 		{ID: "TASK-002", Title: "Task 2", Status: "open"},
 		{ID: "TASK-003", Title: "Task 3", Status: "open"},
 	}
 
 	// Add workbench
-	workbenchSvc.workbenches["BENCH-001"] = &primary.Workbench{
-		ID:   "BENCH-001",
-		Name: "bench-alpha",
-	}
+	summaryRepo.workbenchNames["BENCH-001"] = "bench-alpha"
 
 	// Create another tome
 	tomeSvc.tomes["TOME-002"] = &primary.Tome{
@@ -519,7 +537,7 @@ func TestSummaryService_GetCommissionSummary_FlatStructure(t *testing.T) {
 	}
 
 	// Create service
-	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil)
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, noteSvc, summaryRepo)
 
 	// Request summary
 	req := primary.SummaryRequest{
@@ -569,9 +587,8 @@ func TestSummaryService_GetCommissionSummary_ShowsAllShipments(t *testing.T) {
 	commissionSvc := newMockCommissionServiceForSummary()
 	tomeSvc := newMockTomeServiceForSummary()
 	shipmentSvc := newMockShipmentServiceForSummary()
-	taskSvc := newMockTaskServiceForSummary()
 	noteSvc := newMockNoteServiceForSummary()
-	workbenchSvc := newMockWorkbenchServiceForSummary()
+	summaryRepo := newMockSummaryRepository()
 
 	// Create commission
 	commissionSvc.commissions["COMM-001"] = &primary.Commission{
@@ -608,7 +625,7 @@ func TestSummaryService_GetCommissionSummary_ShowsAllShipments(t *testing.T) {
 	}
 
 	// Create service
-	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil)
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, noteSvc, summaryRepo)
 
 	// Request summary - all shipments should be visible regardless of workbench assignment
 	req := primary.SummaryRequest{
@@ -633,9 +650,8 @@ func TestSummaryService_GetCommissionSummary_TaskCounting(t *testing.T) {
 	commissionSvc := newMockCommissionServiceForSummary()
 	tomeSvc := newMockTomeServiceForSummary()
 	shipmentSvc := newMockShipmentServiceForSummary()
-	taskSvc := newMockTaskServiceForSummary()
 	noteSvc := newMockNoteServiceForSummary()
-	workbenchSvc := newMockWorkbenchServiceForSummary()
+	summaryRepo := newMockSummaryRepository()
 
 	commissionSvc.commissions["COMM-001"] = &primary.Commission{
 		ID:     "COMM-001",
@@ -651,7 +667,7 @@ func TestSummaryService_GetCommissionSummary_TaskCounting(t *testing.T) {
 	}
 
 	// 3 closed, 5 not closed = 8 total, 3 done
-	shipmentSvc.shipmentTasks["SHIP-001"] = []*primary.Task{
+	summaryRepo.shipmentTasks["SHIP-001"] = []*secondary.TaskRecord{
 		{ID: "TASK-001", Status: "closed"},
 		{ID: "TASK-002", Status: "closed"},
 		{ID: "TASK-003", Status: "closed"},
@@ -662,7 +678,7 @@ func TestSummaryService_GetCommissionSummary_TaskCounting(t *testing.T) {
 		{ID: "TASK-008", Status: "open"},
 	}
 
-	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil)
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, noteSvc, summaryRepo)
 
 	req := primary.SummaryRequest{
 		CommissionID: "COMM-001",
@@ -687,9 +703,8 @@ func TestSummaryService_GetCommissionSummary_HidesClosedAndComplete(t *testing.T
 	commissionSvc := newMockCommissionServiceForSummary()
 	tomeSvc := newMockTomeServiceForSummary()
 	shipmentSvc := newMockShipmentServiceForSummary()
-	taskSvc := newMockTaskServiceForSummary()
 	noteSvc := newMockNoteServiceForSummary()
-	workbenchSvc := newMockWorkbenchServiceForSummary()
+	summaryRepo := newMockSummaryRepository()
 
 	commissionSvc.commissions["COMM-001"] = &primary.Commission{
 		ID:     "COMM-001",
@@ -733,7 +748,7 @@ func TestSummaryService_GetCommissionSummary_HidesClosedAndComplete(t *testing.T
 		Status:       "closed",
 	}
 
-	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil)
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, noteSvc, summaryRepo)
 
 	req := primary.SummaryRequest{
 		CommissionID: "COMM-001",
@@ -760,9 +775,8 @@ func TestSummaryService_GetCommissionSummary_FocusedCommission(t *testing.T) {
 	commissionSvc := newMockCommissionServiceForSummary()
 	tomeSvc := newMockTomeServiceForSummary()
 	shipmentSvc := newMockShipmentServiceForSummary()
-	taskSvc := newMockTaskServiceForSummary()
 	noteSvc := newMockNoteServiceForSummary()
-	workbenchSvc := newMockWorkbenchServiceForSummary()
+	summaryRepo := newMockSummaryRepository()
 
 	commissionSvc.commissions["COMM-001"] = &primary.Commission{
 		ID:     "COMM-001",
@@ -777,7 +791,7 @@ func TestSummaryService_GetCommissionSummary_FocusedCommission(t *testing.T) {
 		Status:       "active",
 	}
 
-	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil)
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, noteSvc, summaryRepo)
 
 	// Test with focus on shipment in this commission
 	req := primary.SummaryRequest{
@@ -816,9 +830,8 @@ func TestSummaryService_GetCommissionSummary_TomeNoteCount(t *testing.T) {
 	commissionSvc := newMockCommissionServiceForSummary()
 	tomeSvc := newMockTomeServiceForSummary()
 	shipmentSvc := newMockShipmentServiceForSummary()
-	taskSvc := newMockTaskServiceForSummary()
 	noteSvc := newMockNoteServiceForSummary()
-	workbenchSvc := newMockWorkbenchServiceForSummary()
+	summaryRepo := newMockSummaryRepository()
 
 	commissionSvc.commissions["COMM-001"] = &primary.Commission{
 		ID:     "COMM-001",
@@ -834,13 +847,13 @@ func TestSummaryService_GetCommissionSummary_TomeNoteCount(t *testing.T) {
 	}
 
 	// 2 open notes, 1 closed note (should only count open)
-	tomeSvc.tomeNotes["TOME-001"] = []*primary.Note{
+	summaryRepo.tomeNotes["TOME-001"] = []*secondary.NoteRecord{
 		{ID: "NOTE-001", Title: "Open Note 1", Status: "open"},
 		{ID: "NOTE-002", Title: "Open Note 2", Status: "open"},
 		{ID: "NOTE-003", Title: "Closed Note", Status: "closed"},
 	}
 
-	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil)
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, noteSvc, summaryRepo)
 
 	req := primary.SummaryRequest{
 		CommissionID: "COMM-001",
@@ -861,6 +874,36 @@ func TestSummaryService_GetCommissionSummary_TomeNoteCount(t *testing.T) {
 	}
 }
 
+func TestSummaryService_GetCommissionSummary_OpenIssues(t *testing.T) {
+	commissionSvc := newMockCommissionServiceForSummary()
+	tomeSvc := newMockTomeServiceForSummary()
+	shipmentSvc := newMockShipmentServiceForSummary()
+	summaryRepo := newMockSummaryRepository()
+
+	commissionSvc.commissions["COMM-001"] = &primary.Commission{ID: "COMM-001", Title: "Test Commission", Status: "active"}
+	tomeSvc.tomes["TOME-001"] = &primary.Tome{ID: "TOME-001", CommissionID: "COMM-001", Title: "Research", Status: "open"}
+
+	// Closed and non-issue notes don't count
+	summaryRepo.tomeNotes["TOME-001"] = []*secondary.NoteRecord{
+		{ID: "NOTE-001", Type: "concern", Status: "open"},
+		{ID: "NOTE-002", Type: "concern", Status: "closed"},
+		{ID: "NOTE-003", Type: "finding", Status: "open"},
+		{ID: "NOTE-004", Type: "finding", Status: "in_flight"},
+		{ID: "NOTE-005", Type: "learning", Status: "open"},
+	}
+
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, newMockNoteServiceForSummary(), summaryRepo)
+	summary, err := svc.GetCommissionSummary(context.Background(), primary.SummaryRequest{CommissionID: "COMM-001"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tome := summary.Tomes[0]
+	if tome.OpenConcerns != 1 || tome.OpenFindings != 2 {
+		t.Errorf("expected 1 concern and 2 findings, got %d and %d", tome.OpenConcerns, tome.OpenFindings)
+	}
+}
+
 func TestSummaryService_GetCommissionSummary_TomeExpansion(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -899,9 +942,8 @@ func TestSummaryService_GetCommissionSummary_TomeExpansion(t *testing.T) {
 			commissionSvc := newMockCommissionServiceForSummary()
 			tomeSvc := newMockTomeServiceForSummary()
 			shipmentSvc := newMockShipmentServiceForSummary()
-			taskSvc := newMockTaskServiceForSummary()
 			noteSvc := newMockNoteServiceForSummary()
-			workbenchSvc := newMockWorkbenchServiceForSummary()
+			summaryRepo := newMockSummaryRepository()
 
 			commissionSvc.commissions["COMM-001"] = &primary.Commission{
 				ID:     "COMM-001",
@@ -916,7 +958,7 @@ func TestSummaryService_GetCommissionSummary_TomeExpansion(t *testing.T) {
 				Status:       "open",
 			}
 
-			tomeSvc.tomeNotes["TOME-001"] = []*primary.Note{
+			summaryRepo.tomeNotes["TOME-001"] = []*secondary.NoteRecord{
 				{ID: "NOTE-001", Title: "Note 1", Status: "open"},
 				{ID: "NOTE-002", Title: "Note 2", Status: "open"},
 				{ID: "NOTE-003", Title: "Note 3", Status: "closed"},
@@ -929,7 +971,7 @@ func TestSummaryService_GetCommissionSummary_TomeExpansion(t *testing.T) {
 				Status:       "active",
 			}
 
-			svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, taskSvc, noteSvc, workbenchSvc, nil)
+			svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, noteSvc, summaryRepo)
 
 			req := primary.SummaryRequest{
 				CommissionID: "COMM-001",
@@ -958,3 +1000,185 @@ func TestSummaryService_GetCommissionSummary_TomeExpansion(t *testing.T) {
 
 // Ensure interface compliance
 var _ primary.SummaryService = (*SummaryServiceImpl)(nil)
+
+func TestSummaryService_GetCommissionSummary_BatchedLeafLoads(t *testing.T) {
+	commissionSvc := newMockCommissionServiceForSummary()
+	tomeSvc := newMockTomeServiceForSummary()
+	shipmentSvc := newMockShipmentServiceForSummary()
+	noteSvc := newMockNoteServiceForSummary()
+	summaryRepo := newMockSummaryRepository()
+
+	commissionSvc.commissions["COMM-001"] = &primary.Commission{
+		ID:     "COMM-001",
+		Title:  "Test Commission",
+		Status: "active",
+	}
+
+	// Many shipments and tomes must not multiply repository calls
+	for i := 1; i <= 20; i++ {
+		shipID := fmt.Sprintf("SHIP-%03d", i)
+		shipmentSvc.shipments[shipID] = &primary.Shipment{
+			ID:                  shipID,
+			CommissionID:        "COMM-001",
+			Title:               "Shipment",
+			Status:              "ready",
+			AssignedWorkbenchID: fmt.Sprintf("BENCH-%03d", i),
+		}
+		summaryRepo.shipmentTasks[shipID] = []*secondary.TaskRecord{
+			{ID: fmt.Sprintf("TASK-%03d", i), Title: "Task", Status: "open"},
+		}
+		tomeID := fmt.Sprintf("TOME-%03d", i)
+		tomeSvc.tomes[tomeID] = &primary.Tome{
+			ID:           tomeID,
+			CommissionID: "COMM-001",
+			Title:        "Tome",
+			Status:       "open",
+		}
+	}
+	summaryRepo.shipmentNotes["SHIP-001"] = []*secondary.NoteRecord{
+		{ID: "NOTE-001", Title: "Shipment note", Status: "open"},
+	}
+	summaryRepo.taskPlans["TASK-001"] = []*secondary.PlanRecord{
+		{ID: "PLAN-001", Status: "draft"},
+	}
+	summaryRepo.taskChecklists["TASK-001"] = secondary.ChecklistCount{Done: 2, Total: 5}
+	summaryRepo.aliases["SHIP-001"] = "auth-refactor"
+	summaryRepo.commentCounts["SHIP-001"] = 2
+	summaryRepo.commentCounts["TASK-001"] = 3
+
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, noteSvc, summaryRepo)
+
+	summary, err := svc.GetCommissionSummary(context.Background(), primary.SummaryRequest{
+		CommissionID: "COMM-001",
+		FocusID:      "SHIP-001",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// aliases + tasks + notes + bench names + plans and checklists (focused shipment only) + comment counts
+	if calls := summaryRepo.calls.Load(); calls != 7 {
+		t.Errorf("expected 7 batched repository calls, got %d", calls)
+	}
+	if len(summary.Shipments) != 20 || len(summary.Tomes) != 20 {
+		t.Fatalf("expected 20 shipments and 20 tomes, got %d and %d", len(summary.Shipments), len(summary.Tomes))
+	}
+
+	var focused *primary.ShipmentSummary
+	for i := range summary.Shipments {
+		if summary.Shipments[i].ID == "SHIP-001" {
+			focused = &summary.Shipments[i]
+		}
+	}
+	if focused == nil {
+		t.Fatal("expected SHIP-001 in summary")
+	}
+	if focused.Alias != "auth-refactor" {
+		t.Errorf("expected alias auth-refactor, got %q", focused.Alias)
+	}
+	if focused.NoteCount != 1 || len(focused.Notes) != 1 {
+		t.Errorf("expected 1 expanded note, got count=%d notes=%d", focused.NoteCount, len(focused.Notes))
+	}
+	if len(focused.Tasks) != 1 || len(focused.Tasks[0].Plans) != 1 {
+		t.Fatalf("expected focused task with 1 plan, got %+v", focused.Tasks)
+	}
+	if focused.Tasks[0].Plans[0].ID != "PLAN-001" {
+		t.Errorf("expected PLAN-001, got %s", focused.Tasks[0].Plans[0].ID)
+	}
+	if focused.Tasks[0].ChecklistDone != 2 || focused.Tasks[0].ChecklistTotal != 5 {
+		t.Errorf("expected checklist 2/5, got %d/%d", focused.Tasks[0].ChecklistDone, focused.Tasks[0].ChecklistTotal)
+	}
+	if focused.CommentCount != 2 || focused.Tasks[0].CommentCount != 3 {
+		t.Errorf("expected comment counts 2 (shipment) and 3 (task), got %d and %d", focused.CommentCount, focused.Tasks[0].CommentCount)
+	}
+	if focused.BenchName != "" {
+		t.Errorf("expected empty bench name for unknown bench, got %q", focused.BenchName)
+	}
+}
+
+func TestSummaryService_GetCommissionSummary_Filter(t *testing.T) {
+	commissionSvc := newMockCommissionServiceForSummary()
+	tomeSvc := newMockTomeServiceForSummary()
+	shipmentSvc := newMockShipmentServiceForSummary()
+	summaryRepo := newMockSummaryRepository()
+	summaryRepo.taggedShips = map[string][]string{"infra": {"SHIP-002"}}
+
+	commissionSvc.commissions["COMM-001"] = &primary.Commission{ID: "COMM-001", Title: "Test Commission", Status: "active"}
+	shipmentSvc.shipments["SHIP-001"] = &primary.Shipment{ID: "SHIP-001", CommissionID: "COMM-001", Title: "Drafting", Status: "draft"}
+	shipmentSvc.shipments["SHIP-002"] = &primary.Shipment{ID: "SHIP-002", CommissionID: "COMM-001", Title: "Infra", Status: "ready"}
+	shipmentSvc.shipments["SHIP-003"] = &primary.Shipment{ID: "SHIP-003", CommissionID: "COMM-001", Title: "Docs", Status: "ready"}
+	tomeSvc.tomes["TOME-001"] = &primary.Tome{ID: "TOME-001", CommissionID: "COMM-001", Title: "Research", Status: "open"}
+
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, newMockNoteServiceForSummary(), summaryRepo)
+	summarize := func(filter primary.SummaryFilter) *primary.CommissionSummary {
+		t.Helper()
+		summary, err := svc.GetCommissionSummary(context.Background(), primary.SummaryRequest{CommissionID: "COMM-001", Filter: filter, DebugMode: true})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return summary
+	}
+	ids := func(summary *primary.CommissionSummary) string {
+		var out []string
+		for _, ship := range summary.Shipments {
+			out = append(out, ship.ID)
+		}
+		for _, tome := range summary.Tomes {
+			out = append(out, tome.ID)
+		}
+		sort.Strings(out)
+		return strings.Join(out, ",")
+	}
+
+	if got := ids(summarize(primary.SummaryFilter{})); got != "SHIP-001,SHIP-002,SHIP-003,TOME-001" {
+		t.Errorf("no filter: got %s", got)
+	}
+	if got := ids(summarize(primary.SummaryFilter{Containers: []string{"TOME"}})); got != "TOME-001" {
+		t.Errorf("tomes only: got %s", got)
+	}
+	if got := ids(summarize(primary.SummaryFilter{Statuses: []string{"ready"}})); got != "SHIP-002,SHIP-003" {
+		t.Errorf("ready only: got %s", got)
+	}
+
+	summary := summarize(primary.SummaryFilter{Tags: []string{"infra"}})
+	if got := ids(summary); got != "SHIP-002" {
+		t.Errorf("tagged infra: got %s", got)
+	}
+	if summary.DebugInfo == nil || !strings.Contains(strings.Join(summary.DebugInfo.Messages, "\n"), "Hidden: TOME-001 (Research) - outside view #infra") {
+		t.Errorf("expected debug info for hidden containers, got %+v", summary.DebugInfo)
+	}
+}
+
+func TestSummaryService_GetCommissionSummary_LinkedRefs(t *testing.T) {
+	commissionSvc := newMockCommissionServiceForSummary()
+	tomeSvc := newMockTomeServiceForSummary()
+	shipmentSvc := newMockShipmentServiceForSummary()
+
+	commissionSvc.commissions["COMM-001"] = &primary.Commission{ID: "COMM-001", Title: "Test Commission", Status: "active"}
+	shipmentSvc.shipments["SHIP-001"] = &primary.Shipment{ID: "SHIP-001", CommissionID: "COMM-001", Title: "Billing", Status: "ready"}
+	shipmentSvc.shipments["SHIP-002"] = &primary.Shipment{ID: "SHIP-002", CommissionID: "COMM-001", Title: "Docs", Status: "ready"}
+
+	ledgerSvc, ledgerRepo, _ := newTestLedgerService()
+	ledgerRepo.ledgers["acme"] = &secondary.LedgerRecord{Alias: "acme", Path: "/ledgers/acme.db"}
+	ledgerRepo.refs["SHIP-001"] = []string{"acme:SHIP-004"}
+	ledgerRepo.refs["SHIP-002"] = []string{"acme:SHIP-004"}
+
+	svc := NewSummaryService(commissionSvc, tomeSvc, shipmentSvc, newMockNoteServiceForSummary(), newMockSummaryRepository()).WithLedgerService(ledgerSvc)
+	summary, err := svc.GetCommissionSummary(context.Background(), primary.SummaryRequest{CommissionID: "COMM-001", FocusID: "SHIP-001"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, ship := range summary.Shipments {
+		switch ship.ID {
+		case "SHIP-001":
+			if len(ship.LinkedRefs) != 1 || ship.LinkedRefs[0].Entity == nil || ship.LinkedRefs[0].Entity.Title != "Payments API" {
+				t.Errorf("expected the focused shipment's resolved link, got %+v", ship.LinkedRefs)
+			}
+		case "SHIP-002":
+			if len(ship.LinkedRefs) != 0 {
+				t.Errorf("expected links only for the focused shipment, got %+v", ship.LinkedRefs)
+			}
+		}
+	}
+}
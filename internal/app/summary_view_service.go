@@ -0,0 +1,136 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	coresummary "github.com/example/orc/internal/core/summary"
+	"github.com/example/orc/internal/ctxutil"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// SummaryViewServiceImpl implements the SummaryViewService interface.
+type SummaryViewServiceImpl struct {
+	viewRepo      secondary.SummaryViewRepository
+	workbenchRepo secondary.WorkbenchRepository
+}
+
+// NewSummaryViewService creates a new SummaryViewService with injected dependencies.
+func NewSummaryViewService(viewRepo secondary.SummaryViewRepository, workbenchRepo secondary.WorkbenchRepository) *SummaryViewServiceImpl {
+	return &SummaryViewServiceImpl{
+		viewRepo:      viewRepo,
+		workbenchRepo: workbenchRepo,
+	}
+}
+
+// SaveView creates or replaces one of the current actor's views.
+func (s *SummaryViewServiceImpl) SaveView(ctx context.Context, req primary.SaveSummaryViewRequest) (*primary.SummaryView, error) {
+	if err := coresummary.ValidateViewName(req.Name); err != nil {
+		return nil, err
+	}
+	view, err := coresummary.NormalizeView(coresummary.View{
+		Containers: req.Filter.Containers,
+		Statuses:   req.Filter.Statuses,
+		Tags:       req.Filter.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if view.IsEmpty() {
+		return nil, fmt.Errorf("a view needs at least one filter")
+	}
+
+	actorID := ctxutil.ActorFromContext(ctx)
+	if err := s.viewRepo.Save(ctx, &secondary.SummaryViewRecord{
+		ActorID:    actorID,
+		Name:       req.Name,
+		Containers: strings.Join(view.Containers, ","),
+		Statuses:   strings.Join(view.Statuses, ","),
+		Tags:       strings.Join(view.Tags, ","),
+	}); err != nil {
+		return nil, err
+	}
+	return s.GetView(ctx, req.Name)
+}
+
+// GetView retrieves one of the current actor's views by name.
+func (s *SummaryViewServiceImpl) GetView(ctx context.Context, name string) (*primary.SummaryView, error) {
+	record, err := s.viewRepo.Get(ctx, ctxutil.ActorFromContext(ctx), name)
+	if err != nil {
+		return nil, err
+	}
+	return recordToSummaryView(record), nil
+}
+
+// ListViews lists the current actor's views.
+func (s *SummaryViewServiceImpl) ListViews(ctx context.Context) ([]*primary.SummaryView, error) {
+	records, err := s.viewRepo.List(ctx, ctxutil.ActorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	views := make([]*primary.SummaryView, len(records))
+	for i, r := range records {
+		views[i] = recordToSummaryView(r)
+	}
+	return views, nil
+}
+
+// DeleteView deletes one of the current actor's views. Workbenches using it
+// as their default go back to the unfiltered summary.
+func (s *SummaryViewServiceImpl) DeleteView(ctx context.Context, name string) error {
+	return s.viewRepo.Delete(ctx, ctxutil.ActorFromContext(ctx), name)
+}
+
+// SetDefaultView makes one of the current actor's views the workbench's
+// default. An empty name clears the default.
+func (s *SummaryViewServiceImpl) SetDefaultView(ctx context.Context, workbenchID, name string) error {
+	if _, err := s.workbenchRepo.GetByID(ctx, workbenchID); err != nil {
+		return fmt.Errorf("workbench %s not found", workbenchID)
+	}
+	if name == "" {
+		return s.viewRepo.ClearDefault(ctx, workbenchID)
+	}
+
+	actorID := ctxutil.ActorFromContext(ctx)
+	if _, err := s.viewRepo.Get(ctx, actorID, name); err != nil {
+		return err
+	}
+	return s.viewRepo.SetDefault(ctx, workbenchID, actorID, name)
+}
+
+// GetDefaultView retrieves the workbench's default view, or nil if none is set.
+func (s *SummaryViewServiceImpl) GetDefaultView(ctx context.Context, workbenchID string) (*primary.SummaryView, error) {
+	record, err := s.viewRepo.GetDefault(ctx, workbenchID)
+	if err != nil || record == nil {
+		return nil, err
+	}
+	return recordToSummaryView(record), nil
+}
+
+func recordToSummaryView(r *secondary.SummaryViewRecord) *primary.SummaryView {
+	filter := primary.SummaryFilter{
+		Containers: splitList(r.Containers),
+		Statuses:   splitList(r.Statuses),
+		Tags:       splitList(r.Tags),
+	}
+	return &primary.SummaryView{
+		Name:        r.Name,
+		ActorID:     r.ActorID,
+		Filter:      filter,
+		Description: coresummary.View{Containers: filter.Containers, Statuses: filter.Statuses, Tags: filter.Tags}.Describe(),
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+// splitList splits a stored comma-separated list; empty means none.
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// Ensure SummaryViewServiceImpl implements the interface
+var _ primary.SummaryViewService = (*SummaryViewServiceImpl)(nil)
@@ -0,0 +1,172 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ctxutil"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// mockSummaryViewRepository keeps views in memory, keyed by actor and name.
+type mockSummaryViewRepository struct {
+	views    map[string]*secondary.SummaryViewRecord // actor/name -> view
+	defaults map[string]string                       // workbench -> actor/name
+}
+
+func newMockSummaryViewRepository() *mockSummaryViewRepository {
+	return &mockSummaryViewRepository{
+		views:    make(map[string]*secondary.SummaryViewRecord),
+		defaults: make(map[string]string),
+	}
+}
+
+func (m *mockSummaryViewRepository) Save(ctx context.Context, view *secondary.SummaryViewRecord) error {
+	m.views[view.ActorID+"/"+view.Name] = view
+	return nil
+}
+
+func (m *mockSummaryViewRepository) Get(ctx context.Context, actorID, name string) (*secondary.SummaryViewRecord, error) {
+	if view, ok := m.views[actorID+"/"+name]; ok {
+		return view, nil
+	}
+	return nil, fmt.Errorf("summary view %s not found", name)
+}
+
+func (m *mockSummaryViewRepository) List(ctx context.Context, actorID string) ([]*secondary.SummaryViewRecord, error) {
+	var views []*secondary.SummaryViewRecord
+	for _, v := range m.views {
+		if v.ActorID == actorID {
+			views = append(views, v)
+		}
+	}
+	sort.Slice(views, func(i, j int) bool { return views[i].Name < views[j].Name })
+	return views, nil
+}
+
+func (m *mockSummaryViewRepository) Delete(ctx context.Context, actorID, name string) error {
+	key := actorID + "/" + name
+	if _, ok := m.views[key]; !ok {
+		return fmt.Errorf("summary view %s not found", name)
+	}
+	delete(m.views, key)
+	for bench, k := range m.defaults {
+		if k == key {
+			delete(m.defaults, bench)
+		}
+	}
+	return nil
+}
+
+func (m *mockSummaryViewRepository) SetDefault(ctx context.Context, workbenchID, actorID, name string) error {
+	m.defaults[workbenchID] = actorID + "/" + name
+	return nil
+}
+
+func (m *mockSummaryViewRepository) ClearDefault(ctx context.Context, workbenchID string) error {
+	delete(m.defaults, workbenchID)
+	return nil
+}
+
+func (m *mockSummaryViewRepository) GetDefault(ctx context.Context, workbenchID string) (*secondary.SummaryViewRecord, error) {
+	key, ok := m.defaults[workbenchID]
+	if !ok {
+		return nil, nil
+	}
+	return m.views[key], nil
+}
+
+func newTestSummaryViewService() (*SummaryViewServiceImpl, *mockSummaryViewRepository) {
+	viewRepo := newMockSummaryViewRepository()
+	workbenchRepo := newMockWorkbenchRepository()
+	workbenchRepo.workbenches["BENCH-001"] = &secondary.WorkbenchRecord{ID: "BENCH-001", Name: "bench-one"}
+	return NewSummaryViewService(viewRepo, workbenchRepo), viewRepo
+}
+
+func TestSummaryViewService_SaveView(t *testing.T) {
+	service, repo := newTestSummaryViewService()
+	ctx := ctxutil.WithActorID(context.Background(), "GOBLIN")
+
+	view, err := service.SaveView(ctx, primary.SaveSummaryViewRequest{
+		Name:   "standup",
+		Filter: primary.SummaryFilter{Containers: []string{"ship"}, Statuses: []string{"Ready"}, Tags: []string{"infra"}},
+	})
+	if err != nil {
+		t.Fatalf("SaveView failed: %v", err)
+	}
+	if view.ActorID != "GOBLIN" || strings.Join(view.Filter.Containers, ",") != "SHIP" || view.Filter.Statuses[0] != "ready" {
+		t.Errorf("unexpected view: %+v", view)
+	}
+	if view.Description != "SHIP · ready · #infra" {
+		t.Errorf("Description = %q", view.Description)
+	}
+	if rec := repo.views["GOBLIN/standup"]; rec == nil || rec.Containers != "SHIP" || rec.Tags != "infra" {
+		t.Errorf("unexpected stored view: %+v", rec)
+	}
+
+	// Views are per actor
+	imp := ctxutil.WithActorID(context.Background(), "IMP-BENCH-001")
+	if _, err := service.GetView(imp, "standup"); err == nil {
+		t.Error("expected another actor not to see the view")
+	}
+
+	tests := []struct {
+		name string
+		req  primary.SaveSummaryViewRequest
+		want string
+	}{
+		{"bad name", primary.SaveSummaryViewRequest{Name: "Stand Up", Filter: primary.SummaryFilter{Tags: []string{"x"}}}, "invalid view name"},
+		{"no filter", primary.SaveSummaryViewRequest{Name: "empty"}, "at least one filter"},
+		{"bad status", primary.SaveSummaryViewRequest{Name: "x", Filter: primary.SummaryFilter{Statuses: []string{"paused"}}}, "unknown status"},
+	}
+	for _, tt := range tests {
+		if _, err := service.SaveView(ctx, tt.req); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: expected %q, got %v", tt.name, tt.want, err)
+		}
+	}
+}
+
+func TestSummaryViewService_DefaultView(t *testing.T) {
+	service, _ := newTestSummaryViewService()
+	ctx := ctxutil.WithActorID(context.Background(), "GOBLIN")
+
+	if _, err := service.SaveView(ctx, primary.SaveSummaryViewRequest{Name: "docs", Filter: primary.SummaryFilter{Containers: []string{"TOME"}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.SetDefaultView(ctx, "BENCH-001", "missing"); err == nil {
+		t.Error("expected error for an unknown view")
+	}
+	if err := service.SetDefaultView(ctx, "BENCH-404", "docs"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected unknown workbench, got %v", err)
+	}
+
+	if err := service.SetDefaultView(ctx, "BENCH-001", "docs"); err != nil {
+		t.Fatalf("SetDefaultView failed: %v", err)
+	}
+	// The default applies whoever runs the summary in the workbench
+	imp := ctxutil.WithActorID(context.Background(), "IMP-BENCH-001")
+	view, err := service.GetDefaultView(imp, "BENCH-001")
+	if err != nil || view == nil || view.Name != "docs" {
+		t.Fatalf("unexpected default: %+v (%v)", view, err)
+	}
+
+	if err := service.SetDefaultView(ctx, "BENCH-001", ""); err != nil {
+		t.Fatalf("clearing default failed: %v", err)
+	}
+	if view, _ := service.GetDefaultView(ctx, "BENCH-001"); view != nil {
+		t.Errorf("expected no default, got %+v", view)
+	}
+
+	_ = service.SetDefaultView(ctx, "BENCH-001", "docs")
+	if err := service.DeleteView(ctx, "docs"); err != nil {
+		t.Fatalf("DeleteView failed: %v", err)
+	}
+	if view, _ := service.GetDefaultView(ctx, "BENCH-001"); view != nil {
+		t.Errorf("expected default gone with its view, got %+v", view)
+	}
+}
@@ -4,19 +4,28 @@ import (
 	"context"
 	"fmt"
 
+	coretag "github.com/example/orc/internal/core/tag"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
 )
 
 // TagServiceImpl implements the TagService interface.
 type TagServiceImpl struct {
-	tagRepo secondary.TagRepository
+	tagRepo       secondary.TagRepository
+	routeRepo     secondary.TagRouteRepository
+	workbenchRepo secondary.WorkbenchRepository
 }
 
 // NewTagService creates a new TagService with injected dependencies.
-func NewTagService(tagRepo secondary.TagRepository) *TagServiceImpl {
+func NewTagService(
+	tagRepo secondary.TagRepository,
+	routeRepo secondary.TagRouteRepository,
+	workbenchRepo secondary.WorkbenchRepository,
+) *TagServiceImpl {
 	return &TagServiceImpl{
-		tagRepo: tagRepo,
+		tagRepo:       tagRepo,
+		routeRepo:     routeRepo,
+		workbenchRepo: workbenchRepo,
 	}
 }
 
@@ -100,6 +109,139 @@ func (s *TagServiceImpl) GetEntityTag(ctx context.Context, entityID, entityType
 	return s.recordToTag(record), nil
 }
 
+// RouteTag routes a tag's new tasks to a workbench, replacing any existing route.
+func (s *TagServiceImpl) RouteTag(ctx context.Context, req primary.RouteTagRequest) error {
+	mode := req.Mode
+	if mode == "" {
+		mode = coretag.RouteModeSuggest
+	}
+
+	tag, _ := s.tagRepo.GetByName(ctx, req.TagName)
+
+	workbenchStatus := ""
+	if workbench, err := s.workbenchRepo.GetByID(ctx, req.WorkbenchID); err == nil {
+		workbenchStatus = workbench.Status
+	}
+
+	guardResult := coretag.CanRouteTag(coretag.RouteTagContext{
+		TagName:         req.TagName,
+		TagExists:       tag != nil,
+		WorkbenchID:     req.WorkbenchID,
+		WorkbenchStatus: workbenchStatus,
+		Mode:            mode,
+	})
+	if err := guardResult.Error(); err != nil {
+		return err
+	}
+
+	return s.routeRepo.Set(ctx, &secondary.TagRouteRecord{
+		TagID:       tag.ID,
+		WorkbenchID: req.WorkbenchID,
+		Mode:        mode,
+	})
+}
+
+// UnrouteTag removes a tag's route.
+func (s *TagServiceImpl) UnrouteTag(ctx context.Context, tagName string) error {
+	tag, err := s.tagRepo.GetByName(ctx, tagName)
+	if err != nil || tag == nil {
+		return fmt.Errorf("tag '%s' not found", tagName)
+	}
+	return s.routeRepo.Delete(ctx, tag.ID)
+}
+
+// ListTagRoutes lists tag routes, optionally filtered by workbench.
+func (s *TagServiceImpl) ListTagRoutes(ctx context.Context, workbenchID string) ([]*primary.TagRoute, error) {
+	records, err := s.routeRepo.List(ctx, workbenchID)
+	if err != nil {
+		return nil, err
+	}
+
+	routes := make([]*primary.TagRoute, len(records))
+	for i, r := range records {
+		routes[i] = recordToTagRoute(r)
+	}
+	return routes, nil
+}
+
+// RenameTag renames a tag, keeping its entities and route.
+func (s *TagServiceImpl) RenameTag(ctx context.Context, name, newName string) (*primary.Tag, error) {
+	tag, _ := s.tagRepo.GetByName(ctx, name)
+	taken, _ := s.tagRepo.GetByName(ctx, newName)
+
+	guardResult := coretag.CanRenameTag(coretag.RenameTagContext{
+		Name:         name,
+		Exists:       tag != nil,
+		NewName:      newName,
+		NewNameTaken: taken != nil,
+	})
+	if err := guardResult.Error(); err != nil {
+		return nil, err
+	}
+
+	if err := s.tagRepo.Rename(ctx, tag.ID, newName); err != nil {
+		return nil, err
+	}
+	return s.GetTag(ctx, tag.ID)
+}
+
+// MergeTags folds each source tag into the target and deletes the sources.
+// All sources are checked before any is merged.
+func (s *TagServiceImpl) MergeTags(ctx context.Context, req primary.MergeTagsRequest) (*primary.MergeTagsResponse, error) {
+	target, _ := s.tagRepo.GetByName(ctx, req.Into)
+
+	sources := make([]*secondary.TagRecord, 0, len(req.Sources))
+	for _, name := range req.Sources {
+		source, _ := s.tagRepo.GetByName(ctx, name)
+		guardResult := coretag.CanMergeTag(coretag.MergeTagContext{
+			SourceName:   name,
+			SourceExists: source != nil,
+			TargetName:   req.Into,
+			TargetExists: target != nil,
+		})
+		if err := guardResult.Error(); err != nil {
+			return nil, err
+		}
+		sources = append(sources, source)
+	}
+
+	resp := &primary.MergeTagsResponse{Into: req.Into}
+	for _, source := range sources {
+		record, err := s.tagRepo.Merge(ctx, source.ID, target.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to merge tag '%s': %w", source.Name, err)
+		}
+		resp.Sources = append(resp.Sources, &primary.TagMergeResult{
+			Name:       source.Name,
+			Moved:      record.Moved,
+			Duplicates: record.Duplicates,
+			RouteMoved: record.RouteMoved,
+		})
+	}
+	return resp, nil
+}
+
+// TagUsage counts each tag's uses per entity type and groups near-duplicates.
+func (s *TagServiceImpl) TagUsage(ctx context.Context) (*primary.TagUsageReport, error) {
+	records, err := s.tagRepo.Usage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &primary.TagUsageReport{}
+	names := make([]string, len(records))
+	for i, r := range records {
+		usage := &primary.TagUsage{Name: r.Name, Counts: r.Counts}
+		for _, count := range r.Counts {
+			usage.Total += count
+		}
+		report.Tags = append(report.Tags, usage)
+		names[i] = r.Name
+	}
+	report.Similar = coretag.SimilarGroups(names)
+	return report, nil
+}
+
 // Helper methods
 
 func (s *TagServiceImpl) recordToTag(r *secondary.TagRecord) *primary.Tag {
@@ -112,5 +254,13 @@ func (s *TagServiceImpl) recordToTag(r *secondary.TagRecord) *primary.Tag {
 	}
 }
 
+func recordToTagRoute(r *secondary.TagRouteRecord) *primary.TagRoute {
+	return &primary.TagRoute{
+		TagName:     r.TagName,
+		WorkbenchID: r.WorkbenchID,
+		Mode:        r.Mode,
+	}
+}
+
 // Ensure TagServiceImpl implements the interface.
 var _ primary.TagService = (*TagServiceImpl)(nil)
@@ -3,6 +3,8 @@ package app
 import (
 	"context"
 	"errors"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/example/orc/internal/ports/primary"
@@ -88,16 +90,98 @@ func (m *mockTagRepository) GetEntityTag(ctx context.Context, entityID, entityTy
 	return nil, nil
 }
 
+func (m *mockTagRepository) Rename(ctx context.Context, id, name string) error {
+	tag, ok := m.tags[id]
+	if !ok {
+		return errors.New("tag not found")
+	}
+	tag.Name = name
+	return nil
+}
+
+func (m *mockTagRepository) Merge(ctx context.Context, sourceID, targetID string) (*secondary.TagMergeRecord, error) {
+	record := &secondary.TagMergeRecord{}
+	for key, tag := range m.entityTags {
+		if tag.ID == sourceID {
+			m.entityTags[key] = m.tags[targetID]
+			record.Moved++
+		}
+	}
+	delete(m.tags, sourceID)
+	return record, nil
+}
+
+func (m *mockTagRepository) Usage(ctx context.Context) ([]*secondary.TagUsageRecord, error) {
+	var result []*secondary.TagUsageRecord
+	for _, tag := range m.tags {
+		usage := &secondary.TagUsageRecord{TagID: tag.ID, Name: tag.Name, Counts: map[string]int{}}
+		for key, entityTag := range m.entityTags {
+			if entityTag.ID == tag.ID {
+				entityType, _, _ := strings.Cut(key, ":")
+				usage.Counts[entityType]++
+			}
+		}
+		result = append(result, usage)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+// mockTagRouteRepository implements secondary.TagRouteRepository for testing.
+type mockTagRouteRepository struct {
+	routes map[string]*secondary.TagRouteRecord // tagID -> route
+}
+
+func newMockTagRouteRepository() *mockTagRouteRepository {
+	return &mockTagRouteRepository{routes: make(map[string]*secondary.TagRouteRecord)}
+}
+
+func (m *mockTagRouteRepository) Set(ctx context.Context, route *secondary.TagRouteRecord) error {
+	m.routes[route.TagID] = route
+	return nil
+}
+
+func (m *mockTagRouteRepository) Delete(ctx context.Context, tagID string) error {
+	if _, ok := m.routes[tagID]; !ok {
+		return errors.New("tag has no route")
+	}
+	delete(m.routes, tagID)
+	return nil
+}
+
+func (m *mockTagRouteRepository) GetByTag(ctx context.Context, tagID string) (*secondary.TagRouteRecord, error) {
+	return m.routes[tagID], nil
+}
+
+func (m *mockTagRouteRepository) List(ctx context.Context, workbenchID string) ([]*secondary.TagRouteRecord, error) {
+	var result []*secondary.TagRouteRecord
+	for _, r := range m.routes {
+		if workbenchID == "" || r.WorkbenchID == workbenchID {
+			result = append(result, r)
+		}
+	}
+	return result, nil
+}
+
 // ============================================================================
 // Test Helper
 // ============================================================================
 
 func newTestTagService() (*TagServiceImpl, *mockTagRepository) {
-	tagRepo := newMockTagRepository()
-	service := NewTagService(tagRepo)
+	service, tagRepo, _ := newTestTagServiceWithRoutes()
 	return service, tagRepo
 }
 
+func newTestTagServiceWithRoutes() (*TagServiceImpl, *mockTagRepository, *mockTagRouteRepository) {
+	tagRepo := newMockTagRepository()
+	routeRepo := newMockTagRouteRepository()
+	workbenchRepo := newMockWorkbenchRepository()
+	workbenchRepo.workbenches["BENCH-002"] = &secondary.WorkbenchRecord{ID: "BENCH-002", Status: "active"}
+	workbenchRepo.workbenches["BENCH-009"] = &secondary.WorkbenchRecord{ID: "BENCH-009", Status: "archived"}
+	service := NewTagService(tagRepo, routeRepo, workbenchRepo)
+	return service, tagRepo, routeRepo
+}
+
 // ============================================================================
 // CreateTag Tests
 // ============================================================================
@@ -322,3 +406,117 @@ func TestGetEntityTag_NotFound(t *testing.T) {
 		t.Error("expected nil tag for entity without tag")
 	}
 }
+
+// ============================================================================
+// Tag Routing Tests
+// ============================================================================
+
+func TestRouteTag_DefaultsToSuggest(t *testing.T) {
+	service, tagRepo, routeRepo := newTestTagServiceWithRoutes()
+	ctx := context.Background()
+	tagRepo.tags["TAG-001"] = &secondary.TagRecord{ID: "TAG-001", Name: "database-schema"}
+
+	if err := service.RouteTag(ctx, primary.RouteTagRequest{TagName: "database-schema", WorkbenchID: "BENCH-002"}); err != nil {
+		t.Fatalf("RouteTag failed: %v", err)
+	}
+
+	route := routeRepo.routes["TAG-001"]
+	if route == nil || route.WorkbenchID != "BENCH-002" || route.Mode != primary.TagRouteModeSuggest {
+		t.Errorf("unexpected route: %+v", route)
+	}
+}
+
+func TestRouteTag_RejectsArchivedWorkbench(t *testing.T) {
+	service, tagRepo, routeRepo := newTestTagServiceWithRoutes()
+	tagRepo.tags["TAG-001"] = &secondary.TagRecord{ID: "TAG-001", Name: "database-schema"}
+
+	err := service.RouteTag(context.Background(), primary.RouteTagRequest{TagName: "database-schema", WorkbenchID: "BENCH-009"})
+	if err == nil {
+		t.Fatal("expected error routing to archived workbench")
+	}
+	if len(routeRepo.routes) != 0 {
+		t.Error("expected no route stored")
+	}
+}
+
+func TestUnrouteTag(t *testing.T) {
+	service, tagRepo, routeRepo := newTestTagServiceWithRoutes()
+	ctx := context.Background()
+	tagRepo.tags["TAG-001"] = &secondary.TagRecord{ID: "TAG-001", Name: "database-schema"}
+	routeRepo.routes["TAG-001"] = &secondary.TagRouteRecord{TagID: "TAG-001", TagName: "database-schema", WorkbenchID: "BENCH-002", Mode: "suggest"}
+
+	if err := service.UnrouteTag(ctx, "database-schema"); err != nil {
+		t.Fatalf("UnrouteTag failed: %v", err)
+	}
+	if err := service.UnrouteTag(ctx, "database-schema"); err == nil {
+		t.Error("expected error removing a missing route")
+	}
+}
+
+func TestRenameTag(t *testing.T) {
+	service, tagRepo := newTestTagService()
+	ctx := context.Background()
+	tagRepo.tags["TAG-001"] = &secondary.TagRecord{ID: "TAG-001", Name: "testing"}
+	tagRepo.tags["TAG-002"] = &secondary.TagRecord{ID: "TAG-002", Name: "tests"}
+
+	if _, err := service.RenameTag(ctx, "testing", "tests"); err == nil {
+		t.Error("expected renaming onto an existing tag to fail")
+	}
+
+	tag, err := service.RenameTag(ctx, "testing", "qa")
+	if err != nil {
+		t.Fatalf("RenameTag failed: %v", err)
+	}
+	if tag.ID != "TAG-001" || tag.Name != "qa" {
+		t.Errorf("unexpected tag: %+v", tag)
+	}
+}
+
+func TestMergeTags(t *testing.T) {
+	service, tagRepo := newTestTagService()
+	ctx := context.Background()
+	tagRepo.tags["TAG-001"] = &secondary.TagRecord{ID: "TAG-001", Name: "testing"}
+	tagRepo.tags["TAG-002"] = &secondary.TagRecord{ID: "TAG-002", Name: "tests"}
+	tagRepo.tags["TAG-003"] = &secondary.TagRecord{ID: "TAG-003", Name: "test"}
+	tagRepo.entityTags["task:TASK-001"] = tagRepo.tags["TAG-001"]
+	tagRepo.entityTags["note:NOTE-001"] = tagRepo.tags["TAG-003"]
+
+	// A missing source stops the whole merge before anything moves
+	if _, err := service.MergeTags(ctx, primary.MergeTagsRequest{Sources: []string{"testing", "tset"}, Into: "tests"}); err == nil {
+		t.Fatal("expected missing source to fail")
+	}
+	if len(tagRepo.tags) != 3 {
+		t.Fatal("expected no tags merged")
+	}
+
+	resp, err := service.MergeTags(ctx, primary.MergeTagsRequest{Sources: []string{"testing", "test"}, Into: "tests"})
+	if err != nil {
+		t.Fatalf("MergeTags failed: %v", err)
+	}
+	if len(resp.Sources) != 2 || resp.Sources[0].Name != "testing" || resp.Sources[0].Moved != 1 {
+		t.Errorf("unexpected response: %+v", resp.Sources)
+	}
+	if len(tagRepo.tags) != 1 || tagRepo.entityTags["task:TASK-001"].Name != "tests" {
+		t.Errorf("expected everything on tests, got %+v", tagRepo.entityTags)
+	}
+}
+
+func TestTagUsage(t *testing.T) {
+	service, tagRepo := newTestTagService()
+	tagRepo.tags["TAG-001"] = &secondary.TagRecord{ID: "TAG-001", Name: "testing"}
+	tagRepo.tags["TAG-002"] = &secondary.TagRecord{ID: "TAG-002", Name: "tests"}
+	tagRepo.tags["TAG-003"] = &secondary.TagRecord{ID: "TAG-003", Name: "ui"}
+	tagRepo.entityTags["task:TASK-001"] = tagRepo.tags["TAG-002"]
+	tagRepo.entityTags["note:NOTE-001"] = tagRepo.tags["TAG-002"]
+
+	report, err := service.TagUsage(context.Background())
+	if err != nil {
+		t.Fatalf("TagUsage failed: %v", err)
+	}
+	if len(report.Tags) != 3 || report.Tags[1].Name != "tests" || report.Tags[1].Total != 2 || report.Tags[0].Total != 0 {
+		t.Errorf("unexpected usage: %+v", report.Tags)
+	}
+	if len(report.Similar) != 1 || len(report.Similar[0]) != 2 {
+		t.Errorf("expected testing and tests grouped, got %v", report.Similar)
+	}
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"time"
 
+	coretag "github.com/example/orc/internal/core/tag"
 	"github.com/example/orc/internal/core/task"
+	"github.com/example/orc/internal/ctxutil"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
 )
@@ -15,6 +19,8 @@ type TaskServiceImpl struct {
 	taskRepo     secondary.TaskRepository
 	tagRepo      secondary.TagRepository
 	shipmentRepo secondary.ShipmentRepository
+	routeRepo    secondary.TagRouteRepository
+	now          func() time.Time
 }
 
 // NewTaskService creates a new TaskService with injected dependencies.
@@ -22,16 +28,33 @@ func NewTaskService(
 	taskRepo secondary.TaskRepository,
 	tagRepo secondary.TagRepository,
 	shipmentRepo secondary.ShipmentRepository,
+	routeRepo secondary.TagRouteRepository,
 ) *TaskServiceImpl {
 	return &TaskServiceImpl{
 		taskRepo:     taskRepo,
 		tagRepo:      tagRepo,
 		shipmentRepo: shipmentRepo,
+		routeRepo:    routeRepo,
+		now:          time.Now,
 	}
 }
 
 // CreateTask creates a new task.
 func (s *TaskServiceImpl) CreateTask(ctx context.Context, req primary.CreateTaskRequest) (*primary.CreateTaskResponse, error) {
+	if req.Points < 0 {
+		return nil, fmt.Errorf("points must not be negative")
+	}
+	if req.Priority != "" && !task.ValidPriority(req.Priority) {
+		return nil, fmt.Errorf("invalid priority %q (use low, medium, or high)", req.Priority)
+	}
+	var dueDate string
+	if req.DueDate != "" {
+		var err error
+		if dueDate, err = task.ParseDueDate(req.DueDate, s.now()); err != nil {
+			return nil, err
+		}
+	}
+
 	// Validate commission exists
 	exists, err := s.taskRepo.CommissionExists(ctx, req.CommissionID)
 	if err != nil {
@@ -60,6 +83,20 @@ func (s *TaskServiceImpl) CreateTask(ctx context.Context, req primary.CreateTask
 		}
 	}
 
+	// Resolve the tag and its route, if any
+	var tag *secondary.TagRecord
+	var route *secondary.TagRouteRecord
+	if req.Tag != "" {
+		tag, err = s.tagRepo.GetByName(ctx, req.Tag)
+		if err != nil || tag == nil {
+			return nil, fmt.Errorf("tag '%s' not found", req.Tag)
+		}
+		route, err = s.routeRepo.GetByTag(ctx, tag.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tag route: %w", err)
+		}
+	}
+
 	// Get next ID
 	nextID, err := s.taskRepo.GetNextID(ctx)
 	if err != nil {
@@ -86,21 +123,53 @@ func (s *TaskServiceImpl) CreateTask(ctx context.Context, req primary.CreateTask
 		Type:         req.Type,
 		Status:       "open",
 		DependsOn:    dependsOnJSON,
+		Points:       req.Points,
+		Priority:     req.Priority,
+		DueDate:      dueDate,
+	}
+	if route != nil && route.Mode == coretag.RouteModeAssign {
+		record.AssignedWorkbenchID = route.WorkbenchID
 	}
 
 	if err := s.taskRepo.Create(ctx, record); err != nil {
 		return nil, fmt.Errorf("failed to create task: %w", err)
 	}
 
+	if tag != nil {
+		if err := s.taskRepo.AddTag(ctx, nextID, tag.ID); err != nil {
+			return nil, fmt.Errorf("failed to tag task: %w", err)
+		}
+	}
+
 	// Fetch created task
 	created, err := s.taskRepo.GetByID(ctx, nextID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to fetch created task: %w", err)
 	}
 
-	return &primary.CreateTaskResponse{
+	resp := &primary.CreateTaskResponse{
 		TaskID: created.ID,
 		Task:   recordToTask(created),
+	}
+	if route != nil {
+		resp.Route = recordToTagRoute(route)
+	}
+	return resp, nil
+}
+
+// ParseQuickAdd reads a one-line task into a create request, resolving
+// relative due dates against today.
+func (s *TaskServiceImpl) ParseQuickAdd(ctx context.Context, line string) (*primary.CreateTaskRequest, error) {
+	q, err := task.ParseQuickAdd(line, s.now())
+	if err != nil {
+		return nil, err
+	}
+	return &primary.CreateTaskRequest{
+		Title:      q.Title,
+		Priority:   q.Priority,
+		Tag:        q.Tag,
+		ShipmentID: q.ShipmentID,
+		DueDate:    q.DueDate,
 	}, nil
 }
 
@@ -125,6 +194,19 @@ func (s *TaskServiceImpl) GetTask(ctx context.Context, taskID string) (*primary.
 		}
 	}
 
+	items, err := s.taskRepo.ListChecklistItems(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task checklist: %w", err)
+	}
+	for i, item := range items {
+		task.Checklist = append(task.Checklist, &primary.ChecklistItem{
+			Position:    i + 1,
+			Text:        item.Text,
+			Done:        item.Done,
+			CompletedAt: item.CompletedAt,
+		})
+	}
+
 	return task, nil
 }
 
@@ -147,16 +229,34 @@ func (s *TaskServiceImpl) ListTasks(ctx context.Context, filters primary.TaskFil
 }
 
 // ClaimTask claims a task for a workbench.
+// Claiming a task the state machine does not allow (e.g. a closed one)
+// requires req.Force and is logged as forced.
 func (s *TaskServiceImpl) ClaimTask(ctx context.Context, req primary.ClaimTaskRequest) error {
-	// Verify task exists
-	_, err := s.taskRepo.GetByID(ctx, req.TaskID)
+	record, err := s.taskRepo.GetByID(ctx, req.TaskID)
 	if err != nil {
 		return err
 	}
 
+	ctx, err = checkTaskTransition(ctx, record, "in-progress", req.Force)
+	if err != nil {
+		return err
+	}
+	if err := s.checkTaskStart(ctx, req.TaskID); err != nil {
+		return err
+	}
+
 	return s.taskRepo.Claim(ctx, req.TaskID, req.WorkbenchID)
 }
 
+// checkTaskStart refuses to start a task whose plan is conditionally approved.
+func (s *TaskServiceImpl) checkTaskStart(ctx context.Context, taskID string) error {
+	planIDs, err := s.taskRepo.ListConditionalPlanIDs(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	return task.CanStartTask(task.StartTaskContext{TaskID: taskID, ConditionalPlanIDs: planIDs}).Error()
+}
+
 // CloseTask marks a task as closed.
 func (s *TaskServiceImpl) CloseTask(ctx context.Context, taskID string) error {
 	record, err := s.taskRepo.GetByID(ctx, taskID)
@@ -169,9 +269,118 @@ func (s *TaskServiceImpl) CloseTask(ctx context.Context, taskID string) error {
 		return fmt.Errorf("cannot close pinned task %s. Unpin first with: orc task unpin %s", taskID, taskID)
 	}
 
+	if _, err := checkTaskTransition(ctx, record, "closed", false); err != nil {
+		return err
+	}
+
 	return s.taskRepo.UpdateStatus(ctx, taskID, "closed", false, true)
 }
 
+// RefreshClaims records a heartbeat on a workbench's claimed tasks.
+func (s *TaskServiceImpl) RefreshClaims(ctx context.Context, workbenchID string) (int, error) {
+	return s.taskRepo.RefreshClaims(ctx, workbenchID)
+}
+
+// ListClaims retrieves in-progress tasks with their claim expiry, longest
+// silent first.
+func (s *TaskServiceImpl) ListClaims(ctx context.Context, req primary.ListClaimsRequest) ([]*primary.TaskClaim, error) {
+	records, err := s.taskRepo.List(ctx, secondary.TaskFilters{Status: "in-progress"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list claimed tasks: %w", err)
+	}
+
+	now := s.now()
+	var claims []*primary.TaskClaim
+	for _, r := range records {
+		claim := s.recordToClaim(r, now, req.TTL)
+		if req.StaleOnly && !claim.Stale {
+			continue
+		}
+		claims = append(claims, claim)
+	}
+	sort.Slice(claims, func(i, j int) bool {
+		if claims[i].LastSeenAt != claims[j].LastSeenAt {
+			return claims[i].LastSeenAt < claims[j].LastSeenAt
+		}
+		return claims[i].TaskID < claims[j].TaskID
+	})
+	return claims, nil
+}
+
+// ReapStaleClaims releases expired claims, returning their tasks to open.
+// The tasks stay assigned to their workbench; only the claim is dropped,
+// so the task can be claimed again by any IMP.
+func (s *TaskServiceImpl) ReapStaleClaims(ctx context.Context, req primary.ReapClaimsRequest) ([]*primary.TaskClaim, error) {
+	stale, err := s.ListClaims(ctx, primary.ListClaimsRequest{TTL: req.TTL, StaleOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	if req.DryRun {
+		return stale, nil
+	}
+
+	for _, claim := range stale {
+		if err := s.taskRepo.UpdateStatus(ctx, claim.TaskID, "open", false, false); err != nil {
+			return nil, fmt.Errorf("failed to release claim on %s: %w", claim.TaskID, err)
+		}
+	}
+	return stale, nil
+}
+
+// recordToClaim evaluates an in-progress task's claim at now.
+func (s *TaskServiceImpl) recordToClaim(r *secondary.TaskRecord, now time.Time, ttl time.Duration) *primary.TaskClaim {
+	claimedAt, _ := time.Parse(time.RFC3339, r.ClaimedAt)
+	refreshedAt, _ := time.Parse(time.RFC3339, r.ClaimRefreshedAt)
+	lastSeen := task.ClaimLastSeen(claimedAt, refreshedAt)
+
+	claim := &primary.TaskClaim{
+		TaskID:      r.ID,
+		Title:       r.Title,
+		ShipmentID:  r.ShipmentID,
+		WorkbenchID: r.AssignedWorkbenchID,
+		ClaimedAt:   r.ClaimedAt,
+		Stale: task.CanReapClaim(task.ReapClaimContext{
+			TaskID:   r.ID,
+			Status:   r.Status,
+			LastSeen: lastSeen,
+			Now:      now,
+			TTL:      ttl,
+		}).Allowed,
+	}
+	if !lastSeen.IsZero() {
+		claim.LastSeenAt = lastSeen.UTC().Format(time.RFC3339)
+		claim.ExpiresAt = task.ClaimExpiry(lastSeen, ttl).UTC().Format(time.RFC3339)
+	}
+	return claim
+}
+
+// StatusTransitions returns the legal task status transitions.
+func (s *TaskServiceImpl) StatusTransitions() []primary.StatusTransition {
+	var result []primary.StatusTransition
+	for _, t := range task.Transitions() {
+		result = append(result, primary.StatusTransition{From: t.From, To: t.To, Reason: t.Reason})
+	}
+	return result
+}
+
+// checkTaskTransition guards a task status change against the state machine.
+// A forced illegal transition returns a context that marks the change as forced.
+func checkTaskTransition(ctx context.Context, record *secondary.TaskRecord, to string, force bool) (context.Context, error) {
+	guard := task.CanTransition(task.TransitionContext{
+		TaskID: record.ID,
+		From:   record.Status,
+		To:     to,
+		Force:  force,
+	})
+	if !guard.Allowed {
+		return ctx, guard.Error()
+	}
+	if !task.IsLegalTransition(record.Status, to) {
+		ctx = ctxutil.WithForced(ctx)
+	}
+	return ctx, nil
+}
+
 // CompleteTask marks a task as closed (backwards compatibility alias).
 func (s *TaskServiceImpl) CompleteTask(ctx context.Context, taskID string) error {
 	return s.CloseTask(ctx, taskID)
@@ -203,16 +412,45 @@ func (s *TaskServiceImpl) ResumeTask(ctx context.Context, taskID string) error {
 	if record.Status != "open" {
 		return fmt.Errorf("can only resume open tasks (current status: %s)", record.Status)
 	}
+	if err := s.checkTaskStart(ctx, taskID); err != nil {
+		return err
+	}
 
 	return s.taskRepo.UpdateStatus(ctx, taskID, "in-progress", false, false)
 }
 
+// BlockTask marks an in-progress task as blocked (in-progress -> blocked).
+func (s *TaskServiceImpl) BlockTask(ctx context.Context, taskID string) error {
+	return s.transitionTask(ctx, taskID, "blocked")
+}
+
+// UnblockTask returns a blocked task to work (blocked -> in-progress).
+func (s *TaskServiceImpl) UnblockTask(ctx context.Context, taskID string) error {
+	return s.transitionTask(ctx, taskID, "in-progress")
+}
+
+// transitionTask moves a task along a legal status transition.
+func (s *TaskServiceImpl) transitionTask(ctx context.Context, taskID, to string) error {
+	record, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	if _, err := checkTaskTransition(ctx, record, to, false); err != nil {
+		return err
+	}
+	return s.taskRepo.UpdateStatus(ctx, taskID, to, false, false)
+}
+
 // UpdateTask updates a task's title and/or description.
 func (s *TaskServiceImpl) UpdateTask(ctx context.Context, req primary.UpdateTaskRequest) error {
+	if req.Points < 0 {
+		return fmt.Errorf("points must not be negative")
+	}
 	record := &secondary.TaskRecord{
 		ID:          req.TaskID,
 		Title:       req.Title,
 		Description: req.Description,
+		Points:      req.Points,
 	}
 	return s.taskRepo.Update(ctx, record)
 }
@@ -345,6 +583,78 @@ func (s *TaskServiceImpl) DiscoverTasks(ctx context.Context, workbenchID string)
 	return openTasks, nil
 }
 
+// ClaimNextTask claims the workbench's next open task in a commission.
+func (s *TaskServiceImpl) ClaimNextTask(ctx context.Context, req primary.ClaimNextTaskRequest) (*primary.Task, error) {
+	records, err := s.taskRepo.List(ctx, secondary.TaskFilters{
+		CommissionID: req.CommissionID,
+		Status:       "open",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	routes, err := s.routeRepo.List(ctx, req.WorkbenchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tag routes: %w", err)
+	}
+	routedTags := make(map[string]bool, len(routes))
+	for _, r := range routes {
+		routedTags[r.TagID] = true
+	}
+
+	var candidates []coretag.NextTaskCandidate
+	for _, r := range records {
+		// Tasks assigned to other workbenches belong to them
+		if r.AssignedWorkbenchID != "" && r.AssignedWorkbenchID != req.WorkbenchID {
+			continue
+		}
+
+		candidate := coretag.NextTaskCandidate{
+			ID:           r.ID,
+			AssignedHere: r.AssignedWorkbenchID != "",
+			Blocked:      s.hasOpenDependencies(ctx, r) || s.checkTaskStart(ctx, r.ID) != nil,
+		}
+		if len(routedTags) > 0 {
+			if tag, _ := s.taskRepo.GetTag(ctx, r.ID); tag != nil {
+				candidate.Routed = routedTags[tag.ID]
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	nextID := coretag.PickNextTask(candidates)
+	if nextID == "" {
+		return nil, nil
+	}
+
+	if err := s.taskRepo.Claim(ctx, nextID, req.WorkbenchID); err != nil {
+		return nil, err
+	}
+	claimed, err := s.taskRepo.GetByID(ctx, nextID)
+	if err != nil {
+		return nil, err
+	}
+	return recordToTask(claimed), nil
+}
+
+// hasOpenDependencies reports whether any of a task's dependencies is not closed.
+func (s *TaskServiceImpl) hasOpenDependencies(ctx context.Context, record *secondary.TaskRecord) bool {
+	if record.DependsOn == "" {
+		return false
+	}
+	var dependsOn []string
+	if err := json.Unmarshal([]byte(record.DependsOn), &dependsOn); err != nil {
+		return false
+	}
+	for _, depID := range dependsOn {
+		dep, err := s.taskRepo.GetByID(ctx, depID)
+		if err == nil && dep.Status != "closed" {
+			return true
+		}
+	}
+	return false
+}
+
 // MoveTask moves a task to a different container.
 func (s *TaskServiceImpl) MoveTask(ctx context.Context, req primary.MoveTaskRequest) error {
 	// Verify task exists
@@ -397,5 +707,75 @@ func (s *TaskServiceImpl) MoveTask(ctx context.Context, req primary.MoveTaskRequ
 	return s.taskRepo.Update(ctx, record)
 }
 
+// AddChecklistItem appends a checklist item to a task.
+func (s *TaskServiceImpl) AddChecklistItem(ctx context.Context, taskID, text string) (*primary.ChecklistItem, error) {
+	if text == "" {
+		return nil, fmt.Errorf("checklist item text cannot be empty")
+	}
+
+	// Verify task exists
+	_, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &secondary.ChecklistItemRecord{TaskID: taskID, Text: text}
+	if err := s.taskRepo.AddChecklistItem(ctx, record); err != nil {
+		return nil, err
+	}
+
+	items, err := s.taskRepo.ListChecklistItems(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task checklist: %w", err)
+	}
+
+	return &primary.ChecklistItem{Position: len(items), Text: text}, nil
+}
+
+// SetChecklistItemDone checks or unchecks the item at a 1-based position.
+func (s *TaskServiceImpl) SetChecklistItemDone(ctx context.Context, taskID string, position int, done bool) error {
+	item, err := s.checklistItemAt(ctx, taskID, position)
+	if err != nil {
+		return err
+	}
+
+	return s.taskRepo.SetChecklistItemDone(ctx, item.ID, done)
+}
+
+// RemoveChecklistItem removes the item at a 1-based position.
+func (s *TaskServiceImpl) RemoveChecklistItem(ctx context.Context, taskID string, position int) error {
+	item, err := s.checklistItemAt(ctx, taskID, position)
+	if err != nil {
+		return err
+	}
+
+	return s.taskRepo.DeleteChecklistItem(ctx, item.ID)
+}
+
+// checklistItemAt resolves a 1-based checklist position to its record.
+func (s *TaskServiceImpl) checklistItemAt(ctx context.Context, taskID string, position int) (*secondary.ChecklistItemRecord, error) {
+	// Verify task exists
+	_, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.taskRepo.ListChecklistItems(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get task checklist: %w", err)
+	}
+
+	guard := task.CanEditChecklistItem(task.ChecklistItemContext{
+		TaskID:    taskID,
+		Position:  position,
+		ItemCount: len(items),
+	})
+	if err := guard.Error(); err != nil {
+		return nil, err
+	}
+
+	return items[position-1], nil
+}
+
 // Ensure TaskServiceImpl implements the interface
 var _ primary.TaskService = (*TaskServiceImpl)(nil)
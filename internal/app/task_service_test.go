@@ -3,7 +3,10 @@ package app
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
@@ -28,6 +31,8 @@ type mockTaskRepository struct {
 	commissionExistsErr    error
 	shipmentExistsResult   bool
 	shipmentExistsErr      error
+	checklist              []*secondary.ChecklistItemRecord
+	conditionalPlans       map[string][]string // taskID -> conditionally approved plan IDs
 }
 
 func newMockTaskRepository() *mockTaskRepository {
@@ -115,7 +120,7 @@ func (m *mockTaskRepository) Unpin(ctx context.Context, id string) error {
 }
 
 func (m *mockTaskRepository) GetNextID(ctx context.Context) (string, error) {
-	return "TASK-001", nil
+	return fmt.Sprintf("TASK-%03d", len(m.tasks)+1), nil
 }
 
 func (m *mockTaskRepository) GetByWorkbench(ctx context.Context, workbenchID string) ([]*secondary.TaskRecord, error) {
@@ -154,6 +159,22 @@ func (m *mockTaskRepository) UpdateStatus(ctx context.Context, id, status string
 	return nil
 }
 
+func (m *mockTaskRepository) RevertStatus(ctx context.Context, id, status string, clearClaim, clearCompleted bool) error {
+	if m.updateStatusErr != nil {
+		return m.updateStatusErr
+	}
+	if task, ok := m.tasks[id]; ok {
+		task.Status = status
+		if clearClaim {
+			task.ClaimedAt, task.ClaimRefreshedAt = "", ""
+		}
+		if clearCompleted {
+			task.CompletedAt = ""
+		}
+	}
+	return nil
+}
+
 func (m *mockTaskRepository) Claim(ctx context.Context, id, workbenchID string) error {
 	if m.claimErr != nil {
 		return m.claimErr
@@ -166,6 +187,17 @@ func (m *mockTaskRepository) Claim(ctx context.Context, id, workbenchID string)
 	return nil
 }
 
+func (m *mockTaskRepository) RefreshClaims(ctx context.Context, workbenchID string) (int, error) {
+	refreshed := 0
+	for _, task := range m.tasks {
+		if task.AssignedWorkbenchID == workbenchID && task.Status == "in-progress" {
+			task.ClaimRefreshedAt = "2026-01-20T11:00:00Z"
+			refreshed++
+		}
+	}
+	return refreshed, nil
+}
+
 func (m *mockTaskRepository) AssignWorkbenchByShipment(ctx context.Context, shipmentID, workbenchID string) error {
 	return nil
 }
@@ -222,6 +254,46 @@ func (m *mockTaskRepository) GetNextEntityTagID(ctx context.Context) (string, er
 	return "ENTITY-TAG-001", nil
 }
 
+func (m *mockTaskRepository) ListChecklistItems(ctx context.Context, taskID string) ([]*secondary.ChecklistItemRecord, error) {
+	var items []*secondary.ChecklistItemRecord
+	for _, item := range m.checklist {
+		if item.TaskID == taskID {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+func (m *mockTaskRepository) AddChecklistItem(ctx context.Context, item *secondary.ChecklistItemRecord) error {
+	item.ID = int64(len(m.checklist) + 1)
+	m.checklist = append(m.checklist, item)
+	return nil
+}
+
+func (m *mockTaskRepository) SetChecklistItemDone(ctx context.Context, itemID int64, done bool) error {
+	for _, item := range m.checklist {
+		if item.ID == itemID {
+			item.Done = done
+			return nil
+		}
+	}
+	return errors.New("checklist item not found")
+}
+
+func (m *mockTaskRepository) ListConditionalPlanIDs(ctx context.Context, taskID string) ([]string, error) {
+	return m.conditionalPlans[taskID], nil
+}
+
+func (m *mockTaskRepository) DeleteChecklistItem(ctx context.Context, itemID int64) error {
+	for i, item := range m.checklist {
+		if item.ID == itemID {
+			m.checklist = append(m.checklist[:i], m.checklist[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("checklist item not found")
+}
+
 // mockTagRepositoryForTask implements minimal TagRepository for task tests.
 type mockTagRepositoryForTask struct {
 	tags map[string]*secondary.TagRecord
@@ -275,15 +347,33 @@ func (m *mockTagRepositoryForTask) GetEntityTag(ctx context.Context, entityID, e
 	return nil, nil
 }
 
+func (m *mockTagRepositoryForTask) Rename(ctx context.Context, id, name string) error {
+	return nil
+}
+
+func (m *mockTagRepositoryForTask) Merge(ctx context.Context, sourceID, targetID string) (*secondary.TagMergeRecord, error) {
+	return &secondary.TagMergeRecord{}, nil
+}
+
+func (m *mockTagRepositoryForTask) Usage(ctx context.Context) ([]*secondary.TagUsageRecord, error) {
+	return nil, nil
+}
+
 // ============================================================================
 // Test Helper
 // ============================================================================
 
 func newTestTaskService() (*TaskServiceImpl, *mockTaskRepository, *mockTagRepositoryForTask) {
+	service, taskRepo, tagRepo, _ := newTestTaskServiceWithRoutes()
+	return service, taskRepo, tagRepo
+}
+
+func newTestTaskServiceWithRoutes() (*TaskServiceImpl, *mockTaskRepository, *mockTagRepositoryForTask, *mockTagRouteRepository) {
 	taskRepo := newMockTaskRepository()
 	tagRepo := newMockTagRepositoryForTask()
-	service := NewTaskService(taskRepo, tagRepo, nil) // nil shipmentRepo for basic tests
-	return service, taskRepo, tagRepo
+	routeRepo := newMockTagRouteRepository()
+	service := NewTaskService(taskRepo, tagRepo, nil, routeRepo) // nil shipmentRepo for basic tests
+	return service, taskRepo, tagRepo, routeRepo
 }
 
 // ============================================================================
@@ -333,6 +423,47 @@ func TestCreateTask_WithShipment(t *testing.T) {
 	}
 }
 
+func TestCreateTask_PriorityAndDueDate(t *testing.T) {
+	service, _, _ := newTestTaskService()
+	ctx := context.Background()
+
+	resp, err := service.CreateTask(ctx, primary.CreateTaskRequest{
+		CommissionID: "COMM-001",
+		Title:        "Fix flaky auth test",
+		Priority:     "high",
+		DueDate:      "2026-10-16",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if resp.Task.Priority != "high" || resp.Task.DueDate != "2026-10-16" {
+		t.Errorf("priority, due date = %q, %q; want high, 2026-10-16", resp.Task.Priority, resp.Task.DueDate)
+	}
+
+	for _, req := range []primary.CreateTaskRequest{
+		{CommissionID: "COMM-001", Title: "Bad priority", Priority: "urgent"},
+		{CommissionID: "COMM-001", Title: "Bad due date", DueDate: "someday"},
+	} {
+		if _, err := service.CreateTask(ctx, req); err == nil {
+			t.Errorf("%s: expected error", req.Title)
+		}
+	}
+}
+
+func TestParseQuickAdd(t *testing.T) {
+	service, _, _ := newTestTaskService()
+	service.now = func() time.Time { return time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC) } // A Thursday
+
+	req, err := service.ParseQuickAdd(context.Background(), "Fix flaky auth test !high #testing @SHIP-010 due:fri")
+	if err != nil {
+		t.Fatalf("ParseQuickAdd failed: %v", err)
+	}
+	want := primary.CreateTaskRequest{Title: "Fix flaky auth test", Priority: "high", Tag: "testing", ShipmentID: "SHIP-010", DueDate: "2026-10-16"}
+	if req.Title != want.Title || req.Priority != want.Priority || req.Tag != want.Tag || req.ShipmentID != want.ShipmentID || req.DueDate != want.DueDate {
+		t.Errorf("ParseQuickAdd() = %+v, want %+v", req, want)
+	}
+}
+
 func TestCreateTask_CommissionNotFound(t *testing.T) {
 	service, taskRepo, _ := newTestTaskService()
 	ctx := context.Background()
@@ -584,6 +715,52 @@ func TestClaimTask_TaskNotFound(t *testing.T) {
 	}
 }
 
+func TestClaimTask_ClosedRequiresForce(t *testing.T) {
+	service, taskRepo, _ := newTestTaskService()
+	ctx := context.Background()
+
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{
+		ID:           "TASK-001",
+		CommissionID: "COMM-001",
+		Title:        "Test Task",
+		Status:       "closed",
+	}
+
+	err := service.ClaimTask(ctx, primary.ClaimTaskRequest{TaskID: "TASK-001", WorkbenchID: "BENCH-001"})
+	if err == nil || !strings.Contains(err.Error(), "use --force") {
+		t.Fatalf("expected state machine error, got %v", err)
+	}
+
+	err = service.ClaimTask(ctx, primary.ClaimTaskRequest{TaskID: "TASK-001", WorkbenchID: "BENCH-001", Force: true})
+	if err != nil {
+		t.Fatalf("expected forced claim to succeed, got %v", err)
+	}
+	if taskRepo.tasks["TASK-001"].AssignedWorkbenchID != "BENCH-001" {
+		t.Errorf("expected task claimed by BENCH-001, got %q", taskRepo.tasks["TASK-001"].AssignedWorkbenchID)
+	}
+}
+
+func TestClaimTask_ConditionalPlanBlocks(t *testing.T) {
+	service, taskRepo, _ := newTestTaskService()
+	ctx := context.Background()
+
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", CommissionID: "COMM-001", Status: "open"}
+	taskRepo.conditionalPlans = map[string][]string{"TASK-001": {"PLAN-002"}}
+
+	err := service.ClaimTask(ctx, primary.ClaimTaskRequest{TaskID: "TASK-001", WorkbenchID: "BENCH-001"})
+	if err == nil || !strings.Contains(err.Error(), "plan PLAN-002 is conditionally approved") {
+		t.Fatalf("expected conditional plan error, got %v", err)
+	}
+	if err := service.ResumeTask(ctx, "TASK-001"); err == nil {
+		t.Error("expected resume to be refused too")
+	}
+
+	next, err := service.ClaimNextTask(ctx, primary.ClaimNextTaskRequest{CommissionID: "COMM-001", WorkbenchID: "BENCH-001"})
+	if err != nil || next != nil {
+		t.Errorf("ClaimNextTask = %+v, %v; want nothing claimable", next, err)
+	}
+}
+
 // ============================================================================
 // CompleteTask Tests
 // ============================================================================
@@ -726,6 +903,52 @@ func TestResumeTask_NotOpenBlocked(t *testing.T) {
 	}
 }
 
+// ============================================================================
+// BlockTask/UnblockTask Tests
+// ============================================================================
+
+func TestBlockTask_RoundTrip(t *testing.T) {
+	service, taskRepo, _ := newTestTaskService()
+	ctx := context.Background()
+
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{
+		ID:           "TASK-001",
+		CommissionID: "COMM-001",
+		Title:        "In Progress Task",
+		Status:       "in-progress",
+	}
+
+	if err := service.BlockTask(ctx, "TASK-001"); err != nil {
+		t.Fatalf("BlockTask failed: %v", err)
+	}
+	if taskRepo.tasks["TASK-001"].Status != "blocked" {
+		t.Errorf("expected status 'blocked', got '%s'", taskRepo.tasks["TASK-001"].Status)
+	}
+
+	if err := service.UnblockTask(ctx, "TASK-001"); err != nil {
+		t.Fatalf("UnblockTask failed: %v", err)
+	}
+	if taskRepo.tasks["TASK-001"].Status != "in-progress" {
+		t.Errorf("expected status 'in-progress', got '%s'", taskRepo.tasks["TASK-001"].Status)
+	}
+}
+
+func TestBlockTask_OpenRejected(t *testing.T) {
+	service, taskRepo, _ := newTestTaskService()
+	ctx := context.Background()
+
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{
+		ID:           "TASK-001",
+		CommissionID: "COMM-001",
+		Title:        "Open Task",
+		Status:       "open",
+	}
+
+	if err := service.BlockTask(ctx, "TASK-001"); err == nil {
+		t.Fatal("expected error for blocking an open task, got nil")
+	}
+}
+
 // ============================================================================
 // Pin/Unpin Tests
 // ============================================================================
@@ -1054,3 +1277,257 @@ func TestDeleteTask_NotFound(t *testing.T) {
 		t.Fatal("expected error for non-existent task")
 	}
 }
+
+// ============================================================================
+// Checklist Tests
+// ============================================================================
+
+func TestChecklist_AddCheckRemove(t *testing.T) {
+	service, taskRepo, _ := newTestTaskService()
+	ctx := context.Background()
+
+	taskRepo.tasks["TASK-042"] = &secondary.TaskRecord{
+		ID:           "TASK-042",
+		CommissionID: "COMM-001",
+		Title:        "Test Task",
+		Status:       "open",
+	}
+
+	for i, text := range []string{"write tests", "update docs", "tag release"} {
+		item, err := service.AddChecklistItem(ctx, "TASK-042", text)
+		if err != nil {
+			t.Fatalf("AddChecklistItem failed: %v", err)
+		}
+		if item.Position != i+1 {
+			t.Errorf("expected position %d, got %d", i+1, item.Position)
+		}
+	}
+
+	if err := service.SetChecklistItemDone(ctx, "TASK-042", 2, true); err != nil {
+		t.Fatalf("SetChecklistItemDone failed: %v", err)
+	}
+	if err := service.RemoveChecklistItem(ctx, "TASK-042", 1); err != nil {
+		t.Fatalf("RemoveChecklistItem failed: %v", err)
+	}
+
+	task, err := service.GetTask(ctx, "TASK-042")
+	if err != nil {
+		t.Fatalf("GetTask failed: %v", err)
+	}
+	if len(task.Checklist) != 2 {
+		t.Fatalf("expected 2 checklist items, got %d", len(task.Checklist))
+	}
+	first := task.Checklist[0]
+	if first.Position != 1 || first.Text != "update docs" || !first.Done {
+		t.Errorf("expected renumbered done item 'update docs', got %+v", first)
+	}
+	if task.Checklist[1].Done {
+		t.Error("expected 'tag release' to be open")
+	}
+}
+
+func TestChecklist_AddEmptyText(t *testing.T) {
+	service, taskRepo, _ := newTestTaskService()
+	ctx := context.Background()
+
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", Status: "open"}
+
+	if _, err := service.AddChecklistItem(ctx, "TASK-001", ""); err == nil {
+		t.Fatal("expected error for empty checklist text")
+	}
+}
+
+func TestChecklist_TaskNotFound(t *testing.T) {
+	service, _, _ := newTestTaskService()
+	ctx := context.Background()
+
+	if _, err := service.AddChecklistItem(ctx, "TASK-999", "anything"); err == nil {
+		t.Fatal("expected error for non-existent task")
+	}
+}
+
+func TestChecklist_PositionOutOfRange(t *testing.T) {
+	service, taskRepo, _ := newTestTaskService()
+	ctx := context.Background()
+
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", Status: "open"}
+	if _, err := service.AddChecklistItem(ctx, "TASK-001", "only item"); err != nil {
+		t.Fatalf("AddChecklistItem failed: %v", err)
+	}
+
+	if err := service.SetChecklistItemDone(ctx, "TASK-001", 2, true); err == nil {
+		t.Fatal("expected error for out-of-range position")
+	}
+	if err := service.RemoveChecklistItem(ctx, "TASK-001", 0); err == nil {
+		t.Fatal("expected error for position 0")
+	}
+}
+
+// ============================================================================
+// Tag Routing Tests
+// ============================================================================
+
+func TestCreateTask_WithRoutedTag(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         string
+		wantAssigned string
+	}{
+		{name: "suggest route leaves task unassigned", mode: "suggest", wantAssigned: ""},
+		{name: "assign route assigns the workbench", mode: "assign", wantAssigned: "BENCH-002"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, tagRepo, routeRepo := newTestTaskServiceWithRoutes()
+			tagRepo.tags["TAG-001"] = &secondary.TagRecord{ID: "TAG-001", Name: "database-schema"}
+			routeRepo.routes["TAG-001"] = &secondary.TagRouteRecord{TagID: "TAG-001", TagName: "database-schema", WorkbenchID: "BENCH-002", Mode: tt.mode}
+
+			resp, err := service.CreateTask(context.Background(), primary.CreateTaskRequest{
+				CommissionID: "COMM-001",
+				Title:        "Add index",
+				Tag:          "database-schema",
+			})
+			if err != nil {
+				t.Fatalf("CreateTask failed: %v", err)
+			}
+			if resp.Route == nil || resp.Route.WorkbenchID != "BENCH-002" || resp.Route.Mode != tt.mode {
+				t.Errorf("unexpected route: %+v", resp.Route)
+			}
+			if resp.Task.AssignedWorkbenchID != tt.wantAssigned {
+				t.Errorf("AssignedWorkbenchID = %q, want %q", resp.Task.AssignedWorkbenchID, tt.wantAssigned)
+			}
+		})
+	}
+}
+
+func TestCreateTask_UnknownTag(t *testing.T) {
+	service, taskRepo, _ := newTestTaskService()
+
+	_, err := service.CreateTask(context.Background(), primary.CreateTaskRequest{
+		CommissionID: "COMM-001",
+		Title:        "Add index",
+		Tag:          "nope",
+	})
+	if err == nil {
+		t.Fatal("expected error for unknown tag")
+	}
+	if len(taskRepo.tasks) != 0 {
+		t.Error("expected no task created")
+	}
+}
+
+func TestClaimNextTask_PrefersRoutedTags(t *testing.T) {
+	service, taskRepo, _, routeRepo := newTestTaskServiceWithRoutes()
+	ctx := context.Background()
+
+	routed := &secondary.TagRecord{ID: "TAG-001", Name: "database-schema"}
+	routeRepo.routes["TAG-001"] = &secondary.TagRouteRecord{TagID: "TAG-001", WorkbenchID: "BENCH-002", Mode: "suggest"}
+
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", CommissionID: "COMM-001", Status: "open"}
+	taskRepo.tasks["TASK-002"] = &secondary.TaskRecord{ID: "TASK-002", CommissionID: "COMM-001", Status: "open"}
+	taskRepo.tasks["TASK-003"] = &secondary.TaskRecord{ID: "TASK-003", CommissionID: "COMM-001", Status: "open", AssignedWorkbenchID: "BENCH-007"}
+	taskRepo.tags["TASK-002"] = routed
+	taskRepo.tags["TASK-003"] = routed
+
+	task, err := service.ClaimNextTask(ctx, primary.ClaimNextTaskRequest{WorkbenchID: "BENCH-002", CommissionID: "COMM-001"})
+	if err != nil {
+		t.Fatalf("ClaimNextTask failed: %v", err)
+	}
+	if task == nil || task.ID != "TASK-002" {
+		t.Fatalf("expected TASK-002, got %+v", task)
+	}
+	if claimed := taskRepo.tasks["TASK-002"]; claimed.Status != "in-progress" || claimed.AssignedWorkbenchID != "BENCH-002" {
+		t.Errorf("expected TASK-002 claimed by BENCH-002, got %+v", claimed)
+	}
+}
+
+func TestClaimNextTask_SkipsBlockedAndOthersWork(t *testing.T) {
+	service, taskRepo, _, _ := newTestTaskServiceWithRoutes()
+	ctx := context.Background()
+
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", CommissionID: "COMM-001", Status: "in-progress"}
+	taskRepo.tasks["TASK-002"] = &secondary.TaskRecord{ID: "TASK-002", CommissionID: "COMM-001", Status: "open", DependsOn: `["TASK-001"]`}
+	taskRepo.tasks["TASK-003"] = &secondary.TaskRecord{ID: "TASK-003", CommissionID: "COMM-001", Status: "open", AssignedWorkbenchID: "BENCH-007"}
+
+	task, err := service.ClaimNextTask(ctx, primary.ClaimNextTaskRequest{WorkbenchID: "BENCH-002", CommissionID: "COMM-001"})
+	if err != nil {
+		t.Fatalf("ClaimNextTask failed: %v", err)
+	}
+	if task != nil {
+		t.Errorf("expected no claimable task, got %s", task.ID)
+	}
+}
+
+// ============================================================================
+// Claim Expiry Tests
+// ============================================================================
+
+// newClaimTestService seeds three claims made at 10:00 and refreshes
+// BENCH-002's at 11:00; the clock reads 12:30.
+func newClaimTestService(t *testing.T) (*TaskServiceImpl, *mockTaskRepository) {
+	t.Helper()
+	service, taskRepo, _ := newTestTaskService()
+	service.now = func() time.Time { return time.Date(2026, 1, 20, 12, 30, 0, 0, time.UTC) }
+
+	// Claimed at 10:00; only BENCH-002 has sent a heartbeat since
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", Status: "in-progress", AssignedWorkbenchID: "BENCH-001", ClaimedAt: "2026-01-20T10:00:00Z"}
+	taskRepo.tasks["TASK-002"] = &secondary.TaskRecord{ID: "TASK-002", Status: "in-progress", AssignedWorkbenchID: "BENCH-002", ClaimedAt: "2026-01-20T10:00:00Z"}
+	taskRepo.tasks["TASK-003"] = &secondary.TaskRecord{ID: "TASK-003", Status: "blocked", AssignedWorkbenchID: "BENCH-001", ClaimedAt: "2026-01-20T10:00:00Z"}
+	if _, err := service.RefreshClaims(context.Background(), "BENCH-002"); err != nil {
+		t.Fatalf("failed to refresh BENCH-002's claim: %v", err)
+	}
+	return service, taskRepo
+}
+
+func TestListClaims(t *testing.T) {
+	service, _ := newClaimTestService(t)
+	ctx := context.Background()
+
+	claims, err := service.ListClaims(ctx, primary.ListClaimsRequest{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(claims) != 2 {
+		t.Fatalf("expected 2 claims, got %d", len(claims))
+	}
+	if claims[0].TaskID != "TASK-001" || !claims[0].Stale || claims[0].ExpiresAt != "2026-01-20T12:00:00Z" {
+		t.Errorf("unexpected stale claim: %+v", claims[0])
+	}
+	if claims[1].TaskID != "TASK-002" || claims[1].Stale || claims[1].LastSeenAt != "2026-01-20T11:00:00Z" {
+		t.Errorf("unexpected refreshed claim: %+v", claims[1])
+	}
+
+	// A longer TTL keeps both claims live
+	claims, _ = service.ListClaims(ctx, primary.ListClaimsRequest{TTL: 4 * time.Hour, StaleOnly: true})
+	if len(claims) != 0 {
+		t.Errorf("expected no stale claims with a 4h TTL, got %d", len(claims))
+	}
+}
+
+func TestReapStaleClaims(t *testing.T) {
+	service, taskRepo := newClaimTestService(t)
+	ctx := context.Background()
+
+	reaped, err := service.ReapStaleClaims(ctx, primary.ReapClaimsRequest{DryRun: true})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(reaped) != 1 || taskRepo.tasks["TASK-001"].Status != "in-progress" {
+		t.Fatalf("dry run: expected 1 reported claim and no changes, got %d", len(reaped))
+	}
+
+	reaped, err = service.ReapStaleClaims(ctx, primary.ReapClaimsRequest{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(reaped) != 1 || reaped[0].TaskID != "TASK-001" {
+		t.Fatalf("expected TASK-001 reaped, got %+v", reaped)
+	}
+	if got := taskRepo.tasks["TASK-001"]; got.Status != "open" || got.AssignedWorkbenchID != "BENCH-001" {
+		t.Errorf("expected TASK-001 open and still assigned, got %s/%s", got.Status, got.AssignedWorkbenchID)
+	}
+	if taskRepo.tasks["TASK-002"].Status != "in-progress" || taskRepo.tasks["TASK-003"].Status != "blocked" {
+		t.Error("expected live and blocked tasks untouched")
+	}
+}
@@ -0,0 +1,95 @@
+package app
+
+import (
+	"context"
+
+	coreperf "github.com/example/orc/internal/core/perf"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// telemetryRetentionDays is how long recorded invocations are kept.
+const telemetryRetentionDays = 30
+
+// TelemetryServiceImpl implements the TelemetryService interface.
+type TelemetryServiceImpl struct {
+	statRepo secondary.CommandStatRepository
+}
+
+// NewTelemetryService creates a new TelemetryService with injected dependencies.
+func NewTelemetryService(statRepo secondary.CommandStatRepository) *TelemetryServiceImpl {
+	return &TelemetryServiceImpl{statRepo: statRepo}
+}
+
+// RecordCommand stores one invocation and prunes those past retention.
+func (s *TelemetryServiceImpl) RecordCommand(ctx context.Context, req primary.RecordCommandRequest) error {
+	slow := make([]secondary.SlowQueryRecord, len(req.SlowQueries))
+	for i, q := range req.SlowQueries {
+		slow[i] = secondary.SlowQueryRecord{SQL: q.SQL, MS: q.MS}
+	}
+	if err := s.statRepo.Create(ctx, &secondary.CommandStatRecord{
+		Command:     req.Command,
+		DurationMS:  req.DurationMS,
+		QueryCount:  req.QueryCount,
+		QueryMS:     req.QueryMS,
+		SlowQueries: slow,
+		Failed:      req.Failed,
+	}); err != nil {
+		return err
+	}
+	_, err := s.statRepo.PruneOlderThan(ctx, telemetryRetentionDays)
+	return err
+}
+
+// PerfReport summarizes recorded invocations into hotspots.
+func (s *TelemetryServiceImpl) PerfReport(ctx context.Context, req primary.PerfReportRequest) (*primary.PerfReport, error) {
+	records, err := s.statRepo.List(ctx, secondary.CommandStatFilters{Command: req.Command, Since: req.Since})
+	if err != nil {
+		return nil, err
+	}
+
+	invocations := make([]coreperf.Invocation, len(records))
+	for i, r := range records {
+		slowest := make([]coreperf.Query, len(r.SlowQueries))
+		for j, q := range r.SlowQueries {
+			slowest[j] = coreperf.Query{SQL: q.SQL, MS: q.MS}
+		}
+		invocations[i] = coreperf.Invocation{
+			Command:    r.Command,
+			DurationMS: float64(r.DurationMS),
+			Queries:    r.QueryCount,
+			QueryMS:    float64(r.QueryMS),
+			Slowest:    slowest,
+			Failed:     r.Failed,
+		}
+	}
+
+	summary := coreperf.Summarize(invocations, req.Top)
+	report := &primary.PerfReport{Invocations: summary.Invocations}
+	for _, c := range summary.Commands {
+		report.Commands = append(report.Commands, primary.CommandPerf{
+			Command:     c.Command,
+			Runs:        c.Runs,
+			Failures:    c.Failures,
+			TotalMS:     c.TotalMS,
+			P50MS:       c.P50MS,
+			P95MS:       c.P95MS,
+			MaxMS:       c.MaxMS,
+			MeanQueries: c.MeanQueries,
+			DBShare:     c.DBShare,
+		})
+	}
+	for _, q := range summary.Queries {
+		report.Queries = append(report.Queries, primary.QueryPerf{
+			SQL:      q.SQL,
+			Seen:     q.Seen,
+			TotalMS:  q.TotalMS,
+			MaxMS:    q.MaxMS,
+			Commands: q.Commands,
+		})
+	}
+	return report, nil
+}
+
+// Ensure TelemetryServiceImpl implements the interface
+var _ primary.TelemetryService = (*TelemetryServiceImpl)(nil)
@@ -0,0 +1,96 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+type mockCommandStatRepository struct {
+	stats      []*secondary.CommandStatRecord
+	prunedDays int
+}
+
+func (m *mockCommandStatRepository) Create(ctx context.Context, stat *secondary.CommandStatRecord) error {
+	m.stats = append(m.stats, stat)
+	return nil
+}
+
+func (m *mockCommandStatRepository) List(ctx context.Context, filters secondary.CommandStatFilters) ([]*secondary.CommandStatRecord, error) {
+	var result []*secondary.CommandStatRecord
+	for _, s := range m.stats {
+		if filters.Command != "" && s.Command != filters.Command {
+			continue
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+func (m *mockCommandStatRepository) PruneOlderThan(ctx context.Context, days int) (int, error) {
+	m.prunedDays = days
+	return 0, nil
+}
+
+func TestTelemetryService_RecordCommand(t *testing.T) {
+	repo := &mockCommandStatRepository{}
+	service := NewTelemetryService(repo)
+
+	err := service.RecordCommand(context.Background(), primary.RecordCommandRequest{
+		Command: "orc summary", DurationMS: 420, QueryCount: 38, QueryMS: 310,
+		SlowQueries: []primary.SlowQuery{{SQL: "SELECT * FROM tasks", MS: 120}},
+		Failed:      true,
+	})
+	if err != nil {
+		t.Fatalf("RecordCommand failed: %v", err)
+	}
+
+	if len(repo.stats) != 1 {
+		t.Fatalf("expected 1 stat, got %d", len(repo.stats))
+	}
+	got := repo.stats[0]
+	if got.Command != "orc summary" || got.DurationMS != 420 || got.QueryCount != 38 || got.QueryMS != 310 || !got.Failed {
+		t.Errorf("unexpected stat: %+v", got)
+	}
+	if len(got.SlowQueries) != 1 || got.SlowQueries[0].SQL != "SELECT * FROM tasks" || got.SlowQueries[0].MS != 120 {
+		t.Errorf("unexpected slow queries: %+v", got.SlowQueries)
+	}
+	if repo.prunedDays != telemetryRetentionDays {
+		t.Errorf("expected prune at %d days, got %d", telemetryRetentionDays, repo.prunedDays)
+	}
+}
+
+func TestTelemetryService_PerfReport(t *testing.T) {
+	repo := &mockCommandStatRepository{stats: []*secondary.CommandStatRecord{
+		{Command: "orc summary", DurationMS: 400, QueryCount: 40, QueryMS: 300, SlowQueries: []secondary.SlowQueryRecord{{SQL: "SELECT tasks", MS: 120}}},
+		{Command: "orc summary", DurationMS: 600, QueryCount: 44, QueryMS: 500, SlowQueries: []secondary.SlowQueryRecord{{SQL: "SELECT tasks", MS: 200}}},
+		{Command: "orc task list", DurationMS: 50, QueryCount: 3, QueryMS: 10},
+	}}
+	service := NewTelemetryService(repo)
+	ctx := context.Background()
+
+	report, err := service.PerfReport(ctx, primary.PerfReportRequest{Top: 1})
+	if err != nil {
+		t.Fatalf("PerfReport failed: %v", err)
+	}
+	if report.Invocations != 3 || len(report.Commands) != 1 || len(report.Queries) != 1 {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	summary := report.Commands[0]
+	if summary.Command != "orc summary" || summary.Runs != 2 || summary.TotalMS != 1000 || summary.MeanQueries != 42 || summary.DBShare != 0.8 {
+		t.Errorf("unexpected command perf: %+v", summary)
+	}
+	if q := report.Queries[0]; q.SQL != "SELECT tasks" || q.Seen != 2 || q.MaxMS != 200 {
+		t.Errorf("unexpected query perf: %+v", q)
+	}
+
+	report, err = service.PerfReport(ctx, primary.PerfReportRequest{Command: "orc task list"})
+	if err != nil {
+		t.Fatalf("PerfReport failed: %v", err)
+	}
+	if report.Invocations != 1 || report.Commands[0].Command != "orc task list" || len(report.Queries) != 0 {
+		t.Errorf("unexpected filtered report: %+v", report)
+	}
+}
@@ -15,6 +15,28 @@ type mockWorkspaceAdapter struct {
 	worktreeExistsResult bool
 	createWorktreeErr    error
 	removeWorktreeErr    error
+	bootstrapScripts     []string
+	bootstrapOutput      string
+	bootstrapErr         error
+	branchCommits        []secondary.CommitInfo
+	branchCommitsErr     error
+	branchCommitsBaseRef string
+	branchCommitFiles    map[string][]string
+	branchDiff           []secondary.FileChange
+	branchDiffByWorkdir  map[string][]secondary.FileChange // overrides branchDiff per workdir
+	branchPatch          string
+	branchPatchPaths     []string
+	workingChanges       []string
+	stashSHA             string
+	stashErr             error
+	stashMessages        []string
+	appliedStashes       []string // workdir + ":" + sha
+	droppedStashes       []string
+	directories          map[string]bool
+	gitRepos             map[string]bool
+	remoteURLs           map[string]string // repoPath -> origin URL
+	branches             map[string]bool   // repoPath + ":" + branch
+	registeredWorktrees  map[string][]string
 }
 
 func newMockWorkspaceAdapter() *mockWorkspaceAdapter {
@@ -56,7 +78,56 @@ func (m *mockWorkspaceAdapter) RemoveDirectory(ctx context.Context, path string)
 }
 
 func (m *mockWorkspaceAdapter) DirectoryExists(ctx context.Context, path string) (bool, error) {
-	return false, nil
+	return m.directories[path], nil
+}
+
+func (m *mockWorkspaceAdapter) RunBootstrap(ctx context.Context, workdir, script string) (string, error) {
+	m.bootstrapScripts = append(m.bootstrapScripts, script)
+	return m.bootstrapOutput, m.bootstrapErr
+}
+
+func (m *mockWorkspaceAdapter) ListBranchCommits(ctx context.Context, workdir, baseRef string) ([]secondary.CommitInfo, error) {
+	m.branchCommitsBaseRef = baseRef
+	return m.branchCommits, m.branchCommitsErr
+}
+
+func (m *mockWorkspaceAdapter) ListBranchCommitFiles(ctx context.Context, workdir, baseRef string) (map[string][]string, error) {
+	return m.branchCommitFiles, m.branchCommitsErr
+}
+
+func (m *mockWorkspaceAdapter) ListWorkingChanges(ctx context.Context, workdir string) ([]string, error) {
+	return m.workingChanges, nil
+}
+
+func (m *mockWorkspaceAdapter) StashWorkingChanges(ctx context.Context, workdir, message string) (string, error) {
+	m.stashMessages = append(m.stashMessages, message)
+	return m.stashSHA, m.stashErr
+}
+
+func (m *mockWorkspaceAdapter) ApplyStash(ctx context.Context, workdir, sha string) error {
+	if m.stashErr != nil {
+		return m.stashErr
+	}
+	m.appliedStashes = append(m.appliedStashes, workdir+":"+sha)
+	return nil
+}
+
+func (m *mockWorkspaceAdapter) DropStash(ctx context.Context, workdir, sha string) error {
+	m.droppedStashes = append(m.droppedStashes, sha)
+	return nil
+}
+
+func (m *mockWorkspaceAdapter) DiffBranchStat(ctx context.Context, workdir, baseRef string) ([]secondary.FileChange, error) {
+	m.branchCommitsBaseRef = baseRef
+	if diff, ok := m.branchDiffByWorkdir[workdir]; ok {
+		return diff, m.branchCommitsErr
+	}
+	return m.branchDiff, m.branchCommitsErr
+}
+
+func (m *mockWorkspaceAdapter) DiffBranchPatch(ctx context.Context, workdir, baseRef string, paths []string) (string, error) {
+	m.branchPatchPaths = paths
+	return m.branchPatch, m.branchCommitsErr
 }
 
 func (m *mockWorkspaceAdapter) GetWorktreesBasePath() string {
@@ -70,3 +141,19 @@ func (m *mockWorkspaceAdapter) GetRepoPath(repoName string) string {
 func (m *mockWorkspaceAdapter) ResolveWorkbenchPath(workbenchName string) string {
 	return "/tmp/worktrees/" + workbenchName
 }
+
+func (m *mockWorkspaceAdapter) IsGitRepository(ctx context.Context, path string) (bool, error) {
+	return m.gitRepos[path], nil
+}
+
+func (m *mockWorkspaceAdapter) GetRemoteURL(ctx context.Context, repoPath, remote string) (string, error) {
+	return m.remoteURLs[repoPath], nil
+}
+
+func (m *mockWorkspaceAdapter) BranchExists(ctx context.Context, repoPath, branch string) (bool, error) {
+	return m.branches[repoPath+":"+branch], nil
+}
+
+func (m *mockWorkspaceAdapter) ListWorktrees(ctx context.Context, repoPath string) ([]string, error) {
+	return m.registeredWorktrees[repoPath], nil
+}
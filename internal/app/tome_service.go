@@ -3,7 +3,10 @@ package app
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	coretome "github.com/example/orc/internal/core/tome"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
 )
@@ -141,6 +144,15 @@ func (s *TomeServiceImpl) AssignTomeToWorkbench(ctx context.Context, tomeID, wor
 		return err
 	}
 
+	// Workbenches may live in the infra database, out of reach of foreign keys
+	exists, err := s.tomeRepo.WorkbenchExists(ctx, workbenchID)
+	if err != nil {
+		return fmt.Errorf("failed to validate workbench: %w", err)
+	}
+	if !exists {
+		return fmt.Errorf("workbench %s not found", workbenchID)
+	}
+
 	return s.tomeRepo.AssignWorkbench(ctx, tomeID, workbenchID)
 }
 
@@ -164,6 +176,85 @@ func (s *TomeServiceImpl) GetTomeNotes(ctx context.Context, tomeID string) ([]*p
 	return s.noteService.GetNotesByContainer(ctx, "tome", tomeID)
 }
 
+// SetTomeCharter replaces a tome's charter document.
+func (s *TomeServiceImpl) SetTomeCharter(ctx context.Context, tomeID, charter string) error {
+	if _, err := s.tomeRepo.GetByID(ctx, tomeID); err != nil {
+		return err
+	}
+	return s.tomeRepo.UpdateCharter(ctx, tomeID, strings.TrimSpace(charter))
+}
+
+// ExportTome renders a tome and its notes as a document in the requested format.
+func (s *TomeServiceImpl) ExportTome(ctx context.Context, req primary.ExportTomeRequest) (string, error) {
+	if !coretome.IsValidExportFormat(req.Format) {
+		return "", fmt.Errorf("unsupported export format %q (supported: %s)", req.Format, strings.Join(coretome.ExportFormats, ", "))
+	}
+
+	record, err := s.tomeRepo.GetByID(ctx, req.TomeID)
+	if err != nil {
+		return "", err
+	}
+	notes, err := s.noteService.GetNotesByContainer(ctx, "tome", req.TomeID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tome notes: %w", err)
+	}
+
+	book := coretome.Book{
+		TomeID:       record.ID,
+		CommissionID: record.CommissionID,
+		Title:        record.Title,
+		Description:  record.Description,
+		Charter:      record.Charter,
+		ExportedAt:   time.Now().UTC().Format(time.RFC3339),
+	}
+	for _, n := range notes {
+		if n.ContentExternal {
+			full, err := s.noteService.GetNote(ctx, n.ID)
+			if err != nil {
+				return "", fmt.Errorf("failed to load %s: %w", n.ID, err)
+			}
+			n = full
+		}
+		book.Chapters = append(book.Chapters, coretome.Chapter{
+			NoteID:    n.ID,
+			Title:     n.Title,
+			Type:      n.Type,
+			Status:    n.Status,
+			Content:   n.Content,
+			Pinned:    n.Pinned,
+			Position:  n.Position,
+			CreatedAt: n.CreatedAt,
+			UpdatedAt: n.UpdatedAt,
+			ClosedAt:  n.ClosedAt,
+		})
+	}
+	coretome.OrderChapters(book.Chapters)
+
+	return coretome.RenderMarkdownBook(book), nil
+}
+
+// ReorderTomeNote moves a note within its tome's reading order. The whole
+// order is rewritten, so notes never placed before become ordered too.
+func (s *TomeServiceImpl) ReorderTomeNote(ctx context.Context, req primary.ReorderTomeNoteRequest) error {
+	if _, err := s.tomeRepo.GetByID(ctx, req.TomeID); err != nil {
+		return err
+	}
+	notes, err := s.noteService.GetNotesByContainer(ctx, "tome", req.TomeID)
+	if err != nil {
+		return fmt.Errorf("failed to get tome notes: %w", err)
+	}
+
+	order := make([]string, len(notes))
+	for i, n := range notes {
+		order[i] = n.ID
+	}
+	order, err = coretome.Reorder(order, req.NoteID, req.AfterID, req.BeforeID)
+	if err != nil {
+		return err
+	}
+	return s.tomeRepo.SetNoteOrder(ctx, req.TomeID, order)
+}
+
 // Helper methods
 
 func (s *TomeServiceImpl) recordToTome(r *secondary.TomeRecord) *primary.Tome {
@@ -175,6 +266,7 @@ func (s *TomeServiceImpl) recordToTome(r *secondary.TomeRecord) *primary.Tome {
 		Status:              r.Status,
 		AssignedWorkbenchID: r.AssignedWorkbenchID,
 		Pinned:              r.Pinned,
+		Charter:             r.Charter,
 		CreatedAt:           r.CreatedAt,
 		UpdatedAt:           r.UpdatedAt,
 		ClosedAt:            r.ClosedAt,
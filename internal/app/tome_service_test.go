@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/example/orc/internal/ports/primary"
@@ -25,6 +26,8 @@ type mockTomeRepository struct {
 	assignWorkbenchErr     error
 	commissionExistsResult bool
 	commissionExistsErr    error
+	missingWorkbenches     map[string]bool
+	noteOrder              map[string][]string // tomeID -> SetNoteOrder argument
 }
 
 func newMockTomeRepository() *mockTomeRepository {
@@ -150,9 +153,35 @@ func (m *mockTomeRepository) CommissionExists(ctx context.Context, commissionID
 	return m.commissionExistsResult, nil
 }
 
+func (m *mockTomeRepository) WorkbenchExists(ctx context.Context, workbenchID string) (bool, error) {
+	return !m.missingWorkbenches[workbenchID], nil
+}
+
+func (m *mockTomeRepository) UpdateCharter(ctx context.Context, id, charter string) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	if tome, ok := m.tomes[id]; ok {
+		tome.Charter = charter
+	}
+	return nil
+}
+
+func (m *mockTomeRepository) SetNoteOrder(ctx context.Context, tomeID string, noteIDs []string) error {
+	if m.updateErr != nil {
+		return m.updateErr
+	}
+	if m.noteOrder == nil {
+		m.noteOrder = make(map[string][]string)
+	}
+	m.noteOrder[tomeID] = noteIDs
+	return nil
+}
+
 // mockNoteServiceForTome implements minimal NoteService for tome tests.
 type mockNoteServiceForTome struct {
 	notes map[string][]*primary.Note // containerID -> notes
+	full  map[string]*primary.Note   // noteID -> note with its body, for GetNote
 }
 
 func newMockNoteServiceForTome() *mockNoteServiceForTome {
@@ -166,7 +195,7 @@ func (m *mockNoteServiceForTome) CreateNote(ctx context.Context, req primary.Cre
 }
 
 func (m *mockNoteServiceForTome) GetNote(ctx context.Context, noteID string) (*primary.Note, error) {
-	return nil, nil
+	return m.full[noteID], nil
 }
 
 func (m *mockNoteServiceForTome) ListNotes(ctx context.Context, filters primary.NoteFilters) ([]*primary.Note, error) {
@@ -216,6 +245,34 @@ func (m *mockNoteServiceForTome) SetNoteInFlight(ctx context.Context, noteID str
 	return nil
 }
 
+func (m *mockNoteServiceForTome) TriageNote(ctx context.Context, req primary.TriageNoteRequest) error {
+	return nil
+}
+
+func (m *mockNoteServiceForTome) ListTriageQueue(ctx context.Context, filters primary.TriageQueueFilters) ([]*primary.Note, error) {
+	return nil, nil
+}
+
+func (m *mockNoteServiceForTome) DraftHandoff(ctx context.Context, req primary.DraftHandoffRequest) (*primary.Note, error) {
+	return nil, nil
+}
+
+func (m *mockNoteServiceForTome) ConfirmHandoff(ctx context.Context, noteID string) error {
+	return nil
+}
+
+func (m *mockNoteServiceForTome) ExternalizeNoteContent(ctx context.Context, minBytes int, dryRun bool) ([]*primary.ExternalizedNote, error) {
+	return nil, nil
+}
+
+func (m *mockNoteServiceForTome) ProposeAnswers(ctx context.Context, reportID string) ([]*primary.ProposedAnswer, error) {
+	return nil, nil
+}
+
+func (m *mockNoteServiceForTome) AnswerQuestion(ctx context.Context, req primary.AnswerQuestionRequest) error {
+	return nil
+}
+
 // ============================================================================
 // Test Helper
 // ============================================================================
@@ -563,6 +620,25 @@ func TestAssignTomeToWorkbench_TomeNotFound(t *testing.T) {
 	}
 }
 
+func TestAssignTomeToWorkbench_WorkbenchNotFound(t *testing.T) {
+	service, tomeRepo, _ := newTestTomeService()
+	ctx := context.Background()
+
+	tomeRepo.tomes["TOME-001"] = &secondary.TomeRecord{
+		ID:           "TOME-001",
+		CommissionID: "COMM-001",
+		Title:        "Test Tome",
+		Status:       "open",
+	}
+	tomeRepo.missingWorkbenches = map[string]bool{"BENCH-999": true}
+
+	err := service.AssignTomeToWorkbench(ctx, "TOME-001", "BENCH-999")
+
+	if err == nil {
+		t.Fatal("expected error for non-existent workbench, got nil")
+	}
+}
+
 // ============================================================================
 // GetTomesByWorkbench Tests
 // ============================================================================
@@ -617,3 +693,158 @@ func TestGetTomeNotes_Success(t *testing.T) {
 		t.Errorf("expected 2 notes, got %d", len(notes))
 	}
 }
+
+// ============================================================================
+// ExportTome Tests
+// ============================================================================
+
+func TestExportTome_MarkdownBook(t *testing.T) {
+	service, tomeRepo, noteService := newTestTomeService()
+	ctx := context.Background()
+
+	tomeRepo.tomes["TOME-001"] = &secondary.TomeRecord{
+		ID:           "TOME-001",
+		CommissionID: "COMM-001",
+		Title:        "Auth Research",
+		Status:       "open",
+	}
+	noteService.notes["TOME-001"] = []*primary.Note{
+		{ID: "NOTE-002", Title: "Later", CreatedAt: "2026-10-02T00:00:00Z"},
+		{ID: "NOTE-001", Title: "Earlier", CreatedAt: "2026-10-01T00:00:00Z"},
+	}
+
+	doc, err := service.ExportTome(ctx, primary.ExportTomeRequest{TomeID: "TOME-001", Format: "markdown-book"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !strings.Contains(doc, "# Auth Research") {
+		t.Errorf("expected tome title heading, got:\n%s", doc)
+	}
+	earlier := strings.Index(doc, "## 1. Earlier")
+	later := strings.Index(doc, "## 2. Later")
+	if earlier < 0 || later < earlier {
+		t.Errorf("expected notes ordered oldest first, got:\n%s", doc)
+	}
+}
+
+func TestExportTome_LoadsExternalContent(t *testing.T) {
+	service, tomeRepo, noteService := newTestTomeService()
+	ctx := context.Background()
+
+	tomeRepo.tomes["TOME-001"] = &secondary.TomeRecord{ID: "TOME-001", Title: "Auth Research"}
+	noteService.notes["TOME-001"] = []*primary.Note{
+		{ID: "NOTE-001", Title: "Report", ContentExternal: true},
+	}
+	noteService.full = map[string]*primary.Note{
+		"NOTE-001": {ID: "NOTE-001", Title: "Report", Content: "The long findings"},
+	}
+
+	doc, err := service.ExportTome(ctx, primary.ExportTomeRequest{TomeID: "TOME-001", Format: "markdown-book"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !strings.Contains(doc, "The long findings") {
+		t.Errorf("expected the externalized body in the export, got:\n%s", doc)
+	}
+}
+
+func TestExportTome_UnsupportedFormat(t *testing.T) {
+	service, tomeRepo, _ := newTestTomeService()
+	ctx := context.Background()
+
+	tomeRepo.tomes["TOME-001"] = &secondary.TomeRecord{ID: "TOME-001", Title: "Auth Research"}
+
+	_, err := service.ExportTome(ctx, primary.ExportTomeRequest{TomeID: "TOME-001", Format: "pdf"})
+	if err == nil {
+		t.Fatal("expected error for unsupported format")
+	}
+}
+
+func TestExportTome_NotFound(t *testing.T) {
+	service, _, _ := newTestTomeService()
+	ctx := context.Background()
+
+	_, err := service.ExportTome(ctx, primary.ExportTomeRequest{TomeID: "TOME-999", Format: "markdown-book"})
+	if err == nil {
+		t.Fatal("expected error for missing tome")
+	}
+}
+
+// ============================================================================
+// SetTomeCharter Tests
+// ============================================================================
+
+func TestSetTomeCharter_Success(t *testing.T) {
+	service, tomeRepo, _ := newTestTomeService()
+	ctx := context.Background()
+
+	tomeRepo.tomes["TOME-001"] = &secondary.TomeRecord{
+		ID:           "TOME-001",
+		CommissionID: "COMM-001",
+		Title:        "Test Tome",
+		Status:       "open",
+	}
+
+	err := service.SetTomeCharter(ctx, "TOME-001", "Why this tome exists\n")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := tomeRepo.tomes["TOME-001"].Charter; got != "Why this tome exists" {
+		t.Errorf("expected trimmed charter, got %q", got)
+	}
+}
+
+func TestSetTomeCharter_NotFound(t *testing.T) {
+	service, _, _ := newTestTomeService()
+	ctx := context.Background()
+
+	err := service.SetTomeCharter(ctx, "TOME-999", "charter")
+
+	if err == nil {
+		t.Fatal("expected error for non-existent tome, got nil")
+	}
+}
+
+// ============================================================================
+// ReorderTomeNote Tests
+// ============================================================================
+
+func TestReorderTomeNote_After(t *testing.T) {
+	service, tomeRepo, noteService := newTestTomeService()
+	ctx := context.Background()
+
+	tomeRepo.tomes["TOME-001"] = &secondary.TomeRecord{ID: "TOME-001", CommissionID: "COMM-001", Status: "open"}
+	noteService.notes["TOME-001"] = []*primary.Note{
+		{ID: "NOTE-003", Position: 1},
+		{ID: "NOTE-007", Position: 2},
+		{ID: "NOTE-009"},
+	}
+
+	err := service.ReorderTomeNote(ctx, primary.ReorderTomeNoteRequest{TomeID: "TOME-001", NoteID: "NOTE-003", AfterID: "NOTE-007"})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := strings.Join(tomeRepo.noteOrder["TOME-001"], ","); got != "NOTE-007,NOTE-003,NOTE-009" {
+		t.Errorf("expected NOTE-007,NOTE-003,NOTE-009, got %s", got)
+	}
+}
+
+func TestReorderTomeNote_NoteNotInTome(t *testing.T) {
+	service, tomeRepo, noteService := newTestTomeService()
+	ctx := context.Background()
+
+	tomeRepo.tomes["TOME-001"] = &secondary.TomeRecord{ID: "TOME-001", CommissionID: "COMM-001", Status: "open"}
+	noteService.notes["TOME-001"] = []*primary.Note{{ID: "NOTE-001"}}
+
+	err := service.ReorderTomeNote(ctx, primary.ReorderTomeNoteRequest{TomeID: "TOME-001", NoteID: "NOTE-002"})
+
+	if err == nil || !strings.Contains(err.Error(), "not in this tome") {
+		t.Fatalf("expected not-in-tome error, got %v", err)
+	}
+	if tomeRepo.noteOrder != nil {
+		t.Error("expected no order to be written")
+	}
+}
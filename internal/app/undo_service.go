@@ -0,0 +1,231 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/example/orc/internal/core/task"
+	"github.com/example/orc/internal/core/undo"
+	"github.com/example/orc/internal/ctxutil"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// UndoServiceImpl implements the UndoService interface.
+type UndoServiceImpl struct {
+	logRepo      secondary.WorkshopLogRepository
+	taskRepo     secondary.TaskRepository
+	shipmentRepo secondary.ShipmentRepository
+	noteRepo     secondary.NoteRepository
+}
+
+// NewUndoService creates a new UndoService with injected dependencies.
+func NewUndoService(
+	logRepo secondary.WorkshopLogRepository,
+	taskRepo secondary.TaskRepository,
+	shipmentRepo secondary.ShipmentRepository,
+	noteRepo secondary.NoteRepository,
+) *UndoServiceImpl {
+	return &UndoServiceImpl{
+		logRepo:      logRepo,
+		taskRepo:     taskRepo,
+		shipmentRepo: shipmentRepo,
+		noteRepo:     noteRepo,
+	}
+}
+
+// ListUndoable returns the current actor's most recent undoable actions, newest first.
+func (s *UndoServiceImpl) ListUndoable(ctx context.Context, limit int) ([]*primary.UndoableAction, error) {
+	actorID := ctxutil.ActorFromContext(ctx)
+	if actorID == "" {
+		return nil, fmt.Errorf("no actor context: undo is only available from a workbench")
+	}
+
+	records, err := s.logRepo.ListUndoable(ctx, actorID, undo.UndoableFields(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list undoable actions: %w", err)
+	}
+
+	actions := make([]*primary.UndoableAction, len(records))
+	for i, r := range records {
+		action := recordToUndoableAction(r)
+		if result := s.evaluate(ctx, r, actorID, false); !result.Allowed {
+			action.Safe = false
+			action.Reason = result.Reason
+		}
+		actions[i] = action
+	}
+	return actions, nil
+}
+
+// Undo reverts a logged action by the current actor.
+func (s *UndoServiceImpl) Undo(ctx context.Context, logID string) (*primary.UndoableAction, error) {
+	actorID := ctxutil.ActorFromContext(ctx)
+	if actorID == "" {
+		return nil, fmt.Errorf("no actor context: undo is only available from a workbench")
+	}
+
+	// 1. Resolve target entry
+	var record *secondary.WorkshopLogRecord
+	alreadyUndone := false
+	if logID == "" {
+		records, err := s.logRepo.ListUndoable(ctx, actorID, undo.UndoableFields(), 1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find last action: %w", err)
+		}
+		if len(records) == 0 {
+			return nil, fmt.Errorf("nothing to undo")
+		}
+		record = records[0]
+	} else {
+		var err error
+		record, err = s.logRepo.GetByID(ctx, logID)
+		if err != nil {
+			return nil, err
+		}
+		alreadyUndone, err = s.logRepo.IsUndone(ctx, logID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 2. Guard check
+	if result := s.evaluate(ctx, record, actorID, alreadyUndone); !result.Allowed {
+		return nil, result.Error()
+	}
+
+	// 3. Apply inverse; the resulting log entry is marked as the undo of this one
+	undoCtx := ctxutil.WithUndoOf(ctx, record.ID)
+	if err := s.applyInverse(undoCtx, record); err != nil {
+		return nil, fmt.Errorf("failed to undo %s: %w", record.ID, err)
+	}
+
+	return recordToUndoableAction(record), nil
+}
+
+// evaluate runs the undo guard for a log entry against the entity's current state.
+func (s *UndoServiceImpl) evaluate(ctx context.Context, r *secondary.WorkshopLogRecord, actorID string, alreadyUndone bool) undo.GuardResult {
+	guardCtx := undo.UndoContext{
+		LogID:          r.ID,
+		EntityType:     r.EntityType,
+		EntityID:       r.EntityID,
+		FieldName:      r.FieldName,
+		LoggedActorID:  r.ActorID,
+		ActorID:        actorID,
+		AlreadyUndone:  alreadyUndone || r.UndoOf != "",
+		LoggedNewValue: r.NewValue,
+	}
+	if r.Action != "update" {
+		guardCtx.FieldName = r.Action
+	}
+
+	current, err := s.currentValue(ctx, r)
+	guardCtx.EntityExists = err == nil
+	guardCtx.CurrentValue = current
+
+	return undo.CanUndo(guardCtx)
+}
+
+// currentValue reads the present value of the field an entry changed.
+func (s *UndoServiceImpl) currentValue(ctx context.Context, r *secondary.WorkshopLogRecord) (string, error) {
+	switch undo.FieldKey(r.EntityType, r.FieldName) {
+	case "task.status":
+		task, err := s.taskRepo.GetByID(ctx, r.EntityID)
+		if err != nil {
+			return "", err
+		}
+		return task.Status, nil
+	case "task.tag":
+		if _, err := s.taskRepo.GetByID(ctx, r.EntityID); err != nil {
+			return "", err
+		}
+		tag, err := s.taskRepo.GetTag(ctx, r.EntityID)
+		if err != nil || tag == nil {
+			return "", err
+		}
+		return tag.ID, nil
+	case "shipment.status":
+		shipment, err := s.shipmentRepo.GetByID(ctx, r.EntityID)
+		if err != nil {
+			return "", err
+		}
+		return shipment.Status, nil
+	case "shipment.assigned_workbench_id":
+		shipment, err := s.shipmentRepo.GetByID(ctx, r.EntityID)
+		if err != nil {
+			return "", err
+		}
+		return shipment.AssignedWorkbenchID, nil
+	case "note.status":
+		note, err := s.noteRepo.GetByID(ctx, r.EntityID)
+		if err != nil {
+			return "", err
+		}
+		return note.Status, nil
+	default:
+		return "", nil
+	}
+}
+
+// applyInverse writes the logged old value back.
+func (s *UndoServiceImpl) applyInverse(ctx context.Context, r *secondary.WorkshopLogRecord) error {
+	switch undo.FieldKey(r.EntityType, r.FieldName) {
+	case "task.status":
+		return s.revertTaskStatus(ctx, r.EntityID, r.OldValue)
+	case "task.tag":
+		if r.OldValue == "" {
+			return s.taskRepo.RemoveTag(ctx, r.EntityID)
+		}
+		return s.taskRepo.AddTag(ctx, r.EntityID, r.OldValue)
+	case "shipment.status":
+		return s.shipmentRepo.UpdateStatus(ctx, r.EntityID, r.OldValue, false)
+	case "shipment.assigned_workbench_id":
+		return s.shipmentRepo.AssignWorkbench(ctx, r.EntityID, r.OldValue)
+	case "note.status":
+		return s.noteRepo.UpdateStatus(ctx, r.EntityID, r.OldValue)
+	default:
+		return fmt.Errorf("no inverse for %s", undo.FieldKey(r.EntityType, r.FieldName))
+	}
+}
+
+// revertTaskStatus moves a task back to a logged status through the task
+// state machine: a move it does not allow (e.g. reopening a closed task) is
+// marked as forced, and a task can't return to work while its plan is
+// conditionally approved. Timestamps the undone change set are cleared:
+// completed_at when leaving closed, and the claim when back to open.
+func (s *UndoServiceImpl) revertTaskStatus(ctx context.Context, taskID, status string) error {
+	record, err := s.taskRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+	ctx, err = checkTaskTransition(ctx, record, status, true)
+	if err != nil {
+		return err
+	}
+	if status == "in-progress" {
+		planIDs, err := s.taskRepo.ListConditionalPlanIDs(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		if err := task.CanStartTask(task.StartTaskContext{TaskID: taskID, ConditionalPlanIDs: planIDs}).Error(); err != nil {
+			return err
+		}
+	}
+	return s.taskRepo.RevertStatus(ctx, taskID, status, status == "open", status != "closed")
+}
+
+func recordToUndoableAction(r *secondary.WorkshopLogRecord) *primary.UndoableAction {
+	return &primary.UndoableAction{
+		LogID:      r.ID,
+		Timestamp:  r.Timestamp,
+		EntityType: r.EntityType,
+		EntityID:   r.EntityID,
+		FieldName:  r.FieldName,
+		OldValue:   r.OldValue,
+		NewValue:   r.NewValue,
+		Safe:       true,
+	}
+}
+
+// Ensure UndoServiceImpl implements the interface
+var _ primary.UndoService = (*UndoServiceImpl)(nil)
@@ -0,0 +1,233 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ctxutil"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+func newTestUndoService() (*UndoServiceImpl, *mockWorkshopLogRepository, *mockTaskRepository, *mockShipmentRepository, *mockNoteRepository) {
+	logRepo := newMockWorkshopLogRepository()
+	taskRepo := newMockTaskRepository()
+	shipmentRepo := newMockShipmentRepository()
+	noteRepo := newMockNoteRepository()
+	service := NewUndoService(logRepo, taskRepo, shipmentRepo, noteRepo)
+	return service, logRepo, taskRepo, shipmentRepo, noteRepo
+}
+
+func addUpdateLog(repo *mockWorkshopLogRepository, id, actor, entityType, entityID, field, oldValue, newValue string) {
+	repo.logs[id] = &secondary.WorkshopLogRecord{
+		ID:         id,
+		Timestamp:  "2026-01-20T10:00:00Z",
+		ActorID:    actor,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     "update",
+		FieldName:  field,
+		OldValue:   oldValue,
+		NewValue:   newValue,
+	}
+}
+
+func TestUndoService_Undo_MostRecentTaskStatus(t *testing.T) {
+	service, logRepo, taskRepo, _, _ := newTestUndoService()
+	ctx := ctxutil.WithActorID(context.Background(), "BENCH-001")
+
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", Status: "closed"}
+	addUpdateLog(logRepo, "WL-0001", "BENCH-001", "task", "TASK-001", "status", "open", "in-progress")
+	addUpdateLog(logRepo, "WL-0002", "BENCH-001", "task", "TASK-001", "status", "in-progress", "closed")
+
+	action, err := service.Undo(ctx, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if action.LogID != "WL-0002" {
+		t.Errorf("expected to undo WL-0002, got %s", action.LogID)
+	}
+	if taskRepo.tasks["TASK-001"].Status != "in-progress" {
+		t.Errorf("expected status 'in-progress', got %q", taskRepo.tasks["TASK-001"].Status)
+	}
+}
+
+func TestUndoService_Undo_TaskCompleteClearsCompletedAt(t *testing.T) {
+	service, logRepo, taskRepo, _, _ := newTestUndoService()
+	ctx := ctxutil.WithActorID(context.Background(), "BENCH-001")
+
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", Status: "in-progress", ClaimedAt: "2026-01-20T09:00:00Z"}
+	if err := NewTaskService(taskRepo, nil, nil, nil).CompleteTask(ctx, "TASK-001"); err != nil {
+		t.Fatalf("CompleteTask() error = %v", err)
+	}
+	if taskRepo.tasks["TASK-001"].CompletedAt == "" {
+		t.Fatal("expected completed_at set by CompleteTask")
+	}
+	addUpdateLog(logRepo, "WL-0001", "BENCH-001", "task", "TASK-001", "status", "in-progress", "closed")
+
+	if _, err := service.Undo(ctx, "WL-0001"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	task := taskRepo.tasks["TASK-001"]
+	if task.Status != "in-progress" || task.CompletedAt != "" {
+		t.Errorf("after undo: status %q, completed_at %q; want in-progress and cleared", task.Status, task.CompletedAt)
+	}
+	if task.ClaimedAt != "2026-01-20T09:00:00Z" {
+		t.Errorf("claimed_at = %q, want the claim kept", task.ClaimedAt)
+	}
+}
+
+func TestUndoService_Undo_TaskStatusRespectsConditionalPlan(t *testing.T) {
+	service, logRepo, taskRepo, _, _ := newTestUndoService()
+	ctx := ctxutil.WithActorID(context.Background(), "BENCH-001")
+
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", Status: "open", ClaimedAt: "2026-01-20T09:00:00Z"}
+	taskRepo.conditionalPlans = map[string][]string{"TASK-001": {"PLAN-001"}}
+	addUpdateLog(logRepo, "WL-0001", "BENCH-001", "task", "TASK-001", "status", "in-progress", "open")
+
+	if _, err := service.Undo(ctx, "WL-0001"); err == nil {
+		t.Fatal("expected undo back to in-progress to be refused while the plan is conditional")
+	}
+	if taskRepo.tasks["TASK-001"].Status != "open" {
+		t.Errorf("status = %q, want unchanged open", taskRepo.tasks["TASK-001"].Status)
+	}
+}
+
+func TestUndoService_Undo_ShipmentAssignment(t *testing.T) {
+	service, logRepo, _, shipmentRepo, _ := newTestUndoService()
+	ctx := ctxutil.WithActorID(context.Background(), "BENCH-001")
+
+	shipmentRepo.shipments["SHIP-001"] = &secondary.ShipmentRecord{ID: "SHIP-001", Status: "ready", AssignedWorkbenchID: "BENCH-002"}
+	addUpdateLog(logRepo, "WL-0001", "BENCH-001", "shipment", "SHIP-001", "assigned_workbench_id", "", "BENCH-002")
+
+	if _, err := service.Undo(ctx, "WL-0001"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if shipmentRepo.shipments["SHIP-001"].AssignedWorkbenchID != "" {
+		t.Errorf("expected assignment cleared, got %q", shipmentRepo.shipments["SHIP-001"].AssignedWorkbenchID)
+	}
+}
+
+func TestUndoService_Undo_NoteReopen(t *testing.T) {
+	service, logRepo, _, _, noteRepo := newTestUndoService()
+	ctx := ctxutil.WithActorID(context.Background(), "BENCH-001")
+
+	noteRepo.notes["NOTE-001"] = &secondary.NoteRecord{ID: "NOTE-001", Status: "closed"}
+	addUpdateLog(logRepo, "WL-0001", "BENCH-001", "note", "NOTE-001", "status", "open", "closed")
+
+	if _, err := service.Undo(ctx, ""); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if noteRepo.notes["NOTE-001"].Status != "open" {
+		t.Errorf("expected status 'open', got %q", noteRepo.notes["NOTE-001"].Status)
+	}
+}
+
+func TestUndoService_Undo_Blocked(t *testing.T) {
+	tests := []struct {
+		name      string
+		actor     string
+		logID     string
+		setup     func(*mockWorkshopLogRepository, *mockTaskRepository)
+		wantError string
+	}{
+		{
+			name:      "no actor context",
+			actor:     "",
+			wantError: "no actor context",
+		},
+		{
+			name:      "nothing to undo",
+			actor:     "BENCH-001",
+			wantError: "nothing to undo",
+		},
+		{
+			name:  "value changed since",
+			actor: "BENCH-001",
+			setup: func(l *mockWorkshopLogRepository, tr *mockTaskRepository) {
+				tr.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", Status: "blocked"}
+				addUpdateLog(l, "WL-0001", "BENCH-001", "task", "TASK-001", "status", "open", "in-progress")
+			},
+			wantError: "changed since",
+		},
+		{
+			name:  "other actor's change",
+			actor: "BENCH-001",
+			logID: "WL-0001",
+			setup: func(l *mockWorkshopLogRepository, tr *mockTaskRepository) {
+				tr.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", Status: "in-progress"}
+				addUpdateLog(l, "WL-0001", "BENCH-002", "task", "TASK-001", "status", "open", "in-progress")
+			},
+			wantError: "not by the current actor",
+		},
+		{
+			name:  "already undone",
+			actor: "BENCH-001",
+			logID: "WL-0001",
+			setup: func(l *mockWorkshopLogRepository, tr *mockTaskRepository) {
+				tr.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", Status: "in-progress"}
+				addUpdateLog(l, "WL-0001", "BENCH-001", "task", "TASK-001", "status", "open", "in-progress")
+				addUpdateLog(l, "WL-0002", "BENCH-001", "task", "TASK-001", "status", "in-progress", "open")
+				l.logs["WL-0002"].UndoOf = "WL-0001"
+			},
+			wantError: "already undone",
+		},
+		{
+			name:  "entity deleted",
+			actor: "BENCH-001",
+			setup: func(l *mockWorkshopLogRepository, tr *mockTaskRepository) {
+				addUpdateLog(l, "WL-0001", "BENCH-001", "task", "TASK-404", "status", "open", "in-progress")
+			},
+			wantError: "no longer exists",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, logRepo, taskRepo, _, _ := newTestUndoService()
+			if tt.setup != nil {
+				tt.setup(logRepo, taskRepo)
+			}
+			ctx := context.Background()
+			if tt.actor != "" {
+				ctx = ctxutil.WithActorID(ctx, tt.actor)
+			}
+
+			_, err := service.Undo(ctx, tt.logID)
+			if err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.wantError) {
+				t.Errorf("expected error containing %q, got %q", tt.wantError, err.Error())
+			}
+		})
+	}
+}
+
+func TestUndoService_ListUndoable(t *testing.T) {
+	service, logRepo, taskRepo, _, _ := newTestUndoService()
+	ctx := ctxutil.WithActorID(context.Background(), "BENCH-001")
+
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", Status: "closed"}
+	addUpdateLog(logRepo, "WL-0001", "BENCH-001", "task", "TASK-001", "status", "open", "in-progress")
+	addUpdateLog(logRepo, "WL-0002", "BENCH-001", "task", "TASK-001", "status", "in-progress", "closed")
+	addUpdateLog(logRepo, "WL-0003", "BENCH-001", "task", "TASK-001", "title", "Old", "New")
+	addUpdateLog(logRepo, "WL-0004", "BENCH-002", "task", "TASK-001", "status", "closed", "closed")
+
+	actions, err := service.ListUndoable(ctx, 10)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(actions) != 2 {
+		t.Fatalf("expected 2 undoable actions, got %d", len(actions))
+	}
+	if actions[0].LogID != "WL-0002" || !actions[0].Safe {
+		t.Errorf("expected WL-0002 first and safe, got %s (safe=%v)", actions[0].LogID, actions[0].Safe)
+	}
+	if actions[1].LogID != "WL-0001" || actions[1].Safe {
+		t.Errorf("expected WL-0001 second and unsafe, got %s (safe=%v)", actions[1].LogID, actions[1].Safe)
+	}
+	if actions[1].Reason == "" {
+		t.Error("expected reason for unsafe action")
+	}
+}
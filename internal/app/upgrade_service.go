@@ -0,0 +1,76 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	coreupgrade "github.com/example/orc/internal/core/upgrade"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// UpgradeServiceImpl implements the UpgradeService interface.
+type UpgradeServiceImpl struct {
+	releaseSource  secondary.ReleaseSource
+	currentCommit  string
+	executablePath string
+}
+
+// NewUpgradeService creates a new UpgradeService for the binary at
+// executablePath, built from currentCommit.
+func NewUpgradeService(releaseSource secondary.ReleaseSource, currentCommit, executablePath string) *UpgradeServiceImpl {
+	return &UpgradeServiceImpl{
+		releaseSource:  releaseSource,
+		currentCommit:  currentCommit,
+		executablePath: executablePath,
+	}
+}
+
+// CheckUpgrade fetches the latest release and compares it with the running binary.
+func (s *UpgradeServiceImpl) CheckUpgrade(ctx context.Context) (*primary.UpgradeStatus, error) {
+	release, err := s.releaseSource.Latest(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for a newer release: %w", err)
+	}
+
+	return &primary.UpgradeStatus{
+		CurrentCommit:     s.currentCommit,
+		LatestCommit:      release.Commit,
+		LatestSubject:     release.Subject,
+		LatestCommittedAt: release.CommittedAt,
+		UpToDate:          coreupgrade.SameCommit(s.currentCommit, release.Commit),
+	}, nil
+}
+
+// Upgrade installs the latest release over the running binary.
+func (s *UpgradeServiceImpl) Upgrade(ctx context.Context, force bool) (*primary.UpgradeStatus, error) {
+	status, err := s.CheckUpgrade(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if status.UpToDate {
+		return status, nil
+	}
+
+	guardCtx := coreupgrade.InstallContext{
+		CurrentCommit: s.currentCommit,
+		LatestCommit:  status.LatestCommit,
+		Force:         force,
+	}
+	if s.currentCommit != "" && s.currentCommit != coreupgrade.UnknownCommit {
+		// An unknown commit (e.g. built from an unpushed branch) counts as not behind
+		guardCtx.IsAncestor, _ = s.releaseSource.Contains(ctx, status.LatestCommit, s.currentCommit)
+	}
+	if err := coreupgrade.CanInstall(guardCtx).Error(); err != nil {
+		return nil, err
+	}
+
+	if err := s.releaseSource.Install(ctx, status.LatestCommit, s.executablePath); err != nil {
+		return nil, fmt.Errorf("failed to install release: %w", err)
+	}
+	status.Installed = true
+	return status, nil
+}
+
+// Ensure UpgradeServiceImpl implements the interface
+var _ primary.UpgradeService = (*UpgradeServiceImpl)(nil)
@@ -0,0 +1,104 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// mockReleaseSource implements secondary.ReleaseSource for testing.
+type mockReleaseSource struct {
+	latest     *secondary.Release
+	latestErr  error
+	ancestors  map[string]bool // commits contained in the latest release
+	installed  string
+	installErr error
+}
+
+func (m *mockReleaseSource) Latest(ctx context.Context) (*secondary.Release, error) {
+	return m.latest, m.latestErr
+}
+
+func (m *mockReleaseSource) Contains(ctx context.Context, releaseCommit, commit string) (bool, error) {
+	contained, known := m.ancestors[commit]
+	if !known {
+		return false, errors.New("unknown commit")
+	}
+	return contained, nil
+}
+
+func (m *mockReleaseSource) Install(ctx context.Context, releaseCommit, executablePath string) error {
+	if m.installErr != nil {
+		return m.installErr
+	}
+	m.installed = releaseCommit
+	return nil
+}
+
+func newMockReleaseSource() *mockReleaseSource {
+	return &mockReleaseSource{
+		latest:    &secondary.Release{Commit: "def5678000000000000000000000000000000000", Subject: "Add comments"},
+		ancestors: map[string]bool{"abc1234": true, "fff0000": false},
+	}
+}
+
+func TestUpgradeService_CheckUpgrade(t *testing.T) {
+	source := newMockReleaseSource()
+	ctx := context.Background()
+
+	status, err := NewUpgradeService(source, "abc1234", "/usr/local/bin/orc").CheckUpgrade(ctx)
+	if err != nil {
+		t.Fatalf("CheckUpgrade failed: %v", err)
+	}
+	if status.UpToDate || status.LatestSubject != "Add comments" {
+		t.Errorf("unexpected status: %+v", status)
+	}
+
+	status, _ = NewUpgradeService(source, "def5678", "/usr/local/bin/orc").CheckUpgrade(ctx)
+	if !status.UpToDate {
+		t.Error("expected short commit of latest release to be up to date")
+	}
+}
+
+func TestUpgradeService_Upgrade(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		commit        string
+		force         bool
+		wantInstalled bool
+		wantErr       string
+	}{
+		{name: "behind release", commit: "abc1234", wantInstalled: true},
+		{name: "up to date", commit: "def5678"},
+		{name: "dev build", commit: "unknown", wantErr: "dev build"},
+		{name: "dev build forced", commit: "unknown", force: true, wantInstalled: true},
+		{name: "not behind", commit: "fff0000", wantErr: "not behind"},
+		{name: "unknown commit", commit: "0badc0d", wantErr: "not behind"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := newMockReleaseSource()
+			status, err := NewUpgradeService(source, tt.commit, "/usr/local/bin/orc").Upgrade(ctx, tt.force)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				if source.installed != "" {
+					t.Error("expected nothing installed")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Upgrade failed: %v", err)
+			}
+			if status.Installed != tt.wantInstalled || (source.installed != "") != tt.wantInstalled {
+				t.Errorf("Installed = %v (source installed %q), want %v", status.Installed, source.installed, tt.wantInstalled)
+			}
+		})
+	}
+}
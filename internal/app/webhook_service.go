@@ -0,0 +1,300 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	corewebhook "github.com/example/orc/internal/core/webhook"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// WebhookServiceImpl implements the WebhookService interface.
+type WebhookServiceImpl struct {
+	sourceRepo      secondary.WebhookSourceRepository
+	secretService   primary.SecretService
+	shipmentService primary.ShipmentService
+	taskService     primary.TaskService
+	noteService     primary.NoteService
+	prService       primary.PRService
+}
+
+// NewWebhookService creates a new WebhookService with injected dependencies.
+func NewWebhookService(
+	sourceRepo secondary.WebhookSourceRepository,
+	secretService primary.SecretService,
+	shipmentService primary.ShipmentService,
+	taskService primary.TaskService,
+	noteService primary.NoteService,
+	prService primary.PRService,
+) *WebhookServiceImpl {
+	return &WebhookServiceImpl{
+		sourceRepo:      sourceRepo,
+		secretService:   secretService,
+		shipmentService: shipmentService,
+		taskService:     taskService,
+		noteService:     noteService,
+		prService:       prService,
+	}
+}
+
+// AddSource registers a webhook source.
+func (s *WebhookServiceImpl) AddSource(ctx context.Context, req primary.AddWebhookSourceRequest) (*primary.WebhookSource, error) {
+	mappings := corewebhook.DefaultMappings()
+	if len(req.Mappings) > 0 {
+		mappings = make(map[string][]string, len(req.Mappings))
+		for _, spec := range req.Mappings {
+			event, actions, err := corewebhook.ParseMapping(spec)
+			if err != nil {
+				return nil, err
+			}
+			mappings[event] = actions
+		}
+	}
+
+	_, err := s.sourceRepo.GetByName(ctx, req.Name)
+	guardCtx := corewebhook.AddSourceContext{
+		Name:       req.Name,
+		Kind:       req.Kind,
+		SecretName: req.SecretName,
+		Mappings:   mappings,
+		NameExists: err == nil,
+	}
+	if result := corewebhook.CanAddSource(guardCtx); !result.Allowed {
+		return nil, result.Error()
+	}
+
+	record := &secondary.WebhookSourceRecord{
+		Name:       req.Name,
+		Kind:       req.Kind,
+		SecretName: req.SecretName,
+		Mappings:   mappings,
+	}
+	if err := s.sourceRepo.Create(ctx, record); err != nil {
+		return nil, err
+	}
+	return recordToWebhookSource(record), nil
+}
+
+// ListSources lists registered webhook sources.
+func (s *WebhookServiceImpl) ListSources(ctx context.Context) ([]*primary.WebhookSource, error) {
+	records, err := s.sourceRepo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sources := make([]*primary.WebhookSource, len(records))
+	for i, r := range records {
+		sources[i] = recordToWebhookSource(r)
+	}
+	return sources, nil
+}
+
+// RemoveSource removes a webhook source.
+func (s *WebhookServiceImpl) RemoveSource(ctx context.Context, name string) error {
+	return s.sourceRepo.Delete(ctx, name)
+}
+
+// Deliver verifies a delivery against its source's secret, normalizes it
+// into an event, finds the shipment it concerns, and applies the source's
+// mapped actions. Actions that cannot apply are reported as skipped rather
+// than failing the delivery.
+func (s *WebhookServiceImpl) Deliver(ctx context.Context, req primary.WebhookDelivery) (*primary.WebhookResult, error) {
+	// 1. Source and signature
+	source, err := s.sourceRepo.GetByName(ctx, req.Source)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := s.secretService.ResolveSecret(ctx, source.SecretName, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("webhook source %s: %w", source.Name, err)
+	}
+	if !corewebhook.VerifySignature(secret, req.Body, req.Signature) {
+		return nil, primary.ErrWebhookSignature
+	}
+
+	// 2. Normalize
+	var ev corewebhook.Event
+	switch source.Kind {
+	case corewebhook.KindGitHub:
+		var ok bool
+		ev, ok, err = corewebhook.ParseGitHub(req.Event, req.Body)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return &primary.WebhookResult{Skipped: []string{fmt.Sprintf("ignored GitHub %q delivery", req.Event)}}, nil
+		}
+	default:
+		ev, err = corewebhook.ParseGeneric(req.Body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := &primary.WebhookResult{Event: ev.Type}
+	actions := source.Mappings[ev.Type]
+	if len(actions) == 0 {
+		result.Skipped = append(result.Skipped, fmt.Sprintf("no mapping for %s", ev.Type))
+		return result, nil
+	}
+
+	// 3. Shipment
+	shipment, reason, err := s.resolveShipment(ctx, ev)
+	if err != nil {
+		return nil, err
+	}
+	if shipment == nil {
+		result.Skipped = append(result.Skipped, reason)
+		return result, nil
+	}
+	result.ShipmentID = shipment.ID
+
+	// 4. Actions
+	for _, action := range actions {
+		switch action {
+		case corewebhook.ActionBlock:
+			s.transitionTasks(ctx, shipment.ID, "in-progress", s.taskService.BlockTask, "blocked", result)
+		case corewebhook.ActionUnblock:
+			s.transitionTasks(ctx, shipment.ID, "blocked", s.taskService.UnblockTask, "unblocked", result)
+		case corewebhook.ActionNote:
+			title, content, noteType := corewebhook.NoteFor(ev)
+			resp, err := s.noteService.CreateNote(ctx, primary.CreateNoteRequest{
+				CommissionID:  shipment.CommissionID,
+				Title:         title,
+				Content:       content,
+				Type:          noteType,
+				ContainerID:   shipment.ID,
+				ContainerType: "shipment",
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to record note: %w", err)
+			}
+			result.Applied = append(result.Applied, "noted "+resp.NoteID)
+		case corewebhook.ActionPRSync:
+			s.syncPR(ctx, shipment.ID, ev.Type, result)
+		}
+	}
+	return result, nil
+}
+
+// resolveShipment finds the shipment an event concerns: by ID, then by the
+// shipment's or its PR's branch, then by PR number. A nil shipment comes
+// with the reason none matched.
+func (s *WebhookServiceImpl) resolveShipment(ctx context.Context, ev corewebhook.Event) (*primary.Shipment, string, error) {
+	if ev.ShipmentID != "" {
+		shipment, err := s.shipmentService.GetShipment(ctx, ev.ShipmentID)
+		if err != nil {
+			return nil, fmt.Sprintf("shipment %s not found", ev.ShipmentID), nil
+		}
+		return shipment, "", nil
+	}
+
+	var prShipmentID string
+	if ev.Branch != "" || ev.PRNumber != 0 {
+		prs, err := s.prService.ListPRs(ctx, primary.PRFilters{})
+		if err != nil {
+			return nil, "", err
+		}
+		for _, pr := range prs {
+			if (ev.Branch != "" && pr.Branch == ev.Branch) || (ev.PRNumber != 0 && pr.Number == ev.PRNumber) {
+				prShipmentID = pr.ShipmentID
+				break
+			}
+		}
+	}
+
+	if ev.Branch != "" {
+		shipments, err := s.shipmentService.ListShipments(ctx, primary.ShipmentFilters{})
+		if err != nil {
+			return nil, "", err
+		}
+		for _, shipment := range shipments {
+			if shipment.Branch == ev.Branch {
+				return shipment, "", nil
+			}
+		}
+	}
+	if prShipmentID != "" {
+		shipment, err := s.shipmentService.GetShipment(ctx, prShipmentID)
+		if err != nil {
+			return nil, "", err
+		}
+		return shipment, "", nil
+	}
+
+	switch {
+	case ev.Branch != "":
+		return nil, fmt.Sprintf("no shipment owns branch %s", ev.Branch), nil
+	case ev.PRNumber != 0:
+		return nil, fmt.Sprintf("no shipment has PR #%d", ev.PRNumber), nil
+	default:
+		return nil, "event names no shipment, branch, or PR", nil
+	}
+}
+
+// transitionTasks moves the shipment's tasks in status from along transition.
+func (s *WebhookServiceImpl) transitionTasks(ctx context.Context, shipmentID, from string, transition func(context.Context, string) error, verb string, result *primary.WebhookResult) {
+	tasks, err := s.taskService.ListTasks(ctx, primary.TaskFilters{ShipmentID: shipmentID, Status: from})
+	if err != nil {
+		result.Skipped = append(result.Skipped, fmt.Sprintf("could not list %s tasks: %v", from, err))
+		return
+	}
+	if len(tasks) == 0 {
+		result.Skipped = append(result.Skipped, fmt.Sprintf("no %s tasks on %s", from, shipmentID))
+		return
+	}
+	for _, task := range tasks {
+		if err := transition(ctx, task.ID); err != nil {
+			result.Skipped = append(result.Skipped, fmt.Sprintf("%s: %v", task.ID, err))
+			continue
+		}
+		result.Applied = append(result.Applied, verb+" "+task.ID)
+	}
+}
+
+// syncPR mirrors a PR event onto the shipment's linked PR.
+func (s *WebhookServiceImpl) syncPR(ctx context.Context, shipmentID, eventType string, result *primary.WebhookResult) {
+	pr, err := s.prService.GetPRByShipment(ctx, shipmentID)
+	if err != nil {
+		result.Skipped = append(result.Skipped, fmt.Sprintf("no PR linked to %s", shipmentID))
+		return
+	}
+
+	var target string
+	var apply func(context.Context, string) error
+	switch eventType {
+	case corewebhook.EventPROpened:
+		target, apply = primary.PRStatusOpen, s.prService.OpenPR
+	case corewebhook.EventPRApproved:
+		target, apply = primary.PRStatusApproved, s.prService.ApprovePR
+	case corewebhook.EventPRMerged:
+		target, apply = primary.PRStatusMerged, s.prService.MergePR
+	case corewebhook.EventPRClosed:
+		target, apply = primary.PRStatusClosed, s.prService.ClosePR
+	default:
+		return
+	}
+
+	if pr.Status == target {
+		result.Skipped = append(result.Skipped, fmt.Sprintf("%s already %s", pr.ID, target))
+		return
+	}
+	if err := apply(ctx, pr.ID); err != nil {
+		result.Skipped = append(result.Skipped, fmt.Sprintf("%s: %v", pr.ID, err))
+		return
+	}
+	result.Applied = append(result.Applied, fmt.Sprintf("%s %s", pr.ID, target))
+}
+
+func recordToWebhookSource(r *secondary.WebhookSourceRecord) *primary.WebhookSource {
+	return &primary.WebhookSource{
+		Name:       r.Name,
+		Kind:       r.Kind,
+		SecretName: r.SecretName,
+		Mappings:   corewebhook.FormatMappings(r.Mappings),
+		CreatedAt:  r.CreatedAt,
+	}
+}
+
+// Ensure WebhookServiceImpl implements the interface
+var _ primary.WebhookService = (*WebhookServiceImpl)(nil)
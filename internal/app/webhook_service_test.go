@@ -0,0 +1,217 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	corewebhook "github.com/example/orc/internal/core/webhook"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+type mockWebhookSourceRepository struct {
+	sources map[string]*secondary.WebhookSourceRecord
+}
+
+func newMockWebhookSourceRepository() *mockWebhookSourceRepository {
+	return &mockWebhookSourceRepository{sources: make(map[string]*secondary.WebhookSourceRecord)}
+}
+
+func (m *mockWebhookSourceRepository) Create(ctx context.Context, source *secondary.WebhookSourceRecord) error {
+	m.sources[source.Name] = source
+	return nil
+}
+
+func (m *mockWebhookSourceRepository) GetByName(ctx context.Context, name string) (*secondary.WebhookSourceRecord, error) {
+	if source, ok := m.sources[name]; ok {
+		return source, nil
+	}
+	return nil, fmt.Errorf("webhook source %q not found", name)
+}
+
+func (m *mockWebhookSourceRepository) List(ctx context.Context) ([]*secondary.WebhookSourceRecord, error) {
+	var result []*secondary.WebhookSourceRecord
+	for _, source := range m.sources {
+		result = append(result, source)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+func (m *mockWebhookSourceRepository) Delete(ctx context.Context, name string) error {
+	if _, ok := m.sources[name]; !ok {
+		return fmt.Errorf("webhook source %q not found", name)
+	}
+	delete(m.sources, name)
+	return nil
+}
+
+// newTestWebhookService seeds SHIP-010 on branch ml/SHIP-010-invoices with an
+// in-progress task and an open PR #42, and registers a github source and a
+// generic source signed with "s3cret".
+func newTestWebhookService(t *testing.T) (*WebhookServiceImpl, *mockWebhookSourceRepository, *mockTaskRepository, *mockNoteRepository, *mockPRRepository) {
+	sourceRepo := newMockWebhookSourceRepository()
+	shipmentRepo := newMockShipmentRepository()
+	taskRepo := newMockTaskRepository()
+	noteRepo := newMockNoteRepository()
+	prRepo := newMockPRRepository()
+
+	shipmentRepo.shipments["SHIP-010"] = &secondary.ShipmentRecord{ID: "SHIP-010", CommissionID: "COMM-001", Title: "Invoices", Status: "in-progress", Branch: "ml/SHIP-010-invoices"}
+	taskRepo.tasks["TASK-004"] = &secondary.TaskRecord{ID: "TASK-004", ShipmentID: "SHIP-010", CommissionID: "COMM-001", Status: "in-progress"}
+	taskRepo.tasks["TASK-005"] = &secondary.TaskRecord{ID: "TASK-005", ShipmentID: "SHIP-010", CommissionID: "COMM-001", Status: "open"}
+	pr := &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-010", Number: 42, Branch: "ml/SHIP-010-invoices", Status: "open"}
+	prRepo.prs["PR-001"] = pr
+	prRepo.prsByShipment["SHIP-010"] = pr
+
+	secretService, _ := newTestSecretService()
+	ctx := context.Background()
+	if err := secretService.SetSecret(ctx, primary.SetSecretRequest{Name: "gh-webhook", Scope: primary.SecretScope{Type: "global"}, Value: "s3cret"}); err != nil {
+		t.Fatalf("SetSecret failed: %v", err)
+	}
+
+	lockService := NewLockService(newMockEntityLockRepository(), shipmentRepo, newMockPlanRepository())
+	shipmentService := NewShipmentService(shipmentRepo, taskRepo, nil, lockService)
+	taskService := NewTaskService(taskRepo, newMockTagRepositoryForTask(), shipmentRepo, newMockTagRouteRepository())
+	service := NewWebhookService(sourceRepo, secretService, shipmentService, taskService, NewNoteService(noteRepo), NewPRService(prRepo, shipmentService))
+
+	for _, kind := range []string{corewebhook.KindGitHub, corewebhook.KindGeneric} {
+		if _, err := service.AddSource(ctx, primary.AddWebhookSourceRequest{Name: kind, Kind: kind, SecretName: "gh-webhook"}); err != nil {
+			t.Fatalf("AddSource failed: %v", err)
+		}
+	}
+	return service, sourceRepo, taskRepo, noteRepo, prRepo
+}
+
+// deliverWebhook delivers body to source, signed with the test secret.
+func deliverWebhook(t *testing.T, service *WebhookServiceImpl, source, event, body string) *primary.WebhookResult {
+	t.Helper()
+	result, err := service.Deliver(context.Background(), primary.WebhookDelivery{
+		Source:    source,
+		Event:     event,
+		Signature: corewebhook.Sign("s3cret", []byte(body)),
+		Body:      []byte(body),
+	})
+	if err != nil {
+		t.Fatalf("Deliver failed: %v", err)
+	}
+	return result
+}
+
+func TestWebhookService_AddSource(t *testing.T) {
+	service, sourceRepo, _, _, _ := newTestWebhookService(t)
+	ctx := context.Background()
+
+	sources, err := service.ListSources(ctx)
+	if err != nil {
+		t.Fatalf("ListSources failed: %v", err)
+	}
+	if len(sources) != 2 || len(sources[1].Mappings) != len(corewebhook.DefaultMappings()) {
+		t.Errorf("expected 2 sources with default mappings, got %+v", sources)
+	}
+
+	if _, err := service.AddSource(ctx, primary.AddWebhookSourceRequest{Name: "github", Kind: "github", SecretName: "x"}); err == nil {
+		t.Error("expected duplicate source to fail")
+	}
+	if _, err := service.AddSource(ctx, primary.AddWebhookSourceRequest{Name: "ci", Kind: "generic", SecretName: "x", Mappings: []string{"ci.failed=pr-sync"}}); err == nil {
+		t.Error("expected invalid mapping to fail")
+	}
+
+	if err := service.RemoveSource(ctx, "generic"); err != nil {
+		t.Fatalf("RemoveSource failed: %v", err)
+	}
+	if _, ok := sourceRepo.sources["generic"]; ok {
+		t.Error("expected generic source removed")
+	}
+}
+
+func TestWebhookService_Deliver_CIFailure(t *testing.T) {
+	service, _, taskRepo, noteRepo, _ := newTestWebhookService(t)
+
+	result := deliverWebhook(t, service, "github", "workflow_run",
+		`{"action":"completed","workflow_run":{"name":"test","conclusion":"failure","head_branch":"ml/SHIP-010-invoices","html_url":"https://gh/run/7"}}`)
+
+	if result.Event != corewebhook.EventCIFailed || result.ShipmentID != "SHIP-010" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if taskRepo.tasks["TASK-004"].Status != "blocked" || taskRepo.tasks["TASK-005"].Status != "open" {
+		t.Errorf("expected only the in-progress task blocked, got %s and %s", taskRepo.tasks["TASK-004"].Status, taskRepo.tasks["TASK-005"].Status)
+	}
+	if len(noteRepo.notes) != 1 {
+		t.Fatalf("expected 1 note, got %d", len(noteRepo.notes))
+	}
+	for _, note := range noteRepo.notes {
+		if note.Title != "CI failed: test" || note.Type != "concern" || note.ShipmentID != "SHIP-010" {
+			t.Errorf("unexpected note: %+v", note)
+		}
+	}
+	if len(result.Applied) != 2 || result.Applied[0] != "blocked TASK-004" {
+		t.Errorf("unexpected applied: %v", result.Applied)
+	}
+
+	// The passing run resumes the blocked task
+	result = deliverWebhook(t, service, "github", "workflow_run",
+		`{"action":"completed","workflow_run":{"name":"test","conclusion":"success","head_branch":"ml/SHIP-010-invoices"}}`)
+	if taskRepo.tasks["TASK-004"].Status != "in-progress" {
+		t.Errorf("expected TASK-004 unblocked, got %s (%+v)", taskRepo.tasks["TASK-004"].Status, result)
+	}
+}
+
+func TestWebhookService_Deliver_PRMerged(t *testing.T) {
+	service, _, _, _, prRepo := newTestWebhookService(t)
+
+	result := deliverWebhook(t, service, "github", "pull_request",
+		`{"action":"closed","pull_request":{"number":42,"merged":true,"head":{"ref":"some-other-branch"}}}`)
+
+	if result.ShipmentID != "SHIP-010" {
+		t.Fatalf("expected PR number to resolve SHIP-010, got %+v", result)
+	}
+	if prRepo.prs["PR-001"].Status != "merged" {
+		t.Errorf("expected PR-001 merged, got %s", prRepo.prs["PR-001"].Status)
+	}
+
+	result = deliverWebhook(t, service, "github", "pull_request",
+		`{"action":"closed","pull_request":{"number":42,"merged":true,"head":{"ref":"ml/SHIP-010-invoices"}}}`)
+	if len(result.Applied) != 0 || len(result.Skipped) != 1 || result.Skipped[0] != "PR-001 already merged" {
+		t.Errorf("expected redelivery to be a no-op, got %+v", result)
+	}
+}
+
+func TestWebhookService_Deliver_Generic(t *testing.T) {
+	service, _, taskRepo, _, _ := newTestWebhookService(t)
+
+	result := deliverWebhook(t, service, "generic", "", `{"event":"ci.failed","shipment":"SHIP-010","title":"nightly"}`)
+	if result.ShipmentID != "SHIP-010" || taskRepo.tasks["TASK-004"].Status != "blocked" {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	result = deliverWebhook(t, service, "generic", "", `{"event":"ci.failed","branch":"unknown"}`)
+	if result.ShipmentID != "" || len(result.Skipped) != 1 || result.Skipped[0] != "no shipment owns branch unknown" {
+		t.Errorf("expected unmatched branch to be skipped, got %+v", result)
+	}
+}
+
+func TestWebhookService_Deliver_Rejections(t *testing.T) {
+	service, _, taskRepo, _, _ := newTestWebhookService(t)
+	ctx := context.Background()
+	body := []byte(`{"event":"ci.failed","shipment":"SHIP-010"}`)
+
+	_, err := service.Deliver(ctx, primary.WebhookDelivery{Source: "generic", Signature: corewebhook.Sign("wrong", body), Body: body})
+	if !errors.Is(err, primary.ErrWebhookSignature) {
+		t.Errorf("expected signature error, got %v", err)
+	}
+	if taskRepo.tasks["TASK-004"].Status != "in-progress" {
+		t.Error("expected nothing applied for a bad signature")
+	}
+
+	if _, err := service.Deliver(ctx, primary.WebhookDelivery{Source: "jenkins", Body: body}); err == nil {
+		t.Error("expected unknown source to fail")
+	}
+
+	result := deliverWebhook(t, service, "github", "ping", `{"zen":"hi"}`)
+	if result.Event != "" || len(result.Skipped) != 1 {
+		t.Errorf("expected ping to be ignored, got %+v", result)
+	}
+}
@@ -0,0 +1,131 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	coreworkbench "github.com/example/orc/internal/core/workbench"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// WorkbenchEnvServiceImpl implements the WorkbenchEnvService interface.
+type WorkbenchEnvServiceImpl struct {
+	envRepo       secondary.WorkbenchEnvRepository
+	workbenchRepo secondary.WorkbenchRepository
+	workshopRepo  secondary.WorkshopRepository
+	secretService primary.SecretService
+}
+
+// NewWorkbenchEnvService creates a new WorkbenchEnvService with injected dependencies.
+func NewWorkbenchEnvService(
+	envRepo secondary.WorkbenchEnvRepository,
+	workbenchRepo secondary.WorkbenchRepository,
+	workshopRepo secondary.WorkshopRepository,
+	secretService primary.SecretService,
+) *WorkbenchEnvServiceImpl {
+	return &WorkbenchEnvServiceImpl{
+		envRepo:       envRepo,
+		workbenchRepo: workbenchRepo,
+		workshopRepo:  workshopRepo,
+		secretService: secretService,
+	}
+}
+
+// SetEnv sets a variable on a workbench, replacing any existing one.
+func (s *WorkbenchEnvServiceImpl) SetEnv(ctx context.Context, req primary.SetWorkbenchEnvRequest) error {
+	workbench, _ := s.workbenchRepo.GetByID(ctx, req.WorkbenchID)
+
+	secretResolvable := false
+	if workbench != nil && req.SecretName != "" {
+		_, err := s.resolveSecret(ctx, workbench, req.SecretName)
+		secretResolvable = err == nil
+	}
+
+	guardResult := coreworkbench.CanSetEnv(coreworkbench.SetEnvContext{
+		WorkbenchID:      req.WorkbenchID,
+		WorkbenchExists:  workbench != nil,
+		Name:             req.Name,
+		HasValue:         req.Value != "",
+		SecretName:       req.SecretName,
+		SecretResolvable: secretResolvable,
+	})
+	if err := guardResult.Error(); err != nil {
+		return err
+	}
+
+	return s.envRepo.Set(ctx, &secondary.WorkbenchEnvRecord{
+		WorkbenchID: req.WorkbenchID,
+		Name:        req.Name,
+		Value:       req.Value,
+		SecretName:  req.SecretName,
+	})
+}
+
+// UnsetEnv removes a variable from a workbench.
+func (s *WorkbenchEnvServiceImpl) UnsetEnv(ctx context.Context, workbenchID, name string) error {
+	return s.envRepo.Delete(ctx, workbenchID, name)
+}
+
+// ListEnv lists a workbench's variables without resolving secrets.
+func (s *WorkbenchEnvServiceImpl) ListEnv(ctx context.Context, workbenchID string) ([]*primary.WorkbenchEnvVar, error) {
+	records, err := s.envRepo.List(ctx, workbenchID)
+	if err != nil {
+		return nil, err
+	}
+
+	vars := make([]*primary.WorkbenchEnvVar, len(records))
+	for i, r := range records {
+		vars[i] = &primary.WorkbenchEnvVar{
+			WorkbenchID: r.WorkbenchID,
+			Name:        r.Name,
+			Value:       r.Value,
+			SecretName:  r.SecretName,
+			UpdatedAt:   r.UpdatedAt,
+		}
+	}
+	return vars, nil
+}
+
+// ResolveEnv returns a workbench's variables as KEY=value pairs sorted by name.
+// It fails if any referenced secret no longer resolves.
+func (s *WorkbenchEnvServiceImpl) ResolveEnv(ctx context.Context, workbenchID string) ([]string, error) {
+	records, err := s.envRepo.List(ctx, workbenchID)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	workbench, err := s.workbenchRepo.GetByID(ctx, workbenchID)
+	if err != nil {
+		return nil, err
+	}
+
+	env := make([]string, 0, len(records))
+	for _, r := range records {
+		value := r.Value
+		if r.SecretName != "" {
+			value, err = s.resolveSecret(ctx, workbench, r.SecretName)
+			if err != nil {
+				return nil, fmt.Errorf("variable %s: %w", r.Name, err)
+			}
+		}
+		env = append(env, r.Name+"="+value)
+	}
+	return env, nil
+}
+
+// resolveSecret resolves a secret for the workbench's repo, then its
+// workshop's factory, then globally.
+func (s *WorkbenchEnvServiceImpl) resolveSecret(ctx context.Context, workbench *secondary.WorkbenchRecord, name string) (string, error) {
+	factoryID := ""
+	if workshop, err := s.workshopRepo.GetByID(ctx, workbench.WorkshopID); err == nil {
+		factoryID = workshop.FactoryID
+	}
+	return s.secretService.ResolveSecret(ctx, name, workbench.RepoID, factoryID)
+}
+
+// Ensure WorkbenchEnvServiceImpl implements the interface
+var _ primary.WorkbenchEnvService = (*WorkbenchEnvServiceImpl)(nil)
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// mockWorkbenchEnvRepository implements secondary.WorkbenchEnvRepository for testing.
+type mockWorkbenchEnvRepository struct {
+	vars map[string]*secondary.WorkbenchEnvRecord // keyed by workbench|name
+}
+
+func newMockWorkbenchEnvRepository() *mockWorkbenchEnvRepository {
+	return &mockWorkbenchEnvRepository{vars: make(map[string]*secondary.WorkbenchEnvRecord)}
+}
+
+func (m *mockWorkbenchEnvRepository) Set(ctx context.Context, env *secondary.WorkbenchEnvRecord) error {
+	m.vars[env.WorkbenchID+"|"+env.Name] = env
+	return nil
+}
+
+func (m *mockWorkbenchEnvRepository) Delete(ctx context.Context, workbenchID, name string) error {
+	key := workbenchID + "|" + name
+	if _, ok := m.vars[key]; !ok {
+		return errors.New("variable not set")
+	}
+	delete(m.vars, key)
+	return nil
+}
+
+func (m *mockWorkbenchEnvRepository) List(ctx context.Context, workbenchID string) ([]*secondary.WorkbenchEnvRecord, error) {
+	var result []*secondary.WorkbenchEnvRecord
+	for _, v := range m.vars {
+		if v.WorkbenchID == workbenchID {
+			result = append(result, v)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+func newTestWorkbenchEnvService(t *testing.T) (*WorkbenchEnvServiceImpl, *mockWorkbenchEnvRepository, *SecretServiceImpl) {
+	t.Helper()
+	envRepo := newMockWorkbenchEnvRepository()
+	workbenchRepo := newMockWorkbenchRepository()
+	workbenchRepo.workbenches["BENCH-003"] = &secondary.WorkbenchRecord{ID: "BENCH-003", WorkshopID: "WORK-001", RepoID: "REPO-001"}
+	workshopRepo := newMockWorkshopRepositoryForWorkbench()
+	workshopRepo.workshops["WORK-001"] = &secondary.WorkshopRecord{ID: "WORK-001", FactoryID: "FACT-001"}
+	secretService, _ := newTestSecretService()
+	return NewWorkbenchEnvService(envRepo, workbenchRepo, workshopRepo, secretService), envRepo, secretService
+}
+
+func TestWorkbenchEnvService_SetAndResolve(t *testing.T) {
+	service, envRepo, secretService := newTestWorkbenchEnvService(t)
+	ctx := context.Background()
+
+	if err := secretService.SetSecret(ctx, primary.SetSecretRequest{Name: "github-token", Value: "global-token"}); err != nil {
+		t.Fatalf("SetSecret failed: %v", err)
+	}
+	if err := secretService.SetSecret(ctx, primary.SetSecretRequest{
+		Name: "github-token", Scope: primary.SecretScope{Type: "repo", ID: "REPO-001"}, Value: "repo-token",
+	}); err != nil {
+		t.Fatalf("SetSecret failed: %v", err)
+	}
+
+	if err := service.SetEnv(ctx, primary.SetWorkbenchEnvRequest{WorkbenchID: "BENCH-003", Name: "API_BASE", Value: "staging"}); err != nil {
+		t.Fatalf("SetEnv (value) failed: %v", err)
+	}
+	if err := service.SetEnv(ctx, primary.SetWorkbenchEnvRequest{WorkbenchID: "BENCH-003", Name: "GITHUB_TOKEN", SecretName: "github-token"}); err != nil {
+		t.Fatalf("SetEnv (secret) failed: %v", err)
+	}
+
+	// Only the secret name is stored with the workbench
+	if stored := envRepo.vars["BENCH-003|GITHUB_TOKEN"]; stored.Value != "" || stored.SecretName != "github-token" {
+		t.Errorf("expected secret reference only, got %+v", stored)
+	}
+
+	env, err := service.ResolveEnv(ctx, "BENCH-003")
+	if err != nil {
+		t.Fatalf("ResolveEnv failed: %v", err)
+	}
+	want := []string{"API_BASE=staging", "GITHUB_TOKEN=repo-token"}
+	if len(env) != len(want) || env[0] != want[0] || env[1] != want[1] {
+		t.Errorf("ResolveEnv = %v, want %v", env, want)
+	}
+}
+
+func TestWorkbenchEnvService_SetEnv_UnresolvableSecret(t *testing.T) {
+	service, envRepo, _ := newTestWorkbenchEnvService(t)
+
+	err := service.SetEnv(context.Background(), primary.SetWorkbenchEnvRequest{WorkbenchID: "BENCH-003", Name: "TOKEN", SecretName: "missing"})
+	if err == nil {
+		t.Fatal("expected error for unresolvable secret")
+	}
+	if len(envRepo.vars) != 0 {
+		t.Error("expected nothing stored")
+	}
+}
+
+func TestWorkbenchEnvService_SetEnv_MissingWorkbench(t *testing.T) {
+	service, _, _ := newTestWorkbenchEnvService(t)
+
+	err := service.SetEnv(context.Background(), primary.SetWorkbenchEnvRequest{WorkbenchID: "BENCH-999", Name: "API_BASE", Value: "x"})
+	if err == nil {
+		t.Fatal("expected error for missing workbench")
+	}
+}
+
+func TestWorkbenchEnvService_ResolveEnv_SecretRemoved(t *testing.T) {
+	service, _, secretService := newTestWorkbenchEnvService(t)
+	ctx := context.Background()
+
+	_ = secretService.SetSecret(ctx, primary.SetSecretRequest{Name: "github-token", Value: "t"})
+	if err := service.SetEnv(ctx, primary.SetWorkbenchEnvRequest{WorkbenchID: "BENCH-003", Name: "GITHUB_TOKEN", SecretName: "github-token"}); err != nil {
+		t.Fatalf("SetEnv failed: %v", err)
+	}
+	_ = secretService.DeleteSecret(ctx, "github-token", primary.SecretScope{})
+
+	if _, err := service.ResolveEnv(ctx, "BENCH-003"); err == nil {
+		t.Error("expected error when a referenced secret is gone")
+	}
+}
+
+func TestWorkbenchEnvService_UnsetAndList(t *testing.T) {
+	service, _, _ := newTestWorkbenchEnvService(t)
+	ctx := context.Background()
+
+	_ = service.SetEnv(ctx, primary.SetWorkbenchEnvRequest{WorkbenchID: "BENCH-003", Name: "API_BASE", Value: "staging"})
+	if err := service.UnsetEnv(ctx, "BENCH-003", "API_BASE"); err != nil {
+		t.Fatalf("UnsetEnv failed: %v", err)
+	}
+
+	vars, err := service.ListEnv(ctx, "BENCH-003")
+	if err != nil {
+		t.Fatalf("ListEnv failed: %v", err)
+	}
+	if len(vars) != 0 {
+		t.Errorf("expected no variables, got %d", len(vars))
+	}
+
+	env, err := service.ResolveEnv(ctx, "BENCH-003")
+	if err != nil || env != nil {
+		t.Errorf("expected empty env, got %v, %v", env, err)
+	}
+}
@@ -23,6 +23,7 @@ type WorkbenchServiceImpl struct {
 	executor         EffectExecutor
 	gitService       *GitService
 	workspaceAdapter secondary.WorkspaceAdapter
+	focusHistoryRepo secondary.FocusHistoryRepository
 }
 
 // NewWorkbenchService creates a new WorkbenchService with injected dependencies.
@@ -33,6 +34,7 @@ func NewWorkbenchService(
 	agentProvider secondary.AgentIdentityProvider,
 	executor EffectExecutor,
 	workspaceAdapter secondary.WorkspaceAdapter,
+	focusHistoryRepo secondary.FocusHistoryRepository,
 ) *WorkbenchServiceImpl {
 	return &WorkbenchServiceImpl{
 		workbenchRepo:    workbenchRepo,
@@ -42,6 +44,7 @@ func NewWorkbenchService(
 		executor:         executor,
 		gitService:       NewGitService(),
 		workspaceAdapter: workspaceAdapter,
+		focusHistoryRepo: focusHistoryRepo,
 	}
 }
 
@@ -110,10 +113,26 @@ func (s *WorkbenchServiceImpl) CreateWorkbench(ctx context.Context, req primary.
 		return nil, fmt.Errorf("failed to create config: %w", err)
 	}
 
+	// 10. Run repo bootstrap script (failure is recorded on the workbench, not fatal)
+	var bootstrap *primary.BootstrapWorkbenchResponse
+	if record.RepoID != "" {
+		repo, err := s.repoRepo.GetByID(ctx, record.RepoID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get repo for bootstrap: %w", err)
+		}
+		if repo.BootstrapScript != "" {
+			bootstrap, err = s.runBootstrap(ctx, record, repo.BootstrapScript)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	return &primary.CreateWorkbenchResponse{
 		WorkbenchID: record.ID,
 		Workbench:   s.recordToWorkbench(record),
 		Path:        workbenchPath,
+		Bootstrap:   bootstrap,
 	}, nil
 }
 
@@ -204,16 +223,19 @@ func (s *WorkbenchServiceImpl) DeleteWorkbench(ctx context.Context, req primary.
 
 func (s *WorkbenchServiceImpl) recordToWorkbench(r *secondary.WorkbenchRecord) *primary.Workbench {
 	return &primary.Workbench{
-		ID:            r.ID,
-		Name:          r.Name,
-		WorkshopID:    r.WorkshopID,
-		RepoID:        r.RepoID,
-		Path:          coreworkbench.ComputePath(r.Name),
-		Status:        r.Status,
-		HomeBranch:    r.HomeBranch,
-		CurrentBranch: r.CurrentBranch,
-		CreatedAt:     r.CreatedAt,
-		UpdatedAt:     r.UpdatedAt,
+		ID:              r.ID,
+		Name:            r.Name,
+		WorkshopID:      r.WorkshopID,
+		RepoID:          r.RepoID,
+		Path:            coreworkbench.ComputePath(r.Name),
+		Status:          r.Status,
+		HomeBranch:      r.HomeBranch,
+		CurrentBranch:   r.CurrentBranch,
+		BootstrapStatus: r.BootstrapStatus,
+		BootstrapOutput: r.BootstrapOutput,
+		BootstrappedAt:  r.BootstrappedAt,
+		CreatedAt:       r.CreatedAt,
+		UpdatedAt:       r.UpdatedAt,
 	}
 }
 
@@ -383,8 +405,48 @@ func (s *WorkbenchServiceImpl) GetWorkbenchStatus(ctx context.Context, workbench
 }
 
 // UpdateFocusedID sets or clears the focused container ID for a workbench.
+// Each new focus target is appended to the workbench's focus history.
 func (s *WorkbenchServiceImpl) UpdateFocusedID(ctx context.Context, workbenchID, focusedID string) error {
-	return s.workbenchRepo.UpdateFocusedID(ctx, workbenchID, focusedID)
+	if err := s.workbenchRepo.UpdateFocusedID(ctx, workbenchID, focusedID); err != nil {
+		return err
+	}
+	if focusedID == "" {
+		return nil
+	}
+	return s.focusHistoryRepo.Record(ctx, workbenchID, focusedID)
+}
+
+// ListRecentFocus returns a workbench's recent distinct focus targets,
+// most recently focused first.
+func (s *WorkbenchServiceImpl) ListRecentFocus(ctx context.Context, workbenchID string, limit int) ([]*primary.FocusHistoryEntry, error) {
+	records, err := s.focusHistoryRepo.ListRecent(ctx, workbenchID, limit)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*primary.FocusHistoryEntry, len(records))
+	for i, r := range records {
+		entries[i] = &primary.FocusHistoryEntry{FocusedID: r.FocusedID, FocusedAt: r.FocusedAt}
+	}
+	return entries, nil
+}
+
+// GetPreviousFocus returns the most recent focus target other than the
+// current focus, or empty string if there is none.
+func (s *WorkbenchServiceImpl) GetPreviousFocus(ctx context.Context, workbenchID string) (string, error) {
+	current, err := s.GetFocusedID(ctx, workbenchID)
+	if err != nil {
+		return "", err
+	}
+	records, err := s.focusHistoryRepo.ListRecent(ctx, workbenchID, 2)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range records {
+		if r.FocusedID != current {
+			return r.FocusedID, nil
+		}
+	}
+	return "", nil
 }
 
 // GetFocusedID returns the currently focused container ID for a workbench.
@@ -424,5 +486,74 @@ func (s *WorkbenchServiceImpl) ArchiveWorkbench(ctx context.Context, workbenchID
 	return s.workbenchRepo.Update(ctx, record)
 }
 
+// BootstrapWorkbench runs the linked repo's bootstrap script inside the workbench.
+func (s *WorkbenchServiceImpl) BootstrapWorkbench(ctx context.Context, req primary.BootstrapWorkbenchRequest) (*primary.BootstrapWorkbenchResponse, error) {
+	// 1. Get workbench
+	record, err := s.workbenchRepo.GetByID(ctx, req.WorkbenchID)
+	if err != nil {
+		return nil, fmt.Errorf("workbench not found: %w", err)
+	}
+
+	// 2. Load repo script
+	var script string
+	if record.RepoID != "" {
+		repo, err := s.repoRepo.GetByID(ctx, record.RepoID)
+		if err != nil {
+			return nil, fmt.Errorf("repo %s not found: %w", record.RepoID, err)
+		}
+		script = repo.BootstrapScript
+	}
+
+	// 3. Guard check
+	guardCtx := coreworkbench.BootstrapWorkbenchContext{
+		WorkbenchID:     record.ID,
+		RepoID:          record.RepoID,
+		HasScript:       script != "",
+		BootstrapStatus: record.BootstrapStatus,
+		Rerun:           req.Rerun,
+	}
+	if result := coreworkbench.CanBootstrapWorkbench(guardCtx); !result.Allowed {
+		return nil, result.Error()
+	}
+
+	// 4. Run
+	return s.runBootstrap(ctx, record, script)
+}
+
+// bootstrapOutputLimit caps how much script output is kept on the workbench record.
+const bootstrapOutputLimit = 8 * 1024
+
+// runBootstrap executes script in the workbench and records the outcome.
+// Script failures are reported in the response; only persistence errors are returned.
+func (s *WorkbenchServiceImpl) runBootstrap(ctx context.Context, wb *secondary.WorkbenchRecord, script string) (*primary.BootstrapWorkbenchResponse, error) {
+	if err := s.workbenchRepo.UpdateBootstrapStatus(ctx, wb.ID, primary.BootstrapStatusPending, ""); err != nil {
+		return nil, fmt.Errorf("failed to record bootstrap start: %w", err)
+	}
+
+	wbPath := coreworkbench.ComputePath(wb.Name)
+	output, runErr := s.workspaceAdapter.RunBootstrap(ctx, wbPath, script)
+	if len(output) > bootstrapOutputLimit {
+		output = output[len(output)-bootstrapOutputLimit:]
+	}
+
+	resp := &primary.BootstrapWorkbenchResponse{
+		WorkbenchID: wb.ID,
+		Status:      primary.BootstrapStatusSucceeded,
+		Output:      output,
+	}
+	if runErr != nil {
+		resp.Status = primary.BootstrapStatusFailed
+		resp.Error = runErr.Error()
+	}
+
+	if err := s.workbenchRepo.UpdateBootstrapStatus(ctx, wb.ID, resp.Status, output); err != nil {
+		return nil, fmt.Errorf("failed to record bootstrap result: %w", err)
+	}
+	wb.BootstrapStatus = resp.Status
+	wb.BootstrapOutput = output
+
+	return resp, nil
+}
+
 // Ensure WorkbenchServiceImpl implements the interface
 var _ primary.WorkbenchService = (*WorkbenchServiceImpl)(nil)
@@ -137,6 +137,15 @@ func (m *mockWorkbenchRepository) UpdatePath(ctx context.Context, id, newPath st
 	return errors.New("workbench not found")
 }
 
+func (m *mockWorkbenchRepository) UpdateBootstrapStatus(ctx context.Context, id, status, output string) error {
+	if wb, ok := m.workbenches[id]; ok {
+		wb.BootstrapStatus = status
+		wb.BootstrapOutput = output
+		return nil
+	}
+	return errors.New("workbench not found")
+}
+
 func (m *mockWorkbenchRepository) GetNextID(ctx context.Context) (string, error) {
 	return m.nextID, nil
 }
@@ -223,6 +232,10 @@ func (m *mockWorkshopRepositoryForWorkbench) FactoryExists(ctx context.Context,
 	return true, nil
 }
 
+func (m *mockWorkshopRepositoryForWorkbench) CommissionExists(ctx context.Context, commissionID string) (bool, error) {
+	return true, nil
+}
+
 func (m *mockWorkshopRepositoryForWorkbench) SetActiveCommissionID(ctx context.Context, workshopID, commissionID string) error {
 	if ws, ok := m.workshops[workshopID]; ok {
 		ws.ActiveCommissionID = commissionID
@@ -297,10 +310,42 @@ func (m *mockRepoRepositoryForWorkbench) UpdateStatus(ctx context.Context, id, s
 	return errors.New("repo not found")
 }
 
+func (m *mockRepoRepositoryForWorkbench) UpdateBootstrapScript(ctx context.Context, id, script string) error {
+	if repo, ok := m.repos[id]; ok {
+		repo.BootstrapScript = script
+		return nil
+	}
+	return errors.New("repo not found")
+}
+
 func (m *mockRepoRepositoryForWorkbench) HasActivePRs(ctx context.Context, repoID string) (bool, error) {
 	return false, nil
 }
 
+// mockFocusHistoryRepository implements secondary.FocusHistoryRepository for testing.
+type mockFocusHistoryRepository struct {
+	entries []*secondary.FocusHistoryRecord // oldest first
+}
+
+func (m *mockFocusHistoryRepository) Record(ctx context.Context, workbenchID, focusedID string) error {
+	m.entries = append(m.entries, &secondary.FocusHistoryRecord{WorkbenchID: workbenchID, FocusedID: focusedID, FocusedAt: "2026-01-20T10:00:00Z"})
+	return nil
+}
+
+func (m *mockFocusHistoryRepository) ListRecent(ctx context.Context, workbenchID string, limit int) ([]*secondary.FocusHistoryRecord, error) {
+	seen := make(map[string]bool)
+	var result []*secondary.FocusHistoryRecord
+	for i := len(m.entries) - 1; i >= 0 && len(result) < limit; i-- {
+		e := m.entries[i]
+		if e.WorkbenchID != workbenchID || seen[e.FocusedID] {
+			continue
+		}
+		seen[e.FocusedID] = true
+		result = append(result, e)
+	}
+	return result, nil
+}
+
 // ============================================================================
 // Test Helper
 // ============================================================================
@@ -313,7 +358,7 @@ func newTestWorkbenchService() (*WorkbenchServiceImpl, *mockWorkbenchRepository,
 	executor := newMockEffectExecutor()
 	workspaceAdapter := newMockWorkspaceAdapter()
 
-	service := NewWorkbenchService(workbenchRepo, workshopRepo, repoRepo, agentProvider, executor, workspaceAdapter)
+	service := NewWorkbenchService(workbenchRepo, workshopRepo, repoRepo, agentProvider, executor, workspaceAdapter, &mockFocusHistoryRepository{})
 	return service, workbenchRepo, workshopRepo, repoRepo, executor, workspaceAdapter
 }
 
@@ -705,3 +750,196 @@ func TestWorkbenchService_CreateWorkbench_ExecutesEffects(t *testing.T) {
 		t.Error("expected write effect for config.json")
 	}
 }
+
+// ============================================================================
+// Bootstrap Tests
+// ============================================================================
+
+func TestWorkbenchService_CreateWorkbench_RunsBootstrap(t *testing.T) {
+	service, workbenchRepo, _, repoRepo, _, workspace := newTestWorkbenchService()
+	ctx := context.Background()
+
+	workbenchRepo.workshopExists["WORK-001"] = true
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{
+		ID:              "REPO-001",
+		Name:            "intercom",
+		BootstrapScript: "direnv allow\npre-commit install",
+	}
+	workspace.bootstrapOutput = "pre-commit installed"
+
+	resp, err := service.CreateWorkbench(ctx, primary.CreateWorkbenchRequest{
+		WorkshopID: "WORK-001",
+		RepoID:     "REPO-001",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(workspace.bootstrapScripts) != 1 || workspace.bootstrapScripts[0] != "direnv allow\npre-commit install" {
+		t.Errorf("expected repo bootstrap script to run once, got %v", workspace.bootstrapScripts)
+	}
+	if resp.Bootstrap == nil || resp.Bootstrap.Status != primary.BootstrapStatusSucceeded {
+		t.Fatalf("expected succeeded bootstrap in response, got %+v", resp.Bootstrap)
+	}
+	if workbenchRepo.workbenches["BENCH-001"].BootstrapStatus != primary.BootstrapStatusSucceeded {
+		t.Errorf("expected bootstrap status recorded, got %q", workbenchRepo.workbenches["BENCH-001"].BootstrapStatus)
+	}
+}
+
+func TestWorkbenchService_CreateWorkbench_BootstrapFailureNotFatal(t *testing.T) {
+	service, workbenchRepo, _, repoRepo, _, workspace := newTestWorkbenchService()
+	ctx := context.Background()
+
+	workbenchRepo.workshopExists["WORK-001"] = true
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{
+		ID:              "REPO-001",
+		Name:            "intercom",
+		BootstrapScript: "nvm install",
+	}
+	workspace.bootstrapOutput = "nvm: command not found"
+	workspace.bootstrapErr = errors.New("exit status 127")
+
+	resp, err := service.CreateWorkbench(ctx, primary.CreateWorkbenchRequest{
+		WorkshopID: "WORK-001",
+		RepoID:     "REPO-001",
+	})
+	if err != nil {
+		t.Fatalf("expected workbench creation to succeed, got %v", err)
+	}
+	if resp.Bootstrap.Status != primary.BootstrapStatusFailed {
+		t.Errorf("expected failed bootstrap, got %q", resp.Bootstrap.Status)
+	}
+	wb := workbenchRepo.workbenches["BENCH-001"]
+	if wb.BootstrapStatus != primary.BootstrapStatusFailed {
+		t.Errorf("expected failed status recorded, got %q", wb.BootstrapStatus)
+	}
+	if wb.BootstrapOutput != "nvm: command not found" {
+		t.Errorf("expected output recorded, got %q", wb.BootstrapOutput)
+	}
+}
+
+func TestWorkbenchService_CreateWorkbench_NoBootstrapScript(t *testing.T) {
+	service, workbenchRepo, _, repoRepo, _, workspace := newTestWorkbenchService()
+	ctx := context.Background()
+
+	workbenchRepo.workshopExists["WORK-001"] = true
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "intercom"}
+
+	resp, err := service.CreateWorkbench(ctx, primary.CreateWorkbenchRequest{
+		WorkshopID: "WORK-001",
+		RepoID:     "REPO-001",
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if resp.Bootstrap != nil {
+		t.Errorf("expected no bootstrap, got %+v", resp.Bootstrap)
+	}
+	if len(workspace.bootstrapScripts) != 0 {
+		t.Errorf("expected no script run, got %v", workspace.bootstrapScripts)
+	}
+}
+
+func TestWorkbenchService_BootstrapWorkbench_RequiresRerun(t *testing.T) {
+	service, workbenchRepo, _, repoRepo, _, workspace := newTestWorkbenchService()
+	ctx := context.Background()
+
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "intercom", BootstrapScript: "make setup"}
+	workbenchRepo.workbenches["BENCH-003"] = &secondary.WorkbenchRecord{
+		ID:              "BENCH-003",
+		Name:            "intercom-003",
+		RepoID:          "REPO-001",
+		BootstrapStatus: primary.BootstrapStatusFailed,
+	}
+
+	_, err := service.BootstrapWorkbench(ctx, primary.BootstrapWorkbenchRequest{WorkbenchID: "BENCH-003"})
+	if err == nil {
+		t.Fatal("expected error without --rerun")
+	}
+	if len(workspace.bootstrapScripts) != 0 {
+		t.Errorf("expected no script run, got %v", workspace.bootstrapScripts)
+	}
+
+	resp, err := service.BootstrapWorkbench(ctx, primary.BootstrapWorkbenchRequest{WorkbenchID: "BENCH-003", Rerun: true})
+	if err != nil {
+		t.Fatalf("expected rerun to succeed, got %v", err)
+	}
+	if resp.Status != primary.BootstrapStatusSucceeded {
+		t.Errorf("expected succeeded, got %q", resp.Status)
+	}
+	if workbenchRepo.workbenches["BENCH-003"].BootstrapStatus != primary.BootstrapStatusSucceeded {
+		t.Errorf("expected status updated to succeeded, got %q", workbenchRepo.workbenches["BENCH-003"].BootstrapStatus)
+	}
+}
+
+func TestWorkbenchService_BootstrapWorkbench_NoScript(t *testing.T) {
+	service, workbenchRepo, _, repoRepo, _, _ := newTestWorkbenchService()
+	ctx := context.Background()
+
+	repoRepo.repos["REPO-001"] = &secondary.RepoRecord{ID: "REPO-001", Name: "intercom"}
+	workbenchRepo.workbenches["BENCH-001"] = &secondary.WorkbenchRecord{
+		ID:     "BENCH-001",
+		Name:   "intercom-001",
+		RepoID: "REPO-001",
+	}
+
+	_, err := service.BootstrapWorkbench(ctx, primary.BootstrapWorkbenchRequest{WorkbenchID: "BENCH-001"})
+	if err == nil {
+		t.Fatal("expected error when repo has no bootstrap script")
+	}
+}
+
+// ============================================================================
+// Focus History Tests
+// ============================================================================
+
+func TestGetPreviousFocus(t *testing.T) {
+	service, workbenchRepo, _, _, _, _ := newTestWorkbenchService()
+	ctx := context.Background()
+
+	workbenchRepo.workbenches["BENCH-001"] = &secondary.WorkbenchRecord{ID: "BENCH-001", Status: "active"}
+
+	prev, err := service.GetPreviousFocus(ctx, "BENCH-001")
+	if err != nil {
+		t.Fatalf("GetPreviousFocus failed: %v", err)
+	}
+	if prev != "" {
+		t.Errorf("expected no previous focus, got %q", prev)
+	}
+
+	for _, id := range []string{"SHIP-001", "TOME-001"} {
+		if err := service.UpdateFocusedID(ctx, "BENCH-001", id); err != nil {
+			t.Fatalf("UpdateFocusedID failed: %v", err)
+		}
+	}
+	prev, _ = service.GetPreviousFocus(ctx, "BENCH-001")
+	if prev != "SHIP-001" {
+		t.Errorf("previous focus = %q, want SHIP-001", prev)
+	}
+
+	// Jumping back makes the other target previous again (like cd -)
+	if err := service.UpdateFocusedID(ctx, "BENCH-001", prev); err != nil {
+		t.Fatalf("UpdateFocusedID failed: %v", err)
+	}
+	prev, _ = service.GetPreviousFocus(ctx, "BENCH-001")
+	if prev != "TOME-001" {
+		t.Errorf("previous focus = %q, want TOME-001", prev)
+	}
+
+	// Clearing focus is not history; the last target is previous
+	if err := service.UpdateFocusedID(ctx, "BENCH-001", ""); err != nil {
+		t.Fatalf("UpdateFocusedID failed: %v", err)
+	}
+	prev, _ = service.GetPreviousFocus(ctx, "BENCH-001")
+	if prev != "SHIP-001" {
+		t.Errorf("previous focus after clear = %q, want SHIP-001", prev)
+	}
+
+	recent, err := service.ListRecentFocus(ctx, "BENCH-001", 10)
+	if err != nil {
+		t.Fatalf("ListRecentFocus failed: %v", err)
+	}
+	if len(recent) != 2 || recent[0].FocusedID != "SHIP-001" || recent[1].FocusedID != "TOME-001" {
+		t.Errorf("unexpected recent focus: %+v", recent)
+	}
+}
@@ -0,0 +1,267 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	coreworkbench "github.com/example/orc/internal/core/workbench"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// WorkbenchStashServiceImpl implements the WorkbenchStashService interface.
+type WorkbenchStashServiceImpl struct {
+	stashRepo        secondary.WorkbenchStashRepository
+	workbenchRepo    secondary.WorkbenchRepository
+	taskRepo         secondary.TaskRepository
+	workspaceAdapter secondary.WorkspaceAdapter
+}
+
+// NewWorkbenchStashService creates a new WorkbenchStashService with injected dependencies.
+func NewWorkbenchStashService(
+	stashRepo secondary.WorkbenchStashRepository,
+	workbenchRepo secondary.WorkbenchRepository,
+	taskRepo secondary.TaskRepository,
+	workspaceAdapter secondary.WorkspaceAdapter,
+) *WorkbenchStashServiceImpl {
+	return &WorkbenchStashServiceImpl{
+		stashRepo:        stashRepo,
+		workbenchRepo:    workbenchRepo,
+		taskRepo:         taskRepo,
+		workspaceAdapter: workspaceAdapter,
+	}
+}
+
+// StashWorkbench stashes a workbench's uncommitted changes with git stash and
+// records the stash commit, linked to the task the workbench is working on.
+func (s *WorkbenchStashServiceImpl) StashWorkbench(ctx context.Context, req primary.StashWorkbenchRequest) (*primary.WorkbenchStash, error) {
+	wb, err := s.workbenchRepo.GetByID(ctx, req.WorkbenchID)
+	if err != nil {
+		return nil, fmt.Errorf("workbench not found: %w", err)
+	}
+	workdir := coreworkbench.ComputePath(wb.Name)
+
+	exists, changed, err := s.worktreeState(ctx, workdir)
+	if err != nil {
+		return nil, err
+	}
+	guardResult := coreworkbench.CanStash(coreworkbench.StashContext{
+		WorkbenchID:    wb.ID,
+		WorktreeExists: exists,
+		ChangedFiles:   changed,
+	})
+	if err := guardResult.Error(); err != nil {
+		return nil, err
+	}
+
+	taskID := req.TaskID
+	if taskID != "" {
+		if _, err := s.taskRepo.GetByID(ctx, taskID); err != nil {
+			return nil, fmt.Errorf("task not found: %w", err)
+		}
+	} else if taskID, err = s.activeTask(ctx, wb); err != nil {
+		return nil, err
+	}
+
+	id, err := s.stashRepo.GetNextID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sha, err := s.workspaceAdapter.StashWorkingChanges(ctx, workdir, stashMessage(id, taskID, req.Message))
+	if err != nil {
+		return nil, err
+	}
+
+	branch := wb.CurrentBranch
+	if branch == "" {
+		branch = wb.HomeBranch
+	}
+	record := &secondary.WorkbenchStashRecord{
+		ID:          id,
+		WorkbenchID: wb.ID,
+		RepoID:      wb.RepoID,
+		TaskID:      taskID,
+		Branch:      branch,
+		CommitSHA:   sha,
+		FileCount:   changed,
+		Message:     req.Message,
+	}
+	if err := s.stashRepo.Create(ctx, record); err != nil {
+		return nil, fmt.Errorf("changes were stashed as %s but could not be recorded: %w. Restore them with: git stash apply %s", sha, err, sha)
+	}
+
+	return s.getStash(ctx, id)
+}
+
+// UnstashWorkbench applies a stash to a clean workbench of the same repo and
+// marks it restored. The git stash entry is dropped once the ledger records
+// the restore; the commit stays reachable from the ledger until git gc.
+func (s *WorkbenchStashServiceImpl) UnstashWorkbench(ctx context.Context, req primary.UnstashWorkbenchRequest) (*primary.WorkbenchStash, error) {
+	wb, err := s.workbenchRepo.GetByID(ctx, req.WorkbenchID)
+	if err != nil {
+		return nil, fmt.Errorf("workbench not found: %w", err)
+	}
+
+	var stash *secondary.WorkbenchStashRecord
+	if req.StashID != "" {
+		if stash, err = s.stashRepo.GetByID(ctx, req.StashID); err != nil {
+			return nil, err
+		}
+	} else if stash, err = s.defaultStash(ctx, wb); err != nil {
+		return nil, err
+	}
+
+	workdir := coreworkbench.ComputePath(wb.Name)
+	exists, changed, err := s.worktreeState(ctx, workdir)
+	if err != nil {
+		return nil, err
+	}
+	guardResult := coreworkbench.CanUnstash(coreworkbench.UnstashContext{
+		StashID:              stash.ID,
+		Status:               stash.Status,
+		RestoredTo:           stash.RestoredToWorkbenchID,
+		StashRepoID:          stash.RepoID,
+		TargetWorkbenchID:    wb.ID,
+		TargetRepoID:         wb.RepoID,
+		TargetWorktreeExists: exists,
+		TargetChangedFiles:   changed,
+	})
+	if err := guardResult.Error(); err != nil {
+		return nil, err
+	}
+
+	if err := s.workspaceAdapter.ApplyStash(ctx, workdir, stash.CommitSHA); err != nil {
+		return nil, err
+	}
+	if err := s.stashRepo.MarkRestored(ctx, stash.ID, wb.ID); err != nil {
+		return nil, err
+	}
+	// The work is restored and recorded; a stash entry left behind is harmless.
+	_ = s.workspaceAdapter.DropStash(ctx, workdir, stash.CommitSHA)
+
+	return s.getStash(ctx, stash.ID)
+}
+
+// ListStashes lists stashes matching the filters, newest first.
+func (s *WorkbenchStashServiceImpl) ListStashes(ctx context.Context, filters primary.WorkbenchStashFilters) ([]*primary.WorkbenchStash, error) {
+	records, err := s.stashRepo.List(ctx, secondary.WorkbenchStashFilters{
+		WorkbenchID: filters.WorkbenchID,
+		TaskID:      filters.TaskID,
+		Status:      filters.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stashes := make([]*primary.WorkbenchStash, len(records))
+	for i, r := range records {
+		stashes[i] = s.recordToStash(r)
+	}
+	return stashes, nil
+}
+
+// defaultStash picks the stash to restore onto wb when none is named: the
+// newest outstanding stash of its active task, else the newest taken from wb.
+func (s *WorkbenchStashServiceImpl) defaultStash(ctx context.Context, wb *secondary.WorkbenchRecord) (*secondary.WorkbenchStashRecord, error) {
+	taskID, err := s.activeTask(ctx, wb)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := []secondary.WorkbenchStashFilters{{WorkbenchID: wb.ID, Status: primary.WorkbenchStashStashed}}
+	if taskID != "" {
+		filters = append([]secondary.WorkbenchStashFilters{{TaskID: taskID, Status: primary.WorkbenchStashStashed}}, filters...)
+	}
+	for _, f := range filters {
+		stashes, err := s.stashRepo.List(ctx, f)
+		if err != nil {
+			return nil, err
+		}
+		if len(stashes) > 0 {
+			return stashes[0], nil
+		}
+	}
+	return nil, fmt.Errorf("no outstanding stash for workbench %s. List them with: orc workbench stashes", wb.ID)
+}
+
+// activeTask returns the in-progress task a workbench is working on: its
+// focused task if that is in progress, else its first in-progress task.
+// Returns "" when the workbench has none.
+func (s *WorkbenchStashServiceImpl) activeTask(ctx context.Context, wb *secondary.WorkbenchRecord) (string, error) {
+	tasks, err := s.taskRepo.GetByWorkbench(ctx, wb.ID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get workbench tasks: %w", err)
+	}
+
+	active := ""
+	for _, t := range tasks {
+		if t.Status != "in-progress" {
+			continue
+		}
+		if t.ID == wb.FocusedID {
+			return t.ID, nil
+		}
+		if active == "" {
+			active = t.ID
+		}
+	}
+	return active, nil
+}
+
+// worktreeState reports whether the worktree at workdir exists and how many
+// files have uncommitted changes.
+func (s *WorkbenchStashServiceImpl) worktreeState(ctx context.Context, workdir string) (bool, int, error) {
+	exists, err := s.workspaceAdapter.WorktreeExists(ctx, workdir)
+	if err != nil || !exists {
+		return false, 0, err
+	}
+	changes, err := s.workspaceAdapter.ListWorkingChanges(ctx, workdir)
+	if err != nil {
+		return true, 0, fmt.Errorf("failed to list uncommitted changes: %w", err)
+	}
+	return true, len(changes), nil
+}
+
+func (s *WorkbenchStashServiceImpl) getStash(ctx context.Context, id string) (*primary.WorkbenchStash, error) {
+	record, err := s.stashRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.recordToStash(record), nil
+}
+
+func (s *WorkbenchStashServiceImpl) recordToStash(r *secondary.WorkbenchStashRecord) *primary.WorkbenchStash {
+	return &primary.WorkbenchStash{
+		ID:                    r.ID,
+		WorkbenchID:           r.WorkbenchID,
+		RepoID:                r.RepoID,
+		TaskID:                r.TaskID,
+		Branch:                r.Branch,
+		CommitSHA:             r.CommitSHA,
+		FileCount:             r.FileCount,
+		Message:               r.Message,
+		Status:                r.Status,
+		RestoredToWorkbenchID: r.RestoredToWorkbenchID,
+		CreatedAt:             r.CreatedAt,
+		RestoredAt:            r.RestoredAt,
+	}
+}
+
+// stashMessage builds the git stash message, so the entry can be recognised
+// in git stash list: "orc STASH-001 TASK-042: message".
+func stashMessage(id, taskID, message string) string {
+	parts := []string{"orc", id}
+	if taskID != "" {
+		parts = append(parts, taskID)
+	}
+	msg := strings.Join(parts, " ")
+	if message != "" {
+		msg += ": " + message
+	}
+	return msg
+}
+
+// Ensure WorkbenchStashServiceImpl implements the interface
+var _ primary.WorkbenchStashService = (*WorkbenchStashServiceImpl)(nil)
@@ -0,0 +1,210 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	coreworkbench "github.com/example/orc/internal/core/workbench"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// mockWorkbenchStashRepository implements secondary.WorkbenchStashRepository for testing.
+type mockWorkbenchStashRepository struct {
+	stashes   []*secondary.WorkbenchStashRecord // oldest first
+	createErr error
+}
+
+func (m *mockWorkbenchStashRepository) Create(ctx context.Context, stash *secondary.WorkbenchStashRecord) error {
+	if m.createErr != nil {
+		return m.createErr
+	}
+	record := *stash
+	record.Status = primary.WorkbenchStashStashed
+	m.stashes = append(m.stashes, &record)
+	return nil
+}
+
+func (m *mockWorkbenchStashRepository) GetByID(ctx context.Context, id string) (*secondary.WorkbenchStashRecord, error) {
+	for _, s := range m.stashes {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("stash %s not found", id)
+}
+
+func (m *mockWorkbenchStashRepository) List(ctx context.Context, filters secondary.WorkbenchStashFilters) ([]*secondary.WorkbenchStashRecord, error) {
+	var result []*secondary.WorkbenchStashRecord
+	for i := len(m.stashes) - 1; i >= 0; i-- {
+		s := m.stashes[i]
+		if (filters.WorkbenchID == "" || s.WorkbenchID == filters.WorkbenchID) &&
+			(filters.TaskID == "" || s.TaskID == filters.TaskID) &&
+			(filters.Status == "" || s.Status == filters.Status) {
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockWorkbenchStashRepository) MarkRestored(ctx context.Context, id, workbenchID string) error {
+	s, err := m.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	s.Status = primary.WorkbenchStashRestored
+	s.RestoredToWorkbenchID = workbenchID
+	return nil
+}
+
+func (m *mockWorkbenchStashRepository) GetNextID(ctx context.Context) (string, error) {
+	return fmt.Sprintf("STASH-%03d", len(m.stashes)+1), nil
+}
+
+func newTestWorkbenchStashService() (*WorkbenchStashServiceImpl, *mockWorkbenchStashRepository, *mockTaskRepository, *mockWorkspaceAdapter) {
+	stashRepo := &mockWorkbenchStashRepository{}
+	workbenchRepo := newMockWorkbenchRepository()
+	workbenchRepo.workbenches["BENCH-003"] = &secondary.WorkbenchRecord{ID: "BENCH-003", Name: "bench-three", RepoID: "REPO-001", CurrentBranch: "feature", FocusedID: "TASK-002"}
+	workbenchRepo.workbenches["BENCH-004"] = &secondary.WorkbenchRecord{ID: "BENCH-004", Name: "bench-four", RepoID: "REPO-001"}
+	workbenchRepo.workbenches["BENCH-005"] = &secondary.WorkbenchRecord{ID: "BENCH-005", Name: "bench-five", RepoID: "REPO-002"}
+	taskRepo := newMockTaskRepository()
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", Status: "in-progress", AssignedWorkbenchID: "BENCH-003"}
+	taskRepo.tasks["TASK-002"] = &secondary.TaskRecord{ID: "TASK-002", Status: "in-progress", AssignedWorkbenchID: "BENCH-003"}
+	workspace := newMockWorkspaceAdapter()
+	workspace.worktreeExistsResult = true
+	return NewWorkbenchStashService(stashRepo, workbenchRepo, taskRepo, workspace), stashRepo, taskRepo, workspace
+}
+
+func TestWorkbenchStashService_StashWorkbench(t *testing.T) {
+	service, _, _, workspace := newTestWorkbenchStashService()
+	ctx := context.Background()
+
+	workspace.workingChanges = []string{"main.go", "notes.txt"}
+	workspace.stashSHA = "abc123"
+
+	stash, err := service.StashWorkbench(ctx, primary.StashWorkbenchRequest{WorkbenchID: "BENCH-003", Message: "half-done refactor"})
+	if err != nil {
+		t.Fatalf("StashWorkbench failed: %v", err)
+	}
+
+	// Linked to the focused in-progress task
+	if stash.ID != "STASH-001" || stash.TaskID != "TASK-002" || stash.RepoID != "REPO-001" || stash.Branch != "feature" ||
+		stash.CommitSHA != "abc123" || stash.FileCount != 2 || stash.Status != primary.WorkbenchStashStashed {
+		t.Errorf("unexpected stash: %+v", stash)
+	}
+	if len(workspace.stashMessages) != 1 || workspace.stashMessages[0] != "orc STASH-001 TASK-002: half-done refactor" {
+		t.Errorf("unexpected git stash message: %v", workspace.stashMessages)
+	}
+
+	// An explicit task overrides the active one
+	stash, err = service.StashWorkbench(ctx, primary.StashWorkbenchRequest{WorkbenchID: "BENCH-003", TaskID: "TASK-001"})
+	if err != nil {
+		t.Fatalf("StashWorkbench with task failed: %v", err)
+	}
+	if stash.TaskID != "TASK-001" {
+		t.Errorf("expected TASK-001, got %s", stash.TaskID)
+	}
+
+	if _, err := service.StashWorkbench(ctx, primary.StashWorkbenchRequest{WorkbenchID: "BENCH-003", TaskID: "TASK-999"}); err == nil {
+		t.Error("expected error for unknown task")
+	}
+}
+
+func TestWorkbenchStashService_StashWorkbench_Guards(t *testing.T) {
+	service, stashRepo, _, workspace := newTestWorkbenchStashService()
+	ctx := context.Background()
+
+	// Clean worktree
+	_, err := service.StashWorkbench(ctx, primary.StashWorkbenchRequest{WorkbenchID: "BENCH-003"})
+	if err == nil || !strings.Contains(err.Error(), "no uncommitted changes") {
+		t.Errorf("expected clean worktree error, got %v", err)
+	}
+
+	// Missing worktree
+	workspace.worktreeExistsResult = false
+	workspace.workingChanges = []string{"main.go"}
+	if _, err := service.StashWorkbench(ctx, primary.StashWorkbenchRequest{WorkbenchID: "BENCH-003"}); err == nil {
+		t.Error("expected error for missing worktree")
+	}
+
+	// Stashed but not recorded: the error names the stash commit
+	workspace.worktreeExistsResult = true
+	workspace.stashSHA = "abc123"
+	stashRepo.createErr = errors.New("disk full")
+	_, err = service.StashWorkbench(ctx, primary.StashWorkbenchRequest{WorkbenchID: "BENCH-003"})
+	if err == nil || !strings.Contains(err.Error(), "git stash apply abc123") {
+		t.Errorf("expected recovery hint, got %v", err)
+	}
+}
+
+func TestWorkbenchStashService_UnstashWorkbench(t *testing.T) {
+	service, stashRepo, taskRepo, workspace := newTestWorkbenchStashService()
+	ctx := context.Background()
+
+	stashRepo.stashes = []*secondary.WorkbenchStashRecord{
+		{ID: "STASH-001", WorkbenchID: "BENCH-003", RepoID: "REPO-001", TaskID: "TASK-002", CommitSHA: "aaa", Status: primary.WorkbenchStashStashed},
+		{ID: "STASH-002", WorkbenchID: "BENCH-003", RepoID: "REPO-001", CommitSHA: "bbb", Status: primary.WorkbenchStashStashed},
+	}
+
+	// The task moved to BENCH-004: its stash follows it there
+	taskRepo.tasks["TASK-002"].AssignedWorkbenchID = "BENCH-004"
+	stash, err := service.UnstashWorkbench(ctx, primary.UnstashWorkbenchRequest{WorkbenchID: "BENCH-004"})
+	if err != nil {
+		t.Fatalf("UnstashWorkbench failed: %v", err)
+	}
+	if stash.ID != "STASH-001" || stash.Status != primary.WorkbenchStashRestored || stash.RestoredToWorkbenchID != "BENCH-004" {
+		t.Errorf("unexpected restored stash: %+v", stash)
+	}
+	wantApplied := coreworkbench.ComputePath("bench-four") + ":aaa"
+	if len(workspace.appliedStashes) != 1 || workspace.appliedStashes[0] != wantApplied {
+		t.Errorf("appliedStashes = %v, want [%s]", workspace.appliedStashes, wantApplied)
+	}
+	if len(workspace.droppedStashes) != 1 || workspace.droppedStashes[0] != "aaa" {
+		t.Errorf("droppedStashes = %v, want [aaa]", workspace.droppedStashes)
+	}
+
+	// Restoring twice is refused
+	if _, err := service.UnstashWorkbench(ctx, primary.UnstashWorkbenchRequest{StashID: "STASH-001", WorkbenchID: "BENCH-003"}); err == nil {
+		t.Error("expected error restoring a restored stash")
+	}
+
+	// A bench on another repo cannot take the stash
+	if _, err := service.UnstashWorkbench(ctx, primary.UnstashWorkbenchRequest{StashID: "STASH-002", WorkbenchID: "BENCH-005"}); err == nil {
+		t.Error("expected error restoring onto another repo")
+	}
+
+	// Without a task stash, the newest from the bench itself is restored
+	stash, err = service.UnstashWorkbench(ctx, primary.UnstashWorkbenchRequest{WorkbenchID: "BENCH-003"})
+	if err != nil {
+		t.Fatalf("UnstashWorkbench (bench default) failed: %v", err)
+	}
+	if stash.ID != "STASH-002" {
+		t.Errorf("expected STASH-002, got %s", stash.ID)
+	}
+
+	// Nothing left
+	if _, err := service.UnstashWorkbench(ctx, primary.UnstashWorkbenchRequest{WorkbenchID: "BENCH-003"}); err == nil {
+		t.Error("expected error with no outstanding stash")
+	}
+}
+
+func TestWorkbenchStashService_UnstashWorkbench_DirtyTarget(t *testing.T) {
+	service, stashRepo, _, workspace := newTestWorkbenchStashService()
+	ctx := context.Background()
+
+	stashRepo.stashes = []*secondary.WorkbenchStashRecord{
+		{ID: "STASH-001", WorkbenchID: "BENCH-003", RepoID: "REPO-001", CommitSHA: "aaa", Status: primary.WorkbenchStashStashed},
+	}
+	workspace.workingChanges = []string{"main.go"}
+
+	_, err := service.UnstashWorkbench(ctx, primary.UnstashWorkbenchRequest{StashID: "STASH-001", WorkbenchID: "BENCH-004"})
+	if err == nil || !strings.Contains(err.Error(), "1 uncommitted changes") {
+		t.Errorf("expected dirty target error, got %v", err)
+	}
+	if len(workspace.appliedStashes) != 0 {
+		t.Errorf("expected no stash applied, got %v", workspace.appliedStashes)
+	}
+}
@@ -0,0 +1,195 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	coreworkbench "github.com/example/orc/internal/core/workbench"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// WorkbenchTeardownServiceImpl implements the WorkbenchTeardownService interface.
+type WorkbenchTeardownServiceImpl struct {
+	workbenchRepo    secondary.WorkbenchRepository
+	shipmentRepo     secondary.ShipmentRepository
+	taskRepo         secondary.TaskRepository
+	prRepo           secondary.PRRepository
+	workspaceAdapter secondary.WorkspaceAdapter
+}
+
+// NewWorkbenchTeardownService creates a new WorkbenchTeardownService with injected dependencies.
+func NewWorkbenchTeardownService(
+	workbenchRepo secondary.WorkbenchRepository,
+	shipmentRepo secondary.ShipmentRepository,
+	taskRepo secondary.TaskRepository,
+	prRepo secondary.PRRepository,
+	workspaceAdapter secondary.WorkspaceAdapter,
+) *WorkbenchTeardownServiceImpl {
+	return &WorkbenchTeardownServiceImpl{
+		workbenchRepo:    workbenchRepo,
+		shipmentRepo:     shipmentRepo,
+		taskRepo:         taskRepo,
+		prRepo:           prRepo,
+		workspaceAdapter: workspaceAdapter,
+	}
+}
+
+// unpushedBaseRefs are tried in order to find commits not yet pushed: the
+// branch's upstream, or else the remote's default branch.
+var unpushedBaseRefs = []string{"@{upstream}", "origin/HEAD"}
+
+// PlanTeardown inspects a workbench's worktree and assignments.
+func (s *WorkbenchTeardownServiceImpl) PlanTeardown(ctx context.Context, workbenchID string) (*primary.WorkbenchTeardownPlan, error) {
+	wb, err := s.workbenchRepo.GetByID(ctx, workbenchID)
+	if err != nil {
+		return nil, fmt.Errorf("workbench not found: %w", err)
+	}
+
+	plan := &primary.WorkbenchTeardownPlan{
+		WorkbenchID:   wb.ID,
+		WorkbenchName: wb.Name,
+		WorkshopID:    wb.WorkshopID,
+		Path:          coreworkbench.ComputePath(wb.Name),
+	}
+	plan.WorktreeExists, err = s.workspaceAdapter.WorktreeExists(ctx, plan.Path)
+	if err != nil {
+		return nil, err
+	}
+	if plan.WorktreeExists {
+		plan.ChangedFiles, err = s.workspaceAdapter.ListWorkingChanges(ctx, plan.Path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check %s for changes: %w", wb.ID, err)
+		}
+		for _, baseRef := range unpushedBaseRefs {
+			commits, err := s.workspaceAdapter.ListBranchCommits(ctx, plan.Path, baseRef)
+			if err != nil {
+				continue
+			}
+			for _, c := range commits {
+				plan.UnpushedCommits = append(plan.UnpushedCommits, primary.TeardownCommit{SHA: c.SHA, Subject: c.Subject})
+			}
+			break
+		}
+	}
+
+	tasks, err := s.taskRepo.GetByWorkbench(ctx, wb.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	sort.Slice(tasks, func(i, j int) bool { return tasks[i].ID < tasks[j].ID })
+	for _, t := range tasks {
+		if t.Status == "in-progress" {
+			plan.InProgressTasks = append(plan.InProgressTasks, primary.TeardownTask{ID: t.ID, Title: t.Title})
+		}
+	}
+
+	shipments, err := s.shipmentRepo.GetByWorkbench(ctx, wb.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list shipments: %w", err)
+	}
+	onBench := make(map[string]bool)
+	for _, sh := range shipments {
+		if sh.Status != "closed" {
+			onBench[sh.ID] = true
+			plan.Shipments = append(plan.Shipments, sh.ID)
+		}
+	}
+	sort.Strings(plan.Shipments)
+
+	prs, err := s.prRepo.List(ctx, secondary.PRFilters{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PRs: %w", err)
+	}
+	sort.Slice(prs, func(i, j int) bool { return prs[i].ID < prs[j].ID })
+	for _, pr := range prs {
+		if pr.Status == "merged" || pr.Status == "closed" {
+			continue
+		}
+		if onBench[pr.ShipmentID] || (pr.Branch != "" && pr.Branch == wb.CurrentBranch) {
+			plan.OpenPRs = append(plan.OpenPRs, primary.TeardownPR{ID: pr.ID, ShipmentID: pr.ShipmentID, Branch: pr.Branch, Status: pr.Status})
+		}
+	}
+
+	return plan, nil
+}
+
+// TeardownWorkbench checks that nothing would be lost, moves the workbench's
+// open shipments and in-progress tasks to MigrateTo if given, and archives the
+// workbench. The worktree is removed by orc tmux apply, as for archive.
+func (s *WorkbenchTeardownServiceImpl) TeardownWorkbench(ctx context.Context, req primary.TeardownWorkbenchRequest) (*primary.WorkbenchTeardownResult, error) {
+	// 1. Plan (also checks the workbench exists)
+	plan, err := s.PlanTeardown(ctx, req.WorkbenchID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Guard
+	guardCtx := coreworkbench.TeardownContext{
+		WorkbenchID:     plan.WorkbenchID,
+		ChangedFiles:    len(plan.ChangedFiles),
+		UnpushedCommits: len(plan.UnpushedCommits),
+		InProgressTasks: len(plan.InProgressTasks),
+		OpenPRs:         len(plan.OpenPRs),
+		Force:           req.Force,
+		MigrateTo:       req.MigrateTo,
+		HasShipment:     len(plan.Shipments) > 0,
+	}
+	if req.MigrateTo != "" {
+		if target, err := s.workbenchRepo.GetByID(ctx, req.MigrateTo); err == nil {
+			guardCtx.TargetExists = true
+			guardCtx.TargetActive = target.Status == "active"
+		}
+		exclude := ""
+		if len(plan.Shipments) > 0 {
+			exclude = plan.Shipments[0]
+		}
+		guardCtx.TargetShipmentID, err = s.shipmentRepo.WorkbenchAssignedToOther(ctx, req.MigrateTo, exclude)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if result := coreworkbench.CanTeardown(guardCtx); !result.Allowed {
+		return nil, result.Error()
+	}
+
+	result := &primary.WorkbenchTeardownResult{WorkbenchID: plan.WorkbenchID, WorkshopID: plan.WorkshopID}
+
+	// 3. Migrate assignments
+	if req.MigrateTo != "" {
+		result.MigratedTo = req.MigrateTo
+		for _, shipmentID := range plan.Shipments {
+			if err := s.shipmentRepo.AssignWorkbench(ctx, shipmentID, req.MigrateTo); err != nil {
+				return nil, fmt.Errorf("failed to migrate %s: %w", shipmentID, err)
+			}
+			if err := s.taskRepo.AssignWorkbenchByShipment(ctx, shipmentID, req.MigrateTo); err != nil {
+				return nil, fmt.Errorf("failed to migrate tasks of %s: %w", shipmentID, err)
+			}
+			result.MigratedShipments = append(result.MigratedShipments, shipmentID)
+		}
+		// In-progress tasks outside those shipments keep their claim on the new bench
+		for _, t := range plan.InProgressTasks {
+			task, err := s.taskRepo.GetByID(ctx, t.ID)
+			if err != nil {
+				return nil, err
+			}
+			if task.AssignedWorkbenchID == plan.WorkbenchID {
+				if err := s.taskRepo.Claim(ctx, t.ID, req.MigrateTo); err != nil {
+					return nil, fmt.Errorf("failed to migrate %s: %w", t.ID, err)
+				}
+			}
+			result.MigratedTasks = append(result.MigratedTasks, t.ID)
+		}
+	}
+
+	// 4. Archive
+	if err := s.workbenchRepo.Update(ctx, &secondary.WorkbenchRecord{ID: plan.WorkbenchID, Status: "archived"}); err != nil {
+		return nil, fmt.Errorf("failed to archive workbench %s: %w", plan.WorkbenchID, err)
+	}
+
+	return result, nil
+}
+
+// Ensure WorkbenchTeardownServiceImpl implements the interface
+var _ primary.WorkbenchTeardownService = (*WorkbenchTeardownServiceImpl)(nil)
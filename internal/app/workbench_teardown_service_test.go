@@ -0,0 +1,113 @@
+package app
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/ports/secondary"
+)
+
+// newTestWorkbenchTeardownService sets up BENCH-002 working on SHIP-001
+// (open PR PR-001) with TASK-001 in progress, and an idle BENCH-004 to
+// migrate to.
+func newTestWorkbenchTeardownService() (*WorkbenchTeardownServiceImpl, *mockWorkbenchRepository, *mockShipmentRepository, *mockTaskRepository, *mockWorkspaceAdapter) {
+	workbenchRepo := newMockWorkbenchRepository()
+	shipmentRepo := newMockShipmentRepository()
+	taskRepo := newMockTaskRepository()
+	prRepo := newMockPRRepository()
+	workspace := newMockWorkspaceAdapter()
+
+	workbenchRepo.workbenches["BENCH-002"] = &secondary.WorkbenchRecord{ID: "BENCH-002", Name: "bench-two", WorkshopID: "WORK-001", CurrentBranch: "ml/SHIP-001-login", Status: "active"}
+	workbenchRepo.workbenches["BENCH-004"] = &secondary.WorkbenchRecord{ID: "BENCH-004", Name: "bench-four", WorkshopID: "WORK-001", Status: "active"}
+	shipmentRepo.shipments["SHIP-001"] = &secondary.ShipmentRecord{ID: "SHIP-001", Status: "implementing", AssignedWorkbenchID: "BENCH-002"}
+	shipmentRepo.workbenchAssignments["BENCH-002"] = "SHIP-001"
+	taskRepo.tasks["TASK-001"] = &secondary.TaskRecord{ID: "TASK-001", Title: "Add login", ShipmentID: "SHIP-001", Status: "in-progress", AssignedWorkbenchID: "BENCH-002"}
+	taskRepo.tasks["TASK-002"] = &secondary.TaskRecord{ID: "TASK-002", Title: "Fix typo", Status: "in-progress", AssignedWorkbenchID: "BENCH-002"}
+	prRepo.prs["PR-001"] = &secondary.PRRecord{ID: "PR-001", ShipmentID: "SHIP-001", Branch: "ml/SHIP-001-login", Status: "open"}
+	prRepo.prs["PR-002"] = &secondary.PRRecord{ID: "PR-002", ShipmentID: "SHIP-009", Branch: "other", Status: "open"}
+	workspace.worktreeExistsResult = true
+	service := NewWorkbenchTeardownService(workbenchRepo, shipmentRepo, taskRepo, prRepo, workspace)
+	return service, workbenchRepo, shipmentRepo, taskRepo, workspace
+}
+
+func TestWorkbenchTeardownService_PlanTeardown(t *testing.T) {
+	service, _, _, _, workspace := newTestWorkbenchTeardownService()
+	workspace.workingChanges = []string{"login.go"}
+	workspace.branchCommits = []secondary.CommitInfo{{SHA: "abc1234", Subject: "Add login form"}}
+
+	plan, err := service.PlanTeardown(context.Background(), "BENCH-002")
+	if err != nil {
+		t.Fatalf("PlanTeardown failed: %v", err)
+	}
+	if len(plan.ChangedFiles) != 1 || len(plan.UnpushedCommits) != 1 || plan.UnpushedCommits[0].Subject != "Add login form" {
+		t.Errorf("worktree state = %v / %v, want login.go and one unpushed commit", plan.ChangedFiles, plan.UnpushedCommits)
+	}
+	if workspace.branchCommitsBaseRef != "@{upstream}" {
+		t.Errorf("unpushed commits compared against %q, want @{upstream}", workspace.branchCommitsBaseRef)
+	}
+	if len(plan.InProgressTasks) != 2 || plan.InProgressTasks[0].ID != "TASK-001" {
+		t.Errorf("InProgressTasks = %v, want TASK-001 and TASK-002", plan.InProgressTasks)
+	}
+	if len(plan.OpenPRs) != 1 || plan.OpenPRs[0].ID != "PR-001" {
+		t.Errorf("OpenPRs = %v, want only PR-001", plan.OpenPRs)
+	}
+	if len(plan.Shipments) != 1 || plan.Shipments[0] != "SHIP-001" {
+		t.Errorf("Shipments = %v, want SHIP-001", plan.Shipments)
+	}
+}
+
+func TestWorkbenchTeardownService_RefusesLosingWork(t *testing.T) {
+	service, workbenchRepo, _, _, workspace := newTestWorkbenchTeardownService()
+	workspace.workingChanges = []string{"login.go"}
+
+	_, err := service.TeardownWorkbench(context.Background(), primary.TeardownWorkbenchRequest{WorkbenchID: "BENCH-002"})
+	if err == nil || !strings.Contains(err.Error(), "1 uncommitted changes, 2 in-progress tasks, 1 open PRs") {
+		t.Fatalf("error = %v, want the work that would be lost", err)
+	}
+	if workbenchRepo.workbenches["BENCH-002"].Status != "active" {
+		t.Error("refused teardown should leave the workbench active")
+	}
+
+	if _, err := service.TeardownWorkbench(context.Background(), primary.TeardownWorkbenchRequest{WorkbenchID: "BENCH-002", Force: true}); err != nil {
+		t.Fatalf("forced teardown failed: %v", err)
+	}
+	if workbenchRepo.workbenches["BENCH-002"].Status != "archived" {
+		t.Error("forced teardown should archive the workbench")
+	}
+}
+
+func TestWorkbenchTeardownService_MigratesAssignments(t *testing.T) {
+	service, workbenchRepo, shipmentRepo, taskRepo, _ := newTestWorkbenchTeardownService()
+
+	result, err := service.TeardownWorkbench(context.Background(), primary.TeardownWorkbenchRequest{WorkbenchID: "BENCH-002", MigrateTo: "BENCH-004"})
+	if err != nil {
+		t.Fatalf("TeardownWorkbench failed: %v", err)
+	}
+	if len(result.MigratedShipments) != 1 || len(result.MigratedTasks) != 2 {
+		t.Errorf("result = %+v, want SHIP-001 and two tasks migrated", result)
+	}
+	if got := shipmentRepo.shipments["SHIP-001"].AssignedWorkbenchID; got != "BENCH-004" {
+		t.Errorf("SHIP-001 assigned to %q, want BENCH-004", got)
+	}
+	if task := taskRepo.tasks["TASK-002"]; task.AssignedWorkbenchID != "BENCH-004" || task.Status != "in-progress" {
+		t.Errorf("TASK-002 = %s on %q, want in-progress on BENCH-004", task.Status, task.AssignedWorkbenchID)
+	}
+	if workbenchRepo.workbenches["BENCH-002"].Status != "archived" {
+		t.Error("teardown should archive the workbench")
+	}
+}
+
+func TestWorkbenchTeardownService_MigrateToBusyWorkbench(t *testing.T) {
+	service, _, shipmentRepo, _, _ := newTestWorkbenchTeardownService()
+	shipmentRepo.workbenchAssignments["BENCH-004"] = "SHIP-007"
+
+	_, err := service.TeardownWorkbench(context.Background(), primary.TeardownWorkbenchRequest{WorkbenchID: "BENCH-002", MigrateTo: "BENCH-004"})
+	if err == nil || !strings.Contains(err.Error(), "already assigned to shipment SHIP-007") {
+		t.Fatalf("error = %v, want busy target refused", err)
+	}
+	if got := shipmentRepo.shipments["SHIP-001"].AssignedWorkbenchID; got != "BENCH-002" {
+		t.Errorf("SHIP-001 moved to %q on a refused migration", got)
+	}
+}
@@ -481,6 +481,16 @@ func boolToOpStatus(exists bool) primary.OpStatus {
 // SetActiveCommission sets the active commission for a workshop (Goblin context).
 // Pass empty string to clear.
 func (s *WorkshopServiceImpl) SetActiveCommission(ctx context.Context, workshopID, commissionID string) error {
+	if commissionID != "" {
+		// In two-ledger mode commissions live in the other database
+		exists, err := s.workshopRepo.CommissionExists(ctx, commissionID)
+		if err != nil {
+			return fmt.Errorf("failed to validate commission: %w", err)
+		}
+		if !exists {
+			return fmt.Errorf("commission %s not found", commissionID)
+		}
+	}
 	return s.workshopRepo.SetActiveCommissionID(ctx, workshopID, commissionID)
 }
 
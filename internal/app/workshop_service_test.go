@@ -18,6 +18,7 @@ type mockWorkshopRepository struct {
 	workshops      map[string]*secondary.WorkshopRecord
 	workbenchCount map[string]int
 	factoryExists  map[string]bool
+	commissions    map[string]bool
 	nextID         string
 	createErr      error
 	getErr         error
@@ -122,6 +123,10 @@ func (m *mockWorkshopRepository) FactoryExists(ctx context.Context, factoryID st
 	return m.factoryExists[factoryID], nil
 }
 
+func (m *mockWorkshopRepository) CommissionExists(ctx context.Context, commissionID string) (bool, error) {
+	return m.commissions[commissionID], nil
+}
+
 func (m *mockWorkshopRepository) SetActiveCommissionID(ctx context.Context, workshopID, commissionID string) error {
 	if ws, ok := m.workshops[workshopID]; ok {
 		ws.ActiveCommissionID = commissionID
@@ -275,6 +280,10 @@ func (m *mockWorkbenchRepositoryForWorkshop) WorkshopExists(ctx context.Context,
 	return true, nil
 }
 
+func (m *mockWorkbenchRepositoryForWorkshop) UpdateBootstrapStatus(ctx context.Context, id, status, output string) error {
+	return nil
+}
+
 func (m *mockWorkbenchRepositoryForWorkshop) UpdateFocusedID(ctx context.Context, id, focusedID string) error {
 	if wb, ok := m.workbenches[id]; ok {
 		wb.FocusedID = focusedID
@@ -339,6 +348,10 @@ func (m *mockRepoRepositoryForWorkshop) HasActivePRs(ctx context.Context, repoID
 	return false, nil
 }
 
+func (m *mockRepoRepositoryForWorkshop) UpdateBootstrapScript(ctx context.Context, id, script string) error {
+	return nil
+}
+
 // mockTMuxAdapter implements secondary.TMuxAdapter for testing.
 type mockTMuxAdapter struct {
 	sessions       map[string]bool
@@ -839,3 +852,26 @@ func TestWorkshopService_CloseWorkshop_NoSession(t *testing.T) {
 		t.Fatalf("expected no error for non-existent session, got %v", err)
 	}
 }
+
+func TestWorkshopService_SetActiveCommission(t *testing.T) {
+	service, workshopRepo, _, _ := newTestWorkshopService()
+	ctx := context.Background()
+
+	workshopRepo.workshops["WORK-001"] = &secondary.WorkshopRecord{ID: "WORK-001", FactoryID: "FACT-001", Name: "ws"}
+	workshopRepo.commissions = map[string]bool{"COMM-001": true}
+
+	if err := service.SetActiveCommission(ctx, "WORK-001", "COMM-999"); err == nil {
+		t.Fatal("expected error for non-existent commission, got nil")
+	}
+	if err := service.SetActiveCommission(ctx, "WORK-001", "COMM-001"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if got := workshopRepo.workshops["WORK-001"].ActiveCommissionID; got != "COMM-001" {
+		t.Errorf("expected active commission COMM-001, got %q", got)
+	}
+
+	// Clearing needs no commission
+	if err := service.SetActiveCommission(ctx, "WORK-001", ""); err != nil {
+		t.Fatalf("expected no error clearing, got %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// ActivityCmd returns the activity command.
+func ActivityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "activity",
+		Short: "Show a chronological feed of what actors did",
+		Long: `Show a chronological feed of actions from the workshop activity log:
+claims, completions, notes written, entities created, and field changes.
+
+Useful for standup-style reviews ("what did BENCH-003 do today?") and for
+finding which agent made a change. --actor takes an actor ID (GOBLIN,
+IMP-BENCH-003) or a workbench ID, which means the IMP working in it.
+
+Examples:
+  orc activity --today
+  orc activity --actor BENCH-003 --today
+  orc activity --actor GOBLIN -n 200`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			actorID, _ := cmd.Flags().GetString("actor")
+			today, _ := cmd.Flags().GetBool("today")
+			limit, _ := cmd.Flags().GetInt("limit")
+
+			filters := primary.ActivityFilters{ActorID: actorID, Limit: limit}
+			if today {
+				now := time.Now()
+				midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+				filters.Since = midnight.UTC().Format(time.RFC3339)
+			}
+
+			items, err := wire.LogService().GetActivity(NewContext(), filters)
+			if err != nil {
+				return fmt.Errorf("failed to get activity: %w", err)
+			}
+			if len(items) == 0 {
+				fmt.Println("No activity found.")
+				return nil
+			}
+
+			renderActivity(os.Stdout, items, actorID == "")
+			return nil
+		},
+	}
+	cmd.Flags().String("actor", "", "Only this actor's activity (actor or workbench ID)")
+	cmd.Flags().Bool("today", false, "Only activity since local midnight")
+	cmd.Flags().IntP("limit", "n", 100, "Most recent actions to show")
+	return cmd
+}
+
+// activityIcons marks each kind of activity in the feed.
+var activityIcons = map[string]string{
+	"claim":    "▶",
+	"complete": "✓",
+	"note":     "📝",
+	"create":   "+",
+	"change":   "~",
+	"delete":   "-",
+}
+
+// renderActivity prints the feed grouped by local day. The actor column is
+// shown when the feed mixes actors.
+func renderActivity(w io.Writer, items []*primary.ActivityItem, showActor bool) {
+	day := ""
+	for _, it := range items {
+		t, ok := parseTimestamp(it.Timestamp)
+		clock := it.Timestamp
+		if ok {
+			t = t.In(displayZone)
+			clock = t.Format("15:04")
+			if d := t.Format("Mon 2006-01-02"); d != day {
+				if day != "" {
+					fmt.Fprintln(w)
+				}
+				day = d
+				fmt.Fprintln(w, color.New(color.Bold).Sprint(day))
+			}
+		}
+
+		icon := activityIcons[it.Kind]
+		if icon == "" {
+			icon = "?"
+		}
+		line := "  " + clock
+		if showActor {
+			actor := it.ActorID
+			if actor == "" {
+				actor = "-"
+			}
+			line += fmt.Sprintf("  %-14s", actor)
+		}
+		line += fmt.Sprintf("  %s %s", icon, it.Summary)
+		if it.Forced {
+			line += " [forced]"
+		}
+		fmt.Fprintln(w, line)
+	}
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func TestRenderActivity(t *testing.T) {
+	noColor := color.NoColor
+	defer func() { color.NoColor = noColor }()
+	color.NoColor = true
+
+	first := time.Date(2026, 10, 15, 9, 30, 0, 0, time.Local)
+	second := first.Add(24 * time.Hour)
+	items := []*primary.ActivityItem{
+		{Timestamp: first.UTC().Format(time.RFC3339), ActorID: "IMP-BENCH-003", Kind: "claim", Summary: "claimed TASK-001"},
+		{Timestamp: second.UTC().Format(time.RFC3339), ActorID: "GOBLIN", Kind: "complete", Summary: "completed SHIP-001", Forced: true},
+	}
+
+	var buf bytes.Buffer
+	renderActivity(&buf, items, true)
+
+	out := buf.String()
+	for _, want := range []string{
+		"Thu 2026-10-15\n  09:30  IMP-BENCH-003   ▶ claimed TASK-001",
+		"Fri 2026-10-16\n  09:30  GOBLIN          ✓ completed SHIP-001 [forced]",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	buf.Reset()
+	renderActivity(&buf, items[:1], false)
+	if strings.Contains(buf.String(), "IMP-BENCH-003") {
+		t.Errorf("expected no actor column for a single actor:\n%s", buf.String())
+	}
+}
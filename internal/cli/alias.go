@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/wire"
+)
+
+// aliasablePlaceholders are the positional arguments (named in a command's
+// Use string) that accept an alias in place of an ID.
+var aliasablePlaceholders = map[string]bool{
+	"[shipment-id]":  true,
+	"[task-id]":      true,
+	"[tome-id]":      true,
+	"[container-id]": true,
+	"[entity-id]":    true,
+}
+
+// aliasableFlags are the flags that accept an alias in place of an ID.
+var aliasableFlags = map[string]bool{
+	"shipment":    true,
+	"task":        true,
+	"tome":        true,
+	"to-shipment": true,
+	"to-tome":     true,
+}
+
+// ResolveAliasArgs rewrites aliases to entity IDs in a command's ID
+// arguments and flags, so every command accepts `auth-refactor` wherever
+// it accepts SHIP-014. Other arguments (titles, names) are left alone.
+// Called once from the root command's PersistentPreRunE.
+func ResolveAliasArgs(cmd *cobra.Command, args []string) error {
+	placeholders := strings.Fields(cmd.Use)
+	if len(placeholders) > 0 {
+		placeholders = placeholders[1:] // drop the command name
+	}
+
+	for i := range args {
+		if i >= len(placeholders) || !aliasablePlaceholders[placeholders[i]] {
+			continue
+		}
+		resolved, err := resolveAlias(args[i])
+		if err != nil {
+			return err
+		}
+		args[i] = resolved
+	}
+
+	var flagErr error
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if flagErr != nil || !aliasableFlags[f.Name] {
+			return
+		}
+		resolved, err := resolveAlias(f.Value.String())
+		if err != nil {
+			flagErr = err
+			return
+		}
+		if resolved != f.Value.String() {
+			flagErr = f.Value.Set(resolved)
+		}
+	})
+	return flagErr
+}
+
+// resolveAlias maps a single reference to an entity ID: a slug to the
+// entity it names, an ID from before a prefix rename to its new ID.
+func resolveAlias(ref string) (string, error) {
+	if ref == "" {
+		return ref, nil
+	}
+	if wire.IsLedgerRef(ref) {
+		return "", fmt.Errorf("%s is in another ledger; see it with 'orc ledger show %s'", ref, ref)
+	}
+	return wire.AliasService().ResolveAlias(NewContext(), ref, orccontext.GetContextCommissionID())
+}
+
+// aliasCmd builds the `alias` subcommand for an aliasable entity type.
+func aliasCmd(entityType, exampleID string) *cobra.Command {
+	return &cobra.Command{
+		Use:   fmt.Sprintf("alias [%s-id] [slug]", entityType),
+		Short: fmt.Sprintf("Give a %s a human-friendly alias", entityType),
+		Long: fmt.Sprintf(`Assign a slug that can be used anywhere a %s ID is accepted.
+Slugs are lowercase words separated by hyphens and unique within a
+commission. Assigning a new slug replaces the previous one.
+
+Examples:
+  orc %s alias %s auth-refactor
+  orc %s show auth-refactor`, entityType, entityType, exampleID, entityType),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			entityID, slug := args[0], args[1]
+
+			if err := wire.AliasService().SetAlias(ctx, entityID, slug); err != nil {
+				return fmt.Errorf("failed to set alias: %w", err)
+			}
+
+			fmt.Printf("✓ %s is now also '%s'\n", entityID, slug)
+			return nil
+		},
+	}
+}
+
+// unaliasCmd builds the `unalias` subcommand for an aliasable entity type.
+func unaliasCmd(entityType string) *cobra.Command {
+	return &cobra.Command{
+		Use:   fmt.Sprintf("unalias [%s-id]", entityType),
+		Short: fmt.Sprintf("Remove a %s's alias", entityType),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			entityID := args[0]
+
+			if err := wire.AliasService().RemoveAlias(ctx, entityID); err != nil {
+				return fmt.Errorf("failed to remove alias: %w", err)
+			}
+
+			fmt.Printf("✓ Alias removed from %s\n", entityID)
+			return nil
+		},
+	}
+}
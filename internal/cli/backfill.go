@@ -0,0 +1,226 @@
+package cli
+
+import (
+	gocontext "context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/db"
+)
+
+// BackfillCmd returns the backfill command group for data corrections.
+func BackfillCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backfill",
+		Short: "Run the data corrections declared in orc",
+		Long: `Run data corrections against the ledger in batches, with progress kept in
+the ledger so an interrupted run resumes where it stopped.
+
+Backfills are declared in code (internal/db/backfill.go) rather than run as
+ad-hoc SQL, so each one is reviewed, repeatable, and safe to run again: it
+only touches rows that still need it.`,
+	}
+
+	cmd.AddCommand(backfillListCmd())
+	cmd.AddCommand(backfillRunCmd())
+	cmd.AddCommand(backfillStatusCmd())
+	return cmd
+}
+
+func backfillListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List backfills and the rows each has left",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			database, err := db.GetDB()
+			if err != nil {
+				return fmt.Errorf("failed to open database: %w", err)
+			}
+			states, err := db.ListBackfillStates(NewContext(), database)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tSTATUS\tREMAINING\tDESCRIPTION")
+			fmt.Fprintln(w, "----\t------\t---------\t-----------")
+			for _, s := range states {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", s.Job.Name, s.Status, s.Remaining, s.Job.Description)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+func backfillRunCmd() *cobra.Command {
+	var opts db.BackfillOptions
+
+	cmd := &cobra.Command{
+		Use:   "run <name>",
+		Short: "Run a backfill, resuming an interrupted run",
+		Long: `Correct a backfill's rows in id order, committing one batch at a time
+together with the backfill's progress.
+
+Interrupting a run (Ctrl-C) stops it after the batch in flight; running it
+again resumes after the last committed batch. A completed backfill is only
+run again with --restart. Use --dry-run first on a production ledger.
+
+Examples:
+  orc backfill run task-completed-at --dry-run
+  orc backfill run task-completed-at
+  orc backfill run task-completed-at --batch-size 100
+  orc backfill run task-completed-at --restart`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			job, ok := db.FindBackfillJob(args[0])
+			if !ok {
+				return fmt.Errorf("unknown backfill %q\nHint: List backfills with 'orc backfill list'", args[0])
+			}
+			database, err := db.GetDB()
+			if err != nil {
+				return fmt.Errorf("failed to open database: %w", err)
+			}
+			if opts.BatchSize <= 0 {
+				opts.BatchSize = db.DefaultBackfillBatchSize
+			}
+
+			ctx, stop := signal.NotifyContext(NewContext(), os.Interrupt)
+			defer stop()
+
+			opts.OnBatch = func(state *db.BackfillState, n int) {
+				fmt.Printf("  batch %d: %s (through %s)\n", state.Batches, pluralize(n, "row", "rows"), state.LastID)
+			}
+			report, err := db.RunBackfill(ctx, database, job, opts)
+			if report != nil && errors.Is(err, gocontext.Canceled) {
+				fmt.Printf("\nStopped after %s; %s so far\n", report.State.LastID, pluralize(report.State.RowsUpdated, "row", "rows"))
+				fmt.Printf("  Resume with: orc backfill run %s\n", job.Name)
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			if opts.DryRun {
+				printBackfillDryRun(os.Stdout, report, opts.BatchSize)
+				return nil
+			}
+			if report.Resumed {
+				fmt.Printf("Resumed after %s\n", report.State.LastID)
+			}
+			fmt.Printf("✓ %s: %s corrected in %s\n", job.Name,
+				pluralize(report.Updated, "row", "rows"), pluralize(report.Batches, "batch", "batches"))
+			if report.State.Remaining > 0 {
+				fmt.Printf("  %s still need it; run again with --restart to pick them up\n",
+					pluralize(report.State.Remaining, "row", "rows"))
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the rows that would change without changing them")
+	cmd.Flags().IntVar(&opts.BatchSize, "batch-size", db.DefaultBackfillBatchSize, "Rows per transaction")
+	cmd.Flags().BoolVar(&opts.Restart, "restart", false, "Start over from the first row, even after completion")
+	return cmd
+}
+
+func backfillStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status [name]",
+		Short: "Show backfill progress",
+		Long: `Show the recorded progress of one backfill, or of every backfill that
+has run.
+
+Examples:
+  orc backfill status
+  orc backfill status task-completed-at`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			database, err := db.GetDB()
+			if err != nil {
+				return fmt.Errorf("failed to open database: %w", err)
+			}
+			ctx := NewContext()
+
+			if len(args) == 1 {
+				job, ok := db.FindBackfillJob(args[0])
+				if !ok {
+					return fmt.Errorf("unknown backfill %q\nHint: List backfills with 'orc backfill list'", args[0])
+				}
+				state, err := db.GetBackfillState(ctx, database, job)
+				if err != nil {
+					return err
+				}
+				printBackfillState(os.Stdout, state)
+				return nil
+			}
+
+			states, err := db.ListBackfillStates(ctx, database)
+			if err != nil {
+				return err
+			}
+			shown := 0
+			for _, s := range states {
+				if s.Status == "pending" {
+					continue
+				}
+				if shown > 0 {
+					fmt.Println()
+				}
+				printBackfillState(os.Stdout, s)
+				shown++
+			}
+			if shown == 0 {
+				fmt.Println("No backfills have run on this ledger.")
+				fmt.Println("  List them with: orc backfill list")
+			}
+			return nil
+		},
+	}
+}
+
+// printBackfillState renders a backfill's recorded progress.
+func printBackfillState(w io.Writer, s *db.BackfillState) {
+	fmt.Fprintf(w, "%s: %s\n", s.Job.Name, s.Status)
+	fmt.Fprintf(w, "  %s\n", s.Job.Description)
+	if s.Status != "pending" {
+		fmt.Fprintf(w, "  Started:   %s\n", formatTime(s.StartedAt))
+		fmt.Fprintf(w, "  Progress:  %s in %s, through %s\n",
+			pluralize(s.RowsUpdated, "row", "rows"), pluralize(s.Batches, "batch", "batches"), orDash(s.LastID))
+		if s.CompletedAt != "" {
+			fmt.Fprintf(w, "  Completed: %s\n", formatTime(s.CompletedAt))
+		} else {
+			fmt.Fprintf(w, "  Updated:   %s\n", formatTime(s.UpdatedAt))
+		}
+	}
+	fmt.Fprintf(w, "  Remaining: %s\n", pluralize(s.Remaining, "row", "rows"))
+}
+
+// printBackfillDryRun renders what a backfill run would change.
+func printBackfillDryRun(w io.Writer, r *db.BackfillReport, batchSize int) {
+	s := r.State
+	if s.Remaining == 0 {
+		fmt.Fprintf(w, "%s: no rows to correct\n", s.Job.Name)
+		return
+	}
+	batches := (s.Remaining + batchSize - 1) / batchSize
+	fmt.Fprintf(w, "%s: would correct %s in %s of %d\n", s.Job.Name,
+		pluralize(s.Remaining, "row", "rows"), pluralize(batches, "batch", "batches"), batchSize)
+	fmt.Fprintf(w, "  UPDATE %s SET %s WHERE %s\n", s.Job.Table, s.Job.Set, s.Job.Where)
+	for _, id := range r.Sample {
+		fmt.Fprintf(w, "  %s\n", id)
+	}
+	if s.Remaining > len(r.Sample) {
+		fmt.Fprintf(w, "  ... and %d more\n", s.Remaining-len(r.Sample))
+	}
+	if s.Status == "running" {
+		fmt.Fprintf(w, "  An earlier run stopped after %s; running resumes there\n", s.LastID)
+	}
+	fmt.Fprintln(w, "\nRun without --dry-run to apply.")
+}
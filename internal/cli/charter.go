@@ -0,0 +1,170 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// charterTarget adapts a container type (shipment, tome) to the generic
+// charter subcommands.
+type charterTarget struct {
+	entity string // "shipment" or "tome"
+	get    func(id string) (title, charter string, err error)
+	set    func(id, charter string) error
+}
+
+// newCharterCmd builds the `charter` subcommand tree (edit, show) for a container type.
+func newCharterCmd(target charterTarget) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "charter",
+		Short: fmt.Sprintf("Manage the %s charter (long-form \"why\" document)", target.entity),
+		Long: fmt.Sprintf(`A charter is a markdown document explaining why the %s exists:
+goals, constraints, and non-goals. It is rendered by show commands and
+included in the prime context of agents focused on the %s.`, target.entity, target.entity),
+	}
+
+	editCmd := &cobra.Command{
+		Use:   fmt.Sprintf("edit [%s-id]", target.entity),
+		Short: fmt.Sprintf("Edit the %s charter", target.entity),
+		Long: fmt.Sprintf(`Edit the %s charter.
+
+Content is taken from --content or --file when given. Otherwise the current
+charter is opened in $EDITOR (falls back to vi).
+
+Examples:
+  orc %s charter edit ID
+  orc %s charter edit ID --file charter.md
+  orc %s charter edit ID --content "## Why ..."
+  orc %s charter edit ID --clear`, target.entity, target.entity, target.entity, target.entity, target.entity),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id := args[0]
+			if err := validateEntityID(id, target.entity); err != nil {
+				return err
+			}
+			content, _ := cmd.Flags().GetString("content")
+			file, _ := cmd.Flags().GetString("file")
+			clear, _ := cmd.Flags().GetBool("clear")
+
+			_, current, err := target.get(id)
+			if err != nil {
+				return fmt.Errorf("%s not found: %w", target.entity, err)
+			}
+
+			var charter string
+			switch {
+			case clear:
+				charter = ""
+			case content != "":
+				charter = content
+			case file != "":
+				data, err := os.ReadFile(file)
+				if err != nil {
+					return fmt.Errorf("failed to read charter file: %w", err)
+				}
+				charter = string(data)
+			default:
+				charter, err = editInEditor(id+"-charter-*.md", current)
+				if err != nil {
+					return err
+				}
+				if strings.TrimSpace(charter) == strings.TrimSpace(current) {
+					fmt.Println("Charter unchanged")
+					return nil
+				}
+			}
+
+			if err := target.set(id, charter); err != nil {
+				return fmt.Errorf("failed to update charter: %w", err)
+			}
+
+			if strings.TrimSpace(charter) == "" {
+				fmt.Printf("✓ Charter cleared for %s\n", id)
+			} else {
+				fmt.Printf("✓ Charter updated for %s\n", id)
+			}
+			return nil
+		},
+	}
+	editCmd.Flags().String("content", "", "Charter content (markdown)")
+	editCmd.Flags().String("file", "", "Read charter content from file")
+	editCmd.Flags().Bool("clear", false, "Remove the charter")
+
+	showCmd := &cobra.Command{
+		Use:   fmt.Sprintf("show [%s-id]", target.entity),
+		Short: fmt.Sprintf("Show the %s charter", target.entity),
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id := args[0]
+			title, charter, err := target.get(id)
+			if err != nil {
+				return fmt.Errorf("%s not found: %w", target.entity, err)
+			}
+			if charter == "" {
+				fmt.Printf("%s has no charter\n", id)
+				fmt.Printf("\nAdd one with: orc %s charter edit %s\n", target.entity, id)
+				return nil
+			}
+			fmt.Printf("# Charter: %s - %s\n\n", id, title)
+			fmt.Println(charter)
+			return nil
+		},
+	}
+
+	cmd.AddCommand(editCmd)
+	cmd.AddCommand(showCmd)
+	return cmd
+}
+
+// printCharterSection renders a charter block for show commands (no-op when empty).
+func printCharterSection(charter string) {
+	printPanel(charterPanel(charter))
+}
+
+// charterPanel shows a charter in a show view (empty when there is none).
+func charterPanel(charter string) detailPanel {
+	p := detailPanel{title: "Charter"}
+	if charter != "" {
+		p.lines = strings.Split(charter, "\n")
+	}
+	return p
+}
+
+// editInEditor opens initial content in $EDITOR and returns the saved result.
+func editInEditor(pattern, initial string) (string, error) {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vi"
+	}
+
+	tmp, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(initial); err != nil {
+		tmp.Close()
+		return "", fmt.Errorf("failed to write temp file: %w", err)
+	}
+	tmp.Close()
+
+	parts := strings.Fields(editor)
+	editCmd := exec.Command(parts[0], append(parts[1:], tmp.Name())...)
+	editCmd.Stdin = os.Stdin
+	editCmd.Stdout = os.Stdout
+	editCmd.Stderr = os.Stderr
+	if err := editCmd.Run(); err != nil {
+		return "", fmt.Errorf("editor exited with error: %w", err)
+	}
+
+	data, err := os.ReadFile(tmp.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read edited file: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// CommentCmd returns the comment command
+func CommentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "comment",
+		Short: "Add and list comments on any entity",
+		Long: `Comments are short remarks attached to a commission, shipment, task, tome,
+note, or plan. Unlike notes they are not containers and have no lifecycle:
+use them for quick context ("blocked on infra") rather than knowledge worth
+keeping. Comments are attributed to the current actor and shown by the
+entity's show command.
+
+Examples:
+  orc comment add TASK-042 "this blocked on infra"
+  orc comment add TASK-042 "unblocked, see PR-12" --reply-to CMT-003
+  orc comment list TASK-042`,
+	}
+	cmd.AddCommand(commentAddCmd())
+	cmd.AddCommand(commentListCmd())
+	return cmd
+}
+
+func commentAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add [entity-id] [text]",
+		Short: "Comment on an entity",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			replyTo, _ := cmd.Flags().GetString("reply-to")
+
+			comment, err := wire.CommentService().AddComment(ctx, primary.AddCommentRequest{
+				EntityID:  args[0],
+				Body:      args[1],
+				ReplyToID: replyTo,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add comment: %w", err)
+			}
+
+			fmt.Printf("✓ Added %s on %s\n", comment.ID, comment.EntityID)
+			return nil
+		},
+	}
+	cmd.Flags().String("reply-to", "", "Reply to a comment on the same entity (CMT-xxx)")
+	return cmd
+}
+
+func commentListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [entity-id]",
+		Short: "List an entity's comments",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			comments, err := wire.CommentService().ListComments(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			if len(comments) == 0 {
+				fmt.Printf("No comments on %s\n", args[0])
+				return nil
+			}
+			printComments(comments, "")
+			return nil
+		},
+	}
+}
+
+// printCommentsSection renders an entity's comments for show commands
+// (no-op when there are none).
+func printCommentsSection(ctx context.Context, entityID string) error {
+	comments, err := wire.CommentService().ListComments(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	if len(comments) == 0 {
+		return nil
+	}
+
+	printPanel(commentsPanel(comments))
+	return nil
+}
+
+// printComments renders comments in thread order, indenting replies.
+func printComments(comments []*primary.Comment, indent string) {
+	for _, line := range commentLines(comments, indent) {
+		fmt.Println(line)
+	}
+}
+
+// commentLines renders comments in thread order, indenting replies.
+func commentLines(comments []*primary.Comment, indent string) []string {
+	var lines []string
+	for _, c := range comments {
+		prefix := indent + strings.Repeat("  ", c.Depth)
+		author := c.Author
+		if author == "" {
+			author = "unknown"
+		}
+		lines = append(lines, fmt.Sprintf("%s%s %s (%s):", prefix, c.ID, author, formatWhen(c.CreatedAt)))
+		for _, line := range strings.Split(c.Body, "\n") {
+			lines = append(lines, fmt.Sprintf("%s  %s", prefix, line))
+		}
+	}
+	return lines
+}
@@ -63,7 +63,7 @@ var commissionShowCmd = &cobra.Command{
 			fmt.Println()
 		}
 
-		return nil
+		return printCommentsSection(ctx, id)
 	},
 }
 
@@ -162,6 +162,87 @@ var commissionUnpinCmd = &cobra.Command{
 	},
 }
 
+var commissionBudgetCmd = &cobra.Command{
+	Use:   "budget",
+	Short: "Manage commission budgets",
+	Long: `Track a commission against a budget in hours or task points.
+
+Hours are spent from the time each task was claimed until it was completed
+(or until now, while it is being worked). Points are the estimates of closed
+tasks (see 'orc task create --points'). Warnings are shown by 'orc summary'
+once spend passes a threshold.
+
+Examples:
+  orc commission budget set COMM-001 --hours 40
+  orc commission budget set COMM-001 --points 30 --warn-at 50,80
+  orc commission budget show COMM-001
+  orc report budget`,
+}
+
+var commissionBudgetSetCmd = &cobra.Command{
+	Use:   "set [commission-id]",
+	Short: "Set a commission's budget",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		hours, _ := cmd.Flags().GetFloat64("hours")
+		points, _ := cmd.Flags().GetFloat64("points")
+		warnAt, _ := cmd.Flags().GetString("warn-at")
+
+		req := primary.SetBudgetRequest{CommissionID: args[0], Thresholds: warnAt}
+		switch {
+		case hours > 0 && points > 0:
+			return fmt.Errorf("specify --hours or --points, not both")
+		case hours > 0:
+			req.Unit, req.Amount = primary.BudgetUnitHours, hours
+		case points > 0:
+			req.Unit, req.Amount = primary.BudgetUnitPoints, points
+		default:
+			return fmt.Errorf("must specify --hours or --points")
+		}
+
+		if err := wire.BudgetService().SetBudget(ctx, req); err != nil {
+			return fmt.Errorf("failed to set budget: %w", err)
+		}
+
+		fmt.Printf("✓ Budget for %s set to %s %s\n", args[0], formatBudgetAmount(req.Amount), req.Unit)
+		return nil
+	},
+}
+
+var commissionBudgetShowCmd = &cobra.Command{
+	Use:   "show [commission-id]",
+	Short: "Show a commission's budget consumption",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		report, err := wire.BudgetService().GetBudgetReport(ctx, args[0])
+		if err != nil {
+			return err
+		}
+		if report == nil {
+			fmt.Printf("No budget set for %s\n", args[0])
+			return nil
+		}
+		printBudgetReport(report)
+		return nil
+	},
+}
+
+var commissionBudgetClearCmd = &cobra.Command{
+	Use:   "clear [commission-id]",
+	Short: "Remove a commission's budget",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		if err := wire.BudgetService().ClearBudget(ctx, args[0]); err != nil {
+			return fmt.Errorf("failed to clear budget: %w", err)
+		}
+		fmt.Printf("✓ Budget for %s cleared\n", args[0])
+		return nil
+	},
+}
+
 // CommissionCmd returns the commission command
 func CommissionCmd() *cobra.Command {
 	// Add flags
@@ -170,6 +251,13 @@ func CommissionCmd() *cobra.Command {
 	commissionUpdateCmd.Flags().StringP("title", "t", "", "New commission title")
 	commissionUpdateCmd.Flags().StringP("description", "d", "", "New commission description")
 	commissionDeleteCmd.Flags().BoolP("force", "f", false, "Force delete even with associated data")
+	commissionBudgetSetCmd.Flags().Float64("hours", 0, "Budget in hours")
+	commissionBudgetSetCmd.Flags().Float64("points", 0, "Budget in task points")
+	commissionBudgetSetCmd.Flags().String("warn-at", "", "Warning thresholds as percentages (default 75,90)")
+
+	commissionBudgetCmd.AddCommand(commissionBudgetSetCmd)
+	commissionBudgetCmd.AddCommand(commissionBudgetShowCmd)
+	commissionBudgetCmd.AddCommand(commissionBudgetClearCmd)
 
 	// Add subcommands
 	commissionCmd.AddCommand(commissionCreateCmd)
@@ -182,6 +270,7 @@ func CommissionCmd() *cobra.Command {
 	commissionCmd.AddCommand(commissionDeleteCmd)
 	commissionCmd.AddCommand(commissionPinCmd)
 	commissionCmd.AddCommand(commissionUnpinCmd)
+	commissionCmd.AddCommand(commissionBudgetCmd)
 
 	return commissionCmd
 }
@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+// commitsPanel shows the commits linked to an entity. Links are recorded by
+// `orc workbench sync-commits` and `orc shipment land`.
+func commitsPanel(commits []*primary.CommitLink) detailPanel {
+	p := detailPanel{title: fmt.Sprintf("Commits (%d)", len(commits))}
+	for _, c := range commits {
+		p.lines = append(p.lines, fmt.Sprintf("%s %s", shortSHA(c.CommitSHA), c.Subject))
+	}
+	return p
+}
+
+// shortSHA abbreviates a commit SHA for display.
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
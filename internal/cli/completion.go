@@ -0,0 +1,361 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// CompletionCmd returns the completion command
+func CompletionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "completion [bash|zsh|fish]",
+		Short: "Generate shell completion scripts",
+		Long: `Generate a shell completion script for orc.
+
+Completion suggests live entity IDs from the ledger, with titles as
+descriptions: task IDs for task commands, shipment IDs for shipment
+commands, commissions for --commission, and so on. Commission-scoped
+entities are limited to the --commission flag when given, otherwise to the
+current commission context. Closed entities are not suggested.
+
+Setup:
+  bash:  source <(orc completion bash)          # add to ~/.bashrc
+  zsh:   orc completion zsh > "${fpath[1]}/_orc"  # then restart the shell
+  fish:  orc completion fish > ~/.config/fish/completions/orc.fish`,
+		Args:      cobra.ExactArgs(1),
+		ValidArgs: []string{"bash", "zsh", "fish"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			root := cmd.Root()
+			switch args[0] {
+			case "bash":
+				return root.GenBashCompletionV2(os.Stdout, true)
+			case "zsh":
+				return root.GenZshCompletion(os.Stdout)
+			case "fish":
+				return root.GenFishCompletion(os.Stdout, true)
+			default:
+				return fmt.Errorf("unsupported shell %q: must be bash, zsh, or fish", args[0])
+			}
+		},
+	}
+}
+
+// entityCompleter lists completion candidates ("ID\tTitle") for one entity type.
+type entityCompleter func(ctx context.Context, cmd *cobra.Command) ([]string, error)
+
+// entityCompleters maps entity names (as used in "[x-id]" arg placeholders and
+// flag names) to their live ID listers.
+var entityCompleters = map[string]entityCompleter{
+	"commission": completeCommissions,
+	"shipment":   completeShipments,
+	"task":       completeTasks,
+	"note":       completeNotes,
+	"tome":       completeTomes,
+	"plan":       completePlans,
+	"pr":         completePRs,
+	"repo":       completeRepos,
+	"factory":    completeFactories,
+	"workshop":   completeWorkshops,
+	"workbench":  completeWorkbenches,
+	"tag":        completeTags,
+}
+
+// flagEntities maps flag names to the entity whose IDs they take.
+var flagEntities = map[string]string{
+	"commission":    "commission",
+	"to-commission": "commission",
+	"shipment":      "shipment",
+	"to-shipment":   "shipment",
+	"task":          "task",
+	"tome":          "tome",
+	"to-tome":       "tome",
+	"repo":          "repo",
+	"factory":       "factory",
+	"workshop":      "workshop",
+	"workbench":     "workbench",
+	"actor":         "workbench",
+	"tag":           "tag",
+}
+
+// argPlaceholder matches "[task-id]" style placeholders (and "[tag-name]").
+var argPlaceholder = regexp.MustCompile(`\[([a-z]+)-(?:id|name)\]`)
+
+// RegisterDynamicCompletions walks the command tree and attaches live ID
+// completion to positional "[x-id]" args and entity flags. Commands that
+// already define their own completion are left alone.
+func RegisterDynamicCompletions(root *cobra.Command) {
+	for _, cmd := range root.Commands() {
+		registerCommandCompletions(cmd)
+		RegisterDynamicCompletions(cmd)
+	}
+}
+
+func registerCommandCompletions(cmd *cobra.Command) {
+	if cmd.ValidArgsFunction == nil && len(cmd.ValidArgs) == 0 {
+		var positional []string
+		for _, m := range argPlaceholder.FindAllStringSubmatch(cmd.Use, -1) {
+			positional = append(positional, m[1])
+		}
+		if hasCompleter(positional) {
+			cmd.ValidArgsFunction = completeEntityArgs(positional...)
+		}
+	}
+
+	cmd.LocalFlags().VisitAll(func(flag *pflag.Flag) {
+		entity, ok := flagEntities[flag.Name]
+		if !ok || flag.Value.Type() != "string" {
+			return
+		}
+		if _, exists := cmd.GetFlagCompletionFunc(flag.Name); exists {
+			return
+		}
+		_ = cmd.RegisterFlagCompletionFunc(flag.Name, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return runCompleter(cmd, entity, toComplete)
+		})
+	})
+}
+
+// completeEntityArgs completes positional args with live IDs, one entity per position.
+func completeEntityArgs(entities ...string) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) >= len(entities) {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return runCompleter(cmd, entities[len(args)], toComplete)
+	}
+}
+
+func hasCompleter(entities []string) bool {
+	for _, e := range entities {
+		if _, ok := entityCompleters[e]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func runCompleter(cmd *cobra.Command, entity, toComplete string) ([]string, cobra.ShellCompDirective) {
+	complete, ok := entityCompleters[entity]
+	if !ok {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	candidates, err := complete(NewContext(), cmd)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	var matches []string
+	for _, c := range candidates {
+		if strings.HasPrefix(strings.ToUpper(c), strings.ToUpper(toComplete)) {
+			matches = append(matches, c)
+		}
+	}
+	return matches, cobra.ShellCompDirectiveNoFileComp
+}
+
+// completionItem formats a candidate with its title as the description.
+func completionItem(id, title string) string {
+	title = strings.Join(strings.Fields(title), " ")
+	if title == "" {
+		return id
+	}
+	return id + "\t" + truncate(title, 60)
+}
+
+// completionCommission returns the commission to scope suggestions to:
+// the --commission flag when set, otherwise the current context.
+func completionCommission(cmd *cobra.Command) string {
+	if f := cmd.Flags().Lookup("commission"); f != nil && f.Changed {
+		return f.Value.String()
+	}
+	return orccontext.GetContextCommissionID()
+}
+
+// completionFlag returns a flag value set on the command line being completed.
+func completionFlag(cmd *cobra.Command, name string) string {
+	if f := cmd.Flags().Lookup(name); f != nil && f.Changed {
+		return f.Value.String()
+	}
+	return ""
+}
+
+func completeCommissions(ctx context.Context, _ *cobra.Command) ([]string, error) {
+	commissions, err := wire.CommissionService().ListCommissions(ctx, primary.CommissionFilters{})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, c := range commissions {
+		if c.Status == "complete" || c.Status == "archived" {
+			continue
+		}
+		out = append(out, completionItem(c.ID, c.Title))
+	}
+	return out, nil
+}
+
+func completeShipments(ctx context.Context, cmd *cobra.Command) ([]string, error) {
+	shipments, err := wire.ShipmentService().ListShipments(ctx, primary.ShipmentFilters{CommissionID: completionCommission(cmd)})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, s := range shipments {
+		if s.Status == "closed" {
+			continue
+		}
+		out = append(out, completionItem(s.ID, s.Title))
+	}
+	return out, nil
+}
+
+func completeTasks(ctx context.Context, cmd *cobra.Command) ([]string, error) {
+	filters := primary.TaskFilters{ShipmentID: completionFlag(cmd, "shipment")}
+	if filters.ShipmentID == "" {
+		filters.CommissionID = completionCommission(cmd)
+	}
+	tasks, err := wire.TaskService().ListTasks(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, t := range tasks {
+		if t.Status == "closed" {
+			continue
+		}
+		out = append(out, completionItem(t.ID, t.Title))
+	}
+	return out, nil
+}
+
+func completeNotes(ctx context.Context, cmd *cobra.Command) ([]string, error) {
+	notes, err := wire.NoteService().ListNotes(ctx, primary.NoteFilters{CommissionID: completionCommission(cmd)})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, n := range notes {
+		if n.Status == "closed" {
+			continue
+		}
+		out = append(out, completionItem(n.ID, n.Title))
+	}
+	return out, nil
+}
+
+func completeTomes(ctx context.Context, cmd *cobra.Command) ([]string, error) {
+	tomes, err := wire.TomeService().ListTomes(ctx, primary.TomeFilters{CommissionID: completionCommission(cmd)})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, t := range tomes {
+		if t.Status == "closed" {
+			continue
+		}
+		out = append(out, completionItem(t.ID, t.Title))
+	}
+	return out, nil
+}
+
+func completePlans(ctx context.Context, cmd *cobra.Command) ([]string, error) {
+	plans, err := wire.PlanService().ListPlans(ctx, primary.PlanFilters{
+		TaskID:       completionFlag(cmd, "task"),
+		CommissionID: completionCommission(cmd),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, p := range plans {
+		out = append(out, completionItem(p.ID, p.Title))
+	}
+	return out, nil
+}
+
+func completePRs(ctx context.Context, cmd *cobra.Command) ([]string, error) {
+	prs, err := wire.PRService().ListPRs(ctx, primary.PRFilters{CommissionID: completionCommission(cmd)})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, pr := range prs {
+		if pr.Status == primary.PRStatusMerged || pr.Status == primary.PRStatusClosed {
+			continue
+		}
+		out = append(out, completionItem(pr.ID, pr.Title))
+	}
+	return out, nil
+}
+
+func completeRepos(ctx context.Context, _ *cobra.Command) ([]string, error) {
+	repos, err := wire.RepoService().ListRepos(ctx, primary.RepoFilters{Status: primary.RepoStatusActive})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, r := range repos {
+		out = append(out, completionItem(r.ID, r.Name))
+	}
+	return out, nil
+}
+
+func completeFactories(ctx context.Context, _ *cobra.Command) ([]string, error) {
+	factories, err := wire.FactoryService().ListFactories(ctx, primary.FactoryFilters{})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, f := range factories {
+		out = append(out, completionItem(f.ID, f.Name))
+	}
+	return out, nil
+}
+
+func completeWorkshops(ctx context.Context, cmd *cobra.Command) ([]string, error) {
+	workshops, err := wire.WorkshopService().ListWorkshops(ctx, primary.WorkshopFilters{FactoryID: completionFlag(cmd, "factory")})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, w := range workshops {
+		out = append(out, completionItem(w.ID, w.Name))
+	}
+	return out, nil
+}
+
+func completeWorkbenches(ctx context.Context, cmd *cobra.Command) ([]string, error) {
+	workbenches, err := wire.WorkbenchService().ListWorkbenches(ctx, primary.WorkbenchFilters{
+		WorkshopID: completionFlag(cmd, "workshop"),
+		Status:     "active",
+	})
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, wb := range workbenches {
+		out = append(out, completionItem(wb.ID, wb.Name))
+	}
+	return out, nil
+}
+
+func completeTags(ctx context.Context, _ *cobra.Command) ([]string, error) {
+	tags, err := wire.TagService().ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var out []string
+	for _, t := range tags {
+		out = append(out, completionItem(t.Name, t.Description))
+	}
+	return out, nil
+}
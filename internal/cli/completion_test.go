@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestRegisterDynamicCompletions(t *testing.T) {
+	noop := func(cmd *cobra.Command, args []string) error { return nil }
+
+	root := &cobra.Command{Use: "orc"}
+	task := &cobra.Command{Use: "task"}
+	show := &cobra.Command{Use: "show [task-id]", RunE: noop}
+	show.Flags().String("commission", "", "")
+	show.Flags().String("title", "", "")
+	create := &cobra.Command{Use: "create [title]", RunE: noop}
+	custom := &cobra.Command{Use: "custom [task-id]", RunE: noop, ValidArgs: []string{"a"}}
+	task.AddCommand(show, create, custom)
+	root.AddCommand(task)
+
+	RegisterDynamicCompletions(root)
+
+	if show.ValidArgsFunction == nil {
+		t.Error("expected [task-id] arg to get dynamic completion")
+	}
+	if create.ValidArgsFunction != nil {
+		t.Error("expected [title] arg to be left without completion")
+	}
+	if custom.ValidArgsFunction != nil {
+		t.Error("expected command with static ValidArgs to be left alone")
+	}
+	if _, ok := show.GetFlagCompletionFunc("commission"); !ok {
+		t.Error("expected --commission flag to get dynamic completion")
+	}
+	if _, ok := show.GetFlagCompletionFunc("title"); ok {
+		t.Error("expected --title flag to be left without completion")
+	}
+}
+
+func TestArgPlaceholder(t *testing.T) {
+	tests := []struct {
+		use  string
+		want []string
+	}{
+		{"show [task-id]", []string{"task"}},
+		{"assign [shipment-id] [workbench-id]", []string{"shipment", "workbench"}},
+		{"tag [task-id] [tag-name]", []string{"task", "tag"}},
+		{"create [title]", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.use, func(t *testing.T) {
+			var got []string
+			for _, m := range argPlaceholder.FindAllStringSubmatch(tt.use, -1) {
+				got = append(got, m[1])
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("got %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCompletionItem(t *testing.T) {
+	if got := completionItem("TASK-001", "Fix\nlogin   bug"); got != "TASK-001\tFix login bug" {
+		t.Errorf("got %q", got)
+	}
+	if got := completionItem("TASK-001", ""); got != "TASK-001" {
+		t.Errorf("got %q", got)
+	}
+}
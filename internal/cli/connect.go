@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strings"
 
 	"github.com/spf13/cobra"
+
+	orccontext "github.com/example/orc/internal/context"
 )
 
 // ConnectCmd returns the connect command
@@ -64,9 +67,20 @@ func runConnect(cmd *cobra.Command, args []string) error {
 	// Set working directory to current directory
 	claudeCmd.Dir = cwd
 
+	// Inject the workbench's env, resolved fresh so respawns pick up changes
+	var workbenchEnv []string
+	if workbenchID := orccontext.GetContextWorkbenchID(); workbenchID != "" {
+		workbenchEnv = resolveWorkbenchEnv(workbenchID)
+		claudeCmd.Env = append(os.Environ(), workbenchEnv...)
+	}
+
 	if dryRun {
 		fmt.Printf("Would execute: claude %q\n", primeDirective)
 		fmt.Printf("Working directory: %s\n", claudeCmd.Dir)
+		for _, kv := range workbenchEnv {
+			name, _, _ := strings.Cut(kv, "=")
+			fmt.Printf("Env: %s\n", name)
+		}
 		return nil
 	}
 
@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/config"
+)
+
+// defaultContentWarnLines is the soft budget when ORC_CONTENT_WARN_LINES is unset.
+const defaultContentWarnLines = 1000
+
+// pageAboveLines is how long show output gets before it goes through the pager.
+const pageAboveLines = 60
+
+// addContentFlags registers the content selection flags shared by the show commands.
+func addContentFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("head", 0, "Show only the first N lines of the content")
+	cmd.Flags().String("section", "", "Show only the markdown section under this heading")
+	cmd.Flags().Bool("no-pager", false, "Print directly instead of through $PAGER")
+}
+
+// selectContentFromFlags applies --section, then --head, to content.
+func selectContentFromFlags(cmd *cobra.Command, content string) (string, error) {
+	section, _ := cmd.Flags().GetString("section")
+	head, _ := cmd.Flags().GetInt("head")
+	if section != "" {
+		var err error
+		if content, err = markdownSection(content, section); err != nil {
+			return "", err
+		}
+	}
+	return headLines(content, head), nil
+}
+
+// markdownHeading is a heading line in markdown content.
+type markdownHeading struct {
+	level int
+	text  string
+	line  int
+}
+
+// markdownHeadings lists the headings in content, skipping fenced code blocks.
+func markdownHeadings(content string) []markdownHeading {
+	var headings []markdownHeading
+	inFence := false
+	for i, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence || !strings.HasPrefix(line, "#") {
+			continue
+		}
+		level := len(line) - len(strings.TrimLeft(line, "#"))
+		text := strings.TrimSpace(line[level:])
+		if level > 6 || text == "" || line[level] != ' ' {
+			continue
+		}
+		headings = append(headings, markdownHeading{level: level, text: text, line: i})
+	}
+	return headings
+}
+
+// markdownSection returns the section under the named heading, up to the next
+// heading of the same or a higher level. Names match case-insensitively, in
+// full or as a unique prefix.
+func markdownSection(content, name string) (string, error) {
+	headings := markdownHeadings(content)
+	want := strings.ToLower(strings.TrimSpace(strings.TrimLeft(name, "# ")))
+
+	match := -1
+	var prefixed []int
+	for i, h := range headings {
+		text := strings.ToLower(h.text)
+		if text == want {
+			match = i
+			break
+		}
+		if strings.HasPrefix(text, want) {
+			prefixed = append(prefixed, i)
+		}
+	}
+	if match < 0 && len(prefixed) == 1 {
+		match = prefixed[0]
+	}
+	if match < 0 {
+		var names []string
+		for _, h := range headings {
+			names = append(names, h.text)
+		}
+		if len(names) == 0 {
+			return "", fmt.Errorf("no section %q: the content has no headings", name)
+		}
+		if len(prefixed) > 1 {
+			return "", fmt.Errorf("section %q is ambiguous (headings: %s)", name, strings.Join(names, ", "))
+		}
+		return "", fmt.Errorf("no section %q (headings: %s)", name, strings.Join(names, ", "))
+	}
+
+	lines := strings.Split(content, "\n")
+	end := len(lines)
+	for _, h := range headings[match+1:] {
+		if h.level <= headings[match].level {
+			end = h.line
+			break
+		}
+	}
+	return strings.TrimRight(strings.Join(lines[headings[match].line:end], "\n"), "\n"), nil
+}
+
+// headLines keeps the first n lines of content, noting how many were cut.
+// n <= 0 keeps everything.
+func headLines(content string, n int) string {
+	lines := strings.Split(content, "\n")
+	if n <= 0 || len(lines) <= n {
+		return content
+	}
+	return strings.Join(lines[:n], "\n") + fmt.Sprintf("\n… %s not shown", pluralize(len(lines)-n, "more line", "more lines"))
+}
+
+// checkContentBudget checks content against ORC_CONTENT_WARN_LINES and
+// ORC_CONTENT_MAX_LINES, returning a warning over the soft budget and an
+// error over the hard one.
+func checkContentBudget(content string) (string, error) {
+	if content == "" {
+		return "", nil
+	}
+	warnAt, err := contentBudgetEnv(config.EnvContentWarnLines, defaultContentWarnLines)
+	if err != nil {
+		return "", err
+	}
+	maxLines, err := contentBudgetEnv(config.EnvContentMaxLines, 0)
+	if err != nil {
+		return "", err
+	}
+
+	lines := strings.Count(content, "\n") + 1
+	if maxLines > 0 && lines > maxLines {
+		return "", fmt.Errorf("content is %d lines, over the %d-line limit (%s)\nHint: split it up, or raise the limit", lines, maxLines, config.EnvContentMaxLines)
+	}
+	if warnAt > 0 && lines > warnAt {
+		return fmt.Sprintf("⚠ Content is %d lines, over the %d-line budget (%s)\n  Consider splitting it; readers can narrow 'show' with --section or --head", lines, warnAt, config.EnvContentWarnLines), nil
+	}
+	return "", nil
+}
+
+// contentBudgetEnv reads a line budget from env; zero disables it.
+func contentBudgetEnv(name string, fallback int) (int, error) {
+	env := os.Getenv(name)
+	if env == "" {
+		return fallback, nil
+	}
+	n, err := strconv.Atoi(env)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid %s %q: want a number of lines", name, env)
+	}
+	return n, nil
+}
+
+// printOrPage prints show output, going through the pager when it is long
+// and stdout is a terminal.
+func printOrPage(cmd *cobra.Command, out string) error {
+	noPager, _ := cmd.Flags().GetBool("no-pager")
+	if noPager || strings.Count(out, "\n") <= pageAboveLines || !isTerminal(os.Stdout) {
+		fmt.Print(out)
+		return nil
+	}
+	return page(out)
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/config"
+)
+
+const sampleContent = `# Plan
+
+Intro.
+
+## Rollout
+
+1. Ship it
+
+### Canary
+
+Watch the graphs.
+
+` + "```" + `
+# not a heading
+` + "```" + `
+
+## Rollback
+
+Revert.`
+
+func TestMarkdownSection(t *testing.T) {
+	got, err := markdownSection(sampleContent, "rollout")
+	if err != nil {
+		t.Fatalf("markdownSection() error = %v", err)
+	}
+	if !strings.HasPrefix(got, "## Rollout") || !strings.Contains(got, "Watch the graphs.") || strings.Contains(got, "Revert.") {
+		t.Errorf("section should run to the next heading of its level, got:\n%s", got)
+	}
+
+	got, err = markdownSection(sampleContent, "## Can")
+	if err != nil {
+		t.Fatalf("markdownSection() by prefix error = %v", err)
+	}
+	if !strings.Contains(got, "# not a heading") || strings.Contains(got, "Revert.") {
+		t.Errorf("fenced lines are not headings, got:\n%s", got)
+	}
+
+	if _, err := markdownSection(sampleContent, "Roll"); err == nil || !strings.Contains(err.Error(), "ambiguous") {
+		t.Errorf("expected ambiguous prefix error, got %v", err)
+	}
+	if _, err := markdownSection(sampleContent, "Testing"); err == nil || !strings.Contains(err.Error(), "Rollout, Canary, Rollback") {
+		t.Errorf("expected error listing headings, got %v", err)
+	}
+}
+
+func TestHeadLines(t *testing.T) {
+	if got := headLines("a\nb\nc\nd", 2); got != "a\nb\n… 2 more lines not shown" {
+		t.Errorf("headLines() = %q", got)
+	}
+	if got := headLines("a\nb", 2); got != "a\nb" {
+		t.Errorf("headLines() within limit = %q", got)
+	}
+	if got := headLines("a\nb", 0); got != "a\nb" {
+		t.Errorf("headLines(0) = %q", got)
+	}
+}
+
+func TestCheckContentBudget(t *testing.T) {
+	content := strings.Repeat("line\n", 9) + "line"
+
+	t.Setenv(config.EnvContentWarnLines, "")
+	t.Setenv(config.EnvContentMaxLines, "")
+	if warning, err := checkContentBudget(content); warning != "" || err != nil {
+		t.Errorf("default budget: warning = %q, err = %v", warning, err)
+	}
+
+	t.Setenv(config.EnvContentWarnLines, "5")
+	if warning, err := checkContentBudget(content); !strings.Contains(warning, "10 lines, over the 5-line budget") || err != nil {
+		t.Errorf("soft budget: warning = %q, err = %v", warning, err)
+	}
+
+	t.Setenv(config.EnvContentMaxLines, "8")
+	if _, err := checkContentBudget(content); err == nil || !strings.Contains(err.Error(), "over the 8-line limit") {
+		t.Errorf("hard budget: err = %v", err)
+	}
+
+	t.Setenv(config.EnvContentMaxLines, "lots")
+	if _, err := checkContentBudget(content); err == nil {
+		t.Error("expected error for an invalid budget")
+	}
+}
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/config"
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/wire"
+)
+
+// ContextCmd returns the context command
+func ContextCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "context",
+		Short: "Show or override the detected ORC context",
+		Long: `Show which workbench, workshop, factory, and commission ORC resolves for the
+current directory, and where each value comes from.
+
+Context is read from the nearest .orc/config.json (in this directory or a
+parent). Environment variables take precedence, for scripting:
+
+  ORC_WORKBENCH   Workbench ID (BENCH-xxx)
+  ORC_COMMISSION  Commission ID (COMM-xxx)
+  ORC_FACTORY     Factory ID (FACT-xxx)
+
+Examples:
+  orc context show
+  orc context set --commission COMM-002
+  ORC_COMMISSION=COMM-003 orc shipment list`,
+	}
+	cmd.AddCommand(contextShowCmd())
+	cmd.AddCommand(contextSetCmd())
+	return cmd
+}
+
+func contextShowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Show the resolved context and the source of each value",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			asJSON, _ := cmd.Flags().GetBool("json")
+			resolved := orccontext.Resolve()
+
+			if asJSON {
+				enc := json.NewEncoder(os.Stdout)
+				enc.SetIndent("", "  ")
+				return enc.Encode(resolved)
+			}
+
+			fmt.Printf("Directory: %s\n", resolved.Dir)
+			if resolved.ConfigPath != "" {
+				fmt.Printf("Config:    %s\n", resolved.ConfigPath)
+			} else {
+				fmt.Printf("Config:    (none found in this directory or its parents)\n")
+			}
+			fmt.Println()
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			for _, row := range []struct {
+				label string
+				value orccontext.Value
+			}{
+				{"Workbench", resolved.Workbench},
+				{"Workshop", resolved.Workshop},
+				{"Factory", resolved.Factory},
+				{"Commission", resolved.Commission},
+			} {
+				if row.value.ID == "" {
+					fmt.Fprintf(w, "%s:\t-\t\n", row.label)
+					continue
+				}
+				fmt.Fprintf(w, "%s:\t%s\t(%s)\n", row.label, row.value.ID, row.value.Source)
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().Bool("json", false, "Output as JSON")
+	return cmd
+}
+
+func contextSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set",
+		Short: "Set an explicit context override",
+		Long: `Record an explicit commission in the nearest .orc/config.json (or create one
+in the current directory). The override wins over the commission derived from
+the workbench's focus; ORC_COMMISSION still wins over both.
+
+Examples:
+  orc context set --commission COMM-002
+  orc context set --clear`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			commissionID, _ := cmd.Flags().GetString("commission")
+			clearFlag, _ := cmd.Flags().GetBool("clear")
+
+			if commissionID == "" && !clearFlag {
+				return fmt.Errorf("must specify --commission or --clear")
+			}
+			if commissionID != "" && clearFlag {
+				return fmt.Errorf("cannot use --commission with --clear")
+			}
+
+			if commissionID != "" {
+				if _, err := wire.CommissionService().GetCommission(NewContext(), commissionID); err != nil {
+					return fmt.Errorf("failed to set context: %w", err)
+				}
+			}
+
+			path, err := orccontext.SetCommissionOverride(commissionID)
+			if err != nil {
+				return fmt.Errorf("failed to save context: %w", err)
+			}
+
+			if clearFlag {
+				fmt.Printf("✓ Commission override cleared in %s\n", path)
+			} else {
+				fmt.Printf("✓ Commission set to %s in %s\n", commissionID, path)
+			}
+			if env := os.Getenv(config.EnvCommission); env != "" {
+				fmt.Printf("  Note: $%s=%s takes precedence in this shell\n", config.EnvCommission, env)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringP("commission", "c", "", "Commission ID to use in this directory")
+	cmd.Flags().Bool("clear", false, "Remove the commission override")
+	return cmd
+}
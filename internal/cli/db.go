@@ -0,0 +1,511 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/config"
+	"github.com/example/orc/internal/db"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// DBCmd returns the db command group for database maintenance.
+func DBCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "db",
+		Short: "Database maintenance",
+		Long:  `Maintenance utilities for the ORC SQLite database (~/.orc/orc.db).`,
+	}
+
+	cmd.AddCommand(dbMaintainCmd())
+	cmd.AddCommand(dbMigrationsCmd())
+	cmd.AddCommand(dbMigrateCmd())
+	cmd.AddCommand(dbSplitCmd())
+	cmd.AddCommand(dbExternalizeNotesCmd())
+	cmd.AddCommand(dbRenamePrefixCmd())
+	return cmd
+}
+
+func dbMaintainCmd() *cobra.Command {
+	var checkOnly bool
+	var commissionID string
+
+	cmd := &cobra.Command{
+		Use:   "maintain",
+		Short: "Check integrity and compact the database",
+		Long: `Run database maintenance:
+
+1. PRAGMA integrity_check     (page-level corruption)
+2. PRAGMA foreign_key_check   (rows pointing at missing parents)
+3. ANALYZE                    (refresh query planner statistics)
+4. Vacuum                     (return free pages to the filesystem)
+
+The first run switches the database to incremental auto_vacuum with a full
+VACUUM; later runs vacuum incrementally. Vacuuming is skipped if the
+integrity check fails.
+
+Use --commission to record any findings as a note for follow-up.
+
+Examples:
+  orc db maintain
+  orc db maintain --check
+  orc db maintain --commission COMM-001`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			database, err := db.GetDB()
+			if err != nil {
+				return fmt.Errorf("failed to open database: %w", err)
+			}
+
+			report, err := db.Maintain(ctx, database, checkOnly)
+			if err != nil {
+				return fmt.Errorf("maintenance failed: %w", err)
+			}
+
+			printMaintenanceReport(report)
+
+			if report.Healthy() || commissionID == "" {
+				return nil
+			}
+
+			resp, err := wire.NoteService().CreateNote(ctx, primary.CreateNoteRequest{
+				CommissionID: commissionID,
+				Title:        "Database maintenance findings",
+				Content:      maintenanceFindings(report),
+				Type:         "concern",
+			})
+			if err != nil {
+				return fmt.Errorf("failed to record findings: %w", err)
+			}
+			fmt.Printf("\n📝 Findings recorded as %s\n", resp.NoteID)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&checkOnly, "check", false, "Only run integrity and foreign key checks")
+	cmd.Flags().StringVarP(&commissionID, "commission", "c", "", "Record findings as a note in this commission")
+	return cmd
+}
+
+// printMaintenanceReport renders the outcome of a maintenance run.
+func printMaintenanceReport(report *db.MaintenanceReport) {
+	if len(report.IntegrityErrors) == 0 {
+		fmt.Println("✓ Integrity check passed")
+	} else {
+		fmt.Printf("✗ Integrity check found %d problem(s)\n", len(report.IntegrityErrors))
+	}
+	if len(report.ForeignKeyErrors) == 0 {
+		fmt.Println("✓ Foreign key check passed")
+	} else {
+		fmt.Printf("✗ Foreign key check found %d violation(s)\n", len(report.ForeignKeyErrors))
+	}
+	if report.Analyzed {
+		fmt.Println("✓ Statistics refreshed (ANALYZE)")
+	}
+
+	switch report.VacuumMode {
+	case "full":
+		fmt.Printf("✓ Full VACUUM, switched to incremental auto_vacuum (%d KiB reclaimed)\n", report.ReclaimedBytes()/1024)
+	case "incremental":
+		fmt.Printf("✓ Incremental vacuum (%d KiB reclaimed)\n", report.ReclaimedBytes()/1024)
+	default:
+		if len(report.IntegrityErrors) > 0 {
+			fmt.Println("⚠ Vacuum skipped: fix integrity problems first")
+		}
+	}
+
+	if !report.Healthy() {
+		fmt.Println()
+		fmt.Print(maintenanceFindings(report))
+	}
+}
+
+// maintenanceFindings formats check failures as markdown.
+func maintenanceFindings(report *db.MaintenanceReport) string {
+	var b strings.Builder
+	if len(report.IntegrityErrors) > 0 {
+		b.WriteString("Integrity check:\n")
+		for _, e := range report.IntegrityErrors {
+			fmt.Fprintf(&b, "- %s\n", e)
+		}
+	}
+	if len(report.ForeignKeyErrors) > 0 {
+		b.WriteString("Foreign key check:\n")
+		for _, e := range report.ForeignKeyErrors {
+			fmt.Fprintf(&b, "- %s\n", e)
+		}
+	}
+	return b.String()
+}
+
+func dbMigrationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrations",
+		Short: "Inspect ledger schema migrations",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "status",
+		Short: "List applied and pending schema migrations",
+		Long: `Show the ledger's schema version, the upgrades applied to it, and the
+schema changes this orc would make to it.
+
+The ledger is opened read-only, so this works before an upgrade is applied.
+Upgrades are recorded from schema v12 on; older ones have no timestamps.
+
+Examples:
+  orc db migrations status`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := db.GetDBPath()
+			if err != nil {
+				return fmt.Errorf("failed to resolve database path: %w", err)
+			}
+			report, err := db.InspectMigrations(path)
+			if err != nil {
+				return err
+			}
+			printMigrationReport(os.Stdout, report)
+			return nil
+		},
+	})
+	return cmd
+}
+
+func dbMigrateCmd() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply pending schema migrations",
+		Long: `Bring the ledger's schema up to this orc's version.
+
+Any orc command migrates the ledger when it first opens it; this command
+does it explicitly and reports what changed.
+
+With --dry-run, the migration runs inside a transaction against a temporary
+copy of the ledger and is rolled back. The report lists the schema changes
+it made and whether existing rows survived; the real ledger is not touched.
+
+Examples:
+  orc db migrate --dry-run
+  orc db migrate`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := db.GetDBPath()
+			if err != nil {
+				return fmt.Errorf("failed to resolve database path: %w", err)
+			}
+
+			if dryRun {
+				report, err := db.DryRunMigrations(path)
+				if err != nil {
+					return err
+				}
+				printMigrationReport(os.Stdout, report)
+				return nil
+			}
+
+			report, err := db.InspectMigrations(path)
+			if err != nil {
+				return err
+			}
+			if report.Ledger > report.Binary {
+				return fmt.Errorf("ledger is at schema v%d, newer than this orc (v%d): run 'orc upgrade'", report.Ledger, report.Binary)
+			}
+			if report.UpToDate() {
+				fmt.Printf("✓ Ledger is up to date (schema v%d)\n", report.Ledger)
+				return nil
+			}
+
+			status, err := db.CheckSchema() // opening the ledger migrates it
+			if err != nil {
+				return fmt.Errorf("migration failed: %w", err)
+			}
+			fmt.Printf("✓ Migrated ledger schema v%d → v%d (%d change(s))\n", report.Ledger, status.Binary, len(report.Pending))
+			printSchemaChanges(os.Stdout, report.Pending)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Migrate a temporary copy of the ledger and report the changes")
+	return cmd
+}
+
+func dbSplitCmd() *cobra.Command {
+	var workPath, infraPath string
+
+	cmd := &cobra.Command{
+		Use:   "split",
+		Short: "Split the ledger into work and infra databases",
+		Long: `Copy the ledger into two new databases for two-ledger mode:
+
+  work   commissions, shipments, tasks, notes, and everything else
+  infra  factories, workshops, workbenches, workbench env, focus history,
+         and hook events
+
+Infrastructure churns far more than the work; kept apart, the work ledger
+stays small to back up and share. orc attaches the infra database to every
+connection, so commands behave the same either way. References between the
+two databases are checked by orc rather than by foreign keys, and deleting a
+workbench no longer cascades into the work ledger.
+
+Note bodies kept in blobs/ beside the ledger are copied beside the work
+ledger. The ledger itself is only read. Once the split succeeds, point orc at the
+new files with the printed exports; keep the old ledger until you are happy.
+
+Examples:
+  orc db split
+  orc db split --work ~/orc/work.db --infra ~/orc/infra.db`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if db.TwoLedger() {
+				return fmt.Errorf("already in two-ledger mode (%s is set)", config.EnvInfraDBPath)
+			}
+			path, err := db.GetDBPath()
+			if err != nil {
+				return fmt.Errorf("failed to resolve database path: %w", err)
+			}
+			if workPath == "" {
+				workPath = filepath.Join(filepath.Dir(path), "orc-work.db")
+			}
+			if infraPath == "" {
+				infraPath = filepath.Join(filepath.Dir(path), "orc-infra.db")
+			}
+
+			report, err := db.SplitLedger(NewContext(), path, workPath, infraPath)
+			if err != nil {
+				return fmt.Errorf("split failed: %w", err)
+			}
+
+			fmt.Printf("✓ Split %s\n", path)
+			for _, ledger := range []struct {
+				label, path string
+				infra       bool
+			}{
+				{"Work", report.WorkPath, false},
+				{"Infra", report.InfraPath, true},
+			} {
+				rows, tables := 0, 0
+				for _, t := range report.Tables {
+					if t.Infra == ledger.infra {
+						rows += t.Rows
+						tables++
+					}
+				}
+				fmt.Printf("  %-6s %s (%s, %s)\n", ledger.label+":", ledger.path,
+					pluralize(tables, "table", "tables"), pluralize(rows, "row", "rows"))
+			}
+			if report.Blobs > 0 {
+				fmt.Printf("  Copied %s to %s\n", pluralize(report.Blobs, "note blob", "note blobs"),
+					filepath.Join(filepath.Dir(report.WorkPath), "blobs"))
+			}
+			fmt.Println("\nSwitch to two-ledger mode with:")
+			fmt.Printf("  export ORC_DB_PATH=%s\n", report.WorkPath)
+			fmt.Printf("  export %s=%s\n", config.EnvInfraDBPath, report.InfraPath)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&workPath, "work", "", "Path for the work ledger (default: orc-work.db beside the ledger)")
+	cmd.Flags().StringVar(&infraPath, "infra", "", "Path for the infra database (default: orc-infra.db beside the ledger)")
+	return cmd
+}
+
+// printMigrationReport renders a ledger's migration status or dry run.
+func printMigrationReport(w io.Writer, r *db.MigrationReport) {
+	fmt.Fprintf(w, "Ledger: %s\n", r.Path)
+	switch {
+	case !r.Exists:
+		fmt.Fprintf(w, "Schema: no ledger yet (this orc: v%d)\n", r.Binary)
+	case r.Ledger > r.Binary:
+		fmt.Fprintf(w, "Schema: v%d, newer than this orc (v%d); run 'orc upgrade'\n", r.Ledger, r.Binary)
+	case r.UpToDate():
+		fmt.Fprintf(w, "Schema: v%d (up to date)\n", r.Ledger)
+	default:
+		fmt.Fprintf(w, "Schema: v%d, this orc: v%d\n", r.Ledger, r.Binary)
+	}
+	if r.Lock != nil {
+		fmt.Fprintf(w, "Migrating now: %s, since %s\n", r.Lock, formatTimestamp(r.Lock.AcquiredAt))
+	}
+
+	if r.Exists {
+		fmt.Fprintln(w, "\nApplied:")
+		if len(r.Applied) == 0 {
+			fmt.Fprintln(w, "  (none recorded; upgrades are recorded from schema v12)")
+		}
+		for _, m := range r.Applied {
+			from := fmt.Sprintf("from v%d", m.FromVersion)
+			if m.FromVersion == 0 {
+				from = "new or unversioned ledger"
+			}
+			fmt.Fprintf(w, "  v%-3d %s  (%s)\n", m.Version, formatTimestamp(m.AppliedAt), from)
+		}
+	}
+
+	if r.DryRun {
+		fmt.Fprintf(w, "\nDry run on a copy (%s, rolled back):\n", r.Duration.Round(time.Millisecond))
+		if len(r.Pending) == 0 {
+			fmt.Fprintln(w, "  no schema changes")
+		}
+		printSchemaChanges(w, r.Pending)
+		if r.Preserved {
+			fmt.Fprintln(w, "\n✓ Existing rows preserved")
+		} else {
+			fmt.Fprintln(w, "\n✗ Row counts changed on the copy; do not migrate before investigating")
+		}
+		return
+	}
+
+	if len(r.Pending) > 0 {
+		fmt.Fprintf(w, "\nPending (%d):\n", len(r.Pending))
+		printSchemaChanges(w, r.Pending)
+		fmt.Fprintln(w, "\nTest with 'orc db migrate --dry-run'; apply with 'orc db migrate'.")
+	}
+}
+
+// printSchemaChanges lists schema changes, one per line.
+func printSchemaChanges(w io.Writer, changes []db.SchemaChange) {
+	for _, c := range changes {
+		line := fmt.Sprintf("  + %-7s %s", c.Kind, c.Name)
+		if c.Detail != "" {
+			line += " " + c.Detail
+		}
+		fmt.Fprintln(w, line)
+	}
+}
+
+func dbExternalizeNotesCmd() *cobra.Command {
+	var minKiB int
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "externalize-notes",
+		Short: "Move large note bodies out of the ledger",
+		Long: `Move note bodies of --min-size KiB or more into content-addressed
+files under blobs/ beside the ledger, keeping only their hash in the notes
+table. Notes read the same afterwards; list queries stop carrying the
+large bodies.
+
+New notes at or above 64 KiB are stored this way already; this moves the
+ones written before. Back up blobs/ together with the ledger. Run
+'orc db maintain' afterwards to return the freed pages to the filesystem.
+
+Examples:
+  orc db externalize-notes --dry-run
+  orc db externalize-notes --min-size 16`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			moved, err := wire.NoteService().ExternalizeNoteContent(NewContext(), minKiB*1024, dryRun)
+			total := 0
+			for _, n := range moved {
+				fmt.Printf("  %s  %d KiB\n", n.ID, (n.Bytes+1023)/1024)
+				total += n.Bytes
+			}
+			if err != nil {
+				return fmt.Errorf("failed to externalize notes: %w", err)
+			}
+
+			switch {
+			case len(moved) == 0:
+				fmt.Printf("No notes of %d KiB or more stored inline\n", minKiB)
+			case dryRun:
+				fmt.Printf("\nWould move %s (%d KiB)\n", pluralize(len(moved), "note", "notes"), (total+1023)/1024)
+			default:
+				fmt.Printf("\n✓ Moved %s (%d KiB) to blobs/\n", pluralize(len(moved), "note", "notes"), (total+1023)/1024)
+				fmt.Println("  Run 'orc db maintain' to reclaim the space")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&minKiB, "min-size", 64, "Move bodies of at least this many KiB")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "List the notes that would move without moving them")
+	return cmd
+}
+
+func dbRenamePrefixCmd() *cobra.Command {
+	var tables []string
+	var apply bool
+
+	cmd := &cobra.Command{
+		Use:   "rename-prefix [old] [new]",
+		Short: "Generate the data migration for an entity ID prefix rename",
+		Long: `Generate the migration that renames entity IDs from one prefix to
+another, as when missions (MISSION-004) became commissions (COMM-004).
+
+The ledger is inspected for everything holding the old IDs:
+
+  id         the id column of each --table
+  reference  every *_id column, with or without a declared foreign key
+  actor      IMP actor IDs (IMP-BENCH-003) when workbenches are renamed
+  list       ID lists such as task dependencies
+
+Each renamed entity gets an id_renames record, so its old ID keeps working
+wherever an ID is accepted. Free text (titles, note bodies, log values) is
+left alone.
+
+The migration is printed for review; --apply runs it in one transaction,
+checking foreign keys before it commits. It is refused if another table
+still holds old-prefix IDs or if the new prefix is already in use.
+
+Examples:
+  orc db rename-prefix MISSION COMM --table commissions
+  orc db rename-prefix MISSION COMM --table commissions --apply`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if db.TwoLedger() {
+				return fmt.Errorf("rename-prefix works on a single ledger; unset %s to run it before splitting", config.EnvInfraDBPath)
+			}
+			database, err := db.GetDB()
+			if err != nil {
+				return fmt.Errorf("failed to open database: %w", err)
+			}
+			rename := db.PrefixRename{From: args[0], To: args[1], Tables: tables}
+
+			if !apply {
+				migration, err := db.GeneratePrefixRename(database, rename)
+				if err != nil {
+					return err
+				}
+				printRenameTargets(os.Stdout, migration)
+				fmt.Println()
+				fmt.Print(migration.SQL())
+				fmt.Println("\nApply with --apply.")
+				return nil
+			}
+
+			migration, err := db.ApplyPrefixRename(NewContext(), database, rename)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("✓ Renamed %s- IDs to %s-\n", rename.From, rename.To)
+			printRenameTargets(os.Stdout, migration)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&tables, "table", nil, "Table whose ids carry the old prefix (repeatable)")
+	cmd.Flags().BoolVar(&apply, "apply", false, "Run the migration against the ledger")
+	return cmd
+}
+
+// printRenameTargets lists the columns a prefix rename rewrites.
+func printRenameTargets(w io.Writer, m *db.RenameMigration) {
+	if len(m.Targets) == 0 {
+		fmt.Fprintf(w, "No %s- IDs to rename\n", m.Rename.From)
+		return
+	}
+	for _, t := range m.Targets {
+		fmt.Fprintf(w, "  %-10s %s.%s (%s)\n", t.Kind, t.Table, t.Column, pluralize(t.Rows, "row", "rows"))
+	}
+	fmt.Fprintf(w, "  %-10s id_renames (%s)\n", "aliases", pluralize(m.Aliases, "row", "rows"))
+}
@@ -22,6 +22,7 @@ func DebugCmd() *cobra.Command {
 
 	cmd.AddCommand(debugSessionInfoCmd())
 	cmd.AddCommand(debugValidateContextCmd())
+	cmd.AddCommand(debugPerfCmd())
 
 	return cmd
 }
@@ -0,0 +1,292 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/config"
+	"github.com/example/orc/internal/db"
+)
+
+// demoCommissionID is the commission the demo ledger is built around.
+const demoCommissionID = "COMM-001"
+
+// tourStep is one stop on the demo tour: what it shows and the orc command
+// that shows it.
+type tourStep struct {
+	title   string
+	explain string
+	args    []string
+}
+
+// tourSteps walk the core loop against the demo ledger: get oriented, pick
+// up planned work, do it, record what was decided, and see the result.
+var tourSteps = []tourStep{
+	{
+		title:   "Get oriented",
+		explain: "The summary is where every session starts: the commission, its shipments, and\nwhat your workbench (demo-imp, BENCH-001) is focused on.",
+		args:    []string{"summary"},
+	},
+	{
+		title:   "Look at your shipment",
+		explain: "Shipments are containers of related tasks. You are focused on SHIP-001, which\nis in progress: one task is done and two are waiting.",
+		args:    []string{"shipment", "show", "SHIP-001"},
+	},
+	{
+		title:   "Read the plan",
+		explain: "Before implementation, a task gets a plan. PLAN-001 is the draft plan for\nTASK-002, the next task in line.",
+		args:    []string{"plan", "show", "PLAN-001", "--no-pager"},
+	},
+	{
+		title:   "Approve it",
+		explain: "Plans are reviewed and approved before work starts.",
+		args:    []string{"plan", "approve", "PLAN-001"},
+	},
+	{
+		title:   "Claim the task",
+		explain: "Claiming a task starts it. Its dependency, TASK-001, is already done; tasks\nwaiting on unfinished work are skipped by 'orc task claim --next'.",
+		args:    []string{"task", "claim", "TASK-002"},
+	},
+	{
+		title:   "Finish it",
+		explain: "When the work is done, complete the task. TASK-003 depended on it and is now\nready to claim.",
+		args:    []string{"task", "complete", "TASK-002"},
+	},
+	{
+		title:   "Capture follow-up work",
+		explain: "New work found along the way becomes a task. With no --shipment given, it\nlands in the shipment you are focused on.",
+		args:    []string{"task", "create", "Highlight matched words in results", "--type", "implementation"},
+	},
+	{
+		title:   "Record a decision",
+		explain: "Notes keep what was learned and decided next to the work, so the next session\n(or the next person) doesn't have to rediscover it.",
+		args:    []string{"note", "create", "Search endpoint returns 20 results per page", "--type", "decision", "--content", "Matches the results page design; more on request."},
+	},
+	{
+		title:   "See where things stand",
+		explain: "Back to the summary: the shipment has moved on, and the new task and decision\nare in place.",
+		args:    []string{"summary"},
+	},
+}
+
+// DemoCmd returns the demo command group.
+func DemoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "demo",
+		Short: "Try ORC on a self-contained demo ledger",
+		Long: `Try ORC on sample data without touching your own ledger.
+
+'orc demo init' creates a demo ledger with a commission, shipments, tasks,
+a plan, notes, and two workbenches that exist only in the ledger (no
+worktrees or tmux sessions), plus a directory beside it for the demo
+workbench. 'orc demo tour' then walks through the core loop, running real
+orc commands against it.
+
+Examples:
+  orc demo init
+  orc demo tour
+  cd ~/.orc/demo/demo-imp && ORC_DB_PATH=~/.orc/demo/orc.db orc summary`,
+	}
+
+	cmd.AddCommand(demoInitCmd())
+	cmd.AddCommand(demoTourCmd())
+	return cmd
+}
+
+// demoBenchDir is the demo workbench's directory beside the demo ledger. It
+// holds only the workbench's .orc/config.json, so commands run there see the
+// workbench and its focus the way they would in a real worktree.
+func demoBenchDir(path string) string {
+	return filepath.Join(filepath.Dir(path), "demo-imp")
+}
+
+// writeDemoBench creates the demo workbench's directory and config.
+func writeDemoBench(path string) error {
+	return config.SaveConfig(demoBenchDir(path), &config.Config{Version: "1.0", PlaceID: db.DemoWorkbenchID})
+}
+
+// defaultDemoPath is where the demo ledger lives unless --path says otherwise.
+func defaultDemoPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".orc", "demo", "orc.db"), nil
+}
+
+// demoPath resolves --path, defaulting to ~/.orc/demo/orc.db.
+func demoPath(path string) (string, error) {
+	if path == "" {
+		return defaultDemoPath()
+	}
+	return filepath.Abs(path)
+}
+
+func demoInitCmd() *cobra.Command {
+	var (
+		path  string
+		force bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "init",
+		Short: "Create the demo ledger",
+		Long: `Create a demo ledger with sample data at ~/.orc/demo/orc.db (or --path).
+
+The demo ledger is separate from your own; nothing here reads or writes it.
+Use --force to start over with a fresh copy.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := demoPath(path)
+			if err != nil {
+				return err
+			}
+			if live, err := db.GetDBPath(); err == nil && live == path {
+				return fmt.Errorf("%s is the ledger orc is using; choose another --path", path)
+			}
+
+			if force {
+				if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+					return fmt.Errorf("failed to remove old demo ledger: %w", err)
+				}
+			} else if _, err := os.Stat(path); err == nil {
+				return fmt.Errorf("demo ledger already exists at %s (use --force to recreate it)", path)
+			}
+
+			if err := db.CreateDemoLedger(path); err != nil {
+				return fmt.Errorf("failed to create demo ledger: %w", err)
+			}
+			if err := writeDemoBench(path); err != nil {
+				return fmt.Errorf("failed to create demo workbench: %w", err)
+			}
+
+			fmt.Printf("✓ Created demo ledger at %s\n", path)
+			fmt.Println()
+			fmt.Println("Take the guided tour:")
+			fmt.Println("  orc demo tour")
+			fmt.Println()
+			fmt.Println("Or explore on your own:")
+			fmt.Printf("  cd %s && export ORC_DB_PATH=%s\n", demoBenchDir(path), path)
+			fmt.Println("  orc summary")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&path, "path", "", "Where to create the demo ledger (default ~/.orc/demo/orc.db)")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Replace an existing demo ledger")
+	return cmd
+}
+
+func demoTourCmd() *cobra.Command {
+	var (
+		path string
+		yes  bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "tour",
+		Short: "Walk through the core loop on the demo ledger",
+		Long: `Walk through the core ORC loop on the demo ledger, step by step.
+
+Each step explains what it shows and runs the real orc command against the
+demo ledger, from the demo workbench's directory. The tour pauses between
+steps; --yes runs straight through. The tour changes the demo data, so run
+'orc demo init --force' to take it again from the start.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, err := demoPath(path)
+			if err != nil {
+				return err
+			}
+			if _, err := os.Stat(path); err != nil {
+				return fmt.Errorf("no demo ledger at %s: run 'orc demo init' first", path)
+			}
+			if err := writeDemoBench(path); err != nil {
+				return fmt.Errorf("failed to create demo workbench: %w", err)
+			}
+			orc, err := os.Executable()
+			if err != nil {
+				return fmt.Errorf("failed to find the orc binary: %w", err)
+			}
+
+			pause := !yes && isTerminal(os.Stdin)
+			in := bufio.NewReader(os.Stdin)
+			env := demoEnv(os.Environ(), path)
+			for i, step := range tourSteps {
+				fmt.Printf("\n── Step %d of %d: %s ──\n\n", i+1, len(tourSteps), step.title)
+				fmt.Println(step.explain)
+				fmt.Printf("\n  $ orc %s\n\n", shellJoin(step.args))
+
+				run := exec.Command(orc, step.args...)
+				run.Env = env
+				run.Dir = demoBenchDir(path)
+				run.Stdout = os.Stdout
+				run.Stderr = os.Stderr
+				if err := run.Run(); err != nil {
+					return fmt.Errorf("step %d (orc %s) failed: %w; 'orc demo init --force' resets the demo", i+1, step.args[0], err)
+				}
+
+				if pause && i < len(tourSteps)-1 {
+					fmt.Print("\nPress Enter to continue (q to quit) ")
+					if answer, err := in.ReadString('\n'); err == io.EOF || strings.TrimSpace(answer) == "q" {
+						fmt.Println()
+						return nil
+					}
+				}
+			}
+
+			fmt.Println("\nThat's the core loop. Keep exploring the demo with:")
+			fmt.Printf("  cd %s && export ORC_DB_PATH=%s\n", demoBenchDir(path), path)
+			fmt.Println("When you're ready for your own work, start with 'orc init'.")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&path, "path", "", "Demo ledger to tour (default ~/.orc/demo/orc.db)")
+	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Run every step without pausing")
+	return cmd
+}
+
+// demoEnv returns environ pointed at the demo ledger, as the demo workbench,
+// with anything that would reach the user's own ledger removed.
+func demoEnv(environ []string, path string) []string {
+	overrides := map[string]string{
+		"ORC_DB_PATH":         path,
+		config.EnvWorkbench:   db.DemoWorkbenchID,
+		config.EnvCommission:  demoCommissionID,
+		config.EnvInfraDBPath: "",
+	}
+	env := make([]string, 0, len(environ)+len(overrides))
+	for _, kv := range environ {
+		name, _, _ := strings.Cut(kv, "=")
+		if _, ok := overrides[name]; !ok {
+			env = append(env, kv)
+		}
+	}
+	for name, value := range overrides {
+		if value != "" {
+			env = append(env, name+"="+value)
+		}
+	}
+	return env
+}
+
+// shellJoin renders args as a command line, quoting those with spaces.
+func shellJoin(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if strings.ContainsAny(arg, " ;'") {
+			quoted[i] = "\"" + arg + "\""
+		} else {
+			quoted[i] = arg
+		}
+	}
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestDemoEnv(t *testing.T) {
+	environ := []string{
+		"HOME=/home/el",
+		"ORC_DB_PATH=/home/el/.orc/orc.db",
+		"ORC_INFRA_DB_PATH=/home/el/.orc/infra.db",
+		"ORC_COMMISSION=COMM-042",
+	}
+	env := demoEnv(environ, "/home/el/.orc/demo/orc.db")
+
+	got := make(map[string]string)
+	for _, kv := range env {
+		name, value, _ := strings.Cut(kv, "=")
+		if _, dup := got[name]; dup {
+			t.Errorf("%s set twice", name)
+		}
+		got[name] = value
+	}
+	want := map[string]string{
+		"HOME":           "/home/el",
+		"ORC_DB_PATH":    "/home/el/.orc/demo/orc.db",
+		"ORC_WORKBENCH":  "BENCH-001",
+		"ORC_COMMISSION": "COMM-001",
+	}
+	if len(got) != len(want) {
+		t.Errorf("demoEnv() = %v, want %v", got, want)
+	}
+	for name, value := range want {
+		if got[name] != value {
+			t.Errorf("%s = %q, want %q", name, got[name], value)
+		}
+	}
+}
+
+func TestTourSteps(t *testing.T) {
+	// Every step must name a real command
+	root := &cobra.Command{Use: "orc"}
+	root.AddCommand(SummaryCmd(), ShipmentCmd(), TaskCmd(), PlanCmd(), NoteCmd())
+	for i, step := range tourSteps {
+		if step.title == "" || step.explain == "" || len(step.args) == 0 {
+			t.Errorf("step %d is incomplete: %+v", i+1, step)
+			continue
+		}
+		cmd, _, err := root.Find(step.args)
+		if err != nil || cmd == root {
+			t.Errorf("step %d: orc %s is not a command", i+1, strings.Join(step.args, " "))
+		}
+	}
+}
@@ -0,0 +1,260 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// detailView is the layout shared by the show commands: the entity's own
+// fields, then panels for related entities, checklists, commits, comments,
+// recent events, and suggested next actions. Empty fields and panels are
+// left out, so every entity renders the same way whatever it has.
+type detailView struct {
+	fields []detailField
+	panels []detailPanel
+}
+
+type detailField struct {
+	label string
+	value string
+}
+
+type detailPanel struct {
+	title string
+	lines []string
+}
+
+// newDetailView starts a view whose first field names the entity ("Task: TASK-001").
+func newDetailView(kind, id string) *detailView {
+	v := &detailView{}
+	v.field(kind, id)
+	return v
+}
+
+// field adds a core field, skipping empty values.
+func (v *detailView) field(label, value string) {
+	if value != "" {
+		v.fields = append(v.fields, detailField{label, value})
+	}
+}
+
+// panel adds a titled panel, skipping panels without lines.
+func (v *detailView) panel(p detailPanel) {
+	if len(p.lines) > 0 {
+		v.panels = append(v.panels, p)
+	}
+}
+
+// render writes the fields aligned on their labels, then each panel.
+func (v *detailView) render(w io.Writer) {
+	for _, line := range alignFields(v.fields) {
+		fmt.Fprintln(w, line)
+	}
+	for _, p := range v.panels {
+		renderPanel(w, p)
+	}
+}
+
+func renderPanel(w io.Writer, p detailPanel) {
+	fmt.Fprintf(w, "\n%s:\n", p.title)
+	for _, line := range p.lines {
+		fmt.Fprintf(w, "  %s\n", line)
+	}
+}
+
+// printPanel renders a panel on its own, for show commands not yet built on detailView.
+func printPanel(p detailPanel) {
+	if len(p.lines) > 0 {
+		renderPanel(os.Stdout, p)
+	}
+}
+
+// alignFields renders fields as "Label: value" with the values lined up.
+func alignFields(fields []detailField) []string {
+	width := 0
+	for _, f := range fields {
+		width = max(width, utf8.RuneCountInString(f.label))
+	}
+	lines := make([]string, len(fields))
+	for i, f := range fields {
+		lines[i] = fmt.Sprintf("%s:%s %s", f.label, strings.Repeat(" ", width-utf8.RuneCountInString(f.label)), f.value)
+	}
+	return lines
+}
+
+// detailRelations is what a show view displays besides the entity itself.
+type detailRelations struct {
+	describe func(id string) string // "SHIP-001 Title [status]"; the bare ID when unknown
+	tag      string
+	plans    []*primary.Plan
+	pr       *primary.PR
+	commits  []*primary.CommitLink
+	comments []*primary.Comment
+	events   []*primary.LogEntry       // Newest first
+	refs     []*primary.LedgerRef      // Links to other ledgers
+	presence []*primary.EntityPresence // Other actors recently on the entity
+}
+
+// relatedPanel lists the related entities given as label/ID pairs, each
+// described by rel.describe. Entries with an empty ID are skipped.
+func (rel detailRelations) relatedPanel(entries ...detailField) detailPanel {
+	var fields []detailField
+	for _, e := range entries {
+		if e.value != "" {
+			fields = append(fields, detailField{e.label, rel.describe(e.value)})
+		}
+	}
+	for _, ref := range rel.refs {
+		fields = append(fields, detailField{"Linked", describeLedgerRef(ref)})
+	}
+	if rel.tag != "" {
+		fields = append(fields, detailField{"Tag", rel.tag})
+	}
+	return detailPanel{title: "Related", lines: alignFields(fields)}
+}
+
+// planEntries returns the Related entries for attached plans.
+func (rel detailRelations) planEntries() []detailField {
+	entries := make([]detailField, len(rel.plans))
+	for i, p := range rel.plans {
+		entries[i] = detailField{"Plan", p.ID}
+	}
+	return entries
+}
+
+// prEntry returns the Related entry for the shipment's pull request.
+func (rel detailRelations) prEntry() detailField {
+	if rel.pr == nil {
+		return detailField{}
+	}
+	return detailField{"PR", rel.pr.ID}
+}
+
+func commentsPanel(comments []*primary.Comment) detailPanel {
+	return detailPanel{
+		title: fmt.Sprintf("Comments (%d)", len(comments)),
+		lines: commentLines(comments, ""),
+	}
+}
+
+// eventsPanel lists an entity's most recent workshop log entries, newest first.
+func eventsPanel(events []*primary.LogEntry) detailPanel {
+	p := detailPanel{title: "Recent events"}
+	for _, e := range events {
+		p.lines = append(p.lines, fmt.Sprintf("%s  %-10s %s", formatTimestamp(e.Timestamp), orDash(e.ActorID), describeLogChange(e)))
+	}
+	return p
+}
+
+// describeLogChange summarizes a log entry: "created", "status: open → in-progress".
+func describeLogChange(e *primary.LogEntry) string {
+	var s string
+	switch {
+	case e.Action == "create":
+		s = "created"
+	case e.Action == "delete":
+		s = "deleted"
+	case e.FieldName != "":
+		s = fmt.Sprintf("%s: %s → %s", e.FieldName, orDash(e.OldValue), orDash(e.NewValue))
+	default:
+		s = e.Action
+	}
+	if e.Forced {
+		s += " [forced]"
+	}
+	return s
+}
+
+// nextPanel lists suggested commands.
+func nextPanel(commands ...string) detailPanel {
+	return detailPanel{title: "Next", lines: commands}
+}
+
+// detailEventLimit is how many recent events a show view includes.
+const detailEventLimit = 5
+
+// loadRelations gathers the comments, commits, tag, recent events, and
+// other actors' presence shown for any entity. Failures leave the affected
+// panel empty rather than failing the show command.
+func loadRelations(ctx context.Context, entityID, entityType string) detailRelations {
+	rel := detailRelations{describe: func(id string) string { return describeEntity(ctx, id) }}
+	rel.comments, _ = wire.CommentService().ListComments(ctx, entityID)
+	rel.commits, _ = wire.CommitLinkService().GetEntityCommits(ctx, entityID)
+	if tag, err := wire.TagService().GetEntityTag(ctx, entityID, entityType); err == nil && tag != nil {
+		rel.tag = tag.Name
+	}
+	rel.events, _ = wire.LogService().ListLogs(ctx, primary.LogFilters{EntityID: entityID, Limit: detailEventLimit})
+	rel.refs, _ = wire.LedgerService().ListRefs(ctx, entityID)
+	rel.presence = loadPresence(ctx, entityID)[entityID]
+	return rel
+}
+
+// describeEntity renders a related entity as "ID title [status]", falling
+// back to the bare ID when it cannot be loaded.
+func describeEntity(ctx context.Context, id string) string {
+	if wire.IsLedgerRef(id) {
+		if e, err := wire.LedgerService().Resolve(ctx, id); err == nil {
+			return entityLine(id, e.Title, e.Status)
+		}
+		return id
+	}
+	prefix, _, _ := strings.Cut(id, "-")
+	switch prefix {
+	case "COMM":
+		if c, err := wire.CommissionService().GetCommission(ctx, id); err == nil {
+			return entityLine(id, c.Title, c.Status)
+		}
+	case "SHIP":
+		if s, err := wire.ShipmentService().GetShipment(ctx, id); err == nil {
+			return entityLine(id, s.Title, s.Status)
+		}
+	case "TASK":
+		if t, err := wire.TaskService().GetTask(ctx, id); err == nil {
+			return entityLine(id, t.Title, t.Status)
+		}
+	case "TOME":
+		if t, err := wire.TomeService().GetTome(ctx, id); err == nil {
+			return entityLine(id, t.Title, t.Status)
+		}
+	case "NOTE":
+		if n, err := wire.NoteService().GetNote(ctx, id); err == nil {
+			return entityLine(id, n.Title, n.Status)
+		}
+	case "PLAN":
+		if p, err := wire.PlanService().GetPlan(ctx, id); err == nil {
+			return entityLine(id, p.Title, p.Status)
+		}
+	case "PR":
+		if p, err := wire.PRService().GetPR(ctx, id); err == nil {
+			return entityLine(id, fmt.Sprintf("#%d %s", p.Number, p.Title), p.Status)
+		}
+	case "BENCH":
+		if wb, err := wire.WorkbenchService().GetWorkbench(ctx, id); err == nil {
+			return entityLine(id, wb.Name, "")
+		}
+	case "REPO":
+		if r, err := wire.RepoService().GetRepo(ctx, id); err == nil {
+			return entityLine(id, r.Name, "")
+		}
+	}
+	return id
+}
+
+// entityLine formats "ID title [status]", leaving out empty parts.
+func entityLine(id, title, status string) string {
+	line := id
+	if title != "" {
+		line += " " + title
+	}
+	if status != "" {
+		line += " [" + status + "]"
+	}
+	return line
+}
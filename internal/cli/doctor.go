@@ -12,7 +12,9 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/version"
+	"github.com/example/orc/internal/wire"
 )
 
 // CheckResult represents the outcome of a single check
@@ -38,6 +40,7 @@ Validates:
 - Glue deployment (skills, hooks, tmux scripts)
 - Hook configuration in Claude Code settings
 - Binary installation and PATH
+- Registered repositories (see 'orc repo check')
 
 Examples:
   orc doctor              # Run full health check
@@ -60,6 +63,7 @@ Examples:
 
 			results = append(results, checkHookConfig())
 			results = append(results, checkBinary())
+			results = append(results, checkRepos())
 
 			// Check for errors and warnings
 			for _, r := range results {
@@ -543,6 +547,53 @@ func checkBinary() CheckResult {
 	return CheckResult{Name: "🔧 Binary", Status: "✓", Details: fmt.Sprintf("  %s (%s)", orcPath, version.String())}
 }
 
+// checkRepos runs 'orc repo check' against every active repository
+func checkRepos() CheckResult {
+	ctx := NewContext()
+	repos, err := wire.RepoService().ListRepos(ctx, primary.RepoFilters{Status: primary.RepoStatusActive})
+	if err != nil {
+		return CheckResult{Name: "📦 Repos", Status: "⚠", Details: fmt.Sprintf("  Cannot list repositories: %v", err)}
+	}
+
+	var healths []*primary.RepoHealth
+	for _, r := range repos {
+		health, err := wire.RepoService().CheckRepo(ctx, r.ID)
+		if err != nil {
+			return CheckResult{Name: "📦 Repos", Status: "⚠", Details: fmt.Sprintf("  Cannot check %s: %v", r.ID, err)}
+		}
+		healths = append(healths, health)
+	}
+	return summarizeRepoHealth(healths)
+}
+
+// summarizeRepoHealth folds repository checks into one doctor result whose
+// details list each problem with its suggested fix.
+func summarizeRepoHealth(healths []*primary.RepoHealth) CheckResult {
+	result := CheckResult{Name: "📦 Repos", Status: "✓"}
+	var details []string
+	for _, h := range healths {
+		switch h.Status {
+		case primary.RepoHealthFail:
+			result.Status = "✗"
+		case primary.RepoHealthWarn:
+			if result.Status == "✓" {
+				result.Status = "⚠"
+			}
+		}
+		for _, c := range h.Checks {
+			if c.Status == primary.RepoHealthPass {
+				continue
+			}
+			details = append(details, fmt.Sprintf("  %s %s: %s", h.RepoID, c.Name, strings.ReplaceAll(c.Detail, "\n", "; ")))
+			for _, fix := range strings.Split(c.Fix, "\n") {
+				details = append(details, "    Run: "+fix)
+			}
+		}
+	}
+	result.Details = strings.Join(details, "\n")
+	return result
+}
+
 // isInOrcRepo checks if we're in the ORC repository
 func isInOrcRepo() bool {
 	data, err := os.ReadFile("go.mod")
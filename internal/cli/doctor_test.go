@@ -4,6 +4,8 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
 )
 
 // TestCompareDirs tests the compareDirs function that compares skill directories
@@ -323,6 +325,37 @@ func TestCheckResult(t *testing.T) {
 	}
 }
 
+// TestSummarizeRepoHealth tests folding repository checks into a doctor result
+func TestSummarizeRepoHealth(t *testing.T) {
+	pass := &primary.RepoHealth{RepoID: "REPO-001", Status: primary.RepoHealthPass,
+		Checks: []primary.RepoHealthCheck{{Name: "local path", Status: primary.RepoHealthPass}}}
+	warn := &primary.RepoHealth{RepoID: "REPO-002", Status: primary.RepoHealthWarn,
+		Checks: []primary.RepoHealthCheck{{Name: "worktrees", Status: primary.RepoHealthWarn,
+			Detail: "/wb/a has no workbench\n/wb/b has no workbench", Fix: "git -C /src/api worktree remove /wb/a\ngit -C /src/api worktree remove /wb/b"}}}
+	fail := &primary.RepoHealth{RepoID: "REPO-003", Status: primary.RepoHealthFail,
+		Checks: []primary.RepoHealthCheck{{Name: "gh auth", Status: primary.RepoHealthFail, Detail: "not logged in", Fix: "gh auth login --hostname github.com"}}}
+
+	if got := summarizeRepoHealth(nil); got.Status != "✓" || got.Details != "" {
+		t.Errorf("no repos: got %+v", got)
+	}
+	if got := summarizeRepoHealth([]*primary.RepoHealth{pass, warn}); got.Status != "⚠" {
+		t.Errorf("Status = %q, want ⚠", got.Status)
+	}
+
+	got := summarizeRepoHealth([]*primary.RepoHealth{warn, fail, pass})
+	if got.Status != "✗" {
+		t.Errorf("Status = %q, want ✗", got.Status)
+	}
+	want := `  REPO-002 worktrees: /wb/a has no workbench; /wb/b has no workbench
+    Run: git -C /src/api worktree remove /wb/a
+    Run: git -C /src/api worktree remove /wb/b
+  REPO-003 gh auth: not logged in
+    Run: gh auth login --hostname github.com`
+	if got.Details != want {
+		t.Errorf("Details =\n%s\nwant\n%s", got.Details, want)
+	}
+}
+
 // stringSliceEqual compares two string slices
 func stringSliceEqual(a, b []string) bool {
 	if len(a) != len(b) {
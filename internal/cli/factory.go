@@ -23,6 +23,7 @@ func FactoryCmd() *cobra.Command {
 	cmd.AddCommand(factoryListCmd())
 	cmd.AddCommand(factoryShowCmd())
 	cmd.AddCommand(factoryDeleteCmd())
+	cmd.AddCommand(factoryTeardownCmd())
 
 	return cmd
 }
@@ -90,7 +91,7 @@ func factoryListCmd() *cobra.Command {
 					f.ID,
 					f.Name,
 					f.Status,
-					f.CreatedAt,
+					formatWhen(f.CreatedAt),
 				)
 			}
 
@@ -118,7 +119,7 @@ func factoryShowCmd() *cobra.Command {
 			fmt.Printf("Factory: %s\n", factory.ID)
 			fmt.Printf("Name: %s\n", factory.Name)
 			fmt.Printf("Status: %s\n", factory.Status)
-			fmt.Printf("Created: %s\n", factory.CreatedAt)
+			fmt.Printf("Created: %s\n", formatTime(factory.CreatedAt))
 
 			return nil
 		},
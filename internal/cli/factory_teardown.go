@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+func factoryTeardownCmd() *cobra.Command {
+	var yes bool
+	var force bool
+	var dryRun bool
+	var reportPath string
+
+	cmd := &cobra.Command{
+		Use:   "teardown [factory-id]",
+		Short: "Shut a factory down and archive its infrastructure",
+		Long: `Gracefully shut a factory down instead of deleting it.
+
+Teardown:
+1. Settles each open shipment of the factory's commissions (or assigned to
+   its workbenches): complete it, reassign it to a workbench in another
+   factory, or leave it open and unassigned
+2. Removes each workbench's worktree and archives the workbench
+3. Kills each workshop's tmux session and archives the workshop
+4. Archives the factory
+5. Writes a final commission report (markdown)
+
+Without --yes, prompts for each open shipment and confirms before applying.
+With --yes, shipments whose tasks are all closed are completed and the rest
+are left open. Worktrees with uncommitted changes block teardown unless
+--force is given. If teardown fails partway, run it again to finish.
+
+Examples:
+  orc factory teardown FACT-002 --dry-run
+  orc factory teardown FACT-002
+  orc factory teardown FACT-002 --yes --report ~/reports/staging.md`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			factoryID := args[0]
+			svc := wire.FactoryTeardownService()
+
+			plan, err := svc.PlanTeardown(ctx, factoryID)
+			if err != nil {
+				return err
+			}
+			printTeardownPlan(os.Stdout, plan)
+			if dryRun {
+				return nil
+			}
+
+			reader := bufio.NewReader(os.Stdin)
+			dispositions := make(map[string]primary.ShipmentDisposition)
+			if !yes && len(plan.OpenShipments) > 0 {
+				fmt.Println()
+				for _, sh := range plan.OpenShipments {
+					dispositions[sh.ID] = promptDisposition(reader, os.Stdout, sh)
+				}
+			}
+
+			if !yes {
+				fmt.Printf("\nTear down %s? [y/n] ", plan.FactoryID)
+				response, _ := reader.ReadString('\n')
+				response = strings.TrimSpace(strings.ToLower(response))
+				if response != "y" && response != "yes" {
+					fmt.Println("Canceled.")
+					return nil
+				}
+			}
+
+			result, err := svc.ApplyTeardown(ctx, primary.ApplyTeardownRequest{
+				FactoryID:    plan.FactoryID,
+				Dispositions: dispositions,
+				Force:        force,
+			})
+			if err != nil {
+				return fmt.Errorf("teardown failed: %w", err)
+			}
+
+			if reportPath == "" {
+				reportPath = fmt.Sprintf("%s-teardown.md", strings.ToLower(result.FactoryID))
+			}
+			if err := writeFile(reportPath, []byte(result.Report)); err != nil {
+				return fmt.Errorf("factory archived, but failed to write report: %w", err)
+			}
+
+			fmt.Printf("\n✓ Factory %s archived\n", result.FactoryID)
+			fmt.Printf("  Shipments: %d completed, %d reassigned, %d left open\n", len(result.Completed), len(result.Reassigned), len(result.Released))
+			fmt.Printf("  Archived %s and %s\n",
+				pluralize(len(result.WorkshopsArchived), "workshop", "workshops"),
+				pluralize(len(result.WorkbenchesArchived), "workbench", "workbenches"))
+			fmt.Printf("  Removed %s, killed %s\n",
+				pluralize(len(result.WorktreesRemoved), "worktree", "worktrees"),
+				pluralize(len(result.SessionsKilled), "session", "sessions"))
+			fmt.Printf("  Report: %s\n", reportPath)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&yes, "yes", false, "Use default shipment dispositions and skip confirmation")
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Remove worktrees even with uncommitted changes")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show what teardown would touch without changing anything")
+	cmd.Flags().StringVar(&reportPath, "report", "", "Report file (default: <factory-id>-teardown.md)")
+
+	return cmd
+}
+
+// printTeardownPlan shows the infrastructure and open work teardown will touch.
+func printTeardownPlan(out io.Writer, plan *primary.FactoryTeardownPlan) {
+	fmt.Fprintf(out, "Factory %s (%s) [%s]\n", plan.FactoryID, plan.FactoryName, plan.FactoryStatus)
+
+	fmt.Fprintln(out, "\nWorkshops:")
+	if len(plan.Workshops) == 0 {
+		fmt.Fprintln(out, "  (none)")
+	}
+	for _, ws := range plan.Workshops {
+		fmt.Fprintf(out, "  %s %s [%s]  session: %s\n", ws.ID, ws.Name, ws.Status, orDash(ws.SessionName))
+		for _, wb := range ws.Workbenches {
+			state := "no worktree"
+			if wb.WorktreeExists {
+				state = "clean"
+				if len(wb.ChangedFiles) > 0 {
+					state = pluralize(len(wb.ChangedFiles), "uncommitted change", "uncommitted changes")
+				}
+			}
+			fmt.Fprintf(out, "    %s %s  %s (%s)\n", wb.ID, wb.Name, wb.Path, state)
+		}
+	}
+
+	fmt.Fprintln(out, "\nOpen shipments:")
+	if len(plan.OpenShipments) == 0 {
+		fmt.Fprintln(out, "  (none)")
+	}
+	for _, sh := range plan.OpenShipments {
+		fmt.Fprintf(out, "  %s %s [%s] %d/%d tasks  default: %s\n",
+			sh.ID, truncate(sh.Title, 40), sh.Status, sh.TasksClosed, sh.TaskCount, dispositionLabel(sh.DefaultDisposition))
+	}
+}
+
+// promptDisposition asks what to do with one open shipment. An empty answer
+// takes the shipment's default.
+func promptDisposition(reader *bufio.Reader, out io.Writer, sh primary.TeardownShipment) primary.ShipmentDisposition {
+	for {
+		fmt.Fprintf(out, "%s %s [%s, %d/%d tasks]: [c]omplete, [r]eassign, [l]eave open (default: %s)? ",
+			sh.ID, truncate(sh.Title, 40), sh.Status, sh.TasksClosed, sh.TaskCount, dispositionLabel(sh.DefaultDisposition))
+		response, err := reader.ReadString('\n')
+		response = strings.TrimSpace(strings.ToLower(response))
+
+		switch response {
+		case "":
+			return primary.ShipmentDisposition{Action: sh.DefaultDisposition}
+		case "c", "complete":
+			return primary.ShipmentDisposition{Action: primary.TeardownComplete}
+		case "l", "leave", "release":
+			return primary.ShipmentDisposition{Action: primary.TeardownRelease}
+		case "r", "reassign":
+			fmt.Fprint(out, "  Reassign to workbench: ")
+			target, _ := reader.ReadString('\n')
+			target = strings.TrimSpace(target)
+			if target != "" {
+				return primary.ShipmentDisposition{Action: primary.TeardownReassign, WorkbenchID: target}
+			}
+		}
+
+		if err != nil {
+			// Input closed: fall back to the default rather than looping
+			return primary.ShipmentDisposition{Action: sh.DefaultDisposition}
+		}
+	}
+}
+
+func dispositionLabel(disposition string) string {
+	if disposition == primary.TeardownRelease {
+		return "leave open"
+	}
+	return disposition
+}
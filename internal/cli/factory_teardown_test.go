@@ -0,0 +1,41 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func TestPromptDisposition(t *testing.T) {
+	sh := primary.TeardownShipment{ID: "SHIP-011", Title: "Refunds", Status: "ready", TaskCount: 3, TasksClosed: 1, DefaultDisposition: primary.TeardownRelease}
+
+	tests := []struct {
+		name  string
+		input string
+		want  primary.ShipmentDisposition
+	}{
+		{"empty takes default", "\n", primary.ShipmentDisposition{Action: primary.TeardownRelease}},
+		{"complete", "c\n", primary.ShipmentDisposition{Action: primary.TeardownComplete}},
+		{"leave open", "leave\n", primary.ShipmentDisposition{Action: primary.TeardownRelease}},
+		{"reassign asks for workbench", "r\nBENCH-009\n", primary.ShipmentDisposition{Action: primary.TeardownReassign, WorkbenchID: "BENCH-009"}},
+		{"unknown answer asks again", "x\nc\n", primary.ShipmentDisposition{Action: primary.TeardownComplete}},
+		{"reassign without workbench asks again", "r\n\nl\n", primary.ShipmentDisposition{Action: primary.TeardownRelease}},
+		{"closed input takes default", "x", primary.ShipmentDisposition{Action: primary.TeardownRelease}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			got := promptDisposition(bufio.NewReader(strings.NewReader(tt.input)), &out, sh)
+			if got != tt.want {
+				t.Errorf("promptDisposition() = %+v, want %+v", got, tt.want)
+			}
+			if !strings.Contains(out.String(), "SHIP-011 Refunds [ready, 1/3 tasks]") {
+				t.Errorf("prompt missing shipment summary: %q", out.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// FlowCmd returns the flow command group.
+func FlowCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flow",
+		Short: "Run canned multi-step workflows",
+		Long: `Run declarative flows: multi-command rituals (create a shipment, its
+tasks, assign a bench, leave a kickoff note, focus it) written once as a
+YAML file and shared between operators.
+
+A flow is found by path, or by name in .orc/flows/ of the current directory
+and then ~/.orc/flows/:
+
+  name: kickoff
+  commission: ${commission}
+  params:
+    - commission
+    - title
+    - bench
+  steps:
+    - id: ship
+      do: shipment.create
+      title: ${title}
+    - id: tasks
+      do: task.create
+      shipment: ${ship}
+      titles:
+        - Write the spec
+        - Build ${title}
+    - id: assign
+      do: shipment.assign
+      shipment: ${ship}
+      workbench: ${bench}
+    - id: kickoff
+      do: note.create
+      shipment: ${ship}
+      title: Kickoff
+      content: Tasks ${tasks}
+    - id: focus
+      do: focus
+      workbench: ${bench}
+      target: ${ship}
+
+${name} is a param or the ID an earlier step created or acted on.
+
+Actions: shipment.create (title, description, repo, branch),
+task.create (title or titles, shipment, description, type, tag),
+shipment.assign (shipment, workbench), note.create (title, content, type,
+shipment or tome), focus (workbench, target). Steps that create entities
+use the flow's commission unless they set their own.`,
+	}
+
+	cmd.AddCommand(flowRunCmd())
+	cmd.AddCommand(flowCheckCmd())
+	return cmd
+}
+
+func flowRunCmd() *cobra.Command {
+	var sets []string
+	var runKey string
+
+	cmd := &cobra.Command{
+		Use:   "run [flow]",
+		Short: "Run a flow",
+		Long: `Run a flow's steps in order.
+
+Each step is recorded in the ledger under the run's key as it completes.
+A run with the same key skips the steps already done. The key defaults to
+the flow name plus a hash of the params, so rerunning the same command
+resumes; after fixing a param, pass the printed key with --run to resume
+that run instead of starting a new one. --run also names a fresh run, e.g.
+to repeat a flow with the same params.
+
+A commission param defaults to the current context's commission.
+With --plan, nothing is written.
+
+Examples:
+  orc flow run kickoff --set title="Login page" --set bench=BENCH-014
+  orc flow run ./flows/release.yaml --set version=1.4.0
+  orc flow run kickoff --set title=Retry --set bench=BENCH-014 --run kickoff-second-try`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			definition, err := readFlow(args[0])
+			if err != nil {
+				return err
+			}
+			params, err := parseFlowParams(sets)
+			if err != nil {
+				return err
+			}
+
+			def, err := wire.FlowService().CheckFlow(ctx, definition)
+			if err != nil {
+				return err
+			}
+			if _, ok := params["commission"]; !ok && slices.Contains(def.Params, "commission") {
+				if commissionID := orccontext.GetContextCommissionID(); commissionID != "" {
+					params["commission"] = commissionID
+				}
+			}
+
+			report, err := wire.FlowService().RunFlow(ctx, primary.RunFlowRequest{
+				Definition: definition,
+				Params:     params,
+				RunKey:     runKey,
+			})
+			if report != nil {
+				renderFlowReport(os.Stdout, report, err)
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&sets, "set", nil, "Param value as name=value (repeatable)")
+	cmd.Flags().StringVar(&runKey, "run", "", "Run key (default: flow name plus a hash of the params)")
+	return cmd
+}
+
+func flowCheckCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "check [flow]",
+		Short: "Validate a flow without running it",
+		Long: `Parse and validate a flow: actions, arguments, and that every ${name}
+refers to a param or an earlier step. Nothing is run.
+
+Examples:
+  orc flow check kickoff
+  orc flow check ./flows/release.yaml`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			definition, err := readFlow(args[0])
+			if err != nil {
+				return err
+			}
+			def, err := wire.FlowService().CheckFlow(NewContext(), definition)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("✓ Flow %s is valid\n", def.Name)
+			if def.Description != "" {
+				fmt.Printf("  %s\n", def.Description)
+			}
+			if len(def.Params) > 0 {
+				fmt.Printf("\nParams: %s\n", strings.Join(def.Params, ", "))
+			}
+			fmt.Println("\nSteps:")
+			tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			for i, step := range def.Steps {
+				fmt.Fprintf(tw, "  %d.\t%s\t%s\n", i+1, step.ID, step.Action)
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+// readFlow reads a flow file given by path, or by name from .orc/flows/ in
+// the current directory or ~/.orc/flows/.
+func readFlow(ref string) (string, error) {
+	candidates := []string{ref}
+	if !strings.ContainsRune(ref, filepath.Separator) && filepath.Ext(ref) == "" {
+		candidates = append(candidates, filepath.Join(".orc", "flows", ref+".yaml"))
+		if home, err := os.UserHomeDir(); err == nil {
+			candidates = append(candidates, filepath.Join(home, ".orc", "flows", ref+".yaml"))
+		}
+	}
+
+	for _, path := range candidates {
+		data, err := os.ReadFile(path)
+		if err == nil {
+			return string(data), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", fmt.Errorf("failed to read flow: %w", err)
+		}
+	}
+	return "", fmt.Errorf("flow %q not found (looked in %s)", ref, strings.Join(candidates, ", "))
+}
+
+// parseFlowParams parses --set name=value flags.
+func parseFlowParams(sets []string) (map[string]string, error) {
+	params := make(map[string]string, len(sets))
+	for _, set := range sets {
+		name, value, ok := strings.Cut(set, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid --set %q (expected name=value)", set)
+		}
+		params[name] = value
+	}
+	return params, nil
+}
+
+// renderFlowReport prints each step a run completed or skipped and, when it
+// stopped early, how to resume.
+func renderFlowReport(w io.Writer, r *primary.FlowRunReport, runErr error) {
+	fmt.Fprintln(w, color.New(color.Bold).Sprintf("Flow %s", r.Flow)+color.New(color.Faint).Sprintf(" (run %s)", r.RunKey))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, step := range r.Steps {
+		mark, note := color.New(color.FgGreen).Sprint("✓"), ""
+		if step.Skipped {
+			mark, note = color.New(color.Faint).Sprint("↷"), "(done earlier)"
+		}
+		fmt.Fprintf(tw, "  %s %s\t%s\t%s\t%s\n", mark, step.ID, step.Action, orDash(step.Output), note)
+	}
+	_ = tw.Flush()
+
+	fmt.Fprintln(w)
+	if runErr != nil {
+		fmt.Fprintf(w, "%s Stopped after %s. Fix the problem and rerun with --run %s to resume.\n",
+			color.New(color.FgRed).Sprint("✗"), pluralize(len(r.Steps), "step", "steps"), r.RunKey)
+		return
+	}
+	fmt.Fprintf(w, "✓ Flow complete (%s)\n", pluralize(len(r.Steps), "step", "steps"))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
@@ -28,11 +29,16 @@ Focusable entities:
 Smart clear: Running --clear refocuses to the commission of the current focus.
 Use --clear --force to fully clear focus with no fallback.
 
+Focus history: 'orc focus -' jumps back to the previous focus (like 'cd -');
+'orc focus recent' lists recent focus targets.
+
 Examples:
   orc focus SHIP-178        # Focus on a shipment
   orc focus TOME-028        # Focus on a tome
   orc focus COMM-001        # Focus on a commission
   orc focus NOTE-322        # Focus on a root-level note
+  orc focus -               # Jump back to the previous focus
+  orc focus recent          # List recent focus targets
   orc focus --show          # Show current focus
   orc focus --clear         # Smart clear (refocus to commission)
   orc focus --clear --force # Fully clear focus`,
@@ -42,6 +48,58 @@ Examples:
 	cmd.Flags().Bool("show", false, "Show current focus without changing it")
 	cmd.Flags().Bool("clear", false, "Clear the current focus")
 	cmd.Flags().Bool("force", false, "Fully clear focus (no fallback to commission)")
+	cmd.AddCommand(focusRecentCmd())
+	return cmd
+}
+
+// focusRecentCmd returns the focus recent subcommand.
+func focusRecentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "recent",
+		Short: "List recent focus targets",
+		Long: `List this workbench's most recently focused containers, newest first.
+
+Jump back to the previous one with 'orc focus -'.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			limit, _ := cmd.Flags().GetInt("limit")
+
+			cwd, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("failed to get working directory: %w", err)
+			}
+			cfg, err := MigrateGoblinConfigIfNeeded(cmd.Context(), cwd)
+			if err != nil {
+				return fmt.Errorf("no ORC config found in current directory")
+			}
+			if config.GetPlaceType(cfg.PlaceID) != config.PlaceTypeWorkbench {
+				return fmt.Errorf("focus requires workbench context")
+			}
+
+			ctx := NewContext()
+			entries, err := wire.WorkbenchService().ListRecentFocus(ctx, cfg.PlaceID, limit)
+			if err != nil {
+				return fmt.Errorf("failed to list focus history: %w", err)
+			}
+			if len(entries) == 0 {
+				fmt.Println("No focus history")
+				return nil
+			}
+
+			current, _ := wire.WorkbenchService().GetFocusedID(ctx, cfg.PlaceID)
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			for _, e := range entries {
+				marker := " "
+				if e.FocusedID == current {
+					marker = "*"
+				}
+				_, title, _ := GetFocusInfo(e.FocusedID)
+				fmt.Fprintf(w, "%s %s\t%s\t%s\n", marker, e.FocusedID, formatWhen(e.FocusedAt), title)
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().IntP("limit", "n", 10, "Number of focus targets to show")
 	return cmd
 }
 
@@ -86,11 +144,21 @@ func runIMPFocus(_ *cobra.Command, args []string, cfg *config.Config, showOnly,
 	}
 
 	if len(args) == 0 {
-		return fmt.Errorf("Usage: orc focus <ID> or orc focus --show or orc focus --clear")
+		return fmt.Errorf("Usage: orc focus <ID>, orc focus -, orc focus --show or orc focus --clear")
 	}
 
 	// Set focus
 	containerID := args[0]
+	if containerID == "-" {
+		previous, err := wire.WorkbenchService().GetPreviousFocus(NewContext(), workbenchID)
+		if err != nil {
+			return fmt.Errorf("failed to get focus history: %w", err)
+		}
+		if previous == "" {
+			return fmt.Errorf("no previous focus")
+		}
+		containerID = previous
+	}
 
 	containerType, title, err := validateFocusTarget(containerID)
 	if err != nil {
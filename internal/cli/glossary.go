@@ -0,0 +1,238 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// GlossaryCmd returns the glossary command group.
+func GlossaryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "glossary",
+		Short: "Manage a commission's terminology",
+		Long: `Keep a commission's terms and their definitions in one place.
+
+Terms are included in the IMP's orc prime context. Deprecating a term
+(--replaced-by) lets orc glossary lint flag plans and notes still using it.
+
+Commands default to the commission of the workbench's focus, or the
+commission of the current context.`,
+	}
+
+	cmd.AddCommand(glossaryAddCmd())
+	cmd.AddCommand(glossaryListCmd())
+	cmd.AddCommand(glossaryRemoveCmd())
+	cmd.AddCommand(glossarySeedCmd())
+	cmd.AddCommand(glossaryLintCmd())
+	return cmd
+}
+
+// addGlossaryCommissionFlag adds the --commission flag, defaulting from focus.
+func addGlossaryCommissionFlag(cmd *cobra.Command) {
+	cmd.Flags().StringP("commission", "c", "", "Commission ID (defaults to context)")
+	inferFromFocus(cmd, "commission")
+}
+
+// glossaryCommission returns the commission a glossary command works on.
+func glossaryCommission(cmd *cobra.Command) (string, error) {
+	commissionID, _ := cmd.Flags().GetString("commission")
+	if commissionID == "" {
+		commissionID = orccontext.GetContextCommissionID()
+	}
+	if commissionID == "" {
+		return "", fmt.Errorf("no commission context detected\nHint: Use --commission flag or run from a workbench directory")
+	}
+	return commissionID, nil
+}
+
+func glossaryAddCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <term> [definition]",
+		Short: "Add a term, or update its definition",
+		Long: `Add a term to the commission's glossary. Adding a term that is already
+there replaces its definition.
+
+A term with --replaced-by is deprecated: orc glossary lint flags its use and
+suggests the replacement. Deprecated terms may skip the definition.
+
+Examples:
+  orc glossary add workbench "A git worktree an IMP works in"
+  orc glossary add grove --replaced-by workbench
+  orc glossary add mission "Old name for a commission" --replaced-by commission -c COMM-001`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			commissionID, err := glossaryCommission(cmd)
+			if err != nil {
+				return err
+			}
+			replacedBy, _ := cmd.Flags().GetString("replaced-by")
+			var definition string
+			if len(args) == 2 {
+				definition = args[1]
+			}
+
+			err = wire.GlossaryService().AddTerm(NewContext(), primary.AddGlossaryTermRequest{
+				CommissionID: commissionID,
+				Term:         args[0],
+				Definition:   definition,
+				ReplacedBy:   replacedBy,
+			})
+			if err != nil {
+				return err
+			}
+
+			if replacedBy != "" {
+				fmt.Printf("✓ %s: %q deprecated, use %q\n", commissionID, args[0], replacedBy)
+			} else {
+				fmt.Printf("✓ %s: %q defined\n", commissionID, args[0])
+			}
+			return nil
+		},
+	}
+
+	addGlossaryCommissionFlag(cmd)
+	cmd.Flags().String("replaced-by", "", "Deprecate the term in favor of this one")
+	return cmd
+}
+
+func glossaryListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the commission's glossary",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			commissionID, err := glossaryCommission(cmd)
+			if err != nil {
+				return err
+			}
+			terms, err := wire.GlossaryService().ListTerms(NewContext(), commissionID)
+			if err != nil {
+				return err
+			}
+			if len(terms) == 0 {
+				fmt.Printf("No glossary terms for %s.\nAdd one with: orc glossary add <term> \"<definition>\"\n", commissionID)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "TERM\tDEFINITION")
+			fmt.Fprintln(w, "----\t----------")
+			for _, t := range terms {
+				fmt.Fprintf(w, "%s\t%s\n", t.Term, glossaryDefinition(t))
+			}
+			return w.Flush()
+		},
+	}
+
+	addGlossaryCommissionFlag(cmd)
+	return cmd
+}
+
+func glossaryRemoveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove <term>",
+		Short: "Remove a term from the glossary",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			commissionID, err := glossaryCommission(cmd)
+			if err != nil {
+				return err
+			}
+			if err := wire.GlossaryService().RemoveTerm(NewContext(), commissionID, args[0]); err != nil {
+				return err
+			}
+			fmt.Printf("✓ %s: %q removed\n", commissionID, args[0])
+			return nil
+		},
+	}
+
+	addGlossaryCommissionFlag(cmd)
+	return cmd
+}
+
+func glossarySeedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seed",
+		Short: "Add the described tags as terms",
+		Long: `Add each tag that has a description as a glossary term, using the
+description as its definition. Terms already in the glossary are kept.
+
+Examples:
+  orc glossary seed -c COMM-001`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			commissionID, err := glossaryCommission(cmd)
+			if err != nil {
+				return err
+			}
+			added, err := wire.GlossaryService().SeedFromTags(NewContext(), commissionID)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("✓ %s: added %s from tags\n", commissionID, pluralize(len(added), "term", "terms"))
+			for _, t := range added {
+				fmt.Printf("  %s\n", t.Term)
+			}
+			return nil
+		},
+	}
+
+	addGlossaryCommissionFlag(cmd)
+	return cmd
+}
+
+func glossaryLintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Flag plans and notes using deprecated terms",
+		Long: `Check the commission's plans and open notes for deprecated terms and
+suggest their replacements. Exits with an error when any are found.
+
+Examples:
+  orc glossary lint
+  orc glossary lint -c COMM-001`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			commissionID, err := glossaryCommission(cmd)
+			if err != nil {
+				return err
+			}
+			findings, err := wire.GlossaryService().Lint(NewContext(), commissionID)
+			if err != nil {
+				return err
+			}
+			if len(findings) == 0 {
+				fmt.Printf("✓ %s: no deprecated terms in use\n", commissionID)
+				return nil
+			}
+
+			for _, f := range findings {
+				fmt.Printf("%s %s: %q → use %q\n", f.EntityID, truncate(f.Title, 40), f.Term, f.ReplacedBy)
+			}
+			return fmt.Errorf("%s of deprecated terms", pluralize(len(findings), "use", "uses"))
+		},
+	}
+
+	addGlossaryCommissionFlag(cmd)
+	return cmd
+}
+
+// glossaryDefinition renders a term's definition, noting its replacement.
+func glossaryDefinition(t *primary.GlossaryTerm) string {
+	var parts []string
+	if t.Definition != "" {
+		parts = append(parts, t.Definition)
+	}
+	if t.ReplacedBy != "" {
+		parts = append(parts, fmt.Sprintf("(deprecated: use %s)", t.ReplacedBy))
+	}
+	return strings.Join(parts, " ")
+}
@@ -27,8 +27,9 @@ This command is called by Claude Code hooks and reads event data from stdin.
 Each event has a specific handler subcommand.
 
 Available events:
-  Stop              - Called when Claude wants to stop the session (logs context)
+  Stop              - Called when Claude wants to stop the session (logs context, drives autorun)
   UserPromptSubmit  - Called when user submits a prompt (logs event)
+  SessionEnd        - Called when the session ends (drafts a handoff note)
 
 Example:
   echo '{"session_id":"abc"}' | orc hook Stop`,
@@ -37,6 +38,7 @@ Example:
 	// Add event handlers as subcommands
 	cmd.AddCommand(hookStopCmd())
 	cmd.AddCommand(hookUserPromptSubmitCmd())
+	cmd.AddCommand(hookSessionEndCmd())
 
 	// Add event viewing commands
 	cmd.AddCommand(hookTailCmd())
@@ -61,6 +63,14 @@ type UserPromptSubmitHookEvent struct {
 	TranscriptPath string `json:"transcript_path"`
 }
 
+// SessionEndHookEvent represents the JSON payload from Claude Code SessionEnd hook
+type SessionEndHookEvent struct {
+	Cwd            string `json:"cwd"`
+	SessionID      string `json:"session_id"`
+	TranscriptPath string `json:"transcript_path"`
+	Reason         string `json:"reason"`
+}
+
 // hookContext holds ORC context discovered during hook processing
 type hookContext struct {
 	workbenchID     string
@@ -139,7 +149,7 @@ func hookStopCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "Stop",
 		Short: "Handle Stop event (IMP context)",
-		Long:  "Called when Claude wants to stop. Blocks if IMP has incomplete work.",
+		Long:  "Called when Claude wants to stop. Blocks with the next task when the focused shipment has autorun on.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runHookStop()
 		},
@@ -182,35 +192,72 @@ func runHookStop() error {
 	eventReq.Cwd = event.Cwd
 	eventReq.SessionID = event.SessionID
 
-	// 3. Check stop_hook_active first (prevent infinite loop)
-	if event.StopHookActive {
-		eventReq.Reason = "stop_hook_active=true (preventing loop)"
-		return nil
-	}
-
-	// 4. Look up ORC context
+	// 3. Look up ORC context
 	hctx := lookupORCContext(ctx, event.Cwd)
 	eventReq.WorkbenchID = hctx.workbenchID
 	eventReq.ShipmentID = hctx.shipmentID
 	eventReq.ShipmentStatus = hctx.shipmentStatus
 	eventReq.TaskCountIncomplete = hctx.incompleteCount
 
-	// 5. Check if we have ORC context
+	// 4. Check if we have ORC context
 	if hctx.workbenchID == "" {
 		eventReq.Reason = "no workbench context"
 		return nil
 	}
+	refreshClaims(ctx, hctx.workbenchID)
 
 	if hctx.shipmentID == "" {
 		eventReq.Reason = "no shipment focused"
 		return nil
 	}
 
+	// 5. Autorun: hand the IMP its next task instead of letting it stop
+	if step, err := wire.AutorunService().NextStep(ctx, hctx.shipmentID); err == nil && step.Action == primary.AutorunRun {
+		instruction := autorunInstruction(step)
+		// Already continuing because of this hook: only block again if the
+		// IMP took the last instruction, so an ignored one can't loop forever.
+		if event.StopHookActive && lastStopBlockedWith(ctx, hctx.workbenchID, instruction) {
+			eventReq.Reason = fmt.Sprintf("autorun: %s still unclaimed after instruction (preventing loop)", step.TaskID)
+			return nil
+		}
+		eventReq.Decision = primary.HookDecisionBlock
+		eventReq.Reason = instruction
+		return writeStopDecision(os.Stdout, instruction)
+	}
+
+	if event.StopHookActive {
+		eventReq.Reason = "stop_hook_active=true (preventing loop)"
+		return nil
+	}
+
 	// 6. Log context and allow stop
 	eventReq.Reason = fmt.Sprintf("shipment %s (%s), %d incomplete tasks", hctx.shipmentID, hctx.shipmentStatus, hctx.incompleteCount)
 	return nil
 }
 
+// lastStopBlockedWith reports whether the workbench's previous Stop event
+// blocked with the given reason.
+func lastStopBlockedWith(ctx gocontext.Context, workbenchID, reason string) bool {
+	events, err := wire.HookEventService().ListHookEvents(ctx, primary.HookEventFilters{
+		WorkbenchID: workbenchID,
+		HookType:    primary.HookTypeStop,
+		Limit:       1,
+	})
+	if err != nil || len(events) == 0 {
+		return false
+	}
+	return events[0].Decision == primary.HookDecisionBlock && events[0].Reason == reason
+}
+
+// writeStopDecision tells Claude Code to keep going instead of stopping,
+// with reason as its next instruction.
+func writeStopDecision(w io.Writer, reason string) error {
+	return json.NewEncoder(w).Encode(map[string]string{
+		"decision": primary.HookDecisionBlock,
+		"reason":   reason,
+	})
+}
+
 // hookUserPromptSubmitCmd handles the UserPromptSubmit event
 func hookUserPromptSubmitCmd() *cobra.Command {
 	return &cobra.Command{
@@ -265,6 +312,9 @@ func runHookUserPromptSubmit() error {
 	eventReq.ShipmentID = hctx.shipmentID
 	eventReq.ShipmentStatus = hctx.shipmentStatus
 	eventReq.TaskCountIncomplete = hctx.incompleteCount
+	if hctx.workbenchID != "" {
+		refreshClaims(ctx, hctx.workbenchID)
+	}
 
 	// UserPromptSubmit always allows - it just logs
 	eventReq.Reason = "user prompt logged"
@@ -272,6 +322,110 @@ func runHookUserPromptSubmit() error {
 	return nil
 }
 
+// hookSessionEndCmd handles the SessionEnd event
+func hookSessionEndCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "SessionEnd",
+		Short: "Handle SessionEnd event (draft handoff)",
+		Long: `Called when a session ends. In a workbench, summarizes the session
+transcript into a draft handoff note on the focused shipment or tome, for
+the next IMP to confirm ('orc note confirm') or edit ('orc note update').`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHookSessionEnd(cmd.OutOrStdout())
+		},
+	}
+}
+
+// runHookSessionEnd drafts a handoff note from the ended session. Errors go
+// to stderr and never fail the hook. Nothing is logged to hook_events,
+// which only records Stop and UserPromptSubmit.
+func runHookSessionEnd(out io.Writer) error {
+	ctx := NewContext()
+
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return nil //nolint:nilerr // intentional fail-open design
+	}
+	var event SessionEndHookEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		fmt.Fprintf(os.Stderr, "orc: failed to parse SessionEnd payload: %v\n", err)
+		return nil
+	}
+
+	req, ok := handoffRequest(ctx, event)
+	if !ok {
+		return nil
+	}
+	note, err := wire.NoteService().DraftHandoff(ctx, req)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "orc: failed to draft handoff: %v\n", err)
+		return nil
+	}
+	if note != nil {
+		fmt.Fprintf(out, "✓ Drafted handoff %s (confirm with: orc note confirm %s)\n", note.ID, note.ID)
+	}
+	return nil
+}
+
+// handoffRequest gathers where an ended session ran: its workbench's focused
+// shipment or tome, that container's commission, and the tasks the
+// workbench had in progress. Reports false outside a workbench, without a
+// focus, or when the transcript can't be read.
+func handoffRequest(ctx gocontext.Context, event SessionEndHookEvent) (primary.DraftHandoffRequest, bool) {
+	req := primary.DraftHandoffRequest{SessionID: event.SessionID}
+	if event.Cwd == "" || event.TranscriptPath == "" {
+		return req, false
+	}
+
+	cfg, err := config.LoadConfig(event.Cwd)
+	if err != nil || !config.IsWorkbench(cfg.PlaceID) {
+		return req, false
+	}
+	workbenchID := cfg.PlaceID
+
+	focusID, err := wire.WorkbenchService().GetFocusedID(ctx, workbenchID)
+	if err != nil {
+		return req, false
+	}
+	switch {
+	case strings.HasPrefix(focusID, "SHIP-"):
+		shipment, err := wire.ShipmentService().GetShipment(ctx, focusID)
+		if err != nil {
+			return req, false
+		}
+		req.CommissionID, req.ShipmentID = shipment.CommissionID, shipment.ID
+	case strings.HasPrefix(focusID, "TOME-"):
+		tome, err := wire.TomeService().GetTome(ctx, focusID)
+		if err != nil {
+			return req, false
+		}
+		req.CommissionID, req.TomeID = tome.CommissionID, tome.ID
+	default:
+		return req, false
+	}
+
+	tasks, _ := wire.TaskService().GetTasksByWorkbench(ctx, workbenchID)
+	for _, task := range tasks {
+		if task.Status == "in-progress" {
+			req.TaskIDs = append(req.TaskIDs, task.ID)
+		}
+	}
+
+	req.Transcript, err = os.ReadFile(event.TranscriptPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "orc: failed to read transcript: %v\n", err)
+		return req, false
+	}
+	return req, true
+}
+
+// refreshClaims heartbeats the workbench's claimed tasks so a live IMP's
+// claims don't expire (see 'orc task claims'). Errors are ignored: hooks
+// fail open.
+func refreshClaims(ctx gocontext.Context, workbenchID string) {
+	_, _ = wire.TaskService().RefreshClaims(ctx, workbenchID)
+}
+
 // hookTailCmd shows recent hook events
 func hookTailCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -377,7 +531,7 @@ func runHookShow(eventID string) error {
 
 	fmt.Printf("Hook Event: %s\n", event.ID)
 	fmt.Printf("Type: %s\n", event.HookType)
-	fmt.Printf("Timestamp: %s\n", formatHookTimestamp(event.Timestamp))
+	fmt.Printf("Timestamp: %s\n", formatTimestamp(event.Timestamp))
 	fmt.Printf("Decision: %s\n", formatDecision(event.Decision))
 	if event.Reason != "" {
 		fmt.Printf("Reason: %s\n", event.Reason)
@@ -455,7 +609,7 @@ func printHookEvent(event *primary.HookEvent) {
 	}
 
 	fmt.Printf("%s | %-10s | %-16s | %-26s | %-5s | %s\n",
-		formatHookTimestamp(event.Timestamp),
+		formatTimestamp(event.Timestamp),
 		workbenchInfo,
 		event.HookType,
 		shipmentInfo,
@@ -464,14 +618,6 @@ func printHookEvent(event *primary.HookEvent) {
 	)
 }
 
-func formatHookTimestamp(ts string) string {
-	t, err := time.Parse(time.RFC3339, ts)
-	if err != nil {
-		return ts
-	}
-	return t.Format("2006-01-02 15:04:05")
-}
-
 func formatDecision(decision string) string {
 	if decision == "block" {
 		return "BLOCK"
@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// ImportCmd returns the import command group.
+func ImportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import issues from legacy trackers",
+		Long: `Import a tracker's CSV export into a commission.
+
+Epics (Jira) and projects (Linear) become shipments; other issues become
+tasks in the shipment of their epic or project, or in an "Imported from
+<tracker>" shipment. Each issue's first label becomes the task's tag.
+Every imported entity gets its issue key as an alias (pay-123) and a
+provenance line in its description, and a repeated import skips issues
+it already brought in.
+
+Statuses and issue types are mapped with built-in defaults for the stock
+workflows. Override or extend them with --map, a YAML file:
+
+  statuses:
+    "Ready for QA": in-progress    # open, in-progress, or closed
+  types:
+    Test: skip                     # a task type, shipment, or skip
+  labels:
+    backend: api                   # tag name; "" ignores the label
+
+Run with --dry-run first to review the mapping.`,
+	}
+
+	for _, source := range wire.ImportSources() {
+		cmd.AddCommand(importSourceCmd(source))
+	}
+	return cmd
+}
+
+func importSourceCmd(s primary.ImportSource) *cobra.Command {
+	source, name, columns := s.Source, s.Name, strings.Join(s.Columns, ", ")
+	cmd := &cobra.Command{
+		Use:   source + " <export.csv>",
+		Short: fmt.Sprintf("Import a %s CSV export as shipments and tasks", name),
+		Long: fmt.Sprintf(`Import a %s CSV export as shipments and tasks.
+
+Columns read: %s.
+Use - to read the export from stdin. See 'orc import --help' for how
+issues are mapped.
+
+Examples:
+  orc import %s export.csv --dry-run
+  orc import %s export.csv --commission COMM-005 --map status-mapping.yaml`, name, columns, source, source),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			commissionID, _ := cmd.Flags().GetString("commission")
+			mapPath, _ := cmd.Flags().GetString("map")
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+			if commissionID == "" {
+				commissionID = orccontext.GetContextCommissionID()
+				if commissionID == "" {
+					return fmt.Errorf("no commission context detected\nHint: Use --commission flag or run from a workbench directory")
+				}
+			}
+
+			var data []byte
+			var err error
+			if args[0] == "-" {
+				data, err = io.ReadAll(os.Stdin)
+			} else {
+				data, err = os.ReadFile(args[0])
+			}
+			if err != nil {
+				return fmt.Errorf("failed to read export: %w", err)
+			}
+
+			var mapping []byte
+			if mapPath != "" {
+				if mapping, err = os.ReadFile(mapPath); err != nil {
+					return fmt.Errorf("failed to read mapping: %w", err)
+				}
+			}
+
+			report, err := wire.ImportService().ImportIssues(NewContext(), primary.ImportIssuesRequest{
+				Source:       source,
+				CommissionID: commissionID,
+				CSV:          data,
+				Mapping:      mapping,
+				DryRun:       dryRun,
+			})
+			if report != nil {
+				renderImportReport(os.Stdout, report)
+			}
+			return err
+		},
+	}
+	cmd.Flags().StringP("commission", "c", "", "Commission ID (defaults to context)")
+	cmd.Flags().String("map", "", "YAML file mapping statuses, types, and labels")
+	cmd.Flags().Bool("dry-run", false, "Show what would be imported without writing anything")
+	return cmd
+}
+
+// renderImportReport prints the value mappings, the planned or created
+// entities, and any warnings.
+func renderImportReport(w io.Writer, r *primary.ImportReport) {
+	title := fmt.Sprintf("Import from %s into %s", r.SourceName, r.CommissionID)
+	if r.DryRun {
+		title += " (dry run)"
+	}
+	fmt.Fprintln(w, color.New(color.Bold).Sprint(title))
+
+	renderImportMapCounts(w, "Statuses", r.Statuses)
+	renderImportMapCounts(w, "Types", r.Types)
+
+	var shipments, tasks []primary.ImportedItem
+	for _, item := range r.Items {
+		if item.Kind == "shipment" {
+			shipments = append(shipments, item)
+		} else {
+			tasks = append(tasks, item)
+		}
+	}
+
+	if len(shipments) > 0 {
+		fmt.Fprintf(w, "\nShipments (%d):\n", len(shipments))
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, s := range shipments {
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\n", orDash(s.EntityID), orDash(s.Key), truncate(s.Title, 50), s.Status)
+		}
+		_ = tw.Flush()
+	}
+	if len(tasks) > 0 {
+		fmt.Fprintf(w, "\nTasks (%d):\n", len(tasks))
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, t := range tasks {
+			tag := ""
+			if t.Tag != "" {
+				tag = "#" + t.Tag
+			}
+			fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%s\t%s\t→ %s\n", orDash(t.EntityID), t.Key, truncate(t.Title, 40),
+				orDash(t.Type), t.Status, tag, t.ShipmentID)
+		}
+		_ = tw.Flush()
+	}
+
+	if len(r.NewTags) > 0 {
+		verb := "Created"
+		if r.DryRun {
+			verb = "Will create"
+		}
+		fmt.Fprintf(w, "\n%s tags: %s\n", verb, strings.Join(r.NewTags, ", "))
+	}
+
+	if len(r.Skipped) > 0 {
+		fmt.Fprintf(w, "\nSkipped (%d):\n", len(r.Skipped))
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, s := range r.Skipped {
+			fmt.Fprintf(tw, "  %s\t%s\t%s\n", orDash(s.Key), orDash(s.EntityID), s.Reason)
+		}
+		_ = tw.Flush()
+	}
+
+	if len(r.Warnings) > 0 {
+		fmt.Fprintln(w)
+		for _, warning := range r.Warnings {
+			fmt.Fprintf(w, "%s %s\n", color.New(color.FgYellow).Sprint("⚠"), warning)
+		}
+	}
+
+	fmt.Fprintln(w)
+	if r.DryRun {
+		fmt.Fprintf(w, "Would create %d shipment(s) and %d task(s). Run without --dry-run to import.\n", len(shipments), len(tasks))
+	} else {
+		fmt.Fprintf(w, "✓ Created %d shipment(s) and %d task(s)\n", len(shipments), len(tasks))
+	}
+}
+
+func renderImportMapCounts(w io.Writer, heading string, counts []primary.ImportMapCount) {
+	if len(counts) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "\n%s:\n", heading)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, c := range counts {
+		note := ""
+		if c.Unmapped {
+			note = color.New(color.FgYellow).Sprint("(unmapped)")
+		}
+		fmt.Fprintf(tw, "  %s\t→ %s\t%d\t%s\n", c.From, orDash(c.To), c.Count, note)
+	}
+	_ = tw.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
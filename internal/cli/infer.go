@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/wire"
+)
+
+// inferAnnotation marks a flag that defaults from the workbench's focus.
+const inferAnnotation = "orc_infer_from_focus"
+
+// inferFromFocus marks flags of cmd (commission, shipment, tome) as
+// defaulting from the workbench's focus when none of them is given.
+func inferFromFocus(cmd *cobra.Command, flags ...string) {
+	for _, name := range flags {
+		_ = cmd.Flags().SetAnnotation(name, inferAnnotation, []string{"true"})
+	}
+}
+
+// InferFlags fills a command's focus-inferable flags from the current
+// workbench's focus, printing a note for each value it fills in. Nothing is
+// inferred when any of them is given explicitly, since the focus no longer
+// says unambiguously what is meant, or with --no-infer. Called once from the
+// root command's PersistentPreRunE.
+func InferFlags(cmd *cobra.Command) error {
+	if noInfer, _ := cmd.Flags().GetBool("no-infer"); noInfer {
+		return nil
+	}
+	flags := inferableFlags(cmd)
+	if len(flags) == 0 {
+		return nil
+	}
+	for _, f := range flags {
+		if f.Changed {
+			return nil
+		}
+	}
+
+	workbenchID := orccontext.GetContextWorkbenchID()
+	if workbenchID == "" {
+		return nil
+	}
+	ctx := NewContext()
+	focusID, err := wire.WorkbenchService().GetFocusedID(ctx, workbenchID)
+	if err != nil || focusID == "" || isStaleFocus(ctx, focusID) {
+		return nil //nolint:nilerr // no usable focus: leave the flags to their usual defaults
+	}
+	return applyFocusDefaults(flags, focusDefaults(focusID, resolveContainerCommission(focusID)), os.Stderr)
+}
+
+// inferableFlags returns the command's flags marked by inferFromFocus.
+func inferableFlags(cmd *cobra.Command) []*pflag.Flag {
+	var flags []*pflag.Flag
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if _, ok := f.Annotations[inferAnnotation]; ok {
+			flags = append(flags, f)
+		}
+	})
+	return flags
+}
+
+// focusDefaults maps flag names to the values a focus implies: the focused
+// shipment or tome, and the commission it belongs to.
+func focusDefaults(focusID, commissionID string) map[string]string {
+	defaults := make(map[string]string)
+	switch {
+	case strings.HasPrefix(focusID, "SHIP-"):
+		defaults["shipment"] = focusID
+	case strings.HasPrefix(focusID, "TOME-"):
+		defaults["tome"] = focusID
+	}
+	if commissionID != "" {
+		defaults["commission"] = commissionID
+	}
+	return defaults
+}
+
+// applyFocusDefaults sets each flag the defaults cover, noting it on w.
+func applyFocusDefaults(flags []*pflag.Flag, defaults map[string]string, w io.Writer) error {
+	var inferred []string
+	for _, f := range flags {
+		value, ok := defaults[f.Name]
+		if !ok {
+			continue
+		}
+		if err := f.Value.Set(value); err != nil {
+			return fmt.Errorf("failed to infer --%s: %w", f.Name, err)
+		}
+		inferred = append(inferred, fmt.Sprintf("--%s %s", f.Name, value))
+	}
+	if len(inferred) > 0 {
+		fmt.Fprintf(w, "Inferred %s from focus (--no-infer to turn off)\n", strings.Join(inferred, ", "))
+	}
+	return nil
+}
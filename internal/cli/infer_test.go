@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestFocusDefaults(t *testing.T) {
+	tests := []struct {
+		focusID, commissionID string
+		want                  map[string]string
+	}{
+		{"SHIP-012", "COMM-001", map[string]string{"shipment": "SHIP-012", "commission": "COMM-001"}},
+		{"TOME-003", "COMM-002", map[string]string{"tome": "TOME-003", "commission": "COMM-002"}},
+		{"COMM-001", "COMM-001", map[string]string{"commission": "COMM-001"}},
+		{"SHIP-404", "", map[string]string{"shipment": "SHIP-404"}},
+	}
+	for _, tt := range tests {
+		got := focusDefaults(tt.focusID, tt.commissionID)
+		if len(got) != len(tt.want) {
+			t.Errorf("focusDefaults(%s) = %v, want %v", tt.focusID, got, tt.want)
+			continue
+		}
+		for k, v := range tt.want {
+			if got[k] != v {
+				t.Errorf("focusDefaults(%s)[%s] = %q, want %q", tt.focusID, k, got[k], v)
+			}
+		}
+	}
+}
+
+func newInferTestCmd() *cobra.Command {
+	cmd := &cobra.Command{Use: "create"}
+	cmd.Flags().String("shipment", "", "")
+	cmd.Flags().String("tome", "", "")
+	cmd.Flags().StringP("commission", "c", "", "")
+	cmd.Flags().String("title", "", "")
+	cmd.Flags().Bool("no-infer", false, "")
+	inferFromFocus(cmd, "shipment", "tome", "commission")
+	return cmd
+}
+
+func TestApplyFocusDefaults(t *testing.T) {
+	cmd := newInferTestCmd()
+	flags := inferableFlags(cmd)
+	if len(flags) != 3 {
+		t.Fatalf("expected 3 inferable flags, got %d", len(flags))
+	}
+
+	var out bytes.Buffer
+	if err := applyFocusDefaults(flags, focusDefaults("SHIP-012", "COMM-001"), &out); err != nil {
+		t.Fatalf("applyFocusDefaults() error = %v", err)
+	}
+	shipment, _ := cmd.Flags().GetString("shipment")
+	tome, _ := cmd.Flags().GetString("tome")
+	commission, _ := cmd.Flags().GetString("commission")
+	if shipment != "SHIP-012" || tome != "" || commission != "COMM-001" {
+		t.Errorf("flags = shipment %q, tome %q, commission %q", shipment, tome, commission)
+	}
+	if want := "Inferred --commission COMM-001, --shipment SHIP-012 from focus (--no-infer to turn off)\n"; out.String() != want {
+		t.Errorf("note = %q, want %q", out.String(), want)
+	}
+}
+
+func TestInferFlags_Skipped(t *testing.T) {
+	// An explicit container flag means the focus no longer decides
+	cmd := newInferTestCmd()
+	if err := cmd.Flags().Parse([]string{"--tome", "TOME-001"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := InferFlags(cmd); err != nil {
+		t.Fatalf("InferFlags() error = %v", err)
+	}
+	if shipment, _ := cmd.Flags().GetString("shipment"); shipment != "" {
+		t.Errorf("expected nothing inferred, got --shipment %s", shipment)
+	}
+
+	cmd = newInferTestCmd()
+	if err := cmd.Flags().Parse([]string{"--no-infer"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := InferFlags(cmd); err != nil {
+		t.Fatalf("InferFlags() error = %v", err)
+	}
+	if commission, _ := cmd.Flags().GetString("commission"); commission != "" {
+		t.Errorf("expected nothing inferred with --no-infer, got --commission %s", commission)
+	}
+}
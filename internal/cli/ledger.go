@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// describeLedgerRef renders a link as "alias:ID title [status]", or with the
+// reason it can't be resolved.
+func describeLedgerRef(ref *primary.LedgerRef) string {
+	if ref.Entity == nil {
+		return fmt.Sprintf("%s (unresolved: %s)", ref.Ref, ref.Problem)
+	}
+	return entityLine(ref.Ref, ref.Entity.Title, ref.Entity.Status)
+}
+
+// LedgerCmd returns the ledger command
+func LedgerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ledger",
+		Short: "Refer to work tracked in other ORC ledgers",
+		Long: `Register other ORC ledgers (a client factory's, say) under an alias and
+refer to their entities as alias:ID, e.g. acme:SHIP-004.
+
+Other ledgers are only ever read, never written. Links from entities here to
+entities there show up in show views and, for the focused shipment, in the
+summary. A link whose ledger can't be read stays listed with the reason.
+
+Examples:
+  orc ledger add acme ~/clients/acme/.orc/orc.db
+  orc ledger show acme:SHIP-004
+  orc ledger link SHIP-012 acme:SHIP-004
+  orc ledger list`,
+	}
+	cmd.AddCommand(ledgerAddCmd())
+	cmd.AddCommand(ledgerListCmd())
+	cmd.AddCommand(ledgerRemoveCmd())
+	cmd.AddCommand(ledgerShowCmd())
+	cmd.AddCommand(ledgerLinkCmd())
+	cmd.AddCommand(ledgerUnlinkCmd())
+	return cmd
+}
+
+func ledgerAddCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add [alias] [path]",
+		Short: "Register another ledger under an alias",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ledger, err := wire.LedgerService().AddLedger(NewContext(), args[0], args[1])
+			if err != nil {
+				return fmt.Errorf("failed to add ledger: %w", err)
+			}
+			fmt.Printf("✓ Ledger %s registered (%s, schema v%d)\n", ledger.Alias, ledger.Path, ledger.SchemaVersion)
+			fmt.Printf("  Refer to its entities as %s:SHIP-001\n", ledger.Alias)
+			return nil
+		},
+	}
+}
+
+func ledgerListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List registered ledgers and whether they can be read",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ledgers, err := wire.LedgerService().ListLedgers(NewContext())
+			if err != nil {
+				return fmt.Errorf("failed to list ledgers: %w", err)
+			}
+			if len(ledgers) == 0 {
+				fmt.Println("No ledgers registered.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ALIAS\tPATH\tSTATE")
+			fmt.Fprintln(w, "-----\t----\t-----")
+			for _, l := range ledgers {
+				state := fmt.Sprintf("ok (schema v%d)", l.SchemaVersion)
+				if l.Problem != "" {
+					state = "unreadable: " + l.Problem
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\n", l.Alias, l.Path, state)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+func ledgerRemoveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove [alias]",
+		Short: "Unregister a ledger and drop the links made to it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := wire.LedgerService().RemoveLedger(NewContext(), args[0]); err != nil {
+				return fmt.Errorf("failed to remove ledger: %w", err)
+			}
+			fmt.Printf("✓ Ledger %s removed\n", args[0])
+			return nil
+		},
+	}
+}
+
+func ledgerShowCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show [ref]",
+		Short: "Show an entity from another ledger (alias:ID)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entity, err := wire.LedgerService().Resolve(NewContext(), args[0])
+			if err != nil {
+				return err
+			}
+			foreignEntityDetail(entity).render(os.Stdout)
+			return nil
+		},
+	}
+}
+
+// foreignEntityDetail builds the show view for another ledger's entity.
+func foreignEntityDetail(entity *primary.ForeignEntity) *detailView {
+	kind := strings.ToUpper(entity.Type[:1]) + entity.Type[1:]
+	v := newDetailView(kind, entity.Ref)
+	v.field("Title", entity.Title)
+	v.field("Status", entity.Status)
+	v.field("Ledger", entity.Ledger+" (read-only)")
+	if entity.CommissionID != "" {
+		v.field("Commission", entity.Ledger+":"+entity.CommissionID)
+	}
+	return v
+}
+
+func ledgerLinkCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "link [entity-id] [ref]",
+		Short: "Link an entity here to one in another ledger",
+		Long: `Link a commission, shipment, task, tome, note, or plan to an entity in a
+registered ledger. The reference must resolve when linked.
+
+Examples:
+  orc ledger link SHIP-012 acme:SHIP-004
+  orc ledger link TASK-230 acme:TASK-051`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entity, err := wire.LedgerService().LinkRef(NewContext(), args[0], args[1])
+			if err != nil {
+				return fmt.Errorf("failed to link: %w", err)
+			}
+			fmt.Printf("✓ %s linked to %s\n", args[0], entityLine(entity.Ref, entity.Title, entity.Status))
+			return nil
+		},
+	}
+}
+
+func ledgerUnlinkCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unlink [entity-id] [ref]",
+		Short: "Remove a link to another ledger",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := wire.LedgerService().UnlinkRef(NewContext(), args[0], args[1]); err != nil {
+				return fmt.Errorf("failed to unlink: %w", err)
+			}
+			fmt.Printf("✓ %s unlinked from %s\n", args[0], args[1])
+			return nil
+		},
+	}
+}
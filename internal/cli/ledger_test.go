@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func TestDescribeLedgerRef(t *testing.T) {
+	resolved := &primary.LedgerRef{Ref: "acme:SHIP-004", Entity: &primary.ForeignEntity{Title: "Payments API", Status: "in-progress"}}
+	if got := describeLedgerRef(resolved); got != "acme:SHIP-004 Payments API [in-progress]" {
+		t.Errorf("describeLedgerRef(resolved) = %q", got)
+	}
+	unresolved := &primary.LedgerRef{Ref: "acme:SHIP-004", Problem: "ledger not readable"}
+	if got := describeLedgerRef(unresolved); got != "acme:SHIP-004 (unresolved: ledger not readable)" {
+		t.Errorf("describeLedgerRef(unresolved) = %q", got)
+	}
+}
+
+func TestForeignEntityDetail(t *testing.T) {
+	var buf bytes.Buffer
+	foreignEntityDetail(&primary.ForeignEntity{
+		Ref: "acme:SHIP-004", Ledger: "acme", ID: "SHIP-004", Type: "shipment",
+		Title: "Payments API", Status: "in-progress", CommissionID: "COMM-001",
+	}).render(&buf)
+
+	out := buf.String()
+	for _, want := range []string{"Shipment:   acme:SHIP-004", "Ledger:     acme (read-only)", "Commission: acme:COMM-001"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %q in:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// LockCmd returns the lock command group.
+func LockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock",
+		Short: "Advisory locks against concurrent edits",
+		Long: `Lock a shipment or plan while you restructure it.
+
+Other actors editing a locked entity get a "locked by ... since ..." error
+instead of overwriting your changes. Locks expire on their own; acquire
+again to extend one.`,
+	}
+
+	cmd.AddCommand(lockAcquireCmd())
+	cmd.AddCommand(lockReleaseCmd())
+	cmd.AddCommand(lockStatusCmd())
+	return cmd
+}
+
+func lockAcquireCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "acquire <entity-id>",
+		Short: "Lock a shipment or plan (or extend your lock)",
+		Long: `Lock a shipment or plan for the current actor.
+
+Acquiring a lock you already hold extends it by --ttl.
+
+Examples:
+  orc lock acquire SHIP-010
+  orc lock acquire PLAN-003 --ttl 1h --reason "rewriting steps"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			ttl, _ := cmd.Flags().GetDuration("ttl")
+			reason, _ := cmd.Flags().GetString("reason")
+
+			lock, err := wire.LockService().AcquireLock(ctx, primary.AcquireLockRequest{
+				EntityID: args[0],
+				TTL:      ttl,
+				Reason:   reason,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("✓ Locked %s until %s\n", lock.EntityID, formatClock(lock.ExpiresAt))
+			return nil
+		},
+	}
+
+	cmd.Flags().Duration("ttl", 0, "Lock duration (default 30m, max 8h)")
+	cmd.Flags().String("reason", "", "What the lock is for, shown to others")
+	return cmd
+}
+
+func lockReleaseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release <entity-id>",
+		Short: "Release a lock",
+		Long: `Release a lock you hold. Use --force to break another actor's lock.
+
+Examples:
+  orc lock release SHIP-010
+  orc lock release PLAN-003 --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			force, _ := cmd.Flags().GetBool("force")
+
+			if err := wire.LockService().ReleaseLock(ctx, args[0], force); err != nil {
+				return err
+			}
+
+			fmt.Printf("✓ Released lock on %s\n", args[0])
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool("force", false, "Break a lock held by another actor")
+	return cmd
+}
+
+func lockStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status [entity-id]",
+		Short: "Show who holds a lock",
+		Long: `Show the lock on an entity, or all active locks without an argument.
+
+Examples:
+  orc lock status
+  orc lock status SHIP-010`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			if len(args) == 1 {
+				lock, err := wire.LockService().GetLock(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				if lock == nil {
+					fmt.Printf("%s is not locked\n", args[0])
+					return nil
+				}
+				fmt.Printf("%s is locked by %s since %s (expires %s)\n",
+					lock.EntityID, lock.HeldBy, formatClock(lock.AcquiredAt), formatClock(lock.ExpiresAt))
+				if lock.Reason != "" {
+					fmt.Printf("Reason: %s\n", lock.Reason)
+				}
+				return nil
+			}
+
+			locks, err := wire.LockService().ListLocks(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list locks: %w", err)
+			}
+			if len(locks) == 0 {
+				fmt.Println("No active locks.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ENTITY\tHELD BY\tSINCE\tEXPIRES\tREASON")
+			for _, l := range locks {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+					l.EntityID, l.HeldBy, formatClock(l.AcquiredAt), formatClock(l.ExpiresAt), truncate(l.Reason, 40))
+			}
+			return w.Flush()
+		},
+	}
+}
@@ -1,9 +1,13 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"time"
 
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
 	"github.com/example/orc/internal/ports/primary"
@@ -19,15 +23,29 @@ var logCmd = &cobra.Command{
 var logTailCmd = &cobra.Command{
 	Use:   "tail",
 	Short: "Show recent activity",
-	Long:  "Show recent activity log entries (default 50)",
+	Long: `Show recent activity log entries (default 50).
+
+With --follow, new entries stream as they are written, which is handy for
+watching several IMPs at once. --format json emits one JSON object per line
+for piping into other tools.
+
+Examples:
+  orc log tail
+  orc log tail --follow --entity SHIP-010
+  orc log tail -f --actor IMP-BENCH-003 --format json | jq .`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := NewContext()
 		limit, _ := cmd.Flags().GetInt("limit")
 		workshopID, _ := cmd.Flags().GetString("workshop")
 		actorID, _ := cmd.Flags().GetString("actor")
 		entityType, _ := cmd.Flags().GetString("type")
+		entityID, _ := cmd.Flags().GetString("entity")
 		follow, _ := cmd.Flags().GetBool("follow")
+		format, _ := cmd.Flags().GetString("format")
 
+		if format != "text" && format != "json" {
+			return fmt.Errorf("invalid format %q (must be text or json)", format)
+		}
 		if limit <= 0 {
 			limit = 50
 		}
@@ -36,6 +54,7 @@ var logTailCmd = &cobra.Command{
 			WorkshopID: workshopID,
 			ActorID:    actorID,
 			EntityType: entityType,
+			EntityID:   entityID,
 			Limit:      limit,
 		}
 
@@ -45,41 +64,126 @@ var logTailCmd = &cobra.Command{
 			return fmt.Errorf("failed to fetch logs: %w", err)
 		}
 
-		printLogEntries(entries)
-
-		// If --follow, poll for new entries
-		if follow {
-			var lastTimestamp string
-			if len(entries) > 0 {
-				lastTimestamp = entries[0].Timestamp
+		printer := newLogPrinter(os.Stdout, format)
+		if format == "text" && !follow {
+			printLogEntries(entries)
+		} else {
+			for i := len(entries) - 1; i >= 0; i-- {
+				printer.print(entries[i])
 			}
+		}
 
-			for {
-				time.Sleep(1 * time.Second)
-
-				newEntries, err := wire.LogService().ListLogs(ctx, filters)
-				if err != nil {
-					fmt.Printf("Error fetching logs: %v\n", err)
-					continue
-				}
-
-				// Print only entries newer than lastTimestamp
-				for i := len(newEntries) - 1; i >= 0; i-- {
-					entry := newEntries[i]
-					if lastTimestamp == "" || entry.Timestamp > lastTimestamp {
-						printLogEntry(entry)
-						if entry.Timestamp > lastTimestamp {
-							lastTimestamp = entry.Timestamp
-						}
-					}
-				}
-			}
+		if !follow {
+			return nil
 		}
 
-		return nil
+		// Poll for entries written since the newest one shown
+		cursor := &logCursor{}
+		cursor.advance(entries)
+		filters.Limit = 0
+		for {
+			time.Sleep(1 * time.Second)
+
+			filters.Since = cursor.since
+			newEntries, err := wire.LogService().ListLogs(ctx, filters)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error fetching logs: %v\n", err)
+				continue
+			}
+			for _, entry := range cursor.advance(newEntries) {
+				printer.print(entry)
+			}
+		}
 	},
 }
 
+// logCursor tracks which entries follow mode has already shown. Timestamps
+// have one-second resolution, so IDs seen at the newest second are kept to
+// avoid repeating them on the next (inclusive) poll.
+type logCursor struct {
+	since string          // Timestamp of the newest entry shown
+	seen  map[string]bool // IDs shown at that timestamp
+}
+
+// advance takes entries newest first and returns the unseen ones oldest first.
+func (c *logCursor) advance(entries []*primary.LogEntry) []*primary.LogEntry {
+	var fresh []*primary.LogEntry
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		if entry.Timestamp < c.since || (entry.Timestamp == c.since && c.seen[entry.ID]) {
+			continue
+		}
+		if entry.Timestamp > c.since {
+			c.since = entry.Timestamp
+			c.seen = make(map[string]bool)
+		}
+		c.seen[entry.ID] = true
+		fresh = append(fresh, entry)
+	}
+	return fresh
+}
+
+// logPrinter writes log entries as colorized text or JSON lines.
+type logPrinter struct {
+	w    io.Writer
+	json bool
+}
+
+func newLogPrinter(w io.Writer, format string) *logPrinter {
+	return &logPrinter{w: w, json: format == "json"}
+}
+
+// logEntryJSON is the --format json shape of a log entry.
+type logEntryJSON struct {
+	ID         string `json:"id"`
+	Timestamp  string `json:"timestamp"`
+	WorkshopID string `json:"workshop_id"`
+	ActorID    string `json:"actor_id,omitempty"`
+	Action     string `json:"action"`
+	EntityType string `json:"entity_type"`
+	EntityID   string `json:"entity_id"`
+	FieldName  string `json:"field,omitempty"`
+	OldValue   string `json:"old_value,omitempty"`
+	NewValue   string `json:"new_value,omitempty"`
+	Forced     bool   `json:"forced,omitempty"`
+}
+
+func (p *logPrinter) print(entry *primary.LogEntry) {
+	if p.json {
+		data, _ := json.Marshal(logEntryJSON{
+			ID:         entry.ID,
+			Timestamp:  entry.Timestamp,
+			WorkshopID: entry.WorkshopID,
+			ActorID:    entry.ActorID,
+			Action:     entry.Action,
+			EntityType: entry.EntityType,
+			EntityID:   entry.EntityID,
+			FieldName:  entry.FieldName,
+			OldValue:   entry.OldValue,
+			NewValue:   entry.NewValue,
+			Forced:     entry.Forced,
+		})
+		fmt.Fprintln(p.w, string(data))
+		return
+	}
+	fmt.Fprintln(p.w, formatLogEntry(entry))
+}
+
+// colorizeAction formats a log action with its icon and semantic color.
+func colorizeAction(action string) string {
+	label := getActionIcon(action) + " " + action
+	switch action {
+	case "create":
+		return color.New(color.FgGreen).Sprint(label)
+	case "update":
+		return color.New(color.FgYellow).Sprint(label)
+	case "delete":
+		return color.New(color.FgRed).Sprint(label)
+	default:
+		return label
+	}
+}
+
 var logShowCmd = &cobra.Command{
 	Use:   "show [entity-id]",
 	Short: "Show activity for a specific entity",
@@ -156,30 +260,34 @@ func printLogEntries(entries []*primary.LogEntry) {
 }
 
 func printLogEntry(entry *primary.LogEntry) {
-	// Format: timestamp | actor | action | entity_type/entity_id | field changes
+	fmt.Println(formatLogEntry(entry))
+}
+
+// formatLogEntry renders an entry as
+// timestamp | actor | action | entity_type/entity_id | field changes.
+func formatLogEntry(entry *primary.LogEntry) string {
 	actorStr := entry.ActorID
 	if actorStr == "" {
 		actorStr = "-"
 	}
 
-	actionIcon := getActionIcon(entry.Action)
-
-	// Base line
-	fmt.Printf("%s | %-12s | %s %s | %s/%s",
+	line := fmt.Sprintf("%s | %-12s | %s | %s/%s",
 		formatTimestamp(entry.Timestamp),
 		actorStr,
-		actionIcon,
-		entry.Action,
+		colorizeAction(entry.Action),
 		entry.EntityType,
 		entry.EntityID,
 	)
 
 	// Field changes for updates
 	if entry.Action == "update" && entry.FieldName != "" {
-		fmt.Printf(" | %s: %s -> %s", entry.FieldName, entry.OldValue, entry.NewValue)
+		line += fmt.Sprintf(" | %s: %s -> %s", entry.FieldName, entry.OldValue, entry.NewValue)
+	}
+	if entry.Forced {
+		line += " [forced]"
 	}
 
-	fmt.Println()
+	return line
 }
 
 func getActionIcon(action string) string {
@@ -195,14 +303,6 @@ func getActionIcon(action string) string {
 	}
 }
 
-func formatTimestamp(ts string) string {
-	t, err := time.Parse(time.RFC3339, ts)
-	if err != nil {
-		return ts
-	}
-	return t.Format("2006-01-02 15:04:05")
-}
-
 // LogCmd returns the log command with all subcommands attached.
 func LogCmd() *cobra.Command {
 	// log tail
@@ -210,7 +310,9 @@ func LogCmd() *cobra.Command {
 	logTailCmd.Flags().String("workshop", "", "Filter by workshop ID")
 	logTailCmd.Flags().String("actor", "", "Filter by actor ID")
 	logTailCmd.Flags().String("type", "", "Filter by entity type")
-	logTailCmd.Flags().BoolP("follow", "f", false, "Follow mode: poll for new entries")
+	logTailCmd.Flags().String("entity", "", "Filter by entity ID (e.g. SHIP-010)")
+	logTailCmd.Flags().BoolP("follow", "f", false, "Follow mode: stream new entries as they are written")
+	logTailCmd.Flags().String("format", "text", "Output format (text or json)")
 
 	// log show
 	logShowCmd.Flags().String("actor", "", "Filter by actor ID")
@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func TestLogCursor_Advance(t *testing.T) {
+	cursor := &logCursor{}
+
+	// Initial batch (newest first) is returned oldest first
+	fresh := cursor.advance([]*primary.LogEntry{
+		{ID: "WL-0002", Timestamp: "2026-01-01T10:00:00Z"},
+		{ID: "WL-0001", Timestamp: "2026-01-01T09:59:59Z"},
+	})
+	if len(fresh) != 2 || fresh[0].ID != "WL-0001" || fresh[1].ID != "WL-0002" {
+		t.Fatalf("expected both entries oldest first, got %v", logIDs(fresh))
+	}
+
+	// Next poll is inclusive of the boundary second: already-shown IDs are skipped,
+	// new entries written in the same second are not
+	fresh = cursor.advance([]*primary.LogEntry{
+		{ID: "WL-0004", Timestamp: "2026-01-01T10:00:01Z"},
+		{ID: "WL-0003", Timestamp: "2026-01-01T10:00:00Z"},
+		{ID: "WL-0002", Timestamp: "2026-01-01T10:00:00Z"},
+	})
+	if len(fresh) != 2 || fresh[0].ID != "WL-0003" || fresh[1].ID != "WL-0004" {
+		t.Fatalf("expected WL-0003, WL-0004, got %v", logIDs(fresh))
+	}
+	if cursor.since != "2026-01-01T10:00:01Z" {
+		t.Errorf("since = %q, want newest timestamp", cursor.since)
+	}
+
+	// Nothing new
+	fresh = cursor.advance([]*primary.LogEntry{
+		{ID: "WL-0004", Timestamp: "2026-01-01T10:00:01Z"},
+	})
+	if len(fresh) != 0 {
+		t.Errorf("expected no fresh entries, got %v", logIDs(fresh))
+	}
+}
+
+func TestLogPrinter_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	printer := newLogPrinter(&buf, "json")
+
+	printer.print(&primary.LogEntry{
+		ID:         "WL-0001",
+		Timestamp:  "2026-01-01T10:00:00Z",
+		WorkshopID: "WORK-001",
+		ActorID:    "IMP-BENCH-003",
+		Action:     "update",
+		EntityType: "task",
+		EntityID:   "TASK-001",
+		FieldName:  "status",
+		OldValue:   "open",
+		NewValue:   "in-progress",
+	})
+
+	var got map[string]string
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output is not a JSON object: %v\n%s", err, buf.String())
+	}
+	if got["entity_id"] != "TASK-001" || got["field"] != "status" || got["new_value"] != "in-progress" {
+		t.Errorf("unexpected JSON fields: %v", got)
+	}
+	if strings.Count(buf.String(), "\n") != 1 {
+		t.Errorf("expected one line per entry, got %q", buf.String())
+	}
+}
+
+func TestFormatLogEntry_Color(t *testing.T) {
+	entry := &primary.LogEntry{Timestamp: "2026-01-01T10:00:00Z", Action: "delete", EntityType: "note", EntityID: "NOTE-001"}
+
+	noColor := color.NoColor
+	defer func() { color.NoColor = noColor }()
+
+	color.NoColor = true
+	plain := formatLogEntry(entry)
+	if strings.Contains(plain, "\033[") {
+		t.Errorf("expected no escape codes without color, got %q", plain)
+	}
+	if !strings.Contains(plain, "| - delete | note/NOTE-001") {
+		t.Errorf("unexpected plain format: %q", plain)
+	}
+
+	color.NoColor = false
+	colored := formatLogEntry(entry)
+	if !strings.Contains(colored, "\033[31m- delete\033[0m") {
+		t.Errorf("expected red delete action, got %q", colored)
+	}
+}
+
+func logIDs(entries []*primary.LogEntry) []string {
+	ids := make([]string, len(entries))
+	for i, e := range entries {
+		ids[i] = e.ID
+	}
+	return ids
+}
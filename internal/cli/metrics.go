@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// MetricsCmd returns the metrics command group for monitoring integrations.
+func MetricsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metrics",
+		Short: "Export ledger metrics for monitoring",
+		Long: `Expose ledger gauges and counters in the Prometheus text format so
+existing monitoring stacks can alert on factory health.
+
+Metrics:
+  orc_tasks{commission,status}                task counts
+  orc_shipments{commission,status}            shipment counts (ready = queue depth)
+  orc_plans{commission,status}                plan counts (draft = awaiting approval)
+  orc_hook_events_total{hook_type,decision}   recorded hook invocations
+  orc_build_info{commit}                      build of the serving binary`,
+	}
+
+	cmd.AddCommand(metricsServeCmd())
+	cmd.AddCommand(metricsPrintCmd())
+	return cmd
+}
+
+func metricsServeCmd() *cobra.Command {
+	var host string
+	var port int
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve metrics over HTTP for Prometheus to scrape",
+		Long: `Serve /metrics over HTTP. Counts are queried from the ledger on each
+scrape. Binds to localhost by default; use --host 0.0.0.0 to expose it.
+
+Examples:
+  orc metrics serve
+  orc metrics serve --port 9200 --host 0.0.0.0`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr := net.JoinHostPort(host, strconv.Itoa(port))
+
+			mux := http.NewServeMux()
+			mux.Handle("/metrics", metricsHandler(wire.MetricsService()))
+
+			server := &http.Server{
+				Addr:              addr,
+				Handler:           mux,
+				ReadHeaderTimeout: 5 * time.Second,
+			}
+
+			fmt.Printf("📈 Serving metrics on http://%s/metrics\n", addr)
+			if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return fmt.Errorf("metrics server failed: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&host, "host", "127.0.0.1", "Address to bind")
+	cmd.Flags().IntVarP(&port, "port", "p", 9109, "Port to listen on")
+	return cmd
+}
+
+func metricsPrintCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "print",
+		Short: "Print current metrics to stdout",
+		Long: `Print the current metrics once, e.g. for node_exporter's textfile collector:
+
+  orc metrics print > /var/lib/node_exporter/orc.prom`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			out, err := wire.MetricsService().ExportPrometheus(NewContext())
+			if err != nil {
+				return fmt.Errorf("failed to collect metrics: %w", err)
+			}
+			fmt.Print(out)
+			return nil
+		},
+	}
+}
+
+// metricsHandler serves the Prometheus exposition for each scrape.
+func metricsHandler(service primary.MetricsService) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		out, err := service.ExportPrometheus(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", primary.PrometheusContentType)
+		_, _ = fmt.Fprint(w, out)
+	})
+}
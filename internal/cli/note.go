@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
@@ -25,7 +28,11 @@ var noteCreateCmd = &cobra.Command{
 
 Notes can be attached to a container (shipment or tome) or exist
 directly under the commission. If no container flag is provided, the note
-is created at the commission level.`,
+is created at the commission level.
+
+Bug notes enter the triage queue (orc note triage). Give them a severity
+up front with --severity P0-P3; untriaged P0 and P1 bugs are shown at the
+top of orc summary.`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := NewContext()
@@ -35,6 +42,7 @@ is created at the commission level.`,
 		noteType, _ := cmd.Flags().GetString("type")
 		shipmentID, _ := cmd.Flags().GetString("shipment")
 		tomeID, _ := cmd.Flags().GetString("tome")
+		severity, _ := cmd.Flags().GetString("severity")
 
 		// Validate entity IDs
 		if err := validateEntityID(shipmentID, "shipment"); err != nil {
@@ -66,9 +74,10 @@ is created at the commission level.`,
 			"vision":   true,
 			"idea":     true,
 			"exorcism": true,
+			"handoff":  true,
 		}
 		if noteType != "" && !validTypes[noteType] {
-			return fmt.Errorf("invalid note type: %s\nValid types: learning, concern, finding, frq, bug, spec, roadmap, decision, question, vision, idea, exorcism, journal", noteType)
+			return fmt.Errorf("invalid note type: %s\nValid types: learning, concern, finding, frq, bug, spec, roadmap, decision, question, vision, idea, exorcism, handoff, journal", noteType)
 		}
 
 		// Determine container
@@ -82,6 +91,11 @@ is created at the commission level.`,
 			containerType = "tome"
 		}
 
+		budgetWarning, err := checkContentBudget(content)
+		if err != nil {
+			return err
+		}
+
 		resp, err := wire.NoteService().CreateNote(ctx, primary.CreateNoteRequest{
 			CommissionID:  commissionID,
 			Title:         title,
@@ -89,6 +103,7 @@ is created at the commission level.`,
 			Type:          noteType,
 			ContainerID:   containerID,
 			ContainerType: containerType,
+			Severity:      severity,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create note: %w", err)
@@ -99,12 +114,18 @@ is created at the commission level.`,
 		if note.Type != "" {
 			fmt.Printf("  Type: %s\n", note.Type)
 		}
+		if note.Severity != "" {
+			fmt.Printf("  Severity: %s\n", note.Severity)
+		}
 		if containerID != "" {
 			fmt.Printf("  Container: %s (%s)\n", containerID, containerType)
 		} else {
 			fmt.Printf("  Container: (commission-level)\n")
 		}
 		fmt.Printf("  Commission: %s\n", note.CommissionID)
+		if budgetWarning != "" {
+			fmt.Println(budgetWarning)
+		}
 		return nil
 	},
 }
@@ -119,6 +140,7 @@ var noteListCmd = &cobra.Command{
 		shipmentID, _ := cmd.Flags().GetString("shipment")
 		tomeID, _ := cmd.Flags().GetString("tome")
 		commissionOnly, _ := cmd.Flags().GetBool("commission-only")
+		resolution, _ := cmd.Flags().GetString("resolution")
 
 		// Validate entity IDs
 		if err := validateEntityID(shipmentID, "shipment"); err != nil {
@@ -151,6 +173,7 @@ var noteListCmd = &cobra.Command{
 			notes, err = wire.NoteService().ListNotes(ctx, primary.NoteFilters{
 				Type:         noteType,
 				CommissionID: commissionID,
+				Resolution:   resolution,
 			})
 		}
 
@@ -158,6 +181,17 @@ var noteListCmd = &cobra.Command{
 			return fmt.Errorf("failed to list notes: %w", err)
 		}
 
+		// Container queries don't take filters; apply the resolution here
+		if resolution != "" {
+			filtered := notes[:0]
+			for _, n := range notes {
+				if n.Resolution == resolution {
+					filtered = append(filtered, n)
+				}
+			}
+			notes = filtered
+		}
+
 		if len(notes) == 0 {
 			fmt.Println("No notes found.")
 			return nil
@@ -175,9 +209,14 @@ var noteListCmd = &cobra.Command{
 			if n.Type != "" {
 				typeStr = n.Type
 			}
-			statusStr := n.Status
-			if statusStr == "" {
-				statusStr = "open"
+			if n.Severity != "" {
+				typeStr += " " + n.Severity
+			}
+			statusStr := noteStatus(n)
+			if n.Resolution != "" {
+				statusStr += " (" + n.Resolution + ")"
+			} else if n.Draft {
+				statusStr += " (draft)"
 			}
 			container := "-"
 			if n.ShipmentID != "" {
@@ -195,7 +234,17 @@ var noteListCmd = &cobra.Command{
 var noteShowCmd = &cobra.Command{
 	Use:   "show [note-id]",
 	Short: "Show note details",
-	Args:  cobra.ExactArgs(1),
+	Long: `Show a note's details and content.
+
+Long output goes through $PAGER (falls back to less -R) when writing to a
+terminal. --section shows only the markdown section under a heading (matched
+case-insensitively, in full or by prefix) and --head the first N lines.
+
+Examples:
+  orc note show NOTE-042
+  orc note show NOTE-042 --section "Open questions"
+  orc note show NOTE-042 --head 40`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := NewContext()
 		noteID := args[0]
@@ -205,48 +254,84 @@ var noteShowCmd = &cobra.Command{
 			return fmt.Errorf("note not found: %w", err)
 		}
 
-		fmt.Printf("Note: %s\n", note.ID)
-		fmt.Printf("Title: %s\n", note.Title)
-		if note.Content != "" {
-			fmt.Printf("Content: %s\n", note.Content)
-		}
-		if note.Type != "" {
-			fmt.Printf("Type: %s\n", note.Type)
-		}
-		status := note.Status
-		if status == "" {
-			status = "open"
-		}
-		fmt.Printf("Status: %s\n", status)
-		fmt.Printf("Commission: %s\n", note.CommissionID)
-		if note.ShipmentID != "" {
-			fmt.Printf("Shipment: %s\n", note.ShipmentID)
-		}
-		if note.TomeID != "" {
-			fmt.Printf("Tome: %s\n", note.TomeID)
-		}
-		if note.Pinned {
-			fmt.Printf("Pinned: yes\n")
-		}
-		if note.PromotedFromID != "" {
-			fmt.Printf("Promoted from: %s (%s)\n", note.PromotedFromID, note.PromotedFromType)
-		}
-		if note.CloseReason != "" {
-			fmt.Printf("Close reason: %s\n", note.CloseReason)
-		}
-		if note.ClosedByNoteID != "" {
-			fmt.Printf("Closed by: %s\n", note.ClosedByNoteID)
-		}
-		fmt.Printf("Created: %s\n", note.CreatedAt)
-		fmt.Printf("Updated: %s\n", note.UpdatedAt)
-		if note.ClosedAt != "" {
-			fmt.Printf("Closed: %s\n", note.ClosedAt)
+		if note.Content, err = selectContentFromFlags(cmd, note.Content); err != nil {
+			return err
 		}
 
-		return nil
+		var out strings.Builder
+		noteDetail(note, loadRelations(ctx, noteID, "note")).render(&out)
+		return printOrPage(cmd, out.String())
 	},
 }
 
+// noteDetail builds the note show view.
+func noteDetail(note *primary.Note, rel detailRelations) *detailView {
+	v := newDetailView("Note", note.ID+presenceMarker(rel.presence))
+	v.field("Title", note.Title)
+	v.field("Content", note.Content)
+	v.field("Type", note.Type)
+	if note.Type == primary.NoteTypeBug {
+		v.field("Severity", orDash(note.Severity))
+		v.field("Triage", triageStatusLabel(note.TriageStatus))
+	}
+	v.field("Status", noteStatus(note))
+	if note.Pinned {
+		v.field("Pinned", "yes")
+	}
+	if note.Draft {
+		v.field("Draft", "yes (not yet confirmed)")
+	}
+	v.field("Resolution", note.Resolution)
+	v.field("Close reason", note.CloseReason)
+	v.field("Created", formatTime(note.CreatedAt))
+	v.field("Updated", formatTime(note.UpdatedAt))
+	v.field("Closed", formatTime(note.ClosedAt))
+
+	v.panel(rel.relatedPanel(
+		detailField{"Commission", note.CommissionID},
+		detailField{"Shipment", note.ShipmentID},
+		detailField{"Tome", note.TomeID},
+		detailField{"Promoted from", note.PromotedFromID},
+		detailField{"Closed by", note.ClosedByNoteID},
+	))
+	v.panel(commentsPanel(rel.comments))
+	v.panel(eventsPanel(rel.events))
+	v.panel(nextPanel(noteNextActions(note)...))
+	return v
+}
+
+// noteResolutionList names the resolutions accepted by orc note close.
+const noteResolutionList = "fixed, duplicate, wontfix, promoted, superseded"
+
+// noteStatus returns a note's status; notes without one are open.
+func noteStatus(note *primary.Note) string {
+	if note.Status == "" {
+		return "open"
+	}
+	return note.Status
+}
+
+// noteNextActions suggests commands that move a note forward.
+func noteNextActions(note *primary.Note) []string {
+	switch {
+	case noteStatus(note) == "closed":
+		return []string{"orc note reopen " + note.ID}
+	case note.Draft:
+		return []string{"orc note confirm " + note.ID, fmt.Sprintf("orc note update %s --content \"...\"", note.ID)}
+	case note.Type == primary.NoteTypeBug && (note.TriageStatus == "" || note.TriageStatus == primary.NoteTriageUntriaged):
+		return []string{fmt.Sprintf("orc note triage %s --severity %s --status accepted", note.ID, severityOr(note.Severity, "P2"))}
+	}
+	return []string{fmt.Sprintf("orc note close %s --resolution fixed", note.ID)}
+}
+
+// severityOr returns severity, or fallback when the bug has none.
+func severityOr(severity, fallback string) string {
+	if severity == "" {
+		return fallback
+	}
+	return severity
+}
+
 var noteUpdateCmd = &cobra.Command{
 	Use:   "update [note-id]",
 	Short: "Update note title, content, and/or type",
@@ -277,13 +362,19 @@ var noteUpdateCmd = &cobra.Command{
 				"vision":   true,
 				"idea":     true,
 				"exorcism": true,
+				"handoff":  true,
 			}
 			if !validTypes[noteType] {
-				return fmt.Errorf("invalid note type: %s\nValid types: learning, concern, finding, frq, bug, spec, roadmap, decision, question, vision, idea, exorcism, journal", noteType)
+				return fmt.Errorf("invalid note type: %s\nValid types: learning, concern, finding, frq, bug, spec, roadmap, decision, question, vision, idea, exorcism, handoff, journal", noteType)
 			}
 		}
 
-		err := wire.NoteService().UpdateNote(ctx, primary.UpdateNoteRequest{
+		budgetWarning, err := checkContentBudget(content)
+		if err != nil {
+			return err
+		}
+
+		err = wire.NoteService().UpdateNote(ctx, primary.UpdateNoteRequest{
 			NoteID:  noteID,
 			Title:   title,
 			Content: content,
@@ -294,6 +385,9 @@ var noteUpdateCmd = &cobra.Command{
 		}
 
 		fmt.Printf("✓ Note %s updated\n", noteID)
+		if budgetWarning != "" {
+			fmt.Println(budgetWarning)
+		}
 		return nil
 	},
 }
@@ -354,28 +448,51 @@ var noteDeleteCmd = &cobra.Command{
 
 var noteCloseCmd = &cobra.Command{
 	Use:   "close [note-id]",
-	Short: "Close a note with a reason",
-	Args:  cobra.ExactArgs(1),
+	Short: "Close a note with a resolution",
+	Long: `Close a note, recording how it was resolved.
+
+Resolutions:
+  fixed       The issue was addressed
+  duplicate   Another note covers it (requires --by)
+  wontfix     Deliberately not acted on
+  promoted    Became a shipment, task, or decision
+  superseded  A newer note replaces it (requires --by)
+
+The older --reason values are still accepted and imply a resolution:
+resolved → fixed, deferred and stale → wontfix, duplicate → duplicate,
+superseded and synthesized → superseded.
+
+Examples:
+  orc note close NOTE-042 --resolution fixed
+  orc note close NOTE-042 --resolution duplicate --by NOTE-017
+  orc note close NOTE-042 --reason synthesized --by NOTE-050`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := NewContext()
 		noteID := args[0]
+		resolution, _ := cmd.Flags().GetString("resolution")
 		reason, _ := cmd.Flags().GetString("reason")
 		byNoteID, _ := cmd.Flags().GetString("by")
 
-		if reason == "" {
-			return fmt.Errorf("--reason is required\nValid reasons: superseded, synthesized, resolved, deferred, duplicate, stale")
+		if resolution == "" && reason == "" {
+			return fmt.Errorf("--resolution is required\nValid resolutions: %s", noteResolutionList)
 		}
 
 		err := wire.NoteService().CloseNote(ctx, primary.CloseNoteRequest{
-			NoteID:   noteID,
-			Reason:   reason,
-			ByNoteID: byNoteID,
+			NoteID:     noteID,
+			Resolution: resolution,
+			Reason:     reason,
+			ByNoteID:   byNoteID,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to close note: %w", err)
 		}
 
-		msg := fmt.Sprintf("✓ Note %s closed (reason: %s)", noteID, reason)
+		note, err := wire.NoteService().GetNote(ctx, noteID)
+		if err != nil {
+			return fmt.Errorf("failed to reload note: %w", err)
+		}
+		msg := fmt.Sprintf("✓ Note %s closed (%s)", noteID, note.Resolution)
 		if byNoteID != "" {
 			msg += fmt.Sprintf(" by %s", byNoteID)
 		}
@@ -402,6 +519,116 @@ var noteReopenCmd = &cobra.Command{
 	},
 }
 
+var noteConfirmCmd = &cobra.Command{
+	Use:   "confirm [note-id]",
+	Short: "Confirm a draft handoff note",
+	Long: `Confirm a handoff note drafted when a session ended.
+
+Review the draft first ('orc note show'), and correct it with 'orc note update'
+if the summary missed something.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		noteID := args[0]
+
+		err := wire.NoteService().ConfirmHandoff(ctx, noteID)
+		if err != nil {
+			return fmt.Errorf("failed to confirm handoff: %w", err)
+		}
+
+		fmt.Printf("✓ Handoff %s confirmed\n", noteID)
+		return nil
+	},
+}
+
+var noteResolveFromCmd = &cobra.Command{
+	Use:   "resolve-from [report-note-id]",
+	Short: "Answer question notes from a report note",
+	Long: `Answer the question notes a report answers, all at once.
+
+The report lists its answers one per line, each starting with the
+question's ID:
+
+  ## Answers
+  - NOTE-042: Yes, the API paginates with cursors.
+  - NOTE-043 → Postgres, see the benchmark above.
+
+Each answer is shown for confirmation, then appended to its question,
+which is closed as fixed with the report recorded as the note that closed
+it. Questions that are missing, already closed, or not question notes are
+listed and skipped.
+
+Examples:
+  orc note resolve-from NOTE-300
+  orc note resolve-from NOTE-300 --yes`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		reportID := args[0]
+		yes, _ := cmd.Flags().GetBool("yes")
+
+		proposed, err := wire.NoteService().ProposeAnswers(ctx, reportID)
+		if err != nil {
+			return fmt.Errorf("failed to read report: %w", err)
+		}
+		if len(proposed) == 0 {
+			fmt.Printf("No answers found in %s (expected lines like \"NOTE-042: answer\")\n", reportID)
+			return nil
+		}
+
+		answered, err := resolveFromReport(cmd.InOrStdin(), cmd.OutOrStdout(), reportID, proposed, yes, func(a *primary.ProposedAnswer) error {
+			return wire.NoteService().AnswerQuestion(ctx, primary.AnswerQuestionRequest{
+				QuestionID: a.QuestionID,
+				ReportID:   reportID,
+				Answer:     a.Answer,
+			})
+		})
+		if answered > 0 {
+			fmt.Printf("\n✓ Answered %s from %s\n", pluralize(answered, "question", "questions"), reportID)
+		}
+		return err
+	},
+}
+
+// resolveFromReport lists the answers a report proposes, asks for
+// confirmation unless yes is set, and applies the ones that can be applied.
+// It returns how many questions were answered.
+func resolveFromReport(in io.Reader, out io.Writer, reportID string, proposed []*primary.ProposedAnswer, yes bool, answer func(*primary.ProposedAnswer) error) (int, error) {
+	var ready []*primary.ProposedAnswer
+	fmt.Fprintf(out, "%s answers %s:\n", reportID, pluralize(len(proposed), "question", "questions"))
+	for _, p := range proposed {
+		if p.Problem != "" {
+			fmt.Fprintf(out, "  ✗ %s  skipped: %s\n", p.QuestionID, p.Problem)
+			continue
+		}
+		ready = append(ready, p)
+		fmt.Fprintf(out, "  ✓ %s  %s\n      → %s\n", p.QuestionID, p.QuestionTitle, truncate(p.Answer, 100))
+	}
+	if len(ready) == 0 {
+		fmt.Fprintln(out, "\nNothing to answer.")
+		return 0, nil
+	}
+
+	if !yes {
+		fmt.Fprintf(out, "\nAnswer and close %s? [y/N] ", pluralize(len(ready), "question", "questions"))
+		response, _ := bufio.NewReader(in).ReadString('\n')
+		response = strings.TrimSpace(strings.ToLower(response))
+		if response != "y" && response != "yes" {
+			fmt.Fprintln(out, "Aborted.")
+			return 0, nil
+		}
+	}
+
+	answered := 0
+	for _, p := range ready {
+		if err := answer(p); err != nil {
+			return answered, fmt.Errorf("failed to answer %s: %w", p.QuestionID, err)
+		}
+		answered++
+	}
+	return answered, nil
+}
+
 var noteMoveCmd = &cobra.Command{
 	Use:   "move [note-id]",
 	Short: "Move a note to a different container",
@@ -480,13 +707,197 @@ var noteMergeCmd = &cobra.Command{
 	},
 }
 
+var noteTriageCmd = &cobra.Command{
+	Use:   "triage [note-id]",
+	Short: "Triage bug notes by severity",
+	Long: `Walk through a commission's untriaged bug notes, most severe and then
+oldest first, and record a severity and a triage decision for each.
+
+Answer each prompt with a severity and a decision, in either order:
+
+  p1 accept     P1, accepted
+  accept        accepted, keeping the bug's current severity
+  p3 info       P3, needs more information
+  wontfix       won't fix
+  p2            P2, still untriaged
+
+Press Enter to skip a bug or q to stop. Accepting a bug needs a severity.
+Untriaged P0 and P1 bugs are shown at the top of orc summary.
+
+With a note ID and --severity and/or --status, triage that note directly.
+
+Examples:
+  orc note triage
+  orc note triage --commission COMM-001
+  orc note triage NOTE-042 --severity P1 --status accepted`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		severity, _ := cmd.Flags().GetString("severity")
+		status, _ := cmd.Flags().GetString("status")
+
+		if len(args) == 1 {
+			if severity == "" && status == "" {
+				return fmt.Errorf("must specify --severity and/or --status")
+			}
+			if status == "" {
+				note, err := wire.NoteService().GetNote(ctx, args[0])
+				if err != nil {
+					return fmt.Errorf("note not found: %w", err)
+				}
+				status = note.TriageStatus
+				if status == "" {
+					status = primary.NoteTriageUntriaged
+				}
+			}
+			err := wire.NoteService().TriageNote(ctx, primary.TriageNoteRequest{
+				NoteID:   args[0],
+				Severity: severity,
+				Status:   status,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to triage note: %w", err)
+			}
+			fmt.Printf("✓ Note %s triaged (%s)\n", args[0], triageStatusLabel(status))
+			return nil
+		}
+
+		if severity != "" || status != "" {
+			return fmt.Errorf("--severity and --status require a note ID")
+		}
+		commissionID, _ := cmd.Flags().GetString("commission")
+		if commissionID == "" {
+			commissionID = orccontext.GetContextCommissionID()
+			if commissionID == "" {
+				return fmt.Errorf("no commission context detected\nHint: Use --commission flag or run from a workbench directory")
+			}
+		}
+
+		queue, err := wire.NoteService().ListTriageQueue(ctx, primary.TriageQueueFilters{CommissionID: commissionID})
+		if err != nil {
+			return fmt.Errorf("failed to load triage queue: %w", err)
+		}
+		if len(queue) == 0 {
+			fmt.Printf("No untriaged bugs in %s.\n", commissionID)
+			return nil
+		}
+
+		triaged, err := triageNotes(cmd.InOrStdin(), cmd.OutOrStdout(), queue, func(noteID, severity, status string) error {
+			return wire.NoteService().TriageNote(ctx, primary.TriageNoteRequest{NoteID: noteID, Severity: severity, Status: status})
+		})
+		fmt.Printf("\n✓ Triaged %d of %d bug(s)\n", triaged, len(queue))
+		return err
+	},
+}
+
+// triageDecisions maps the answers accepted by orc note triage to triage states.
+var triageDecisions = map[string]string{
+	"accept":  primary.NoteTriageAccepted,
+	"a":       primary.NoteTriageAccepted,
+	"info":    primary.NoteTriageNeedsInfo,
+	"i":       primary.NoteTriageNeedsInfo,
+	"wontfix": primary.NoteTriageWontFix,
+	"w":       primary.NoteTriageWontFix,
+}
+
+// parseTriageAnswer splits an answer such as "p1 accept" into a severity and
+// a triage state. A severity alone leaves the bug untriaged.
+func parseTriageAnswer(answer string) (severity, status string, err error) {
+	for _, field := range strings.Fields(strings.ToLower(answer)) {
+		switch {
+		case len(field) == 2 && field[0] == 'p' && field[1] >= '0' && field[1] <= '3':
+			severity = strings.ToUpper(field)
+		case triageDecisions[field] != "":
+			status = triageDecisions[field]
+		default:
+			return "", "", fmt.Errorf("unrecognized %q", field)
+		}
+	}
+	if status == "" {
+		if severity == "" {
+			return "", "", fmt.Errorf("give a severity (p0-p3) and/or a decision (accept, info, wontfix)")
+		}
+		status = primary.NoteTriageUntriaged
+	}
+	return severity, status, nil
+}
+
+// triageNotes prompts for a severity and decision for each bug and records
+// them with triage. It returns how many bugs were triaged; input ending early
+// stops the walk.
+func triageNotes(in io.Reader, out io.Writer, notes []*primary.Note, triage func(noteID, severity, status string) error) (int, error) {
+	scanner := bufio.NewScanner(in)
+	triaged := 0
+	for i, note := range notes {
+		container := ""
+		if note.ShipmentID != "" {
+			container = " in " + note.ShipmentID
+		} else if note.TomeID != "" {
+			container = " in " + note.TomeID
+		}
+		fmt.Fprintf(out, "\n(%d/%d) 🐛 %s [%s]: %s%s\n", i+1, len(notes), note.ID, orDash(note.Severity), note.Title, container)
+		if note.Content != "" {
+			fmt.Fprintf(out, "  %s\n", truncate(strings.ReplaceAll(note.Content, "\n", " "), 100))
+		}
+		fmt.Fprintf(out, "  opened %s\n", formatWhen(note.CreatedAt))
+
+		for {
+			fmt.Fprint(out, "Triage (p0-p3, accept/info/wontfix, Enter to skip, q to quit): ")
+			if !scanner.Scan() {
+				fmt.Fprintln(out)
+				return triaged, scanner.Err()
+			}
+			answer := strings.TrimSpace(scanner.Text())
+			if answer == "" {
+				break
+			}
+			if answer == "q" {
+				return triaged, nil
+			}
+
+			severity, status, err := parseTriageAnswer(answer)
+			if err != nil {
+				fmt.Fprintf(out, "  %v\n", err)
+				continue
+			}
+			if err := triage(note.ID, severity, status); err != nil {
+				fmt.Fprintf(out, "  %v\n", err)
+				continue
+			}
+			if severity == "" {
+				severity = note.Severity
+			}
+			fmt.Fprintf(out, "  ✓ %s → %s %s\n", note.ID, orDash(severity), triageStatusLabel(status))
+			triaged++
+			break
+		}
+	}
+	return triaged, nil
+}
+
+// triageStatusLabel renders a bug's triage state; bugs recorded before triage
+// states existed have none and are untriaged.
+func triageStatusLabel(status string) string {
+	switch status {
+	case "", primary.NoteTriageUntriaged:
+		return "untriaged"
+	case primary.NoteTriageNeedsInfo:
+		return "needs info"
+	case primary.NoteTriageWontFix:
+		return "won't fix"
+	}
+	return status
+}
+
 func init() {
 	// note create flags
 	noteCreateCmd.Flags().StringP("commission", "c", "", "Commission ID (defaults to context)")
 	noteCreateCmd.Flags().String("content", "", "Note content")
-	noteCreateCmd.Flags().StringP("type", "t", "", "Note type (learning, concern, finding, frq, bug, spec, roadmap, decision, question, vision, idea, exorcism, journal)")
+	noteCreateCmd.Flags().StringP("type", "t", "", "Note type (learning, concern, finding, frq, bug, spec, roadmap, decision, question, vision, idea, exorcism, handoff, journal)")
 	noteCreateCmd.Flags().String("shipment", "", "Shipment ID to attach note to")
 	noteCreateCmd.Flags().String("tome", "", "Tome ID to attach note to")
+	inferFromFocus(noteCreateCmd, "commission", "shipment", "tome")
+	noteCreateCmd.Flags().String("severity", "", "Bug severity (P0, P1, P2, P3); bug notes only")
 
 	// note list flags
 	noteListCmd.Flags().StringP("commission", "c", "", "Filter by commission")
@@ -494,11 +905,12 @@ func init() {
 	noteListCmd.Flags().String("shipment", "", "Filter by shipment")
 	noteListCmd.Flags().String("tome", "", "Filter by tome")
 	noteListCmd.Flags().Bool("commission-only", false, "List only commission-level notes (not in any container)")
+	noteListCmd.Flags().String("resolution", "", "Filter closed notes by resolution: "+noteResolutionList)
 
 	// note update flags
 	noteUpdateCmd.Flags().String("title", "", "New title")
 	noteUpdateCmd.Flags().String("content", "", "New content")
-	noteUpdateCmd.Flags().String("type", "", "Note type (learning, concern, finding, frq, bug, spec, roadmap, decision, question, vision, idea, exorcism, journal)")
+	noteUpdateCmd.Flags().String("type", "", "Note type (learning, concern, finding, frq, bug, spec, roadmap, decision, question, vision, idea, exorcism, handoff, journal)")
 
 	// note move flags
 	noteMoveCmd.Flags().String("to-tome", "", "Move to tome")
@@ -506,8 +918,20 @@ func init() {
 	noteMoveCmd.Flags().String("to-commission", "", "Promote to commission level (clears container associations)")
 
 	// note close flags
-	noteCloseCmd.Flags().StringP("reason", "r", "", "Close reason (required): superseded, synthesized, resolved, deferred, duplicate, stale")
-	noteCloseCmd.Flags().String("by", "", "Reference to another note (optional)")
+	noteCloseCmd.Flags().StringP("resolution", "R", "", "Resolution (required): "+noteResolutionList)
+	noteCloseCmd.Flags().StringP("reason", "r", "", "Legacy close reason, implies a resolution: superseded, synthesized, resolved, deferred, duplicate, stale")
+	noteCloseCmd.Flags().String("by", "", "Note that replaces this one (required for duplicate and superseded)")
+
+	// note triage flags
+	noteTriageCmd.Flags().StringP("commission", "c", "", "Commission ID (defaults to context)")
+	noteTriageCmd.Flags().String("severity", "", "Severity to set (P0, P1, P2, P3); requires a note ID")
+	noteTriageCmd.Flags().String("status", "", "Triage state to set (untriaged, accepted, needs_info, wont_fix); requires a note ID")
+
+	// note show flags
+	addContentFlags(noteShowCmd)
+
+	// note resolve-from flags
+	noteResolveFromCmd.Flags().BoolP("yes", "y", false, "Answer without asking for confirmation")
 
 	// Register subcommands
 	noteCmd.AddCommand(noteCreateCmd)
@@ -519,8 +943,11 @@ func init() {
 	noteCmd.AddCommand(noteDeleteCmd)
 	noteCmd.AddCommand(noteCloseCmd)
 	noteCmd.AddCommand(noteReopenCmd)
+	noteCmd.AddCommand(noteConfirmCmd)
+	noteCmd.AddCommand(noteResolveFromCmd)
 	noteCmd.AddCommand(noteMoveCmd)
 	noteCmd.AddCommand(noteMergeCmd)
+	noteCmd.AddCommand(noteTriageCmd)
 }
 
 // NoteCmd returns the note command
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func TestParseTriageAnswer(t *testing.T) {
+	tests := []struct {
+		answer       string
+		wantSeverity string
+		wantStatus   string
+		wantErr      bool
+	}{
+		{"p1 accept", "P1", primary.NoteTriageAccepted, false},
+		{"accept P0", "P0", primary.NoteTriageAccepted, false},
+		{"a", "", primary.NoteTriageAccepted, false},
+		{"p3 info", "P3", primary.NoteTriageNeedsInfo, false},
+		{"wontfix", "", primary.NoteTriageWontFix, false},
+		{"p2", "P2", primary.NoteTriageUntriaged, false},
+		{"p5 accept", "", "", true},
+		{"maybe", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.answer, func(t *testing.T) {
+			severity, status, err := parseTriageAnswer(tt.answer)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if severity != tt.wantSeverity || status != tt.wantStatus {
+				t.Errorf("parseTriageAnswer(%q) = %q, %q, want %q, %q", tt.answer, severity, status, tt.wantSeverity, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestTriageNotes(t *testing.T) {
+	notes := []*primary.Note{
+		{ID: "NOTE-001", Title: "Checkout crashes", Severity: "P0", ShipmentID: "SHIP-001", CreatedAt: "2026-10-01T09:00:00Z"},
+		{ID: "NOTE-002", Title: "Typo on login page", CreatedAt: "2026-10-02T09:00:00Z"},
+		{ID: "NOTE-003", Title: "Skipped"},
+		{ID: "NOTE-004", Title: "Never reached"},
+	}
+
+	type decision struct{ severity, status string }
+	triaged := map[string]decision{}
+	in := strings.NewReader("accept\nsoon\naccept\np3 accept\n\nq\n")
+	var out bytes.Buffer
+
+	n, err := triageNotes(in, &out, notes, func(noteID, severity, status string) error {
+		if status == primary.NoteTriageAccepted && severity == "" && noteID == "NOTE-002" {
+			return errors.New("cannot accept NOTE-002 without a severity")
+		}
+		triaged[noteID] = decision{severity, status}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("triageNotes failed: %v", err)
+	}
+	if n != 2 || len(triaged) != 2 ||
+		triaged["NOTE-001"] != (decision{"", primary.NoteTriageAccepted}) ||
+		triaged["NOTE-002"] != (decision{"P3", primary.NoteTriageAccepted}) {
+		t.Errorf("triaged %d: %v", n, triaged)
+	}
+	for _, want := range []string{
+		"(1/4) 🐛 NOTE-001 [P0]: Checkout crashes in SHIP-001",
+		"✓ NOTE-001 → P0 accepted",
+		`unrecognized "soon"`,
+		"cannot accept NOTE-002 without a severity",
+		"(2/4) 🐛 NOTE-002 [-]: Typo on login page",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestResolveFromReport(t *testing.T) {
+	proposed := []*primary.ProposedAnswer{
+		{QuestionID: "NOTE-042", QuestionTitle: "Does it paginate?", Answer: "Yes, with cursors"},
+		{QuestionID: "NOTE-043", Answer: "Postgres", Problem: "not found"},
+		{QuestionID: "NOTE-044", QuestionTitle: "Which region?", Answer: "eu-west-1"},
+	}
+
+	var answered []string
+	answer := func(a *primary.ProposedAnswer) error {
+		answered = append(answered, a.QuestionID)
+		return nil
+	}
+
+	var out bytes.Buffer
+	n, err := resolveFromReport(strings.NewReader("n\n"), &out, "NOTE-300", proposed, false, answer)
+	if err != nil || n != 0 || len(answered) != 0 {
+		t.Fatalf("declining answered %d (%v): %v", n, answered, err)
+	}
+	for _, want := range []string{
+		"NOTE-300 answers 3 questions:",
+		"✓ NOTE-042  Does it paginate?\n      → Yes, with cursors",
+		"✗ NOTE-043  skipped: not found",
+		"Answer and close 2 questions? [y/N]",
+		"Aborted.",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+
+	n, err = resolveFromReport(strings.NewReader("y\n"), &bytes.Buffer{}, "NOTE-300", proposed, false, answer)
+	if err != nil || n != 2 || strings.Join(answered, ",") != "NOTE-042,NOTE-044" {
+		t.Errorf("confirming answered %d (%v): %v", n, answered, err)
+	}
+
+	answered = nil
+	failing := func(a *primary.ProposedAnswer) error { return errors.New("database is locked") }
+	if n, err := resolveFromReport(nil, &bytes.Buffer{}, "NOTE-300", proposed, true, failing); err == nil || n != 0 {
+		t.Errorf("expected the first failure to stop, got %d, %v", n, err)
+	}
+}
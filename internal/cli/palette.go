@@ -0,0 +1,239 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// PaletteCmd returns the palette command.
+func PaletteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "palette",
+		Short: "Pick a quick action for the current workbench with one key",
+		Long: `Show quick actions for the current workbench and its focus, then run
+the chosen orc command.
+
+Actions depend on context: claim the next task or complete the one in
+progress, open a draft PR for the focused shipment (or mark it ready, or
+fetch its review feedback), show the shipment's diff and tasks, and so on.
+
+Built for a tmux popup; the status bar's right-click menu opens it:
+  display-popup -E 'cd #{pane_current_path} && CLICOLOR_FORCE=1 orc palette'
+
+Examples:
+  orc palette
+  orc palette --list   # Print the actions without prompting`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			list, _ := cmd.Flags().GetBool("list")
+
+			pc := loadPaletteContext(NewContext())
+			actions := buildPaletteActions(pc)
+			renderPalette(os.Stdout, pc, actions)
+			if list {
+				return nil
+			}
+
+			fmt.Print("\n> ")
+			key, err := readKey()
+			fmt.Println()
+			if err != nil {
+				return nil //nolint:nilerr // closing the popup is the same as quitting
+			}
+			action := findPaletteAction(actions, key)
+			if action == nil {
+				return nil
+			}
+
+			fmt.Printf("$ orc %s\n\n", strings.Join(action.Args, " "))
+			runErr := runOrc(action.Args)
+			if runErr != nil {
+				fmt.Printf("\n✗ %v\n", runErr)
+			}
+			fmt.Print("\nPress any key to close...")
+			_, _ = readKey()
+			fmt.Println()
+			return nil
+		},
+	}
+	cmd.Flags().Bool("list", false, "Print the available actions and exit")
+	return cmd
+}
+
+// paletteContext is what the palette knows about the current workbench.
+type paletteContext struct {
+	WorkbenchID string
+	FocusID     string
+	Shipment    *primary.Shipment // Set when the focus is a shipment
+	PR          *primary.PR       // The focused shipment's live PR, if any
+	ActiveTask  *primary.Task     // The workbench's in-progress task, if any
+}
+
+// paletteAction is one selectable quick action.
+type paletteAction struct {
+	Key   byte
+	Label string
+	Args  []string // orc arguments
+}
+
+// loadPaletteContext gathers the workbench, its focus, and related work.
+// Lookups that fail leave their part of the context empty.
+func loadPaletteContext(ctx context.Context) paletteContext {
+	pc := paletteContext{WorkbenchID: orccontext.GetContextWorkbenchID()}
+	if pc.WorkbenchID == "" {
+		return pc
+	}
+
+	pc.FocusID, _ = wire.WorkbenchService().GetFocusedID(ctx, pc.WorkbenchID)
+	if tasks, err := wire.TaskService().GetTasksByWorkbench(ctx, pc.WorkbenchID); err == nil {
+		for _, t := range tasks {
+			if t.Status == "in-progress" {
+				pc.ActiveTask = t
+				break
+			}
+		}
+	}
+
+	if strings.HasPrefix(pc.FocusID, "SHIP-") {
+		pc.Shipment, _ = wire.ShipmentService().GetShipment(ctx, pc.FocusID)
+		prs, _ := wire.PRService().ListPRs(ctx, primary.PRFilters{ShipmentID: pc.FocusID})
+		for _, pr := range prs {
+			if pr.Status != primary.PRStatusMerged && pr.Status != primary.PRStatusClosed {
+				pc.PR = pr
+				break
+			}
+		}
+	}
+	return pc
+}
+
+// focusShowCommands maps focus ID prefixes to the command that shows them.
+var focusShowCommands = map[string]string{
+	"COMM": "commission",
+	"SHIP": "shipment",
+	"TOME": "tome",
+	"NOTE": "note",
+	"PLAN": "plan",
+}
+
+// buildPaletteActions lists the quick actions that make sense in pc.
+func buildPaletteActions(pc paletteContext) []paletteAction {
+	var actions []paletteAction
+
+	if pc.WorkbenchID != "" {
+		if t := pc.ActiveTask; t != nil {
+			actions = append(actions,
+				paletteAction{'c', fmt.Sprintf("Complete %s: %s", t.ID, truncate(t.Title, 40)), []string{"task", "complete", t.ID}},
+				paletteAction{'z', "Pause " + t.ID, []string{"task", "pause", t.ID}},
+			)
+		} else {
+			actions = append(actions, paletteAction{'n', "Claim next task", []string{"task", "claim", "--next"}})
+		}
+	}
+
+	if s := pc.Shipment; s != nil {
+		switch {
+		case pc.PR == nil && s.RepoID != "" && s.Branch != "":
+			actions = append(actions, paletteAction{'r', "Open draft PR for " + s.ID,
+				[]string{"pr", "create", s.ID, s.Title, "--repo", s.RepoID, "--branch", s.Branch, "--draft"}})
+		case pc.PR != nil && pc.PR.Status == primary.PRStatusDraft:
+			actions = append(actions, paletteAction{'r', fmt.Sprintf("Mark %s ready for review", pc.PR.ID), []string{"pr", "open", pc.PR.ID}})
+		case pc.PR != nil:
+			actions = append(actions, paletteAction{'r', fmt.Sprintf("Fetch review feedback on %s", pc.PR.ID), []string{"pr", "review", pc.PR.ID}})
+		}
+		actions = append(actions,
+			paletteAction{'d', "Show " + s.ID + " diff", []string{"shipment", "diff", s.ID}},
+			paletteAction{'t', "List " + s.ID + " tasks", []string{"task", "list", "--shipment", s.ID}},
+		)
+	}
+
+	if prefix, _, ok := strings.Cut(pc.FocusID, "-"); ok && focusShowCommands[prefix] != "" {
+		actions = append(actions, paletteAction{'o', "Show " + pc.FocusID, []string{focusShowCommands[prefix], "show", pc.FocusID}})
+	}
+	actions = append(actions, paletteAction{'f', "Switch to a recent focus", []string{"focus", "recent"}})
+	if pc.WorkbenchID != "" {
+		actions = append(actions, paletteAction{'a', "Today's activity here", []string{"activity", "--actor", pc.WorkbenchID, "--today"}})
+	}
+	actions = append(actions, paletteAction{'s', "Summary", []string{"summary"}})
+
+	return actions
+}
+
+// findPaletteAction returns the action bound to key, or nil.
+func findPaletteAction(actions []paletteAction, key byte) *paletteAction {
+	for i := range actions {
+		if actions[i].Key == key {
+			return &actions[i]
+		}
+	}
+	return nil
+}
+
+// renderPalette prints the context header and the actions with their keys.
+func renderPalette(w io.Writer, pc paletteContext, actions []paletteAction) {
+	header := "ORC"
+	if pc.WorkbenchID != "" {
+		header += " · " + pc.WorkbenchID
+	}
+	if pc.Shipment != nil {
+		header += fmt.Sprintf(" · %s %s", pc.Shipment.ID, pc.Shipment.Title)
+	} else if pc.FocusID != "" {
+		header += " · " + pc.FocusID
+	}
+	fmt.Fprintln(w, color.New(color.Bold).Sprint(header))
+	fmt.Fprintln(w)
+
+	keyColor := color.New(color.FgCyan, color.Bold)
+	for _, a := range actions {
+		fmt.Fprintf(w, "  %s  %s\n", keyColor.Sprintf("[%c]", a.Key), a.Label)
+	}
+	fmt.Fprintf(w, "  %s  %s\n", keyColor.Sprint("[q]"), "Quit")
+}
+
+// runOrc runs this orc binary with args, attached to the terminal.
+func runOrc(args []string) error {
+	exe, err := os.Executable()
+	if err != nil {
+		exe = "orc"
+	}
+	cmd := exec.Command(exe, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// readKey reads a single keypress. When stdin is a terminal it is switched
+// to unbuffered, no-echo mode with stty for the read.
+func readKey() (byte, error) {
+	if saved, err := stty("-g"); err == nil {
+		if _, err := stty("-icanon", "-echo", "min", "1"); err == nil {
+			defer func() { _, _ = stty(strings.TrimSpace(saved)) }()
+		}
+	}
+
+	buf := make([]byte, 1)
+	if _, err := os.Stdin.Read(buf); err != nil {
+		return 0, err
+	}
+	return buf[0], nil
+}
+
+// stty runs stty against the terminal on stdin.
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	out, err := cmd.Output()
+	return string(out), err
+}
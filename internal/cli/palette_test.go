@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func paletteKeys(actions []paletteAction) string {
+	var keys []byte
+	for _, a := range actions {
+		keys = append(keys, a.Key)
+	}
+	return string(keys)
+}
+
+func TestBuildPaletteActions(t *testing.T) {
+	shipment := &primary.Shipment{ID: "SHIP-010", Title: "Auth", RepoID: "REPO-001", Branch: "ml/SHIP-010-auth"}
+
+	tests := []struct {
+		name     string
+		pc       paletteContext
+		wantKeys string
+		wantArgs map[byte]string // key -> joined args
+	}{
+		{
+			name:     "no workbench",
+			pc:       paletteContext{},
+			wantKeys: "fs",
+		},
+		{
+			name:     "idle workbench claims next",
+			pc:       paletteContext{WorkbenchID: "BENCH-003"},
+			wantKeys: "nfas",
+			wantArgs: map[byte]string{
+				'n': "task claim --next",
+				'a': "activity --actor BENCH-003 --today",
+			},
+		},
+		{
+			name: "shipment focus without PR offers a draft PR",
+			pc: paletteContext{
+				WorkbenchID: "BENCH-003",
+				FocusID:     "SHIP-010",
+				Shipment:    shipment,
+				ActiveTask:  &primary.Task{ID: "TASK-042", Title: "Add login", Status: "in-progress"},
+			},
+			wantKeys: "czrdtofas",
+			wantArgs: map[byte]string{
+				'c': "task complete TASK-042",
+				'r': "pr create SHIP-010 Auth --repo REPO-001 --branch ml/SHIP-010-auth --draft",
+				'o': "shipment show SHIP-010",
+			},
+		},
+		{
+			name:     "draft PR can be opened for review",
+			pc:       paletteContext{WorkbenchID: "BENCH-003", FocusID: "SHIP-010", Shipment: shipment, PR: &primary.PR{ID: "PR-004", Status: primary.PRStatusDraft}},
+			wantKeys: "nrdtofas",
+			wantArgs: map[byte]string{'r': "pr open PR-004"},
+		},
+		{
+			name:     "open PR fetches review feedback",
+			pc:       paletteContext{WorkbenchID: "BENCH-003", FocusID: "SHIP-010", Shipment: shipment, PR: &primary.PR{ID: "PR-004", Status: primary.PRStatusOpen}},
+			wantKeys: "nrdtofas",
+			wantArgs: map[byte]string{'r': "pr review PR-004"},
+		},
+		{
+			name:     "tome focus",
+			pc:       paletteContext{WorkbenchID: "BENCH-003", FocusID: "TOME-002"},
+			wantKeys: "nofas",
+			wantArgs: map[byte]string{'o': "tome show TOME-002"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actions := buildPaletteActions(tt.pc)
+			if got := paletteKeys(actions); got != tt.wantKeys {
+				t.Errorf("keys = %q, want %q", got, tt.wantKeys)
+			}
+			for key, want := range tt.wantArgs {
+				a := findPaletteAction(actions, key)
+				if a == nil {
+					t.Errorf("no action for %q", key)
+					continue
+				}
+				if got := strings.Join(a.Args, " "); got != want {
+					t.Errorf("[%c] args = %q, want %q", key, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderPalette(t *testing.T) {
+	noColor := color.NoColor
+	defer func() { color.NoColor = noColor }()
+	color.NoColor = true
+
+	pc := paletteContext{WorkbenchID: "BENCH-003", FocusID: "SHIP-010", Shipment: &primary.Shipment{ID: "SHIP-010", Title: "Auth"}}
+	var buf bytes.Buffer
+	renderPalette(&buf, pc, []paletteAction{{Key: 'n', Label: "Claim next task"}})
+
+	want := "ORC · BENCH-003 · SHIP-010 Auth\n\n  [n]  Claim next task\n  [q]  Quit\n"
+	if got := buf.String(); got != want {
+		t.Errorf("renderPalette =\n%q\nwant\n%q", got, want)
+	}
+}
@@ -3,6 +3,8 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
@@ -36,6 +38,11 @@ var planCreateCmd = &cobra.Command{
 			}
 		}
 
+		budgetWarning, err := checkContentBudget(content)
+		if err != nil {
+			return err
+		}
+
 		ctx := NewContext()
 		resp, err := wire.PlanService().CreatePlan(ctx, primary.CreatePlanRequest{
 			CommissionID: commissionID,
@@ -55,6 +62,9 @@ var planCreateCmd = &cobra.Command{
 		}
 		fmt.Printf("  Commission: %s\n", plan.CommissionID)
 		fmt.Printf("  Status: %s\n", plan.Status)
+		if budgetWarning != "" {
+			fmt.Println(budgetWarning)
+		}
 		fmt.Println()
 		fmt.Println("Next steps:")
 		fmt.Printf("   orc plan show %s    # View plan details\n", plan.ID)
@@ -100,8 +110,11 @@ var planListCmd = &cobra.Command{
 				pinnedMark = " [pinned]"
 			}
 			statusIcon := "📝"
-			if p.Status == "approved" {
+			switch p.Status {
+			case "approved":
 				statusIcon = "✅"
+			case "conditionally_approved":
+				statusIcon = "☑️"
 			}
 			task := "-"
 			if p.TaskID != "" {
@@ -117,7 +130,18 @@ var planListCmd = &cobra.Command{
 var planShowCmd = &cobra.Command{
 	Use:   "show [plan-id]",
 	Short: "Show plan details",
-	Args:  cobra.ExactArgs(1),
+	Long: `Show a plan's details, content, and step progress.
+
+Long output goes through $PAGER (falls back to less -R) when writing to a
+terminal. --section shows only the markdown section under a heading (matched
+case-insensitively, in full or by prefix) and --head the first N lines of
+the content.
+
+Examples:
+  orc plan show PLAN-007
+  orc plan show PLAN-007 --section Rollout
+  orc plan show PLAN-007 --head 40 --no-pager`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		planID := args[0]
 
@@ -127,42 +151,123 @@ var planShowCmd = &cobra.Command{
 			return fmt.Errorf("plan not found: %w", err)
 		}
 
-		fmt.Printf("Plan: %s\n", plan.ID)
-		fmt.Printf("Title: %s\n", plan.Title)
-		if plan.Description != "" {
-			fmt.Printf("Description: %s\n", plan.Description)
-		}
-		fmt.Printf("Status: %s\n", plan.Status)
-		if plan.Content != "" {
-			fmt.Printf("\nContent:\n%s\n", plan.Content)
-		}
-		fmt.Printf("\nCommission: %s\n", plan.CommissionID)
-		if plan.TaskID != "" {
-			fmt.Printf("Task: %s\n", plan.TaskID)
+		// Untracked plans have no steps; GetPlanProgress reports that as an error.
+		progress, _ := wire.PlanService().GetPlanProgress(ctx, planID)
+		conditions, err := wire.PlanService().ListPlanConditions(ctx, planID)
+		if err != nil {
+			return err
 		}
-		if plan.Pinned {
-			fmt.Printf("Pinned: yes\n")
+
+		if plan.Content, err = selectContentFromFlags(cmd, plan.Content); err != nil {
+			return err
 		}
-		if plan.PromotedFromID != "" {
-			fmt.Printf("Promoted from: %s (%s)\n", plan.PromotedFromID, plan.PromotedFromType)
+
+		var out strings.Builder
+		planDetail(plan, progress, conditions, loadRelations(ctx, planID, "plan")).render(&out)
+		return printOrPage(cmd, out.String())
+	},
+}
+
+// planDetail builds the plan show view. progress is nil for untracked plans.
+func planDetail(plan *primary.Plan, progress *primary.PlanProgress, conditions []*primary.PlanCondition, rel detailRelations) *detailView {
+	v := newDetailView("Plan", plan.ID+presenceMarker(rel.presence))
+	v.field("Title", plan.Title)
+	v.field("Description", plan.Description)
+	v.field("Status", plan.Status)
+	if plan.Pinned {
+		v.field("Pinned", "yes")
+	}
+	v.field("Created", formatTime(plan.CreatedAt))
+	v.field("Approved", formatTime(plan.ApprovedAt))
+
+	v.panel(rel.relatedPanel(
+		detailField{"Commission", plan.CommissionID},
+		detailField{"Task", plan.TaskID},
+		detailField{"Promoted from", plan.PromotedFromID},
+	))
+
+	content := detailPanel{title: "Content"}
+	if plan.Content != "" {
+		content.lines = strings.Split(plan.Content, "\n")
+	}
+	v.panel(content)
+
+	if len(conditions) > 0 {
+		addressed := 0
+		panel := detailPanel{}
+		for _, c := range conditions {
+			if c.Addressed {
+				addressed++
+			}
+			panel.lines = append(panel.lines, planConditionLine(c))
 		}
-		fmt.Printf("Created: %s\n", plan.CreatedAt)
-		if plan.ApprovedAt != "" {
-			fmt.Printf("Approved: %s\n", plan.ApprovedAt)
+		panel.title = fmt.Sprintf("Conditions (%d/%d addressed)", addressed, len(conditions))
+		v.panel(panel)
+	}
+
+	if progress != nil {
+		steps := detailPanel{title: fmt.Sprintf("Steps (%d/%d)", progress.Completed, len(progress.Steps))}
+		for _, step := range progress.Steps {
+			steps.lines = append(steps.lines, planStepLine(step))
 		}
+		v.panel(steps)
+	}
+	v.panel(commentsPanel(rel.comments))
+	v.panel(eventsPanel(rel.events))
+	v.panel(nextPanel(planNextActions(plan, progress)...))
+	return v
+}
 
-		return nil
-	},
+// planConditionLine renders an approval condition: "✓ 1. Add a rollback step  (see ## Rollback)".
+func planConditionLine(c *primary.PlanCondition) string {
+	if !c.Addressed {
+		return fmt.Sprintf("○ %d. %s", c.Position, c.Description)
+	}
+	return fmt.Sprintf("✓ %d. %s  (%s)", c.Position, c.Description, c.Evidence)
+}
+
+// planNextActions suggests commands that move a plan forward.
+func planNextActions(plan *primary.Plan, progress *primary.PlanProgress) []string {
+	switch {
+	case plan.Status == "conditionally_approved":
+		return []string{fmt.Sprintf("orc plan address %s <n> --evidence \"...\"", plan.ID)}
+	case plan.Status == "draft":
+		return []string{"orc plan approve " + plan.ID}
+	case progress == nil:
+		return []string{fmt.Sprintf("orc plan track %s --generate-tasks", plan.ID)}
+	}
+	return nil
 }
 
 var planApproveCmd = &cobra.Command{
 	Use:   "approve [plan-id]",
 	Short: "Approve a plan",
-	Args:  cobra.ExactArgs(1),
+	Long: `Approve a draft plan.
+
+With --with-conditions, approve it subject to required amendments (repeat the
+flag for each). The plan is conditionally approved, and its task can't be
+started, until each condition is marked addressed with evidence; addressing
+the last one approves the plan.
+
+Examples:
+  orc plan approve PLAN-007
+  orc plan approve PLAN-007 --with-conditions "add a rollback step" --with-conditions "cover the empty cart"
+  orc plan address PLAN-007 1 --evidence "see ## Rollback"`,
+	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		planID := args[0]
+		conditions, _ := cmd.Flags().GetStringArray("with-conditions")
 
 		ctx := NewContext()
+		if cmd.Flags().Changed("with-conditions") {
+			if err := wire.PlanService().ApprovePlanWithConditions(ctx, planID, conditions); err != nil {
+				return fmt.Errorf("failed to approve plan: %w", err)
+			}
+			fmt.Printf("✓ Plan %s conditionally approved (%s)\n", planID, pluralize(len(conditions), "condition", "conditions"))
+			fmt.Printf("   orc plan address %s <n> --evidence \"...\"  # Mark each addressed\n", planID)
+			return nil
+		}
+
 		if err := wire.PlanService().ApprovePlan(ctx, planID); err != nil {
 			return fmt.Errorf("failed to approve plan: %w", err)
 		}
@@ -172,6 +277,41 @@ var planApproveCmd = &cobra.Command{
 	},
 }
 
+var planAddressCmd = &cobra.Command{
+	Use:   "address [plan-id] [n]",
+	Short: "Mark a plan approval condition addressed",
+	Long: `Mark a condition from a conditional approval as addressed.
+
+--evidence records where the amendment is: a plan section, a commit, or a
+note. Addressing the last open condition approves the plan.
+
+Examples:
+  orc plan address PLAN-007 1 --evidence "see ## Rollback"
+  orc plan address PLAN-007 2 --evidence "NOTE-042"`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		planID := args[0]
+		evidence, _ := cmd.Flags().GetString("evidence")
+
+		position, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid condition number %q (use the number shown by orc plan show)", args[1])
+		}
+
+		ctx := NewContext()
+		approved, err := wire.PlanService().AddressPlanCondition(ctx, planID, position, evidence)
+		if err != nil {
+			return fmt.Errorf("failed to address condition: %w", err)
+		}
+
+		fmt.Printf("✓ %s condition %d addressed\n", planID, position)
+		if approved {
+			fmt.Printf("✓ Plan %s approved (all conditions addressed)\n", planID)
+		}
+		return nil
+	},
+}
+
 var planUpdateCmd = &cobra.Command{
 	Use:   "update [plan-id]",
 	Short: "Update plan title, description, and/or content",
@@ -186,8 +326,13 @@ var planUpdateCmd = &cobra.Command{
 			return fmt.Errorf("must specify --title, --description, and/or --content")
 		}
 
+		budgetWarning, err := checkContentBudget(content)
+		if err != nil {
+			return err
+		}
+
 		ctx := NewContext()
-		err := wire.PlanService().UpdatePlan(ctx, primary.UpdatePlanRequest{
+		err = wire.PlanService().UpdatePlan(ctx, primary.UpdatePlanRequest{
 			PlanID:      planID,
 			Title:       title,
 			Description: description,
@@ -198,6 +343,9 @@ var planUpdateCmd = &cobra.Command{
 		}
 
 		fmt.Printf("✓ Plan %s updated\n", planID)
+		if budgetWarning != "" {
+			fmt.Println(budgetWarning)
+		}
 		return nil
 	},
 }
@@ -256,31 +404,146 @@ var planDeleteCmd = &cobra.Command{
 	},
 }
 
+var planTrackCmd = &cobra.Command{
+	Use:   "track [plan-id]",
+	Short: "Track an approved plan's steps against tasks",
+	Long: `Record the steps of an approved plan so their completion can be measured.
+
+Steps are the plan's top-level numbered items (1. ...) or checklist items
+(- [ ] ...); a plan with neither uses its ## and ### headings. Steps are read
+from the content the first time a plan is tracked.
+
+With --generate-tasks, a task is created for every step that has none, in the
+same shipment as the plan's task. Re-running only fills the gaps.
+
+Examples:
+  orc plan track PLAN-020 --generate-tasks
+  orc plan link-step PLAN-020 3 TASK-101
+  orc plan progress PLAN-020`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		planID := args[0]
+		generateTasks, _ := cmd.Flags().GetBool("generate-tasks")
+
+		ctx := NewContext()
+		progress, err := wire.PlanService().TrackPlan(ctx, planID, generateTasks)
+		if err != nil {
+			return fmt.Errorf("failed to track plan: %w", err)
+		}
+
+		fmt.Printf("✓ Tracking %d steps of %s\n", len(progress.Steps), planID)
+		for _, taskID := range progress.CreatedTaskIDs {
+			fmt.Printf("  Created %s\n", taskID)
+		}
+		fmt.Println()
+		printPlanSteps(progress)
+		return nil
+	},
+}
+
+var planLinkStepCmd = &cobra.Command{
+	Use:   "link-step [plan-id] [n] [task-id]",
+	Short: "Link a plan step to an existing task",
+	Args:  cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		planID, taskID := args[0], args[2]
+
+		position, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid step number %q (use the number shown by orc plan progress)", args[1])
+		}
+
+		ctx := NewContext()
+		if err := wire.PlanService().LinkPlanStep(ctx, planID, position, taskID); err != nil {
+			return fmt.Errorf("failed to link step: %w", err)
+		}
+
+		fmt.Printf("✓ %s step %d linked to %s\n", planID, position, taskID)
+		return nil
+	},
+}
+
+var planProgressCmd = &cobra.Command{
+	Use:   "progress [plan-id]",
+	Short: "Show which plan steps have completed tasks",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		planID := args[0]
+
+		ctx := NewContext()
+		progress, err := wire.PlanService().GetPlanProgress(ctx, planID)
+		if err != nil {
+			return fmt.Errorf("failed to get plan progress: %w", err)
+		}
+
+		printPlanSteps(progress)
+		return nil
+	},
+}
+
+// printPlanSteps renders a tracked plan's steps with their task status.
+func printPlanSteps(progress *primary.PlanProgress) {
+	fmt.Printf("%s: %d/%d steps complete\n", progress.PlanID, progress.Completed, len(progress.Steps))
+	for _, step := range progress.Steps {
+		fmt.Printf("  %s\n", planStepLine(step))
+	}
+}
+
+// planStepLine renders a plan step with its task: "✓ 1. Add keychain  TASK-001 [closed]".
+func planStepLine(step *primary.PlanStep) string {
+	mark := "○"
+	if step.Complete {
+		mark = "✓"
+	}
+	task := "(no task)"
+	if step.TaskID != "" {
+		task = fmt.Sprintf("%s [%s]", step.TaskID, step.TaskStatus)
+	}
+	return fmt.Sprintf("%s %d. %s  %s", mark, step.Position, step.Title, task)
+}
+
 func init() {
 	// plan create flags
 	planCreateCmd.Flags().StringP("commission", "c", "", "Commission ID (defaults to context)")
 	planCreateCmd.Flags().StringP("description", "d", "", "Plan description")
 	planCreateCmd.Flags().String("content", "", "Plan content")
 	planCreateCmd.Flags().String("task", "", "Task ID to attach plan to")
+	inferFromFocus(planCreateCmd, "commission")
 	// plan list flags
 	planListCmd.Flags().StringP("commission", "c", "", "Filter by commission")
 	planListCmd.Flags().String("task", "", "Filter by task")
-	planListCmd.Flags().StringP("status", "s", "", "Filter by status (draft, approved)")
+	planListCmd.Flags().StringP("status", "s", "", "Filter by status (draft, conditionally_approved, approved)")
+
+	// plan show flags
+	addContentFlags(planShowCmd)
+
+	// plan approve flags
+	planApproveCmd.Flags().StringArray("with-conditions", nil, "Approve subject to a required amendment (repeatable)")
+
+	// plan address flags
+	planAddressCmd.Flags().String("evidence", "", "Where the amendment is: a plan section, commit, or note (required)")
 
 	// plan update flags
 	planUpdateCmd.Flags().String("title", "", "New title")
 	planUpdateCmd.Flags().StringP("description", "d", "", "New description")
 	planUpdateCmd.Flags().String("content", "", "New content")
 
+	// plan track flags
+	planTrackCmd.Flags().Bool("generate-tasks", false, "Create a task for each step without one")
+
 	// Register subcommands
 	planCmd.AddCommand(planCreateCmd)
 	planCmd.AddCommand(planListCmd)
 	planCmd.AddCommand(planShowCmd)
 	planCmd.AddCommand(planApproveCmd)
+	planCmd.AddCommand(planAddressCmd)
 	planCmd.AddCommand(planUpdateCmd)
 	planCmd.AddCommand(planPinCmd)
 	planCmd.AddCommand(planUnpinCmd)
 	planCmd.AddCommand(planDeleteCmd)
+	planCmd.AddCommand(planTrackCmd)
+	planCmd.AddCommand(planLinkStepCmd)
+	planCmd.AddCommand(planProgressCmd)
 }
 
 // PlanCmd returns the plan command
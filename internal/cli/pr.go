@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
@@ -28,6 +29,8 @@ func PRCmd() *cobra.Command {
 	cmd.AddCommand(prMergeCmd())
 	cmd.AddCommand(prCloseCmd())
 	cmd.AddCommand(prLinkCmd())
+	cmd.AddCommand(prReviewCmd())
+	cmd.AddCommand(prDescribeCmd())
 
 	return cmd
 }
@@ -205,13 +208,13 @@ func prShowCmd() *cobra.Command {
 			if pr.Description != "" {
 				fmt.Printf("  Description: %s\n", pr.Description)
 			}
-			fmt.Printf("  Created: %s\n", pr.CreatedAt)
-			fmt.Printf("  Updated: %s\n", pr.UpdatedAt)
+			fmt.Printf("  Created: %s\n", formatTime(pr.CreatedAt))
+			fmt.Printf("  Updated: %s\n", formatTime(pr.UpdatedAt))
 			if pr.MergedAt != "" {
-				fmt.Printf("  Merged: %s\n", pr.MergedAt)
+				fmt.Printf("  Merged: %s\n", formatTime(pr.MergedAt))
 			}
 			if pr.ClosedAt != "" {
-				fmt.Printf("  Closed: %s\n", pr.ClosedAt)
+				fmt.Printf("  Closed: %s\n", formatTime(pr.ClosedAt))
 			}
 
 			return nil
@@ -356,3 +359,203 @@ Examples:
 
 	return cmd
 }
+
+func prReviewCmd() *cobra.Command {
+	var offline bool
+
+	cmd := &cobra.Command{
+		Use:   "review [pr-id]",
+		Short: "Fetch and show review feedback on a PR",
+		Long: `Fetch reviews and inline review comments from GitHub (via the gh CLI),
+store them in the ledger, and show them grouped by review.
+
+Each requested change (the body of a "changes requested" review, or an
+inline comment submitted with one) becomes an open fix task in the PR's
+shipment. Changes already covered by a task, including repeats of the same
+comment on the same file, are skipped.
+
+Examples:
+  orc pr review PR-012
+  orc pr review PR-012 --offline   # Show stored reviews without fetching`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			prID := args[0]
+
+			if !offline {
+				result, err := wire.PRReviewService().SyncReviews(ctx, prID)
+				if err != nil {
+					return fmt.Errorf("failed to sync reviews: %w", err)
+				}
+				fmt.Printf("🔁 Fetched %d review item(s) for %s, %d new\n", result.Fetched, prID, result.New)
+				if len(result.CreatedTasks) > 0 {
+					fmt.Printf("✓ Created %d task(s) from requested changes: %s\n", len(result.CreatedTasks), strings.Join(result.CreatedTasks, ", "))
+				}
+				fmt.Println()
+			}
+
+			reviews, err := wire.PRReviewService().ListReviews(ctx, prID)
+			if err != nil {
+				return fmt.Errorf("failed to list reviews: %w", err)
+			}
+			if len(reviews) == 0 {
+				fmt.Printf("No reviews on %s yet.\n", prID)
+				return nil
+			}
+
+			printPRReviews(prID, reviews)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&offline, "offline", false, "Show stored reviews without fetching from GitHub")
+	return cmd
+}
+
+// printPRReviews renders reviews with their inline comments nested beneath.
+func printPRReviews(prID string, reviews []*primary.PRReview) {
+	comments := make(map[string][]*primary.PRReview)
+	var orphans []*primary.PRReview
+	known := make(map[string]bool)
+	for _, r := range reviews {
+		if r.Kind == "review" {
+			known[r.ExternalID] = true
+		}
+	}
+	for _, r := range reviews {
+		if r.Kind != "comment" {
+			continue
+		}
+		if known[r.ReviewExternalID] {
+			comments[r.ReviewExternalID] = append(comments[r.ReviewExternalID], r)
+		} else {
+			orphans = append(orphans, r)
+		}
+	}
+
+	fmt.Printf("Reviews of %s:\n", prID)
+	for _, r := range reviews {
+		if r.Kind != "review" {
+			continue
+		}
+		fmt.Printf("\n%s %s %s%s%s\n", reviewStateIcon(r.State), r.Author, reviewStateVerb(r.State), formatReviewDate(r.SubmittedAt), formatReviewTask(r.TaskID))
+		if body := strings.TrimSpace(r.Body); body != "" {
+			for _, line := range strings.Split(body, "\n") {
+				fmt.Printf("    %s\n", line)
+			}
+		}
+		for _, c := range comments[r.ExternalID] {
+			printReviewComment(c)
+		}
+	}
+	for _, c := range orphans {
+		printReviewComment(c)
+	}
+}
+
+func printReviewComment(c *primary.PRReview) {
+	location := c.Path
+	if c.Line > 0 {
+		location = fmt.Sprintf("%s:%d", c.Path, c.Line)
+	}
+	indent := "  💬 "
+	if c.InReplyTo {
+		indent = "     ↳ "
+	}
+	firstLine, _, _ := strings.Cut(strings.TrimSpace(c.Body), "\n")
+	fmt.Printf("%s%s — %s: %s%s\n", indent, location, c.Author, firstLine, formatReviewTask(c.TaskID))
+}
+
+func reviewStateIcon(state string) string {
+	switch state {
+	case "APPROVED":
+		return "✓"
+	case "CHANGES_REQUESTED":
+		return "✗"
+	case "DISMISSED":
+		return "⊘"
+	default:
+		return "💬"
+	}
+}
+
+func reviewStateVerb(state string) string {
+	switch state {
+	case "APPROVED":
+		return "approved"
+	case "CHANGES_REQUESTED":
+		return "requested changes"
+	case "DISMISSED":
+		return "review dismissed"
+	default:
+		return "commented"
+	}
+}
+
+func formatReviewDate(submittedAt string) string {
+	if submittedAt == "" {
+		return ""
+	}
+	return " (" + formatWhen(submittedAt) + ")"
+}
+
+func formatReviewTask(taskID string) string {
+	if taskID == "" {
+		return ""
+	}
+	return " → " + taskID
+}
+
+func prDescribeCmd() *cobra.Command {
+	var apply bool
+
+	cmd := &cobra.Command{
+		Use:   "describe [pr-id]",
+		Short: "Generate a PR description from the ledger",
+		Long: `Generate a PR description from its shipment: the charter (or description),
+the approved plans and linked commits of its closed tasks, and its notes.
+
+The generated text sits between orc:generated markers. Regenerating replaces
+only that block, so anything written outside it, on GitHub or in the ledger,
+is kept. A description without the markers is kept below the new block.
+
+Without --apply the description is printed. With --apply it is written to
+GitHub (via the gh CLI) when the PR has a GitHub URL, and to the ledger.
+
+Examples:
+  orc pr describe PR-014             # Print the description
+  orc pr describe PR-014 --apply     # Write it to GitHub and the ledger`,
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{outsideWritesAnnotation: "apply"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			prID := args[0]
+
+			if !apply {
+				desc, err := wire.PRDescriptionService().DescribePR(ctx, prID)
+				if err != nil {
+					return fmt.Errorf("failed to describe PR: %w", err)
+				}
+				fmt.Println(desc.Body)
+				return nil
+			}
+
+			desc, err := wire.PRDescriptionService().ApplyDescription(ctx, prID)
+			if err != nil {
+				return fmt.Errorf("failed to apply description: %w", err)
+			}
+			switch {
+			case !desc.Changed:
+				fmt.Printf("✓ Description of %s is up to date\n", prID)
+			case desc.Source == "github":
+				fmt.Printf("✓ Updated description of %s on GitHub and in the ledger\n", prID)
+			default:
+				fmt.Printf("✓ Updated description of %s in the ledger (no GitHub URL; see 'orc pr link')\n", prID)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&apply, "apply", false, "Write the description to GitHub and the ledger")
+	return cmd
+}
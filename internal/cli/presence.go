@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/db"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// presenceEntities are the entity IDs the running command names, noted by
+// RecordPresence and recorded by FinishPresence.
+var (
+	presenceEntities []string
+	presenceBaseline int64 // db.LedgerChanges when the command started
+)
+
+// RecordPresence notes the entities a command names, so FinishPresence can
+// mark the current actor on them and others see "(BENCH-003 active 2m ago)".
+// --plan runs are not recorded. Called from the root command's
+// PersistentPreRunE, after aliases resolve.
+func RecordPresence(cmd *cobra.Command, args []string) {
+	if GetActorID() == "" || db.Simulating() || strings.HasPrefix(cmd.CommandPath(), "orc hook") {
+		return
+	}
+	for _, arg := range args {
+		if wire.IsEntityID(arg) {
+			presenceEntities = append(presenceEntities, arg)
+		}
+	}
+	presenceBaseline = db.LedgerChanges()
+}
+
+// FinishPresence records the current actor on the entities the command
+// named: as an edit if the command changed the ledger, a view otherwise.
+// Best-effort: failures are ignored.
+func FinishPresence() {
+	if len(presenceEntities) == 0 {
+		return
+	}
+	action := "view"
+	if db.LedgerChanges() > presenceBaseline {
+		action = "edit"
+	}
+	ctx := NewContext()
+	for _, id := range presenceEntities {
+		_ = wire.PresenceService().RecordPresence(ctx, id, action)
+	}
+}
+
+// loadPresence maps entity IDs to the other actors recently on them. A
+// failure leaves the markers out rather than failing the command.
+func loadPresence(ctx context.Context, entityID string) map[string][]*primary.EntityPresence {
+	active, err := wire.PresenceService().ListActive(ctx, entityID)
+	if err != nil {
+		return nil
+	}
+	byEntity := make(map[string][]*primary.EntityPresence)
+	for _, p := range active {
+		byEntity[p.EntityID] = append(byEntity[p.EntityID], p)
+	}
+	return byEntity
+}
+
+// presenceMarker renders the other actors recently on an entity, newest
+// first: " (BENCH-003 active 2m ago, ORC edited just now)". Empty when
+// nobody else is.
+func presenceMarker(active []*primary.EntityPresence) string {
+	if len(active) == 0 {
+		return ""
+	}
+	parts := make([]string, len(active))
+	for i, p := range active {
+		verb := "active"
+		if p.Action == "edit" {
+			verb = "edited"
+		}
+		parts[i] = fmt.Sprintf("%s %s %s", p.Actor, verb, formatWhen(p.SeenAt))
+	}
+	return color.New(color.Faint).Sprintf(" (%s)", strings.Join(parts, ", "))
+}
@@ -9,7 +9,9 @@ import (
 
 	"github.com/example/orc/internal/config"
 	ctx "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/templates"
+	"github.com/example/orc/internal/wire"
 )
 
 // PrimeCmd returns the prime command
@@ -150,6 +152,15 @@ func buildIMPPrimeOutput(workbenchCtx *ctx.WorkbenchContext, cwd string) string
 	output.WriteString(fmt.Sprintf("**Workbench**: `%s`\n", workbenchCtx.WorkbenchID))
 	output.WriteString(fmt.Sprintf("**Location**: `%s`\n\n", cwd))
 
+	// Charter of the focused container (the "why" behind the work)
+	output.WriteString(getFocusCharter(workbenchCtx.WorkbenchID))
+
+	// Where the last session on the focused container left off
+	output.WriteString(getFocusHandoff(workbenchCtx.WorkbenchID))
+
+	// Terminology of the focused container's commission
+	output.WriteString(getFocusGlossary(workbenchCtx.WorkbenchID))
+
 	// Git context
 	output.WriteString(getGitInstructions())
 
@@ -201,3 +212,118 @@ func getCoreRules() string {
 	}
 	return content
 }
+
+// getFocusCharter returns the charter section for the workbench's focused container.
+// Returns empty string when nothing is focused or the container has no charter.
+func getFocusCharter(workbenchID string) string {
+	ctx := NewContext()
+	focusID, err := wire.WorkbenchService().GetFocusedID(ctx, workbenchID)
+	if err != nil || focusID == "" {
+		return ""
+	}
+
+	var title, charter string
+	switch {
+	case strings.HasPrefix(focusID, "SHIP-"):
+		if ship, err := wire.ShipmentService().GetShipment(ctx, focusID); err == nil {
+			title, charter = ship.Title, ship.Charter
+		}
+	case strings.HasPrefix(focusID, "TOME-"):
+		if tome, err := wire.TomeService().GetTome(ctx, focusID); err == nil {
+			title, charter = tome.Title, tome.Charter
+		}
+	}
+	if charter == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("## Charter: %s - %s\n\n%s\n\n", focusID, title, charter)
+}
+
+// getFocusGlossary returns the glossary of the focused container's
+// commission, deprecated terms last. Returns empty string when the commission
+// has no glossary.
+func getFocusGlossary(workbenchID string) string {
+	ctx := NewContext()
+	focusID, err := wire.WorkbenchService().GetFocusedID(ctx, workbenchID)
+	if err != nil || focusID == "" {
+		return ""
+	}
+	commissionID := resolveContainerCommission(focusID)
+	if commissionID == "" {
+		return ""
+	}
+	terms, err := wire.GlossaryService().ListTerms(ctx, commissionID)
+	if err != nil || len(terms) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Glossary: %s\n\n", commissionID)
+	var deprecated []string
+	for _, t := range terms {
+		if t.ReplacedBy != "" {
+			deprecated = append(deprecated, fmt.Sprintf("%q → %q", t.Term, t.ReplacedBy))
+			continue
+		}
+		fmt.Fprintf(&b, "- **%s**: %s\n", t.Term, t.Definition)
+	}
+	if len(deprecated) > 0 {
+		fmt.Fprintf(&b, "\n**Don't say**: %s\n", strings.Join(deprecated, ", "))
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// getFocusHandoff returns the latest open handoff note on the workbench's
+// focused container, asking the IMP to confirm or correct it while it is
+// still a draft. Returns empty string when there is none.
+func getFocusHandoff(workbenchID string) string {
+	ctx := NewContext()
+	focusID, err := wire.WorkbenchService().GetFocusedID(ctx, workbenchID)
+	if err != nil || focusID == "" {
+		return ""
+	}
+
+	var containerType string
+	switch {
+	case strings.HasPrefix(focusID, "SHIP-"):
+		containerType = "shipment"
+	case strings.HasPrefix(focusID, "TOME-"):
+		containerType = "tome"
+	default:
+		return ""
+	}
+	notes, err := wire.NoteService().GetNotesByContainer(ctx, containerType, focusID)
+	if err != nil {
+		return ""
+	}
+
+	var latest *primary.Note
+	for _, n := range notes {
+		if n.Type != primary.NoteTypeHandoff || noteStatus(n) != "open" {
+			continue
+		}
+		if latest == nil || n.CreatedAt > latest.CreatedAt {
+			latest = n
+		}
+	}
+	if latest == nil {
+		return ""
+	}
+	if latest.ContentExternal {
+		if latest, err = wire.NoteService().GetNote(ctx, latest.ID); err != nil {
+			return ""
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## Handoff: %s\n\n", latest.ID)
+	if latest.Draft {
+		fmt.Fprintf(&b, "*Draft summary of the last session. Check it against the code, correct it with `orc note update %s --content \"...\"`, then run `orc note confirm %s`.*\n\n", latest.ID, latest.ID)
+	}
+	// Nest the note's own sections under this one
+	b.WriteString(strings.ReplaceAll(latest.Content, "\n## ", "\n### "))
+	b.WriteString("\n\n")
+	return b.String()
+}
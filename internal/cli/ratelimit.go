@@ -0,0 +1,221 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/db"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// outsideWritesAnnotation marks commands that change git, tmux, or GitHub
+// before they write the ledger, so a throttled actor is refused up front
+// rather than at its first ledger write. The value is "true", or the flag
+// that makes the command write (e.g. "apply").
+const outsideWritesAnnotation = "orc.outside-writes"
+
+// CheckRateLimit throttles the running actor if its recent activity is over a
+// limit, and refuses a throttled actor's writes for the rest of the command.
+// The ledger itself refuses them (db.RefuseWrites), so every command that
+// writes is covered; reads stay open so a throttled IMP can still see why
+// ('orc summary') and ORC can inspect what it did. Only IMPs and ORC are
+// checked.
+func CheckRateLimit(cmd *cobra.Command) error {
+	path := cmd.CommandPath()
+	if GetActorID() == "" || strings.HasPrefix(path, "orc ratelimit") || strings.HasPrefix(path, "orc hook") {
+		return nil
+	}
+	err := wire.RateLimitService().CheckWrite(NewContext())
+	if err == nil {
+		return nil
+	}
+	refusal := fmt.Errorf("refusing '%s': %w", path, err)
+	if writesOutsideLedger(cmd) {
+		return refusal
+	}
+	db.RefuseWrites(refusal)
+	// FinishRateLimit reports a refused write; cobra would print SQLite's
+	// wording with the usage first
+	cmd.SilenceErrors, cmd.SilenceUsage = true, true
+	return nil
+}
+
+// FinishRateLimit returns the throttle behind a ledger write refused during
+// the command in place of the error it surfaced as, which SQLite words as
+// "not authorized".
+func FinishRateLimit(cmdErr error) error {
+	if refused := db.RefusedWrite(); refused != nil {
+		return refused
+	}
+	return cmdErr
+}
+
+// writesOutsideLedger reports whether cmd is marked with
+// outsideWritesAnnotation and, for a flag-gated mark, was given that flag.
+func writesOutsideLedger(cmd *cobra.Command) bool {
+	mark, ok := cmd.Annotations[outsideWritesAnnotation]
+	if !ok {
+		return false
+	}
+	if mark == "true" {
+		return true
+	}
+	return cmd.Flags().Changed(mark)
+}
+
+// RateLimitCmd returns the ratelimit command group.
+func RateLimitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ratelimit",
+		Short: "Throttle runaway agents",
+		Long: `Catch an agent stuck in a loop before it floods the ledger.
+
+Each command an IMP runs is checked against its activity over the last
+minute: notes created, status changes, and writes of any kind. An IMP over a
+limit is throttled for 15 minutes: the ledger refuses its writes, whatever
+the command, and 'orc summary' shows the throttle to ORC, who can lift it
+once the loop is fixed. Commands that change git or GitHub before the ledger
+(workbench checkout, stash, and unstash; pr describe --apply) are refused
+outright.
+
+Limits are per actor type (IMP or GOBLIN) and activity kind (notes, status,
+writes). Only ORC can change them.`,
+	}
+
+	cmd.AddCommand(rateLimitListCmd())
+	cmd.AddCommand(rateLimitSetCmd())
+	cmd.AddCommand(rateLimitResetCmd())
+	cmd.AddCommand(rateLimitLiftCmd())
+	return cmd
+}
+
+func rateLimitListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Show limits and throttled actors",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			limits, err := wire.RateLimitService().ListLimits(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list limits: %w", err)
+			}
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ACTOR\tKIND\tPER MINUTE\t")
+			for _, l := range limits {
+				perMinute := "off"
+				if l.PerMinute > 0 {
+					perMinute = strconv.Itoa(l.PerMinute)
+				}
+				source := ""
+				if l.Default {
+					source = "(default)"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", l.ActorType, l.Kind, perMinute, source)
+			}
+			if err := w.Flush(); err != nil {
+				return err
+			}
+
+			throttles, err := wire.RateLimitService().ListThrottles(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list throttles: %w", err)
+			}
+			fmt.Println()
+			if len(throttles) == 0 {
+				fmt.Println("No throttled actors.")
+				return nil
+			}
+			renderThrottles(throttles)
+			return nil
+		},
+	}
+}
+
+func rateLimitSetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set <IMP|GOBLIN> <notes|status|writes> <per-minute>",
+		Short: "Set a per-minute limit (0 turns it off)",
+		Long: `Set an actor type's per-minute limit on an activity kind.
+
+Examples:
+  orc ratelimit set IMP notes 10
+  orc ratelimit set GOBLIN writes 300
+  orc ratelimit set IMP status 0   # no limit on status changes`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			perMinute, err := strconv.Atoi(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid limit %q: must be a number", args[2])
+			}
+			if err := wire.RateLimitService().SetLimit(NewContext(), args[0], args[1], perMinute); err != nil {
+				return err
+			}
+			fmt.Printf("✓ %s %s limit set to %d per minute\n", args[0], args[1], perMinute)
+			return nil
+		},
+	}
+}
+
+func rateLimitResetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reset <IMP|GOBLIN> <notes|status|writes>",
+		Short: "Restore a default limit",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := wire.RateLimitService().ResetLimit(NewContext(), args[0], args[1]); err != nil {
+				return err
+			}
+			fmt.Printf("✓ %s %s limit restored to the default\n", args[0], args[1])
+			return nil
+		},
+	}
+}
+
+func rateLimitLiftCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "lift <actor-id>",
+		Short: "End a throttle early (ORC only)",
+		Long: `Let a throttled actor write again before its throttle expires.
+
+Activity from before the lift is not counted again.
+
+Examples:
+  orc ratelimit lift IMP-BENCH-014`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := wire.RateLimitService().LiftThrottle(NewContext(), args[0]); err != nil {
+				return err
+			}
+			fmt.Printf("✓ Lifted throttle on %s\n", args[0])
+			return nil
+		},
+	}
+}
+
+// renderThrottles prints throttled actors, one per line.
+func renderThrottles(throttles []*primary.ActorThrottle) {
+	alert := color.New(color.FgRed, color.Bold)
+	for _, th := range throttles {
+		fmt.Printf("   %s %s (since %s, until %s)\n", alert.Sprint(th.ActorID), th.Reason, formatClock(th.ThrottledAt), formatClock(th.ExpiresAt))
+	}
+}
+
+// renderThrottleBanner prints the throttled actors banner, if there are any.
+func renderThrottleBanner(throttles []*primary.ActorThrottle) {
+	if len(throttles) == 0 {
+		return
+	}
+	alert := color.New(color.FgRed, color.Bold)
+	fmt.Println(alert.Sprintf("🚨 %s for runaway activity — orc ratelimit lift <actor>", pluralize(len(throttles), "actor throttled", "actors throttled")))
+	renderThrottles(throttles)
+	fmt.Println()
+}
@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestWritesOutsideLedger(t *testing.T) {
+	checkout := &cobra.Command{Use: "checkout", Annotations: map[string]string{outsideWritesAnnotation: "true"}}
+	describe := &cobra.Command{Use: "describe", Annotations: map[string]string{outsideWritesAnnotation: "apply"}}
+	describe.Flags().Bool("apply", false, "")
+	show := &cobra.Command{Use: "show"}
+
+	if !writesOutsideLedger(checkout) {
+		t.Error("checkout should write outside the ledger")
+	}
+	if writesOutsideLedger(describe) || writesOutsideLedger(show) {
+		t.Error("describe without --apply and show should not")
+	}
+	if err := describe.Flags().Set("apply", "true"); err != nil {
+		t.Fatal(err)
+	}
+	if !writesOutsideLedger(describe) {
+		t.Error("describe --apply should write outside the ledger")
+	}
+}
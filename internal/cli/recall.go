@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// RecallCmd returns the recall command.
+func RecallCmd() *cobra.Command {
+	var commissionID string
+	var kind string
+	var limit int
+	var all bool
+	var reindex bool
+	var rebuild bool
+
+	cmd := &cobra.Command{
+		Use:   "recall [query]",
+		Short: "Find notes, questions, and plans by meaning",
+		Long: `Search the ledger's notes (questions included) and plans with a free-text
+query and list the best matches, each with its most relevant passage.
+
+Matching uses embeddings computed locally and stored in the ledger; no
+model download or external service is involved. The index is brought up
+to date on every recall, so new and edited entities are always found.
+Matching is by shared vocabulary (inflections and partial words count),
+not by synonyms.
+
+Searches the current commission when run from a workbench or workshop
+with one, otherwise every commission.
+
+Examples:
+  orc recall "what did we decide about branch naming"
+  orc recall token refresh --kind question
+  orc recall "release process" --all --limit 5
+  orc recall --reindex`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 && !reindex && !rebuild {
+				return fmt.Errorf("requires a query\nHint: orc recall \"what did we decide about branch naming\"")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			if reindex || rebuild {
+				stats, err := wire.RecallService().Reindex(ctx, rebuild)
+				if err != nil {
+					return fmt.Errorf("failed to update recall index: %w", err)
+				}
+				fmt.Printf("✓ Recall index up to date: %s (%d embedded, %d removed)\n",
+					pluralize(stats.Entities, "entity", "entities"), stats.Embedded, stats.Removed)
+				if len(args) == 0 {
+					return nil
+				}
+			}
+
+			if commissionID == "" && !all {
+				commissionID = orccontext.GetContextCommissionID()
+			}
+
+			query := strings.Join(args, " ")
+			hits, err := wire.RecallService().Recall(ctx, primary.RecallRequest{
+				Query:        query,
+				CommissionID: commissionID,
+				Kind:         kind,
+				Limit:        limit,
+			})
+			if err != nil {
+				return err
+			}
+			if len(hits) == 0 {
+				fmt.Printf("Nothing in the ledger matches %q.\n", query)
+				return nil
+			}
+
+			for i, h := range hits {
+				if i > 0 {
+					fmt.Println()
+				}
+				fmt.Printf("%s  %s  [%s, %s]  %.2f\n", h.EntityID, h.Title, h.Kind, orDash(h.Status), h.Score)
+				if h.Snippet != "" {
+					fmt.Printf("    %s\n", h.Snippet)
+				}
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&commissionID, "commission", "c", "", "Only search this commission (defaults to context)")
+	cmd.Flags().BoolVar(&all, "all", false, "Search every commission, ignoring context")
+	cmd.Flags().StringVar(&kind, "kind", "", "Only return this kind: note, question, or plan (note includes questions)")
+	cmd.Flags().IntVarP(&limit, "limit", "n", 10, "Maximum number of results")
+	cmd.Flags().BoolVar(&reindex, "reindex", false, "Bring the index up to date and report what changed")
+	cmd.Flags().BoolVar(&rebuild, "rebuild", false, "Recompute every embedding")
+
+	return cmd
+}
@@ -2,7 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
@@ -26,6 +28,8 @@ func RepoCmd() *cobra.Command {
 	cmd.AddCommand(repoArchiveCmd())
 	cmd.AddCommand(repoRestoreCmd())
 	cmd.AddCommand(repoDeleteCmd())
+	cmd.AddCommand(repoBootstrapCmd())
+	cmd.AddCommand(repoCheckCmd())
 
 	return cmd
 }
@@ -161,14 +165,104 @@ func repoShowCmd() *cobra.Command {
 				fmt.Printf("  Local Path: %s\n", repo.LocalPath)
 			}
 			fmt.Printf("  Default Branch: %s\n", repo.DefaultBranch)
-			fmt.Printf("  Created: %s\n", repo.CreatedAt)
-			fmt.Printf("  Updated: %s\n", repo.UpdatedAt)
+			if repo.BootstrapScript != "" {
+				fmt.Printf("  Bootstrap Script: %d lines (orc repo bootstrap show %s)\n", len(strings.Split(repo.BootstrapScript, "\n")), repo.ID)
+			}
+			fmt.Printf("  Created: %s\n", formatTime(repo.CreatedAt))
+			fmt.Printf("  Updated: %s\n", formatTime(repo.UpdatedAt))
 
 			return nil
 		},
 	}
 }
 
+func repoCheckCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "check [repo-id]",
+		Short: "Validate repository clones and worktrees",
+		Long: `Validate a registered repository, or every active one:
+
+- local path exists and is a git repository
+- origin matches the registered URL
+- the default branch exists locally or on origin
+- gh is logged in to the host (GitHub remotes only)
+- workbench worktrees exist and are registered with git, and no stray
+  worktrees are left under ~/wb
+
+Each problem comes with a suggested fix. 'orc doctor' runs the same checks.
+
+Examples:
+  orc repo check REPO-002
+  orc repo check`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			var repoIDs []string
+			if len(args) == 1 {
+				repoIDs = args
+			} else {
+				repos, err := wire.RepoService().ListRepos(ctx, primary.RepoFilters{Status: primary.RepoStatusActive})
+				if err != nil {
+					return fmt.Errorf("failed to list repositories: %w", err)
+				}
+				for _, r := range repos {
+					repoIDs = append(repoIDs, r.ID)
+				}
+			}
+			if len(repoIDs) == 0 {
+				fmt.Println("No repositories found.")
+				return nil
+			}
+
+			failed := 0
+			for i, id := range repoIDs {
+				health, err := wire.RepoService().CheckRepo(ctx, id)
+				if err != nil {
+					return fmt.Errorf("failed to check repository: %w", err)
+				}
+				if i > 0 {
+					fmt.Println()
+				}
+				renderRepoHealth(os.Stdout, health)
+				if health.Status == primary.RepoHealthFail {
+					failed++
+				}
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d repository check(s) failed", failed)
+			}
+			return nil
+		},
+	}
+}
+
+// repoHealthIcons mark check statuses, matching orc doctor.
+var repoHealthIcons = map[string]string{
+	primary.RepoHealthPass: "✓",
+	primary.RepoHealthWarn: "⚠",
+	primary.RepoHealthFail: "✗",
+}
+
+// renderRepoHealth prints one repository's checks with details and fixes
+// for those that did not pass.
+func renderRepoHealth(w io.Writer, h *primary.RepoHealth) {
+	fmt.Fprintf(w, "%s %s (%s)\n", repoHealthIcons[h.Status], h.RepoID, h.Name)
+	for _, c := range h.Checks {
+		fmt.Fprintf(w, "  %s %s\n", repoHealthIcons[c.Status], c.Name)
+		if c.Status == primary.RepoHealthPass {
+			continue
+		}
+		for _, line := range strings.Split(c.Detail, "\n") {
+			fmt.Fprintf(w, "      %s\n", line)
+		}
+		for _, line := range strings.Split(c.Fix, "\n") {
+			fmt.Fprintf(w, "      Fix: %s\n", line)
+		}
+	}
+}
+
 func repoUpdateCmd() *cobra.Command {
 	var url, localPath, defaultBranch string
 
@@ -292,3 +386,116 @@ Examples:
 
 	return cmd
 }
+
+func repoBootstrapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bootstrap",
+		Short: "Manage the workbench bootstrap script",
+		Long: `A bootstrap script is a shell script run (sh -e) inside every new
+workbench created for the repo: direnv allow, toolchain setup (nvm, go),
+pre-commit install, and so on.
+
+Outcome is tracked per workbench. Rerun with:
+  orc workbench bootstrap BENCH-xxx --rerun`,
+	}
+
+	cmd.AddCommand(repoBootstrapSetCmd())
+	cmd.AddCommand(repoBootstrapShowCmd())
+
+	return cmd
+}
+
+func repoBootstrapSetCmd() *cobra.Command {
+	var content, file string
+	var clear bool
+
+	cmd := &cobra.Command{
+		Use:   "set [repo-id]",
+		Short: "Set the workbench bootstrap script",
+		Long: `Set the workbench bootstrap script for a repository.
+
+Content is taken from --content or --file when given. Otherwise the current
+script is opened in $EDITOR (falls back to vi).
+
+Examples:
+  orc repo bootstrap set REPO-001 --file scripts/bootstrap.sh
+  orc repo bootstrap set REPO-001 --content "direnv allow && pre-commit install"
+  orc repo bootstrap set REPO-001 --clear`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			repoID := args[0]
+
+			repo, err := wire.RepoService().GetRepo(ctx, repoID)
+			if err != nil {
+				return fmt.Errorf("failed to get repository: %w", err)
+			}
+
+			var script string
+			switch {
+			case clear:
+				script = ""
+			case content != "":
+				script = content
+			case file != "":
+				data, err := os.ReadFile(file)
+				if err != nil {
+					return fmt.Errorf("failed to read script file: %w", err)
+				}
+				script = string(data)
+			default:
+				script, err = editInEditor(repoID+"-bootstrap-*.sh", repo.BootstrapScript)
+				if err != nil {
+					return err
+				}
+				if strings.TrimSpace(script) == strings.TrimSpace(repo.BootstrapScript) {
+					fmt.Println("Bootstrap script unchanged")
+					return nil
+				}
+			}
+
+			if err := wire.RepoService().SetBootstrapScript(ctx, repoID, script); err != nil {
+				return fmt.Errorf("failed to update bootstrap script: %w", err)
+			}
+
+			if strings.TrimSpace(script) == "" {
+				fmt.Printf("✓ Bootstrap script cleared for %s\n", repoID)
+			} else {
+				fmt.Printf("✓ Bootstrap script updated for %s\n", repoID)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&content, "content", "", "Script content")
+	cmd.Flags().StringVar(&file, "file", "", "Read script from file")
+	cmd.Flags().BoolVar(&clear, "clear", false, "Remove the bootstrap script")
+
+	return cmd
+}
+
+func repoBootstrapShowCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show [repo-id]",
+		Short: "Show the workbench bootstrap script",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			repoID := args[0]
+
+			repo, err := wire.RepoService().GetRepo(ctx, repoID)
+			if err != nil {
+				return fmt.Errorf("failed to get repository: %w", err)
+			}
+
+			if repo.BootstrapScript == "" {
+				fmt.Printf("%s has no bootstrap script\n", repoID)
+				fmt.Printf("\nAdd one with: orc repo bootstrap set %s --file <script>\n", repoID)
+				return nil
+			}
+
+			fmt.Println(repo.BootstrapScript)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,341 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// ReportCmd returns the report command group.
+func ReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Reports across the ledger",
+	}
+
+	cmd.AddCommand(reportBudgetCmd())
+	cmd.AddCommand(reportDAGCmd())
+	cmd.AddCommand(reportConflictsCmd())
+	return cmd
+}
+
+func reportBudgetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "budget [commission-id]",
+		Short: "Report budget consumption per commission",
+		Long: `Report how much of each commission's budget has been spent.
+
+Without an argument, every commission with a budget is listed. Set budgets
+with 'orc commission budget set'.
+
+Examples:
+  orc report budget
+  orc report budget COMM-001`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			if len(args) == 1 {
+				report, err := wire.BudgetService().GetBudgetReport(ctx, args[0])
+				if err != nil {
+					return err
+				}
+				if report == nil {
+					fmt.Printf("No budget set for %s\n", args[0])
+					return nil
+				}
+				printBudgetReport(report)
+				return nil
+			}
+
+			reports, err := wire.BudgetService().ListBudgetReports(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list budgets: %w", err)
+			}
+			if len(reports) == 0 {
+				fmt.Println("No commission budgets set.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "COMMISSION\tTITLE\tUNIT\tSPENT\tBUDGET\t%\tSTATUS")
+			for _, r := range reports {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%.0f%%\t%s\n",
+					r.CommissionID, truncate(r.CommissionTitle, 40), r.Unit,
+					formatBudgetAmount(r.Spent), formatBudgetAmount(r.Amount), r.Percent, budgetStatusLabel(r))
+			}
+			return w.Flush()
+		},
+	}
+}
+
+// printBudgetReport prints one commission's budget in detail.
+func printBudgetReport(r *primary.BudgetReport) {
+	fmt.Printf("Budget: %s - %s\n", r.CommissionID, r.CommissionTitle)
+	fmt.Printf("Spent: %s / %s %s (%.0f%%)\n", formatBudgetAmount(r.Spent), formatBudgetAmount(r.Amount), r.Unit, r.Percent)
+	if r.Unit == primary.BudgetUnitPoints {
+		fmt.Printf("Planned: %s points across all tasks\n", formatBudgetAmount(r.Planned))
+		if r.Unestimated > 0 {
+			fmt.Printf("Unestimated: %s\n", pluralize(r.Unestimated, "open task", "open tasks"))
+		}
+	}
+	thresholds := make([]string, len(r.Thresholds))
+	for i, th := range r.Thresholds {
+		thresholds[i] = strconv.Itoa(th) + "%"
+	}
+	fmt.Printf("Warnings at: %s\n", strings.Join(thresholds, ", "))
+	fmt.Printf("Status: %s\n", budgetStatusLabel(r))
+}
+
+// renderBudgetWarning prints a warning line under a commission summary
+// once its budget has passed a threshold. Errors are ignored: the summary
+// must render without budgets.
+func renderBudgetWarning(ctx context.Context, commissionID string) {
+	report, err := wire.BudgetService().GetBudgetReport(ctx, commissionID)
+	if err != nil || report == nil || report.ThresholdReached == 0 {
+		return
+	}
+	fmt.Printf("⚠️  Budget: %s/%s %s (%.0f%%) — %s\n",
+		formatBudgetAmount(report.Spent), formatBudgetAmount(report.Amount), report.Unit, report.Percent, budgetStatusLabel(report))
+}
+
+func budgetStatusLabel(r *primary.BudgetReport) string {
+	switch {
+	case r.Over:
+		return "over budget"
+	case r.ThresholdReached > 0:
+		return fmt.Sprintf("past %d%% threshold", r.ThresholdReached)
+	default:
+		return "ok"
+	}
+}
+
+// formatBudgetAmount prints whole amounts without decimals and others to one place.
+func formatBudgetAmount(v float64) string {
+	if v == float64(int64(v)) {
+		return strconv.FormatInt(int64(v), 10)
+	}
+	return strconv.FormatFloat(v, 'f', 1, 64)
+}
+
+func reportDAGCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dag",
+		Short: "Render a commission's shipment dependencies as a DAG",
+		Long: `Render shipments and the dependencies between them.
+
+Shipment A depends on shipment B when one of A's tasks depends on a task
+in B (see 'orc task create --depends-on'). Layers group shipments that can
+launch together: layer 0 is blocked by nothing, layer 1 only by layer 0,
+and so on. The critical path (★) is the chain with the most open tasks;
+it decides how soon the commission can finish.
+
+The dot format pipes into Graphviz:
+  orc report dag --format dot | dot -Tsvg > dag.svg
+
+Examples:
+  orc report dag
+  orc report dag --commission COMM-001 --format dot`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			commissionID, _ := cmd.Flags().GetString("commission")
+			format, _ := cmd.Flags().GetString("format")
+
+			if format != "ascii" && format != "dot" {
+				return fmt.Errorf("unknown format %q (use ascii or dot)", format)
+			}
+			if commissionID == "" {
+				commissionID = orccontext.GetContextCommissionID()
+				if commissionID == "" {
+					return fmt.Errorf("no commission context detected\nHint: Use --commission flag or run from a workbench directory")
+				}
+			}
+
+			dag, err := wire.ShipmentService().GetShipmentDAG(NewContext(), commissionID)
+			if err != nil {
+				return fmt.Errorf("failed to build shipment DAG: %w", err)
+			}
+
+			if format == "dot" {
+				renderDAGDot(os.Stdout, dag)
+			} else {
+				renderDAGASCII(os.Stdout, dag)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringP("commission", "c", "", "Commission ID (defaults to context)")
+	cmd.Flags().String("format", "ascii", "Output format (ascii or dot)")
+	return cmd
+}
+
+// renderDAGASCII prints the DAG layer by layer, each shipment with its blockers.
+func renderDAGASCII(w io.Writer, dag *primary.ShipmentDAG) {
+	fmt.Fprintf(w, "Shipment DAG: %s\n", dag.CommissionID)
+	if len(dag.Nodes) == 0 {
+		fmt.Fprintln(w, "\nNo shipments.")
+		return
+	}
+
+	blockers := make(map[string][]string)
+	for _, e := range dag.Edges {
+		blockers[e.To] = append(blockers[e.To], e.From)
+	}
+	cyclic := make(map[string]bool, len(dag.Cyclic))
+	for _, id := range dag.Cyclic {
+		cyclic[id] = true
+	}
+
+	layer := -1
+	for _, n := range dag.Nodes {
+		if n.Layer != layer {
+			layer = n.Layer
+			switch {
+			case cyclic[n.ID]:
+				fmt.Fprintln(w, "\nIn a cycle")
+			case layer == 0:
+				fmt.Fprintln(w, "\nLayer 0 (unblocked)")
+			default:
+				fmt.Fprintf(w, "\nLayer %d\n", layer)
+			}
+		}
+
+		marker := " "
+		if n.Critical {
+			marker = color.New(color.FgRed).Sprint("★")
+		}
+		line := fmt.Sprintf("  %s %s %s %s", marker, n.ID, n.Title, colorizeShipmentStatus(n.Status))
+		if n.TotalTasks > 0 {
+			line += fmt.Sprintf(" %d/%d open", n.OpenTasks, n.TotalTasks)
+		}
+		if b := blockers[n.ID]; len(b) > 0 {
+			line += " ← " + strings.Join(b, ", ")
+		}
+		fmt.Fprintln(w, line)
+	}
+
+	fmt.Fprintln(w)
+	if len(dag.CriticalPath) > 0 {
+		fmt.Fprintf(w, "Critical path: %s\n", strings.Join(dag.CriticalPath, " → "))
+	} else {
+		fmt.Fprintln(w, "Critical path: none (all shipments closed)")
+	}
+	if len(dag.Cyclic) > 0 {
+		fmt.Fprintf(w, "⚠️  Dependency cycle between %s\n", strings.Join(dag.Cyclic, ", "))
+	}
+}
+
+// dagDotColors fills DAG nodes by shipment status.
+var dagDotColors = map[string]string{
+	"draft":       "lightgray",
+	"ready":       "lightyellow",
+	"in-progress": "lightblue",
+	"closed":      "palegreen",
+}
+
+// renderDAGDot prints the DAG in Graphviz dot format.
+func renderDAGDot(w io.Writer, dag *primary.ShipmentDAG) {
+	fmt.Fprintf(w, "digraph \"%s\" {\n", dotEscape(dag.CommissionID))
+	fmt.Fprintln(w, "  rankdir=LR;")
+	fmt.Fprintln(w, "  node [shape=box, style=\"rounded,filled\"];")
+	for _, n := range dag.Nodes {
+		fill, ok := dagDotColors[n.Status]
+		if !ok {
+			fill = "white"
+		}
+		label := dotEscape(n.ID) + `\n` + dotEscape(n.Title) + `\n[` + dotEscape(n.Status) + `]`
+		attrs := fmt.Sprintf(`label="%s", fillcolor=%s`, label, fill)
+		if n.Critical {
+			attrs += ", color=red, penwidth=2"
+		}
+		fmt.Fprintf(w, "  \"%s\" [%s];\n", dotEscape(n.ID), attrs)
+	}
+	for _, e := range dag.Edges {
+		if e.Critical {
+			fmt.Fprintf(w, "  \"%s\" -> \"%s\" [color=red, penwidth=2];\n", dotEscape(e.From), dotEscape(e.To))
+		} else {
+			fmt.Fprintf(w, "  \"%s\" -> \"%s\";\n", dotEscape(e.From), dotEscape(e.To))
+		}
+	}
+	fmt.Fprintln(w, "}")
+}
+
+// dotEscape escapes a string for use inside a double-quoted dot ID.
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", " ").Replace(s)
+}
+
+func reportConflictsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conflicts",
+		Short: "Warn about open shipments changing the same files",
+		Long: `Compare the branches of open shipments and report pairs that modify
+the same files, before they collide at merge time.
+
+A shipment's changes are its workbench branch against the repo's default
+branch plus any uncommitted work. Only shipments with an assigned
+workbench are compared, and only against shipments in the same repo.
+Shipments whose workbench can't be read are listed as skipped.
+
+Without --commission, the current commission is checked; outside a
+commission context, all commissions are.
+
+Examples:
+  orc report conflicts
+  orc report conflicts --commission COMM-001`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			commissionID, _ := cmd.Flags().GetString("commission")
+			if commissionID == "" {
+				commissionID = orccontext.GetContextCommissionID()
+			}
+
+			report, err := wire.CommitLinkService().FindConflicts(NewContext(), commissionID)
+			if err != nil {
+				return fmt.Errorf("failed to check for conflicts: %w", err)
+			}
+
+			renderConflictReport(os.Stdout, report)
+			return nil
+		},
+	}
+	cmd.Flags().StringP("commission", "c", "", "Commission ID (defaults to context)")
+	return cmd
+}
+
+// renderConflictReport prints overlapping shipment pairs with their shared files.
+func renderConflictReport(w io.Writer, report *primary.ConflictReport) {
+	fmt.Fprintf(w, "Checked %d open shipment(s)\n", len(report.Checked))
+
+	if len(report.Overlaps) == 0 {
+		fmt.Fprintln(w, "\n✓ No overlapping changes between open shipments")
+	}
+	for _, o := range report.Overlaps {
+		fmt.Fprintf(w, "\n%s %s (%s) ↔ %s (%s): %d shared file(s)\n",
+			color.New(color.FgYellow).Sprint("⚠️ "), o.ShipmentA, o.WorkbenchA, o.ShipmentB, o.WorkbenchB, len(o.Files))
+		for _, f := range o.Files {
+			fmt.Fprintf(w, "    %s\n", f)
+		}
+	}
+	if len(report.Overlaps) > 0 {
+		fmt.Fprintln(w, "\nLand one shipment first, or sequence them with 'orc task create --depends-on'.")
+	}
+
+	if len(report.Skipped) > 0 {
+		fmt.Fprintln(w, "\nSkipped:")
+		for _, s := range report.Skipped {
+			fmt.Fprintf(w, "  %s: %s\n", s.ShipmentID, s.Reason)
+		}
+	}
+}
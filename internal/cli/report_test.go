@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func testShipmentDAG() *primary.ShipmentDAG {
+	return &primary.ShipmentDAG{
+		CommissionID: "COMM-001",
+		Nodes: []*primary.ShipmentDAGNode{
+			{ID: "SHIP-001", Title: "Schema", Status: "in-progress", OpenTasks: 1, TotalTasks: 2, Critical: true},
+			{ID: "SHIP-003", Title: `Docs "v2"`, Status: "draft"},
+			{ID: "SHIP-002", Title: "API", Status: "ready", OpenTasks: 3, TotalTasks: 3, Layer: 1, Critical: true},
+		},
+		Edges:        []primary.ShipmentDAGEdge{{From: "SHIP-001", To: "SHIP-002", Critical: true}},
+		CriticalPath: []string{"SHIP-001", "SHIP-002"},
+	}
+}
+
+func TestRenderDAGASCII(t *testing.T) {
+	noColor := color.NoColor
+	defer func() { color.NoColor = noColor }()
+	color.NoColor = true
+
+	var buf bytes.Buffer
+	renderDAGASCII(&buf, testShipmentDAG())
+
+	out := buf.String()
+	for _, want := range []string{
+		"Layer 0 (unblocked)",
+		"★ SHIP-001 Schema [in-progress] 1/2 open",
+		"Layer 1",
+		"★ SHIP-002 API [ready] 3/3 open ← SHIP-001",
+		"Critical path: SHIP-001 → SHIP-002",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderDAGDot(t *testing.T) {
+	var buf bytes.Buffer
+	renderDAGDot(&buf, testShipmentDAG())
+
+	out := buf.String()
+	for _, want := range []string{
+		`digraph "COMM-001" {`,
+		`"SHIP-001" [label="SHIP-001\nSchema\n[in-progress]", fillcolor=lightblue, color=red, penwidth=2];`,
+		`"SHIP-003" [label="SHIP-003\nDocs \"v2\"\n[draft]", fillcolor=lightgray];`,
+		`"SHIP-001" -> "SHIP-002" [color=red, penwidth=2];`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderConflictReport(t *testing.T) {
+	noColor := color.NoColor
+	defer func() { color.NoColor = noColor }()
+	color.NoColor = true
+
+	var buf bytes.Buffer
+	renderConflictReport(&buf, &primary.ConflictReport{
+		Checked:  []string{"SHIP-001", "SHIP-002"},
+		Skipped:  []primary.ConflictSkip{{ShipmentID: "SHIP-003", Reason: "workbench BENCH-404 not found"}},
+		Overlaps: []*primary.ShipmentOverlap{{ShipmentA: "SHIP-001", WorkbenchA: "BENCH-001", ShipmentB: "SHIP-002", WorkbenchB: "BENCH-002", Files: []string{"main.go"}}},
+	})
+
+	out := buf.String()
+	for _, want := range []string{
+		"Checked 2 open shipment(s)",
+		"SHIP-001 (BENCH-001) ↔ SHIP-002 (BENCH-002): 1 shared file(s)",
+		"    main.go",
+		"--depends-on",
+		"SHIP-003: workbench BENCH-404 not found",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	buf.Reset()
+	renderConflictReport(&buf, &primary.ConflictReport{Checked: []string{"SHIP-001"}})
+	if !strings.Contains(buf.String(), "No overlapping changes") {
+		t.Errorf("expected all-clear message, got:\n%s", buf.String())
+	}
+}
@@ -57,84 +57,49 @@ Examples:
 			return fmt.Errorf("failed to generate entity: %w", err)
 		}
 
-		// Display what will be created
-		fmt.Printf("Generating entity '%s'", spec.Name)
-		if len(spec.Fields) > 0 {
-			fieldStrs := make([]string, len(spec.Fields))
-			for i, f := range spec.Fields {
-				nullable := ""
-				if f.Nullable {
-					nullable = "?"
-				}
-				fieldStrs[i] = fmt.Sprintf("%s(%s%s)", f.NameSnake, f.Type, nullable)
-			}
-			fmt.Printf(" with fields: %s", strings.Join(fieldStrs, ", "))
-		}
-		fmt.Println()
-		fmt.Println()
+		return applyScaffold(spec, result, dryRun)
+	},
+}
 
-		// Show files to create
-		fmt.Println("Files to create:")
-		for _, f := range result.Files {
-			if f.Operation == "create" {
-				fmt.Printf("  %s\n", f.Path)
-			}
-		}
-		fmt.Println()
+var scaffoldAdapterCmd = &cobra.Command{
+	Use:   "adapter <spec-file>",
+	Short: "Generate an entity stack and schema table from a definition file",
+	Long: `Generate the full hexagonal stack for a new entity from a definition file:
+the files and snippets of 'orc scaffold entity', plus a CREATE TABLE
+appended to internal/db/schema.sql.
 
-		// Show files to modify
-		fmt.Println("Files to modify:")
-		for _, f := range result.Files {
-			if f.Operation != "create" {
-				fmt.Printf("  %s\n", f.Path)
-			}
-		}
-		fmt.Println()
+The definition file holds one "key: value" per line (# starts a comment);
+"fields" may repeat:
 
-		if dryRun {
-			fmt.Println("(dry-run mode - no files written)")
-			fmt.Println()
-			// Show generated content for inspection
-			for _, f := range result.Files {
-				if f.Operation == "create" {
-					fmt.Printf("--- %s ---\n", f.Path)
-					fmt.Println(f.Content)
-					fmt.Println()
-				}
-			}
-			return nil
-		}
+  name: widget
+  id-prefix: WDG
+  fields: name:string, value:int
+  fields: due_at:time?
+  status: draft, active, done
+  parent: shipment:n:1
 
-		// Confirm
-		fmt.Print("Proceed? [y/N] ")
-		reader := bufio.NewReader(os.Stdin)
-		response, _ := reader.ReadString('\n')
-		response = strings.TrimSpace(strings.ToLower(response))
-		if response != "y" && response != "yes" {
-			fmt.Println("Aborted.")
-			return nil
-		}
+Examples:
+  orc scaffold adapter widget.spec --dry-run
+  orc scaffold adapter widget.spec`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
 
-		// Write files
-		for _, f := range result.Files {
-			if err := writeGeneratedFile(f); err != nil {
-				return fmt.Errorf("failed to write %s: %w", f.Path, err)
-			}
-			if f.Operation == "create" {
-				fmt.Printf("✓ Created %s\n", f.Path)
-			} else {
-				fmt.Printf("✓ Modified %s\n", f.Path)
-			}
+		content, err := os.ReadFile(args[0])
+		if err != nil {
+			return fmt.Errorf("failed to read spec file: %w", err)
+		}
+		spec, err := scaffold.ParseSpecFile(string(content))
+		if err != nil {
+			return fmt.Errorf("%s: %w", args[0], err)
 		}
 
-		// Show next steps
-		fmt.Println()
-		fmt.Println("Next steps:")
-		for i, step := range result.NextSteps {
-			fmt.Printf("  %d. %s\n", i+1, step)
+		result, err := scaffold.NewGenerator().GenerateAdapter(spec)
+		if err != nil {
+			return fmt.Errorf("failed to generate adapter: %w", err)
 		}
 
-		return nil
+		return applyScaffold(spec, result, dryRun)
 	},
 }
 
@@ -146,7 +111,11 @@ func init() {
 	scaffoldEntityCmd.Flags().String("parent", "", "Parent entity relationship (e.g., 'shipment:1:1' or 'shipment:n:1')")
 	scaffoldEntityCmd.Flags().Bool("dry-run", false, "Preview without writing files")
 
+	// Adapter flags
+	scaffoldAdapterCmd.Flags().Bool("dry-run", false, "Preview without writing files")
+
 	scaffoldCmd.AddCommand(scaffoldEntityCmd)
+	scaffoldCmd.AddCommand(scaffoldAdapterCmd)
 }
 
 // ScaffoldCmd returns the scaffold command
@@ -154,6 +123,93 @@ func ScaffoldCmd() *cobra.Command {
 	return scaffoldCmd
 }
 
+// applyScaffold previews generated files and, once confirmed, writes them.
+func applyScaffold(spec *scaffold.EntitySpec, result *scaffold.GeneratorResult, dryRun bool) error {
+	// Display what will be created
+	fmt.Printf("Generating entity '%s'", spec.Name)
+	if len(spec.Fields) > 0 {
+		fieldStrs := make([]string, len(spec.Fields))
+		for i, f := range spec.Fields {
+			nullable := ""
+			if f.Nullable {
+				nullable = "?"
+			}
+			fieldStrs[i] = fmt.Sprintf("%s(%s%s)", f.NameSnake, f.Type, nullable)
+		}
+		fmt.Printf(" with fields: %s", strings.Join(fieldStrs, ", "))
+	}
+	fmt.Println()
+	fmt.Println()
+
+	// Show files to create
+	fmt.Println("Files to create:")
+	for _, f := range result.Files {
+		if f.Operation == "create" {
+			fmt.Printf("  %s\n", f.Path)
+		}
+	}
+	fmt.Println()
+
+	// Show files to modify
+	fmt.Println("Files to modify:")
+	for _, f := range result.Files {
+		if f.Operation != "create" {
+			fmt.Printf("  %s\n", f.Path)
+		}
+	}
+	fmt.Println()
+
+	if dryRun {
+		fmt.Println("(dry-run mode - no files written)")
+		fmt.Println()
+		// Show generated content for inspection
+		for _, f := range result.Files {
+			switch f.Operation {
+			case "create":
+				fmt.Printf("--- %s ---\n", f.Path)
+				fmt.Println(f.Content)
+				fmt.Println()
+			case "append":
+				fmt.Printf("--- %s (appended) ---\n", f.Path)
+				fmt.Println(f.Snippet)
+				fmt.Println()
+			}
+		}
+		return nil
+	}
+
+	// Confirm
+	fmt.Print("Proceed? [y/N] ")
+	reader := bufio.NewReader(os.Stdin)
+	response, _ := reader.ReadString('\n')
+	response = strings.TrimSpace(strings.ToLower(response))
+	if response != "y" && response != "yes" {
+		fmt.Println("Aborted.")
+		return nil
+	}
+
+	// Write files
+	for _, f := range result.Files {
+		if err := writeGeneratedFile(f); err != nil {
+			return fmt.Errorf("failed to write %s: %w", f.Path, err)
+		}
+		if f.Operation == "create" {
+			fmt.Printf("✓ Created %s\n", f.Path)
+		} else {
+			fmt.Printf("✓ Modified %s\n", f.Path)
+		}
+	}
+
+	// Show next steps
+	fmt.Println()
+	fmt.Println("Next steps:")
+	for i, step := range result.NextSteps {
+		fmt.Printf("  %d. %s\n", i+1, step)
+	}
+
+	return nil
+}
+
 // writeGeneratedFile writes a generated file to disk.
 func writeGeneratedFile(f scaffold.GeneratedFile) error {
 	if f.Operation == "create" {
@@ -165,6 +221,19 @@ func writeGeneratedFile(f scaffold.GeneratedFile) error {
 		return os.WriteFile(f.Path, []byte(f.Content), 0644)
 	}
 
+	// Appends go at the end of the file, so need no insertion point
+	if f.Operation == "append" {
+		file, err := os.OpenFile(f.Path, os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+		if _, err := file.WriteString(f.Snippet); err != nil {
+			file.Close()
+			return err
+		}
+		return file.Close()
+	}
+
 	// For append/insert operations, we'd need to modify existing files
 	// This is more complex and would require finding the insertion point
 	fmt.Printf("  NOTE: %s requires manual integration - see snippet below:\n", f.Path)
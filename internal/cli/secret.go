@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// SecretCmd returns the secret command
+func SecretCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secret",
+		Short: "Manage encrypted integration credentials",
+		Long: `Store credentials for integrations (GitHub tokens, Slack webhooks) in the
+ledger, encrypted with a key kept beside the database (or $ORC_SECRET_KEY).
+
+Secrets are global by default, or scoped to a factory or repo. When a secret
+is resolved for a repo, the repo's value wins over its factory's, which wins
+over the global one. Values are only ever printed by 'orc secret get'.
+
+Examples:
+  echo "$TOKEN" | orc secret set github-token
+  orc secret set github-token --repo REPO-003 --value ghp_...
+  orc secret get github-token --repo REPO-003 --resolve
+  orc secret list`,
+	}
+	cmd.AddCommand(secretSetCmd())
+	cmd.AddCommand(secretGetCmd())
+	cmd.AddCommand(secretListCmd())
+	cmd.AddCommand(secretDeleteCmd())
+	return cmd
+}
+
+// addSecretScopeFlags registers the --repo and --factory scope flags.
+func addSecretScopeFlags(cmd *cobra.Command) {
+	cmd.Flags().String("repo", "", "Scope to a repo (REPO-xxx)")
+	cmd.Flags().String("factory", "", "Scope to a factory (FACT-xxx)")
+}
+
+// secretScopeFromFlags builds the scope selected by --repo or --factory (global if neither).
+func secretScopeFromFlags(cmd *cobra.Command) (primary.SecretScope, error) {
+	repoID, _ := cmd.Flags().GetString("repo")
+	factoryID, _ := cmd.Flags().GetString("factory")
+
+	switch {
+	case repoID != "" && factoryID != "":
+		return primary.SecretScope{}, fmt.Errorf("use --repo or --factory, not both")
+	case repoID != "":
+		return primary.SecretScope{Type: "repo", ID: repoID}, nil
+	case factoryID != "":
+		return primary.SecretScope{Type: "factory", ID: factoryID}, nil
+	}
+	return primary.SecretScope{Type: "global"}, nil
+}
+
+// describeSecretScope renders a scope for output ("global", "repo REPO-003").
+func describeSecretScope(scope primary.SecretScope) string {
+	if scope.ID == "" {
+		return scope.Type
+	}
+	return scope.Type + " " + scope.ID
+}
+
+func secretSetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set [name]",
+		Short: "Store a secret (value from stdin or --value)",
+		Long: `Store a secret, replacing any existing value in the same scope.
+
+Prefer piping the value on stdin so it stays out of shell history:
+  pbpaste | orc secret set github-token --repo REPO-003`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			scope, err := secretScopeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			value, _ := cmd.Flags().GetString("value")
+			if value == "" {
+				value, err = readSecretFromStdin()
+				if err != nil {
+					return err
+				}
+			}
+
+			ctx := NewContext()
+			if err := wire.SecretService().SetSecret(ctx, primary.SetSecretRequest{
+				Name:  name,
+				Scope: scope,
+				Value: value,
+			}); err != nil {
+				return fmt.Errorf("failed to set secret: %w", err)
+			}
+
+			fmt.Printf("✓ Secret %s stored (%s)\n", name, describeSecretScope(scope))
+			return nil
+		},
+	}
+	addSecretScopeFlags(cmd)
+	cmd.Flags().String("value", "", "Secret value (visible in shell history; prefer stdin)")
+	return cmd
+}
+
+// readSecretFromStdin reads a piped secret value, trimming the trailing newline.
+func readSecretFromStdin() (string, error) {
+	info, err := os.Stdin.Stat()
+	if err != nil || info.Mode()&os.ModeCharDevice != 0 {
+		return "", fmt.Errorf("no value given: pipe it on stdin or use --value")
+	}
+	data, err := io.ReadAll(bufio.NewReader(os.Stdin))
+	if err != nil {
+		return "", fmt.Errorf("failed to read value from stdin: %w", err)
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+func secretGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get [name]",
+		Short: "Print a secret's value",
+		Long: `Print a secret's value to stdout (nothing else), for use in scripts.
+
+Without --resolve, the value must exist in exactly the given scope. With
+--resolve, the repo, factory, and global scopes are searched in that order.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			resolve, _ := cmd.Flags().GetBool("resolve")
+
+			ctx := NewContext()
+			var value string
+			var err error
+			if resolve {
+				repoID, _ := cmd.Flags().GetString("repo")
+				factoryID, _ := cmd.Flags().GetString("factory")
+				value, err = wire.SecretService().ResolveSecret(ctx, name, repoID, factoryID)
+			} else {
+				scope, scopeErr := secretScopeFromFlags(cmd)
+				if scopeErr != nil {
+					return scopeErr
+				}
+				value, err = wire.SecretService().GetSecret(ctx, name, scope)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get secret: %w", err)
+			}
+
+			fmt.Println(value)
+			return nil
+		},
+	}
+	addSecretScopeFlags(cmd)
+	cmd.Flags().Bool("resolve", false, "Fall back from repo to factory to global scope")
+	return cmd
+}
+
+func secretListCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List secret names and scopes (never values)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var scope primary.SecretScope
+			if cmd.Flags().Changed("repo") || cmd.Flags().Changed("factory") {
+				var err error
+				if scope, err = secretScopeFromFlags(cmd); err != nil {
+					return err
+				}
+			}
+
+			ctx := NewContext()
+			secrets, err := wire.SecretService().ListSecrets(ctx, scope)
+			if err != nil {
+				return fmt.Errorf("failed to list secrets: %w", err)
+			}
+
+			if len(secrets) == 0 {
+				fmt.Println("No secrets found.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tSCOPE\tUPDATED")
+			fmt.Fprintln(w, "----\t-----\t-------")
+			for _, s := range secrets {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", s.Name, describeSecretScope(s.Scope), formatWhen(s.UpdatedAt))
+			}
+			return w.Flush()
+		},
+	}
+	addSecretScopeFlags(cmd)
+	return cmd
+}
+
+func secretDeleteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [name]",
+		Short: "Delete a secret from a scope",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			scope, err := secretScopeFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctx := NewContext()
+			if err := wire.SecretService().DeleteSecret(ctx, name, scope); err != nil {
+				return fmt.Errorf("failed to delete secret: %w", err)
+			}
+
+			fmt.Printf("✓ Secret %s deleted (%s)\n", name, describeSecretScope(scope))
+			return nil
+		},
+	}
+	addSecretScopeFlags(cmd)
+	return cmd
+}
@@ -87,14 +87,18 @@ var shipmentListCmd = &cobra.Command{
 		}
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintln(w, "ID\tTITLE\tSTATUS\tCOMMISSION")
-		fmt.Fprintln(w, "--\t-----\t------\t-------")
+		fmt.Fprintln(w, "ID\tTITLE\tSTATUS\tTASKS\tWORKBENCH\tCOMMISSION")
+		fmt.Fprintln(w, "--\t-----\t------\t-----\t---------\t-------")
 		for _, s := range shipments {
 			pinnedMark := ""
 			if s.Pinned {
 				pinnedMark = " [pinned]"
 			}
-			fmt.Fprintf(w, "%s\t%s%s\t%s\t%s\n", s.ID, s.Title, pinnedMark, s.Status, s.CommissionID)
+			workbench := s.WorkbenchName
+			if workbench == "" {
+				workbench = "-"
+			}
+			fmt.Fprintf(w, "%s\t%s%s\t%s\t%d/%d\t%s\t%s\n", s.ID, s.Title, pinnedMark, s.Status, s.TasksClosed, s.TaskCount, workbench, s.CommissionID)
 		}
 		w.Flush()
 		return nil
@@ -114,48 +118,78 @@ var shipmentShowCmd = &cobra.Command{
 			return fmt.Errorf("shipment not found: %w", err)
 		}
 
-		fmt.Printf("Shipment: %s\n", shipment.ID)
-		fmt.Printf("Title: %s\n", shipment.Title)
-		if shipment.Description != "" {
-			fmt.Printf("Description: %s\n", shipment.Description)
-		}
-		fmt.Printf("Status: %s\n", shipment.Status)
-		fmt.Printf("Commission: %s\n", shipment.CommissionID)
-		if shipment.AssignedWorkbenchID != "" {
-			fmt.Printf("Assigned Workbench: %s\n", shipment.AssignedWorkbenchID)
-		}
-		if shipment.RepoID != "" {
-			fmt.Printf("Repository: %s\n", shipment.RepoID)
-		}
-		if shipment.Branch != "" {
-			fmt.Printf("Branch: %s\n", shipment.Branch)
-		}
-		if shipment.Pinned {
-			fmt.Printf("Pinned: yes\n")
-		}
-		fmt.Printf("Created: %s\n", shipment.CreatedAt)
-		if shipment.CompletedAt != "" {
-			fmt.Printf("Completed: %s\n", shipment.CompletedAt)
-		}
-
-		// Show tasks
 		tasks, err := wire.ShipmentService().GetShipmentTasks(ctx, shipmentID)
 		if err != nil {
 			return fmt.Errorf("failed to get tasks: %w", err)
 		}
 
-		if len(tasks) > 0 {
-			fmt.Printf("\nTasks (%d):\n", len(tasks))
-			for _, task := range tasks {
-				statusIcon := getStatusIcon(task.Status)
-				fmt.Printf("  %s %s: %s [%s]\n", statusIcon, task.ID, task.Title, task.Status)
-			}
-		}
+		rel := loadRelations(ctx, shipmentID, "shipment")
+		rel.pr, _ = wire.PRService().GetPRByShipment(ctx, shipmentID)
 
+		shipmentDetail(shipment, tasks, rel).render(os.Stdout)
 		return nil
 	},
 }
 
+// shipmentDetail builds the shipment show view: fields, charter, and tasks.
+func shipmentDetail(shipment *primary.Shipment, tasks []*primary.Task, rel detailRelations) *detailView {
+	v := newDetailView("Shipment", shipment.ID+presenceMarker(rel.presence))
+	v.field("Title", shipment.Title)
+	v.field("Description", shipment.Description)
+	v.field("Status", shipment.Status)
+	v.field("Branch", shipment.Branch)
+	if shipment.Pinned {
+		v.field("Pinned", "yes")
+	}
+	v.field("Created", formatTime(shipment.CreatedAt))
+	v.field("Completed", formatTime(shipment.CompletedAt))
+
+	v.panel(rel.relatedPanel(
+		detailField{"Commission", shipment.CommissionID},
+		detailField{"Workbench", shipment.AssignedWorkbenchID},
+		detailField{"Repository", shipment.RepoID},
+		rel.prEntry(),
+	))
+	v.panel(charterPanel(shipment.Charter))
+
+	taskPanel := detailPanel{title: fmt.Sprintf("Tasks (%d)", len(tasks))}
+	for _, task := range tasks {
+		taskPanel.lines = append(taskPanel.lines, fmt.Sprintf("%s %s: %s [%s]", getStatusIcon(task.Status), task.ID, task.Title, task.Status))
+	}
+	v.panel(taskPanel)
+	v.panel(commitsPanel(rel.commits))
+	v.panel(commentsPanel(rel.comments))
+	v.panel(eventsPanel(rel.events))
+	v.panel(nextPanel(shipmentNextActions(shipment, tasks)...))
+	return v
+}
+
+// shipmentNextActions suggests commands that move a shipment forward.
+func shipmentNextActions(shipment *primary.Shipment, tasks []*primary.Task) []string {
+	switch shipment.Status {
+	case "draft":
+		return []string{fmt.Sprintf("orc shipment status %s --set ready", shipment.ID)}
+	case "ready":
+		return []string{fmt.Sprintf("orc shipment status %s --set in-progress", shipment.ID)}
+	case "in-progress":
+		if len(tasks) == 0 {
+			return []string{fmt.Sprintf("orc task create \"...\" --shipment %s", shipment.ID)}
+		}
+		for _, task := range tasks {
+			if task.Status == "open" {
+				return []string{"orc task claim " + task.ID}
+			}
+		}
+		for _, task := range tasks {
+			if task.Status != "closed" {
+				return nil
+			}
+		}
+		return []string{"orc shipment land " + shipment.ID}
+	}
+	return nil
+}
+
 var shipmentCompleteCmd = &cobra.Command{
 	Use:   "complete [shipment-id]",
 	Short: "Mark shipment as complete",
@@ -175,6 +209,39 @@ var shipmentCompleteCmd = &cobra.Command{
 	},
 }
 
+var shipmentLandCmd = &cobra.Command{
+	Use:   "land [shipment-id]",
+	Short: "Verify linked commits and complete the shipment",
+	Long: `Land a shipment: sync commits from its assigned workbench, verify that
+every task has at least one linked commit, then mark the shipment complete.
+
+Commits are linked by mentioning task IDs in commit messages
+(e.g. "TASK-123: fix redirect").
+
+--force skips the commit and open-task checks and tolerates a failed sync.
+
+Examples:
+  orc shipment land SHIP-001
+  orc shipment land SHIP-001 --force`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		shipmentID := args[0]
+		force, _ := cmd.Flags().GetBool("force")
+
+		result, err := wire.CommitLinkService().LandShipment(ctx, shipmentID, force)
+		if err != nil {
+			return fmt.Errorf("failed to land shipment: %w", err)
+		}
+
+		if result.Sync != nil {
+			printSyncCommitsResult(result.Sync)
+		}
+		fmt.Printf("🛬 Shipment %s landed (%d task(s) verified)\n", shipmentID, result.TaskCount)
+		return nil
+	},
+}
+
 var shipmentUpdateCmd = &cobra.Command{
 	Use:   "update [shipment-id]",
 	Short: "Update shipment title, description, and/or branch",
@@ -267,7 +334,9 @@ var shipmentStatusCmd = &cobra.Command{
 
 Valid statuses: draft, ready, in-progress, closed
 
-Backwards transitions require --force flag.`,
+Only transitions in the shipment state machine are allowed (see
+'orc shipment transitions'); anything else, such as ready -> closed,
+requires --force and is logged as forced.`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := NewContext()
@@ -292,6 +361,7 @@ Backwards transitions require --force flag.`,
 func init() {
 	// shipment create flags
 	shipmentCreateCmd.Flags().StringP("commission", "c", "", "Commission ID (defaults to context)")
+	inferFromFocus(shipmentCreateCmd, "commission")
 	shipmentCreateCmd.Flags().StringP("description", "d", "", "Shipment description")
 	shipmentCreateCmd.Flags().StringP("repo", "r", "", "Repository ID to link for branch ownership")
 	shipmentCreateCmd.Flags().String("branch", "", "Override auto-generated branch name")
@@ -308,20 +378,42 @@ func init() {
 	// Flags for complete command
 	shipmentCompleteCmd.Flags().BoolP("force", "f", false, "Complete even if tasks are incomplete")
 
+	// Flags for land command
+	shipmentLandCmd.Flags().BoolP("force", "f", false, "Land even if tasks lack linked commits or are incomplete")
+
 	// Flags for status command
 	shipmentStatusCmd.Flags().String("set", "", "Status to set (required)")
-	shipmentStatusCmd.Flags().Bool("force", false, "Allow backwards transitions")
+	shipmentStatusCmd.Flags().Bool("force", false, "Allow transitions outside the state machine")
 
 	// Register subcommands
 	shipmentCmd.AddCommand(shipmentCreateCmd)
 	shipmentCmd.AddCommand(shipmentListCmd)
 	shipmentCmd.AddCommand(shipmentShowCmd)
 	shipmentCmd.AddCommand(shipmentCompleteCmd)
+	shipmentCmd.AddCommand(shipmentLandCmd)
 	shipmentCmd.AddCommand(shipmentUpdateCmd)
 	shipmentCmd.AddCommand(shipmentPinCmd)
 	shipmentCmd.AddCommand(shipmentUnpinCmd)
+	shipmentCmd.AddCommand(aliasCmd("shipment", "SHIP-014"))
+	shipmentCmd.AddCommand(unaliasCmd("shipment"))
 	shipmentCmd.AddCommand(shipmentAssignCmd)
 	shipmentCmd.AddCommand(shipmentStatusCmd)
+	shipmentCmd.AddCommand(transitionsCmd("shipment", func() []primary.StatusTransition {
+		return wire.ShipmentService().StatusTransitions()
+	}))
+	shipmentCmd.AddCommand(newCharterCmd(charterTarget{
+		entity: "shipment",
+		get: func(id string) (string, string, error) {
+			shipment, err := wire.ShipmentService().GetShipment(NewContext(), id)
+			if err != nil {
+				return "", "", err
+			}
+			return shipment.Title, shipment.Charter, nil
+		},
+		set: func(id, charter string) error {
+			return wire.ShipmentService().SetShipmentCharter(NewContext(), id, charter)
+		},
+	}))
 }
 
 // ShipmentCmd returns the shipment command
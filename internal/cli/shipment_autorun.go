@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+var shipmentAutorunCmd = &cobra.Command{
+	Use:   "autorun",
+	Short: "Run a shipment's tasks unattended",
+	Long: `Let a shipment's IMP work through its tasks without being told each one.
+
+With autorun on, whenever the IMP of a workbench focused on the shipment
+tries to stop with no task in progress, the Stop hook tells it which task to
+claim next. Tasks run one at a time in ID order, each once the tasks it
+depends on are closed. The run waits, letting the IMP stop, while:
+
+  - the shipment is still draft (mark it ready to launch)
+  - a task is blocked
+  - the next task has a draft plan awaiting approval
+  - the next tasks depend on open tasks in other shipments
+
+Pause to stop handing out tasks without losing the setting.
+
+Examples:
+  orc shipment autorun on SHIP-010
+  orc shipment autorun status SHIP-010
+  orc shipment autorun pause SHIP-010
+  orc shipment autorun resume SHIP-010`,
+}
+
+var shipmentAutorunOnCmd = &cobra.Command{
+	Use:   "on [shipment-id]",
+	Short: "Turn autorun on",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setAutorun(args[0], primary.AutorunOn, "on")
+	},
+}
+
+var shipmentAutorunOffCmd = &cobra.Command{
+	Use:   "off [shipment-id]",
+	Short: "Turn autorun off",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setAutorun(args[0], "", "off")
+	},
+}
+
+var shipmentAutorunPauseCmd = &cobra.Command{
+	Use:   "pause [shipment-id]",
+	Short: "Stop handing out tasks until resumed",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setAutorun(args[0], primary.AutorunPaused, "paused")
+	},
+}
+
+var shipmentAutorunResumeCmd = &cobra.Command{
+	Use:   "resume [shipment-id]",
+	Short: "Resume a paused autorun",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setAutorun(args[0], primary.AutorunOn, "resumed")
+	},
+}
+
+var shipmentAutorunStatusCmd = &cobra.Command{
+	Use:   "status [shipment-id]",
+	Short: "Show autorun state and the next step",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		step, err := wire.AutorunService().NextStep(NewContext(), args[0])
+		if err != nil {
+			return fmt.Errorf("failed to get autorun status: %w", err)
+		}
+		fmt.Printf("%s autorun: %s\n", step.ShipmentID, autorunStateLabel(step.State))
+		fmt.Printf("  Next: %s\n", describeAutorunStep(step))
+		return nil
+	},
+}
+
+// setAutorun changes a shipment's autorun state and shows what happens next.
+func setAutorun(shipmentID, state, verb string) error {
+	ctx := NewContext()
+	if err := wire.AutorunService().SetAutorun(ctx, shipmentID, state); err != nil {
+		return fmt.Errorf("failed to update autorun: %w", err)
+	}
+	fmt.Printf("✓ Autorun %s for %s\n", verb, shipmentID)
+
+	if state == primary.AutorunOn {
+		if step, err := wire.AutorunService().NextStep(ctx, shipmentID); err == nil {
+			fmt.Printf("  Next: %s\n", describeAutorunStep(step))
+		}
+	}
+	return nil
+}
+
+// autorunStateLabel names an autorun state; the empty state is off.
+func autorunStateLabel(state string) string {
+	if state == "" {
+		return "off"
+	}
+	return state
+}
+
+// describeAutorunStep summarizes the executor's decision in a line.
+func describeAutorunStep(step *primary.AutorunStep) string {
+	switch step.Action {
+	case primary.AutorunRun:
+		return fmt.Sprintf("claim %s (%s)", step.TaskID, step.TaskTitle)
+	case primary.AutorunWorking:
+		return fmt.Sprintf("%s (%s) is in progress", step.TaskID, step.TaskTitle)
+	case primary.AutorunWait:
+		return "waiting: " + step.Reason
+	case primary.AutorunDone:
+		return "done: " + step.Reason
+	}
+	return step.Reason
+}
+
+// autorunInstruction is what the Stop hook tells an IMP to do next.
+func autorunInstruction(step *primary.AutorunStep) string {
+	return fmt.Sprintf("Autorun %s: %s (%s) is next. Claim it with 'orc task claim %s', complete it, then stop.",
+		step.ShipmentID, step.TaskID, step.TaskTitle, step.TaskID)
+}
+
+func init() {
+	shipmentAutorunCmd.AddCommand(shipmentAutorunOnCmd)
+	shipmentAutorunCmd.AddCommand(shipmentAutorunOffCmd)
+	shipmentAutorunCmd.AddCommand(shipmentAutorunPauseCmd)
+	shipmentAutorunCmd.AddCommand(shipmentAutorunResumeCmd)
+	shipmentAutorunCmd.AddCommand(shipmentAutorunStatusCmd)
+	shipmentCmd.AddCommand(shipmentAutorunCmd)
+}
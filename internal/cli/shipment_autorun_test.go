@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func TestDescribeAutorunStep(t *testing.T) {
+	tests := []struct {
+		step primary.AutorunStep
+		want string
+	}{
+		{primary.AutorunStep{Action: primary.AutorunRun, TaskID: "TASK-003", TaskTitle: "Model"}, "claim TASK-003 (Model)"},
+		{primary.AutorunStep{Action: primary.AutorunWorking, TaskID: "TASK-003", TaskTitle: "Model"}, "TASK-003 (Model) is in progress"},
+		{primary.AutorunStep{Action: primary.AutorunWait, Reason: "PLAN-001 for TASK-003 awaits approval"}, "waiting: PLAN-001 for TASK-003 awaits approval"},
+		{primary.AutorunStep{Action: primary.AutorunIdle, Reason: "autorun is paused"}, "autorun is paused"},
+	}
+	for _, tt := range tests {
+		if got := describeAutorunStep(&tt.step); got != tt.want {
+			t.Errorf("describeAutorunStep(%s) = %q, want %q", tt.step.Action, got, tt.want)
+		}
+	}
+}
+
+func TestWriteStopDecision(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeStopDecision(&buf, "claim TASK-003"); err != nil {
+		t.Fatalf("writeStopDecision() error = %v", err)
+	}
+	var got map[string]string
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output is not JSON: %q", buf.String())
+	}
+	if got["decision"] != "block" || got["reason"] != "claim TASK-003" {
+		t.Errorf("decision = %v", got)
+	}
+}
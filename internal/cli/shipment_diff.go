@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+var shipmentDiffCmd = &cobra.Command{
+	Use:   "diff [shipment-id] [path...]",
+	Short: "Show the shipment branch's changes against its base branch",
+	Long: `Show the aggregate diff of a shipment's workbench branch against the
+repo's default branch (main when the workbench has no repo), for review
+before landing.
+
+Each changed file is listed with its line counts and the tasks whose
+commits touched it, followed by the full patch. Give paths to limit the
+patch to those files. The patch is shown through $PAGER (falls back to
+less -R) when writing to a terminal.
+
+Examples:
+  orc shipment diff SHIP-010
+  orc shipment diff SHIP-010 --stat
+  orc shipment diff SHIP-010 internal/app/plan_service.go`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		statOnly, _ := cmd.Flags().GetBool("stat")
+		noPager, _ := cmd.Flags().GetBool("no-pager")
+
+		diff, err := wire.CommitLinkService().DiffShipment(ctx, primary.DiffShipmentRequest{
+			ShipmentID: args[0],
+			WithPatch:  !statOnly,
+			Paths:      args[1:],
+		})
+		if err != nil {
+			return fmt.Errorf("failed to diff shipment: %w", err)
+		}
+
+		var out strings.Builder
+		writeShipmentDiffStat(&out, diff)
+		if diff.Patch != "" {
+			out.WriteString("\n")
+			out.WriteString(diff.Patch)
+		}
+
+		if noPager || diff.Patch == "" || !isTerminal(os.Stdout) {
+			fmt.Print(out.String())
+			return nil
+		}
+		return page(out.String())
+	},
+}
+
+// writeShipmentDiffStat writes the summary line and per-file table.
+func writeShipmentDiffStat(out io.Writer, diff *primary.ShipmentDiff) {
+	fmt.Fprintf(out, "%s vs %s (%s): %d file(s) changed, +%d -%d\n",
+		diff.ShipmentID, diff.BaseRef, diff.WorkbenchID, len(diff.Files), diff.Added, diff.Deleted)
+	if len(diff.Files) == 0 {
+		return
+	}
+
+	fmt.Fprintln(out)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, f := range diff.Files {
+		counts := fmt.Sprintf("+%d -%d", f.Added, f.Deleted)
+		if f.Binary {
+			counts = "binary"
+		}
+		tasks := strings.Join(f.TaskIDs, ", ")
+		if tasks == "" {
+			tasks = "-"
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", f.Path, counts, tasks)
+	}
+	w.Flush()
+}
+
+// isTerminal reports whether f is attached to a terminal.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// page shows text through $PAGER, falling back to less -R.
+func page(text string) error {
+	pager := os.Getenv("PAGER")
+	if pager == "" {
+		pager = "less -R"
+	}
+
+	parts := strings.Fields(pager)
+	pagerCmd := exec.Command(parts[0], parts[1:]...)
+	pagerCmd.Stdin = strings.NewReader(text)
+	pagerCmd.Stdout = os.Stdout
+	pagerCmd.Stderr = os.Stderr
+	if err := pagerCmd.Start(); err != nil {
+		// A missing pager shouldn't hide the diff
+		fmt.Print(text)
+		return nil
+	}
+	return pagerCmd.Wait()
+}
+
+func init() {
+	shipmentDiffCmd.Flags().Bool("stat", false, "Show only the per-file summary")
+	shipmentDiffCmd.Flags().Bool("no-pager", false, "Print the patch directly instead of through $PAGER")
+	shipmentCmd.AddCommand(shipmentDiffCmd)
+}
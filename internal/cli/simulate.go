@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/db"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// unplannableCommands act on tmux, git, processes, or the ledger file
+// directly rather than through the services --plan intercepts, so they
+// refuse to simulate. A command is refused if it or any parent is listed.
+var unplannableCommands = map[string]bool{
+	"attach":     true,
+	"checkout":   true, // Switches branches with git directly
+	"completion": true,
+	"connect":    true,
+	"db":         true, // Has its own --dry-run
+	"dev":        true,
+	"focus":      true, // Checks out branches and sets tmux options directly
+	"hello":      true,
+	"hook":       true,
+	"init":       true,
+	"palette":    true,
+	"scaffold":   true,
+	"serve":      true,
+	"test":       true, // Has its own --dry-run
+	"tmux":       true,
+	"upgrade":    true,
+}
+
+// StartPlan switches this invocation to simulation when --plan is given:
+// the command runs against a throwaway copy of the ledger, and git, tmux,
+// and file changes are recorded instead of made. Call it before anything
+// opens the ledger.
+func StartPlan(cmd *cobra.Command) error {
+	plan, _ := cmd.Flags().GetBool("plan")
+	if !plan {
+		return nil
+	}
+	for c := cmd; c != nil; c = c.Parent() {
+		if unplannableCommands[c.Name()] {
+			return fmt.Errorf("'%s' does not support --plan", cmd.CommandPath())
+		}
+	}
+	db.EnableSimulation()
+	wire.EnablePlanMode()
+	return nil
+}
+
+// FinishPlan prints what a simulated command would have changed and
+// discards the sandbox. A failed command prints nothing: its error says why.
+func FinishPlan(cmd *cobra.Command, cmdErr error) {
+	if !db.Simulating() {
+		return
+	}
+	defer func() { _ = db.DiscardSimulation() }()
+	if cmd == nil || cmdErr != nil {
+		return
+	}
+
+	changes, err := db.SimulatedChanges(NewContext())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to compute plan: %v\n", err)
+		return
+	}
+	printPlan(os.Stdout, cmd.CommandPath(), changes, wire.PlanIntents())
+}
+
+// printPlan lists the records a simulated command created, updated, and
+// deleted, then what it would have done outside the ledger.
+func printPlan(out io.Writer, command string, changes []db.RecordChange, intents []primary.Intent) {
+	fmt.Fprintf(out, "\nPlan: %s (nothing was written)\n", command)
+	if len(changes) == 0 && len(intents) == 0 {
+		fmt.Fprintln(out, "No changes.")
+		return
+	}
+
+	fmt.Fprintln(out, "\nActions:")
+	n := 0
+	for _, c := range changes {
+		n++
+		fmt.Fprintf(out, "  %d. [%s] %s\n", n, c.Op, describeRecordChange(c))
+	}
+	for _, intent := range intents {
+		n++
+		fmt.Fprintf(out, "  %d. [%s] %s\n", n, intent.Kind, intent.Action)
+	}
+}
+
+// describeRecordChange renders a row change: the table and key, then the
+// row's title for creates and deletes, or the changed columns for updates.
+func describeRecordChange(c db.RecordChange) string {
+	desc := c.Table + " " + c.Key
+	if c.Op != "update" {
+		if c.Label != "" {
+			desc += fmt.Sprintf(" %q", truncate(c.Label, 50))
+		}
+		return desc
+	}
+	fields := make([]string, len(c.Fields))
+	for i, f := range c.Fields {
+		fields[i] = fmt.Sprintf("%s %s → %s", f.Column, orDash(truncate(f.Before, 30)), orDash(truncate(f.After, 30)))
+	}
+	return desc + ": " + strings.Join(fields, ", ")
+}
+
+// writeFile writes a file the command produces itself. Under --plan it
+// records the write instead, so the plan lists it alongside service effects.
+func writeFile(path string, data []byte) error {
+	if wire.RecordPlanIntent("file", "write %s (%d bytes)", path, len(data)) {
+		return nil
+	}
+	return os.WriteFile(path, data, 0644)
+}
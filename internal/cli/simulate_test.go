@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/example/orc/internal/db"
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func TestPrintPlan(t *testing.T) {
+	var out bytes.Buffer
+	printPlan(&out, "orc task complete", []db.RecordChange{
+		{Op: "create", Table: "workshop_logs", Key: "WL-0042"},
+		{Op: "update", Table: "tasks", Key: "TASK-012", Fields: []db.FieldChange{
+			{Column: "status", Before: "in_progress", After: "complete"},
+			{Column: "completed_at", After: "2026-10-16T14:00:00Z"},
+		}},
+		{Op: "delete", Table: "entity_locks", Key: "SHIP-003", Label: "restructuring"},
+	}, []primary.Intent{{Kind: "tmux", Action: "kill window orc:imps"}})
+
+	want := `
+Plan: orc task complete (nothing was written)
+
+Actions:
+  1. [create] workshop_logs WL-0042
+  2. [update] tasks TASK-012: status in_progress → complete, completed_at - → 2026-10-16T14:00:00Z
+  3. [delete] entity_locks SHIP-003 "restructuring"
+  4. [tmux] kill window orc:imps
+`
+	if out.String() != want {
+		t.Errorf("printPlan =\n%s\nwant\n%s", out.String(), want)
+	}
+
+	out.Reset()
+	printPlan(&out, "orc summary", nil, nil)
+	if want := "\nPlan: orc summary (nothing was written)\nNo changes.\n"; out.String() != want {
+		t.Errorf("printPlan with no changes = %q, want %q", out.String(), want)
+	}
+}
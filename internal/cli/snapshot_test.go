@@ -0,0 +1,336 @@
+package cli
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/fatih/color"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+// Snapshot harness: display-heavy output is compared against golden files
+// in testdata/snapshots. After an intended formatting change, regenerate
+// them with:
+//
+//	go test ./internal/cli -run Snapshot -update
+var update = flag.Bool("update", false, "rewrite snapshot golden files")
+
+var ansiPattern = regexp.MustCompile("\x1b\\[[0-9;]*m")
+
+// captureStdout runs fn with os.Stdout redirected and returns what it
+// printed. Colors are forced on so colored code paths run, then stripped.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+
+	noColor := color.NoColor
+	color.NoColor = false
+	defer func() { color.NoColor = noColor }()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	done := make(chan []byte)
+	go func() {
+		out, _ := io.ReadAll(r)
+		done <- out
+	}()
+
+	fn()
+	w.Close()
+	return ansiPattern.ReplaceAllString(string(<-done), "")
+}
+
+// assertSnapshot compares got with testdata/snapshots/<name>.golden, or
+// rewrites the file when -update is set.
+func assertSnapshot(t *testing.T, name, got string) {
+	t.Helper()
+
+	path := filepath.Join("testdata", "snapshots", name+".golden")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create snapshot dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatalf("failed to write snapshot: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("missing snapshot %s (run with -update to create it): %v", path, err)
+	}
+	if !bytes.Equal(want, []byte(got)) {
+		t.Errorf("output differs from %s (run with -update if intended)\n--- want\n%s\n--- got\n%s", path, want, got)
+	}
+}
+
+func snapshotCommissionSummary() *primary.CommissionSummary {
+	return &primary.CommissionSummary{
+		ID:                  "COMM-001",
+		Title:               "Payments",
+		IsFocusedCommission: true,
+		Shipments: []primary.ShipmentSummary{
+			{ID: "SHIP-002", Title: "Refunds", Status: "ready", TasksTotal: 3, NoteCount: 1},
+			{
+				ID: "SHIP-001", Alias: "checkout", Title: "Checkout v2", Status: "in-progress", IsFocused: true,
+				Pinned: true, BenchID: "BENCH-001", BenchName: "pay-main", TasksDone: 2, TasksTotal: 3, CommentCount: 2,
+				Notes: []primary.NoteSummary{{ID: "NOTE-004", Title: "Card networks differ on rounding", Type: "learning"}},
+				Tasks: []primary.TaskSummary{
+					{ID: "TASK-001", Title: "Card form", Status: "closed"},
+					{ID: "TASK-002", Alias: "3ds", Title: "3-D Secure challenge", Status: "in-progress", ChecklistDone: 1, ChecklistTotal: 4,
+						Plans: []primary.PlanSummary{{ID: "PLAN-001", Status: "approved"}, {ID: "PLAN-002", Status: "draft"}}},
+					{ID: "TASK-003", Title: "Receipt email", Status: "open", CommentCount: 1},
+				},
+			},
+			{ID: "SHIP-003", Title: "Payouts", Status: "draft"},
+		},
+		Tomes: []primary.TomeSummary{
+			{ID: "TOME-001", Title: "Runbooks", NoteCount: 2, IsFocused: true, Notes: []primary.NoteSummary{
+				{ID: "NOTE-001", Title: "Rotating API keys", Type: "spec"},
+				{ID: "NOTE-002", Title: "Reconciling a failed payout"},
+			}},
+			{ID: "TOME-002", Title: "Archive", NoteCount: 7, Pinned: true},
+		},
+		Notes: []primary.NoteSummary{
+			{ID: "NOTE-010", Title: "Which PSP for EU?", Type: "question", Pinned: true},
+			{ID: "NOTE-011", Title: "Quarterly roadmap"},
+		},
+	}
+}
+
+func TestSnapshot_Summary(t *testing.T) {
+	pinTimeDisplay(t)
+	focus := workshopFocusInfo{
+		containerToWorkbench: map[string][]string{
+			"SHIP-002": {"pay-refunds@BENCH-002"},
+			"SHIP-001": {"pay-review@BENCH-003"},
+		},
+		presence: map[string][]*primary.EntityPresence{
+			"PLAN-002": {{EntityID: "PLAN-002", Actor: "BENCH-003", Action: "view", SeenAt: "2026-10-05T08:58:00Z"}},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		showProgress bool
+	}{
+		{"summary", true},
+		{"summary_no_progress", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := captureStdout(t, func() {
+				renderSummary(snapshotCommissionSummary(), "", focus, tt.showProgress)
+			})
+			assertSnapshot(t, tt.name, got)
+		})
+	}
+}
+
+func TestSnapshot_SummaryCollapsed(t *testing.T) {
+	got := captureStdout(t, func() {
+		renderCollapsedCommission(snapshotCommissionSummary(), true)
+		renderCollapsedCommission(&primary.CommissionSummary{ID: "COMM-002", Title: "Empty"}, true)
+	})
+	assertSnapshot(t, "summary_collapsed", got)
+}
+
+func TestSnapshot_SummaryUrgentBugs(t *testing.T) {
+	bugs := []*primary.Note{
+		{ID: "NOTE-014", CommissionID: "COMM-001", Title: "Refunds double-charge cards in EUR", Severity: "P0", ShipmentID: "SHIP-002"},
+		{ID: "NOTE-009", CommissionID: "COMM-003", Title: "Login loops after password reset", Severity: "P1"},
+		{ID: "NOTE-021", CommissionID: "COMM-004", Title: "Archived commission bug", Severity: "P0"},
+	}
+	commissions := []*primary.Commission{
+		{ID: "COMM-001", Status: "active"},
+		{ID: "COMM-003", Status: "active"},
+		{ID: "COMM-004", Status: "archived"},
+	}
+
+	got := captureStdout(t, func() {
+		renderUrgentBugs(liveCommissionBugs(bugs, commissions))
+		renderUrgentBugs(nil)
+	})
+	assertSnapshot(t, "summary_urgent_bugs", got)
+}
+
+func TestSnapshot_ShipmentShow(t *testing.T) {
+	pinTimeDisplay(t)
+	shipment := &primary.Shipment{
+		ID:                  "SHIP-001",
+		CommissionID:        "COMM-001",
+		Title:               "Checkout v2",
+		Description:         "Rebuild checkout on the new PSP",
+		Status:              "in-progress",
+		AssignedWorkbenchID: "BENCH-001",
+		RepoID:              "REPO-001",
+		Branch:              "ml/SHIP-001-checkout-v2",
+		Pinned:              true,
+		CreatedAt:           "2026-10-01T09:00:00Z",
+		Charter:             "Goal: one-page checkout\nOut of scope: wallets",
+	}
+	tasks := []*primary.Task{
+		{ID: "TASK-001", Title: "Card form", Status: "closed"},
+		{ID: "TASK-002", Title: "3-D Secure challenge", Status: "in-progress"},
+		{ID: "TASK-004", Title: "Fraud rules", Status: "blocked"},
+	}
+
+	rel := snapshotRelations()
+	rel.pr = &primary.PR{ID: "PR-003", ShipmentID: "SHIP-001"}
+
+	got := captureStdout(t, func() { shipmentDetail(shipment, tasks, rel).render(os.Stdout) })
+	assertSnapshot(t, "shipment_show", got)
+}
+
+// snapshotRelations describes related entities from a fixed table, the way
+// the show commands do from the ledger.
+func snapshotRelations() detailRelations {
+	known := map[string]string{
+		"COMM-001":  "COMM-001 Payments [active]",
+		"SHIP-001":  "SHIP-001 Checkout v2 [in-progress]",
+		"TOME-002":  "TOME-002 PSP research [open]",
+		"BENCH-001": "BENCH-001 checkout-bench",
+		"REPO-001":  "REPO-001 storefront",
+		"PLAN-004":  "PLAN-004 3-D Secure rollout [approved]",
+		"PR-003":    "PR-003 #41 Checkout v2 [open]",
+		"TASK-001":  "TASK-001 Card form [closed]",
+		"TASK-002":  "TASK-002 3-D Secure challenge [in-progress]",
+	}
+	return detailRelations{
+		describe: func(id string) string {
+			if line, ok := known[id]; ok {
+				return line
+			}
+			return id
+		},
+		comments: []*primary.Comment{
+			{ID: "CMT-001", Author: "BENCH-001", Body: "waiting on PSP sandbox keys", CreatedAt: "2026-10-02T10:00:00Z"},
+			{ID: "CMT-002", Author: "GOBLIN", Body: "keys are in 1Password", CreatedAt: "2026-10-02T11:00:00Z", Depth: 1},
+		},
+		events: []*primary.LogEntry{
+			{Timestamp: "2026-10-03T09:30:00Z", ActorID: "BENCH-001", Action: "update", FieldName: "status", OldValue: "open", NewValue: "in-progress"},
+			{Timestamp: "2026-10-01T09:00:00Z", ActorID: "GOBLIN", Action: "create"},
+		},
+	}
+}
+
+func TestSnapshot_TaskShow(t *testing.T) {
+	pinTimeDisplay(t)
+	task := &primary.Task{
+		ID:                  "TASK-002",
+		CommissionID:        "COMM-001",
+		ShipmentID:          "SHIP-001",
+		Title:               "3-D Secure challenge",
+		Status:              "in-progress",
+		Type:                "implementation",
+		Points:              3,
+		AssignedWorkbenchID: "BENCH-001",
+		DependsOn:           []string{"TASK-001"},
+		CreatedAt:           "2026-10-01T09:00:00Z",
+		ClaimedAt:           "2026-10-03T09:30:00Z",
+		Checklist: []*primary.ChecklistItem{
+			{Position: 1, Text: "frictionless flow", Done: true},
+			{Position: 2, Text: "challenge iframe"},
+		},
+	}
+	rel := snapshotRelations()
+	rel.tag = "payments"
+	rel.plans = []*primary.Plan{{ID: "PLAN-004"}}
+	rel.pr = &primary.PR{ID: "PR-003"}
+	rel.commits = []*primary.CommitLink{{CommitSHA: "9f2c4e1a7b", Subject: "TASK-002: frictionless flow"}}
+
+	got := captureStdout(t, func() { taskDetail(task, rel).render(os.Stdout) })
+	assertSnapshot(t, "task_show", got)
+}
+
+func TestSnapshot_NoteShow(t *testing.T) {
+	pinTimeDisplay(t)
+	note := &primary.Note{
+		ID:           "NOTE-014",
+		CommissionID: "COMM-001",
+		ShipmentID:   "SHIP-001",
+		Title:        "Refunds double-charge cards in EUR",
+		Type:         primary.NoteTypeBug,
+		Severity:     "P0",
+		CreatedAt:    "2026-10-04T08:00:00Z",
+		UpdatedAt:    "2026-10-04T08:00:00Z",
+	}
+	rel := snapshotRelations()
+	rel.comments = nil
+
+	got := captureStdout(t, func() { noteDetail(note, rel).render(os.Stdout) })
+	assertSnapshot(t, "note_show", got)
+}
+
+func TestSnapshot_PlanShow(t *testing.T) {
+	pinTimeDisplay(t)
+	plan := &primary.Plan{
+		ID:           "PLAN-004",
+		CommissionID: "COMM-001",
+		TaskID:       "TASK-002",
+		Title:        "3-D Secure rollout",
+		Status:       "approved",
+		Content:      "1. Frictionless flow\n2. Challenge iframe",
+		CreatedAt:    "2026-10-01T09:00:00Z",
+		ApprovedAt:   "2026-10-01T12:00:00Z",
+	}
+	progress := &primary.PlanProgress{
+		PlanID:    "PLAN-004",
+		Completed: 1,
+		Steps: []*primary.PlanStep{
+			{Position: 1, Title: "Frictionless flow", TaskID: "TASK-005", TaskStatus: "closed", Complete: true},
+			{Position: 2, Title: "Challenge iframe"},
+		},
+	}
+	rel := snapshotRelations()
+	rel.comments, rel.events = nil, nil
+
+	got := captureStdout(t, func() {
+		planDetail(plan, progress, nil, rel).render(os.Stdout)
+		fmt.Println("---")
+		draftRel := rel
+		draftRel.presence = []*primary.EntityPresence{{EntityID: "PLAN-005", Actor: "BENCH-003", Action: "edit", SeenAt: "2026-10-05T08:58:00Z"}}
+		planDetail(&primary.Plan{ID: "PLAN-005", Title: "Draft", Status: "draft"}, nil, nil, draftRel).render(os.Stdout)
+		fmt.Println("---")
+		planDetail(&primary.Plan{ID: "PLAN-006", Title: "Refund flow", Status: "conditionally_approved"}, nil, []*primary.PlanCondition{
+			{Position: 1, Description: "Add a rollback step", Addressed: true, Evidence: "see ## Rollback"},
+			{Position: 2, Description: "Cover partial refunds"},
+		}, rel).render(os.Stdout)
+	})
+	assertSnapshot(t, "plan_show", got)
+}
+
+func TestSnapshot_Status(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{"status_no_context", printNoContextStatus},
+		{"status_imp", func() {
+			printStatusRole("IMP", "BENCH-001")
+			printStatusFocus("SHIP-001", "Shipment", "Checkout v2", "in-progress")
+		}},
+		{"status_goblin_missing_focus", func() {
+			printStatusRole("GOBLIN", "WORK-001")
+			printStatusFocus("SHIP-404", "", "", "")
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertSnapshot(t, tt.name, captureStdout(t, tt.fn))
+		})
+	}
+}
@@ -32,12 +32,7 @@ This provides a focused view of "where am I right now?"`,
 			cfg, cfgErr := MigrateGoblinConfigIfNeeded(cmd.Context(), cwd)
 			if cfgErr != nil {
 				// No config - show minimal status
-				fmt.Println("❓ ORC Status - No Context")
-				fmt.Println()
-				fmt.Println("No .orc/config.json found in current directory.")
-				fmt.Println("This is a Goblin context (no workbench configured).")
-				fmt.Println()
-				fmt.Println("Run `orc commission list` to see available commissions.")
+				printNoContextStatus()
 				return nil //nolint:nilerr // Missing config is intentionally not an error
 			}
 
@@ -48,27 +43,13 @@ This provides a focused view of "where am I right now?"`,
 			}
 
 			// Show status based on role
-			if role == config.RoleGoblin {
-				fmt.Println("👺 ORC Status - Goblin Context")
-			} else if role == config.RoleIMP {
-				fmt.Println("👹 ORC Status - IMP Context")
-				if config.IsWorkbench(cfg.PlaceID) {
-					fmt.Printf("  🔧 Workbench: %s\n", cfg.PlaceID)
-				}
-			}
-			fmt.Println()
+			printStatusRole(role, cfg.PlaceID)
 
 			// Display current focus if set (read from DB for IMP context)
 			focusID := GetCurrentFocus(cfg)
 			if focusID != "" {
 				containerType, title, status := GetFocusInfo(focusID)
-				if containerType != "" {
-					fmt.Printf("🎯 Focus: %s - %s [%s]\n", focusID, title, status)
-					fmt.Printf("   (%s)\n", containerType)
-				} else {
-					fmt.Printf("🎯 Focus: %s (container not found)\n", focusID)
-				}
-				fmt.Println()
+				printStatusFocus(focusID, containerType, title, status)
 			}
 
 			// If IMP, show workbench-specific info
@@ -90,3 +71,38 @@ This provides a focused view of "where am I right now?"`,
 
 	return cmd
 }
+
+// printNoContextStatus prints the status of a directory with no orc config.
+func printNoContextStatus() {
+	fmt.Println("❓ ORC Status - No Context")
+	fmt.Println()
+	fmt.Println("No .orc/config.json found in current directory.")
+	fmt.Println("This is a Goblin context (no workbench configured).")
+	fmt.Println()
+	fmt.Println("Run `orc commission list` to see available commissions.")
+}
+
+// printStatusRole prints the status header for a role and place.
+func printStatusRole(role, placeID string) {
+	if role == config.RoleGoblin {
+		fmt.Println("👺 ORC Status - Goblin Context")
+	} else if role == config.RoleIMP {
+		fmt.Println("👹 ORC Status - IMP Context")
+		if config.IsWorkbench(placeID) {
+			fmt.Printf("  🔧 Workbench: %s\n", placeID)
+		}
+	}
+	fmt.Println()
+}
+
+// printStatusFocus prints the focused container; containerType is empty if
+// the container was not found.
+func printStatusFocus(focusID, containerType, title, status string) {
+	if containerType != "" {
+		fmt.Printf("🎯 Focus: %s - %s [%s]\n", focusID, title, status)
+		fmt.Printf("   (%s)\n", containerType)
+	} else {
+		fmt.Printf("🎯 Focus: %s (container not found)\n", focusID)
+	}
+	fmt.Println()
+}
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/config"
+	"github.com/example/orc/internal/db"
+)
+
+// lightweightAnnotation marks commands that skip startup (actor detection,
+// tmux bindings, schema checks, telemetry) and never initialize wire.
+const lightweightAnnotation = "orc.lightweight"
+
+// Lightweight reports whether cmd runs on the lightweight path.
+func Lightweight(cmd *cobra.Command) bool {
+	return cmd != nil && cmd.Annotations[lightweightAnnotation] == "true"
+}
+
+func tmuxStatusLineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "statusline [dir]",
+		Short: "Print a compact status segment for the tmux status bar",
+		Long: `Print a workbench's status for embedding in tmux status-right: its focus,
+draft plans awaiting approval on its tasks, and its stuck tasks (blocked, or
+claimed without a heartbeat for the claim TTL; see 'orc task claims').
+
+The workbench is read from dir (default: the current directory). Outside a
+workbench nothing is printed. The ledger is read directly, without the usual
+startup, so the status bar can poll it cheaply.
+
+Example (~/.tmux.conf):
+  set -g status-interval 15
+  set -g status-right '#(orc tmux statusline "#{pane_current_path}")'`,
+		Args:        cobra.MaximumNArgs(1),
+		Annotations: map[string]string{lightweightAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			plain, _ := cmd.Flags().GetBool("plain")
+			dir := ""
+			if len(args) == 1 {
+				dir = args[0]
+			} else {
+				dir, _ = os.Getwd()
+			}
+
+			// A status bar can't show errors; anything missing prints nothing
+			cfg, err := config.LoadConfig(dir)
+			if err != nil || !config.IsWorkbench(cfg.PlaceID) {
+				return nil //nolint:nilerr // outside a workbench there is no segment
+			}
+			ttl, _ := claimTTL(cmd) // A bad ORC_CLAIM_TTL falls back to the default
+			database, err := db.OpenReadOnly()
+			if err != nil || database == nil {
+				return nil //nolint:nilerr // no ledger yet, or it can't be opened
+			}
+			defer database.Close()
+
+			status, err := db.ReadStatusLine(NewContext(), database, cfg.PlaceID, ttl)
+			if err != nil {
+				return nil //nolint:nilerr // a locked or older ledger shows nothing this tick
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), formatStatusLine(status, plain))
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool("plain", false, "Omit tmux color codes")
+	return cmd
+}
+
+// formatStatusLine renders the segment, leaving out counts that are zero.
+func formatStatusLine(s *db.StatusLine, plain bool) string {
+	style := func(color, text string) string {
+		if plain {
+			return text
+		}
+		return "#[fg=" + color + "]" + text + "#[default]"
+	}
+
+	var parts []string
+	if s.FocusID != "" {
+		parts = append(parts, style("cyan", s.FocusID))
+	}
+	if s.PendingApprovals > 0 {
+		parts = append(parts, style("yellow", fmt.Sprintf("%d to approve", s.PendingApprovals)))
+	}
+	if s.StuckTasks > 0 {
+		parts = append(parts, style("red", fmt.Sprintf("%d stuck", s.StuckTasks)))
+	}
+	return strings.Join(parts, " · ")
+}
@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/example/orc/internal/db"
+)
+
+func TestFormatStatusLine(t *testing.T) {
+	tests := []struct {
+		name   string
+		status db.StatusLine
+		plain  bool
+		want   string
+	}{
+		{"nothing", db.StatusLine{}, false, ""},
+		{"focus only", db.StatusLine{FocusID: "SHIP-042"}, true, "SHIP-042"},
+		{"all plain", db.StatusLine{FocusID: "SHIP-042", PendingApprovals: 2, StuckTasks: 1}, true, "SHIP-042 · 2 to approve · 1 stuck"},
+		{"colored", db.StatusLine{StuckTasks: 3}, false, "#[fg=red]3 stuck#[default]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatStatusLine(&tt.status, tt.plain); got != tt.want {
+				t.Errorf("formatStatusLine() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
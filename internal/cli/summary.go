@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
@@ -72,16 +73,26 @@ Display modes:
   Default: Show only focused container's commission (if focus is set)
   --all: Show all commissions and containers
   --commission [id]: Show specific commission (or 'current' for focus/context)
+  --workshop [id]: Group containers by the workbenches working them (or
+                   'current' for this workbench's workshop); idle benches flagged
 
 Structure:
   Commission
   ├── Shipment (implementation work)
   └── Tome (exploration notes)
 
+Views:
+  --filter-containers, --filter-statuses and --tags narrow the containers
+  shown. Save a combination with 'orc summary save-view' and reuse it with
+  --view; 'orc summary default-view' makes one a workbench's default.
+
 Examples:
   orc summary                          # focused container's commission only
   orc summary --all                    # all commissions
-  orc summary --commission COMM-001    # specific commission`,
+  orc summary --commission COMM-001    # specific commission
+  orc summary --workshop WORK-001      # what each workbench is working
+  orc summary --filter-statuses ready,in-progress --tags infra
+  orc summary --view standup           # a saved view`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Get current working directory for config
 			cwd, err := os.Getwd()
@@ -94,6 +105,8 @@ Examples:
 			expandAll, _ := cmd.Flags().GetBool("all")
 			debugMode, _ := cmd.Flags().GetBool("debug")
 			expandAllCommissions, _ := cmd.Flags().GetBool("expand-all-commissions")
+			noProgress, _ := cmd.Flags().GetBool("no-progress")
+			workshopFilter, _ := cmd.Flags().GetString("workshop")
 
 			// Load config for role detection
 			cfg, _ := MigrateGoblinConfigIfNeeded(cmd.Context(), cwd)
@@ -115,6 +128,24 @@ Examples:
 			// Get current focus
 			focusID := GetCurrentFocus(cfg)
 
+			view, err := resolveSummaryView(cmd, workbenchID)
+			if err != nil {
+				return err
+			}
+
+			if workshopFilter != "" {
+				if view.Explicit {
+					return fmt.Errorf("--view and filters apply to the commission summary, not --workshop")
+				}
+				if workshopFilter == "current" {
+					if workshopID == "" {
+						return fmt.Errorf("--workshop current requires being in a workbench")
+					}
+					workshopFilter = workshopID
+				}
+				return runWorkshopSummary(cmd.Context(), workshopFilter, workbenchID, focusID, !noProgress)
+			}
+
 			// Determine which commission to show
 			var filterCommissionID string
 			if commissionFilter == "current" {
@@ -200,27 +231,53 @@ Examples:
 				return openCommissions[i].ID < openCommissions[j].ID
 			})
 
+			// Throttled actors lead: a looping agent needs ORC now
+			if throttles, err := wire.RateLimitService().ListThrottles(cmd.Context()); err == nil {
+				renderThrottleBanner(throttles)
+			}
+
+			// Untriaged P0/P1 bugs lead, whatever focus and filters hide below
+			if bugs, err := wire.NoteService().ListTriageQueue(cmd.Context(), primary.TriageQueueFilters{UrgentOnly: true}); err == nil {
+				renderUrgentBugs(liveCommissionBugs(bugs, commissions))
+			}
+
 			// Render header based on role
 			renderHeader(role, workbenchID, workshopID, focusID, filterCommissionID)
+			if view.Label != "" {
+				fmt.Println(color.New(color.Faint).Sprintf("View: %s", view.Label))
+				fmt.Println()
+			}
 
 			// Build map of focused containers across all workbenches in this workshop
 			workshopFocus := buildWorkshopFocusMap(cmd.Context(), workshopID, workbenchID)
+			workshopFocus.presence = loadPresence(NewContext(), "")
+
+			// Load all commission summaries concurrently, then render in order
+			summaries := make([]*primary.CommissionSummary, len(openCommissions))
+			summaryErrs := make([]error, len(openCommissions))
+			var wg sync.WaitGroup
+			for i, commission := range openCommissions {
+				wg.Add(1)
+				go func(i int, commissionID string) {
+					defer wg.Done()
+					summaries[i], summaryErrs[i] = wire.SummaryService().GetCommissionSummary(context.Background(), primary.SummaryRequest{
+						CommissionID: commissionID,
+						WorkbenchID:  workbenchID,
+						WorkshopID:   workshopID,
+						FocusID:      focusID,
+						DebugMode:    debugMode,
+						Filter:       view.Filter,
+					})
+				}(i, commission.ID)
+			}
+			wg.Wait()
 
 			// Display each commission
 			for i, commission := range openCommissions {
 				isFocusedCommission := commission.ID == focusedCommissionID
 				shouldExpand := isFocusedCommission || expandAllCommissions
 
-				// Build summary request
-				req := primary.SummaryRequest{
-					CommissionID: commission.ID,
-					WorkbenchID:  workbenchID,
-					WorkshopID:   workshopID,
-					FocusID:      focusID,
-					DebugMode:    debugMode,
-				}
-
-				summary, err := wire.SummaryService().GetCommissionSummary(context.Background(), req)
+				summary, err := summaries[i], summaryErrs[i]
 				if err != nil {
 					fmt.Printf("Error getting summary for %s: %v\n", commission.ID, err)
 					continue
@@ -228,7 +285,7 @@ Examples:
 
 				if shouldExpand {
 					// Render full summary for focused or expanded commissions
-					renderSummary(summary, focusID, workshopFocus)
+					renderSummary(summary, focusID, workshopFocus, !noProgress)
 
 					// Render debug info if present
 					if summary.DebugInfo != nil && len(summary.DebugInfo.Messages) > 0 {
@@ -237,8 +294,9 @@ Examples:
 					}
 				} else {
 					// Render collapsed summary for non-focused commissions
-					renderCollapsedCommission(summary)
+					renderCollapsedCommission(summary, !noProgress)
 				}
+				renderBudgetWarning(cmd.Context(), commission.ID)
 
 				if i < len(openCommissions)-1 {
 					fmt.Println()
@@ -253,10 +311,55 @@ Examples:
 	cmd.Flags().Bool("all", false, "Show all containers (default: only show focused container if set)")
 	cmd.Flags().Bool("debug", false, "Show debug info about hidden/filtered content")
 	cmd.Flags().Bool("expand-all-commissions", false, "Expand all commissions (default: only focused commission expanded)")
+	cmd.Flags().Bool("no-progress", false, "Hide container progress bars")
+	cmd.Flags().StringP("workshop", "w", "", "Workshop view: workshop ID or 'current'; groups containers by workbench")
+	cmd.Flags().String("view", "", "Saved view to apply (see 'orc summary list-views')")
+	addSummaryFilterFlags(cmd)
+
+	cmd.AddCommand(summarySaveViewCmd())
+	cmd.AddCommand(summaryListViewsCmd())
+	cmd.AddCommand(summaryDeleteViewCmd())
+	cmd.AddCommand(summaryDefaultViewCmd())
 
 	return cmd
 }
 
+// liveCommissionBugs drops bugs filed in complete or archived commissions.
+func liveCommissionBugs(bugs []*primary.Note, commissions []*primary.Commission) []*primary.Note {
+	live := make(map[string]bool, len(commissions))
+	for _, c := range commissions {
+		if c.Status != "complete" && c.Status != "archived" {
+			live[c.ID] = true
+		}
+	}
+	var result []*primary.Note
+	for _, bug := range bugs {
+		if live[bug.CommissionID] {
+			result = append(result, bug)
+		}
+	}
+	return result
+}
+
+// renderUrgentBugs prints the untriaged P0/P1 bugs banner, if there are any.
+func renderUrgentBugs(bugs []*primary.Note) {
+	if len(bugs) == 0 {
+		return
+	}
+	alert := color.New(color.FgRed, color.Bold)
+	fmt.Println(alert.Sprintf("🚨 %s awaiting triage — orc note triage", pluralize(len(bugs), "untriaged P0/P1 bug", "untriaged P0/P1 bugs")))
+	for _, bug := range bugs {
+		container := ""
+		if bug.ShipmentID != "" {
+			container = " · " + bug.ShipmentID
+		} else if bug.TomeID != "" {
+			container = " · " + bug.TomeID
+		}
+		fmt.Printf("   %s %s %s (%s%s)\n", colorizeID(bug.ID), alert.Sprint(bug.Severity), truncate(bug.Title, 60), bug.CommissionID, container)
+	}
+	fmt.Println()
+}
+
 // renderHeader prints the header line based on role
 func renderHeader(role, workbenchID, workshopID, _, commissionID string) {
 	// Show workshop context
@@ -304,34 +407,20 @@ func renderWorkshopBenches(workshopID, currentWorkbenchID string) {
 		return
 	}
 
+	statuses := loadBenchGitStatuses(workbenches)
+
 	fmt.Println("|")
 	itemIdx := 0
 
 	// Render workbenches
-	for _, wb := range workbenches {
+	for i, wb := range workbenches {
 		isLast := itemIdx == totalItems-1
 		prefix := "├── "
 		if isLast {
 			prefix = "└── "
 		}
 
-		// Build workbench line with optional focus indicator
-		line := fmt.Sprintf("👹 %s", wb.Name)
-		if wb.ID == currentWorkbenchID {
-			line = color.New(color.FgHiMagenta).Sprint(line)
-		}
-
-		// Add git branch and dirty status (colored branch name)
-		if wb.Path != "" {
-			branch, dirty, err := getGitBranchStatus(wb.Path)
-			if err != nil {
-				line += color.New(color.FgHiBlack).Sprint(" [?]")
-			} else if dirty {
-				line += color.New(color.FgYellow).Sprintf(" [%s]", branch)
-			} else {
-				line += color.New(color.FgGreen).Sprintf(" [%s]", branch)
-			}
-		}
+		line := formatBenchLine(wb.Name, wb, statuses[i], currentWorkbenchID)
 
 		// Add focused shipment inline if workbench has one (skip stale focus)
 		focusedID, _ := wire.WorkbenchService().GetFocusedID(ctx, wb.ID)
@@ -344,6 +433,54 @@ func renderWorkshopBenches(workshopID, currentWorkbenchID string) {
 	}
 }
 
+// benchGitStatus is a workbench's checked-out branch and whether it has
+// uncommitted changes.
+type benchGitStatus struct {
+	branch string
+	dirty  bool
+	err    error
+}
+
+// loadBenchGitStatuses reads each workbench's git status. Git shells out per
+// workbench, so the reads run concurrently.
+func loadBenchGitStatuses(workbenches []*primary.Workbench) []benchGitStatus {
+	statuses := make([]benchGitStatus, len(workbenches))
+	var wg sync.WaitGroup
+	for i, wb := range workbenches {
+		if wb.Path == "" {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, path string) {
+			defer wg.Done()
+			st := &statuses[i]
+			st.branch, st.dirty, st.err = getGitBranchStatus(path)
+		}(i, wb.Path)
+	}
+	wg.Wait()
+	return statuses
+}
+
+// formatBenchLine renders a workbench's label, highlighted if it is the
+// current one, and its branch colored by dirty status.
+func formatBenchLine(label string, wb *primary.Workbench, st benchGitStatus, currentWorkbenchID string) string {
+	line := fmt.Sprintf("👹 %s", label)
+	if wb.ID == currentWorkbenchID {
+		line = color.New(color.FgHiMagenta).Sprint(line)
+	}
+
+	if wb.Path != "" {
+		if st.err != nil {
+			line += color.New(color.FgHiBlack).Sprint(" [?]")
+		} else if st.dirty {
+			line += color.New(color.FgYellow).Sprintf(" [%s]", st.branch)
+		} else {
+			line += color.New(color.FgGreen).Sprintf(" [%s]", st.branch)
+		}
+	}
+	return line
+}
+
 // isStaleFocus returns true if the focus points to a completed/deployed shipment
 func isStaleFocus(ctx context.Context, focusedID string) bool {
 	if !strings.HasPrefix(focusedID, "SHIP-") {
@@ -391,6 +528,8 @@ type workshopFocusInfo struct {
 	containerToWorkbench map[string][]string // containerID -> list of actors focusing it
 	myName               string              // current workbench name
 	myID                 string              // current workbench ID
+
+	presence map[string][]*primary.EntityPresence // entityID -> other actors recently on it
 }
 
 // buildWorkshopFocusMap fetches focus for all workbenches in the workshop
@@ -465,7 +604,7 @@ func formatFocusActors(actors []string, isMeFocused bool) string {
 }
 
 // renderCollapsedCommission renders a commission as a single collapsed line with counts
-func renderCollapsedCommission(summary *primary.CommissionSummary) {
+func renderCollapsedCommission(summary *primary.CommissionSummary, showProgress bool) {
 	// Count items
 	shipmentCount := len(summary.Shipments)
 	noteCount := len(summary.Notes)
@@ -488,11 +627,32 @@ func renderCollapsedCommission(summary *primary.CommissionSummary) {
 		countsStr = fmt.Sprintf(" (%s)", strings.Join(counts, ", "))
 	}
 
-	fmt.Printf("%s - %s%s\n", colorizeID(summary.ID), summary.Title, countsStr)
+	progress := ""
+	if showProgress {
+		done, total := 0, 0
+		for _, ship := range summary.Shipments {
+			done += ship.TasksDone
+			total += ship.TasksTotal
+		}
+		progress = progressBar(done, total)
+	}
+
+	// Open issues stay visible even when the commission is collapsed
+	concerns, findings := 0, 0
+	for _, ship := range summary.Shipments {
+		concerns += ship.OpenConcerns
+		findings += ship.OpenFindings
+	}
+	for _, tome := range summary.Tomes {
+		concerns += tome.OpenConcerns
+		findings += tome.OpenFindings
+	}
+
+	fmt.Printf("%s%s - %s%s%s\n", colorizeID(summary.ID), progress, summary.Title, countsStr, formatOpenIssues(concerns, findings))
 }
 
 // renderSummary renders the commission with notes, shipments, and tomes in tree format
-func renderSummary(summary *primary.CommissionSummary, _ string, workshopFocus workshopFocusInfo) {
+func renderSummary(summary *primary.CommissionSummary, _ string, workshopFocus workshopFocusInfo, showProgress bool) {
 	// Commission header with focused marker
 	focusedMarker := ""
 	if summary.IsFocusedCommission {
@@ -530,7 +690,7 @@ func renderSummary(summary *primary.CommissionSummary, _ string, workshopFocus w
 
 	// 1. Render focused shipments
 	for _, ship := range focusedShips {
-		renderShipment(ship, workshopFocus, &itemIdx, totalItems)
+		renderShipment(ship, workshopFocus, &itemIdx, totalItems, showProgress)
 	}
 
 	// Visual gap between focused and non-focused shipments
@@ -540,48 +700,12 @@ func renderSummary(summary *primary.CommissionSummary, _ string, workshopFocus w
 
 	// 2. Render non-focused shipments
 	for _, ship := range otherShips {
-		renderShipment(ship, workshopFocus, &itemIdx, totalItems)
+		renderShipment(ship, workshopFocus, &itemIdx, totalItems, showProgress)
 	}
 
 	// 3. Render tomes
 	for _, tome := range summary.Tomes {
-		isLast := itemIdx == totalItems-1 && len(summary.Notes) == 0
-		tomePrefix := "├── "
-		tomeChildPrefix := "│   "
-		if isLast {
-			tomePrefix = "└── "
-			tomeChildPrefix = "    "
-		}
-
-		noteInfo := ""
-		if tome.NoteCount > 0 && len(tome.Notes) == 0 {
-			noteInfo = fmt.Sprintf(" (%s)", pluralize(tome.NoteCount, "note", "notes"))
-		}
-		pinnedMark := ""
-		if tome.Pinned {
-			pinnedMark = " *"
-		}
-		focusMark := formatFocusActors(workshopFocus.containerToWorkbench[tome.ID], tome.IsFocused)
-
-		fmt.Printf("%s%s%s%s - %s%s\n", tomePrefix, colorizeID(tome.ID), focusMark, pinnedMark, tome.Title, noteInfo)
-
-		// Expand notes for focused tome
-		if len(tome.Notes) > 0 {
-			for j, note := range tome.Notes {
-				isLastNote := j == len(tome.Notes)-1
-				notePrefix := tomeChildPrefix + "├── "
-				if isLastNote {
-					notePrefix = tomeChildPrefix + "└── "
-				}
-				typeMarker := ""
-				if note.Type != "" {
-					typeMarker = color.New(color.FgYellow).Sprintf("[%s] ", note.Type)
-				}
-				fmt.Printf("%s%s %s- %s\n", notePrefix, colorizeID(note.ID), typeMarker, truncate(note.Title, 60))
-			}
-		}
-
-		itemIdx++
+		renderTome(tome, workshopFocus, &itemIdx, totalItems, len(summary.Notes) == 0)
 	}
 
 	// Visual gap before commission-level notes
@@ -610,7 +734,7 @@ func renderSummary(summary *primary.CommissionSummary, _ string, workshopFocus w
 }
 
 // renderShipment renders a single shipment with its children if focused
-func renderShipment(ship primary.ShipmentSummary, workshopFocus workshopFocusInfo, itemIdx *int, totalItems int) {
+func renderShipment(ship primary.ShipmentSummary, workshopFocus workshopFocusInfo, itemIdx *int, totalItems int, showProgress bool) {
 	isLast := *itemIdx == totalItems-1
 	prefix := "├── "
 	taskPrefix := "│   "
@@ -631,22 +755,33 @@ func renderShipment(ship primary.ShipmentSummary, workshopFocus workshopFocusInf
 	if ship.Status != "" && ship.Status != "closed" {
 		statusBadge = " " + colorizeShipmentStatus(ship.Status)
 	}
-	taskInfo := fmt.Sprintf(" (%d/%d done", ship.TasksDone, ship.TasksTotal)
+	// With progress bars the task counts move into the bar next to the ID
+	progress := ""
+	var counts []string
+	if showProgress {
+		progress = progressBar(ship.TasksDone, ship.TasksTotal)
+	} else {
+		counts = append(counts, fmt.Sprintf("%d/%d done", ship.TasksDone, ship.TasksTotal))
+	}
 	if ship.NoteCount > 0 {
-		taskInfo += fmt.Sprintf(", %s", pluralize(ship.NoteCount, "note", "notes"))
+		counts = append(counts, pluralize(ship.NoteCount, "note", "notes"))
+	}
+	taskInfo := ""
+	if len(counts) > 0 {
+		taskInfo = fmt.Sprintf(" (%s)", strings.Join(counts, ", "))
 	}
-	taskInfo += ")"
 	pinnedMark := ""
 	if ship.Pinned {
 		pinnedMark = " *"
 	}
 	focusMark := formatFocusActors(workshopFocus.containerToWorkbench[ship.ID], ship.IsFocused)
 
-	fmt.Printf("%s%s%s%s%s%s - %s%s\n", prefix, colorizeID(ship.ID), statusBadge, benchMarker, focusMark, pinnedMark, ship.Title, taskInfo)
+	fmt.Printf("%s%s%s%s%s%s%s - %s%s%s%s%s\n", prefix, colorizeID(ship.ID)+formatAlias(ship.Alias), progress, statusBadge, benchMarker, focusMark, pinnedMark, ship.Title, taskInfo, formatOpenIssues(ship.OpenConcerns, ship.OpenFindings), formatCommentCount(ship.CommentCount), presenceMarker(workshopFocus.presence[ship.ID]))
 
-	// Expand children for focused shipment (notes first, then tasks)
+	// Expand children for focused shipment (notes first, then tasks, then
+	// links to other ledgers)
 	if ship.IsFocused {
-		totalChildren := len(ship.Notes) + len(ship.Tasks)
+		totalChildren := len(ship.Notes) + len(ship.Tasks) + len(ship.LinkedRefs)
 		childIdx := 0
 
 		// Render notes first (context)
@@ -677,11 +812,63 @@ func renderShipment(ship primary.ShipmentSummary, workshopFocus workshopFocusInf
 			if task.Status != "" && task.Status != "open" {
 				statusMark = colorizeStatus(task.Status) + " - "
 			}
-			fmt.Printf("%s%s - %s%s\n", tPrefix, colorizeID(task.ID), statusMark, task.Title)
+			fmt.Printf("%s%s%s - %s%s%s%s\n", tPrefix, colorizeID(task.ID)+formatAlias(task.Alias), checklistProgress(task), statusMark, task.Title, formatCommentCount(task.CommentCount), presenceMarker(workshopFocus.presence[task.ID]))
 			// Render task children (plans)
-			renderTaskChildren(task, taskChildPrefix)
+			renderTaskChildren(task, taskChildPrefix, workshopFocus.presence)
 			childIdx++
 		}
+
+		// Render links last (related work elsewhere)
+		for _, ref := range ship.LinkedRefs {
+			rPrefix := taskPrefix + "├── "
+			if childIdx == totalChildren-1 {
+				rPrefix = taskPrefix + "└── "
+			}
+			fmt.Printf("%s%s\n", rPrefix, color.New(color.Faint).Sprint("↔ "+describeLedgerRef(ref)))
+			childIdx++
+		}
+	}
+
+	*itemIdx++
+}
+
+// renderTome renders a single tome, expanding its notes if focused. last
+// says whether nothing follows the tome's group in the tree.
+func renderTome(tome primary.TomeSummary, workshopFocus workshopFocusInfo, itemIdx *int, totalItems int, last bool) {
+	isLast := *itemIdx == totalItems-1 && last
+	tomePrefix := "├── "
+	tomeChildPrefix := "│   "
+	if isLast {
+		tomePrefix = "└── "
+		tomeChildPrefix = "    "
+	}
+
+	noteInfo := ""
+	if tome.NoteCount > 0 && len(tome.Notes) == 0 {
+		noteInfo = fmt.Sprintf(" (%s)", pluralize(tome.NoteCount, "note", "notes"))
+	}
+	pinnedMark := ""
+	if tome.Pinned {
+		pinnedMark = " *"
+	}
+	focusMark := formatFocusActors(workshopFocus.containerToWorkbench[tome.ID], tome.IsFocused)
+
+	fmt.Printf("%s%s%s%s - %s%s%s%s\n", tomePrefix, colorizeID(tome.ID)+formatAlias(tome.Alias), focusMark, pinnedMark, tome.Title, noteInfo, formatOpenIssues(tome.OpenConcerns, tome.OpenFindings), formatCommentCount(tome.CommentCount))
+
+	// Expand notes for focused tome
+	if len(tome.Notes) > 0 {
+		for j, note := range tome.Notes {
+			isLastNote := j == len(tome.Notes)-1
+			notePrefix := tomeChildPrefix + "├── "
+			if isLastNote {
+				notePrefix = tomeChildPrefix + "└── "
+			}
+			typeMarker := ""
+			if note.Type != "" {
+				typeMarker = color.New(color.FgYellow).Sprintf("[%s] ", note.Type)
+			}
+			fmt.Printf("%s%s %s- %s\n", notePrefix, colorizeID(note.ID), typeMarker, truncate(note.Title, 60))
+		}
 	}
 
 	*itemIdx++
@@ -762,13 +949,75 @@ func colorizePlanStatus(status string) string {
 	switch status {
 	case "approved":
 		return color.New(color.FgHiGreen).Sprintf("✓ %s", upper)
+	case "conditionally_approved":
+		return color.New(color.FgYellow).Sprintf("✓ %s", upper)
 	default:
 		return upper // draft
 	}
 }
 
+// formatAlias returns " (slug)" for entities with an alias, or "" otherwise
+func formatAlias(alias string) string {
+	if alias == "" {
+		return ""
+	}
+	return color.New(color.Faint).Sprintf(" (%s)", alias)
+}
+
+// checklistProgress returns " [done/total]" for tasks with a checklist, or "" otherwise
+func checklistProgress(task primary.TaskSummary) string {
+	if task.ChecklistTotal == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" [%d/%d]", task.ChecklistDone, task.ChecklistTotal)
+}
+
+// progressBarWidth is the number of cells in a container progress bar
+const progressBarWidth = 5
+
+// progressBar returns " [▓▓▓░░ done/total tasks]", or "" when there are no tasks
+func progressBar(done, total int) string {
+	if total <= 0 {
+		return ""
+	}
+	if done > total {
+		done = total
+	}
+	filled := (done*progressBarWidth + total/2) / total
+	// Only a fully done container gets a full bar
+	if filled == progressBarWidth && done < total {
+		filled--
+	}
+	bar := strings.Repeat("▓", filled) + strings.Repeat("░", progressBarWidth-filled)
+	return fmt.Sprintf(" [%s %d/%d tasks]", bar, done, total)
+}
+
+// formatCommentCount returns " (n💬)" for entities with comments, or "" otherwise
+func formatCommentCount(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return color.New(color.Faint).Sprintf(" (%d💬)", n)
+}
+
+// formatOpenIssues returns a warning with the open concern and finding
+// counts, or "" when there are none.
+func formatOpenIssues(concerns, findings int) string {
+	var parts []string
+	if concerns > 0 {
+		parts = append(parts, pluralize(concerns, "concern", "concerns"))
+	}
+	if findings > 0 {
+		parts = append(parts, pluralize(findings, "finding", "findings"))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return color.New(color.FgYellow).Sprintf(" ⚠ %s", strings.Join(parts, ", "))
+}
+
 // renderTaskChildren renders the child entities (plans) under a task
-func renderTaskChildren(task primary.TaskSummary, prefix string) {
+func renderTaskChildren(task primary.TaskSummary, prefix string, presence map[string][]*primary.EntityPresence) {
 	totalChildren := len(task.Plans)
 	if totalChildren == 0 {
 		return
@@ -779,7 +1028,7 @@ func renderTaskChildren(task primary.TaskSummary, prefix string) {
 		if i == totalChildren-1 {
 			childPrefix = prefix + "└── "
 		}
-		fmt.Printf("%s%s %s\n", childPrefix, colorizeID(plan.ID), colorizePlanStatus(plan.Status))
+		fmt.Printf("%s%s %s%s\n", childPrefix, colorizeID(plan.ID), colorizePlanStatus(plan.Status), presenceMarker(presence[plan.ID]))
 	}
 }
 
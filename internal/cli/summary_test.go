@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func TestProgressBar(t *testing.T) {
+	tests := []struct {
+		name        string
+		done, total int
+		want        string
+	}{
+		{"no tasks", 0, 0, ""},
+		{"none done", 0, 4, " [░░░░░ 0/4 tasks]"},
+		{"rounds to nearest cell", 12, 20, " [▓▓▓░░ 12/20 tasks]"},
+		{"almost done is not full", 19, 20, " [▓▓▓▓░ 19/20 tasks]"},
+		{"all done", 3, 3, " [▓▓▓▓▓ 3/3 tasks]"},
+		{"done clamped to total", 5, 3, " [▓▓▓▓▓ 3/3 tasks]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := progressBar(tt.done, tt.total); got != tt.want {
+				t.Errorf("progressBar(%d, %d) = %q, want %q", tt.done, tt.total, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatOpenIssues(t *testing.T) {
+	tests := []struct {
+		concerns, findings int
+		want               string
+	}{
+		{0, 0, ""},
+		{1, 0, " ⚠ 1 concern"},
+		{0, 3, " ⚠ 3 findings"},
+		{2, 1, " ⚠ 2 concerns, 1 finding"},
+	}
+	for _, tt := range tests {
+		if got := formatOpenIssues(tt.concerns, tt.findings); got != tt.want {
+			t.Errorf("formatOpenIssues(%d, %d) = %q, want %q", tt.concerns, tt.findings, got, tt.want)
+		}
+	}
+}
+
+func TestDescribeSummaryFilter(t *testing.T) {
+	tests := []struct {
+		filter primary.SummaryFilter
+		want   string
+	}{
+		{primary.SummaryFilter{}, "everything"},
+		{primary.SummaryFilter{Statuses: []string{"ready", "in-progress"}}, "ready,in-progress"},
+		{primary.SummaryFilter{Containers: []string{"SHIP"}, Tags: []string{"infra", "ops"}}, "SHIP · #infra · #ops"},
+	}
+	for _, tt := range tests {
+		if got := describeSummaryFilter(tt.filter); got != tt.want {
+			t.Errorf("describeSummaryFilter(%+v) = %q, want %q", tt.filter, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// summaryView is the filter a summary run applies, and how to label it.
+type summaryView struct {
+	Filter   primary.SummaryFilter
+	Label    string // e.g. "standup (SHIP · ready)"; empty when unfiltered
+	Explicit bool   // Chosen with --view or filter flags, not the workbench default
+}
+
+// addSummaryFilterFlags registers the filter flags shared by summary and save-view.
+func addSummaryFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("filter-containers", nil, "Container kinds to show: SHIP, TOME")
+	cmd.Flags().StringSlice("filter-statuses", nil, "Container statuses to show, e.g. ready,in-progress")
+	cmd.Flags().StringSlice("tags", nil, "Only shipments holding a task with one of these tags")
+}
+
+// resolveSummaryView works out the filter for a summary run: --view names a
+// saved view, filter flags override its fields, and without either the
+// workbench's default view applies.
+func resolveSummaryView(cmd *cobra.Command, workbenchID string) (summaryView, error) {
+	ctx := NewContext()
+	name, _ := cmd.Flags().GetString("view")
+
+	var view summaryView
+	switch {
+	case name != "":
+		saved, err := wire.SummaryViewService().GetView(ctx, name)
+		if err != nil {
+			return view, fmt.Errorf("%w\nHint: orc summary list-views shows your saved views", err)
+		}
+		view = summaryView{Filter: saved.Filter, Label: fmt.Sprintf("%s (%s)", saved.Name, saved.Description), Explicit: true}
+	case !summaryFilterFlagsChanged(cmd) && workbenchID != "":
+		saved, err := wire.SummaryViewService().GetDefaultView(ctx, workbenchID)
+		if err != nil {
+			return view, err
+		}
+		if saved != nil {
+			view = summaryView{Filter: saved.Filter, Label: fmt.Sprintf("%s (%s) · workbench default, --view to change", saved.Name, saved.Description)}
+		}
+	}
+
+	if summaryFilterFlagsChanged(cmd) {
+		if cmd.Flags().Changed("filter-containers") {
+			view.Filter.Containers, _ = cmd.Flags().GetStringSlice("filter-containers")
+		}
+		if cmd.Flags().Changed("filter-statuses") {
+			view.Filter.Statuses, _ = cmd.Flags().GetStringSlice("filter-statuses")
+		}
+		if cmd.Flags().Changed("tags") {
+			view.Filter.Tags, _ = cmd.Flags().GetStringSlice("tags")
+		}
+		view.Label = describeSummaryFilter(view.Filter)
+		if name != "" {
+			view.Label = fmt.Sprintf("%s with overrides (%s)", name, view.Label)
+		}
+		view.Explicit = true
+	}
+	return view, nil
+}
+
+func summaryFilterFlagsChanged(cmd *cobra.Command) bool {
+	return cmd.Flags().Changed("filter-containers") || cmd.Flags().Changed("filter-statuses") || cmd.Flags().Changed("tags")
+}
+
+// summaryFilterFromFlags reads the filter flags as given.
+func summaryFilterFromFlags(cmd *cobra.Command) primary.SummaryFilter {
+	var filter primary.SummaryFilter
+	filter.Containers, _ = cmd.Flags().GetStringSlice("filter-containers")
+	filter.Statuses, _ = cmd.Flags().GetStringSlice("filter-statuses")
+	filter.Tags, _ = cmd.Flags().GetStringSlice("tags")
+	return filter
+}
+
+// describeSummaryFilter summarizes filter flags, e.g. "SHIP · ready · #infra".
+func describeSummaryFilter(f primary.SummaryFilter) string {
+	var parts []string
+	if len(f.Containers) > 0 {
+		parts = append(parts, strings.Join(f.Containers, ","))
+	}
+	if len(f.Statuses) > 0 {
+		parts = append(parts, strings.Join(f.Statuses, ","))
+	}
+	for _, tag := range f.Tags {
+		parts = append(parts, "#"+tag)
+	}
+	if len(parts) == 0 {
+		return "everything"
+	}
+	return strings.Join(parts, " · ")
+}
+
+func summarySaveViewCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "save-view [name]",
+		Short: "Save summary filters as a named view",
+		Long: `Save a combination of summary filters under a name, to reuse with
+'orc summary --view <name>'. Views belong to the actor that saves them;
+saving an existing name replaces its filters.
+
+--filter-containers takes SHIP and TOME. --filter-statuses takes container
+statuses (draft, ready, in-progress, open, closed). --tags shows only
+shipments holding a task with one of the tags, so it hides tomes.
+
+Examples:
+  orc summary save-view standup --filter-containers SHIP --filter-statuses ready,in-progress
+  orc summary save-view infra --tags infra,ops`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			view, err := wire.SummaryViewService().SaveView(NewContext(), primary.SaveSummaryViewRequest{
+				Name:   args[0],
+				Filter: summaryFilterFromFlags(cmd),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to save view: %w", err)
+			}
+
+			fmt.Printf("✓ Saved view %s: %s\n", view.Name, view.Description)
+			fmt.Printf("  Use with: orc summary --view %s\n", view.Name)
+			return nil
+		},
+	}
+	addSummaryFilterFlags(cmd)
+	return cmd
+}
+
+func summaryListViewsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list-views",
+		Short: "List your saved summary views",
+		Long: `List the summary views saved by the current actor. The current
+workbench's default view, if it is one of them, is marked with *.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			views, err := wire.SummaryViewService().ListViews(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list views: %w", err)
+			}
+			if len(views) == 0 {
+				fmt.Println("No saved views. Create one with: orc summary save-view <name> --filter-statuses ready")
+				return nil
+			}
+
+			defaultName := ""
+			if workbenchID := orccontext.GetContextWorkbenchID(); workbenchID != "" {
+				if view, err := wire.SummaryViewService().GetDefaultView(ctx, workbenchID); err == nil && view != nil && view.ActorID == views[0].ActorID {
+					defaultName = view.Name
+				}
+			}
+
+			tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(tw, "NAME\tFILTER\tUPDATED")
+			for _, view := range views {
+				name := view.Name
+				if name == defaultName {
+					name += " *"
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%s\n", name, view.Description, formatWhen(view.UpdatedAt))
+			}
+			return tw.Flush()
+		},
+	}
+}
+
+func summaryDeleteViewCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete-view [name]",
+		Short: "Delete a saved summary view",
+		Long: `Delete one of your saved summary views. Workbenches that used it as
+their default go back to the unfiltered summary.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := wire.SummaryViewService().DeleteView(NewContext(), args[0]); err != nil {
+				return fmt.Errorf("failed to delete view: %w", err)
+			}
+			fmt.Printf("✓ Deleted view %s\n", args[0])
+			return nil
+		},
+	}
+}
+
+func summaryDefaultViewCmd() *cobra.Command {
+	var workbenchID string
+	var clear bool
+
+	cmd := &cobra.Command{
+		Use:   "default-view [name]",
+		Short: "Show or set a workbench's default summary view",
+		Long: `Make one of your saved views the default for 'orc summary' run in a
+workbench, so the agent there sees the slice relevant to its role without
+passing --view. --view and filter flags still take precedence.
+
+Without a name, shows the workbench's default. Defaults to the current
+workbench.
+
+Examples:
+  orc summary default-view infra --workbench BENCH-003
+  orc summary default-view
+  orc summary default-view --clear`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			if workbenchID == "" {
+				workbenchID = orccontext.GetContextWorkbenchID()
+				if workbenchID == "" {
+					return fmt.Errorf("no workbench context detected\nHint: Use --workbench flag or run from a workbench directory")
+				}
+			}
+
+			switch {
+			case clear:
+				if len(args) > 0 {
+					return fmt.Errorf("pass a view name or --clear, not both")
+				}
+				if err := wire.SummaryViewService().SetDefaultView(ctx, workbenchID, ""); err != nil {
+					return fmt.Errorf("failed to clear default view: %w", err)
+				}
+				fmt.Printf("✓ Cleared the default view of %s\n", workbenchID)
+
+			case len(args) > 0:
+				if err := wire.SummaryViewService().SetDefaultView(ctx, workbenchID, args[0]); err != nil {
+					return fmt.Errorf("failed to set default view: %w", err)
+				}
+				fmt.Printf("✓ %s now shows view %s by default\n", workbenchID, args[0])
+
+			default:
+				view, err := wire.SummaryViewService().GetDefaultView(ctx, workbenchID)
+				if err != nil {
+					return err
+				}
+				if view == nil {
+					fmt.Printf("%s has no default view\n", workbenchID)
+					return nil
+				}
+				fmt.Printf("%s: %s (%s, saved by %s)\n", workbenchID, view.Name, view.Description, view.ActorID)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&workbenchID, "workbench", "w", "", "Workbench (defaults to the current workbench)")
+	cmd.Flags().BoolVar(&clear, "clear", false, "Remove the workbench's default view")
+	return cmd
+}
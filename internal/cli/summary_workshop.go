@@ -0,0 +1,151 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/fatih/color"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// benchWork is what one workbench is working: its focus and the open
+// shipments and tomes it is assigned or focused on.
+type benchWork struct {
+	bench     *primary.Workbench
+	git       benchGitStatus
+	focusID   string // Empty when unfocused or the focus is stale
+	shipments []primary.ShipmentSummary
+	tomes     []primary.TomeSummary
+}
+
+// idle reports whether the workbench has nothing focused and nothing assigned.
+func (b benchWork) idle() bool {
+	return b.focusID == "" && len(b.shipments) == 0 && len(b.tomes) == 0
+}
+
+// groupWorkByBench assigns each open container to the workbenches working
+// it: a shipment to the bench it is assigned to and any bench focused on it,
+// a tome to any bench focused on it. focus maps workbench ID to focus.
+func groupWorkByBench(benches []*primary.Workbench, focus map[string]string, summaries []*primary.CommissionSummary) []benchWork {
+	groups := make([]benchWork, len(benches))
+	for i, wb := range benches {
+		groups[i] = benchWork{bench: wb, focusID: focus[wb.ID]}
+		for _, summary := range summaries {
+			if summary == nil {
+				continue
+			}
+			for _, ship := range summary.Shipments {
+				if ship.BenchID == wb.ID || ship.ID == groups[i].focusID {
+					groups[i].shipments = append(groups[i].shipments, ship)
+				}
+			}
+			for _, tome := range summary.Tomes {
+				if tome.ID == groups[i].focusID {
+					groups[i].tomes = append(groups[i].tomes, tome)
+				}
+			}
+		}
+	}
+	return groups
+}
+
+// runWorkshopSummary renders the workshop-centric summary: each active
+// workbench in the workshop with the containers it is working, idle benches
+// flagged.
+func runWorkshopSummary(ctx context.Context, workshopID, currentWorkbenchID, focusID string, showProgress bool) error {
+	workshop, err := wire.WorkshopService().GetWorkshop(ctx, workshopID)
+	if err != nil {
+		return fmt.Errorf("workshop %q not found", workshopID)
+	}
+
+	allWorkbenches, err := wire.WorkbenchService().ListWorkbenches(ctx, primary.WorkbenchFilters{WorkshopID: workshopID})
+	if err != nil {
+		return fmt.Errorf("failed to list workbenches: %w", err)
+	}
+	var benches []*primary.Workbench
+	focus := make(map[string]string)
+	for _, wb := range allWorkbenches {
+		if wb.Status != "active" {
+			continue
+		}
+		benches = append(benches, wb)
+		if id, err := wire.WorkbenchService().GetFocusedID(ctx, wb.ID); err == nil && id != "" && !isStaleFocus(ctx, id) {
+			focus[wb.ID] = id
+		}
+	}
+
+	fmt.Printf("Workshop %s (%s)\n", workshop.ID, workshop.Name)
+	if len(benches) == 0 {
+		fmt.Println("\nNo active workbenches")
+		return nil
+	}
+
+	commissions, err := wire.CommissionService().ListCommissions(ctx, primary.CommissionFilters{})
+	if err != nil {
+		return fmt.Errorf("failed to list commissions: %w", err)
+	}
+	summaries := make([]*primary.CommissionSummary, len(commissions))
+	var wg sync.WaitGroup
+	for i, commission := range commissions {
+		if commission.Status == "complete" || commission.Status == "archived" {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, commissionID string) {
+			defer wg.Done()
+			summaries[i], _ = wire.SummaryService().GetCommissionSummary(ctx, primary.SummaryRequest{
+				CommissionID: commissionID,
+				WorkbenchID:  currentWorkbenchID,
+				WorkshopID:   workshopID,
+				FocusID:      focusID,
+			})
+		}(i, commission.ID)
+	}
+	wg.Wait()
+
+	groups := groupWorkByBench(benches, focus, summaries)
+	statuses := loadBenchGitStatuses(benches)
+	idleCount := 0
+	for i := range groups {
+		groups[i].git = statuses[i]
+		if groups[i].idle() {
+			idleCount++
+		}
+	}
+
+	workingCount := len(groups) - idleCount
+	fmt.Printf("%s working, %s\n", pluralize(workingCount, "bench", "benches"), color.New(color.FgYellow).Sprintf("%d idle", idleCount))
+
+	workshopFocus := buildWorkshopFocusMap(ctx, workshopID, currentWorkbenchID)
+	for _, group := range groups {
+		fmt.Println()
+		renderBenchWork(group, workshopFocus, currentWorkbenchID, showProgress)
+	}
+	return nil
+}
+
+// renderBenchWork renders one workbench and the containers it is working.
+func renderBenchWork(group benchWork, workshopFocus workshopFocusInfo, currentWorkbenchID string, showProgress bool) {
+	wb := group.bench
+	line := formatBenchLine(fmt.Sprintf("%s@%s", wb.Name, wb.ID), wb, group.git, currentWorkbenchID)
+	switch {
+	case group.idle():
+		line += color.New(color.FgYellow).Sprint(" (idle)")
+	case group.focusID != "" && len(group.shipments) == 0 && len(group.tomes) == 0:
+		// Focused on something without a container row, like a commission
+		line += color.New(color.FgCyan).Sprintf(" → %s", group.focusID)
+	}
+	fmt.Println(line)
+
+	totalItems := len(group.shipments) + len(group.tomes)
+	itemIdx := 0
+	for _, ship := range group.shipments {
+		renderShipment(ship, workshopFocus, &itemIdx, totalItems, showProgress)
+	}
+	for _, tome := range group.tomes {
+		renderTome(tome, workshopFocus, &itemIdx, totalItems, true)
+	}
+}
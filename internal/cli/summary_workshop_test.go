@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func TestGroupWorkByBench(t *testing.T) {
+	benches := []*primary.Workbench{
+		{ID: "BENCH-001", Name: "orc-001"},
+		{ID: "BENCH-002", Name: "orc-002"},
+		{ID: "BENCH-003", Name: "orc-003"},
+		{ID: "BENCH-004", Name: "orc-004"},
+	}
+	focus := map[string]string{
+		"BENCH-001": "SHIP-002", // Focused on a shipment assigned elsewhere
+		"BENCH-002": "TOME-001",
+		"BENCH-004": "COMM-001",
+	}
+	summaries := []*primary.CommissionSummary{
+		{
+			ID: "COMM-001",
+			Shipments: []primary.ShipmentSummary{
+				{ID: "SHIP-001", BenchID: "BENCH-001"},
+				{ID: "SHIP-002", BenchID: "BENCH-002"},
+				{ID: "SHIP-003"},
+			},
+			Tomes: []primary.TomeSummary{{ID: "TOME-001"}, {ID: "TOME-002"}},
+		},
+		nil, // A commission whose summary failed to load
+	}
+
+	groups := groupWorkByBench(benches, focus, summaries)
+
+	containerIDs := func(g benchWork) []string {
+		var ids []string
+		for _, s := range g.shipments {
+			ids = append(ids, s.ID)
+		}
+		for _, tome := range g.tomes {
+			ids = append(ids, tome.ID)
+		}
+		return ids
+	}
+	tests := []struct {
+		bench string
+		want  []string
+		idle  bool
+	}{
+		{"BENCH-001", []string{"SHIP-001", "SHIP-002"}, false},
+		{"BENCH-002", []string{"SHIP-002", "TOME-001"}, false},
+		{"BENCH-003", nil, true},
+		{"BENCH-004", nil, false}, // Focused on the commission itself
+	}
+	for i, tt := range tests {
+		g := groups[i]
+		if g.bench.ID != tt.bench {
+			t.Fatalf("group %d is %s, want %s", i, g.bench.ID, tt.bench)
+		}
+		got := containerIDs(g)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s containers = %v, want %v", tt.bench, got, tt.want)
+			continue
+		}
+		for j := range got {
+			if got[j] != tt.want[j] {
+				t.Errorf("%s containers = %v, want %v", tt.bench, got, tt.want)
+				break
+			}
+		}
+		if g.idle() != tt.idle {
+			t.Errorf("%s idle = %v, want %v", tt.bench, g.idle(), tt.idle)
+		}
+	}
+}
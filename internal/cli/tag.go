@@ -2,6 +2,9 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
@@ -12,7 +15,7 @@ import (
 var tagCmd = &cobra.Command{
 	Use:   "tag",
 	Short: "Manage tags (classification labels for tasks)",
-	Long:  "Create, list, show, and delete tags in the ORC ledger",
+	Long:  "Create, list, show, rename, merge, and delete tags in the ORC ledger",
 }
 
 var tagCreateCmd = &cobra.Command{
@@ -86,7 +89,16 @@ var tagShowCmd = &cobra.Command{
 		if tag.Description != "" {
 			fmt.Printf("Description: %s\n", tag.Description)
 		}
-		fmt.Printf("Created: %s\n", tag.CreatedAt)
+		fmt.Printf("Created: %s\n", formatTime(tag.CreatedAt))
+		routes, err := wire.TagService().ListTagRoutes(ctx, "")
+		if err != nil {
+			return fmt.Errorf("failed to get tag routes: %w", err)
+		}
+		for _, route := range routes {
+			if route.TagName == tag.Name {
+				fmt.Printf("Routed to: %s (%s)\n", route.WorkbenchID, route.Mode)
+			}
+		}
 		fmt.Println()
 
 		// Display tasks with this tag
@@ -109,6 +121,83 @@ var tagShowCmd = &cobra.Command{
 	},
 }
 
+var tagRouteCmd = &cobra.Command{
+	Use:   "route [name] [workbench-id]",
+	Short: "Route a tag's new tasks to a specialized workbench",
+	Long: `Route a tag to the workbench that specializes in its work.
+
+'orc task create --tag <name>' then suggests the workbench, or with
+--assign assigns the new task to it. 'orc task claim --next' in that
+workbench prefers tasks carrying its routed tags. A tag routes to one
+workbench; routing it again replaces the route.
+
+Examples:
+  orc tag route database-schema BENCH-002
+  orc tag route database-schema BENCH-002 --assign`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		assign, _ := cmd.Flags().GetBool("assign")
+
+		mode := primary.TagRouteModeSuggest
+		if assign {
+			mode = primary.TagRouteModeAssign
+		}
+
+		err := wire.TagService().RouteTag(ctx, primary.RouteTagRequest{
+			TagName:     args[0],
+			WorkbenchID: args[1],
+			Mode:        mode,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to route tag: %w", err)
+		}
+
+		fmt.Printf("✓ Tag %s routed to %s (%s)\n", args[0], args[1], mode)
+		return nil
+	},
+}
+
+var tagUnrouteCmd = &cobra.Command{
+	Use:   "unroute [name]",
+	Short: "Remove a tag's workbench route",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+
+		if err := wire.TagService().UnrouteTag(ctx, args[0]); err != nil {
+			return fmt.Errorf("failed to unroute tag: %w", err)
+		}
+
+		fmt.Printf("✓ Tag %s unrouted\n", args[0])
+		return nil
+	},
+}
+
+var tagRoutesCmd = &cobra.Command{
+	Use:   "routes",
+	Short: "List tag routes",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		workbenchID, _ := cmd.Flags().GetString("workbench")
+
+		routes, err := wire.TagService().ListTagRoutes(ctx, workbenchID)
+		if err != nil {
+			return fmt.Errorf("failed to list tag routes: %w", err)
+		}
+
+		if len(routes) == 0 {
+			fmt.Println("No tag routes found.")
+			return nil
+		}
+
+		for _, route := range routes {
+			fmt.Printf("%-24s → %s (%s)\n", route.TagName, route.WorkbenchID, route.Mode)
+		}
+		return nil
+	},
+}
+
 var tagDeleteCmd = &cobra.Command{
 	Use:   "delete [name]",
 	Short: "Delete a tag (removes from all tasks)",
@@ -134,15 +223,135 @@ var tagDeleteCmd = &cobra.Command{
 	},
 }
 
+var tagRenameCmd = &cobra.Command{
+	Use:   "rename [name] [new-name]",
+	Short: "Rename a tag (tagged entities and routes follow)",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+
+		tag, err := wire.TagService().RenameTag(ctx, args[0], args[1])
+		if err != nil {
+			return fmt.Errorf("failed to rename tag: %w", err)
+		}
+
+		fmt.Printf("✓ Tag %s renamed: %s → %s\n", tag.ID, args[0], tag.Name)
+		return nil
+	},
+}
+
+var tagMergeCmd = &cobra.Command{
+	Use:   "merge [name...] --into [target]",
+	Short: "Fold near-duplicate tags into one",
+	Long: `Re-tag everything carrying the named tags with the target tag, then delete
+the named tags. An entity that already carries the target keeps it once. A
+merged tag's workbench route moves to the target unless the target has its own.
+
+Every named tag is checked before any is merged.
+
+Examples:
+  orc tag merge testing --into tests
+  orc tag merge test testing --into tests`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		into, _ := cmd.Flags().GetString("into")
+
+		resp, err := wire.TagService().MergeTags(ctx, primary.MergeTagsRequest{
+			Sources: args,
+			Into:    into,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to merge tags: %w", err)
+		}
+
+		for _, merged := range resp.Sources {
+			fmt.Printf("✓ Merged %s into %s: %s re-tagged", merged.Name, resp.Into, pluralize(merged.Moved, "entity", "entities"))
+			if merged.Duplicates > 0 {
+				fmt.Printf(", %d already tagged %s", merged.Duplicates, resp.Into)
+			}
+			if merged.RouteMoved {
+				fmt.Print(", route moved")
+			}
+			fmt.Println()
+		}
+		return nil
+	},
+}
+
+var tagUsageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Count tag uses per entity type to guide cleanup",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		report, err := wire.TagService().TagUsage(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get tag usage: %w", err)
+		}
+
+		if len(report.Tags) == 0 {
+			fmt.Println("No tags found.")
+			return nil
+		}
+
+		types := []string{"task", "shipment", "plan", "note", "tome"}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "TAG\tTASKS\tSHIPMENTS\tPLANS\tNOTES\tTOMES\tTOTAL")
+		fmt.Fprintln(w, "---\t-----\t---------\t-----\t-----\t-----\t-----")
+		for _, usage := range report.Tags {
+			fmt.Fprint(w, usage.Name)
+			for _, entityType := range types {
+				fmt.Fprintf(w, "\t%d", usage.Counts[entityType])
+			}
+			fmt.Fprintf(w, "\t%d\n", usage.Total)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+
+		if len(report.Similar) > 0 {
+			fmt.Println()
+			fmt.Println("Possible duplicates:")
+			for _, group := range report.Similar {
+				fmt.Printf("  %s\n", strings.Join(group, ", "))
+			}
+			fmt.Println("Fold them with: orc tag merge <name>... --into <name>")
+		}
+		return nil
+	},
+}
+
 func init() {
 	// tag create flags
 	tagCreateCmd.Flags().StringP("description", "d", "", "Tag description")
 
+	// tag route flags
+	tagRouteCmd.Flags().Bool("assign", false, "Assign new tagged tasks to the workbench instead of suggesting it")
+	tagRoutesCmd.Flags().String("workbench", "", "Only routes to this workbench")
+
+	// tag merge flags
+	tagMergeCmd.Flags().String("into", "", "Tag to merge into (required)")
+	_ = tagMergeCmd.MarkFlagRequired("into")
+
+	// Complete existing tag names
+	tagShowCmd.ValidArgsFunction = completeEntityArgs("tag")
+	tagDeleteCmd.ValidArgsFunction = completeEntityArgs("tag")
+	tagRouteCmd.ValidArgsFunction = completeEntityArgs("tag")
+	tagUnrouteCmd.ValidArgsFunction = completeEntityArgs("tag")
+	tagRenameCmd.ValidArgsFunction = completeEntityArgs("tag")
+	tagMergeCmd.ValidArgsFunction = completeEntityArgs("tag")
+
 	// Register subcommands
 	tagCmd.AddCommand(tagCreateCmd)
 	tagCmd.AddCommand(tagListCmd)
 	tagCmd.AddCommand(tagShowCmd)
 	tagCmd.AddCommand(tagDeleteCmd)
+	tagCmd.AddCommand(tagRouteCmd)
+	tagCmd.AddCommand(tagUnrouteCmd)
+	tagCmd.AddCommand(tagRoutesCmd)
+	tagCmd.AddCommand(tagRenameCmd)
+	tagCmd.AddCommand(tagMergeCmd)
+	tagCmd.AddCommand(tagUsageCmd)
 }
 
 // TagCmd returns the tag command
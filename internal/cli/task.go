@@ -1,9 +1,12 @@
 package cli
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -30,6 +33,10 @@ var taskCreateCmd = &cobra.Command{
 		description, _ := cmd.Flags().GetString("description")
 		taskType, _ := cmd.Flags().GetString("type")
 		dependsOn, _ := cmd.Flags().GetStringSlice("depends-on")
+		tag, _ := cmd.Flags().GetString("tag")
+		points, _ := cmd.Flags().GetInt("points")
+		priority, _ := cmd.Flags().GetString("priority")
+		due, _ := cmd.Flags().GetString("due")
 
 		// Validate entity IDs
 		if err := validateEntityID(shipmentID, "shipment"); err != nil {
@@ -51,24 +58,62 @@ var taskCreateCmd = &cobra.Command{
 			Description:  description,
 			Type:         taskType,
 			DependsOn:    dependsOn,
+			Tag:          tag,
+			Points:       points,
+			Priority:     priority,
+			DueDate:      due,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create task: %w", err)
 		}
 
-		task := resp.Task
-		fmt.Printf("✓ Created task %s: %s\n", task.ID, task.Title)
-		if task.ShipmentID != "" {
-			fmt.Printf("  Under shipment: %s\n", task.ShipmentID)
-		}
-		fmt.Printf("  Commission: %s\n", task.CommissionID)
-		if len(task.DependsOn) > 0 {
-			fmt.Printf("  Depends on: %s\n", strings.Join(task.DependsOn, ", "))
-		}
+		printCreatedTask(resp, tag)
 		return nil
 	},
 }
 
+// printCreatedTask reports a newly created task and where its tag routes it.
+func printCreatedTask(resp *primary.CreateTaskResponse, tag string) {
+	task := resp.Task
+	fmt.Printf("✓ Created task %s: %s\n", task.ID, task.Title)
+	if task.ShipmentID != "" {
+		fmt.Printf("  Under shipment: %s\n", task.ShipmentID)
+	}
+	fmt.Printf("  Commission: %s\n", task.CommissionID)
+	if len(task.DependsOn) > 0 {
+		fmt.Printf("  Depends on: %s\n", strings.Join(task.DependsOn, ", "))
+	}
+	if tag != "" {
+		fmt.Printf("  Tag: %s\n", tag)
+	}
+	if task.Priority != "" {
+		fmt.Printf("  Priority: %s\n", task.Priority)
+	}
+	if task.DueDate != "" {
+		fmt.Printf("  Due: %s\n", formatDueDate(task.DueDate))
+	}
+	if task.Points > 0 {
+		fmt.Printf("  Points: %d\n", task.Points)
+	}
+	if route := resp.Route; route != nil {
+		if task.AssignedWorkbenchID != "" {
+			fmt.Printf("  Assigned to workbench: %s (routed by tag %s)\n", task.AssignedWorkbenchID, route.TagName)
+		} else {
+			fmt.Printf("💡 Tag %s routes to %s: claim it from there with orc task claim %s\n", route.TagName, route.WorkbenchID, task.ID)
+		}
+	}
+}
+
+// formatDueDate renders a YYYY-MM-DD due date with its weekday, e.g.
+// "Fri 2026-10-16". Values that don't parse are shown as stored.
+func formatDueDate(date string) string {
+	d, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return date
+	}
+	return d.Format("Mon 2006-01-02")
+}
+
 var taskListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List tasks",
@@ -134,7 +179,18 @@ var taskListCmd = &cobra.Command{
 				typeStr = fmt.Sprintf(" [%s]", task.Type)
 			}
 
-			fmt.Printf("%s %s: %s%s [%s]%s\n", statusIcon, task.ID, task.Title, typeStr, task.Status, pinnedIcon)
+			extras := ""
+			if task.ChecklistTotal > 0 {
+				extras += fmt.Sprintf(" [%d/%d]", task.ChecklistDone, task.ChecklistTotal)
+			}
+			if task.CommentCount > 0 {
+				extras += fmt.Sprintf(" (%d💬)", task.CommentCount)
+			}
+
+			fmt.Printf("%s %s: %s%s [%s]%s%s\n", statusIcon, task.ID, task.Title, typeStr, task.Status, pinnedIcon, extras)
+			if task.TagName != "" {
+				fmt.Printf("   Tag: %s\n", task.TagName)
+			}
 			if task.ShipmentID != "" {
 				fmt.Printf("   Shipment: %s\n", task.ShipmentID)
 			}
@@ -163,57 +219,97 @@ var taskShowCmd = &cobra.Command{
 			return fmt.Errorf("task not found: %w", err)
 		}
 
-		// Display task details
-		fmt.Printf("Task: %s\n", task.ID)
-		fmt.Printf("Title: %s\n", task.Title)
-		if task.Description != "" {
-			fmt.Printf("Description: %s\n", task.Description)
-		}
-		fmt.Printf("Status: %s\n", task.Status)
-		if task.Type != "" {
-			fmt.Printf("Type: %s\n", task.Type)
-		}
-		fmt.Printf("Commission: %s\n", task.CommissionID)
+		rel := loadRelations(ctx, taskID, "task")
+		rel.plans, _ = wire.PlanService().ListPlans(ctx, primary.PlanFilters{TaskID: taskID})
 		if task.ShipmentID != "" {
-			fmt.Printf("Shipment: %s\n", task.ShipmentID)
-		}
-		if task.TomeID != "" {
-			fmt.Printf("Tome: %s\n", task.TomeID)
-		}
-		if task.AssignedWorkbenchID != "" {
-			fmt.Printf("Assigned Workbench: %s\n", task.AssignedWorkbenchID)
-		}
-		if task.Priority != "" {
-			fmt.Printf("Priority: %s\n", task.Priority)
-		}
-		if len(task.DependsOn) > 0 {
-			fmt.Printf("Depends on: %s\n", strings.Join(task.DependsOn, ", "))
-		}
-		if task.Pinned {
-			fmt.Printf("Pinned: yes\n")
-		}
-		fmt.Printf("Created: %s\n", task.CreatedAt)
-		if task.ClaimedAt != "" {
-			fmt.Printf("Claimed: %s\n", task.ClaimedAt)
-		}
-		if task.CompletedAt != "" {
-			fmt.Printf("Completed: %s\n", task.CompletedAt)
-		}
-		if task.Tag != nil {
-			fmt.Printf("Tag: %s\n", task.Tag.Name)
+			rel.pr, _ = wire.PRService().GetPRByShipment(ctx, task.ShipmentID)
 		}
 
+		taskDetail(task, rel).render(os.Stdout)
 		return nil
 	},
 }
 
+// taskDetail builds the task show view.
+func taskDetail(task *primary.Task, rel detailRelations) *detailView {
+	v := newDetailView("Task", task.ID+presenceMarker(rel.presence))
+	v.field("Title", task.Title)
+	v.field("Description", task.Description)
+	v.field("Status", task.Status)
+	v.field("Type", task.Type)
+	if task.Points > 0 {
+		v.field("Points", strconv.Itoa(task.Points))
+	}
+	v.field("Priority", task.Priority)
+	if task.DueDate != "" {
+		v.field("Due", formatDueDate(task.DueDate))
+	}
+	if task.Pinned {
+		v.field("Pinned", "yes")
+	}
+	v.field("Created", formatTime(task.CreatedAt))
+	v.field("Claimed", formatTime(task.ClaimedAt))
+	v.field("Completed", formatTime(task.CompletedAt))
+
+	related := []detailField{
+		{"Commission", task.CommissionID},
+		{"Shipment", task.ShipmentID},
+		{"Tome", task.TomeID},
+		{"Workbench", task.AssignedWorkbenchID},
+	}
+	related = append(related, rel.planEntries()...)
+	related = append(related, rel.prEntry())
+	for _, dep := range task.DependsOn {
+		related = append(related, detailField{"Depends on", dep})
+	}
+	v.panel(rel.relatedPanel(related...))
+	v.panel(checklistPanel(task))
+	v.panel(commitsPanel(rel.commits))
+	v.panel(commentsPanel(rel.comments))
+	v.panel(eventsPanel(rel.events))
+	v.panel(nextPanel(taskNextActions(task)...))
+	return v
+}
+
+// taskNextActions suggests commands that move a task forward.
+func taskNextActions(task *primary.Task) []string {
+	switch task.Status {
+	case "open":
+		return []string{"orc task claim " + task.ID}
+	case "in-progress":
+		var next []string
+		for _, item := range task.Checklist {
+			if !item.Done {
+				next = append(next, fmt.Sprintf("orc task check done %s %d", task.ID, item.Position))
+				break
+			}
+		}
+		return append(next, "orc task complete "+task.ID)
+	}
+	return nil
+}
+
 var taskClaimCmd = &cobra.Command{
 	Use:   "claim [task-id]",
 	Short: "Claim a task (mark as implement)",
-	Args:  cobra.ExactArgs(1),
+	Long: `Claim a task for the current workbench.
+
+With --next, claim the workbench's next open task in the current
+commission instead: tasks already assigned to the workbench first, then
+tasks whose tag routes to it (see 'orc tag route'), then any other
+unassigned task. Tasks with unfinished dependencies are skipped.
+
+Claiming a closed task is outside the task state machine (see
+'orc task transitions') and requires --force.
+
+Examples:
+  orc task claim TASK-042
+  orc task claim --next`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := NewContext()
-		taskID := args[0]
+		next, _ := cmd.Flags().GetBool("next")
+		force, _ := cmd.Flags().GetBool("force")
 
 		// Try to get workbench from current directory
 		cwd, _ := os.Getwd()
@@ -224,9 +320,21 @@ var taskClaimCmd = &cobra.Command{
 			workbenchID = workbench.ID
 		}
 
+		if next {
+			if len(args) > 0 {
+				return fmt.Errorf("use a task ID or --next, not both")
+			}
+			return claimNextTask(ctx, workbenchID)
+		}
+		if len(args) == 0 {
+			return fmt.Errorf("task ID required (or use --next)")
+		}
+		taskID := args[0]
+
 		err := wire.TaskService().ClaimTask(ctx, primary.ClaimTaskRequest{
 			TaskID:      taskID,
 			WorkbenchID: workbenchID,
+			Force:       force,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to claim task: %w", err)
@@ -244,6 +352,37 @@ var taskClaimCmd = &cobra.Command{
 	},
 }
 
+// claimNextTask claims the workbench's next open task in the context commission.
+func claimNextTask(ctx context.Context, workbenchID string) error {
+	if workbenchID == "" {
+		return fmt.Errorf("--next requires running from a workbench directory")
+	}
+	commissionID := orccontext.GetContextCommissionID()
+	if commissionID == "" {
+		return fmt.Errorf("no commission context detected\nHint: run from a workbench focused on a commission")
+	}
+
+	task, err := wire.TaskService().ClaimNextTask(ctx, primary.ClaimNextTaskRequest{
+		WorkbenchID:  workbenchID,
+		CommissionID: commissionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to claim next task: %w", err)
+	}
+	if task == nil {
+		fmt.Printf("✓ No claimable open tasks in %s\n", commissionID)
+		return nil
+	}
+
+	fmt.Printf("✓ Task %s claimed: %s\n", task.ID, task.Title)
+	fmt.Printf("  Assigned to workbench: %s\n", workbenchID)
+	fmt.Println()
+	fmt.Println("💡 Next steps:")
+	fmt.Println("   # Do the work...")
+	fmt.Printf("   orc task complete %s\n", task.ID)
+	return nil
+}
+
 var taskCompleteCmd = &cobra.Command{
 	Use:   "complete [task-id]",
 	Short: "Mark task as complete",
@@ -303,22 +442,24 @@ var taskResumeCmd = &cobra.Command{
 
 var taskUpdateCmd = &cobra.Command{
 	Use:   "update [task-id]",
-	Short: "Update task title and/or description",
+	Short: "Update task title, description, and/or points",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := NewContext()
 		taskID := args[0]
 		title, _ := cmd.Flags().GetString("title")
 		description, _ := cmd.Flags().GetString("description")
+		points, _ := cmd.Flags().GetInt("points")
 
-		if title == "" && description == "" {
-			return fmt.Errorf("must specify --title and/or --description")
+		if title == "" && description == "" && points == 0 {
+			return fmt.Errorf("must specify --title, --description, and/or --points")
 		}
 
 		err := wire.TaskService().UpdateTask(ctx, primary.UpdateTaskRequest{
 			TaskID:      taskID,
 			Title:       title,
 			Description: description,
+			Points:      points,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to update task: %w", err)
@@ -541,9 +682,18 @@ func init() {
 	// task create flags
 	taskCreateCmd.Flags().String("shipment", "", "Shipment ID")
 	taskCreateCmd.Flags().StringP("commission", "c", "", "Commission ID (defaults to context)")
+	inferFromFocus(taskCreateCmd, "shipment", "commission")
 	taskCreateCmd.Flags().StringP("description", "d", "", "Task description")
 	taskCreateCmd.Flags().String("type", "", "Task type (research, implementation, fix, documentation, maintenance)")
 	taskCreateCmd.Flags().StringSlice("depends-on", nil, "Task IDs this task depends on (comma-separated or repeated)")
+	taskCreateCmd.Flags().String("tag", "", "Tag the task (a routed tag suggests or assigns its workbench)")
+	taskCreateCmd.Flags().Int("points", 0, "Estimate in task points (for commission budgets)")
+	taskCreateCmd.Flags().String("priority", "", "Priority (low, medium, high)")
+	taskCreateCmd.Flags().String("due", "", "Due date (2026-10-20, fri, tomorrow, +3d)")
+
+	// task claim flags
+	taskClaimCmd.Flags().Bool("next", false, "Claim this workbench's next open task, preferring its routed tags")
+	taskClaimCmd.Flags().Bool("force", false, "Allow claiming outside the state machine (e.g. a closed task)")
 
 	// task list flags
 	taskListCmd.Flags().String("shipment", "", "Filter by shipment")
@@ -553,6 +703,7 @@ func init() {
 	// task update flags
 	taskUpdateCmd.Flags().String("title", "", "New title")
 	taskUpdateCmd.Flags().StringP("description", "d", "", "New description")
+	taskUpdateCmd.Flags().Int("points", 0, "New estimate in task points")
 
 	// task discover flags
 	taskDiscoverCmd.Flags().Bool("auto-claim", false, "Automatically claim the first open task")
@@ -575,11 +726,17 @@ func init() {
 	taskCmd.AddCommand(taskUpdateCmd)
 	taskCmd.AddCommand(taskPinCmd)
 	taskCmd.AddCommand(taskUnpinCmd)
+	taskCmd.AddCommand(aliasCmd("task", "TASK-042"))
+	taskCmd.AddCommand(unaliasCmd("task"))
 	taskCmd.AddCommand(taskDiscoverCmd)
 	taskCmd.AddCommand(taskTagCmd)
 	taskCmd.AddCommand(taskUntagCmd)
+	taskCmd.AddCommand(taskCheckCmd)
 	taskCmd.AddCommand(taskMoveCmd)
 	taskCmd.AddCommand(taskDeleteCmd)
+	taskCmd.AddCommand(transitionsCmd("task", func() []primary.StatusTransition {
+		return wire.TaskService().StatusTransitions()
+	}))
 }
 
 // TaskCmd returns the task command
@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+var taskAddCmd = &cobra.Command{
+	Use:   "add <line>",
+	Short: "Create a task from one line of quick-add syntax",
+	Long: `Create a task from one line, reading its fields from tokens:
+
+  !high, !medium, !low    priority (!h, !med, !l also work)
+  #testing                tag (the first character must be a letter, so #42 stays in the title)
+  @SHIP-010               shipment; also sets the commission to the shipment's
+  due:fri                 due date: 2026-10-20, today, tomorrow, a weekday, +3d, +2w
+
+The remaining words form the title. Prefix a word with a backslash to keep
+it as written: \#hashtag. Quote the line, since the shell reads # as a comment.
+
+The parsed fields are shown for confirmation first; --no-confirm skips the
+prompt for scripts. Without @SHIP-..., the shipment and commission default
+as for 'orc task create'.
+
+Examples:
+  orc task add "Fix flaky auth test !high #testing @SHIP-010 due:fri"
+  orc task add "Write release notes due:+2d" --no-confirm`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		noConfirm, _ := cmd.Flags().GetBool("no-confirm")
+
+		req, err := wire.TaskService().ParseQuickAdd(ctx, strings.Join(args, " "))
+		if err != nil {
+			return err
+		}
+
+		if req.ShipmentID != "" {
+			req.CommissionID = resolveContainerCommission(req.ShipmentID)
+			if req.CommissionID == "" {
+				return fmt.Errorf("shipment %s not found", req.ShipmentID)
+			}
+		} else {
+			req.ShipmentID, _ = cmd.Flags().GetString("shipment")
+			req.CommissionID, _ = cmd.Flags().GetString("commission")
+		}
+		if req.CommissionID == "" {
+			req.CommissionID = orccontext.GetContextCommissionID()
+			if req.CommissionID == "" {
+				return fmt.Errorf("no commission context detected\nHint: Use --commission flag, name a shipment with @SHIP-..., or run from a workbench directory")
+			}
+		}
+
+		printQuickAdd(cmd.OutOrStdout(), req)
+		if !noConfirm && !confirmQuickAdd(cmd.InOrStdin(), cmd.OutOrStdout()) {
+			fmt.Fprintln(cmd.OutOrStdout(), "Aborted.")
+			return nil
+		}
+
+		resp, err := wire.TaskService().CreateTask(ctx, *req)
+		if err != nil {
+			return fmt.Errorf("failed to create task: %w", err)
+		}
+		printCreatedTask(resp, req.Tag)
+		return nil
+	},
+}
+
+// printQuickAdd previews the task a quick-add line describes.
+func printQuickAdd(out io.Writer, req *primary.CreateTaskRequest) {
+	fmt.Fprintf(out, "  Title:      %s\n", req.Title)
+	fmt.Fprintf(out, "  Commission: %s\n", req.CommissionID)
+	fmt.Fprintf(out, "  Shipment:   %s\n", orDash(req.ShipmentID))
+	fmt.Fprintf(out, "  Priority:   %s\n", orDash(req.Priority))
+	fmt.Fprintf(out, "  Tag:        %s\n", orDash(req.Tag))
+	due := "-"
+	if req.DueDate != "" {
+		due = formatDueDate(req.DueDate)
+	}
+	fmt.Fprintf(out, "  Due:        %s\n", due)
+}
+
+// confirmQuickAdd asks whether to create the previewed task. An empty answer
+// means yes; no answer at all (stdin closed) means no.
+func confirmQuickAdd(in io.Reader, out io.Writer) bool {
+	fmt.Fprint(out, "\nCreate this task? [Y/n] ")
+	response, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && response == "" {
+		fmt.Fprintln(out)
+		return false
+	}
+	response = strings.TrimSpace(strings.ToLower(response))
+	return response == "" || response == "y" || response == "yes"
+}
+
+func init() {
+	taskAddCmd.Flags().String("shipment", "", "Shipment ID, unless the line names one")
+	taskAddCmd.Flags().StringP("commission", "c", "", "Commission ID (defaults to context)")
+	inferFromFocus(taskAddCmd, "shipment", "commission")
+	taskAddCmd.Flags().Bool("no-confirm", false, "Create without showing the parsed fields for confirmation")
+
+	taskCmd.AddCommand(taskAddCmd)
+}
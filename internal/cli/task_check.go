@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+var taskCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Manage a task's checklist",
+	Long: `Checklist items are lightweight sub-steps of a task that don't need
+their own task. Items are numbered in the order they were added, as shown
+by 'orc task show'.
+
+Examples:
+  orc task check add TASK-042 "update docs"
+  orc task check done TASK-042 2
+  orc task check undo TASK-042 2
+  orc task check remove TASK-042 2`,
+}
+
+var taskCheckAddCmd = &cobra.Command{
+	Use:   "add [task-id] [text]",
+	Short: "Add a checklist item to a task",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		taskID := args[0]
+
+		item, err := wire.TaskService().AddChecklistItem(ctx, taskID, args[1])
+		if err != nil {
+			return fmt.Errorf("failed to add checklist item: %w", err)
+		}
+
+		fmt.Printf("✓ Added item %d to %s: %s\n", item.Position, taskID, item.Text)
+		return nil
+	},
+}
+
+var taskCheckDoneCmd = &cobra.Command{
+	Use:   "done [task-id] [n]",
+	Short: "Mark a checklist item done",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setChecklistItemDone(args[0], args[1], true)
+	},
+}
+
+var taskCheckUndoCmd = &cobra.Command{
+	Use:   "undo [task-id] [n]",
+	Short: "Mark a checklist item not done",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return setChecklistItemDone(args[0], args[1], false)
+	},
+}
+
+var taskCheckRemoveCmd = &cobra.Command{
+	Use:   "remove [task-id] [n]",
+	Short: "Remove a checklist item (later items are renumbered)",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		taskID := args[0]
+
+		position, err := parseChecklistPosition(args[1])
+		if err != nil {
+			return err
+		}
+
+		if err := wire.TaskService().RemoveChecklistItem(ctx, taskID, position); err != nil {
+			return fmt.Errorf("failed to remove checklist item: %w", err)
+		}
+
+		fmt.Printf("✓ Removed item %d from %s\n", position, taskID)
+		return nil
+	},
+}
+
+// setChecklistItemDone checks or unchecks an item and reports the task's progress.
+func setChecklistItemDone(taskID, arg string, done bool) error {
+	ctx := NewContext()
+
+	position, err := parseChecklistPosition(arg)
+	if err != nil {
+		return err
+	}
+
+	if err := wire.TaskService().SetChecklistItemDone(ctx, taskID, position, done); err != nil {
+		return fmt.Errorf("failed to update checklist item: %w", err)
+	}
+
+	state := "done"
+	if !done {
+		state = "not done"
+	}
+	fmt.Printf("✓ %s item %d marked %s%s\n", taskID, position, state, checklistTally(ctx, taskID))
+	return nil
+}
+
+// checklistTally returns " (done/total)" for a task, or "" if it can't be loaded.
+func checklistTally(ctx context.Context, taskID string) string {
+	task, err := wire.TaskService().GetTask(ctx, taskID)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf(" (%d/%d)", countChecklistDone(task.Checklist), len(task.Checklist))
+}
+
+// countChecklistDone returns the number of checked items.
+func countChecklistDone(items []*primary.ChecklistItem) int {
+	done := 0
+	for _, item := range items {
+		if item.Done {
+			done++
+		}
+	}
+	return done
+}
+
+// parseChecklistPosition parses a 1-based checklist item number.
+func parseChecklistPosition(arg string) (int, error) {
+	position, err := strconv.Atoi(arg)
+	if err != nil {
+		return 0, fmt.Errorf("invalid checklist item number %q (use the number shown by orc task show)", arg)
+	}
+	return position, nil
+}
+
+// checklistPanel shows a task's checklist in task show (empty when it has none).
+func checklistPanel(task *primary.Task) detailPanel {
+	p := detailPanel{title: fmt.Sprintf("Checklist (%d/%d)", countChecklistDone(task.Checklist), len(task.Checklist))}
+	for _, item := range task.Checklist {
+		mark := " "
+		if item.Done {
+			mark = "x"
+		}
+		p.lines = append(p.lines, fmt.Sprintf("%d. [%s] %s", item.Position, mark, item.Text))
+	}
+	return p
+}
+
+func init() {
+	taskCheckCmd.AddCommand(taskCheckAddCmd)
+	taskCheckCmd.AddCommand(taskCheckDoneCmd)
+	taskCheckCmd.AddCommand(taskCheckUndoCmd)
+	taskCheckCmd.AddCommand(taskCheckRemoveCmd)
+}
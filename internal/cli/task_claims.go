@@ -0,0 +1,165 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/config"
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+var taskClaimsCmd = &cobra.Command{
+	Use:   "claims",
+	Short: "List claimed tasks and when their claims expire",
+	Long: `List in-progress tasks with the workbench holding each one.
+
+A claim stays live while its IMP's hooks keep heartbeating it (or
+'orc task heartbeat' is run). A claim not seen for the claim TTL
+(default 2h; --ttl or ORC_CLAIM_TTL) is stale: its IMP has likely died,
+and 'orc task reap' releases it.
+
+Examples:
+  orc task claims
+  orc task claims --stale
+  orc task claims --stale --ttl 30m`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ttl, err := claimTTL(cmd)
+		if err != nil {
+			return err
+		}
+		stale, _ := cmd.Flags().GetBool("stale")
+
+		claims, err := wire.TaskService().ListClaims(NewContext(), primary.ListClaimsRequest{TTL: ttl, StaleOnly: stale})
+		if err != nil {
+			return fmt.Errorf("failed to list claims: %w", err)
+		}
+		if len(claims) == 0 {
+			if stale {
+				fmt.Println("No stale claims.")
+			} else {
+				fmt.Println("No claimed tasks.")
+			}
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "TASK\tWORKBENCH\tLAST SEEN\tEXPIRES\tTITLE")
+		for _, c := range claims {
+			expires := formatClock(c.ExpiresAt)
+			if c.Stale {
+				expires = "STALE"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+				c.TaskID, claimHolder(c), formatClock(c.LastSeenAt), expires, truncate(c.Title, 40))
+		}
+		return w.Flush()
+	},
+}
+
+var taskReapCmd = &cobra.Command{
+	Use:   "reap",
+	Short: "Release stale task claims back to open",
+	Long: `Release claims that have gone stale (see 'orc task claims --stale').
+
+Each released task returns to open so it can be claimed again. Its
+workbench assignment is kept, so the IMP picks it back up with
+'orc task claim --next' if it comes back. Releases are recorded in the
+workshop log ('orc log').
+
+Examples:
+  orc task reap --dry-run
+  orc task reap --ttl 4h`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ttl, err := claimTTL(cmd)
+		if err != nil {
+			return err
+		}
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		released, err := wire.TaskService().ReapStaleClaims(NewContext(), primary.ReapClaimsRequest{TTL: ttl, DryRun: dryRun})
+		if err != nil {
+			return fmt.Errorf("failed to reap claims: %w", err)
+		}
+		if len(released) == 0 {
+			fmt.Println("No stale claims.")
+			return nil
+		}
+
+		verb := "Released"
+		if dryRun {
+			verb = "Would release"
+		}
+		for _, c := range released {
+			fmt.Printf("✓ %s %s (%s, last seen %s)\n", verb, c.TaskID, claimHolder(c), formatClock(c.LastSeenAt))
+		}
+		return nil
+	},
+}
+
+var taskHeartbeatCmd = &cobra.Command{
+	Use:   "heartbeat",
+	Short: "Refresh this workbench's task claims",
+	Long: `Mark the current workbench's claimed tasks as still being worked on.
+
+The Stop and UserPromptSubmit hooks do this automatically; run it by hand
+during long stretches without hook activity.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		workbenchID := orccontext.GetContextWorkbenchID()
+		if workbenchID == "" {
+			return fmt.Errorf("no workbench context detected\nHint: Run from a workbench directory")
+		}
+
+		n, err := wire.TaskService().RefreshClaims(NewContext(), workbenchID)
+		if err != nil {
+			return fmt.Errorf("failed to refresh claims: %w", err)
+		}
+		fmt.Printf("✓ Refreshed %d claim(s) for %s\n", n, workbenchID)
+		return nil
+	},
+}
+
+// claimHolder names the workbench holding a claim.
+func claimHolder(c *primary.TaskClaim) string {
+	if c.WorkbenchID == "" {
+		return "(unassigned)"
+	}
+	return c.WorkbenchID
+}
+
+// claimTTL reads the claim TTL from --ttl, falling back to ORC_CLAIM_TTL.
+// Zero means the default TTL.
+func claimTTL(cmd *cobra.Command) (time.Duration, error) {
+	if cmd.Flags().Changed("ttl") {
+		return cmd.Flags().GetDuration("ttl")
+	}
+	env := os.Getenv(config.EnvClaimTTL)
+	if env == "" {
+		return 0, nil
+	}
+	ttl, err := time.ParseDuration(env)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", config.EnvClaimTTL, env, err)
+	}
+	return ttl, nil
+}
+
+func init() {
+	taskClaimsCmd.Flags().Bool("stale", false, "Only show expired claims")
+	taskClaimsCmd.Flags().Duration("ttl", 0, "Claim lifetime without a heartbeat (default 2h)")
+
+	taskReapCmd.Flags().Duration("ttl", 0, "Claim lifetime without a heartbeat (default 2h)")
+	taskReapCmd.Flags().Bool("dry-run", false, "Show what would be released without releasing")
+
+	taskCmd.AddCommand(taskClaimsCmd)
+	taskCmd.AddCommand(taskReapCmd)
+	taskCmd.AddCommand(taskHeartbeatCmd)
+}
@@ -0,0 +1,159 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/config"
+	"github.com/example/orc/internal/db"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// telemetryStart is when this invocation started; zero when telemetry is off.
+var telemetryStart time.Time
+
+// StartTelemetry begins timing this invocation and profiling its ledger
+// queries when ORC_TELEMETRY=1. Call it before anything opens the ledger.
+func StartTelemetry() {
+	if os.Getenv(config.EnvTelemetry) != "1" {
+		return
+	}
+	telemetryStart = time.Now()
+	db.EnableProfiling()
+}
+
+// FinishTelemetry records the invocation started by StartTelemetry. Commands
+// that never touched the ledger (help, version, completion) and --plan runs
+// are not recorded, and a failure to record is ignored: telemetry never
+// fails a command.
+func FinishTelemetry(cmd *cobra.Command, cmdErr error) {
+	if telemetryStart.IsZero() || cmd == nil || db.Simulating() || Lightweight(cmd) {
+		return
+	}
+	elapsed := time.Since(telemetryStart)
+	profile := db.ProfileSnapshot()
+	if profile.Queries == 0 {
+		return
+	}
+
+	slow := make([]primary.SlowQuery, len(profile.Slowest))
+	for i, q := range profile.Slowest {
+		slow[i] = primary.SlowQuery{SQL: q.SQL, MS: float64(q.Duration.Microseconds()) / 1000}
+	}
+	_ = wire.TelemetryService().RecordCommand(NewContext(), primary.RecordCommandRequest{
+		Command:     cmd.CommandPath(),
+		DurationMS:  elapsed.Milliseconds(),
+		QueryCount:  profile.Queries,
+		QueryMS:     profile.QueryTime.Milliseconds(),
+		SlowQueries: slow,
+		Failed:      cmdErr != nil,
+	})
+}
+
+func debugPerfCmd() *cobra.Command {
+	var top int
+	var days int
+	var command string
+
+	cmd := &cobra.Command{
+		Use:   "perf",
+		Short: "Summarize command timings recorded by opt-in telemetry",
+		Long: `Summarize where orc spends its time, from timings recorded locally while
+ORC_TELEMETRY=1 is set. Each invocation records its duration, how many
+ledger queries it ran and how long they took, and its slowest queries
+(SQL text only, never arguments). Nothing leaves the machine, and
+invocations older than 30 days are pruned.
+
+Shows commands by total time spent, with median and 95th percentile
+durations and the share spent in queries, then the queries that most often
+rank among an invocation's slowest.
+
+Enable recording in your shell profile:
+  export ORC_TELEMETRY=1
+
+Examples:
+  orc debug perf
+  orc debug perf --top 20
+  orc debug perf --days 1 --command "orc summary"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := primary.PerfReportRequest{Top: top, Command: command}
+			if days > 0 {
+				req.Since = time.Now().AddDate(0, 0, -days).UTC().Format(time.RFC3339)
+			}
+
+			report, err := wire.TelemetryService().PerfReport(NewContext(), req)
+			if err != nil {
+				return fmt.Errorf("failed to build perf report: %w", err)
+			}
+			if report.Invocations == 0 {
+				fmt.Println("No invocations recorded.")
+				if os.Getenv(config.EnvTelemetry) != "1" {
+					fmt.Printf("Hint: Telemetry is off; set %s=1 to start recording\n", config.EnvTelemetry)
+				}
+				return nil
+			}
+
+			renderPerfReport(os.Stdout, report)
+			return nil
+		},
+	}
+
+	cmd.Flags().IntVar(&top, "top", 10, "Entries per section (0 for all)")
+	cmd.Flags().IntVar(&days, "days", 0, "Only invocations from the last N days (default: all kept)")
+	cmd.Flags().StringVar(&command, "command", "", "Only this command path (e.g. \"orc summary\")")
+
+	return cmd
+}
+
+// renderPerfReport prints the command and slow query sections of a report.
+func renderPerfReport(out io.Writer, report *primary.PerfReport) {
+	fmt.Fprintf(out, "%s recorded\n\n", pluralize(report.Invocations, "invocation", "invocations"))
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "COMMAND\tRUNS\tTOTAL\tP50\tP95\tMAX\tQUERIES\tIN DB\tFAILED")
+	for _, c := range report.Commands {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\t%.0f\t%.0f%%\t%s\n",
+			c.Command, c.Runs, formatMS(c.TotalMS), formatMS(c.P50MS), formatMS(c.P95MS), formatMS(c.MaxMS),
+			c.MeanQueries, c.DBShare*100, orDash(failureCount(c.Failures)))
+	}
+	w.Flush()
+
+	if len(report.Queries) == 0 {
+		return
+	}
+	fmt.Fprintln(out, "\nSlowest queries:")
+	w = tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  SEEN\tTOTAL\tMAX\tCOMMANDS\tSQL")
+	for _, q := range report.Queries {
+		fmt.Fprintf(w, "  %d\t%s\t%s\t%s\t%s\n",
+			q.Seen, formatMS(q.TotalMS), formatMS(q.MaxMS), truncate(strings.Join(q.Commands, ", "), 30), truncate(q.SQL, 80))
+	}
+	w.Flush()
+}
+
+// formatMS renders milliseconds compactly: "850µs", "42ms", "1.3s".
+func formatMS(ms float64) string {
+	switch {
+	case ms < 1:
+		return fmt.Sprintf("%.0fµs", ms*1000)
+	case ms < 1000:
+		return fmt.Sprintf("%.0fms", ms)
+	default:
+		return fmt.Sprintf("%.1fs", ms/1000)
+	}
+}
+
+func failureCount(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d", n)
+}
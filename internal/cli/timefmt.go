@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// Timestamps reach the CLI as the strings the ledger stores: RFC3339 from
+// the repositories, or SQLite's "YYYY-MM-DD HH:MM:SS" (UTC) from raw
+// columns. Every command renders them through the helpers below, so the
+// global --utc and --absolute flags behave the same everywhere.
+
+var (
+	displayZone     = time.Local // --utc switches to time.UTC
+	displayAbsolute bool         // --absolute: dates instead of "2h ago"
+	displayNow      = time.Now   // Overridden in tests
+)
+
+// ConfigureTimeDisplay applies the global --utc and --absolute flags.
+func ConfigureTimeDisplay(cmd *cobra.Command) {
+	if utc, _ := cmd.Flags().GetBool("utc"); utc {
+		displayZone = time.UTC
+	}
+	displayAbsolute, _ = cmd.Flags().GetBool("absolute")
+}
+
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// parseTimestamp parses a stored timestamp. Values without a zone are UTC,
+// which is how SQLite's CURRENT_TIMESTAMP writes them.
+func parseTimestamp(ts string) (time.Time, bool) {
+	ts = strings.TrimSpace(ts)
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, ts); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// formatTime renders a timestamp for show views: "2026-10-03 11:30 (2h ago)",
+// or "2026-10-03 11:30:00" with --absolute. Values that don't parse are
+// shown as stored.
+func formatTime(ts string) string {
+	t, ok := parseTimestamp(ts)
+	if !ok {
+		return ts
+	}
+	t = t.In(displayZone)
+	if displayAbsolute {
+		return t.Format("2006-01-02 15:04:05")
+	}
+	return fmt.Sprintf("%s (%s)", t.Format("2006-01-02 15:04"), relativeTime(t, displayNow()))
+}
+
+// formatWhen renders a timestamp for list columns: "2h ago", or the date
+// and time with --absolute.
+func formatWhen(ts string) string {
+	t, ok := parseTimestamp(ts)
+	if !ok {
+		return ts
+	}
+	t = t.In(displayZone)
+	if displayAbsolute {
+		return t.Format("2006-01-02 15:04")
+	}
+	return relativeTime(t, displayNow())
+}
+
+// formatTimestamp renders a timestamp in full, for logs and event trails
+// where the exact time matters more than its age.
+func formatTimestamp(ts string) string {
+	t, ok := parseTimestamp(ts)
+	if !ok {
+		return ts
+	}
+	return t.In(displayZone).Format("2006-01-02 15:04:05")
+}
+
+// formatClock renders a timestamp as wall-clock time, e.g. for lock expiry.
+func formatClock(ts string) string {
+	t, ok := parseTimestamp(ts)
+	if !ok {
+		return ts
+	}
+	return t.In(displayZone).Format("15:04")
+}
+
+// relativeTime describes t relative to now: "just now", "5m ago", "2h ago",
+// "3d ago", or "in 10m" for future times. Beyond 30 days it gives the date.
+func relativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	suffix, prefix := " ago", ""
+	if d < 0 {
+		d, suffix, prefix = -d, "", "in "
+	}
+
+	var span string
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		span = fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		span = fmt.Sprintf("%dh", int(d.Hours()))
+	case d < 30*24*time.Hour:
+		span = fmt.Sprintf("%dd", int(d.Hours()/24))
+	default:
+		return t.Format("2006-01-02")
+	}
+	return prefix + span + suffix
+}
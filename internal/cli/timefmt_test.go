@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"testing"
+	"time"
+)
+
+// pinTimeDisplay fixes "now" at 2026-10-05 09:00 UTC and shows times in UTC,
+// so output with relative times is stable.
+func pinTimeDisplay(t *testing.T) {
+	t.Helper()
+	zone, now, absolute := displayZone, displayNow, displayAbsolute
+	t.Cleanup(func() { displayZone, displayNow, displayAbsolute = zone, now, absolute })
+
+	displayZone = time.UTC
+	displayNow = func() time.Time { return time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC) }
+	displayAbsolute = false
+}
+
+func TestRelativeTime(t *testing.T) {
+	now := time.Date(2026, 10, 5, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		t    time.Time
+		want string
+	}{
+		{now.Add(-20 * time.Second), "just now"},
+		{now.Add(-5 * time.Minute), "5m ago"},
+		{now.Add(-150 * time.Minute), "2h ago"},
+		{now.Add(-3 * 24 * time.Hour), "3d ago"},
+		{now.Add(10 * time.Minute), "in 10m"},
+		{now.Add(-45 * 24 * time.Hour), "2026-08-21"},
+	}
+	for _, tt := range tests {
+		if got := relativeTime(tt.t, now); got != tt.want {
+			t.Errorf("relativeTime(%v) = %q, want %q", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	want := time.Date(2026, 10, 3, 9, 30, 0, 0, time.UTC)
+	for _, ts := range []string{"2026-10-03T09:30:00Z", "2026-10-03 09:30:00", "2026-10-03T11:30:00+02:00"} {
+		got, ok := parseTimestamp(ts)
+		if !ok || !got.Equal(want) {
+			t.Errorf("parseTimestamp(%q) = %v, %v", ts, got, ok)
+		}
+	}
+	if _, ok := parseTimestamp("yesterday"); ok {
+		t.Error("expected an unparseable timestamp to fail")
+	}
+}
+
+func TestFormatTime(t *testing.T) {
+	pinTimeDisplay(t)
+
+	if got := formatTime("2026-10-05T07:00:00Z"); got != "2026-10-05 07:00 (2h ago)" {
+		t.Errorf("formatTime() = %q", got)
+	}
+	if got := formatWhen("2026-10-05T07:00:00Z"); got != "2h ago" {
+		t.Errorf("formatWhen() = %q", got)
+	}
+	if got := formatTime("not a time"); got != "not a time" {
+		t.Errorf("formatTime() should pass unparseable values through, got %q", got)
+	}
+
+	displayAbsolute = true
+	if got := formatTime("2026-10-05 07:00:00"); got != "2026-10-05 07:00:00" {
+		t.Errorf("formatTime() with --absolute = %q", got)
+	}
+	if got := formatWhen("2026-10-05T07:00:00Z"); got != "2026-10-05 07:00" {
+		t.Errorf("formatWhen() with --absolute = %q", got)
+	}
+
+	displayZone = time.FixedZone("CEST", 2*60*60)
+	if got := formatTimestamp("2026-10-05T07:00:00Z"); got != "2026-10-05 09:00:00" {
+		t.Errorf("formatTimestamp() in a local zone = %q", got)
+	}
+}
@@ -4,10 +4,12 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -28,6 +30,8 @@ func TmuxCmd() *cobra.Command {
 		tmuxApplyCmd(),
 		tmuxEnrichCmd(),
 		tmuxArchiveWorkbenchCmd(),
+		tmuxStatusLineCmd(),
+		tmuxWatchCmd(),
 	)
 
 	return cmd
@@ -103,7 +107,8 @@ Actions performed:
 - Relocate guest panes to -imps windows
 - Prune dead panes in -imps windows
 - Kill empty -imps windows (all panes dead)
-- Reconcile layout (main-vertical, 50% main-pane-width)
+- Reconcile layout (main-vertical, 50% main-pane-width, remain-on-exit so
+  crashed panes stay for 'orc tmux watch')
 - Apply ORC enrichment (bindings, pane titles)
 
 Without --yes, shows a plan and prompts for confirmation.
@@ -143,6 +148,7 @@ Examples:
 						Path:       wb.Path,
 						ID:         wb.ID,
 						WorkshopID: workshopID,
+						Env:        resolveWorkbenchEnv(wb.ID),
 					})
 				}
 			}
@@ -221,6 +227,8 @@ func printApplyPlan(plan *wire.ApplyPlan, workshopID string) {
 		} else {
 			if ws.Healthy {
 				fmt.Printf("Window: %s (%d panes, healthy)\n", ws.Name, ws.PaneCount)
+			} else if ws.DeadPanes > 0 {
+				fmt.Printf("Window: %s (%d panes, %d dead) -> orc tmux watch %s --once\n", ws.Name, ws.PaneCount, ws.DeadPanes, workshopID)
 			} else {
 				fmt.Printf("Window: %s (%d panes)\n", ws.Name, ws.PaneCount)
 			}
@@ -302,6 +310,129 @@ Examples:
 	return cmd
 }
 
+func tmuxWatchCmd() *cobra.Command {
+	var (
+		interval time.Duration
+		once     bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "watch [workshop-id]",
+		Short: "Respawn crashed workbench panes",
+		Long: `Watch a workshop's tmux session and respawn workbench panes whose
+process died (an agent that exited, a shell that was killed), so factories
+keep running unattended.
+
+Workbench windows keep dead panes in place (remain-on-exit, set by
+'orc tmux apply'). The watcher finds them by @pane_role and restarts them
+with their original command: vim and the shell with the workbench's
+environment, the goblin pane with orc connect. Guest panes are left alone.
+
+Each respawn is recorded in the workshop log as a change to the workbench's
+pane.<role> field, so 'orc log tail --entity BENCH-003' shows a bench's
+crash history.
+
+If no workshop ID is provided, uses current workshop from context.
+
+Examples:
+  orc tmux watch WORK-001
+  orc tmux watch --interval 10s
+  orc tmux watch WORK-001 --once    # Respawn what is dead now and exit`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			var workshopID string
+			if len(args) > 0 {
+				workshopID = args[0]
+			} else {
+				cwd, err := os.Getwd()
+				if err != nil {
+					return fmt.Errorf("failed to get current directory: %w", err)
+				}
+				workbench, err := wire.WorkbenchService().GetWorkbenchByPath(ctx, cwd)
+				if err != nil {
+					return fmt.Errorf("no workshop ID provided and not in a workbench directory")
+				}
+				workshopID = workbench.WorkshopID
+			}
+			if interval < time.Second {
+				return fmt.Errorf("--interval must be at least 1s")
+			}
+
+			workshop, err := wire.WorkshopService().GetWorkshop(ctx, workshopID)
+			if err != nil {
+				return fmt.Errorf("workshop not found: %s", workshopID)
+			}
+			gotmuxAdapter, err := wire.NewGotmuxAdapter()
+			if err != nil {
+				return fmt.Errorf("failed to create tmux adapter: %w", err)
+			}
+
+			sweep := func() error {
+				workbenches, err := wire.WorkbenchService().ListWorkbenches(ctx, primary.WorkbenchFilters{WorkshopID: workshopID})
+				if err != nil {
+					return fmt.Errorf("failed to list workbenches: %w", err)
+				}
+				var desired []wire.DesiredWorkbench
+				for _, wb := range workbenches {
+					if wb.Status == "active" {
+						desired = append(desired, wire.DesiredWorkbench{
+							Name:       wb.Name,
+							Path:       wb.Path,
+							ID:         wb.ID,
+							WorkshopID: workshopID,
+							Env:        resolveWorkbenchEnv(wb.ID),
+						})
+					}
+				}
+
+				respawned, err := gotmuxAdapter.RespawnDeadPanes(workshop.Name, desired)
+				reportRespawns(os.Stdout, workshopID, respawned, func(req primary.PaneRespawn) error {
+					return wire.LogService().RecordPaneRespawn(ctx, req)
+				})
+				return err
+			}
+
+			if once {
+				return sweep()
+			}
+
+			fmt.Printf("👀 Watching %s every %s (Ctrl-C to stop)\n", workshop.Name, interval)
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				if err := sweep(); err != nil {
+					fmt.Printf("⚠️  %v\n", err)
+				}
+				<-ticker.C
+			}
+		},
+	}
+
+	cmd.Flags().DurationVar(&interval, "interval", time.Minute, "How often to check for dead panes")
+	cmd.Flags().BoolVar(&once, "once", false, "Check once and exit")
+	return cmd
+}
+
+// reportRespawns prints each respawned pane and records it in the workshop
+// log. A failure to record is printed; the pane is already running again.
+func reportRespawns(w io.Writer, workshopID string, respawned []wire.DeadPane, record func(primary.PaneRespawn) error) {
+	for _, p := range respawned {
+		fmt.Fprintf(w, "⟳ %s respawned %s in %s (%s): %s\n",
+			displayNow().In(displayZone).Format("15:04"), p.Role, p.WindowName, p.WorkbenchID, p.Reason())
+		err := record(primary.PaneRespawn{
+			WorkshopID:  workshopID,
+			WorkbenchID: p.WorkbenchID,
+			Role:        p.Role,
+			Reason:      p.Reason(),
+		})
+		if err != nil {
+			fmt.Fprintf(w, "⚠️  Failed to record respawn of %s pane in %s: %v\n", p.Role, p.WorkbenchID, err)
+		}
+	}
+}
+
 // archiveWorkbenchRunE is the RunE function for the archive-workbench command.
 // Extracted for testability — the getwd and execCommand parameters allow injection.
 func archiveWorkbenchRunE(ctx context.Context, getwd func() (string, error), execCommand func(name string, arg ...string) *exec.Cmd) error {
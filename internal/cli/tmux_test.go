@@ -1,9 +1,14 @@
 package cli
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
 )
 
 // TestTmuxArchiveWorkbenchCmdStructure verifies the archive-workbench subcommand
@@ -43,3 +48,34 @@ func TestArchiveWorkbenchRunE_GetwdError(t *testing.T) {
 		t.Errorf("error = %q, want prefix %q", err.Error(), want)
 	}
 }
+
+func TestReportRespawns(t *testing.T) {
+	pinTimeDisplay(t)
+
+	var recorded []primary.PaneRespawn
+	var out bytes.Buffer
+	reportRespawns(&out, "WORK-001", []wire.DeadPane{
+		{WindowName: "api", Role: "goblin", WorkbenchID: "BENCH-003", ExitStatus: 1},
+		{WindowName: "web", Role: "shell", WorkbenchID: "BENCH-004", ExitSignal: 9},
+	}, func(req primary.PaneRespawn) error {
+		recorded = append(recorded, req)
+		if req.Role == "shell" {
+			return fmt.Errorf("database is locked")
+		}
+		return nil
+	})
+
+	got := out.String()
+	for _, want := range []string{
+		"⟳ 09:00 respawned goblin in api (BENCH-003): exited 1",
+		"⟳ 09:00 respawned shell in web (BENCH-004): killed by signal 9",
+		"Failed to record respawn of shell pane in BENCH-004: database is locked",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+	if len(recorded) != 2 || recorded[0].WorkshopID != "WORK-001" || recorded[0].Reason != "exited 1" {
+		t.Errorf("unexpected records: %+v", recorded)
+	}
+}
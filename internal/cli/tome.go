@@ -1,8 +1,12 @@
 package cli
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
@@ -124,10 +128,11 @@ var tomeShowCmd = &cobra.Command{
 		if tome.Pinned {
 			fmt.Printf("Pinned: yes\n")
 		}
-		fmt.Printf("Created: %s\n", tome.CreatedAt)
+		fmt.Printf("Created: %s\n", formatTime(tome.CreatedAt))
 		if tome.ClosedAt != "" {
-			fmt.Printf("Closed: %s\n", tome.ClosedAt)
+			fmt.Printf("Closed: %s\n", formatTime(tome.ClosedAt))
 		}
+		printCharterSection(tome.Charter)
 
 		// Show notes in this tome
 		notes, err := wire.TomeService().GetTomeNotes(ctx, tomeID)
@@ -146,7 +151,7 @@ var tomeShowCmd = &cobra.Command{
 			}
 		}
 
-		return nil
+		return printCommentsSection(ctx, tomeID)
 	},
 }
 
@@ -250,9 +255,194 @@ var tomeDeleteCmd = &cobra.Command{
 	},
 }
 
+var tomeExportCmd = &cobra.Command{
+	Use:   "export [tome-id]",
+	Short: "Export a tome and its notes as a markdown book",
+	Long: `Export a tome and its notes as a single markdown document, suitable
+for committing into a docs repository.
+
+The charter becomes the introduction and each note a numbered section
+(notes ordered with 'orc tome reorder' first, then pinned notes, then
+oldest first). YAML front matter records the
+tome and the provenance of every note: ID, type, status, and timestamps.
+
+Examples:
+  orc tome export TOME-007 > docs/auth-research.md
+  orc tome export TOME-007 --output docs/auth-research.md`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		format, _ := cmd.Flags().GetString("format")
+		output, _ := cmd.Flags().GetString("output")
+
+		doc, err := wire.TomeService().ExportTome(ctx, primary.ExportTomeRequest{
+			TomeID: args[0],
+			Format: format,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to export tome: %w", err)
+		}
+
+		if output == "" {
+			fmt.Print(doc)
+			return nil
+		}
+		if err := writeFile(output, []byte(doc)); err != nil {
+			return fmt.Errorf("failed to write %s: %w", output, err)
+		}
+		fmt.Printf("✓ Tome %s exported to %s\n", args[0], output)
+		return nil
+	},
+}
+
+var tomeReorderCmd = &cobra.Command{
+	Use:   "reorder [tome-id] [note-id]",
+	Short: "Move a note within a tome's reading order",
+	Long: `Move a note within a tome's reading order, used by 'orc tome show'
+and 'orc tome export'.
+
+The note moves right after --after, right before --before, or to the front
+with neither. Notes never placed follow the ordered ones, newest first;
+reordering places every note in the tome.
+
+Examples:
+  orc tome reorder TOME-004 NOTE-003 --after NOTE-007
+  orc tome reorder TOME-004 NOTE-012 --before NOTE-003
+  orc tome reorder TOME-004 NOTE-012`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		afterID, _ := cmd.Flags().GetString("after")
+		beforeID, _ := cmd.Flags().GetString("before")
+
+		err := wire.TomeService().ReorderTomeNote(NewContext(), primary.ReorderTomeNoteRequest{
+			TomeID:   args[0],
+			NoteID:   args[1],
+			AfterID:  afterID,
+			BeforeID: beforeID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to reorder note: %w", err)
+		}
+
+		switch {
+		case afterID != "":
+			fmt.Printf("✓ %s moved after %s in %s\n", args[1], afterID, args[0])
+		case beforeID != "":
+			fmt.Printf("✓ %s moved before %s in %s\n", args[1], beforeID, args[0])
+		default:
+			fmt.Printf("✓ %s moved to the front of %s\n", args[1], args[0])
+		}
+		return nil
+	},
+}
+
+var tomeCurateCmd = &cobra.Command{
+	Use:   "curate",
+	Short: "File a commission's unfiled notes into tomes interactively",
+	Long: `Walk through the notes filed directly under a commission (in no
+shipment or tome) and file each into one of the commission's open tomes.
+
+For each note, answer with a tome's number or ID, press Enter to skip it,
+or q to stop. Filed notes follow the tome's ordered notes.
+
+Examples:
+  orc tome curate
+  orc tome curate --commission COMM-001`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		commissionID, _ := cmd.Flags().GetString("commission")
+		if commissionID == "" {
+			commissionID = orccontext.GetContextCommissionID()
+			if commissionID == "" {
+				return fmt.Errorf("no commission context detected\nHint: Use --commission flag or run from a workbench directory")
+			}
+		}
+
+		notes, err := wire.NoteService().GetNotesByContainer(ctx, "commission", commissionID)
+		if err != nil {
+			return fmt.Errorf("failed to get unfiled notes: %w", err)
+		}
+		if len(notes) == 0 {
+			fmt.Printf("No unfiled notes in %s.\n", commissionID)
+			return nil
+		}
+		tomes, err := wire.TomeService().ListTomes(ctx, primary.TomeFilters{CommissionID: commissionID, Status: "open"})
+		if err != nil {
+			return fmt.Errorf("failed to list tomes: %w", err)
+		}
+		if len(tomes) == 0 {
+			return fmt.Errorf("%s has no open tomes\nHint: orc tome create \"Title\" --commission %s", commissionID, commissionID)
+		}
+
+		filed, err := curateNotes(cmd.InOrStdin(), cmd.OutOrStdout(), notes, tomes, func(noteID, tomeID string) error {
+			return wire.NoteService().MoveNote(ctx, primary.MoveNoteRequest{NoteID: noteID, ToTomeID: tomeID})
+		})
+		fmt.Printf("\n✓ Filed %d of %d note(s)\n", filed, len(notes))
+		return err
+	},
+}
+
+// curateNotes prompts for a tome for each note and files it with file.
+// It returns how many notes were filed; input ending early stops the walk.
+func curateNotes(in io.Reader, out io.Writer, notes []*primary.Note, tomes []*primary.Tome, file func(noteID, tomeID string) error) (int, error) {
+	fmt.Fprintln(out, "Tomes:")
+	known := make(map[string]bool, len(tomes))
+	for i, t := range tomes {
+		fmt.Fprintf(out, "  %d) %s: %s\n", i+1, t.ID, t.Title)
+		known[t.ID] = true
+	}
+
+	scanner := bufio.NewScanner(in)
+	filed := 0
+	for i, note := range notes {
+		typeStr := ""
+		if note.Type != "" {
+			typeStr = fmt.Sprintf(" [%s]", note.Type)
+		}
+		fmt.Fprintf(out, "\n(%d/%d) 📝 %s: %s%s\n", i+1, len(notes), note.ID, note.Title, typeStr)
+
+		for {
+			fmt.Fprint(out, "File into (number or ID, Enter to skip, q to quit): ")
+			if !scanner.Scan() {
+				fmt.Fprintln(out)
+				return filed, scanner.Err()
+			}
+			answer := strings.TrimSpace(scanner.Text())
+			if answer == "" {
+				break
+			}
+			if answer == "q" {
+				return filed, nil
+			}
+
+			tomeID := ""
+			if known[answer] {
+				tomeID = answer
+			}
+			if n, err := strconv.Atoi(answer); err == nil && n >= 1 && n <= len(tomes) {
+				tomeID = tomes[n-1].ID
+			}
+			if tomeID == "" {
+				fmt.Fprintf(out, "  no tome %q\n", answer)
+				continue
+			}
+
+			if err := file(note.ID, tomeID); err != nil {
+				return filed, fmt.Errorf("failed to file %s: %w", note.ID, err)
+			}
+			fmt.Fprintf(out, "  ✓ %s → %s\n", note.ID, tomeID)
+			filed++
+			break
+		}
+	}
+	return filed, nil
+}
+
 func init() {
 	// tome create flags
 	tomeCreateCmd.Flags().StringP("commission", "c", "", "Commission ID (defaults to context)")
+	inferFromFocus(tomeCreateCmd, "commission")
 	tomeCreateCmd.Flags().StringP("description", "d", "", "Tome description")
 
 	// tome list flags
@@ -263,6 +453,17 @@ func init() {
 	tomeUpdateCmd.Flags().String("title", "", "New title")
 	tomeUpdateCmd.Flags().StringP("description", "d", "", "New description")
 
+	// tome export flags
+	tomeExportCmd.Flags().String("format", "markdown-book", "Export format (markdown-book)")
+	tomeExportCmd.Flags().StringP("output", "o", "", "Write to this file instead of stdout")
+
+	// tome reorder flags
+	tomeReorderCmd.Flags().String("after", "", "Place the note right after this note")
+	tomeReorderCmd.Flags().String("before", "", "Place the note right before this note")
+
+	// tome curate flags
+	tomeCurateCmd.Flags().StringP("commission", "c", "", "Commission ID (defaults to context)")
+
 	// Register subcommands
 	tomeCmd.AddCommand(tomeCreateCmd)
 	tomeCmd.AddCommand(tomeListCmd)
@@ -271,7 +472,25 @@ func init() {
 	tomeCmd.AddCommand(tomeUpdateCmd)
 	tomeCmd.AddCommand(tomePinCmd)
 	tomeCmd.AddCommand(tomeUnpinCmd)
+	tomeCmd.AddCommand(aliasCmd("tome", "TOME-003"))
+	tomeCmd.AddCommand(unaliasCmd("tome"))
 	tomeCmd.AddCommand(tomeDeleteCmd)
+	tomeCmd.AddCommand(tomeExportCmd)
+	tomeCmd.AddCommand(tomeReorderCmd)
+	tomeCmd.AddCommand(tomeCurateCmd)
+	tomeCmd.AddCommand(newCharterCmd(charterTarget{
+		entity: "tome",
+		get: func(id string) (string, string, error) {
+			tome, err := wire.TomeService().GetTome(NewContext(), id)
+			if err != nil {
+				return "", "", err
+			}
+			return tome.Title, tome.Charter, nil
+		},
+		set: func(id, charter string) error {
+			return wire.TomeService().SetTomeCharter(NewContext(), id, charter)
+		},
+	}))
 }
 
 // TomeCmd returns the tome command
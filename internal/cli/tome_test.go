@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func TestCurateNotes(t *testing.T) {
+	notes := []*primary.Note{
+		{ID: "NOTE-001", Title: "Keychain APIs", Type: "learning"},
+		{ID: "NOTE-002", Title: "Token TTL"},
+		{ID: "NOTE-003", Title: "Unrelated"},
+		{ID: "NOTE-004", Title: "Never reached"},
+	}
+	tomes := []*primary.Tome{
+		{ID: "TOME-001", Title: "Auth research"},
+		{ID: "TOME-002", Title: "Ops"},
+	}
+
+	filed := map[string]string{}
+	in := strings.NewReader("2\nTOME-009\nTOME-001\n\nq\n")
+	var out bytes.Buffer
+
+	n, err := curateNotes(in, &out, notes, tomes, func(noteID, tomeID string) error {
+		filed[noteID] = tomeID
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("curateNotes failed: %v", err)
+	}
+	if n != 2 || filed["NOTE-001"] != "TOME-002" || filed["NOTE-002"] != "TOME-001" || len(filed) != 2 {
+		t.Errorf("filed %d: %v", n, filed)
+	}
+	for _, want := range []string{"1) TOME-001: Auth research", "(1/4) 📝 NOTE-001: Keychain APIs [learning]", `no tome "TOME-009"`} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output missing %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestCurateNotes_FileError(t *testing.T) {
+	notes := []*primary.Note{{ID: "NOTE-001"}}
+	tomes := []*primary.Tome{{ID: "TOME-001"}}
+
+	n, err := curateNotes(strings.NewReader("1\n"), &bytes.Buffer{}, notes, tomes, func(noteID, tomeID string) error {
+		return errors.New("note is closed")
+	})
+	if n != 0 || err == nil || !strings.Contains(err.Error(), "failed to file NOTE-001") {
+		t.Errorf("expected file error, got %d, %v", n, err)
+	}
+}
@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+// transitionsCmd builds the `transitions` subcommand showing an entity's
+// status state machine.
+func transitionsCmd(entityType string, list func() []primary.StatusTransition) *cobra.Command {
+	return &cobra.Command{
+		Use:   "transitions",
+		Short: fmt.Sprintf("Show the legal %s status transitions", entityType),
+		Long: fmt.Sprintf(`Show the %s status state machine.
+
+Status changes not listed here are refused unless --force is given;
+forced changes are marked [forced] in 'orc log'.`, entityType),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			printTransitions(os.Stdout, entityType, list())
+			return nil
+		},
+	}
+}
+
+// printTransitions renders transitions as "from ──▶ to   reason" rows.
+func printTransitions(w io.Writer, entityType string, transitions []primary.StatusTransition) {
+	fmt.Fprintf(w, "%s status transitions:\n\n", entityType)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, t := range transitions {
+		fmt.Fprintf(tw, "  %s\t──▶ %s\t%s\n", t.From, t.To, t.Reason)
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Any other change requires --force and is logged as forced.")
+}
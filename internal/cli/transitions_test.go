@@ -0,0 +1,24 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/ports/primary"
+)
+
+func TestPrintTransitions(t *testing.T) {
+	var buf bytes.Buffer
+	printTransitions(&buf, "shipment", []primary.StatusTransition{
+		{From: "draft", To: "ready", Reason: "planning done"},
+		{From: "in-progress", To: "closed", Reason: "all work done"},
+	})
+
+	out := buf.String()
+	for _, want := range []string{"shipment status transitions:", "draft        ──▶ ready", "in-progress  ──▶ closed", "--force"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+var undoCmd = &cobra.Command{
+	Use:   "undo [log-id]",
+	Short: "Revert your most recent mutation",
+	Long: `Revert the most recent mutation made by the current actor.
+
+Undo works from the workshop activity log, so it only covers changes made
+from a workbench. Supported changes: task status, task tag, shipment status,
+shipment assignment, and note status (including note close).
+
+An action is only undone if the field still holds the value it set;
+changes made since then are never overwritten. Pass a log ID to undo an
+older action when the most recent one can't be reverted.
+
+Examples:
+  orc undo              # revert the most recent action
+  orc undo --list       # show the last 10 undoable actions
+  orc undo WL-0042      # revert a specific action`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := NewContext()
+		list, _ := cmd.Flags().GetBool("list")
+		limit, _ := cmd.Flags().GetInt("limit")
+
+		if list {
+			if len(args) > 0 {
+				return fmt.Errorf("--list does not take a log ID")
+			}
+			if limit <= 0 {
+				limit = 10
+			}
+			actions, err := wire.UndoService().ListUndoable(ctx, limit)
+			if err != nil {
+				return err
+			}
+			if len(actions) == 0 {
+				fmt.Println("Nothing to undo.")
+				return nil
+			}
+			for _, a := range actions {
+				marker := "↩"
+				if !a.Safe {
+					marker = "✗"
+				}
+				fmt.Printf("%s %s | %s | %s\n", marker, a.LogID, formatTimestamp(a.Timestamp), describeUndoableAction(a))
+				if !a.Safe {
+					fmt.Printf("    %s\n", a.Reason)
+				}
+			}
+			return nil
+		}
+
+		logID := ""
+		if len(args) > 0 {
+			logID = args[0]
+		}
+		action, err := wire.UndoService().Undo(ctx, logID)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("↩ Undid %s: %s\n", action.LogID, describeUndoableAction(action))
+		return nil
+	},
+}
+
+// describeUndoableAction renders an action as "task TASK-012 status open → closed".
+func describeUndoableAction(a *primary.UndoableAction) string {
+	return fmt.Sprintf("%s %s %s %s → %s", a.EntityType, a.EntityID, a.FieldName, undoValue(a.OldValue), undoValue(a.NewValue))
+}
+
+func undoValue(v string) string {
+	if v == "" {
+		return "(none)"
+	}
+	return v
+}
+
+func init() {
+	undoCmd.Flags().Bool("list", false, "List recent undoable actions instead of undoing")
+	undoCmd.Flags().IntP("limit", "n", 10, "Number of actions to list with --list")
+}
+
+// UndoCmd returns the undo command
+func UndoCmd() *cobra.Command {
+	return undoCmd
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/example/orc/internal/db"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// destructiveCommands are refused while the ledger was written by a newer orc:
+// an older binary can't see newer columns and tables, so deleting or rewriting
+// rows could silently drop data it doesn't know about.
+var destructiveCommands = map[string]bool{
+	"delete":   true,
+	"remove":   true,
+	"archive":  true,
+	"prune":    true,
+	"reset":    true,
+	"merge":    true,
+	"undo":     true,
+	"maintain": true,
+	"teardown": true,
+}
+
+// UpgradeCmd returns the upgrade command
+func UpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Update orc to the latest release",
+		Long: `Check for a newer orc and install it over the running binary.
+
+Releases are commits on the default branch of the orc source checkout's origin
+($ORC_SOURCE_DIR, default ~/src/orc). The release is built from a clean
+export of the commit, so the checkout's working tree is left alone. The new
+binary is smoke-tested before it atomically replaces the old one, which is
+kept beside it as <path>.previous.
+
+Run this on every machine that shares a ledger: orc refuses destructive
+commands when the ledger was written by a newer version.
+
+Examples:
+  orc upgrade --check   # Report whether a newer release exists
+  orc upgrade           # Install it
+  orc upgrade --force   # Replace a dev build or a build from another branch`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			checkOnly, _ := cmd.Flags().GetBool("check")
+			force, _ := cmd.Flags().GetBool("force")
+
+			service, err := wire.UpgradeService()
+			if err != nil {
+				return err
+			}
+
+			var status *primary.UpgradeStatus
+			if checkOnly {
+				status, err = service.CheckUpgrade(ctx)
+			} else {
+				status, err = service.Upgrade(ctx, force)
+			}
+			if err != nil {
+				return err
+			}
+
+			latest := fmt.Sprintf("%s %s (%s)", shortSHA(status.LatestCommit), status.LatestSubject, formatWhen(status.LatestCommittedAt))
+			switch {
+			case status.UpToDate:
+				fmt.Printf("✓ orc is up to date: %s\n", latest)
+			case status.Installed:
+				fmt.Printf("✓ Upgraded orc %s → %s\n", status.CurrentCommit, latest)
+			default:
+				fmt.Printf("Newer release available: %s\n", latest)
+				fmt.Printf("  Running: %s\n", status.CurrentCommit)
+				fmt.Println("  Run 'orc upgrade' to install it")
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Bool("check", false, "Only report whether a newer release exists")
+	cmd.Flags().Bool("force", false, "Replace dev builds and builds that are not behind the release")
+	return cmd
+}
+
+// CheckSchemaSkew compares the binary's schema version with the ledger's before
+// a command runs. It warns when the versions differ and refuses destructive
+// commands when the ledger was written by a newer orc.
+func CheckSchemaSkew(cmd *cobra.Command) error {
+	path := cmd.CommandPath()
+	if cmd.Name() == "upgrade" || cmd.Name() == "help" || strings.HasPrefix(path, "orc hook") || strings.HasPrefix(path, "orc completion") ||
+		strings.HasPrefix(path, "orc db migrat") { // inspect the ledger before opening it migrates it
+		return nil
+	}
+
+	status, err := db.CheckSchema()
+	if err != nil {
+		return nil // Commands report ledger errors themselves
+	}
+
+	switch {
+	case status.LedgerAhead():
+		fmt.Fprintf(os.Stderr, "⚠️  This ledger was written by a newer orc (schema v%d; this binary knows v%d). Run 'orc upgrade'.\n", status.Ledger, status.Binary)
+		if destructiveCommands[cmd.Name()] {
+			return fmt.Errorf("refusing '%s' while this orc is older than the ledger: run 'orc upgrade' first", path)
+		}
+	case status.LedgerUpgraded():
+		fmt.Fprintf(os.Stderr, "ℹ️  Upgraded ledger schema v%d → v%d. Other machines sharing this ledger need 'orc upgrade'.\n", status.Ledger, status.Binary)
+	}
+	return nil
+}
@@ -0,0 +1,239 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+// maxWebhookBody caps an inbound delivery; GitHub payloads stay well under it.
+const maxWebhookBody = 1 << 20
+
+// WebhookCmd returns the webhook command group for inbound events.
+func WebhookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook",
+		Short: "Receive signed events from CI, GitHub, and monitoring",
+		Long: `Let external systems update the ledger by posting signed events.
+
+Each source has a kind (github or generic), a signing secret stored with
+'orc secret set', and mappings from events to actions. Deliveries are
+posted to /webhooks/<source> and must carry an X-Hub-Signature-256 header
+(sha256=<hex HMAC of the body>).
+
+Events:
+  ci.failed, ci.passed, pr.opened, pr.approved, pr.merged, pr.closed
+
+Actions:
+  block     block the shipment's in-progress tasks
+  unblock   resume the shipment's blocked tasks
+  note      record a note on the shipment
+  pr-sync   mirror a PR event onto the shipment's PR (PR events only)
+
+Default mappings:
+  ci.failed=block,note  ci.passed=unblock  pr.*=pr-sync
+
+Events find their shipment by ID (generic only), branch, or PR number.
+
+Examples:
+  echo "$HOOK_SECRET" | orc secret set github-webhook
+  orc webhook add github --kind github --secret github-webhook
+  orc webhook add nightly --kind generic --secret nightly-hook --map ci.failed=note
+  orc webhook serve --port 9110`,
+	}
+
+	cmd.AddCommand(webhookAddCmd())
+	cmd.AddCommand(webhookListCmd())
+	cmd.AddCommand(webhookRemoveCmd())
+	cmd.AddCommand(webhookServeCmd())
+	return cmd
+}
+
+func webhookAddCmd() *cobra.Command {
+	var kind, secretName string
+	var mappings []string
+
+	cmd := &cobra.Command{
+		Use:   "add [name]",
+		Short: "Register a webhook source",
+		Long: `Register a webhook source. The signing secret must be a global secret.
+
+A github source reads pull_request, pull_request_review, and workflow_run
+deliveries. A generic source takes JSON bodies of the form:
+  {"event": "ci.failed", "shipment": "SHIP-010", "branch": "...", "pr": 42,
+   "title": "...", "url": "...", "detail": "..."}
+
+Examples:
+  orc webhook add github --kind github --secret github-webhook
+  orc webhook add nightly --kind generic --secret nightly-hook --map ci.failed=block,note`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			source, err := wire.WebhookService().AddSource(ctx, primary.AddWebhookSourceRequest{
+				Name:       args[0],
+				Kind:       kind,
+				SecretName: secretName,
+				Mappings:   mappings,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add webhook source: %w", err)
+			}
+
+			fmt.Printf("✓ Webhook source %s added (%s)\n", source.Name, source.Kind)
+			for _, spec := range source.Mappings {
+				fmt.Printf("  %s\n", spec)
+			}
+			fmt.Printf("  POST /webhooks/%s\n", source.Name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&kind, "kind", "github", "Source kind (github or generic)")
+	cmd.Flags().StringVar(&secretName, "secret", "", "Global secret holding the signing key")
+	cmd.Flags().StringArrayVar(&mappings, "map", nil, "Event mapping event=action[,action] (repeatable)")
+	return cmd
+}
+
+func webhookListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List webhook sources",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			sources, err := wire.WebhookService().ListSources(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to list webhook sources: %w", err)
+			}
+
+			if len(sources) == 0 {
+				fmt.Println("No webhook sources found.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tKIND\tSECRET\tMAPPINGS")
+			fmt.Fprintln(w, "----\t----\t------\t--------")
+			for _, s := range sources {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.Name, s.Kind, s.SecretName, strings.Join(s.Mappings, " "))
+			}
+			return w.Flush()
+		},
+	}
+}
+
+func webhookRemoveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove [name]",
+		Short: "Remove a webhook source",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			if err := wire.WebhookService().RemoveSource(ctx, args[0]); err != nil {
+				return fmt.Errorf("failed to remove webhook source: %w", err)
+			}
+			fmt.Printf("✓ Webhook source %s removed\n", args[0])
+			return nil
+		},
+	}
+}
+
+func webhookServeCmd() *cobra.Command {
+	var host string
+	var port int
+	var actorID string
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Receive webhook deliveries over HTTP",
+		Long: `Serve POST /webhooks/<source> over HTTP. Deliveries with a bad signature
+are rejected with 401; accepted deliveries return 202 with what was applied.
+Binds to localhost by default; put it behind a tunnel or reverse proxy, or use
+--host 0.0.0.0 to expose it.
+
+Changes a delivery makes are attributed to the actor running the server, as
+with any other command, unless --actor names another.
+
+Examples:
+  orc webhook serve
+  orc webhook serve --port 9200 --host 0.0.0.0`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr := net.JoinHostPort(host, strconv.Itoa(port))
+			if actorID == "" {
+				actorID = GetActorID()
+			}
+
+			mux := http.NewServeMux()
+			mux.Handle("POST /webhooks/{source}", webhookHandler(wire.WebhookService(), actorID, log.New(os.Stdout, "", log.LstdFlags)))
+
+			server := &http.Server{
+				Addr:              addr,
+				Handler:           mux,
+				ReadHeaderTimeout: 5 * time.Second,
+			}
+
+			fmt.Printf("🪝 Receiving webhooks on http://%s/webhooks/<source>\n", addr)
+			if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				return fmt.Errorf("webhook server failed: %w", err)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&host, "host", "127.0.0.1", "Address to bind")
+	cmd.Flags().IntVarP(&port, "port", "p", 9110, "Port to listen on")
+	cmd.Flags().StringVar(&actorID, "actor", "", "Actor deliveries act as (default: the actor running the server)")
+	return cmd
+}
+
+// webhookHandler verifies and applies each delivery as actorID, logging one
+// line per request.
+func webhookHandler(service primary.WebhookService, actorID string, logger *log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		source := r.PathValue("source")
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBody))
+		if err != nil {
+			http.Error(w, "failed to read body", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		ctx := orccontext.WithActorID(r.Context(), actorID)
+		result, err := service.Deliver(ctx, primary.WebhookDelivery{
+			Source:    source,
+			Event:     r.Header.Get("X-GitHub-Event"),
+			Signature: r.Header.Get("X-Hub-Signature-256"),
+			Body:      body,
+		})
+		if errors.Is(err, primary.ErrWebhookSignature) {
+			logger.Printf("%s: rejected (bad signature)", source)
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		if err != nil {
+			logger.Printf("%s: failed: %v", source, err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		logger.Printf("%s: %s %s applied=%s skipped=%s", source, orDash(result.Event), orDash(result.ShipmentID),
+			orDash(strings.Join(result.Applied, ", ")), orDash(strings.Join(result.Skipped, "; ")))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(result)
+	})
+}
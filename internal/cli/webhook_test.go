@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+)
+
+type fakeWebhookService struct {
+	primary.WebhookService
+	got   primary.WebhookDelivery
+	actor string
+	err   error
+}
+
+func (f *fakeWebhookService) Deliver(ctx context.Context, req primary.WebhookDelivery) (*primary.WebhookResult, error) {
+	f.got = req
+	f.actor = orccontext.ActorFromContext(ctx)
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &primary.WebhookResult{Event: "ci.failed", ShipmentID: "SHIP-010", Applied: []string{"blocked TASK-004"}}, nil
+}
+
+func TestWebhookHandler(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		wantLog  string
+	}{
+		{"accepted", nil, http.StatusAccepted, "github: ci.failed SHIP-010 applied=blocked TASK-004 skipped=-"},
+		{"bad signature", primary.ErrWebhookSignature, http.StatusUnauthorized, "github: rejected (bad signature)"},
+		{"other error", errors.New(`webhook source "github" not found`), http.StatusBadRequest, "github: failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &fakeWebhookService{err: tt.err}
+			var logs bytes.Buffer
+			mux := http.NewServeMux()
+			mux.Handle("POST /webhooks/{source}", webhookHandler(service, "IMP-BENCH-003", log.New(&logs, "", 0)))
+
+			req := httptest.NewRequest(http.MethodPost, "/webhooks/github", strings.NewReader(`{"action":"completed"}`))
+			req.Header.Set("X-GitHub-Event", "workflow_run")
+			req.Header.Set("X-Hub-Signature-256", "sha256=abc")
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantCode)
+			}
+			if !strings.Contains(logs.String(), tt.wantLog) {
+				t.Errorf("log = %q, want %q", logs.String(), tt.wantLog)
+			}
+			if service.got.Source != "github" || service.got.Event != "workflow_run" || service.got.Signature != "sha256=abc" || string(service.got.Body) != `{"action":"completed"}` {
+				t.Errorf("unexpected delivery: %+v", service.got)
+			}
+			// Changes are logged as the serving actor, like any other command
+			if service.actor != "IMP-BENCH-003" {
+				t.Errorf("delivered as actor %q, want IMP-BENCH-003", service.actor)
+			}
+		})
+	}
+}
@@ -2,11 +2,14 @@ package cli
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
+	orccontext "github.com/example/orc/internal/context"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/wire"
 )
@@ -27,6 +30,13 @@ func WorkbenchCmd() *cobra.Command {
 	cmd.AddCommand(workbenchArchiveCmd())
 	cmd.AddCommand(workbenchCheckoutCmd())
 	cmd.AddCommand(workbenchStatusCmd())
+	cmd.AddCommand(workbenchBootstrapCmd())
+	cmd.AddCommand(workbenchSyncCommitsCmd())
+	cmd.AddCommand(workbenchEnvCmd())
+	cmd.AddCommand(workbenchWatchCmd())
+	cmd.AddCommand(workbenchStashCmd())
+	cmd.AddCommand(workbenchUnstashCmd())
+	cmd.AddCommand(workbenchStashesCmd())
 
 	return cmd
 }
@@ -48,6 +58,10 @@ This command creates:
 - Git worktree (or directory if no repo)
 - .orc/config.json file
 
+If the repo has a bootstrap script (orc repo bootstrap set), it runs in the
+new worktree. A failing script does not undo creation; fix it and rerun with
+orc workbench bootstrap <id> --rerun.
+
 Examples:
   orc workbench create --workshop WORK-001 --repo-id REPO-001`,
 		Args: cobra.NoArgs,
@@ -77,6 +91,10 @@ Examples:
 			fmt.Printf("  Workshop: %s\n", workbench.WorkshopID)
 			fmt.Printf("  Path: %s\n", workbench.Path)
 
+			if resp.Bootstrap != nil {
+				printBootstrapResult(resp.Bootstrap)
+			}
+
 			return nil
 		},
 	}
@@ -162,7 +180,14 @@ func workbenchShowCmd() *cobra.Command {
 			if workbench.CurrentBranch != "" {
 				fmt.Printf("Current Branch: %s\n", workbench.CurrentBranch)
 			}
-			fmt.Printf("Created: %s\n", workbench.CreatedAt)
+			if workbench.BootstrapStatus != "" {
+				if workbench.BootstrappedAt != "" {
+					fmt.Printf("Bootstrap: %s (%s)\n", workbench.BootstrapStatus, formatWhen(workbench.BootstrappedAt))
+				} else {
+					fmt.Printf("Bootstrap: %s\n", workbench.BootstrapStatus)
+				}
+			}
+			fmt.Printf("Created: %s\n", formatTime(workbench.CreatedAt))
 
 			return nil
 		},
@@ -201,23 +226,107 @@ Examples:
 }
 
 func workbenchDeleteCmd() *cobra.Command {
+	var force bool
+	var migrateTo string
+
 	cmd := &cobra.Command{
 		Use:   "delete [workbench-id]",
-		Short: "Delete a workbench (DEPRECATED)",
-		Long: `DEPRECATED: Use archive + tmux apply instead.
+		Short: "Tear down a workbench, refusing to lose work",
+		Long: `Tear down a workbench: archive it so orc tmux apply removes its worktree
+and pane, after checking that nothing on it would be lost.
+
+Delete refuses, listing exactly what would be lost, when the workbench has:
+- uncommitted changes (untracked files included)
+- commits not pushed to the branch's upstream
+- in-progress tasks
+- an open PR for its shipment or current branch
 
-To remove a workbench:
-  orc workbench archive BENCH-xxx
-  orc tmux apply WORK-xxx`,
+--migrate-to moves the workbench's open shipment (with its tasks) and its
+in-progress tasks to another active workbench first, so only unsaved git
+work can still block. --force tears down regardless.
+
+Examples:
+  orc workbench delete BENCH-002
+  orc workbench delete BENCH-002 --migrate-to BENCH-004
+  orc workbench delete BENCH-002 --force`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return fmt.Errorf("orc workbench delete is deprecated. Use:\n  orc workbench archive %s\n  orc tmux apply <workshop-id>", args[0])
+			ctx := NewContext()
+			svc := wire.WorkbenchTeardownService()
+
+			plan, err := svc.PlanTeardown(ctx, args[0])
+			if err != nil {
+				return err
+			}
+			printWorkbenchTeardownLosses(os.Stdout, plan, migrateTo != "")
+
+			result, err := svc.TeardownWorkbench(ctx, primary.TeardownWorkbenchRequest{
+				WorkbenchID: plan.WorkbenchID,
+				MigrateTo:   migrateTo,
+				Force:       force,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("✓ Workbench %s archived\n", result.WorkbenchID)
+			if result.MigratedTo != "" {
+				moved := append(append([]string{}, result.MigratedShipments...), result.MigratedTasks...)
+				if len(moved) == 0 {
+					moved = []string{"nothing to move"}
+				}
+				fmt.Printf("  Moved to %s: %s\n", result.MigratedTo, strings.Join(moved, ", "))
+			}
+			fmt.Printf("\nTo remove the worktree, run:\n")
+			fmt.Printf("  orc tmux apply %s\n", result.WorkshopID)
+			return nil
 		},
 	}
 
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Tear down even if work would be lost")
+	cmd.Flags().StringVar(&migrateTo, "migrate-to", "", "Move shipments and in-progress tasks to this workbench first")
+
 	return cmd
 }
 
+// printWorkbenchTeardownLosses lists what tearing down a workbench would
+// lose. Tasks and PRs are not lost when they migrate to another workbench.
+func printWorkbenchTeardownLosses(out io.Writer, plan *primary.WorkbenchTeardownPlan, migrating bool) {
+	var sections []string
+	if n := len(plan.ChangedFiles); n > 0 {
+		lines := []string{fmt.Sprintf("  %s in %s:", pluralize(n, "uncommitted change", "uncommitted changes"), plan.Path)}
+		for _, f := range plan.ChangedFiles {
+			lines = append(lines, "    "+f)
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+	if n := len(plan.UnpushedCommits); n > 0 {
+		lines := []string{fmt.Sprintf("  %s:", pluralize(n, "unpushed commit", "unpushed commits"))}
+		for _, c := range plan.UnpushedCommits {
+			lines = append(lines, fmt.Sprintf("    %s %s", shortSHA(c.SHA), c.Subject))
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+	if n := len(plan.InProgressTasks); n > 0 && !migrating {
+		lines := []string{fmt.Sprintf("  %s:", pluralize(n, "in-progress task", "in-progress tasks"))}
+		for _, t := range plan.InProgressTasks {
+			lines = append(lines, fmt.Sprintf("    %s %s", t.ID, t.Title))
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+	if n := len(plan.OpenPRs); n > 0 && !migrating {
+		lines := []string{fmt.Sprintf("  %s:", pluralize(n, "open PR", "open PRs"))}
+		for _, pr := range plan.OpenPRs {
+			lines = append(lines, fmt.Sprintf("    %s %s [%s] (%s)", pr.ID, pr.Branch, pr.Status, pr.ShipmentID))
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+	if len(sections) == 0 {
+		return
+	}
+	fmt.Fprintf(out, "Tearing down %s (%s) would lose:\n%s\n\n", plan.WorkbenchID, plan.WorkbenchName, strings.Join(sections, "\n"))
+}
+
 func workbenchArchiveCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "archive [workbench-id]",
@@ -276,7 +385,8 @@ This allows seamless context switching even with dirty working directories.
 Examples:
   orc workbench checkout BENCH-001 main
   orc workbench checkout BENCH-001 ml/SHIP-205-feature`,
-		Args: cobra.ExactArgs(2),
+		Args:        cobra.ExactArgs(2),
+		Annotations: map[string]string{outsideWritesAnnotation: "true"},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ctx := NewContext()
 			workbenchID := args[0]
@@ -344,3 +454,112 @@ Examples:
 
 	return cmd
 }
+
+func workbenchBootstrapCmd() *cobra.Command {
+	var rerun bool
+
+	cmd := &cobra.Command{
+		Use:   "bootstrap [workbench-id]",
+		Short: "Run the repo bootstrap script in a workbench",
+		Long: `Run the linked repo's bootstrap script inside the workbench worktree.
+
+Bootstrap runs automatically on workbench creation. Use this command to
+retry after a failure or to re-apply setup after changing the script.
+
+Examples:
+  orc workbench bootstrap BENCH-003
+  orc workbench bootstrap BENCH-003 --rerun`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			workbenchID := args[0]
+
+			resp, err := wire.WorkbenchService().BootstrapWorkbench(ctx, primary.BootstrapWorkbenchRequest{
+				WorkbenchID: workbenchID,
+				Rerun:       rerun,
+			})
+			if err != nil {
+				return err
+			}
+
+			printBootstrapResult(resp)
+			if resp.Status == primary.BootstrapStatusFailed {
+				return fmt.Errorf("bootstrap failed for %s", workbenchID)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&rerun, "rerun", false, "Run again even if bootstrap already ran")
+
+	return cmd
+}
+
+func workbenchSyncCommitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync-commits [workbench-id]",
+		Short: "Link branch commits to the tasks and shipments they mention",
+		Long: `Scan the workbench branch for commits that mention task or shipment IDs
+(e.g. "TASK-123: fix redirect") and record commit links in the ledger.
+
+Only commits not yet on the repo's default branch are scanned. Linked
+commits appear in 'orc task show' and 'orc shipment show'. Syncing is
+idempotent; 'orc shipment land' syncs automatically.
+
+Defaults to the current workbench when run from a workbench directory.
+
+Examples:
+  orc workbench sync-commits
+  orc workbench sync-commits BENCH-003`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			var workbenchID string
+			if len(args) > 0 {
+				workbenchID = args[0]
+			} else {
+				workbenchID = orccontext.GetContextWorkbenchID()
+				if workbenchID == "" {
+					return fmt.Errorf("no workbench context detected\nHint: Pass a workbench ID or run from a workbench directory")
+				}
+			}
+
+			result, err := wire.CommitLinkService().SyncWorkbenchCommits(ctx, workbenchID)
+			if err != nil {
+				return fmt.Errorf("failed to sync commits: %w", err)
+			}
+
+			printSyncCommitsResult(result)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// printSyncCommitsResult renders the outcome of a commit sync.
+func printSyncCommitsResult(result *primary.SyncCommitsResult) {
+	fmt.Printf("🔗 Scanned %d commit(s) on %s since %s, %d new link(s)\n",
+		result.Scanned, result.WorkbenchID, result.BaseRef, result.NewLinks)
+	if len(result.UnknownRefs) > 0 {
+		fmt.Printf("  Skipped unknown IDs: %s\n", strings.Join(result.UnknownRefs, ", "))
+	}
+}
+
+// printBootstrapResult renders the outcome of a bootstrap run.
+func printBootstrapResult(resp *primary.BootstrapWorkbenchResponse) {
+	if resp.Status == primary.BootstrapStatusSucceeded {
+		fmt.Printf("✓ Bootstrap succeeded for %s\n", resp.WorkbenchID)
+		return
+	}
+
+	fmt.Printf("✗ Bootstrap failed for %s: %s\n", resp.WorkbenchID, resp.Error)
+	if resp.Output != "" {
+		fmt.Println("\nOutput:")
+		for _, line := range strings.Split(strings.TrimRight(resp.Output, "\n"), "\n") {
+			fmt.Printf("  %s\n", line)
+		}
+	}
+	fmt.Printf("\nRetry with: orc workbench bootstrap %s --rerun\n", resp.WorkbenchID)
+}
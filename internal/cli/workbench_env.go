@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+func workbenchEnvCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "env",
+		Short: "Manage environment variables for a workbench's panes and agents",
+		Long: `Manage environment variables injected into a workbench's tmux panes and
+Claude sessions.
+
+Variables hold a plain value or a reference to an orc secret. Secrets are
+resolved when a pane or agent starts (repo, then factory, then global
+scope), so their values are never stored with the workbench.
+
+New panes get the variables from 'orc tmux apply'; 'orc connect' resolves
+them on every start, so respawning an agent pane picks up changes.
+
+Examples:
+  orc workbench env set BENCH-003 API_BASE=staging
+  orc workbench env set BENCH-003 GITHUB_TOKEN --secret github-token
+  orc workbench env list BENCH-003
+  orc workbench env unset BENCH-003 API_BASE`,
+	}
+	cmd.AddCommand(workbenchEnvSetCmd())
+	cmd.AddCommand(workbenchEnvUnsetCmd())
+	cmd.AddCommand(workbenchEnvListCmd())
+	return cmd
+}
+
+func workbenchEnvSetCmd() *cobra.Command {
+	var secretName string
+
+	cmd := &cobra.Command{
+		Use:   "set [workbench-id] KEY=value... | KEY --secret name",
+		Short: "Set workbench environment variables",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			workbenchID := args[0]
+			service := wire.WorkbenchEnvService()
+
+			if secretName != "" {
+				if len(args) != 2 || strings.Contains(args[1], "=") {
+					return fmt.Errorf("--secret takes a single variable name (e.g. GITHUB_TOKEN --secret github-token)")
+				}
+				if err := service.SetEnv(ctx, primary.SetWorkbenchEnvRequest{
+					WorkbenchID: workbenchID,
+					Name:        args[1],
+					SecretName:  secretName,
+				}); err != nil {
+					return err
+				}
+				fmt.Printf("✓ Set %s on %s from secret %s\n", args[1], workbenchID, secretName)
+				return nil
+			}
+
+			for _, assignment := range args[1:] {
+				name, value, ok := strings.Cut(assignment, "=")
+				if !ok {
+					return fmt.Errorf("expected KEY=value, got %q", assignment)
+				}
+				if err := service.SetEnv(ctx, primary.SetWorkbenchEnvRequest{
+					WorkbenchID: workbenchID,
+					Name:        name,
+					Value:       value,
+				}); err != nil {
+					return err
+				}
+				fmt.Printf("✓ Set %s on %s\n", name, workbenchID)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&secretName, "secret", "", "Take the value from this orc secret")
+
+	return cmd
+}
+
+func workbenchEnvUnsetCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unset [workbench-id] KEY...",
+		Short: "Remove workbench environment variables",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+			workbenchID := args[0]
+
+			for _, name := range args[1:] {
+				if err := wire.WorkbenchEnvService().UnsetEnv(ctx, workbenchID, name); err != nil {
+					return err
+				}
+				fmt.Printf("✓ Unset %s on %s\n", name, workbenchID)
+			}
+			return nil
+		},
+	}
+}
+
+func workbenchEnvListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list [workbench-id]",
+		Short: "List workbench environment variables (secret values are not shown)",
+		Long: `List a workbench's environment variables. Secret-backed variables show
+the secret name, not its value.
+
+Defaults to the current workbench when run from a workbench directory.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			var workbenchID string
+			if len(args) > 0 {
+				workbenchID = args[0]
+			} else {
+				workbenchID = orccontext.GetContextWorkbenchID()
+				if workbenchID == "" {
+					return fmt.Errorf("no workbench context detected\nHint: Pass a workbench ID or run from a workbench directory")
+				}
+			}
+
+			vars, err := wire.WorkbenchEnvService().ListEnv(ctx, workbenchID)
+			if err != nil {
+				return fmt.Errorf("failed to list workbench env: %w", err)
+			}
+			if len(vars) == 0 {
+				fmt.Printf("No environment variables set on %s.\n", workbenchID)
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "NAME\tVALUE")
+			for _, v := range vars {
+				value := v.Value
+				if v.SecretName != "" {
+					value = "secret:" + v.SecretName
+				}
+				fmt.Fprintf(w, "%s\t%s\n", v.Name, value)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+// resolveWorkbenchEnv returns a workbench's resolved KEY=value pairs. Failures
+// are reported as a warning so a missing secret never blocks pane startup.
+func resolveWorkbenchEnv(workbenchID string) []string {
+	env, err := wire.WorkbenchEnvService().ResolveEnv(NewContext(), workbenchID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "⚠️  Skipping environment for %s: %v\n", workbenchID, err)
+		return nil
+	}
+	return env
+}
@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+func workbenchStashCmd() *cobra.Command {
+	var taskID string
+	var message string
+
+	cmd := &cobra.Command{
+		Use:   "stash [workbench-id]",
+		Short: "Stash a workbench's uncommitted work, linked to its active task",
+		Long: `Stash a workbench's uncommitted changes (untracked files included) with
+git stash and record the stash in the ledger, linked to the task the
+workbench is working on: its focused in-progress task, or else its first
+in-progress task. The worktree is left clean.
+
+The stash can be restored with 'orc workbench unstash', on the same
+workbench or on another workbench of the same repo.
+
+Defaults to the current workbench when run from a workbench directory.
+
+Examples:
+  orc workbench stash
+  orc workbench stash BENCH-003 -m "half-done refactor"
+  orc workbench stash BENCH-003 --task TASK-042`,
+		Args:        cobra.MaximumNArgs(1),
+		Annotations: map[string]string{outsideWritesAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			var workbenchID string
+			if len(args) > 0 {
+				workbenchID = args[0]
+			} else {
+				workbenchID = orccontext.GetContextWorkbenchID()
+				if workbenchID == "" {
+					return fmt.Errorf("no workbench context detected\nHint: Pass a workbench ID or run from a workbench directory")
+				}
+			}
+
+			stash, err := wire.WorkbenchStashService().StashWorkbench(ctx, primary.StashWorkbenchRequest{
+				WorkbenchID: workbenchID,
+				TaskID:      taskID,
+				Message:     message,
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("✓ Stashed %s from %s as %s\n", pluralize(stash.FileCount, "file", "files"), stash.WorkbenchID, stash.ID)
+			if stash.TaskID != "" {
+				fmt.Printf("  Task:   %s\n", stash.TaskID)
+			}
+			fmt.Printf("  Commit: %s\n", stash.CommitSHA)
+			fmt.Printf("  Restore with: orc workbench unstash %s\n", stash.ID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&taskID, "task", "", "Link the stash to this task instead of the active one")
+	cmd.Flags().StringVarP(&message, "message", "m", "", "Describe the stashed work")
+
+	return cmd
+}
+
+func workbenchUnstashCmd() *cobra.Command {
+	var targetID string
+
+	cmd := &cobra.Command{
+		Use:   "unstash [stash-id]",
+		Short: "Restore stashed work onto a workbench",
+		Long: `Restore a stash made with 'orc workbench stash' onto a workbench. The
+target must be a workbench of the same repo with no uncommitted changes.
+
+Without a stash ID, restores the newest outstanding stash of the target's
+active task, or else the newest one taken from the target itself.
+
+The target defaults to the current workbench when run from a workbench
+directory.
+
+Examples:
+  orc workbench unstash
+  orc workbench unstash STASH-004
+  orc workbench unstash STASH-004 --to BENCH-005`,
+		Args:        cobra.MaximumNArgs(1),
+		Annotations: map[string]string{outsideWritesAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			workbenchID := targetID
+			if workbenchID == "" {
+				workbenchID = orccontext.GetContextWorkbenchID()
+				if workbenchID == "" {
+					return fmt.Errorf("no workbench context detected\nHint: Use --to BENCH-ID or run from a workbench directory")
+				}
+			}
+
+			req := primary.UnstashWorkbenchRequest{WorkbenchID: workbenchID}
+			if len(args) > 0 {
+				req.StashID = args[0]
+			}
+
+			stash, err := wire.WorkbenchStashService().UnstashWorkbench(ctx, req)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("✓ Restored %s onto %s (%s", stash.ID, stash.RestoredToWorkbenchID, pluralize(stash.FileCount, "file", "files"))
+			if stash.WorkbenchID != stash.RestoredToWorkbenchID {
+				fmt.Printf(" from %s", stash.WorkbenchID)
+			}
+			fmt.Println(")")
+			if stash.TaskID != "" {
+				fmt.Printf("  Task: %s\n", stash.TaskID)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&targetID, "to", "", "Workbench to restore onto (default: current workbench)")
+
+	return cmd
+}
+
+func workbenchStashesCmd() *cobra.Command {
+	var workbenchID string
+	var taskID string
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "stashes",
+		Short: "List stashed workbench work",
+		Long: `List stashes made with 'orc workbench stash', newest first. Only
+outstanding stashes are shown unless --all is given.
+
+Examples:
+  orc workbench stashes
+  orc workbench stashes --task TASK-042
+  orc workbench stashes --workbench BENCH-003 --all`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			filters := primary.WorkbenchStashFilters{WorkbenchID: workbenchID, TaskID: taskID}
+			if !all {
+				filters.Status = primary.WorkbenchStashStashed
+			}
+
+			stashes, err := wire.WorkbenchStashService().ListStashes(ctx, filters)
+			if err != nil {
+				return fmt.Errorf("failed to list stashes: %w", err)
+			}
+			if len(stashes) == 0 {
+				fmt.Println("No stashes found.")
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "ID\tBENCH\tTASK\tFILES\tSTASHED\tSTATUS\tMESSAGE")
+			for _, s := range stashes {
+				status := s.Status
+				if s.Status == primary.WorkbenchStashRestored {
+					status = "→ " + s.RestoredToWorkbenchID
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+					s.ID, s.WorkbenchID, orDash(s.TaskID), s.FileCount, formatWhen(s.CreatedAt), status, truncate(orDash(s.Message), 40))
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().StringVarP(&workbenchID, "workbench", "w", "", "Only stashes taken from this workbench")
+	cmd.Flags().StringVar(&taskID, "task", "", "Only stashes linked to this task")
+	cmd.Flags().BoolVar(&all, "all", false, "Include restored stashes")
+
+	return cmd
+}
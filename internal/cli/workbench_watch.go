@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	orccontext "github.com/example/orc/internal/context"
+	"github.com/example/orc/internal/ports/primary"
+	"github.com/example/orc/internal/wire"
+)
+
+func workbenchWatchCmd() *cobra.Command {
+	var (
+		commissionID string
+		interval     time.Duration
+		auto         bool
+		once         bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "watch [workbench-id]",
+		Short: "Suggest the task being worked from the files being edited",
+		Long: `Watch the workbench's uncommitted changes and suggest which open or
+in-progress task they belong to, so task claims keep up with real work.
+
+A file counts for a task when a branch commit mentioning the task touched it,
+or when the task's title or description names the file. Suggestions are
+printed whenever the set of edited files changes.
+
+With --auto, a suggestion that clearly outranks the rest is claimed (set
+in-progress for this workbench) without asking.
+
+Defaults to the current workbench when run from a workbench directory.
+
+Examples:
+  orc workbench watch
+  orc workbench watch --auto --interval 30s
+  orc workbench watch BENCH-003 --commission COMM-001 --once`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := NewContext()
+
+			var workbenchID string
+			if len(args) > 0 {
+				workbenchID = args[0]
+			} else {
+				workbenchID = orccontext.GetContextWorkbenchID()
+				if workbenchID == "" {
+					return fmt.Errorf("no workbench context detected\nHint: Pass a workbench ID or run from a workbench directory")
+				}
+			}
+			if commissionID == "" {
+				commissionID = orccontext.GetContextCommissionID()
+				if commissionID == "" {
+					return fmt.Errorf("no commission context detected\nHint: Use --commission flag or run from a workbench focused on a commission")
+				}
+			}
+			if interval < time.Second {
+				return fmt.Errorf("--interval must be at least 1s")
+			}
+
+			req := primary.SuggestTasksRequest{WorkbenchID: workbenchID, CommissionID: commissionID}
+			if once {
+				_, err := watchOnce(ctx, req, auto, "")
+				return err
+			}
+
+			fmt.Printf("👀 Watching %s every %s (Ctrl-C to stop)\n", workbenchID, interval)
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+
+			var last string
+			for {
+				seen, err := watchOnce(ctx, req, auto, last)
+				if err != nil {
+					fmt.Printf("⚠️  %v\n", err)
+				} else {
+					last = seen
+				}
+				<-ticker.C
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&commissionID, "commission", "c", "", "Commission whose tasks to match (defaults to context)")
+	cmd.Flags().DurationVar(&interval, "interval", 10*time.Second, "How often to check for edited files")
+	cmd.Flags().BoolVar(&auto, "auto", false, "Claim a clear best match automatically")
+	cmd.Flags().BoolVar(&once, "once", false, "Print suggestions once and exit")
+
+	return cmd
+}
+
+// watchOnce computes suggestions and prints them unless the edited files are
+// unchanged since the previous check. It returns the edited-file key to pass
+// as last on the next check.
+func watchOnce(ctx context.Context, req primary.SuggestTasksRequest, auto bool, last string) (string, error) {
+	result, err := wire.CommitLinkService().SuggestTasks(ctx, req)
+	if err != nil {
+		return last, fmt.Errorf("failed to suggest tasks: %w", err)
+	}
+
+	key := fmt.Sprintf("%d:%s", len(result.EditedFiles), strings.Join(result.EditedFiles, "\n"))
+	if key == last {
+		return key, nil
+	}
+
+	printTaskSuggestions(result)
+
+	if auto && result.AutoClaimID != "" {
+		if err := wire.TaskService().ClaimTask(ctx, primary.ClaimTaskRequest{
+			TaskID:      result.AutoClaimID,
+			WorkbenchID: result.WorkbenchID,
+		}); err != nil {
+			return key, fmt.Errorf("failed to claim %s: %w", result.AutoClaimID, err)
+		}
+		fmt.Printf("✓ Task %s claimed for %s\n", result.AutoClaimID, result.WorkbenchID)
+		if result.InProgressID != "" {
+			fmt.Printf("  %s is still in progress: orc task complete %s\n", result.InProgressID, result.InProgressID)
+		}
+	}
+	return key, nil
+}
+
+// printTaskSuggestions renders ranked task suggestions for edited files.
+func printTaskSuggestions(result *primary.TaskSuggestions) {
+	stamp := time.Now().Format("15:04:05")
+	if len(result.EditedFiles) == 0 {
+		fmt.Printf("[%s] No uncommitted changes\n", stamp)
+		return
+	}
+	fmt.Printf("[%s] %s edited\n", stamp, pluralize(len(result.EditedFiles), "file", "files"))
+	if len(result.Suggestions) == 0 {
+		fmt.Println("  No matching tasks")
+		return
+	}
+
+	for _, s := range result.Suggestions {
+		marker := " "
+		if s.TaskID == result.InProgressID {
+			marker = "▶"
+		}
+		fmt.Printf("  %s %s [%s] %s (%s)\n", marker, s.TaskID, s.Status, s.Title, strings.Join(s.Matches, ", "))
+	}
+
+	top := result.Suggestions[0]
+	if top.TaskID != result.InProgressID && top.Status == "open" {
+		fmt.Printf("💡 Looks like %s: orc task claim %s\n", top.TaskID, top.TaskID)
+	}
+}
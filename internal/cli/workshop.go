@@ -141,7 +141,7 @@ func workshopShowCmd() *cobra.Command {
 			fmt.Printf("Name: %s\n", workshop.Name)
 			fmt.Printf("Factory: %s\n", workshop.FactoryID)
 			fmt.Printf("Status: %s\n", workshop.Status)
-			fmt.Printf("Created: %s\n", workshop.CreatedAt)
+			fmt.Printf("Created: %s\n", formatTime(workshop.CreatedAt))
 
 			return nil
 		},
@@ -19,11 +19,45 @@ const (
 	PlaceTypeWorkbench = "workbench" // BENCH-XXX
 )
 
-// Config represents the flat ORC configuration (identity only)
+// Environment variables that override detected context (for scripting).
+const (
+	EnvWorkbench  = "ORC_WORKBENCH"
+	EnvCommission = "ORC_COMMISSION"
+	EnvFactory    = "ORC_FACTORY"
+)
+
+// EnvSourceDir overrides the orc source checkout used by 'orc upgrade' (default ~/src/orc).
+const EnvSourceDir = "ORC_SOURCE_DIR"
+
+// EnvClaimTTL sets how long a task claim lives without a heartbeat (e.g. "90m").
+const EnvClaimTTL = "ORC_CLAIM_TTL"
+
+// EnvMigrationWait sets how long a process waits for another to finish
+// migrating the ledger (e.g. "2m"; default 1m, 0 to fail at once).
+const EnvMigrationWait = "ORC_MIGRATION_WAIT"
+
+// EnvInfraDBPath turns on two-ledger mode: factories, workshops, workbenches,
+// and their runtime records are stored in this database, attached to the work
+// ledger at ORC_DB_PATH (or ~/.orc/orc.db). See 'orc db split'.
+const EnvInfraDBPath = "ORC_INFRA_DB_PATH"
+
+// EnvTelemetry opts in to local per-command telemetry when set to "1" (see 'orc debug perf').
+const EnvTelemetry = "ORC_TELEMETRY"
+
+// EnvContentWarnLines sets the note and plan content size, in lines, above
+// which saves warn (default 1000). EnvContentMaxLines sets the size above which
+// saves are refused (default 0, no limit).
+const (
+	EnvContentWarnLines = "ORC_CONTENT_WARN_LINES"
+	EnvContentMaxLines  = "ORC_CONTENT_MAX_LINES"
+)
+
+// Config represents the flat ORC configuration (identity plus optional overrides)
 // New format uses place_id; legacy role-based format is migrated on load.
 type Config struct {
-	Version string `json:"version"`
-	PlaceID string `json:"place_id"` // BENCH-XXX
+	Version      string `json:"version"`
+	PlaceID      string `json:"place_id"`                // BENCH-XXX
+	CommissionID string `json:"commission_id,omitempty"` // Explicit override set by 'orc context set'
 }
 
 // legacyIMPConfig is used for reading old IMP config format during migration
@@ -76,6 +110,29 @@ func LoadConfig(dir string) (*Config, error) {
 	return &cfg, nil
 }
 
+// FindConfig walks up from dir to the nearest .orc/config.json, so context
+// is detected from subdirectories of a workbench too. The walk stops before
+// the home directory (~/.orc holds ORC's data, not a place config).
+// Returns the config and the directory containing .orc.
+func FindConfig(dir string) (*Config, string, error) {
+	home, _ := os.UserHomeDir()
+	for {
+		if dir == home {
+			break
+		}
+		if _, err := os.Stat(filepath.Join(dir, ".orc", "config.json")); err == nil {
+			cfg, err := LoadConfig(dir)
+			return cfg, dir, err
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			break
+		}
+		dir = parent
+	}
+	return nil, "", fmt.Errorf("no .orc/config.json found")
+}
+
 // SaveConfig writes config.json to directory
 func SaveConfig(dir string, cfg *Config) error {
 	orcDir := filepath.Join(dir, ".orc")
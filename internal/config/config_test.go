@@ -105,6 +105,35 @@ func TestLoadConfig_BackwardCompatibility(t *testing.T) {
 	}
 }
 
+func TestFindConfig_WalksUp(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := SaveConfig(tmpDir, &Config{Version: "1.0", PlaceID: "BENCH-007", CommissionID: "COMM-002"}); err != nil {
+		t.Fatalf("SaveConfig failed: %v", err)
+	}
+
+	nested := filepath.Join(tmpDir, "internal", "pkg")
+	if err := os.MkdirAll(nested, 0755); err != nil {
+		t.Fatalf("failed to create nested dir: %v", err)
+	}
+
+	cfg, dir, err := FindConfig(nested)
+	if err != nil {
+		t.Fatalf("FindConfig failed: %v", err)
+	}
+	if dir != tmpDir {
+		t.Errorf("dir = %q, want %q", dir, tmpDir)
+	}
+	if cfg.PlaceID != "BENCH-007" || cfg.CommissionID != "COMM-002" {
+		t.Errorf("cfg = %+v, want BENCH-007 with COMM-002 override", cfg)
+	}
+}
+
+func TestFindConfig_NotFound(t *testing.T) {
+	if _, _, err := FindConfig(t.TempDir()); err == nil {
+		t.Error("expected error when no config exists")
+	}
+}
+
 func TestGetPlaceType(t *testing.T) {
 	tests := []struct {
 		placeID  string
@@ -2,6 +2,7 @@ package context
 
 import (
 	gocontext "context"
+	"fmt"
 	"os"
 	"path/filepath"
 
@@ -13,135 +14,216 @@ import (
 type WorkbenchContext struct {
 	WorkbenchID string `json:"workbench_id"`
 	Role        string `json:"role"`
-	ConfigPath  string `json:"config_path"` // Path to .orc/config.json
+	ConfigPath  string `json:"config_path"` // Path to .orc/config.json (empty when set by environment)
 }
 
-// DetectWorkbenchContext checks if we're in a workbench context (IMP territory)
-// by looking for .orc/config.json in current directory only (no tree walking)
-func DetectWorkbenchContext() (*WorkbenchContext, error) {
+// Value is a resolved context value and where it came from.
+type Value struct {
+	ID     string `json:"id"`
+	Source string `json:"source"`
+}
+
+// ResolvedContext is the full context for the current directory, as shown by
+// 'orc context show'. Empty IDs mean the value could not be determined.
+type ResolvedContext struct {
+	Dir        string `json:"dir"`
+	ConfigPath string `json:"config_path"` // Nearest .orc/config.json, if any
+	Workbench  Value  `json:"workbench"`
+	Workshop   Value  `json:"workshop"`
+	Factory    Value  `json:"factory"`
+	Commission Value  `json:"commission"`
+}
+
+// findConfig locates the nearest .orc/config.json from the current directory.
+// Returns nil config and empty path when none is found.
+func findConfig() (*config.Config, string) {
 	dir, err := os.Getwd()
 	if err != nil {
-		return nil, err
+		return nil, ""
 	}
-
-	cfg, err := config.LoadConfig(dir)
+	cfg, cfgDir, err := config.FindConfig(dir)
 	if err != nil {
-		return nil, nil //nolint:nilerr // No config found is not an error, just no context
-	}
-
-	// Only return context if this is a workbench (BENCH-XXX place_id)
-	if config.IsWorkbench(cfg.PlaceID) {
-		return &WorkbenchContext{
-			WorkbenchID: cfg.PlaceID,
-			Role:        config.RoleIMP,
-			ConfigPath:  filepath.Join(dir, ".orc", "config.json"),
-		}, nil
+		return nil, ""
 	}
+	return cfg, filepath.Join(cfgDir, ".orc", "config.json")
+}
 
-	return nil, nil
+// ConfigPath returns the path of the nearest .orc/config.json, or "" if none.
+func ConfigPath() string {
+	_, path := findConfig()
+	return path
 }
 
-// GetContextWorkbenchID returns the workbench ID (BENCH-xxx) if we're in a workbench context.
-// Returns empty string if not in a workbench context.
-func GetContextWorkbenchID() string {
-	ctx, err := DetectWorkbenchContext()
-	if err != nil || ctx == nil {
-		return ""
+// resolveWorkbench determines the workbench from ORC_WORKBENCH or place_id.
+func resolveWorkbench(cfg *config.Config, cfgPath string) Value {
+	if id := os.Getenv(config.EnvWorkbench); id != "" {
+		return Value{ID: id, Source: "$" + config.EnvWorkbench}
+	}
+	if cfg != nil && config.IsWorkbench(cfg.PlaceID) {
+		return Value{ID: cfg.PlaceID, Source: cfgPath}
 	}
-	return ctx.WorkbenchID
+	return Value{}
 }
 
-// GetContextCommissionID returns the commission ID from workbench context.
-// For IMP contexts, looks up commission via workbench's focused shipment or tome.
-// Returns empty string if no context found.
-func GetContextCommissionID() string {
-	dir, err := os.Getwd()
-	if err != nil {
-		return ""
+// resolveCommission determines the commission from ORC_COMMISSION, an
+// explicit override in config, or the workbench's focused container.
+func resolveCommission(cfg *config.Config, cfgPath, workbenchID string) Value {
+	if id := os.Getenv(config.EnvCommission); id != "" {
+		return Value{ID: id, Source: "$" + config.EnvCommission}
+	}
+	if cfg != nil && cfg.CommissionID != "" {
+		return Value{ID: cfg.CommissionID, Source: "override in " + cfgPath}
 	}
+	if workbenchID != "" {
+		return getCommissionFromWorkbench(workbenchID)
+	}
+	return Value{}
+}
 
-	cfg, err := config.LoadConfig(dir)
-	if err != nil {
-		return ""
+// DetectWorkbenchContext checks if we're in a workbench context (IMP territory)
+// via ORC_WORKBENCH or the nearest .orc/config.json.
+func DetectWorkbenchContext() (*WorkbenchContext, error) {
+	cfg, cfgPath := findConfig()
+	workbench := resolveWorkbench(cfg, cfgPath)
+	if workbench.ID == "" {
+		return nil, nil
 	}
 
-	// For workbench place, look up commission through focused container
-	if config.IsWorkbench(cfg.PlaceID) {
-		return getCommissionFromWorkbench(cfg.PlaceID)
+	configPath := cfgPath
+	if workbench.Source != cfgPath {
+		configPath = ""
 	}
+	return &WorkbenchContext{
+		WorkbenchID: workbench.ID,
+		Role:        config.RoleIMP,
+		ConfigPath:  configPath,
+	}, nil
+}
 
-	return ""
+// GetContextWorkbenchID returns the workbench ID (BENCH-xxx) if we're in a workbench context.
+// Returns empty string if not in a workbench context.
+func GetContextWorkbenchID() string {
+	cfg, cfgPath := findConfig()
+	return resolveWorkbench(cfg, cfgPath).ID
+}
+
+// GetContextCommissionID returns the commission ID for the current context.
+// Resolution order: ORC_COMMISSION, a commission override in config, then
+// the commission of the workbench's focused shipment or tome.
+// Returns empty string if no context found.
+func GetContextCommissionID() string {
+	cfg, cfgPath := findConfig()
+	workbench := resolveWorkbench(cfg, cfgPath)
+	return resolveCommission(cfg, cfgPath, workbench.ID).ID
 }
 
 // getCommissionFromWorkbench looks up commission ID via workbench's focused container.
 // Returns the commission that the workbench's focused shipment/tome belongs to.
-func getCommissionFromWorkbench(workbenchID string) string {
+func getCommissionFromWorkbench(workbenchID string) Value {
 	ctx := gocontext.Background()
 
 	// Get workbench's focused ID
 	focusedID, err := wire.WorkbenchService().GetFocusedID(ctx, workbenchID)
 	if err != nil || focusedID == "" {
-		return ""
+		return Value{}
 	}
 
 	// Look up commission from focused container
+	source := fmt.Sprintf("focus %s on %s", focusedID, workbenchID)
 	switch {
 	case len(focusedID) > 5 && focusedID[:5] == "SHIP-":
 		ship, err := wire.ShipmentService().GetShipment(ctx, focusedID)
 		if err != nil {
-			return ""
+			return Value{}
 		}
-		return ship.CommissionID
+		return Value{ID: ship.CommissionID, Source: source}
 	case len(focusedID) > 5 && focusedID[:5] == "TOME-":
 		tome, err := wire.TomeService().GetTome(ctx, focusedID)
 		if err != nil {
-			return ""
+			return Value{}
 		}
-		return tome.CommissionID
+		return Value{ID: tome.CommissionID, Source: source}
 	}
 
-	return ""
+	return Value{}
 }
 
-// GetContextFactoryID returns the factory ID from workbench context.
-// For IMP contexts, looks up factory via workbench → workshop → factory.
+// GetContextFactoryID returns the factory ID for the current context.
+// Resolution order: ORC_FACTORY, then workbench → workshop → factory.
 // Returns empty string if no context found.
 func GetContextFactoryID() string {
-	dir, err := os.Getwd()
-	if err != nil {
-		return ""
+	if id := os.Getenv(config.EnvFactory); id != "" {
+		return id
 	}
 
-	cfg, err := config.LoadConfig(dir)
-	if err != nil {
+	workbenchID := GetContextWorkbenchID()
+	if workbenchID == "" {
 		return ""
 	}
-
-	// For workbench place, look up factory through workbench chain
-	if config.IsWorkbench(cfg.PlaceID) {
-		return getFactoryFromWorkbench(cfg.PlaceID)
-	}
-
-	return ""
+	_, factory := getPlacesFromWorkbench(workbenchID)
+	return factory.ID
 }
 
-// getFactoryFromWorkbench looks up factory ID via workbench → workshop → factory chain.
-func getFactoryFromWorkbench(workbenchID string) string {
+// getPlacesFromWorkbench looks up the workshop and factory via workbench → workshop → factory chain.
+func getPlacesFromWorkbench(workbenchID string) (workshop, factory Value) {
 	ctx := gocontext.Background()
 
 	// 1. Get workbench to find workshop
 	bench, err := wire.WorkbenchService().GetWorkbench(ctx, workbenchID)
 	if err != nil || bench.WorkshopID == "" {
-		return ""
+		return Value{}, Value{}
 	}
+	workshop = Value{ID: bench.WorkshopID, Source: "workbench " + workbenchID}
 
 	// 2. Get workshop to find factory
-	workshop, err := wire.WorkshopService().GetWorkshop(ctx, bench.WorkshopID)
-	if err != nil {
-		return ""
+	ws, err := wire.WorkshopService().GetWorkshop(ctx, bench.WorkshopID)
+	if err != nil || ws.FactoryID == "" {
+		return workshop, Value{}
+	}
+
+	return workshop, Value{ID: ws.FactoryID, Source: "workshop " + bench.WorkshopID}
+}
+
+// Resolve determines the full context for the current directory, recording
+// where each value came from. Environment variables take precedence over
+// config, and config over values derived from the workbench.
+func Resolve() *ResolvedContext {
+	dir, _ := os.Getwd()
+	cfg, cfgPath := findConfig()
+
+	resolved := &ResolvedContext{Dir: dir, ConfigPath: cfgPath}
+	resolved.Workbench = resolveWorkbench(cfg, cfgPath)
+	if resolved.Workbench.ID != "" {
+		resolved.Workshop, resolved.Factory = getPlacesFromWorkbench(resolved.Workbench.ID)
+	}
+	if id := os.Getenv(config.EnvFactory); id != "" {
+		resolved.Factory = Value{ID: id, Source: "$" + config.EnvFactory}
 	}
+	resolved.Commission = resolveCommission(cfg, cfgPath, resolved.Workbench.ID)
 
-	return workshop.FactoryID
+	return resolved
+}
+
+// SetCommissionOverride records an explicit commission in the nearest
+// .orc/config.json (creating one in the current directory if none exists).
+// An empty commissionID clears the override. Returns the config path written.
+func SetCommissionOverride(commissionID string) (string, error) {
+	cfg, cfgPath := findConfig()
+	dir := filepath.Dir(filepath.Dir(cfgPath))
+	if cfg == nil {
+		cwd, err := os.Getwd()
+		if err != nil {
+			return "", err
+		}
+		cfg = &config.Config{Version: "1.0"}
+		dir = cwd
+	}
+
+	cfg.CommissionID = commissionID
+	if err := config.SaveConfig(dir, cfg); err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, ".orc", "config.json"), nil
 }
 
 // WriteCommissionContext creates a minimal .orc/config.json for legacy commission workspaces.
@@ -0,0 +1,95 @@
+// Package activity contains the pure logic for turning workshop log entries
+// into a readable, per-actor activity feed.
+package activity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Activity kinds, from most to least specific.
+const (
+	KindClaim    = "claim"    // started work on a task
+	KindComplete = "complete" // closed a task, shipment, or note
+	KindNote     = "note"     // wrote a note
+	KindCreate   = "create"   // created any other entity
+	KindChange   = "change"   // any other field update
+	KindDelete   = "delete"   // deleted an entity
+)
+
+// Event is one workshop log entry.
+type Event struct {
+	LogID      string
+	Timestamp  string // RFC3339
+	ActorID    string
+	EntityType string
+	EntityID   string
+	Action     string // create, update, delete
+	FieldName  string
+	OldValue   string
+	NewValue   string
+	Forced     bool
+}
+
+// Item is one line of the activity feed.
+type Item struct {
+	Event
+	Kind    string
+	Summary string // e.g. "claimed TASK-001"
+}
+
+// Describe classifies an event and summarizes it in a few words.
+func Describe(e Event) (kind, summary string) {
+	switch e.Action {
+	case "create":
+		if e.EntityType == "note" {
+			return KindNote, "wrote " + e.EntityID
+		}
+		return KindCreate, "created " + e.EntityID
+	case "delete":
+		return KindDelete, "deleted " + e.EntityID
+	}
+
+	switch {
+	case e.EntityType == "task" && e.FieldName == "status" && e.NewValue == "in-progress" && e.OldValue == "open":
+		return KindClaim, "claimed " + e.EntityID
+	case e.FieldName == "status" && (e.NewValue == "closed" || e.NewValue == "resolved"):
+		return KindComplete, "completed " + e.EntityID
+	case e.FieldName != "":
+		return KindChange, fmt.Sprintf("changed %s %s: %s → %s", e.EntityID, e.FieldName, orDash(e.OldValue), orDash(e.NewValue))
+	}
+	return KindChange, "updated " + e.EntityID
+}
+
+// Feed describes events in chronological order (oldest first).
+func Feed(events []Event) []Item {
+	items := make([]Item, len(events))
+	for i, e := range events {
+		kind, summary := Describe(e)
+		items[i] = Item{Event: e, Kind: kind, Summary: summary}
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Timestamp != items[j].Timestamp {
+			return items[i].Timestamp < items[j].Timestamp
+		}
+		return items[i].LogID < items[j].LogID
+	})
+	return items
+}
+
+// NormalizeActorID maps a workbench ID to the IMP actor working in it, so
+// BENCH-003 finds IMP-BENCH-003's activity. Other IDs pass through.
+func NormalizeActorID(ref string) string {
+	if strings.HasPrefix(ref, "BENCH-") {
+		return "IMP-" + ref
+	}
+	return ref
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
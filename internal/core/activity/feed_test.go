@@ -0,0 +1,100 @@
+package activity
+
+import "testing"
+
+func TestDescribe(t *testing.T) {
+	tests := []struct {
+		name        string
+		event       Event
+		wantKind    string
+		wantSummary string
+	}{
+		{
+			name:        "task claim",
+			event:       Event{EntityType: "task", EntityID: "TASK-001", Action: "update", FieldName: "status", OldValue: "open", NewValue: "in-progress"},
+			wantKind:    KindClaim,
+			wantSummary: "claimed TASK-001",
+		},
+		{
+			name:        "unblocking is not a claim",
+			event:       Event{EntityType: "task", EntityID: "TASK-001", Action: "update", FieldName: "status", OldValue: "blocked", NewValue: "in-progress"},
+			wantKind:    KindChange,
+			wantSummary: "changed TASK-001 status: blocked → in-progress",
+		},
+		{
+			name:        "task completion",
+			event:       Event{EntityType: "task", EntityID: "TASK-001", Action: "update", FieldName: "status", OldValue: "in-progress", NewValue: "closed"},
+			wantKind:    KindComplete,
+			wantSummary: "completed TASK-001",
+		},
+		{
+			name:        "note resolved",
+			event:       Event{EntityType: "note", EntityID: "NOTE-004", Action: "update", FieldName: "status", OldValue: "open", NewValue: "resolved"},
+			wantKind:    KindComplete,
+			wantSummary: "completed NOTE-004",
+		},
+		{
+			name:        "note written",
+			event:       Event{EntityType: "note", EntityID: "NOTE-004", Action: "create"},
+			wantKind:    KindNote,
+			wantSummary: "wrote NOTE-004",
+		},
+		{
+			name:        "other entity created",
+			event:       Event{EntityType: "shipment", EntityID: "SHIP-002", Action: "create"},
+			wantKind:    KindCreate,
+			wantSummary: "created SHIP-002",
+		},
+		{
+			name:        "field cleared",
+			event:       Event{EntityType: "shipment", EntityID: "SHIP-002", Action: "update", FieldName: "assigned_workbench_id", OldValue: "BENCH-001"},
+			wantKind:    KindChange,
+			wantSummary: "changed SHIP-002 assigned_workbench_id: BENCH-001 → -",
+		},
+		{
+			name:        "delete",
+			event:       Event{EntityType: "task", EntityID: "TASK-009", Action: "delete"},
+			wantKind:    KindDelete,
+			wantSummary: "deleted TASK-009",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, summary := Describe(tt.event)
+			if kind != tt.wantKind {
+				t.Errorf("kind = %q, want %q", kind, tt.wantKind)
+			}
+			if summary != tt.wantSummary {
+				t.Errorf("summary = %q, want %q", summary, tt.wantSummary)
+			}
+		})
+	}
+}
+
+func TestFeed_Chronological(t *testing.T) {
+	items := Feed([]Event{
+		{LogID: "WL-0003", Timestamp: "2026-10-16T10:00:00Z", EntityID: "TASK-002", Action: "create"},
+		{LogID: "WL-0002", Timestamp: "2026-10-16T09:00:00Z", EntityID: "TASK-001", Action: "create"},
+		{LogID: "WL-0001", Timestamp: "2026-10-16T09:00:00Z", EntityID: "SHIP-001", Action: "create"},
+	})
+
+	want := []string{"WL-0001", "WL-0002", "WL-0003"}
+	for i, id := range want {
+		if items[i].LogID != id {
+			t.Errorf("item %d: got %s, want %s", i, items[i].LogID, id)
+		}
+	}
+}
+
+func TestNormalizeActorID(t *testing.T) {
+	for ref, want := range map[string]string{
+		"BENCH-003":     "IMP-BENCH-003",
+		"IMP-BENCH-003": "IMP-BENCH-003",
+		"GOBLIN":        "GOBLIN",
+	} {
+		if got := NormalizeActorID(ref); got != want {
+			t.Errorf("NormalizeActorID(%q) = %q, want %q", ref, got, want)
+		}
+	}
+}
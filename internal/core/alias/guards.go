@@ -0,0 +1,90 @@
+// Package alias contains the pure business logic for entity aliases.
+// Aliases are human-friendly slugs (e.g. "auth-refactor") that stand in
+// for an entity ID wherever the CLI accepts one.
+package alias
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/example/orc/internal/core/ledger"
+)
+
+// MaxSlugLength is the longest slug accepted.
+const MaxSlugLength = 40
+
+// GuardResult represents the outcome of a guard evaluation.
+type GuardResult struct {
+	Allowed bool
+	Reason  string
+}
+
+// Error converts the guard result to an error if not allowed.
+func (r GuardResult) Error() error {
+	if r.Allowed {
+		return nil
+	}
+	return fmt.Errorf("%s", r.Reason)
+}
+
+// entityTypesByPrefix maps aliasable ID prefixes to entity types.
+var entityTypesByPrefix = map[string]string{
+	"SHIP": "shipment",
+	"TASK": "task",
+	"TOME": "tome",
+}
+
+// IsSlug reports whether s is shaped like an alias slug: lowercase
+// letters and digits in hyphen-separated words, starting with a letter.
+// Entity IDs (uppercase prefix) are never slugs.
+func IsSlug(s string) bool {
+	return len(s) <= MaxSlugLength && ledger.SlugPattern.MatchString(s)
+}
+
+// EntityType returns the aliasable entity type for an ID, based on its prefix.
+func EntityType(entityID string) (string, bool) {
+	prefix, _, found := strings.Cut(entityID, "-")
+	if !found {
+		return "", false
+	}
+	entityType, ok := entityTypesByPrefix[prefix]
+	return entityType, ok
+}
+
+// AssignAliasContext provides context for alias assignment guards.
+type AssignAliasContext struct {
+	EntityID     string
+	Slug         string
+	HolderID     string // Entity in the same commission already using the slug, empty if none
+	HolderExists bool   // Whether HolderID still exists (aliases of deleted entities can be reused)
+}
+
+// CanAssignAlias evaluates whether a slug can be assigned to an entity.
+// Rules:
+// - Entity must be a shipment, task, or tome
+// - Slug must be lowercase words separated by hyphens, at most MaxSlugLength characters
+// - Slug must not be in use by another existing entity in the same commission
+func CanAssignAlias(ctx AssignAliasContext) GuardResult {
+	if _, ok := EntityType(ctx.EntityID); !ok {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot alias %s: only shipments, tasks, and tomes can have aliases", ctx.EntityID),
+		}
+	}
+
+	if !IsSlug(ctx.Slug) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("invalid alias '%s': use lowercase letters, digits, and hyphens, starting with a letter (max %d characters)", ctx.Slug, MaxSlugLength),
+		}
+	}
+
+	if ctx.HolderID != "" && ctx.HolderID != ctx.EntityID && ctx.HolderExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("alias '%s' is already used by %s in this commission", ctx.Slug, ctx.HolderID),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
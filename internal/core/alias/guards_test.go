@@ -0,0 +1,109 @@
+package alias
+
+import "testing"
+
+func TestIsSlug(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"auth-refactor", true},
+		{"v2", true},
+		{"a", true},
+		{"SHIP-014", false},
+		{"Auth-refactor", false},
+		{"2fa", false},
+		{"auth--refactor", false},
+		{"auth-", false},
+		{"auth_refactor", false},
+		{"", false},
+		{"a2345678901234567890123456789012345678901", false}, // 41 characters
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := IsSlug(tt.input); got != tt.want {
+				t.Errorf("IsSlug(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEntityType(t *testing.T) {
+	tests := []struct {
+		id       string
+		wantType string
+		wantOK   bool
+	}{
+		{"SHIP-014", "shipment", true},
+		{"TASK-001", "task", true},
+		{"TOME-003", "tome", true},
+		{"NOTE-001", "", false},
+		{"auth-refactor", "", false},
+		{"SHIP", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			gotType, gotOK := EntityType(tt.id)
+			if gotType != tt.wantType || gotOK != tt.wantOK {
+				t.Errorf("EntityType(%q) = (%q, %v), want (%q, %v)", tt.id, gotType, gotOK, tt.wantType, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCanAssignAlias(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         AssignAliasContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can assign unused slug",
+			ctx:         AssignAliasContext{EntityID: "SHIP-014", Slug: "auth-refactor"},
+			wantAllowed: true,
+		},
+		{
+			name:        "can reassign own slug",
+			ctx:         AssignAliasContext{EntityID: "SHIP-014", Slug: "auth-refactor", HolderID: "SHIP-014", HolderExists: true},
+			wantAllowed: true,
+		},
+		{
+			name:        "can take slug from deleted entity",
+			ctx:         AssignAliasContext{EntityID: "SHIP-014", Slug: "auth-refactor", HolderID: "SHIP-002", HolderExists: false},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot alias unsupported entity",
+			ctx:         AssignAliasContext{EntityID: "NOTE-001", Slug: "idea"},
+			wantAllowed: false,
+			wantReason:  "cannot alias NOTE-001: only shipments, tasks, and tomes can have aliases",
+		},
+		{
+			name:        "cannot assign invalid slug",
+			ctx:         AssignAliasContext{EntityID: "SHIP-014", Slug: "Auth Refactor"},
+			wantAllowed: false,
+			wantReason:  "invalid alias 'Auth Refactor': use lowercase letters, digits, and hyphens, starting with a letter (max 40 characters)",
+		},
+		{
+			name:        "cannot assign slug used by another entity",
+			ctx:         AssignAliasContext{EntityID: "SHIP-014", Slug: "auth-refactor", HolderID: "TASK-002", HolderExists: true},
+			wantAllowed: false,
+			wantReason:  "alias 'auth-refactor' is already used by TASK-002 in this commission",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanAssignAlias(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
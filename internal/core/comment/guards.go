@@ -0,0 +1,143 @@
+// Package comment contains the pure business logic for entity comments.
+// Comments are short, attributed remarks attached to any entity; unlike
+// notes they are not containers and have no lifecycle.
+package comment
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GuardResult represents the outcome of a guard evaluation.
+type GuardResult struct {
+	Allowed bool
+	Reason  string
+}
+
+// Error converts the guard result to an error if not allowed.
+func (r GuardResult) Error() error {
+	if r.Allowed {
+		return nil
+	}
+	return fmt.Errorf("%s", r.Reason)
+}
+
+// entityTypesByPrefix maps commentable ID prefixes to entity types.
+var entityTypesByPrefix = map[string]string{
+	"COMM": "commission",
+	"SHIP": "shipment",
+	"TASK": "task",
+	"TOME": "tome",
+	"NOTE": "note",
+	"PLAN": "plan",
+}
+
+// EntityType returns the commentable entity type for an ID, based on its prefix.
+func EntityType(entityID string) (string, bool) {
+	prefix, _, found := strings.Cut(entityID, "-")
+	if !found {
+		return "", false
+	}
+	entityType, ok := entityTypesByPrefix[prefix]
+	return entityType, ok
+}
+
+// AddCommentContext provides context for comment creation guards.
+type AddCommentContext struct {
+	EntityID        string
+	EntityExists    bool
+	Body            string
+	ReplyToID       string // Comment being replied to, empty for a new thread
+	ReplyToEntityID string // Entity the replied-to comment is on, empty if it doesn't exist
+}
+
+// CanAddComment evaluates whether a comment can be added.
+// Rules:
+// - Entity must be a commission, shipment, task, tome, note, or plan
+// - Entity must exist
+// - Body must not be blank
+// - A reply must answer an existing comment on the same entity
+func CanAddComment(ctx AddCommentContext) GuardResult {
+	if _, ok := EntityType(ctx.EntityID); !ok {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot comment on %s: only commissions, shipments, tasks, tomes, notes, and plans take comments", ctx.EntityID),
+		}
+	}
+
+	if !ctx.EntityExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("%s not found", ctx.EntityID),
+		}
+	}
+
+	if strings.TrimSpace(ctx.Body) == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  "comment text cannot be empty",
+		}
+	}
+
+	if ctx.ReplyToID != "" {
+		if ctx.ReplyToEntityID == "" {
+			return GuardResult{
+				Allowed: false,
+				Reason:  fmt.Sprintf("comment %s not found", ctx.ReplyToID),
+			}
+		}
+		if ctx.ReplyToEntityID != ctx.EntityID {
+			return GuardResult{
+				Allowed: false,
+				Reason:  fmt.Sprintf("cannot reply to %s: it is on %s, not %s", ctx.ReplyToID, ctx.ReplyToEntityID, ctx.EntityID),
+			}
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// Entry is a comment's identity and parent, in chronological order.
+type Entry struct {
+	ID        string
+	ReplyToID string
+}
+
+// Placement locates an entry in thread order.
+type Placement struct {
+	Index int // Position of the entry in the input slice
+	Depth int // 0 for thread starters, 1 for direct replies, and so on
+}
+
+// Thread orders chronological entries so each reply follows its parent
+// (and the parent's earlier replies). Replies to unknown comments are
+// treated as thread starters.
+func Thread(entries []Entry) []Placement {
+	known := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		known[e.ID] = true
+	}
+
+	children := make(map[string][]int)
+	var roots []int
+	for i, e := range entries {
+		if e.ReplyToID != "" && known[e.ReplyToID] && e.ReplyToID != e.ID {
+			children[e.ReplyToID] = append(children[e.ReplyToID], i)
+		} else {
+			roots = append(roots, i)
+		}
+	}
+
+	placements := make([]Placement, 0, len(entries))
+	var walk func(index, depth int)
+	walk = func(index, depth int) {
+		placements = append(placements, Placement{Index: index, Depth: depth})
+		for _, child := range children[entries[index].ID] {
+			walk(child, depth+1)
+		}
+	}
+	for _, root := range roots {
+		walk(root, 0)
+	}
+	return placements
+}
@@ -0,0 +1,119 @@
+package comment
+
+import "testing"
+
+func TestEntityType(t *testing.T) {
+	tests := []struct {
+		id       string
+		wantType string
+		wantOK   bool
+	}{
+		{"COMM-001", "commission", true},
+		{"TASK-042", "task", true},
+		{"NOTE-007", "note", true},
+		{"PLAN-003", "plan", true},
+		{"BENCH-001", "", false},
+		{"TASK", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			gotType, gotOK := EntityType(tt.id)
+			if gotType != tt.wantType || gotOK != tt.wantOK {
+				t.Errorf("EntityType(%q) = (%q, %v), want (%q, %v)", tt.id, gotType, gotOK, tt.wantType, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCanAddComment(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         AddCommentContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can comment on existing entity",
+			ctx:         AddCommentContext{EntityID: "TASK-042", EntityExists: true, Body: "blocked on infra"},
+			wantAllowed: true,
+		},
+		{
+			name:        "can reply on same entity",
+			ctx:         AddCommentContext{EntityID: "TASK-042", EntityExists: true, Body: "unblocked", ReplyToID: "CMT-001", ReplyToEntityID: "TASK-042"},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot comment on unsupported entity",
+			ctx:         AddCommentContext{EntityID: "BENCH-001", EntityExists: true, Body: "hi"},
+			wantAllowed: false,
+			wantReason:  "cannot comment on BENCH-001: only commissions, shipments, tasks, tomes, notes, and plans take comments",
+		},
+		{
+			name:        "cannot comment on missing entity",
+			ctx:         AddCommentContext{EntityID: "TASK-999", Body: "hi"},
+			wantAllowed: false,
+			wantReason:  "TASK-999 not found",
+		},
+		{
+			name:        "cannot add blank comment",
+			ctx:         AddCommentContext{EntityID: "TASK-042", EntityExists: true, Body: "  \n"},
+			wantAllowed: false,
+			wantReason:  "comment text cannot be empty",
+		},
+		{
+			name:        "cannot reply to missing comment",
+			ctx:         AddCommentContext{EntityID: "TASK-042", EntityExists: true, Body: "ok", ReplyToID: "CMT-009"},
+			wantAllowed: false,
+			wantReason:  "comment CMT-009 not found",
+		},
+		{
+			name:        "cannot reply across entities",
+			ctx:         AddCommentContext{EntityID: "TASK-042", EntityExists: true, Body: "ok", ReplyToID: "CMT-001", ReplyToEntityID: "SHIP-010"},
+			wantAllowed: false,
+			wantReason:  "cannot reply to CMT-001: it is on SHIP-010, not TASK-042",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanAddComment(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestThread(t *testing.T) {
+	entries := []Entry{
+		{ID: "CMT-001"},
+		{ID: "CMT-002"},
+		{ID: "CMT-003", ReplyToID: "CMT-001"},
+		{ID: "CMT-004", ReplyToID: "CMT-003"},
+		{ID: "CMT-005", ReplyToID: "CMT-001"},
+		{ID: "CMT-006", ReplyToID: "CMT-404"}, // Parent not in list
+	}
+
+	want := []Placement{
+		{Index: 0, Depth: 0},
+		{Index: 2, Depth: 1},
+		{Index: 3, Depth: 2},
+		{Index: 4, Depth: 1},
+		{Index: 1, Depth: 0},
+		{Index: 5, Depth: 0},
+	}
+
+	got := Thread(entries)
+	if len(got) != len(want) {
+		t.Fatalf("got %d placements, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("placement %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
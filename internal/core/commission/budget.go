@@ -0,0 +1,133 @@
+package commission
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Budget units.
+const (
+	BudgetUnitHours  = "hours"
+	BudgetUnitPoints = "points"
+)
+
+// DefaultBudgetThresholds are the warning percentages used when none are configured.
+const DefaultBudgetThresholds = "75,90"
+
+// SetBudgetContext provides context for budget configuration guards.
+type SetBudgetContext struct {
+	CommissionID     string
+	CommissionExists bool
+	Unit             string
+	Amount           float64
+	Thresholds       string
+}
+
+// CanSetBudget evaluates whether a commission budget can be configured.
+// Rules:
+// - Commission must exist
+// - Unit must be hours or points
+// - Amount must be positive
+// - Thresholds must be comma-separated percentages between 1 and 100
+func CanSetBudget(ctx SetBudgetContext) GuardResult {
+	if !ctx.CommissionExists {
+		return GuardResult{Allowed: false, Reason: fmt.Sprintf("commission %s not found", ctx.CommissionID)}
+	}
+	if ctx.Unit != BudgetUnitHours && ctx.Unit != BudgetUnitPoints {
+		return GuardResult{Allowed: false, Reason: fmt.Sprintf("invalid budget unit %q: use hours or points", ctx.Unit)}
+	}
+	if ctx.Amount <= 0 {
+		return GuardResult{Allowed: false, Reason: "budget amount must be positive"}
+	}
+	if _, err := ParseThresholds(ctx.Thresholds); err != nil {
+		return GuardResult{Allowed: false, Reason: err.Error()}
+	}
+	return GuardResult{Allowed: true}
+}
+
+// ParseThresholds parses comma-separated warning percentages (e.g. "75,90")
+// into ascending order, without duplicates.
+func ParseThresholds(s string) ([]int, error) {
+	seen := map[int]bool{}
+	var thresholds []int
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		pct, err := strconv.Atoi(strings.TrimSuffix(part, "%"))
+		if err != nil || pct < 1 || pct > 100 {
+			return nil, fmt.Errorf("invalid budget threshold %q: use percentages between 1 and 100", part)
+		}
+		if !seen[pct] {
+			seen[pct] = true
+			thresholds = append(thresholds, pct)
+		}
+	}
+	sort.Ints(thresholds)
+	return thresholds, nil
+}
+
+// BudgetTask is the slice of a task that budget consumption depends on.
+type BudgetTask struct {
+	Status      string
+	Points      int
+	ClaimedAt   time.Time // Zero if never claimed
+	CompletedAt time.Time // Zero if not completed
+}
+
+// Spent computes budget consumption from a commission's tasks.
+// Hours: time from claim to completion, or to now for tasks still being
+// worked. Unclaimed tasks spend nothing.
+// Points: estimates of closed tasks.
+func Spent(unit string, tasks []BudgetTask, now time.Time) float64 {
+	var spent float64
+	for _, t := range tasks {
+		switch unit {
+		case BudgetUnitHours:
+			if t.ClaimedAt.IsZero() {
+				continue
+			}
+			end := t.CompletedAt
+			if end.IsZero() {
+				end = now
+			}
+			if end.After(t.ClaimedAt) {
+				spent += end.Sub(t.ClaimedAt).Hours()
+			}
+		case BudgetUnitPoints:
+			if t.Status == "closed" {
+				spent += float64(t.Points)
+			}
+		}
+	}
+	return spent
+}
+
+// BudgetStatus is the evaluated state of a budget.
+type BudgetStatus struct {
+	Percent   float64 // Spent as a percentage of the amount
+	Threshold int     // Highest threshold reached; 0 if none
+	Over      bool    // Spent exceeds the amount
+}
+
+// EvaluateBudget compares spend against the amount and warning thresholds.
+// Thresholds must be ascending (see ParseThresholds).
+func EvaluateBudget(amount, spent float64, thresholds []int) BudgetStatus {
+	if amount <= 0 {
+		return BudgetStatus{}
+	}
+	status := BudgetStatus{
+		Percent: spent / amount * 100,
+		Over:    spent > amount,
+	}
+	for _, th := range thresholds {
+		if status.Percent >= float64(th) {
+			status.Threshold = th
+		}
+	}
+	return status
+}
@@ -0,0 +1,112 @@
+package commission
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCanSetBudget(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         SetBudgetContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can set hours budget",
+			ctx:         SetBudgetContext{CommissionID: "COMM-001", CommissionExists: true, Unit: "hours", Amount: 40, Thresholds: "75,90"},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot set budget on missing commission",
+			ctx:         SetBudgetContext{CommissionID: "COMM-999", Unit: "hours", Amount: 40, Thresholds: "75"},
+			wantAllowed: false,
+			wantReason:  "commission COMM-999 not found",
+		},
+		{
+			name:        "cannot set unknown unit",
+			ctx:         SetBudgetContext{CommissionID: "COMM-001", CommissionExists: true, Unit: "dollars", Amount: 40, Thresholds: "75"},
+			wantAllowed: false,
+			wantReason:  `invalid budget unit "dollars": use hours or points`,
+		},
+		{
+			name:        "cannot set zero amount",
+			ctx:         SetBudgetContext{CommissionID: "COMM-001", CommissionExists: true, Unit: "points", Amount: 0, Thresholds: "75"},
+			wantAllowed: false,
+			wantReason:  "budget amount must be positive",
+		},
+		{
+			name:        "cannot set out of range threshold",
+			ctx:         SetBudgetContext{CommissionID: "COMM-001", CommissionExists: true, Unit: "points", Amount: 20, Thresholds: "75,150"},
+			wantAllowed: false,
+			wantReason:  `invalid budget threshold "150": use percentages between 1 and 100`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanSetBudget(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("CanSetBudget() Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("CanSetBudget() Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestParseThresholds(t *testing.T) {
+	got, err := ParseThresholds("90, 75%,90,")
+	if err != nil {
+		t.Fatalf("ParseThresholds failed: %v", err)
+	}
+	if want := []int{75, 90}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseThresholds() = %v, want %v", got, want)
+	}
+
+	if _, err := ParseThresholds("high"); err == nil {
+		t.Error("expected error for non-numeric threshold")
+	}
+}
+
+func TestSpent(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	tasks := []BudgetTask{
+		{Status: "closed", Points: 3, ClaimedAt: now.Add(-10 * time.Hour), CompletedAt: now.Add(-6 * time.Hour)},
+		{Status: "in-progress", Points: 5, ClaimedAt: now.Add(-2 * time.Hour)},
+		{Status: "open", Points: 8},
+	}
+
+	if got := Spent(BudgetUnitHours, tasks, now); math.Abs(got-6) > 1e-9 {
+		t.Errorf("Spent(hours) = %v, want 6", got)
+	}
+	if got := Spent(BudgetUnitPoints, tasks, now); got != 3 {
+		t.Errorf("Spent(points) = %v, want 3", got)
+	}
+}
+
+func TestEvaluateBudget(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount float64
+		spent  float64
+		want   BudgetStatus
+	}{
+		{"under all thresholds", 40, 10, BudgetStatus{Percent: 25}},
+		{"past first threshold", 40, 32, BudgetStatus{Percent: 80, Threshold: 75}},
+		{"at last threshold", 40, 36, BudgetStatus{Percent: 90, Threshold: 90}},
+		{"over budget", 40, 50, BudgetStatus{Percent: 125, Threshold: 90, Over: true}},
+		{"no amount", 0, 5, BudgetStatus{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EvaluateBudget(tt.amount, tt.spent, []int{75, 90}); got != tt.want {
+				t.Errorf("EvaluateBudget() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package commission
+
+// Health badges, from best to worst.
+const (
+	HealthOK        = "ok"
+	HealthAttention = "attention"
+	HealthAtRisk    = "at-risk"
+)
+
+// Health thresholds.
+const (
+	// AtRiskBlockedTasks is the blocked task count that puts a commission at risk.
+	AtRiskBlockedTasks = 3
+	// AttentionPendingApprovals is the draft plan count that needs attention.
+	AttentionPendingApprovals = 3
+)
+
+// HealthContext holds a commission's rollup counts.
+type HealthContext struct {
+	ReadyTasks       int
+	InProgressTasks  int
+	BlockedTasks     int
+	PendingApprovals int // Draft plans on open tasks
+}
+
+// Health grades a commission from its rollup counts.
+// Rules:
+// - At risk: AtRiskBlockedTasks or more blocked tasks, or at least half of the
+// unfinished tasks blocked
+// - Attention: any blocked task, AttentionPendingApprovals or more plans
+// awaiting approval, or ready tasks with none in progress
+// - Otherwise ok
+func Health(ctx HealthContext) string {
+	unfinished := ctx.ReadyTasks + ctx.InProgressTasks + ctx.BlockedTasks
+	if ctx.BlockedTasks >= AtRiskBlockedTasks || (ctx.BlockedTasks > 0 && ctx.BlockedTasks*2 >= unfinished) {
+		return HealthAtRisk
+	}
+	if ctx.BlockedTasks > 0 || ctx.PendingApprovals >= AttentionPendingApprovals || (ctx.ReadyTasks > 0 && ctx.InProgressTasks == 0) {
+		return HealthAttention
+	}
+	return HealthOK
+}
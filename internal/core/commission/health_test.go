@@ -0,0 +1,28 @@
+package commission
+
+import "testing"
+
+func TestHealth(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  HealthContext
+		want string
+	}{
+		{name: "nothing open", ctx: HealthContext{}, want: HealthOK},
+		{name: "work moving", ctx: HealthContext{ReadyTasks: 4, InProgressTasks: 2}, want: HealthOK},
+		{name: "ready work, nothing in progress", ctx: HealthContext{ReadyTasks: 2}, want: HealthAttention},
+		{name: "one blocked among many", ctx: HealthContext{ReadyTasks: 3, InProgressTasks: 2, BlockedTasks: 1}, want: HealthAttention},
+		{name: "approvals piling up", ctx: HealthContext{InProgressTasks: 3, PendingApprovals: 3}, want: HealthAttention},
+		{name: "approvals below threshold", ctx: HealthContext{InProgressTasks: 3, PendingApprovals: 2}, want: HealthOK},
+		{name: "half blocked", ctx: HealthContext{InProgressTasks: 1, BlockedTasks: 1}, want: HealthAtRisk},
+		{name: "many blocked", ctx: HealthContext{ReadyTasks: 10, InProgressTasks: 5, BlockedTasks: 3}, want: HealthAtRisk},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Health(tt.ctx); got != tt.want {
+				t.Errorf("Health() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
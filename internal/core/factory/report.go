@@ -0,0 +1,81 @@
+package factory
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TeardownReport is the final record of a factory written at teardown.
+type TeardownReport struct {
+	FactoryID   string
+	FactoryName string
+	GeneratedAt string
+	Commissions []ReportCommission
+	Workshops   []string // Archived workshop IDs
+	Workbenches []string // Archived workbench IDs
+	Worktrees   []string // Removed worktree paths
+	Sessions    []string // Killed tmux sessions
+}
+
+// ReportCommission is a commission's state at teardown.
+type ReportCommission struct {
+	ID        string
+	Title     string
+	Status    string
+	Shipments []ReportShipment
+}
+
+// ReportShipment is a shipment's state at teardown.
+type ReportShipment struct {
+	ID          string
+	Title       string
+	Status      string
+	TaskCount   int
+	TasksClosed int
+	Disposition string // Empty when teardown did not touch it
+}
+
+// RenderTeardownReport renders a teardown report as markdown.
+func RenderTeardownReport(r TeardownReport) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Factory %s (%s) teardown\n\n", r.FactoryName, r.FactoryID)
+	fmt.Fprintf(&b, "Generated %s\n", r.GeneratedAt)
+
+	b.WriteString("\n## Commissions\n")
+	if len(r.Commissions) == 0 {
+		b.WriteString("\nNone.\n")
+	}
+	for _, c := range r.Commissions {
+		fmt.Fprintf(&b, "\n### %s: %s (%s)\n\n", c.ID, c.Title, c.Status)
+		if len(c.Shipments) == 0 {
+			b.WriteString("No shipments.\n")
+			continue
+		}
+		b.WriteString("| Shipment | Title | Status | Tasks | Teardown |\n")
+		b.WriteString("|---|---|---|---|---|\n")
+		for _, s := range c.Shipments {
+			disposition := s.Disposition
+			if disposition == "" {
+				disposition = "-"
+			}
+			fmt.Fprintf(&b, "| %s | %s | %s | %d/%d | %s |\n",
+				s.ID, strings.ReplaceAll(s.Title, "|", "\\|"), s.Status, s.TasksClosed, s.TaskCount, disposition)
+		}
+	}
+
+	b.WriteString("\n## Infrastructure\n\n")
+	writeReportList(&b, "Workshops archived", r.Workshops)
+	writeReportList(&b, "Workbenches archived", r.Workbenches)
+	writeReportList(&b, "Worktrees removed", r.Worktrees)
+	writeReportList(&b, "Tmux sessions killed", r.Sessions)
+
+	return b.String()
+}
+
+func writeReportList(b *strings.Builder, label string, items []string) {
+	if len(items) == 0 {
+		fmt.Fprintf(b, "- %s: none\n", label)
+		return
+	}
+	fmt.Fprintf(b, "- %s: %s\n", label, strings.Join(items, ", "))
+}
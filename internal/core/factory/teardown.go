@@ -0,0 +1,103 @@
+package factory
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Shipment dispositions for factory teardown.
+const (
+	DispositionComplete = "complete" // Close the shipment
+	DispositionReassign = "reassign" // Move it to a workbench in another factory
+	DispositionRelease  = "release"  // Leave it open and unassigned
+)
+
+// DefaultDisposition picks what teardown does with an open shipment nobody
+// decided on: finished work is completed, anything else is released so it
+// can be picked up elsewhere.
+func DefaultDisposition(taskCount, tasksClosed int) string {
+	if taskCount > 0 && tasksClosed == taskCount {
+		return DispositionComplete
+	}
+	return DispositionRelease
+}
+
+// TeardownShipment describes an open shipment and what teardown will do with it.
+type TeardownShipment struct {
+	ShipmentID        string
+	Disposition       string
+	TargetWorkbenchID string // For reassign
+	TargetActive      bool
+	TargetInFactory   bool
+}
+
+// TeardownFactoryContext provides context for factory teardown guards.
+type TeardownFactoryContext struct {
+	FactoryID        string
+	FactoryExists    bool
+	FactoryStatus    string
+	Shipments        []TeardownShipment
+	DirtyWorkbenches []string // Workbenches whose worktree has uncommitted changes
+	Force            bool
+}
+
+// CanTeardownFactory evaluates whether a factory can be torn down.
+// Rules:
+// - Factory must exist and not already be archived
+// - Every open shipment must be completed, reassigned, or released
+// - A reassigned shipment needs an active workbench outside the factory
+// - Workbenches with uncommitted changes require --force
+func CanTeardownFactory(ctx TeardownFactoryContext) GuardResult {
+	if !ctx.FactoryExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("factory %s not found", ctx.FactoryID),
+		}
+	}
+
+	if ctx.FactoryStatus == "archived" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("factory %s is already archived", ctx.FactoryID),
+		}
+	}
+
+	for _, s := range ctx.Shipments {
+		switch s.Disposition {
+		case DispositionComplete, DispositionRelease:
+		case DispositionReassign:
+			if s.TargetWorkbenchID == "" {
+				return GuardResult{
+					Allowed: false,
+					Reason:  fmt.Sprintf("shipment %s: reassign needs a target workbench", s.ShipmentID),
+				}
+			}
+			if !s.TargetActive {
+				return GuardResult{
+					Allowed: false,
+					Reason:  fmt.Sprintf("shipment %s: workbench %s is not active", s.ShipmentID, s.TargetWorkbenchID),
+				}
+			}
+			if s.TargetInFactory {
+				return GuardResult{
+					Allowed: false,
+					Reason:  fmt.Sprintf("shipment %s: workbench %s is being torn down with factory %s", s.ShipmentID, s.TargetWorkbenchID, ctx.FactoryID),
+				}
+			}
+		default:
+			return GuardResult{
+				Allowed: false,
+				Reason:  fmt.Sprintf("shipment %s: unknown disposition %q (want complete, reassign, or release)", s.ShipmentID, s.Disposition),
+			}
+		}
+	}
+
+	if len(ctx.DirtyWorkbenches) > 0 && !ctx.Force {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("workbenches with uncommitted changes: %s. Commit or stash them, or use --force to discard", strings.Join(ctx.DirtyWorkbenches, ", ")),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
@@ -0,0 +1,163 @@
+package factory
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefaultDisposition(t *testing.T) {
+	tests := []struct {
+		name        string
+		taskCount   int
+		tasksClosed int
+		want        string
+	}{
+		{"all tasks closed completes", 3, 3, DispositionComplete},
+		{"open tasks release", 3, 2, DispositionRelease},
+		{"no tasks release", 0, 0, DispositionRelease},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DefaultDisposition(tt.taskCount, tt.tasksClosed); got != tt.want {
+				t.Errorf("DefaultDisposition(%d, %d) = %q, want %q", tt.taskCount, tt.tasksClosed, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanTeardownFactory(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         TeardownFactoryContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name: "can tear down factory with decided shipments",
+			ctx: TeardownFactoryContext{
+				FactoryID:     "FACT-002",
+				FactoryExists: true,
+				FactoryStatus: "active",
+				Shipments: []TeardownShipment{
+					{ShipmentID: "SHIP-001", Disposition: DispositionComplete},
+					{ShipmentID: "SHIP-002", Disposition: DispositionRelease},
+					{ShipmentID: "SHIP-003", Disposition: DispositionReassign, TargetWorkbenchID: "BENCH-009", TargetActive: true},
+				},
+			},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot tear down non-existent factory",
+			ctx:         TeardownFactoryContext{FactoryID: "FACT-999"},
+			wantAllowed: false,
+			wantReason:  "factory FACT-999 not found",
+		},
+		{
+			name:        "cannot tear down archived factory",
+			ctx:         TeardownFactoryContext{FactoryID: "FACT-002", FactoryExists: true, FactoryStatus: "archived"},
+			wantAllowed: false,
+			wantReason:  "factory FACT-002 is already archived",
+		},
+		{
+			name: "cannot reassign without a target",
+			ctx: TeardownFactoryContext{
+				FactoryID: "FACT-002", FactoryExists: true, FactoryStatus: "active",
+				Shipments: []TeardownShipment{{ShipmentID: "SHIP-001", Disposition: DispositionReassign}},
+			},
+			wantAllowed: false,
+			wantReason:  "shipment SHIP-001: reassign needs a target workbench",
+		},
+		{
+			name: "cannot reassign to archived workbench",
+			ctx: TeardownFactoryContext{
+				FactoryID: "FACT-002", FactoryExists: true, FactoryStatus: "active",
+				Shipments: []TeardownShipment{{ShipmentID: "SHIP-001", Disposition: DispositionReassign, TargetWorkbenchID: "BENCH-009"}},
+			},
+			wantAllowed: false,
+			wantReason:  "shipment SHIP-001: workbench BENCH-009 is not active",
+		},
+		{
+			name: "cannot reassign within the factory",
+			ctx: TeardownFactoryContext{
+				FactoryID: "FACT-002", FactoryExists: true, FactoryStatus: "active",
+				Shipments: []TeardownShipment{{ShipmentID: "SHIP-001", Disposition: DispositionReassign, TargetWorkbenchID: "BENCH-003", TargetActive: true, TargetInFactory: true}},
+			},
+			wantAllowed: false,
+			wantReason:  "shipment SHIP-001: workbench BENCH-003 is being torn down with factory FACT-002",
+		},
+		{
+			name: "cannot use unknown disposition",
+			ctx: TeardownFactoryContext{
+				FactoryID: "FACT-002", FactoryExists: true, FactoryStatus: "active",
+				Shipments: []TeardownShipment{{ShipmentID: "SHIP-001", Disposition: "abandon"}},
+			},
+			wantAllowed: false,
+			wantReason:  `shipment SHIP-001: unknown disposition "abandon" (want complete, reassign, or release)`,
+		},
+		{
+			name: "dirty workbenches require force",
+			ctx: TeardownFactoryContext{
+				FactoryID: "FACT-002", FactoryExists: true, FactoryStatus: "active",
+				DirtyWorkbenches: []string{"BENCH-003", "BENCH-004"},
+			},
+			wantAllowed: false,
+			wantReason:  "workbenches with uncommitted changes: BENCH-003, BENCH-004. Commit or stash them, or use --force to discard",
+		},
+		{
+			name: "dirty workbenches allowed with force",
+			ctx: TeardownFactoryContext{
+				FactoryID: "FACT-002", FactoryExists: true, FactoryStatus: "active",
+				DirtyWorkbenches: []string{"BENCH-003"},
+				Force:            true,
+			},
+			wantAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanTeardownFactory(tt.ctx)
+
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("CanTeardownFactory() Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+
+			if result.Reason != tt.wantReason {
+				t.Errorf("CanTeardownFactory() Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestRenderTeardownReport(t *testing.T) {
+	report := RenderTeardownReport(TeardownReport{
+		FactoryID:   "FACT-002",
+		FactoryName: "staging",
+		GeneratedAt: "2026-10-16 14:00",
+		Commissions: []ReportCommission{
+			{ID: "COMM-003", Title: "Billing", Status: "active", Shipments: []ReportShipment{
+				{ID: "SHIP-010", Title: "Invoices | PDF", Status: "closed", TaskCount: 2, TasksClosed: 2, Disposition: DispositionComplete},
+				{ID: "SHIP-011", Title: "Refunds", Status: "draft"},
+			}},
+			{ID: "COMM-004", Title: "Empty", Status: "initial"},
+		},
+		Workshops: []string{"WORK-004"},
+		Sessions:  []string{"staging-main"},
+	})
+
+	for _, want := range []string{
+		"# Factory staging (FACT-002) teardown",
+		"### COMM-003: Billing (active)",
+		`| SHIP-010 | Invoices \| PDF | closed | 2/2 | complete |`,
+		"| SHIP-011 | Refunds | draft | 0/0 | - |",
+		"### COMM-004: Empty (initial)\n\nNo shipments.",
+		"- Workshops archived: WORK-004",
+		"- Worktrees removed: none",
+		"- Tmux sessions killed: staging-main",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("report missing %q:\n%s", want, report)
+		}
+	}
+}
@@ -0,0 +1,356 @@
+// Package flow contains the pure logic for canned workflows: declarative,
+// multi-step flows that 'orc flow run' executes in order.
+package flow
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/example/orc/internal/core/yamlsubset"
+)
+
+// Step actions a flow can run.
+const (
+	ActionCreateShipment = "shipment.create"
+	ActionCreateTasks    = "task.create"
+	ActionAssign         = "shipment.assign"
+	ActionCreateNote     = "note.create"
+	ActionFocus          = "focus"
+)
+
+// actionArgs lists the arguments each action takes.
+var actionArgs = map[string]struct{ required, optional []string }{
+	ActionCreateShipment: {required: []string{"title"}, optional: []string{"commission", "description", "repo", "branch"}},
+	ActionCreateTasks:    {optional: []string{"commission", "shipment", "title", "description", "type", "tag"}},
+	ActionAssign:         {required: []string{"shipment", "workbench"}},
+	ActionCreateNote:     {required: []string{"title"}, optional: []string{"commission", "content", "type", "shipment", "tome"}},
+	ActionFocus:          {required: []string{"workbench", "target"}},
+}
+
+// Actions returns the supported step actions, sorted.
+func Actions() []string {
+	actions := make([]string, 0, len(actionArgs))
+	for a := range actionArgs {
+		actions = append(actions, a)
+	}
+	sort.Strings(actions)
+	return actions
+}
+
+// Flow is a parsed flow definition.
+type Flow struct {
+	Name        string
+	Description string
+	Commission  string   // Default commission for steps that create entities
+	Params      []string // Values supplied with --set
+	Steps       []Step
+}
+
+// Step is one step of a flow. Argument values may reference params and the
+// output of earlier steps as ${name}.
+type Step struct {
+	ID     string
+	Action string
+	Args   map[string]string
+	Titles []string // task.create: one task per title
+}
+
+// StepCommission returns the commission a step creates entities in.
+func (f *Flow) StepCommission(s Step) string {
+	if c := s.Args["commission"]; c != "" {
+		return c
+	}
+	return f.Commission
+}
+
+// Parse reads a flow file: a YAML document with name, description,
+// commission, a params list, and a steps list. Each step has an id, an
+// action under "do", and the action's arguments.
+//
+//	name: kickoff
+//	commission: ${commission}
+//	params:
+//	  - commission
+//	  - title
+//	steps:
+//	  - id: ship
+//	    do: shipment.create
+//	    title: ${title}
+//	  - id: tasks
+//	    do: task.create
+//	    shipment: ${ship}
+//	    titles:
+//	      - Write the spec
+//	      - Build it
+//
+// The file is read with yamlsubset, so YAML beyond that subset (flow
+// collections, anchors, multi-line values, ...) is an error.
+func Parse(data string) (*Flow, error) {
+	root, err := yamlsubset.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	if root.Kind != yamlsubset.Mapping {
+		return nil, root.Errorf("expected name, steps, ... at the top level, got a %s", root.Kind)
+	}
+
+	f := &Flow{}
+	for _, pair := range root.Pairs {
+		switch pair.Key {
+		case "name", "description", "commission":
+			value, err := scalarValue(pair)
+			if err != nil {
+				return nil, err
+			}
+			switch pair.Key {
+			case "name":
+				f.Name = value
+			case "description":
+				f.Description = value
+			default:
+				f.Commission = value
+			}
+		case "params":
+			if f.Params, err = scalarList(pair); err != nil {
+				return nil, err
+			}
+		case "steps":
+			if pair.Value.Empty() {
+				continue
+			}
+			if pair.Value.Kind != yamlsubset.Sequence {
+				return nil, pair.Value.Errorf("steps must be a list")
+			}
+			for _, item := range pair.Value.Items {
+				step, err := parseStep(item)
+				if err != nil {
+					return nil, err
+				}
+				f.Steps = append(f.Steps, step)
+			}
+		default:
+			return nil, fmt.Errorf("line %d: unknown key %q (expected name, description, commission, params, or steps)", pair.Line, pair.Key)
+		}
+	}
+
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// parseStep reads one item of the steps list.
+func parseStep(item *yamlsubset.Node) (Step, error) {
+	if item.Kind != yamlsubset.Mapping {
+		return Step{}, item.Errorf("expected '- id: ...' to start a step")
+	}
+	step := Step{Args: map[string]string{}}
+	for _, pair := range item.Pairs {
+		if pair.Key == "titles" {
+			titles, err := scalarList(pair)
+			if err != nil {
+				return Step{}, err
+			}
+			step.Titles = titles
+			continue
+		}
+
+		value, err := scalarValue(pair)
+		if err != nil {
+			return Step{}, err
+		}
+		switch pair.Key {
+		case "id":
+			step.ID = value
+		case "do":
+			step.Action = value
+		default:
+			step.Args[pair.Key] = value
+		}
+	}
+	return step, nil
+}
+
+// scalarValue returns the value of a "key: value" pair.
+func scalarValue(pair yamlsubset.Pair) (string, error) {
+	if pair.Value.Kind != yamlsubset.Scalar {
+		return "", pair.Value.Errorf("%s must be a single value, got a %s", pair.Key, pair.Value.Kind)
+	}
+	return pair.Value.Value, nil
+}
+
+// scalarList returns the values of a pair holding a list of "- value" items.
+func scalarList(pair yamlsubset.Pair) ([]string, error) {
+	if pair.Value.Empty() {
+		return nil, nil
+	}
+	if pair.Value.Kind != yamlsubset.Sequence {
+		return nil, pair.Value.Errorf("%s must be a list", pair.Key)
+	}
+	values := make([]string, 0, len(pair.Value.Items))
+	for _, item := range pair.Value.Items {
+		if item.Kind != yamlsubset.Scalar || item.Value == "" {
+			return nil, item.Errorf("expected '- value' under %s", pair.Key)
+		}
+		values = append(values, item.Value)
+	}
+	return values, nil
+}
+
+var (
+	namePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+	refPattern  = regexp.MustCompile(`\$\{([^}]*)\}`)
+)
+
+// validate checks a parsed flow: step IDs are unique names, actions and
+// their arguments are known, and references point at params or earlier steps.
+func (f *Flow) validate() error {
+	if f.Name == "" {
+		return fmt.Errorf("flow has no name")
+	}
+	if len(f.Steps) == 0 {
+		return fmt.Errorf("flow has no steps")
+	}
+
+	known := map[string]bool{}
+	for _, p := range f.Params {
+		if !namePattern.MatchString(p) {
+			return fmt.Errorf("invalid param name %q (use lowercase letters, digits, - and _)", p)
+		}
+		if known[p] {
+			return fmt.Errorf("param %q listed twice", p)
+		}
+		known[p] = true
+	}
+	if err := checkRefs(f.Commission, known); err != nil {
+		return fmt.Errorf("commission: %w", err)
+	}
+
+	for i, s := range f.Steps {
+		label := fmt.Sprintf("step %d", i+1)
+		if s.ID != "" {
+			label = "step " + s.ID
+		}
+		if !namePattern.MatchString(s.ID) {
+			return fmt.Errorf("%s: id %q must be lowercase letters, digits, - and _", label, s.ID)
+		}
+		if known[s.ID] {
+			return fmt.Errorf("%s: id is already used by a param or earlier step", label)
+		}
+
+		args, ok := actionArgs[s.Action]
+		if !ok {
+			return fmt.Errorf("%s: unknown action %q (expected one of %s)", label, s.Action, strings.Join(Actions(), ", "))
+		}
+		for _, name := range args.required {
+			if s.Args[name] == "" {
+				return fmt.Errorf("%s: %s needs %s", label, s.Action, name)
+			}
+		}
+		for name, value := range s.Args {
+			if !slices.Contains(args.required, name) && !slices.Contains(args.optional, name) {
+				return fmt.Errorf("%s: %s does not take %s", label, s.Action, name)
+			}
+			if err := checkRefs(value, known); err != nil {
+				return fmt.Errorf("%s: %s: %w", label, name, err)
+			}
+		}
+		for _, title := range s.Titles {
+			if err := checkRefs(title, known); err != nil {
+				return fmt.Errorf("%s: titles: %w", label, err)
+			}
+		}
+
+		switch {
+		case s.Action == ActionCreateTasks && (s.Args["title"] == "") == (len(s.Titles) == 0):
+			return fmt.Errorf("%s: %s needs either title or titles", label, s.Action)
+		case s.Action != ActionCreateTasks && len(s.Titles) > 0:
+			return fmt.Errorf("%s: %s does not take titles", label, s.Action)
+		}
+		if creates(s.Action) && f.StepCommission(s) == "" {
+			return fmt.Errorf("%s: no commission (set one on the step or at the top of the flow)", label)
+		}
+
+		known[s.ID] = true
+	}
+	return nil
+}
+
+// creates reports whether an action creates entities in a commission.
+func creates(action string) bool {
+	return action == ActionCreateShipment || action == ActionCreateTasks || action == ActionCreateNote
+}
+
+// checkRefs checks that every ${name} in value is known.
+func checkRefs(value string, known map[string]bool) error {
+	for _, m := range refPattern.FindAllStringSubmatch(value, -1) {
+		if !known[m[1]] {
+			return fmt.Errorf("${%s} is not a param or an earlier step", m[1])
+		}
+	}
+	return nil
+}
+
+// Expand replaces each ${name} in value with vars[name].
+func Expand(value string, vars map[string]string) (string, error) {
+	var missing string
+	expanded := refPattern.ReplaceAllStringFunc(value, func(ref string) string {
+		name := ref[2 : len(ref)-1]
+		v, ok := vars[name]
+		if !ok && missing == "" {
+			missing = name
+		}
+		return v
+	})
+	if missing != "" {
+		return "", fmt.Errorf("${%s} has no value", missing)
+	}
+	return expanded, nil
+}
+
+// CheckParams checks that values supply every param and nothing else.
+func (f *Flow) CheckParams(values map[string]string) error {
+	var missing []string
+	for _, p := range f.Params {
+		if _, ok := values[p]; !ok {
+			missing = append(missing, p)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing params: %s (pass --set name=value)", strings.Join(missing, ", "))
+	}
+	for name := range values {
+		if !slices.Contains(f.Params, name) {
+			return fmt.Errorf("flow %s has no param %q", f.Name, name)
+		}
+	}
+	return nil
+}
+
+// RunKey names a run of a flow with the given params. Running the flow
+// again with the same params gives the same key, which is how a rerun
+// resumes instead of starting over.
+func RunKey(name string, params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s=%s\n", k, params[k])
+	}
+	return name + "-" + hex.EncodeToString(h.Sum(nil))[:8]
+}
+
+// ItemKey names one task of a task.create step's titles list, so each task
+// is recorded as done on its own.
+func ItemKey(stepID string, n int) string {
+	return fmt.Sprintf("%s#%d", stepID, n)
+}
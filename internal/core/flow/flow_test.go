@@ -0,0 +1,132 @@
+package flow
+
+import (
+	"strings"
+	"testing"
+)
+
+const kickoff = `# Start a feature
+name: kickoff
+description: "Shipment, tasks, bench, focus"
+commission: ${commission}
+params:
+  - commission
+  - title
+  - bench
+steps:
+  - id: ship
+    do: shipment.create
+    title: ${title}   # shown in the summary
+  - id: tasks
+    do: task.create
+    shipment: ${ship}
+    titles:
+      - Write the spec
+      - "Build: ${title}"
+  - id: assign
+    do: shipment.assign
+    shipment: ${ship}
+    workbench: ${bench}
+  - id: focus
+    do: focus
+    workbench: ${bench}
+    target: ${ship}
+`
+
+func TestParse(t *testing.T) {
+	f, err := Parse(kickoff)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if f.Name != "kickoff" || f.Description != "Shipment, tasks, bench, focus" || f.Commission != "${commission}" {
+		t.Errorf("unexpected header: %+v", f)
+	}
+	if strings.Join(f.Params, ",") != "commission,title,bench" {
+		t.Errorf("Params = %v", f.Params)
+	}
+	if len(f.Steps) != 4 {
+		t.Fatalf("expected 4 steps, got %d", len(f.Steps))
+	}
+	if s := f.Steps[0]; s.ID != "ship" || s.Action != ActionCreateShipment || s.Args["title"] != "${title}" {
+		t.Errorf("unexpected first step: %+v", s)
+	}
+	if s := f.Steps[1]; len(s.Titles) != 2 || s.Titles[1] != "Build: ${title}" || s.Args["shipment"] != "${ship}" {
+		t.Errorf("unexpected tasks step: %+v", s)
+	}
+	if s := f.Steps[3]; s.Action != ActionFocus || s.Args["target"] != "${ship}" {
+		t.Errorf("unexpected focus step: %+v", s)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"no name", "steps:\n  - id: a\n    do: focus\n", "no name"},
+		{"no steps", "name: x\n", "no steps"},
+		{"unknown key", "name: x\nowner: me\n", `unknown key "owner"`},
+		{"unknown action", "name: x\nsteps:\n  - id: a\n    do: mail.send\n", `unknown action "mail.send"`},
+		{"missing arg", "name: x\nsteps:\n  - id: a\n    do: focus\n    target: SHIP-001\n", "focus needs workbench"},
+		{"extra arg", "name: x\nsteps:\n  - id: a\n    do: focus\n    workbench: B\n    target: S\n    when: now\n", "does not take when"},
+		{"forward reference", "name: x\nsteps:\n  - id: a\n    do: focus\n    workbench: ${b}\n    target: S\n", "${b} is not a param or an earlier step"},
+		{"duplicate id", "name: x\nsteps:\n  - id: a\n    do: focus\n    workbench: B\n    target: S\n  - id: a\n    do: focus\n    workbench: B\n    target: S\n", "already used"},
+		{"no commission", "name: x\nsteps:\n  - id: a\n    do: shipment.create\n    title: T\n", "no commission"},
+		{"title and titles", "name: x\ncommission: COMM-001\nsteps:\n  - id: a\n    do: task.create\n    title: T\n    titles:\n      - U\n", "either title or titles"},
+		{"scalar steps", "name: x\nsteps: focus\n", "steps must be a list"},
+		{"step without mapping", "name: x\nsteps:\n  - focus\n", "expected '- id: ...'"},
+		{"flow list", "name: x\nparams: [a, b]\n", "flow collections"},
+		{"titles on other action", "name: x\nsteps:\n  - id: a\n    do: focus\n    workbench: B\n    target: S\n    titles:\n      - U\n", "does not take titles"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.data)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Parse() error = %v, want it to contain %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	vars := map[string]string{"ship": "SHIP-001", "title": "Login"}
+	got, err := Expand("Build ${title} for ${ship}", vars)
+	if err != nil || got != "Build Login for SHIP-001" {
+		t.Errorf("Expand() = %q, %v", got, err)
+	}
+	if _, err := Expand("${bench}", vars); err == nil {
+		t.Error("expected error for a missing value")
+	}
+}
+
+func TestCheckParams(t *testing.T) {
+	f, err := Parse(kickoff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := f.CheckParams(map[string]string{"commission": "COMM-001", "title": "Login"}); err == nil || !strings.Contains(err.Error(), "bench") {
+		t.Errorf("expected missing bench, got %v", err)
+	}
+	if err := f.CheckParams(map[string]string{"commission": "C", "title": "T", "bench": "B", "extra": "x"}); err == nil {
+		t.Error("expected error for an unknown param")
+	}
+	if err := f.CheckParams(map[string]string{"commission": "C", "title": "T", "bench": "B"}); err != nil {
+		t.Errorf("CheckParams() error = %v", err)
+	}
+}
+
+func TestRunKey(t *testing.T) {
+	a := RunKey("kickoff", map[string]string{"title": "Login", "bench": "BENCH-001"})
+	b := RunKey("kickoff", map[string]string{"bench": "BENCH-001", "title": "Login"})
+	c := RunKey("kickoff", map[string]string{"bench": "BENCH-002", "title": "Login"})
+	if a != b {
+		t.Errorf("same params gave different keys: %s, %s", a, b)
+	}
+	if a == c {
+		t.Errorf("different params gave the same key %s", a)
+	}
+	if !strings.HasPrefix(a, "kickoff-") || len(a) != len("kickoff-")+8 {
+		t.Errorf("unexpected key %q", a)
+	}
+}
@@ -0,0 +1,59 @@
+package git
+
+import "sort"
+
+// BranchChanges is the set of files a shipment branch modifies.
+type BranchChanges struct {
+	ShipmentID string
+	RepoID     string // branches only conflict within the same repo
+	Files      []string
+}
+
+// Overlap is a pair of shipment branches modifying the same files.
+type Overlap struct {
+	ShipmentA string
+	ShipmentB string
+	Files     []string // sorted
+}
+
+// FindOverlaps returns every pair of branches in the same repo that modify
+// at least one common file, most shared files first, then by shipment IDs.
+// Shared files are likely merge conflicts once either branch lands.
+func FindOverlaps(branches []BranchChanges) []Overlap {
+	sorted := make([]BranchChanges, len(branches))
+	copy(sorted, branches)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ShipmentID < sorted[j].ShipmentID })
+
+	var overlaps []Overlap
+	for i, a := range sorted {
+		files := make(map[string]bool, len(a.Files))
+		for _, f := range a.Files {
+			files[f] = true
+		}
+		for _, b := range sorted[i+1:] {
+			if a.RepoID != b.RepoID {
+				continue
+			}
+			shared := make(map[string]bool)
+			for _, f := range b.Files {
+				if files[f] {
+					shared[f] = true
+				}
+			}
+			if len(shared) == 0 {
+				continue
+			}
+			overlap := Overlap{ShipmentA: a.ShipmentID, ShipmentB: b.ShipmentID}
+			for f := range shared {
+				overlap.Files = append(overlap.Files, f)
+			}
+			sort.Strings(overlap.Files)
+			overlaps = append(overlaps, overlap)
+		}
+	}
+
+	sort.SliceStable(overlaps, func(i, j int) bool {
+		return len(overlaps[i].Files) > len(overlaps[j].Files)
+	})
+	return overlaps
+}
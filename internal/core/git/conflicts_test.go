@@ -0,0 +1,31 @@
+package git
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindOverlaps(t *testing.T) {
+	branches := []BranchChanges{
+		{ShipmentID: "SHIP-003", RepoID: "REPO-001", Files: []string{"a.go", "b.go", "c.go"}},
+		{ShipmentID: "SHIP-001", RepoID: "REPO-001", Files: []string{"a.go", "d.go"}},
+		{ShipmentID: "SHIP-002", RepoID: "REPO-001", Files: []string{"b.go", "c.go", "b.go"}},
+		{ShipmentID: "SHIP-004", RepoID: "REPO-002", Files: []string{"a.go"}}, // other repo
+		{ShipmentID: "SHIP-005", RepoID: "REPO-001", Files: []string{"e.go"}},
+	}
+
+	want := []Overlap{
+		{ShipmentA: "SHIP-002", ShipmentB: "SHIP-003", Files: []string{"b.go", "c.go"}},
+		{ShipmentA: "SHIP-001", ShipmentB: "SHIP-003", Files: []string{"a.go"}},
+	}
+	if got := FindOverlaps(branches); !reflect.DeepEqual(got, want) {
+		t.Errorf("FindOverlaps() = %v, want %v", got, want)
+	}
+}
+
+func TestFindOverlaps_None(t *testing.T) {
+	got := FindOverlaps([]BranchChanges{{ShipmentID: "SHIP-001", Files: []string{"a.go"}}})
+	if len(got) != 0 {
+		t.Errorf("expected no overlaps, got %v", got)
+	}
+}
@@ -0,0 +1,71 @@
+package git
+
+import (
+	"regexp"
+	"sort"
+)
+
+// EntityRef is an entity ID mentioned in a commit message.
+type EntityRef struct {
+	EntityType string // "task" or "shipment"
+	EntityID   string
+}
+
+// refPrefixes maps linkable ID prefixes to entity types.
+var refPrefixes = map[string]string{
+	"TASK": "task",
+	"SHIP": "shipment",
+}
+
+var entityRefPattern = regexp.MustCompile(`\b(TASK|SHIP)-\d+\b`)
+
+// ExtractEntityRefs returns the task and shipment IDs mentioned in a commit
+// message, in order of first appearance, without duplicates.
+func ExtractEntityRefs(message string) []EntityRef {
+	var refs []EntityRef
+	seen := make(map[string]bool)
+	for _, m := range entityRefPattern.FindAllStringSubmatch(message, -1) {
+		id := m[0]
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		refs = append(refs, EntityRef{EntityType: refPrefixes[m[1]], EntityID: id})
+	}
+	return refs
+}
+
+// TasksByFile maps each file to the tasks mentioned by the commits that
+// touched it. messages and files are keyed by commit SHA. Task IDs per file
+// are sorted and unique; files touched only by untagged commits are omitted.
+func TasksByFile(messages map[string]string, files map[string][]string) map[string][]string {
+	seen := make(map[string]map[string]bool)
+	for sha, paths := range files {
+		var taskIDs []string
+		for _, ref := range ExtractEntityRefs(messages[sha]) {
+			if ref.EntityType == "task" {
+				taskIDs = append(taskIDs, ref.EntityID)
+			}
+		}
+		if len(taskIDs) == 0 {
+			continue
+		}
+		for _, path := range paths {
+			if seen[path] == nil {
+				seen[path] = make(map[string]bool)
+			}
+			for _, id := range taskIDs {
+				seen[path][id] = true
+			}
+		}
+	}
+
+	result := make(map[string][]string, len(seen))
+	for path, ids := range seen {
+		for id := range ids {
+			result[path] = append(result[path], id)
+		}
+		sort.Strings(result[path])
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package git
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractEntityRefs(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    []EntityRef
+	}{
+		{
+			name:    "single task in subject",
+			message: "TASK-123: fix login redirect",
+			want:    []EntityRef{{EntityType: "task", EntityID: "TASK-123"}},
+		},
+		{
+			name:    "task and shipment across subject and body",
+			message: "Add retry to sync (TASK-001)\n\nPart of SHIP-045.",
+			want: []EntityRef{
+				{EntityType: "task", EntityID: "TASK-001"},
+				{EntityType: "shipment", EntityID: "SHIP-045"},
+			},
+		},
+		{
+			name:    "duplicates collapse",
+			message: "TASK-7 and again TASK-7",
+			want:    []EntityRef{{EntityType: "task", EntityID: "TASK-7"}},
+		},
+		{
+			name:    "ignores other prefixes and partial matches",
+			message: "NOTE-001 MYTASK-12 TASK-abc SHIP-",
+			want:    nil,
+		},
+		{
+			name:    "no references",
+			message: "Refactor parser",
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractEntityRefs(tt.message)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractEntityRefs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTasksByFile(t *testing.T) {
+	messages := map[string]string{
+		"c1": "TASK-002: add parser",
+		"c2": "TASK-001: wire flag (SHIP-001)",
+		"c3": "tidy imports",
+	}
+	files := map[string][]string{
+		"c1": {"parser.go", "parser_test.go"},
+		"c2": {"parser.go", "cmd.go"},
+		"c3": {"util.go"},
+	}
+
+	want := map[string][]string{
+		"parser.go":      {"TASK-001", "TASK-002"},
+		"parser_test.go": {"TASK-002"},
+		"cmd.go":         {"TASK-001"},
+	}
+	if got := TasksByFile(messages, files); !reflect.DeepEqual(got, want) {
+		t.Errorf("TasksByFile() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,51 @@
+// Package glossary contains the pure logic for a commission's glossary: the
+// terms its work uses, and deprecated terms with the term to use instead.
+package glossary
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Term is a glossary entry. A deprecated term has ReplacedBy set.
+type Term struct {
+	Term       string
+	Definition string
+	ReplacedBy string
+}
+
+// Deprecated reports whether the term should no longer be used.
+func (t Term) Deprecated() bool {
+	return t.ReplacedBy != ""
+}
+
+// NormalizeTerm trims a term and collapses inner whitespace.
+func NormalizeTerm(term string) string {
+	return strings.Join(strings.Fields(term), " ")
+}
+
+// FindDeprecated returns the deprecated terms that text uses, in the order
+// given. Matching is case-insensitive on whole words, plurals included, so
+// "Groves" matches grove but "groveling" does not.
+func FindDeprecated(text string, terms []Term) []Term {
+	var used []Term
+	for _, t := range terms {
+		if !t.Deprecated() {
+			continue
+		}
+		if termPattern(t.Term).MatchString(text) {
+			used = append(used, t)
+		}
+	}
+	return used
+}
+
+// termPattern matches a term as a whole word, optionally pluralized. Inner
+// spaces match any run of whitespace, so terms can wrap across lines.
+func termPattern(term string) *regexp.Regexp {
+	words := strings.Fields(term)
+	for i, w := range words {
+		words[i] = regexp.QuoteMeta(w)
+	}
+	return regexp.MustCompile(`(?i)\b` + strings.Join(words, `\s+`) + `(s|es)?\b`)
+}
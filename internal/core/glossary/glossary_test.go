@@ -0,0 +1,46 @@
+package glossary
+
+import "testing"
+
+func TestFindDeprecated(t *testing.T) {
+	terms := []Term{
+		{Term: "workbench", Definition: "A git worktree an IMP works in"},
+		{Term: "grove", ReplacedBy: "workbench"},
+		{Term: "mission", ReplacedBy: "commission"},
+		{Term: "work order", ReplacedBy: "shipment"},
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"none", "Move the workbench to the new repo", nil},
+		{"case and plural", "Both Groves need a rebuild", []string{"grove"}},
+		{"whole words only", "No groveling over the commission", nil},
+		{"several", "The mission's grove", []string{"grove", "mission"}},
+		{"phrase across lines", "Close the work\norder first", []string{"work order"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, term := range FindDeprecated(tt.text, terms) {
+				got = append(got, term.Term)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("FindDeprecated() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("FindDeprecated() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeTerm(t *testing.T) {
+	if got := NormalizeTerm("  work \t order "); got != "work order" {
+		t.Errorf("NormalizeTerm() = %q, want %q", got, "work order")
+	}
+}
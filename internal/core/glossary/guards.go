@@ -0,0 +1,67 @@
+package glossary
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GuardResult represents the outcome of a guard evaluation.
+type GuardResult struct {
+	Allowed bool
+	Reason  string
+}
+
+// Error converts the guard result to an error if not allowed.
+func (r GuardResult) Error() error {
+	if r.Allowed {
+		return nil
+	}
+	return fmt.Errorf("%s", r.Reason)
+}
+
+// AddTermContext provides context for adding a glossary term.
+type AddTermContext struct {
+	CommissionID     string
+	CommissionExists bool
+	Term             string // Normalized
+	Definition       string
+	ReplacedBy       string // Normalized
+}
+
+// CanAddTerm evaluates whether a term can be added to a commission's glossary.
+// Rules:
+// - Commission must exist
+// - Term must not be empty
+// - A term needs a definition, or a replacement when deprecated
+// - A deprecated term cannot be replaced by itself
+func CanAddTerm(ctx AddTermContext) GuardResult {
+	if !ctx.CommissionExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("commission %s not found", ctx.CommissionID),
+		}
+	}
+
+	if ctx.Term == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  "term cannot be empty",
+		}
+	}
+
+	if strings.TrimSpace(ctx.Definition) == "" && ctx.ReplacedBy == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("term %q needs a definition, or --replaced-by to deprecate it", ctx.Term),
+		}
+	}
+
+	if strings.EqualFold(ctx.Term, ctx.ReplacedBy) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("term %q cannot be replaced by itself", ctx.Term),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
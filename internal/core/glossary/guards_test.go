@@ -0,0 +1,61 @@
+package glossary
+
+import "testing"
+
+func TestCanAddTerm(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         AddTermContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can add defined term",
+			ctx:         AddTermContext{CommissionID: "COMM-001", CommissionExists: true, Term: "workbench", Definition: "A git worktree"},
+			wantAllowed: true,
+		},
+		{
+			name:        "can deprecate term without definition",
+			ctx:         AddTermContext{CommissionID: "COMM-001", CommissionExists: true, Term: "grove", ReplacedBy: "workbench"},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot add to missing commission",
+			ctx:         AddTermContext{CommissionID: "COMM-999", Term: "workbench", Definition: "A git worktree"},
+			wantAllowed: false,
+			wantReason:  "commission COMM-999 not found",
+		},
+		{
+			name:        "cannot add empty term",
+			ctx:         AddTermContext{CommissionID: "COMM-001", CommissionExists: true, Definition: "Nothing"},
+			wantAllowed: false,
+			wantReason:  "term cannot be empty",
+		},
+		{
+			name:        "cannot add term without definition",
+			ctx:         AddTermContext{CommissionID: "COMM-001", CommissionExists: true, Term: "workbench", Definition: "  "},
+			wantAllowed: false,
+			wantReason:  `term "workbench" needs a definition, or --replaced-by to deprecate it`,
+		},
+		{
+			name:        "cannot replace term by itself",
+			ctx:         AddTermContext{CommissionID: "COMM-001", CommissionExists: true, Term: "grove", ReplacedBy: "Grove"},
+			wantAllowed: false,
+			wantReason:  `term "grove" cannot be replaced by itself`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanAddTerm(tt.ctx)
+
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("CanAddTerm() Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+
+			if result.Reason != tt.wantReason {
+				t.Errorf("CanAddTerm() Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package handoff
+
+import "fmt"
+
+// GuardResult represents the outcome of a guard evaluation.
+type GuardResult struct {
+	Allowed bool
+	Reason  string
+}
+
+// Error converts the guard result to an error if not allowed.
+func (r GuardResult) Error() error {
+	if r.Allowed {
+		return nil
+	}
+	return fmt.Errorf("%s", r.Reason)
+}
+
+// ConfirmContext provides context for handoff confirm guards.
+type ConfirmContext struct {
+	NoteID   string
+	NoteType string
+	Draft    bool
+}
+
+// CanConfirm evaluates whether a handoff note can be confirmed.
+// Rules:
+// - Note must be a handoff
+// - Note must still be a draft
+func CanConfirm(ctx ConfirmContext) GuardResult {
+	if ctx.NoteType != "handoff" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("note %s is not a handoff", ctx.NoteID),
+		}
+	}
+
+	if !ctx.Draft {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("handoff %s is already confirmed", ctx.NoteID),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
@@ -0,0 +1,42 @@
+package handoff
+
+import "testing"
+
+func TestCanConfirm(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         ConfirmContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can confirm draft handoff",
+			ctx:         ConfirmContext{NoteID: "NOTE-001", NoteType: "handoff", Draft: true},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot confirm other note types",
+			ctx:         ConfirmContext{NoteID: "NOTE-001", NoteType: "learning"},
+			wantAllowed: false,
+			wantReason:  "note NOTE-001 is not a handoff",
+		},
+		{
+			name:        "cannot confirm twice",
+			ctx:         ConfirmContext{NoteID: "NOTE-001", NoteType: "handoff"},
+			wantAllowed: false,
+			wantReason:  "handoff NOTE-001 is already confirmed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanConfirm(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// Package handoff contains the pure business logic for handoff notes: the
+// summary drafted from an agent session transcript when the session ends,
+// and confirming a draft.
+package handoff
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Limits on how much of a session a summary keeps.
+const (
+	maxPrompts  = 5   // Most recent
+	maxCommands = 10  // Most recent
+	maxFiles    = 20  // First touched
+	maxText     = 300 // Characters per prompt, command, or reply
+)
+
+// editTools are the agent tools that change files, by the input field
+// holding the path.
+var editTools = map[string]string{
+	"Edit":         "file_path",
+	"MultiEdit":    "file_path",
+	"Write":        "file_path",
+	"NotebookEdit": "notebook_path",
+}
+
+// Summary is what a session did, distilled from its transcript.
+type Summary struct {
+	Prompts   []string // What the user asked, oldest first
+	Files     []string // Files edited or written, in first-touched order
+	Commands  []string // Shell commands run, oldest first
+	LastReply string   // The agent's final text reply
+}
+
+// Empty reports whether the session did nothing worth handing off.
+func (s Summary) Empty() bool {
+	return len(s.Prompts) == 0 && len(s.Files) == 0 && len(s.Commands) == 0
+}
+
+// transcriptEntry is one line of an agent session transcript (JSONL).
+type transcriptEntry struct {
+	Type    string `json:"type"`
+	IsMeta  bool   `json:"isMeta"`
+	Message struct {
+		Role    string          `json:"role"`
+		Content json.RawMessage `json:"content"`
+	} `json:"message"`
+}
+
+// contentBlock is one block of a message whose content is a list.
+type contentBlock struct {
+	Type  string         `json:"type"`
+	Text  string         `json:"text"`
+	Name  string         `json:"name"`
+	Input map[string]any `json:"input"`
+}
+
+// Summarize distills a session transcript. Lines that aren't valid entries
+// are skipped, so a transcript cut off mid-write still summarizes.
+func Summarize(transcript []byte) Summary {
+	var s Summary
+	seen := map[string]bool{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(transcript))
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024) // Tool results can make long lines
+	for scanner.Scan() {
+		var entry transcriptEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil || entry.IsMeta {
+			continue
+		}
+		text, blocks := messageContent(entry.Message.Content)
+
+		switch entry.Type {
+		case "user":
+			// Tool results come back as user messages with no text
+			if prompt := clip(text); prompt != "" && !strings.HasPrefix(prompt, "<") {
+				s.Prompts = append(s.Prompts, prompt)
+			}
+		case "assistant":
+			if reply := clip(text); reply != "" {
+				s.LastReply = reply
+			}
+			for _, b := range blocks {
+				if b.Type != "tool_use" {
+					continue
+				}
+				if field, ok := editTools[b.Name]; ok {
+					if path, _ := b.Input[field].(string); path != "" && !seen[path] {
+						seen[path] = true
+						s.Files = append(s.Files, path)
+					}
+				}
+				if b.Name == "Bash" {
+					if command, _ := b.Input["command"].(string); command != "" {
+						s.Commands = append(s.Commands, clip(command))
+					}
+				}
+			}
+		}
+	}
+
+	s.Prompts = lastN(s.Prompts, maxPrompts)
+	s.Commands = lastN(s.Commands, maxCommands)
+	if len(s.Files) > maxFiles {
+		s.Files = s.Files[:maxFiles]
+	}
+	return s
+}
+
+// messageContent returns a message's text and, for list content, its blocks.
+func messageContent(raw json.RawMessage) (string, []contentBlock) {
+	var text string
+	if json.Unmarshal(raw, &text) == nil {
+		return text, nil
+	}
+	var blocks []contentBlock
+	if json.Unmarshal(raw, &blocks) != nil {
+		return "", nil
+	}
+	var parts []string
+	for _, b := range blocks {
+		if b.Type == "text" && strings.TrimSpace(b.Text) != "" {
+			parts = append(parts, b.Text)
+		}
+	}
+	return strings.Join(parts, "\n"), blocks
+}
+
+// Title names a handoff note after the session's last request.
+func Title(s Summary) string {
+	if len(s.Prompts) == 0 {
+		return "Handoff"
+	}
+	prompt := strings.Join(strings.Fields(s.Prompts[len(s.Prompts)-1]), " ")
+	if len(prompt) > 60 {
+		prompt = prompt[:57] + "..."
+	}
+	return "Handoff: " + prompt
+}
+
+// RenderContext is what a handoff note records about where the session ran.
+type RenderContext struct {
+	SessionID string
+	TaskIDs   []string // Tasks the workbench had in progress
+}
+
+// Render formats a summary as the markdown content of a handoff note.
+func Render(s Summary, ctx RenderContext) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Drafted from session %s when it ended.\n", orUnknown(ctx.SessionID))
+
+	section := func(heading string, items []string, format string) {
+		if len(items) == 0 {
+			return
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", heading)
+		for _, item := range items {
+			fmt.Fprintf(&b, "- "+format+"\n", item)
+		}
+	}
+	section("Active tasks", ctx.TaskIDs, "%s")
+	section("Asked", s.Prompts, "%s")
+	section("Files changed", s.Files, "%s")
+	section("Commands run", s.Commands, "`%s`")
+	if s.LastReply != "" {
+		fmt.Fprintf(&b, "\n## Where it left off\n\n%s\n", s.LastReply)
+	}
+	return b.String()
+}
+
+// clip trims text to one paragraph-free line of at most maxText characters.
+func clip(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if len(text) > maxText {
+		return text[:maxText-3] + "..."
+	}
+	return text
+}
+
+func lastN(items []string, n int) []string {
+	if len(items) > n {
+		return items[len(items)-n:]
+	}
+	return items
+}
+
+func orUnknown(s string) string {
+	if s == "" {
+		return "(unknown)"
+	}
+	return s
+}
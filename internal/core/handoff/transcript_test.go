@@ -0,0 +1,75 @@
+package handoff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const sampleTranscript = `{"type":"summary","summary":"Earlier work"}
+{"type":"user","message":{"role":"user","content":"Fix the login redirect"}}
+{"type":"user","isMeta":true,"message":{"role":"user","content":"Caveat: generated by a local command"}}
+{"type":"assistant","message":{"role":"assistant","content":[{"type":"text","text":"Looking at the handler."},{"type":"tool_use","name":"Read","input":{"file_path":"/wb/orc-014/auth.go"}}]}}
+{"type":"user","message":{"role":"user","content":[{"type":"tool_result","content":"package auth"}]}}
+{"type":"assistant","message":{"role":"assistant","content":[{"type":"tool_use","name":"Edit","input":{"file_path":"/wb/orc-014/auth.go"}},{"type":"tool_use","name":"Bash","input":{"command":"go test ./auth/..."}}]}}
+{"type":"user","message":{"role":"user","content":[{"type":"text","text":"Now add a test\nfor the redirect"}]}}
+{"type":"assistant","message":{"role":"assistant","content":[{"type":"tool_use","name":"Write","input":{"file_path":"/wb/orc-014/auth_test.go"}},{"type":"tool_use","name":"Edit","input":{"file_path":"/wb/orc-014/auth.go"}}]}}
+{"type":"assistant","message":{"role":"assistant","content":[{"type":"text","text":"Test added; the redirect test still fails on expired sessions."}]}}
+not json, from a write cut short`
+
+func TestSummarize(t *testing.T) {
+	s := Summarize([]byte(sampleTranscript))
+
+	if want := []string{"Fix the login redirect", "Now add a test for the redirect"}; !reflect.DeepEqual(s.Prompts, want) {
+		t.Errorf("Prompts = %q, want %q", s.Prompts, want)
+	}
+	if want := []string{"/wb/orc-014/auth.go", "/wb/orc-014/auth_test.go"}; !reflect.DeepEqual(s.Files, want) {
+		t.Errorf("Files = %q, want %q", s.Files, want)
+	}
+	if want := []string{"go test ./auth/..."}; !reflect.DeepEqual(s.Commands, want) {
+		t.Errorf("Commands = %q, want %q", s.Commands, want)
+	}
+	if want := "Test added; the redirect test still fails on expired sessions."; s.LastReply != want {
+		t.Errorf("LastReply = %q, want %q", s.LastReply, want)
+	}
+	if s.Empty() {
+		t.Error("Empty() = true for a session with work")
+	}
+	if !Summarize([]byte(`{"type":"summary","summary":"nothing"}`)).Empty() {
+		t.Error("Empty() = false for a session with no prompts or tools")
+	}
+}
+
+func TestSummarize_KeepsRecentPrompts(t *testing.T) {
+	var lines []string
+	for _, p := range []string{"one", "two", "three", "four", "five", "six", "seven"} {
+		lines = append(lines, `{"type":"user","message":{"role":"user","content":"`+p+`"}}`)
+	}
+	s := Summarize([]byte(strings.Join(lines, "\n")))
+	if want := []string{"three", "four", "five", "six", "seven"}; !reflect.DeepEqual(s.Prompts, want) {
+		t.Errorf("Prompts = %q, want %q", s.Prompts, want)
+	}
+}
+
+func TestTitleAndRender(t *testing.T) {
+	s := Summarize([]byte(sampleTranscript))
+	if got, want := Title(s), "Handoff: Now add a test for the redirect"; got != want {
+		t.Errorf("Title = %q, want %q", got, want)
+	}
+	if got := Title(Summary{}); got != "Handoff" {
+		t.Errorf("Title of empty summary = %q", got)
+	}
+
+	content := Render(s, RenderContext{SessionID: "abc123", TaskIDs: []string{"TASK-012"}})
+	for _, want := range []string{
+		"Drafted from session abc123 when it ended.",
+		"## Active tasks\n\n- TASK-012\n",
+		"## Files changed\n\n- /wb/orc-014/auth.go\n- /wb/orc-014/auth_test.go\n",
+		"## Commands run\n\n- `go test ./auth/...`\n",
+		"## Where it left off\n\nTest added;",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("Render missing %q in:\n%s", want, content)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Package ledger contains the pure business logic for cross-ledger references.
+// A ledger registered under an alias (e.g. "acme") can be referred to from
+// this one as "acme:SHIP-004"; the other ledger is only ever read.
+package ledger
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxAliasLength is the longest ledger alias accepted.
+const MaxAliasLength = 32
+
+// SlugPattern matches lowercase words of letters and digits joined by
+// single hyphens, starting with a letter. Ledger aliases and entity aliases
+// share this shape.
+var SlugPattern = regexp.MustCompile(`^[a-z][a-z0-9]*(-[a-z0-9]+)*$`)
+
+var entityIDPattern = regexp.MustCompile(`^[A-Z]+-\d+$`)
+
+// entityTypesByPrefix maps the ID prefixes a foreign ledger can resolve to
+// entity types. It is the one list of cross-ledger entities: the ledger
+// tables and show-view labels are derived from these types.
+var entityTypesByPrefix = map[string]string{
+	"COMM": "commission",
+	"SHIP": "shipment",
+	"TASK": "task",
+	"TOME": "tome",
+	"NOTE": "note",
+	"PLAN": "plan",
+}
+
+// Ref is a reference to an entity in another ledger.
+type Ref struct {
+	Alias    string
+	EntityID string
+}
+
+// String renders the reference as "alias:ID".
+func (r Ref) String() string {
+	return r.Alias + ":" + r.EntityID
+}
+
+// IsEntityID reports whether s is shaped like an entity ID (SHIP-004).
+func IsEntityID(s string) bool {
+	return entityIDPattern.MatchString(s)
+}
+
+// IsRef reports whether s is shaped like a cross-ledger reference.
+func IsRef(s string) bool {
+	_, err := ParseRef(s)
+	return err == nil
+}
+
+// ParseRef parses "alias:ID", e.g. "acme:SHIP-004".
+func ParseRef(s string) (Ref, error) {
+	alias, id, found := strings.Cut(s, ":")
+	if !found {
+		return Ref{}, fmt.Errorf("invalid reference '%s': expected ledger-alias:ID, e.g. acme:SHIP-004", s)
+	}
+	if err := ValidateAlias(alias); err != nil {
+		return Ref{}, err
+	}
+	if !IsEntityID(id) {
+		return Ref{}, fmt.Errorf("invalid reference '%s': '%s' is not an entity ID", s, id)
+	}
+	return Ref{Alias: alias, EntityID: id}, nil
+}
+
+// ValidateAlias checks that a ledger alias is lowercase words separated by
+// hyphens, starting with a letter.
+func ValidateAlias(alias string) error {
+	if len(alias) > MaxAliasLength || !SlugPattern.MatchString(alias) {
+		return fmt.Errorf("invalid ledger alias '%s': use lowercase letters, digits, and hyphens, starting with a letter (max %d characters)", alias, MaxAliasLength)
+	}
+	return nil
+}
+
+// EntityType returns the type of entity a foreign ledger can resolve for an
+// ID, based on its prefix.
+func EntityType(entityID string) (string, bool) {
+	prefix, _, _ := strings.Cut(entityID, "-")
+	entityType, ok := entityTypesByPrefix[prefix]
+	return entityType, ok
+}
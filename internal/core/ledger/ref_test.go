@@ -0,0 +1,85 @@
+package ledger
+
+import "testing"
+
+func TestParseRef(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Ref
+		wantErr bool
+	}{
+		{"acme:SHIP-004", Ref{Alias: "acme", EntityID: "SHIP-004"}, false},
+		{"client-2:TASK-120", Ref{Alias: "client-2", EntityID: "TASK-120"}, false},
+		{"SHIP-004", Ref{}, true},
+		{"Acme:SHIP-004", Ref{}, true},
+		{"acme:ship-004", Ref{}, true},
+		{"acme:SHIP-", Ref{}, true},
+		{":SHIP-004", Ref{}, true},
+		{"acme:", Ref{}, true},
+		{"acme-:SHIP-004", Ref{}, true},
+		{"ac--me:SHIP-004", Ref{}, true},
+		{"a23456789012345678901234567890123:SHIP-004", Ref{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseRef(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRef(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseRef(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+			if !tt.wantErr && got.String() != tt.input {
+				t.Errorf("String() = %q, want %q", got.String(), tt.input)
+			}
+		})
+	}
+}
+
+func TestValidateAlias(t *testing.T) {
+	tests := []struct {
+		alias   string
+		wantErr bool
+	}{
+		{"acme", false},
+		{"client-factory", false},
+		{"f2", false},
+		{"2f", true},
+		{"Acme", true},
+		{"acme_corp", true},
+		{"", true},
+		{"a23456789012345678901234567890123", true}, // 33 characters
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.alias, func(t *testing.T) {
+			if err := ValidateAlias(tt.alias); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateAlias(%q) error = %v, wantErr %v", tt.alias, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEntityType(t *testing.T) {
+	if got, ok := EntityType("SHIP-004"); !ok || got != "shipment" {
+		t.Errorf("EntityType(SHIP-004) = %q, %v", got, ok)
+	}
+	if _, ok := EntityType("BENCH-001"); ok {
+		t.Error("expected workbenches not to resolve across ledgers")
+	}
+}
+
+func TestIsEntityID(t *testing.T) {
+	for s, want := range map[string]bool{
+		"SHIP-004":      true,
+		"TASK-1":        true,
+		"acme:SHIP-004": false,
+		"auth-refactor": false,
+		"SHIP-":         false,
+	} {
+		if got := IsEntityID(s); got != want {
+			t.Errorf("IsEntityID(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
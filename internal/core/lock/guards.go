@@ -0,0 +1,174 @@
+// Package lock contains the pure business logic for advisory entity locks.
+// A lock tells other actors that someone is mid-way through a multi-step edit
+// (revising a plan, restructuring a shipment); it expires on its own so a
+// crashed session never leaves an entity stuck.
+package lock
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultTTL is how long a lock lasts when no TTL is given.
+const DefaultTTL = 30 * time.Minute
+
+// MaxTTL caps lock lifetimes; longer edits renew by acquiring again.
+const MaxTTL = 8 * time.Hour
+
+// GuardResult represents the outcome of a guard evaluation.
+type GuardResult struct {
+	Allowed bool
+	Reason  string
+}
+
+// Error converts the guard result to an error if not allowed.
+func (r GuardResult) Error() error {
+	if r.Allowed {
+		return nil
+	}
+	return fmt.Errorf("%s", r.Reason)
+}
+
+// entityTypesByPrefix maps lockable ID prefixes to entity types.
+var entityTypesByPrefix = map[string]string{
+	"SHIP": "shipment",
+	"PLAN": "plan",
+}
+
+// EntityType returns the lockable entity type for an ID, based on its prefix.
+func EntityType(entityID string) (string, bool) {
+	prefix, _, found := strings.Cut(entityID, "-")
+	if !found {
+		return "", false
+	}
+	entityType, ok := entityTypesByPrefix[prefix]
+	return entityType, ok
+}
+
+// State describes an entity's lock as stored. A zero State means unlocked.
+type State struct {
+	HeldBy     string
+	AcquiredAt time.Time
+	ExpiresAt  time.Time
+}
+
+// Active returns true if the lock is held and has not expired.
+func (s State) Active(now time.Time) bool {
+	return s.HeldBy != "" && now.Before(s.ExpiresAt)
+}
+
+// lockedReason describes who holds a lock, e.g. "SHIP-010 is locked by GOBLIN since 14:02".
+func lockedReason(entityID string, lock State) string {
+	return fmt.Sprintf("%s is locked by %s since %s (expires %s)",
+		entityID, lock.HeldBy, lock.AcquiredAt.Format("15:04"), lock.ExpiresAt.Format("15:04"))
+}
+
+// ModifyContext provides context for checking whether an actor may edit an entity.
+type ModifyContext struct {
+	EntityID string
+	ActorID  string
+	Lock     State
+	Now      time.Time
+}
+
+// CanModify evaluates whether an actor may edit an entity.
+// Rules:
+// - Unlocked or expired entities can be edited by anyone
+// - A locked entity can only be edited by the lock holder
+func CanModify(ctx ModifyContext) GuardResult {
+	if !ctx.Lock.Active(ctx.Now) || ctx.Lock.HeldBy == ctx.ActorID {
+		return GuardResult{Allowed: true}
+	}
+	return GuardResult{
+		Allowed: false,
+		Reason:  lockedReason(ctx.EntityID, ctx.Lock) + "; wait for it to be released or expire",
+	}
+}
+
+// AcquireContext provides context for lock acquisition guards.
+type AcquireContext struct {
+	EntityID     string
+	EntityExists bool
+	ActorID      string
+	TTL          time.Duration
+	Lock         State
+	Now          time.Time
+}
+
+// CanAcquire evaluates whether an actor can take (or renew) a lock.
+// Rules:
+// - Only shipments and plans can be locked
+// - Entity must exist
+// - The actor must be known, so others can see who holds the lock
+// - TTL must be positive and at most MaxTTL
+// - An active lock held by another actor blocks acquisition; the holder renews
+func CanAcquire(ctx AcquireContext) GuardResult {
+	if _, ok := EntityType(ctx.EntityID); !ok {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot lock %s: only shipments and plans can be locked", ctx.EntityID),
+		}
+	}
+
+	if !ctx.EntityExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("%s not found", ctx.EntityID),
+		}
+	}
+
+	if ctx.ActorID == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  "cannot lock without a known actor",
+		}
+	}
+
+	if ctx.TTL <= 0 || ctx.TTL > MaxTTL {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("lock duration must be between 1s and %s (got %s)", MaxTTL, ctx.TTL),
+		}
+	}
+
+	if ctx.Lock.Active(ctx.Now) && ctx.Lock.HeldBy != ctx.ActorID {
+		return GuardResult{
+			Allowed: false,
+			Reason:  lockedReason(ctx.EntityID, ctx.Lock),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// ReleaseContext provides context for lock release guards.
+type ReleaseContext struct {
+	EntityID string
+	ActorID  string
+	Lock     State
+	Now      time.Time
+	Force    bool
+}
+
+// CanRelease evaluates whether an actor can release a lock.
+// Rules:
+// - The entity must be locked
+// - Only the holder can release, unless forced
+func CanRelease(ctx ReleaseContext) GuardResult {
+	if !ctx.Lock.Active(ctx.Now) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("%s is not locked", ctx.EntityID),
+		}
+	}
+
+	if ctx.Lock.HeldBy != ctx.ActorID && !ctx.Force {
+		return GuardResult{
+			Allowed: false,
+			Reason:  lockedReason(ctx.EntityID, ctx.Lock) + "; use --force to break it",
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
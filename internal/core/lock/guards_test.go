@@ -0,0 +1,196 @@
+package lock
+
+import (
+	"testing"
+	"time"
+)
+
+var (
+	now    = time.Date(2026, 10, 1, 14, 10, 0, 0, time.UTC)
+	active = State{
+		HeldBy:     "GOBLIN",
+		AcquiredAt: time.Date(2026, 10, 1, 14, 2, 0, 0, time.UTC),
+		ExpiresAt:  time.Date(2026, 10, 1, 14, 32, 0, 0, time.UTC),
+	}
+	expired = State{
+		HeldBy:     "GOBLIN",
+		AcquiredAt: time.Date(2026, 10, 1, 13, 0, 0, 0, time.UTC),
+		ExpiresAt:  time.Date(2026, 10, 1, 13, 30, 0, 0, time.UTC),
+	}
+)
+
+func TestEntityType(t *testing.T) {
+	tests := []struct {
+		id       string
+		wantType string
+		wantOK   bool
+	}{
+		{"SHIP-010", "shipment", true},
+		{"PLAN-003", "plan", true},
+		{"TASK-001", "", false},
+		{"garbage", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got, ok := EntityType(tt.id)
+			if got != tt.wantType || ok != tt.wantOK {
+				t.Errorf("EntityType(%q) = %q, %v, want %q, %v", tt.id, got, ok, tt.wantType, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCanModify(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         ModifyContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "unlocked",
+			ctx:         ModifyContext{EntityID: "SHIP-010", ActorID: "IMP-BENCH-001", Now: now},
+			wantAllowed: true,
+		},
+		{
+			name:        "holder may edit",
+			ctx:         ModifyContext{EntityID: "SHIP-010", ActorID: "GOBLIN", Lock: active, Now: now},
+			wantAllowed: true,
+		},
+		{
+			name:        "expired lock is ignored",
+			ctx:         ModifyContext{EntityID: "SHIP-010", ActorID: "IMP-BENCH-001", Lock: expired, Now: now},
+			wantAllowed: true,
+		},
+		{
+			name:        "locked by someone else",
+			ctx:         ModifyContext{EntityID: "SHIP-010", ActorID: "IMP-BENCH-001", Lock: active, Now: now},
+			wantAllowed: false,
+			wantReason:  "SHIP-010 is locked by GOBLIN since 14:02 (expires 14:32); wait for it to be released or expire",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanModify(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanAcquire(t *testing.T) {
+	base := AcquireContext{EntityID: "PLAN-003", EntityExists: true, ActorID: "IMP-BENCH-001", TTL: DefaultTTL, Now: now}
+
+	tests := []struct {
+		name        string
+		modify      func(*AcquireContext)
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "free entity",
+			modify:      func(c *AcquireContext) {},
+			wantAllowed: true,
+		},
+		{
+			name:        "unlockable entity",
+			modify:      func(c *AcquireContext) { c.EntityID = "TASK-001" },
+			wantAllowed: false,
+			wantReason:  "cannot lock TASK-001: only shipments and plans can be locked",
+		},
+		{
+			name:        "missing entity",
+			modify:      func(c *AcquireContext) { c.EntityExists = false },
+			wantAllowed: false,
+			wantReason:  "PLAN-003 not found",
+		},
+		{
+			name:        "unknown actor",
+			modify:      func(c *AcquireContext) { c.ActorID = "" },
+			wantAllowed: false,
+			wantReason:  "cannot lock without a known actor",
+		},
+		{
+			name:        "TTL too long",
+			modify:      func(c *AcquireContext) { c.TTL = 9 * time.Hour },
+			wantAllowed: false,
+			wantReason:  "lock duration must be between 1s and 8h0m0s (got 9h0m0s)",
+		},
+		{
+			name:        "held by another actor",
+			modify:      func(c *AcquireContext) { c.Lock = active },
+			wantAllowed: false,
+			wantReason:  "PLAN-003 is locked by GOBLIN since 14:02 (expires 14:32)",
+		},
+		{
+			name:        "holder renews",
+			modify:      func(c *AcquireContext) { c.Lock = active; c.ActorID = "GOBLIN" },
+			wantAllowed: true,
+		},
+		{
+			name:        "expired lock can be taken over",
+			modify:      func(c *AcquireContext) { c.Lock = expired },
+			wantAllowed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := base
+			tt.modify(&ctx)
+			result := CanAcquire(ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanRelease(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         ReleaseContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "holder releases",
+			ctx:         ReleaseContext{EntityID: "SHIP-010", ActorID: "GOBLIN", Lock: active, Now: now},
+			wantAllowed: true,
+		},
+		{
+			name:        "not locked",
+			ctx:         ReleaseContext{EntityID: "SHIP-010", ActorID: "GOBLIN", Lock: expired, Now: now},
+			wantAllowed: false,
+			wantReason:  "SHIP-010 is not locked",
+		},
+		{
+			name:        "someone else's lock",
+			ctx:         ReleaseContext{EntityID: "SHIP-010", ActorID: "IMP-BENCH-001", Lock: active, Now: now},
+			wantAllowed: false,
+			wantReason:  "SHIP-010 is locked by GOBLIN since 14:02 (expires 14:32); use --force to break it",
+		},
+		{
+			name:        "forced break",
+			ctx:         ReleaseContext{EntityID: "SHIP-010", ActorID: "IMP-BENCH-001", Lock: active, Now: now, Force: true},
+			wantAllowed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanRelease(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
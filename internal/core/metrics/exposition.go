@@ -0,0 +1,90 @@
+// Package metrics contains the pure logic for rendering ledger metrics
+// in the Prometheus text exposition format.
+package metrics
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Type is a Prometheus metric type.
+type Type string
+
+// Metric types used by the ledger exporter.
+const (
+	Gauge   Type = "gauge"
+	Counter Type = "counter"
+)
+
+// Label is a metric label name/value pair.
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Sample is one labelled value of a metric family.
+type Sample struct {
+	Labels []Label
+	Value  float64
+}
+
+// Family groups the samples that share a metric name.
+type Family struct {
+	Name    string
+	Help    string
+	Type    Type
+	Samples []Sample
+}
+
+// FormatText renders families in the Prometheus text exposition format
+// (version 0.0.4). Samples are sorted by label values so output is stable
+// between scrapes. Families without samples still emit HELP and TYPE lines.
+func FormatText(families []Family) string {
+	var b strings.Builder
+	for _, f := range families {
+		b.WriteString("# HELP " + f.Name + " " + escapeHelp(f.Help) + "\n")
+		b.WriteString("# TYPE " + f.Name + " " + string(f.Type) + "\n")
+
+		samples := make([]Sample, len(f.Samples))
+		copy(samples, f.Samples)
+		sort.SliceStable(samples, func(i, j int) bool {
+			return labelKey(samples[i].Labels) < labelKey(samples[j].Labels)
+		})
+
+		for _, s := range samples {
+			b.WriteString(f.Name)
+			if len(s.Labels) > 0 {
+				b.WriteString("{")
+				for i, l := range s.Labels {
+					if i > 0 {
+						b.WriteString(",")
+					}
+					b.WriteString(l.Name + `="` + escapeLabelValue(l.Value) + `"`)
+				}
+				b.WriteString("}")
+			}
+			b.WriteString(" " + strconv.FormatFloat(s.Value, 'g', -1, 64) + "\n")
+		}
+	}
+	return b.String()
+}
+
+// labelKey joins label values for ordering samples.
+func labelKey(labels []Label) string {
+	values := make([]string, len(labels))
+	for i, l := range labels {
+		values[i] = l.Value
+	}
+	return strings.Join(values, "\x00")
+}
+
+// escapeHelp escapes backslashes and newlines in HELP text.
+func escapeHelp(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(s)
+}
+
+// escapeLabelValue escapes backslashes, quotes, and newlines in label values.
+func escapeLabelValue(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
@@ -0,0 +1,77 @@
+package metrics
+
+import "testing"
+
+func TestFormatText(t *testing.T) {
+	tests := []struct {
+		name     string
+		families []Family
+		want     string
+	}{
+		{
+			name: "gauge with sorted labelled samples",
+			families: []Family{{
+				Name: "orc_tasks",
+				Help: "Tasks by commission and status.",
+				Type: Gauge,
+				Samples: []Sample{
+					{Labels: []Label{{"commission", "COMM-002"}, {"status", "open"}}, Value: 1},
+					{Labels: []Label{{"commission", "COMM-001"}, {"status", "open"}}, Value: 3},
+					{Labels: []Label{{"commission", "COMM-001"}, {"status", "blocked"}}, Value: 2},
+				},
+			}},
+			want: `# HELP orc_tasks Tasks by commission and status.
+# TYPE orc_tasks gauge
+orc_tasks{commission="COMM-001",status="blocked"} 2
+orc_tasks{commission="COMM-001",status="open"} 3
+orc_tasks{commission="COMM-002",status="open"} 1
+`,
+		},
+		{
+			name: "unlabelled counter",
+			families: []Family{{
+				Name:    "orc_events_total",
+				Help:    "Events.",
+				Type:    Counter,
+				Samples: []Sample{{Value: 42}},
+			}},
+			want: `# HELP orc_events_total Events.
+# TYPE orc_events_total counter
+orc_events_total 42
+`,
+		},
+		{
+			name: "empty family keeps metadata",
+			families: []Family{{
+				Name: "orc_plans",
+				Help: "Plans.",
+				Type: Gauge,
+			}},
+			want: `# HELP orc_plans Plans.
+# TYPE orc_plans gauge
+`,
+		},
+		{
+			name: "escapes label values and help",
+			families: []Family{{
+				Name:    "orc_build_info",
+				Help:    "Build info\nwith \\ newline.",
+				Type:    Gauge,
+				Samples: []Sample{{Labels: []Label{{"commit", "a\"b\\c\nd"}}, Value: 1}},
+			}},
+			want: `# HELP orc_build_info Build info\nwith \\ newline.
+# TYPE orc_build_info gauge
+orc_build_info{commit="a\"b\\c\nd"} 1
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatText(tt.families)
+			if got != tt.want {
+				t.Errorf("FormatText() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package note
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Answer is an answer to a question note proposed by a report note.
+type Answer struct {
+	QuestionID string
+	Text       string
+}
+
+// answerLine matches "NOTE-042: answer" once list markers and emphasis are
+// stripped. The separator may be ":", "-", "–", "—", "->", "=>", or "→".
+var answerLine = regexp.MustCompile(`^(NOTE-\d+)\s*(?::|->|=>|→|–|—|-)\s*(.+)$`)
+
+// ParseAnswers finds the answers a report proposes, one per line:
+//
+//	## Answers
+//	- NOTE-042: Yes, the API paginates with cursors.
+//	- **NOTE-043** → Postgres, see the benchmark above.
+//
+// Lines that don't start with a note ID are ignored. If a question is
+// answered twice, the first answer wins.
+func ParseAnswers(content string) []Answer {
+	var answers []Answer
+	seen := map[string]bool{}
+	for _, raw := range strings.Split(content, "\n") {
+		line := strings.TrimSpace(raw)
+		line = strings.TrimLeft(line, "-*+> \t")
+		if i := strings.Index(line, ". "); i > 0 && i <= 3 && strings.Trim(line[:i], "0123456789") == "" {
+			line = line[i+2:]
+		}
+		line = strings.NewReplacer("**", "", "__", "", "`", "").Replace(line)
+
+		m := answerLine.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil || seen[m[1]] {
+			continue
+		}
+		seen[m[1]] = true
+		answers = append(answers, Answer{QuestionID: m[1], Text: strings.TrimSpace(m[2])})
+	}
+	return answers
+}
+
+// AnswerQuestionContext provides context for the answer guard.
+type AnswerQuestionContext struct {
+	NoteID   string
+	NoteType string
+	Status   string
+	ReportID string
+}
+
+// CanAnswerQuestion evaluates whether a note can be answered from a report.
+// Rules:
+// - Note must be a question
+// - Note must not be closed
+// - A question cannot answer itself
+func CanAnswerQuestion(ctx AnswerQuestionContext) GuardResult {
+	if ctx.NoteType != "question" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("%s is not a question (type %s)", ctx.NoteID, orNone(ctx.NoteType)),
+		}
+	}
+	if ctx.Status == "closed" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("%s is already closed", ctx.NoteID),
+		}
+	}
+	if ctx.NoteID == ctx.ReportID {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("%s cannot answer itself", ctx.NoteID),
+		}
+	}
+	return GuardResult{Allowed: true}
+}
+
+// AppendAnswer adds an answer, and the note it came from, to a question's content.
+func AppendAnswer(content, reportID, answer string) string {
+	entry := fmt.Sprintf("Answer (from %s): %s", reportID, answer)
+	if strings.TrimSpace(content) == "" {
+		return entry
+	}
+	return strings.TrimRight(content, "\n") + "\n\n" + entry
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
@@ -0,0 +1,61 @@
+package note
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseAnswers(t *testing.T) {
+	report := `# Pagination investigation
+
+We looked at NOTE-040 and the API docs.
+
+## Answers
+- NOTE-042: Yes, the API paginates with cursors.
+* **NOTE-043** → Postgres, see the benchmark above.
+1. ` + "`NOTE-044`" + ` - Not before Q3.
+- NOTE-042: a second answer is ignored
+NOTE-045 has no separator
+`
+	want := []Answer{
+		{QuestionID: "NOTE-042", Text: "Yes, the API paginates with cursors."},
+		{QuestionID: "NOTE-043", Text: "Postgres, see the benchmark above."},
+		{QuestionID: "NOTE-044", Text: "Not before Q3."},
+	}
+	if got := ParseAnswers(report); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseAnswers() = %+v, want %+v", got, want)
+	}
+	if got := ParseAnswers("no answers here"); len(got) != 0 {
+		t.Errorf("expected no answers, got %+v", got)
+	}
+}
+
+func TestCanAnswerQuestion(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        AnswerQuestionContext
+		wantReason string
+	}{
+		{"open question", AnswerQuestionContext{NoteID: "NOTE-042", NoteType: "question", Status: "open", ReportID: "NOTE-300"}, ""},
+		{"not a question", AnswerQuestionContext{NoteID: "NOTE-042", NoteType: "idea", Status: "open", ReportID: "NOTE-300"}, "NOTE-042 is not a question (type idea)"},
+		{"closed", AnswerQuestionContext{NoteID: "NOTE-042", NoteType: "question", Status: "closed", ReportID: "NOTE-300"}, "NOTE-042 is already closed"},
+		{"self", AnswerQuestionContext{NoteID: "NOTE-042", NoteType: "question", Status: "open", ReportID: "NOTE-042"}, "NOTE-042 cannot answer itself"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanAnswerQuestion(tt.ctx)
+			if result.Allowed != (tt.wantReason == "") || result.Reason != tt.wantReason {
+				t.Errorf("CanAnswerQuestion() = %+v, want reason %q", result, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestAppendAnswer(t *testing.T) {
+	if got := AppendAnswer("", "NOTE-300", "Yes"); got != "Answer (from NOTE-300): Yes" {
+		t.Errorf("AppendAnswer() = %q", got)
+	}
+	if got := AppendAnswer("Does it paginate?\n", "NOTE-300", "Yes"); got != "Does it paginate?\n\nAnswer (from NOTE-300): Yes" {
+		t.Errorf("AppendAnswer() = %q", got)
+	}
+}
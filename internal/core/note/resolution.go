@@ -0,0 +1,133 @@
+// Package note contains the pure business logic for closing notes: the
+// resolution every closed note records, and which open notes count as
+// unresolved issues.
+package note
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Resolutions, recorded on every closed note.
+const (
+	ResolutionFixed      = "fixed"      // The issue was addressed
+	ResolutionDuplicate  = "duplicate"  // Another note covers it (requires --by)
+	ResolutionWontFix    = "wontfix"    // Deliberately not acted on
+	ResolutionPromoted   = "promoted"   // Became a shipment, task, or decision
+	ResolutionSuperseded = "superseded" // A newer note replaces it (requires --by)
+)
+
+var resolutions = []string{ResolutionFixed, ResolutionDuplicate, ResolutionWontFix, ResolutionPromoted, ResolutionSuperseded}
+
+// closeReasons are the close reasons accepted before resolutions existed,
+// mapped to the resolution each implies.
+var closeReasons = map[string]string{
+	"superseded":  ResolutionSuperseded,
+	"synthesized": ResolutionSuperseded,
+	"resolved":    ResolutionFixed,
+	"deferred":    ResolutionWontFix,
+	"duplicate":   ResolutionDuplicate,
+	"stale":       ResolutionWontFix,
+}
+
+// Resolutions returns the valid resolutions in display order.
+func Resolutions() []string {
+	return slices.Clone(resolutions)
+}
+
+// IsValidResolution reports whether resolution is a known resolution.
+func IsValidResolution(resolution string) bool {
+	return slices.Contains(resolutions, resolution)
+}
+
+// IsValidCloseReason reports whether reason is a known close reason.
+func IsValidCloseReason(reason string) bool {
+	_, ok := closeReasons[reason]
+	return ok
+}
+
+// ResolutionForReason returns the resolution a close reason implies, or ""
+// for an unknown reason.
+func ResolutionForReason(reason string) string {
+	return closeReasons[reason]
+}
+
+// NeedsReference reports whether a resolution must name the note it defers to.
+func NeedsReference(resolution string) bool {
+	return resolution == ResolutionDuplicate || resolution == ResolutionSuperseded
+}
+
+// IsIssue reports whether notes of this type track something that needs
+// resolving, so open ones are surfaced in summaries.
+func IsIssue(noteType string) bool {
+	return noteType == "concern" || noteType == "finding"
+}
+
+// GuardResult represents the outcome of a guard evaluation.
+type GuardResult struct {
+	Allowed bool
+	Reason  string
+}
+
+// Error converts the guard result to an error if not allowed.
+func (r GuardResult) Error() error {
+	if r.Allowed {
+		return nil
+	}
+	return fmt.Errorf("%s", r.Reason)
+}
+
+// CloseNoteContext provides context for note close guards.
+type CloseNoteContext struct {
+	NoteID     string
+	Status     string
+	Resolution string // Already defaulted from the close reason, if any
+	Reason     string // Optional legacy close reason
+	ByNoteID   string
+}
+
+// CanCloseNote evaluates whether a note can be closed.
+// Rules:
+// - Note must not already be closed
+// - A close reason, if given, must be a known one
+// - A resolution is required and must be a known one
+// - duplicate and superseded must name the note they defer to
+func CanCloseNote(ctx CloseNoteContext) GuardResult {
+	if ctx.Status == "closed" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("note %s is already closed", ctx.NoteID),
+		}
+	}
+
+	if ctx.Reason != "" && !IsValidCloseReason(ctx.Reason) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("invalid close reason %q: must be one of superseded, synthesized, resolved, deferred, duplicate, stale", ctx.Reason),
+		}
+	}
+
+	if ctx.Resolution == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("a resolution is required: %s", strings.Join(resolutions, ", ")),
+		}
+	}
+
+	if !IsValidResolution(ctx.Resolution) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("invalid resolution %q: must be one of %s", ctx.Resolution, strings.Join(resolutions, ", ")),
+		}
+	}
+
+	if NeedsReference(ctx.Resolution) && ctx.ByNoteID == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("resolution %s requires --by with the note that replaces %s", ctx.Resolution, ctx.NoteID),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
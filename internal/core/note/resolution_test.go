@@ -0,0 +1,89 @@
+package note
+
+import "testing"
+
+func TestCanCloseNote(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         CloseNoteContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can close with resolution",
+			ctx:         CloseNoteContext{NoteID: "NOTE-001", Status: "open", Resolution: ResolutionFixed},
+			wantAllowed: true,
+		},
+		{
+			name:        "can close duplicate with reference",
+			ctx:         CloseNoteContext{NoteID: "NOTE-001", Status: "open", Resolution: ResolutionDuplicate, ByNoteID: "NOTE-002"},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot close closed note",
+			ctx:         CloseNoteContext{NoteID: "NOTE-001", Status: "closed", Resolution: ResolutionFixed},
+			wantAllowed: false,
+			wantReason:  "note NOTE-001 is already closed",
+		},
+		{
+			name:        "cannot close with unknown reason",
+			ctx:         CloseNoteContext{NoteID: "NOTE-001", Status: "open", Reason: "meh", Resolution: ResolutionFixed},
+			wantAllowed: false,
+			wantReason:  `invalid close reason "meh": must be one of superseded, synthesized, resolved, deferred, duplicate, stale`,
+		},
+		{
+			name:        "cannot close without resolution",
+			ctx:         CloseNoteContext{NoteID: "NOTE-001", Status: "open"},
+			wantAllowed: false,
+			wantReason:  "a resolution is required: fixed, duplicate, wontfix, promoted, superseded",
+		},
+		{
+			name:        "cannot close with unknown resolution",
+			ctx:         CloseNoteContext{NoteID: "NOTE-001", Status: "in_flight", Resolution: "done"},
+			wantAllowed: false,
+			wantReason:  `invalid resolution "done": must be one of fixed, duplicate, wontfix, promoted, superseded`,
+		},
+		{
+			name:        "cannot supersede without reference",
+			ctx:         CloseNoteContext{NoteID: "NOTE-001", Status: "open", Resolution: ResolutionSuperseded},
+			wantAllowed: false,
+			wantReason:  "resolution superseded requires --by with the note that replaces NOTE-001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanCloseNote(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestResolutionForReason(t *testing.T) {
+	tests := map[string]string{
+		"synthesized": ResolutionSuperseded,
+		"resolved":    ResolutionFixed,
+		"deferred":    ResolutionWontFix,
+		"stale":       ResolutionWontFix,
+		"duplicate":   ResolutionDuplicate,
+		"unknown":     "",
+	}
+	for reason, want := range tests {
+		if got := ResolutionForReason(reason); got != want {
+			t.Errorf("ResolutionForReason(%q) = %q, want %q", reason, got, want)
+		}
+	}
+}
+
+func TestIsIssue(t *testing.T) {
+	for noteType, want := range map[string]bool{"concern": true, "finding": true, "learning": false, "bug": false, "": false} {
+		if got := IsIssue(noteType); got != want {
+			t.Errorf("IsIssue(%q) = %v, want %v", noteType, got, want)
+		}
+	}
+}
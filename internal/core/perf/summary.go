@@ -0,0 +1,144 @@
+// Package perf contains the pure logic for summarizing per-command telemetry
+// into hotspots: which commands cost the most time, and which queries keep
+// showing up among the slowest.
+package perf
+
+import (
+	"math"
+	"sort"
+)
+
+// Invocation is one recorded command run.
+type Invocation struct {
+	Command    string
+	DurationMS float64
+	Queries    int
+	QueryMS    float64
+	Slowest    []Query
+	Failed     bool
+}
+
+// Query is a query's SQL text and its duration in one invocation.
+type Query struct {
+	SQL string
+	MS  float64
+}
+
+// CommandSummary aggregates the invocations of one command.
+type CommandSummary struct {
+	Command     string
+	Runs        int
+	Failures    int
+	TotalMS     float64
+	P50MS       float64
+	P95MS       float64
+	MaxMS       float64
+	MeanQueries float64
+	DBShare     float64 // Fraction of the command's time spent in queries
+}
+
+// QuerySummary aggregates the appearances of one SQL statement among the
+// slowest queries of each invocation.
+type QuerySummary struct {
+	SQL      string
+	Seen     int
+	TotalMS  float64
+	MaxMS    float64
+	Commands []string // Commands it was slow in, sorted
+}
+
+// Report is the hotspot summary of a set of invocations.
+type Report struct {
+	Invocations int
+	Commands    []CommandSummary // Most total time first
+	Queries     []QuerySummary   // Most total time first
+}
+
+// Summarize aggregates invocations by command and by slow query, keeping the
+// top entries of each by total time. A top of 0 or less keeps everything.
+func Summarize(invocations []Invocation, top int) Report {
+	durations := map[string][]float64{}
+	commands := map[string]*CommandSummary{}
+	queries := map[string]*QuerySummary{}
+	queryCommands := map[string]map[string]bool{}
+	queryTotals := map[string]float64{}
+	queryCounts := map[string]int{}
+
+	for _, inv := range invocations {
+		c := commands[inv.Command]
+		if c == nil {
+			c = &CommandSummary{Command: inv.Command}
+			commands[inv.Command] = c
+		}
+		c.Runs++
+		if inv.Failed {
+			c.Failures++
+		}
+		c.TotalMS += inv.DurationMS
+		c.MaxMS = math.Max(c.MaxMS, inv.DurationMS)
+		queryTotals[inv.Command] += inv.QueryMS
+		queryCounts[inv.Command] += inv.Queries
+		durations[inv.Command] = append(durations[inv.Command], inv.DurationMS)
+
+		for _, q := range inv.Slowest {
+			s := queries[q.SQL]
+			if s == nil {
+				s = &QuerySummary{SQL: q.SQL}
+				queries[q.SQL] = s
+				queryCommands[q.SQL] = map[string]bool{}
+			}
+			s.Seen++
+			s.TotalMS += q.MS
+			s.MaxMS = math.Max(s.MaxMS, q.MS)
+			queryCommands[q.SQL][inv.Command] = true
+		}
+	}
+
+	report := Report{Invocations: len(invocations)}
+	for name, c := range commands {
+		sort.Float64s(durations[name])
+		c.P50MS = percentile(durations[name], 50)
+		c.P95MS = percentile(durations[name], 95)
+		c.MeanQueries = float64(queryCounts[name]) / float64(c.Runs)
+		if c.TotalMS > 0 {
+			c.DBShare = math.Min(queryTotals[name]/c.TotalMS, 1)
+		}
+		report.Commands = append(report.Commands, *c)
+	}
+	for sql, q := range queries {
+		for name := range queryCommands[sql] {
+			q.Commands = append(q.Commands, name)
+		}
+		sort.Strings(q.Commands)
+		report.Queries = append(report.Queries, *q)
+	}
+
+	sort.Slice(report.Commands, func(i, j int) bool {
+		a, b := report.Commands[i], report.Commands[j]
+		if a.TotalMS != b.TotalMS {
+			return a.TotalMS > b.TotalMS
+		}
+		return a.Command < b.Command
+	})
+	sort.Slice(report.Queries, func(i, j int) bool {
+		a, b := report.Queries[i], report.Queries[j]
+		if a.TotalMS != b.TotalMS {
+			return a.TotalMS > b.TotalMS
+		}
+		return a.SQL < b.SQL
+	})
+	if top > 0 {
+		report.Commands = report.Commands[:min(top, len(report.Commands))]
+		report.Queries = report.Queries[:min(top, len(report.Queries))]
+	}
+	return report
+}
+
+// percentile returns the nearest-rank percentile of sorted values.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank, 1)-1]
+}
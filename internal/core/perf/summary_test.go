@@ -0,0 +1,95 @@
+package perf
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSummarize(t *testing.T) {
+	invocations := []Invocation{
+		{Command: "orc summary", DurationMS: 400, Queries: 40, QueryMS: 300, Slowest: []Query{{"SELECT tasks", 120}, {"SELECT notes", 60}}},
+		{Command: "orc summary", DurationMS: 600, Queries: 44, QueryMS: 500, Slowest: []Query{{"SELECT tasks", 200}}},
+		{Command: "orc summary", DurationMS: 200, Queries: 36, QueryMS: 100, Failed: true},
+		{Command: "orc task list", DurationMS: 50, Queries: 3, QueryMS: 10, Slowest: []Query{{"SELECT tasks", 8}}},
+		{Command: "orc status", DurationMS: 1500, Queries: 0},
+	}
+
+	report := Summarize(invocations, 0)
+
+	if report.Invocations != 5 {
+		t.Errorf("Invocations = %d, want 5", report.Invocations)
+	}
+
+	var order []string
+	for _, c := range report.Commands {
+		order = append(order, c.Command)
+	}
+	if want := []string{"orc status", "orc summary", "orc task list"}; !reflect.DeepEqual(order, want) {
+		t.Fatalf("command order = %v, want %v (most total time first)", order, want)
+	}
+
+	summary := report.Commands[1]
+	want := CommandSummary{
+		Command: "orc summary", Runs: 3, Failures: 1, TotalMS: 1200,
+		P50MS: 400, P95MS: 600, MaxMS: 600, MeanQueries: 40, DBShare: 0.75,
+	}
+	if summary != want {
+		t.Errorf("summary = %+v, want %+v", summary, want)
+	}
+	if status := report.Commands[0]; status.DBShare != 0 || status.MeanQueries != 0 {
+		t.Errorf("command without queries = %+v, want zero DB share", status)
+	}
+
+	if len(report.Queries) != 2 {
+		t.Fatalf("expected 2 slow queries, got %+v", report.Queries)
+	}
+	tasks := report.Queries[0]
+	if tasks.SQL != "SELECT tasks" || tasks.Seen != 3 || tasks.TotalMS != 328 || tasks.MaxMS != 200 ||
+		!reflect.DeepEqual(tasks.Commands, []string{"orc summary", "orc task list"}) {
+		t.Errorf("unexpected top query: %+v", tasks)
+	}
+}
+
+func TestSummarize_Top(t *testing.T) {
+	invocations := []Invocation{
+		{Command: "a", DurationMS: 10, Slowest: []Query{{"q1", 5}, {"q2", 3}}},
+		{Command: "b", DurationMS: 30, Slowest: []Query{{"q3", 9}}},
+		{Command: "c", DurationMS: 20},
+	}
+
+	report := Summarize(invocations, 2)
+	if len(report.Commands) != 2 || report.Commands[0].Command != "b" || report.Commands[1].Command != "c" {
+		t.Errorf("top commands = %+v, want b, c", report.Commands)
+	}
+	if len(report.Queries) != 2 || report.Queries[0].SQL != "q3" || report.Queries[1].SQL != "q1" {
+		t.Errorf("top queries = %+v, want q3, q1", report.Queries)
+	}
+	if report.Invocations != 3 {
+		t.Errorf("Invocations = %d, want 3 (top does not limit the count)", report.Invocations)
+	}
+
+	if empty := Summarize(nil, 5); empty.Invocations != 0 || len(empty.Commands) != 0 || len(empty.Queries) != 0 {
+		t.Errorf("Summarize(nil) = %+v, want empty", empty)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{10, 20, 30, 40, 50, 60, 70, 80, 90, 100}
+	tests := []struct {
+		p    float64
+		want float64
+	}{
+		{50, 50},
+		{95, 100},
+		{10, 10},
+		{0, 10},
+	}
+	for _, tt := range tests {
+		if got := percentile(values, tt.p); got != tt.want {
+			t.Errorf("percentile(%v) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+	if got := percentile(nil, 50); got != 0 {
+		t.Errorf("percentile of empty = %v, want 0", got)
+	}
+}
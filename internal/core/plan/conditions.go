@@ -0,0 +1,99 @@
+package plan
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StatusConditionallyApproved is the status reported for a draft plan whose
+// approver required amendments: it becomes approved once every condition is
+// addressed. The ledger stores such plans as draft with open conditions.
+const StatusConditionallyApproved = "conditionally_approved"
+
+// EffectiveStatus returns the status to report for a stored plan status and
+// its count of open conditions.
+func EffectiveStatus(status string, openConditions int) string {
+	if status == "draft" && openConditions > 0 {
+		return StatusConditionallyApproved
+	}
+	return status
+}
+
+// ApproveWithConditionsContext provides context for conditional approval guards.
+type ApproveWithConditionsContext struct {
+	ApprovePlanContext
+	Conditions []string
+}
+
+// AddressConditionContext provides context for addressing a plan condition.
+type AddressConditionContext struct {
+	PlanID         string
+	Position       int // 1-based
+	ConditionCount int
+	Addressed      bool // The condition at Position is already addressed
+	Evidence       string
+}
+
+// CanApprovePlanWithConditions evaluates whether a plan can be approved with conditions.
+// Rules:
+// - The plan must be approvable (see CanApprovePlan)
+// - At least one condition, none blank
+func CanApprovePlanWithConditions(ctx ApproveWithConditionsContext) GuardResult {
+	if result := CanApprovePlan(ctx.ApprovePlanContext); !result.Allowed {
+		return result
+	}
+
+	if len(ctx.Conditions) == 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason:  "conditional approval needs at least one condition",
+		}
+	}
+	for i, condition := range ctx.Conditions {
+		if strings.TrimSpace(condition) == "" {
+			return GuardResult{
+				Allowed: false,
+				Reason:  fmt.Sprintf("condition %d is blank", i+1),
+			}
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// CanAddressCondition evaluates whether a plan condition can be marked addressed.
+// Rules:
+// - Position must refer to an existing condition
+// - The condition must still be open
+// - Evidence is required
+func CanAddressCondition(ctx AddressConditionContext) GuardResult {
+	if ctx.ConditionCount == 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("plan %s has no conditions", ctx.PlanID),
+		}
+	}
+
+	if ctx.Position < 1 || ctx.Position > ctx.ConditionCount {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("plan %s has no condition %d (valid: 1-%d)", ctx.PlanID, ctx.Position, ctx.ConditionCount),
+		}
+	}
+
+	if ctx.Addressed {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("condition %d of plan %s is already addressed", ctx.Position, ctx.PlanID),
+		}
+	}
+
+	if strings.TrimSpace(ctx.Evidence) == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  "addressing a condition needs --evidence (a commit, plan section, or note showing the amendment)",
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
@@ -0,0 +1,120 @@
+package plan
+
+import "testing"
+
+func TestEffectiveStatus(t *testing.T) {
+	tests := []struct {
+		status         string
+		openConditions int
+		want           string
+	}{
+		{"draft", 0, "draft"},
+		{"draft", 2, StatusConditionallyApproved},
+		{"approved", 0, "approved"},
+	}
+	for _, tt := range tests {
+		if got := EffectiveStatus(tt.status, tt.openConditions); got != tt.want {
+			t.Errorf("EffectiveStatus(%q, %d) = %q, want %q", tt.status, tt.openConditions, got, tt.want)
+		}
+	}
+}
+
+func TestCanApprovePlanWithConditions(t *testing.T) {
+	draft := ApprovePlanContext{PlanID: "PLAN-001", Status: "draft"}
+	tests := []struct {
+		name        string
+		ctx         ApproveWithConditionsContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can approve draft plan with conditions",
+			ctx:         ApproveWithConditionsContext{ApprovePlanContext: draft, Conditions: []string{"add a rollback step"}},
+			wantAllowed: true,
+		},
+		{
+			name: "cannot approve approved plan",
+			ctx: ApproveWithConditionsContext{
+				ApprovePlanContext: ApprovePlanContext{PlanID: "PLAN-001", Status: "approved"},
+				Conditions:         []string{"add a rollback step"},
+			},
+			wantAllowed: false,
+			wantReason:  "can only approve draft plans (current status: approved)",
+		},
+		{
+			name:        "cannot approve without conditions",
+			ctx:         ApproveWithConditionsContext{ApprovePlanContext: draft},
+			wantAllowed: false,
+			wantReason:  "conditional approval needs at least one condition",
+		},
+		{
+			name:        "cannot approve with a blank condition",
+			ctx:         ApproveWithConditionsContext{ApprovePlanContext: draft, Conditions: []string{"add a rollback step", "  "}},
+			wantAllowed: false,
+			wantReason:  "condition 2 is blank",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanApprovePlanWithConditions(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanAddressCondition(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         AddressConditionContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can address open condition with evidence",
+			ctx:         AddressConditionContext{PlanID: "PLAN-001", Position: 1, ConditionCount: 2, Evidence: "see ## Rollback"},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot address on a plan without conditions",
+			ctx:         AddressConditionContext{PlanID: "PLAN-001", Position: 1, Evidence: "see ## Rollback"},
+			wantAllowed: false,
+			wantReason:  "plan PLAN-001 has no conditions",
+		},
+		{
+			name:        "cannot address missing condition",
+			ctx:         AddressConditionContext{PlanID: "PLAN-001", Position: 3, ConditionCount: 2, Evidence: "see ## Rollback"},
+			wantAllowed: false,
+			wantReason:  "plan PLAN-001 has no condition 3 (valid: 1-2)",
+		},
+		{
+			name:        "cannot address twice",
+			ctx:         AddressConditionContext{PlanID: "PLAN-001", Position: 1, ConditionCount: 2, Addressed: true, Evidence: "again"},
+			wantAllowed: false,
+			wantReason:  "condition 1 of plan PLAN-001 is already addressed",
+		},
+		{
+			name:        "cannot address without evidence",
+			ctx:         AddressConditionContext{PlanID: "PLAN-001", Position: 1, ConditionCount: 2},
+			wantAllowed: false,
+			wantReason:  "addressing a condition needs --evidence (a commit, plan section, or note showing the amendment)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanAddressCondition(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
@@ -29,9 +29,10 @@ type CreatePlanContext struct {
 
 // ApprovePlanContext provides context for plan approval guards.
 type ApprovePlanContext struct {
-	PlanID   string
-	Status   string // "draft", "approved"
-	IsPinned bool
+	PlanID         string
+	Status         string // "draft", "approved"
+	IsPinned       bool
+	OpenConditions int // Conditions from an earlier conditional approval
 }
 
 // DeletePlanContext provides context for plan deletion guards.
@@ -40,6 +41,22 @@ type DeletePlanContext struct {
 	IsPinned bool
 }
 
+// TrackPlanContext provides context for plan tracking guards.
+type TrackPlanContext struct {
+	PlanID    string
+	Status    string // "draft", "approved"
+	StepCount int    // Stored steps, or steps parsed from content if not yet tracked
+}
+
+// LinkPlanStepContext provides context for linking a plan step to a task.
+type LinkPlanStepContext struct {
+	PlanID     string
+	Position   int // 1-based
+	StepCount  int // 0 means the plan is not tracked
+	TaskID     string
+	TaskExists bool
+}
+
 // CanCreatePlan evaluates whether a plan can be created.
 // Rules:
 // - Commission must exist
@@ -77,6 +94,7 @@ func CanCreatePlan(ctx CreatePlanContext) GuardResult {
 // Rules:
 // - Status must be "draft"
 // - Plan must not be pinned
+// - A conditionally approved plan is approved by addressing its conditions
 func CanApprovePlan(ctx ApprovePlanContext) GuardResult {
 	// Check status is draft
 	if ctx.Status != "draft" {
@@ -94,6 +112,15 @@ func CanApprovePlan(ctx ApprovePlanContext) GuardResult {
 		}
 	}
 
+	// Check no conditions are outstanding
+	if ctx.OpenConditions > 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason: fmt.Sprintf("plan %s is conditionally approved with %d open condition(s); it is approved once they are addressed with: orc plan address %s <n> --evidence ...",
+				ctx.PlanID, ctx.OpenConditions, ctx.PlanID),
+		}
+	}
+
 	return GuardResult{Allowed: true}
 }
 
@@ -110,3 +137,55 @@ func CanDeletePlan(ctx DeletePlanContext) GuardResult {
 
 	return GuardResult{Allowed: true}
 }
+
+// CanTrackPlan evaluates whether a plan's steps can be tracked.
+// Rules:
+// - Plan must be approved
+// - Plan must contain at least one step
+func CanTrackPlan(ctx TrackPlanContext) GuardResult {
+	if ctx.Status != "approved" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("can only track approved plans (current status: %s). Approve first with: orc plan approve %s", ctx.Status, ctx.PlanID),
+		}
+	}
+
+	if ctx.StepCount == 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("plan %s has no steps. Use numbered items (1. ...), checklist items (- [ ] ...), or ## headings", ctx.PlanID),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// CanLinkPlanStep evaluates whether a plan step can be linked to a task.
+// Rules:
+// - Plan must be tracked
+// - Position must refer to an existing step
+// - Task must exist
+func CanLinkPlanStep(ctx LinkPlanStepContext) GuardResult {
+	if ctx.StepCount == 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("plan %s is not tracked. Track it first with: orc plan track %s", ctx.PlanID, ctx.PlanID),
+		}
+	}
+
+	if ctx.Position < 1 || ctx.Position > ctx.StepCount {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("plan %s has no step %d (valid: 1-%d)", ctx.PlanID, ctx.Position, ctx.StepCount),
+		}
+	}
+
+	if !ctx.TaskExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("task %s not found", ctx.TaskID),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
@@ -122,6 +122,16 @@ func TestCanApprovePlan(t *testing.T) {
 			wantAllowed: false,
 			wantReason:  "can only approve draft plans (current status: approved)",
 		},
+		{
+			name: "cannot approve conditionally approved plan",
+			ctx: ApprovePlanContext{
+				PlanID:         "PLAN-001",
+				Status:         "draft",
+				OpenConditions: 2,
+			},
+			wantAllowed: false,
+			wantReason:  "plan PLAN-001 is conditionally approved with 2 open condition(s); it is approved once they are addressed with: orc plan address PLAN-001 <n> --evidence ...",
+		},
 	}
 
 	for _, tt := range tests {
@@ -184,6 +194,90 @@ func TestCanDeletePlan(t *testing.T) {
 	}
 }
 
+func TestCanTrackPlan(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         TrackPlanContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can track approved plan with steps",
+			ctx:         TrackPlanContext{PlanID: "PLAN-001", Status: "approved", StepCount: 3},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot track draft plan",
+			ctx:         TrackPlanContext{PlanID: "PLAN-001", Status: "draft", StepCount: 3},
+			wantAllowed: false,
+			wantReason:  "can only track approved plans (current status: draft). Approve first with: orc plan approve PLAN-001",
+		},
+		{
+			name:        "cannot track plan without steps",
+			ctx:         TrackPlanContext{PlanID: "PLAN-001", Status: "approved", StepCount: 0},
+			wantAllowed: false,
+			wantReason:  "plan PLAN-001 has no steps. Use numbered items (1. ...), checklist items (- [ ] ...), or ## headings",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanTrackPlan(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanLinkPlanStep(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         LinkPlanStepContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can link existing step to existing task",
+			ctx:         LinkPlanStepContext{PlanID: "PLAN-001", Position: 2, StepCount: 3, TaskID: "TASK-005", TaskExists: true},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot link untracked plan",
+			ctx:         LinkPlanStepContext{PlanID: "PLAN-001", Position: 1, StepCount: 0, TaskID: "TASK-005", TaskExists: true},
+			wantAllowed: false,
+			wantReason:  "plan PLAN-001 is not tracked. Track it first with: orc plan track PLAN-001",
+		},
+		{
+			name:        "cannot link out of range step",
+			ctx:         LinkPlanStepContext{PlanID: "PLAN-001", Position: 4, StepCount: 3, TaskID: "TASK-005", TaskExists: true},
+			wantAllowed: false,
+			wantReason:  "plan PLAN-001 has no step 4 (valid: 1-3)",
+		},
+		{
+			name:        "cannot link missing task",
+			ctx:         LinkPlanStepContext{PlanID: "PLAN-001", Position: 1, StepCount: 3, TaskID: "TASK-999", TaskExists: false},
+			wantAllowed: false,
+			wantReason:  "task TASK-999 not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanLinkPlanStep(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
 func TestGuardResult_Error(t *testing.T) {
 	t.Run("allowed result returns nil error", func(t *testing.T) {
 		result := GuardResult{Allowed: true}
@@ -0,0 +1,47 @@
+package plan
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	orderedItemPattern = regexp.MustCompile(`^\d+[.)]\s+(.+)$`)
+	taskItemPattern    = regexp.MustCompile(`^[-*]\s+\[[ xX]\]\s+(.+)$`)
+	headingPattern     = regexp.MustCompile(`^#{2,3}\s+(.+)$`)
+)
+
+// ParseSteps extracts the trackable steps from a plan's markdown content.
+// Rules:
+// - A step is a top-level ordered list item ("1. ...") or task list item ("- [ ] ...")
+// - If the plan has no such items, each level 2 or 3 heading is a step
+// - Indented items and lines inside fenced code blocks are ignored
+// Steps are returned in document order.
+func ParseSteps(content string) []string {
+	var items, headings []string
+	inFence := false
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		if m := orderedItemPattern.FindStringSubmatch(line); m != nil {
+			items = append(items, strings.TrimSpace(m[1]))
+		} else if m := taskItemPattern.FindStringSubmatch(line); m != nil {
+			items = append(items, strings.TrimSpace(m[1]))
+		} else if m := headingPattern.FindStringSubmatch(line); m != nil {
+			headings = append(headings, strings.TrimSpace(m[1]))
+		}
+	}
+
+	if len(items) > 0 {
+		return items
+	}
+	return headings
+}
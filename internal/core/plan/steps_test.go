@@ -0,0 +1,54 @@
+package plan
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseSteps(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "ordered list",
+			content: "Intro text\n\n1. Add schema\n2. Write repo\n   1. nested detail\n3) Wire CLI\n",
+			want:    []string{"Add schema", "Write repo", "Wire CLI"},
+		},
+		{
+			name:    "task list",
+			content: "- [ ] Parse content\n- [x] Store steps\n- plain bullet\n",
+			want:    []string{"Parse content", "Store steps"},
+		},
+		{
+			name:    "headings when no list items",
+			content: "# Plan\n\n## Schema\nDetails\n\n### Repository\n#### Too deep\n",
+			want:    []string{"Schema", "Repository"},
+		},
+		{
+			name:    "list items win over headings",
+			content: "## Steps\n1. Only step\n",
+			want:    []string{"Only step"},
+		},
+		{
+			name:    "fenced code is ignored",
+			content: "1. Real step\n```\n2. not a step\n## nor this\n```\n",
+			want:    []string{"Real step"},
+		},
+		{
+			name:    "no steps",
+			content: "Just prose.",
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseSteps(tt.content)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSteps() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
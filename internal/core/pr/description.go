@@ -0,0 +1,110 @@
+package pr
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Markers around the generated part of a PR description. Regenerating
+// replaces only what lies between them, so edits outside survive.
+const (
+	GeneratedStart = "<!-- orc:generated:start -->"
+	GeneratedEnd   = "<!-- orc:generated:end -->"
+)
+
+// DescriptionInput is the ledger state a PR description is generated from.
+type DescriptionInput struct {
+	ShipmentID string
+	Summary    string // The shipment's charter, else its description
+	Plans      []DescribedPlan
+	Tasks      []DescribedTask // Completed tasks
+	Notes      []DescribedNote
+}
+
+// DescribedPlan is an approved plan behind the PR's work.
+type DescribedPlan struct {
+	ID          string
+	TaskID      string
+	Title       string
+	Description string
+}
+
+// DescribedTask is a completed task and the commits that landed it.
+type DescribedTask struct {
+	ID      string
+	Title   string
+	Commits []string // Short SHAs
+}
+
+// DescribedNote is a note filed on the shipment.
+type DescribedNote struct {
+	ID    string
+	Type  string
+	Title string
+}
+
+// RenderDescription renders the generated block, markers included.
+func RenderDescription(in DescriptionInput) string {
+	var b strings.Builder
+	b.WriteString(GeneratedStart + "\n")
+	fmt.Fprintf(&b, "<!-- Generated from %s by 'orc pr describe'. Edits inside this block are replaced on regeneration; write outside it. -->\n", in.ShipmentID)
+
+	if summary := strings.TrimSpace(in.Summary); summary != "" {
+		fmt.Fprintf(&b, "\n## Summary\n\n%s\n", summary)
+	}
+
+	if len(in.Plans) > 0 {
+		b.WriteString("\n## Plan\n\n")
+		for _, p := range in.Plans {
+			line := fmt.Sprintf("- **%s** (%s, %s)", p.Title, p.ID, p.TaskID)
+			if d := strings.TrimSpace(p.Description); d != "" {
+				line += ": " + d
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+
+	if len(in.Tasks) > 0 {
+		b.WriteString("\n## Completed\n\n")
+		for _, t := range in.Tasks {
+			line := fmt.Sprintf("- %s %s", t.ID, t.Title)
+			if len(t.Commits) > 0 {
+				line += " (" + strings.Join(t.Commits, ", ") + ")"
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+
+	if len(in.Notes) > 0 {
+		b.WriteString("\n## Notes\n\n")
+		for _, n := range in.Notes {
+			if n.Type != "" {
+				fmt.Fprintf(&b, "- %s [%s] %s\n", n.ID, n.Type, n.Title)
+			} else {
+				fmt.Fprintf(&b, "- %s %s\n", n.ID, n.Title)
+			}
+		}
+	}
+
+	b.WriteString(GeneratedEnd)
+	return b.String()
+}
+
+// MergeDescription puts a generated block into the current description.
+// An existing block is replaced in place. Without one, the block goes on top
+// and the current text, written by hand, is kept below it.
+func MergeDescription(current, block string) string {
+	start := strings.Index(current, GeneratedStart)
+	if start >= 0 {
+		if end := strings.Index(current[start:], GeneratedEnd); end >= 0 {
+			end += start + len(GeneratedEnd)
+			return current[:start] + block + current[end:]
+		}
+	}
+
+	current = strings.TrimSpace(current)
+	if current == "" {
+		return block
+	}
+	return block + "\n\n" + current
+}
@@ -0,0 +1,70 @@
+package pr
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderDescription(t *testing.T) {
+	block := RenderDescription(DescriptionInput{
+		ShipmentID: "SHIP-001",
+		Summary:    "Add login",
+		Plans:      []DescribedPlan{{ID: "PLAN-001", TaskID: "TASK-001", Title: "Auth flow", Description: "Session cookies"}},
+		Tasks:      []DescribedTask{{ID: "TASK-001", Title: "Build form", Commits: []string{"abc1234", "def5678"}}},
+		Notes:      []DescribedNote{{ID: "NOTE-001", Type: "decision", Title: "Use bcrypt"}},
+	})
+
+	if !strings.HasPrefix(block, GeneratedStart+"\n") || !strings.HasSuffix(block, GeneratedEnd) {
+		t.Fatalf("block not wrapped in markers:\n%s", block)
+	}
+	for _, want := range []string{
+		"## Summary\n\nAdd login",
+		"- **Auth flow** (PLAN-001, TASK-001): Session cookies",
+		"- TASK-001 Build form (abc1234, def5678)",
+		"- NOTE-001 [decision] Use bcrypt",
+	} {
+		if !strings.Contains(block, want) {
+			t.Errorf("block missing %q:\n%s", want, block)
+		}
+	}
+}
+
+func TestRenderDescription_OmitsEmptySections(t *testing.T) {
+	block := RenderDescription(DescriptionInput{ShipmentID: "SHIP-001"})
+	for _, section := range []string{"## Summary", "## Plan", "## Completed", "## Notes"} {
+		if strings.Contains(block, section) {
+			t.Errorf("empty input rendered %q:\n%s", section, block)
+		}
+	}
+}
+
+func TestMergeDescription(t *testing.T) {
+	block := GeneratedStart + "\nnew\n" + GeneratedEnd
+
+	tests := []struct {
+		name    string
+		current string
+		want    string
+	}{
+		{name: "empty", current: "  ", want: block},
+		{name: "manual text only", current: "Reviewers: check the migration.\n", want: block + "\n\nReviewers: check the migration."},
+		{
+			name:    "replaces block, keeps edits around it",
+			current: "Intro\n\n" + GeneratedStart + "\nold\n" + GeneratedEnd + "\n\nOutro",
+			want:    "Intro\n\n" + block + "\n\nOutro",
+		},
+		{
+			name:    "unterminated block treated as manual text",
+			current: GeneratedStart + "\nold",
+			want:    block + "\n\n" + GeneratedStart + "\nold",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MergeDescription(tt.current, block); got != tt.want {
+				t.Errorf("MergeDescription() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
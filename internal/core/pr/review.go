@@ -0,0 +1,112 @@
+package pr
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Review item kinds.
+const (
+	ReviewKindReview  = "review"  // A submitted review with an overall state
+	ReviewKindComment = "comment" // An inline comment on a file
+)
+
+// ReviewStateChangesRequested is the GitHub state of a review requesting changes.
+const ReviewStateChangesRequested = "CHANGES_REQUESTED"
+
+// maxReviewTaskTitle bounds task titles derived from review text.
+const maxReviewTaskTitle = 80
+
+// ParseGitHubPRURL extracts "owner/name" and the PR number from a GitHub
+// pull request URL such as https://github.com/acme/app/pull/12.
+func ParseGitHubPRURL(rawURL string) (repo string, number int, err error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || u.Host == "" {
+		return "", 0, fmt.Errorf("invalid PR URL %q", rawURL)
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 4 || parts[2] != "pull" {
+		return "", 0, fmt.Errorf("not a GitHub pull request URL: %q", rawURL)
+	}
+	number, err = strconv.Atoi(parts[3])
+	if err != nil || number <= 0 {
+		return "", 0, fmt.Errorf("not a GitHub pull request URL: %q", rawURL)
+	}
+	return parts[0] + "/" + parts[1], number, nil
+}
+
+// ReviewItem is a review or inline review comment on a pull request.
+type ReviewItem struct {
+	ExternalID       string // e.g. "review:123" or "comment:456"
+	Kind             string // review or comment
+	ReviewExternalID string // Comments: the review they were submitted with
+	InReplyTo        bool   // Comments: a reply within an existing thread
+	State            string // Reviews: APPROVED, CHANGES_REQUESTED, COMMENTED, DISMISSED
+	Body             string
+	Path             string // Comments: file commented on
+	Line             int    // Comments: line commented on, 0 if unknown
+	TaskID           string // Task already created for this item
+}
+
+// RequestedChanges returns the items that each ask for a change: the body of
+// every review requesting changes, and every top-level inline comment
+// submitted with such a review. Empty bodies and thread replies are skipped.
+// Input order is preserved.
+func RequestedChanges(items []ReviewItem) []ReviewItem {
+	requesting := make(map[string]bool)
+	for _, item := range items {
+		if item.Kind == ReviewKindReview && item.State == ReviewStateChangesRequested {
+			requesting[item.ExternalID] = true
+		}
+	}
+
+	var changes []ReviewItem
+	for _, item := range items {
+		if strings.TrimSpace(item.Body) == "" {
+			continue
+		}
+		switch item.Kind {
+		case ReviewKindReview:
+			if requesting[item.ExternalID] {
+				changes = append(changes, item)
+			}
+		case ReviewKindComment:
+			if !item.InReplyTo && requesting[item.ReviewExternalID] {
+				changes = append(changes, item)
+			}
+		}
+	}
+	return changes
+}
+
+// ReviewDedupKey identifies a requested change regardless of which review
+// repeated it: the file it targets and its whitespace- and case-normalized text.
+func ReviewDedupKey(item ReviewItem) string {
+	return item.Path + "\x00" + strings.ToLower(strings.Join(strings.Fields(item.Body), " "))
+}
+
+// ReviewTaskTitle derives a task title from a requested change: the first
+// line of its text, prefixed with the file it targets.
+func ReviewTaskTitle(item ReviewItem) string {
+	text := strings.TrimSpace(item.Body)
+	if i := strings.IndexByte(text, '\n'); i >= 0 {
+		text = strings.TrimSpace(text[:i])
+	}
+
+	prefix := "Review: "
+	if item.Path != "" {
+		prefix += item.Path
+		if item.Line > 0 {
+			prefix += ":" + strconv.Itoa(item.Line)
+		}
+		prefix += " — "
+	}
+
+	title := prefix + text
+	if runes := []rune(title); len(runes) > maxReviewTaskTitle {
+		title = string(runes[:maxReviewTaskTitle-1]) + "…"
+	}
+	return title
+}
@@ -0,0 +1,96 @@
+package pr
+
+import "testing"
+
+func TestParseGitHubPRURL(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		wantRepo   string
+		wantNumber int
+		wantErr    bool
+	}{
+		{name: "pull URL", url: "https://github.com/acme/app/pull/12", wantRepo: "acme/app", wantNumber: 12},
+		{name: "files tab", url: "https://github.com/acme/app/pull/7/files", wantRepo: "acme/app", wantNumber: 7},
+		{name: "issue URL", url: "https://github.com/acme/app/issues/12", wantErr: true},
+		{name: "no number", url: "https://github.com/acme/app/pull/", wantErr: true},
+		{name: "not a URL", url: "acme/app#12", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, number, err := ParseGitHubPRURL(tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseGitHubPRURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if repo != tt.wantRepo || number != tt.wantNumber {
+				t.Errorf("ParseGitHubPRURL() = %q, %d, want %q, %d", repo, number, tt.wantRepo, tt.wantNumber)
+			}
+		})
+	}
+}
+
+func TestRequestedChanges(t *testing.T) {
+	items := []ReviewItem{
+		{ExternalID: "review:1", Kind: ReviewKindReview, State: "APPROVED", Body: "LGTM"},
+		{ExternalID: "review:2", Kind: ReviewKindReview, State: ReviewStateChangesRequested, Body: "Needs tests"},
+		{ExternalID: "review:3", Kind: ReviewKindReview, State: ReviewStateChangesRequested},
+		{ExternalID: "comment:10", Kind: ReviewKindComment, ReviewExternalID: "review:3", Path: "a.go", Body: "Handle nil"},
+		{ExternalID: "comment:11", Kind: ReviewKindComment, ReviewExternalID: "review:3", Path: "a.go", Body: "done", InReplyTo: true},
+		{ExternalID: "comment:12", Kind: ReviewKindComment, ReviewExternalID: "review:1", Path: "b.go", Body: "nit"},
+	}
+
+	got := RequestedChanges(items)
+	var ids []string
+	for _, item := range got {
+		ids = append(ids, item.ExternalID)
+	}
+	if len(ids) != 2 || ids[0] != "review:2" || ids[1] != "comment:10" {
+		t.Errorf("RequestedChanges() = %v, want [review:2 comment:10]", ids)
+	}
+}
+
+func TestReviewDedupKey(t *testing.T) {
+	a := ReviewItem{Path: "a.go", Body: "Handle  nil\ncase"}
+	b := ReviewItem{Path: "a.go", Body: "handle nil case "}
+	c := ReviewItem{Path: "b.go", Body: "handle nil case"}
+
+	if ReviewDedupKey(a) != ReviewDedupKey(b) {
+		t.Error("expected same key for reworded whitespace and case")
+	}
+	if ReviewDedupKey(a) == ReviewDedupKey(c) {
+		t.Error("expected different keys for different files")
+	}
+}
+
+func TestReviewTaskTitle(t *testing.T) {
+	tests := []struct {
+		name string
+		item ReviewItem
+		want string
+	}{
+		{
+			name: "review body",
+			item: ReviewItem{Body: "Please add tests\n\nSee the contributing guide."},
+			want: "Review: Please add tests",
+		},
+		{
+			name: "inline comment",
+			item: ReviewItem{Path: "internal/app/x.go", Line: 42, Body: "Handle the nil case"},
+			want: "Review: internal/app/x.go:42 — Handle the nil case",
+		},
+		{
+			name: "truncated",
+			item: ReviewItem{Body: "This sentence is deliberately far too long to fit in a task title without being cut short"},
+			want: "Review: This sentence is deliberately far too long to fit in a task title witho…",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReviewTaskTitle(tt.item); got != tt.want {
+				t.Errorf("ReviewTaskTitle() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
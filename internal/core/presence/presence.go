@@ -0,0 +1,53 @@
+// Package presence contains the pure logic for live collaboration markers:
+// who else has recently viewed or edited an entity. Presence is
+// best-effort, taken from the commands actors run, and only advises; locks
+// are what refuse concurrent edits.
+package presence
+
+import (
+	"strings"
+	"time"
+)
+
+// Window is how long after a command an actor still counts as active on
+// the entity it touched.
+const Window = 10 * time.Minute
+
+// Actions an actor can be seen taking on an entity.
+const (
+	ActionView = "view"
+	ActionEdit = "edit"
+)
+
+// Sighting is an actor last seen on an entity.
+type Sighting struct {
+	EntityID string
+	ActorID  string
+	Action   string
+	SeenAt   time.Time
+}
+
+// Active returns the sightings by actors other than self within Window of
+// now, in the order given.
+func Active(sightings []Sighting, self string, now time.Time) []Sighting {
+	var active []Sighting
+	for _, s := range sightings {
+		if s.ActorID == self || now.Sub(s.SeenAt) > Window {
+			continue
+		}
+		active = append(active, s)
+	}
+	return active
+}
+
+// ActorLabel names an actor as other views do: an IMP by its workbench
+// (BENCH-003), the human orchestrator as ORC.
+func ActorLabel(actorID string) string {
+	if bench, ok := strings.CutPrefix(actorID, "IMP-"); ok {
+		return bench
+	}
+	if actorID == "GOBLIN" {
+		return "ORC"
+	}
+	return actorID
+}
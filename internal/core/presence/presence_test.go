@@ -0,0 +1,36 @@
+package presence
+
+import (
+	"testing"
+	"time"
+)
+
+func TestActive(t *testing.T) {
+	now := time.Date(2026, 10, 1, 14, 10, 0, 0, time.UTC)
+	sightings := []Sighting{
+		{ActorID: "IMP-BENCH-003", Action: ActionEdit, SeenAt: now.Add(-2 * time.Minute)},
+		{ActorID: "GOBLIN", Action: ActionView, SeenAt: now.Add(-time.Minute)},
+		{ActorID: "IMP-BENCH-004", Action: ActionView, SeenAt: now.Add(-time.Hour)},
+	}
+
+	active := Active(sightings, "GOBLIN", now)
+	if len(active) != 1 || active[0].ActorID != "IMP-BENCH-003" {
+		t.Errorf("Active() = %+v, want only IMP-BENCH-003 (self and stale left out)", active)
+	}
+	if active := Active(sightings, "IMP-BENCH-003", now); len(active) != 1 || active[0].ActorID != "GOBLIN" {
+		t.Errorf("Active() for the IMP = %+v, want only GOBLIN", active)
+	}
+}
+
+func TestActorLabel(t *testing.T) {
+	tests := map[string]string{
+		"IMP-BENCH-003": "BENCH-003",
+		"GOBLIN":        "ORC",
+		"someone":       "someone",
+	}
+	for actorID, want := range tests {
+		if got := ActorLabel(actorID); got != want {
+			t.Errorf("ActorLabel(%q) = %q, want %q", actorID, got, want)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+// Package ratelimit contains the pure business logic for spotting runaway
+// actors: an agent stuck in a loop creating notes or flipping statuses far
+// faster than any working session would. An actor over its limit is
+// throttled, its writes refused until the throttle expires or ORC lifts it.
+package ratelimit
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Window is the span activity is counted over; limits are per Window.
+const Window = time.Minute
+
+// Cooldown is how long a throttle lasts unless ORC lifts it sooner.
+const Cooldown = 15 * time.Minute
+
+// Activity kinds a limit can be set on.
+const (
+	KindNotes  = "notes"  // Notes created
+	KindStatus = "status" // Status changes on any entity
+	KindWrites = "writes" // Every logged change
+)
+
+// Kinds lists the activity kinds, in the order they are checked.
+var Kinds = []string{KindNotes, KindStatus, KindWrites}
+
+// Actor types limits are set per.
+const (
+	ActorIMP    = "IMP"
+	ActorGoblin = "GOBLIN"
+)
+
+// DefaultLimits are the per-minute limits where none is configured, well
+// above what a working IMP produces. ORC, whom throttles are escalated to,
+// has none by default.
+var DefaultLimits = map[string]map[string]int{
+	ActorIMP: {KindNotes: 20, KindStatus: 30, KindWrites: 120},
+}
+
+// GuardResult represents the outcome of a guard evaluation.
+type GuardResult struct {
+	Allowed bool
+	Reason  string
+}
+
+// Error converts the guard result to an error if not allowed.
+func (r GuardResult) Error() error {
+	if r.Allowed {
+		return nil
+	}
+	return fmt.Errorf("%s", r.Reason)
+}
+
+// ActorType returns the actor type of an actor ID: IMP for IMP-BENCH-xxx,
+// GOBLIN for GOBLIN, "" otherwise.
+func ActorType(actorID string) string {
+	switch {
+	case strings.HasPrefix(actorID, ActorIMP+"-"):
+		return ActorIMP
+	case actorID == ActorGoblin:
+		return ActorGoblin
+	}
+	return ""
+}
+
+// ValidateActorType returns an error unless actorType is IMP or GOBLIN.
+func ValidateActorType(actorType string) error {
+	if actorType != ActorIMP && actorType != ActorGoblin {
+		return fmt.Errorf("invalid actor type %q: must be %s or %s", actorType, ActorIMP, ActorGoblin)
+	}
+	return nil
+}
+
+// ValidateKind returns an error unless kind is a known activity kind.
+func ValidateKind(kind string) error {
+	for _, k := range Kinds {
+		if kind == k {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid activity kind %q: must be one of %s", kind, strings.Join(Kinds, ", "))
+}
+
+// Activity counts an actor's changes over the last Window.
+type Activity struct {
+	Notes         int
+	StatusChanges int
+	Writes        int
+}
+
+// Count returns the activity of one kind.
+func (a Activity) Count(kind string) int {
+	switch kind {
+	case KindNotes:
+		return a.Notes
+	case KindStatus:
+		return a.StatusChanges
+	case KindWrites:
+		return a.Writes
+	}
+	return 0
+}
+
+// Breach is an activity kind over its limit.
+type Breach struct {
+	Kind  string
+	Count int
+	Limit int
+}
+
+// String describes a breach, e.g. "25 notes in the last minute (limit 20)".
+func (b Breach) String() string {
+	what := b.Kind
+	if b.Kind == KindStatus {
+		what = "status changes"
+	}
+	return fmt.Sprintf("%d %s in the last minute (limit %d)", b.Count, what, b.Limit)
+}
+
+// Check returns the first kind, in Kinds order, whose activity exceeds its
+// limit. A limit of 0 (or none) is off.
+func Check(activity Activity, limits map[string]int) (Breach, bool) {
+	for _, kind := range Kinds {
+		limit := limits[kind]
+		if limit > 0 && activity.Count(kind) > limit {
+			return Breach{Kind: kind, Count: activity.Count(kind), Limit: limit}, true
+		}
+	}
+	return Breach{}, false
+}
+
+// Throttle is an actor's throttle as stored. A zero Throttle means none.
+type Throttle struct {
+	ActorID   string
+	Reason    string
+	ExpiresAt time.Time
+}
+
+// Active returns true if the throttle is set and has not expired.
+func (t Throttle) Active(now time.Time) bool {
+	return t.ActorID != "" && now.Before(t.ExpiresAt)
+}
+
+// WriteContext provides context for checking whether an actor may write.
+type WriteContext struct {
+	ActorID  string
+	Throttle Throttle
+	Now      time.Time
+}
+
+// CanWrite evaluates whether an actor may write.
+// Rules:
+// - An actor with an active throttle may not write until it expires or is lifted
+func CanWrite(ctx WriteContext) GuardResult {
+	if !ctx.Throttle.Active(ctx.Now) {
+		return GuardResult{Allowed: true}
+	}
+	return GuardResult{
+		Allowed: false,
+		Reason: fmt.Sprintf("%s is throttled for runaway activity (%s); writes are refused until %s unless ORC lifts it ('orc ratelimit lift %s')",
+			ctx.ActorID, ctx.Throttle.Reason, ctx.Throttle.ExpiresAt.Format("15:04"), ctx.ActorID),
+	}
+}
+
+// LiftContext provides context for checking whether a throttle can be lifted.
+type LiftContext struct {
+	ActorID     string // Who is lifting
+	ThrottledID string
+	Throttle    Throttle
+	Now         time.Time
+}
+
+// CanLift evaluates whether an actor may lift a throttle.
+// Rules:
+// - The throttle must be active
+// - Only ORC (GOBLIN) lifts throttles; a throttled IMP can't release itself
+func CanLift(ctx LiftContext) GuardResult {
+	if !ctx.Throttle.Active(ctx.Now) {
+		return GuardResult{Allowed: false, Reason: fmt.Sprintf("%s is not throttled", ctx.ThrottledID)}
+	}
+	if ActorType(ctx.ActorID) != ActorGoblin {
+		return GuardResult{Allowed: false, Reason: fmt.Sprintf("only ORC can lift %s's throttle", ctx.ThrottledID)}
+	}
+	return GuardResult{Allowed: true}
+}
+
+// CanConfigure evaluates whether an actor may change limits.
+// Rules:
+// - IMPs can't change limits, so a looping IMP can't raise its own
+func CanConfigure(actorID string) GuardResult {
+	if ActorType(actorID) == ActorIMP {
+		return GuardResult{Allowed: false, Reason: fmt.Sprintf("only ORC can change rate limits (running as %s)", actorID)}
+	}
+	return GuardResult{Allowed: true}
+}
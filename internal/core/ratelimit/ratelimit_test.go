@@ -0,0 +1,168 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+var (
+	now       = time.Date(2026, 10, 1, 14, 10, 0, 0, time.UTC)
+	throttled = Throttle{
+		ActorID:   "IMP-BENCH-003",
+		Reason:    "25 notes in the last minute (limit 20)",
+		ExpiresAt: time.Date(2026, 10, 1, 14, 25, 0, 0, time.UTC),
+	}
+	lapsed = Throttle{
+		ActorID:   "IMP-BENCH-003",
+		Reason:    "25 notes in the last minute (limit 20)",
+		ExpiresAt: time.Date(2026, 10, 1, 14, 0, 0, 0, time.UTC),
+	}
+)
+
+func TestActorType(t *testing.T) {
+	tests := map[string]string{
+		"IMP-BENCH-014": ActorIMP,
+		"GOBLIN":        ActorGoblin,
+		"":              "",
+		"IMPOSTOR":      "",
+	}
+	for id, want := range tests {
+		if got := ActorType(id); got != want {
+			t.Errorf("ActorType(%q) = %q, want %q", id, got, want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := ValidateKind("notes"); err != nil {
+		t.Errorf("ValidateKind(notes) = %v", err)
+	}
+	if err := ValidateKind("comments"); err == nil {
+		t.Error("expected an error for an unknown kind")
+	}
+	if err := ValidateActorType("IMP"); err != nil {
+		t.Errorf("ValidateActorType(IMP) = %v", err)
+	}
+	if err := ValidateActorType("imp"); err == nil {
+		t.Error("expected an error for a lowercase actor type")
+	}
+}
+
+func TestCheck(t *testing.T) {
+	limits := DefaultLimits[ActorIMP]
+	tests := []struct {
+		name     string
+		activity Activity
+		want     string // Breach description; empty for none
+	}{
+		{"quiet", Activity{Notes: 2, StatusChanges: 3, Writes: 12}, ""},
+		{"at the limit", Activity{Notes: 20, StatusChanges: 30, Writes: 120}, ""},
+		{"note flood", Activity{Notes: 25, Writes: 25}, "25 notes in the last minute (limit 20)"},
+		{"status flips", Activity{StatusChanges: 31, Writes: 31}, "31 status changes in the last minute (limit 30)"},
+		{"notes checked first", Activity{Notes: 40, StatusChanges: 40, Writes: 200}, "40 notes in the last minute (limit 20)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			breach, ok := Check(tt.activity, limits)
+			if ok != (tt.want != "") {
+				t.Fatalf("Check() breached = %v, want %v", ok, tt.want != "")
+			}
+			if ok && breach.String() != tt.want {
+				t.Errorf("breach = %q, want %q", breach.String(), tt.want)
+			}
+		})
+	}
+
+	// No limits (ORC's default) never breach
+	if _, ok := Check(Activity{Notes: 500, Writes: 500}, DefaultLimits[ActorGoblin]); ok {
+		t.Error("expected no breach without limits")
+	}
+}
+
+func TestCanWrite(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         WriteContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "not throttled",
+			ctx:         WriteContext{ActorID: "IMP-BENCH-003", Now: now},
+			wantAllowed: true,
+		},
+		{
+			name:        "throttle lapsed",
+			ctx:         WriteContext{ActorID: "IMP-BENCH-003", Throttle: lapsed, Now: now},
+			wantAllowed: true,
+		},
+		{
+			name:        "throttled",
+			ctx:         WriteContext{ActorID: "IMP-BENCH-003", Throttle: throttled, Now: now},
+			wantAllowed: false,
+			wantReason:  "IMP-BENCH-003 is throttled for runaway activity (25 notes in the last minute (limit 20)); writes are refused until 14:25 unless ORC lifts it ('orc ratelimit lift IMP-BENCH-003')",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanWrite(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanLift(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         LiftContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "ORC lifts",
+			ctx:         LiftContext{ActorID: "GOBLIN", ThrottledID: "IMP-BENCH-003", Throttle: throttled, Now: now},
+			wantAllowed: true,
+		},
+		{
+			name:        "IMP cannot lift its own",
+			ctx:         LiftContext{ActorID: "IMP-BENCH-003", ThrottledID: "IMP-BENCH-003", Throttle: throttled, Now: now},
+			wantAllowed: false,
+			wantReason:  "only ORC can lift IMP-BENCH-003's throttle",
+		},
+		{
+			name:        "nothing to lift",
+			ctx:         LiftContext{ActorID: "GOBLIN", ThrottledID: "IMP-BENCH-003", Throttle: lapsed, Now: now},
+			wantAllowed: false,
+			wantReason:  "IMP-BENCH-003 is not throttled",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanLift(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanConfigure(t *testing.T) {
+	if result := CanConfigure("GOBLIN"); !result.Allowed {
+		t.Errorf("GOBLIN should configure limits, got %q", result.Reason)
+	}
+	if result := CanConfigure(""); !result.Allowed {
+		t.Errorf("a human outside a workbench should configure limits, got %q", result.Reason)
+	}
+	result := CanConfigure("IMP-BENCH-003")
+	if result.Allowed || result.Reason != "only ORC can change rate limits (running as IMP-BENCH-003)" {
+		t.Errorf("CanConfigure(IMP) = %+v", result)
+	}
+}
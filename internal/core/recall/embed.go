@@ -0,0 +1,191 @@
+// Package recall contains the pure logic behind orc recall: a local text
+// embedding that needs no model download or external service, and the
+// ranking of indexed entities against a query.
+//
+// The embedding hashes word, word-pair, and character-trigram features into a
+// fixed-size vector (the "hashing trick"). It has no notion of synonyms, but
+// it tolerates inflections and partial words and ranks by shared vocabulary,
+// which is what recalling a ledger's own decisions mostly needs.
+package recall
+
+import (
+	"hash/fnv"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Model names the embedding scheme. Stored vectors from a different model are
+// recomputed, so change it whenever the embedding functions would produce different vectors.
+const Model = "hashed-ngrams-v1"
+
+// Dimensions is the length of every embedding vector.
+const Dimensions = 512
+
+// Feature weights: whole words carry the meaning, word pairs reward phrases,
+// and trigrams let "branches" match "branch" and "naming" match "name". A
+// document's title counts double, since it summarizes the whole entity.
+const (
+	wordWeight    = 1.0
+	pairWeight    = 0.5
+	trigramWeight = 0.2
+	titleWeight   = 2.0
+)
+
+var stopWords = map[string]bool{
+	"a": true, "about": true, "after": true, "all": true, "also": true, "an": true, "and": true,
+	"any": true, "are": true, "as": true, "at": true, "be": true, "been": true, "but": true,
+	"by": true, "can": true, "could": true, "did": true, "do": true, "does": true, "for": true,
+	"from": true, "had": true, "has": true, "have": true, "how": true, "if": true, "in": true,
+	"into": true, "is": true, "it": true, "its": true, "of": true, "on": true, "or": true,
+	"our": true, "should": true, "so": true, "than": true, "that": true, "the": true,
+	"their": true, "then": true, "there": true, "these": true, "this": true, "to": true,
+	"was": true, "we": true, "were": true, "what": true, "when": true, "where": true,
+	"which": true, "who": true, "why": true, "will": true, "with": true, "would": true,
+	"you": true,
+}
+
+// Tokenize splits text into lowercase, lightly stemmed terms, dropping stop
+// words and single characters.
+func Tokenize(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	terms := make([]string, 0, len(words))
+	for _, w := range words {
+		if len([]rune(w)) < 2 || stopWords[w] {
+			continue
+		}
+		terms = append(terms, stem(w))
+	}
+	return terms
+}
+
+// stem strips common English suffixes so inflections share a term. It is
+// deliberately conservative: stems shorter than three letters are left alone.
+func stem(word string) string {
+	for _, s := range []struct{ suffix, replace string }{
+		{"ies", "y"}, {"ing", ""}, {"ed", ""}, {"es", ""}, {"s", ""},
+	} {
+		if strings.HasSuffix(word, s.suffix) && len(word)-len(s.suffix)+len(s.replace) >= 3 {
+			if s.suffix == "s" && strings.HasSuffix(word, "ss") {
+				return word
+			}
+			return strings.TrimSuffix(word, s.suffix) + s.replace
+		}
+	}
+	return word
+}
+
+// Embed returns the unit-length embedding of text. Text without any terms
+// embeds to the zero vector, which matches nothing.
+func Embed(text string) []float32 {
+	vec := make([]float64, Dimensions)
+	addTerms(vec, Tokenize(text), 1)
+	return normalize(vec)
+}
+
+// EmbedDocument embeds an entity's title and body, weighting the title so
+// that a long body does not drown out what the entity is about.
+func EmbedDocument(title, body string) []float32 {
+	vec := make([]float64, Dimensions)
+	addTerms(vec, Tokenize(title), titleWeight)
+	addTerms(vec, Tokenize(body), 1)
+	return normalize(vec)
+}
+
+// addTerms adds the word, word-pair, and trigram features of terms.
+func addTerms(vec []float64, terms []string, scale float64) {
+	for i, term := range terms {
+		addFeature(vec, "w:"+term, scale*wordWeight)
+		if i > 0 {
+			addFeature(vec, "p:"+terms[i-1]+" "+term, scale*pairWeight)
+		}
+		padded := []rune("^" + term + "$")
+		for j := 0; j+3 <= len(padded); j++ {
+			addFeature(vec, "t:"+string(padded[j:j+3]), scale*trigramWeight)
+		}
+	}
+}
+
+// normalize scales vec to unit length as float32s.
+func normalize(vec []float64) []float32 {
+	var norm float64
+	for _, x := range vec {
+		norm += x * x
+	}
+	out := make([]float32, Dimensions)
+	if norm == 0 {
+		return out
+	}
+	norm = math.Sqrt(norm)
+	for i, x := range vec {
+		out[i] = float32(x / norm)
+	}
+	return out
+}
+
+// addFeature hashes a feature into a bucket, using a second hash bit as its
+// sign so that collisions cancel out on average instead of accumulating.
+func addFeature(vec []float64, feature string, weight float64) {
+	h := fnv.New64a()
+	h.Write([]byte(feature))
+	sum := h.Sum64()
+	if sum>>63 == 1 {
+		weight = -weight
+	}
+	vec[sum%Dimensions] += weight
+}
+
+// Similarity returns the cosine similarity of two embeddings, or 0 when they
+// differ in length or either is the zero vector.
+func Similarity(a, b []float32) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / math.Sqrt(na*nb)
+}
+
+// Candidate is an indexed entity to rank.
+type Candidate struct {
+	ID     string
+	Vector []float32
+}
+
+// Match is a ranked candidate.
+type Match struct {
+	ID    string
+	Score float64
+}
+
+// Rank scores candidates against the query embedding and returns those
+// scoring at least minScore, best first (ties by ID), at most limit of them.
+// A limit of 0 or less returns every match.
+func Rank(query []float32, candidates []Candidate, minScore float64, limit int) []Match {
+	var matches []Match
+	for _, c := range candidates {
+		if score := Similarity(query, c.Vector); score >= minScore && score > 0 {
+			matches = append(matches, Match{ID: c.ID, Score: score})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].ID < matches[j].ID
+	})
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
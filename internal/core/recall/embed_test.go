@@ -0,0 +1,171 @@
+package recall
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{
+			name: "drops stop words and stems",
+			text: "What did we decide about branch naming?",
+			want: []string{"decide", "branch", "nam"},
+		},
+		{
+			name: "splits on punctuation and keeps digits",
+			text: "ml/SHIP-001 uses OAuth2 tokens",
+			want: []string{"ml", "ship", "001", "use", "oauth2", "token"},
+		},
+		{
+			name: "leaves short stems and double s alone",
+			text: "bus class queries",
+			want: []string{"bus", "class", "query"},
+		},
+		{
+			name: "empty",
+			text: "  ...  ",
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tokenize(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmbed(t *testing.T) {
+	v := Embed("Branch naming convention")
+	if len(v) != Dimensions {
+		t.Fatalf("len = %d, want %d", len(v), Dimensions)
+	}
+	var norm float64
+	for _, x := range v {
+		norm += float64(x) * float64(x)
+	}
+	if math.Abs(norm-1) > 1e-5 {
+		t.Errorf("norm = %v, want 1", norm)
+	}
+	if !reflect.DeepEqual(v, Embed("Branch naming convention")) {
+		t.Error("Embed is not deterministic")
+	}
+
+	zero := Embed("the of and")
+	for _, x := range zero {
+		if x != 0 {
+			t.Fatal("stop-word-only text should embed to the zero vector")
+		}
+	}
+}
+
+func TestEmbedDocument(t *testing.T) {
+	query := Embed("branch naming")
+	body := "We compared several options and settled on a convention after a long discussion of shipment slugs."
+
+	titled := EmbedDocument("Branch naming", body)
+	untitled := EmbedDocument("", "Branch naming "+body)
+	if Similarity(query, titled) <= Similarity(query, untitled) {
+		t.Errorf("title should weigh more than body: titled %.3f, untitled %.3f",
+			Similarity(query, titled), Similarity(query, untitled))
+	}
+	if !reflect.DeepEqual(EmbedDocument("", "branch naming"), Embed("branch naming")) {
+		t.Error("EmbedDocument without a title should match Embed of the body")
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	query := Embed("what did we decide about branch naming")
+	related := Embed("Decision: branches are named ml/SHIP-xxx-slug")
+	unrelated := Embed("Keychain APIs store OAuth tokens securely")
+
+	if Similarity(query, related) <= Similarity(query, unrelated) {
+		t.Errorf("related %.3f should outscore unrelated %.3f",
+			Similarity(query, related), Similarity(query, unrelated))
+	}
+	if got := Similarity(query, query); math.Abs(got-1) > 1e-5 {
+		t.Errorf("self similarity = %v, want 1", got)
+	}
+	if got := Similarity(query, Embed("")); got != 0 {
+		t.Errorf("similarity with zero vector = %v, want 0", got)
+	}
+	if got := Similarity(query, query[:10]); got != 0 {
+		t.Errorf("similarity with mismatched length = %v, want 0", got)
+	}
+}
+
+func TestRank(t *testing.T) {
+	query := Embed("branch naming")
+	candidates := []Candidate{
+		{ID: "NOTE-002", Vector: Embed("Keychain APIs")},
+		{ID: "NOTE-001", Vector: Embed("Branch naming convention")},
+		{ID: "PLAN-001", Vector: Embed("Rename branches to the new naming scheme")},
+		{ID: "NOTE-003", Vector: Embed("")},
+	}
+
+	got := Rank(query, candidates, 0.1, 0)
+	if len(got) != 2 || got[0].ID != "NOTE-001" || got[1].ID != "PLAN-001" {
+		t.Fatalf("Rank = %+v, want NOTE-001 then PLAN-001", got)
+	}
+	if got[0].Score < got[1].Score {
+		t.Errorf("scores not descending: %+v", got)
+	}
+
+	if got := Rank(query, candidates, 0.1, 1); len(got) != 1 || got[0].ID != "NOTE-001" {
+		t.Errorf("Rank with limit 1 = %+v, want NOTE-001 only", got)
+	}
+	if got := Rank(query, nil, 0, 5); len(got) != 0 {
+		t.Errorf("Rank with no candidates = %+v, want none", got)
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	text := `Context first.
+We looked at several options. The decision is to name branches ml/SHIP-xxx-slug! Everything else stays.`
+
+	tests := []struct {
+		name  string
+		query string
+		width int
+		want  string
+	}{
+		{
+			name:  "picks the best matching sentence",
+			query: "branch naming",
+			width: 80,
+			want:  "The decision is to name branches ml/SHIP-xxx-slug!",
+		},
+		{
+			name:  "falls back to the first passage",
+			query: "keychain",
+			width: 80,
+			want:  "Context first.",
+		},
+		{
+			name:  "clips to width",
+			query: "branch naming",
+			width: 20,
+			want:  "The decision is t...",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Snippet(text, tt.query, tt.width); got != tt.want {
+				t.Errorf("Snippet = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got := Snippet("", "anything", 40); got != "" {
+		t.Errorf("Snippet of empty text = %q, want empty", got)
+	}
+}
@@ -0,0 +1,66 @@
+package recall
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Snippet picks the passage of text that best matches the query: the line or
+// sentence sharing the most query terms, or the first one when none do.
+// Whitespace is collapsed and the result is cut to width runes.
+func Snippet(text, query string, width int) string {
+	queryTerms := map[string]bool{}
+	for _, t := range Tokenize(query) {
+		queryTerms[t] = true
+	}
+
+	best, bestHits := "", -1
+	for _, passage := range passages(text) {
+		hits := 0
+		for _, t := range Tokenize(passage) {
+			if queryTerms[t] {
+				hits++
+			}
+		}
+		if hits > bestHits {
+			best, bestHits = passage, hits
+		}
+	}
+	return clip(best, width)
+}
+
+// passages splits text into its non-empty lines, and lines into sentences.
+func passages(text string) []string {
+	var out []string
+	for _, line := range strings.Split(text, "\n") {
+		for _, sentence := range splitSentences(line) {
+			if s := strings.Join(strings.Fields(sentence), " "); s != "" {
+				out = append(out, s)
+			}
+		}
+	}
+	return out
+}
+
+// splitSentences splits a line after ". ", "? ", and "! ".
+func splitSentences(line string) []string {
+	var out []string
+	start := 0
+	for i := 0; i+1 < len(line); i++ {
+		if strings.ContainsRune(".?!", rune(line[i])) && line[i+1] == ' ' {
+			out = append(out, line[start:i+1])
+			start = i + 2
+		}
+	}
+	return append(out, line[start:])
+}
+
+func clip(s string, width int) string {
+	if width <= 0 || utf8.RuneCountInString(s) <= width {
+		return s
+	}
+	if width <= 3 {
+		return string([]rune(s)[:width])
+	}
+	return string([]rune(s)[:width-3]) + "..."
+}
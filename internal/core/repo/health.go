@@ -0,0 +1,231 @@
+package repo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Health check statuses, from best to worst.
+const (
+	HealthPass = "pass"
+	HealthWarn = "warn"
+	HealthFail = "fail"
+)
+
+// HealthCheck is the outcome of one repository health check.
+type HealthCheck struct {
+	Name   string
+	Status string // pass, warn, fail
+	Detail string // What was found; empty for passing checks
+	Fix    string // Suggested command; empty for passing checks
+}
+
+// WorktreeFact describes a workbench's worktree of the repository.
+type WorktreeFact struct {
+	WorkbenchID string
+	Path        string
+	Exists      bool // Directory exists on disk
+	Registered  bool // Listed by 'git worktree list' in the repository
+}
+
+// HealthContext gathers what was observed about a registered repository.
+// Facts that depend on an earlier failed check (e.g. remotes of a missing
+// path) are ignored.
+type HealthContext struct {
+	RepoID        string
+	URL           string // Registered URL; empty if none
+	LocalPath     string // Registered local path; empty if none
+	DefaultBranch string
+
+	PathExists          bool
+	IsGitRepo           bool
+	OriginURL           string // URL of the origin remote; empty if none
+	DefaultBranchExists bool
+
+	AuthChecked bool   // Whether the host's CLI auth was checked
+	AuthError   string // Why auth failed; empty if it works
+
+	Worktrees       []WorktreeFact
+	OrphanWorktrees []string // Registered worktrees under the workbench root with no workbench
+}
+
+// EvaluateHealth turns observed facts into health checks.
+// Rules:
+// - local_path must be set, exist, and be a git repository
+// - origin must match the registered URL (SSH and HTTPS forms are equal)
+// - the default branch must exist locally or on origin
+// - gh must be authenticated for GitHub hosts
+// - every workbench worktree must exist and be registered with git;
+// unclaimed worktrees under the workbench root are warnings
+func EvaluateHealth(ctx HealthContext) []HealthCheck {
+	checks := []HealthCheck{checkPath(ctx)}
+	if ctx.LocalPath == "" || !ctx.PathExists || !ctx.IsGitRepo {
+		return checks
+	}
+
+	checks = append(checks, checkRemote(ctx), checkDefaultBranch(ctx))
+	if ctx.AuthChecked {
+		checks = append(checks, checkAuth(ctx))
+	}
+	return append(checks, checkWorktrees(ctx))
+}
+
+// OverallStatus returns the worst status among checks (pass if there are none).
+func OverallStatus(checks []HealthCheck) string {
+	status := HealthPass
+	for _, c := range checks {
+		switch c.Status {
+		case HealthFail:
+			return HealthFail
+		case HealthWarn:
+			status = HealthWarn
+		}
+	}
+	return status
+}
+
+func checkPath(ctx HealthContext) HealthCheck {
+	c := HealthCheck{Name: "local path", Status: HealthPass}
+	clone := fmt.Sprintf("git clone %s %s", orPlaceholder(ctx.URL, "<url>"), ctx.LocalPath)
+	switch {
+	case ctx.LocalPath == "":
+		c.Status = HealthFail
+		c.Detail = "no local path registered"
+		c.Fix = fmt.Sprintf("orc repo update %s --path <path>", ctx.RepoID)
+	case !ctx.PathExists:
+		c.Status = HealthFail
+		c.Detail = fmt.Sprintf("%s does not exist", ctx.LocalPath)
+		c.Fix = clone
+	case !ctx.IsGitRepo:
+		c.Status = HealthFail
+		c.Detail = fmt.Sprintf("%s is not a git repository", ctx.LocalPath)
+		c.Fix = clone
+	}
+	return c
+}
+
+func checkRemote(ctx HealthContext) HealthCheck {
+	c := HealthCheck{Name: "remote", Status: HealthPass}
+	switch {
+	case ctx.URL == "" && ctx.OriginURL == "":
+		c.Status = HealthWarn
+		c.Detail = "no URL registered and no origin remote"
+		c.Fix = fmt.Sprintf("orc repo update %s --url <url>", ctx.RepoID)
+	case ctx.URL == "":
+		c.Status = HealthWarn
+		c.Detail = fmt.Sprintf("no URL registered (origin is %s)", ctx.OriginURL)
+		c.Fix = fmt.Sprintf("orc repo update %s --url %s", ctx.RepoID, ctx.OriginURL)
+	case ctx.OriginURL == "":
+		c.Status = HealthFail
+		c.Detail = "repository has no origin remote"
+		c.Fix = fmt.Sprintf("git -C %s remote add origin %s", ctx.LocalPath, ctx.URL)
+	case !SameRemote(ctx.URL, ctx.OriginURL):
+		c.Status = HealthFail
+		c.Detail = fmt.Sprintf("origin is %s, registered URL is %s", ctx.OriginURL, ctx.URL)
+		c.Fix = fmt.Sprintf("git -C %s remote set-url origin %s  (or: orc repo update %s --url %s)",
+			ctx.LocalPath, ctx.URL, ctx.RepoID, ctx.OriginURL)
+	}
+	return c
+}
+
+func checkDefaultBranch(ctx HealthContext) HealthCheck {
+	c := HealthCheck{Name: "default branch", Status: HealthPass}
+	if !ctx.DefaultBranchExists {
+		c.Status = HealthFail
+		c.Detail = fmt.Sprintf("branch %s not found locally or on origin", ctx.DefaultBranch)
+		c.Fix = fmt.Sprintf("git -C %s fetch origin  (or: orc repo update %s --default-branch <branch>)", ctx.LocalPath, ctx.RepoID)
+	}
+	return c
+}
+
+func checkAuth(ctx HealthContext) HealthCheck {
+	c := HealthCheck{Name: "gh auth", Status: HealthPass}
+	if ctx.AuthError != "" {
+		c.Status = HealthFail
+		c.Detail = ctx.AuthError
+		c.Fix = "gh auth login --hostname " + RemoteHost(ctx.URL)
+	}
+	return c
+}
+
+func checkWorktrees(ctx HealthContext) HealthCheck {
+	c := HealthCheck{Name: "worktrees", Status: HealthPass}
+	var problems, fixes []string
+	for _, wt := range ctx.Worktrees {
+		switch {
+		case !wt.Exists:
+			problems = append(problems, fmt.Sprintf("%s: %s is missing", wt.WorkbenchID, wt.Path))
+			fixes = append(fixes, fmt.Sprintf("git -C %s worktree prune", ctx.LocalPath))
+		case !wt.Registered:
+			problems = append(problems, fmt.Sprintf("%s: %s is not a worktree of this repository", wt.WorkbenchID, wt.Path))
+			fixes = append(fixes, fmt.Sprintf("git -C %s worktree repair %s", ctx.LocalPath, wt.Path))
+		}
+	}
+	if len(problems) > 0 {
+		c.Status = HealthFail
+	}
+	for _, path := range ctx.OrphanWorktrees {
+		if c.Status == HealthPass {
+			c.Status = HealthWarn
+		}
+		problems = append(problems, fmt.Sprintf("%s has no workbench", path))
+		fixes = append(fixes, fmt.Sprintf("git -C %s worktree remove %s", ctx.LocalPath, path))
+	}
+	c.Detail = strings.Join(problems, "\n")
+	c.Fix = strings.Join(dedupe(fixes), "\n")
+	return c
+}
+
+// SameRemote reports whether two git remote URLs name the same repository,
+// treating SSH, HTTPS, and .git-suffixed forms as equal.
+func SameRemote(a, b string) bool {
+	return NormalizeRemoteURL(a) == NormalizeRemoteURL(b)
+}
+
+// NormalizeRemoteURL reduces a git remote URL to lower-case host/path,
+// e.g. git@github.com:org/repo.git and https://github.com/org/repo both
+// become github.com/org/repo.
+func NormalizeRemoteURL(url string) string {
+	u := strings.TrimSpace(url)
+	if i := strings.Index(u, "://"); i >= 0 {
+		u = u[i+3:]
+		if at := strings.Index(u, "@"); at >= 0 && at < strings.Index(u+"/", "/") {
+			u = u[at+1:]
+		}
+	} else if at := strings.Index(u, "@"); at >= 0 {
+		// scp-like syntax: user@host:path
+		u = strings.Replace(u[at+1:], ":", "/", 1)
+	}
+	u = strings.TrimSuffix(strings.TrimSuffix(u, "/"), ".git")
+	return strings.ToLower(u)
+}
+
+// RemoteHost returns the host of a git remote URL, or "" if it has none.
+func RemoteHost(url string) string {
+	n := NormalizeRemoteURL(url)
+	host, _, found := strings.Cut(n, "/")
+	if !found {
+		return ""
+	}
+	host, _, _ = strings.Cut(host, ":") // drop a port
+	return host
+}
+
+func orPlaceholder(s, placeholder string) string {
+	if s == "" {
+		return placeholder
+	}
+	return s
+}
+
+func dedupe(items []string) []string {
+	seen := make(map[string]bool, len(items))
+	var out []string
+	for _, it := range items {
+		if !seen[it] {
+			seen[it] = true
+			out = append(out, it)
+		}
+	}
+	return out
+}
@@ -0,0 +1,166 @@
+package repo
+
+import "testing"
+
+func TestNormalizeRemoteURL(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"git@github.com:org/repo.git", "github.com/org/repo"},
+		{"https://github.com/org/repo", "github.com/org/repo"},
+		{"https://github.com/Org/Repo.git/", "github.com/org/repo"},
+		{"ssh://git@github.example.com:2222/org/repo.git", "github.example.com:2222/org/repo"},
+		{"https://token@github.com/org/repo.git", "github.com/org/repo"},
+		{"/srv/git/repo.git", "/srv/git/repo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			if got := NormalizeRemoteURL(tt.url); got != tt.want {
+				t.Errorf("NormalizeRemoteURL(%q) = %q, want %q", tt.url, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoteHost(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"git@github.com:org/repo.git", "github.com"},
+		{"ssh://git@github.example.com:2222/org/repo.git", "github.example.com"},
+		{"/srv/git/repo.git", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			if got := RemoteHost(tt.url); got != tt.want {
+				t.Errorf("RemoteHost(%q) = %q, want %q", tt.url, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateHealth(t *testing.T) {
+	healthy := HealthContext{
+		RepoID:              "REPO-002",
+		URL:                 "git@github.com:org/repo.git",
+		LocalPath:           "/src/repo",
+		DefaultBranch:       "main",
+		PathExists:          true,
+		IsGitRepo:           true,
+		OriginURL:           "https://github.com/org/repo",
+		DefaultBranchExists: true,
+		AuthChecked:         true,
+		Worktrees: []WorktreeFact{
+			{WorkbenchID: "BENCH-001", Path: "/wb/one", Exists: true, Registered: true},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		modify     func(*HealthContext)
+		wantStatus map[string]string // check name -> status; unlisted checks pass
+		wantNames  int
+	}{
+		{
+			name:      "healthy repo passes every check",
+			modify:    func(*HealthContext) {},
+			wantNames: 5,
+		},
+		{
+			name:       "missing path stops further checks",
+			modify:     func(c *HealthContext) { c.PathExists = false },
+			wantStatus: map[string]string{"local path": HealthFail},
+			wantNames:  1,
+		},
+		{
+			name:       "not a git repo stops further checks",
+			modify:     func(c *HealthContext) { c.IsGitRepo = false },
+			wantStatus: map[string]string{"local path": HealthFail},
+			wantNames:  1,
+		},
+		{
+			name:       "mismatched origin fails",
+			modify:     func(c *HealthContext) { c.OriginURL = "git@github.com:fork/repo.git" },
+			wantStatus: map[string]string{"remote": HealthFail},
+			wantNames:  5,
+		},
+		{
+			name:       "unregistered URL warns",
+			modify:     func(c *HealthContext) { c.URL = ""; c.AuthChecked = false },
+			wantStatus: map[string]string{"remote": HealthWarn},
+			wantNames:  4,
+		},
+		{
+			name:       "missing default branch fails",
+			modify:     func(c *HealthContext) { c.DefaultBranchExists = false },
+			wantStatus: map[string]string{"default branch": HealthFail},
+			wantNames:  5,
+		},
+		{
+			name:       "gh auth failure fails",
+			modify:     func(c *HealthContext) { c.AuthError = "not logged in" },
+			wantStatus: map[string]string{"gh auth": HealthFail},
+			wantNames:  5,
+		},
+		{
+			name:       "orphan worktree warns",
+			modify:     func(c *HealthContext) { c.OrphanWorktrees = []string{"/wb/old"} },
+			wantStatus: map[string]string{"worktrees": HealthWarn},
+			wantNames:  5,
+		},
+		{
+			name: "missing worktree fails",
+			modify: func(c *HealthContext) {
+				c.Worktrees = []WorktreeFact{{WorkbenchID: "BENCH-001", Path: "/wb/one", Registered: true}}
+				c.OrphanWorktrees = []string{"/wb/old"}
+			},
+			wantStatus: map[string]string{"worktrees": HealthFail},
+			wantNames:  5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := healthy
+			tt.modify(&ctx)
+
+			checks := EvaluateHealth(ctx)
+			if len(checks) != tt.wantNames {
+				t.Fatalf("got %d checks, want %d: %+v", len(checks), tt.wantNames, checks)
+			}
+			for _, c := range checks {
+				want := tt.wantStatus[c.Name]
+				if want == "" {
+					want = HealthPass
+				}
+				if c.Status != want {
+					t.Errorf("%s: Status = %q, want %q (%s)", c.Name, c.Status, want, c.Detail)
+				}
+				if c.Status != HealthPass && c.Fix == "" {
+					t.Errorf("%s: expected a suggested fix", c.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestOverallStatus(t *testing.T) {
+	pass := HealthCheck{Status: HealthPass}
+	warn := HealthCheck{Status: HealthWarn}
+	fail := HealthCheck{Status: HealthFail}
+
+	if got := OverallStatus(nil); got != HealthPass {
+		t.Errorf("OverallStatus(nil) = %q, want pass", got)
+	}
+	if got := OverallStatus([]HealthCheck{pass, warn}); got != HealthWarn {
+		t.Errorf("OverallStatus(pass, warn) = %q, want warn", got)
+	}
+	if got := OverallStatus([]HealthCheck{warn, fail, pass}); got != HealthFail {
+		t.Errorf("OverallStatus(warn, fail, pass) = %q, want fail", got)
+	}
+}
@@ -0,0 +1,102 @@
+// Package secret contains the pure business logic for integration secrets.
+// Secrets are named credentials scoped globally, to a factory, or to a repo;
+// the most specific scope wins when a secret is resolved.
+package secret
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Scope types, from least to most specific.
+const (
+	ScopeGlobal  = "global"
+	ScopeFactory = "factory"
+	ScopeRepo    = "repo"
+)
+
+// GuardResult represents the outcome of a guard evaluation.
+type GuardResult struct {
+	Allowed bool
+	Reason  string
+}
+
+// Error converts the guard result to an error if not allowed.
+func (r GuardResult) Error() error {
+	if r.Allowed {
+		return nil
+	}
+	return fmt.Errorf("%s", r.Reason)
+}
+
+// Scope identifies where a secret applies. ID is empty for the global scope.
+type Scope struct {
+	Type string
+	ID   string
+}
+
+// String renders a scope for display ("global", "repo REPO-001").
+func (s Scope) String() string {
+	if s.Type == ScopeGlobal {
+		return ScopeGlobal
+	}
+	return s.Type + " " + s.ID
+}
+
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9]*([-_][a-z0-9]+)*$`)
+
+// IsValidName reports whether name is a valid secret name, e.g. "github-token".
+func IsValidName(name string) bool {
+	return namePattern.MatchString(name)
+}
+
+// ResolutionOrder returns the scopes to search for a secret, most specific
+// first. Empty IDs are skipped; the global scope is always last.
+func ResolutionOrder(repoID, factoryID string) []Scope {
+	var scopes []Scope
+	if repoID != "" {
+		scopes = append(scopes, Scope{Type: ScopeRepo, ID: repoID})
+	}
+	if factoryID != "" {
+		scopes = append(scopes, Scope{Type: ScopeFactory, ID: factoryID})
+	}
+	return append(scopes, Scope{Type: ScopeGlobal})
+}
+
+// SetSecretContext provides context for storing a secret.
+type SetSecretContext struct {
+	Name        string
+	Scope       Scope
+	ScopeExists bool // Whether the factory or repo exists (ignored for global)
+	ValueLength int
+}
+
+// CanSetSecret evaluates whether a secret can be stored.
+// Rules:
+// - Name must be lowercase words separated by hyphens or underscores
+// - Factory or repo scope must exist
+// - Value must not be empty
+func CanSetSecret(ctx SetSecretContext) GuardResult {
+	if !IsValidName(ctx.Name) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("invalid secret name '%s': use lowercase letters, digits, hyphens, and underscores, starting with a letter", ctx.Name),
+		}
+	}
+
+	if ctx.Scope.Type != ScopeGlobal && !ctx.ScopeExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("%s %s not found", ctx.Scope.Type, ctx.Scope.ID),
+		}
+	}
+
+	if ctx.ValueLength == 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("secret '%s' has an empty value", ctx.Name),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
@@ -0,0 +1,121 @@
+package secret
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIsValidName(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"github-token", true},
+		{"slack_webhook", true},
+		{"token2", true},
+		{"GitHub-Token", false},
+		{"2token", false},
+		{"token-", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsValidName(tt.name); got != tt.want {
+				t.Errorf("IsValidName(%q) = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolutionOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		repoID    string
+		factoryID string
+		want      []Scope
+	}{
+		{
+			name:      "repo and factory",
+			repoID:    "REPO-001",
+			factoryID: "FACT-001",
+			want:      []Scope{{ScopeRepo, "REPO-001"}, {ScopeFactory, "FACT-001"}, {ScopeGlobal, ""}},
+		},
+		{
+			name:      "factory only",
+			factoryID: "FACT-001",
+			want:      []Scope{{ScopeFactory, "FACT-001"}, {ScopeGlobal, ""}},
+		},
+		{
+			name: "global only",
+			want: []Scope{{ScopeGlobal, ""}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolutionOrder(tt.repoID, tt.factoryID); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResolutionOrder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanSetSecret(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         SetSecretContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can set global secret",
+			ctx:         SetSecretContext{Name: "github-token", Scope: Scope{Type: ScopeGlobal}, ValueLength: 40},
+			wantAllowed: true,
+		},
+		{
+			name:        "can set secret on existing repo",
+			ctx:         SetSecretContext{Name: "github-token", Scope: Scope{ScopeRepo, "REPO-001"}, ScopeExists: true, ValueLength: 40},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot set invalid name",
+			ctx:         SetSecretContext{Name: "GitHub Token", Scope: Scope{Type: ScopeGlobal}, ValueLength: 40},
+			wantAllowed: false,
+			wantReason:  "invalid secret name 'GitHub Token': use lowercase letters, digits, hyphens, and underscores, starting with a letter",
+		},
+		{
+			name:        "cannot set secret on missing factory",
+			ctx:         SetSecretContext{Name: "github-token", Scope: Scope{ScopeFactory, "FACT-999"}, ScopeExists: false, ValueLength: 40},
+			wantAllowed: false,
+			wantReason:  "factory FACT-999 not found",
+		},
+		{
+			name:        "cannot set empty value",
+			ctx:         SetSecretContext{Name: "github-token", Scope: Scope{Type: ScopeGlobal}},
+			wantAllowed: false,
+			wantReason:  "secret 'github-token' has an empty value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanSetSecret(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestScope_String(t *testing.T) {
+	if got := (Scope{Type: ScopeGlobal}).String(); got != "global" {
+		t.Errorf("global scope = %q", got)
+	}
+	if got := (Scope{Type: ScopeRepo, ID: "REPO-001"}).String(); got != "repo REPO-001" {
+		t.Errorf("repo scope = %q", got)
+	}
+}
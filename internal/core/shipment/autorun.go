@@ -0,0 +1,122 @@
+package shipment
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Autorun states. A shipment with autorun off has the empty state.
+const (
+	AutorunOn     = "on"
+	AutorunPaused = "paused"
+)
+
+// Autorun actions: what the executor asks of the shipment's IMP next.
+const (
+	AutorunRun     = "run"     // claim and start TaskID
+	AutorunWorking = "working" // TaskID is in progress; let the IMP finish it
+	AutorunWait    = "wait"    // a gate holds the run; Reason says which
+	AutorunDone    = "done"    // every task is closed
+	AutorunIdle    = "idle"    // autorun is off or paused, or the shipment isn't launched
+)
+
+// AutorunTask is a shipment task as seen by the autorun executor.
+type AutorunTask struct {
+	ID          string
+	Status      string
+	DependsOn   []string
+	DraftPlanID string // a plan for the task still awaiting approval
+}
+
+// AutorunContext is what the executor needs to pick the next step.
+type AutorunContext struct {
+	State          string // "", on, or paused
+	ShipmentStatus string
+	Tasks          []AutorunTask
+	DepStatus      map[string]string // status of dependencies outside the shipment
+}
+
+// AutorunStep is the executor's decision.
+type AutorunStep struct {
+	Action string
+	TaskID string
+	Reason string
+}
+
+// NextAutorunStep decides what a shipment's IMP should do next. Tasks run one
+// at a time in ID order. A task may start once every task it depends on is
+// closed; a draft plan for it, or a blocked task anywhere in the shipment,
+// holds the run until a human clears the gate.
+func NextAutorunStep(ctx AutorunContext) AutorunStep {
+	switch {
+	case ctx.State == "":
+		return AutorunStep{Action: AutorunIdle, Reason: "autorun is off"}
+	case ctx.State == AutorunPaused:
+		return AutorunStep{Action: AutorunIdle, Reason: "autorun is paused"}
+	case ctx.ShipmentStatus == "draft":
+		return AutorunStep{Action: AutorunIdle, Reason: "shipment is still draft; mark it ready to launch"}
+	case ctx.ShipmentStatus == "closed":
+		return AutorunStep{Action: AutorunDone, Reason: "shipment is closed"}
+	}
+
+	tasks := append([]AutorunTask(nil), ctx.Tasks...)
+	sort.Slice(tasks, func(i, j int) bool { return lessID(tasks[i].ID, tasks[j].ID) })
+
+	status := make(map[string]string, len(tasks)+len(ctx.DepStatus))
+	for id, s := range ctx.DepStatus {
+		status[id] = s
+	}
+	for _, t := range tasks {
+		status[t.ID] = t.Status
+	}
+
+	for _, t := range tasks {
+		switch t.Status {
+		case "in-progress":
+			return AutorunStep{Action: AutorunWorking, TaskID: t.ID, Reason: t.ID + " is in progress"}
+		case "blocked":
+			return AutorunStep{Action: AutorunWait, TaskID: t.ID, Reason: t.ID + " is blocked"}
+		}
+	}
+
+	var waiting []string
+	for _, t := range tasks {
+		if t.Status == "closed" {
+			continue
+		}
+		if open := openDeps(t, status); len(open) > 0 {
+			waiting = append(waiting, fmt.Sprintf("%s waits on %s", t.ID, strings.Join(open, ", ")))
+			continue
+		}
+		if t.DraftPlanID != "" {
+			return AutorunStep{Action: AutorunWait, TaskID: t.ID, Reason: fmt.Sprintf("%s for %s awaits approval", t.DraftPlanID, t.ID)}
+		}
+		return AutorunStep{Action: AutorunRun, TaskID: t.ID, Reason: "next task in order"}
+	}
+
+	if len(waiting) > 0 {
+		return AutorunStep{Action: AutorunWait, Reason: strings.Join(waiting, "; ")}
+	}
+	return AutorunStep{Action: AutorunDone, Reason: "every task is closed"}
+}
+
+// openDeps lists the task's dependencies that aren't closed. Dependencies
+// the ledger no longer knows about don't hold the task back.
+func openDeps(t AutorunTask, status map[string]string) []string {
+	var open []string
+	for _, dep := range t.DependsOn {
+		if s, ok := status[dep]; ok && s != "closed" {
+			open = append(open, dep)
+		}
+	}
+	return open
+}
+
+// lessID orders IDs like TASK-999 before TASK-1000.
+func lessID(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
@@ -0,0 +1,108 @@
+package shipment
+
+import "testing"
+
+func TestNextAutorunStep(t *testing.T) {
+	tests := []struct {
+		name       string
+		ctx        AutorunContext
+		wantAction string
+		wantTask   string
+	}{
+		{
+			name:       "off",
+			ctx:        AutorunContext{ShipmentStatus: "ready", Tasks: []AutorunTask{{ID: "TASK-001", Status: "open"}}},
+			wantAction: AutorunIdle,
+		},
+		{
+			name:       "paused",
+			ctx:        AutorunContext{State: AutorunPaused, ShipmentStatus: "ready", Tasks: []AutorunTask{{ID: "TASK-001", Status: "open"}}},
+			wantAction: AutorunIdle,
+		},
+		{
+			name:       "draft shipment has not launched",
+			ctx:        AutorunContext{State: AutorunOn, ShipmentStatus: "draft", Tasks: []AutorunTask{{ID: "TASK-001", Status: "open"}}},
+			wantAction: AutorunIdle,
+		},
+		{
+			name: "first open task in ID order",
+			ctx: AutorunContext{State: AutorunOn, ShipmentStatus: "ready", Tasks: []AutorunTask{
+				{ID: "TASK-1000", Status: "open"},
+				{ID: "TASK-999", Status: "open"},
+				{ID: "TASK-998", Status: "closed"},
+			}},
+			wantAction: AutorunRun,
+			wantTask:   "TASK-999",
+		},
+		{
+			name: "in-progress task is left to finish",
+			ctx: AutorunContext{State: AutorunOn, ShipmentStatus: "in-progress", Tasks: []AutorunTask{
+				{ID: "TASK-001", Status: "open"},
+				{ID: "TASK-002", Status: "in-progress"},
+			}},
+			wantAction: AutorunWorking,
+			wantTask:   "TASK-002",
+		},
+		{
+			name: "blocked task holds the run",
+			ctx: AutorunContext{State: AutorunOn, ShipmentStatus: "in-progress", Tasks: []AutorunTask{
+				{ID: "TASK-001", Status: "blocked"},
+				{ID: "TASK-002", Status: "open"},
+			}},
+			wantAction: AutorunWait,
+			wantTask:   "TASK-001",
+		},
+		{
+			name: "dependencies are respected",
+			ctx: AutorunContext{State: AutorunOn, ShipmentStatus: "in-progress", Tasks: []AutorunTask{
+				{ID: "TASK-001", Status: "open", DependsOn: []string{"TASK-002"}},
+				{ID: "TASK-002", Status: "open"},
+			}},
+			wantAction: AutorunRun,
+			wantTask:   "TASK-002",
+		},
+		{
+			name: "open dependency outside the shipment waits",
+			ctx: AutorunContext{State: AutorunOn, ShipmentStatus: "in-progress",
+				Tasks:     []AutorunTask{{ID: "TASK-002", Status: "open", DependsOn: []string{"TASK-050"}}},
+				DepStatus: map[string]string{"TASK-050": "open"},
+			},
+			wantAction: AutorunWait,
+		},
+		{
+			name: "unknown dependency does not hold the task",
+			ctx: AutorunContext{State: AutorunOn, ShipmentStatus: "in-progress",
+				Tasks: []AutorunTask{{ID: "TASK-002", Status: "open", DependsOn: []string{"TASK-404"}}},
+			},
+			wantAction: AutorunRun,
+			wantTask:   "TASK-002",
+		},
+		{
+			name: "draft plan is an approval gate",
+			ctx: AutorunContext{State: AutorunOn, ShipmentStatus: "in-progress", Tasks: []AutorunTask{
+				{ID: "TASK-001", Status: "open", DraftPlanID: "PLAN-003"},
+				{ID: "TASK-002", Status: "open"},
+			}},
+			wantAction: AutorunWait,
+			wantTask:   "TASK-001",
+		},
+		{
+			name: "all closed",
+			ctx: AutorunContext{State: AutorunOn, ShipmentStatus: "in-progress", Tasks: []AutorunTask{
+				{ID: "TASK-001", Status: "closed"},
+			}},
+			wantAction: AutorunDone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NextAutorunStep(tt.ctx)
+			if got.Action != tt.wantAction || got.TaskID != tt.wantTask {
+				t.Errorf("NextAutorunStep() = %+v, want action %q task %q", got, tt.wantAction, tt.wantTask)
+			}
+			if got.Reason == "" {
+				t.Error("expected a reason")
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package shipment
+
+import "sort"
+
+// DAGShipment is a shipment as seen by the dependency graph.
+type DAGShipment struct {
+	ID        string
+	Status    string
+	OpenTasks int // tasks not yet closed
+}
+
+// DAGTask is a task with the tasks it depends on.
+type DAGTask struct {
+	ID         string
+	ShipmentID string
+	DependsOn  []string
+}
+
+// Edge says shipment From must land before shipment To.
+type Edge struct {
+	From string
+	To   string
+}
+
+// DAG is the shipment dependency graph of a commission.
+type DAG struct {
+	Layer        map[string]int // topological depth; shipments in a cycle sit below all others
+	Edges        []Edge         // sorted by From, then To
+	CriticalPath []string       // longest chain of remaining work, first blocker first
+	Cyclic       []string       // shipments whose dependencies form a cycle
+}
+
+// BuildDAG derives shipment dependencies from task dependencies: a shipment
+// depends on another when one of its tasks depends on a task in the other.
+// The critical path weighs each unfinished shipment by its open tasks
+// (at least one); closed shipments weigh nothing and never start or end it.
+func BuildDAG(shipments []DAGShipment, tasks []DAGTask) DAG {
+	known := make(map[string]DAGShipment, len(shipments))
+	ids := make([]string, 0, len(shipments))
+	for _, s := range shipments {
+		known[s.ID] = s
+		ids = append(ids, s.ID)
+	}
+	sort.Strings(ids)
+
+	taskShipment := make(map[string]string, len(tasks))
+	for _, t := range tasks {
+		taskShipment[t.ID] = t.ShipmentID
+	}
+
+	edgeSet := make(map[Edge]bool)
+	for _, t := range tasks {
+		if _, ok := known[t.ShipmentID]; !ok {
+			continue
+		}
+		for _, dep := range t.DependsOn {
+			from := taskShipment[dep]
+			if _, ok := known[from]; !ok || from == t.ShipmentID {
+				continue
+			}
+			edgeSet[Edge{From: from, To: t.ShipmentID}] = true
+		}
+	}
+
+	dag := DAG{Layer: make(map[string]int, len(ids))}
+	preds := make(map[string][]string)
+	succs := make(map[string][]string)
+	for e := range edgeSet {
+		dag.Edges = append(dag.Edges, e)
+	}
+	sort.Slice(dag.Edges, func(i, j int) bool {
+		if dag.Edges[i].From != dag.Edges[j].From {
+			return dag.Edges[i].From < dag.Edges[j].From
+		}
+		return dag.Edges[i].To < dag.Edges[j].To
+	})
+	for _, e := range dag.Edges {
+		preds[e.To] = append(preds[e.To], e.From)
+		succs[e.From] = append(succs[e.From], e.To)
+	}
+
+	// Kahn's algorithm, visiting ready shipments in ID order
+	inDegree := make(map[string]int, len(ids))
+	var ready []string
+	for _, id := range ids {
+		dag.Layer[id] = 0
+		inDegree[id] = len(preds[id])
+		if inDegree[id] == 0 {
+			ready = append(ready, id)
+		}
+	}
+	var order []string
+	maxLayer := 0
+	for len(ready) > 0 {
+		id := ready[0]
+		ready = ready[1:]
+		order = append(order, id)
+		for _, p := range preds[id] {
+			if dag.Layer[p]+1 > dag.Layer[id] {
+				dag.Layer[id] = dag.Layer[p] + 1
+			}
+		}
+		if dag.Layer[id] > maxLayer {
+			maxLayer = dag.Layer[id]
+		}
+		for _, s := range succs[id] {
+			inDegree[s]--
+			if inDegree[s] == 0 {
+				ready = append(ready, s)
+				sort.Strings(ready)
+			}
+		}
+	}
+	for _, id := range ids {
+		if inDegree[id] > 0 {
+			dag.Cyclic = append(dag.Cyclic, id)
+			dag.Layer[id] = maxLayer + 1
+		}
+	}
+
+	dag.CriticalPath = criticalPath(order, preds, known)
+	return dag
+}
+
+// criticalPath finds the heaviest chain through shipments in topological order.
+func criticalPath(order []string, preds map[string][]string, known map[string]DAGShipment) []string {
+	dist := make(map[string]int, len(order))
+	via := make(map[string]string, len(order))
+	best, bestDist := "", 0
+	for _, id := range order {
+		weight := 0
+		if s := known[id]; s.Status != "closed" {
+			weight = max(s.OpenTasks, 1)
+		}
+		longest := 0
+		for _, p := range preds[id] {
+			if d, ok := dist[p]; ok && d > longest {
+				longest, via[id] = d, p
+			}
+		}
+		dist[id] = weight + longest
+		if weight > 0 && dist[id] > bestDist {
+			best, bestDist = id, dist[id]
+		}
+	}
+
+	var path []string
+	for id := best; id != ""; id = via[id] {
+		path = append([]string{id}, path...)
+	}
+	return path
+}
@@ -0,0 +1,81 @@
+package shipment
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildDAG(t *testing.T) {
+	shipments := []DAGShipment{
+		{ID: "SHIP-001", Status: "closed"},
+		{ID: "SHIP-002", Status: "in-progress", OpenTasks: 2},
+		{ID: "SHIP-003", Status: "ready", OpenTasks: 5},
+		{ID: "SHIP-004", Status: "draft", OpenTasks: 1},
+		{ID: "SHIP-005", Status: "draft", OpenTasks: 1},
+	}
+	tasks := []DAGTask{
+		{ID: "TASK-001", ShipmentID: "SHIP-001"},
+		{ID: "TASK-002", ShipmentID: "SHIP-002", DependsOn: []string{"TASK-001"}},
+		{ID: "TASK-003", ShipmentID: "SHIP-003"},
+		{ID: "TASK-004", ShipmentID: "SHIP-004", DependsOn: []string{"TASK-002", "TASK-003", "TASK-005"}},
+		{ID: "TASK-005", ShipmentID: "SHIP-004"}, // same-shipment dependency is not an edge
+		{ID: "TASK-006", ShipmentID: "SHIP-005", DependsOn: []string{"TASK-099"}},
+	}
+
+	dag := BuildDAG(shipments, tasks)
+
+	wantEdges := []Edge{
+		{From: "SHIP-001", To: "SHIP-002"},
+		{From: "SHIP-002", To: "SHIP-004"},
+		{From: "SHIP-003", To: "SHIP-004"},
+	}
+	if !reflect.DeepEqual(dag.Edges, wantEdges) {
+		t.Errorf("Edges = %v, want %v", dag.Edges, wantEdges)
+	}
+
+	wantLayers := map[string]int{"SHIP-001": 0, "SHIP-002": 1, "SHIP-003": 0, "SHIP-004": 2, "SHIP-005": 0}
+	if !reflect.DeepEqual(dag.Layer, wantLayers) {
+		t.Errorf("Layer = %v, want %v", dag.Layer, wantLayers)
+	}
+
+	// SHIP-003 (5 open) outweighs SHIP-001 (closed) -> SHIP-002 (2 open)
+	if want := []string{"SHIP-003", "SHIP-004"}; !reflect.DeepEqual(dag.CriticalPath, want) {
+		t.Errorf("CriticalPath = %v, want %v", dag.CriticalPath, want)
+	}
+	if len(dag.Cyclic) != 0 {
+		t.Errorf("Cyclic = %v, want none", dag.Cyclic)
+	}
+}
+
+func TestBuildDAG_Cycle(t *testing.T) {
+	shipments := []DAGShipment{
+		{ID: "SHIP-001", Status: "ready", OpenTasks: 1},
+		{ID: "SHIP-002", Status: "ready", OpenTasks: 1},
+		{ID: "SHIP-003", Status: "ready", OpenTasks: 1},
+	}
+	tasks := []DAGTask{
+		{ID: "TASK-001", ShipmentID: "SHIP-001", DependsOn: []string{"TASK-002"}},
+		{ID: "TASK-002", ShipmentID: "SHIP-002"},
+		{ID: "TASK-003", ShipmentID: "SHIP-002", DependsOn: []string{"TASK-004"}},
+		{ID: "TASK-004", ShipmentID: "SHIP-001"},
+	}
+
+	dag := BuildDAG(shipments, tasks)
+
+	if want := []string{"SHIP-001", "SHIP-002"}; !reflect.DeepEqual(dag.Cyclic, want) {
+		t.Errorf("Cyclic = %v, want %v", dag.Cyclic, want)
+	}
+	if dag.Layer["SHIP-001"] != 1 || dag.Layer["SHIP-003"] != 0 {
+		t.Errorf("expected cyclic shipments below the rest, got %v", dag.Layer)
+	}
+	if want := []string{"SHIP-003"}; !reflect.DeepEqual(dag.CriticalPath, want) {
+		t.Errorf("CriticalPath = %v, want %v", dag.CriticalPath, want)
+	}
+}
+
+func TestBuildDAG_AllClosed(t *testing.T) {
+	dag := BuildDAG([]DAGShipment{{ID: "SHIP-001", Status: "closed"}}, nil)
+	if len(dag.CriticalPath) != 0 {
+		t.Errorf("CriticalPath = %v, want none", dag.CriticalPath)
+	}
+}
@@ -41,6 +41,14 @@ type CloseShipmentContext struct {
 	ForceCompletion bool // Skip task check if explicitly forced
 }
 
+// LandShipmentContext provides context for shipment land guards.
+type LandShipmentContext struct {
+	ShipmentID   string
+	Tasks        []TaskSummary
+	CommitCounts map[string]int // linked commit count per task ID
+	Force        bool           // Skip commit check if explicitly forced
+}
+
 // StatusTransitionContext provides context for status transition guards.
 type StatusTransitionContext struct {
 	ShipmentID    string
@@ -127,7 +135,7 @@ func ValidStatuses() []string {
 // CanOverrideStatus evaluates whether a shipment status can be overridden.
 // Rules:
 // - New status must be valid
-// - Backwards transitions require --force flag
+// - Transitions outside the state machine (see Transitions) require --force flag
 func CanOverrideStatus(ctx OverrideStatusContext) GuardResult {
 	// Rule 1: New status must be valid
 	if _, ok := statusOrder[ctx.NewStatus]; !ok {
@@ -137,20 +145,11 @@ func CanOverrideStatus(ctx OverrideStatusContext) GuardResult {
 		}
 	}
 
-	// Rule 2: Check for backwards transition
-	currentIdx, currentOk := statusOrder[ctx.CurrentStatus]
-	newIdx := statusOrder[ctx.NewStatus]
-
-	// If current status is unknown, allow transition
-	if !currentOk {
-		return GuardResult{Allowed: true}
-	}
-
-	// Backwards transition requires force
-	if newIdx < currentIdx && !ctx.Force {
+	// Rule 2: Illegal transitions require force
+	if !ctx.Force && !IsLegalTransition(ctx.CurrentStatus, ctx.NewStatus) {
 		return GuardResult{
 			Allowed: false,
-			Reason:  fmt.Sprintf("backwards transition from '%s' to '%s' requires --force flag", ctx.CurrentStatus, ctx.NewStatus),
+			Reason:  illegalTransitionReason(ctx.CurrentStatus, ctx.NewStatus),
 		}
 	}
 
@@ -181,3 +180,28 @@ func CanAssignWorkbench(ctx AssignWorkbenchContext) GuardResult {
 
 	return GuardResult{Allowed: true}
 }
+
+// CanLandShipment evaluates whether a shipment can be landed.
+// Rules:
+// - Every task must have at least one linked commit (unless forced)
+func CanLandShipment(ctx LandShipmentContext) GuardResult {
+	if ctx.Force {
+		return GuardResult{Allowed: true}
+	}
+
+	var unlinked []string
+	for _, t := range ctx.Tasks {
+		if ctx.CommitCounts[t.ID] == 0 {
+			unlinked = append(unlinked, t.ID)
+		}
+	}
+	if len(unlinked) > 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason: fmt.Sprintf("cannot land shipment %s: %d task(s) have no linked commits (%s). Reference task IDs in commit messages or use --force to land anyway",
+				ctx.ShipmentID, len(unlinked), strings.Join(unlinked, ", ")),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
@@ -123,6 +123,72 @@ func TestCanCloseShipment(t *testing.T) {
 	}
 }
 
+func TestCanLandShipment(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         LandShipmentContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name: "can land when every task has a linked commit",
+			ctx: LandShipmentContext{
+				ShipmentID: "SHIP-001",
+				Tasks: []TaskSummary{
+					{ID: "TASK-001", Status: "closed"},
+					{ID: "TASK-002", Status: "closed"},
+				},
+				CommitCounts: map[string]int{"TASK-001": 1, "TASK-002": 3},
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "can land shipment with no tasks",
+			ctx: LandShipmentContext{
+				ShipmentID: "SHIP-001",
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "cannot land with unlinked tasks",
+			ctx: LandShipmentContext{
+				ShipmentID: "SHIP-001",
+				Tasks: []TaskSummary{
+					{ID: "TASK-001", Status: "closed"},
+					{ID: "TASK-002", Status: "closed"},
+					{ID: "TASK-003", Status: "closed"},
+				},
+				CommitCounts: map[string]int{"TASK-002": 1},
+			},
+			wantAllowed: false,
+			wantReason:  "cannot land shipment SHIP-001: 2 task(s) have no linked commits (TASK-001, TASK-003). Reference task IDs in commit messages or use --force to land anyway",
+		},
+		{
+			name: "can force land with unlinked tasks",
+			ctx: LandShipmentContext{
+				ShipmentID: "SHIP-001",
+				Tasks: []TaskSummary{
+					{ID: "TASK-001", Status: "closed"},
+				},
+				Force: true,
+			},
+			wantAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanLandShipment(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
 func TestCanOverrideStatus(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -157,7 +223,45 @@ func TestCanOverrideStatus(t *testing.T) {
 				NewStatus:     "draft",
 			},
 			wantAllowed: false,
-			wantReason:  "backwards transition from 'in-progress' to 'draft' requires --force flag",
+			wantReason:  "transition from 'in-progress' to 'draft' is not allowed (from 'in-progress': ready, closed); use --force to override",
+		},
+		{
+			name: "cannot skip work from ready to closed without force",
+			ctx: OverrideStatusContext{
+				ShipmentID:    "SHIP-001",
+				CurrentStatus: "ready",
+				NewStatus:     "closed",
+			},
+			wantAllowed: false,
+			wantReason:  "transition from 'ready' to 'closed' is not allowed (from 'ready': draft, in-progress); use --force to override",
+		},
+		{
+			name: "cannot reopen closed shipment without force",
+			ctx: OverrideStatusContext{
+				ShipmentID:    "SHIP-001",
+				CurrentStatus: "closed",
+				NewStatus:     "in-progress",
+			},
+			wantAllowed: false,
+			wantReason:  "transition from 'closed' to 'in-progress' is not allowed (from 'closed': none); use --force to override",
+		},
+		{
+			name: "can pause in-progress shipment back to ready",
+			ctx: OverrideStatusContext{
+				ShipmentID:    "SHIP-001",
+				CurrentStatus: "in-progress",
+				NewStatus:     "ready",
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "can keep the same status",
+			ctx: OverrideStatusContext{
+				ShipmentID:    "SHIP-001",
+				CurrentStatus: "ready",
+				NewStatus:     "ready",
+			},
+			wantAllowed: true,
 		},
 		{
 			name: "can go backwards with force",
@@ -0,0 +1,67 @@
+package shipment
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Transition is a legal status change and why it happens.
+type Transition struct {
+	From   string
+	To     string
+	Reason string
+}
+
+// transitions is the shipment status state machine. Any change not listed
+// here needs --force and is marked as forced in the workshop log.
+var transitions = []Transition{
+	{From: "draft", To: "ready", Reason: "planning done; tasks can be picked up"},
+	{From: "ready", To: "draft", Reason: "back to planning"},
+	{From: "ready", To: "in-progress", Reason: "work started"},
+	{From: "in-progress", To: "ready", Reason: "work paused"},
+	{From: "in-progress", To: "closed", Reason: "all work done"},
+}
+
+// Transitions returns the legal shipment status transitions.
+func Transitions() []Transition {
+	result := make([]Transition, len(transitions))
+	copy(result, transitions)
+	return result
+}
+
+// IsLegalTransition reports whether a status change needs no --force.
+// Keeping the same status and leaving an unknown (legacy) status are legal.
+func IsLegalTransition(from, to string) bool {
+	if from == to {
+		return true
+	}
+	if _, ok := statusOrder[from]; !ok {
+		return true
+	}
+	for _, t := range transitions {
+		if t.From == from && t.To == to {
+			return true
+		}
+	}
+	return false
+}
+
+// legalTargets lists the statuses reachable from a status without --force.
+func legalTargets(from string) []string {
+	var targets []string
+	for _, t := range transitions {
+		if t.From == from {
+			targets = append(targets, t.To)
+		}
+	}
+	return targets
+}
+
+// illegalTransitionReason explains a refused transition and what is allowed instead.
+func illegalTransitionReason(from, to string) string {
+	allowed := "none"
+	if targets := legalTargets(from); len(targets) > 0 {
+		allowed = strings.Join(targets, ", ")
+	}
+	return fmt.Sprintf("transition from '%s' to '%s' is not allowed (from '%s': %s); use --force to override", from, to, from, allowed)
+}
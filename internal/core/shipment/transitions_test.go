@@ -0,0 +1,40 @@
+package shipment
+
+import "testing"
+
+func TestIsLegalTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{"draft", "ready", true},
+		{"ready", "in-progress", true},
+		{"in-progress", "closed", true},
+		{"draft", "in-progress", false},
+		{"ready", "closed", false},
+		{"closed", "draft", false},
+		{"closed", "closed", true},
+		{"legacy_status", "closed", true},
+	}
+
+	for _, tt := range tests {
+		if got := IsLegalTransition(tt.from, tt.to); got != tt.want {
+			t.Errorf("IsLegalTransition(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestTransitions_CoverValidStatuses(t *testing.T) {
+	valid := make(map[string]bool)
+	for _, s := range ValidStatuses() {
+		valid[s] = true
+	}
+	for _, tr := range Transitions() {
+		if !valid[tr.From] || !valid[tr.To] {
+			t.Errorf("transition %s -> %s uses an unknown status", tr.From, tr.To)
+		}
+		if tr.Reason == "" {
+			t.Errorf("transition %s -> %s has no reason", tr.From, tr.To)
+		}
+	}
+}
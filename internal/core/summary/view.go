@@ -0,0 +1,120 @@
+// Package summary contains the pure rules for saved summary views.
+package summary
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Container kinds a view can show.
+const (
+	ContainerShipment = "SHIP"
+	ContainerTome     = "TOME"
+)
+
+// containerStatuses are the statuses shipments and tomes can have.
+var containerStatuses = []string{"draft", "ready", "in-progress", "open", "closed"}
+
+var viewNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// View is a slice of the summary: which kinds of container to show, in which
+// statuses, and (for shipments) carrying tasks with which tags. Empty lists
+// don't filter.
+type View struct {
+	Containers []string
+	Statuses   []string
+	Tags       []string
+}
+
+// IsEmpty reports whether the view shows everything.
+func (v View) IsEmpty() bool {
+	return len(v.Containers) == 0 && len(v.Statuses) == 0 && len(v.Tags) == 0
+}
+
+// ShowsContainer reports whether containers of the given kind (SHIP or TOME)
+// are shown. Tags label tasks, so a view with tags shows only shipments.
+func (v View) ShowsContainer(kind string) bool {
+	if len(v.Tags) > 0 && kind != ContainerShipment {
+		return false
+	}
+	return len(v.Containers) == 0 || slices.Contains(v.Containers, kind)
+}
+
+// ShowsStatus reports whether containers in the given status are shown.
+func (v View) ShowsStatus(status string) bool {
+	return len(v.Statuses) == 0 || slices.Contains(v.Statuses, status)
+}
+
+// Describe summarizes the view in a few words, e.g. "SHIP · in-progress · #infra".
+func (v View) Describe() string {
+	if v.IsEmpty() {
+		return "everything"
+	}
+	var parts []string
+	if len(v.Containers) > 0 {
+		parts = append(parts, strings.Join(v.Containers, ","))
+	}
+	if len(v.Statuses) > 0 {
+		parts = append(parts, strings.Join(v.Statuses, ","))
+	}
+	for _, tag := range v.Tags {
+		parts = append(parts, "#"+tag)
+	}
+	return strings.Join(parts, " · ")
+}
+
+// NormalizeView validates a view and returns it with container kinds in upper
+// case, statuses and tags in lower case, and duplicates dropped. Container
+// kinds may be given as prefixes (SHIP, TOME) or words (shipment, tomes).
+func NormalizeView(v View) (View, error) {
+	var out View
+	for _, c := range v.Containers {
+		kind, err := parseContainer(c)
+		if err != nil {
+			return View{}, err
+		}
+		out.Containers = appendUnique(out.Containers, kind)
+	}
+	for _, s := range v.Statuses {
+		status := strings.ToLower(strings.TrimSpace(s))
+		if !slices.Contains(containerStatuses, status) {
+			return View{}, fmt.Errorf("unknown status %q (valid: %s)", s, strings.Join(containerStatuses, ", "))
+		}
+		out.Statuses = appendUnique(out.Statuses, status)
+	}
+	for _, t := range v.Tags {
+		tag := strings.ToLower(strings.TrimSpace(t))
+		if tag == "" {
+			return View{}, fmt.Errorf("tag names cannot be empty")
+		}
+		out.Tags = appendUnique(out.Tags, tag)
+	}
+	return out, nil
+}
+
+// ValidateViewName checks that a view name is a short lower-case slug.
+func ValidateViewName(name string) error {
+	if !viewNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid view name %q (use lower-case letters, digits, - and _)", name)
+	}
+	return nil
+}
+
+func parseContainer(s string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "ship", "shipment", "shipments":
+		return ContainerShipment, nil
+	case "tome", "tomes":
+		return ContainerTome, nil
+	}
+	return "", fmt.Errorf("unknown container kind %q (valid: SHIP, TOME)", s)
+}
+
+func appendUnique(list []string, s string) []string {
+	if slices.Contains(list, s) {
+		return list
+	}
+	return append(list, s)
+}
@@ -0,0 +1,79 @@
+package summary
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeView(t *testing.T) {
+	v, err := NormalizeView(View{
+		Containers: []string{"ship", "SHIP", "tomes"},
+		Statuses:   []string{"In-Progress", "ready"},
+		Tags:       []string{"Infra", "infra"},
+	})
+	if err != nil {
+		t.Fatalf("NormalizeView() error = %v", err)
+	}
+	if strings.Join(v.Containers, ",") != "SHIP,TOME" {
+		t.Errorf("Containers = %v", v.Containers)
+	}
+	if strings.Join(v.Statuses, ",") != "in-progress,ready" {
+		t.Errorf("Statuses = %v", v.Statuses)
+	}
+	if strings.Join(v.Tags, ",") != "infra" {
+		t.Errorf("Tags = %v", v.Tags)
+	}
+
+	if _, err := NormalizeView(View{Containers: []string{"TASK"}}); err == nil || !strings.Contains(err.Error(), "unknown container kind") {
+		t.Errorf("expected unknown container kind, got %v", err)
+	}
+	if _, err := NormalizeView(View{Statuses: []string{"paused"}}); err == nil || !strings.Contains(err.Error(), "unknown status") {
+		t.Errorf("expected unknown status, got %v", err)
+	}
+	if _, err := NormalizeView(View{Tags: []string{" "}}); err == nil {
+		t.Error("expected error for an empty tag")
+	}
+}
+
+func TestView_Shows(t *testing.T) {
+	all := View{}
+	if !all.IsEmpty() || !all.ShowsContainer(ContainerTome) || !all.ShowsStatus("draft") {
+		t.Error("empty view should show everything")
+	}
+
+	ships := View{Containers: []string{ContainerShipment}, Statuses: []string{"ready"}}
+	if ships.ShowsContainer(ContainerTome) || !ships.ShowsContainer(ContainerShipment) {
+		t.Error("expected only shipments shown")
+	}
+	if ships.ShowsStatus("draft") || !ships.ShowsStatus("ready") {
+		t.Error("expected only ready containers shown")
+	}
+
+	tagged := View{Tags: []string{"infra"}}
+	if tagged.ShowsContainer(ContainerTome) || !tagged.ShowsContainer(ContainerShipment) {
+		t.Error("a view with tags should show only shipments")
+	}
+}
+
+func TestView_Describe(t *testing.T) {
+	if got := (View{}).Describe(); got != "everything" {
+		t.Errorf("Describe() = %q", got)
+	}
+	v := View{Containers: []string{"SHIP"}, Statuses: []string{"ready", "in-progress"}, Tags: []string{"infra"}}
+	if got := v.Describe(); got != "SHIP · ready,in-progress · #infra" {
+		t.Errorf("Describe() = %q", got)
+	}
+}
+
+func TestValidateViewName(t *testing.T) {
+	for _, name := range []string{"standup", "my-view_2", "0day"} {
+		if err := ValidateViewName(name); err != nil {
+			t.Errorf("ValidateViewName(%q) error = %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "Standup", "-x", "two words"} {
+		if err := ValidateViewName(name); err == nil {
+			t.Errorf("ValidateViewName(%q) should fail", name)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package tag
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MergeTagContext provides context for tag merge guards.
+type MergeTagContext struct {
+	SourceName   string
+	SourceExists bool
+	TargetName   string
+	TargetExists bool
+}
+
+// CanMergeTag evaluates whether one tag can be merged into another.
+// Rules:
+// - Both tags must exist
+// - A tag cannot be merged into itself
+func CanMergeTag(ctx MergeTagContext) GuardResult {
+	if !ctx.SourceExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("tag '%s' not found", ctx.SourceName),
+		}
+	}
+
+	if !ctx.TargetExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("tag '%s' not found (create it first or use orc tag rename)", ctx.TargetName),
+		}
+	}
+
+	if ctx.SourceName == ctx.TargetName {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot merge tag '%s' into itself", ctx.SourceName),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// RenameTagContext provides context for tag rename guards.
+type RenameTagContext struct {
+	Name         string
+	Exists       bool
+	NewName      string
+	NewNameTaken bool
+}
+
+// CanRenameTag evaluates whether a tag can be renamed.
+// Rules:
+// - Tag must exist
+// - New name must be non-empty and differ from the current name
+// - New name must not belong to another tag (merge instead)
+func CanRenameTag(ctx RenameTagContext) GuardResult {
+	if !ctx.Exists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("tag '%s' not found", ctx.Name),
+		}
+	}
+
+	if strings.TrimSpace(ctx.NewName) == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  "new tag name is required",
+		}
+	}
+
+	if ctx.NewName == ctx.Name {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("tag is already named '%s'", ctx.Name),
+		}
+	}
+
+	if ctx.NewNameTaken {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("tag '%s' already exists (use orc tag merge %s --into %s)", ctx.NewName, ctx.Name, ctx.NewName),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// stemSuffixes are stripped, longest first, when comparing tag names.
+var stemSuffixes = []string{"ing", "es", "s"}
+
+// Stem reduces a tag name to the form near-duplicates share: lower case,
+// letters and digits only, without a plural or -ing suffix. "Tests",
+// "testing", and "test" all stem to "test".
+func Stem(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	stem := b.String()
+	for _, suffix := range stemSuffixes {
+		if trimmed, ok := strings.CutSuffix(stem, suffix); ok && len(trimmed) >= 3 {
+			return trimmed
+		}
+	}
+	return stem
+}
+
+// SimilarGroups groups tag names that share a stem, for merge suggestions.
+// Only groups of two or more are returned; names within a group and the
+// groups themselves are sorted.
+func SimilarGroups(names []string) [][]string {
+	byStem := make(map[string][]string)
+	for _, name := range names {
+		stem := Stem(name)
+		byStem[stem] = append(byStem[stem], name)
+	}
+
+	var groups [][]string
+	for _, group := range byStem {
+		if len(group) < 2 {
+			continue
+		}
+		sort.Strings(group)
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i][0] < groups[j][0] })
+	return groups
+}
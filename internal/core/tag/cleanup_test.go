@@ -0,0 +1,132 @@
+package tag
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCanMergeTag(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         MergeTagContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can merge into existing tag",
+			ctx:         MergeTagContext{SourceName: "testing", SourceExists: true, TargetName: "tests", TargetExists: true},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot merge missing tag",
+			ctx:         MergeTagContext{SourceName: "testng", TargetName: "tests", TargetExists: true},
+			wantAllowed: false,
+			wantReason:  "tag 'testng' not found",
+		},
+		{
+			name:        "cannot merge into missing tag",
+			ctx:         MergeTagContext{SourceName: "testing", SourceExists: true, TargetName: "qa"},
+			wantAllowed: false,
+			wantReason:  "tag 'qa' not found (create it first or use orc tag rename)",
+		},
+		{
+			name:        "cannot merge into itself",
+			ctx:         MergeTagContext{SourceName: "tests", SourceExists: true, TargetName: "tests", TargetExists: true},
+			wantAllowed: false,
+			wantReason:  "cannot merge tag 'tests' into itself",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanMergeTag(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanRenameTag(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         RenameTagContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can rename to a free name",
+			ctx:         RenameTagContext{Name: "testing", Exists: true, NewName: "tests"},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot rename missing tag",
+			ctx:         RenameTagContext{Name: "testng", NewName: "tests"},
+			wantAllowed: false,
+			wantReason:  "tag 'testng' not found",
+		},
+		{
+			name:        "cannot rename to blank",
+			ctx:         RenameTagContext{Name: "testing", Exists: true, NewName: "  "},
+			wantAllowed: false,
+			wantReason:  "new tag name is required",
+		},
+		{
+			name:        "cannot rename to same name",
+			ctx:         RenameTagContext{Name: "tests", Exists: true, NewName: "tests"},
+			wantAllowed: false,
+			wantReason:  "tag is already named 'tests'",
+		},
+		{
+			name:        "cannot rename onto another tag",
+			ctx:         RenameTagContext{Name: "testing", Exists: true, NewName: "tests", NewNameTaken: true},
+			wantAllowed: false,
+			wantReason:  "tag 'tests' already exists (use orc tag merge testing --into tests)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanRenameTag(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestStem(t *testing.T) {
+	tests := map[string]string{
+		"test":      "test",
+		"tests":     "test",
+		"testing":   "test",
+		"Testing":   "test",
+		"db-schema": "dbschema",
+		"db_schema": "dbschema",
+		"fixes":     "fix",
+		"bus":       "bus",
+		"ops":       "ops",
+	}
+	for name, want := range tests {
+		if got := Stem(name); got != want {
+			t.Errorf("Stem(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestSimilarGroups(t *testing.T) {
+	got := SimilarGroups([]string{"tests", "ui", "testing", "db-schema", "test", "db_schema", "docs"})
+	want := [][]string{{"db-schema", "db_schema"}, {"test", "testing", "tests"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SimilarGroups = %v, want %v", got, want)
+	}
+	if groups := SimilarGroups([]string{"ui", "docs"}); len(groups) != 0 {
+		t.Errorf("expected no groups, got %v", groups)
+	}
+}
@@ -0,0 +1,104 @@
+// Package tag contains the pure business logic for tags and tag routing.
+// A tag route names the workbench that specializes in a tag's work, so new
+// tasks carrying the tag are suggested to (or assigned to) that workbench.
+package tag
+
+import "fmt"
+
+// Route modes
+const (
+	RouteModeSuggest = "suggest" // Suggest the workbench when a tagged task is created
+	RouteModeAssign  = "assign"  // Assign tagged tasks to the workbench on creation
+)
+
+// GuardResult represents the outcome of a guard evaluation.
+type GuardResult struct {
+	Allowed bool
+	Reason  string
+}
+
+// Error converts the guard result to an error if not allowed.
+func (r GuardResult) Error() error {
+	if r.Allowed {
+		return nil
+	}
+	return fmt.Errorf("%s", r.Reason)
+}
+
+// RouteTagContext provides context for tag routing guards.
+type RouteTagContext struct {
+	TagName         string
+	TagExists       bool
+	WorkbenchID     string
+	WorkbenchStatus string // Empty when the workbench doesn't exist
+	Mode            string
+}
+
+// CanRouteTag evaluates whether a tag can be routed to a workbench.
+// Rules:
+// - Tag must exist
+// - Workbench must exist and be active
+// - Mode must be suggest or assign
+func CanRouteTag(ctx RouteTagContext) GuardResult {
+	if !ctx.TagExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("tag '%s' not found", ctx.TagName),
+		}
+	}
+
+	if ctx.WorkbenchStatus == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("workbench %s not found", ctx.WorkbenchID),
+		}
+	}
+
+	if ctx.WorkbenchStatus != "active" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot route to workbench %s: status is %s", ctx.WorkbenchID, ctx.WorkbenchStatus),
+		}
+	}
+
+	if ctx.Mode != RouteModeSuggest && ctx.Mode != RouteModeAssign {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("invalid route mode %q: use %s or %s", ctx.Mode, RouteModeSuggest, RouteModeAssign),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// NextTaskCandidate describes an open task a workbench could claim next.
+type NextTaskCandidate struct {
+	ID           string
+	AssignedHere bool // Already assigned to the claiming workbench
+	Routed       bool // Carries a tag routed to the claiming workbench
+	Blocked      bool // Has dependencies that are not closed, or a conditionally approved plan
+}
+
+// PickNextTask returns the ID of the task a workbench should claim next, or
+// "" when none is claimable. Tasks already assigned to the workbench come
+// first, then tasks routed to it, then the rest. Blocked tasks are skipped;
+// candidates keep their given order within each group.
+func PickNextTask(candidates []NextTaskCandidate) string {
+	best, bestRank := "", 0
+	for _, c := range candidates {
+		if c.Blocked {
+			continue
+		}
+		rank := 1
+		switch {
+		case c.AssignedHere:
+			rank = 3
+		case c.Routed:
+			rank = 2
+		}
+		if rank > bestRank {
+			best, bestRank = c.ID, rank
+		}
+	}
+	return best
+}
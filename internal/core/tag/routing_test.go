@@ -0,0 +1,113 @@
+package tag
+
+import "testing"
+
+func TestCanRouteTag(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         RouteTagContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can route to active workbench",
+			ctx:         RouteTagContext{TagName: "database-schema", TagExists: true, WorkbenchID: "BENCH-002", WorkbenchStatus: "active", Mode: RouteModeSuggest},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot route missing tag",
+			ctx:         RouteTagContext{TagName: "nope", WorkbenchID: "BENCH-002", WorkbenchStatus: "active", Mode: RouteModeSuggest},
+			wantAllowed: false,
+			wantReason:  "tag 'nope' not found",
+		},
+		{
+			name:        "cannot route to missing workbench",
+			ctx:         RouteTagContext{TagName: "database-schema", TagExists: true, WorkbenchID: "BENCH-999", Mode: RouteModeSuggest},
+			wantAllowed: false,
+			wantReason:  "workbench BENCH-999 not found",
+		},
+		{
+			name:        "cannot route to archived workbench",
+			ctx:         RouteTagContext{TagName: "database-schema", TagExists: true, WorkbenchID: "BENCH-002", WorkbenchStatus: "archived", Mode: RouteModeAssign},
+			wantAllowed: false,
+			wantReason:  "cannot route to workbench BENCH-002: status is archived",
+		},
+		{
+			name:        "cannot route with unknown mode",
+			ctx:         RouteTagContext{TagName: "database-schema", TagExists: true, WorkbenchID: "BENCH-002", WorkbenchStatus: "active", Mode: "force"},
+			wantAllowed: false,
+			wantReason:  `invalid route mode "force": use suggest or assign`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanRouteTag(tt.ctx)
+
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("CanRouteTag() Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+
+			if result.Reason != tt.wantReason {
+				t.Errorf("CanRouteTag() Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestPickNextTask(t *testing.T) {
+	tests := []struct {
+		name       string
+		candidates []NextTaskCandidate
+		want       string
+	}{
+		{
+			name: "first unrouted task when nothing is preferred",
+			candidates: []NextTaskCandidate{
+				{ID: "TASK-001"},
+				{ID: "TASK-002"},
+			},
+			want: "TASK-001",
+		},
+		{
+			name: "routed task beats earlier unrouted task",
+			candidates: []NextTaskCandidate{
+				{ID: "TASK-001"},
+				{ID: "TASK-002", Routed: true},
+				{ID: "TASK-003", Routed: true},
+			},
+			want: "TASK-002",
+		},
+		{
+			name: "assigned task beats routed task",
+			candidates: []NextTaskCandidate{
+				{ID: "TASK-001", Routed: true},
+				{ID: "TASK-002", AssignedHere: true},
+			},
+			want: "TASK-002",
+		},
+		{
+			name: "blocked tasks are skipped",
+			candidates: []NextTaskCandidate{
+				{ID: "TASK-001", AssignedHere: true, Blocked: true},
+				{ID: "TASK-002"},
+			},
+			want: "TASK-002",
+		},
+		{
+			name: "nothing claimable",
+			candidates: []NextTaskCandidate{
+				{ID: "TASK-001", Blocked: true},
+			},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PickNextTask(tt.candidates); got != tt.want {
+				t.Errorf("PickNextTask() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
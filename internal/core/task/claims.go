@@ -0,0 +1,64 @@
+package task
+
+import (
+	"fmt"
+	"time"
+)
+
+// DefaultClaimTTL is how long a claim stays live without a heartbeat.
+const DefaultClaimTTL = 2 * time.Hour
+
+// ClaimLastSeen returns when a claim was last known to be alive: the later
+// of the claim itself and its most recent heartbeat. Either may be zero.
+func ClaimLastSeen(claimedAt, refreshedAt time.Time) time.Time {
+	if refreshedAt.After(claimedAt) {
+		return refreshedAt
+	}
+	return claimedAt
+}
+
+// ClaimExpiry returns when a claim last seen at lastSeen expires.
+// A non-positive ttl uses DefaultClaimTTL.
+func ClaimExpiry(lastSeen time.Time, ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		ttl = DefaultClaimTTL
+	}
+	return lastSeen.Add(ttl)
+}
+
+// ReapClaimContext provides context for releasing an expired claim.
+type ReapClaimContext struct {
+	TaskID   string
+	Status   string
+	LastSeen time.Time // Zero if the claim time is unknown
+	Now      time.Time
+	TTL      time.Duration
+}
+
+// CanReapClaim evaluates whether a task's claim has expired and can be
+// released back to open.
+// Rules:
+// - Task must be in-progress (blocked tasks wait on something else, not their IMP)
+// - Claim must have a known claim or heartbeat time
+// - Claim must be older than the TTL
+func CanReapClaim(ctx ReapClaimContext) GuardResult {
+	if ctx.Status != "in-progress" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("task %s is %s, not in-progress", ctx.TaskID, ctx.Status),
+		}
+	}
+	if ctx.LastSeen.IsZero() {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("task %s has no claim time", ctx.TaskID),
+		}
+	}
+	if expiry := ClaimExpiry(ctx.LastSeen, ctx.TTL); ctx.Now.Before(expiry) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("claim on task %s is live until %s", ctx.TaskID, expiry.Format(time.RFC3339)),
+		}
+	}
+	return GuardResult{Allowed: true}
+}
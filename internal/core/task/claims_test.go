@@ -0,0 +1,74 @@
+package task
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCanReapClaim(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		ctx         ReapClaimContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can reap claim past the TTL",
+			ctx:         ReapClaimContext{TaskID: "TASK-001", Status: "in-progress", LastSeen: now.Add(-3 * time.Hour), Now: now},
+			wantAllowed: true,
+		},
+		{
+			name:        "can reap claim past a custom TTL",
+			ctx:         ReapClaimContext{TaskID: "TASK-001", Status: "in-progress", LastSeen: now.Add(-20 * time.Minute), Now: now, TTL: 15 * time.Minute},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot reap live claim",
+			ctx:         ReapClaimContext{TaskID: "TASK-001", Status: "in-progress", LastSeen: now.Add(-time.Hour), Now: now},
+			wantAllowed: false,
+			wantReason:  "claim on task TASK-001 is live until 2026-10-16T13:00:00Z",
+		},
+		{
+			name:        "cannot reap blocked task",
+			ctx:         ReapClaimContext{TaskID: "TASK-001", Status: "blocked", LastSeen: now.Add(-3 * time.Hour), Now: now},
+			wantAllowed: false,
+			wantReason:  "task TASK-001 is blocked, not in-progress",
+		},
+		{
+			name:        "cannot reap claim without a time",
+			ctx:         ReapClaimContext{TaskID: "TASK-001", Status: "in-progress", Now: now},
+			wantAllowed: false,
+			wantReason:  "task TASK-001 has no claim time",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanReapClaim(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestClaimLastSeen(t *testing.T) {
+	claimed := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	refreshed := claimed.Add(time.Hour)
+
+	if got := ClaimLastSeen(claimed, refreshed); !got.Equal(refreshed) {
+		t.Errorf("expected heartbeat time, got %v", got)
+	}
+	if got := ClaimLastSeen(claimed, time.Time{}); !got.Equal(claimed) {
+		t.Errorf("expected claim time without heartbeat, got %v", got)
+	}
+	// A heartbeat from a previous claim doesn't extend a newer one
+	if got := ClaimLastSeen(refreshed, claimed); !got.Equal(refreshed) {
+		t.Errorf("expected newer claim time, got %v", got)
+	}
+}
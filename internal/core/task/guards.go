@@ -45,6 +45,19 @@ type TagTaskContext struct {
 	ExistingTagName string
 }
 
+// ChecklistItemContext provides context for checklist item guards.
+type ChecklistItemContext struct {
+	TaskID    string
+	Position  int // 1-based position as shown by orc task show
+	ItemCount int
+}
+
+// StartTaskContext provides context for starting work on a task.
+type StartTaskContext struct {
+	TaskID             string
+	ConditionalPlanIDs []string // The task's plans with open approval conditions
+}
+
 // CanCreateTask evaluates whether a task can be created.
 // Rules:
 // - Commission must exist
@@ -125,3 +138,40 @@ func CanTagTask(ctx TagTaskContext) GuardResult {
 
 	return GuardResult{Allowed: true}
 }
+
+// CanEditChecklistItem evaluates whether a checklist item can be checked, unchecked, or removed.
+// Rules:
+// - Position must refer to an existing item (1..ItemCount)
+func CanEditChecklistItem(ctx ChecklistItemContext) GuardResult {
+	if ctx.ItemCount == 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("task %s has no checklist items. Add one with: orc task check add %s \"<text>\"", ctx.TaskID, ctx.TaskID),
+		}
+	}
+
+	if ctx.Position < 1 || ctx.Position > ctx.ItemCount {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("task %s has no checklist item %d (valid: 1-%d)", ctx.TaskID, ctx.Position, ctx.ItemCount),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// CanStartTask evaluates whether work on a task can start (claim or resume).
+// Rules:
+// - The task's plan must not be conditionally approved (conditions open)
+func CanStartTask(ctx StartTaskContext) GuardResult {
+	if len(ctx.ConditionalPlanIDs) > 0 {
+		planID := ctx.ConditionalPlanIDs[0]
+		return GuardResult{
+			Allowed: false,
+			Reason: fmt.Sprintf("task %s's plan %s is conditionally approved; address its conditions first (orc plan show %s)",
+				ctx.TaskID, planID, planID),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
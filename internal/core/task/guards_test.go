@@ -144,6 +144,63 @@ func TestCanTagTask(t *testing.T) {
 	}
 }
 
+func TestCanEditChecklistItem(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         ChecklistItemContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can edit item within range",
+			ctx:         ChecklistItemContext{TaskID: "TASK-042", Position: 2, ItemCount: 5},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot edit item on empty checklist",
+			ctx:         ChecklistItemContext{TaskID: "TASK-042", Position: 1, ItemCount: 0},
+			wantAllowed: false,
+			wantReason:  "task TASK-042 has no checklist items. Add one with: orc task check add TASK-042 \"<text>\"",
+		},
+		{
+			name:        "cannot edit item past the end",
+			ctx:         ChecklistItemContext{TaskID: "TASK-042", Position: 6, ItemCount: 5},
+			wantAllowed: false,
+			wantReason:  "task TASK-042 has no checklist item 6 (valid: 1-5)",
+		},
+		{
+			name:        "cannot edit item zero",
+			ctx:         ChecklistItemContext{TaskID: "TASK-042", Position: 0, ItemCount: 5},
+			wantAllowed: false,
+			wantReason:  "task TASK-042 has no checklist item 0 (valid: 1-5)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanEditChecklistItem(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanStartTask(t *testing.T) {
+	if result := CanStartTask(StartTaskContext{TaskID: "TASK-001"}); !result.Allowed {
+		t.Errorf("expected task without conditional plan to start, got %q", result.Reason)
+	}
+
+	result := CanStartTask(StartTaskContext{TaskID: "TASK-001", ConditionalPlanIDs: []string{"PLAN-002"}})
+	want := "task TASK-001's plan PLAN-002 is conditionally approved; address its conditions first (orc plan show PLAN-002)"
+	if result.Allowed || result.Reason != want {
+		t.Errorf("CanStartTask() = %+v, want refused with %q", result, want)
+	}
+}
+
 func TestGuardResult_Error(t *testing.T) {
 	t.Run("allowed result returns nil error", func(t *testing.T) {
 		result := GuardResult{Allowed: true}
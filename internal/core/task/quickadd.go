@@ -0,0 +1,146 @@
+package task
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DueDateLayout is the format due dates are stored and shown in.
+const DueDateLayout = "2006-01-02"
+
+// Priorities are the task priorities, lowest first.
+var Priorities = []string{"low", "medium", "high"}
+
+// QuickAdd is a task described in one line, e.g.
+// "Fix flaky auth test !high #testing @SHIP-010 due:fri".
+type QuickAdd struct {
+	Title      string
+	Priority   string // low, medium, or high; empty when not given
+	Tag        string // Empty when not given
+	ShipmentID string // Empty when not given
+	DueDate    string // DueDateLayout; empty when not given
+}
+
+var (
+	quickTagRe      = regexp.MustCompile(`^#([A-Za-z][\w-]*)$`)
+	quickShipmentRe = regexp.MustCompile(`(?i)^@(SHIP-\d+)$`)
+	relativeDueRe   = regexp.MustCompile(`^\+(\d+)([dw])$`)
+)
+
+// priorityAliases maps the accepted !priority spellings to priorities.
+var priorityAliases = map[string]string{
+	"low": "low", "l": "low",
+	"medium": "medium", "med": "medium", "m": "medium",
+	"high": "high", "h": "high",
+}
+
+// ParseQuickAdd reads a quick-add line. Tokens set fields and the remaining
+// words, in order, form the title:
+//
+//	!high, !med, !l     priority
+//	#testing            tag (must start with a letter, so #42 stays in the title)
+//	@SHIP-010           shipment
+//	due:fri             due date (see ParseDueDate)
+//
+// A token starting with a backslash is kept in the title without it, so
+// "\#hashtag" means the literal word "#hashtag".
+func ParseQuickAdd(line string, today time.Time) (QuickAdd, error) {
+	var q QuickAdd
+	var title []string
+	for _, word := range strings.Fields(line) {
+		switch {
+		case strings.HasPrefix(word, `\`) && len(word) > 1:
+			title = append(title, word[1:])
+		case strings.HasPrefix(word, "!") && len(word) > 1:
+			priority, ok := priorityAliases[strings.ToLower(word[1:])]
+			if !ok {
+				return QuickAdd{}, fmt.Errorf("unknown priority %s (use !low, !medium, or !high)", word)
+			}
+			if q.Priority != "" {
+				return QuickAdd{}, fmt.Errorf("priority given twice (!%s and %s)", q.Priority, word)
+			}
+			q.Priority = priority
+		case quickTagRe.MatchString(word):
+			if q.Tag != "" {
+				return QuickAdd{}, fmt.Errorf("a task takes one tag (#%s and %s)", q.Tag, word)
+			}
+			q.Tag = quickTagRe.FindStringSubmatch(word)[1]
+		case quickShipmentRe.MatchString(word):
+			if q.ShipmentID != "" {
+				return QuickAdd{}, fmt.Errorf("shipment given twice (@%s and %s)", q.ShipmentID, word)
+			}
+			q.ShipmentID = strings.ToUpper(quickShipmentRe.FindStringSubmatch(word)[1])
+		case strings.HasPrefix(strings.ToLower(word), "due:"):
+			if q.DueDate != "" {
+				return QuickAdd{}, fmt.Errorf("due date given twice (due:%s and %s)", q.DueDate, word)
+			}
+			due, err := ParseDueDate(word[len("due:"):], today)
+			if err != nil {
+				return QuickAdd{}, err
+			}
+			q.DueDate = due
+		default:
+			title = append(title, word)
+		}
+	}
+
+	q.Title = strings.Join(title, " ")
+	if q.Title == "" {
+		return QuickAdd{}, fmt.Errorf("quick-add needs a title besides its tokens")
+	}
+	return q, nil
+}
+
+// ParseDueDate resolves a due date relative to today and returns it in
+// DueDateLayout. It accepts a date (2026-10-20), today, tomorrow, a weekday
+// (fri, friday: the next one after today), or an offset (+3d, +2w).
+func ParseDueDate(value string, today time.Time) (string, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+
+	switch value {
+	case "":
+		return "", fmt.Errorf("due date is empty (e.g. due:fri, due:+3d, due:2026-10-20)")
+	case "today":
+		return today.Format(DueDateLayout), nil
+	case "tomorrow", "tmr":
+		return today.AddDate(0, 0, 1).Format(DueDateLayout), nil
+	}
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		name := strings.ToLower(day.String())
+		if value == name || value == name[:3] {
+			ahead := (int(day) - int(today.Weekday()) + 7) % 7
+			if ahead == 0 {
+				ahead = 7
+			}
+			return today.AddDate(0, 0, ahead).Format(DueDateLayout), nil
+		}
+	}
+
+	if m := relativeDueRe.FindStringSubmatch(value); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		if m[2] == "w" {
+			n *= 7
+		}
+		return today.AddDate(0, 0, n).Format(DueDateLayout), nil
+	}
+
+	if d, err := time.Parse(DueDateLayout, value); err == nil {
+		return d.Format(DueDateLayout), nil
+	}
+	return "", fmt.Errorf("unrecognized due date %q (e.g. fri, tomorrow, +3d, 2026-10-20)", value)
+}
+
+// ValidPriority reports whether p is a task priority.
+func ValidPriority(p string) bool {
+	for _, known := range Priorities {
+		if p == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package task
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// quickAddToday is a Thursday.
+var quickAddToday = time.Date(2026, 10, 15, 17, 30, 0, 0, time.UTC)
+
+func TestParseQuickAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    QuickAdd
+		wantErr string
+	}{
+		{
+			name: "all tokens",
+			line: "Fix flaky auth test !high #testing @SHIP-010 due:fri",
+			want: QuickAdd{Title: "Fix flaky auth test", Priority: "high", Tag: "testing", ShipmentID: "SHIP-010", DueDate: "2026-10-16"},
+		},
+		{
+			name: "tokens anywhere, aliases, lowercase shipment",
+			line: "!m Update #docs the guide @ship-3",
+			want: QuickAdd{Title: "Update the guide", Priority: "medium", Tag: "docs", ShipmentID: "SHIP-3"},
+		},
+		{
+			name: "issue numbers, mentions, and escapes stay in the title",
+			line: `Close #42 for @alice \#hashtag \!important`,
+			want: QuickAdd{Title: "Close #42 for @alice #hashtag !important"},
+		},
+		{name: "unknown priority", line: "Task !urgent", wantErr: "unknown priority !urgent"},
+		{name: "priority twice", line: "Task !high !low", wantErr: "priority given twice"},
+		{name: "two tags", line: "Task #ui #testing", wantErr: "a task takes one tag"},
+		{name: "shipment twice", line: "Task @SHIP-001 @SHIP-002", wantErr: "shipment given twice"},
+		{name: "bad due date", line: "Task due:someday", wantErr: "unrecognized due date"},
+		{name: "only tokens", line: "!high #testing", wantErr: "needs a title"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseQuickAdd(tt.line, quickAddToday)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseQuickAdd() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDueDate(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"today", "2026-10-15"},
+		{"tomorrow", "2026-10-16"},
+		{"fri", "2026-10-16"},
+		{"Monday", "2026-10-19"},
+		{"thu", "2026-10-22"}, // Today's weekday means next week's
+		{"+3d", "2026-10-18"},
+		{"+2w", "2026-10-29"},
+		{"2026-12-01", "2026-12-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseDueDate(tt.value, quickAddToday)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDueDate(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+
+	for _, bad := range []string{"", "2026-13-01", "next week", "+3m"} {
+		if _, err := ParseDueDate(bad, quickAddToday); err == nil {
+			t.Errorf("ParseDueDate(%q) should fail", bad)
+		}
+	}
+}
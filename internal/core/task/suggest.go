@@ -0,0 +1,133 @@
+package task
+
+import (
+	"path"
+	"sort"
+	"strings"
+)
+
+// Scores awarded per edited file when suggesting which task is being worked on.
+const (
+	scoreLinkedFile   = 3 // A commit mentioning the task touched the file
+	scorePathMention  = 3 // The task names the file's path
+	scoreBaseMention  = 2 // The task names the file (e.g. "session.go")
+	scoreStemMention  = 1 // The task names the file's stem (e.g. "session")
+	minStemLength     = 4 // Shorter stems ("api", "db") match too much prose
+	autoClaimMinScore = 4 // A suggestion must reach this to be claimed automatically
+)
+
+// SuggestCandidate is a task that edited files might belong to.
+type SuggestCandidate struct {
+	ID          string
+	Title       string
+	Description string
+	Status      string   // open or in-progress
+	LinkedFiles []string // Files touched by commits that mention the task
+}
+
+// Suggestion is a task ranked against a set of edited files.
+type Suggestion struct {
+	TaskID  string
+	Status  string
+	Score   int
+	Matches []string // Edited files that matched, in input order
+}
+
+// SuggestTasks ranks candidates by how well they explain the edited files.
+// A file counts for a task if a commit mentioning the task touched it, or if
+// the task's title or description names its path, file name, or stem.
+// Tasks matching nothing are omitted. Ranked by score, then task ID.
+func SuggestTasks(editedFiles []string, candidates []SuggestCandidate) []Suggestion {
+	var suggestions []Suggestion
+	for _, c := range candidates {
+		linked := make(map[string]bool, len(c.LinkedFiles))
+		for _, f := range c.LinkedFiles {
+			linked[f] = true
+		}
+		text := strings.ToLower(c.Title + "\n" + c.Description)
+
+		s := Suggestion{TaskID: c.ID, Status: c.Status}
+		for _, file := range editedFiles {
+			score := 0
+			if linked[file] {
+				score += scoreLinkedFile
+			}
+			score += mentionScore(text, file)
+			if score > 0 {
+				s.Score += score
+				s.Matches = append(s.Matches, file)
+			}
+		}
+		if s.Score > 0 {
+			suggestions = append(suggestions, s)
+		}
+	}
+
+	sort.SliceStable(suggestions, func(i, j int) bool {
+		if suggestions[i].Score != suggestions[j].Score {
+			return suggestions[i].Score > suggestions[j].Score
+		}
+		return suggestions[i].TaskID < suggestions[j].TaskID
+	})
+	return suggestions
+}
+
+// mentionScore scores how specifically lowercased task text names a file.
+func mentionScore(text, file string) int {
+	file = strings.ToLower(file)
+	if strings.Contains(text, file) {
+		return scorePathMention
+	}
+	base := path.Base(file)
+	if strings.Contains(text, base) {
+		return scoreBaseMention
+	}
+	stem := strings.TrimSuffix(base, path.Ext(base))
+	stem = strings.TrimSuffix(stem, "_test")
+	if len(stem) >= minStemLength && containsWord(text, stem) {
+		return scoreStemMention
+	}
+	return 0
+}
+
+// containsWord reports whether word appears in text bounded by non-word characters.
+func containsWord(text, word string) bool {
+	for i := 0; ; {
+		idx := strings.Index(text[i:], word)
+		if idx < 0 {
+			return false
+		}
+		start, end := i+idx, i+idx+len(word)
+		if (start == 0 || !isWordByte(text[start-1])) && (end == len(text) || !isWordByte(text[end])) {
+			return true
+		}
+		i = start + 1
+	}
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || b >= 'a' && b <= 'z' || b >= '0' && b <= '9'
+}
+
+// PickAutoClaim returns the suggestion confident enough to claim without
+// asking, or "" if there is none.
+// Rules:
+// - The top suggestion must score at least autoClaimMinScore
+// - It must strictly outscore the runner-up
+// - It must not already be in progress
+func PickAutoClaim(suggestions []Suggestion) string {
+	if len(suggestions) == 0 {
+		return ""
+	}
+	top := suggestions[0]
+	if top.Score < autoClaimMinScore {
+		return ""
+	}
+	if len(suggestions) > 1 && suggestions[1].Score == top.Score {
+		return ""
+	}
+	if top.Status == "in-progress" {
+		return ""
+	}
+	return top.TaskID
+}
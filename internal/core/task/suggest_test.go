@@ -0,0 +1,86 @@
+package task
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSuggestTasks(t *testing.T) {
+	candidates := []SuggestCandidate{
+		{ID: "TASK-001", Title: "Fix login redirect", Description: "See internal/auth/redirect.go", Status: "open"},
+		{ID: "TASK-002", Title: "Refactor session store", Status: "in-progress", LinkedFiles: []string{"internal/auth/session.go"}},
+		{ID: "TASK-003", Title: "Update docs", Status: "open"},
+		{ID: "TASK-004", Title: "Tidy api handlers", Status: "open"},
+	}
+	edited := []string{"internal/auth/redirect.go", "internal/auth/session.go", "internal/api/api.go"}
+
+	got := SuggestTasks(edited, candidates)
+	want := []Suggestion{
+		{TaskID: "TASK-002", Status: "in-progress", Score: 4, Matches: []string{"internal/auth/session.go"}},
+		{TaskID: "TASK-001", Status: "open", Score: 3, Matches: []string{"internal/auth/redirect.go"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SuggestTasks() = %+v, want %+v", got, want)
+	}
+}
+
+func TestMentionScore(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		file string
+		want int
+	}{
+		{"full path", "touch cmd/orc/main.go", "cmd/orc/main.go", scorePathMention},
+		{"file name", "edit main.go", "cmd/orc/main.go", scoreBaseMention},
+		{"stem as word", "the redirect handler", "web/redirect.ts", scoreStemMention},
+		{"test file stem", "the redirect handler", "web/redirect_test.go", scoreStemMention},
+		{"stem inside word", "redirection", "web/redirect.ts", 0},
+		{"short stem ignored", "the db layer", "internal/db/db.go", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mentionScore(tt.text, tt.file); got != tt.want {
+				t.Errorf("mentionScore(%q, %q) = %d, want %d", tt.text, tt.file, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPickAutoClaim(t *testing.T) {
+	tests := []struct {
+		name        string
+		suggestions []Suggestion
+		want        string
+	}{
+		{
+			name:        "confident open task",
+			suggestions: []Suggestion{{TaskID: "TASK-001", Status: "open", Score: 6}, {TaskID: "TASK-002", Status: "open", Score: 2}},
+			want:        "TASK-001",
+		},
+		{
+			name:        "score too low",
+			suggestions: []Suggestion{{TaskID: "TASK-001", Status: "open", Score: 3}},
+		},
+		{
+			name:        "tie with runner-up",
+			suggestions: []Suggestion{{TaskID: "TASK-001", Status: "open", Score: 6}, {TaskID: "TASK-002", Status: "open", Score: 6}},
+		},
+		{
+			name:        "already in progress",
+			suggestions: []Suggestion{{TaskID: "TASK-001", Status: "in-progress", Score: 6}},
+		},
+		{
+			name: "no suggestions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PickAutoClaim(tt.suggestions); got != tt.want {
+				t.Errorf("PickAutoClaim() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package task
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Transition is a legal status change and why it happens.
+type Transition struct {
+	From   string
+	To     string
+	Reason string
+}
+
+// transitions is the task status state machine. Any change not listed
+// here needs --force and is marked as forced in the workshop log.
+var transitions = []Transition{
+	{From: "open", To: "in-progress", Reason: "claimed by a workbench"},
+	{From: "open", To: "closed", Reason: "done or dropped without a claim"},
+	{From: "in-progress", To: "open", Reason: "paused"},
+	{From: "in-progress", To: "blocked", Reason: "waiting on something outside the task"},
+	{From: "in-progress", To: "closed", Reason: "work done"},
+	{From: "blocked", To: "in-progress", Reason: "unblocked"},
+	{From: "blocked", To: "closed", Reason: "dropped while blocked"},
+}
+
+// validStatuses lists the task statuses known to the state machine.
+var validStatuses = map[string]bool{
+	"open":        true,
+	"in-progress": true,
+	"blocked":     true,
+	"closed":      true,
+}
+
+// Transitions returns the legal task status transitions.
+func Transitions() []Transition {
+	result := make([]Transition, len(transitions))
+	copy(result, transitions)
+	return result
+}
+
+// IsLegalTransition reports whether a status change needs no --force.
+// Keeping the same status and leaving an unknown (legacy) status are legal.
+func IsLegalTransition(from, to string) bool {
+	if from == to || !validStatuses[from] {
+		return true
+	}
+	for _, t := range transitions {
+		if t.From == from && t.To == to {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitionContext provides context for task status transition guards.
+type TransitionContext struct {
+	TaskID string
+	From   string
+	To     string
+	Force  bool
+}
+
+// CanTransition evaluates whether a task can move between statuses.
+// Rules:
+// - Transitions outside the state machine (see Transitions) require --force flag
+func CanTransition(ctx TransitionContext) GuardResult {
+	if ctx.Force || IsLegalTransition(ctx.From, ctx.To) {
+		return GuardResult{Allowed: true}
+	}
+
+	var targets []string
+	for _, t := range transitions {
+		if t.From == ctx.From {
+			targets = append(targets, t.To)
+		}
+	}
+	allowed := "none"
+	if len(targets) > 0 {
+		allowed = strings.Join(targets, ", ")
+	}
+	return GuardResult{
+		Allowed: false,
+		Reason: fmt.Sprintf("task %s cannot move from '%s' to '%s' (from '%s': %s); use --force to override",
+			ctx.TaskID, ctx.From, ctx.To, ctx.From, allowed),
+	}
+}
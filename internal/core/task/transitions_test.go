@@ -0,0 +1,62 @@
+package task
+
+import "testing"
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         TransitionContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can claim open task",
+			ctx:         TransitionContext{TaskID: "TASK-001", From: "open", To: "in-progress"},
+			wantAllowed: true,
+		},
+		{
+			name:        "can close blocked task",
+			ctx:         TransitionContext{TaskID: "TASK-001", From: "blocked", To: "closed"},
+			wantAllowed: true,
+		},
+		{
+			name:        "can reclaim in-progress task",
+			ctx:         TransitionContext{TaskID: "TASK-001", From: "in-progress", To: "in-progress"},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot claim closed task without force",
+			ctx:         TransitionContext{TaskID: "TASK-001", From: "closed", To: "in-progress"},
+			wantAllowed: false,
+			wantReason:  "task TASK-001 cannot move from 'closed' to 'in-progress' (from 'closed': none); use --force to override",
+		},
+		{
+			name:        "cannot block open task without force",
+			ctx:         TransitionContext{TaskID: "TASK-001", From: "open", To: "blocked"},
+			wantAllowed: false,
+			wantReason:  "task TASK-001 cannot move from 'open' to 'blocked' (from 'open': in-progress, closed); use --force to override",
+		},
+		{
+			name:        "can claim closed task with force",
+			ctx:         TransitionContext{TaskID: "TASK-001", From: "closed", To: "in-progress", Force: true},
+			wantAllowed: true,
+		},
+		{
+			name:        "allows transition from unknown status",
+			ctx:         TransitionContext{TaskID: "TASK-001", From: "legacy", To: "closed"},
+			wantAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanTransition(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
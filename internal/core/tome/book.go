@@ -0,0 +1,175 @@
+// Package tome contains the pure logic for exporting a tome and its notes
+// as a markdown book.
+package tome
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FormatMarkdownBook renders a tome as a single multi-section markdown document.
+const FormatMarkdownBook = "markdown-book"
+
+// ExportFormats lists the supported export formats.
+var ExportFormats = []string{FormatMarkdownBook}
+
+// IsValidExportFormat reports whether format is a supported export format.
+func IsValidExportFormat(format string) bool {
+	for _, f := range ExportFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// Book is a tome prepared for export.
+type Book struct {
+	TomeID       string
+	CommissionID string
+	Title        string
+	Description  string
+	Charter      string
+	ExportedAt   string
+	Chapters     []Chapter
+}
+
+// Chapter is one note of the tome, with the provenance recorded in the
+// book's front matter.
+type Chapter struct {
+	NoteID    string
+	Title     string
+	Type      string
+	Status    string
+	Content   string
+	Pinned    bool
+	Position  int // Curated reading order; 0 means unordered
+	CreatedAt string
+	UpdatedAt string
+	ClosedAt  string
+}
+
+// OrderChapters sorts chapters into reading order: notes curated with
+// 'orc tome reorder' first, in their curated order; then pinned notes; then
+// oldest first, so the rest reads in the order the knowledge accumulated.
+func OrderChapters(chapters []Chapter) {
+	sort.SliceStable(chapters, func(i, j int) bool {
+		pi, pj := chapters[i].Position, chapters[j].Position
+		if (pi > 0) != (pj > 0) {
+			return pi > 0
+		}
+		if pi != pj {
+			return pi < pj
+		}
+		if chapters[i].Pinned != chapters[j].Pinned {
+			return chapters[i].Pinned
+		}
+		if chapters[i].CreatedAt != chapters[j].CreatedAt {
+			return chapters[i].CreatedAt < chapters[j].CreatedAt
+		}
+		return chapters[i].NoteID < chapters[j].NoteID
+	})
+}
+
+// RenderMarkdownBook renders the book as one markdown document. YAML front
+// matter records the tome and the provenance of every note; the charter
+// becomes the introduction and each note a numbered section. Headings inside
+// note content are demoted below the section heading so the outline holds.
+// Chapters are rendered in the order given (see OrderChapters).
+func RenderMarkdownBook(b Book) string {
+	var sb strings.Builder
+
+	sb.WriteString("---\n")
+	writeField(&sb, "", "tome", b.TomeID)
+	writeField(&sb, "", "title", b.Title)
+	writeField(&sb, "", "commission", b.CommissionID)
+	writeField(&sb, "", "exported_at", b.ExportedAt)
+	if len(b.Chapters) > 0 {
+		sb.WriteString("notes:\n")
+		for _, c := range b.Chapters {
+			sb.WriteString("  - id: " + c.NoteID + "\n")
+			writeField(&sb, "    ", "title", c.Title)
+			writeField(&sb, "    ", "type", c.Type)
+			writeField(&sb, "    ", "status", c.Status)
+			writeField(&sb, "    ", "created_at", c.CreatedAt)
+			writeField(&sb, "    ", "updated_at", c.UpdatedAt)
+			writeField(&sb, "    ", "closed_at", c.ClosedAt)
+		}
+	}
+	sb.WriteString("---\n\n")
+
+	sb.WriteString("# " + b.Title + "\n\n")
+	if b.Description != "" {
+		sb.WriteString(b.Description + "\n\n")
+	}
+
+	if len(b.Chapters) > 0 {
+		sb.WriteString("## Contents\n\n")
+		for i, c := range b.Chapters {
+			fmt.Fprintf(&sb, "%d. [%s](#%s)\n", i+1, c.Title, anchor(c.NoteID))
+		}
+		sb.WriteString("\n")
+	}
+
+	if charter := strings.TrimSpace(b.Charter); charter != "" {
+		sb.WriteString("## Introduction\n\n")
+		sb.WriteString(DemoteHeadings(charter, 2) + "\n\n")
+	}
+
+	for i, c := range b.Chapters {
+		fmt.Fprintf(&sb, "<a id=\"%s\"></a>\n\n", anchor(c.NoteID))
+		fmt.Fprintf(&sb, "## %d. %s\n\n", i+1, c.Title)
+		meta := c.NoteID
+		if c.Type != "" {
+			meta += " · " + c.Type
+		}
+		sb.WriteString("*" + meta + "*\n\n")
+		if content := strings.TrimSpace(c.Content); content != "" {
+			sb.WriteString(DemoteHeadings(content, 2) + "\n\n")
+		}
+	}
+
+	return strings.TrimRight(sb.String(), "\n") + "\n"
+}
+
+// DemoteHeadings pushes every ATX heading in markdown down by levels
+// (capped at level 6), leaving fenced code blocks untouched.
+func DemoteHeadings(markdown string, levels int) string {
+	lines := strings.Split(markdown, "\n")
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence || !strings.HasPrefix(line, "#") {
+			continue
+		}
+		level := len(line) - len(strings.TrimLeft(line, "#"))
+		if level > 6 || (len(line) > level && line[level] != ' ') {
+			continue
+		}
+		newLevel := level + levels
+		if newLevel > 6 {
+			newLevel = 6
+		}
+		lines[i] = strings.Repeat("#", newLevel) + line[level:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// anchor returns the in-document anchor for a note.
+func anchor(noteID string) string {
+	return strings.ToLower(noteID)
+}
+
+// writeField writes a quoted YAML scalar, skipping empty values.
+func writeField(sb *strings.Builder, indent, key, value string) {
+	if value == "" {
+		return
+	}
+	sb.WriteString(indent + key + ": " + strconv.Quote(value) + "\n")
+}
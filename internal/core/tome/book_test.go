@@ -0,0 +1,184 @@
+package tome
+
+import "testing"
+
+func TestRenderMarkdownBook(t *testing.T) {
+	book := Book{
+		TomeID:       "TOME-007",
+		CommissionID: "COMM-001",
+		Title:        "Auth Research",
+		Description:  "What we learned about auth.",
+		Charter:      "# Why\nKeep the findings.",
+		ExportedAt:   "2026-10-16T09:00:00Z",
+		Chapters: []Chapter{
+			{
+				NoteID:    "NOTE-012",
+				Title:     "Token storage",
+				Type:      "decision",
+				Status:    "closed",
+				Content:   "Use the keychain.\n\n## Alternatives\nFiles.",
+				CreatedAt: "2026-10-01T10:00:00Z",
+				UpdatedAt: "2026-10-02T10:00:00Z",
+				ClosedAt:  "2026-10-03T10:00:00Z",
+			},
+			{
+				NoteID:    "NOTE-015",
+				Title:     "Open questions",
+				Status:    "open",
+				CreatedAt: "2026-10-04T10:00:00Z",
+				UpdatedAt: "2026-10-04T10:00:00Z",
+			},
+		},
+	}
+
+	want := `---
+tome: "TOME-007"
+title: "Auth Research"
+commission: "COMM-001"
+exported_at: "2026-10-16T09:00:00Z"
+notes:
+  - id: NOTE-012
+    title: "Token storage"
+    type: "decision"
+    status: "closed"
+    created_at: "2026-10-01T10:00:00Z"
+    updated_at: "2026-10-02T10:00:00Z"
+    closed_at: "2026-10-03T10:00:00Z"
+  - id: NOTE-015
+    title: "Open questions"
+    status: "open"
+    created_at: "2026-10-04T10:00:00Z"
+    updated_at: "2026-10-04T10:00:00Z"
+---
+
+# Auth Research
+
+What we learned about auth.
+
+## Contents
+
+1. [Token storage](#note-012)
+2. [Open questions](#note-015)
+
+## Introduction
+
+### Why
+Keep the findings.
+
+<a id="note-012"></a>
+
+## 1. Token storage
+
+*NOTE-012 · decision*
+
+Use the keychain.
+
+#### Alternatives
+Files.
+
+<a id="note-015"></a>
+
+## 2. Open questions
+
+*NOTE-015*
+`
+
+	if got := RenderMarkdownBook(book); got != want {
+		t.Errorf("RenderMarkdownBook() mismatch\ngot:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderMarkdownBook_Empty(t *testing.T) {
+	got := RenderMarkdownBook(Book{TomeID: "TOME-001", Title: "Empty"})
+	want := "---\ntome: \"TOME-001\"\ntitle: \"Empty\"\n---\n\n# Empty\n"
+	if got != want {
+		t.Errorf("RenderMarkdownBook() = %q, want %q", got, want)
+	}
+}
+
+func TestOrderChapters(t *testing.T) {
+	chapters := []Chapter{
+		{NoteID: "NOTE-003", CreatedAt: "2026-10-03T00:00:00Z"},
+		{NoteID: "NOTE-001", CreatedAt: "2026-10-01T00:00:00Z"},
+		{NoteID: "NOTE-005", CreatedAt: "2026-10-05T00:00:00Z", Pinned: true},
+		{NoteID: "NOTE-002", CreatedAt: "2026-10-01T00:00:00Z"},
+	}
+
+	OrderChapters(chapters)
+
+	want := []string{"NOTE-005", "NOTE-001", "NOTE-002", "NOTE-003"}
+	for i, id := range want {
+		if chapters[i].NoteID != id {
+			t.Errorf("position %d: got %s, want %s", i, chapters[i].NoteID, id)
+		}
+	}
+}
+
+func TestOrderChapters_Curated(t *testing.T) {
+	chapters := []Chapter{
+		{NoteID: "NOTE-001", CreatedAt: "2026-10-01T00:00:00Z"},
+		{NoteID: "NOTE-005", CreatedAt: "2026-10-05T00:00:00Z", Pinned: true},
+		{NoteID: "NOTE-003", CreatedAt: "2026-10-03T00:00:00Z", Position: 2},
+		{NoteID: "NOTE-004", CreatedAt: "2026-10-04T00:00:00Z", Position: 1},
+	}
+
+	OrderChapters(chapters)
+
+	want := []string{"NOTE-004", "NOTE-003", "NOTE-005", "NOTE-001"}
+	for i, id := range want {
+		if chapters[i].NoteID != id {
+			t.Errorf("position %d: got %s, want %s", i, chapters[i].NoteID, id)
+		}
+	}
+}
+
+func TestDemoteHeadings(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		levels int
+		want   string
+	}{
+		{
+			name:   "demotes ATX headings",
+			input:  "# Title\ntext\n## Sub",
+			levels: 2,
+			want:   "### Title\ntext\n#### Sub",
+		},
+		{
+			name:   "caps at level six",
+			input:  "##### Deep",
+			levels: 2,
+			want:   "###### Deep",
+		},
+		{
+			name:   "ignores fenced code",
+			input:  "```sh\n# comment\n```\n# Heading",
+			levels: 1,
+			want:   "```sh\n# comment\n```\n## Heading",
+		},
+		{
+			name:   "ignores hashtags without a space",
+			input:  "#hashtag",
+			levels: 1,
+			want:   "#hashtag",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DemoteHeadings(tt.input, tt.levels); got != tt.want {
+				t.Errorf("DemoteHeadings() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsValidExportFormat(t *testing.T) {
+	if !IsValidExportFormat(FormatMarkdownBook) {
+		t.Errorf("expected %s to be valid", FormatMarkdownBook)
+	}
+	if IsValidExportFormat("pdf") {
+		t.Error("expected pdf to be invalid")
+	}
+}
@@ -0,0 +1,35 @@
+package tome
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Reorder moves noteID within a tome's reading order. The note goes right
+// after afterID, or right before beforeID; with neither it goes first.
+// order is the tome's current reading order and must contain every ID named.
+func Reorder(order []string, noteID, afterID, beforeID string) ([]string, error) {
+	if afterID != "" && beforeID != "" {
+		return nil, fmt.Errorf("specify only one of after and before")
+	}
+	anchor := afterID + beforeID
+	if anchor == noteID {
+		return nil, fmt.Errorf("cannot place %s relative to itself", noteID)
+	}
+	if !slices.Contains(order, noteID) {
+		return nil, fmt.Errorf("%s is not in this tome", noteID)
+	}
+
+	result := slices.DeleteFunc(slices.Clone(order), func(id string) bool { return id == noteID })
+	at := 0
+	if anchor != "" {
+		at = slices.Index(result, anchor)
+		if at < 0 {
+			return nil, fmt.Errorf("%s is not in this tome", anchor)
+		}
+		if afterID != "" {
+			at++
+		}
+	}
+	return slices.Insert(result, at, noteID), nil
+}
@@ -0,0 +1,51 @@
+package tome
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReorder(t *testing.T) {
+	order := []string{"NOTE-1", "NOTE-2", "NOTE-3", "NOTE-4"}
+
+	tests := []struct {
+		name     string
+		noteID   string
+		afterID  string
+		beforeID string
+		want     string
+		wantErr  string
+	}{
+		{name: "after a later note", noteID: "NOTE-1", afterID: "NOTE-3", want: "NOTE-2,NOTE-3,NOTE-1,NOTE-4"},
+		{name: "after an earlier note", noteID: "NOTE-4", afterID: "NOTE-1", want: "NOTE-1,NOTE-4,NOTE-2,NOTE-3"},
+		{name: "after the last note", noteID: "NOTE-2", afterID: "NOTE-4", want: "NOTE-1,NOTE-3,NOTE-4,NOTE-2"},
+		{name: "before a note", noteID: "NOTE-4", beforeID: "NOTE-2", want: "NOTE-1,NOTE-4,NOTE-2,NOTE-3"},
+		{name: "to the front", noteID: "NOTE-3", want: "NOTE-3,NOTE-1,NOTE-2,NOTE-4"},
+		{name: "both anchors", noteID: "NOTE-1", afterID: "NOTE-2", beforeID: "NOTE-3", wantErr: "only one"},
+		{name: "relative to itself", noteID: "NOTE-2", afterID: "NOTE-2", wantErr: "itself"},
+		{name: "note outside tome", noteID: "NOTE-9", afterID: "NOTE-1", wantErr: "NOTE-9 is not in this tome"},
+		{name: "anchor outside tome", noteID: "NOTE-1", afterID: "NOTE-9", wantErr: "NOTE-9 is not in this tome"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Reorder(order, tt.noteID, tt.afterID, tt.beforeID)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Join(got, ",") != tt.want {
+				t.Errorf("got %v, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if strings.Join(order, ",") != "NOTE-1,NOTE-2,NOTE-3,NOTE-4" {
+		t.Errorf("input order was modified: %v", order)
+	}
+}
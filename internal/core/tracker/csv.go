@@ -0,0 +1,193 @@
+// Package tracker contains the pure logic for importing issues exported
+// from legacy trackers (Jira, Linear) as orc shipments and tasks.
+package tracker
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Supported export sources.
+const (
+	SourceJira   = "jira"
+	SourceLinear = "linear"
+)
+
+// Sources lists the supported export sources.
+var Sources = []string{SourceJira, SourceLinear}
+
+// SourceName returns the display name of a source ("Jira", "Linear").
+func SourceName(source string) string {
+	switch source {
+	case SourceJira:
+		return "Jira"
+	case SourceLinear:
+		return "Linear"
+	}
+	return source
+}
+
+// Issue is one row of a tracker export.
+type Issue struct {
+	Key         string // PROJ-123 (Jira) or ENG-42 (Linear)
+	Summary     string
+	Type        string // Issue type; empty for Linear
+	Status      string
+	Description string
+	Labels      []string
+	Parent      string // Parent issue key (epic, story, or parent issue)
+	Project     string // Linear project; empty for Jira
+	Points      int
+}
+
+// columns lists the header names each field is read from, per source.
+// Jira repeats some headers (one Labels column per label).
+var columns = map[string]map[string][]string{
+	SourceJira: {
+		"key":         {"Issue key"},
+		"id":          {"Issue id"},
+		"summary":     {"Summary"},
+		"type":        {"Issue Type"},
+		"status":      {"Status"},
+		"description": {"Description"},
+		"labels":      {"Labels"},
+		"parent":      {"Parent key", "Parent", "Parent id", "Custom field (Epic Link)", "Epic Link"},
+		"points":      {"Custom field (Story Points)", "Story Points", "Custom field (Story point estimate)", "Story point estimate"},
+	},
+	SourceLinear: {
+		"key":         {"ID"},
+		"summary":     {"Title"},
+		"status":      {"Status"},
+		"description": {"Description"},
+		"labels":      {"Labels"},
+		"parent":      {"Parent issue", "Parent"},
+		"project":     {"Project"},
+		"points":      {"Estimate"},
+	},
+}
+
+// columnOrder is the order Columns lists fields in.
+var columnOrder = []string{"key", "summary", "type", "status", "description", "labels", "project", "parent", "points"}
+
+// Columns returns the header each field of a source's export is read from
+// (the first choice where several are accepted).
+func Columns(source string) []string {
+	var headers []string
+	for _, field := range columnOrder {
+		if names := columns[source][field]; len(names) > 0 {
+			headers = append(headers, names[0])
+		}
+	}
+	return headers
+}
+
+// ParseCSV reads a tracker's CSV export. Rows without a key are skipped.
+// Jira parents given as numeric issue IDs are resolved to keys.
+func ParseCSV(r io.Reader, source string) ([]Issue, error) {
+	cols, ok := columns[source]
+	if !ok {
+		return nil, fmt.Errorf("unknown source %q (supported: %s, %s)", source, SourceJira, SourceLinear)
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff") // Excel-style BOM
+	}
+
+	index := make(map[string][]int)
+	for field, names := range cols {
+		for _, name := range names {
+			for i, h := range header {
+				if strings.EqualFold(strings.TrimSpace(h), name) {
+					index[field] = append(index[field], i)
+				}
+			}
+		}
+	}
+	if len(index["key"]) == 0 {
+		return nil, fmt.Errorf("CSV has no %q column; is this a %s export?", cols["key"][0], SourceName(source))
+	}
+
+	var issues []Issue
+	keysByID := make(map[string]string)
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		issue := Issue{
+			Key:         first(row, index["key"]),
+			Summary:     first(row, index["summary"]),
+			Type:        first(row, index["type"]),
+			Status:      first(row, index["status"]),
+			Description: first(row, index["description"]),
+			Parent:      first(row, index["parent"]),
+			Project:     first(row, index["project"]),
+		}
+		if issue.Key == "" {
+			continue
+		}
+		if id := first(row, index["id"]); id != "" {
+			keysByID[id] = issue.Key
+		}
+		for _, i := range index["labels"] {
+			if i < len(row) {
+				issue.Labels = append(issue.Labels, splitLabels(row[i])...)
+			}
+		}
+		if p := first(row, index["points"]); p != "" {
+			f, err := strconv.ParseFloat(p, 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid estimate %q for %s", line, p, issue.Key)
+			}
+			issue.Points = int(math.Round(f))
+		}
+		issues = append(issues, issue)
+	}
+
+	for i := range issues {
+		if key, ok := keysByID[issues[i].Parent]; ok {
+			issues[i].Parent = key
+		}
+	}
+	return issues, nil
+}
+
+// first returns the first non-empty value among the given columns.
+func first(row []string, indexes []int) string {
+	for _, i := range indexes {
+		if i < len(row) {
+			if v := strings.TrimSpace(row[i]); v != "" {
+				return v
+			}
+		}
+	}
+	return ""
+}
+
+// splitLabels splits a label cell. Linear joins labels with commas; Jira
+// uses one column per label.
+func splitLabels(cell string) []string {
+	var labels []string
+	for _, l := range strings.Split(cell, ",") {
+		if l = strings.TrimSpace(l); l != "" {
+			labels = append(labels, l)
+		}
+	}
+	return labels
+}
@@ -0,0 +1,95 @@
+package tracker
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParseCSV_Jira(t *testing.T) {
+	export := "\ufeffSummary,Issue key,Issue id,Issue Type,Status,Labels,Labels,Parent id,Custom field (Story Points),Description\n" +
+		"Checkout v2,PAY-1,10001,Epic,In Progress,,,,,Rebuild checkout\n" +
+		"Card form,PAY-2,10002,Story,To Do,frontend,Needs Design,10001,2.5,\n" +
+		"\"Fix rounding, again\",PAY-3,10003,Bug,Done,backend,,,1,\"Multi\nline\"\n" +
+		",,,,,,,,,\n"
+
+	issues, err := ParseCSV(strings.NewReader(export), SourceJira)
+	if err != nil {
+		t.Fatalf("ParseCSV() error = %v", err)
+	}
+	if len(issues) != 3 {
+		t.Fatalf("got %d issues, want 3 (blank rows skipped)", len(issues))
+	}
+
+	story := issues[1]
+	if story.Key != "PAY-2" || story.Type != "Story" || story.Status != "To Do" {
+		t.Errorf("story = %+v", story)
+	}
+	if story.Parent != "PAY-1" {
+		t.Errorf("story.Parent = %q, want PAY-1 (resolved from issue id)", story.Parent)
+	}
+	if !slices.Equal(story.Labels, []string{"frontend", "Needs Design"}) {
+		t.Errorf("story.Labels = %v", story.Labels)
+	}
+	if story.Points != 3 {
+		t.Errorf("story.Points = %d, want 3 (rounded)", story.Points)
+	}
+
+	bug := issues[2]
+	if bug.Summary != "Fix rounding, again" || bug.Description != "Multi\nline" {
+		t.Errorf("bug = %+v", bug)
+	}
+}
+
+func TestParseCSV_Linear(t *testing.T) {
+	export := "ID,Title,Status,Labels,Project,Parent issue,Estimate\n" +
+		"ENG-1,Set up CI,Todo,\"infra, ci\",Platform,,\n" +
+		"ENG-2,Cache builds,Backlog,,Platform,ENG-1,2\n"
+
+	issues, err := ParseCSV(strings.NewReader(export), SourceLinear)
+	if err != nil {
+		t.Fatalf("ParseCSV() error = %v", err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("got %d issues, want 2", len(issues))
+	}
+	if !slices.Equal(issues[0].Labels, []string{"infra", "ci"}) {
+		t.Errorf("Labels = %v, want [infra ci]", issues[0].Labels)
+	}
+	if issues[1].Project != "Platform" || issues[1].Parent != "ENG-1" || issues[1].Points != 2 {
+		t.Errorf("issue = %+v", issues[1])
+	}
+}
+
+func TestParseCSV_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		export  string
+		wantErr string
+	}{
+		{"unknown source", "asana", "Key\n", "unknown source"},
+		{"wrong export", SourceJira, "ID,Title\nENG-1,x\n", "no \"Issue key\" column"},
+		{"bad estimate", SourceLinear, "ID,Estimate\nENG-1,lots\n", "invalid estimate"},
+		{"empty file", SourceJira, "", "failed to read CSV header"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseCSV(strings.NewReader(tt.export), tt.source)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseCSV() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestColumns(t *testing.T) {
+	want := []string{"ID", "Title", "Status", "Description", "Labels", "Project", "Parent issue", "Estimate"}
+	if got := Columns(SourceLinear); !slices.Equal(got, want) {
+		t.Errorf("Columns(linear) = %v, want %v", got, want)
+	}
+	if got := Columns("asana"); len(got) != 0 {
+		t.Errorf("Columns(asana) = %v, want none", got)
+	}
+}
@@ -0,0 +1,184 @@
+package tracker
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/example/orc/internal/core/yamlsubset"
+)
+
+// Import targets for issue types besides task types.
+const (
+	TypeShipment = "shipment" // The issue becomes a shipment (e.g. epics)
+	TypeSkip     = "skip"     // The issue is not imported
+)
+
+// TaskStatuses are the statuses an imported task can start in.
+var TaskStatuses = []string{"open", "in-progress", "closed"}
+
+// TaskTypes are the orc task types an issue type can map to.
+var TaskTypes = []string{"research", "implementation", "fix", "documentation", "maintenance"}
+
+// Mapping says how tracker values translate to orc. Keys are compared
+// case-insensitively.
+type Mapping struct {
+	Statuses map[string]string // Tracker status -> task status
+	Types    map[string]string // Tracker issue type -> task type, "shipment", or "skip"
+	Labels   map[string]string // Tracker label -> tag name
+}
+
+// DefaultMapping covers the stock Jira and Linear workflows.
+func DefaultMapping() Mapping {
+	return Mapping{
+		Statuses: map[string]string{
+			"backlog":                  "open",
+			"to do":                    "open",
+			"todo":                     "open",
+			"open":                     "open",
+			"selected for development": "open",
+			"triage":                   "open",
+			"in progress":              "in-progress",
+			"in review":                "in-progress",
+			"in development":           "in-progress",
+			"blocked":                  "in-progress",
+			"done":                     "closed",
+			"closed":                   "closed",
+			"resolved":                 "closed",
+			"canceled":                 "closed",
+			"cancelled":                "closed",
+			"duplicate":                "closed",
+			"won't do":                 "closed",
+		},
+		Types: map[string]string{
+			"epic":        TypeShipment,
+			"story":       "implementation",
+			"task":        "implementation",
+			"sub-task":    "implementation",
+			"subtask":     "implementation",
+			"improvement": "implementation",
+			"new feature": "implementation",
+			"bug":         "fix",
+			"spike":       "research",
+			"research":    "research",
+			"chore":       "maintenance",
+			"tech debt":   "maintenance",
+			"docs":        "documentation",
+		},
+		Labels: map[string]string{},
+	}
+}
+
+// Merge returns m with override's entries added or replacing m's.
+func (m Mapping) Merge(override Mapping) Mapping {
+	merged := Mapping{Statuses: map[string]string{}, Types: map[string]string{}, Labels: map[string]string{}}
+	for _, pair := range []struct{ dst, a, b map[string]string }{
+		{merged.Statuses, m.Statuses, override.Statuses},
+		{merged.Types, m.Types, override.Types},
+		{merged.Labels, m.Labels, override.Labels},
+	} {
+		for k, v := range pair.a {
+			pair.dst[k] = v
+		}
+		for k, v := range pair.b {
+			pair.dst[k] = v
+		}
+	}
+	return merged
+}
+
+// ParseMapping reads a mapping file: a YAML document with top-level
+// statuses, types, and labels sections of "key: value" pairs.
+//
+//	statuses:
+//	  "Ready for QA": in-progress
+//	types:
+//	  Epic: shipment
+//	  Test: skip
+//	labels:
+//	  backend: api
+//
+// The file is read with yamlsubset, so YAML beyond that subset (flow
+// collections, anchors, multi-line values, ...) is an error.
+func ParseMapping(data string) (Mapping, error) {
+	root, err := yamlsubset.Parse(data)
+	if err != nil {
+		return Mapping{}, err
+	}
+	if root.Kind != yamlsubset.Mapping {
+		return Mapping{}, root.Errorf("expected sections (statuses, types, labels), got a %s", root.Kind)
+	}
+
+	m := Mapping{Statuses: map[string]string{}, Types: map[string]string{}, Labels: map[string]string{}}
+	for _, sec := range root.Pairs {
+		var section map[string]string
+		switch sec.Key {
+		case "statuses":
+			section = m.Statuses
+		case "types":
+			section = m.Types
+		case "labels":
+			section = m.Labels
+		default:
+			return Mapping{}, fmt.Errorf("line %d: unknown section %q (expected statuses, types, or labels)", sec.Line, sec.Key)
+		}
+		if sec.Value.Empty() {
+			continue
+		}
+		if sec.Value.Kind != yamlsubset.Mapping {
+			return Mapping{}, sec.Value.Errorf("section %s: expected 'key: value' entries, got a %s", sec.Key, sec.Value.Kind)
+		}
+
+		for _, entry := range sec.Value.Pairs {
+			if entry.Value.Kind != yamlsubset.Scalar {
+				return Mapping{}, entry.Value.Errorf("%q: expected a value, got a %s", entry.Key, entry.Value.Kind)
+			}
+			if err := validateTarget(sec.Key, entry.Value.Value); err != nil {
+				return Mapping{}, fmt.Errorf("line %d: %w", entry.Line, err)
+			}
+			section[strings.ToLower(entry.Key)] = entry.Value.Value
+		}
+	}
+	return m, nil
+}
+
+var tagNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// validateTarget checks a mapping value against what its section allows.
+func validateTarget(section, value string) error {
+	switch section {
+	case "statuses":
+		if !slices.Contains(TaskStatuses, value) {
+			return fmt.Errorf("invalid status %q (expected one of %s)", value, strings.Join(TaskStatuses, ", "))
+		}
+	case "types":
+		if value != TypeShipment && value != TypeSkip && !slices.Contains(TaskTypes, value) {
+			return fmt.Errorf("invalid type %q (expected %s, %s, or %s)", value, TypeShipment, TypeSkip, strings.Join(TaskTypes, ", "))
+		}
+	case "labels":
+		if value != "" && !tagNamePattern.MatchString(value) {
+			return fmt.Errorf("invalid tag name %q (use lowercase letters, digits, and hyphens)", value)
+		}
+	}
+	return nil
+}
+
+// TagName turns a tracker label into a tag name: lowercase, with runs of
+// other characters collapsed to hyphens. Returns "" if nothing is left.
+func TagName(label string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(label) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+		} else {
+			hyphen = true
+		}
+	}
+	return b.String()
+}
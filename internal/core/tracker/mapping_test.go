@@ -0,0 +1,105 @@
+package tracker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseMapping(t *testing.T) {
+	data := `# team workflow
+statuses:
+  "Ready for QA": in-progress   # still being worked
+  Won't Fix: closed
+types:
+  Epic: shipment
+  Test: skip
+labels:
+  backend: api
+  wontfix: ""
+`
+	m, err := ParseMapping(data)
+	if err != nil {
+		t.Fatalf("ParseMapping() error = %v", err)
+	}
+	checks := []struct {
+		table map[string]string
+		key   string
+		want  string
+	}{
+		{m.Statuses, "ready for qa", "in-progress"},
+		{m.Statuses, "won't fix", "closed"},
+		{m.Types, "epic", TypeShipment},
+		{m.Types, "test", TypeSkip},
+		{m.Labels, "backend", "api"},
+		{m.Labels, "wontfix", ""},
+	}
+	for _, c := range checks {
+		if got, ok := c.table[c.key]; !ok || got != c.want {
+			t.Errorf("mapping[%q] = %q (present %v), want %q", c.key, got, ok, c.want)
+		}
+	}
+}
+
+func TestParseMapping_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"unknown section", "priorities:\n  High: urgent\n", "unknown section"},
+		{"entry outside section", "  Done: closed\n", "unexpected indentation"},
+		{"list section", "statuses:\n  - Done\n", "expected 'key: value' entries"},
+		{"flow mapping", "labels: {ui: frontend}\n", "flow collections"},
+		{"anchor", "types:\n  Bug: &fix fix\n", "anchors and aliases"},
+		{"invalid status", "statuses:\n  Done: finished\n", "invalid status"},
+		{"invalid type", "types:\n  Bug: defect\n", "invalid type"},
+		{"invalid tag", "labels:\n  ui: Front End\n", "invalid tag name"},
+		{"missing colon", "statuses:\n  Done closed\n", "expected 'key: value'"},
+		{"unterminated quote", "statuses:\n  \"Done: closed\n", "unterminated quote"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseMapping(tt.data)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseMapping() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMapping_Merge(t *testing.T) {
+	base := DefaultMapping()
+	merged := base.Merge(Mapping{Types: map[string]string{"story": "research", "test": TypeSkip}})
+
+	if merged.Types["story"] != "research" {
+		t.Errorf("story = %q, want override research", merged.Types["story"])
+	}
+	if merged.Types["bug"] != "fix" {
+		t.Errorf("bug = %q, want default fix", merged.Types["bug"])
+	}
+	if base.Types["story"] != "implementation" {
+		t.Error("Merge modified the receiver")
+	}
+}
+
+func TestTagName(t *testing.T) {
+	tests := []struct {
+		label string
+		want  string
+	}{
+		{"backend", "backend"},
+		{"Needs Design", "needs-design"},
+		{"  UI/UX!! ", "ui-ux"},
+		{"v2.1", "v2-1"},
+		{"***", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.label, func(t *testing.T) {
+			if got := TagName(tt.label); got != tt.want {
+				t.Errorf("TagName(%q) = %q, want %q", tt.label, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package tracker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/example/orc/internal/core/alias"
+)
+
+// FallbackShipmentKey identifies the shipment that collects issues with no
+// epic or project.
+const FallbackShipmentKey = ""
+
+// PlannedShipment is a shipment the import will create.
+type PlannedShipment struct {
+	Key         string // Source epic key, "project:<name>" for Linear projects, or FallbackShipmentKey
+	Alias       string // Slug recording where the shipment came from; empty if none fits
+	Title       string
+	Description string
+	Closed      bool // The epic itself is done
+}
+
+// PlannedTask is a task the import will create.
+type PlannedTask struct {
+	Key         string
+	Alias       string // Lowercase key (e.g. pay-123); empty if the key is not a valid slug
+	Title       string
+	Description string
+	Type        string // Empty if the issue type is unmapped
+	Status      string
+	Tag         string // Empty if no label maps to a tag
+	Points      int
+	ShipmentKey string
+}
+
+// ValueCount reports how one tracker value was mapped.
+type ValueCount struct {
+	From     string
+	To       string
+	Count    int
+	Unmapped bool // No mapping entry; a fallback was used
+}
+
+// Plan is what an import will do, computed before anything is written.
+type Plan struct {
+	Source    string
+	Shipments []PlannedShipment
+	Tasks     []PlannedTask
+	Skipped   []string // Keys of issues whose type maps to skip
+	Statuses  []ValueCount
+	Types     []ValueCount
+	Tags      []string // Tag names the tasks use
+}
+
+// BuildPlan decides which issues become shipments and which become tasks,
+// and how their fields map.
+// Rules:
+// - issues whose type maps to "shipment" become shipments (Jira epics by default)
+// - Linear projects become shipments
+// - other issues become tasks in the shipment of their nearest shipment
+// ancestor, else their project's, else a fallback shipment
+// - unmapped statuses start as open; unmapped types leave the task untyped
+// - a task is tagged with its first label that maps to a tag
+func BuildPlan(issues []Issue, source string, m Mapping) *Plan {
+	plan := &Plan{Source: source}
+	byKey := make(map[string]Issue, len(issues))
+	for _, is := range issues {
+		byKey[is.Key] = is
+	}
+
+	statusCounts := map[string]*ValueCount{}
+	typeCounts := map[string]*ValueCount{}
+	count := func(counts map[string]*ValueCount, from, to string, unmapped bool) {
+		if c, ok := counts[from]; ok {
+			c.Count++
+			return
+		}
+		counts[from] = &ValueCount{From: from, To: to, Count: 1, Unmapped: unmapped}
+	}
+
+	shipmentKeys := map[string]bool{}
+	projects := map[string]bool{}
+	for _, is := range issues {
+		target, known := lookup(m.Types, is.Type)
+		if is.Type != "" {
+			count(typeCounts, is.Type, target, !known)
+		}
+		if target == TypeShipment {
+			status, _ := mapStatus(m, is.Status)
+			plan.Shipments = append(plan.Shipments, PlannedShipment{
+				Key:         is.Key,
+				Alias:       ProvenanceAlias(source, is.Key),
+				Title:       is.Summary,
+				Description: provenance(is.Description, source, is.Key),
+				Closed:      status == "closed",
+			})
+			shipmentKeys[is.Key] = true
+		}
+	}
+
+	needFallback := false
+	tags := map[string]bool{}
+	for _, is := range issues {
+		target, _ := lookup(m.Types, is.Type)
+		switch target {
+		case TypeShipment:
+			continue
+		case TypeSkip:
+			plan.Skipped = append(plan.Skipped, is.Key)
+			continue
+		}
+
+		status, known := mapStatus(m, is.Status)
+		count(statusCounts, is.Status, status, !known)
+
+		task := PlannedTask{
+			Key:         is.Key,
+			Alias:       ProvenanceAlias(source, is.Key),
+			Title:       is.Summary,
+			Description: provenance(is.Description, source, is.Key),
+			Type:        target,
+			Status:      status,
+			Tag:         tagFor(m, is.Labels),
+			Points:      is.Points,
+			ShipmentKey: shipmentFor(is, byKey, shipmentKeys),
+		}
+		if task.ShipmentKey == FallbackShipmentKey && is.Project != "" {
+			task.ShipmentKey = "project:" + is.Project
+			if !projects[is.Project] {
+				projects[is.Project] = true
+				plan.Shipments = append(plan.Shipments, PlannedShipment{
+					Key:         task.ShipmentKey,
+					Alias:       ProvenanceAlias(source, task.ShipmentKey),
+					Title:       is.Project,
+					Description: fmt.Sprintf("Imported from %s project %s.", SourceName(source), is.Project),
+				})
+			}
+		}
+		if task.ShipmentKey == FallbackShipmentKey {
+			needFallback = true
+		}
+		if task.Tag != "" {
+			tags[task.Tag] = true
+		}
+		plan.Tasks = append(plan.Tasks, task)
+	}
+
+	if needFallback {
+		plan.Shipments = append(plan.Shipments, PlannedShipment{
+			Key:         FallbackShipmentKey,
+			Alias:       ProvenanceAlias(source, FallbackShipmentKey),
+			Title:       "Imported from " + SourceName(source),
+			Description: fmt.Sprintf("Issues imported from %s without an epic or project.", SourceName(source)),
+		})
+	}
+
+	plan.Statuses = sortedCounts(statusCounts)
+	plan.Types = sortedCounts(typeCounts)
+	for t := range tags {
+		plan.Tags = append(plan.Tags, t)
+	}
+	sort.Strings(plan.Tags)
+	return plan
+}
+
+// ProvenanceAlias returns the alias that marks an entity as imported from
+// key, so a repeated import can find it: the lowercase issue key,
+// <source>-<project> for project shipments, or <source>-import for the
+// fallback shipment. Returns "" if the result is not a valid alias.
+func ProvenanceAlias(source, key string) string {
+	var slug string
+	switch {
+	case key == FallbackShipmentKey:
+		slug = source + "-import"
+	case strings.HasPrefix(key, "project:"):
+		slug = source + "-" + TagName(strings.TrimPrefix(key, "project:"))
+	default:
+		slug = strings.ToLower(key)
+	}
+	if !alias.IsSlug(slug) {
+		return ""
+	}
+	return slug
+}
+
+// shipmentFor walks up an issue's parents to the nearest shipment.
+func shipmentFor(is Issue, byKey map[string]Issue, shipmentKeys map[string]bool) string {
+	seen := map[string]bool{is.Key: true}
+	for parent := is.Parent; parent != "" && !seen[parent]; {
+		if shipmentKeys[parent] {
+			return parent
+		}
+		seen[parent] = true
+		next, ok := byKey[parent]
+		if !ok {
+			break
+		}
+		parent = next.Parent
+	}
+	return FallbackShipmentKey
+}
+
+func mapStatus(m Mapping, status string) (string, bool) {
+	if to, ok := lookup(m.Statuses, status); ok {
+		return to, true
+	}
+	return "open", false
+}
+
+func tagFor(m Mapping, labels []string) string {
+	for _, l := range labels {
+		if tag, ok := lookup(m.Labels, l); ok {
+			if tag != "" {
+				return tag
+			}
+			continue // mapped to "" to ignore it
+		}
+		if tag := TagName(l); tag != "" {
+			return tag
+		}
+	}
+	return ""
+}
+
+func lookup(table map[string]string, key string) (string, bool) {
+	v, ok := table[strings.ToLower(strings.TrimSpace(key))]
+	return v, ok
+}
+
+// provenance appends a reference to the original issue.
+func provenance(description, source, key string) string {
+	ref := fmt.Sprintf("Imported from %s %s.", SourceName(source), key)
+	if description == "" {
+		return ref
+	}
+	return description + "\n\n" + ref
+}
+
+func sortedCounts(counts map[string]*ValueCount) []ValueCount {
+	result := make([]ValueCount, 0, len(counts))
+	for _, c := range counts {
+		result = append(result, *c)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].From < result[j].From
+	})
+	return result
+}
@@ -0,0 +1,151 @@
+package tracker
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestBuildPlan_Jira(t *testing.T) {
+	issues := []Issue{
+		{Key: "PAY-1", Summary: "Checkout v2", Type: "Epic", Status: "Done"},
+		{Key: "PAY-2", Summary: "Card form", Type: "Story", Status: "In Progress", Parent: "PAY-1", Labels: []string{"Needs Design", "frontend"}},
+		{Key: "PAY-3", Summary: "Card form subtask", Type: "Sub-task", Status: "Ready for QA", Parent: "PAY-2"},
+		{Key: "PAY-4", Summary: "Rounding", Type: "Bug", Status: "To Do", Description: "Off by a cent", Labels: []string{"backend"}},
+		{Key: "PAY-5", Summary: "Load test", Type: "Test", Status: "To Do"},
+		{Key: "PAY-6", Summary: "Mystery", Type: "Incident", Status: "To Do"},
+	}
+	m := DefaultMapping().Merge(Mapping{
+		Types:  map[string]string{"test": TypeSkip},
+		Labels: map[string]string{"needs design": "", "backend": "api"},
+	})
+
+	plan := BuildPlan(issues, SourceJira, m)
+
+	if len(plan.Shipments) != 2 {
+		t.Fatalf("got %d shipments, want epic + fallback: %+v", len(plan.Shipments), plan.Shipments)
+	}
+	if s := plan.Shipments[0]; s.Key != "PAY-1" || !s.Closed || !strings.Contains(s.Description, "Imported from Jira PAY-1.") {
+		t.Errorf("epic shipment = %+v", s)
+	}
+	if s := plan.Shipments[1]; s.Key != FallbackShipmentKey || s.Title != "Imported from Jira" {
+		t.Errorf("fallback shipment = %+v", s)
+	}
+	if !slices.Equal(plan.Skipped, []string{"PAY-5"}) {
+		t.Errorf("Skipped = %v, want [PAY-5]", plan.Skipped)
+	}
+
+	tasks := map[string]PlannedTask{}
+	for _, task := range plan.Tasks {
+		tasks[task.Key] = task
+	}
+	if len(tasks) != 4 {
+		t.Fatalf("got %d tasks, want 4", len(tasks))
+	}
+
+	tests := []struct {
+		key      string
+		shipment string
+		typ      string
+		status   string
+		tag      string
+	}{
+		{"PAY-2", "PAY-1", "implementation", "in-progress", "frontend"},
+		{"PAY-3", "PAY-1", "implementation", "open", ""},
+		{"PAY-4", FallbackShipmentKey, "fix", "open", "api"},
+		{"PAY-6", FallbackShipmentKey, "", "open", ""},
+	}
+	for _, tt := range tests {
+		got := tasks[tt.key]
+		if got.ShipmentKey != tt.shipment || got.Type != tt.typ || got.Status != tt.status || got.Tag != tt.tag {
+			t.Errorf("%s = {shipment %q type %q status %q tag %q}, want {%q %q %q %q}",
+				tt.key, got.ShipmentKey, got.Type, got.Status, got.Tag, tt.shipment, tt.typ, tt.status, tt.tag)
+		}
+	}
+	if d := tasks["PAY-4"].Description; d != "Off by a cent\n\nImported from Jira PAY-4." {
+		t.Errorf("PAY-4 description = %q", d)
+	}
+
+	if !slices.Equal(plan.Tags, []string{"api", "frontend"}) {
+		t.Errorf("Tags = %v, want [api frontend]", plan.Tags)
+	}
+
+	var unmappedStatus *ValueCount
+	for i := range plan.Statuses {
+		if plan.Statuses[i].From == "Ready for QA" {
+			unmappedStatus = &plan.Statuses[i]
+		}
+	}
+	if unmappedStatus == nil || !unmappedStatus.Unmapped || unmappedStatus.To != "open" {
+		t.Errorf("Ready for QA count = %+v, want unmapped -> open", unmappedStatus)
+	}
+	if plan.Statuses[0].From != "To Do" || plan.Statuses[0].Count != 2 {
+		t.Errorf("Statuses[0] = %+v, want most common first (To Do x2)", plan.Statuses[0])
+	}
+}
+
+func TestBuildPlan_LinearProjects(t *testing.T) {
+	issues := []Issue{
+		{Key: "ENG-1", Summary: "Set up CI", Status: "Todo", Project: "Platform"},
+		{Key: "ENG-2", Summary: "Cache builds", Status: "Backlog", Project: "Platform", Parent: "ENG-1"},
+		{Key: "ENG-3", Summary: "Loose end", Status: "Done"},
+	}
+
+	plan := BuildPlan(issues, SourceLinear, DefaultMapping())
+
+	if len(plan.Shipments) != 2 {
+		t.Fatalf("got %d shipments, want project + fallback: %+v", len(plan.Shipments), plan.Shipments)
+	}
+	if s := plan.Shipments[0]; s.Key != "project:Platform" || s.Title != "Platform" {
+		t.Errorf("project shipment = %+v", s)
+	}
+	for _, task := range plan.Tasks[:2] {
+		if task.ShipmentKey != "project:Platform" {
+			t.Errorf("%s shipment = %q, want project:Platform", task.Key, task.ShipmentKey)
+		}
+	}
+	if plan.Tasks[2].ShipmentKey != FallbackShipmentKey || plan.Tasks[2].Status != "closed" {
+		t.Errorf("ENG-3 = %+v", plan.Tasks[2])
+	}
+	if len(plan.Types) != 0 {
+		t.Errorf("Types = %v, want none (Linear has no issue types)", plan.Types)
+	}
+}
+
+func TestBuildPlan_ParentCycle(t *testing.T) {
+	issues := []Issue{
+		{Key: "A-1", Summary: "a", Type: "Task", Parent: "A-2"},
+		{Key: "A-2", Summary: "b", Type: "Task", Parent: "A-1"},
+	}
+
+	plan := BuildPlan(issues, SourceJira, DefaultMapping())
+
+	for _, task := range plan.Tasks {
+		if task.ShipmentKey != FallbackShipmentKey {
+			t.Errorf("%s shipment = %q, want fallback", task.Key, task.ShipmentKey)
+		}
+	}
+}
+
+func TestProvenanceAlias(t *testing.T) {
+	tests := []struct {
+		source string
+		key    string
+		want   string
+	}{
+		{SourceJira, "PAY-123", "pay-123"},
+		{SourceLinear, "project:Mobile App", "linear-mobile-app"},
+		{SourceJira, FallbackShipmentKey, "jira-import"},
+		{SourceJira, "123", ""},
+		{SourceJira, "PAY_1", ""},
+		{SourceLinear, "project:" + strings.Repeat("x", 40), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := ProvenanceAlias(tt.source, tt.key); got != tt.want {
+				t.Errorf("ProvenanceAlias(%q, %q) = %q, want %q", tt.source, tt.key, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Package triage contains the pure business logic for triaging bug notes:
+// severity levels, triage states, and the order bugs are worked through.
+package triage
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity levels, most urgent first.
+const (
+	SeverityP0 = "P0"
+	SeverityP1 = "P1"
+	SeverityP2 = "P2"
+	SeverityP3 = "P3"
+)
+
+// Triage states. Bug notes start untriaged; notes of other types have none.
+const (
+	StatusUntriaged = "untriaged"
+	StatusAccepted  = "accepted"
+	StatusNeedsInfo = "needs_info"
+	StatusWontFix   = "wont_fix"
+)
+
+var severities = []string{SeverityP0, SeverityP1, SeverityP2, SeverityP3}
+
+var statuses = []string{StatusUntriaged, StatusAccepted, StatusNeedsInfo, StatusWontFix}
+
+// GuardResult represents the outcome of a guard evaluation.
+type GuardResult struct {
+	Allowed bool
+	Reason  string
+}
+
+// Error converts the guard result to an error if not allowed.
+func (r GuardResult) Error() error {
+	if r.Allowed {
+		return nil
+	}
+	return fmt.Errorf("%s", r.Reason)
+}
+
+// NormalizeSeverity returns the canonical form of a severity ("p1" -> "P1")
+// and whether it is a known level.
+func NormalizeSeverity(severity string) (string, bool) {
+	s := strings.ToUpper(strings.TrimSpace(severity))
+	for _, known := range severities {
+		if s == known {
+			return s, true
+		}
+	}
+	return s, false
+}
+
+// ValidStatus returns true if status is a known triage state.
+func ValidStatus(status string) bool {
+	for _, known := range statuses {
+		if status == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Untriaged returns true if a bug with this triage state still needs triage.
+// Bugs recorded before triage states existed have none and count as untriaged.
+func Untriaged(status string) bool {
+	return status == "" || status == StatusUntriaged
+}
+
+// Urgent returns true for untriaged P0 and P1 bugs, which summary surfaces
+// ahead of everything else.
+func Urgent(severity, status string) bool {
+	return Untriaged(status) && (severity == SeverityP0 || severity == SeverityP1)
+}
+
+// Rank orders severities from 0 (P0) to 3 (P3); bugs without a severity rank last.
+func Rank(severity string) int {
+	for i, known := range severities {
+		if severity == known {
+			return i
+		}
+	}
+	return len(severities)
+}
+
+// Item is what the triage queue is ordered by.
+type Item struct {
+	Severity  string
+	CreatedAt string // RFC3339, so lexical order is chronological
+}
+
+// Compare orders the triage queue: most severe first, then oldest first.
+func Compare(a, b Item) int {
+	if ra, rb := Rank(a.Severity), Rank(b.Severity); ra != rb {
+		return ra - rb
+	}
+	return strings.Compare(a.CreatedAt, b.CreatedAt)
+}
+
+// CreateContext provides context for setting a severity on a new note.
+type CreateContext struct {
+	NoteType string
+	Severity string // Empty when no severity was given
+}
+
+// CanCreate evaluates whether a note can be created with the given severity.
+// Rules:
+// - Notes without a severity can always be created
+// - Only bug notes take a severity
+// - The severity must be P0, P1, P2, or P3
+func CanCreate(ctx CreateContext) GuardResult {
+	if ctx.Severity == "" {
+		return GuardResult{Allowed: true}
+	}
+	if ctx.NoteType != "bug" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  "only bug notes have a severity",
+		}
+	}
+	if _, ok := NormalizeSeverity(ctx.Severity); !ok {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("invalid severity %q: must be one of P0, P1, P2, P3", ctx.Severity),
+		}
+	}
+	return GuardResult{Allowed: true}
+}
+
+// TriageContext provides context for triaging a note.
+type TriageContext struct {
+	NoteID          string
+	NoteType        string
+	NoteStatus      string // open, in_flight, resolved, closed
+	CurrentSeverity string
+	Severity        string // New severity; empty keeps the current one
+	Status          string // New triage state
+}
+
+// CanTriage evaluates whether a note can be triaged.
+// Rules:
+// - Only bug notes can be triaged
+// - Closed notes cannot be triaged
+// - The severity, if given, must be P0, P1, P2, or P3
+// - The triage state must be untriaged, accepted, needs_info, or wont_fix
+// - Accepting a bug requires a severity, given now or already set
+func CanTriage(ctx TriageContext) GuardResult {
+	if ctx.NoteType != "bug" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot triage %s: only bug notes are triaged (type is %q)", ctx.NoteID, ctx.NoteType),
+		}
+	}
+	if ctx.NoteStatus == "closed" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot triage %s: note is closed", ctx.NoteID),
+		}
+	}
+	if ctx.Severity != "" {
+		if _, ok := NormalizeSeverity(ctx.Severity); !ok {
+			return GuardResult{
+				Allowed: false,
+				Reason:  fmt.Sprintf("invalid severity %q: must be one of P0, P1, P2, P3", ctx.Severity),
+			}
+		}
+	}
+	if !ValidStatus(ctx.Status) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("invalid triage state %q: must be one of untriaged, accepted, needs_info, wont_fix", ctx.Status),
+		}
+	}
+	if ctx.Status == StatusAccepted && ctx.Severity == "" && ctx.CurrentSeverity == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot accept %s without a severity: give one of P0, P1, P2, P3", ctx.NoteID),
+		}
+	}
+	return GuardResult{Allowed: true}
+}
@@ -0,0 +1,179 @@
+package triage
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNormalizeSeverity(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   string
+		wantOK bool
+	}{
+		{"P0", "P0", true},
+		{"p2", "P2", true},
+		{" P3 ", "P3", true},
+		{"P4", "P4", false},
+		{"high", "HIGH", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, ok := NormalizeSeverity(tt.in)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("NormalizeSeverity(%q) = %q, %v, want %q, %v", tt.in, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestUrgent(t *testing.T) {
+	tests := []struct {
+		severity string
+		status   string
+		want     bool
+	}{
+		{SeverityP0, StatusUntriaged, true},
+		{SeverityP1, "", true},
+		{SeverityP2, StatusUntriaged, false},
+		{"", StatusUntriaged, false},
+		{SeverityP0, StatusAccepted, false},
+		{SeverityP1, StatusNeedsInfo, false},
+	}
+	for _, tt := range tests {
+		if got := Urgent(tt.severity, tt.status); got != tt.want {
+			t.Errorf("Urgent(%q, %q) = %v, want %v", tt.severity, tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	items := []Item{
+		{Severity: "", CreatedAt: "2026-01-01T00:00:00Z"},
+		{Severity: SeverityP2, CreatedAt: "2026-03-01T00:00:00Z"},
+		{Severity: SeverityP0, CreatedAt: "2026-05-01T00:00:00Z"},
+		{Severity: SeverityP2, CreatedAt: "2026-02-01T00:00:00Z"},
+		{Severity: SeverityP0, CreatedAt: "2026-04-01T00:00:00Z"},
+	}
+	slices.SortFunc(items, Compare)
+
+	want := []Item{
+		{Severity: SeverityP0, CreatedAt: "2026-04-01T00:00:00Z"},
+		{Severity: SeverityP0, CreatedAt: "2026-05-01T00:00:00Z"},
+		{Severity: SeverityP2, CreatedAt: "2026-02-01T00:00:00Z"},
+		{Severity: SeverityP2, CreatedAt: "2026-03-01T00:00:00Z"},
+		{Severity: "", CreatedAt: "2026-01-01T00:00:00Z"},
+	}
+	if !slices.Equal(items, want) {
+		t.Errorf("sorted = %v, want %v", items, want)
+	}
+}
+
+func TestCanCreate(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         CreateContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "no severity",
+			ctx:         CreateContext{NoteType: "learning"},
+			wantAllowed: true,
+		},
+		{
+			name:        "bug with severity",
+			ctx:         CreateContext{NoteType: "bug", Severity: "p1"},
+			wantAllowed: true,
+		},
+		{
+			name:        "severity on a non-bug",
+			ctx:         CreateContext{NoteType: "concern", Severity: "P1"},
+			wantAllowed: false,
+			wantReason:  "only bug notes have a severity",
+		},
+		{
+			name:        "unknown severity",
+			ctx:         CreateContext{NoteType: "bug", Severity: "P9"},
+			wantAllowed: false,
+			wantReason:  `invalid severity "P9": must be one of P0, P1, P2, P3`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanCreate(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanTriage(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         TriageContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "accept with severity",
+			ctx:         TriageContext{NoteID: "NOTE-001", NoteType: "bug", NoteStatus: "open", Severity: "P1", Status: StatusAccepted},
+			wantAllowed: true,
+		},
+		{
+			name:        "accept keeping current severity",
+			ctx:         TriageContext{NoteID: "NOTE-001", NoteType: "bug", NoteStatus: "open", CurrentSeverity: "P2", Status: StatusAccepted},
+			wantAllowed: true,
+		},
+		{
+			name:        "needs info without severity",
+			ctx:         TriageContext{NoteID: "NOTE-001", NoteType: "bug", NoteStatus: "open", Status: StatusNeedsInfo},
+			wantAllowed: true,
+		},
+		{
+			name:        "not a bug",
+			ctx:         TriageContext{NoteID: "NOTE-001", NoteType: "idea", NoteStatus: "open", Status: StatusAccepted},
+			wantAllowed: false,
+			wantReason:  `cannot triage NOTE-001: only bug notes are triaged (type is "idea")`,
+		},
+		{
+			name:        "closed",
+			ctx:         TriageContext{NoteID: "NOTE-001", NoteType: "bug", NoteStatus: "closed", Severity: "P1", Status: StatusAccepted},
+			wantAllowed: false,
+			wantReason:  "cannot triage NOTE-001: note is closed",
+		},
+		{
+			name:        "unknown severity",
+			ctx:         TriageContext{NoteID: "NOTE-001", NoteType: "bug", NoteStatus: "open", Severity: "urgent", Status: StatusAccepted},
+			wantAllowed: false,
+			wantReason:  `invalid severity "urgent": must be one of P0, P1, P2, P3`,
+		},
+		{
+			name:        "unknown state",
+			ctx:         TriageContext{NoteID: "NOTE-001", NoteType: "bug", NoteStatus: "open", Severity: "P1", Status: "done"},
+			wantAllowed: false,
+			wantReason:  `invalid triage state "done": must be one of untriaged, accepted, needs_info, wont_fix`,
+		},
+		{
+			name:        "accept without any severity",
+			ctx:         TriageContext{NoteID: "NOTE-001", NoteType: "bug", NoteStatus: "open", Status: StatusAccepted},
+			wantAllowed: false,
+			wantReason:  "cannot accept NOTE-001 without a severity: give one of P0, P1, P2, P3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanTriage(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
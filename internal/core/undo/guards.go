@@ -0,0 +1,121 @@
+// Package undo contains the pure business logic for reverting logged mutations.
+// Guards are pure functions that evaluate preconditions without side effects.
+package undo
+
+import (
+	"fmt"
+	"sort"
+)
+
+// GuardResult represents the outcome of a guard evaluation.
+type GuardResult struct {
+	Allowed bool
+	Reason  string
+}
+
+// Error converts the guard result to an error if not allowed.
+func (r GuardResult) Error() error {
+	if r.Allowed {
+		return nil
+	}
+	return fmt.Errorf("%s", r.Reason)
+}
+
+// undoableFields lists the "entity_type.field_name" log keys that have a safe inverse.
+// Each inverse simply writes the logged old value back.
+var undoableFields = map[string]bool{
+	"task.status":                    true,
+	"task.tag":                       true,
+	"shipment.status":                true,
+	"shipment.assigned_workbench_id": true,
+	"note.status":                    true,
+}
+
+// FieldKey builds the "entity_type.field_name" key used to identify undoable changes.
+func FieldKey(entityType, fieldName string) string {
+	return entityType + "." + fieldName
+}
+
+// IsUndoable returns true if changes to the field have a safe inverse.
+func IsUndoable(entityType, fieldName string) bool {
+	return undoableFields[FieldKey(entityType, fieldName)]
+}
+
+// UndoableFields returns the sorted list of undoable field keys.
+func UndoableFields() []string {
+	fields := make([]string, 0, len(undoableFields))
+	for f := range undoableFields {
+		fields = append(fields, f)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// UndoContext provides context for undo guards.
+type UndoContext struct {
+	LogID          string
+	EntityType     string
+	EntityID       string
+	FieldName      string
+	LoggedActorID  string // Actor that made the original change
+	ActorID        string // Actor requesting the undo
+	AlreadyUndone  bool
+	EntityExists   bool
+	LoggedNewValue string // Value the change set
+	CurrentValue   string // Value the entity has now
+}
+
+// CanUndo evaluates whether a logged change can be reverted.
+// Rules:
+// - Field must have a safe inverse
+// - Only the actor that made the change can undo it
+// - A change can only be undone once
+// - Entity must still exist
+// - Entity must still hold the value the change set (nothing changed since)
+func CanUndo(ctx UndoContext) GuardResult {
+	if !IsUndoable(ctx.EntityType, ctx.FieldName) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot undo %s: %s changes have no safe inverse", ctx.LogID, FieldKey(ctx.EntityType, ctx.FieldName)),
+		}
+	}
+
+	if ctx.ActorID == "" || ctx.LoggedActorID != ctx.ActorID {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot undo %s: change was made by %s, not by the current actor", ctx.LogID, displayValue(ctx.LoggedActorID)),
+		}
+	}
+
+	if ctx.AlreadyUndone {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot undo %s: already undone", ctx.LogID),
+		}
+	}
+
+	if !ctx.EntityExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("cannot undo %s: %s %s no longer exists", ctx.LogID, ctx.EntityType, ctx.EntityID),
+		}
+	}
+
+	if ctx.CurrentValue != ctx.LoggedNewValue {
+		return GuardResult{
+			Allowed: false,
+			Reason: fmt.Sprintf("cannot undo %s: %s %s %s changed since (now %s)",
+				ctx.LogID, ctx.EntityType, ctx.EntityID, ctx.FieldName, displayValue(ctx.CurrentValue)),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// displayValue renders empty values readably in messages.
+func displayValue(v string) string {
+	if v == "" {
+		return "(none)"
+	}
+	return v
+}
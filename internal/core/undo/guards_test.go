@@ -0,0 +1,129 @@
+package undo
+
+import (
+	"testing"
+)
+
+func TestIsUndoable(t *testing.T) {
+	tests := []struct {
+		entityType string
+		fieldName  string
+		want       bool
+	}{
+		{"task", "status", true},
+		{"task", "tag", true},
+		{"shipment", "status", true},
+		{"shipment", "assigned_workbench_id", true},
+		{"note", "status", true},
+		{"task", "description", false},
+		{"commission", "status", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(FieldKey(tt.entityType, tt.fieldName), func(t *testing.T) {
+			if got := IsUndoable(tt.entityType, tt.fieldName); got != tt.want {
+				t.Errorf("IsUndoable(%q, %q) = %v, want %v", tt.entityType, tt.fieldName, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUndoableFields(t *testing.T) {
+	fields := UndoableFields()
+	if len(fields) != 5 {
+		t.Fatalf("expected 5 undoable fields, got %d", len(fields))
+	}
+	for i := 1; i < len(fields); i++ {
+		if fields[i-1] > fields[i] {
+			t.Errorf("expected sorted fields, got %v", fields)
+		}
+	}
+}
+
+func TestCanUndo(t *testing.T) {
+	base := UndoContext{
+		LogID:          "WL-0042",
+		EntityType:     "task",
+		EntityID:       "TASK-001",
+		FieldName:      "status",
+		LoggedActorID:  "IMP-BENCH-001",
+		ActorID:        "IMP-BENCH-001",
+		EntityExists:   true,
+		LoggedNewValue: "closed",
+		CurrentValue:   "closed",
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(c *UndoContext)
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can undo own unchanged mutation",
+			modify:      func(c *UndoContext) {},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot undo field without inverse",
+			modify:      func(c *UndoContext) { c.FieldName = "description" },
+			wantAllowed: false,
+			wantReason:  "cannot undo WL-0042: task.description changes have no safe inverse",
+		},
+		{
+			name:        "cannot undo another actor's change",
+			modify:      func(c *UndoContext) { c.ActorID = "IMP-BENCH-002" },
+			wantAllowed: false,
+			wantReason:  "cannot undo WL-0042: change was made by IMP-BENCH-001, not by the current actor",
+		},
+		{
+			name:        "cannot undo without actor",
+			modify:      func(c *UndoContext) { c.ActorID = "" },
+			wantAllowed: false,
+			wantReason:  "cannot undo WL-0042: change was made by IMP-BENCH-001, not by the current actor",
+		},
+		{
+			name:        "cannot undo twice",
+			modify:      func(c *UndoContext) { c.AlreadyUndone = true },
+			wantAllowed: false,
+			wantReason:  "cannot undo WL-0042: already undone",
+		},
+		{
+			name:        "cannot undo deleted entity",
+			modify:      func(c *UndoContext) { c.EntityExists = false },
+			wantAllowed: false,
+			wantReason:  "cannot undo WL-0042: task TASK-001 no longer exists",
+		},
+		{
+			name:        "cannot undo when value changed since",
+			modify:      func(c *UndoContext) { c.CurrentValue = "open" },
+			wantAllowed: false,
+			wantReason:  "cannot undo WL-0042: task TASK-001 status changed since (now open)",
+		},
+		{
+			name: "cannot undo when value cleared since",
+			modify: func(c *UndoContext) {
+				c.FieldName = "tag"
+				c.LoggedNewValue = "TAG-001"
+				c.CurrentValue = ""
+			},
+			wantAllowed: false,
+			wantReason:  "cannot undo WL-0042: task TASK-001 tag changed since (now (none))",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := base
+			tt.modify(&ctx)
+			result := CanUndo(ctx)
+
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("CanUndo() Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if result.Reason != tt.wantReason {
+				t.Errorf("CanUndo() Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
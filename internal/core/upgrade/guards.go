@@ -0,0 +1,85 @@
+// Package upgrade contains the pure business logic for self-updating orc.
+// Builds are identified by the commit they were built from; the newest
+// release is the head of the source checkout's upstream branch.
+package upgrade
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnknownCommit is the commit reported by binaries built without ldflags.
+const UnknownCommit = "unknown"
+
+// GuardResult represents the outcome of a guard evaluation.
+type GuardResult struct {
+	Allowed bool
+	Reason  string
+}
+
+// Error converts the guard result to an error if not allowed.
+func (r GuardResult) Error() error {
+	if r.Allowed {
+		return nil
+	}
+	return fmt.Errorf("%s", r.Reason)
+}
+
+// SameCommit reports whether two commit IDs name the same commit, allowing
+// either to be abbreviated (binaries record the short hash).
+func SameCommit(a, b string) bool {
+	if a == "" || b == "" || a == UnknownCommit || b == UnknownCommit {
+		return false
+	}
+	return strings.HasPrefix(a, b) || strings.HasPrefix(b, a)
+}
+
+// InstallContext provides context for upgrade installation guards.
+type InstallContext struct {
+	CurrentCommit string // Commit the running binary was built from
+	LatestCommit  string
+	IsAncestor    bool // Whether CurrentCommit is contained in LatestCommit's history
+	Force         bool
+}
+
+// CanInstall evaluates whether the latest release can replace the running binary.
+// Rules:
+// - The running binary must not already be the latest release
+// - Without force, the running binary's commit must be known (not a dev build)
+// - Without force, the running binary's commit must be an ancestor of the latest release
+func CanInstall(ctx InstallContext) GuardResult {
+	if SameCommit(ctx.CurrentCommit, ctx.LatestCommit) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("already up to date (%s)", ctx.CurrentCommit),
+		}
+	}
+
+	if ctx.Force {
+		return GuardResult{Allowed: true}
+	}
+
+	if ctx.CurrentCommit == "" || ctx.CurrentCommit == UnknownCommit {
+		return GuardResult{
+			Allowed: false,
+			Reason:  "running binary has no recorded commit (dev build). Use --force to replace it with the latest release",
+		}
+	}
+
+	if !ctx.IsAncestor {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("running binary (%s) is not behind the latest release (%s); it may be built from an unpushed branch. Use --force to replace it anyway", ctx.CurrentCommit, shortCommit(ctx.LatestCommit)),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// shortCommit abbreviates a commit ID for messages.
+func shortCommit(commit string) string {
+	if len(commit) > 7 {
+		return commit[:7]
+	}
+	return commit
+}
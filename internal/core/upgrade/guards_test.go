@@ -0,0 +1,74 @@
+package upgrade
+
+import "testing"
+
+func TestSameCommit(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"abc1234", "abc1234def5678", true},
+		{"abc1234def5678", "abc1234", true},
+		{"abc1234", "abd1234", false},
+		{"unknown", "unknown", false},
+		{"", "abc1234", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if got := SameCommit(tt.a, tt.b); got != tt.want {
+				t.Errorf("SameCommit(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanInstall(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         InstallContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can install newer release",
+			ctx:         InstallContext{CurrentCommit: "abc1234", LatestCommit: "def5678aaaa", IsAncestor: true},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot install when up to date",
+			ctx:         InstallContext{CurrentCommit: "abc1234", LatestCommit: "abc1234ffff", IsAncestor: true, Force: true},
+			wantAllowed: false,
+			wantReason:  "already up to date (abc1234)",
+		},
+		{
+			name:        "cannot replace dev build without force",
+			ctx:         InstallContext{CurrentCommit: "unknown", LatestCommit: "def5678aaaa"},
+			wantAllowed: false,
+			wantReason:  "running binary has no recorded commit (dev build). Use --force to replace it with the latest release",
+		},
+		{
+			name:        "can replace dev build with force",
+			ctx:         InstallContext{CurrentCommit: "unknown", LatestCommit: "def5678aaaa", Force: true},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot move sideways without force",
+			ctx:         InstallContext{CurrentCommit: "abc1234", LatestCommit: "def5678aaaa", IsAncestor: false},
+			wantAllowed: false,
+			wantReason:  "running binary (abc1234) is not behind the latest release (def5678); it may be built from an unpushed branch. Use --force to replace it anyway",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanInstall(tt.ctx)
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+			if !tt.wantAllowed && result.Reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
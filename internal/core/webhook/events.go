@@ -0,0 +1,276 @@
+// Package webhook contains the pure business logic for inbound webhooks:
+// verifying signatures, normalizing payloads from external systems into
+// events, and mapping events to ledger actions.
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Source kinds.
+const (
+	KindGitHub  = "github"  // GitHub webhooks (pull_request, pull_request_review, workflow_run)
+	KindGeneric = "generic" // Any system posting the generic JSON payload
+)
+
+// Normalized event types.
+const (
+	EventCIFailed   = "ci.failed"
+	EventCIPassed   = "ci.passed"
+	EventPROpened   = "pr.opened"
+	EventPRApproved = "pr.approved"
+	EventPRMerged   = "pr.merged"
+	EventPRClosed   = "pr.closed"
+)
+
+// Actions an event can be mapped to.
+const (
+	ActionBlock   = "block"   // Block the shipment's in-progress tasks
+	ActionUnblock = "unblock" // Resume the shipment's blocked tasks
+	ActionNote    = "note"    // Record the event as a note on the shipment
+	ActionPRSync  = "pr-sync" // Mirror a PR event onto the shipment's linked PR
+)
+
+var knownEvents = []string{EventCIFailed, EventCIPassed, EventPROpened, EventPRApproved, EventPRMerged, EventPRClosed}
+
+var knownActions = []string{ActionBlock, ActionUnblock, ActionNote, ActionPRSync}
+
+// Event is an external event normalized for mapping. The shipment is
+// identified by ID, by branch, or by PR number, in that order of preference.
+type Event struct {
+	Type       string
+	ShipmentID string
+	Branch     string
+	PRNumber   int
+	Title      string // Short subject, e.g. the workflow or PR title
+	URL        string
+	Detail     string
+}
+
+// Events returns the known event types.
+func Events() []string {
+	return append([]string(nil), knownEvents...)
+}
+
+// Actions returns the known actions.
+func Actions() []string {
+	return append([]string(nil), knownActions...)
+}
+
+// DefaultMappings returns the mappings used when a source is added without
+// any: CI failures block work and leave a note, CI passes resume it, and PR
+// events are mirrored onto the linked PR.
+func DefaultMappings() map[string][]string {
+	return map[string][]string{
+		EventCIFailed:   {ActionBlock, ActionNote},
+		EventCIPassed:   {ActionUnblock},
+		EventPROpened:   {ActionPRSync},
+		EventPRApproved: {ActionPRSync},
+		EventPRMerged:   {ActionPRSync},
+		EventPRClosed:   {ActionPRSync},
+	}
+}
+
+// ParseMapping parses a mapping spec of the form "ci.failed=block,note".
+func ParseMapping(spec string) (string, []string, error) {
+	event, list, ok := strings.Cut(spec, "=")
+	event = strings.TrimSpace(event)
+	if !ok || event == "" {
+		return "", nil, fmt.Errorf("invalid mapping %q (want event=action[,action])", spec)
+	}
+	var actions []string
+	for _, a := range strings.Split(list, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			actions = append(actions, a)
+		}
+	}
+	if len(actions) == 0 {
+		return "", nil, fmt.Errorf("mapping %q has no actions", spec)
+	}
+	return event, actions, nil
+}
+
+// FormatMappings renders mappings as sorted "event=action,action" specs.
+func FormatMappings(mappings map[string][]string) []string {
+	specs := make([]string, 0, len(mappings))
+	for event, actions := range mappings {
+		specs = append(specs, event+"="+strings.Join(actions, ","))
+	}
+	sort.Strings(specs)
+	return specs
+}
+
+// IsPREvent reports whether an event type is about a pull request.
+func IsPREvent(eventType string) bool {
+	return strings.HasPrefix(eventType, "pr.")
+}
+
+// NoteFor returns the title, content, and note type recorded for an event.
+func NoteFor(ev Event) (title, content, noteType string) {
+	noteType = "finding"
+	subject := ev.Title
+	if subject == "" {
+		subject = ev.Branch
+	}
+	switch ev.Type {
+	case EventCIFailed:
+		title = "CI failed: " + subject
+		noteType = "concern"
+	case EventCIPassed:
+		title = "CI passed: " + subject
+	default:
+		title = fmt.Sprintf("%s: %s", ev.Type, subject)
+	}
+
+	var parts []string
+	if ev.Detail != "" {
+		parts = append(parts, ev.Detail)
+	}
+	if ev.URL != "" {
+		parts = append(parts, ev.URL)
+	}
+	return title, strings.Join(parts, "\n\n"), noteType
+}
+
+// genericPayload is the body external systems post to a generic source.
+type genericPayload struct {
+	Event    string `json:"event"`
+	Shipment string `json:"shipment"`
+	Branch   string `json:"branch"`
+	PR       int    `json:"pr"`
+	Title    string `json:"title"`
+	URL      string `json:"url"`
+	Detail   string `json:"detail"`
+}
+
+// ParseGeneric parses a generic payload:
+//
+//	{"event": "ci.failed", "shipment": "SHIP-001", "branch": "...", "pr": 12,
+//	 "title": "...", "url": "...", "detail": "..."}
+func ParseGeneric(body []byte) (Event, error) {
+	var p genericPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return Event{}, fmt.Errorf("invalid payload: %w", err)
+	}
+	if !slices.Contains(knownEvents, p.Event) {
+		return Event{}, fmt.Errorf("unknown event %q", p.Event)
+	}
+	return Event{
+		Type:       p.Event,
+		ShipmentID: p.Shipment,
+		Branch:     p.Branch,
+		PRNumber:   p.PR,
+		Title:      p.Title,
+		URL:        p.URL,
+		Detail:     p.Detail,
+	}, nil
+}
+
+// githubPR is the subset of a GitHub pull request object used here.
+type githubPR struct {
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	HTMLURL string `json:"html_url"`
+	Merged  bool   `json:"merged"`
+	Head    struct {
+		Ref string `json:"ref"`
+	} `json:"head"`
+}
+
+type githubPayload struct {
+	Action      string    `json:"action"`
+	PullRequest *githubPR `json:"pull_request"`
+	Review      *struct {
+		State   string `json:"state"`
+		HTMLURL string `json:"html_url"`
+	} `json:"review"`
+	WorkflowRun *struct {
+		Name         string `json:"name"`
+		Conclusion   string `json:"conclusion"`
+		HeadBranch   string `json:"head_branch"`
+		HTMLURL      string `json:"html_url"`
+		PullRequests []struct {
+			Number int `json:"number"`
+		} `json:"pull_requests"`
+	} `json:"workflow_run"`
+}
+
+// ParseGitHub normalizes a GitHub delivery given its X-GitHub-Event header.
+// It returns false for deliveries that map to no event (pings, labels,
+// in-progress runs, and so on).
+func ParseGitHub(githubEvent string, body []byte) (Event, bool, error) {
+	if githubEvent == "ping" {
+		return Event{}, false, nil
+	}
+	var p githubPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return Event{}, false, fmt.Errorf("invalid payload: %w", err)
+	}
+
+	switch githubEvent {
+	case "pull_request":
+		if p.PullRequest == nil {
+			return Event{}, false, nil
+		}
+		ev := prEvent(p.PullRequest)
+		switch {
+		case p.Action == "opened" || p.Action == "reopened" || p.Action == "ready_for_review":
+			ev.Type = EventPROpened
+		case p.Action == "closed" && p.PullRequest.Merged:
+			ev.Type = EventPRMerged
+		case p.Action == "closed":
+			ev.Type = EventPRClosed
+		default:
+			return Event{}, false, nil
+		}
+		return ev, true, nil
+
+	case "pull_request_review":
+		if p.PullRequest == nil || p.Review == nil || p.Action != "submitted" || !strings.EqualFold(p.Review.State, "approved") {
+			return Event{}, false, nil
+		}
+		ev := prEvent(p.PullRequest)
+		ev.Type = EventPRApproved
+		ev.URL = p.Review.HTMLURL
+		return ev, true, nil
+
+	case "workflow_run":
+		run := p.WorkflowRun
+		if run == nil || p.Action != "completed" {
+			return Event{}, false, nil
+		}
+		ev := Event{
+			Branch: run.HeadBranch,
+			Title:  run.Name,
+			URL:    run.HTMLURL,
+			Detail: fmt.Sprintf("Workflow %q concluded %s on %s.", run.Name, run.Conclusion, run.HeadBranch),
+		}
+		if len(run.PullRequests) > 0 {
+			ev.PRNumber = run.PullRequests[0].Number
+		}
+		switch run.Conclusion {
+		case "failure", "timed_out", "startup_failure":
+			ev.Type = EventCIFailed
+		case "success":
+			ev.Type = EventCIPassed
+		default:
+			return Event{}, false, nil
+		}
+		return ev, true, nil
+	}
+
+	return Event{}, false, nil
+}
+
+func prEvent(pr *githubPR) Event {
+	return Event{
+		Branch:   pr.Head.Ref,
+		PRNumber: pr.Number,
+		Title:    pr.Title,
+		URL:      pr.HTMLURL,
+	}
+}
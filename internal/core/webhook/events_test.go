@@ -0,0 +1,133 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseGitHub(t *testing.T) {
+	tests := []struct {
+		name   string
+		event  string
+		body   string
+		wantOK bool
+		want   Event
+	}{
+		{
+			name:   "merged PR",
+			event:  "pull_request",
+			body:   `{"action":"closed","pull_request":{"number":42,"title":"Add invoices","html_url":"https://gh/pr/42","merged":true,"head":{"ref":"ml/SHIP-010-invoices"}}}`,
+			wantOK: true,
+			want:   Event{Type: EventPRMerged, Branch: "ml/SHIP-010-invoices", PRNumber: 42, Title: "Add invoices", URL: "https://gh/pr/42"},
+		},
+		{
+			name:   "closed unmerged PR",
+			event:  "pull_request",
+			body:   `{"action":"closed","pull_request":{"number":42,"merged":false,"head":{"ref":"b"}}}`,
+			wantOK: true,
+			want:   Event{Type: EventPRClosed, Branch: "b", PRNumber: 42},
+		},
+		{
+			name:  "labeled PR is ignored",
+			event: "pull_request",
+			body:  `{"action":"labeled","pull_request":{"number":42,"head":{"ref":"b"}}}`,
+		},
+		{
+			name:   "approving review",
+			event:  "pull_request_review",
+			body:   `{"action":"submitted","review":{"state":"APPROVED","html_url":"https://gh/review/1"},"pull_request":{"number":42,"head":{"ref":"b"}}}`,
+			wantOK: true,
+			want:   Event{Type: EventPRApproved, Branch: "b", PRNumber: 42, URL: "https://gh/review/1"},
+		},
+		{
+			name:  "commenting review is ignored",
+			event: "pull_request_review",
+			body:  `{"action":"submitted","review":{"state":"commented"},"pull_request":{"number":42}}`,
+		},
+		{
+			name:   "failed workflow run",
+			event:  "workflow_run",
+			body:   `{"action":"completed","workflow_run":{"name":"test","conclusion":"failure","head_branch":"b","html_url":"https://gh/run/7","pull_requests":[{"number":42}]}}`,
+			wantOK: true,
+			want:   Event{Type: EventCIFailed, Branch: "b", PRNumber: 42, Title: "test", URL: "https://gh/run/7", Detail: `Workflow "test" concluded failure on b.`},
+		},
+		{
+			name:  "in-progress workflow run is ignored",
+			event: "workflow_run",
+			body:  `{"action":"requested","workflow_run":{"name":"test","head_branch":"b"}}`,
+		},
+		{
+			name:  "ping is ignored",
+			event: "ping",
+			body:  `{"zen":"Keep it logically awesome."}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok, err := ParseGitHub(tt.event, []byte(tt.body))
+			if err != nil {
+				t.Fatalf("ParseGitHub() error = %v", err)
+			}
+			if ok != tt.wantOK {
+				t.Fatalf("ParseGitHub() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if got != tt.want {
+				t.Errorf("ParseGitHub() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if _, _, err := ParseGitHub("pull_request", []byte("not json")); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
+
+func TestParseGeneric(t *testing.T) {
+	got, err := ParseGeneric([]byte(`{"event":"ci.failed","shipment":"SHIP-010","title":"nightly","detail":"3 tests failed"}`))
+	if err != nil {
+		t.Fatalf("ParseGeneric() error = %v", err)
+	}
+	if got.Type != EventCIFailed || got.ShipmentID != "SHIP-010" || got.Detail != "3 tests failed" {
+		t.Errorf("unexpected event: %+v", got)
+	}
+
+	if _, err := ParseGeneric([]byte(`{"event":"deploy.done"}`)); err == nil || !strings.Contains(err.Error(), `unknown event "deploy.done"`) {
+		t.Errorf("expected unknown event error, got %v", err)
+	}
+}
+
+func TestParseMapping(t *testing.T) {
+	event, actions, err := ParseMapping("ci.failed = block, note")
+	if err != nil {
+		t.Fatalf("ParseMapping() error = %v", err)
+	}
+	if event != EventCIFailed || len(actions) != 2 || actions[0] != ActionBlock || actions[1] != ActionNote {
+		t.Errorf("ParseMapping() = %q, %v", event, actions)
+	}
+
+	for _, bad := range []string{"ci.failed", "=note", "ci.failed=", "ci.failed= , "} {
+		if _, _, err := ParseMapping(bad); err == nil {
+			t.Errorf("ParseMapping(%q) expected error", bad)
+		}
+	}
+}
+
+func TestNoteFor(t *testing.T) {
+	title, content, noteType := NoteFor(Event{Type: EventCIFailed, Title: "test", URL: "https://gh/run/7", Detail: "Workflow failed."})
+	if title != "CI failed: test" || noteType != "concern" || content != "Workflow failed.\n\nhttps://gh/run/7" {
+		t.Errorf("NoteFor() = %q, %q, %q", title, content, noteType)
+	}
+
+	title, _, noteType = NoteFor(Event{Type: EventPRMerged, Branch: "ml/SHIP-010"})
+	if title != "pr.merged: ml/SHIP-010" || noteType != "finding" {
+		t.Errorf("NoteFor() = %q, %q", title, noteType)
+	}
+}
+
+func TestFormatMappings(t *testing.T) {
+	got := FormatMappings(map[string][]string{EventPRMerged: {ActionPRSync}, EventCIFailed: {ActionBlock, ActionNote}})
+	if strings.Join(got, " ") != "ci.failed=block,note pr.merged=pr-sync" {
+		t.Errorf("FormatMappings() = %v", got)
+	}
+}
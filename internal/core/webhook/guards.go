@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// GuardResult represents the outcome of a guard evaluation.
+type GuardResult struct {
+	Allowed bool
+	Reason  string
+}
+
+// Error converts the guard result to an error if not allowed.
+func (r GuardResult) Error() error {
+	if r.Allowed {
+		return nil
+	}
+	return fmt.Errorf("%s", r.Reason)
+}
+
+// AddSourceContext provides context for webhook source creation guards.
+type AddSourceContext struct {
+	Name       string
+	Kind       string
+	SecretName string
+	Mappings   map[string][]string
+	NameExists bool
+}
+
+// CanAddSource evaluates whether a webhook source can be added.
+// Rules:
+// - Name must be set and unique
+// - Kind must be github or generic
+// - A signing secret is required
+// - Mappings must use known events and actions
+// - pr-sync only applies to PR events
+func CanAddSource(ctx AddSourceContext) GuardResult {
+	if ctx.Name == "" {
+		return GuardResult{Allowed: false, Reason: "webhook source name is required"}
+	}
+	if ctx.NameExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("webhook source %q already exists", ctx.Name),
+		}
+	}
+	if ctx.Kind != KindGitHub && ctx.Kind != KindGeneric {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("unknown webhook kind %q (want github or generic)", ctx.Kind),
+		}
+	}
+	if ctx.SecretName == "" {
+		return GuardResult{Allowed: false, Reason: "a signing secret is required (--secret)"}
+	}
+
+	for event, actions := range ctx.Mappings {
+		if !slices.Contains(knownEvents, event) {
+			return GuardResult{
+				Allowed: false,
+				Reason:  fmt.Sprintf("unknown event %q (want one of %s)", event, strings.Join(knownEvents, ", ")),
+			}
+		}
+		for _, action := range actions {
+			if !slices.Contains(knownActions, action) {
+				return GuardResult{
+					Allowed: false,
+					Reason:  fmt.Sprintf("unknown action %q for %s (want one of %s)", action, event, strings.Join(knownActions, ", ")),
+				}
+			}
+			if action == ActionPRSync && !IsPREvent(event) {
+				return GuardResult{
+					Allowed: false,
+					Reason:  fmt.Sprintf("%s only applies to PR events, not %s", ActionPRSync, event),
+				}
+			}
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// Sign returns the signature of body under secret in the X-Hub-Signature-256
+// format GitHub uses: "sha256=" followed by the hex HMAC-SHA256.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether signature is body's signature under secret.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	if secret == "" || !strings.HasPrefix(signature, "sha256=") {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
@@ -0,0 +1,101 @@
+package webhook
+
+import "testing"
+
+func TestCanAddSource(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         AddSourceContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can add source with default mappings",
+			ctx:         AddSourceContext{Name: "github", Kind: KindGitHub, SecretName: "gh-webhook", Mappings: DefaultMappings()},
+			wantAllowed: true,
+		},
+		{
+			name:        "name is required",
+			ctx:         AddSourceContext{Kind: KindGitHub, SecretName: "s"},
+			wantAllowed: false,
+			wantReason:  "webhook source name is required",
+		},
+		{
+			name:        "name must be unique",
+			ctx:         AddSourceContext{Name: "ci", Kind: KindGeneric, SecretName: "s", NameExists: true},
+			wantAllowed: false,
+			wantReason:  `webhook source "ci" already exists`,
+		},
+		{
+			name:        "kind must be known",
+			ctx:         AddSourceContext{Name: "ci", Kind: "gitlab", SecretName: "s"},
+			wantAllowed: false,
+			wantReason:  `unknown webhook kind "gitlab" (want github or generic)`,
+		},
+		{
+			name:        "secret is required",
+			ctx:         AddSourceContext{Name: "ci", Kind: KindGeneric},
+			wantAllowed: false,
+			wantReason:  "a signing secret is required (--secret)",
+		},
+		{
+			name:        "unknown event",
+			ctx:         AddSourceContext{Name: "ci", Kind: KindGeneric, SecretName: "s", Mappings: map[string][]string{"deploy.done": {ActionNote}}},
+			wantAllowed: false,
+			wantReason:  `unknown event "deploy.done" (want one of ci.failed, ci.passed, pr.opened, pr.approved, pr.merged, pr.closed)`,
+		},
+		{
+			name:        "unknown action",
+			ctx:         AddSourceContext{Name: "ci", Kind: KindGeneric, SecretName: "s", Mappings: map[string][]string{EventCIFailed: {"page"}}},
+			wantAllowed: false,
+			wantReason:  `unknown action "page" for ci.failed (want one of block, unblock, note, pr-sync)`,
+		},
+		{
+			name:        "pr-sync only on PR events",
+			ctx:         AddSourceContext{Name: "ci", Kind: KindGeneric, SecretName: "s", Mappings: map[string][]string{EventCIFailed: {ActionPRSync}}},
+			wantAllowed: false,
+			wantReason:  "pr-sync only applies to PR events, not ci.failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanAddSource(tt.ctx)
+
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("CanAddSource() Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+
+			if result.Reason != tt.wantReason {
+				t.Errorf("CanAddSource() Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"event":"ci.failed"}`)
+	signature := Sign("s3cret", body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		body      []byte
+		signature string
+		want      bool
+	}{
+		{"valid signature", "s3cret", body, signature, true},
+		{"wrong secret", "other", body, signature, false},
+		{"tampered body", "s3cret", []byte(`{"event":"ci.passed"}`), signature, false},
+		{"missing prefix", "s3cret", body, signature[len("sha256="):], false},
+		{"empty secret never verifies", "", body, Sign("", body), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifySignature(tt.secret, tt.body, tt.signature); got != tt.want {
+				t.Errorf("VerifySignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,7 +2,11 @@
 // Guards are pure functions that evaluate preconditions without side effects.
 package workbench
 
-import "fmt"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
 
 // GuardResult represents the outcome of a guard evaluation.
 type GuardResult struct {
@@ -60,6 +64,89 @@ func CanDeleteWorkbench(ctx DeleteWorkbenchContext) GuardResult {
 	return GuardResult{Allowed: true}
 }
 
+// TeardownContext provides context for tearing down a workbench.
+type TeardownContext struct {
+	WorkbenchID      string
+	ChangedFiles     int
+	UnpushedCommits  int
+	InProgressTasks  int
+	OpenPRs          int
+	Force            bool
+	MigrateTo        string // Empty when not migrating
+	TargetExists     bool
+	TargetActive     bool
+	TargetShipmentID string // Shipment the target already holds, if any
+	HasShipment      bool   // Whether the workbench has a shipment to migrate
+}
+
+// CanTeardown evaluates whether a workbench can be torn down.
+// Rules:
+// - A migration target must be another active workbench
+// - A target holding a shipment cannot take over another one
+// - Uncommitted changes and unpushed commits require --force; migration does not carry them
+// - In-progress tasks and open PRs require --migrate-to or --force
+func CanTeardown(ctx TeardownContext) GuardResult {
+	if ctx.MigrateTo != "" {
+		if ctx.MigrateTo == ctx.WorkbenchID {
+			return GuardResult{
+				Allowed: false,
+				Reason:  fmt.Sprintf("cannot migrate workbench %s to itself", ctx.WorkbenchID),
+			}
+		}
+		if !ctx.TargetExists {
+			return GuardResult{
+				Allowed: false,
+				Reason:  fmt.Sprintf("workbench %s not found", ctx.MigrateTo),
+			}
+		}
+		if !ctx.TargetActive {
+			return GuardResult{
+				Allowed: false,
+				Reason:  fmt.Sprintf("cannot migrate to workbench %s: it is not active", ctx.MigrateTo),
+			}
+		}
+		if ctx.HasShipment && ctx.TargetShipmentID != "" {
+			return GuardResult{
+				Allowed: false,
+				Reason:  fmt.Sprintf("cannot migrate to workbench %s: it is already assigned to shipment %s", ctx.MigrateTo, ctx.TargetShipmentID),
+			}
+		}
+	}
+
+	if ctx.Force {
+		return GuardResult{Allowed: true}
+	}
+
+	var lost []string
+	if ctx.ChangedFiles > 0 {
+		lost = append(lost, fmt.Sprintf("%d uncommitted changes", ctx.ChangedFiles))
+	}
+	if ctx.UnpushedCommits > 0 {
+		lost = append(lost, fmt.Sprintf("%d unpushed commits", ctx.UnpushedCommits))
+	}
+	migratable := len(lost) == 0
+	if ctx.MigrateTo == "" {
+		if ctx.InProgressTasks > 0 {
+			lost = append(lost, fmt.Sprintf("%d in-progress tasks", ctx.InProgressTasks))
+		}
+		if ctx.OpenPRs > 0 {
+			lost = append(lost, fmt.Sprintf("%d open PRs", ctx.OpenPRs))
+		}
+	}
+	if len(lost) == 0 {
+		return GuardResult{Allowed: true}
+	}
+
+	hint := "Commit and push first, or use --force to tear down anyway"
+	if migratable {
+		hint = "Use --migrate-to BENCH-xxx to move them, or --force to tear down anyway"
+	}
+	return GuardResult{
+		Allowed: false,
+		Reason:  fmt.Sprintf("workbench %s has %s. %s", ctx.WorkbenchID, strings.Join(lost, ", "), hint),
+	}
+}
+
 // CanRenameWorkbench evaluates whether a workbench can be renamed.
 // Rules:
 // - Workbench must exist
@@ -72,3 +159,197 @@ func CanRenameWorkbench(workbenchExists bool, workbenchID string) GuardResult {
 	}
 	return GuardResult{Allowed: true}
 }
+
+// BootstrapWorkbenchContext provides context for workbench bootstrap guards.
+type BootstrapWorkbenchContext struct {
+	WorkbenchID     string
+	RepoID          string
+	HasScript       bool
+	BootstrapStatus string // Empty when never bootstrapped
+	Rerun           bool
+}
+
+// CanBootstrapWorkbench evaluates whether a workbench bootstrap can run.
+// Rules:
+// - Workbench must be linked to a repo with a bootstrap script
+// - A workbench that already ran its bootstrap requires --rerun
+func CanBootstrapWorkbench(ctx BootstrapWorkbenchContext) GuardResult {
+	if ctx.RepoID == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("workbench %s is not linked to a repo", ctx.WorkbenchID),
+		}
+	}
+
+	if !ctx.HasScript {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("repo %s has no bootstrap script. Set one with: orc repo bootstrap set %s", ctx.RepoID, ctx.RepoID),
+		}
+	}
+
+	if ctx.BootstrapStatus != "" && !ctx.Rerun {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("workbench %s bootstrap already ran (status: %s). Use --rerun to run it again", ctx.WorkbenchID, ctx.BootstrapStatus),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// StashContext provides context for stashing a workbench's uncommitted work.
+type StashContext struct {
+	WorkbenchID    string
+	WorktreeExists bool
+	ChangedFiles   int
+}
+
+// CanStash evaluates whether a workbench's uncommitted work can be stashed.
+// Rules:
+// - The workbench worktree must exist
+// - There must be uncommitted changes to stash
+func CanStash(ctx StashContext) GuardResult {
+	if !ctx.WorktreeExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("workbench %s has no worktree on disk", ctx.WorkbenchID),
+		}
+	}
+
+	if ctx.ChangedFiles == 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("workbench %s has no uncommitted changes to stash", ctx.WorkbenchID),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+// UnstashContext provides context for restoring a stash onto a workbench.
+type UnstashContext struct {
+	StashID              string
+	Status               string // stashed, restored
+	RestoredTo           string // Workbench the stash was restored to, if any
+	StashRepoID          string // Empty when the stashed bench had no repo
+	TargetWorkbenchID    string
+	TargetRepoID         string
+	TargetWorktreeExists bool
+	TargetChangedFiles   int
+}
+
+// CanUnstash evaluates whether a stash can be restored onto a workbench.
+// Rules:
+// - The stash must not have been restored already
+// - The target worktree must exist
+// - The target must belong to the same repo: the stash commit lives there
+// - The target must have no uncommitted changes, so nothing is overwritten
+func CanUnstash(ctx UnstashContext) GuardResult {
+	if ctx.Status == "restored" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("stash %s was already restored to %s", ctx.StashID, ctx.RestoredTo),
+		}
+	}
+
+	if !ctx.TargetWorktreeExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("workbench %s has no worktree on disk", ctx.TargetWorkbenchID),
+		}
+	}
+
+	if ctx.StashRepoID != ctx.TargetRepoID {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("stash %s was taken from repo %s but workbench %s is on %s", ctx.StashID, orNone(ctx.StashRepoID), ctx.TargetWorkbenchID, orNone(ctx.TargetRepoID)),
+		}
+	}
+
+	if ctx.TargetChangedFiles > 0 {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("workbench %s has %d uncommitted changes. Commit or stash them first", ctx.TargetWorkbenchID, ctx.TargetChangedFiles),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
+
+func orNone(id string) string {
+	if id == "" {
+		return "(none)"
+	}
+	return id
+}
+
+var envNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// IsValidEnvName reports whether name is a valid environment variable name.
+func IsValidEnvName(name string) bool {
+	return envNamePattern.MatchString(name)
+}
+
+// SetEnvContext provides context for setting a workbench environment variable.
+type SetEnvContext struct {
+	WorkbenchID      string
+	WorkbenchExists  bool
+	Name             string
+	HasValue         bool
+	SecretName       string // Secret reference; empty for a plain value
+	SecretResolvable bool   // Whether the secret resolves for this workbench
+}
+
+// CanSetEnv evaluates whether a workbench environment variable can be set.
+// Rules:
+// - Workbench must exist
+// - Name must be a valid environment variable name
+// - ORC_ names are reserved for orc itself
+// - Exactly one of a value or a secret reference must be given
+// - A secret reference must resolve for the workbench's repo or factory
+func CanSetEnv(ctx SetEnvContext) GuardResult {
+	if !ctx.WorkbenchExists {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("workbench %s not found", ctx.WorkbenchID),
+		}
+	}
+
+	if !IsValidEnvName(ctx.Name) {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("invalid variable name %q: use letters, digits, and underscores, not starting with a digit", ctx.Name),
+		}
+	}
+
+	if strings.HasPrefix(strings.ToUpper(ctx.Name), "ORC_") {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("variable %s is reserved: ORC_ variables are set by orc", ctx.Name),
+		}
+	}
+
+	if ctx.HasValue && ctx.SecretName != "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("variable %s cannot have both a value and a secret reference", ctx.Name),
+		}
+	}
+
+	if !ctx.HasValue && ctx.SecretName == "" {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("variable %s needs a value or a secret reference", ctx.Name),
+		}
+	}
+
+	if ctx.SecretName != "" && !ctx.SecretResolvable {
+		return GuardResult{
+			Allowed: false,
+			Reason:  fmt.Sprintf("secret %s not found for workbench %s. Store it with: orc secret set %s", ctx.SecretName, ctx.WorkbenchID, ctx.SecretName),
+		}
+	}
+
+	return GuardResult{Allowed: true}
+}
@@ -109,6 +109,87 @@ func TestCanDeleteWorkbench(t *testing.T) {
 	}
 }
 
+func TestCanTeardown(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         TeardownContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can tear down idle workbench",
+			ctx:         TeardownContext{WorkbenchID: "BENCH-002"},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot tear down with unsaved work",
+			ctx:         TeardownContext{WorkbenchID: "BENCH-002", ChangedFiles: 2, UnpushedCommits: 1, InProgressTasks: 1},
+			wantAllowed: false,
+			wantReason:  "workbench BENCH-002 has 2 uncommitted changes, 1 unpushed commits, 1 in-progress tasks. Commit and push first, or use --force to tear down anyway",
+		},
+		{
+			name:        "cannot tear down with assignments",
+			ctx:         TeardownContext{WorkbenchID: "BENCH-002", InProgressTasks: 1, OpenPRs: 1},
+			wantAllowed: false,
+			wantReason:  "workbench BENCH-002 has 1 in-progress tasks, 1 open PRs. Use --migrate-to BENCH-xxx to move them, or --force to tear down anyway",
+		},
+		{
+			name:        "can tear down with assignments when migrating",
+			ctx:         TeardownContext{WorkbenchID: "BENCH-002", InProgressTasks: 1, OpenPRs: 1, MigrateTo: "BENCH-004", TargetExists: true, TargetActive: true, HasShipment: true},
+			wantAllowed: true,
+		},
+		{
+			name:        "migrating does not carry uncommitted changes",
+			ctx:         TeardownContext{WorkbenchID: "BENCH-002", ChangedFiles: 1, MigrateTo: "BENCH-004", TargetExists: true, TargetActive: true},
+			wantAllowed: false,
+			wantReason:  "workbench BENCH-002 has 1 uncommitted changes. Commit and push first, or use --force to tear down anyway",
+		},
+		{
+			name:        "can force tear down with unsaved work",
+			ctx:         TeardownContext{WorkbenchID: "BENCH-002", ChangedFiles: 2, UnpushedCommits: 1, OpenPRs: 1, Force: true},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot migrate to itself",
+			ctx:         TeardownContext{WorkbenchID: "BENCH-002", MigrateTo: "BENCH-002", TargetExists: true, TargetActive: true},
+			wantAllowed: false,
+			wantReason:  "cannot migrate workbench BENCH-002 to itself",
+		},
+		{
+			name:        "cannot migrate to missing workbench",
+			ctx:         TeardownContext{WorkbenchID: "BENCH-002", MigrateTo: "BENCH-009", Force: true},
+			wantAllowed: false,
+			wantReason:  "workbench BENCH-009 not found",
+		},
+		{
+			name:        "cannot migrate to archived workbench",
+			ctx:         TeardownContext{WorkbenchID: "BENCH-002", MigrateTo: "BENCH-004", TargetExists: true},
+			wantAllowed: false,
+			wantReason:  "cannot migrate to workbench BENCH-004: it is not active",
+		},
+		{
+			name:        "cannot migrate shipment to busy workbench",
+			ctx:         TeardownContext{WorkbenchID: "BENCH-002", MigrateTo: "BENCH-004", TargetExists: true, TargetActive: true, HasShipment: true, TargetShipmentID: "SHIP-009"},
+			wantAllowed: false,
+			wantReason:  "cannot migrate to workbench BENCH-004: it is already assigned to shipment SHIP-009",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanTeardown(tt.ctx)
+
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("CanTeardown() Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+
+			if result.Reason != tt.wantReason {
+				t.Errorf("CanTeardown() Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
 func TestCanRenameWorkbench(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -148,6 +229,243 @@ func TestCanRenameWorkbench(t *testing.T) {
 	}
 }
 
+func TestCanBootstrapWorkbench(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         BootstrapWorkbenchContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name: "can bootstrap fresh workbench with script",
+			ctx: BootstrapWorkbenchContext{
+				WorkbenchID: "BENCH-001",
+				RepoID:      "REPO-001",
+				HasScript:   true,
+			},
+			wantAllowed: true,
+		},
+		{
+			name: "cannot bootstrap workbench without repo",
+			ctx: BootstrapWorkbenchContext{
+				WorkbenchID: "BENCH-001",
+			},
+			wantAllowed: false,
+			wantReason:  "workbench BENCH-001 is not linked to a repo",
+		},
+		{
+			name: "cannot bootstrap when repo has no script",
+			ctx: BootstrapWorkbenchContext{
+				WorkbenchID: "BENCH-001",
+				RepoID:      "REPO-001",
+				HasScript:   false,
+			},
+			wantAllowed: false,
+			wantReason:  "repo REPO-001 has no bootstrap script. Set one with: orc repo bootstrap set REPO-001",
+		},
+		{
+			name: "cannot bootstrap twice without rerun",
+			ctx: BootstrapWorkbenchContext{
+				WorkbenchID:     "BENCH-003",
+				RepoID:          "REPO-001",
+				HasScript:       true,
+				BootstrapStatus: "failed",
+			},
+			wantAllowed: false,
+			wantReason:  "workbench BENCH-003 bootstrap already ran (status: failed). Use --rerun to run it again",
+		},
+		{
+			name: "can bootstrap again with rerun",
+			ctx: BootstrapWorkbenchContext{
+				WorkbenchID:     "BENCH-003",
+				RepoID:          "REPO-001",
+				HasScript:       true,
+				BootstrapStatus: "succeeded",
+				Rerun:           true,
+			},
+			wantAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanBootstrapWorkbench(tt.ctx)
+
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("CanBootstrapWorkbench() Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+
+			if result.Reason != tt.wantReason {
+				t.Errorf("CanBootstrapWorkbench() Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanSetEnv(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         SetEnvContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can set plain value",
+			ctx:         SetEnvContext{WorkbenchID: "BENCH-003", WorkbenchExists: true, Name: "API_BASE", HasValue: true},
+			wantAllowed: true,
+		},
+		{
+			name:        "can set resolvable secret reference",
+			ctx:         SetEnvContext{WorkbenchID: "BENCH-003", WorkbenchExists: true, Name: "GITHUB_TOKEN", SecretName: "github-token", SecretResolvable: true},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot set on missing workbench",
+			ctx:         SetEnvContext{WorkbenchID: "BENCH-999", Name: "API_BASE", HasValue: true},
+			wantAllowed: false,
+			wantReason:  "workbench BENCH-999 not found",
+		},
+		{
+			name:        "cannot set invalid name",
+			ctx:         SetEnvContext{WorkbenchID: "BENCH-003", WorkbenchExists: true, Name: "1API", HasValue: true},
+			wantAllowed: false,
+			wantReason:  `invalid variable name "1API": use letters, digits, and underscores, not starting with a digit`,
+		},
+		{
+			name:        "cannot set reserved name",
+			ctx:         SetEnvContext{WorkbenchID: "BENCH-003", WorkbenchExists: true, Name: "ORC_CONTEXT", HasValue: true},
+			wantAllowed: false,
+			wantReason:  "variable ORC_CONTEXT is reserved: ORC_ variables are set by orc",
+		},
+		{
+			name:        "cannot set both value and secret",
+			ctx:         SetEnvContext{WorkbenchID: "BENCH-003", WorkbenchExists: true, Name: "TOKEN", HasValue: true, SecretName: "github-token", SecretResolvable: true},
+			wantAllowed: false,
+			wantReason:  "variable TOKEN cannot have both a value and a secret reference",
+		},
+		{
+			name:        "cannot set without value or secret",
+			ctx:         SetEnvContext{WorkbenchID: "BENCH-003", WorkbenchExists: true, Name: "TOKEN"},
+			wantAllowed: false,
+			wantReason:  "variable TOKEN needs a value or a secret reference",
+		},
+		{
+			name:        "cannot reference unresolvable secret",
+			ctx:         SetEnvContext{WorkbenchID: "BENCH-003", WorkbenchExists: true, Name: "TOKEN", SecretName: "missing"},
+			wantAllowed: false,
+			wantReason:  "secret missing not found for workbench BENCH-003. Store it with: orc secret set missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanSetEnv(tt.ctx)
+
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("CanSetEnv() Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+
+			if result.Reason != tt.wantReason {
+				t.Errorf("CanSetEnv() Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanStash(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         StashContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can stash dirty worktree",
+			ctx:         StashContext{WorkbenchID: "BENCH-003", WorktreeExists: true, ChangedFiles: 2},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot stash without worktree",
+			ctx:         StashContext{WorkbenchID: "BENCH-003", ChangedFiles: 2},
+			wantAllowed: false,
+			wantReason:  "workbench BENCH-003 has no worktree on disk",
+		},
+		{
+			name:        "cannot stash clean worktree",
+			ctx:         StashContext{WorkbenchID: "BENCH-003", WorktreeExists: true},
+			wantAllowed: false,
+			wantReason:  "workbench BENCH-003 has no uncommitted changes to stash",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanStash(tt.ctx)
+
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("CanStash() Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+
+			if result.Reason != tt.wantReason {
+				t.Errorf("CanStash() Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCanUnstash(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         UnstashContext
+		wantAllowed bool
+		wantReason  string
+	}{
+		{
+			name:        "can restore onto clean bench of the same repo",
+			ctx:         UnstashContext{StashID: "STASH-001", Status: "stashed", StashRepoID: "REPO-001", TargetWorkbenchID: "BENCH-004", TargetRepoID: "REPO-001", TargetWorktreeExists: true},
+			wantAllowed: true,
+		},
+		{
+			name:        "cannot restore twice",
+			ctx:         UnstashContext{StashID: "STASH-001", Status: "restored", RestoredTo: "BENCH-003", StashRepoID: "REPO-001", TargetWorkbenchID: "BENCH-004", TargetRepoID: "REPO-001", TargetWorktreeExists: true},
+			wantAllowed: false,
+			wantReason:  "stash STASH-001 was already restored to BENCH-003",
+		},
+		{
+			name:        "cannot restore without worktree",
+			ctx:         UnstashContext{StashID: "STASH-001", Status: "stashed", StashRepoID: "REPO-001", TargetWorkbenchID: "BENCH-004", TargetRepoID: "REPO-001"},
+			wantAllowed: false,
+			wantReason:  "workbench BENCH-004 has no worktree on disk",
+		},
+		{
+			name:        "cannot restore onto another repo",
+			ctx:         UnstashContext{StashID: "STASH-001", Status: "stashed", StashRepoID: "REPO-001", TargetWorkbenchID: "BENCH-004", TargetWorktreeExists: true},
+			wantAllowed: false,
+			wantReason:  "stash STASH-001 was taken from repo REPO-001 but workbench BENCH-004 is on (none)",
+		},
+		{
+			name:        "cannot restore onto dirty bench",
+			ctx:         UnstashContext{StashID: "STASH-001", Status: "stashed", StashRepoID: "REPO-001", TargetWorkbenchID: "BENCH-004", TargetRepoID: "REPO-001", TargetWorktreeExists: true, TargetChangedFiles: 3},
+			wantAllowed: false,
+			wantReason:  "workbench BENCH-004 has 3 uncommitted changes. Commit or stash them first",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CanUnstash(tt.ctx)
+
+			if result.Allowed != tt.wantAllowed {
+				t.Errorf("CanUnstash() Allowed = %v, want %v", result.Allowed, tt.wantAllowed)
+			}
+
+			if result.Reason != tt.wantReason {
+				t.Errorf("CanUnstash() Reason = %q, want %q", result.Reason, tt.wantReason)
+			}
+		})
+	}
+}
+
 func TestGuardResult_Error(t *testing.T) {
 	tests := []struct {
 		name      string
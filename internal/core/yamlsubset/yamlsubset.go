@@ -0,0 +1,411 @@
+// Package yamlsubset parses the subset of YAML that orc's hand-written
+// config files use: flow definitions and tracker import mappings.
+//
+// Supported:
+//
+//   - block mappings ("key: value", nested by indentation with spaces)
+//   - block sequences ("- item"), including mappings started on the dash
+//     line ("- id: ship") and sequences at their key's indentation
+//   - plain, 'single-quoted' (a quote doubled inside), and "double-quoted"
+//     scalars (escapes \\ \" \n \t), each on one line
+//   - "# comments", and a single leading "---"
+//
+// Every scalar is a string; a key with no value and nothing nested under it
+// is the empty string. Anything else YAML allows is rejected with an error
+// naming the line, rather than misread: flow collections ([a, b], {a: b}),
+// block scalars (| and >), values continued over several lines, anchors and
+// aliases (&a, *a), tags (!tag), complex keys (?), multiple documents,
+// duplicate keys, and tabs in indentation.
+package yamlsubset
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kind is the kind of a Node.
+type Kind int
+
+// Node kinds.
+const (
+	Scalar Kind = iota
+	Mapping
+	Sequence
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Mapping:
+		return "mapping"
+	case Sequence:
+		return "list"
+	default:
+		return "value"
+	}
+}
+
+// Node is a parsed value.
+type Node struct {
+	Kind  Kind
+	Line  int     // 1-based line the node starts on
+	Value string  // Scalar
+	Pairs []Pair  // Mapping, in file order
+	Items []*Node // Sequence
+}
+
+// Pair is one entry of a mapping.
+type Pair struct {
+	Key   string
+	Line  int
+	Value *Node
+}
+
+// Empty reports whether n is a key with no value: callers expecting a
+// mapping or list treat it as an empty one.
+func (n *Node) Empty() bool {
+	return n.Kind == Scalar && n.Value == ""
+}
+
+// Errorf returns an error prefixed with n's line.
+func (n *Node) Errorf(format string, args ...any) error {
+	return fmt.Errorf("line %d: %s", n.Line, fmt.Sprintf(format, args...))
+}
+
+// line is one significant (non-blank, non-comment) line of input.
+type line struct {
+	n      int    // 1-based line number
+	indent int    // Column of the first character of text
+	text   string // Without indentation or trailing comment
+}
+
+// Parse parses data. An empty document is an empty mapping.
+func Parse(data string) (*Node, error) {
+	lines, err := scan(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return &Node{Kind: Mapping, Line: 1}, nil
+	}
+	if lines[0].indent != 0 {
+		return nil, fmt.Errorf("line %d: unexpected indentation", lines[0].n)
+	}
+
+	p := &parser{lines: lines}
+	root, err := p.block(0)
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.lines) {
+		return nil, fmt.Errorf("line %d: expected a list item", p.lines[p.pos].n)
+	}
+	return root, nil
+}
+
+// scan splits data into significant lines.
+func scan(data string) ([]line, error) {
+	var lines []line
+	for i, raw := range strings.Split(data, "\n") {
+		n := i + 1
+		raw = strings.TrimRight(raw, " \t\r")
+		body := strings.TrimLeft(raw, " ")
+		indent := len(raw) - len(body)
+		if strings.HasPrefix(body, "\t") {
+			return nil, fmt.Errorf("line %d: tabs are not allowed in indentation", n)
+		}
+		text := strings.TrimRight(stripComment(body), " \t")
+		if text == "" {
+			continue
+		}
+		if indent == 0 && (text == "---" || text == "...") {
+			if text == "---" && len(lines) == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: multiple documents are not supported", n)
+		}
+		lines = append(lines, line{n: n, indent: indent, text: text})
+	}
+	return lines, nil
+}
+
+// stripComment removes a "# comment" that starts the line or follows
+// whitespace, outside quotes.
+func stripComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote == '"' && c == '\\':
+			i++
+		case quote == '\'' && c == '\'' && i+1 < len(s) && s[i+1] == '\'':
+			i++
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case (c == '"' || c == '\'') && (i == 0 || strings.ContainsRune(" \t-:", rune(s[i-1]))):
+			quote = c
+		case c == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			return s[:i]
+		}
+	}
+	return s
+}
+
+type parser struct {
+	lines []line
+	pos   int
+}
+
+func (p *parser) peek() (line, bool) {
+	if p.pos >= len(p.lines) {
+		return line{}, false
+	}
+	return p.lines[p.pos], true
+}
+
+// block parses the mapping or sequence whose lines start at indent.
+func (p *parser) block(indent int) (*Node, error) {
+	l, _ := p.peek()
+	if isItem(l.text) {
+		return p.sequence(indent)
+	}
+	return p.mapping(indent)
+}
+
+func isItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+func (p *parser) mapping(indent int) (*Node, error) {
+	start, _ := p.peek()
+	node := &Node{Kind: Mapping, Line: start.n}
+	seen := map[string]bool{}
+
+	for {
+		l, ok := p.peek()
+		if !ok || l.indent < indent {
+			return node, nil
+		}
+		if l.indent > indent {
+			return nil, fmt.Errorf("line %d: unexpected indentation", l.n)
+		}
+		if isItem(l.text) {
+			return nil, fmt.Errorf("line %d: expected 'key: value', got a list item", l.n)
+		}
+
+		key, rest, err := splitKey(l.text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", l.n, err)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("line %d: duplicate key %q", l.n, key)
+		}
+		seen[key] = true
+		p.pos++
+
+		value, err := p.value(l, indent, rest)
+		if err != nil {
+			return nil, err
+		}
+		node.Pairs = append(node.Pairs, Pair{Key: key, Line: l.n, Value: value})
+	}
+}
+
+func (p *parser) sequence(indent int) (*Node, error) {
+	start, _ := p.peek()
+	node := &Node{Kind: Sequence, Line: start.n}
+
+	for {
+		l, ok := p.peek()
+		if !ok || l.indent < indent {
+			return node, nil
+		}
+		if l.indent > indent {
+			return nil, fmt.Errorf("line %d: unexpected indentation", l.n)
+		}
+		if !isItem(l.text) {
+			return node, nil // A mapping key after a list at the key's own indentation
+		}
+
+		rest := strings.TrimLeft(l.text[1:], " ")
+		if rest == "" {
+			p.pos++
+			item, err := p.value(l, indent, "")
+			if err != nil {
+				return nil, err
+			}
+			node.Items = append(node.Items, item)
+			continue
+		}
+
+		// The item's content starts on the dash line: re-read that line as
+		// the first line of a block indented to where the content starts.
+		col := l.indent + len(l.text) - len(rest)
+		if isItem(rest) || isMappingLine(rest) {
+			p.lines[p.pos] = line{n: l.n, indent: col, text: rest}
+			item, err := p.block(col)
+			if err != nil {
+				return nil, err
+			}
+			node.Items = append(node.Items, item)
+			continue
+		}
+
+		p.pos++
+		item, err := scalar(l.n, rest)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.noContinuation(indent); err != nil {
+			return nil, err
+		}
+		node.Items = append(node.Items, item)
+	}
+}
+
+// value parses what follows "key:" or "-" on line l, which sits at indent:
+// the rest of the line, or else a block nested under it.
+func (p *parser) value(l line, indent int, rest string) (*Node, error) {
+	if rest != "" {
+		node, err := scalar(l.n, rest)
+		if err != nil {
+			return nil, err
+		}
+		return node, p.noContinuation(indent)
+	}
+
+	next, ok := p.peek()
+	switch {
+	case ok && next.indent > indent:
+		return p.block(next.indent)
+	case ok && next.indent == indent && isItem(next.text) && !isItem(l.text):
+		return p.sequence(indent)
+	}
+	return &Node{Kind: Scalar, Line: l.n}, nil
+}
+
+// noContinuation rejects a line indented under a value that was given
+// inline, which YAML would read as the value continuing.
+func (p *parser) noContinuation(indent int) error {
+	if next, ok := p.peek(); ok && next.indent > indent {
+		return fmt.Errorf("line %d: values continued over several lines are not supported", next.n)
+	}
+	return nil
+}
+
+// isMappingLine reports whether text starts a mapping entry.
+func isMappingLine(text string) bool {
+	_, _, err := splitKey(text)
+	return err == nil
+}
+
+// splitKey splits "key: rest".
+func splitKey(text string) (key, rest string, err error) {
+	if err := checkIndicator(text); err != nil {
+		return "", "", err
+	}
+
+	if q := text[0]; q == '"' || q == '\'' {
+		key, after, err := quoted(text)
+		if err != nil {
+			return "", "", err
+		}
+		if after != ":" && !strings.HasPrefix(after, ": ") {
+			return "", "", fmt.Errorf("expected ':' after %q", key)
+		}
+		return key, strings.TrimSpace(after[1:]), nil
+	}
+
+	for i := 0; i < len(text); i++ {
+		if text[i] == ':' && (i == len(text)-1 || text[i+1] == ' ') {
+			key = strings.TrimSpace(text[:i])
+			if key == "" {
+				break
+			}
+			return key, strings.TrimSpace(text[i+1:]), nil
+		}
+	}
+	return "", "", fmt.Errorf("expected 'key: value', got %q", text)
+}
+
+// scalar parses an inline value.
+func scalar(n int, text string) (*Node, error) {
+	if err := checkIndicator(text); err != nil {
+		return nil, fmt.Errorf("line %d: %w", n, err)
+	}
+
+	if q := text[0]; q == '"' || q == '\'' {
+		value, after, err := quoted(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		if after != "" {
+			return nil, fmt.Errorf("line %d: unexpected %q after quoted value", n, after)
+		}
+		return &Node{Kind: Scalar, Line: n, Value: value}, nil
+	}
+
+	if strings.Contains(text, ": ") || strings.HasSuffix(text, ":") {
+		return nil, fmt.Errorf("line %d: nested mappings must start on their own line (quote the value if it contains ': ')", n)
+	}
+	return &Node{Kind: Scalar, Line: n, Value: text}, nil
+}
+
+// checkIndicator rejects text starting with a YAML feature outside the subset.
+func checkIndicator(text string) error {
+	switch text[0] {
+	case '[', '{':
+		return fmt.Errorf("flow collections ([...] and {...}) are not supported; use an indented list or mapping")
+	case '|', '>':
+		return fmt.Errorf("block scalars (| and >) are not supported; keep the value on one line")
+	case '&', '*':
+		return fmt.Errorf("anchors and aliases are not supported")
+	case '!':
+		return fmt.Errorf("tags are not supported")
+	case '?':
+		return fmt.Errorf("complex keys (?) are not supported")
+	case '@', '`', '%':
+		return fmt.Errorf("%q cannot start a value; quote it", text[0])
+	}
+	return nil
+}
+
+// quoted reads the quoted string text starts with, returning its value and
+// the trimmed text after the closing quote.
+func quoted(text string) (value, after string, err error) {
+	q := text[0]
+	var b strings.Builder
+	for i := 1; i < len(text); i++ {
+		c := text[i]
+		switch {
+		case q == '\'' && c == '\'':
+			if i+1 < len(text) && text[i+1] == '\'' {
+				b.WriteByte('\'')
+				i++
+				continue
+			}
+			return b.String(), strings.TrimSpace(text[i+1:]), nil
+		case q == '"' && c == '"':
+			return b.String(), strings.TrimSpace(text[i+1:]), nil
+		case q == '"' && c == '\\':
+			if i+1 == len(text) {
+				break
+			}
+			i++
+			switch text[i] {
+			case '\\', '"', '/':
+				b.WriteByte(text[i])
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				return "", "", fmt.Errorf("unsupported escape \\%c", text[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", "", fmt.Errorf("unterminated quote in %s (values continued over several lines are not supported)", text)
+}
@@ -0,0 +1,108 @@
+package yamlsubset
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// dump renders a node compactly for comparison: scalars as themselves,
+// mappings as {k=v ...}, and sequences as [a b ...].
+func dump(n *Node) string {
+	switch n.Kind {
+	case Mapping:
+		parts := make([]string, len(n.Pairs))
+		for i, p := range n.Pairs {
+			parts[i] = p.Key + "=" + dump(p.Value)
+		}
+		return "{" + strings.Join(parts, " ") + "}"
+	case Sequence:
+		parts := make([]string, len(n.Items))
+		for i, item := range n.Items {
+			parts[i] = dump(item)
+		}
+		return "[" + strings.Join(parts, " ") + "]"
+	default:
+		return n.Value
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"empty document", "# nothing here\n\n", "{}"},
+		{"scalars", "---\nname: ship # trailing\nurl: http://x/#frag\n", "{name=ship url=http://x/#frag}"},
+		{"quoted", `a: "x: #y \"z\""` + "\nb: 'it''s'\n'c d': e\n", `{a=x: #y "z" b=it's c d=e}`},
+		{"empty value", "a:\nb: c\n", "{a= b=c}"},
+		{"nested mapping", "a:\n  b: c\n  d:\n    e: f\ng: h\n", "{a={b=c d={e=f}} g=h}"},
+		{"indented list", "a:\n  - x\n  - y\n", "{a=[x y]}"},
+		{"list at key indent", "a:\n- x\n- y\nb: z\n", "{a=[x y] b=z}"},
+		{"mappings in list", "steps:\n  - id: one\n    do: run\n  - id: two\n", "{steps=[{id=one do=run} {id=two}]}"},
+		{"list in list item", "- - a\n  - b\n- c\n", "[[a b] c]"},
+		{"empty item", "-\n- a\n", "[ a]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := Parse(tt.data)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := dump(root); got != tt.want {
+				t.Errorf("Parse() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_Lines(t *testing.T) {
+	root, err := Parse("# header\na: b\nc:\n  - d\n")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	got := []int{root.Pairs[0].Line, root.Pairs[1].Line, root.Pairs[1].Value.Items[0].Line}
+	if want := []int{2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("lines = %v, want %v", got, want)
+	}
+}
+
+func TestParse_Unsupported(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{"flow sequence", "a: [x, y]\n", "line 1: flow collections"},
+		{"flow mapping", "a:\n  - {x: y}\n", "line 2: flow collections"},
+		{"literal block", "a: |\n  text\n", "line 1: block scalars"},
+		{"folded block", "a: >\n  text\n", "line 1: block scalars"},
+		{"anchor", "a: &x y\n", "anchors and aliases"},
+		{"alias", "a: *x\n", "anchors and aliases"},
+		{"tag", "a: !!str 1\n", "tags are not supported"},
+		{"complex key", "? a\n: b\n", "complex keys"},
+		{"second document", "a: b\n---\nc: d\n", "line 2: multiple documents"},
+		{"tab indent", "a:\n\tb: c\n", "line 2: tabs"},
+		{"continued plain", "a: one\n  two\n", "line 2: values continued over several lines"},
+		{"continued quote", "a: \"one\n  two\"\n", "line 1: unterminated quote"},
+		{"inline nested mapping", "a: b: c\n", "nested mappings must start on their own line"},
+		{"duplicate key", "a: b\na: c\n", `line 2: duplicate key "a"`},
+		{"bad indentation", "a:\n    b: c\n  d: e\n", "line 3: unexpected indentation"},
+		{"indented top level", "  a: b\n", "line 1: unexpected indentation"},
+		{"missing colon", "a: b\nc\n", `expected 'key: value', got "c"`},
+		{"list then key", "- a\nb: c\n", "line 2: expected a list item"},
+		{"list in mapping", "a: b\n- c\n", "line 2: expected 'key: value', got a list item"},
+		{"bad escape", `a: "\q"` + "\n", `unsupported escape \q`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.data)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Parse() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
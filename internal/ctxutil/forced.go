@@ -0,0 +1,19 @@
+package ctxutil
+
+import "context"
+
+// ForcedKey is the context key for mutations that override a guard.
+type ForcedKey struct{}
+
+// WithForced returns a context marking mutations as forced past a guard
+// (e.g. an illegal status transition allowed by --force).
+// Log writers record the mark so overrides stay visible in the activity log.
+func WithForced(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ForcedKey{}, true)
+}
+
+// ForcedFromContext returns true if mutations in this context were forced.
+func ForcedFromContext(ctx context.Context) bool {
+	v, _ := ctx.Value(ForcedKey{}).(bool)
+	return v
+}
@@ -0,0 +1,20 @@
+package ctxutil
+
+import "context"
+
+// UndoOfKey is the context key for the log entry being undone.
+type UndoOfKey struct{}
+
+// WithUndoOf returns a context marking mutations as the undo of a log entry.
+// Log writers record the ID so the reverted entry is no longer undoable.
+func WithUndoOf(ctx context.Context, logID string) context.Context {
+	return context.WithValue(ctx, UndoOfKey{}, logID)
+}
+
+// UndoOfFromContext returns the log entry being undone, or empty string if not set.
+func UndoOfFromContext(ctx context.Context) string {
+	if v := ctx.Value(UndoOfKey{}); v != nil {
+		return v.(string)
+	}
+	return ""
+}
@@ -0,0 +1,284 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// DefaultBackfillBatchSize is the number of rows a backfill corrects per
+// transaction unless told otherwise.
+const DefaultBackfillBatchSize = 500
+
+// BackfillJob is a data correction declared in code. Where selects the rows of
+// Table still needing it and Set corrects them. A corrected row must no longer
+// match Where, so running a job again only touches rows that still need it.
+type BackfillJob struct {
+	Name        string
+	Description string
+	Table       string // Table corrected; rows are keyed by its TEXT id column
+	Where       string // Rows still needing the correction
+	Set         string // SET clause that corrects them
+}
+
+// BackfillJobs are the backfills orc backfill runs. Declare data corrections
+// here rather than running ad-hoc SQL against a production ledger.
+var BackfillJobs = []BackfillJob{
+	{
+		Name:        "task-completed-at",
+		Description: "Stamp closed tasks missing completed_at with their last update time",
+		Table:       "tasks",
+		Where:       "status = 'closed' AND completed_at IS NULL",
+		Set:         "completed_at = COALESCE(updated_at, created_at)",
+	},
+	{
+		Name:        "note-closed-at",
+		Description: "Stamp closed notes missing closed_at with their last update time",
+		Table:       "notes",
+		Where:       "status = 'closed' AND closed_at IS NULL",
+		Set:         "closed_at = COALESCE(updated_at, created_at)",
+	},
+	{
+		Name:        "task-commission-from-shipment",
+		Description: "Move tasks filed under another commission than their shipment's",
+		Table:       "tasks",
+		Where:       "shipment_id IS NOT NULL AND commission_id != (SELECT s.commission_id FROM shipments s WHERE s.id = tasks.shipment_id)",
+		Set:         "commission_id = (SELECT s.commission_id FROM shipments s WHERE s.id = tasks.shipment_id)",
+	},
+}
+
+// FindBackfillJob returns the declared job with the given name.
+func FindBackfillJob(name string) (BackfillJob, bool) {
+	for _, job := range BackfillJobs {
+		if job.Name == name {
+			return job, true
+		}
+	}
+	return BackfillJob{}, false
+}
+
+// BackfillState is a job's recorded progress and the rows it has left.
+type BackfillState struct {
+	Job         BackfillJob
+	Status      string // "pending" (never run), "running" (in progress or interrupted), or "completed"
+	LastID      string // Highest id of the last committed batch
+	RowsUpdated int
+	Batches     int
+	StartedAt   string
+	UpdatedAt   string
+	CompletedAt string
+	Remaining   int // Rows still matching the job's Where
+}
+
+// BackfillOptions controls a backfill run.
+type BackfillOptions struct {
+	BatchSize int  // Rows per transaction; DefaultBackfillBatchSize when zero
+	DryRun    bool // Report what would change without writing
+	Restart   bool // Start over from the first row, even after completion
+
+	// OnBatch, when set, is called after each committed batch.
+	OnBatch func(state *BackfillState, batchRows int)
+}
+
+// BackfillReport describes a backfill run.
+type BackfillReport struct {
+	State   *BackfillState // Progress after the run
+	Resumed bool           // The run continued after an interrupted one
+	Updated int            // Rows corrected by this run
+	Batches int            // Batches committed by this run
+	Sample  []string       // Dry run: the first ids that would change
+}
+
+// backfillSampleSize is the number of ids a dry run lists.
+const backfillSampleSize = 10
+
+// GetBackfillState returns a job's recorded progress and remaining rows.
+func GetBackfillState(ctx context.Context, database *sql.DB, job BackfillJob) (*BackfillState, error) {
+	state := &BackfillState{Job: job, Status: "pending"}
+	err := database.QueryRowContext(ctx,
+		`SELECT status, last_id, rows_updated, batches, COALESCE(started_at, ''), COALESCE(updated_at, ''), COALESCE(completed_at, '')
+		FROM backfill_jobs WHERE name = ?`, job.Name,
+	).Scan(&state.Status, &state.LastID, &state.RowsUpdated, &state.Batches, &state.StartedAt, &state.UpdatedAt, &state.CompletedAt)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to read backfill %s: %w", job.Name, err)
+	}
+	if state.Remaining, err = countBackfillRows(ctx, database, job); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// ListBackfillStates returns the state of every declared job.
+func ListBackfillStates(ctx context.Context, database *sql.DB) ([]*BackfillState, error) {
+	states := make([]*BackfillState, 0, len(BackfillJobs))
+	for _, job := range BackfillJobs {
+		state, err := GetBackfillState(ctx, database, job)
+		if err != nil {
+			return nil, err
+		}
+		states = append(states, state)
+	}
+	return states, nil
+}
+
+// RunBackfill corrects a job's rows in id order, one batch per transaction.
+// Each batch commits together with the job's progress, so a run that is
+// interrupted (or whose context is cancelled) loses at most the batch in
+// flight, and the next run resumes after the last committed one. A completed
+// job is refused unless opts.Restart is set.
+func RunBackfill(ctx context.Context, database *sql.DB, job BackfillJob, opts BackfillOptions) (*BackfillReport, error) {
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBackfillBatchSize
+	}
+
+	state, err := GetBackfillState(ctx, database, job)
+	if err != nil {
+		return nil, err
+	}
+	report := &BackfillReport{State: state}
+
+	if opts.DryRun {
+		report.Sample, err = backfillBatchIDs(ctx, database, job, "", backfillSampleSize)
+		return report, err
+	}
+
+	switch {
+	case state.Status == "completed" && !opts.Restart:
+		return nil, fmt.Errorf("backfill %s already completed at %s; use --restart to run it again", job.Name, state.CompletedAt)
+	case state.Status == "running" && !opts.Restart:
+		report.Resumed = true
+	default:
+		_, err := database.ExecContext(ctx,
+			`INSERT INTO backfill_jobs (name, status, last_id, rows_updated, batches, started_at, updated_at, completed_at)
+			VALUES (?, 'running', '', 0, 0, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, NULL)
+			ON CONFLICT(name) DO UPDATE SET status = 'running', last_id = '', rows_updated = 0, batches = 0,
+				started_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP, completed_at = NULL`, job.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start backfill %s: %w", job.Name, err)
+		}
+		state.Status, state.LastID, state.RowsUpdated, state.Batches = "running", "", 0, 0
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return report, fmt.Errorf("backfill %s interrupted after %s: %w", job.Name, state.LastID, err)
+		}
+		n, done, err := runBackfillBatch(ctx, database, job, state, batchSize)
+		if err != nil {
+			return report, err
+		}
+		if done {
+			break
+		}
+		report.Updated += n
+		report.Batches++
+		if opts.OnBatch != nil {
+			opts.OnBatch(state, n)
+		}
+	}
+
+	if state.Remaining, err = countBackfillRows(ctx, database, job); err != nil {
+		return report, err
+	}
+	return report, nil
+}
+
+// runBackfillBatch corrects the next batch after state.LastID and records the
+// progress in the same transaction, updating state once committed. It marks
+// the job completed and reports done when no rows are left.
+func runBackfillBatch(ctx context.Context, database *sql.DB, job BackfillJob, state *BackfillState, batchSize int) (int, bool, error) {
+	tx, err := database.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to begin backfill batch: %w", err)
+	}
+	defer tx.Rollback()
+
+	ids, err := backfillBatchIDs(ctx, tx, job, state.LastID, batchSize)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if len(ids) == 0 {
+		if _, err := tx.ExecContext(ctx,
+			"UPDATE backfill_jobs SET status = 'completed', updated_at = CURRENT_TIMESTAMP, completed_at = CURRENT_TIMESTAMP WHERE name = ?",
+			job.Name); err != nil {
+			return 0, false, fmt.Errorf("failed to complete backfill %s: %w", job.Name, err)
+		}
+		if err := tx.Commit(); err != nil {
+			return 0, false, fmt.Errorf("failed to complete backfill %s: %w", job.Name, err)
+		}
+		state.Status = "completed"
+		return 0, true, nil
+	}
+
+	args := make([]any, len(ids))
+	for i, id := range ids {
+		args[i] = id
+	}
+	result, err := tx.ExecContext(ctx,
+		fmt.Sprintf("UPDATE %s SET %s WHERE id IN (%s) AND (%s)", job.Table, job.Set, placeholders(len(ids)), job.Where),
+		args...)
+	if err != nil {
+		return 0, false, fmt.Errorf("backfill %s failed on batch after %q: %w", job.Name, state.LastID, err)
+	}
+	n, _ := result.RowsAffected()
+
+	lastID := ids[len(ids)-1]
+	if _, err := tx.ExecContext(ctx,
+		"UPDATE backfill_jobs SET last_id = ?, rows_updated = rows_updated + ?, batches = batches + 1, updated_at = CURRENT_TIMESTAMP WHERE name = ?",
+		lastID, n, job.Name); err != nil {
+		return 0, false, fmt.Errorf("failed to record backfill %s progress: %w", job.Name, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, false, fmt.Errorf("failed to commit backfill batch: %w", err)
+	}
+
+	state.LastID = lastID
+	state.RowsUpdated += int(n)
+	state.Batches++
+	return int(n), false, nil
+}
+
+// backfillQuerier is the subset of *sql.DB and *sql.Tx used to read batches.
+type backfillQuerier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// backfillBatchIDs returns up to limit ids after afterID still needing the job.
+// Ids are compared as text, which holds steady across VACUUM where rowids
+// of tables keyed by TEXT may not.
+func backfillBatchIDs(ctx context.Context, q backfillQuerier, job BackfillJob, afterID string, limit int) ([]string, error) {
+	rows, err := q.QueryContext(ctx,
+		fmt.Sprintf("SELECT id FROM %s WHERE id > ? AND (%s) ORDER BY id LIMIT ?", job.Table, job.Where),
+		afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select backfill %s rows: %w", job.Name, err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan backfill %s row: %w", job.Name, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// countBackfillRows counts the rows still needing the job.
+func countBackfillRows(ctx context.Context, database *sql.DB, job BackfillJob) (int, error) {
+	var n int
+	if err := database.QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", job.Table, job.Where)).Scan(&n); err != nil {
+		return 0, fmt.Errorf("failed to count backfill %s rows: %w", job.Name, err)
+	}
+	return n, nil
+}
+
+// placeholders returns n comma-separated "?" parameters.
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
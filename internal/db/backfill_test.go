@@ -0,0 +1,116 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// openBackfillTestDB loads a fixture ledger and upgrades it to the current schema.
+func openBackfillTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	fixture, err := os.ReadFile(filepath.Join("testdata", "migrations", "v29.sql"))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	database := openFixtureDB(t, string(fixture))
+	if _, err := applySchema(database); err != nil {
+		t.Fatalf("failed to upgrade fixture: %v", err)
+	}
+	return database
+}
+
+func TestBackfillJobs_Valid(t *testing.T) {
+	database := openBackfillTestDB(t)
+	seen := make(map[string]bool)
+	for _, job := range BackfillJobs {
+		if seen[job.Name] {
+			t.Errorf("duplicate backfill %s", job.Name)
+		}
+		seen[job.Name] = true
+		if _, err := GetBackfillState(context.Background(), database, job); err != nil {
+			t.Errorf("%s: %v", job.Name, err)
+		}
+	}
+}
+
+func TestRunBackfill(t *testing.T) {
+	database := openBackfillTestDB(t)
+	ctx := context.Background()
+	job, _ := FindBackfillJob("note-closed-at")
+
+	dry, err := RunBackfill(ctx, database, job, BackfillOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("dry run failed: %v", err)
+	}
+	if want := []string{"NOTE-002", "NOTE-006"}; !reflect.DeepEqual(dry.Sample, want) {
+		t.Errorf("Sample = %v, want %v", dry.Sample, want)
+	}
+	if dry.State.Status != "pending" || dry.State.Remaining != 2 {
+		t.Errorf("dry run state = %+v, want pending with 2 remaining", dry.State)
+	}
+
+	var batches []int
+	report, err := RunBackfill(ctx, database, job, BackfillOptions{
+		BatchSize: 1,
+		OnBatch:   func(_ *BackfillState, n int) { batches = append(batches, n) },
+	})
+	if err != nil {
+		t.Fatalf("RunBackfill failed: %v", err)
+	}
+	if report.Updated != 2 || !reflect.DeepEqual(batches, []int{1, 1}) {
+		t.Errorf("Updated = %d, batches = %v; want 2 in two batches", report.Updated, batches)
+	}
+	if report.State.Status != "completed" || report.State.Remaining != 0 || report.State.LastID != "NOTE-006" {
+		t.Errorf("state = %+v, want completed through NOTE-006", report.State)
+	}
+	if queryString(t, database, "SELECT COALESCE(closed_at, '') FROM notes WHERE id = 'NOTE-002'") == "" {
+		t.Error("NOTE-002 closed_at not backfilled")
+	}
+
+	if _, err := RunBackfill(ctx, database, job, BackfillOptions{}); err == nil || !strings.Contains(err.Error(), "already completed") {
+		t.Errorf("rerun error = %v, want already completed", err)
+	}
+	again, err := RunBackfill(ctx, database, job, BackfillOptions{Restart: true})
+	if err != nil {
+		t.Fatalf("restart failed: %v", err)
+	}
+	if again.Updated != 0 || again.State.Status != "completed" {
+		t.Errorf("restart = %+v, want no rows left to correct", again)
+	}
+}
+
+func TestRunBackfill_ResumesAfterInterruption(t *testing.T) {
+	database := openBackfillTestDB(t)
+	job, _ := FindBackfillJob("note-closed-at")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	_, err := RunBackfill(ctx, database, job, BackfillOptions{
+		BatchSize: 1,
+		OnBatch:   func(*BackfillState, int) { cancel() },
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("error = %v, want interruption", err)
+	}
+
+	state, err := GetBackfillState(context.Background(), database, job)
+	if err != nil {
+		t.Fatalf("GetBackfillState failed: %v", err)
+	}
+	if state.Status != "running" || state.LastID != "NOTE-002" || state.RowsUpdated != 1 || state.Remaining != 1 {
+		t.Fatalf("interrupted state = %+v, want running after NOTE-002", state)
+	}
+
+	report, err := RunBackfill(context.Background(), database, job, BackfillOptions{BatchSize: 1})
+	if err != nil {
+		t.Fatalf("resume failed: %v", err)
+	}
+	if !report.Resumed || report.Updated != 1 || report.State.RowsUpdated != 2 || report.State.Status != "completed" {
+		t.Errorf("resumed report = %+v, state = %+v", report, report.State)
+	}
+}
@@ -40,21 +40,45 @@ func GetDB() (*sql.DB, error) {
 		return nil, fmt.Errorf("failed to create .orc directory: %w", err)
 	}
 
-	// Open database connection
-	db, err = sql.Open("sqlite3", dbPath)
+	// Simulated runs work on a copy; the ledger itself is only read
+	infraPath := InfraDBPath()
+	if simulating {
+		if dbPath, err = openSandbox(dbPath); err != nil {
+			return nil, err
+		}
+		if infraPath != "" {
+			if infraPath, err = openInfraSandbox(infraPath); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// In two-ledger mode every connection attaches the infra database
+	if infraPath != "" {
+		if err := openInfra(infraPath); err != nil {
+			return nil, err
+		}
+	}
+
+	// Open database connection. Pragmas go in the DSN so every pooled
+	// connection gets them (summary loading reads on several connections).
+	db, err = sql.Open(driverName(), dbPath+"?_foreign_keys=on&_busy_timeout=5000")
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
-	// Enable foreign keys
-	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
-		return nil, fmt.Errorf("failed to enable foreign keys: %w", err)
+	// Verify the connection (sql.Open is lazy)
+	if err := db.Ping(); err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
 	// Run migrations on first connection (but avoid recursion)
 	if !dbInitialized {
 		dbInitialized = true
 		if err := InitSchema(); err != nil {
+			// Don't hand the unusable ledger to later callers
+			db.Close()
+			db, dbInitialized = nil, false
 			return nil, fmt.Errorf("failed to initialize schema: %w", err)
 		}
 	}
@@ -0,0 +1,88 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// DemoWorkbenchID is the demo workbench the tour runs its commands from.
+const DemoWorkbenchID = "BENCH-001"
+
+// CreateDemoLedger creates a self-contained ledger at path, with the current
+// schema and a small commission to explore: shipments in each state, tasks,
+// a draft plan, notes, a tome, and two workbenches that exist only in the
+// ledger (no worktrees or tmux sessions). It never touches the ledger GetDB
+// opens, and refuses to overwrite an existing file.
+func CreateDemoLedger(path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return fmt.Errorf("%s already exists", path)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	database, err := sql.Open("sqlite3", path+"?_foreign_keys=on")
+	if err != nil {
+		return fmt.Errorf("failed to create demo ledger: %w", err)
+	}
+	defer database.Close()
+
+	if _, err := applySchemaScript(database, GetSchemaSQL(), true); err != nil {
+		os.Remove(path)
+		return fmt.Errorf("failed to create schema: %w", err)
+	}
+	if err := seedDemo(database); err != nil {
+		database.Close()
+		os.Remove(path)
+		return err
+	}
+	return nil
+}
+
+// demoRows are the demo ledger's contents, inserted in order.
+var demoRows = []struct {
+	table string
+	query string
+	args  []any
+}{
+	{"factories", "INSERT INTO factories (id, name) VALUES (?, ?)", []any{"FACT-001", "demo"}},
+	{"workshops", "INSERT INTO workshops (id, factory_id, name) VALUES (?, ?, ?)", []any{"WORK-001", "FACT-001", "test-kitchen"}},
+	{"workbenches", "INSERT INTO workbenches (id, workshop_id, name, focused_id) VALUES (?, ?, ?, ?)", []any{DemoWorkbenchID, "WORK-001", "demo-imp", "SHIP-001"}},
+	{"workbenches", "INSERT INTO workbenches (id, workshop_id, name, focused_id) VALUES (?, ?, ?, ?)", []any{"BENCH-002", "WORK-001", "demo-reviewer", "SHIP-002"}},
+	{"commissions", "INSERT INTO commissions (id, workshop_id, title, description, status) VALUES (?, ?, ?, ?, 'active')", []any{"COMM-001", "WORK-001", "Launch the recipe app", "Everything needed to put the recipe app in front of its first users."}},
+
+	{"shipments", "INSERT INTO shipments (id, commission_id, title, description, status, assigned_workbench_id) VALUES (?, ?, ?, ?, ?, ?)", []any{"SHIP-001", "COMM-001", "Recipe search", "Find recipes by name or ingredient.", "in-progress", DemoWorkbenchID}},
+	{"shipments", "INSERT INTO shipments (id, commission_id, title, description, status, assigned_workbench_id) VALUES (?, ?, ?, ?, ?, ?)", []any{"SHIP-002", "COMM-001", "Shopping list", "Turn a week of recipes into one list.", "ready", "BENCH-002"}},
+	{"shipments", "INSERT INTO shipments (id, commission_id, title, description, status) VALUES (?, ?, ?, ?, ?)", []any{"SHIP-003", "COMM-001", "Welcome emails", "Greet new users and point them at search.", "draft"}},
+
+	{"tasks", "INSERT INTO tasks (id, shipment_id, commission_id, title, type, status, completed_at) VALUES (?, ?, ?, ?, ?, 'closed', CURRENT_TIMESTAMP)", []any{"TASK-001", "SHIP-001", "COMM-001", "Index recipes by ingredient", "implementation"}},
+	{"tasks", "INSERT INTO tasks (id, shipment_id, commission_id, title, type, depends_on) VALUES (?, ?, ?, ?, ?, ?)", []any{"TASK-002", "SHIP-001", "COMM-001", "Search endpoint", "implementation", `["TASK-001"]`}},
+	{"tasks", "INSERT INTO tasks (id, shipment_id, commission_id, title, type, depends_on) VALUES (?, ?, ?, ?, ?, ?)", []any{"TASK-003", "SHIP-001", "COMM-001", "Search results page", "implementation", `["TASK-002"]`}},
+	{"tasks", "INSERT INTO tasks (id, shipment_id, commission_id, title, type) VALUES (?, ?, ?, ?, ?)", []any{"TASK-004", "SHIP-002", "COMM-001", "Merge duplicate ingredients", "implementation"}},
+	{"tasks", "INSERT INTO tasks (id, shipment_id, commission_id, title, type) VALUES (?, ?, ?, ?, ?)", []any{"TASK-005", "SHIP-002", "COMM-001", "Print-friendly list", "implementation"}},
+
+	{"plans", "INSERT INTO plans (id, commission_id, task_id, title, content) VALUES (?, ?, ?, ?, ?)", []any{"PLAN-001", "COMM-001", "TASK-002", "Search endpoint plan", "## Approach\n\nAdd GET /recipes/search?q= backed by the ingredient index.\n\n## Files\n\n- api/search.go: handler and query parsing\n- api/search_test.go: table tests for names and ingredients\n"}},
+
+	{"tomes", "INSERT INTO tomes (id, commission_id, title) VALUES (?, ?, ?)", []any{"TOME-001", "COMM-001", "Product decisions"}},
+	{"notes", "INSERT INTO notes (id, commission_id, tome_id, title, content, type) VALUES (?, ?, ?, ?, ?, ?)", []any{"NOTE-001", "COMM-001", "TOME-001", "Search matches whole words only", "Partial matches returned too much noise in testing.", "decision"}},
+	{"notes", "INSERT INTO notes (id, commission_id, shipment_id, title, content, type) VALUES (?, ?, ?, ?, ?, ?)", []any{"NOTE-002", "COMM-001", "SHIP-001", "Should search rank by rating?", "Users asked for it; ratings aren't stored yet.", "question"}},
+	{"notes", "INSERT INTO notes (id, commission_id, title, type) VALUES (?, ?, ?, ?)", []any{"NOTE-003", "COMM-001", "Offline mode for the shopping list", "idea"}},
+}
+
+// seedDemo inserts the demo rows in one transaction.
+func seedDemo(database *sql.DB) error {
+	tx, err := database.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to seed demo ledger: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, row := range demoRows {
+		if _, err := tx.Exec(row.query, row.args...); err != nil {
+			return fmt.Errorf("seed %s: %w", row.table, err)
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,54 @@
+package db
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+)
+
+func TestCreateDemoLedger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "demo", "orc.db")
+	if err := CreateDemoLedger(path); err != nil {
+		t.Fatalf("CreateDemoLedger failed: %v", err)
+	}
+
+	ledger, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatalf("failed to open demo ledger: %v", err)
+	}
+	defer ledger.Close()
+
+	var version int
+	if err := ledger.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		t.Fatalf("failed to read schema version: %v", err)
+	}
+	if version != SchemaVersion {
+		t.Errorf("user_version = %d, want %d", version, SchemaVersion)
+	}
+
+	var focus string
+	if err := ledger.QueryRow("SELECT focused_id FROM workbenches WHERE id = ?", DemoWorkbenchID).Scan(&focus); err != nil {
+		t.Fatalf("demo workbench missing: %v", err)
+	}
+	if focus != "SHIP-001" {
+		t.Errorf("demo workbench focus = %q, want SHIP-001", focus)
+	}
+
+	var tasks int
+	if err := ledger.QueryRow("SELECT COUNT(*) FROM tasks WHERE commission_id = 'COMM-001'").Scan(&tasks); err != nil {
+		t.Fatalf("failed to count tasks: %v", err)
+	}
+	if tasks != 5 {
+		t.Errorf("demo tasks = %d, want 5", tasks)
+	}
+}
+
+func TestCreateDemoLedger_Exists(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "orc.db")
+	if err := CreateDemoLedger(path); err != nil {
+		t.Fatalf("CreateDemoLedger failed: %v", err)
+	}
+	if err := CreateDemoLedger(path); err == nil {
+		t.Error("expected an error creating over an existing ledger")
+	}
+}
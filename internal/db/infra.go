@@ -0,0 +1,293 @@
+package db
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+
+	sqlite3 "github.com/mattn/go-sqlite3"
+
+	"github.com/example/orc/internal/config"
+)
+
+// ledgerDriverName is the driver GetDB opens; it attaches the infra database
+// to every pooled connection in two-ledger mode.
+const ledgerDriverName = "sqlite3-ledger"
+
+// infraSchemaName is the name the infra database is attached under.
+const infraSchemaName = "infra"
+
+// infraTables are the tables two-ledger mode stores in the infra database:
+// the places work runs in and the records they churn out, which change far
+// more often than the work itself and would otherwise bloat its backups.
+var infraTables = map[string]bool{
+	"factories":     true,
+	"workshops":     true,
+	"workbenches":   true,
+	"workbench_env": true,
+	"focus_history": true,
+	"hook_events":   true,
+}
+
+// ledgerDriver is shared by the plain and profiled ledger drivers.
+var ledgerDriver = &sqlite3.SQLiteDriver{ConnectHook: connectLedger}
+
+// infraAttachPath is the infra database every new ledger connection attaches;
+// empty outside two-ledger mode. Set before the ledger is opened.
+var infraAttachPath string
+
+func init() {
+	sql.Register(ledgerDriverName, ledgerDriver)
+}
+
+// InfraDBPath returns the infra database path, or "" when a single ledger
+// holds everything.
+func InfraDBPath() string {
+	return os.Getenv(config.EnvInfraDBPath)
+}
+
+// TwoLedger reports whether infrastructure is stored apart from the work ledger.
+func TwoLedger() bool {
+	return InfraDBPath() != ""
+}
+
+// connectLedger prepares a new ledger connection: it attaches the infra
+// database and guards writes.
+func connectLedger(conn *sqlite3.SQLiteConn) error {
+	if err := attachInfra(conn); err != nil {
+		return err
+	}
+	guardWrites(conn)
+	return nil
+}
+
+// attachInfra attaches the infra database to a new connection and creates the
+// views that read from both databases. A persistent view can only see tables
+// in its own database, so those views are TEMP and made per connection.
+// Unqualified table names resolve across attached databases, so repositories
+// need no changes.
+func attachInfra(conn *sqlite3.SQLiteConn) error {
+	if infraAttachPath == "" {
+		return nil
+	}
+	if _, err := conn.Exec("ATTACH DATABASE ? AS "+infraSchemaName, []driver.Value{infraAttachPath}); err != nil {
+		return fmt.Errorf("failed to attach infra database: %w", err)
+	}
+	for _, view := range splitSchemas().crossViews {
+		if _, err := conn.Exec(view, nil); err != nil {
+			return fmt.Errorf("failed to create view: %w", err)
+		}
+	}
+	return nil
+}
+
+// openInfra brings the infra database at path up to date and arranges for
+// the ledger's connections to attach it. Cross-database references lose
+// their foreign keys, so the services validate them instead.
+func openInfra(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create infra database directory: %w", err)
+	}
+	infra, err := sql.Open("sqlite3", path+"?_foreign_keys=on&_busy_timeout=5000")
+	if err != nil {
+		return fmt.Errorf("failed to open infra database: %w", err)
+	}
+	defer infra.Close()
+	release, err := lockForMigration(infra)
+	if err != nil {
+		return fmt.Errorf("infra database: %w", err)
+	}
+	defer release()
+	if _, err := applySchemaScript(infra, splitSchemas().infra, false); err != nil {
+		return fmt.Errorf("failed to initialize infra schema: %w", err)
+	}
+	infraAttachPath = path
+	return nil
+}
+
+// checkLedgerMode refuses a ledger whose layout does not match the mode: a
+// single ledger opened with ORC_INFRA_DB_PATH set would hide the infra
+// database behind its own tables, and a split ledger opened without it
+// would grow a second, empty set of infra tables.
+func checkLedgerMode(database schemaConn) error {
+	var hasWork, hasInfra bool
+	if err := database.QueryRow("SELECT EXISTS (SELECT 1 FROM main.sqlite_master WHERE type = 'table' AND name = 'commissions')").Scan(&hasWork); err != nil {
+		return fmt.Errorf("failed to inspect ledger: %w", err)
+	}
+	if err := database.QueryRow("SELECT EXISTS (SELECT 1 FROM main.sqlite_master WHERE type = 'table' AND name = 'workbenches')").Scan(&hasInfra); err != nil {
+		return fmt.Errorf("failed to inspect ledger: %w", err)
+	}
+	switch {
+	case TwoLedger() && hasInfra:
+		return fmt.Errorf("%s is set but the ledger still holds infrastructure tables: split it with 'orc db split', or unset %s", config.EnvInfraDBPath, config.EnvInfraDBPath)
+	case !TwoLedger() && hasWork && !hasInfra:
+		return fmt.Errorf("the ledger's infrastructure is stored in a separate database: set %s to its path", config.EnvInfraDBPath)
+	}
+	return nil
+}
+
+// ledgerSchemaSQL returns the schema the ledger file itself holds: all of
+// schema.sql, or its work half in two-ledger mode.
+func ledgerSchemaSQL() string {
+	if TwoLedger() {
+		return splitSchemas().work
+	}
+	return SchemaSQL
+}
+
+// ledgerSchemas is schema.sql divided between the two databases.
+type ledgerSchemas struct {
+	work       string
+	infra      string
+	crossViews []string // TEMP views over tables in both databases
+}
+
+var splitSchemas = sync.OnceValue(func() ledgerSchemas {
+	return splitSchema(SchemaSQL)
+})
+
+var (
+	createTableRe = regexp.MustCompile(`(?i)^CREATE TABLE IF NOT EXISTS (\w+)`)
+	createIndexRe = regexp.MustCompile(`(?i)^CREATE (?:UNIQUE )?INDEX IF NOT EXISTS \w+ ON (\w+)`)
+	createViewRe  = regexp.MustCompile(`(?i)^CREATE VIEW IF NOT EXISTS`)
+	viewSourceRe  = regexp.MustCompile(`(?i)\b(?:FROM|JOIN)\s+(\w+)`)
+	foreignKeyRe  = regexp.MustCompile(`(?i)^\s*FOREIGN KEY .* REFERENCES (\w+)\s*\(`)
+)
+
+// splitSchema divides a schema script: infra tables and their indexes go to
+// the infra database, everything else to the work ledger, and views reading
+// infra tables become TEMP views. Foreign keys that would cross from one
+// database to the other are dropped, since SQLite cannot enforce them.
+func splitSchema(script string) ledgerSchemas {
+	var work, infra strings.Builder
+	var split ledgerSchemas
+	for _, stmt := range schemaStatements(script) {
+		body := statementBody(stmt)
+		switch {
+		case createTableRe.MatchString(body):
+			table := createTableRe.FindStringSubmatch(body)[1]
+			stmt = dropCrossForeignKeys(stmt, infraTables[table])
+			if infraTables[table] {
+				infra.WriteString(stmt)
+			} else {
+				work.WriteString(stmt)
+			}
+		case createIndexRe.MatchString(body):
+			if infraTables[createIndexRe.FindStringSubmatch(body)[1]] {
+				infra.WriteString(stmt)
+			} else {
+				work.WriteString(stmt)
+			}
+		case createViewRe.MatchString(body) && readsInfra(body):
+			split.crossViews = append(split.crossViews, createViewRe.ReplaceAllString(body, "CREATE TEMP VIEW IF NOT EXISTS"))
+		default:
+			work.WriteString(stmt)
+		}
+	}
+	split.work, split.infra = work.String(), infra.String()
+	return split
+}
+
+// schemaStatements splits a script into statements, each with the comment
+// lines before it. A statement ends at a line whose code ends with ";".
+func schemaStatements(script string) []string {
+	var stmts []string
+	var current strings.Builder
+	for _, line := range strings.SplitAfter(script, "\n") {
+		current.WriteString(line)
+		code, _ := splitSQLComment(line)
+		if strings.HasSuffix(strings.TrimSpace(code), ";") {
+			stmts = append(stmts, current.String())
+			current.Reset()
+		}
+	}
+	if strings.TrimSpace(current.String()) != "" {
+		stmts = append(stmts, current.String())
+	}
+	return stmts
+}
+
+// statementBody returns a statement without its leading comment lines.
+func statementBody(stmt string) string {
+	lines := strings.SplitAfter(stmt, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed != "" && !strings.HasPrefix(trimmed, "--") {
+			return strings.Join(lines[i:], "")
+		}
+	}
+	return ""
+}
+
+// dropCrossForeignKeys removes a CREATE TABLE's foreign keys to tables in the
+// other database, fixing up the comma left on the line before.
+func dropCrossForeignKeys(stmt string, inInfra bool) string {
+	lines := strings.SplitAfter(stmt, "\n")
+	kept := make([]string, 0, len(lines))
+	dropped := false
+	for _, line := range lines {
+		if m := foreignKeyRe.FindStringSubmatch(line); m != nil && infraTables[m[1]] != inInfra {
+			dropped = true
+			continue
+		}
+		kept = append(kept, line)
+	}
+	if !dropped {
+		return stmt
+	}
+	// The constraint list ends at the line holding ");"; the definition
+	// before it must not end with a comma.
+	for i := len(kept) - 1; i > 0; i-- {
+		if strings.HasPrefix(strings.TrimSpace(kept[i]), ");") {
+			kept[i-1] = trimTrailingComma(kept[i-1])
+			break
+		}
+	}
+	return strings.Join(kept, "")
+}
+
+// trimTrailingComma removes the comma ending a line's code, keeping any comment.
+func trimTrailingComma(line string) string {
+	code, comment := splitSQLComment(strings.TrimRight(line, "\n"))
+	trimmed := strings.TrimRight(code, " \t")
+	if !strings.HasSuffix(trimmed, ",") {
+		return line
+	}
+	code = strings.TrimSuffix(trimmed, ",")
+	if comment != "" {
+		code += " " + comment
+	}
+	if strings.HasSuffix(line, "\n") {
+		code += "\n"
+	}
+	return code
+}
+
+// splitSQLComment splits a line at its "--" comment, outside string literals.
+func splitSQLComment(line string) (code, comment string) {
+	inString := false
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\'':
+			inString = !inString
+		case !inString && strings.HasPrefix(line[i:], "--"):
+			return line[:i], line[i:]
+		}
+	}
+	return line, ""
+}
+
+// readsInfra reports whether a view selects from an infra table.
+func readsInfra(view string) bool {
+	for _, m := range viewSourceRe.FindAllStringSubmatch(view, -1) {
+		if infraTables[m[1]] {
+			return true
+		}
+	}
+	return false
+}
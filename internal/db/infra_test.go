@@ -0,0 +1,184 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/example/orc/internal/config"
+)
+
+func TestSplitSchema(t *testing.T) {
+	split := splitSchema(SchemaSQL)
+
+	for table := range infraTables {
+		create := "CREATE TABLE IF NOT EXISTS " + table + " ("
+		if !strings.Contains(split.infra, create) {
+			t.Errorf("infra schema missing %s", table)
+		}
+		if strings.Contains(split.work, create) {
+			t.Errorf("work schema still creates %s", table)
+		}
+		if strings.Contains(split.work, "REFERENCES "+table+"(") {
+			t.Errorf("work schema references infra table %s", table)
+		}
+	}
+	for _, table := range []string{"commissions", "repos", "tasks"} {
+		if strings.Contains(split.infra, "REFERENCES "+table+"(") {
+			t.Errorf("infra schema references work table %s", table)
+		}
+	}
+	if len(split.crossViews) != 1 || !strings.HasPrefix(split.crossViews[0], "CREATE TEMP VIEW IF NOT EXISTS shipment_list_view") {
+		t.Errorf("expected shipment_list_view as the only cross view, got %q", split.crossViews)
+	}
+
+	// Each half must build on its own
+	for name, script := range map[string]string{"work": split.work, "infra": split.infra} {
+		database, err := sql.Open("sqlite3", ":memory:")
+		if err != nil {
+			t.Fatal(err)
+		}
+		database.SetMaxOpenConns(1)
+		if _, err := database.Exec(script); err != nil {
+			t.Errorf("%s schema failed to apply: %v", name, err)
+		}
+		database.Close()
+	}
+}
+
+func TestDropCrossForeignKeys(t *testing.T) {
+	stmt := `CREATE TABLE IF NOT EXISTS notes (
+	id TEXT PRIMARY KEY,
+	position INTEGER, -- Reading order
+	FOREIGN KEY (workbench_id) REFERENCES workbenches(id) ON DELETE CASCADE
+);
+`
+	want := `CREATE TABLE IF NOT EXISTS notes (
+	id TEXT PRIMARY KEY,
+	position INTEGER -- Reading order
+);
+`
+	if got := dropCrossForeignKeys(stmt, false); got != want {
+		t.Errorf("dropCrossForeignKeys() =\n%s\nwant\n%s", got, want)
+	}
+	if got := dropCrossForeignKeys(stmt, true); got != stmt {
+		t.Errorf("infra table lost a foreign key to an infra table:\n%s", got)
+	}
+}
+
+func TestSplitLedger(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "orc.db")
+
+	ledger, err := sql.Open("sqlite3", path+"?_foreign_keys=on")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := applySchema(ledger); err != nil {
+		t.Fatalf("applySchema failed: %v", err)
+	}
+	for _, stmt := range []string{
+		"INSERT INTO factories (id, name) VALUES ('FACT-001', 'f')",
+		"INSERT INTO workshops (id, factory_id, name) VALUES ('WORK-001', 'FACT-001', 'w')",
+		"INSERT INTO workbenches (id, workshop_id, name) VALUES ('BENCH-001', 'WORK-001', 'bench')",
+		"INSERT INTO commissions (id, title) VALUES ('COMM-001', 'c')",
+		"INSERT INTO shipments (id, commission_id, title, assigned_workbench_id) VALUES ('SHIP-001', 'COMM-001', 's', 'BENCH-001')",
+	} {
+		if _, err := ledger.Exec(stmt); err != nil {
+			t.Fatalf("setup failed: %v", err)
+		}
+	}
+	ledger.Close()
+
+	blob := filepath.Join("ab", "ab12")
+	if err := os.MkdirAll(filepath.Join(dir, "blobs", "ab"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "blobs", blob), []byte("long body"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	workDir := filepath.Join(dir, "split")
+	if err := os.Mkdir(workDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	workPath, infraPath := filepath.Join(workDir, "work.db"), filepath.Join(dir, "infra.db")
+	report, err := SplitLedger(ctx, path, workPath, infraPath)
+	if err != nil {
+		t.Fatalf("SplitLedger failed: %v", err)
+	}
+	rows := map[string]SplitTable{}
+	for _, table := range report.Tables {
+		rows[table.Name] = table
+	}
+	if w := rows["workbenches"]; !w.Infra || w.Rows != 1 {
+		t.Errorf("workbenches = %+v, want 1 infra row", w)
+	}
+	if s := rows["shipments"]; s.Infra || s.Rows != 1 {
+		t.Errorf("shipments = %+v, want 1 work row", s)
+	}
+	// Externalized note bodies follow the work ledger
+	if data, err := os.ReadFile(filepath.Join(workDir, "blobs", blob)); err != nil || string(data) != "long body" || report.Blobs != 1 {
+		t.Errorf("blob copy = %q, %v (report.Blobs = %d), want the ledger's blob", data, err, report.Blobs)
+	}
+
+	if _, err := SplitLedger(ctx, path, workPath, infraPath); err == nil {
+		t.Error("expected an error when the targets exist")
+	}
+
+	// The work ledger, with the infra database attached, reads like the original
+	infraAttachPath = infraPath
+	t.Cleanup(func() { infraAttachPath = "" })
+	work, err := sql.Open(ledgerDriverName, workPath+"?_foreign_keys=on")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer work.Close()
+
+	var bench string
+	if err := work.QueryRow("SELECT workbench_name FROM shipment_list_view WHERE id = 'SHIP-001'").Scan(&bench); err != nil {
+		t.Fatalf("cross-database view failed: %v", err)
+	}
+	if bench != "bench" {
+		t.Errorf("workbench_name = %q, want bench", bench)
+	}
+	if _, err := work.Exec("INSERT INTO workbenches (id, workshop_id, name) VALUES ('BENCH-002', 'WORK-001', 'other')"); err != nil {
+		t.Fatalf("insert into attached infra table failed: %v", err)
+	}
+	var count int
+	if err := work.QueryRow("SELECT COUNT(*) FROM infra.workbenches").Scan(&count); err != nil || count != 2 {
+		t.Errorf("infra.workbenches count = %d (%v), want 2", count, err)
+	}
+
+	if err := checkLedgerMode(work); err == nil || !strings.Contains(err.Error(), config.EnvInfraDBPath) {
+		t.Errorf("expected split ledger without %s to be refused, got %v", config.EnvInfraDBPath, err)
+	}
+	t.Setenv(config.EnvInfraDBPath, infraPath)
+	if err := checkLedgerMode(work); err != nil {
+		t.Errorf("split ledger in two-ledger mode refused: %v", err)
+	}
+}
+
+func TestCheckLedgerMode_SingleLedgerWithInfraPath(t *testing.T) {
+	database, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer database.Close()
+	database.SetMaxOpenConns(1)
+	if _, err := applySchema(database); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := checkLedgerMode(database); err != nil {
+		t.Errorf("single ledger refused: %v", err)
+	}
+	t.Setenv(config.EnvInfraDBPath, filepath.Join(t.TempDir(), "infra.db"))
+	if err := checkLedgerMode(database); err == nil || !strings.Contains(err.Error(), "orc db split") {
+		t.Errorf("expected single ledger with %s to be refused, got %v", config.EnvInfraDBPath, err)
+	}
+}
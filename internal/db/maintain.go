@@ -0,0 +1,136 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// autoVacuumIncremental is the PRAGMA auto_vacuum value for INCREMENTAL mode.
+const autoVacuumIncremental = 2
+
+// MaintenanceReport describes the outcome of a maintenance run.
+type MaintenanceReport struct {
+	IntegrityErrors  []string // Rows from PRAGMA integrity_check other than "ok"
+	ForeignKeyErrors []string // One line per PRAGMA foreign_key_check violation
+	Analyzed         bool
+	VacuumMode       string // "incremental", "full" (one-time switch to incremental), or "" when skipped
+	PageSize         int
+	FreePagesBefore  int
+	FreePagesAfter   int
+}
+
+// Healthy reports whether the integrity and foreign key checks found nothing.
+func (r *MaintenanceReport) Healthy() bool {
+	return len(r.IntegrityErrors) == 0 && len(r.ForeignKeyErrors) == 0
+}
+
+// ReclaimedBytes returns the space returned to the filesystem by vacuuming.
+func (r *MaintenanceReport) ReclaimedBytes() int {
+	return (r.FreePagesBefore - r.FreePagesAfter) * r.PageSize
+}
+
+// Maintain runs integrity_check and foreign_key_check, then (unless checkOnly)
+// ANALYZE and a vacuum. Databases not yet in incremental auto_vacuum mode get a
+// one-time full VACUUM that switches them over; later runs vacuum incrementally.
+// Vacuuming is skipped when the integrity check fails.
+func Maintain(ctx context.Context, database *sql.DB, checkOnly bool) (*MaintenanceReport, error) {
+	// Pin one connection: auto_vacuum must be set on the connection that vacuums.
+	conn, err := database.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Close()
+
+	report := &MaintenanceReport{}
+
+	if report.IntegrityErrors, err = integrityCheck(ctx, conn); err != nil {
+		return nil, err
+	}
+	if report.ForeignKeyErrors, err = foreignKeyCheck(ctx, conn); err != nil {
+		return nil, err
+	}
+	if err := conn.QueryRowContext(ctx, "PRAGMA page_size").Scan(&report.PageSize); err != nil {
+		return nil, fmt.Errorf("failed to read page size: %w", err)
+	}
+	if err := conn.QueryRowContext(ctx, "PRAGMA freelist_count").Scan(&report.FreePagesBefore); err != nil {
+		return nil, fmt.Errorf("failed to read freelist count: %w", err)
+	}
+	report.FreePagesAfter = report.FreePagesBefore
+
+	if checkOnly || len(report.IntegrityErrors) > 0 {
+		return report, nil
+	}
+
+	if _, err := conn.ExecContext(ctx, "ANALYZE"); err != nil {
+		return nil, fmt.Errorf("failed to analyze: %w", err)
+	}
+	report.Analyzed = true
+
+	var autoVacuum int
+	if err := conn.QueryRowContext(ctx, "PRAGMA auto_vacuum").Scan(&autoVacuum); err != nil {
+		return nil, fmt.Errorf("failed to read auto_vacuum mode: %w", err)
+	}
+	if autoVacuum == autoVacuumIncremental {
+		if _, err := conn.ExecContext(ctx, "PRAGMA incremental_vacuum"); err != nil {
+			return nil, fmt.Errorf("failed to run incremental vacuum: %w", err)
+		}
+		report.VacuumMode = "incremental"
+	} else {
+		if _, err := conn.ExecContext(ctx, "PRAGMA auto_vacuum = INCREMENTAL"); err != nil {
+			return nil, fmt.Errorf("failed to enable incremental auto_vacuum: %w", err)
+		}
+		if _, err := conn.ExecContext(ctx, "VACUUM"); err != nil {
+			return nil, fmt.Errorf("failed to vacuum: %w", err)
+		}
+		report.VacuumMode = "full"
+	}
+
+	if err := conn.QueryRowContext(ctx, "PRAGMA freelist_count").Scan(&report.FreePagesAfter); err != nil {
+		return nil, fmt.Errorf("failed to read freelist count: %w", err)
+	}
+
+	return report, nil
+}
+
+// integrityCheck returns the problems reported by PRAGMA integrity_check.
+func integrityCheck(ctx context.Context, conn *sql.Conn) ([]string, error) {
+	rows, err := conn.QueryContext(ctx, "PRAGMA integrity_check")
+	if err != nil {
+		return nil, fmt.Errorf("failed to run integrity check: %w", err)
+	}
+	defer rows.Close()
+
+	var problems []string
+	for rows.Next() {
+		var line string
+		if err := rows.Scan(&line); err != nil {
+			return nil, fmt.Errorf("failed to scan integrity check: %w", err)
+		}
+		if line != "ok" {
+			problems = append(problems, line)
+		}
+	}
+	return problems, rows.Err()
+}
+
+// foreignKeyCheck returns one line per row that violates a foreign key.
+func foreignKeyCheck(ctx context.Context, conn *sql.Conn) ([]string, error) {
+	rows, err := conn.QueryContext(ctx, "PRAGMA foreign_key_check")
+	if err != nil {
+		return nil, fmt.Errorf("failed to run foreign key check: %w", err)
+	}
+	defer rows.Close()
+
+	var violations []string
+	for rows.Next() {
+		var table, parent string
+		var rowID sql.NullInt64
+		var fkID int
+		if err := rows.Scan(&table, &rowID, &parent, &fkID); err != nil {
+			return nil, fmt.Errorf("failed to scan foreign key check: %w", err)
+		}
+		violations = append(violations, fmt.Sprintf("%s rowid %d: missing %s row", table, rowID.Int64, parent))
+	}
+	return violations, rows.Err()
+}
@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func openMaintenanceTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	// File-backed (VACUUM is meaningless in memory); foreign keys off so tests can seed orphans.
+	database, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "orc.db"))
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	t.Cleanup(func() { database.Close() })
+	if _, err := database.Exec(GetSchemaSQL()); err != nil {
+		t.Fatalf("failed to apply schema: %v", err)
+	}
+	return database
+}
+
+func TestMaintain_HealthyDatabase(t *testing.T) {
+	database := openMaintenanceTestDB(t)
+	ctx := context.Background()
+
+	// Churn: insert and delete rows to leave free pages behind
+	for i := 0; i < 200; i++ {
+		if _, err := database.Exec("INSERT INTO tags (id, name, description) VALUES (?, ?, ?)",
+			fmt.Sprintf("TAG-%03d", i), fmt.Sprintf("tag-%d", i), strings.Repeat("x", 500)); err != nil {
+			t.Fatalf("failed to seed tag: %v", err)
+		}
+	}
+	if _, err := database.Exec("DELETE FROM tags"); err != nil {
+		t.Fatalf("failed to delete tags: %v", err)
+	}
+
+	report, err := Maintain(ctx, database, false)
+	if err != nil {
+		t.Fatalf("Maintain failed: %v", err)
+	}
+	if !report.Healthy() {
+		t.Errorf("expected healthy report, got integrity=%v fk=%v", report.IntegrityErrors, report.ForeignKeyErrors)
+	}
+	if !report.Analyzed {
+		t.Error("expected ANALYZE to run")
+	}
+	if report.VacuumMode != "full" {
+		t.Errorf("VacuumMode = %q, want %q on first run", report.VacuumMode, "full")
+	}
+	if report.FreePagesBefore == 0 {
+		t.Error("expected free pages before vacuum")
+	}
+	if report.FreePagesAfter != 0 {
+		t.Errorf("FreePagesAfter = %d, want 0", report.FreePagesAfter)
+	}
+	if report.ReclaimedBytes() <= 0 {
+		t.Errorf("expected reclaimed bytes, got %d", report.ReclaimedBytes())
+	}
+
+	// Subsequent runs vacuum incrementally
+	report, err = Maintain(ctx, database, false)
+	if err != nil {
+		t.Fatalf("second Maintain failed: %v", err)
+	}
+	if report.VacuumMode != "incremental" {
+		t.Errorf("VacuumMode = %q, want %q after switch", report.VacuumMode, "incremental")
+	}
+}
+
+func TestMaintain_ForeignKeyViolation(t *testing.T) {
+	database := openMaintenanceTestDB(t)
+	ctx := context.Background()
+
+	if _, err := database.Exec("INSERT INTO shipments (id, commission_id, title) VALUES ('SHIP-001', 'COMM-404', 'Orphan')"); err != nil {
+		t.Fatalf("failed to seed orphan shipment: %v", err)
+	}
+
+	report, err := Maintain(ctx, database, true)
+	if err != nil {
+		t.Fatalf("Maintain failed: %v", err)
+	}
+	if report.Healthy() {
+		t.Fatal("expected unhealthy report")
+	}
+	if len(report.ForeignKeyErrors) != 1 || !strings.Contains(report.ForeignKeyErrors[0], "shipments") {
+		t.Errorf("ForeignKeyErrors = %v, want one shipments violation", report.ForeignKeyErrors)
+	}
+	if report.Analyzed || report.VacuumMode != "" {
+		t.Error("expected check-only run to skip ANALYZE and VACUUM")
+	}
+}
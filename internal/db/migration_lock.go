@@ -0,0 +1,192 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/example/orc/internal/config"
+)
+
+// Migration lock timing. The holder heartbeats every migrationHeartbeat; a
+// lock whose heartbeat is older than migrationLockStale belongs to a process
+// that died mid-migration and is taken over.
+const (
+	migrationHeartbeat   = 5 * time.Second
+	migrationLockStale   = 30 * time.Second
+	migrationLockPoll    = 250 * time.Millisecond
+	defaultMigrationWait = time.Minute
+)
+
+// MigrationLock describes the process holding a ledger's migration lock.
+type MigrationLock struct {
+	PID          int
+	Host         string
+	AcquiredAt   string
+	HeartbeatAge time.Duration // Time since the holder's last heartbeat
+}
+
+func (l *MigrationLock) String() string {
+	return fmt.Sprintf("pid %d on %s (last heartbeat %s ago)", l.PID, l.Host, l.HeartbeatAge)
+}
+
+// MigrationLockedError reports that another process kept the migration lock
+// for longer than this one would wait.
+type MigrationLockedError struct {
+	Holder *MigrationLock
+	Waited time.Duration
+}
+
+func (e *MigrationLockedError) Error() string {
+	if e.Waited == 0 {
+		return fmt.Sprintf("ledger is being migrated by %s; try again shortly, or set %s to wait", e.Holder, config.EnvMigrationWait)
+	}
+	return fmt.Sprintf("ledger is still being migrated by %s after waiting %s; set %s to wait longer", e.Holder, e.Waited, config.EnvMigrationWait)
+}
+
+// migrationLockTable is migration_lock's CREATE TABLE from schema.sql. The
+// lock is taken before schema.sql is applied, so it creates its own table.
+var migrationLockTable = sync.OnceValue(func() string {
+	for _, stmt := range schemaStatements(SchemaSQL) {
+		body := strings.TrimSpace(statementBody(stmt))
+		if m := createTableRe.FindStringSubmatch(body); m != nil && m[1] == "migration_lock" {
+			return body
+		}
+	}
+	panic("schema.sql declares no migration_lock table")
+})
+
+// lockForMigration takes the migration lock when database is behind
+// SchemaVersion, so that processes starting together migrate it one at a
+// time; the others wait, then find it current. Applying schema.sql to a
+// current ledger changes nothing, so no lock is taken then. The returned
+// release is always safe to call.
+func lockForMigration(database *sql.DB) (release func(), err error) {
+	var version int
+	if err := database.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		return nil, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	if version >= SchemaVersion {
+		return func() {}, nil
+	}
+	wait, err := migrationWait()
+	if err != nil {
+		return nil, err
+	}
+	return lockMigrations(database, wait)
+}
+
+// migrationWait reads ORC_MIGRATION_WAIT, defaulting to a minute.
+func migrationWait() (time.Duration, error) {
+	env := os.Getenv(config.EnvMigrationWait)
+	if env == "" {
+		return defaultMigrationWait, nil
+	}
+	wait, err := time.ParseDuration(env)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", config.EnvMigrationWait, env, err)
+	}
+	return wait, nil
+}
+
+// lockMigrations takes database's migration lock, waiting up to wait for
+// another process to release it, and heartbeats until release is called.
+func lockMigrations(database *sql.DB, wait time.Duration) (release func(), err error) {
+	if _, err := database.Exec(migrationLockTable()); err != nil {
+		return nil, fmt.Errorf("failed to create migration lock: %w", err)
+	}
+	pid := os.Getpid()
+	host, _ := os.Hostname()
+
+	start := time.Now()
+	for {
+		acquired, err := tryLockMigrations(database, pid, host)
+		if err != nil {
+			return nil, err
+		}
+		if acquired {
+			break
+		}
+		if time.Since(start) >= wait {
+			holder, err := migrationLockHolder(database)
+			if err != nil {
+				return nil, err
+			}
+			if holder != nil {
+				return nil, &MigrationLockedError{Holder: holder, Waited: wait}
+			}
+			continue // Released since: try again
+		}
+		time.Sleep(migrationLockPoll)
+	}
+
+	stop, done := make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(migrationHeartbeat)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				_, _ = database.Exec("UPDATE migration_lock SET heartbeat_at = CURRENT_TIMESTAMP WHERE owner_pid = ? AND owner_host = ?", pid, host)
+			}
+		}
+	}()
+	return func() {
+		close(stop)
+		<-done
+		_, _ = database.Exec("DELETE FROM migration_lock WHERE owner_pid = ? AND owner_host = ?", pid, host)
+	}, nil
+}
+
+// tryLockMigrations takes the lock if it is free or its holder has stopped
+// heartbeating. The single statement makes the check and the take atomic.
+func tryLockMigrations(database *sql.DB, pid int, host string) (bool, error) {
+	result, err := database.Exec(`INSERT INTO migration_lock (id, owner_pid, owner_host, acquired_at, heartbeat_at)
+		VALUES (1, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+		ON CONFLICT(id) DO UPDATE SET owner_pid = excluded.owner_pid, owner_host = excluded.owner_host,
+			acquired_at = excluded.acquired_at, heartbeat_at = excluded.heartbeat_at
+		WHERE heartbeat_at < datetime('now', ?)`,
+		pid, host, fmt.Sprintf("-%d seconds", int(migrationLockStale.Seconds())))
+	if err != nil {
+		return false, fmt.Errorf("failed to take migration lock: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to take migration lock: %w", err)
+	}
+	return n == 1, nil
+}
+
+// migrationLockHolder returns the lock's holder, or nil when it is free or
+// the ledger has no lock table yet.
+func migrationLockHolder(database schemaConn) (*MigrationLock, error) {
+	var exists bool
+	if err := database.QueryRow("SELECT EXISTS (SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = 'migration_lock')").Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to read migration lock: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	var (
+		lock MigrationLock
+		age  int64
+	)
+	err := database.QueryRow(`SELECT owner_pid, owner_host, acquired_at,
+		CAST(strftime('%s', 'now') - strftime('%s', heartbeat_at) AS INTEGER)
+		FROM migration_lock WHERE id = 1`).Scan(&lock.PID, &lock.Host, &lock.AcquiredAt, &age)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migration lock: %w", err)
+	}
+	lock.HeartbeatAge = time.Duration(age) * time.Second
+	return &lock, nil
+}
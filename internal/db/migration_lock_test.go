@@ -0,0 +1,119 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// openLockTestDBs opens two handles on one ledger, as two processes would.
+func openLockTestDBs(t *testing.T) (*sql.DB, *sql.DB) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "orc.db")
+	open := func() *sql.DB {
+		database, err := sql.Open("sqlite3", path+"?_busy_timeout=5000")
+		if err != nil {
+			t.Fatalf("failed to open db: %v", err)
+		}
+		t.Cleanup(func() { database.Close() })
+		return database
+	}
+	return open(), open()
+}
+
+func TestLockMigrations_Exclusive(t *testing.T) {
+	first, second := openLockTestDBs(t)
+
+	release, err := lockMigrations(first, 0)
+	if err != nil {
+		t.Fatalf("lockMigrations failed: %v", err)
+	}
+
+	_, err = lockMigrations(second, 0)
+	var locked *MigrationLockedError
+	if !errors.As(err, &locked) {
+		t.Fatalf("expected MigrationLockedError while held, got %v", err)
+	}
+	if locked.Holder.PID != os.Getpid() {
+		t.Errorf("holder pid = %d, want %d", locked.Holder.PID, os.Getpid())
+	}
+	if want := fmt.Sprintf("pid %d on ", os.Getpid()); !strings.Contains(err.Error(), want) {
+		t.Errorf("error %q does not name the holder", err)
+	}
+
+	release()
+	release, err = lockMigrations(second, 0)
+	if err != nil {
+		t.Fatalf("lockMigrations after release failed: %v", err)
+	}
+	release()
+}
+
+func TestLockMigrations_WaitsForRelease(t *testing.T) {
+	first, second := openLockTestDBs(t)
+
+	release, err := lockMigrations(first, 0)
+	if err != nil {
+		t.Fatalf("lockMigrations failed: %v", err)
+	}
+	go func() {
+		time.Sleep(300 * time.Millisecond)
+		release()
+	}()
+
+	release, err = lockMigrations(second, 5*time.Second)
+	if err != nil {
+		t.Fatalf("expected to get the lock once released, got %v", err)
+	}
+	release()
+}
+
+func TestLockMigrations_TakesOverStaleLock(t *testing.T) {
+	first, second := openLockTestDBs(t)
+	if _, err := first.Exec(migrationLockTable()); err != nil {
+		t.Fatalf("failed to create lock table: %v", err)
+	}
+	if _, err := first.Exec(`INSERT INTO migration_lock (id, owner_pid, owner_host, acquired_at, heartbeat_at)
+		VALUES (1, 99999, 'gone', datetime('now', '-5 minutes'), datetime('now', '-2 minutes'))`); err != nil {
+		t.Fatalf("failed to seed stale lock: %v", err)
+	}
+
+	release, err := lockMigrations(second, 0)
+	if err != nil {
+		t.Fatalf("expected to take over a stale lock, got %v", err)
+	}
+	holder, err := migrationLockHolder(first)
+	if err != nil || holder == nil || holder.PID != os.Getpid() {
+		t.Errorf("holder = %+v (%v), want this process", holder, err)
+	}
+	release()
+
+	if holder, _ := migrationLockHolder(first); holder != nil {
+		t.Errorf("expected lock free after release, held by %s", holder)
+	}
+}
+
+func TestLockForMigration_CurrentLedger(t *testing.T) {
+	database, _ := openLockTestDBs(t)
+	if _, err := applySchema(database); err != nil {
+		t.Fatalf("applySchema failed: %v", err)
+	}
+	// Hold the lock elsewhere: a current ledger must not wait for it
+	release, err := lockMigrations(database, 0)
+	if err != nil {
+		t.Fatalf("lockMigrations failed: %v", err)
+	}
+	defer release()
+
+	t.Setenv("ORC_MIGRATION_WAIT", "0")
+	noop, err := lockForMigration(database)
+	if err != nil {
+		t.Fatalf("lockForMigration on a current ledger failed: %v", err)
+	}
+	noop()
+}
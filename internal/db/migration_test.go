@@ -0,0 +1,258 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Migration harness: each testdata/migrations/vN.sql is a golden ledger at
+// schema version N (that release's schema.sql plus representative rows).
+// Every fixture is upgraded with applySchema and must end up indistinguishable
+// in shape from a fresh ledger, with its rows and foreign keys intact.
+//
+// When a release changes schema.sql in a way that needs care (new columns on
+// existing tables, new constraints), add a fixture for the version before it:
+//
+//	git show <release>:internal/db/schema.sql  # then append rows and PRAGMA user_version
+
+var fixtureVersion = regexp.MustCompile(`^v(\d+)\.sql$`)
+
+func TestMigrations_UpgradeFixtures(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "migrations", "v*.sql"))
+	if err != nil {
+		t.Fatalf("failed to list fixtures: %v", err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("no migration fixtures found")
+	}
+
+	fresh := openFixtureDB(t, "")
+	if _, err := applySchema(fresh); err != nil {
+		t.Fatalf("failed to build fresh ledger: %v", err)
+	}
+	want := schemaShape(t, fresh)
+
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			match := fixtureVersion.FindStringSubmatch(filepath.Base(path))
+			if match == nil {
+				t.Fatalf("fixture name must be vN.sql")
+			}
+			version, _ := strconv.Atoi(match[1])
+
+			fixture, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("failed to read fixture: %v", err)
+			}
+			database := openFixtureDB(t, string(fixture))
+			if got := userVersion(t, database); got != version {
+				t.Fatalf("fixture records user_version %d, want %d", got, version)
+			}
+			before := rowCounts(t, database)
+
+			found, err := applySchema(database)
+			if err != nil {
+				t.Fatalf("applySchema failed: %v", err)
+			}
+			if found != version {
+				t.Errorf("applySchema found version %d, want %d", found, version)
+			}
+			if got := userVersion(t, database); got != SchemaVersion {
+				t.Errorf("user_version = %d after upgrade, want %d", got, SchemaVersion)
+			}
+
+			assertSameShape(t, want, schemaShape(t, database))
+			assertRowsPreserved(t, before, rowCounts(t, database))
+			assertForeignKeysValid(t, database)
+			assertViewsQueryable(t, database)
+
+			// Upgrading again is a no-op
+			if _, err := applySchema(database); err != nil {
+				t.Errorf("second applySchema failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestColumnInfo_AddColumnSQL(t *testing.T) {
+	col := columnInfo{Name: "owner", Type: "TEXT", NotNull: true}
+	if got := col.addColumnSQL("tags"); got != "ALTER TABLE tags ADD COLUMN owner TEXT" {
+		t.Errorf("addColumnSQL = %q", got)
+	}
+
+	col = columnInfo{Name: "status", Type: "TEXT", NotNull: true, Default: sql.NullString{String: "'open'", Valid: true}}
+	if got := col.addColumnSQL("tags"); got != "ALTER TABLE tags ADD COLUMN status TEXT NOT NULL DEFAULT 'open'" {
+		t.Errorf("addColumnSQL = %q", got)
+	}
+
+	col = columnInfo{Name: "seen_at", Type: "DATETIME", Default: sql.NullString{String: "CURRENT_TIMESTAMP", Valid: true}}
+	if got := col.addColumnSQL("tags"); got != "ALTER TABLE tags ADD COLUMN seen_at DATETIME" {
+		t.Errorf("addColumnSQL = %q", got)
+	}
+}
+
+// openFixtureDB opens a file-backed ledger with foreign keys on, as
+// production does, and loads the fixture SQL into it.
+func openFixtureDB(t *testing.T, fixture string) *sql.DB {
+	t.Helper()
+	database, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "orc.db")+"?_foreign_keys=on")
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	t.Cleanup(func() { database.Close() })
+	if fixture != "" {
+		if _, err := database.Exec(fixture); err != nil {
+			t.Fatalf("failed to load fixture: %v", err)
+		}
+	}
+	return database
+}
+
+// schemaShape maps each table and view to its column names and types.
+func schemaShape(t *testing.T, database *sql.DB) map[string]map[string]string {
+	t.Helper()
+	rows, err := database.Query("SELECT name FROM sqlite_master WHERE type IN ('table', 'view') AND name NOT LIKE 'sqlite_%'")
+	if err != nil {
+		t.Fatalf("failed to list tables: %v", err)
+	}
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			t.Fatalf("failed to scan table: %v", err)
+		}
+		names = append(names, name)
+	}
+	rows.Close()
+
+	shape := make(map[string]map[string]string, len(names))
+	for _, name := range names {
+		cols, err := database.Query(fmt.Sprintf("PRAGMA table_info(%s)", name))
+		if err != nil {
+			t.Fatalf("failed to read columns of %s: %v", name, err)
+		}
+		shape[name] = map[string]string{}
+		for cols.Next() {
+			var (
+				cid, notNull, pk int
+				col, colType     string
+				dflt             sql.NullString
+			)
+			if err := cols.Scan(&cid, &col, &colType, &notNull, &dflt, &pk); err != nil {
+				t.Fatalf("failed to scan column of %s: %v", name, err)
+			}
+			shape[name][col] = colType
+		}
+		cols.Close()
+	}
+	return shape
+}
+
+func assertSameShape(t *testing.T, want, got map[string]map[string]string) {
+	t.Helper()
+	for table, cols := range want {
+		gotCols, ok := got[table]
+		if !ok {
+			t.Errorf("missing table %s", table)
+			continue
+		}
+		for col, colType := range cols {
+			gotType, ok := gotCols[col]
+			if !ok {
+				t.Errorf("missing column %s.%s", table, col)
+				continue
+			}
+			if !strings.EqualFold(gotType, colType) {
+				t.Errorf("column %s.%s has type %s, want %s", table, col, gotType, colType)
+			}
+		}
+	}
+}
+
+// rowCounts counts the rows of every table.
+func rowCounts(t *testing.T, database *sql.DB) map[string]int {
+	t.Helper()
+	counts := map[string]int{}
+	for table := range schemaShape(t, database) {
+		var kind string
+		if err := database.QueryRow("SELECT type FROM sqlite_master WHERE name = ?", table).Scan(&kind); err != nil {
+			t.Fatalf("failed to read type of %s: %v", table, err)
+		}
+		if kind != "table" {
+			continue
+		}
+		var n int
+		if err := database.QueryRow(fmt.Sprintf("SELECT COUNT(*) FROM %s", table)).Scan(&n); err != nil {
+			t.Fatalf("failed to count %s: %v", table, err)
+		}
+		counts[table] = n
+	}
+	return counts
+}
+
+func assertRowsPreserved(t *testing.T, before, after map[string]int) {
+	t.Helper()
+	for table, n := range before {
+		if table == "schema_migrations" {
+			if after[table] != n+1 {
+				t.Errorf("schema_migrations has %d rows after upgrade, want %d (one per upgrade)", after[table], n+1)
+			}
+			continue
+		}
+		if after[table] != n {
+			t.Errorf("table %s has %d rows after upgrade, had %d", table, after[table], n)
+		}
+	}
+}
+
+func assertForeignKeysValid(t *testing.T, database *sql.DB) {
+	t.Helper()
+	rows, err := database.Query("PRAGMA foreign_key_check")
+	if err != nil {
+		t.Fatalf("foreign_key_check failed: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var (
+			table, parent string
+			rowid         sql.NullInt64
+			fkid          int
+		)
+		if err := rows.Scan(&table, &rowid, &parent, &fkid); err != nil {
+			t.Fatalf("failed to scan foreign_key_check: %v", err)
+		}
+		t.Errorf("dangling foreign key: %s row %d → %s", table, rowid.Int64, parent)
+	}
+}
+
+func assertViewsQueryable(t *testing.T, database *sql.DB) {
+	t.Helper()
+	rows, err := database.Query("SELECT name FROM sqlite_master WHERE type = 'view'")
+	if err != nil {
+		t.Fatalf("failed to list views: %v", err)
+	}
+	var views []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			t.Fatalf("failed to scan view: %v", err)
+		}
+		views = append(views, name)
+	}
+	rows.Close()
+
+	for _, view := range views {
+		rows, err := database.Query(fmt.Sprintf("SELECT * FROM %s", view))
+		if err != nil {
+			t.Errorf("view %s is not queryable: %v", view, err)
+			continue
+		}
+		rows.Close()
+	}
+}
@@ -0,0 +1,307 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// Migration is a schema upgrade recorded in a ledger's schema_migrations.
+type Migration struct {
+	Version     int
+	FromVersion int // 0 for new ledgers and ledgers that predate versioning
+	AppliedAt   string
+}
+
+// SchemaChange is one object a migration creates or alters.
+type SchemaChange struct {
+	Kind   string // table, column, index, view, or trigger
+	Name   string // Object name; table.column for columns
+	Detail string // Column type, or the table an index or trigger belongs to
+}
+
+// MigrationReport describes a ledger's migration state.
+type MigrationReport struct {
+	Path      string
+	Exists    bool // Whether the ledger file exists; a missing ledger is created on first use
+	Ledger    int  // The ledger's user_version
+	Binary    int  // SchemaVersion
+	Applied   []Migration
+	Pending   []SchemaChange // What migrating would change (or, after a dry run, did change on the copy)
+	DryRun    bool
+	Duration  time.Duration  // Dry runs: how long the migration took on the copy
+	Preserved bool           // Dry runs: row counts of existing tables are unchanged
+	Lock      *MigrationLock // The process migrating the ledger right now, if any
+}
+
+// UpToDate reports whether the ledger needs no migration.
+func (r *MigrationReport) UpToDate() bool {
+	return r.Ledger >= r.Binary && len(r.Pending) == 0
+}
+
+// InspectMigrations reports the ledger's applied upgrades and the schema
+// changes still pending, opening the file read-only. Unlike GetDB it does not
+// migrate the ledger.
+func InspectMigrations(path string) (*MigrationReport, error) {
+	report := &MigrationReport{Path: path, Binary: SchemaVersion}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		report.Pending, err = pendingChanges(nil)
+		return report, err
+	}
+	report.Exists = true
+
+	ledger, err := sql.Open("sqlite3", "file:"+path+"?mode=ro&_busy_timeout=5000")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ledger: %w", err)
+	}
+	defer ledger.Close()
+
+	if err := ledger.QueryRow("PRAGMA user_version").Scan(&report.Ledger); err != nil {
+		return nil, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	if report.Applied, err = appliedMigrations(ledger); err != nil {
+		return nil, err
+	}
+	if report.Pending, err = pendingChanges(ledger); err != nil {
+		return nil, err
+	}
+	if report.Lock, err = migrationLockHolder(ledger); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// DryRunMigrations migrates a temporary copy of the ledger inside a
+// transaction that is rolled back, and reports what changed. The ledger
+// itself is never opened for writing.
+func DryRunMigrations(path string) (*MigrationReport, error) {
+	report, err := InspectMigrations(path)
+	if err != nil {
+		return nil, err
+	}
+	report.DryRun = true
+
+	dir, err := os.MkdirTemp("", "orc-migrate-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	copyPath := filepath.Join(dir, "orc.db")
+	if report.Exists {
+		for _, suffix := range []string{"", "-wal"} {
+			if err := copyFile(path+suffix, copyPath+suffix); err != nil && !os.IsNotExist(err) {
+				return nil, fmt.Errorf("failed to copy ledger: %w", err)
+			}
+		}
+	}
+
+	ledger, err := sql.Open("sqlite3", copyPath+"?_foreign_keys=on")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ledger copy: %w", err)
+	}
+	defer ledger.Close()
+	ledger.SetMaxOpenConns(1)
+
+	before, err := tableRowCounts(ledger)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := ledger.Begin()
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	start := time.Now()
+	if _, err := applySchema(tx); err != nil {
+		return nil, fmt.Errorf("migration failed on the copy: %w", err)
+	}
+	report.Duration = time.Since(start)
+
+	after, err := schemaObjects(tx)
+	if err != nil {
+		return nil, err
+	}
+	// Everything the copy now has that it lacked is what migrating changes
+	report.Pending = diffSchema(before, after)
+
+	counts, err := tableRowCounts(tx)
+	if err != nil {
+		return nil, err
+	}
+	report.Preserved = true
+	for table, n := range before.counts {
+		if table != "schema_migrations" && counts.counts[table] != n {
+			report.Preserved = false
+		}
+	}
+	return report, nil
+}
+
+// appliedMigrations lists recorded upgrades, oldest first. Ledgers that
+// predate schema_migrations have none.
+func appliedMigrations(database schemaConn) ([]Migration, error) {
+	var exists int
+	if err := database.QueryRow("SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'schema_migrations'").Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to check schema_migrations: %w", err)
+	}
+	if exists == 0 {
+		return nil, nil
+	}
+
+	rows, err := database.Query("SELECT version, from_version, COALESCE(applied_at, '') FROM schema_migrations ORDER BY version")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	defer rows.Close()
+
+	var migrations []Migration
+	for rows.Next() {
+		var m Migration
+		if err := rows.Scan(&m.Version, &m.FromVersion, &m.AppliedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan migration: %w", err)
+		}
+		migrations = append(migrations, m)
+	}
+	return migrations, rows.Err()
+}
+
+// schemaSnapshot is the set of schema objects in a database.
+type schemaSnapshot struct {
+	objects map[string]SchemaChange // "kind:name" -> object
+	columns map[string][]columnInfo // table -> columns
+	counts  map[string]int          // table -> rows (only filled for dry runs)
+}
+
+// pendingChanges compares a ledger with schema.sql. A nil ledger is treated
+// as empty.
+func pendingChanges(ledger schemaConn) ([]SchemaChange, error) {
+	ref, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open reference schema: %w", err)
+	}
+	defer ref.Close()
+	ref.SetMaxOpenConns(1)
+	if _, err := ref.Exec(ledgerSchemaSQL()); err != nil {
+		return nil, fmt.Errorf("failed to build reference schema: %w", err)
+	}
+	want, err := schemaObjects(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	have := &schemaSnapshot{objects: map[string]SchemaChange{}, columns: map[string][]columnInfo{}}
+	if ledger != nil {
+		if have, err = schemaObjects(ledger); err != nil {
+			return nil, err
+		}
+	}
+	return diffSchema(have, want), nil
+}
+
+// schemaObjects reads the tables, indexes, views, triggers, and columns of a database.
+func schemaObjects(database schemaConn) (*schemaSnapshot, error) {
+	rows, err := database.Query("SELECT type, name, tbl_name FROM sqlite_master WHERE name NOT LIKE 'sqlite_%' AND type IN ('table', 'index', 'view', 'trigger')")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schema objects: %w", err)
+	}
+	snap := &schemaSnapshot{objects: map[string]SchemaChange{}}
+	for rows.Next() {
+		var obj SchemaChange
+		var table string
+		if err := rows.Scan(&obj.Kind, &obj.Name, &table); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan schema object: %w", err)
+		}
+		if obj.Kind == "index" || obj.Kind == "trigger" {
+			obj.Detail = "on " + table
+		}
+		snap.objects[obj.Kind+":"+obj.Name] = obj
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if snap.columns, err = tableColumns(database); err != nil {
+		return nil, err
+	}
+	return snap, nil
+}
+
+// diffSchema lists the objects and columns in want that have lacks: new
+// tables first, then columns added to existing tables, then the rest.
+func diffSchema(have, want *schemaSnapshot) []SchemaChange {
+	var tables, columns, others []SchemaChange
+	for _, key := range slices.Sorted(maps.Keys(want.objects)) {
+		obj := want.objects[key]
+		if _, ok := have.objects[key]; ok {
+			if obj.Kind == "table" {
+				columns = append(columns, missingColumns(obj.Name, have.columns[obj.Name], want.columns[obj.Name])...)
+			}
+			continue
+		}
+		if obj.Kind == "table" {
+			tables = append(tables, obj)
+		} else {
+			others = append(others, obj)
+		}
+	}
+	return append(append(tables, columns...), others...)
+}
+
+func missingColumns(table string, have, want []columnInfo) []SchemaChange {
+	present := make(map[string]bool, len(have))
+	for _, c := range have {
+		present[c.Name] = true
+	}
+	var changes []SchemaChange
+	for _, c := range want {
+		if !present[c.Name] {
+			changes = append(changes, SchemaChange{Kind: "column", Name: table + "." + c.Name, Detail: c.Type})
+		}
+	}
+	return changes
+}
+
+// tableRowCounts snapshots the schema and the row count of every table.
+func tableRowCounts(database schemaConn) (*schemaSnapshot, error) {
+	snap, err := schemaObjects(database)
+	if err != nil {
+		return nil, err
+	}
+	snap.counts = make(map[string]int, len(snap.columns))
+	for table := range snap.columns {
+		var n int
+		if err := database.QueryRow(fmt.Sprintf("SELECT COUNT(*) FROM %q", table)).Scan(&n); err != nil {
+			return nil, fmt.Errorf("failed to count rows of %s: %w", table, err)
+		}
+		snap.counts[table] = n
+	}
+	return snap, nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
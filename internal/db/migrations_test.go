@@ -0,0 +1,136 @@
+package db
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeFixtureLedger loads a migration fixture into a ledger file and
+// returns its path.
+func writeFixtureLedger(t *testing.T, fixture string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "migrations", fixture))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "orc.db")
+	database, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatalf("failed to open ledger: %v", err)
+	}
+	defer database.Close()
+	if _, err := database.Exec(string(data)); err != nil {
+		t.Fatalf("failed to load fixture: %v", err)
+	}
+	return path
+}
+
+func hasChange(changes []SchemaChange, kind, name string) bool {
+	for _, c := range changes {
+		if c.Kind == kind && c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func TestInspectMigrations(t *testing.T) {
+	path := writeFixtureLedger(t, "v10.sql")
+
+	report, err := InspectMigrations(path)
+	if err != nil {
+		t.Fatalf("InspectMigrations failed: %v", err)
+	}
+	if !report.Exists || report.Ledger != 10 || report.Binary != SchemaVersion {
+		t.Errorf("report = %+v, want existing ledger at v10", report)
+	}
+	if report.UpToDate() {
+		t.Error("UpToDate() = true for a v10 ledger")
+	}
+	if !hasChange(report.Pending, "table", "schema_migrations") {
+		t.Errorf("Pending = %+v, want schema_migrations table", report.Pending)
+	}
+	if len(report.Applied) != 0 {
+		t.Errorf("Applied = %+v, want none before schema_migrations existed", report.Applied)
+	}
+
+	// Inspecting does not migrate
+	again, err := InspectMigrations(path)
+	if err != nil {
+		t.Fatalf("second InspectMigrations failed: %v", err)
+	}
+	if again.Ledger != 10 {
+		t.Errorf("ledger version = %d after inspecting, want 10", again.Ledger)
+	}
+
+	// After migrating, the upgrade is recorded and nothing is pending
+	database, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatalf("failed to open ledger: %v", err)
+	}
+	if _, err := applySchema(database); err != nil {
+		t.Fatalf("applySchema failed: %v", err)
+	}
+	database.Close()
+
+	report, err = InspectMigrations(path)
+	if err != nil {
+		t.Fatalf("InspectMigrations failed: %v", err)
+	}
+	if !report.UpToDate() {
+		t.Errorf("Pending = %+v after migrating, want none", report.Pending)
+	}
+	if len(report.Applied) != 1 || report.Applied[0].Version != SchemaVersion || report.Applied[0].FromVersion != 10 || report.Applied[0].AppliedAt == "" {
+		t.Errorf("Applied = %+v, want one upgrade from v10", report.Applied)
+	}
+}
+
+func TestInspectMigrations_MissingLedger(t *testing.T) {
+	report, err := InspectMigrations(filepath.Join(t.TempDir(), "orc.db"))
+	if err != nil {
+		t.Fatalf("InspectMigrations failed: %v", err)
+	}
+	if report.Exists || !hasChange(report.Pending, "table", "tasks") {
+		t.Errorf("report = %+v, want missing ledger with every table pending", report)
+	}
+}
+
+func TestDryRunMigrations(t *testing.T) {
+	path := writeFixtureLedger(t, "v3.sql")
+	original, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read ledger: %v", err)
+	}
+
+	inspected, err := InspectMigrations(path)
+	if err != nil {
+		t.Fatalf("InspectMigrations failed: %v", err)
+	}
+	report, err := DryRunMigrations(path)
+	if err != nil {
+		t.Fatalf("DryRunMigrations failed: %v", err)
+	}
+
+	if !report.DryRun || !report.Preserved {
+		t.Errorf("report = %+v, want a dry run that preserved rows", report)
+	}
+	if len(report.Pending) != len(inspected.Pending) {
+		t.Errorf("dry run changed %d objects, inspection predicted %d:\n%+v\n%+v",
+			len(report.Pending), len(inspected.Pending), report.Pending, inspected.Pending)
+	}
+	for _, c := range inspected.Pending {
+		if !hasChange(report.Pending, c.Kind, c.Name) {
+			t.Errorf("dry run did not apply predicted %s %s", c.Kind, c.Name)
+		}
+	}
+
+	after, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read ledger: %v", err)
+	}
+	if string(after) != string(original) {
+		t.Error("dry run modified the ledger file")
+	}
+}
@@ -0,0 +1,150 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	sqlite3 "github.com/mattn/go-sqlite3"
+)
+
+// profiledDriverName is the driver GetDB opens once profiling is enabled.
+const profiledDriverName = "sqlite3-profiled"
+
+// profileSlowest is how many of the slowest queries a profile keeps.
+const profileSlowest = 5
+
+// profileSQLWidth is the longest SQL text kept for a slow query.
+const profileSQLWidth = 200
+
+func init() {
+	sql.Register(profiledDriverName, &profiledDriver{driver: ledgerDriver})
+}
+
+var (
+	profiling bool
+	profileMu sync.Mutex
+	profile   Profile
+)
+
+// Profile counts and times the queries run through the ledger connection.
+type Profile struct {
+	Queries   int
+	QueryTime time.Duration
+	Slowest   []QueryTiming // Slowest first
+}
+
+// QueryTiming is one query's SQL text and how long it took, including
+// reading its rows.
+type QueryTiming struct {
+	SQL      string
+	Duration time.Duration
+}
+
+// EnableProfiling makes GetDB open the ledger through a driver that records
+// every query in the process-wide profile. It must be called before the
+// first GetDB; later calls have no effect on an open connection.
+func EnableProfiling() {
+	profiling = true
+}
+
+// ProfileSnapshot returns a copy of the queries profiled so far.
+func ProfileSnapshot() Profile {
+	profileMu.Lock()
+	defer profileMu.Unlock()
+	snapshot := profile
+	snapshot.Slowest = append([]QueryTiming(nil), profile.Slowest...)
+	return snapshot
+}
+
+// driverName returns the sql driver GetDB should open.
+func driverName() string {
+	if profiling {
+		return profiledDriverName
+	}
+	return ledgerDriverName
+}
+
+// recordQuery adds a finished query to the profile, keeping the slowest few.
+func recordQuery(query string, d time.Duration) {
+	profileMu.Lock()
+	defer profileMu.Unlock()
+
+	profile.Queries++
+	profile.QueryTime += d
+	if len(profile.Slowest) == profileSlowest && d <= profile.Slowest[profileSlowest-1].Duration {
+		return
+	}
+	profile.Slowest = append(profile.Slowest, QueryTiming{SQL: compactSQL(query), Duration: d})
+	sort.SliceStable(profile.Slowest, func(i, j int) bool {
+		return profile.Slowest[i].Duration > profile.Slowest[j].Duration
+	})
+	if len(profile.Slowest) > profileSlowest {
+		profile.Slowest = profile.Slowest[:profileSlowest]
+	}
+}
+
+// compactSQL collapses whitespace and shortens long statements. Arguments are
+// bound separately, so the text carries no ledger data.
+func compactSQL(query string) string {
+	s := strings.Join(strings.Fields(query), " ")
+	if len(s) > profileSQLWidth {
+		s = s[:profileSQLWidth-3] + "..."
+	}
+	return s
+}
+
+// profiledDriver wraps the sqlite3 driver, timing queries and statements run
+// directly on a connection. The repositories never prepare statements, so
+// prepared statements are passed through untimed.
+type profiledDriver struct {
+	driver driver.Driver
+}
+
+func (d *profiledDriver) Open(dsn string) (driver.Conn, error) {
+	conn, err := d.driver.Open(dsn)
+	if err != nil {
+		return nil, err
+	}
+	return &profiledConn{conn.(*sqlite3.SQLiteConn)}, nil
+}
+
+type profiledConn struct {
+	*sqlite3.SQLiteConn
+}
+
+func (c *profiledConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	start := time.Now()
+	result, err := c.SQLiteConn.ExecContext(ctx, query, args)
+	recordQuery(query, time.Since(start))
+	return result, err
+}
+
+func (c *profiledConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	start := time.Now()
+	rows, err := c.SQLiteConn.QueryContext(ctx, query, args)
+	if err != nil {
+		recordQuery(query, time.Since(start))
+		return nil, err
+	}
+	// SQLite does most of a query's work while stepping through rows, so the
+	// timing ends when they are closed.
+	return &profiledRows{Rows: rows, query: query, start: start}, nil
+}
+
+type profiledRows struct {
+	driver.Rows
+	query string
+	start time.Time
+	once  sync.Once
+}
+
+func (r *profiledRows) Close() error {
+	err := r.Rows.Close()
+	r.once.Do(func() { recordQuery(r.query, time.Since(r.start)) })
+	return err
+}
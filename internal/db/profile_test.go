@@ -0,0 +1,121 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func resetProfile(t *testing.T) {
+	t.Helper()
+	profileMu.Lock()
+	profile = Profile{}
+	profileMu.Unlock()
+}
+
+func TestProfiledDriver_RecordsQueries(t *testing.T) {
+	resetProfile(t)
+	t.Cleanup(func() { resetProfile(t) })
+
+	database, err := sql.Open(profiledDriverName, ":memory:")
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	defer database.Close()
+	database.SetMaxOpenConns(1) // One in-memory database
+	ctx := context.Background()
+
+	if _, err := database.ExecContext(ctx, "CREATE TABLE t (id INTEGER PRIMARY KEY, name TEXT)"); err != nil {
+		t.Fatalf("create failed: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := database.ExecContext(ctx, "INSERT INTO t (name) VALUES (?)", fmt.Sprintf("row %d", i)); err != nil {
+			t.Fatalf("insert failed: %v", err)
+		}
+	}
+
+	rows, err := database.QueryContext(ctx, "SELECT   name\n\tFROM t ORDER BY id")
+	if err != nil {
+		t.Fatalf("query failed: %v", err)
+	}
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			t.Fatalf("scan failed: %v", err)
+		}
+		names = append(names, name)
+	}
+	rows.Close()
+	if len(names) != 3 {
+		t.Fatalf("expected 3 rows through the profiled driver, got %v", names)
+	}
+
+	var count int
+	if err := database.QueryRowContext(ctx, "SELECT COUNT(*) FROM t").Scan(&count); err != nil || count != 3 {
+		t.Fatalf("QueryRow = %d, %v; want 3", count, err)
+	}
+
+	// Transactions run through the same connection
+	tx, err := database.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("begin failed: %v", err)
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM t WHERE id = 1"); err != nil {
+		t.Fatalf("delete failed: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("commit failed: %v", err)
+	}
+
+	p := ProfileSnapshot()
+	if p.Queries != 7 {
+		t.Errorf("Queries = %d, want 7 (create, 3 inserts, 2 selects, delete)", p.Queries)
+	}
+	if p.QueryTime <= 0 {
+		t.Errorf("QueryTime = %v, want > 0", p.QueryTime)
+	}
+	if len(p.Slowest) != profileSlowest {
+		t.Errorf("kept %d slow queries, want %d", len(p.Slowest), profileSlowest)
+	}
+	for i := 1; i < len(p.Slowest); i++ {
+		if p.Slowest[i].Duration > p.Slowest[i-1].Duration {
+			t.Errorf("slow queries not sorted: %+v", p.Slowest)
+		}
+	}
+	for _, q := range p.Slowest {
+		if strings.Contains(q.SQL, "\n") || strings.Contains(q.SQL, "  ") {
+			t.Errorf("SQL not compacted: %q", q.SQL)
+		}
+	}
+}
+
+func TestRecordQuery_KeepsSlowest(t *testing.T) {
+	resetProfile(t)
+	t.Cleanup(func() { resetProfile(t) })
+
+	for i := 1; i <= 8; i++ {
+		recordQuery(fmt.Sprintf("SELECT %d", i), time.Duration(i)*time.Millisecond)
+	}
+	recordQuery("SELECT "+strings.Repeat("x", 300), time.Millisecond)
+
+	p := ProfileSnapshot()
+	if p.Queries != 9 || p.QueryTime != 37*time.Millisecond {
+		t.Errorf("Queries = %d, QueryTime = %v; want 9, 37ms", p.Queries, p.QueryTime)
+	}
+	var got []string
+	for _, q := range p.Slowest {
+		got = append(got, q.SQL)
+	}
+	want := []string{"SELECT 8", "SELECT 7", "SELECT 6", "SELECT 5", "SELECT 4"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Slowest = %v, want %v", got, want)
+	}
+
+	if s := compactSQL("SELECT " + strings.Repeat("x", 300)); len(s) != profileSQLWidth || !strings.HasSuffix(s, "...") {
+		t.Errorf("compactSQL did not shorten long SQL: %d chars", len(s))
+	}
+}
@@ -0,0 +1,224 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// PrefixRename describes a change of entity ID prefix, such as MISSION-004
+// becoming COMM-004 when missions were renamed to commissions.
+type PrefixRename struct {
+	From   string   // Old prefix, e.g. MISSION
+	To     string   // New prefix, e.g. COMM
+	Tables []string // Tables whose id column holds the renamed entities
+}
+
+// RenameTarget is a column a prefix rename rewrites.
+type RenameTarget struct {
+	Table  string
+	Column string
+	Kind   string // "id", "reference", "actor" (IMP-<prefix>-...), or "list" (JSON list of IDs)
+	Rows   int    // Rows holding the old prefix
+}
+
+// RenameMigration is the data migration generated for a PrefixRename.
+type RenameMigration struct {
+	Rename  PrefixRename
+	Targets []RenameTarget // Only columns with rows to rewrite
+	Aliases int            // Old IDs recorded in id_renames
+}
+
+var prefixPattern = regexp.MustCompile(`^[A-Z]+$`)
+
+// listColumns are text columns holding JSON lists of IDs.
+var listColumns = map[string]string{
+	"tasks": "depends_on",
+}
+
+// GeneratePrefixRename inspects the ledger and returns the migration that
+// renames r.From IDs to r.To: the ids of r.Tables, every *_id column
+// referencing them (declared foreign key or not), IMP actor IDs, ID lists,
+// and an id_renames record per renamed entity so old IDs keep resolving.
+// Free text (titles, note bodies, log values) is left alone.
+//
+// It refuses a rename that would leave old IDs behind in a table not listed,
+// or that would reuse a prefix already in use.
+func GeneratePrefixRename(database schemaConn, r PrefixRename) (*RenameMigration, error) {
+	if !prefixPattern.MatchString(r.From) || !prefixPattern.MatchString(r.To) {
+		return nil, fmt.Errorf("prefixes must be uppercase letters, e.g. MISSION and COMM (got %q and %q)", r.From, r.To)
+	}
+	if r.From == r.To {
+		return nil, fmt.Errorf("old and new prefix are both %s", r.From)
+	}
+	if len(r.Tables) == 0 {
+		return nil, fmt.Errorf("name the tables whose ids carry %s- with --table", r.From)
+	}
+
+	columns, err := tableColumns(database)
+	if err != nil {
+		return nil, err
+	}
+	renamed := make(map[string]bool, len(r.Tables))
+	for _, table := range r.Tables {
+		if !hasColumn(columns[table], "id") {
+			return nil, fmt.Errorf("%s is not a table with an id column", table)
+		}
+		renamed[table] = true
+	}
+
+	tables := make([]string, 0, len(columns))
+	for table := range columns {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+
+	m := &RenameMigration{Rename: r}
+	for _, table := range tables {
+		if hasColumn(columns[table], "id") {
+			inUse, err := countRows(database, table, prefixMatch("id", r.To))
+			if err != nil {
+				return nil, err
+			}
+			if inUse > 0 {
+				return nil, fmt.Errorf("prefix %s- is already used by %d row(s) in %s", r.To, inUse, table)
+			}
+			if !renamed[table] {
+				left, err := countRows(database, table, prefixMatch("id", r.From))
+				if err != nil {
+					return nil, err
+				}
+				if left > 0 {
+					return nil, fmt.Errorf("%s also has %d %s- id(s); include it with --table %s", table, left, r.From, table)
+				}
+			}
+		}
+
+		for _, c := range columns[table] {
+			target := RenameTarget{Table: table, Column: c.Name}
+			switch {
+			case c.Name == "id" && renamed[table]:
+				target.Kind = "id"
+			case c.Name == "actor_id":
+				target.Kind = "actor"
+			case listColumns[table] == c.Name:
+				target.Kind = "list"
+			case strings.HasSuffix(c.Name, "_id") && strings.EqualFold(c.Type, "TEXT") && !(table == "id_renames" && c.Name == "old_id"):
+				target.Kind = "reference"
+			default:
+				continue
+			}
+			where, _ := target.rewrite(r)
+			if target.Rows, err = countRows(database, table, where); err != nil {
+				return nil, err
+			}
+			if target.Rows == 0 {
+				continue
+			}
+			if target.Kind == "id" {
+				m.Aliases += target.Rows
+			}
+			m.Targets = append(m.Targets, target)
+		}
+	}
+	return m, nil
+}
+
+// Statements returns the migration's SQL, in order. Foreign keys must be
+// deferred while it runs, as ApplyPrefixRename does.
+func (m *RenameMigration) Statements() []string {
+	r := m.Rename
+	// Old IDs under the new prefix are live again after a rename back
+	stmts := []string{fmt.Sprintf("DELETE FROM id_renames WHERE %s", prefixMatch("old_id", r.To))}
+	for _, t := range m.Targets {
+		if t.Kind != "id" {
+			continue
+		}
+		where, set := t.rewrite(r)
+		stmts = append(stmts, fmt.Sprintf("INSERT OR REPLACE INTO id_renames (old_id, new_id) SELECT id, %s FROM %s WHERE %s", set, t.Table, where))
+	}
+	for _, t := range m.Targets {
+		where, set := t.rewrite(r)
+		stmts = append(stmts, fmt.Sprintf("UPDATE %s SET %s = %s WHERE %s", t.Table, t.Column, set, where))
+	}
+	return stmts
+}
+
+// SQL renders the migration as a script for review.
+func (m *RenameMigration) SQL() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "-- Rename %s- IDs to %s- (%s)\n", m.Rename.From, m.Rename.To, strings.Join(m.Rename.Tables, ", "))
+	b.WriteString("PRAGMA defer_foreign_keys = ON;\n")
+	for _, stmt := range m.Statements() {
+		b.WriteString(stmt + ";\n")
+	}
+	return b.String()
+}
+
+// ApplyPrefixRename generates and runs the migration for r in one
+// transaction, with foreign keys checked when it commits.
+func ApplyPrefixRename(ctx context.Context, database *sql.DB, r PrefixRename) (*RenameMigration, error) {
+	tx, err := database.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin rename: %w", err)
+	}
+	defer tx.Rollback()
+
+	m, err := GeneratePrefixRename(tx, r)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, "PRAGMA defer_foreign_keys = ON"); err != nil {
+		return nil, fmt.Errorf("failed to defer foreign keys: %w", err)
+	}
+	for _, stmt := range m.Statements() {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("rename failed on %q: %w", stmt, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit rename: %w", err)
+	}
+	return m, nil
+}
+
+// rewrite returns the WHERE clause selecting a target's old-prefix rows and
+// the expression giving their new value. Prefixes are validated uppercase
+// letters, so they are safe to inline.
+func (t RenameTarget) rewrite(r PrefixRename) (where, set string) {
+	switch t.Kind {
+	case "actor":
+		from, to := "IMP-"+r.From, "IMP-"+r.To
+		return prefixMatch(t.Column, from), fmt.Sprintf("'%s-' || substr(%s, %d)", to, t.Column, len(from)+2)
+	case "list":
+		return fmt.Sprintf(`instr(%s, '"%s-') > 0`, t.Column, r.From), fmt.Sprintf(`replace(%s, '"%s-', '"%s-')`, t.Column, r.From, r.To)
+	}
+	return prefixMatch(t.Column, r.From), fmt.Sprintf("'%s-' || substr(%s, %d)", r.To, t.Column, len(r.From)+2)
+}
+
+// prefixMatch returns a case-sensitive test for column starting with prefix-.
+func prefixMatch(column, prefix string) string {
+	return fmt.Sprintf("substr(%s, 1, %d) = '%s-'", column, len(prefix)+1, prefix)
+}
+
+// countRows counts the rows of table matching where.
+func countRows(database schemaConn, table, where string) (int, error) {
+	var n int
+	if err := database.QueryRow(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", table, where)).Scan(&n); err != nil {
+		return 0, fmt.Errorf("failed to count %s rows: %w", table, err)
+	}
+	return n, nil
+}
+
+// hasColumn reports whether cols includes name.
+func hasColumn(cols []columnInfo, name string) bool {
+	for _, c := range cols {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
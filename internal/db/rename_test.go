@@ -0,0 +1,128 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// openRenameTestDB loads a fixture ledger and upgrades it to the current schema.
+func openRenameTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	fixture, err := os.ReadFile(filepath.Join("testdata", "migrations", "v27.sql"))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	database := openFixtureDB(t, string(fixture))
+	if _, err := applySchema(database); err != nil {
+		t.Fatalf("failed to upgrade fixture: %v", err)
+	}
+	return database
+}
+
+func queryString(t *testing.T, database *sql.DB, query string) string {
+	t.Helper()
+	var s string
+	if err := database.QueryRow(query).Scan(&s); err != nil {
+		t.Fatalf("%s: %v", query, err)
+	}
+	return s
+}
+
+func TestGeneratePrefixRename(t *testing.T) {
+	database := openRenameTestDB(t)
+
+	m, err := GeneratePrefixRename(database, PrefixRename{From: "TASK", To: "JOB", Tables: []string{"tasks"}})
+	if err != nil {
+		t.Fatalf("GeneratePrefixRename failed: %v", err)
+	}
+	if m.Aliases != 3 {
+		t.Errorf("Aliases = %d, want 3", m.Aliases)
+	}
+	kinds := make(map[string]string)
+	for _, target := range m.Targets {
+		kinds[target.Table+"."+target.Column] = target.Kind
+	}
+	for column, want := range map[string]string{
+		"tasks.id":           "id",
+		"tasks.depends_on":   "list",
+		"plan_steps.task_id": "reference",
+		"plans.task_id":      "reference",
+	} {
+		if kinds[column] != want {
+			t.Errorf("%s kind = %q, want %q", column, kinds[column], want)
+		}
+	}
+	if _, ok := kinds["notes.id"]; ok {
+		t.Error("unrelated ids should not be targeted")
+	}
+
+	script := m.SQL()
+	for _, want := range []string{
+		"PRAGMA defer_foreign_keys = ON;",
+		"INSERT OR REPLACE INTO id_renames (old_id, new_id) SELECT id, 'JOB-' || substr(id, 6) FROM tasks WHERE substr(id, 1, 5) = 'TASK-';",
+		`UPDATE tasks SET depends_on = replace(depends_on, '"TASK-', '"JOB-') WHERE instr(depends_on, '"TASK-') > 0;`,
+	} {
+		if !strings.Contains(script, want) {
+			t.Errorf("script missing %q:\n%s", want, script)
+		}
+	}
+}
+
+func TestGeneratePrefixRename_Refuses(t *testing.T) {
+	database := openRenameTestDB(t)
+
+	tests := []struct {
+		name   string
+		rename PrefixRename
+		want   string
+	}{
+		{"lowercase prefix", PrefixRename{From: "task", To: "JOB", Tables: []string{"tasks"}}, "prefixes must be uppercase letters"},
+		{"no tables", PrefixRename{From: "TASK", To: "JOB"}, "name the tables"},
+		{"unknown table", PrefixRename{From: "TASK", To: "JOB", Tables: []string{"jobs"}}, "jobs is not a table with an id column"},
+		{"prefix in use", PrefixRename{From: "TASK", To: "WORK", Tables: []string{"tasks"}}, "prefix WORK- is already used by 1 row(s) in workshops"},
+		{"ids left behind", PrefixRename{From: "NOTE", To: "MEMO", Tables: []string{"tasks"}}, "notes also has"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := GeneratePrefixRename(database, tt.rename)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyPrefixRename(t *testing.T) {
+	database := openRenameTestDB(t)
+	ctx := context.Background()
+
+	if _, err := ApplyPrefixRename(ctx, database, PrefixRename{From: "BENCH", To: "DESK", Tables: []string{"workbenches"}}); err != nil {
+		t.Fatalf("ApplyPrefixRename failed: %v", err)
+	}
+	assertForeignKeysValid(t, database)
+	if got := queryString(t, database, "SELECT assigned_workbench_id FROM tasks WHERE id = 'TASK-001'"); got != "DESK-001" {
+		t.Errorf("task assignee = %q, want DESK-001", got)
+	}
+	if got := queryString(t, database, "SELECT actor_id FROM actor_throttles"); got != "IMP-DESK-001" {
+		t.Errorf("throttled actor = %q, want IMP-DESK-001", got)
+	}
+	if got := queryString(t, database, "SELECT new_id FROM id_renames WHERE old_id = 'BENCH-001'"); got != "DESK-001" {
+		t.Errorf("alias for BENCH-001 = %q, want DESK-001", got)
+	}
+
+	// A later rename carries earlier aliases along
+	if _, err := ApplyPrefixRename(ctx, database, PrefixRename{From: "DESK", To: "BENCH", Tables: []string{"workbenches"}}); err != nil {
+		t.Fatalf("ApplyPrefixRename back failed: %v", err)
+	}
+	if got := queryString(t, database, "SELECT new_id FROM id_renames WHERE old_id = 'DESK-001'"); got != "BENCH-001" {
+		t.Errorf("alias for DESK-001 = %q, want BENCH-001", got)
+	}
+	var live int
+	if err := database.QueryRow("SELECT COUNT(*) FROM id_renames WHERE old_id = 'BENCH-001'").Scan(&live); err != nil || live != 0 {
+		t.Errorf("live BENCH-001 should no longer be an alias (count %d, %v)", live, err)
+	}
+}
@@ -1,9 +1,23 @@
 package db
 
 import (
+	"database/sql"
 	_ "embed"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
 )
 
+// SchemaVersion is the schema revision this binary writes, recorded in the
+// ledger's PRAGMA user_version. Bump it whenever schema.sql changes so that
+// older binaries sharing a synced ledger can tell they are behind.
+const SchemaVersion = 31
+
+// ledgerSchemaVersion is the ledger's user_version as found when this
+// process opened it, before InitSchema brought it up to SchemaVersion.
+var ledgerSchemaVersion int
+
 // SchemaSQL is the complete modern schema for fresh ORC installs.
 // This schema reflects the current state after all migrations.
 //
@@ -27,21 +41,230 @@ import (
 //  2. Run: make schema-diff   (preview changes)
 //  3. Run: make schema-apply  (apply to local DB)
 //  4. Run: make test          (verify alignment)
+//  5. Bump SchemaVersion (TestSchemaVersion_BumpedWithSchema fails until you do)
 //
 //go:embed schema.sql
 var SchemaSQL string
 
-// InitSchema creates the database schema.
-// The schema.sql uses IF NOT EXISTS so this is idempotent.
+// InitSchema creates the database schema and records SchemaVersion.
+// The schema.sql uses IF NOT EXISTS so this is idempotent. An outdated
+// ledger is migrated under the migration lock (see lockForMigration).
 func InitSchema() error {
 	db, err := GetDB()
 	if err != nil {
 		return err
 	}
-	_, err = db.Exec(SchemaSQL)
+	if err := checkLedgerMode(db); err != nil {
+		return err
+	}
+	release, err := lockForMigration(db)
+	if err != nil {
+		return err
+	}
+	defer release()
+	ledgerSchemaVersion, err = applySchema(db)
 	return err
 }
 
+// schemaConn is what applying and inspecting the schema needs; both
+// *sql.DB and *sql.Tx satisfy it.
+type schemaConn interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+// applySchema applies schema.sql (its work half, in two-ledger mode) and
+// raises the ledger's user_version to SchemaVersion, recording the upgrade in
+// schema_migrations, and returns the version found beforehand. A ledger
+// written by a newer binary keeps its higher version.
+func applySchema(database schemaConn) (int, error) {
+	return applySchemaScript(database, ledgerSchemaSQL(), true)
+}
+
+// applySchemaScript applies script as applySchema does. record is false for
+// the infra database, which has no schema_migrations table.
+func applySchemaScript(database schemaConn, script string, record bool) (int, error) {
+	var found int
+	if err := database.QueryRow("PRAGMA user_version").Scan(&found); err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	if found < SchemaVersion {
+		if err := addMissingColumns(database, script); err != nil {
+			return found, err
+		}
+	}
+	if _, err := database.Exec(script); err != nil {
+		return found, err
+	}
+	if found < SchemaVersion {
+		// PRAGMA arguments can't be bound parameters
+		if _, err := database.Exec(fmt.Sprintf("PRAGMA user_version = %d", SchemaVersion)); err != nil {
+			return found, fmt.Errorf("failed to record schema version: %w", err)
+		}
+		if !record {
+			return found, nil
+		}
+		if _, err := database.Exec("INSERT OR REPLACE INTO schema_migrations (version, from_version) VALUES (?, ?)", SchemaVersion, found); err != nil {
+			return found, fmt.Errorf("failed to record schema migration: %w", err)
+		}
+	}
+	return found, nil
+}
+
+// addMissingColumns adds the columns script declares that the database's
+// existing tables lack. CREATE TABLE IF NOT EXISTS leaves existing tables
+// untouched, so without this a ledger created by an older binary never gains
+// columns added to its tables since. Columns are added with their type,
+// NOT NULL, and a constant default; CHECK and REFERENCES constraints are not
+// retrofitted. It runs before schema.sql so indexes on new columns succeed.
+func addMissingColumns(database schemaConn, script string) error {
+	ref, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		return fmt.Errorf("failed to open reference schema: %w", err)
+	}
+	defer ref.Close()
+	ref.SetMaxOpenConns(1) // each :memory: connection is its own database
+	if _, err := ref.Exec(script); err != nil {
+		return fmt.Errorf("failed to build reference schema: %w", err)
+	}
+
+	want, err := tableColumns(ref)
+	if err != nil {
+		return err
+	}
+	have, err := tableColumns(database)
+	if err != nil {
+		return err
+	}
+
+	for _, table := range slices.Sorted(maps.Keys(want)) {
+		existing, ok := have[table]
+		if !ok {
+			continue // new table: schema.sql creates it
+		}
+		present := make(map[string]bool, len(existing))
+		for _, c := range existing {
+			present[c.Name] = true
+		}
+		for _, c := range want[table] {
+			if present[c.Name] {
+				continue
+			}
+			if _, err := database.Exec(c.addColumnSQL(table)); err != nil {
+				return fmt.Errorf("failed to add column %s.%s: %w", table, c.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// columnInfo is one row of PRAGMA table_info.
+type columnInfo struct {
+	Name    string
+	Type    string
+	NotNull bool
+	Default sql.NullString
+}
+
+// addColumnSQL renders the ALTER TABLE statement that adds the column.
+// SQLite only accepts constant defaults on added columns, so expression
+// defaults such as CURRENT_TIMESTAMP are dropped.
+func (c columnInfo) addColumnSQL(table string) string {
+	stmt := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, c.Name, c.Type)
+	constDefault := c.Default.Valid && isConstantDefault(c.Default.String)
+	if c.NotNull && constDefault {
+		stmt += " NOT NULL"
+	}
+	if constDefault {
+		stmt += " DEFAULT " + c.Default.String
+	}
+	return stmt
+}
+
+// isConstantDefault reports whether a column default is a literal.
+func isConstantDefault(expr string) bool {
+	if expr == "" {
+		return false
+	}
+	switch expr[0] {
+	case '\'', '"', '-', '+', '.', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+		return true
+	}
+	upper := strings.ToUpper(expr)
+	return upper == "NULL" || upper == "TRUE" || upper == "FALSE"
+}
+
+// tableColumns maps each table in the database to its columns.
+func tableColumns(database schemaConn) (map[string][]columnInfo, error) {
+	rows, err := database.Query("SELECT name FROM sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%'")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	var tables []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan table name: %w", err)
+		}
+		tables = append(tables, name)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]columnInfo, len(tables))
+	for _, table := range tables {
+		cols, err := database.Query(fmt.Sprintf("PRAGMA table_info(%s)", table))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read columns of %s: %w", table, err)
+		}
+		for cols.Next() {
+			var (
+				cid int
+				c   columnInfo
+				pk  int
+			)
+			if err := cols.Scan(&cid, &c.Name, &c.Type, &c.NotNull, &c.Default, &pk); err != nil {
+				cols.Close()
+				return nil, fmt.Errorf("failed to scan column of %s: %w", table, err)
+			}
+			result[table] = append(result[table], c)
+		}
+		cols.Close()
+		if err := cols.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// SchemaStatus compares this binary's schema version with the ledger's.
+type SchemaStatus struct {
+	Binary int // SchemaVersion
+	Ledger int // The ledger's version when opened; 0 for ledgers that predate versioning
+}
+
+// LedgerAhead reports whether a newer orc has written the ledger.
+func (s SchemaStatus) LedgerAhead() bool {
+	return s.Ledger > s.Binary
+}
+
+// LedgerUpgraded reports whether this binary just raised a versioned ledger's schema.
+func (s SchemaStatus) LedgerUpgraded() bool {
+	return s.Ledger != 0 && s.Ledger < s.Binary
+}
+
+// CheckSchema opens the ledger and reports its schema version relative to this binary.
+func CheckSchema() (SchemaStatus, error) {
+	if _, err := GetDB(); err != nil {
+		return SchemaStatus{}, err
+	}
+	return SchemaStatus{Binary: SchemaVersion, Ledger: ledgerSchemaVersion}, nil
+}
+
 // GetSchemaSQL returns the authoritative schema SQL for use by tests.
 // Tests should use this instead of hardcoding their own schema to prevent drift.
 func GetSchemaSQL() string {
@@ -28,6 +28,7 @@ CREATE TABLE IF NOT EXISTS repos (
 	url TEXT,
 	local_path TEXT,
 	default_branch TEXT DEFAULT 'main',
+	bootstrap_script TEXT,
 	status TEXT NOT NULL CHECK(status IN ('active', 'archived')) DEFAULT 'active',
 	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
@@ -66,6 +67,9 @@ CREATE TABLE IF NOT EXISTS workbenches (
 	home_branch TEXT,
 	current_branch TEXT,
 	focused_id TEXT,
+	bootstrap_status TEXT CHECK(bootstrap_status IN ('pending', 'succeeded', 'failed')),
+	bootstrap_output TEXT,
+	bootstrapped_at DATETIME,
 	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	FOREIGN KEY (workshop_id) REFERENCES workshops(id),
@@ -104,6 +108,8 @@ CREATE TABLE IF NOT EXISTS shipments (
 	branch TEXT,
 	pinned INTEGER DEFAULT 0,
 	spec_note_id TEXT,
+	charter TEXT,
+	autorun TEXT, -- NULL (off), 'on', or 'paused'
 	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	completed_at DATETIME,
@@ -122,6 +128,7 @@ CREATE TABLE IF NOT EXISTS tomes (
 	status TEXT NOT NULL CHECK(status IN ('open', 'closed')) DEFAULT 'open',
 	assigned_workbench_id TEXT,
 	pinned INTEGER DEFAULT 0,
+	charter TEXT,
 	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	closed_at DATETIME,
@@ -143,9 +150,12 @@ CREATE TABLE IF NOT EXISTS tasks (
 	assigned_workbench_id TEXT,
 	pinned INTEGER DEFAULT 0,
 	depends_on TEXT,
+	points INTEGER, -- Estimate in task points (for commission budgets)
+	due_date DATE, -- YYYY-MM-DD, e.g. from orc task add "... due:fri"
 	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	claimed_at DATETIME,
+	claim_refreshed_at DATETIME, -- Last heartbeat from the claiming workbench (claims expire without one)
 	completed_at DATETIME,
 	FOREIGN KEY (shipment_id) REFERENCES shipments(id) ON DELETE CASCADE,
 	FOREIGN KEY (commission_id) REFERENCES commissions(id),
@@ -212,6 +222,12 @@ CREATE TABLE IF NOT EXISTS notes (
 	promoted_from_type TEXT,
 	close_reason TEXT,
 	closed_by_note_id TEXT,
+	position INTEGER, -- Reading order within the tome; NULL notes follow the ordered ones
+	severity TEXT CHECK(severity IN ('P0', 'P1', 'P2', 'P3')), -- Bug notes only
+	triage_status TEXT CHECK(triage_status IN ('untriaged', 'accepted', 'needs_info', 'wont_fix')), -- Bug notes only; NULL on older bugs means untriaged
+	resolution TEXT CHECK(resolution IN ('fixed', 'duplicate', 'wontfix', 'promoted', 'superseded')), -- Set when closed; NULL while open and on notes closed before resolutions
+	draft INTEGER DEFAULT 0, -- Handoff notes only: 1 until the IMP confirms the summary drafted at session end
+	content_blob TEXT, -- SHA-256 of a large body kept under blobs/ beside the ledger; content is NULL then
 	FOREIGN KEY (commission_id) REFERENCES commissions(id),
 	FOREIGN KEY (shipment_id) REFERENCES shipments(id) ON DELETE SET NULL,
 	FOREIGN KEY (tome_id) REFERENCES tomes(id) ON DELETE SET NULL,
@@ -266,6 +282,8 @@ CREATE TABLE IF NOT EXISTS workshop_logs (
 	field_name TEXT,
 	old_value TEXT,
 	new_value TEXT,
+	undo_of TEXT, -- Log entry this entry reverted (set by orc undo)
+	forced INTEGER NOT NULL DEFAULT 0, -- 1 when a guard was overridden with --force
 	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
 	FOREIGN KEY (workshop_id) REFERENCES workshops(id) ON DELETE CASCADE
 );
@@ -296,3 +314,372 @@ CREATE TABLE IF NOT EXISTS hook_events (
 CREATE INDEX IF NOT EXISTS idx_hook_events_workbench ON hook_events(workbench_id);
 CREATE INDEX IF NOT EXISTS idx_hook_events_timestamp ON hook_events(timestamp DESC);
 CREATE INDEX IF NOT EXISTS idx_hook_events_type ON hook_events(hook_type);
+
+-- Commit Links (commits whose messages reference a task or shipment ID)
+CREATE TABLE IF NOT EXISTS commit_links (
+	commit_sha TEXT NOT NULL,
+	entity_type TEXT NOT NULL CHECK(entity_type IN ('task', 'shipment')),
+	entity_id TEXT NOT NULL,
+	workbench_id TEXT,
+	subject TEXT NOT NULL,
+	committed_at DATETIME,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (commit_sha, entity_id),
+	FOREIGN KEY (workbench_id) REFERENCES workbenches(id) ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS idx_commit_links_entity ON commit_links(entity_id);
+
+-- Task Checklist Items (lightweight sub-steps within a task)
+CREATE TABLE IF NOT EXISTS task_checklist_items (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	task_id TEXT NOT NULL,
+	text TEXT NOT NULL,
+	done INTEGER DEFAULT 0,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	completed_at DATETIME,
+	FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_task_checklist_items_task ON task_checklist_items(task_id);
+
+-- Entity Aliases (human-friendly slugs accepted wherever an ID is)
+CREATE TABLE IF NOT EXISTS entity_aliases (
+	entity_id TEXT PRIMARY KEY,
+	entity_type TEXT NOT NULL CHECK(entity_type IN ('shipment', 'task', 'tome')),
+	commission_id TEXT NOT NULL,
+	slug TEXT NOT NULL,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (commission_id) REFERENCES commissions(id) ON DELETE CASCADE,
+	UNIQUE(commission_id, slug)
+);
+CREATE INDEX IF NOT EXISTS idx_entity_aliases_slug ON entity_aliases(slug);
+
+-- ID Renames (old IDs kept resolving after an entity prefix rename)
+CREATE TABLE IF NOT EXISTS id_renames (
+	old_id TEXT PRIMARY KEY, -- e.g. MISSION-004
+	new_id TEXT NOT NULL, -- e.g. COMM-004
+	renamed_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Plan Steps (approved plan sections tracked against tasks)
+CREATE TABLE IF NOT EXISTS plan_steps (
+	plan_id TEXT NOT NULL,
+	position INTEGER NOT NULL,
+	title TEXT NOT NULL,
+	task_id TEXT,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (plan_id, position),
+	FOREIGN KEY (plan_id) REFERENCES plans(id) ON DELETE CASCADE,
+	FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS idx_plan_steps_task ON plan_steps(task_id);
+
+-- Plan Conditions (amendments required by a conditional approval; the plan
+-- stays draft until every condition is addressed)
+CREATE TABLE IF NOT EXISTS plan_conditions (
+	plan_id TEXT NOT NULL,
+	position INTEGER NOT NULL,
+	description TEXT NOT NULL,
+	status TEXT NOT NULL CHECK(status IN ('open', 'addressed')) DEFAULT 'open',
+	evidence TEXT,
+	approved_by TEXT NOT NULL,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	addressed_by TEXT,
+	addressed_at DATETIME,
+	PRIMARY KEY (plan_id, position),
+	FOREIGN KEY (plan_id) REFERENCES plans(id) ON DELETE CASCADE
+);
+
+-- Secrets (encrypted integration credentials, scoped global/factory/repo)
+CREATE TABLE IF NOT EXISTS secrets (
+	name TEXT NOT NULL,
+	scope_type TEXT NOT NULL CHECK(scope_type IN ('global', 'factory', 'repo')),
+	scope_id TEXT NOT NULL DEFAULT '',
+	ciphertext TEXT NOT NULL,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (name, scope_type, scope_id)
+);
+
+-- Comments (lightweight attributed remarks on any entity, threaded by reply_to_id)
+CREATE TABLE IF NOT EXISTS comments (
+	id TEXT PRIMARY KEY,
+	entity_id TEXT NOT NULL,
+	entity_type TEXT NOT NULL CHECK(entity_type IN ('commission', 'shipment', 'task', 'tome', 'note', 'plan')),
+	reply_to_id TEXT,
+	author TEXT,
+	body TEXT NOT NULL,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (reply_to_id) REFERENCES comments(id) ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS idx_comments_entity ON comments(entity_id);
+
+-- Workbench environment variables (injected into tmux panes and agent sessions)
+-- A variable holds either a plain value or a reference to a secret, resolved at injection time.
+CREATE TABLE IF NOT EXISTS workbench_env (
+	workbench_id TEXT NOT NULL,
+	name TEXT NOT NULL,
+	value TEXT,
+	secret_name TEXT,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (workbench_id, name),
+	FOREIGN KEY (workbench_id) REFERENCES workbenches(id) ON DELETE CASCADE
+);
+
+-- Tag routes (the workbench that specializes in a tag's tasks)
+CREATE TABLE IF NOT EXISTS tag_routes (
+	tag_id TEXT PRIMARY KEY,
+	workbench_id TEXT NOT NULL,
+	mode TEXT NOT NULL CHECK(mode IN ('suggest', 'assign')) DEFAULT 'suggest',
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE,
+	FOREIGN KEY (workbench_id) REFERENCES workbenches(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_tag_routes_workbench ON tag_routes(workbench_id);
+
+-- Read models: denormalized list views so list queries fetch each row's
+-- tag, checklist, comment, and task counts in one query instead of per row.
+-- Views are computed on read, so they never go stale and need no triggers.
+CREATE VIEW IF NOT EXISTS task_list_view AS
+SELECT t.*,
+	(SELECT MIN(tg.name) FROM entity_tags et JOIN tags tg ON tg.id = et.tag_id
+	 WHERE et.entity_id = t.id AND et.entity_type = 'task') AS tag_name,
+	(SELECT COUNT(*) FROM task_checklist_items c WHERE c.task_id = t.id AND c.done = 1) AS checklist_done,
+	(SELECT COUNT(*) FROM task_checklist_items c WHERE c.task_id = t.id) AS checklist_total,
+	(SELECT COUNT(*) FROM comments cm WHERE cm.entity_id = t.id AND cm.entity_type = 'task') AS comment_count
+FROM tasks t;
+
+CREATE VIEW IF NOT EXISTS shipment_list_view AS
+SELECT s.*,
+	(SELECT COUNT(*) FROM tasks t WHERE t.shipment_id = s.id) AS task_count,
+	(SELECT COUNT(*) FROM tasks t WHERE t.shipment_id = s.id AND t.status = 'closed') AS tasks_closed,
+	(SELECT w.name FROM workbenches w WHERE w.id = s.assigned_workbench_id) AS workbench_name
+FROM shipments s;
+
+-- Commission Budgets (planned spend in hours or task points, with warning thresholds)
+CREATE TABLE IF NOT EXISTS commission_budgets (
+	commission_id TEXT PRIMARY KEY,
+	unit TEXT NOT NULL CHECK(unit IN ('hours', 'points')),
+	amount REAL NOT NULL CHECK(amount > 0),
+	thresholds TEXT NOT NULL DEFAULT '75,90', -- Comma-separated warning percentages
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (commission_id) REFERENCES commissions(id) ON DELETE CASCADE
+);
+
+-- Glossary (a commission's terms; deprecated terms name their replacement)
+CREATE TABLE IF NOT EXISTS glossary_terms (
+	commission_id TEXT NOT NULL,
+	term TEXT NOT NULL COLLATE NOCASE,
+	definition TEXT NOT NULL DEFAULT '',
+	replaced_by TEXT, -- Set when the term is deprecated
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (commission_id, term),
+	FOREIGN KEY (commission_id) REFERENCES commissions(id) ON DELETE CASCADE
+);
+
+-- PR Reviews (reviews and inline review comments fetched from GitHub)
+CREATE TABLE IF NOT EXISTS pr_reviews (
+	pr_id TEXT NOT NULL,
+	external_id TEXT NOT NULL, -- 'review:<id>' or 'comment:<id>'
+	kind TEXT NOT NULL CHECK(kind IN ('review', 'comment')),
+	review_external_id TEXT, -- Comments: the review they were submitted with
+	in_reply_to INTEGER DEFAULT 0,
+	author TEXT,
+	state TEXT, -- Reviews: APPROVED, CHANGES_REQUESTED, COMMENTED, DISMISSED
+	body TEXT,
+	path TEXT,
+	line INTEGER,
+	url TEXT,
+	submitted_at DATETIME,
+	task_id TEXT, -- Task created for a requested change
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (pr_id, external_id),
+	FOREIGN KEY (pr_id) REFERENCES prs(id) ON DELETE CASCADE,
+	FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE SET NULL
+);
+
+-- Entity Locks (advisory locks against concurrent edits; expired rows are ignored)
+CREATE TABLE IF NOT EXISTS entity_locks (
+	entity_id TEXT PRIMARY KEY, -- SHIP-xxx or PLAN-xxx
+	held_by TEXT NOT NULL, -- Actor ID, e.g. GOBLIN or IMP-BENCH-001
+	reason TEXT,
+	acquired_at DATETIME NOT NULL,
+	expires_at DATETIME NOT NULL
+);
+
+-- Entity Presence (who last viewed or edited an entity, for "active 2m ago" markers)
+CREATE TABLE IF NOT EXISTS entity_presence (
+	entity_id TEXT NOT NULL,
+	actor_id TEXT NOT NULL, -- e.g. GOBLIN or IMP-BENCH-003
+	action TEXT NOT NULL CHECK(action IN ('view', 'edit')),
+	seen_at DATETIME NOT NULL,
+	PRIMARY KEY (entity_id, actor_id)
+);
+CREATE INDEX IF NOT EXISTS idx_entity_presence_seen ON entity_presence(seen_at);
+
+-- Focus History (past focus targets per workbench, for orc focus recent / orc focus -)
+CREATE TABLE IF NOT EXISTS focus_history (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	workbench_id TEXT NOT NULL,
+	focused_id TEXT NOT NULL,
+	focused_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (workbench_id) REFERENCES workbenches(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_focus_history_workbench ON focus_history(workbench_id);
+
+-- Schema Migrations (upgrades applied to this ledger, for orc db migrations status)
+CREATE TABLE IF NOT EXISTS schema_migrations (
+	version INTEGER PRIMARY KEY, -- SchemaVersion the ledger was raised to
+	from_version INTEGER NOT NULL DEFAULT 0, -- user_version beforehand; 0 for new or unversioned ledgers
+	applied_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Migration Lock (held while a process migrates the ledger; one row at most)
+-- A holder that stops heartbeating is presumed dead and its lock is taken over.
+CREATE TABLE IF NOT EXISTS migration_lock (
+	id INTEGER PRIMARY KEY CHECK(id = 1),
+	owner_pid INTEGER NOT NULL,
+	owner_host TEXT NOT NULL,
+	acquired_at DATETIME NOT NULL,
+	heartbeat_at DATETIME NOT NULL
+);
+
+-- Backfill Jobs (progress of the data corrections declared in internal/db/backfill.go, for orc backfill)
+-- A job works through its table in id order; an interrupted run resumes after last_id.
+CREATE TABLE IF NOT EXISTS backfill_jobs (
+	name TEXT PRIMARY KEY,
+	status TEXT NOT NULL CHECK(status IN ('running', 'completed')) DEFAULT 'running',
+	last_id TEXT NOT NULL DEFAULT '', -- Highest id of the last committed batch
+	rows_updated INTEGER NOT NULL DEFAULT 0,
+	batches INTEGER NOT NULL DEFAULT 0,
+	started_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	completed_at DATETIME
+);
+
+-- Workbench Stashes (uncommitted work snapshotted with git stash, for orc workbench stash / unstash)
+-- Rows outlive the workbench: the stash commit lives in the repo, so another bench can restore it.
+CREATE TABLE IF NOT EXISTS workbench_stashes (
+	id TEXT PRIMARY KEY,
+	workbench_id TEXT NOT NULL, -- Bench the work was stashed from
+	repo_id TEXT,
+	task_id TEXT, -- Task the bench was working on
+	branch TEXT,
+	commit_sha TEXT NOT NULL, -- git stash commit
+	file_count INTEGER NOT NULL DEFAULT 0,
+	message TEXT,
+	status TEXT NOT NULL CHECK(status IN ('stashed', 'restored')) DEFAULT 'stashed',
+	restored_to_workbench_id TEXT,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	restored_at DATETIME,
+	FOREIGN KEY (repo_id) REFERENCES repos(id) ON DELETE SET NULL,
+	FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_workbench_stashes_task ON workbench_stashes(task_id);
+
+-- Embeddings (local semantic index over notes and plans, for orc recall)
+-- Derived data: a row is recomputed when its entity's content_hash or the model changes.
+CREATE TABLE IF NOT EXISTS embeddings (
+	entity_id TEXT PRIMARY KEY,
+	entity_type TEXT NOT NULL CHECK(entity_type IN ('note', 'plan')),
+	model TEXT NOT NULL, -- Embedding scheme the vector was computed with
+	content_hash TEXT NOT NULL, -- sha256 of the embedded text
+	vector BLOB NOT NULL, -- Little-endian float32s
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Command Stats (opt-in local telemetry: one row per orc invocation, for orc debug perf)
+-- Written only when ORC_TELEMETRY=1; rows older than 30 days are pruned as new ones arrive.
+CREATE TABLE IF NOT EXISTS command_stats (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	command TEXT NOT NULL, -- Command path, e.g. "orc summary"
+	duration_ms INTEGER NOT NULL,
+	query_count INTEGER NOT NULL DEFAULT 0,
+	query_ms INTEGER NOT NULL DEFAULT 0, -- Time spent in ledger queries
+	slow_queries TEXT, -- JSON [{sql, ms}], slowest first
+	failed INTEGER NOT NULL DEFAULT 0,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_command_stats_created ON command_stats(created_at);
+
+-- Webhook Sources (external systems allowed to post events to orc webhook serve)
+-- Deliveries are signed with the named secret; mappings turn events into ledger actions.
+CREATE TABLE IF NOT EXISTS webhook_sources (
+	name TEXT PRIMARY KEY,
+	kind TEXT NOT NULL CHECK(kind IN ('github', 'generic')),
+	secret_name TEXT NOT NULL, -- Name of a global secret (orc secret set)
+	mappings TEXT NOT NULL, -- JSON {event: [actions]}
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Flow Steps (completed steps of orc flow run, so rerunning a flow resumes where it stopped)
+-- A run is keyed by its flow name and parameters; task lists record one row per task.
+CREATE TABLE IF NOT EXISTS flow_steps (
+	run_key TEXT NOT NULL, -- e.g. kickoff-3f2a91c0
+	step_key TEXT NOT NULL, -- Step id, or id#n for the nth task of a titles list
+	output TEXT NOT NULL, -- ID the step created or acted on
+	completed_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (run_key, step_key)
+);
+
+-- Summary Views (saved orc summary filters, per actor)
+CREATE TABLE IF NOT EXISTS summary_views (
+	actor_id TEXT NOT NULL, -- Actor that saved the view, e.g. GOBLIN or IMP-BENCH-003
+	name TEXT NOT NULL,
+	containers TEXT, -- Comma-separated container kinds (SHIP, TOME); NULL shows all
+	statuses TEXT, -- Comma-separated container statuses; NULL shows all
+	tags TEXT, -- Comma-separated task tags; NULL shows all
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (actor_id, name)
+);
+
+-- Workbench default summary views (used by orc summary run in the workbench)
+CREATE TABLE IF NOT EXISTS summary_view_defaults (
+	workbench_id TEXT PRIMARY KEY,
+	actor_id TEXT NOT NULL,
+	view_name TEXT NOT NULL,
+	FOREIGN KEY (workbench_id) REFERENCES workbenches(id) ON DELETE CASCADE,
+	FOREIGN KEY (actor_id, view_name) REFERENCES summary_views(actor_id, name) ON DELETE CASCADE
+);
+
+-- Ledgers (other ORC ledgers this one can refer to, read-only)
+CREATE TABLE IF NOT EXISTS ledgers (
+	alias TEXT PRIMARY KEY, -- Used in references, e.g. acme in acme:SHIP-004
+	path TEXT NOT NULL, -- Path to the other ledger's database file
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Ledger References (links from entities here to entities in other ledgers)
+CREATE TABLE IF NOT EXISTS ledger_refs (
+	entity_id TEXT NOT NULL, -- Local entity, e.g. SHIP-012
+	ref TEXT NOT NULL, -- alias:ID, e.g. acme:SHIP-004
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (entity_id, ref)
+);
+CREATE INDEX IF NOT EXISTS idx_ledger_refs_ref ON ledger_refs(ref);
+
+-- Rate Limits (per-minute activity limits by actor type, for catching runaway agents)
+-- Kinds without a row use the built-in defaults.
+CREATE TABLE IF NOT EXISTS rate_limits (
+	actor_type TEXT NOT NULL CHECK(actor_type IN ('IMP', 'GOBLIN')),
+	kind TEXT NOT NULL CHECK(kind IN ('notes', 'status', 'writes')),
+	per_minute INTEGER NOT NULL, -- 0 turns the limit off
+	PRIMARY KEY (actor_type, kind)
+);
+
+-- Actor Throttles (actors caught over a rate limit; their writes are refused until expires_at)
+-- One row per actor, kept after expiry: activity before expires_at never counts again.
+CREATE TABLE IF NOT EXISTS actor_throttles (
+	actor_id TEXT PRIMARY KEY, -- e.g. IMP-BENCH-003
+	reason TEXT NOT NULL, -- The breach, e.g. "25 notes in the last minute (limit 20)"
+	throttled_at DATETIME NOT NULL,
+	expires_at DATETIME NOT NULL -- Set to the lift time when ORC lifts the throttle
+);
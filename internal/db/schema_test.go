@@ -0,0 +1,115 @@
+package db
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+)
+
+// schemaChecksum is the sha256 of schema.sql as of schemaChecksumVersion.
+// addMissingColumns only runs on ledgers below SchemaVersion, so a schema
+// change without a bump never reaches ledgers already at the version.
+const (
+	schemaChecksumVersion = 31
+	schemaChecksum        = "7eee664726ae0583232be72c8dcf8bc8dc15a1016b1a67939b75c36a1809e29a"
+)
+
+func openSchemaTestDB(t *testing.T) *sql.DB {
+	t.Helper()
+	database, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "orc.db"))
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	t.Cleanup(func() { database.Close() })
+	return database
+}
+
+func userVersion(t *testing.T, database *sql.DB) int {
+	t.Helper()
+	var v int
+	if err := database.QueryRow("PRAGMA user_version").Scan(&v); err != nil {
+		t.Fatalf("failed to read user_version: %v", err)
+	}
+	return v
+}
+
+func TestApplySchema_RecordsVersion(t *testing.T) {
+	database := openSchemaTestDB(t)
+
+	found, err := applySchema(database)
+	if err != nil {
+		t.Fatalf("applySchema failed: %v", err)
+	}
+	if found != 0 {
+		t.Errorf("expected fresh ledger to report version 0, got %d", found)
+	}
+	if got := userVersion(t, database); got != SchemaVersion {
+		t.Errorf("user_version = %d, want %d", got, SchemaVersion)
+	}
+
+	// Reopening reports the recorded version
+	found, err = applySchema(database)
+	if err != nil {
+		t.Fatalf("applySchema failed: %v", err)
+	}
+	if found != SchemaVersion {
+		t.Errorf("expected %d on second apply, got %d", SchemaVersion, found)
+	}
+}
+
+func TestSchemaVersion_BumpedWithSchema(t *testing.T) {
+	sum := sha256.Sum256([]byte(SchemaSQL))
+	got := hex.EncodeToString(sum[:])
+	if SchemaVersion != schemaChecksumVersion {
+		t.Fatalf("SchemaVersion is %d: add testdata/migrations/v%d.sql and record schemaChecksum %s for it",
+			SchemaVersion, SchemaVersion-1, got)
+	}
+	if got != schemaChecksum {
+		t.Errorf("schema.sql changed without bumping SchemaVersion (%d)", SchemaVersion)
+	}
+}
+
+func TestApplySchema_KeepsNewerVersion(t *testing.T) {
+	database := openSchemaTestDB(t)
+	if _, err := database.Exec("PRAGMA user_version = 99"); err != nil {
+		t.Fatalf("failed to set user_version: %v", err)
+	}
+
+	found, err := applySchema(database)
+	if err != nil {
+		t.Fatalf("applySchema failed: %v", err)
+	}
+	if found != 99 {
+		t.Errorf("expected found version 99, got %d", found)
+	}
+	if got := userVersion(t, database); got != 99 {
+		t.Errorf("expected newer ledger version to be kept, got %d", got)
+	}
+}
+
+func TestSchemaStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       SchemaStatus
+		wantAhead    bool
+		wantUpgraded bool
+	}{
+		{"in sync", SchemaStatus{Binary: 3, Ledger: 3}, false, false},
+		{"unversioned ledger", SchemaStatus{Binary: 3, Ledger: 0}, false, false},
+		{"ledger behind", SchemaStatus{Binary: 3, Ledger: 2}, false, true},
+		{"ledger ahead", SchemaStatus{Binary: 3, Ledger: 4}, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.status.LedgerAhead(); got != tt.wantAhead {
+				t.Errorf("LedgerAhead() = %v, want %v", got, tt.wantAhead)
+			}
+			if got := tt.status.LedgerUpgraded(); got != tt.wantUpgraded {
+				t.Errorf("LedgerUpgraded() = %v, want %v", got, tt.wantUpgraded)
+			}
+		})
+	}
+}
@@ -0,0 +1,451 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// simulationSkipTables are bookkeeping tables left out of simulated changes.
+var simulationSkipTables = map[string]bool{
+	"schema_migrations": true, // Raised by opening a copy of an older ledger
+	"command_stats":     true, // Telemetry of the simulated run itself
+	"entity_presence":   true, // Presence markers the simulated run left
+}
+
+var (
+	simulating       bool
+	simulationDir    string // Holds the sandbox copy; removed by DiscardSimulation
+	simulationLedger string // The real ledger the sandbox was copied from; "" if there was none
+	simulationInfra  string // Two-ledger mode: the sandbox copy of the infra database
+	infraLedger      string // Two-ledger mode: the real infra database; "" if there was none
+)
+
+// RecordChange is one row a simulated command created, updated, or deleted.
+type RecordChange struct {
+	Op     string // create, update, or delete
+	Table  string
+	Key    string        // Primary key, with composite keys joined by "/"
+	Label  string        // Title or name of created and deleted rows, if the table has one
+	Fields []FieldChange // Updates only: the columns that changed
+}
+
+// FieldChange is one column an update changed.
+type FieldChange struct {
+	Column string
+	Before string
+	After  string
+}
+
+// EnableSimulation makes GetDB open a throwaway copy of the ledger, so a
+// command runs against real data without writing to it. Like
+// EnableProfiling, it must be called before the first GetDB.
+func EnableSimulation() {
+	simulating = true
+}
+
+// Simulating reports whether the ledger connection is a sandbox copy.
+func Simulating() bool {
+	return simulating
+}
+
+// openSandbox copies the ledger at path into a temporary directory and
+// returns the copy's path. A missing ledger leaves the sandbox empty.
+func openSandbox(path string) (string, error) {
+	dir, err := os.MkdirTemp("", "orc-plan-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create sandbox dir: %w", err)
+	}
+	simulationDir = dir
+	sandbox := filepath.Join(dir, "orc.db")
+
+	copied, err := copyIntoSandbox(path, sandbox)
+	if err != nil || !copied {
+		return sandbox, err
+	}
+	simulationLedger = path
+	return sandbox, nil
+}
+
+// openInfraSandbox copies the infra database at path beside the sandboxed
+// ledger and returns the copy's path. Call it after openSandbox.
+func openInfraSandbox(path string) (string, error) {
+	simulationInfra = filepath.Join(simulationDir, "infra.db")
+	copied, err := copyIntoSandbox(path, simulationInfra)
+	if err != nil {
+		return "", err
+	}
+	if copied {
+		infraLedger = path
+	}
+	return simulationInfra, nil
+}
+
+// copyIntoSandbox snapshots the database at path into sandbox, reporting
+// whether there was one to copy.
+func copyIntoSandbox(path, sandbox string) (bool, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return false, nil
+	}
+	ledger, err := sql.Open("sqlite3", "file:"+path+"?mode=ro&_busy_timeout=5000")
+	if err != nil {
+		return false, fmt.Errorf("failed to open ledger: %w", err)
+	}
+	defer ledger.Close()
+	// VACUUM INTO takes a consistent snapshot, including pages still in the WAL
+	if _, err := ledger.Exec("VACUUM INTO ?", sandbox); err != nil {
+		return false, fmt.Errorf("failed to copy ledger into sandbox: %w", err)
+	}
+	return true, nil
+}
+
+// SimulatedChanges compares the sandbox with the ledger it was copied from
+// and lists every row the simulated command created, updated, or deleted,
+// grouped by table. In two-ledger mode the infra database's changes follow.
+func SimulatedChanges(ctx context.Context) ([]RecordChange, error) {
+	if !simulating || db == nil {
+		return nil, nil
+	}
+	changes, err := diffSandbox(ctx, db, simulationLedger)
+	if err != nil || simulationInfra == "" {
+		return changes, err
+	}
+
+	infra, err := sql.Open("sqlite3", simulationInfra)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open infra sandbox: %w", err)
+	}
+	defer infra.Close()
+	infraChanges, err := diffSandbox(ctx, infra, infraLedger)
+	if err != nil {
+		return nil, err
+	}
+	return append(changes, infraChanges...), nil
+}
+
+// diffSandbox compares the sandbox database with the real one at ledger.
+func diffSandbox(ctx context.Context, sandbox *sql.DB, ledger string) ([]RecordChange, error) {
+	conn, err := sandbox.Conn(ctx) // ATTACH applies to a single connection
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sandbox connection: %w", err)
+	}
+	defer conn.Close()
+
+	if ledger == "" {
+		ledger = ":memory:" // No ledger yet: every row is new
+	}
+	if _, err := conn.ExecContext(ctx, "ATTACH DATABASE ? AS ledger", ledger); err != nil {
+		return nil, fmt.Errorf("failed to attach ledger: %w", err)
+	}
+	defer func() { _, _ = conn.ExecContext(context.Background(), "DETACH DATABASE ledger") }()
+
+	return diffRecords(ctx, conn)
+}
+
+// DiscardSimulation closes the sandbox and deletes it.
+func DiscardSimulation() error {
+	if !simulating {
+		return nil
+	}
+	if db != nil {
+		db.Close()
+		db = nil
+	}
+	if simulationDir == "" {
+		return nil
+	}
+	return os.RemoveAll(simulationDir)
+}
+
+// simTable is a table's columns as seen in the sandbox ("main") and the
+// attached ledger.
+type simTable struct {
+	name     string
+	keys     []string // Primary key columns; rowid for tables without one
+	label    string   // title or name column, if any
+	shared   []string // Non-key columns present in both databases
+	inMain   bool
+	inLedger bool
+}
+
+// diffRecords compares every table of main against ledger on conn.
+func diffRecords(ctx context.Context, conn *sql.Conn) ([]RecordChange, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT name FROM main.sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan table name: %w", err)
+		}
+		if !simulationSkipTables[name] {
+			names = append(names, name)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var changes []RecordChange
+	for _, name := range names {
+		table, err := describeSimTable(ctx, conn, name)
+		if err != nil {
+			return nil, err
+		}
+		tableChanges, err := diffTable(ctx, conn, table)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, tableChanges...)
+	}
+	return changes, nil
+}
+
+// describeSimTable reads a table's key, label, and shared columns.
+func describeSimTable(ctx context.Context, conn *sql.Conn, name string) (*simTable, error) {
+	table := &simTable{name: name}
+	mainCols, keys, err := simColumns(ctx, conn, "main", name)
+	if err != nil {
+		return nil, err
+	}
+	ledgerCols, _, err := simColumns(ctx, conn, "ledger", name)
+	if err != nil {
+		return nil, err
+	}
+	table.inMain = len(mainCols) > 0
+	table.inLedger = len(ledgerCols) > 0
+
+	table.keys = keys
+	if len(table.keys) == 0 {
+		table.keys = []string{"rowid"}
+	}
+	ledgerHas := make(map[string]bool, len(ledgerCols))
+	for _, c := range ledgerCols {
+		ledgerHas[c] = true
+	}
+	isKey := make(map[string]bool, len(table.keys))
+	for _, k := range table.keys {
+		isKey[k] = true
+	}
+	for _, c := range mainCols {
+		if table.label == "" && (c == "title" || c == "name") && !isKey[c] {
+			table.label = c
+		}
+		if ledgerHas[c] && !isKey[c] {
+			table.shared = append(table.shared, c)
+		}
+	}
+	return table, nil
+}
+
+// simColumns lists a table's columns in one schema, and its primary key
+// columns in key order. A table missing from the schema has no columns.
+func simColumns(ctx context.Context, conn *sql.Conn, schema, table string) ([]string, []string, error) {
+	rows, err := conn.QueryContext(ctx, fmt.Sprintf("PRAGMA %s.table_info(%q)", schema, table))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read columns of %s.%s: %w", schema, table, err)
+	}
+	defer rows.Close()
+
+	var columns []string
+	pks := map[int]string{}
+	for rows.Next() {
+		var (
+			cid     int
+			name    string
+			typ     string
+			notNull bool
+			dflt    sql.NullString
+			pk      int
+		)
+		if err := rows.Scan(&cid, &name, &typ, &notNull, &dflt, &pk); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan column of %s.%s: %w", schema, table, err)
+		}
+		columns = append(columns, name)
+		if pk > 0 {
+			pks[pk] = name
+		}
+	}
+	keys := make([]string, 0, len(pks))
+	for i := 1; i <= len(pks); i++ {
+		keys = append(keys, pks[i])
+	}
+	return columns, keys, rows.Err()
+}
+
+// diffTable lists the rows of one table created, updated, and deleted in
+// main relative to ledger.
+func diffTable(ctx context.Context, conn *sql.Conn, t *simTable) ([]RecordChange, error) {
+	keyList := quoteColumns("", t.keys)
+	label := "''"
+	if t.label != "" {
+		label = fmt.Sprintf("%q", t.label)
+	}
+
+	var changes []RecordChange
+	switch {
+	case t.inMain && !t.inLedger:
+		return simRows(ctx, conn, "create", t.name, fmt.Sprintf(`SELECT %s, %s FROM main.%q`, keyList, label, t.name), len(t.keys))
+	case !t.inMain:
+		return nil, nil
+	}
+
+	created, err := simRows(ctx, conn, "create", t.name, fmt.Sprintf(
+		`SELECT %s, %s FROM main.%q WHERE (%s) NOT IN (SELECT %s FROM ledger.%q)`,
+		keyList, label, t.name, keyList, keyList, t.name), len(t.keys))
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, created...)
+
+	updated, err := simUpdates(ctx, conn, t)
+	if err != nil {
+		return nil, err
+	}
+	changes = append(changes, updated...)
+
+	deleted, err := simRows(ctx, conn, "delete", t.name, fmt.Sprintf(
+		`SELECT %s, %s FROM ledger.%q WHERE (%s) NOT IN (SELECT %s FROM main.%q)`,
+		keyList, label, t.name, keyList, keyList, t.name), len(t.keys))
+	if err != nil {
+		return nil, err
+	}
+	return append(changes, deleted...), nil
+}
+
+// simRows runs a query returning key columns then a label, as changes.
+func simRows(ctx context.Context, conn *sql.Conn, op, table, query string, keyCount int) ([]RecordChange, error) {
+	rows, err := conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	var changes []RecordChange
+	for rows.Next() {
+		values := make([]any, keyCount+1)
+		ptrs := make([]any, len(values))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, fmt.Errorf("failed to scan %s row: %w", table, err)
+		}
+		changes = append(changes, RecordChange{
+			Op:    op,
+			Table: table,
+			Key:   joinKey(values[:keyCount]),
+			Label: formatSimValue(values[keyCount]),
+		})
+	}
+	sortChanges(changes)
+	return changes, rows.Err()
+}
+
+// simUpdates lists rows present in both databases whose shared columns differ.
+func simUpdates(ctx context.Context, conn *sql.Conn, t *simTable) ([]RecordChange, error) {
+	if len(t.shared) == 0 {
+		return nil, nil
+	}
+	var join, differs []string
+	for _, k := range t.keys {
+		join = append(join, fmt.Sprintf("m.%q = l.%q", k, k))
+	}
+	for _, c := range t.shared {
+		differs = append(differs, fmt.Sprintf("m.%q IS NOT l.%q", c, c))
+	}
+	query := fmt.Sprintf("SELECT %s, %s, %s FROM main.%q m JOIN ledger.%q l ON %s WHERE %s",
+		quoteColumns("m.", t.keys), quoteColumns("l.", t.shared), quoteColumns("m.", t.shared),
+		t.name, t.name, strings.Join(join, " AND "), strings.Join(differs, " OR "))
+
+	rows, err := conn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s: %w", t.name, err)
+	}
+	defer rows.Close()
+
+	var changes []RecordChange
+	n := len(t.keys)
+	for rows.Next() {
+		values := make([]any, n+2*len(t.shared))
+		ptrs := make([]any, len(values))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			return nil, fmt.Errorf("failed to scan %s row: %w", t.name, err)
+		}
+		change := RecordChange{Op: "update", Table: t.name, Key: joinKey(values[:n])}
+		for i, c := range t.shared {
+			before, after := formatSimValue(values[n+i]), formatSimValue(values[n+len(t.shared)+i])
+			if before != after {
+				change.Fields = append(change.Fields, FieldChange{Column: c, Before: before, After: after})
+			}
+		}
+		changes = append(changes, change)
+	}
+	sortChanges(changes)
+	return changes, rows.Err()
+}
+
+// quoteColumns renders columns as a quoted, comma-separated list. rowid is
+// left bare so it still names the implicit column.
+func quoteColumns(prefix string, columns []string) string {
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		if c == "rowid" {
+			quoted[i] = prefix + c
+		} else {
+			quoted[i] = fmt.Sprintf("%s%q", prefix, c)
+		}
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func joinKey(values []any) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = formatSimValue(v)
+	}
+	return strings.Join(parts, "/")
+}
+
+// formatSimValue renders a column value for display. Binary values are
+// shown by size.
+func formatSimValue(v any) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		return val.Format(time.RFC3339)
+	case []byte:
+		if !utf8.Valid(val) {
+			return fmt.Sprintf("<%d bytes>", len(val))
+		}
+		return string(val)
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+// sortChanges orders one table's changes by key, shorter keys first so
+// TASK-9 sorts before TASK-10.
+func sortChanges(changes []RecordChange) {
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := changes[i].Key, changes[j].Key
+		if len(a) != len(b) {
+			return len(a) < len(b)
+		}
+		return a < b
+	})
+}
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestOpenSandbox_DiffRecords(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "orc.db")
+	ledger, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatalf("failed to open ledger: %v", err)
+	}
+	defer ledger.Close()
+	for _, stmt := range []string{
+		"CREATE TABLE tasks (id TEXT PRIMARY KEY, title TEXT, status TEXT)",
+		"CREATE TABLE entity_tags (entity_id TEXT, tag_id TEXT, PRIMARY KEY (entity_id, tag_id))",
+		"CREATE TABLE commit_links (commit_sha TEXT NOT NULL, entity_id TEXT NOT NULL)",
+		"INSERT INTO tasks VALUES ('TASK-001', 'Keep', 'open'), ('TASK-002', 'Change', 'open'), ('TASK-003', 'Drop', 'open')",
+		"INSERT INTO entity_tags VALUES ('TASK-001', 'TAG-001')",
+	} {
+		if _, err := ledger.Exec(stmt); err != nil {
+			t.Fatalf("setup failed: %v", err)
+		}
+	}
+
+	t.Cleanup(func() {
+		os.RemoveAll(simulationDir)
+		simulationDir, simulationLedger = "", ""
+	})
+	sandboxPath, err := openSandbox(path)
+	if err != nil {
+		t.Fatalf("openSandbox failed: %v", err)
+	}
+	if simulationLedger != path {
+		t.Errorf("simulationLedger = %q, want %q", simulationLedger, path)
+	}
+	sandbox, err := sql.Open("sqlite3", sandboxPath)
+	if err != nil {
+		t.Fatalf("failed to open sandbox: %v", err)
+	}
+	defer sandbox.Close()
+	for _, stmt := range []string{
+		"INSERT INTO tasks VALUES ('TASK-010', 'New', 'open')",
+		"UPDATE tasks SET status = 'in_progress' WHERE id = 'TASK-002'",
+		"DELETE FROM tasks WHERE id = 'TASK-003'",
+		"INSERT INTO entity_tags VALUES ('TASK-010', 'TAG-001')",
+		"INSERT INTO commit_links VALUES ('abc123', 'TASK-010')",
+	} {
+		if _, err := sandbox.Exec(stmt); err != nil {
+			t.Fatalf("simulated write failed: %v", err)
+		}
+	}
+
+	conn, err := sandbox.Conn(ctx)
+	if err != nil {
+		t.Fatalf("failed to get connection: %v", err)
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "ATTACH DATABASE ? AS ledger", path); err != nil {
+		t.Fatalf("attach failed: %v", err)
+	}
+
+	changes, err := diffRecords(ctx, conn)
+	if err != nil {
+		t.Fatalf("diffRecords failed: %v", err)
+	}
+	want := []RecordChange{
+		{Op: "create", Table: "commit_links", Key: "1"},
+		{Op: "create", Table: "entity_tags", Key: "TASK-010/TAG-001"},
+		{Op: "create", Table: "tasks", Key: "TASK-010", Label: "New"},
+		{Op: "update", Table: "tasks", Key: "TASK-002", Fields: []FieldChange{{Column: "status", Before: "open", After: "in_progress"}}},
+		{Op: "delete", Table: "tasks", Key: "TASK-003", Label: "Drop"},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("changes =\n%+v\nwant\n%+v", changes, want)
+	}
+
+	// The ledger itself is untouched
+	var count int
+	if err := ledger.QueryRow("SELECT COUNT(*) FROM tasks").Scan(&count); err != nil || count != 3 {
+		t.Errorf("ledger tasks = %d (err %v), want 3", count, err)
+	}
+}
+
+func TestDiffSandbox_ReadOnlyCommand(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "orc.db")
+	if err := CreateDemoLedger(path); err != nil {
+		t.Fatalf("CreateDemoLedger failed: %v", err)
+	}
+
+	t.Cleanup(func() {
+		os.RemoveAll(simulationDir)
+		simulationDir, simulationLedger = "", ""
+	})
+	sandboxPath, err := openSandbox(path)
+	if err != nil {
+		t.Fatalf("openSandbox failed: %v", err)
+	}
+	sandbox, err := sql.Open("sqlite3", sandboxPath)
+	if err != nil {
+		t.Fatalf("failed to open sandbox: %v", err)
+	}
+	defer sandbox.Close()
+
+	// What 'orc task show TASK-002 --plan' leaves behind: presence and
+	// telemetry bookkeeping, nothing the user asked for
+	for _, stmt := range []string{
+		"INSERT INTO entity_presence (entity_id, actor_id, action, seen_at) VALUES ('TASK-002', 'IMP-BENCH-001', 'view', CURRENT_TIMESTAMP)",
+		"INSERT INTO command_stats (command, duration_ms, query_count, query_ms) VALUES ('orc task show', 12, 4, 1)",
+	} {
+		if _, err := sandbox.Exec(stmt); err != nil {
+			t.Fatalf("simulated write failed: %v", err)
+		}
+	}
+
+	changes, err := diffSandbox(ctx, sandbox, path)
+	if err != nil {
+		t.Fatalf("diffSandbox failed: %v", err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("read-only plan changes = %+v, want none", changes)
+	}
+}
@@ -0,0 +1,227 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// SplitTable is one table copied by SplitLedger.
+type SplitTable struct {
+	Name  string
+	Infra bool // Copied to the infra database
+	Rows  int
+}
+
+// SplitReport describes a ledger split into work and infra databases.
+type SplitReport struct {
+	WorkPath  string
+	InfraPath string
+	Tables    []SplitTable
+	Blobs     int // Note bodies copied to the work ledger's blobs/
+}
+
+// SplitLedger copies the single ledger at path into a new work ledger at
+// workPath and a new infra database at infraPath. The ledger itself is only
+// read, so it stays usable until ORC_DB_PATH and ORC_INFRA_DB_PATH point at
+// the new files. Neither target may exist yet. Note bodies kept in blobs/
+// beside the ledger are copied beside the work ledger, which now owns them.
+func SplitLedger(ctx context.Context, path, workPath, infraPath string) (*SplitReport, error) {
+	for _, target := range []string{workPath, infraPath} {
+		if _, err := os.Stat(target); err == nil {
+			return nil, fmt.Errorf("%s already exists", target)
+		}
+	}
+
+	source, err := sql.Open("sqlite3", "file:"+path+"?mode=ro&_busy_timeout=5000")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ledger: %w", err)
+	}
+	defer source.Close()
+	var version int
+	if err := source.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+		return nil, fmt.Errorf("failed to read ledger: %w", err)
+	}
+	if version != SchemaVersion {
+		return nil, fmt.Errorf("ledger is at schema v%d, not v%d: run 'orc db migrate' (or 'orc upgrade') first", version, SchemaVersion)
+	}
+	var single bool
+	if err := source.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM sqlite_master WHERE type = 'table' AND name = 'workbenches')").Scan(&single); err != nil {
+		return nil, fmt.Errorf("failed to inspect ledger: %w", err)
+	}
+	if !single {
+		return nil, fmt.Errorf("%s holds no infrastructure tables: it is already split", path)
+	}
+
+	report := &SplitReport{WorkPath: workPath, InfraPath: infraPath}
+	schemas := splitSchemas()
+	for _, target := range []struct {
+		path, script string
+		infra        bool
+	}{
+		{workPath, schemas.work, false},
+		{infraPath, schemas.infra, true},
+	} {
+		tables, err := copyLedgerTables(ctx, path, target.path, target.script, !target.infra)
+		if err != nil {
+			os.Remove(workPath)
+			os.Remove(infraPath)
+			return nil, err
+		}
+		for i := range tables {
+			tables[i].Infra = target.infra
+		}
+		report.Tables = append(report.Tables, tables...)
+	}
+
+	report.Blobs, err = copyBlobs(filepath.Join(filepath.Dir(path), "blobs"), filepath.Join(filepath.Dir(workPath), "blobs"))
+	if err != nil {
+		os.Remove(workPath)
+		os.Remove(infraPath)
+		return nil, err
+	}
+	return report, nil
+}
+
+// copyBlobs copies the note bodies under source into target, skipping blobs
+// target already holds. Blobs are named by their hash, so an existing file
+// already has the right content. It copies nothing when both are the same
+// directory or source does not exist.
+func copyBlobs(source, target string) (int, error) {
+	if filepath.Clean(source) == filepath.Clean(target) {
+		return 0, nil
+	}
+	if _, err := os.Stat(source); os.IsNotExist(err) {
+		return 0, nil
+	}
+
+	copied := 0
+	err := filepath.WalkDir(source, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		dest := filepath.Join(target, rel)
+		if d.IsDir() {
+			return os.MkdirAll(dest, 0700)
+		}
+		if strings.Contains(d.Name(), ".tmp-") {
+			return nil // An interrupted write, never referenced
+		}
+		if _, err := os.Stat(dest); err == nil {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(dest, data, 0600); err != nil {
+			return err
+		}
+		copied++
+		return nil
+	})
+	if err != nil {
+		return copied, fmt.Errorf("failed to copy note blobs: %w", err)
+	}
+	return copied, nil
+}
+
+// copyLedgerTables creates a database at target with script and copies every
+// table's rows from the ledger at source, column by column. Foreign keys are
+// off while copying, so rows are copied in any order.
+func copyLedgerTables(ctx context.Context, source, target, script string, record bool) ([]SplitTable, error) {
+	database, err := sql.Open("sqlite3", target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", target, err)
+	}
+	defer database.Close()
+	if _, err := applySchemaScript(database, script, record); err != nil {
+		return nil, fmt.Errorf("failed to initialize %s: %w", target, err)
+	}
+
+	conn, err := database.Conn(ctx) // ATTACH applies to a single connection
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if _, err := conn.ExecContext(ctx, "ATTACH DATABASE ? AS source", "file:"+source+"?mode=ro"); err != nil {
+		return nil, fmt.Errorf("failed to attach ledger: %w", err)
+	}
+	defer func() { _, _ = conn.ExecContext(context.Background(), "DETACH DATABASE source") }()
+
+	names, err := tableNames(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	var tables []SplitTable
+	for _, name := range names {
+		if name == "schema_migrations" {
+			// The new ledger records its own creation; keep the ledger's history too
+			if _, err := conn.ExecContext(ctx, "INSERT OR IGNORE INTO main.schema_migrations SELECT * FROM source.schema_migrations"); err != nil {
+				return nil, fmt.Errorf("failed to copy schema_migrations: %w", err)
+			}
+			continue
+		}
+		mainCols, _, err := simColumns(ctx, conn, "main", name)
+		if err != nil {
+			return nil, err
+		}
+		sourceCols, _, err := simColumns(ctx, conn, "source", name)
+		if err != nil {
+			return nil, err
+		}
+		shared := sharedColumns(mainCols, sourceCols)
+		if len(shared) == 0 {
+			continue
+		}
+		cols := strings.Join(shared, ", ")
+		result, err := conn.ExecContext(ctx, fmt.Sprintf("INSERT INTO main.%s (%s) SELECT %s FROM source.%s", name, cols, cols, name))
+		if err != nil {
+			return nil, fmt.Errorf("failed to copy %s: %w", name, err)
+		}
+		rows, _ := result.RowsAffected()
+		tables = append(tables, SplitTable{Name: name, Rows: int(rows)})
+	}
+	return tables, nil
+}
+
+// tableNames lists the tables of main, by name.
+func tableNames(ctx context.Context, conn *sql.Conn) ([]string, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT name FROM main.sqlite_master WHERE type = 'table' AND name NOT LIKE 'sqlite_%' ORDER BY name")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	defer rows.Close()
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan table name: %w", err)
+		}
+		names = append(names, name)
+	}
+	return names, rows.Err()
+}
+
+// sharedColumns returns the columns of a that b also has, in a's order.
+func sharedColumns(a, b []string) []string {
+	inB := make(map[string]bool, len(b))
+	for _, c := range b {
+		inB[c] = true
+	}
+	var shared []string
+	for _, c := range a {
+		if inB[c] {
+			shared = append(shared, c)
+		}
+	}
+	return shared
+}
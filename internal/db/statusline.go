@@ -0,0 +1,82 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+)
+
+// defaultClaimTTL mirrors the task claim default: a claim without a
+// heartbeat for this long probably belongs to a dead IMP.
+const defaultClaimTTL = 2 * time.Hour
+
+// StatusLine is what a workbench's tmux status segment shows.
+type StatusLine struct {
+	FocusID          string // Empty when nothing is focused
+	PendingApprovals int    // Draft plans on the workbench's open tasks
+	StuckTasks       int    // The workbench's blocked tasks and stale claims
+}
+
+// OpenReadOnly opens the ledger read-only, skipping the schema checks and
+// migrations GetDB runs, for callers polled often enough that startup cost
+// matters. In two-ledger mode the infra database is attached read-only too.
+// Returns nil when there is no ledger yet.
+func OpenReadOnly() (*sql.DB, error) {
+	path, err := GetDBPath()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, nil
+	}
+	if infra := InfraDBPath(); infra != "" {
+		if _, err := os.Stat(infra); err == nil {
+			infraAttachPath = "file:" + infra + "?mode=ro"
+		}
+	}
+	database, err := sql.Open(ledgerDriverName, "file:"+path+"?mode=ro&_busy_timeout=1000")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	return database, nil
+}
+
+// ReadStatusLine reads a workbench's status segment in three queries. A
+// claim is stale when its last heartbeat is older than claimTTL; a
+// non-positive claimTTL uses the default.
+func ReadStatusLine(ctx context.Context, database *sql.DB, workbenchID string, claimTTL time.Duration) (*StatusLine, error) {
+	if claimTTL <= 0 {
+		claimTTL = defaultClaimTTL
+	}
+	status := &StatusLine{}
+
+	var focusID sql.NullString
+	err := database.QueryRowContext(ctx, "SELECT focused_id FROM workbenches WHERE id = ?", workbenchID).Scan(&focusID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to read focus: %w", err)
+	}
+	status.FocusID = focusID.String
+
+	err = database.QueryRowContext(ctx, `SELECT COUNT(*) FROM plans p
+		JOIN tasks t ON t.id = p.task_id
+		WHERE p.status = 'draft' AND t.assigned_workbench_id = ? AND t.status != 'closed'`,
+		workbenchID,
+	).Scan(&status.PendingApprovals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count pending approvals: %w", err)
+	}
+
+	cutoff := time.Now().Add(-claimTTL).UTC().Format("2006-01-02 15:04:05")
+	err = database.QueryRowContext(ctx, `SELECT COUNT(*) FROM tasks
+		WHERE assigned_workbench_id = ? AND (status = 'blocked' OR
+			(status = 'in-progress' AND COALESCE(claim_refreshed_at, claimed_at) < ?))`,
+		workbenchID, cutoff,
+	).Scan(&status.StuckTasks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count stuck tasks: %w", err)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestReadStatusLine(t *testing.T) {
+	database := openMaintenanceTestDB(t)
+	ctx := context.Background()
+
+	stale := time.Now().Add(-3 * time.Hour).UTC().Format("2006-01-02 15:04:05")
+	for _, stmt := range []string{
+		"INSERT INTO commissions (id, title) VALUES ('COMM-001', 'Demo')",
+		"INSERT INTO workbenches (id, workshop_id, name, focused_id) VALUES ('BENCH-001', 'WORK-001', 'orc-001', 'SHIP-001'), ('BENCH-002', 'WORK-001', 'orc-002', NULL)",
+		"INSERT INTO tasks (id, commission_id, title, status, assigned_workbench_id, claimed_at) VALUES " +
+			"('TASK-001', 'COMM-001', 'Live claim', 'in-progress', 'BENCH-001', CURRENT_TIMESTAMP), " +
+			"('TASK-002', 'COMM-001', 'Stale claim', 'in-progress', 'BENCH-001', '" + stale + "'), " +
+			"('TASK-003', 'COMM-001', 'Blocked', 'blocked', 'BENCH-001', NULL), " +
+			"('TASK-004', 'COMM-001', 'Done', 'closed', 'BENCH-001', NULL), " +
+			"('TASK-005', 'COMM-001', 'Elsewhere', 'blocked', 'BENCH-002', NULL)",
+		"INSERT INTO plans (id, commission_id, task_id, title, status) VALUES " +
+			"('PLAN-001', 'COMM-001', 'TASK-001', 'Draft', 'draft'), " +
+			"('PLAN-002', 'COMM-001', 'TASK-001', 'Approved', 'approved'), " +
+			"('PLAN-003', 'COMM-001', 'TASK-004', 'Draft on a closed task', 'draft')",
+	} {
+		if _, err := database.Exec(stmt); err != nil {
+			t.Fatalf("setup failed: %v", err)
+		}
+	}
+
+	got, err := ReadStatusLine(ctx, database, "BENCH-001", 0)
+	if err != nil {
+		t.Fatalf("ReadStatusLine failed: %v", err)
+	}
+	want := StatusLine{FocusID: "SHIP-001", PendingApprovals: 1, StuckTasks: 2}
+	if *got != want {
+		t.Errorf("status = %+v, want %+v", *got, want)
+	}
+
+	// A longer TTL keeps the old claim live
+	got, _ = ReadStatusLine(ctx, database, "BENCH-001", 4*time.Hour)
+	if got.StuckTasks != 1 {
+		t.Errorf("stuck with 4h TTL = %d, want 1", got.StuckTasks)
+	}
+
+	got, err = ReadStatusLine(ctx, database, "BENCH-999", 0)
+	if err != nil || *got != (StatusLine{}) {
+		t.Errorf("unknown workbench = %+v, %v; want empty", got, err)
+	}
+}
@@ -0,0 +1,522 @@
+-- Golden fixture: a ledger at schema v11, with claim heartbeats.
+-- Schema copied verbatim from that release's schema.sql, followed by
+-- representative rows. Do not edit; add a new fixture for a new version.
+
+-- ORC Database Schema
+-- This file defines the SQLite schema for the ORC orchestration system.
+-- Use Atlas for migrations: see CLAUDE.md for workflow.
+
+-- Tags (generic tagging system)
+CREATE TABLE IF NOT EXISTS tags (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL UNIQUE,
+	description TEXT,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS entity_tags (
+	id TEXT PRIMARY KEY,
+	entity_id TEXT NOT NULL,
+	entity_type TEXT NOT NULL CHECK(entity_type IN ('task', 'plan', 'note', 'shipment', 'tome')),
+	tag_id TEXT NOT NULL,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE,
+	UNIQUE(entity_id, entity_type, tag_id)
+);
+
+-- Repos (Repository configurations)
+CREATE TABLE IF NOT EXISTS repos (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL UNIQUE,
+	url TEXT,
+	local_path TEXT,
+	default_branch TEXT DEFAULT 'main',
+	bootstrap_script TEXT,
+	status TEXT NOT NULL CHECK(status IN ('active', 'archived')) DEFAULT 'active',
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Factories (TMux sessions - runtime environments)
+CREATE TABLE IF NOT EXISTS factories (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL UNIQUE,
+	status TEXT NOT NULL CHECK(status IN ('active', 'archived')) DEFAULT 'active',
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Workshops (TMux sessions - runtime environments within a factory)
+CREATE TABLE IF NOT EXISTS workshops (
+	id TEXT PRIMARY KEY,
+	factory_id TEXT NOT NULL,
+	name TEXT NOT NULL,
+	status TEXT NOT NULL CHECK(status IN ('active', 'archived')) DEFAULT 'active',
+	active_commission_id TEXT,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (factory_id) REFERENCES factories(id),
+	FOREIGN KEY (active_commission_id) REFERENCES commissions(id)
+);
+
+-- Workbenches (Git worktrees within a workshop)
+-- Path is computed dynamically as ~/wb/{name}, not stored
+CREATE TABLE IF NOT EXISTS workbenches (
+	id TEXT PRIMARY KEY,
+	workshop_id TEXT NOT NULL,
+	name TEXT NOT NULL UNIQUE,
+	repo_id TEXT,
+	status TEXT NOT NULL CHECK(status IN ('active', 'archived')) DEFAULT 'active',
+	home_branch TEXT,
+	current_branch TEXT,
+	focused_id TEXT,
+	bootstrap_status TEXT CHECK(bootstrap_status IN ('pending', 'succeeded', 'failed')),
+	bootstrap_output TEXT,
+	bootstrapped_at DATETIME,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (workshop_id) REFERENCES workshops(id),
+	FOREIGN KEY (repo_id) REFERENCES repos(id)
+);
+
+-- Commissions (Tracks of work - what you're working on)
+-- Workshop → Commissions is 1:many (a workshop can have multiple commissions)
+CREATE TABLE IF NOT EXISTS commissions (
+	id TEXT PRIMARY KEY,
+	factory_id TEXT,
+	workshop_id TEXT,
+	title TEXT NOT NULL,
+	description TEXT,
+	status TEXT NOT NULL CHECK(status IN ('initial', 'active', 'paused', 'complete', 'archived', 'deleted')) DEFAULT 'initial',
+	pinned INTEGER DEFAULT 0,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	started_at DATETIME,
+	completed_at DATETIME,
+	updated_at DATETIME,
+	FOREIGN KEY (factory_id) REFERENCES factories(id),
+	FOREIGN KEY (workshop_id) REFERENCES workshops(id)
+);
+
+-- Shipments (Work containers)
+-- Lifecycle: draft → ready → in-progress → closed
+CREATE TABLE IF NOT EXISTS shipments (
+	id TEXT PRIMARY KEY,
+	commission_id TEXT NOT NULL,
+	title TEXT NOT NULL,
+	description TEXT,
+	status TEXT NOT NULL CHECK(status IN ('draft', 'ready', 'in-progress', 'closed')) DEFAULT 'draft',
+	closed_reason TEXT,
+	assigned_workbench_id TEXT,
+	repo_id TEXT,
+	branch TEXT,
+	pinned INTEGER DEFAULT 0,
+	spec_note_id TEXT,
+	charter TEXT,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	completed_at DATETIME,
+	FOREIGN KEY (commission_id) REFERENCES commissions(id),
+	FOREIGN KEY (assigned_workbench_id) REFERENCES workbenches(id),
+	FOREIGN KEY (repo_id) REFERENCES repos(id),
+	FOREIGN KEY (spec_note_id) REFERENCES notes(id) ON DELETE SET NULL
+);
+
+-- Tomes (Knowledge containers)
+CREATE TABLE IF NOT EXISTS tomes (
+	id TEXT PRIMARY KEY,
+	commission_id TEXT NOT NULL,
+	title TEXT NOT NULL,
+	description TEXT,
+	status TEXT NOT NULL CHECK(status IN ('open', 'closed')) DEFAULT 'open',
+	assigned_workbench_id TEXT,
+	pinned INTEGER DEFAULT 0,
+	charter TEXT,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	closed_at DATETIME,
+	FOREIGN KEY (commission_id) REFERENCES commissions(id),
+	FOREIGN KEY (assigned_workbench_id) REFERENCES workbenches(id)
+);
+
+-- Tasks (Atomic units of work)
+CREATE TABLE IF NOT EXISTS tasks (
+	id TEXT PRIMARY KEY,
+	shipment_id TEXT,
+	commission_id TEXT NOT NULL,
+	tome_id TEXT,
+	title TEXT NOT NULL,
+	description TEXT,
+	type TEXT CHECK(type IN ('research', 'implementation', 'fix', 'documentation', 'maintenance')),
+	status TEXT NOT NULL CHECK(status IN ('open', 'in-progress', 'blocked', 'closed')) DEFAULT 'open',
+	priority TEXT CHECK(priority IN ('low', 'medium', 'high')),
+	assigned_workbench_id TEXT,
+	pinned INTEGER DEFAULT 0,
+	depends_on TEXT,
+	points INTEGER, -- Estimate in task points (for commission budgets)
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	claimed_at DATETIME,
+	claim_refreshed_at DATETIME, -- Last heartbeat from the claiming workbench (claims expire without one)
+	completed_at DATETIME,
+	FOREIGN KEY (shipment_id) REFERENCES shipments(id) ON DELETE CASCADE,
+	FOREIGN KEY (commission_id) REFERENCES commissions(id),
+	FOREIGN KEY (tome_id) REFERENCES tomes(id) ON DELETE SET NULL,
+	FOREIGN KEY (assigned_workbench_id) REFERENCES workbenches(id)
+);
+
+-- PRs (Pull requests)
+CREATE TABLE IF NOT EXISTS prs (
+	id TEXT PRIMARY KEY,
+	shipment_id TEXT NOT NULL UNIQUE,
+	repo_id TEXT NOT NULL,
+	commission_id TEXT NOT NULL,
+	number INTEGER,
+	title TEXT NOT NULL,
+	description TEXT,
+	branch TEXT NOT NULL,
+	target_branch TEXT,
+	url TEXT,
+	status TEXT NOT NULL CHECK(status IN ('draft', 'open', 'approved', 'merged', 'closed')) DEFAULT 'open',
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	merged_at DATETIME,
+	closed_at DATETIME,
+	FOREIGN KEY (shipment_id) REFERENCES shipments(id) ON DELETE CASCADE,
+	FOREIGN KEY (repo_id) REFERENCES repos(id),
+	FOREIGN KEY (commission_id) REFERENCES commissions(id)
+);
+
+-- Plans (Implementation plans - 1:many with Task)
+CREATE TABLE IF NOT EXISTS plans (
+	id TEXT PRIMARY KEY,
+	commission_id TEXT NOT NULL,
+	task_id TEXT NOT NULL,
+	title TEXT NOT NULL,
+	description TEXT,
+	content TEXT,
+	status TEXT NOT NULL CHECK(status IN ('draft', 'approved')) DEFAULT 'draft',
+	pinned INTEGER DEFAULT 0,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	approved_at DATETIME,
+	promoted_from_id TEXT,
+	promoted_from_type TEXT,
+	FOREIGN KEY (commission_id) REFERENCES commissions(id),
+	FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+
+-- Notes (Observations and learnings)
+CREATE TABLE IF NOT EXISTS notes (
+	id TEXT PRIMARY KEY,
+	commission_id TEXT NOT NULL,
+	shipment_id TEXT,
+	tome_id TEXT,
+	title TEXT NOT NULL,
+	content TEXT,
+	type TEXT,
+	status TEXT NOT NULL CHECK(status IN ('open', 'in_flight', 'resolved', 'closed')) DEFAULT 'open',
+	pinned INTEGER DEFAULT 0,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	closed_at DATETIME,
+	promoted_from_id TEXT,
+	promoted_from_type TEXT,
+	close_reason TEXT,
+	closed_by_note_id TEXT,
+	position INTEGER, -- Reading order within the tome; NULL notes follow the ordered ones
+	FOREIGN KEY (commission_id) REFERENCES commissions(id),
+	FOREIGN KEY (shipment_id) REFERENCES shipments(id) ON DELETE SET NULL,
+	FOREIGN KEY (tome_id) REFERENCES tomes(id) ON DELETE SET NULL,
+	FOREIGN KEY (closed_by_note_id) REFERENCES notes(id) ON DELETE SET NULL
+);
+
+-- Create indexes for common queries
+CREATE INDEX IF NOT EXISTS idx_tags_name ON tags(name);
+CREATE INDEX IF NOT EXISTS idx_entity_tags_entity ON entity_tags(entity_id, entity_type);
+CREATE INDEX IF NOT EXISTS idx_entity_tags_tag ON entity_tags(tag_id);
+CREATE INDEX IF NOT EXISTS idx_entity_tags_type ON entity_tags(entity_type);
+CREATE INDEX IF NOT EXISTS idx_repos_name ON repos(name);
+CREATE INDEX IF NOT EXISTS idx_repos_status ON repos(status);
+CREATE INDEX IF NOT EXISTS idx_factories_name ON factories(name);
+CREATE INDEX IF NOT EXISTS idx_factories_status ON factories(status);
+CREATE INDEX IF NOT EXISTS idx_workshops_factory ON workshops(factory_id);
+CREATE INDEX IF NOT EXISTS idx_workshops_status ON workshops(status);
+CREATE INDEX IF NOT EXISTS idx_workshops_commission ON workshops(active_commission_id);
+CREATE INDEX IF NOT EXISTS idx_workbenches_workshop ON workbenches(workshop_id);
+CREATE INDEX IF NOT EXISTS idx_workbenches_status ON workbenches(status);
+CREATE INDEX IF NOT EXISTS idx_workbenches_repo ON workbenches(repo_id);
+CREATE INDEX IF NOT EXISTS idx_commissions_factory ON commissions(factory_id);
+CREATE INDEX IF NOT EXISTS idx_commissions_workshop ON commissions(workshop_id);
+CREATE INDEX IF NOT EXISTS idx_commissions_status ON commissions(status);
+CREATE INDEX IF NOT EXISTS idx_shipments_commission ON shipments(commission_id);
+CREATE INDEX IF NOT EXISTS idx_shipments_status ON shipments(status);
+CREATE INDEX IF NOT EXISTS idx_shipments_workbench ON shipments(assigned_workbench_id);
+CREATE INDEX IF NOT EXISTS idx_tomes_commission ON tomes(commission_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_shipment ON tasks(shipment_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_commission ON tasks(commission_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_status ON tasks(status);
+CREATE INDEX IF NOT EXISTS idx_tasks_workbench ON tasks(assigned_workbench_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_tome ON tasks(tome_id);
+CREATE INDEX IF NOT EXISTS idx_prs_shipment ON prs(shipment_id);
+CREATE INDEX IF NOT EXISTS idx_prs_repo ON prs(repo_id);
+CREATE INDEX IF NOT EXISTS idx_prs_commission ON prs(commission_id);
+CREATE INDEX IF NOT EXISTS idx_prs_status ON prs(status);
+CREATE INDEX IF NOT EXISTS idx_plans_commission ON plans(commission_id);
+CREATE INDEX IF NOT EXISTS idx_plans_task ON plans(task_id);
+CREATE INDEX IF NOT EXISTS idx_plans_status ON plans(status);
+CREATE INDEX IF NOT EXISTS idx_notes_commission ON notes(commission_id);
+CREATE INDEX IF NOT EXISTS idx_notes_shipment ON notes(shipment_id);
+-- Workshop Logs (audit trail for workshop changes)
+CREATE TABLE IF NOT EXISTS workshop_logs (
+	id TEXT PRIMARY KEY,
+	workshop_id TEXT NOT NULL,
+	timestamp DATETIME DEFAULT CURRENT_TIMESTAMP,
+	actor_id TEXT,
+	entity_type TEXT NOT NULL,
+	entity_id TEXT NOT NULL,
+	action TEXT NOT NULL CHECK(action IN ('create', 'update', 'delete')),
+	field_name TEXT,
+	old_value TEXT,
+	new_value TEXT,
+	undo_of TEXT, -- Log entry this entry reverted (set by orc undo)
+	forced INTEGER NOT NULL DEFAULT 0, -- 1 when a guard was overridden with --force
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (workshop_id) REFERENCES workshops(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_workshop_logs_workshop ON workshop_logs(workshop_id);
+CREATE INDEX IF NOT EXISTS idx_workshop_logs_timestamp ON workshop_logs(timestamp DESC);
+CREATE INDEX IF NOT EXISTS idx_workshop_logs_actor ON workshop_logs(actor_id);
+CREATE INDEX IF NOT EXISTS idx_workshop_logs_entity ON workshop_logs(entity_type, entity_id);
+
+-- Hook Events (audit trail for Claude Code hook invocations)
+CREATE TABLE IF NOT EXISTS hook_events (
+	id TEXT PRIMARY KEY,
+	workbench_id TEXT NOT NULL,
+	hook_type TEXT NOT NULL CHECK(hook_type IN ('Stop', 'UserPromptSubmit')),
+	timestamp DATETIME DEFAULT CURRENT_TIMESTAMP,
+	payload_json TEXT,
+	cwd TEXT,
+	session_id TEXT,
+	shipment_id TEXT,
+	shipment_status TEXT,
+	task_count_incomplete INTEGER,
+	decision TEXT NOT NULL CHECK(decision IN ('allow', 'block')),
+	reason TEXT,
+	duration_ms INTEGER,
+	error TEXT,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (workbench_id) REFERENCES workbenches(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_hook_events_workbench ON hook_events(workbench_id);
+CREATE INDEX IF NOT EXISTS idx_hook_events_timestamp ON hook_events(timestamp DESC);
+CREATE INDEX IF NOT EXISTS idx_hook_events_type ON hook_events(hook_type);
+
+-- Commit Links (commits whose messages reference a task or shipment ID)
+CREATE TABLE IF NOT EXISTS commit_links (
+	commit_sha TEXT NOT NULL,
+	entity_type TEXT NOT NULL CHECK(entity_type IN ('task', 'shipment')),
+	entity_id TEXT NOT NULL,
+	workbench_id TEXT,
+	subject TEXT NOT NULL,
+	committed_at DATETIME,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (commit_sha, entity_id),
+	FOREIGN KEY (workbench_id) REFERENCES workbenches(id) ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS idx_commit_links_entity ON commit_links(entity_id);
+
+-- Task Checklist Items (lightweight sub-steps within a task)
+CREATE TABLE IF NOT EXISTS task_checklist_items (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	task_id TEXT NOT NULL,
+	text TEXT NOT NULL,
+	done INTEGER DEFAULT 0,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	completed_at DATETIME,
+	FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_task_checklist_items_task ON task_checklist_items(task_id);
+
+-- Entity Aliases (human-friendly slugs accepted wherever an ID is)
+CREATE TABLE IF NOT EXISTS entity_aliases (
+	entity_id TEXT PRIMARY KEY,
+	entity_type TEXT NOT NULL CHECK(entity_type IN ('shipment', 'task', 'tome')),
+	commission_id TEXT NOT NULL,
+	slug TEXT NOT NULL,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (commission_id) REFERENCES commissions(id) ON DELETE CASCADE,
+	UNIQUE(commission_id, slug)
+);
+CREATE INDEX IF NOT EXISTS idx_entity_aliases_slug ON entity_aliases(slug);
+
+-- Plan Steps (approved plan sections tracked against tasks)
+CREATE TABLE IF NOT EXISTS plan_steps (
+	plan_id TEXT NOT NULL,
+	position INTEGER NOT NULL,
+	title TEXT NOT NULL,
+	task_id TEXT,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (plan_id, position),
+	FOREIGN KEY (plan_id) REFERENCES plans(id) ON DELETE CASCADE,
+	FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS idx_plan_steps_task ON plan_steps(task_id);
+
+-- Secrets (encrypted integration credentials, scoped global/factory/repo)
+CREATE TABLE IF NOT EXISTS secrets (
+	name TEXT NOT NULL,
+	scope_type TEXT NOT NULL CHECK(scope_type IN ('global', 'factory', 'repo')),
+	scope_id TEXT NOT NULL DEFAULT '',
+	ciphertext TEXT NOT NULL,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (name, scope_type, scope_id)
+);
+
+-- Comments (lightweight attributed remarks on any entity, threaded by reply_to_id)
+CREATE TABLE IF NOT EXISTS comments (
+	id TEXT PRIMARY KEY,
+	entity_id TEXT NOT NULL,
+	entity_type TEXT NOT NULL CHECK(entity_type IN ('commission', 'shipment', 'task', 'tome', 'note', 'plan')),
+	reply_to_id TEXT,
+	author TEXT,
+	body TEXT NOT NULL,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (reply_to_id) REFERENCES comments(id) ON DELETE SET NULL
+);
+CREATE INDEX IF NOT EXISTS idx_comments_entity ON comments(entity_id);
+
+-- Workbench environment variables (injected into tmux panes and agent sessions)
+-- A variable holds either a plain value or a reference to a secret, resolved at injection time.
+CREATE TABLE IF NOT EXISTS workbench_env (
+	workbench_id TEXT NOT NULL,
+	name TEXT NOT NULL,
+	value TEXT,
+	secret_name TEXT,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (workbench_id, name),
+	FOREIGN KEY (workbench_id) REFERENCES workbenches(id) ON DELETE CASCADE
+);
+
+-- Tag routes (the workbench that specializes in a tag's tasks)
+CREATE TABLE IF NOT EXISTS tag_routes (
+	tag_id TEXT PRIMARY KEY,
+	workbench_id TEXT NOT NULL,
+	mode TEXT NOT NULL CHECK(mode IN ('suggest', 'assign')) DEFAULT 'suggest',
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE,
+	FOREIGN KEY (workbench_id) REFERENCES workbenches(id) ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_tag_routes_workbench ON tag_routes(workbench_id);
+
+-- Read models: denormalized list views so list queries fetch each row's
+-- tag, checklist, comment, and task counts in one query instead of per row.
+-- Views are computed on read, so they never go stale and need no triggers.
+CREATE VIEW IF NOT EXISTS task_list_view AS
+SELECT t.*,
+	(SELECT MIN(tg.name) FROM entity_tags et JOIN tags tg ON tg.id = et.tag_id
+	 WHERE et.entity_id = t.id AND et.entity_type = 'task') AS tag_name,
+	(SELECT COUNT(*) FROM task_checklist_items c WHERE c.task_id = t.id AND c.done = 1) AS checklist_done,
+	(SELECT COUNT(*) FROM task_checklist_items c WHERE c.task_id = t.id) AS checklist_total,
+	(SELECT COUNT(*) FROM comments cm WHERE cm.entity_id = t.id AND cm.entity_type = 'task') AS comment_count
+FROM tasks t;
+
+CREATE VIEW IF NOT EXISTS shipment_list_view AS
+SELECT s.*,
+	(SELECT COUNT(*) FROM tasks t WHERE t.shipment_id = s.id) AS task_count,
+	(SELECT COUNT(*) FROM tasks t WHERE t.shipment_id = s.id AND t.status = 'closed') AS tasks_closed,
+	(SELECT w.name FROM workbenches w WHERE w.id = s.assigned_workbench_id) AS workbench_name
+FROM shipments s;
+
+-- Commission Budgets (planned spend in hours or task points, with warning thresholds)
+CREATE TABLE IF NOT EXISTS commission_budgets (
+	commission_id TEXT PRIMARY KEY,
+	unit TEXT NOT NULL CHECK(unit IN ('hours', 'points')),
+	amount REAL NOT NULL CHECK(amount > 0),
+	thresholds TEXT NOT NULL DEFAULT '75,90', -- Comma-separated warning percentages
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (commission_id) REFERENCES commissions(id) ON DELETE CASCADE
+);
+
+-- PR Reviews (reviews and inline review comments fetched from GitHub)
+CREATE TABLE IF NOT EXISTS pr_reviews (
+	pr_id TEXT NOT NULL,
+	external_id TEXT NOT NULL, -- 'review:<id>' or 'comment:<id>'
+	kind TEXT NOT NULL CHECK(kind IN ('review', 'comment')),
+	review_external_id TEXT, -- Comments: the review they were submitted with
+	in_reply_to INTEGER DEFAULT 0,
+	author TEXT,
+	state TEXT, -- Reviews: APPROVED, CHANGES_REQUESTED, COMMENTED, DISMISSED
+	body TEXT,
+	path TEXT,
+	line INTEGER,
+	url TEXT,
+	submitted_at DATETIME,
+	task_id TEXT, -- Task created for a requested change
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	PRIMARY KEY (pr_id, external_id),
+	FOREIGN KEY (pr_id) REFERENCES prs(id) ON DELETE CASCADE,
+	FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE SET NULL
+);
+
+-- Entity Locks (advisory locks against concurrent edits; expired rows are ignored)
+CREATE TABLE IF NOT EXISTS entity_locks (
+	entity_id TEXT PRIMARY KEY, -- SHIP-xxx or PLAN-xxx
+	held_by TEXT NOT NULL, -- Actor ID, e.g. GOBLIN or IMP-BENCH-001
+	reason TEXT,
+	acquired_at DATETIME NOT NULL,
+	expires_at DATETIME NOT NULL
+);
+
+-- Focus History (past focus targets per workbench, for orc focus recent / orc focus -)
+CREATE TABLE IF NOT EXISTS focus_history (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	workbench_id TEXT NOT NULL,
+	focused_id TEXT NOT NULL,
+	focused_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	FOREIGN KEY (workbench_id) REFERENCES workbenches(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_focus_history_workbench ON focus_history(workbench_id);
+
+-- Fixture rows
+INSERT INTO factories (id, name) VALUES ('FACT-001', 'default');
+INSERT INTO workshops (id, factory_id, name) VALUES ('WORK-001', 'FACT-001', 'ironforge');
+INSERT INTO repos (id, name, local_path) VALUES ('REPO-001', 'orc', '/src/orc');
+INSERT INTO commissions (id, workshop_id, title, status) VALUES ('COMM-001', 'WORK-001', 'Ship it', 'active');
+UPDATE workshops SET active_commission_id = 'COMM-001' WHERE id = 'WORK-001';
+INSERT INTO workbenches (id, workshop_id, name, repo_id, home_branch) VALUES ('BENCH-001', 'WORK-001', 'orc-001', 'REPO-001', 'ml/orc-001');
+INSERT INTO workbenches (id, workshop_id, name, repo_id, status) VALUES ('BENCH-002', 'WORK-001', 'orc-002', 'REPO-001', 'archived');
+INSERT INTO shipments (id, commission_id, title, status, assigned_workbench_id, repo_id, branch) VALUES ('SHIP-001', 'COMM-001', 'Auth refactor', 'in-progress', 'BENCH-001', 'REPO-001', 'ml/SHIP-001-auth');
+INSERT INTO shipments (id, commission_id, title, status) VALUES ('SHIP-002', 'COMM-001', 'Docs', 'closed');
+INSERT INTO tomes (id, commission_id, title) VALUES ('TOME-001', 'COMM-001', 'Auth research');
+INSERT INTO tasks (id, shipment_id, commission_id, title, type, status, assigned_workbench_id) VALUES ('TASK-001', 'SHIP-001', 'COMM-001', 'Move tokens', 'implementation', 'in-progress', 'BENCH-001');
+INSERT INTO tasks (id, shipment_id, commission_id, title, status, depends_on) VALUES ('TASK-002', 'SHIP-001', 'COMM-001', 'Remove old store', 'open', '["TASK-001"]');
+INSERT INTO tasks (id, shipment_id, commission_id, title, status) VALUES ('TASK-003', 'SHIP-002', 'COMM-001', 'Write guide', 'closed');
+INSERT INTO plans (id, commission_id, task_id, title, content, status) VALUES ('PLAN-001', 'COMM-001', 'TASK-001', 'Token plan', '1. Add keychain
+2. Migrate', 'approved');
+INSERT INTO notes (id, commission_id, tome_id, title, content, type) VALUES ('NOTE-001', 'COMM-001', 'TOME-001', 'Keychain APIs', 'Use the OS keychain.', 'learning');
+INSERT INTO notes (id, commission_id, shipment_id, title, type, status) VALUES ('NOTE-002', 'COMM-001', 'SHIP-001', 'Flaky login test', 'bug', 'closed');
+INSERT INTO tags (id, name) VALUES ('TAG-001', 'security');
+INSERT INTO entity_tags (id, entity_id, entity_type, tag_id) VALUES ('ET-001', 'TASK-001', 'task', 'TAG-001');
+INSERT INTO workshop_logs (id, workshop_id, actor_id, entity_type, entity_id, action, field_name, old_value, new_value, forced) VALUES ('WL-0001', 'WORK-001', 'BENCH-001', 'task', 'TASK-001', 'update', 'status', 'open', 'in-progress', 1);
+INSERT INTO task_checklist_items (task_id, text, done) VALUES ('TASK-001', 'update callers', 1);
+INSERT INTO entity_aliases (entity_id, entity_type, commission_id, slug) VALUES ('SHIP-001', 'shipment', 'COMM-001', 'auth-refactor');
+INSERT INTO plan_steps (plan_id, position, title, task_id) VALUES ('PLAN-001', 1, 'Add keychain', 'TASK-001');
+INSERT INTO commit_links (commit_sha, entity_type, entity_id, workbench_id, subject) VALUES ('abc123', 'task', 'TASK-001', 'BENCH-001', 'TASK-001: move tokens');
+INSERT INTO comments (id, entity_id, entity_type, author, body) VALUES ('CMT-001', 'TASK-001', 'task', 'BENCH-001', 'blocked on infra');
+INSERT INTO workbench_env (workbench_id, name, value) VALUES ('BENCH-001', 'API_BASE', 'staging');
+INSERT INTO tag_routes (tag_id, workbench_id, mode) VALUES ('TAG-001', 'BENCH-001', 'assign');
+INSERT INTO commission_budgets (commission_id, unit, amount) VALUES ('COMM-001', 'hours', 40);
+INSERT INTO prs (id, shipment_id, repo_id, commission_id, number, title, branch, url, status) VALUES ('PR-001', 'SHIP-001', 'REPO-001', 'COMM-001', 12, 'Auth refactor', 'ml/SHIP-001-auth', 'https://github.com/acme/orc/pull/12', 'open');
+INSERT INTO pr_reviews (pr_id, external_id, kind, author, state, body, task_id) VALUES ('PR-001', 'review:1', 'review', 'octocat', 'CHANGES_REQUESTED', 'Needs tests', 'TASK-002');
+INSERT INTO entity_locks (entity_id, held_by, acquired_at, expires_at) VALUES ('SHIP-001', 'GOBLIN', '2026-10-16 14:02:00', '2026-10-16 14:32:00');
+INSERT INTO notes (id, commission_id, tome_id, title, type, position) VALUES ('NOTE-003', 'COMM-001', 'TOME-001', 'Token rotation', 'decision', 1);
+INSERT INTO focus_history (workbench_id, focused_id) VALUES ('BENCH-001', 'SHIP-001');
+UPDATE tasks SET claimed_at = '2026-10-16 13:40:00', claim_refreshed_at = '2026-10-16 14:05:00' WHERE id = 'TASK-001';
+
+PRAGMA user_version = 11;
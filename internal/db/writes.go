@@ -0,0 +1,88 @@
+package db
+
+import (
+	"sync"
+
+	sqlite3 "github.com/mattn/go-sqlite3"
+)
+
+// bookkeepingTables are written as a side effect of any command, reads
+// included, so RefuseWrites leaves them open and LedgerChanges ignores them.
+var bookkeepingTables = map[string]bool{
+	"schema_migrations": true, // Raised when a command opens an older ledger
+	"migration_lock":    true,
+	"command_stats":     true, // Telemetry of the command itself
+	"entity_presence":   true, // Presence markers of the command itself
+	"actor_throttles":   true, // Saved by the check that refuses writes
+	"embeddings":        true, // Cache filled by 'orc recall'
+}
+
+var (
+	writeMu       sync.Mutex
+	refuseReason  error // Set by RefuseWrites
+	refusedWrite  bool  // A write was refused since
+	ledgerChanges int64 // Rows changed outside the bookkeeping tables
+)
+
+// RefuseWrites makes every later change to the ledger fail, for an actor
+// throttled for runaway activity, while reads keep working. The refusal is
+// enforced by SQLite on every ledger connection, so it covers every command
+// and service alike. RefusedWrite returns reason once a write was refused.
+func RefuseWrites(reason error) {
+	writeMu.Lock()
+	defer writeMu.Unlock()
+	refuseReason = reason
+}
+
+// RefusedWrite returns the reason given to RefuseWrites if a write has been
+// refused since, or nil. Commands surface the refusal as SQLite's "not
+// authorized"; this says why.
+func RefusedWrite() error {
+	writeMu.Lock()
+	defer writeMu.Unlock()
+	if !refusedWrite {
+		return nil
+	}
+	return refuseReason
+}
+
+// LedgerChanges counts the rows this process has inserted, updated, or
+// deleted in the ledger, bookkeeping tables aside. Comparing two counts
+// tells whether a command wrote anything in between.
+func LedgerChanges() int64 {
+	writeMu.Lock()
+	defer writeMu.Unlock()
+	return ledgerChanges
+}
+
+// guardWrites installs the RefuseWrites check and the LedgerChanges count
+// on a new ledger connection.
+func guardWrites(conn *sqlite3.SQLiteConn) {
+	conn.RegisterAuthorizer(authorizeWrite)
+	conn.RegisterUpdateHook(func(op int, database, table string, rowid int64) {
+		if database == "temp" || bookkeepingTables[table] {
+			return
+		}
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		ledgerChanges++
+	})
+}
+
+// authorizeWrite denies inserts, updates, and deletes on ledger tables once
+// writes are refused. SQLite consults it as each statement is prepared.
+func authorizeWrite(op int, table, _, database string) int {
+	if op != sqlite3.SQLITE_INSERT && op != sqlite3.SQLITE_UPDATE && op != sqlite3.SQLITE_DELETE {
+		return sqlite3.SQLITE_OK
+	}
+	if database == "temp" || bookkeepingTables[table] {
+		return sqlite3.SQLITE_OK
+	}
+	writeMu.Lock()
+	defer writeMu.Unlock()
+	if refuseReason == nil {
+		return sqlite3.SQLITE_OK
+	}
+	refusedWrite = true
+	return sqlite3.SQLITE_DENY
+}
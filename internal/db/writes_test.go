@@ -0,0 +1,63 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestRefuseWrites(t *testing.T) {
+	ledger, err := sql.Open(ledgerDriverName, filepath.Join(t.TempDir(), "orc.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ledger.Close()
+	for _, stmt := range []string{
+		"CREATE TABLE tasks (id TEXT PRIMARY KEY, status TEXT)",
+		"CREATE TABLE entity_presence (entity_id TEXT PRIMARY KEY)",
+		"INSERT INTO tasks VALUES ('TASK-001', 'open')",
+	} {
+		if _, err := ledger.Exec(stmt); err != nil {
+			t.Fatalf("setup failed: %v", err)
+		}
+	}
+	t.Cleanup(func() { refuseReason, refusedWrite = nil, false })
+
+	before := LedgerChanges()
+	if _, err := ledger.Exec("UPDATE tasks SET status = 'closed'"); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if LedgerChanges() != before+1 {
+		t.Errorf("LedgerChanges = %d, want %d", LedgerChanges(), before+1)
+	}
+
+	throttled := errors.New("IMP-BENCH-014 is throttled")
+	RefuseWrites(throttled)
+	if RefusedWrite() != nil {
+		t.Error("RefusedWrite reported a refusal before any write")
+	}
+
+	var status string
+	if err := ledger.QueryRow("SELECT status FROM tasks WHERE id = 'TASK-001'").Scan(&status); err != nil {
+		t.Errorf("read refused: %v", err)
+	}
+	if _, err := ledger.Exec("INSERT INTO entity_presence VALUES ('TASK-001')"); err != nil {
+		t.Errorf("bookkeeping write refused: %v", err)
+	}
+	for _, stmt := range []string{
+		"INSERT INTO tasks VALUES ('TASK-002', 'open')",
+		"UPDATE tasks SET status = 'open'",
+		"DELETE FROM tasks",
+	} {
+		if _, err := ledger.Exec(stmt); err == nil {
+			t.Errorf("%s: expected the write to be refused", stmt)
+		}
+	}
+	if !errors.Is(RefusedWrite(), throttled) {
+		t.Errorf("RefusedWrite = %v, want the throttle", RefusedWrite())
+	}
+	if LedgerChanges() != before+1 {
+		t.Errorf("LedgerChanges = %d, want only the first update counted", LedgerChanges())
+	}
+}
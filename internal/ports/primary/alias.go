@@ -0,0 +1,20 @@
+package primary
+
+import "context"
+
+// AliasService defines the primary port for entity aliases.
+// Aliases are human-friendly slugs for shipments, tasks, and tomes,
+// unique within a commission.
+type AliasService interface {
+	// SetAlias assigns a slug to a shipment, task, or tome, replacing any previous alias.
+	SetAlias(ctx context.Context, entityID, slug string) error
+
+	// RemoveAlias removes an entity's alias.
+	RemoveAlias(ctx context.Context, entityID string) error
+
+	// ResolveAlias returns the entity ID a slug refers to, or ref unchanged
+	// if it is not a known alias. commissionID (optional) picks between
+	// entities that use the same slug in different commissions. IDs from
+	// before a prefix rename ('orc db rename-prefix') resolve to their new IDs.
+	ResolveAlias(ctx context.Context, ref, commissionID string) (string, error)
+}
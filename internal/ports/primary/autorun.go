@@ -0,0 +1,39 @@
+package primary
+
+import "context"
+
+// AutorunService drives shipments that run their tasks unattended: the
+// shipment's IMP is told which task to claim next, one at a time, until a
+// gate needs a human.
+type AutorunService interface {
+	// SetAutorun turns autorun on, pauses it, or turns it off (empty state).
+	SetAutorun(ctx context.Context, shipmentID, state string) error
+
+	// NextStep decides what the shipment's IMP should do next.
+	NextStep(ctx context.Context, shipmentID string) (*AutorunStep, error)
+}
+
+// Autorun states. A shipment with autorun off has the empty state.
+const (
+	AutorunOn     = "on"
+	AutorunPaused = "paused"
+)
+
+// Autorun actions.
+const (
+	AutorunRun     = "run"     // claim and start TaskID
+	AutorunWorking = "working" // TaskID is in progress
+	AutorunWait    = "wait"    // a gate holds the run
+	AutorunDone    = "done"    // every task is closed
+	AutorunIdle    = "idle"    // autorun is off or paused, or the shipment isn't launched
+)
+
+// AutorunStep is the executor's decision for a shipment.
+type AutorunStep struct {
+	ShipmentID string
+	State      string // "", on, or paused
+	Action     string
+	TaskID     string
+	TaskTitle  string
+	Reason     string
+}
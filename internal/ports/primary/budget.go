@@ -0,0 +1,48 @@
+package primary
+
+import "context"
+
+// BudgetService defines the primary port for commission budgets.
+type BudgetService interface {
+	// SetBudget creates or replaces a commission's budget.
+	SetBudget(ctx context.Context, req SetBudgetRequest) error
+
+	// ClearBudget removes a commission's budget.
+	ClearBudget(ctx context.Context, commissionID string) error
+
+	// GetBudgetReport computes a commission's budget consumption.
+	// Returns nil if the commission has no budget.
+	GetBudgetReport(ctx context.Context, commissionID string) (*BudgetReport, error)
+
+	// ListBudgetReports computes consumption for every commission with a budget.
+	ListBudgetReports(ctx context.Context) ([]*BudgetReport, error)
+}
+
+// Budget units.
+const (
+	BudgetUnitHours  = "hours"
+	BudgetUnitPoints = "points"
+)
+
+// SetBudgetRequest contains parameters for setting a commission budget.
+type SetBudgetRequest struct {
+	CommissionID string
+	Unit         string  // hours or points
+	Amount       float64 // Budgeted hours or points
+	Thresholds   string  // Comma-separated warning percentages; empty uses "75,90"
+}
+
+// BudgetReport is a commission budget with its computed consumption.
+type BudgetReport struct {
+	CommissionID     string
+	CommissionTitle  string
+	Unit             string
+	Amount           float64
+	Spent            float64 // Hours worked on claimed tasks, or points of closed tasks
+	Planned          float64 // Points: estimates of all tasks. Hours: 0
+	Unestimated      int     // Points: open tasks without an estimate
+	Percent          float64 // Spent as a percentage of Amount
+	Thresholds       []int
+	ThresholdReached int  // Highest threshold reached, 0 if none
+	Over             bool // Spent exceeds Amount
+}
@@ -0,0 +1,33 @@
+package primary
+
+import "context"
+
+// CommentService defines the primary port for entity comments.
+// Comments are lightweight, attributed remarks on commissions, shipments,
+// tasks, tomes, notes, and plans. Unlike notes they are not containers.
+type CommentService interface {
+	// AddComment attaches a comment to an entity, attributed to the current actor.
+	AddComment(ctx context.Context, req AddCommentRequest) (*Comment, error)
+
+	// ListComments retrieves an entity's comments in thread order:
+	// chronological, with each reply following the comment it answers.
+	ListComments(ctx context.Context, entityID string) ([]*Comment, error)
+}
+
+// AddCommentRequest contains parameters for adding a comment.
+type AddCommentRequest struct {
+	EntityID  string
+	Body      string
+	ReplyToID string // Optional: comment on the same entity being answered
+}
+
+// Comment represents a comment on an entity.
+type Comment struct {
+	ID        string
+	EntityID  string
+	ReplyToID string
+	Author    string // Actor ID, empty when added outside a workbench
+	Body      string
+	CreatedAt string
+	Depth     int // Reply depth within the thread (0 for thread starters)
+}
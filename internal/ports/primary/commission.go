@@ -20,7 +20,8 @@ type CommissionService interface {
 	// GetCommission retrieves a commission by ID.
 	GetCommission(ctx context.Context, commissionID string) (*Commission, error)
 
-	// ListCommissions lists commissions with optional filters.
+	// ListCommissions lists commissions with optional filters. With
+	// WithRollups, each commission carries its open work counts and health.
 	ListCommissions(ctx context.Context, filters CommissionFilters) ([]*Commission, error)
 
 	// CompleteCommission marks a commission as complete.
@@ -85,12 +86,24 @@ type Commission struct {
 	CreatedAt   string
 	StartedAt   string
 	CompletedAt string
+	Rollup      *CommissionRollup // Set when listed WithRollups
+}
+
+// CommissionRollup summarizes a commission's open work.
+type CommissionRollup struct {
+	OpenShipments    int
+	ReadyTasks       int // Open tasks
+	InProgressTasks  int
+	BlockedTasks     int
+	PendingApprovals int    // Draft plans on tasks not closed
+	Health           string // 'ok', 'attention', 'at-risk'
 }
 
 // CommissionFilters contains filter options for listing commissions.
 type CommissionFilters struct {
-	Status string
-	Limit  int
+	Status      string
+	Limit       int
+	WithRollups bool // Include each commission's rollup
 }
 
 // UpdateCommissionRequest contains parameters for updating a commission.
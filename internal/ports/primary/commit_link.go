@@ -0,0 +1,131 @@
+package primary
+
+import "context"
+
+// CommitLinkService defines the primary port for commit↔entity linking.
+// Commits on a workbench branch whose messages mention task or shipment IDs
+// (e.g. "TASK-123: fix redirect") are recorded as links to those entities.
+type CommitLinkService interface {
+	// SyncWorkbenchCommits scans the workbench branch for entity references
+	// and records any new commit links.
+	SyncWorkbenchCommits(ctx context.Context, workbenchID string) (*SyncCommitsResult, error)
+
+	// GetEntityCommits retrieves the commits linked to a task or shipment, newest first.
+	GetEntityCommits(ctx context.Context, entityID string) ([]*CommitLink, error)
+
+	// LandShipment syncs the shipment's workbench, verifies every task has at
+	// least one linked commit, and completes the shipment.
+	LandShipment(ctx context.Context, shipmentID string, force bool) (*LandShipmentResult, error)
+
+	// DiffShipment returns the aggregate diff of the shipment's workbench branch
+	// against its base, with each file annotated by the tasks that touched it.
+	DiffShipment(ctx context.Context, req DiffShipmentRequest) (*ShipmentDiff, error)
+
+	// SuggestTasks matches the files being edited in a workbench against the
+	// commission's open and in-progress tasks to suggest which one is being worked.
+	SuggestTasks(ctx context.Context, req SuggestTasksRequest) (*TaskSuggestions, error)
+
+	// FindConflicts compares the branches of open shipments with assigned
+	// workbenches and reports pairs modifying the same files.
+	// An empty commissionID checks every commission.
+	FindConflicts(ctx context.Context, commissionID string) (*ConflictReport, error)
+}
+
+// ConflictReport lists open shipments whose branches modify the same files.
+type ConflictReport struct {
+	Checked  []string // Shipments whose branches were compared
+	Skipped  []ConflictSkip
+	Overlaps []*ShipmentOverlap
+}
+
+// ConflictSkip is a shipment whose branch could not be read.
+type ConflictSkip struct {
+	ShipmentID string
+	Reason     string
+}
+
+// ShipmentOverlap is a pair of shipment branches modifying the same files.
+type ShipmentOverlap struct {
+	ShipmentA  string
+	WorkbenchA string
+	ShipmentB  string
+	WorkbenchB string
+	Files      []string
+}
+
+// SuggestTasksRequest selects the workbench and commission to match.
+type SuggestTasksRequest struct {
+	WorkbenchID  string
+	CommissionID string
+}
+
+// TaskSuggestions ranks tasks against a workbench's uncommitted changes.
+type TaskSuggestions struct {
+	WorkbenchID  string
+	EditedFiles  []string
+	Suggestions  []*TaskSuggestion
+	AutoClaimID  string // Suggestion confident enough to claim unasked; empty if none
+	InProgressID string // Task this workbench already has in progress; empty if none
+}
+
+// TaskSuggestion is one task that may explain the edited files.
+type TaskSuggestion struct {
+	TaskID  string
+	Title   string
+	Status  string
+	Score   int
+	Matches []string // Edited files attributed to the task
+}
+
+// DiffShipmentRequest selects what DiffShipment returns.
+type DiffShipmentRequest struct {
+	ShipmentID string
+	WithPatch  bool     // Include the unified patch
+	Paths      []string // Limit the patch to these files (all files when empty)
+}
+
+// ShipmentDiff is a shipment branch's changes against its base branch.
+type ShipmentDiff struct {
+	ShipmentID  string
+	WorkbenchID string
+	BaseRef     string
+	Files       []*DiffFile
+	Added       int
+	Deleted     int
+	Patch       string // Empty unless requested
+}
+
+// DiffFile is one changed file in a shipment diff.
+type DiffFile struct {
+	Path    string
+	Added   int
+	Deleted int
+	Binary  bool
+	TaskIDs []string // Tasks referenced by commits that touched the file
+}
+
+// SyncCommitsResult contains the outcome of scanning a workbench branch.
+type SyncCommitsResult struct {
+	WorkbenchID string
+	BaseRef     string   // Commits reachable from this ref were skipped
+	Scanned     int      // Commits examined
+	NewLinks    int      // Links recorded by this sync
+	UnknownRefs []string // Referenced IDs not present in the ledger
+}
+
+// LandShipmentResult contains the outcome of landing a shipment.
+type LandShipmentResult struct {
+	ShipmentID string
+	Sync       *SyncCommitsResult // Nil when the shipment has no workbench
+	TaskCount  int
+}
+
+// CommitLink represents a commit that references an entity.
+type CommitLink struct {
+	CommitSHA   string
+	EntityType  string
+	EntityID    string
+	WorkbenchID string
+	Subject     string
+	CommittedAt string
+}
@@ -0,0 +1,87 @@
+package primary
+
+import "context"
+
+// FactoryTeardownService defines the primary port for shutting a factory down:
+// settling its open shipments, archiving its workbenches and workshops,
+// removing worktrees, killing tmux sessions, and writing a final report.
+type FactoryTeardownService interface {
+	// PlanTeardown inspects a factory and reports what teardown would touch.
+	PlanTeardown(ctx context.Context, factoryID string) (*FactoryTeardownPlan, error)
+
+	// ApplyTeardown tears the factory down and archives its infrastructure.
+	ApplyTeardown(ctx context.Context, req ApplyTeardownRequest) (*FactoryTeardownResult, error)
+}
+
+// Shipment dispositions for factory teardown.
+const (
+	TeardownComplete = "complete" // Close the shipment
+	TeardownReassign = "reassign" // Move it to a workbench in another factory
+	TeardownRelease  = "release"  // Leave it open and unassigned
+)
+
+// FactoryTeardownPlan describes a factory's infrastructure and open work.
+type FactoryTeardownPlan struct {
+	FactoryID     string
+	FactoryName   string
+	FactoryStatus string
+	Workshops     []TeardownWorkshop
+	OpenShipments []TeardownShipment
+}
+
+// TeardownWorkshop is a workshop that teardown will archive.
+type TeardownWorkshop struct {
+	ID          string
+	Name        string
+	Status      string
+	SessionName string // Empty when no tmux session is running
+	Workbenches []TeardownWorkbench
+}
+
+// TeardownWorkbench is an active workbench that teardown will archive.
+type TeardownWorkbench struct {
+	ID             string
+	Name           string
+	Path           string
+	WorktreeExists bool
+	ChangedFiles   []string // Uncommitted changes that removal would discard
+}
+
+// TeardownShipment is an open shipment belonging to the factory.
+type TeardownShipment struct {
+	ID                 string
+	Title              string
+	Status             string
+	CommissionID       string
+	WorkbenchID        string
+	TaskCount          int
+	TasksClosed        int
+	DefaultDisposition string
+}
+
+// ShipmentDisposition is what teardown does with one open shipment.
+type ShipmentDisposition struct {
+	Action      string // TeardownComplete, TeardownReassign, or TeardownRelease
+	WorkbenchID string // Target for TeardownReassign
+}
+
+// ApplyTeardownRequest contains parameters for tearing a factory down.
+type ApplyTeardownRequest struct {
+	FactoryID    string
+	Dispositions map[string]ShipmentDisposition // By shipment ID; missing ones use the default
+	Force        bool                           // Discard uncommitted changes in worktrees
+}
+
+// FactoryTeardownResult records what teardown did.
+type FactoryTeardownResult struct {
+	FactoryID           string
+	FactoryName         string
+	Completed           []string
+	Reassigned          []string
+	Released            []string
+	WorkbenchesArchived []string
+	WorktreesRemoved    []string
+	SessionsKilled      []string
+	WorkshopsArchived   []string
+	Report              string // Markdown commission report
+}
@@ -0,0 +1,52 @@
+package primary
+
+import "context"
+
+// FlowService defines the primary port for canned workflows: declarative,
+// multi-step flows (create a shipment, its tasks, assign a bench, focus it)
+// run as one command and shared as files between operators.
+type FlowService interface {
+	// CheckFlow parses and validates a flow definition without running it.
+	CheckFlow(ctx context.Context, definition string) (*FlowDefinition, error)
+
+	// RunFlow runs a flow's steps in order. Steps an earlier run with the same
+	// run key completed are skipped, so rerunning after a failure resumes it.
+	// On failure the report lists the steps completed so far.
+	RunFlow(ctx context.Context, req RunFlowRequest) (*FlowRunReport, error)
+}
+
+// RunFlowRequest contains parameters for running a flow.
+type RunFlowRequest struct {
+	Definition string            // Flow file contents
+	Params     map[string]string // Values for the flow's params
+	RunKey     string            // Optional: defaults to the flow name plus a hash of Params
+}
+
+// FlowDefinition describes a valid flow.
+type FlowDefinition struct {
+	Name        string
+	Description string
+	Params      []string
+	Steps       []FlowStepInfo
+}
+
+// FlowStepInfo is one step of a flow definition.
+type FlowStepInfo struct {
+	ID     string
+	Action string // e.g. shipment.create
+}
+
+// FlowRunReport records what a flow run did.
+type FlowRunReport struct {
+	Flow   string
+	RunKey string
+	Steps  []FlowStepResult
+}
+
+// FlowStepResult is one step of a flow run.
+type FlowStepResult struct {
+	ID      string
+	Action  string
+	Output  string // IDs the step created or acted on, comma-separated
+	Skipped bool   // Completed by an earlier run with the same key
+}
@@ -0,0 +1,48 @@
+package primary
+
+import "context"
+
+// GlossaryService defines the primary port for commission glossaries: the
+// terms a commission's work uses, and deprecated terms with their replacements.
+type GlossaryService interface {
+	// AddTerm adds a term to a commission's glossary, or replaces its
+	// definition and replacement if it is already there.
+	AddTerm(ctx context.Context, req AddGlossaryTermRequest) error
+
+	// ListTerms lists a commission's glossary ordered by term.
+	ListTerms(ctx context.Context, commissionID string) ([]*GlossaryTerm, error)
+
+	// RemoveTerm removes a term from a commission's glossary.
+	RemoveTerm(ctx context.Context, commissionID, term string) error
+
+	// SeedFromTags adds a term for each described tag not yet in the
+	// commission's glossary, returning the terms added.
+	SeedFromTags(ctx context.Context, commissionID string) ([]*GlossaryTerm, error)
+
+	// Lint finds deprecated terms in the commission's plans and open notes.
+	Lint(ctx context.Context, commissionID string) ([]*GlossaryFinding, error)
+}
+
+// AddGlossaryTermRequest contains parameters for adding a glossary term.
+type AddGlossaryTermRequest struct {
+	CommissionID string
+	Term         string
+	Definition   string
+	ReplacedBy   string // Optional; deprecates the term in favor of this one
+}
+
+// GlossaryTerm is a glossary entry at the port boundary.
+type GlossaryTerm struct {
+	CommissionID string
+	Term         string
+	Definition   string
+	ReplacedBy   string // Empty unless the term is deprecated
+}
+
+// GlossaryFinding is a deprecated term used by a plan or note.
+type GlossaryFinding struct {
+	EntityID   string
+	Title      string
+	Term       string
+	ReplacedBy string
+}
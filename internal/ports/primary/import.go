@@ -0,0 +1,63 @@
+package primary
+
+import "context"
+
+// ImportService defines the primary port for importing issues from legacy trackers.
+type ImportService interface {
+	// ImportIssues converts a tracker CSV export into shipments and tasks in
+	// a commission. Issues imported before (found by their provenance alias)
+	// are skipped. With DryRun set, nothing is written and the report shows
+	// what would be created.
+	ImportIssues(ctx context.Context, req ImportIssuesRequest) (*ImportReport, error)
+}
+
+// ImportSource describes a tracker whose exports can be imported.
+type ImportSource struct {
+	Source  string   // jira or linear
+	Name    string   // Display name
+	Columns []string // CSV headers read
+}
+
+// ImportIssuesRequest contains parameters for an import.
+type ImportIssuesRequest struct {
+	Source       string // jira or linear
+	CommissionID string
+	CSV          []byte
+	Mapping      []byte // Optional: mapping file overriding the default status/type/label mapping
+	DryRun       bool
+}
+
+// ImportReport describes what an import did (or, for a dry run, would do).
+type ImportReport struct {
+	Source       string
+	SourceName   string // Display name of Source
+	CommissionID string
+	DryRun       bool
+	Items        []ImportedItem
+	Skipped      []ImportedItem   // Already imported, or of a type mapped to skip
+	Statuses     []ImportMapCount // Tracker status -> task status
+	Types        []ImportMapCount // Tracker issue type -> task type or shipment
+	NewTags      []string         // Tags created for labels
+	Warnings     []string
+}
+
+// ImportedItem is one issue and the entity it became.
+type ImportedItem struct {
+	Key        string // Original issue key; empty for generated shipments
+	EntityID   string // SHIP-xxx or TASK-xxx; empty on a dry run or for skipped types
+	Kind       string // shipment or task
+	Title      string
+	ShipmentID string // Tasks: their shipment (on a dry run, the shipment's key or title)
+	Status     string
+	Type       string
+	Tag        string
+	Reason     string // Skipped items: why
+}
+
+// ImportMapCount reports how many issues had a tracker value and what it mapped to.
+type ImportMapCount struct {
+	From     string
+	To       string
+	Count    int
+	Unmapped bool // No mapping entry; the default was used
+}
@@ -0,0 +1,57 @@
+package primary
+
+import "context"
+
+// LedgerService defines the primary port for cross-ledger references.
+// Other ledgers are registered under an alias and only ever read; their
+// entities are referred to as "alias:ID", e.g. "acme:SHIP-004".
+type LedgerService interface {
+	// AddLedger registers the ledger at path under an alias.
+	AddLedger(ctx context.Context, alias, path string) (*Ledger, error)
+
+	// ListLedgers lists the registered ledgers, checking each is readable.
+	ListLedgers(ctx context.Context) ([]*Ledger, error)
+
+	// RemoveLedger unregisters a ledger and removes the links made to it.
+	RemoveLedger(ctx context.Context, alias string) error
+
+	// Resolve reads the entity a reference points to.
+	Resolve(ctx context.Context, ref string) (*ForeignEntity, error)
+
+	// LinkRef links a local entity to a reference, which must resolve.
+	LinkRef(ctx context.Context, entityID, ref string) (*ForeignEntity, error)
+
+	// UnlinkRef removes a link.
+	UnlinkRef(ctx context.Context, entityID, ref string) error
+
+	// ListRefs lists the references linked from an entity, resolved where
+	// the other ledger can be read.
+	ListRefs(ctx context.Context, entityID string) ([]*LedgerRef, error)
+}
+
+// Ledger is a registered ledger.
+type Ledger struct {
+	Alias         string
+	Path          string
+	SchemaVersion int    // 0 when the ledger can't be read
+	Problem       string // Why the ledger can't be read; empty when it can
+	CreatedAt     string
+}
+
+// ForeignEntity is an entity read from another ledger.
+type ForeignEntity struct {
+	Ref          string // alias:ID
+	Ledger       string
+	ID           string
+	Type         string // commission, shipment, task, tome, note, or plan
+	Title        string
+	Status       string
+	CommissionID string // Empty for commissions
+}
+
+// LedgerRef is a link from a local entity to another ledger's entity.
+type LedgerRef struct {
+	Ref     string
+	Entity  *ForeignEntity // nil when the reference can't be resolved
+	Problem string         // Why it can't be resolved
+}
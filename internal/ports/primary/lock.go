@@ -0,0 +1,40 @@
+package primary
+
+import (
+	"context"
+	"time"
+)
+
+// LockService defines the primary port for advisory entity locks.
+type LockService interface {
+	// AcquireLock locks an entity for the current actor, or renews the actor's lock.
+	AcquireLock(ctx context.Context, req AcquireLockRequest) (*EntityLock, error)
+
+	// ReleaseLock releases an entity's lock. Force breaks another actor's lock.
+	ReleaseLock(ctx context.Context, entityID string, force bool) error
+
+	// GetLock retrieves an entity's active lock (nil if unlocked or expired).
+	GetLock(ctx context.Context, entityID string) (*EntityLock, error)
+
+	// ListLocks retrieves all active locks, clearing out expired ones.
+	ListLocks(ctx context.Context) ([]*EntityLock, error)
+
+	// CheckUnlocked returns an error if another actor holds an active lock on the entity.
+	CheckUnlocked(ctx context.Context, entityID string) error
+}
+
+// AcquireLockRequest contains parameters for acquiring a lock.
+type AcquireLockRequest struct {
+	EntityID string
+	TTL      time.Duration // Zero uses the default lock duration
+	Reason   string
+}
+
+// EntityLock represents an active advisory lock.
+type EntityLock struct {
+	EntityID   string
+	HeldBy     string
+	Reason     string
+	AcquiredAt string // RFC3339
+	ExpiresAt  string // RFC3339
+}
@@ -12,6 +12,39 @@ type LogService interface {
 
 	// PruneLogs deletes log entries older than the specified number of days.
 	PruneLogs(ctx context.Context, olderThanDays int) (int, error)
+
+	// GetActivity describes logged actions as a chronological activity feed.
+	GetActivity(ctx context.Context, filters ActivityFilters) ([]*ActivityItem, error)
+
+	// RecordPaneRespawn logs that a workbench's tmux pane died and was respawned.
+	RecordPaneRespawn(ctx context.Context, req PaneRespawn) error
+}
+
+// PaneRespawn describes a dead workbench pane that was respawned.
+type PaneRespawn struct {
+	WorkshopID  string
+	WorkbenchID string
+	Role        string // vim, goblin, or shell
+	Reason      string // How the pane's process ended, e.g. "exited 1"
+}
+
+// ActivityFilters contains filter options for the activity feed.
+type ActivityFilters struct {
+	ActorID string // Actor or workbench ID (BENCH-003 means IMP-BENCH-003); empty for everyone
+	Since   string // RFC3339; only actions at or after this time
+	Limit   int    // Most recent actions to include
+}
+
+// ActivityItem is one action in the activity feed.
+type ActivityItem struct {
+	LogID      string
+	Timestamp  string
+	ActorID    string
+	EntityType string
+	EntityID   string
+	Kind       string // claim, complete, note, create, change, delete
+	Summary    string // e.g. "claimed TASK-001"
+	Forced     bool
 }
 
 // LogEntry represents a workshop activity log entry at the port boundary.
@@ -26,6 +59,7 @@ type LogEntry struct {
 	FieldName  string // For updates only
 	OldValue   string
 	NewValue   string
+	Forced     bool // A guard was overridden with --force
 	CreatedAt  string
 }
 
@@ -36,5 +70,6 @@ type LogFilters struct {
 	EntityID   string
 	ActorID    string
 	Action     string
+	Since      string // RFC3339; only entries at or after this time
 	Limit      int
 }
@@ -0,0 +1,13 @@
+package primary
+
+import "context"
+
+// MetricsService defines the primary port for exporting ledger metrics.
+type MetricsService interface {
+	// ExportPrometheus renders current ledger gauges and counters in the
+	// Prometheus text exposition format.
+	ExportPrometheus(ctx context.Context) (string, error)
+}
+
+// PrometheusContentType is the Content-Type for ExportPrometheus output.
+const PrometheusContentType = "text/plain; version=0.0.4; charset=utf-8"
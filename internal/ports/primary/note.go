@@ -28,7 +28,7 @@ type NoteService interface {
 	// GetNotesByContainer retrieves notes for a specific container.
 	GetNotesByContainer(ctx context.Context, containerType, containerID string) ([]*Note, error)
 
-	// CloseNote closes a note with a reason.
+	// CloseNote closes a note with a resolution.
 	CloseNote(ctx context.Context, req CloseNoteRequest) error
 
 	// ReopenNote reopens a closed note.
@@ -43,6 +43,53 @@ type NoteService interface {
 	// SetNoteInFlight sets a note status to in_flight.
 	// Used when a shipment is created from a spec note.
 	SetNoteInFlight(ctx context.Context, noteID string) error
+
+	// TriageNote sets a bug note's severity and triage state.
+	TriageNote(ctx context.Context, req TriageNoteRequest) error
+
+	// ListTriageQueue lists a commission's open bug notes awaiting triage,
+	// most severe and then oldest first.
+	ListTriageQueue(ctx context.Context, filters TriageQueueFilters) ([]*Note, error)
+
+	// DraftHandoff summarizes an ended session's transcript into a draft
+	// handoff note. Returns nil when the session did nothing worth handing off.
+	DraftHandoff(ctx context.Context, req DraftHandoffRequest) (*Note, error)
+
+	// ConfirmHandoff marks a draft handoff note as reviewed by the IMP.
+	ConfirmHandoff(ctx context.Context, noteID string) error
+
+	// ExternalizeNoteContent moves note bodies of at least minBytes out of
+	// the ledger into content-addressed files. With dryRun it only lists them.
+	ExternalizeNoteContent(ctx context.Context, minBytes int, dryRun bool) ([]*ExternalizedNote, error)
+
+	// ProposeAnswers reads the question answers a report note proposes
+	// ("NOTE-042: answer" lines), noting any that cannot be applied.
+	ProposeAnswers(ctx context.Context, reportID string) ([]*ProposedAnswer, error)
+
+	// AnswerQuestion records an answer on a question note and closes it,
+	// referencing the report the answer came from.
+	AnswerQuestion(ctx context.Context, req AnswerQuestionRequest) error
+}
+
+// ProposedAnswer is an answer a report proposes for a question note.
+type ProposedAnswer struct {
+	QuestionID    string
+	QuestionTitle string // Empty when the question was not found
+	Answer        string
+	Problem       string // Why the answer cannot be applied; empty when it can
+}
+
+// AnswerQuestionRequest contains parameters for answering a question note.
+type AnswerQuestionRequest struct {
+	QuestionID string
+	ReportID   string // Note the answer came from
+	Answer     string
+}
+
+// ExternalizedNote is a note whose body was (or would be) moved out of the ledger.
+type ExternalizedNote struct {
+	ID    string
+	Bytes int
 }
 
 // CreateNoteRequest contains parameters for creating a note.
@@ -50,9 +97,10 @@ type CreateNoteRequest struct {
 	CommissionID  string
 	Title         string
 	Content       string
-	Type          string // learning, concern, finding, frq, bug, spec, roadmap, decision, question, vision, idea, exorcism
+	Type          string // learning, concern, finding, frq, bug, spec, roadmap, decision, question, vision, idea, exorcism, handoff
 	ContainerID   string // The container ID (shipment or tome), or empty for commission-level notes
 	ContainerType string // "shipment", "tome", or "" (empty = commission-level note)
+	Severity      string // P0-P3; bug notes only
 }
 
 // CreateNoteResponse contains the result of creating a note.
@@ -84,11 +132,37 @@ type MergeNoteRequest struct {
 	TargetNoteID string
 }
 
-// CloseNoteRequest contains parameters for closing a note with a reason.
+// CloseNoteRequest contains parameters for closing a note.
+// Resolution is required, but may be left empty when Reason implies one.
 type CloseNoteRequest struct {
+	NoteID     string
+	Resolution string // fixed, duplicate, wontfix, promoted, superseded
+	Reason     string // Optional: superseded, synthesized, resolved, deferred, duplicate, stale
+	ByNoteID   string // Note that replaces this one; required for duplicate and superseded
+}
+
+// TriageNoteRequest contains parameters for triaging a bug note.
+type TriageNoteRequest struct {
 	NoteID   string
-	Reason   string // superseded, synthesized, resolved, deferred, duplicate, stale
-	ByNoteID string // Optional reference to another note
+	Severity string // P0-P3; empty keeps the current severity
+	Status   string // untriaged, accepted, needs_info, wont_fix
+}
+
+// DraftHandoffRequest contains parameters for drafting a handoff note.
+// The note attaches to the shipment or tome, if any, else the commission.
+type DraftHandoffRequest struct {
+	CommissionID string
+	ShipmentID   string
+	TomeID       string
+	TaskIDs      []string // Tasks the workbench had in progress
+	SessionID    string
+	Transcript   []byte // The session transcript (JSONL)
+}
+
+// TriageQueueFilters contains filter options for the triage queue.
+type TriageQueueFilters struct {
+	CommissionID string // Empty lists every commission's queue
+	UrgentOnly   bool   // Only P0 and P1 bugs
 }
 
 // Note represents a note entity at the port boundary.
@@ -109,12 +183,19 @@ type Note struct {
 	PromotedFromType string
 	CloseReason      string
 	ClosedByNoteID   string
+	Position         int    // Reading order within the tome; 0 means unordered
+	Severity         string // P0-P3 for bug notes; empty if unset
+	TriageStatus     string // Bug notes only: untriaged, accepted, needs_info, wont_fix
+	Resolution       string // Closed notes: fixed, duplicate, wontfix, promoted, superseded
+	Draft            bool   // Handoff notes only: not yet confirmed by the IMP
+	ContentExternal  bool   // Listed without its externalized body; GetNote loads it
 }
 
 // NoteFilters contains filter options for listing notes.
 type NoteFilters struct {
 	Type         string
 	CommissionID string
+	Resolution   string
 }
 
 // Note type constants
@@ -131,6 +212,7 @@ const (
 	NoteTypeVision   = "vision"
 	NoteTypeIdea     = "idea"
 	NoteTypeExorcism = "exorcism"
+	NoteTypeHandoff  = "handoff"
 )
 
 // Note status constants
@@ -139,3 +221,28 @@ const (
 	NoteStatusInFlight = "in_flight"
 	NoteStatusClosed   = "closed"
 )
+
+// Note resolution constants, recorded when a note is closed
+const (
+	NoteResolutionFixed      = "fixed"
+	NoteResolutionDuplicate  = "duplicate"
+	NoteResolutionWontFix    = "wontfix"
+	NoteResolutionPromoted   = "promoted"
+	NoteResolutionSuperseded = "superseded"
+)
+
+// Bug severity constants, most urgent first
+const (
+	NoteSeverityP0 = "P0"
+	NoteSeverityP1 = "P1"
+	NoteSeverityP2 = "P2"
+	NoteSeverityP3 = "P3"
+)
+
+// Bug triage state constants
+const (
+	NoteTriageUntriaged = "untriaged"
+	NoteTriageAccepted  = "accepted"
+	NoteTriageNeedsInfo = "needs_info"
+	NoteTriageWontFix   = "wont_fix"
+)
@@ -16,6 +16,18 @@ type PlanService interface {
 	// ApprovePlan approves a plan (draft -> approved).
 	ApprovePlan(ctx context.Context, planID string) error
 
+	// ApprovePlanWithConditions approves a draft plan subject to required
+	// amendments. The plan is conditionally approved until every condition is
+	// addressed, and its task can't be started meanwhile.
+	ApprovePlanWithConditions(ctx context.Context, planID string, conditions []string) error
+
+	// ListPlanConditions retrieves a plan's approval conditions in order.
+	ListPlanConditions(ctx context.Context, planID string) ([]*PlanCondition, error)
+
+	// AddressPlanCondition marks a condition (1-based) addressed with evidence.
+	// Addressing the last open condition approves the plan; approved reports that.
+	AddressPlanCondition(ctx context.Context, planID string, position int, evidence string) (approved bool, err error)
+
 	// UpdatePlan updates a plan's title, description, and/or content.
 	UpdatePlan(ctx context.Context, req UpdatePlanRequest) error
 
@@ -30,6 +42,45 @@ type PlanService interface {
 
 	// GetTaskActivePlan retrieves the active (draft) plan for a task.
 	GetTaskActivePlan(ctx context.Context, taskID string) (*Plan, error)
+
+	// TrackPlan records an approved plan's steps (parsed from its content on
+	// first use) and optionally creates a task for each step without one.
+	TrackPlan(ctx context.Context, planID string, generateTasks bool) (*PlanProgress, error)
+
+	// LinkPlanStep links a tracked plan step (1-based) to an existing task.
+	LinkPlanStep(ctx context.Context, planID string, position int, taskID string) error
+
+	// GetPlanProgress reports which of a tracked plan's steps have closed tasks.
+	GetPlanProgress(ctx context.Context, planID string) (*PlanProgress, error)
+}
+
+// PlanStep is a tracked plan step at the port boundary.
+type PlanStep struct {
+	Position   int
+	Title      string
+	TaskID     string // Empty when the step has no task yet
+	TaskStatus string
+	Complete   bool // Linked task is closed
+}
+
+// PlanCondition is an amendment required by a conditional approval.
+type PlanCondition struct {
+	Position    int
+	Description string
+	Addressed   bool
+	Evidence    string
+	ApprovedBy  string
+	CreatedAt   string
+	AddressedBy string
+	AddressedAt string
+}
+
+// PlanProgress summarizes a tracked plan's steps.
+type PlanProgress struct {
+	PlanID         string
+	Steps          []*PlanStep
+	Completed      int
+	CreatedTaskIDs []string // Tasks generated by this call (TrackPlan only)
 }
 
 // CreatePlanRequest contains parameters for creating a plan.
@@ -62,7 +113,7 @@ type Plan struct {
 	CommissionID     string
 	Title            string
 	Description      string
-	Status           string
+	Status           string // draft, conditionally_approved, or approved
 	Content          string
 	Pinned           bool
 	CreatedAt        string
@@ -0,0 +1,25 @@
+package primary
+
+import "context"
+
+// PRDescriptionService defines the primary port for generating pull request
+// descriptions from the ledger.
+type PRDescriptionService interface {
+	// DescribePR renders the PR's description from its shipment's charter,
+	// approved plans, completed tasks, and notes, merged into the current
+	// description without writing it anywhere.
+	DescribePR(ctx context.Context, prID string) (*PRDescription, error)
+
+	// ApplyDescription renders the description as DescribePR does and writes
+	// it to the ledger, and to GitHub when the PR has a GitHub URL.
+	ApplyDescription(ctx context.Context, prID string) (*PRDescription, error)
+}
+
+// PRDescription is a generated PR description.
+type PRDescription struct {
+	PRID      string
+	Body      string // The full description: the generated block and manual edits
+	Generated string // The generated block alone
+	Source    string // Where the current description was read: 'github', 'ledger'
+	Changed   bool   // Body differs from the current description
+}
@@ -0,0 +1,38 @@
+package primary
+
+import "context"
+
+// PRReviewService defines the primary port for pull request review feedback.
+type PRReviewService interface {
+	// SyncReviews fetches the PR's reviews and inline comments from GitHub,
+	// stores them, and creates an open task in the PR's shipment for each
+	// requested change not already covered by one.
+	SyncReviews(ctx context.Context, prID string) (*SyncReviewsResult, error)
+
+	// ListReviews retrieves the stored reviews and comments of a PR, oldest first.
+	ListReviews(ctx context.Context, prID string) ([]*PRReview, error)
+}
+
+// SyncReviewsResult contains the outcome of fetching a PR's reviews.
+type SyncReviewsResult struct {
+	PRID         string
+	Fetched      int      // Reviews and comments reported by GitHub
+	New          int      // Items not seen before
+	CreatedTasks []string // Tasks created for requested changes
+}
+
+// PRReview is a review or inline review comment at the port boundary.
+type PRReview struct {
+	ExternalID       string // "review:<id>" or "comment:<id>"
+	Kind             string // review or comment
+	ReviewExternalID string // Comments: the review they were submitted with
+	InReplyTo        bool
+	Author           string
+	State            string // Reviews: APPROVED, CHANGES_REQUESTED, COMMENTED, DISMISSED
+	Body             string
+	Path             string
+	Line             int
+	URL              string
+	SubmittedAt      string
+	TaskID           string // Task created for this requested change
+}
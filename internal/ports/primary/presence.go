@@ -0,0 +1,22 @@
+package primary
+
+import "context"
+
+// PresenceService defines the primary port for live collaboration markers.
+type PresenceService interface {
+	// RecordPresence notes that the current actor viewed or edited an entity.
+	RecordPresence(ctx context.Context, entityID, action string) error
+
+	// ListActive retrieves other actors seen recently, newest first; on one
+	// entity, or on every entity when entityID is empty.
+	ListActive(ctx context.Context, entityID string) ([]*EntityPresence, error)
+}
+
+// EntityPresence is another actor recently seen on an entity.
+type EntityPresence struct {
+	EntityID string
+	ActorID  string
+	Actor    string // As shown: the IMP's workbench (BENCH-003), or ORC
+	Action   string // "view" or "edit"
+	SeenAt   string // RFC3339
+}
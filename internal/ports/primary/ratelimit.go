@@ -0,0 +1,42 @@
+package primary
+
+import "context"
+
+// RateLimitService defines the primary port for catching runaway actors.
+type RateLimitService interface {
+	// CheckWrite returns an error if the current actor is throttled, first
+	// throttling it if its activity over the last minute is over a limit.
+	CheckWrite(ctx context.Context) error
+
+	// ListLimits retrieves the effective limits for every actor type and kind.
+	ListLimits(ctx context.Context) ([]*RateLimit, error)
+
+	// SetLimit sets an actor type's per-minute limit on an activity kind; 0 turns it off.
+	// IMPs can't change limits.
+	SetLimit(ctx context.Context, actorType, kind string, perMinute int) error
+
+	// ResetLimit restores an actor type's default limit on an activity kind.
+	ResetLimit(ctx context.Context, actorType, kind string) error
+
+	// ListThrottles retrieves the active throttles.
+	ListThrottles(ctx context.Context) ([]*ActorThrottle, error)
+
+	// LiftThrottle ends an actor's throttle early. Only ORC may lift throttles.
+	LiftThrottle(ctx context.Context, actorID string) error
+}
+
+// RateLimit is an actor type's effective limit on one activity kind.
+type RateLimit struct {
+	ActorType string
+	Kind      string
+	PerMinute int  // 0 means no limit
+	Default   bool // Not configured; the built-in default applies
+}
+
+// ActorThrottle represents an actor whose writes are being refused.
+type ActorThrottle struct {
+	ActorID     string
+	Reason      string
+	ThrottledAt string // RFC3339
+	ExpiresAt   string // RFC3339
+}
@@ -0,0 +1,49 @@
+package primary
+
+import "context"
+
+// RecallService defines the primary port for semantic recall over the
+// ledger's notes (questions included) and plans. Embeddings are computed
+// locally and stored in the ledger; the index is brought up to date on each
+// recall, so only entities created or edited since the last one are embedded.
+type RecallService interface {
+	// Recall returns the entities that best match a free-text query, best first.
+	Recall(ctx context.Context, req RecallRequest) ([]*RecallHit, error)
+
+	// Reindex brings the index up to date, recomputing every embedding when
+	// rebuild is set.
+	Reindex(ctx context.Context, rebuild bool) (*RecallIndexStats, error)
+}
+
+// RecallRequest contains parameters for a recall query.
+type RecallRequest struct {
+	Query        string
+	CommissionID string // Optional; limits results to one commission
+	Kind         string // Optional; note, question, or plan
+	Limit        int    // 0 means the default
+}
+
+// RecallHit is an entity matching a recall query.
+type RecallHit struct {
+	EntityID     string
+	Kind         string // note, question, plan
+	CommissionID string
+	Title        string
+	Status       string
+	Snippet      string // Best matching passage of the content; empty when there is none
+	Score        float64
+}
+
+// RecallIndexStats summarizes an index refresh.
+type RecallIndexStats struct {
+	Entities int // Entities in the index
+	Embedded int // Embeddings computed by this refresh
+	Removed  int // Embeddings dropped for deleted entities
+}
+
+// Recall kinds. Questions are notes of type question, searchable on their own.
+const (
+	RecallKindNote     = "note"
+	RecallKindQuestion = "question"
+	RecallKindPlan     = "plan"
+)
@@ -27,6 +27,14 @@ type RepoService interface {
 
 	// DeleteRepo hard-deletes a repository.
 	DeleteRepo(ctx context.Context, repoID string) error
+
+	// SetBootstrapScript sets or clears the script run in new workbenches for this repo.
+	// Pass empty string to clear.
+	SetBootstrapScript(ctx context.Context, repoID, script string) error
+
+	// CheckRepo validates a repository's local clone, remote, default branch,
+	// host auth, and workbench worktrees.
+	CheckRepo(ctx context.Context, repoID string) (*RepoHealth, error)
 }
 
 // CreateRepoRequest contains parameters for creating a repository.
@@ -53,14 +61,15 @@ type UpdateRepoRequest struct {
 
 // Repo represents a repository entity at the port boundary.
 type Repo struct {
-	ID            string
-	Name          string
-	URL           string
-	LocalPath     string
-	DefaultBranch string
-	Status        string
-	CreatedAt     string
-	UpdatedAt     string
+	ID              string
+	Name            string
+	URL             string
+	LocalPath       string
+	DefaultBranch   string
+	BootstrapScript string // Shell script run in new workbenches (direnv, toolchains, hooks)
+	Status          string
+	CreatedAt       string
+	UpdatedAt       string
 }
 
 // RepoFilters contains filter options for listing repositories.
@@ -73,3 +82,26 @@ const (
 	RepoStatusActive   = "active"
 	RepoStatusArchived = "archived"
 )
+
+// RepoHealth is the result of checking a registered repository.
+type RepoHealth struct {
+	RepoID string
+	Name   string
+	Status string // Worst status among the checks
+	Checks []RepoHealthCheck
+}
+
+// RepoHealthCheck is one check of a repository's health.
+type RepoHealthCheck struct {
+	Name   string
+	Status string
+	Detail string // What was found; empty for passing checks
+	Fix    string // Suggested command(s), one per line; empty for passing checks
+}
+
+// Repository health check statuses
+const (
+	RepoHealthPass = "pass"
+	RepoHealthWarn = "warn"
+	RepoHealthFail = "fail"
+)
@@ -0,0 +1,45 @@
+package primary
+
+import "context"
+
+// SecretService defines the primary port for integration secrets.
+// Secrets are scoped globally, to a factory, or to a repo. Values are only
+// returned by GetSecret and ResolveSecret; listings never include them.
+type SecretService interface {
+	// SetSecret stores a secret, replacing any existing value in the same scope.
+	SetSecret(ctx context.Context, req SetSecretRequest) error
+
+	// GetSecret returns the value stored under name in exactly the given scope.
+	GetSecret(ctx context.Context, name string, scope SecretScope) (string, error)
+
+	// ResolveSecret returns the most specific value for name: repo, then
+	// factory, then global. Empty IDs skip that scope.
+	ResolveSecret(ctx context.Context, name, repoID, factoryID string) (string, error)
+
+	// ListSecrets lists secret metadata, optionally filtered by scope (empty Type lists all).
+	ListSecrets(ctx context.Context, scope SecretScope) ([]*Secret, error)
+
+	// DeleteSecret removes a secret from the given scope.
+	DeleteSecret(ctx context.Context, name string, scope SecretScope) error
+}
+
+// SecretScope identifies where a secret applies.
+type SecretScope struct {
+	Type string // "global", "factory", "repo"
+	ID   string // Factory or repo ID; empty for global
+}
+
+// SetSecretRequest contains parameters for storing a secret.
+type SetSecretRequest struct {
+	Name  string
+	Scope SecretScope
+	Value string
+}
+
+// Secret is secret metadata at the port boundary. It never carries the value.
+type Secret struct {
+	Name      string
+	Scope     SecretScope
+	CreatedAt string
+	UpdatedAt string
+}
@@ -42,8 +42,52 @@ type ShipmentService interface {
 	UpdateStatus(ctx context.Context, shipmentID, status string) error
 
 	// SetStatus sets a shipment's status with escape hatch protection.
-	// If force is true, allows backwards transitions.
+	// If force is true, allows transitions outside the state machine.
 	SetStatus(ctx context.Context, shipmentID, status string, force bool) error
+
+	// StatusTransitions returns the legal shipment status transitions.
+	StatusTransitions() []StatusTransition
+
+	// GetShipmentDAG returns a commission's shipments as a dependency graph
+	// derived from cross-shipment task dependencies.
+	GetShipmentDAG(ctx context.Context, commissionID string) (*ShipmentDAG, error)
+
+	// SetShipmentCharter replaces a shipment's charter document.
+	SetShipmentCharter(ctx context.Context, shipmentID, charter string) error
+}
+
+// ShipmentDAG is the shipment dependency graph of a commission.
+type ShipmentDAG struct {
+	CommissionID string
+	Nodes        []*ShipmentDAGNode // ordered by layer, then ID
+	Edges        []ShipmentDAGEdge
+	CriticalPath []string // longest chain of remaining work, first blocker first
+	Cyclic       []string // shipments whose dependencies form a cycle
+}
+
+// ShipmentDAGNode is a shipment in the dependency graph.
+type ShipmentDAGNode struct {
+	ID         string
+	Title      string
+	Status     string
+	OpenTasks  int
+	TotalTasks int
+	Layer      int // 0 = nothing blocks it
+	Critical   bool
+}
+
+// ShipmentDAGEdge says shipment From must land before shipment To.
+type ShipmentDAGEdge struct {
+	From     string
+	To       string
+	Critical bool
+}
+
+// StatusTransition is a legal status change in an entity's state machine.
+type StatusTransition struct {
+	From   string
+	To     string
+	Reason string
 }
 
 // CreateShipmentRequest contains parameters for creating a shipment.
@@ -83,9 +127,13 @@ type Shipment struct {
 	Branch              string // Owned branch (e.g., ml/SHIP-001-feature-name)
 	Pinned              bool
 	SpecNoteID          string // Spec note that generated this shipment (NOTE-xxx)
+	Charter             string // Long-form markdown explaining why the shipment exists
 	CreatedAt           string
 	UpdatedAt           string
 	CompletedAt         string
+	TaskCount           int    // Populated when listing shipments
+	TasksClosed         int    // Populated when listing shipments
+	WorkbenchName       string // Populated when listing shipments
 }
 
 // ShipmentFilters contains filter options for listing shipments.
@@ -0,0 +1,8 @@
+package primary
+
+// Intent is an action outside the ledger that a command run with --plan
+// would have taken: collected instead of executed.
+type Intent struct {
+	Kind   string // git, tmux, file, or github
+	Action string // e.g. "create worktree ~/wb/orc-014 on branch ml/SHIP-042"
+}
@@ -16,6 +16,14 @@ type SummaryRequest struct {
 	WorkshopID   string // The workshop making the request (for context)
 	FocusID      string // Currently focused container (SHIP-xxx or TOME-xxx)
 	DebugMode    bool   // Show debug info about what was filtered
+	Filter       SummaryFilter
+}
+
+// SummaryFilter narrows which containers a summary shows. Empty lists don't filter.
+type SummaryFilter struct {
+	Containers []string // Container kinds: SHIP, TOME
+	Statuses   []string // Container statuses, e.g. ready, in-progress
+	Tags       []string // Shipments holding a task with one of these tags (hides tomes)
 }
 
 // CommissionSummary represents the flat summary of a commission.
@@ -37,13 +45,17 @@ type DebugInfo struct {
 
 // TomeSummary represents a tome with its note count.
 type TomeSummary struct {
-	ID        string
-	Title     string
-	Status    string
-	NoteCount int
-	IsFocused bool
-	Pinned    bool
-	Notes     []NoteSummary // Populated when tome is focused
+	ID           string
+	Alias        string // Human-friendly slug, empty if none
+	Title        string
+	Status       string
+	NoteCount    int
+	OpenConcerns int // Open concern notes, surfaced even when notes are collapsed
+	OpenFindings int // Open finding notes
+	CommentCount int
+	IsFocused    bool
+	Pinned       bool
+	Notes        []NoteSummary // Populated when tome is focused
 }
 
 // NoteSummary represents a note in the summary view.
@@ -57,26 +69,35 @@ type NoteSummary struct {
 
 // ShipmentSummary represents a shipment with task progress.
 type ShipmentSummary struct {
-	ID         string
-	Title      string
-	Status     string
-	IsFocused  bool
-	Pinned     bool
-	BenchID    string // Assigned workbench ID (empty if unassigned)
-	BenchName  string // Assigned workbench name (for display)
-	TasksDone  int
-	TasksTotal int
-	NoteCount  int
-	Tasks      []TaskSummary // Populated only for focused shipment
-	Notes      []NoteSummary // Populated only for focused shipment
+	ID           string
+	Alias        string // Human-friendly slug, empty if none
+	Title        string
+	Status       string
+	IsFocused    bool
+	Pinned       bool
+	BenchID      string // Assigned workbench ID (empty if unassigned)
+	BenchName    string // Assigned workbench name (for display)
+	TasksDone    int
+	TasksTotal   int
+	NoteCount    int
+	OpenConcerns int // Open concern notes, surfaced even when notes are collapsed
+	OpenFindings int // Open finding notes
+	CommentCount int
+	Tasks        []TaskSummary // Populated only for focused shipment
+	Notes        []NoteSummary // Populated only for focused shipment
+	LinkedRefs   []*LedgerRef  // Links to other ledgers; populated only for focused shipment
 }
 
 // TaskSummary represents a task in the summary view.
 type TaskSummary struct {
-	ID     string
-	Title  string
-	Status string
-	Plans  []PlanSummary
+	ID             string
+	Alias          string // Human-friendly slug, empty if none
+	Title          string
+	Status         string
+	Plans          []PlanSummary
+	ChecklistDone  int
+	ChecklistTotal int // 0 when the task has no checklist
+	CommentCount   int
 }
 
 // PlanSummary represents a plan in the summary view.
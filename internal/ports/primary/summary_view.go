@@ -0,0 +1,41 @@
+package primary
+
+import "context"
+
+// SummaryViewService defines the primary port for saved summary views.
+// Views are stored per actor; a workbench can have a default view.
+type SummaryViewService interface {
+	// SaveView creates or replaces one of the current actor's views.
+	SaveView(ctx context.Context, req SaveSummaryViewRequest) (*SummaryView, error)
+
+	// GetView retrieves one of the current actor's views by name.
+	GetView(ctx context.Context, name string) (*SummaryView, error)
+
+	// ListViews lists the current actor's views.
+	ListViews(ctx context.Context) ([]*SummaryView, error)
+
+	// DeleteView deletes one of the current actor's views.
+	DeleteView(ctx context.Context, name string) error
+
+	// SetDefaultView makes one of the current actor's views the workbench's
+	// default. An empty name clears the default.
+	SetDefaultView(ctx context.Context, workbenchID, name string) error
+
+	// GetDefaultView retrieves the workbench's default view, or nil if none is set.
+	GetDefaultView(ctx context.Context, workbenchID string) (*SummaryView, error)
+}
+
+// SaveSummaryViewRequest contains the parameters for saving a summary view.
+type SaveSummaryViewRequest struct {
+	Name   string
+	Filter SummaryFilter
+}
+
+// SummaryView is a saved summary filter.
+type SummaryView struct {
+	Name        string
+	ActorID     string
+	Filter      SummaryFilter
+	Description string // e.g. "SHIP · ready · #infra"
+	UpdatedAt   string
+}
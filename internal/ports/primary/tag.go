@@ -21,6 +21,77 @@ type TagService interface {
 
 	// GetEntityTag retrieves the tag for an entity.
 	GetEntityTag(ctx context.Context, entityID, entityType string) (*Tag, error)
+
+	// RouteTag routes a tag's new tasks to a workbench, replacing any existing route.
+	RouteTag(ctx context.Context, req RouteTagRequest) error
+
+	// UnrouteTag removes a tag's route.
+	UnrouteTag(ctx context.Context, tagName string) error
+
+	// ListTagRoutes lists tag routes, optionally filtered by workbench.
+	ListTagRoutes(ctx context.Context, workbenchID string) ([]*TagRoute, error)
+
+	// RenameTag renames a tag, keeping its entities and route.
+	RenameTag(ctx context.Context, name, newName string) (*Tag, error)
+
+	// MergeTags folds each source tag into the target and deletes the sources.
+	MergeTags(ctx context.Context, req MergeTagsRequest) (*MergeTagsResponse, error)
+
+	// TagUsage counts each tag's uses per entity type and groups near-duplicates.
+	TagUsage(ctx context.Context) (*TagUsageReport, error)
+}
+
+// MergeTagsRequest contains parameters for merging tags.
+type MergeTagsRequest struct {
+	Sources []string // Tag names folded into Into
+	Into    string
+}
+
+// MergeTagsResponse reports what each merged source moved.
+type MergeTagsResponse struct {
+	Into    string
+	Sources []*TagMergeResult
+}
+
+// TagMergeResult reports one source tag's merge.
+type TagMergeResult struct {
+	Name       string
+	Moved      int  // Entities re-tagged with the target
+	Duplicates int  // Entities that already carried the target
+	RouteMoved bool // The source's workbench route now applies to the target
+}
+
+// TagUsageReport is the tag usage overview behind orc tag usage.
+type TagUsageReport struct {
+	Tags    []*TagUsage
+	Similar [][]string // Groups of near-duplicate tag names, e.g. [test testing tests]
+}
+
+// TagUsage counts one tag's uses.
+type TagUsage struct {
+	Name   string
+	Counts map[string]int // entity type -> count
+	Total  int
+}
+
+// Tag route modes
+const (
+	TagRouteModeSuggest = "suggest" // Suggest the workbench when a tagged task is created
+	TagRouteModeAssign  = "assign"  // Assign tagged tasks to the workbench on creation
+)
+
+// RouteTagRequest contains parameters for routing a tag to a workbench.
+type RouteTagRequest struct {
+	TagName     string
+	WorkbenchID string
+	Mode        string // suggest (default) or assign
+}
+
+// TagRoute names the workbench that specializes in a tag's tasks.
+type TagRoute struct {
+	TagName     string
+	WorkbenchID string
+	Mode        string
 }
 
 // CreateTagRequest contains parameters for creating a tag.
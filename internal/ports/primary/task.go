@@ -1,12 +1,19 @@
 package primary
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // TaskService defines the primary port for task operations.
 type TaskService interface {
 	// CreateTask creates a new task.
 	CreateTask(ctx context.Context, req CreateTaskRequest) (*CreateTaskResponse, error)
 
+	// ParseQuickAdd reads a one-line task ("Fix flaky test !high #testing
+	// @SHIP-010 due:fri") into a create request without creating it.
+	ParseQuickAdd(ctx context.Context, line string) (*CreateTaskRequest, error)
+
 	// GetTask retrieves a task by ID.
 	GetTask(ctx context.Context, taskID string) (*Task, error)
 
@@ -25,6 +32,12 @@ type TaskService interface {
 	// ResumeTask resumes a paused task.
 	ResumeTask(ctx context.Context, taskID string) error
 
+	// BlockTask marks an in-progress task as blocked on something outside it.
+	BlockTask(ctx context.Context, taskID string) error
+
+	// UnblockTask returns a blocked task to in-progress.
+	UnblockTask(ctx context.Context, taskID string) error
+
 	// UpdateTask updates a task's title and/or description.
 	UpdateTask(ctx context.Context, req UpdateTaskRequest) error
 
@@ -53,8 +66,59 @@ type TaskService interface {
 	// DiscoverTasks finds ready tasks in the current workbench context.
 	DiscoverTasks(ctx context.Context, workbenchID string) ([]*Task, error)
 
+	// ClaimNextTask claims the workbench's next open task in a commission,
+	// preferring tasks assigned to it, then tasks whose tag routes to it.
+	// Returns nil when no task is claimable.
+	ClaimNextTask(ctx context.Context, req ClaimNextTaskRequest) (*Task, error)
+
 	// MoveTask moves a task to a different container.
 	MoveTask(ctx context.Context, req MoveTaskRequest) error
+
+	// AddChecklistItem appends a checklist item to a task.
+	AddChecklistItem(ctx context.Context, taskID, text string) (*ChecklistItem, error)
+
+	// SetChecklistItemDone checks or unchecks the item at a 1-based position.
+	SetChecklistItemDone(ctx context.Context, taskID string, position int, done bool) error
+
+	// RemoveChecklistItem removes the item at a 1-based position.
+	RemoveChecklistItem(ctx context.Context, taskID string, position int) error
+
+	// StatusTransitions returns the legal task status transitions.
+	StatusTransitions() []StatusTransition
+
+	// RefreshClaims records a heartbeat on a workbench's claimed tasks,
+	// returning how many claims were refreshed.
+	RefreshClaims(ctx context.Context, workbenchID string) (int, error)
+
+	// ListClaims retrieves in-progress tasks with their claim expiry.
+	ListClaims(ctx context.Context, req ListClaimsRequest) ([]*TaskClaim, error)
+
+	// ReapStaleClaims releases expired claims, returning their tasks to open.
+	ReapStaleClaims(ctx context.Context, req ReapClaimsRequest) ([]*TaskClaim, error)
+}
+
+// ListClaimsRequest contains parameters for listing task claims.
+type ListClaimsRequest struct {
+	TTL       time.Duration // Zero uses the default claim TTL
+	StaleOnly bool
+}
+
+// ReapClaimsRequest contains parameters for releasing expired claims.
+type ReapClaimsRequest struct {
+	TTL    time.Duration // Zero uses the default claim TTL
+	DryRun bool          // Report what would be released without changing anything
+}
+
+// TaskClaim is a workbench's hold on an in-progress task.
+type TaskClaim struct {
+	TaskID      string
+	Title       string
+	ShipmentID  string
+	WorkbenchID string
+	ClaimedAt   string // RFC3339
+	LastSeenAt  string // RFC3339; the claim or its latest heartbeat
+	ExpiresAt   string // RFC3339
+	Stale       bool
 }
 
 // CreateTaskRequest contains parameters for creating a task.
@@ -65,18 +129,30 @@ type CreateTaskRequest struct {
 	Description  string
 	Type         string   // Optional: research, implementation, fix, documentation, maintenance
 	DependsOn    []string // Optional: task IDs this task depends on
+	Tag          string   // Optional: tag name; a routed tag suggests or assigns a workbench
+	Points       int      // Optional: estimate in task points
+	Priority     string   // Optional: low, medium, high
+	DueDate      string   // Optional: YYYY-MM-DD, or relative (fri, tomorrow, +3d)
 }
 
 // CreateTaskResponse contains the result of creating a task.
 type CreateTaskResponse struct {
 	TaskID string
 	Task   *Task
+	Route  *TagRoute // Route of the task's tag, nil if unrouted
 }
 
 // ClaimTaskRequest contains parameters for claiming a task.
 type ClaimTaskRequest struct {
 	TaskID      string
 	WorkbenchID string // Optional, can be derived from context
+	Force       bool   // Allow claiming outside the state machine (e.g. a closed task)
+}
+
+// ClaimNextTaskRequest contains parameters for claiming the next task.
+type ClaimNextTaskRequest struct {
+	WorkbenchID  string
+	CommissionID string
 }
 
 // UpdateTaskRequest contains parameters for updating a task.
@@ -84,6 +160,7 @@ type UpdateTaskRequest struct {
 	TaskID      string
 	Title       string
 	Description string
+	Points      int // Estimate in task points; 0 leaves it unchanged
 }
 
 // MoveTaskRequest contains parameters for moving a task to a different container.
@@ -108,11 +185,26 @@ type Task struct {
 	AssignedWorkbenchID string
 	Pinned              bool
 	DependsOn           []string // Task IDs this task depends on
+	Points              int      // Estimate in task points, 0 if unestimated
+	DueDate             string   // YYYY-MM-DD, empty if none
 	CreatedAt           string
 	UpdatedAt           string
 	ClaimedAt           string
 	CompletedAt         string
-	Tag                 *TaskTag // Populated when retrieving task details
+	Tag                 *TaskTag         // Populated when retrieving task details
+	Checklist           []*ChecklistItem // Populated when retrieving task details
+	TagName             string           // Populated when listing tasks
+	ChecklistDone       int              // Populated when listing tasks
+	ChecklistTotal      int              // Populated when listing tasks
+	CommentCount        int              // Populated when listing tasks
+}
+
+// ChecklistItem represents a task checklist item at the port boundary.
+type ChecklistItem struct {
+	Position    int // 1-based, in creation order
+	Text        string
+	Done        bool
+	CompletedAt string
 }
 
 // TaskTag represents a tag associated with a task.
@@ -0,0 +1,68 @@
+package primary
+
+import "context"
+
+// TelemetryService defines the primary port for opt-in local telemetry: how
+// long each orc invocation took and how much of that went to ledger queries.
+// Nothing leaves the machine; the data exists to find hotspots.
+type TelemetryService interface {
+	// RecordCommand stores one invocation and prunes invocations older than
+	// the retention window.
+	RecordCommand(ctx context.Context, req RecordCommandRequest) error
+
+	// PerfReport summarizes recorded invocations into per-command timings and
+	// the queries that most often show up among the slowest.
+	PerfReport(ctx context.Context, req PerfReportRequest) (*PerfReport, error)
+}
+
+// RecordCommandRequest contains one invocation's timings.
+type RecordCommandRequest struct {
+	Command     string // Command path, e.g. "orc summary"
+	DurationMS  int64
+	QueryCount  int
+	QueryMS     int64
+	SlowQueries []SlowQuery // Slowest first
+	Failed      bool
+}
+
+// SlowQuery is one of an invocation's slowest queries.
+type SlowQuery struct {
+	SQL string
+	MS  float64
+}
+
+// PerfReportRequest contains parameters for a performance report.
+type PerfReportRequest struct {
+	Top     int    // Entries per section; 0 means all
+	Since   string // RFC3339; empty means the whole retention window
+	Command string // Optional; only this command path
+}
+
+// PerfReport is the hotspot summary of recorded invocations.
+type PerfReport struct {
+	Invocations int
+	Commands    []CommandPerf // Most total time first
+	Queries     []QueryPerf   // Most total time first
+}
+
+// CommandPerf aggregates the invocations of one command.
+type CommandPerf struct {
+	Command     string
+	Runs        int
+	Failures    int
+	TotalMS     float64
+	P50MS       float64
+	P95MS       float64
+	MaxMS       float64
+	MeanQueries float64
+	DBShare     float64 // Fraction of the command's time spent in queries
+}
+
+// QueryPerf aggregates a query's appearances among invocations' slowest.
+type QueryPerf struct {
+	SQL      string
+	Seen     int
+	TotalMS  float64
+	MaxMS    float64
+	Commands []string
+}
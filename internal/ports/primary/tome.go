@@ -36,6 +36,30 @@ type TomeService interface {
 
 	// GetTomeNotes retrieves all notes in a tome.
 	GetTomeNotes(ctx context.Context, tomeID string) ([]*Note, error)
+
+	// SetTomeCharter replaces a tome's charter document.
+	SetTomeCharter(ctx context.Context, tomeID, charter string) error
+
+	// ExportTome renders a tome and its notes as a document in the requested format.
+	ExportTome(ctx context.Context, req ExportTomeRequest) (string, error)
+
+	// ReorderTomeNote moves a note within its tome's reading order.
+	ReorderTomeNote(ctx context.Context, req ReorderTomeNoteRequest) error
+}
+
+// ReorderTomeNoteRequest contains parameters for moving a note within a tome.
+// With neither AfterID nor BeforeID, the note moves to the front.
+type ReorderTomeNoteRequest struct {
+	TomeID   string
+	NoteID   string
+	AfterID  string
+	BeforeID string
+}
+
+// ExportTomeRequest contains parameters for exporting a tome.
+type ExportTomeRequest struct {
+	TomeID string
+	Format string // markdown-book
 }
 
 // CreateTomeRequest contains parameters for creating a tome.
@@ -67,6 +91,7 @@ type Tome struct {
 	Status              string
 	AssignedWorkbenchID string
 	Pinned              bool
+	Charter             string // Long-form markdown explaining why the tome exists
 	CreatedAt           string
 	UpdatedAt           string
 	ClosedAt            string
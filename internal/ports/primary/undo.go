@@ -0,0 +1,28 @@
+package primary
+
+import "context"
+
+// UndoService defines the primary port for reverting recent mutations.
+// Undo works off the workshop activity log: each undoable entry records the
+// old value, and the inverse writes it back.
+type UndoService interface {
+	// ListUndoable returns the current actor's most recent undoable actions, newest first.
+	ListUndoable(ctx context.Context, limit int) ([]*UndoableAction, error)
+
+	// Undo reverts a logged action by the current actor.
+	// Pass empty logID to revert the most recent undoable action.
+	Undo(ctx context.Context, logID string) (*UndoableAction, error)
+}
+
+// UndoableAction represents a logged mutation that can potentially be reverted.
+type UndoableAction struct {
+	LogID      string
+	Timestamp  string
+	EntityType string
+	EntityID   string
+	FieldName  string
+	OldValue   string // Value the undo restores
+	NewValue   string // Value the action set
+	Safe       bool   // False when the entity changed since (undo would clobber it)
+	Reason     string // Why the action is not safe to undo
+}
@@ -0,0 +1,23 @@
+package primary
+
+import "context"
+
+// UpgradeService defines the primary port for updating the orc binary.
+type UpgradeService interface {
+	// CheckUpgrade fetches the latest release and compares it with the running binary.
+	CheckUpgrade(ctx context.Context) (*UpgradeStatus, error)
+
+	// Upgrade installs the latest release over the running binary. Force
+	// replaces dev builds and builds that are not behind the release.
+	Upgrade(ctx context.Context, force bool) (*UpgradeStatus, error)
+}
+
+// UpgradeStatus describes the running binary relative to the latest release.
+type UpgradeStatus struct {
+	CurrentCommit     string
+	LatestCommit      string
+	LatestSubject     string
+	LatestCommittedAt string
+	UpToDate          bool
+	Installed         bool // Set by Upgrade when the binary was replaced
+}
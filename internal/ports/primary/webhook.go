@@ -0,0 +1,60 @@
+package primary
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrWebhookSignature is returned by Deliver when a delivery's signature does
+// not match its source's secret.
+var ErrWebhookSignature = errors.New("invalid webhook signature")
+
+// WebhookService defines the primary port for inbound webhooks: external
+// systems (CI, GitHub, monitoring) posting signed events that update the
+// ledger through per-source mappings.
+type WebhookService interface {
+	// AddSource registers a webhook source. Without mappings, the defaults apply.
+	AddSource(ctx context.Context, req AddWebhookSourceRequest) (*WebhookSource, error)
+
+	// ListSources lists registered webhook sources.
+	ListSources(ctx context.Context) ([]*WebhookSource, error)
+
+	// RemoveSource removes a webhook source.
+	RemoveSource(ctx context.Context, name string) error
+
+	// Deliver verifies and applies one delivery to a source.
+	Deliver(ctx context.Context, req WebhookDelivery) (*WebhookResult, error)
+}
+
+// AddWebhookSourceRequest contains parameters for registering a webhook source.
+type AddWebhookSourceRequest struct {
+	Name       string
+	Kind       string   // "github" or "generic"
+	SecretName string   // Global secret holding the signing key
+	Mappings   []string // "event=action[,action]" specs; empty uses the defaults
+}
+
+// WebhookSource is a webhook source at the port boundary.
+type WebhookSource struct {
+	Name       string
+	Kind       string
+	SecretName string
+	Mappings   []string // Sorted "event=action[,action]" specs
+	CreatedAt  string
+}
+
+// WebhookDelivery is one inbound request to a source.
+type WebhookDelivery struct {
+	Source    string
+	Event     string // X-GitHub-Event header for github sources
+	Signature string // X-Hub-Signature-256 header
+	Body      []byte
+}
+
+// WebhookResult records what a delivery did.
+type WebhookResult struct {
+	Event      string // Normalized event type; empty when the delivery was ignored
+	ShipmentID string
+	Applied    []string // Human-readable effects, e.g. "blocked TASK-004"
+	Skipped    []string // Why mapped actions did nothing
+}
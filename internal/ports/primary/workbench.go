@@ -39,6 +39,14 @@ type WorkbenchService interface {
 	// GetFocusedID returns the currently focused container ID for a workbench.
 	GetFocusedID(ctx context.Context, workbenchID string) (string, error)
 
+	// ListRecentFocus returns a workbench's recent distinct focus targets,
+	// most recently focused first.
+	ListRecentFocus(ctx context.Context, workbenchID string, limit int) ([]*FocusHistoryEntry, error)
+
+	// GetPreviousFocus returns the focus target before the current one,
+	// or empty string if there is none.
+	GetPreviousFocus(ctx context.Context, workbenchID string) (string, error)
+
 	// GetWorkbenchesByFocusedID returns all active workbenches focused on a given container.
 	// Used for focus exclusivity checks (IMP cannot focus on container already focused by another IMP).
 	GetWorkbenchesByFocusedID(ctx context.Context, focusedID string) ([]*Workbench, error)
@@ -46,6 +54,10 @@ type WorkbenchService interface {
 	// ArchiveWorkbench soft-deletes a workbench by setting status to 'archived'.
 	// The record remains in DB so infra plan can detect it as a DELETE target.
 	ArchiveWorkbench(ctx context.Context, workbenchID string) error
+
+	// BootstrapWorkbench runs the linked repo's bootstrap script inside the workbench.
+	// Workbenches that already ran their bootstrap require Rerun.
+	BootstrapWorkbench(ctx context.Context, req BootstrapWorkbenchRequest) (*BootstrapWorkbenchResponse, error)
 }
 
 // CreateWorkbenchRequest contains parameters for creating a workbench.
@@ -60,7 +72,23 @@ type CreateWorkbenchRequest struct {
 type CreateWorkbenchResponse struct {
 	WorkbenchID string
 	Workbench   *Workbench
-	Path        string // Materialized workbench path
+	Path        string                      // Materialized workbench path
+	Bootstrap   *BootstrapWorkbenchResponse // Nil when the repo has no bootstrap script
+}
+
+// BootstrapWorkbenchRequest contains parameters for bootstrapping a workbench.
+type BootstrapWorkbenchRequest struct {
+	WorkbenchID string
+	Rerun       bool // Run again even if a previous bootstrap succeeded or failed
+}
+
+// BootstrapWorkbenchResponse contains the result of a bootstrap run.
+// A failed script is reported via Status, not as an error.
+type BootstrapWorkbenchResponse struct {
+	WorkbenchID string
+	Status      string // succeeded or failed
+	Output      string // Combined script output (tail)
+	Error       string // Failure reason when Status is failed
 }
 
 // RenameWorkbenchRequest contains parameters for renaming a workbench.
@@ -76,19 +104,28 @@ type DeleteWorkbenchRequest struct {
 	Force       bool
 }
 
+// FocusHistoryEntry is a past focus target of a workbench.
+type FocusHistoryEntry struct {
+	FocusedID string
+	FocusedAt string // RFC3339, when it was last focused
+}
+
 // Workbench represents a workbench entity at the port boundary.
 // A Workbench is a git worktree.
 type Workbench struct {
-	ID            string
-	Name          string
-	WorkshopID    string
-	RepoID        string
-	Path          string
-	Status        string
-	HomeBranch    string // Git home branch (e.g., ml/BENCH-name)
-	CurrentBranch string // Currently checked out branch
-	CreatedAt     string
-	UpdatedAt     string
+	ID              string
+	Name            string
+	WorkshopID      string
+	RepoID          string
+	Path            string
+	Status          string
+	HomeBranch      string // Git home branch (e.g., ml/BENCH-name)
+	CurrentBranch   string // Currently checked out branch
+	BootstrapStatus string // Empty when never bootstrapped; pending, succeeded, failed
+	BootstrapOutput string // Output of the last bootstrap run
+	BootstrappedAt  string
+	CreatedAt       string
+	UpdatedAt       string
 }
 
 // WorkbenchFilters contains filter options for listing workbenches.
@@ -99,6 +136,13 @@ type WorkbenchFilters struct {
 	Limit      int
 }
 
+// Workbench bootstrap status constants
+const (
+	BootstrapStatusPending   = "pending"
+	BootstrapStatusSucceeded = "succeeded"
+	BootstrapStatusFailed    = "failed"
+)
+
 // CheckoutBranchRequest contains parameters for switching branches.
 type CheckoutBranchRequest struct {
 	WorkbenchID  string
@@ -0,0 +1,40 @@
+package primary
+
+import "context"
+
+// WorkbenchEnvService defines the primary port for workbench environment variables.
+// Variables are injected into a workbench's tmux panes and agent sessions.
+// A variable holds a plain value or a reference to a secret; secret values are
+// resolved at injection time and never stored with the workbench.
+type WorkbenchEnvService interface {
+	// SetEnv sets a variable on a workbench, replacing any existing one.
+	SetEnv(ctx context.Context, req SetWorkbenchEnvRequest) error
+
+	// UnsetEnv removes a variable from a workbench.
+	UnsetEnv(ctx context.Context, workbenchID, name string) error
+
+	// ListEnv lists a workbench's variables. Secret values are not included.
+	ListEnv(ctx context.Context, workbenchID string) ([]*WorkbenchEnvVar, error)
+
+	// ResolveEnv returns a workbench's variables as sorted KEY=value pairs,
+	// with secrets resolved for the workbench's repo and factory.
+	ResolveEnv(ctx context.Context, workbenchID string) ([]string, error)
+}
+
+// SetWorkbenchEnvRequest contains parameters for setting a workbench variable.
+// Exactly one of Value and SecretName must be set.
+type SetWorkbenchEnvRequest struct {
+	WorkbenchID string
+	Name        string
+	Value       string
+	SecretName  string
+}
+
+// WorkbenchEnvVar is a workbench environment variable at the port boundary.
+type WorkbenchEnvVar struct {
+	WorkbenchID string
+	Name        string
+	Value       string // Empty for secret-backed variables
+	SecretName  string // Empty for plain values
+	UpdatedAt   string
+}
@@ -0,0 +1,62 @@
+package primary
+
+import "context"
+
+// WorkbenchStashService defines the primary port for stashing a workbench's
+// uncommitted work. The work is saved with git stash and the stash commit is
+// recorded in the ledger, linked to the task the bench was working on, so it
+// can be restored later on the same bench or on another bench of the same repo.
+type WorkbenchStashService interface {
+	// StashWorkbench stashes a workbench's uncommitted changes, leaving its worktree clean.
+	StashWorkbench(ctx context.Context, req StashWorkbenchRequest) (*WorkbenchStash, error)
+
+	// UnstashWorkbench restores a stash onto a workbench. Without a stash ID it
+	// restores the newest outstanding stash of the workbench's active task, or
+	// else the newest one taken from the workbench itself.
+	UnstashWorkbench(ctx context.Context, req UnstashWorkbenchRequest) (*WorkbenchStash, error)
+
+	// ListStashes lists stashes matching the filters, newest first.
+	ListStashes(ctx context.Context, filters WorkbenchStashFilters) ([]*WorkbenchStash, error)
+}
+
+// StashWorkbenchRequest contains parameters for stashing a workbench.
+type StashWorkbenchRequest struct {
+	WorkbenchID string
+	TaskID      string // Optional; defaults to the workbench's active task
+	Message     string // Optional
+}
+
+// UnstashWorkbenchRequest contains parameters for restoring a stash.
+type UnstashWorkbenchRequest struct {
+	StashID     string // Optional; see UnstashWorkbench
+	WorkbenchID string // Workbench to restore onto
+}
+
+// WorkbenchStashFilters contains filter options for listing stashes.
+type WorkbenchStashFilters struct {
+	WorkbenchID string
+	TaskID      string
+	Status      string // stashed, restored
+}
+
+// WorkbenchStash is a stash of uncommitted workbench changes at the port boundary.
+type WorkbenchStash struct {
+	ID                    string
+	WorkbenchID           string
+	RepoID                string
+	TaskID                string
+	Branch                string
+	CommitSHA             string
+	FileCount             int
+	Message               string
+	Status                string // stashed, restored
+	RestoredToWorkbenchID string
+	CreatedAt             string
+	RestoredAt            string
+}
+
+// Workbench stash statuses.
+const (
+	WorkbenchStashStashed  = "stashed"
+	WorkbenchStashRestored = "restored"
+)
@@ -0,0 +1,67 @@
+package primary
+
+import "context"
+
+// WorkbenchTeardownService defines the primary port for tearing down a single
+// workbench safely. Teardown archives the workbench (orc tmux apply removes
+// its worktree) after checking that nothing on it would be lost, optionally
+// moving its assignments to another workbench first.
+type WorkbenchTeardownService interface {
+	// PlanTeardown lists what tearing down a workbench would touch: uncommitted
+	// changes, unpushed commits, in-progress tasks, open PRs, and assigned shipments.
+	PlanTeardown(ctx context.Context, workbenchID string) (*WorkbenchTeardownPlan, error)
+
+	// TeardownWorkbench migrates assignments if asked, then archives the
+	// workbench. Without Force it refuses when work would be lost.
+	TeardownWorkbench(ctx context.Context, req TeardownWorkbenchRequest) (*WorkbenchTeardownResult, error)
+}
+
+// TeardownWorkbenchRequest contains parameters for tearing down a workbench.
+type TeardownWorkbenchRequest struct {
+	WorkbenchID string
+	MigrateTo   string // Optional workbench to move shipments and in-progress tasks to
+	Force       bool   // Tear down even if work would be lost
+}
+
+// WorkbenchTeardownPlan is what tearing down a workbench would touch.
+type WorkbenchTeardownPlan struct {
+	WorkbenchID     string
+	WorkbenchName   string
+	WorkshopID      string
+	Path            string
+	WorktreeExists  bool
+	ChangedFiles    []string // Uncommitted changes, untracked files included
+	UnpushedCommits []TeardownCommit
+	InProgressTasks []TeardownTask
+	OpenPRs         []TeardownPR
+	Shipments       []string // Open shipments assigned to the workbench
+}
+
+// TeardownCommit is a commit not yet pushed from a workbench.
+type TeardownCommit struct {
+	SHA     string
+	Subject string
+}
+
+// TeardownTask is an in-progress task assigned to a workbench.
+type TeardownTask struct {
+	ID    string
+	Title string
+}
+
+// TeardownPR is an unmerged pull request for a workbench's shipment or branch.
+type TeardownPR struct {
+	ID         string
+	ShipmentID string
+	Branch     string
+	Status     string
+}
+
+// WorkbenchTeardownResult contains the result of a workbench teardown.
+type WorkbenchTeardownResult struct {
+	WorkbenchID       string
+	WorkshopID        string
+	MigratedTo        string
+	MigratedShipments []string
+	MigratedTasks     []string
+}
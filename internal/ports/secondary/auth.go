@@ -0,0 +1,9 @@
+package secondary
+
+import "context"
+
+// HostAuthChecker verifies that the git host's CLI is logged in.
+type HostAuthChecker interface {
+	// CheckAuth returns an error describing why host is not usable, or nil.
+	CheckAuth(ctx context.Context, host string) error
+}
@@ -0,0 +1,12 @@
+package secondary
+
+// BlobStore defines the secondary port for content-addressed storage of
+// large bodies kept outside the ledger.
+type BlobStore interface {
+	// Put stores data and returns its key, the hex SHA-256 of data.
+	// Storing the same data twice is a no-op.
+	Put(data []byte) (string, error)
+
+	// Get returns the data stored under key.
+	Get(key string) ([]byte, error)
+}
@@ -32,6 +32,11 @@ type CommissionRepository interface {
 
 	// CountShipments returns the number of shipments for a commission.
 	CountShipments(ctx context.Context, commissionID string) (int, error)
+
+	// ListRollups returns every commission's open work counts in one
+	// aggregated query, keyed by commission ID. Commissions without open
+	// work may be missing.
+	ListRollups(ctx context.Context) (map[string]*CommissionRollupRecord, error)
 }
 
 // CommissionRecord represents a commission as stored in persistence.
@@ -54,6 +59,160 @@ type CommissionFilters struct {
 	Limit  int
 }
 
+// CommissionRollupRecord holds a commission's open work counts.
+type CommissionRollupRecord struct {
+	CommissionID     string
+	OpenShipments    int // Shipments not closed
+	ReadyTasks       int // Open tasks
+	InProgressTasks  int
+	BlockedTasks     int
+	PendingApprovals int // Draft plans on tasks not closed
+}
+
+// CommissionBudgetRepository defines the secondary port for commission budgets.
+// A commission has at most one budget.
+type CommissionBudgetRepository interface {
+	// Set creates or replaces a commission's budget.
+	Set(ctx context.Context, budget *CommissionBudgetRecord) error
+
+	// Get retrieves a commission's budget (nil if none).
+	Get(ctx context.Context, commissionID string) (*CommissionBudgetRecord, error)
+
+	// Delete removes a commission's budget.
+	Delete(ctx context.Context, commissionID string) error
+
+	// List retrieves all budgets ordered by commission ID.
+	List(ctx context.Context) ([]*CommissionBudgetRecord, error)
+}
+
+// CommissionBudgetRecord represents a commission budget as stored in persistence.
+type CommissionBudgetRecord struct {
+	CommissionID string
+	Unit         string // hours or points
+	Amount       float64
+	Thresholds   string // Comma-separated warning percentages, e.g. "75,90"
+	CreatedAt    string
+	UpdatedAt    string
+}
+
+// GlossaryRepository defines the secondary port for commission glossaries.
+// Terms are unique per commission, ignoring case.
+type GlossaryRepository interface {
+	// Save creates a term or replaces its definition and replacement.
+	Save(ctx context.Context, term *GlossaryTermRecord) error
+
+	// List retrieves a commission's terms ordered by term.
+	List(ctx context.Context, commissionID string) ([]*GlossaryTermRecord, error)
+
+	// Delete removes a term from a commission's glossary.
+	Delete(ctx context.Context, commissionID, term string) error
+}
+
+// GlossaryTermRecord represents a glossary term as stored in persistence.
+type GlossaryTermRecord struct {
+	CommissionID string
+	Term         string
+	Definition   string
+	ReplacedBy   string // Empty string means null (not deprecated)
+	CreatedAt    string
+	UpdatedAt    string
+}
+
+// EntityLockRepository defines the secondary port for advisory entity locks.
+// An entity has at most one lock row; expiry is judged by the caller.
+type EntityLockRepository interface {
+	// Save creates or replaces an entity's lock.
+	Save(ctx context.Context, lock *EntityLockRecord) error
+
+	// Get retrieves an entity's lock (nil if none), expired or not.
+	Get(ctx context.Context, entityID string) (*EntityLockRecord, error)
+
+	// Delete removes an entity's lock.
+	Delete(ctx context.Context, entityID string) error
+
+	// List retrieves all lock rows ordered by entity ID.
+	List(ctx context.Context) ([]*EntityLockRecord, error)
+
+	// DeleteExpired removes locks that expired at or before the given RFC3339 time.
+	DeleteExpired(ctx context.Context, before string) (int, error)
+}
+
+// EntityLockRecord represents an entity lock as stored in persistence.
+type EntityLockRecord struct {
+	EntityID   string
+	HeldBy     string
+	Reason     string // Empty string means null
+	AcquiredAt string // RFC3339
+	ExpiresAt  string // RFC3339
+}
+
+// PresenceRepository defines the secondary port for entity presence: the
+// last time each actor viewed or edited each entity.
+type PresenceRepository interface {
+	// Touch creates or replaces an actor's sighting on an entity.
+	Touch(ctx context.Context, presence *PresenceRecord) error
+
+	// ListSince retrieves sightings at or after the given RFC3339 time,
+	// newest first. An empty entityID lists sightings on every entity.
+	ListSince(ctx context.Context, entityID, since string) ([]*PresenceRecord, error)
+}
+
+// PresenceRecord represents an actor's last sighting on an entity as stored in persistence.
+type PresenceRecord struct {
+	EntityID string
+	ActorID  string
+	Action   string // 'view' or 'edit'
+	SeenAt   string // RFC3339
+}
+
+// RateLimitRepository defines the secondary port for catching runaway actors:
+// configured limits, activity counted from the workshop logs, and throttles.
+// An actor has at most one throttle row; expiry is judged by the caller.
+type RateLimitRepository interface {
+	// ListLimits retrieves the configured limits ordered by actor type and kind.
+	ListLimits(ctx context.Context) ([]*RateLimitRecord, error)
+
+	// SaveLimit creates or replaces a limit.
+	SaveLimit(ctx context.Context, limit *RateLimitRecord) error
+
+	// DeleteLimit removes a configured limit, restoring the default.
+	DeleteLimit(ctx context.Context, actorType, kind string) error
+
+	// CountActivity counts the changes an actor has logged since the given RFC3339 time.
+	CountActivity(ctx context.Context, actorID, since string) (*ActivityRecord, error)
+
+	// SaveThrottle creates or replaces an actor's throttle.
+	SaveThrottle(ctx context.Context, throttle *ActorThrottleRecord) error
+
+	// GetThrottle retrieves an actor's throttle (nil if none), expired or not.
+	GetThrottle(ctx context.Context, actorID string) (*ActorThrottleRecord, error)
+
+	// ListActiveThrottles retrieves throttles expiring after the given RFC3339 time.
+	ListActiveThrottles(ctx context.Context, after string) ([]*ActorThrottleRecord, error)
+}
+
+// RateLimitRecord represents a configured rate limit as stored in persistence.
+type RateLimitRecord struct {
+	ActorType string
+	Kind      string
+	PerMinute int
+}
+
+// ActivityRecord counts an actor's logged changes.
+type ActivityRecord struct {
+	Notes         int // Notes created
+	StatusChanges int
+	Writes        int // Every logged change
+}
+
+// ActorThrottleRecord represents an actor's throttle as stored in persistence.
+type ActorThrottleRecord struct {
+	ActorID     string
+	Reason      string
+	ThrottledAt string // RFC3339
+	ExpiresAt   string // RFC3339
+}
+
 // AgentIdentityProvider defines the secondary port for agent identity resolution.
 // This abstracts the detection of current agent context (ORC vs IMP).
 type AgentIdentityProvider interface {
@@ -116,8 +275,17 @@ type ShipmentRepository interface {
 	// CommissionExists checks if a commission exists (for validation).
 	CommissionExists(ctx context.Context, commissionID string) (bool, error)
 
+	// WorkbenchExists checks if a workbench exists (for validation).
+	WorkbenchExists(ctx context.Context, workbenchID string) (bool, error)
+
 	// WorkbenchAssignedToOther checks if workbench is assigned to another shipment.
 	WorkbenchAssignedToOther(ctx context.Context, workbenchID, excludeShipmentID string) (string, error)
+
+	// UpdateCharter replaces the charter document (empty string clears it).
+	UpdateCharter(ctx context.Context, id, charter string) error
+
+	// UpdateAutorun sets the autorun state ("on", "paused"; empty string turns it off).
+	UpdateAutorun(ctx context.Context, id, state string) error
 }
 
 // ShipmentRecord represents a shipment as stored in persistence.
@@ -132,9 +300,16 @@ type ShipmentRecord struct {
 	Branch              string // Empty string means null - owned branch (e.g., ml/SHIP-001-feature-name)
 	Pinned              bool
 	SpecNoteID          string // Empty string means null - spec note that generated this shipment (NOTE-xxx)
+	Charter             string // Empty string means null - long-form markdown "why" document
+	Autorun             string // Empty string means off; "on" or "paused" (populated by GetByID)
 	CreatedAt           string
 	UpdatedAt           string
 	CompletedAt         string // Empty string means null
+
+	// Read-model fields, populated by List from shipment_list_view.
+	TaskCount     int
+	TasksClosed   int
+	WorkbenchName string // Empty string means unassigned
 }
 
 // ShipmentFilters contains filter options for querying shipments.
@@ -178,9 +353,17 @@ type TaskRepository interface {
 	// UpdateStatus updates the status with optional timestamps.
 	UpdateStatus(ctx context.Context, id, status string, setClaimed, setCompleted bool) error
 
+	// RevertStatus sets the status back for an undo, optionally clearing the
+	// claim (claimed_at, claim_refreshed_at) and completed_at.
+	RevertStatus(ctx context.Context, id, status string, clearClaim, clearCompleted bool) error
+
 	// Claim claims a task for a workbench.
 	Claim(ctx context.Context, id, workbenchID string) error
 
+	// RefreshClaims records a heartbeat on a workbench's in-progress tasks,
+	// returning how many claims were refreshed.
+	RefreshClaims(ctx context.Context, workbenchID string) (int, error)
+
 	// AssignWorkbenchByShipment assigns all tasks of a shipment to a workbench.
 	AssignWorkbenchByShipment(ctx context.Context, shipmentID, workbenchID string) error
 
@@ -207,6 +390,32 @@ type TaskRepository interface {
 
 	// GetNextEntityTagID returns the next available entity tag ID.
 	GetNextEntityTagID(ctx context.Context) (string, error)
+
+	// ListChecklistItems retrieves a task's checklist items in creation order.
+	ListChecklistItems(ctx context.Context, taskID string) ([]*ChecklistItemRecord, error)
+
+	// AddChecklistItem appends a checklist item to a task and sets its ID.
+	AddChecklistItem(ctx context.Context, item *ChecklistItemRecord) error
+
+	// SetChecklistItemDone marks a checklist item done or not done.
+	SetChecklistItemDone(ctx context.Context, itemID int64, done bool) error
+
+	// DeleteChecklistItem removes a checklist item.
+	DeleteChecklistItem(ctx context.Context, itemID int64) error
+
+	// ListConditionalPlanIDs returns the task's plans that are conditionally
+	// approved (draft with open conditions).
+	ListConditionalPlanIDs(ctx context.Context, taskID string) ([]string, error)
+}
+
+// ChecklistItemRecord represents a task checklist item as stored in persistence.
+type ChecklistItemRecord struct {
+	ID          int64
+	TaskID      string
+	Text        string
+	Done        bool
+	CreatedAt   string
+	CompletedAt string // Empty string means null
 }
 
 // TaskRecord represents a task as stored in persistence.
@@ -223,10 +432,19 @@ type TaskRecord struct {
 	AssignedWorkbenchID string // Empty string means null
 	Pinned              bool
 	DependsOn           string // JSON array of task IDs, empty string means null
+	Points              int    // Estimate in task points, 0 means null
+	DueDate             string // YYYY-MM-DD, empty string means null
 	CreatedAt           string
 	UpdatedAt           string
 	ClaimedAt           string // Empty string means null
+	ClaimRefreshedAt    string // Empty string means null
 	CompletedAt         string // Empty string means null
+
+	// Read-model fields, populated by List from task_list_view.
+	TagName        string // Empty string means untagged
+	ChecklistDone  int
+	ChecklistTotal int
+	CommentCount   int
 }
 
 // TaskFilters contains filter options for querying tasks.
@@ -245,6 +463,45 @@ type TagRecord struct {
 	UpdatedAt   string
 }
 
+// TagMergeRecord reports what merging one tag into another moved.
+type TagMergeRecord struct {
+	Moved      int  // entity_tags rows re-pointed to the target
+	Duplicates int  // Rows dropped because the entity already had the target
+	RouteMoved bool // The source's route became the target's
+}
+
+// TagUsageRecord counts a tag's uses per entity type.
+type TagUsageRecord struct {
+	TagID  string
+	Name   string
+	Counts map[string]int // entity_type -> count; empty for unused tags
+}
+
+// TagRouteRepository defines the secondary port for tag routes.
+// Each tag routes to at most one workbench.
+type TagRouteRepository interface {
+	// Set routes a tag to a workbench, replacing any existing route for the tag.
+	Set(ctx context.Context, route *TagRouteRecord) error
+
+	// Delete removes a tag's route.
+	Delete(ctx context.Context, tagID string) error
+
+	// GetByTag retrieves a tag's route (nil if none).
+	GetByTag(ctx context.Context, tagID string) (*TagRouteRecord, error)
+
+	// List retrieves routes ordered by tag name, optionally filtered by workbench.
+	List(ctx context.Context, workbenchID string) ([]*TagRouteRecord, error)
+}
+
+// TagRouteRecord represents a tag route as stored in persistence.
+type TagRouteRecord struct {
+	TagID       string
+	TagName     string // Populated on read
+	WorkbenchID string
+	Mode        string // 'suggest', 'assign'
+	CreatedAt   string
+}
+
 // TagRepository defines the secondary port for tag persistence.
 type TagRepository interface {
 	// Create persists a new tag.
@@ -267,6 +524,16 @@ type TagRepository interface {
 
 	// GetEntityTag retrieves the tag for an entity (nil if none).
 	GetEntityTag(ctx context.Context, entityID, entityType string) (*TagRecord, error)
+
+	// Rename changes a tag's name.
+	Rename(ctx context.Context, id, name string) error
+
+	// Merge re-points the source tag's entities (and its route, if the target
+	// has none) to the target, then deletes the source, in one transaction.
+	Merge(ctx context.Context, sourceID, targetID string) (*TagMergeRecord, error)
+
+	// Usage counts each tag's uses per entity type, ordered by name.
+	Usage(ctx context.Context) ([]*TagUsageRecord, error)
 }
 
 // NoteRepository defines the secondary port for note persistence.
@@ -310,11 +577,29 @@ type NoteRepository interface {
 	// UpdateStatus updates the status of a note (open/closed).
 	UpdateStatus(ctx context.Context, id string, status string) error
 
-	// CloseWithMerge closes a note and records it was merged into another note.
+	// CloseWithMerge closes a note as a duplicate and records it was merged into another note.
 	CloseWithMerge(ctx context.Context, sourceID, targetID string) error
 
-	// CloseWithReason closes a note with a reason and optional reference to another note.
-	CloseWithReason(ctx context.Context, id, reason, byNoteID string) error
+	// CloseWithReason closes a note with a resolution, an optional reason, and
+	// an optional reference to another note.
+	CloseWithReason(ctx context.Context, id, resolution, reason, byNoteID string) error
+
+	// UpdateTriage sets a bug note's severity and triage state.
+	UpdateTriage(ctx context.Context, id, severity, triageStatus string) error
+
+	// SetDraft marks a handoff note as a draft or as confirmed.
+	SetDraft(ctx context.Context, id string, draft bool) error
+
+	// ExternalizeContent moves note bodies of at least minBytes out of the
+	// ledger into the blob store, returning the notes moved. With dryRun it
+	// only reports them.
+	ExternalizeContent(ctx context.Context, minBytes int, dryRun bool) ([]*ExternalizedNoteRecord, error)
+}
+
+// ExternalizedNoteRecord is a note whose body was moved to the blob store.
+type ExternalizedNoteRecord struct {
+	ID    string
+	Bytes int
 }
 
 // NoteRecord represents a note as stored in persistence.
@@ -335,13 +620,20 @@ type NoteRecord struct {
 	PromotedFromType    string // Empty string means null
 	CloseReason         string // Empty string means null
 	ClosedByNoteID      string // Empty string means null
+	Position            int    // Reading order within the tome; 0 means unordered
+	Severity            string // P0-P3 for bug notes; empty string means null
+	TriageStatus        string // Bug notes only; empty string means null
+	Resolution          string // fixed, duplicate, wontfix, promoted, superseded; empty string means null
+	Draft               bool   // Handoff notes only: true until the IMP confirms the summary
 	PromoteToCommission bool   // When true, clear all container associations to make commission-level
+	ContentExternal     bool   // Listed without its externalized body; GetByID loads it
 }
 
 // NoteFilters contains filter options for querying notes.
 type NoteFilters struct {
 	Type         string
 	CommissionID string
+	Resolution   string
 }
 
 // TomeRepository defines the secondary port for tome persistence.
@@ -379,8 +671,17 @@ type TomeRepository interface {
 	// AssignWorkbench assigns a tome to a workbench.
 	AssignWorkbench(ctx context.Context, tomeID, workbenchID string) error
 
+	// WorkbenchExists checks if a workbench exists (for validation).
+	WorkbenchExists(ctx context.Context, workbenchID string) (bool, error)
+
 	// CommissionExists checks if a commission exists (for validation).
 	CommissionExists(ctx context.Context, commissionID string) (bool, error)
+
+	// UpdateCharter replaces the charter document (empty string clears it).
+	UpdateCharter(ctx context.Context, id, charter string) error
+
+	// SetNoteOrder records the reading order of the given notes in a tome.
+	SetNoteOrder(ctx context.Context, tomeID string, noteIDs []string) error
 }
 
 // TomeRecord represents a tome as stored in persistence.
@@ -392,6 +693,7 @@ type TomeRecord struct {
 	Status              string
 	AssignedWorkbenchID string // Empty string means null
 	Pinned              bool
+	Charter             string // Empty string means null - long-form markdown "why" document
 	CreatedAt           string
 	UpdatedAt           string
 	ClosedAt            string // Empty string means null
@@ -446,6 +748,47 @@ type PlanRepository interface {
 
 	// TaskExists checks if a task exists (for validation).
 	TaskExists(ctx context.Context, taskID string) (bool, error)
+
+	// ListSteps retrieves a plan's tracked steps in order, with linked task status.
+	ListSteps(ctx context.Context, planID string) ([]*PlanStepRecord, error)
+
+	// CreateSteps records a plan's steps. Positions must be 1-based and contiguous.
+	CreateSteps(ctx context.Context, steps []*PlanStepRecord) error
+
+	// SetStepTask links a plan step to a task.
+	SetStepTask(ctx context.Context, planID string, position int, taskID string) error
+
+	// ListConditions retrieves a plan's approval conditions in order.
+	ListConditions(ctx context.Context, planID string) ([]*PlanConditionRecord, error)
+
+	// CreateConditions records a conditional approval's conditions. Positions
+	// must be 1-based and contiguous.
+	CreateConditions(ctx context.Context, conditions []*PlanConditionRecord) error
+
+	// AddressCondition marks an open condition addressed with its evidence.
+	AddressCondition(ctx context.Context, planID string, position int, evidence, addressedBy string) error
+}
+
+// PlanConditionRecord represents a plan approval condition as stored in persistence.
+type PlanConditionRecord struct {
+	PlanID      string
+	Position    int
+	Description string
+	Status      string // "open" or "addressed"
+	Evidence    string // Empty string means null
+	ApprovedBy  string // Actor who approved the plan with this condition
+	CreatedAt   string
+	AddressedBy string // Empty string means null
+	AddressedAt string // Empty string means null
+}
+
+// PlanStepRecord represents a tracked plan step as stored in persistence.
+type PlanStepRecord struct {
+	PlanID     string
+	Position   int
+	Title      string
+	TaskID     string // Empty string means null
+	TaskStatus string // Read-only, joined from tasks; empty when unlinked
 }
 
 // PlanRecord represents a plan as stored in persistence.
@@ -500,18 +843,23 @@ type RepoRepository interface {
 
 	// HasActivePRs checks if a repository has active (non-terminal) PRs.
 	HasActivePRs(ctx context.Context, repoID string) (bool, error)
+
+	// UpdateBootstrapScript sets or clears the workbench bootstrap script.
+	// Pass empty string to clear.
+	UpdateBootstrapScript(ctx context.Context, id, script string) error
 }
 
 // RepoRecord represents a repository as stored in persistence.
 type RepoRecord struct {
-	ID            string
-	Name          string
-	URL           string // Empty string means null
-	LocalPath     string // Empty string means null
-	DefaultBranch string
-	Status        string
-	CreatedAt     string
-	UpdatedAt     string
+	ID              string
+	Name            string
+	URL             string // Empty string means null
+	LocalPath       string // Empty string means null
+	DefaultBranch   string
+	BootstrapScript string // Empty string means null - shell script run in new workbenches
+	Status          string
+	CreatedAt       string
+	UpdatedAt       string
 }
 
 // RepoFilters contains filter options for querying repositories.
@@ -661,6 +1009,9 @@ type WorkshopRepository interface {
 	// FactoryExists checks if a factory exists (for validation).
 	FactoryExists(ctx context.Context, factoryID string) (bool, error)
 
+	// CommissionExists checks if a commission exists (for validation).
+	CommissionExists(ctx context.Context, commissionID string) (bool, error)
+
 	// SetActiveCommissionID updates the active commission for a workshop (Goblin context).
 	// Pass empty string to clear.
 	SetActiveCommissionID(ctx context.Context, workshopID, commissionID string) error
@@ -732,21 +1083,45 @@ type WorkbenchRepository interface {
 
 	// WorkshopExists checks if a workshop exists (for validation).
 	WorkshopExists(ctx context.Context, workshopID string) (bool, error)
+
+	// UpdateBootstrapStatus records the outcome of a bootstrap run.
+	// bootstrapped_at is stamped for terminal statuses (succeeded, failed).
+	UpdateBootstrapStatus(ctx context.Context, id, status, output string) error
+}
+
+// FocusHistoryRepository defines the secondary port for workbench focus history.
+type FocusHistoryRepository interface {
+	// Record appends a focus change, keeping only the newest entries per workbench.
+	Record(ctx context.Context, workbenchID, focusedID string) error
+
+	// ListRecent retrieves a workbench's distinct focus targets, most recently
+	// focused first, each with the time it was last focused.
+	ListRecent(ctx context.Context, workbenchID string, limit int) ([]*FocusHistoryRecord, error)
+}
+
+// FocusHistoryRecord represents a focus target as stored in persistence.
+type FocusHistoryRecord struct {
+	WorkbenchID string
+	FocusedID   string
+	FocusedAt   string // RFC3339
 }
 
 // WorkbenchRecord represents a workbench as stored in persistence.
 type WorkbenchRecord struct {
-	ID            string
-	Name          string
-	WorkshopID    string
-	RepoID        string // Optional - linked repo
-	WorktreePath  string
-	Status        string
-	HomeBranch    string // Git home branch for this workbench (e.g., ml/BENCH-name)
-	CurrentBranch string // Currently checked out branch
-	FocusedID     string // Empty string means null - IMP focus (CON-xxx or SHIP-xxx)
-	CreatedAt     string
-	UpdatedAt     string
+	ID              string
+	Name            string
+	WorkshopID      string
+	RepoID          string // Optional - linked repo
+	WorktreePath    string
+	Status          string
+	HomeBranch      string // Git home branch for this workbench (e.g., ml/BENCH-name)
+	CurrentBranch   string // Currently checked out branch
+	FocusedID       string // Empty string means null - IMP focus (CON-xxx or SHIP-xxx)
+	BootstrapStatus string // Empty string means null - pending, succeeded, failed
+	BootstrapOutput string // Empty string means null - combined output of last bootstrap run
+	BootstrappedAt  string // Empty string means null
+	CreatedAt       string
+	UpdatedAt       string
 }
 
 // WorkshopLogRepository defines the secondary port for workshop log (audit trail) persistence.
@@ -770,6 +1145,14 @@ type WorkshopLogRepository interface {
 	// PruneOlderThan deletes log entries older than the given number of days.
 	// Returns the number of deleted entries.
 	PruneOlderThan(ctx context.Context, days int) (int, error)
+
+	// ListUndoable retrieves update entries made by an actor that have not been
+	// undone and are not themselves undo entries, newest first.
+	// fields restricts results to "entity_type.field_name" keys.
+	ListUndoable(ctx context.Context, actorID string, fields []string, limit int) ([]*WorkshopLogRecord, error)
+
+	// IsUndone checks if a later entry reverted the given log entry.
+	IsUndone(ctx context.Context, logID string) (bool, error)
 }
 
 // WorkshopLogRecord represents a workshop log entry as stored in persistence.
@@ -784,6 +1167,8 @@ type WorkshopLogRecord struct {
 	FieldName  string // Empty string means null - for updates only
 	OldValue   string // Empty string means null
 	NewValue   string // Empty string means null
+	UndoOf     string // Empty string means null - log entry this entry reverted
+	Forced     bool   // A guard was overridden with --force
 	CreatedAt  string
 }
 
@@ -794,6 +1179,7 @@ type WorkshopLogFilters struct {
 	EntityID   string
 	ActorID    string
 	Action     string
+	Since      string // RFC3339; only entries at or after this time
 	Limit      int
 }
 
@@ -838,3 +1224,471 @@ type HookEventFilters struct {
 	HookType    string
 	Limit       int
 }
+
+// SummaryRepository defines the secondary port for batched summary reads.
+// Each method loads one entity type for many parents in a single query, so
+// rendering a summary costs a fixed number of queries regardless of ledger size.
+// Returned records carry only the fields the summary renders.
+type SummaryRepository interface {
+	// ListTasksByShipments retrieves tasks (ID, ShipmentID, Title, Status) for the given shipments.
+	ListTasksByShipments(ctx context.Context, shipmentIDs []string) ([]*TaskRecord, error)
+
+	// ListNotesByContainers retrieves notes (ID, Title, Type, Status, Pinned, ShipmentID, TomeID)
+	// held by any of the given shipments or tomes.
+	ListNotesByContainers(ctx context.Context, shipmentIDs, tomeIDs []string) ([]*NoteRecord, error)
+
+	// ListPlansByTasks retrieves plans (ID, TaskID, Status) for the given tasks.
+	ListPlansByTasks(ctx context.Context, taskIDs []string) ([]*PlanRecord, error)
+
+	// GetWorkbenchNames maps the given workbench IDs to their names.
+	GetWorkbenchNames(ctx context.Context, workbenchIDs []string) (map[string]string, error)
+
+	// CountChecklistByTasks returns checklist progress per task ID.
+	// Tasks without checklist items are omitted from the map.
+	CountChecklistByTasks(ctx context.Context, taskIDs []string) (map[string]ChecklistCount, error)
+	// GetAliasesByCommission maps entity IDs in a commission to their alias slugs.
+	GetAliasesByCommission(ctx context.Context, commissionID string) (map[string]string, error)
+	// CountCommentsByEntities returns the number of comments on each of the given entities.
+	// Entities without comments are omitted from the map.
+	CountCommentsByEntities(ctx context.Context, entityIDs []string) (map[string]int, error)
+	// ListShipmentIDsByTaskTags returns the commission's shipments holding a task
+	// tagged with any of the given tag names.
+	ListShipmentIDsByTaskTags(ctx context.Context, commissionID string, tags []string) ([]string, error)
+}
+
+// ChecklistCount is the done/total tally of a task's checklist items.
+type ChecklistCount struct {
+	Done  int
+	Total int
+}
+
+// CommitLinkRepository defines the secondary port for commit↔entity links.
+// Links are discovered by scanning workbench branches for entity IDs in commit messages.
+type CommitLinkRepository interface {
+	// Create persists a link, ignoring links that already exist.
+	// Returns true if the link was new.
+	Create(ctx context.Context, link *CommitLinkRecord) (bool, error)
+
+	// ListByEntity retrieves links for an entity, newest commit first.
+	ListByEntity(ctx context.Context, entityID string) ([]*CommitLinkRecord, error)
+
+	// CountByEntities returns the number of linked commits per entity ID.
+	// Entities without links are omitted from the map.
+	CountByEntities(ctx context.Context, entityIDs []string) (map[string]int, error)
+}
+
+// CommitLinkRecord represents a commit↔entity link as stored in persistence.
+type CommitLinkRecord struct {
+	CommitSHA   string
+	EntityType  string // 'task', 'shipment'
+	EntityID    string
+	WorkbenchID string // Empty string means null
+	Subject     string
+	CommittedAt string // RFC3339; empty string means null
+	CreatedAt   string
+}
+
+// PRReviewRepository defines the secondary port for PR review persistence.
+// Reviews are keyed by PR and the external (GitHub) ID, so re-fetching updates
+// existing rows instead of duplicating them.
+type PRReviewRepository interface {
+	// Save inserts or updates a review item, preserving its task link.
+	// Returns true if the item was new.
+	Save(ctx context.Context, review *PRReviewRecord) (bool, error)
+
+	// ListByPR retrieves a PR's review items, oldest first; a review precedes
+	// the comments submitted with it.
+	ListByPR(ctx context.Context, prID string) ([]*PRReviewRecord, error)
+
+	// SetTask links a review item to the task created for it.
+	SetTask(ctx context.Context, prID, externalID, taskID string) error
+}
+
+// PRReviewRecord represents a review or inline review comment as stored in persistence.
+type PRReviewRecord struct {
+	PRID             string
+	ExternalID       string // 'review:<id>' or 'comment:<id>'
+	Kind             string // 'review', 'comment'
+	ReviewExternalID string // Empty string means null
+	InReplyTo        bool
+	Author           string // Empty string means null
+	State            string // Empty string means null
+	Body             string // Empty string means null
+	Path             string // Empty string means null
+	Line             int    // 0 means null
+	URL              string // Empty string means null
+	SubmittedAt      string // Empty string means null
+	TaskID           string // Empty string means null
+	CreatedAt        string
+	UpdatedAt        string
+}
+
+// MetricsRepository defines the secondary port for ledger metrics queries.
+type MetricsRepository interface {
+	// CountTasks returns task counts grouped by commission and status.
+	CountTasks(ctx context.Context) ([]*StatusCountRecord, error)
+
+	// CountShipments returns shipment counts grouped by commission and status.
+	CountShipments(ctx context.Context) ([]*StatusCountRecord, error)
+
+	// CountPlans returns plan counts grouped by commission and status.
+	CountPlans(ctx context.Context) ([]*StatusCountRecord, error)
+
+	// CountHookEvents returns hook event counts grouped by hook type and decision.
+	CountHookEvents(ctx context.Context) ([]*HookEventCountRecord, error)
+}
+
+// StatusCountRecord is the number of entities in one commission with one status.
+type StatusCountRecord struct {
+	CommissionID string
+	Status       string
+	Count        int
+}
+
+// HookEventCountRecord is the number of hook events of one type with one decision.
+type HookEventCountRecord struct {
+	HookType string
+	Decision string
+	Count    int
+}
+
+// AliasRepository defines the secondary port for entity alias persistence.
+// Slugs are unique per commission; each entity has at most one alias.
+type AliasRepository interface {
+	// Set assigns an alias, replacing any alias the entity already has and
+	// any alias held by another entity under the same slug and commission.
+	Set(ctx context.Context, alias *AliasRecord) error
+
+	// Delete removes an entity's alias.
+	Delete(ctx context.Context, entityID string) error
+
+	// GetByEntity retrieves an entity's alias (nil if none).
+	GetByEntity(ctx context.Context, entityID string) (*AliasRecord, error)
+
+	// FindBySlug retrieves aliases with the given slug across all commissions.
+	FindBySlug(ctx context.Context, slug string) ([]*AliasRecord, error)
+
+	// GetRenamedID returns the ID an entity took when its prefix was
+	// renamed (empty if oldID was never renamed).
+	GetRenamedID(ctx context.Context, oldID string) (string, error)
+}
+
+// AliasRecord represents an entity alias as stored in persistence.
+type AliasRecord struct {
+	EntityID     string
+	EntityType   string // 'shipment', 'task', 'tome'
+	CommissionID string
+	Slug         string
+	CreatedAt    string
+}
+
+// CommentRepository defines the secondary port for entity comment persistence.
+type CommentRepository interface {
+	// Create persists a new comment.
+	Create(ctx context.Context, comment *CommentRecord) error
+
+	// GetByID retrieves a comment by its ID.
+	GetByID(ctx context.Context, id string) (*CommentRecord, error)
+
+	// ListByEntity retrieves the comments on an entity, oldest first.
+	ListByEntity(ctx context.Context, entityID string) ([]*CommentRecord, error)
+
+	// GetNextID returns the next available comment ID.
+	GetNextID(ctx context.Context) (string, error)
+}
+
+// CommentRecord represents a comment as stored in persistence.
+type CommentRecord struct {
+	ID         string
+	EntityID   string
+	EntityType string // 'commission', 'shipment', 'task', 'tome', 'note', 'plan'
+	ReplyToID  string // Empty string means null (starts a thread)
+	Author     string // Empty string means null (no actor context)
+	Body       string
+	CreatedAt  string
+}
+
+// SecretRepository defines the secondary port for secret persistence.
+// Values are stored encrypted; the repository never sees plaintext.
+type SecretRepository interface {
+	// Set stores a secret, replacing any existing value for the same name and scope.
+	Set(ctx context.Context, secret *SecretRecord) error
+
+	// Get retrieves a secret by name and scope (nil if none).
+	Get(ctx context.Context, name, scopeType, scopeID string) (*SecretRecord, error)
+
+	// List retrieves secrets, optionally filtered by scope type and ID.
+	List(ctx context.Context, scopeType, scopeID string) ([]*SecretRecord, error)
+
+	// Delete removes a secret by name and scope.
+	Delete(ctx context.Context, name, scopeType, scopeID string) error
+}
+
+// SecretRecord represents a secret as stored in persistence.
+type SecretRecord struct {
+	Name       string
+	ScopeType  string // 'global', 'factory', 'repo'
+	ScopeID    string // Empty string for global
+	Ciphertext string
+	CreatedAt  string
+	UpdatedAt  string
+}
+
+// WorkbenchEnvRepository defines the secondary port for workbench environment variables.
+// Secret-backed variables store only the secret name, never its value.
+type WorkbenchEnvRepository interface {
+	// Set stores a variable, replacing any existing one with the same name.
+	Set(ctx context.Context, env *WorkbenchEnvRecord) error
+
+	// Delete removes a variable from a workbench.
+	Delete(ctx context.Context, workbenchID, name string) error
+
+	// List retrieves a workbench's variables ordered by name.
+	List(ctx context.Context, workbenchID string) ([]*WorkbenchEnvRecord, error)
+}
+
+// WorkbenchEnvRecord represents a workbench environment variable as stored in persistence.
+type WorkbenchEnvRecord struct {
+	WorkbenchID string
+	Name        string
+	Value       string // Empty string means null (secret-backed)
+	SecretName  string // Empty string means null (plain value)
+	CreatedAt   string
+	UpdatedAt   string
+}
+
+// WorkbenchStashRepository defines the secondary port for workbench stash persistence.
+// A record points at a git stash commit; the uncommitted work itself stays in git.
+type WorkbenchStashRepository interface {
+	// Create persists a new stash record.
+	Create(ctx context.Context, stash *WorkbenchStashRecord) error
+
+	// GetByID retrieves a stash by its ID.
+	GetByID(ctx context.Context, id string) (*WorkbenchStashRecord, error)
+
+	// List retrieves stashes matching the given filters, newest first.
+	List(ctx context.Context, filters WorkbenchStashFilters) ([]*WorkbenchStashRecord, error)
+
+	// MarkRestored records that a stash was applied to a workbench.
+	MarkRestored(ctx context.Context, id, workbenchID string) error
+
+	// GetNextID returns the next available stash ID.
+	GetNextID(ctx context.Context) (string, error)
+}
+
+// WorkbenchStashRecord represents a workbench stash as stored in persistence.
+type WorkbenchStashRecord struct {
+	ID                    string
+	WorkbenchID           string
+	RepoID                string // Empty string means null
+	TaskID                string // Empty string means null
+	Branch                string // Empty string means null
+	CommitSHA             string
+	FileCount             int
+	Message               string // Empty string means null
+	Status                string // stashed, restored
+	RestoredToWorkbenchID string // Empty string means null
+	CreatedAt             string
+	RestoredAt            string // Empty string means null
+}
+
+// WorkbenchStashFilters contains filter options for querying stashes.
+type WorkbenchStashFilters struct {
+	WorkbenchID string
+	TaskID      string
+	Status      string
+}
+
+// EmbeddingRepository defines the secondary port for the recall index.
+// Embeddings are derived data, recomputed from their entity whenever its
+// content or the embedding model changes.
+type EmbeddingRepository interface {
+	// Upsert stores an entity's embedding, replacing any previous one.
+	Upsert(ctx context.Context, embedding *EmbeddingRecord) error
+
+	// List retrieves every stored embedding.
+	List(ctx context.Context) ([]*EmbeddingRecord, error)
+
+	// Delete removes an entity's embedding.
+	Delete(ctx context.Context, entityID string) error
+}
+
+// EmbeddingRecord represents an entity's embedding as stored in persistence.
+type EmbeddingRecord struct {
+	EntityID    string
+	EntityType  string // note, plan
+	Model       string
+	ContentHash string
+	Vector      []float32
+	UpdatedAt   string
+}
+
+// CommandStatRepository defines the secondary port for per-invocation telemetry.
+type CommandStatRepository interface {
+	// Create records one command invocation.
+	Create(ctx context.Context, stat *CommandStatRecord) error
+
+	// List retrieves invocations matching the given filters, newest first.
+	List(ctx context.Context, filters CommandStatFilters) ([]*CommandStatRecord, error)
+
+	// PruneOlderThan removes invocations older than the given number of days.
+	PruneOlderThan(ctx context.Context, days int) (int, error)
+}
+
+// CommandStatRecord represents one command invocation as stored in persistence.
+type CommandStatRecord struct {
+	ID          int64
+	Command     string
+	DurationMS  int64
+	QueryCount  int
+	QueryMS     int64
+	SlowQueries []SlowQueryRecord // Slowest first
+	Failed      bool
+	CreatedAt   string
+}
+
+// SlowQueryRecord is one of an invocation's slowest queries.
+type SlowQueryRecord struct {
+	SQL string  `json:"sql"`
+	MS  float64 `json:"ms"`
+}
+
+// CommandStatFilters contains filter options for querying invocations.
+type CommandStatFilters struct {
+	Command string // Exact command path
+	Since   string // RFC3339; empty means all time
+}
+
+// WebhookSourceRepository defines the secondary port for inbound webhook sources.
+type WebhookSourceRepository interface {
+	// Create persists a new webhook source.
+	Create(ctx context.Context, source *WebhookSourceRecord) error
+
+	// GetByName retrieves a webhook source by name.
+	GetByName(ctx context.Context, name string) (*WebhookSourceRecord, error)
+
+	// List retrieves all webhook sources ordered by name.
+	List(ctx context.Context) ([]*WebhookSourceRecord, error)
+
+	// Delete removes a webhook source.
+	Delete(ctx context.Context, name string) error
+}
+
+// WebhookSourceRecord represents a webhook source as stored in persistence.
+type WebhookSourceRecord struct {
+	Name       string
+	Kind       string // github, generic
+	SecretName string
+	Mappings   map[string][]string // Event -> actions
+	CreatedAt  string
+	UpdatedAt  string
+}
+
+// FlowStepRepository defines the secondary port for flow run progress.
+type FlowStepRepository interface {
+	// ListByRun retrieves the completed steps of a flow run, oldest first.
+	ListByRun(ctx context.Context, runKey string) ([]*FlowStepRecord, error)
+
+	// Record marks a step of a flow run completed.
+	Record(ctx context.Context, step *FlowStepRecord) error
+}
+
+// FlowStepRecord represents a completed flow step as stored in persistence.
+type FlowStepRecord struct {
+	RunKey      string
+	StepKey     string // Step ID, or ID#n for one task of a titles list
+	Output      string // ID the step created or acted on
+	CompletedAt string
+}
+
+// SummaryViewRepository defines the secondary port for saved summary views.
+// Views belong to the actor that saved them; a workbench can name one of them
+// as the default for summaries run there.
+type SummaryViewRepository interface {
+	// Save creates or replaces a view.
+	Save(ctx context.Context, view *SummaryViewRecord) error
+
+	// Get retrieves an actor's view by name.
+	Get(ctx context.Context, actorID, name string) (*SummaryViewRecord, error)
+
+	// List retrieves an actor's views, by name.
+	List(ctx context.Context, actorID string) ([]*SummaryViewRecord, error)
+
+	// Delete removes a view and any workbench defaults naming it.
+	Delete(ctx context.Context, actorID, name string) error
+
+	// SetDefault makes a view the workbench's default, replacing any other.
+	SetDefault(ctx context.Context, workbenchID, actorID, name string) error
+
+	// ClearDefault removes the workbench's default view.
+	ClearDefault(ctx context.Context, workbenchID string) error
+
+	// GetDefault retrieves the workbench's default view, or nil if it has none.
+	GetDefault(ctx context.Context, workbenchID string) (*SummaryViewRecord, error)
+}
+
+// SummaryViewRecord represents a saved summary view as stored in persistence.
+type SummaryViewRecord struct {
+	ActorID    string
+	Name       string
+	Containers string // Comma-separated container kinds (SHIP, TOME); empty for all
+	Statuses   string // Comma-separated container statuses; empty for all
+	Tags       string // Comma-separated task tag names; empty for all
+	CreatedAt  string
+	UpdatedAt  string
+}
+
+// LedgerRepository defines the secondary port for the registry of other
+// ledgers and the links made to their entities.
+type LedgerRepository interface {
+	// Add registers a ledger under an alias.
+	Add(ctx context.Context, ledger *LedgerRecord) error
+
+	// Get retrieves a registered ledger by alias.
+	Get(ctx context.Context, alias string) (*LedgerRecord, error)
+
+	// List retrieves the registered ledgers, by alias.
+	List(ctx context.Context) ([]*LedgerRecord, error)
+
+	// Delete unregisters a ledger and removes the links made to its entities.
+	Delete(ctx context.Context, alias string) error
+
+	// EntityExists reports whether a commission, shipment, task, tome, note,
+	// or plan with the ID exists in this ledger.
+	EntityExists(ctx context.Context, entityID string) (bool, error)
+
+	// AddRef links a local entity to a reference ("alias:ID").
+	AddRef(ctx context.Context, entityID, ref string) error
+
+	// RemoveRef removes a link.
+	RemoveRef(ctx context.Context, entityID, ref string) error
+
+	// ListRefs retrieves the references linked from an entity, in the order
+	// they were made.
+	ListRefs(ctx context.Context, entityID string) ([]string, error)
+}
+
+// LedgerRecord represents a registered ledger as stored in persistence.
+type LedgerRecord struct {
+	Alias     string
+	Path      string
+	CreatedAt string
+}
+
+// ForeignLedgerReader defines the secondary port for reading another ledger.
+// Implementations must never write to it.
+type ForeignLedgerReader interface {
+	// LookupEntity retrieves an entity from the ledger at path.
+	LookupEntity(ctx context.Context, path, entityID string) (*ForeignEntityRecord, error)
+
+	// Check opens the ledger at path and returns its schema version.
+	Check(ctx context.Context, path string) (int, error)
+}
+
+// ForeignEntityRecord represents an entity read from another ledger.
+type ForeignEntityRecord struct {
+	ID           string
+	Title        string
+	Status       string
+	CommissionID string // Empty for commissions
+}
@@ -0,0 +1,25 @@
+package secondary
+
+import "context"
+
+// ReleaseSource defines the secondary port for obtaining newer orc builds.
+// Releases are identified by commit.
+type ReleaseSource interface {
+	// Latest fetches the upstream branch and returns its head.
+	Latest(ctx context.Context) (*Release, error)
+
+	// Contains reports whether commit is in the history of releaseCommit.
+	// It fails if commit is unknown to the source.
+	Contains(ctx context.Context, releaseCommit, commit string) (bool, error)
+
+	// Install builds releaseCommit and atomically replaces the binary at
+	// executablePath, keeping the old binary beside it as <path>.previous.
+	Install(ctx context.Context, releaseCommit, executablePath string) error
+}
+
+// Release describes an available orc build.
+type Release struct {
+	Commit      string // Full commit hash
+	Subject     string
+	CommittedAt string
+}
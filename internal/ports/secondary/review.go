@@ -0,0 +1,36 @@
+package secondary
+
+import "context"
+
+// PRReviewSource defines the secondary port for fetching pull request reviews
+// from the hosting service.
+type PRReviewSource interface {
+	// FetchReviews returns the reviews and inline review comments of pull
+	// request number in repo ("owner/name"), reviews first.
+	FetchReviews(ctx context.Context, repo string, number int) ([]*FetchedReview, error)
+}
+
+// FetchedReview is a review or inline review comment as reported by the host.
+type FetchedReview struct {
+	ExternalID       string // 'review:<id>' or 'comment:<id>'
+	Kind             string // 'review', 'comment'
+	ReviewExternalID string // Comments: the review they were submitted with
+	InReplyTo        bool   // Comments: a reply within an existing thread
+	Author           string
+	State            string // Reviews: APPROVED, CHANGES_REQUESTED, COMMENTED, DISMISSED
+	Body             string
+	Path             string
+	Line             int
+	URL              string
+	SubmittedAt      string
+}
+
+// PRBodyStore defines the secondary port for reading and writing a pull
+// request's description on the hosting service.
+type PRBodyStore interface {
+	// FetchBody returns the description of pull request number in repo.
+	FetchBody(ctx context.Context, repo string, number int) (string, error)
+
+	// UpdateBody replaces the description of pull request number in repo.
+	UpdateBody(ctx context.Context, repo string, number int, body string) error
+}
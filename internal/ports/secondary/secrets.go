@@ -0,0 +1,10 @@
+package secondary
+
+// SecretCipher defines the secondary port for encrypting secret values at rest.
+type SecretCipher interface {
+	// Encrypt returns an opaque, printable ciphertext for plaintext.
+	Encrypt(plaintext string) (string, error)
+
+	// Decrypt reverses Encrypt. It fails if the ciphertext was produced with a different key.
+	Decrypt(ciphertext string) (string, error)
+}
@@ -1,7 +1,10 @@
 // Package secondary defines the secondary ports (driven adapters) for the application.
 package secondary
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // WorkspaceAdapter defines the secondary port for filesystem and git worktree operations.
 type WorkspaceAdapter interface {
@@ -15,8 +18,64 @@ type WorkspaceAdapter interface {
 	RemoveDirectory(ctx context.Context, path string) error
 	DirectoryExists(ctx context.Context, path string) (bool, error)
 
+	// Bootstrap operations
+	// RunBootstrap executes a shell script inside workdir and returns its combined output.
+	RunBootstrap(ctx context.Context, workdir, script string) (string, error)
+
+	// Commit history
+	// ListBranchCommits returns commits reachable from HEAD in workdir but not from baseRef.
+	ListBranchCommits(ctx context.Context, workdir, baseRef string) ([]CommitInfo, error)
+	// ListBranchCommitFiles returns the files touched by each branch commit, keyed by SHA.
+	ListBranchCommitFiles(ctx context.Context, workdir, baseRef string) (map[string][]string, error)
+
+	// ListWorkingChanges returns the paths with uncommitted changes in workdir,
+	// including untracked files.
+	ListWorkingChanges(ctx context.Context, workdir string) ([]string, error)
+
+	// Stash operations
+	// StashWorkingChanges stashes all uncommitted changes in workdir, untracked
+	// files included, and returns the stash commit SHA.
+	StashWorkingChanges(ctx context.Context, workdir, message string) (string, error)
+	// ApplyStash applies a stash commit to workdir. The stash entry is kept.
+	ApplyStash(ctx context.Context, workdir, sha string) error
+	// DropStash removes the stash entry for sha, if it is still in the stash list.
+	DropStash(ctx context.Context, workdir, sha string) error
+
+	// Branch diff
+	// DiffBranchStat returns per-file line counts for HEAD against its merge base with baseRef.
+	DiffBranchStat(ctx context.Context, workdir, baseRef string) ([]FileChange, error)
+	// DiffBranchPatch returns the unified diff against the merge base, limited to paths if given.
+	DiffBranchPatch(ctx context.Context, workdir, baseRef string, paths []string) (string, error)
+
+	// Repository inspection
+	// IsGitRepository reports whether path is inside a git repository.
+	IsGitRepository(ctx context.Context, path string) (bool, error)
+	// GetRemoteURL returns the URL of a remote in repoPath, or "" if it is not configured.
+	GetRemoteURL(ctx context.Context, repoPath, remote string) (string, error)
+	// BranchExists reports whether branch exists locally or as a remote-tracking branch of origin.
+	BranchExists(ctx context.Context, repoPath, branch string) (bool, error)
+	// ListWorktrees returns the paths of the worktrees registered with repoPath,
+	// excluding the main working tree.
+	ListWorktrees(ctx context.Context, repoPath string) ([]string, error)
+
 	// Path resolution
 	GetWorktreesBasePath() string
 	GetRepoPath(repoName string) string
 	ResolveWorkbenchPath(workbenchName string) string
 }
+
+// FileChange describes one file's changes in a diff.
+type FileChange struct {
+	Path    string
+	Added   int
+	Deleted int
+	Binary  bool // Line counts are zero for binary files
+}
+
+// CommitInfo describes a single git commit.
+type CommitInfo struct {
+	SHA         string
+	Subject     string
+	Message     string // Full message (subject and body)
+	CommittedAt time.Time
+}
@@ -76,13 +76,40 @@ func (g *Generator) GenerateEntity(spec *EntitySpec) (*GeneratorResult, error) {
 
 	// Add next steps
 	result.NextSteps = []string{
-		fmt.Sprintf("Run 'orc scaffold migration create_%s_table' to create DB migration", spec.NamePlural),
+		fmt.Sprintf("Add a %s table to internal/db/schema.sql (or use 'orc scaffold adapter')", spec.NamePlural),
 		"Run 'make dev && ./orc " + spec.NameSnake + " --help' to test",
 	}
 
 	return result, nil
 }
 
+// GenerateAdapter generates the entity stack plus its schema table, for
+// entities defined in a spec file. The table is appended to schema.sql.
+func (g *Generator) GenerateAdapter(spec *EntitySpec) (*GeneratorResult, error) {
+	result, err := g.GenerateEntity(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := g.renderTemplate("schema_snippet.sql", spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render schema_snippet.sql: %w", err)
+	}
+	result.Files = append(result.Files, GeneratedFile{
+		Path:      "internal/db/schema.sql",
+		Snippet:   content,
+		Operation: "append",
+	})
+
+	result.NextSteps = []string{
+		"Bump SchemaVersion in internal/db/schema.go",
+		"Paste the port, wire, and main.go snippets printed above",
+		"Run 'make test' to check the repository against the new table",
+		"Run 'make dev && ./orc " + spec.NameSnake + " --help' to test",
+	}
+	return result, nil
+}
+
 // renderTemplate renders an entity template.
 func (g *Generator) renderTemplate(name string, spec *EntitySpec) (string, error) {
 	tmplContent, err := scaffoldtmpl.GetEntityTemplate(name)
@@ -0,0 +1,58 @@
+package scaffold
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+)
+
+// ParseSpecFile parses an entity definition file into an EntitySpec.
+//
+// The format is one "key: value" per line; blank lines and lines starting
+// with # are ignored. Keys mirror the 'scaffold entity' flags, and "fields"
+// may repeat to spread a long field list over several lines:
+//
+//	name: widget
+//	id-prefix: WDG
+//	fields: name:string, value:int
+//	fields: due_at:time?
+//	status: draft, active, done
+//	parent: shipment:n:1
+func ParseSpecFile(content string) (*EntitySpec, error) {
+	values := make(map[string]string)
+	var fields []string
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			return nil, fmt.Errorf("line %d: expected 'key: value', got %q", lineNo, line)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "fields":
+			fields = append(fields, value)
+		case "name", "id-prefix", "status", "parent":
+			if _, dup := values[key]; dup {
+				return nil, fmt.Errorf("line %d: %q given twice", lineNo, key)
+			}
+			values[key] = value
+		default:
+			return nil, fmt.Errorf("line %d: unknown key %q (valid: name, id-prefix, fields, status, parent)", lineNo, key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return BuildEntitySpec(values["name"], strings.Join(fields, ","), values["status"], values["id-prefix"], values["parent"])
+}
@@ -0,0 +1,88 @@
+package scaffold
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseSpecFile(t *testing.T) {
+	spec, err := ParseSpecFile(`# Work orders hang off shipments
+name: work_order
+id-prefix: WO
+
+fields: outcome:string, cost:int
+fields: due_at:time?
+status: draft, active, complete
+parent: shipment:1:1
+`)
+	if err != nil {
+		t.Fatalf("ParseSpecFile() error = %v", err)
+	}
+
+	if spec.Name != "WorkOrder" || spec.IDPrefix != "WO" {
+		t.Errorf("Name, IDPrefix = %q, %q, want WorkOrder, WO", spec.Name, spec.IDPrefix)
+	}
+	if len(spec.Fields) != 3 || !spec.Fields[2].Nullable {
+		t.Errorf("expected 3 fields with a nullable due_at, got %+v", spec.Fields)
+	}
+	if len(spec.StatusValues) != 3 || !spec.HasParent || spec.ParentCardinality != "1:1" {
+		t.Errorf("unexpected status/parent: %+v", spec)
+	}
+}
+
+func TestParseSpecFile_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"missing name", "fields: a:string", "entity name is required"},
+		{"unknown key", "name: widget\ncolour: blue", `line 2: unknown key "colour"`},
+		{"no colon", "name widget", "line 1: expected 'key: value'"},
+		{"duplicate key", "name: a\nname: b", `line 2: "name" given twice`},
+		{"bad field", "name: widget\nfields: a:float", "unknown type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSpecFile(tt.content)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ParseSpecFile() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateAdapter_SchemaTable(t *testing.T) {
+	spec, err := BuildEntitySpec("work_order", "outcome:string,due_at:time?", "draft,done", "WO", "shipment:1:1")
+	if err != nil {
+		t.Fatalf("BuildEntitySpec() error = %v", err)
+	}
+
+	result, err := NewGenerator().GenerateAdapter(spec)
+	if err != nil {
+		t.Fatalf("GenerateAdapter() error = %v", err)
+	}
+
+	var schema *GeneratedFile
+	for i, f := range result.Files {
+		if f.Path == "internal/db/schema.sql" {
+			schema = &result.Files[i]
+		}
+	}
+	if schema == nil || schema.Operation != "append" {
+		t.Fatalf("expected schema.sql append, got %+v", result.Files)
+	}
+
+	for _, want := range []string{
+		"CREATE TABLE IF NOT EXISTS work_orders (",
+		"shipment_id TEXT NOT NULL UNIQUE,",
+		"outcome TEXT NOT NULL,",
+		"due_at DATETIME,",
+		"status TEXT NOT NULL DEFAULT 'draft' CHECK(status IN ('draft', 'done')),",
+		"updated_at DATETIME DEFAULT CURRENT_TIMESTAMP,\n\tFOREIGN KEY (shipment_id) REFERENCES shipments(id) ON DELETE CASCADE\n);",
+	} {
+		if !strings.Contains(schema.Snippet, want) {
+			t.Errorf("schema snippet missing %q:\n%s", want, schema.Snippet)
+		}
+	}
+}
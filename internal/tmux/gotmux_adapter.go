@@ -36,7 +36,8 @@ func escapeShellCommand(cmd string) string {
 // CreateWorkbenchSession creates a tmux session with a 3-pane workbench window.
 // Layout: vim (left) | goblin (top-right) / shell (bottom-right)
 // Uses NewSession + AddWorkbenchWindow for the initial window.
-func (g *GotmuxAdapter) CreateWorkbenchSession(sessionName, workbenchName, workbenchPath, workbenchID, workshopID string) error {
+// env (KEY=value pairs) is injected into the vim and shell panes.
+func (g *GotmuxAdapter) CreateWorkbenchSession(sessionName, workbenchName, workbenchPath, workbenchID, workshopID string, env []string) error {
 	// Create session with plain shell (no ShellCommand — AddWorkbenchWindow handles pane setup)
 	session, err := g.tmux.NewSession(&gotmux.SessionOptions{
 		Name:           sessionName,
@@ -57,13 +58,14 @@ func (g *GotmuxAdapter) CreateWorkbenchSession(sessionName, workbenchName, workb
 	firstWindow := windows[0]
 
 	// Set up the 3-pane layout on the existing first window
-	return g.setupWorkbenchPanes(firstWindow, workbenchName, workbenchPath, workbenchID, workshopID)
+	return g.setupWorkbenchPanes(firstWindow, workbenchName, workbenchPath, workbenchID, workshopID, env)
 }
 
 // AddWorkbenchWindow creates a new window on an existing session with a 3-pane workbench layout.
 // Layout: vim (left) | goblin (top-right) / shell (bottom-right)
 // Pane options (@pane_role, @bench_id, @workshop_id) are set on all three panes.
-func (g *GotmuxAdapter) AddWorkbenchWindow(session *gotmux.Session, workbenchName, workbenchPath, workbenchID, workshopID string) error {
+// env (KEY=value pairs) is injected into the vim and shell panes.
+func (g *GotmuxAdapter) AddWorkbenchWindow(session *gotmux.Session, workbenchName, workbenchPath, workbenchID, workshopID string, env []string) error {
 	// Create new window (no ShellCommand available on NewWindowOptions)
 	window, err := session.NewWindow(&gotmux.NewWindowOptions{
 		WindowName:     workbenchName,
@@ -74,13 +76,15 @@ func (g *GotmuxAdapter) AddWorkbenchWindow(session *gotmux.Session, workbenchNam
 		return fmt.Errorf("failed to create window %s: %w", workbenchName, err)
 	}
 
-	return g.setupWorkbenchPanes(window, workbenchName, workbenchPath, workbenchID, workshopID)
+	return g.setupWorkbenchPanes(window, workbenchName, workbenchPath, workbenchID, workshopID, env)
 }
 
 // setupWorkbenchPanes configures a window with the standard 3-pane workbench layout.
 // The window must already exist with at least one pane. It will be renamed to workbenchName
 // and populated with: vim (left) | goblin (top-right) / shell (bottom-right).
-func (g *GotmuxAdapter) setupWorkbenchPanes(window *gotmux.Window, workbenchName, workbenchPath, workbenchID, workshopID string) error {
+// The goblin pane gets no env here: orc connect resolves the workbench env
+// itself on every start, so respawned agents pick up changes.
+func (g *GotmuxAdapter) setupWorkbenchPanes(window *gotmux.Window, workbenchName, workbenchPath, workbenchID, workshopID string, env []string) error {
 	// Rename window to workbench name
 	if err := window.Rename(workbenchName); err != nil {
 		return fmt.Errorf("failed to rename window: %w", err)
@@ -95,7 +99,8 @@ func (g *GotmuxAdapter) setupWorkbenchPanes(window *gotmux.Window, workbenchName
 
 	// Make vim the root process of the first pane via respawn-pane -k
 	// (NewWindowOptions doesn't support ShellCommand, so we respawn)
-	if err := exec.Command("tmux", "respawn-pane", "-t", vimPane.Id, "-k", "vim").Run(); err != nil {
+	vimArgs := append([]string{"respawn-pane", "-t", vimPane.Id, "-k"}, envArgs(env)...)
+	if err := exec.Command("tmux", append(vimArgs, "vim")...).Run(); err != nil {
 		return fmt.Errorf("failed to respawn vim pane: %w", err)
 	}
 
@@ -130,6 +135,20 @@ func (g *GotmuxAdapter) setupWorkbenchPanes(window *gotmux.Window, workbenchName
 	}
 	shellPane := panes[2]
 
+	// SplitWindowOptions can't set env, so respawn the fresh shell with it
+	if len(env) > 0 {
+		shellArgs := append([]string{"respawn-pane", "-t", shellPane.Id, "-k"}, envArgs(env)...)
+		if err := exec.Command("tmux", shellArgs...).Run(); err != nil {
+			return fmt.Errorf("failed to respawn shell pane with env: %w", err)
+		}
+	}
+
+	// Keep panes whose process exits in place (dead) so 'orc tmux watch' can
+	// respawn them with the right command instead of the layout collapsing.
+	if err := window.SetOption("remain-on-exit", "on"); err != nil {
+		return fmt.Errorf("failed to set remain-on-exit: %w", err)
+	}
+
 	// Set main-pane-width BEFORE applying layout — tmux uses the current option
 	// value at layout-selection time, so the option must be set first.
 	if err := window.SetOption("main-pane-width", "50%"); err != nil {
@@ -166,6 +185,15 @@ func (g *GotmuxAdapter) setupWorkbenchPanes(window *gotmux.Window, workbenchName
 	return nil
 }
 
+// envArgs converts KEY=value pairs into tmux -e flags.
+func envArgs(env []string) []string {
+	args := make([]string, 0, 2*len(env))
+	for _, kv := range env {
+		args = append(args, "-e", kv)
+	}
+	return args
+}
+
 // GetSession returns a gotmux Session by name, or nil if not found.
 // Returns (nil, nil) when the tmux server is not running, allowing callers
 // like PlanApply to treat a dead server as "no sessions exist".
@@ -234,6 +262,7 @@ type ApplyAction struct {
 	WorkbenchPath string
 	WorkbenchID   string
 	WorkshopID    string
+	Env           []string // KEY=value pairs for new workbench panes
 }
 
 // DesiredWorkbench describes a workbench that should exist as a window.
@@ -242,6 +271,7 @@ type DesiredWorkbench struct {
 	Path       string
 	ID         string
 	WorkshopID string
+	Env        []string // KEY=value pairs injected into the workbench's panes
 }
 
 // ApplyPlan contains the full reconciliation plan.
@@ -289,6 +319,7 @@ func (g *GotmuxAdapter) PlanApply(sessionName string, workbenches []DesiredWorkb
 			WorkbenchPath: first.Path,
 			WorkbenchID:   first.ID,
 			WorkshopID:    first.WorkshopID,
+			Env:           first.Env,
 		})
 
 		// Remaining workbenches get added as windows
@@ -301,6 +332,7 @@ func (g *GotmuxAdapter) PlanApply(sessionName string, workbenches []DesiredWorkb
 				WorkbenchPath: wb.Path,
 				WorkbenchID:   wb.ID,
 				WorkshopID:    wb.WorkshopID,
+				Env:           wb.Env,
 			})
 		}
 
@@ -339,6 +371,7 @@ func (g *GotmuxAdapter) PlanApply(sessionName string, workbenches []DesiredWorkb
 				WorkbenchPath: wb.Path,
 				WorkbenchID:   wb.ID,
 				WorkshopID:    wb.WorkshopID,
+				Env:           wb.Env,
 			})
 		}
 	}
@@ -442,7 +475,7 @@ func (g *GotmuxAdapter) ExecutePlan(plan *ApplyPlan) error {
 func (g *GotmuxAdapter) executeAction(action ApplyAction) error {
 	switch action.Type {
 	case ActionCreateSession:
-		return g.CreateWorkbenchSession(action.SessionName, action.WorkbenchName, action.WorkbenchPath, action.WorkbenchID, action.WorkshopID)
+		return g.CreateWorkbenchSession(action.SessionName, action.WorkbenchName, action.WorkbenchPath, action.WorkbenchID, action.WorkshopID, action.Env)
 
 	case ActionAddWindow:
 		session, err := g.GetSession(action.SessionName)
@@ -452,7 +485,7 @@ func (g *GotmuxAdapter) executeAction(action ApplyAction) error {
 		if session == nil {
 			return fmt.Errorf("session %s not found", action.SessionName)
 		}
-		return g.AddWorkbenchWindow(session, action.WorkbenchName, action.WorkbenchPath, action.WorkbenchID, action.WorkshopID)
+		return g.AddWorkbenchWindow(session, action.WorkbenchName, action.WorkbenchPath, action.WorkbenchID, action.WorkshopID, action.Env)
 
 	case ActionRelocateGuests:
 		return RefreshWorkbenchLayout(action.SessionName, action.WindowName)
@@ -515,6 +548,11 @@ func (g *GotmuxAdapter) reconcileLayout(sessionName, windowName string) error {
 		return fmt.Errorf("window %s not found", windowName)
 	}
 
+	// Windows created before remain-on-exit was set get it here
+	if err := window.SetOption("remain-on-exit", "on"); err != nil {
+		return fmt.Errorf("failed to set remain-on-exit: %w", err)
+	}
+
 	// Set main-pane-width BEFORE applying layout — tmux uses the current option
 	// value at layout-selection time, so the option must be set first.
 	if err := window.SetOption("main-pane-width", "50%"); err != nil {
@@ -529,6 +567,94 @@ func (g *GotmuxAdapter) reconcileLayout(sessionName, windowName string) error {
 	return nil
 }
 
+// DeadPane describes a workbench pane whose process exited.
+type DeadPane struct {
+	WindowName  string
+	PaneID      string
+	Role        string // @pane_role: vim, goblin, or shell
+	WorkbenchID string
+	ExitStatus  int
+	ExitSignal  int // Non-zero when the process was killed by a signal
+}
+
+// Reason describes how the pane's process ended, e.g. "exited 1".
+func (d DeadPane) Reason() string {
+	if d.ExitSignal != 0 {
+		return fmt.Sprintf("killed by signal %d", d.ExitSignal)
+	}
+	return fmt.Sprintf("exited %d", d.ExitStatus)
+}
+
+// RespawnDeadPanes restarts dead vim, goblin, and shell panes in the windows
+// of the given workbenches with the command and environment they were
+// created with, and returns the panes it respawned. Guest panes and windows
+// of other workbenches are left alone.
+func (g *GotmuxAdapter) RespawnDeadPanes(sessionName string, workbenches []DesiredWorkbench) ([]DeadPane, error) {
+	session, err := g.GetSession(sessionName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check session: %w", err)
+	}
+	if session == nil {
+		return nil, fmt.Errorf("session %s not found", sessionName)
+	}
+
+	var respawned []DeadPane
+	for _, wb := range workbenches {
+		window, err := session.GetWindowByName(wb.Name)
+		if err != nil || window == nil {
+			continue
+		}
+		panes, err := window.ListPanes()
+		if err != nil {
+			return respawned, fmt.Errorf("failed to list panes of %s: %w", wb.Name, err)
+		}
+
+		for _, p := range panes {
+			if !p.Dead {
+				continue
+			}
+			opt, err := p.Option("@pane_role")
+			if err != nil || opt == nil {
+				continue
+			}
+			command := respawnCommand(opt.Value, wb.Path, wb.Env)
+			if command == nil {
+				continue
+			}
+			args := append([]string{"respawn-pane", "-t", p.Id, "-k"}, command...)
+			if err := exec.Command("tmux", args...).Run(); err != nil {
+				return respawned, fmt.Errorf("failed to respawn %s pane in %s: %w", opt.Value, wb.Name, err)
+			}
+			respawned = append(respawned, DeadPane{
+				WindowName:  wb.Name,
+				PaneID:      p.Id,
+				Role:        opt.Value,
+				WorkbenchID: wb.ID,
+				ExitStatus:  p.DeadStatus,
+				ExitSignal:  p.DeadSignal,
+			})
+		}
+	}
+	return respawned, nil
+}
+
+// respawnCommand returns the respawn-pane arguments (after the target) that
+// recreate a workbench pane of the given role, matching setupWorkbenchPanes.
+// Returns nil for roles orc did not create.
+func respawnCommand(role, workbenchPath string, env []string) []string {
+	args := []string{"-c", workbenchPath}
+	switch role {
+	case "vim":
+		return append(append(args, envArgs(env)...), "vim")
+	case "goblin":
+		// orc connect resolves the workbench env itself
+		return append(args, "orc", "connect")
+	case "shell":
+		return append(args, envArgs(env)...)
+	}
+	return nil
+}
+
 // AttachInstructions returns instructions for attaching to a session
 func (g *GotmuxAdapter) AttachInstructions(sessionName string) string {
 	return fmt.Sprintf("Attach to session: tmux attach -t %s\n\n"+
@@ -1,6 +1,7 @@
 package tmux
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -54,6 +55,35 @@ func TestAttachInstructions(t *testing.T) {
 	}
 }
 
+func TestRespawnCommand(t *testing.T) {
+	env := []string{"ORC_WORKBENCH=BENCH-003"}
+	tests := []struct {
+		role string
+		want string
+	}{
+		{"vim", "-c /wb/api -e ORC_WORKBENCH=BENCH-003 vim"},
+		{"goblin", "-c /wb/api orc connect"},
+		{"shell", "-c /wb/api -e ORC_WORKBENCH=BENCH-003"},
+		{"", ""},
+		{"guest", ""},
+	}
+	for _, tt := range tests {
+		got := strings.Join(respawnCommand(tt.role, "/wb/api", env), " ")
+		if got != tt.want {
+			t.Errorf("respawnCommand(%q) = %q, want %q", tt.role, got, tt.want)
+		}
+	}
+}
+
+func TestDeadPaneReason(t *testing.T) {
+	if got := (DeadPane{ExitStatus: 1}).Reason(); got != "exited 1" {
+		t.Errorf("Reason() = %q", got)
+	}
+	if got := (DeadPane{ExitStatus: 137, ExitSignal: 9}).Reason(); got != "killed by signal 9" {
+		t.Errorf("Reason() = %q", got)
+	}
+}
+
 func contains(s, substr string) bool {
 	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) && findSubstring(s, substr))
 }
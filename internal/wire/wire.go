@@ -3,25 +3,31 @@
 package wire
 
 import (
+	"fmt"
 	"io"
 	"log"
 	"os"
+	"path/filepath"
 	"sync"
 
 	cliadapter "github.com/example/orc/internal/adapters/cli"
 	"github.com/example/orc/internal/adapters/filesystem"
+	"github.com/example/orc/internal/adapters/github"
 	"github.com/example/orc/internal/adapters/persistence"
 	"github.com/example/orc/internal/adapters/sqlite"
 	tmuxadapter "github.com/example/orc/internal/adapters/tmux"
 	"github.com/example/orc/internal/app"
+	"github.com/example/orc/internal/config"
 	"github.com/example/orc/internal/db"
 	"github.com/example/orc/internal/ports/primary"
 	"github.com/example/orc/internal/ports/secondary"
+	"github.com/example/orc/internal/version"
 )
 
 var (
 	commissionService              primary.CommissionService
 	shipmentService                primary.ShipmentService
+	autorunService                 primary.AutorunService
 	taskService                    primary.TaskService
 	noteService                    primary.NoteService
 	tomeService                    primary.TomeService
@@ -29,18 +35,87 @@ var (
 	tagService                     primary.TagService
 	repoService                    primary.RepoService
 	prService                      primary.PRService
+	prReviewService                primary.PRReviewService
+	prDescriptionService           primary.PRDescriptionService
 	factoryService                 primary.FactoryService
+	factoryTeardownService         primary.FactoryTeardownService
 	workshopService                primary.WorkshopService
 	workbenchService               primary.WorkbenchService
 	summaryService                 primary.SummaryService
 	logService                     primary.LogService
 	hookEventService               primary.HookEventService
+	undoService                    primary.UndoService
+	commitLinkService              primary.CommitLinkService
+	metricsService                 primary.MetricsService
+	webhookService                 primary.WebhookService
+	aliasService                   primary.AliasService
+	importService                  primary.ImportService
+	flowService                    primary.FlowService
+	summaryViewService             primary.SummaryViewService
+	ledgerService                  primary.LedgerService
+	secretService                  primary.SecretService
+	commentService                 primary.CommentService
+	workbenchEnvService            primary.WorkbenchEnvService
+	workbenchStashService          primary.WorkbenchStashService
+	workbenchTeardownService       primary.WorkbenchTeardownService
+	recallService                  primary.RecallService
+	telemetryService               primary.TelemetryService
+	budgetService                  primary.BudgetService
+	glossaryService                primary.GlossaryService
+	lockService                    primary.LockService
+	rateLimitService               primary.RateLimitService
+	presenceService                primary.PresenceService
 	commissionOrchestrationService *app.CommissionOrchestrationService
 	tmuxService                    secondary.TMuxAdapter
 	shipmentRepo                   secondary.ShipmentRepository
+	planRecorder                   *app.IntentRecorder // Set by EnablePlanMode
 	once                           sync.Once
 )
 
+// ImportSources describes the trackers ImportService imports from. It needs
+// no services, so command setup can call it without opening the ledger.
+func ImportSources() []primary.ImportSource {
+	return app.ImportSources()
+}
+
+// IsEntityID reports whether s is shaped like an entity ID. Like
+// ImportSources, it needs no services.
+func IsEntityID(s string) bool {
+	return app.IsEntityID(s)
+}
+
+// IsLedgerRef reports whether s is shaped like a reference to another
+// ledger's entity ("acme:SHIP-004"). Like ImportSources, it needs no services.
+func IsLedgerRef(s string) bool {
+	return app.IsLedgerRef(s)
+}
+
+// EnablePlanMode makes the services record git, tmux, and file changes
+// instead of making them, for --plan runs. Like db.EnableSimulation, it must
+// be called before the first service is used.
+func EnablePlanMode() {
+	planRecorder = app.NewIntentRecorder()
+}
+
+// RecordPlanIntent notes a change a command makes itself, outside the
+// services, so --plan runs can report it. It reports false, recording
+// nothing, when no plan is active and the caller should go ahead.
+func RecordPlanIntent(kind, format string, args ...any) bool {
+	if planRecorder == nil {
+		return false
+	}
+	planRecorder.Record(kind, format, args...)
+	return true
+}
+
+// PlanIntents returns what a --plan run would have done outside the ledger.
+func PlanIntents() []primary.Intent {
+	if planRecorder == nil {
+		return nil
+	}
+	return planRecorder.Intents()
+}
+
 // CommissionService returns the singleton CommissionService instance.
 func CommissionService() primary.CommissionService {
 	once.Do(initServices)
@@ -53,6 +128,12 @@ func ShipmentService() primary.ShipmentService {
 	return shipmentService
 }
 
+// AutorunService returns the singleton AutorunService instance.
+func AutorunService() primary.AutorunService {
+	once.Do(initServices)
+	return autorunService
+}
+
 // TaskService returns the singleton TaskService instance.
 func TaskService() primary.TaskService {
 	once.Do(initServices)
@@ -95,12 +176,30 @@ func PRService() primary.PRService {
 	return prService
 }
 
+// PRReviewService returns the singleton PRReviewService instance.
+func PRReviewService() primary.PRReviewService {
+	once.Do(initServices)
+	return prReviewService
+}
+
+// PRDescriptionService returns the singleton PRDescriptionService instance.
+func PRDescriptionService() primary.PRDescriptionService {
+	once.Do(initServices)
+	return prDescriptionService
+}
+
 // FactoryService returns the singleton FactoryService instance.
 func FactoryService() primary.FactoryService {
 	once.Do(initServices)
 	return factoryService
 }
 
+// FactoryTeardownService returns the singleton FactoryTeardownService instance.
+func FactoryTeardownService() primary.FactoryTeardownService {
+	once.Do(initServices)
+	return factoryTeardownService
+}
+
 // WorkshopService returns the singleton WorkshopService instance.
 func WorkshopService() primary.WorkshopService {
 	once.Do(initServices)
@@ -131,6 +230,158 @@ func HookEventService() primary.HookEventService {
 	return hookEventService
 }
 
+// UndoService returns the singleton UndoService instance.
+func UndoService() primary.UndoService {
+	once.Do(initServices)
+	return undoService
+}
+
+// CommitLinkService returns the singleton CommitLinkService instance.
+func CommitLinkService() primary.CommitLinkService {
+	once.Do(initServices)
+	return commitLinkService
+}
+
+// SecretService returns the singleton SecretService instance.
+// Adapters that need credentials resolve them here rather than reading
+// environment variables or config files directly.
+func SecretService() primary.SecretService {
+	once.Do(initServices)
+	return secretService
+}
+
+// AliasService returns the singleton AliasService instance.
+func AliasService() primary.AliasService {
+	once.Do(initServices)
+	return aliasService
+}
+
+// ImportService returns the singleton ImportService instance.
+func ImportService() primary.ImportService {
+	once.Do(initServices)
+	return importService
+}
+
+// FlowService returns the singleton FlowService instance.
+func FlowService() primary.FlowService {
+	once.Do(initServices)
+	return flowService
+}
+
+// SummaryViewService returns the singleton SummaryViewService instance.
+func SummaryViewService() primary.SummaryViewService {
+	once.Do(initServices)
+	return summaryViewService
+}
+
+// LedgerService returns the singleton LedgerService instance.
+func LedgerService() primary.LedgerService {
+	once.Do(initServices)
+	return ledgerService
+}
+
+// WorkbenchEnvService returns the singleton WorkbenchEnvService instance.
+func WorkbenchEnvService() primary.WorkbenchEnvService {
+	once.Do(initServices)
+	return workbenchEnvService
+}
+
+// WorkbenchStashService returns the singleton WorkbenchStashService instance.
+func WorkbenchStashService() primary.WorkbenchStashService {
+	once.Do(initServices)
+	return workbenchStashService
+}
+
+// WorkbenchTeardownService returns the singleton WorkbenchTeardownService instance.
+func WorkbenchTeardownService() primary.WorkbenchTeardownService {
+	once.Do(initServices)
+	return workbenchTeardownService
+}
+
+// RecallService returns the singleton RecallService instance.
+func RecallService() primary.RecallService {
+	once.Do(initServices)
+	return recallService
+}
+
+// TelemetryService returns the singleton TelemetryService instance.
+func TelemetryService() primary.TelemetryService {
+	once.Do(initServices)
+	return telemetryService
+}
+
+// BudgetService returns the singleton BudgetService instance.
+func BudgetService() primary.BudgetService {
+	once.Do(initServices)
+	return budgetService
+}
+
+// GlossaryService returns the singleton GlossaryService instance.
+func GlossaryService() primary.GlossaryService {
+	once.Do(initServices)
+	return glossaryService
+}
+
+// LockService returns the singleton LockService instance.
+func LockService() primary.LockService {
+	once.Do(initServices)
+	return lockService
+}
+
+// RateLimitService returns the singleton RateLimitService instance.
+func RateLimitService() primary.RateLimitService {
+	once.Do(initServices)
+	return rateLimitService
+}
+
+// PresenceService returns the singleton PresenceService instance.
+func PresenceService() primary.PresenceService {
+	once.Do(initServices)
+	return presenceService
+}
+
+// CommentService returns the singleton CommentService instance.
+func CommentService() primary.CommentService {
+	once.Do(initServices)
+	return commentService
+}
+
+// MetricsService returns the singleton MetricsService instance.
+func MetricsService() primary.MetricsService {
+	once.Do(initServices)
+	return metricsService
+}
+
+// WebhookService returns the singleton WebhookService instance.
+func WebhookService() primary.WebhookService {
+	once.Do(initServices)
+	return webhookService
+}
+
+// UpgradeService returns an UpgradeService for the running binary, building
+// releases from the orc source checkout ($ORC_SOURCE_DIR or ~/src/orc).
+// It does not open the ledger, so upgrading works even when the ledger can't.
+func UpgradeService() (primary.UpgradeService, error) {
+	executable, err := os.Executable()
+	if err != nil {
+		return nil, fmt.Errorf("failed to locate running binary: %w", err)
+	}
+	if executable, err = filepath.EvalSymlinks(executable); err != nil {
+		return nil, fmt.Errorf("failed to resolve running binary: %w", err)
+	}
+
+	sourceDir := os.Getenv(config.EnvSourceDir)
+	if sourceDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get home directory: %w", err)
+		}
+		sourceDir = filepath.Join(home, "src", "orc")
+	}
+
+	return app.NewUpgradeService(filesystem.NewGitReleaseSource(sourceDir), version.Commit, executable), nil
+}
+
 // CommissionOrchestrationService returns the singleton CommissionOrchestrationService instance.
 func CommissionOrchestrationService() *app.CommissionOrchestrationService {
 	once.Do(initServices)
@@ -167,18 +418,28 @@ func initServices() {
 	// Create repository adapters (secondary ports) - sqlite adapters with injected DB
 	commissionRepo := sqlite.NewCommissionRepository(database, logWriter)
 	agentProvider := persistence.NewAgentIdentityProvider()
-	tmuxAdapter := tmuxadapter.NewAdapter()
-	tmuxService = tmuxAdapter // Store for getter
+	var tmuxAdapter secondary.TMuxAdapter = tmuxadapter.NewAdapter()
 
 	// Create workspace adapter (needed by effect executor and workshop service)
 	home, _ := os.UserHomeDir()
-	workspaceAdapter, err := filesystem.NewWorkspaceAdapter(home+"/wb", home+"/src") // ~/wb for worktrees, ~/src for repos
+	var workspaceAdapter secondary.WorkspaceAdapter
+	workspaceAdapter, err = filesystem.NewWorkspaceAdapter(home+"/wb", home+"/src") // ~/wb for worktrees, ~/src for repos
 	if err != nil {
 		log.Fatalf("failed to create workspace adapter: %v", err)
 	}
 
+	// Plan mode: git, tmux, and file changes are recorded instead of made
+	if planRecorder != nil {
+		tmuxAdapter = app.NewPlanTMuxAdapter(tmuxAdapter, planRecorder)
+		workspaceAdapter = app.NewPlanWorkspaceAdapter(workspaceAdapter, planRecorder)
+	}
+	tmuxService = tmuxAdapter // Store for getter
+
 	// Create effect executor with injected repositories and adapters
-	executor := app.NewEffectExecutor(commissionRepo, tmuxAdapter, workspaceAdapter)
+	var executor app.EffectExecutor = app.NewEffectExecutor(commissionRepo, tmuxAdapter, workspaceAdapter)
+	if planRecorder != nil {
+		executor = app.NewPlanEffectExecutor(executor, planRecorder)
+	}
 
 	// Create services (primary ports implementation)
 	commissionService = app.NewCommissionService(commissionRepo, agentProvider, executor)
@@ -187,28 +448,45 @@ func initServices() {
 	shipmentRepo = sqlite.NewShipmentRepository(database, logWriter)
 	taskRepo := sqlite.NewTaskRepository(database, logWriter)
 	tagRepo := sqlite.NewTagRepository(database)
-	taskService = app.NewTaskService(taskRepo, tagRepo, shipmentRepo)
+	tagRouteRepo := sqlite.NewTagRouteRepository(database)
+	taskService = app.NewTaskService(taskRepo, tagRepo, shipmentRepo, tagRouteRepo)
+	budgetService = app.NewBudgetService(sqlite.NewCommissionBudgetRepository(database), commissionRepo, taskRepo)
 
-	// Create note and tome services
-	noteRepo := sqlite.NewNoteRepository(database, logWriter)
+	// Create note and tome services (large note bodies live in blobs/ beside the ledger)
+	dbPath, err := db.GetDBPath()
+	if err != nil {
+		log.Fatalf("failed to resolve database path: %v", err)
+	}
+	noteBlobThreshold := sqlite.DefaultNoteBlobThreshold
+	if planRecorder != nil {
+		noteBlobThreshold = 0 // Keep --plan runs from writing blob files
+	}
+	noteRepo := sqlite.NewNoteRepository(database, logWriter).
+		WithBlobStore(filesystem.NewDirBlobStore(filepath.Join(filepath.Dir(dbPath), "blobs")), noteBlobThreshold)
 	tomeRepo := sqlite.NewTomeRepository(database, logWriter)
 	noteService = app.NewNoteService(noteRepo)
 
+	// Create plan repository and lock service (shipment and plan edits check locks)
+	planRepo := sqlite.NewPlanRepository(database, logWriter)
+	lockService = app.NewLockService(sqlite.NewEntityLockRepository(database), shipmentRepo, planRepo)
+	rateLimitService = app.NewRateLimitService(sqlite.NewRateLimitRepository(database))
+	presenceService = app.NewPresenceService(sqlite.NewPresenceRepository(database))
+	glossaryService = app.NewGlossaryService(sqlite.NewGlossaryRepository(database), commissionRepo, tagRepo, planRepo, noteRepo)
+
 	// Create tome and shipment services
 	tomeService = app.NewTomeService(tomeRepo, noteService)
-	shipmentService = app.NewShipmentService(shipmentRepo, taskRepo, noteService)
-
-	// Create plan repository
-	planRepo := sqlite.NewPlanRepository(database, logWriter)
+	shipmentService = app.NewShipmentService(shipmentRepo, taskRepo, noteService, lockService)
+	autorunService = app.NewAutorunService(shipmentRepo, taskRepo, planRepo)
 
 	// Create tag service
-	tagService = app.NewTagService(tagRepo)
+	tagService = app.NewTagService(tagRepo, tagRouteRepo, workbenchRepo)
 
 	// Create repo and PR services
 	repoRepo := sqlite.NewRepoRepository(database)
 	prRepo := sqlite.NewPRRepository(database)
-	repoService = app.NewRepoService(repoRepo)
+	repoService = app.NewRepoService(repoRepo, workbenchRepo, workspaceAdapter, github.NewGHAuthChecker())
 	prService = app.NewPRService(prRepo, shipmentService)
+	prReviewService = app.NewPRReviewService(prRepo, sqlite.NewPRReviewRepository(database), github.NewGHReviewSource(), taskService)
 
 	// Create factory, workshop, and workbench services
 	factoryRepo := sqlite.NewFactoryRepository(database)
@@ -216,14 +494,66 @@ func initServices() {
 	// workbenchRepo already created early for LogWriter (with nil LogWriter due to circular dependency)
 	factoryService = app.NewFactoryService(factoryRepo)
 	workshopService = app.NewWorkshopService(factoryRepo, workshopRepo, workbenchRepo, repoRepo, tmuxService, workspaceAdapter, executor)
-	workbenchService = app.NewWorkbenchService(workbenchRepo, workshopRepo, repoRepo, agentProvider, executor, workspaceAdapter)
+	workbenchService = app.NewWorkbenchService(workbenchRepo, workshopRepo, repoRepo, agentProvider, executor, workspaceAdapter, sqlite.NewFocusHistoryRepository(database))
+	factoryTeardownService = app.NewFactoryTeardownService(factoryRepo, workshopRepo, workbenchRepo, commissionRepo, shipmentRepo, shipmentService, workspaceAdapter, tmuxService)
 
 	// Create plan service
-	planService = app.NewPlanService(planRepo)
+	planService = app.NewPlanService(planRepo, taskService, lockService)
 
 	// Create log service for activity logs (workshopLogRepo created early for LogWriter)
 	logService = app.NewLogService(workshopLogRepo)
 
+	// Create undo service (reverts logged mutations via the same repositories)
+	undoService = app.NewUndoService(workshopLogRepo, taskRepo, shipmentRepo, noteRepo)
+
+	// Create commit link service (scans workbench branches for entity IDs)
+	commitLinkRepo := sqlite.NewCommitLinkRepository(database)
+	commitLinkService = app.NewCommitLinkService(commitLinkRepo, workbenchRepo, repoRepo, taskRepo, shipmentRepo, workspaceAdapter, shipmentService)
+
+	// Create PR description service (generated from the shipment's plans, tasks, commits, and notes)
+	var bodyStore secondary.PRBodyStore = github.NewGHBodyStore()
+	if planRecorder != nil {
+		bodyStore = app.NewPlanPRBodyStore(bodyStore, planRecorder)
+	}
+	prDescriptionService = app.NewPRDescriptionService(prRepo, bodyStore, shipmentService, planService, noteService, commitLinkService)
+
+	// Create alias service (human-friendly slugs for shipments, tasks, and tomes)
+	aliasService = app.NewAliasService(sqlite.NewAliasRepository(database), shipmentRepo, taskRepo, tomeRepo)
+
+	// Create import service (Jira/Linear CSV exports as shipments and tasks)
+	importService = app.NewImportService(commissionService, shipmentService, taskService, tagService, aliasService)
+
+	// Create flow service (canned multi-step workflows, resumable per step)
+	flowService = app.NewFlowService(sqlite.NewFlowStepRepository(database), shipmentService, taskService, noteService, workbenchService)
+
+	// Create comment service (lightweight remarks on any entity)
+	commentService = app.NewCommentService(sqlite.NewCommentRepository(database), commissionRepo, shipmentRepo, taskRepo, tomeRepo, noteRepo, planRepo)
+
+	// Create secret service (values encrypted with a key kept beside the ledger)
+	secretKeyPath := filepath.Join(filepath.Dir(dbPath), "secret.key")
+	secretService = app.NewSecretService(sqlite.NewSecretRepository(database), filesystem.NewKeyFileCipher(secretKeyPath), factoryRepo, repoRepo)
+
+	// Create workbench env service (variables injected into panes and agent sessions)
+	workbenchEnvService = app.NewWorkbenchEnvService(sqlite.NewWorkbenchEnvRepository(database), workbenchRepo, workshopRepo, secretService)
+
+	// Create workbench stash service (uncommitted work moved between benches with git stash)
+	workbenchStashService = app.NewWorkbenchStashService(sqlite.NewWorkbenchStashRepository(database), workbenchRepo, taskRepo, workspaceAdapter)
+
+	// Create workbench teardown service (delete refuses to lose work, can migrate assignments)
+	workbenchTeardownService = app.NewWorkbenchTeardownService(workbenchRepo, shipmentRepo, taskRepo, prRepo, workspaceAdapter)
+
+	// Create recall service (local embedding index over notes and plans)
+	recallService = app.NewRecallService(sqlite.NewEmbeddingRepository(database), noteRepo, planRepo)
+
+	// Create telemetry service (opt-in per-command timings, for orc debug perf)
+	telemetryService = app.NewTelemetryService(sqlite.NewCommandStatRepository(database))
+
+	// Create metrics service (Prometheus exposition of ledger counts)
+	metricsService = app.NewMetricsService(sqlite.NewMetricsRepository(database), version.Commit)
+
+	// Create webhook service (signed inbound events from CI and GitHub)
+	webhookService = app.NewWebhookService(sqlite.NewWebhookSourceRepository(database), secretService, shipmentService, taskService, noteService, prService)
+
 	// Create hook event service for hook invocation tracking
 	hookEventRepo := sqlite.NewHookEventRepository(database)
 	hookEventService = app.NewHookEventService(hookEventRepo)
@@ -231,16 +561,18 @@ func initServices() {
 	// Create orchestration services
 	commissionOrchestrationService = app.NewCommissionOrchestrationService(commissionService, agentProvider)
 
-	// Create summary service (depends on most other services)
+	// Create ledger service (other ledgers are opened read-only per lookup)
+	ledgerService = app.NewLedgerService(sqlite.NewLedgerRepository(database), sqlite.NewForeignLedgerReader(), dbPath)
+
+	// Create summary service (containers via services, leaves via batched reads)
 	summaryService = app.NewSummaryService(
 		commissionService,
 		tomeService,
 		shipmentService,
-		taskService,
 		noteService,
-		workbenchService,
-		planService,
-	)
+		sqlite.NewSummaryRepository(database),
+	).WithLedgerService(ledgerService)
+	summaryViewService = app.NewSummaryViewService(sqlite.NewSummaryViewRepository(database), workbenchRepo)
 }
 
 // ApplyGlobalTMuxBindings sets up ORC's global tmux key bindings.
@@ -284,6 +616,9 @@ type DesiredWorkbench = tmuxadapter.DesiredWorkbench
 // ApplyPlan re-exports the reconciliation plan type.
 type ApplyPlan = tmuxadapter.ApplyPlan
 
+// DeadPane re-exports the dead workbench pane type.
+type DeadPane = tmuxadapter.DeadPane
+
 // NewGotmuxAdapter creates a new gotmux adapter for programmatic tmux lifecycle management.
 func NewGotmuxAdapter() (*GotmuxAdapter, error) {
 	return tmuxadapter.NewGotmuxAdapter()